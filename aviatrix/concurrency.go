@@ -0,0 +1,34 @@
+package aviatrix
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// runConcurrently calls fn for each index in [0, n) with at most limit calls
+// in flight, and returns the error of each call by index. Unlike an
+// errgroup, a failed call does not stop the others, since callers need to
+// know which of their objects were created or deleted. Once ctx is done,
+// calls that have not started are skipped and get ctx's error.
+func runConcurrently(ctx context.Context, limit, n int, fn func(i int) error) []error {
+	errs := make([]error, n)
+	var g errgroup.Group
+	g.SetLimit(max(limit, 1))
+	for i := range n {
+		if err := ctx.Err(); err != nil {
+			errs[i] = err
+			continue
+		}
+		g.Go(func() error {
+			if err := ctx.Err(); err != nil {
+				errs[i] = err
+				return nil
+			}
+			errs[i] = fn(i)
+			return nil
+		})
+	}
+	_ = g.Wait()
+	return errs
+}
@@ -0,0 +1,60 @@
+package aviatrix
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunConcurrently(t *testing.T) {
+	var inFlight, maxInFlight, calls atomic.Int32
+	errs := runConcurrently(context.Background(), 3, 20, func(i int) error {
+		calls.Add(1)
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		if i%5 == 0 {
+			return errors.New("failed")
+		}
+		return nil
+	})
+
+	assert.Equal(t, int32(20), calls.Load())
+	assert.Equal(t, int32(3), maxInFlight.Load())
+	assert.Len(t, errs, 20)
+	for i, err := range errs {
+		if i%5 == 0 {
+			assert.Error(t, err, "index %d", i)
+		} else {
+			assert.NoError(t, err, "index %d", i)
+		}
+	}
+}
+
+func TestRunConcurrently_ContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls atomic.Int32
+	errs := runConcurrently(ctx, 1, 10, func(i int) error {
+		calls.Add(1)
+		cancel()
+		return nil
+	})
+
+	// The call in flight when ctx is canceled finishes, and the queued ones
+	// are skipped.
+	assert.Equal(t, int32(1), calls.Load())
+	assert.NoError(t, errs[0])
+	for _, err := range errs[1:] {
+		assert.ErrorIs(t, err, context.Canceled)
+	}
+}
@@ -1,15 +1,17 @@
 package aviatrix
 
 import (
-	"crypto/tls"
-	"crypto/x509"
+	"context"
 	_ "embed"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/http"
-	"os"
 	"runtime"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
 )
 
@@ -22,6 +24,12 @@ type Config struct {
 	Username string
 	// Password is the password for accessing the Aviatrix Controller.
 	Password string
+	// APIToken is a pre-issued API token (CID). When set, it is used instead
+	// of Username and Password.
+	APIToken string
+	// APITokenFile is the path to a file holding the API token. It takes
+	// precedence over APIToken and is read again when it changes.
+	APITokenFile string
 	// ControllerIP Is the IP address of the Aviatrix Controller.
 	ControllerIP string
 	// VerifyCert signals whether to verify the server's certificate chain and
@@ -30,61 +38,65 @@ type Config struct {
 	// PathToCACert represents the path to the CA Certificate to use when
 	// communicating with the Aviatrix Controller.
 	PathToCACert string
+	// ClientCert and ClientKey are the client certificate and key, as file
+	// paths or PEM content, presented to proxies that require mutual TLS.
+	ClientCert string
+	ClientKey  string
+	// TLSServerName overrides the host name used for SNI and certificate
+	// verification.
+	TLSServerName string
+	// DefaultTags are added to the tags of every taggable resource.
+	DefaultTags *goaviatrix.DefaultTagsConfig
 	// IgnoreTags represents keys or key prefixes that should be ignored
 	// across all resources handled by this provider for situations where
 	// external systems are managing certain tags.
 	IgnoreTags *goaviatrix.IgnoreTagsConfig
+	// Retry overrides the default policy for retrying failed Controller API
+	// calls.
+	Retry *goaviatrix.RetryPolicy
+	// RateLimit limits the rate and concurrency of Controller API calls.
+	RateLimit *goaviatrix.RateLimit
+	// Cassette records Controller API calls to, or replays them from, a
+	// file.
+	Cassette *goaviatrix.CassetteOptions
 }
 
 // wrapTransport represents an HTTP transport used for setting the user-agent
-// for all requests.
+// and propagating the trace context for all requests.
 type wrapTransport struct {
 	transport http.RoundTripper
 	userAgent string
 }
 
-// RoundTrip implements the HTTP transport interface sending user-agent for all
-// requests.
+// RoundTrip implements the HTTP transport interface sending user-agent and
+// the trace context, if any, for all requests.
 func (wtr *wrapTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	req = req.Clone(req.Context())
 	req.Header.Set("User-Agent", wtr.userAgent)
+	otel.GetTextMapPropagator().Inject(req.Context(), propagation.HeaderCarrier(req.Header))
 	return wtr.transport.RoundTrip(req)
 }
 
 // defaultTransport returns the default HTTP transport to use when accessing the
 // Aviatrix Controller.
-func defaultTransport(caCertPath string, verifyCert bool) (*http.Transport, error) {
-	tlsConfig := &tls.Config{
-		InsecureSkipVerify: !verifyCert, //nolint:gosec // G402: InsecureSkipVerify is controlled by user config
-		MinVersion:         tls.VersionTLS12,
-	}
-
-	if verifyCert && caCertPath != "" {
-		caCert, err := os.ReadFile(caCertPath)
-		if err != nil {
-			return nil, fmt.Errorf("failed to read CA certificate: %w", err)
-		}
-		caCertPool := x509.NewCertPool()
-		if ok := caCertPool.AppendCertsFromPEM(caCert); !ok {
-			return nil, fmt.Errorf("failed to append CA certificate to pool")
-		}
-		tlsConfig.RootCAs = caCertPool
-	}
-
-	return &http.Transport{
-		Proxy:           http.ProxyFromEnvironment,
-		TLSClientConfig: tlsConfig,
-	}, nil
+func (c *Config) defaultTransport() (*http.Transport, error) {
+	return goaviatrix.NewTransport(goaviatrix.TLSOptions{
+		VerifyCert: c.VerifyCert,
+		CACertPath: c.PathToCACert,
+		ClientCert: c.ClientCert,
+		ClientKey:  c.ClientKey,
+		ServerName: c.TLSServerName,
+	})
 }
 
 // getUserAgent returns a string representing the user-agent used by the terraform client.
 func getUserAgent() string {
-	return fmt.Sprintf("terraform-provider-aviatrix/%s (%s; %s; %s)", Version, runtime.GOOS, runtime.GOARCH, runtime.Version())
+	return fmt.Sprintf("terraform-provider-aviatrix/%s (%s; %s; %s) %s", Version, runtime.GOOS, runtime.GOARCH, runtime.Version(), goaviatrix.UserAgent)
 }
 
 // Client returns a client for accessing the Aviatrix Controller
-func (c *Config) Client() (*goaviatrix.Client, error) {
-	tr, err := defaultTransport(c.PathToCACert, c.VerifyCert)
+func (c *Config) Client(ctx context.Context) (*goaviatrix.Client, error) {
+	tr, err := c.defaultTransport()
 	if err != nil {
 		return nil, err
 	}
@@ -94,12 +106,31 @@ func (c *Config) Client() (*goaviatrix.Client, error) {
 		userAgent: getUserAgent(),
 		transport: tr,
 	}
-	client, err := goaviatrix.NewClient(c.Username, c.Password, c.ControllerIP, &http.Client{Transport: wtr}, c.IgnoreTags)
+	opts := []goaviatrix.ClientOption{goaviatrix.WithLogger(slog.New(goaviatrixLogHandler{}))}
+	if c.Retry != nil {
+		opts = append(opts, goaviatrix.WithRetryPolicy(*c.Retry))
+	}
+	if c.RateLimit != nil {
+		opts = append(opts, goaviatrix.WithRateLimit(*c.RateLimit))
+	}
+	if c.DefaultTags != nil {
+		opts = append(opts, goaviatrix.WithDefaultTags(c.DefaultTags))
+	}
+	if c.Cassette != nil {
+		opts = append(opts, goaviatrix.WithCassette(*c.Cassette))
+	}
+	if c.APIToken != "" {
+		opts = append(opts, goaviatrix.WithAPIToken(c.APIToken))
+	}
+	if c.APITokenFile != "" {
+		opts = append(opts, goaviatrix.WithAPITokenFile(c.APITokenFile))
+	}
+	client, err := goaviatrix.NewClient(c.Username, c.Password, c.ControllerIP, &http.Client{Transport: wtr}, c.IgnoreTags, opts...)
 
-	log.Printf("[INFO] Aviatrix Client configured for use")
+	tflog.Info(ctx, "Aviatrix Client configured for use")
 
 	if client == nil || err != nil {
-		log.Printf("[ERROR] unable to create client: %s", err)
+		tflog.Error(ctx, fmt.Sprintf("unable to create client: %s", err))
 	}
 	return client, err
 }
@@ -113,3 +144,53 @@ func mustClient(meta any) *goaviatrix.Client {
 	}
 	panic("internal error: provider meta is not a valid *goaviatrix.Client; check provider configuration")
 }
+
+// mustAccountClient asserts that meta implements goaviatrix.AccountClient,
+// the API used by the account resources. Like the other per-domain helpers
+// below, it lets unit tests pass a generated mock instead of a live client.
+func mustAccountClient(meta any) goaviatrix.AccountClient {
+	if client, ok := meta.(goaviatrix.AccountClient); ok && client != nil {
+		return client
+	}
+	panic("internal error: provider meta is not a valid goaviatrix.AccountClient; check provider configuration")
+}
+
+// mustGatewayClient asserts that meta implements goaviatrix.GatewayClient.
+func mustGatewayClient(meta any) goaviatrix.GatewayClient {
+	if client, ok := meta.(goaviatrix.GatewayClient); ok && client != nil {
+		return client
+	}
+	panic("internal error: provider meta is not a valid goaviatrix.GatewayClient; check provider configuration")
+}
+
+// mustDCFClient asserts that meta implements goaviatrix.DCFClient.
+func mustDCFClient(meta any) goaviatrix.DCFClient {
+	if client, ok := meta.(goaviatrix.DCFClient); ok && client != nil {
+		return client
+	}
+	panic("internal error: provider meta is not a valid goaviatrix.DCFClient; check provider configuration")
+}
+
+// mustSegmentationClient asserts that meta implements goaviatrix.SegmentationClient.
+func mustSegmentationClient(meta any) goaviatrix.SegmentationClient {
+	if client, ok := meta.(goaviatrix.SegmentationClient); ok && client != nil {
+		return client
+	}
+	panic("internal error: provider meta is not a valid goaviatrix.SegmentationClient; check provider configuration")
+}
+
+// mustSite2CloudClient asserts that meta implements goaviatrix.Site2CloudClient.
+func mustSite2CloudClient(meta any) goaviatrix.Site2CloudClient {
+	if client, ok := meta.(goaviatrix.Site2CloudClient); ok && client != nil {
+		return client
+	}
+	panic("internal error: provider meta is not a valid goaviatrix.Site2CloudClient; check provider configuration")
+}
+
+// mustEdgeClient asserts that meta implements goaviatrix.EdgeClient.
+func mustEdgeClient(meta any) goaviatrix.EdgeClient {
+	if client, ok := meta.(goaviatrix.EdgeClient); ok && client != nil {
+		return client
+	}
+	panic("internal error: provider meta is not a valid goaviatrix.EdgeClient; check provider configuration")
+}
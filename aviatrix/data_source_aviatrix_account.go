@@ -1,10 +1,11 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAviatrixAccount() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAviatrixAccountRead,
+		ReadWithoutTimeout: withContext(dataSourceAviatrixAccountRead),
 
 		Schema: map[string]*schema.Schema{
 			"account_name": {
@@ -119,14 +120,14 @@ func dataSourceAviatrixAccount() *schema.Resource {
 	}
 }
 
-func dataSourceAviatrixAccountRead(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func dataSourceAviatrixAccountRead(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustAccountClient(meta)
 
 	account := &goaviatrix.Account{
 		AccountName: getString(d, "account_name"),
 	}
 
-	log.Printf("[INFO] Looking for Aviatrix account: %#v", account)
+	tflog.Info(ctx, fmt.Sprintf("Looking for Aviatrix account: %#v", goaviatrix.Redact(account)))
 
 	acc, err := client.GetAccount(account)
 	if err != nil {
@@ -1,15 +1,17 @@
 package aviatrix
 
 import (
-	"log"
+	"context"
+	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAviatrixCallerIdentity() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAviatrixCallerIdentityRead,
+		ReadWithoutTimeout: withContext(dataSourceAviatrixCallerIdentityRead),
 
 		Schema: map[string]*schema.Schema{
 			"cid": {
@@ -21,12 +23,12 @@ func dataSourceAviatrixCallerIdentity() *schema.Resource {
 	}
 }
 
-func dataSourceAviatrixCallerIdentityRead(d *schema.ResourceData, meta any) error {
+func dataSourceAviatrixCallerIdentityRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
-	log.Printf("[DEBUG] CID is '%s'", client.CID)
+	tflog.Debug(ctx, fmt.Sprintf("CID is '%s'", client.GetCID()))
 
 	d.SetId(time.Now().UTC().String())
-	mustSet(d, "cid", client.CID)
+	mustSet(d, "cid", client.GetCID())
 	return nil
 }
@@ -52,7 +52,9 @@ func testAccDataSourceAviatrixCallerIdentity(name string) resource.TestCheckFunc
 		}
 
 		client := mustClient(testAccProvider.Meta())
-		client.CID = rs.Primary.Attributes["cid"]
+		if cid := rs.Primary.Attributes["cid"]; cid != client.GetCID() {
+			return fmt.Errorf("CID %q is not the session of the provider", cid)
+		}
 
 		version, err := client.GetCurrentVersion()
 		if err != nil {
@@ -0,0 +1,197 @@
+package aviatrix
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
+)
+
+func dataSourceAviatrixConnectionStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAviatrixConnectionStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"connection_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					connectionTypeSite2Cloud,
+					connectionTypeExternalDevice,
+					connectionTypeTransitPeering,
+					connectionTypeSpokeTransitAttachment,
+				}, false),
+				Description: "Type of the connection: `site2cloud`, `external_device`, `transit_peering` or `spoke_transit_attachment`.",
+			},
+			"connection_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the connection. Required for `site2cloud` and `external_device` connections.",
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "VPC ID of the connection. Required for `site2cloud` and `external_device` connections.",
+			},
+			"gateway1": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "First transit gateway of a `transit_peering`, or spoke gateway of a `spoke_transit_attachment`.",
+			},
+			"gateway2": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Second transit gateway of a `transit_peering`, or transit gateway of a `spoke_transit_attachment`.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "`up` if every tunnel is up, `down` if none is, and `partial` otherwise.",
+			},
+			"tunnels": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Status of each tunnel of the connection.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"gw_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Gateway terminating the tunnel. For peerings, `gateway1` or its HA gateway.",
+						},
+						"ha": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the tunnel is on the HA gateway of the connection.",
+						},
+						"local_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IP address of the gateway end of the tunnel. Empty for peerings.",
+						},
+						"remote_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IP address of the remote end of the tunnel. Empty for peerings.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the tunnel as reported by the Controller, e.g. `up` or `down`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Values of connection_type. External device connections are site2cloud
+// connections on the Controller, and transit peerings and spoke-transit
+// attachments are encrypted peerings between two gateways.
+const (
+	connectionTypeSite2Cloud             = "site2cloud"
+	connectionTypeExternalDevice         = "external_device"
+	connectionTypeTransitPeering         = "transit_peering"
+	connectionTypeSpokeTransitAttachment = "spoke_transit_attachment"
+)
+
+func dataSourceAviatrixConnectionStatusRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := mustClient(meta)
+
+	connType := getString(d, "connection_type")
+	connName := getString(d, "connection_name")
+	vpcID := getString(d, "vpc_id")
+	gateway1 := getString(d, "gateway1")
+	gateway2 := getString(d, "gateway2")
+
+	var id []string
+	var tunnels []map[string]any
+	switch connType {
+	case connectionTypeSite2Cloud, connectionTypeExternalDevice:
+		if connName == "" || vpcID == "" {
+			return diag.Errorf("`connection_name` and `vpc_id` are required for %s connections", connType)
+		}
+		if gateway1 != "" || gateway2 != "" {
+			return diag.Errorf("`gateway1` and `gateway2` must be empty for %s connections", connType)
+		}
+		id = []string{connName, vpcID}
+
+		status, err := client.GetSite2CloudConnStatus(ctx, connName, vpcID)
+		if err != nil {
+			if errors.Is(err, goaviatrix.ErrNotFound) {
+				return diag.Errorf("connection %s in %s not found", connName, vpcID)
+			}
+			return diag.Errorf("failed to get connection status: %v", err)
+		}
+		for _, t := range status.Tunnels {
+			tunnels = append(tunnels, map[string]any{
+				"gw_name":   t.GwName,
+				"ha":        status.HaGwName != "" && t.GwName == status.HaGwName,
+				"local_ip":  t.IPAddr,
+				"remote_ip": t.PeerIP,
+				"status":    t.Status,
+			})
+		}
+	default:
+		if gateway1 == "" || gateway2 == "" {
+			return diag.Errorf("`gateway1` and `gateway2` are required for %s connections", connType)
+		}
+		if connName != "" || vpcID != "" {
+			return diag.Errorf("`connection_name` and `vpc_id` must be empty for %s connections", connType)
+		}
+		id = []string{gateway1, gateway2}
+
+		status, err := client.GetPeeringStatus(ctx, gateway1, gateway2)
+		if err != nil {
+			if errors.Is(err, goaviatrix.ErrNotFound) {
+				return diag.Errorf("%s between %s and %s not found", connType, gateway1, gateway2)
+			}
+			return diag.Errorf("failed to get connection status: %v", err)
+		}
+		tunnels = append(tunnels, map[string]any{
+			"gw_name": status.GwName,
+			"ha":      false,
+			"status":  status.State,
+		})
+		if status.HaGwName != "" {
+			tunnels = append(tunnels, map[string]any{
+				"gw_name": status.HaGwName,
+				"ha":      true,
+				"status":  status.HaState,
+			})
+		}
+	}
+
+	up := 0
+	for _, t := range tunnels {
+		if tunnelUp(t["status"].(string)) {
+			up++
+		}
+	}
+	switch {
+	case len(tunnels) != 0 && up == len(tunnels):
+		mustSet(d, "status", "up")
+	case up == 0:
+		mustSet(d, "status", "down")
+	default:
+		mustSet(d, "status", "partial")
+	}
+	if err := d.Set("tunnels", tunnels); err != nil {
+		return diag.Errorf("failed to set tunnels: %v", err)
+	}
+
+	d.SetId(strings.Join(append([]string{connType}, id...), "~"))
+	return nil
+}
+
+// tunnelUp reports whether a tunnel or peering status means it is up. The
+// Controller reports "up" for site2cloud tunnels and "active" for peerings.
+func tunnelUp(status string) bool {
+	return strings.EqualFold(status, "up") || strings.EqualFold(status, "active")
+}
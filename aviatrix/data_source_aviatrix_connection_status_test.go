@@ -0,0 +1,156 @@
+package aviatrix
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix/controllertest"
+)
+
+// testSite2CloudConnDetail is the results of get_site2cloud_conn_detail for
+// an HA connection with its HA tunnel down, in the shape decoded by
+// GetSite2CloudConnDetail.
+const testSite2CloudConnDetail = `{
+	"connections": {
+		"name": ["s2c"],
+		"vpc_id": ["vpc-1"],
+		"type": "unmapped",
+		"tunnel_type": "route",
+		"gw_name": "spoke-1",
+		"ha_status": "enabled",
+		"peer_type": "generic",
+		"algorithm": {
+			"ph1_auth": ["SHA-256"], "ph1_dh": ["14"], "ph1_encr": ["AES-256-CBC"],
+			"ph2_auth": ["HMAC-SHA-256"], "ph2_dh": ["14"], "ph2_encr": ["AES-256-CBC"]
+		},
+		"tunnels": [
+			{"gw_name": "spoke-1", "ip_addr": "10.1.0.4", "peer_ip": "198.51.100.1", "status": "up"},
+			{"gw_name": "spoke-1-hagw", "ip_addr": "10.1.0.5", "peer_ip": "198.51.100.2", "status": "down"}
+		]
+	}
+}`
+
+// handleTestGateways answers list_vpcs_summary for spoke-1, whose HA gateway
+// is spoke-1-hagw, and transit-1 and transit-2, which have none.
+func handleTestGateways(s *controllertest.Server) {
+	s.HandleAction("list_vpcs_summary", func(p controllertest.Params) (any, error) {
+		gws := []map[string]any{
+			{"vpc_name": "spoke-1", "group_name": "spoke-grp", "hagw_details": map[string]any{"vpc_name": "spoke-1-hagw"}},
+			{"vpc_name": "transit-1"},
+			{"vpc_name": "transit-2"},
+		}
+		for _, gw := range gws {
+			if gw["vpc_name"] == p.String("gateway_name") {
+				return []map[string]any{gw}, nil
+			}
+		}
+		return []map[string]any{}, nil
+	})
+}
+
+func TestDataSourceAviatrixConnectionStatusRead(t *testing.T) {
+	s, meta := testFakeControllerMeta(t)
+	handleTestGateways(s)
+	s.HandleAction("get_site2cloud_conn_detail", func(p controllertest.Params) (any, error) {
+		if p.String("conn_name") != "s2c" || p.String("vpc_id") != "vpc-1" {
+			return nil, fmt.Errorf("connection %s does not exist", p.String("conn_name"))
+		}
+		return json.RawMessage(testSite2CloudConnDetail), nil
+	})
+	ds := dataSourceAviatrixConnectionStatus()
+
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]any{
+		"connection_type": "site2cloud",
+		"connection_name": "s2c",
+		"vpc_id":          "vpc-1",
+	})
+	require.False(t, dataSourceAviatrixConnectionStatusRead(context.Background(), d, meta).HasError())
+	assert.Equal(t, "site2cloud~s2c~vpc-1", d.Id())
+	assert.Equal(t, "partial", d.Get("status"))
+	assert.Equal(t, 2, d.Get("tunnels.#"))
+	assert.Equal(t, "10.1.0.4", d.Get("tunnels.0.local_ip"))
+	assert.Equal(t, "down", d.Get("tunnels.1.status"))
+
+	// The HA side is the tunnel on the HA gateway of the primary gateway,
+	// which the aviatrix_site2cloud resource reads as the backup gateway.
+	conn, err := mustClient(meta).GetSite2CloudConnDetail(&goaviatrix.Site2Cloud{TunnelName: "s2c", VpcID: "vpc-1"})
+	require.NoError(t, err)
+	assert.Equal(t, conn.GwName, d.Get("tunnels.0.gw_name"))
+	assert.Equal(t, false, d.Get("tunnels.0.ha"))
+	assert.Equal(t, conn.RemoteGwIP, d.Get("tunnels.0.remote_ip"))
+	assert.Equal(t, conn.BackupGwName, d.Get("tunnels.1.gw_name"))
+	assert.Equal(t, true, d.Get("tunnels.1.ha"))
+	assert.Equal(t, conn.RemoteGwIP2, d.Get("tunnels.1.remote_ip"))
+
+	d = schema.TestResourceDataRaw(t, ds.Schema, map[string]any{
+		"connection_type": "external_device",
+		"connection_name": "s2c-2",
+		"vpc_id":          "vpc-1",
+	})
+	diags := dataSourceAviatrixConnectionStatusRead(context.Background(), d, meta)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "connection s2c-2 in vpc-1 not found", diags[0].Summary)
+
+	d = schema.TestResourceDataRaw(t, ds.Schema, map[string]any{
+		"connection_type": "site2cloud",
+		"gateway1":        "spoke-1",
+		"gateway2":        "transit-1",
+	})
+	diags = dataSourceAviatrixConnectionStatusRead(context.Background(), d, meta)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "`connection_name` and `vpc_id` are required for site2cloud connections", diags[0].Summary)
+}
+
+func TestDataSourceAviatrixConnectionStatusRead_Peering(t *testing.T) {
+	s, meta := testFakeControllerMeta(t)
+	handleTestGateways(s)
+	s.HandleAction("list_peer_vpc_pairs", func(controllertest.Params) (any, error) {
+		return map[string]any{"pair_list": []map[string]any{
+			{"vpc_name1": "spoke-grp", "vpc_name2": "transit-1", "peering_state": "active", "peering_ha_status": "down"},
+			{"vpc_name1": "transit-1", "vpc_name2": "transit-2", "peering_state": "active", "peering_ha_status": "disabled"},
+		}}, nil
+	})
+	ds := dataSourceAviatrixConnectionStatus()
+
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]any{
+		"connection_type": "spoke_transit_attachment",
+		"gateway1":        "spoke-1",
+		"gateway2":        "transit-1",
+	})
+	require.False(t, dataSourceAviatrixConnectionStatusRead(context.Background(), d, meta).HasError())
+	assert.Equal(t, "spoke_transit_attachment~spoke-1~transit-1", d.Id())
+	assert.Equal(t, "partial", d.Get("status"))
+	assert.Equal(t, 2, d.Get("tunnels.#"))
+	assert.Equal(t, "spoke-1", d.Get("tunnels.0.gw_name"))
+	assert.Equal(t, false, d.Get("tunnels.0.ha"))
+	assert.Equal(t, "active", d.Get("tunnels.0.status"))
+	assert.Equal(t, "spoke-1-hagw", d.Get("tunnels.1.gw_name"))
+	assert.Equal(t, true, d.Get("tunnels.1.ha"))
+	assert.Equal(t, "down", d.Get("tunnels.1.status"))
+
+	// Without an HA gateway only the primary side is reported.
+	d = schema.TestResourceDataRaw(t, ds.Schema, map[string]any{
+		"connection_type": "transit_peering",
+		"gateway1":        "transit-2",
+		"gateway2":        "transit-1",
+	})
+	require.False(t, dataSourceAviatrixConnectionStatusRead(context.Background(), d, meta).HasError())
+	assert.Equal(t, "up", d.Get("status"))
+	assert.Equal(t, 1, d.Get("tunnels.#"))
+
+	d = schema.TestResourceDataRaw(t, ds.Schema, map[string]any{
+		"connection_type": "transit_peering",
+		"gateway1":        "transit-1",
+		"gateway2":        "transit-3",
+	})
+	diags := dataSourceAviatrixConnectionStatusRead(context.Background(), d, meta)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "transit_peering between transit-1 and transit-3 not found", diags[0].Summary)
+}
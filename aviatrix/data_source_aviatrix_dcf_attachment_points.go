@@ -27,7 +27,7 @@ func dataSourceAviatrixDcfAttachmentPoints() *schema.Resource {
 }
 
 func dataSourceAviatrixDcfAttachmentPointsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	name := getString(d, "name")
 
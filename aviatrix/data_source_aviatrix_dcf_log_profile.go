@@ -36,7 +36,7 @@ func dataSourceAviatrixDcfLogProfile() *schema.Resource {
 }
 
 func dataSourceAviatrixDcfLogProfileRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	profileName := getString(d, "profile_name")
 
@@ -51,7 +51,7 @@ func dataSourceAviatrixDCFMitmCa() *schema.Resource {
 }
 
 func dataSourceAviatrixDCFMitmCaRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	name := getString(d, "name")
 
@@ -44,7 +44,7 @@ func dataSourceAviatrixDCFTLSProfile() *schema.Resource {
 }
 
 func dataSourceAviatrixDCFTLSProfileRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	displayName := getString(d, "display_name")
 
@@ -40,7 +40,7 @@ func dataSourceAviatrixDcfTrustbundle() *schema.Resource {
 }
 
 func dataSourceAviatrixDcfTrustbundleRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	name := getString(d, "display_name")
 
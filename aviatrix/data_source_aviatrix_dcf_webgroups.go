@@ -53,7 +53,7 @@ func dataSourceAviatrixDcfWebgroups() *schema.Resource {
 }
 
 func dataSourceAviatrixDcfWebgroupsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	name := getString(d, "name")
 
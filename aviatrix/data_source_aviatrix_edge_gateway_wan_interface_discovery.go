@@ -33,7 +33,7 @@ func dataSourceAviatrixEdgeGatewayWanInterfaceDiscovery() *schema.Resource {
 }
 
 func dataSourceAviatrixEdgeGatewayWanInterfaceDiscoveryRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	gwName := getString(d, "gw_name")
 	wanInterfaceName := getString(d, "wan_interface_name")
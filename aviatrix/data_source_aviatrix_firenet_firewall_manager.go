@@ -2,6 +2,7 @@ package aviatrix
 
 import (
 	"context"
+	"os"
 	"strings"
 	"time"
 
@@ -78,7 +79,7 @@ func dataSourceAviatrixFireNetFirewallManager() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Sensitive:   true,
-				Description: "Panorama login password for API calls.",
+				Description: "Panorama login password for API calls. If unset, the AVIATRIX_FIREWALL_MANAGER_PASSWORD environment variable is used, which keeps the password out of the state.",
 			},
 			"template": {
 				Type:        schema.TypeString,
@@ -167,6 +168,16 @@ func dataSourceAviatrixFireNetFirewallManager() *schema.Resource {
 	}
 }
 
+// firewallManagerPassword returns the Panorama password from the configuration
+// or, when unset, from the environment. Data sources cannot take write-only
+// arguments, so the environment is the way to keep the password out of state.
+func firewallManagerPassword(d *schema.ResourceData) string {
+	if password := getString(d, "password"); password != "" {
+		return password
+	}
+	return os.Getenv("AVIATRIX_FIREWALL_MANAGER_PASSWORD")
+}
+
 func dataSourceAviatrixFireNetFirewallManagerRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := mustClient(meta)
 
@@ -176,7 +187,7 @@ func dataSourceAviatrixFireNetFirewallManagerRead(ctx context.Context, d *schema
 		VendorType:  getString(d, "vendor_type"),
 		PublicIP:    getString(d, "public_ip"),
 		Username:    getString(d, "username"),
-		Password:    getString(d, "password"),
+		Password:    firewallManagerPassword(d),
 		Save:        getBool(d, "save"),
 		Synchronize: getBool(d, "synchronize"),
 		ConfigMode:  getString(d, "config_mode"),
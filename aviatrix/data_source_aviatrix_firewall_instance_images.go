@@ -1,6 +1,7 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
 	"sort"
 
@@ -9,7 +10,7 @@ import (
 
 func dataSourceAviatrixFirewallInstanceImages() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAviatrixFirewallInstanceImagesRead,
+		ReadWithoutTimeout: withContext(dataSourceAviatrixFirewallInstanceImagesRead),
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -52,7 +53,7 @@ func dataSourceAviatrixFirewallInstanceImages() *schema.Resource {
 	}
 }
 
-func dataSourceAviatrixFirewallInstanceImagesRead(d *schema.ResourceData, meta any) error {
+func dataSourceAviatrixFirewallInstanceImagesRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	vpcId := getString(d, "vpc_id")
@@ -72,7 +73,7 @@ func dataSourceAviatrixFirewallInstanceImagesRead(d *schema.ResourceData, meta a
 		fI["firewall_image"] = image.Image
 		versionList := image.Version
 		sort.Slice(versionList, func(i, j int) bool {
-			return sortVersion(versionList, i, j, image.Image)
+			return sortVersion(ctx, versionList, i, j, image.Image)
 		})
 		fI["firewall_image_version"] = versionList
 		sizeList := image.Size
@@ -1,12 +1,13 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAviatrixGateway() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAviatrixGatewayRead,
+		ReadWithoutTimeout: withContext(dataSourceAviatrixGatewayRead),
 
 		Schema: map[string]*schema.Schema{
 			"gw_name": {
@@ -436,8 +437,8 @@ func dataSourceAviatrixGateway() *schema.Resource {
 	}
 }
 
-func dataSourceAviatrixGatewayRead(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func dataSourceAviatrixGatewayRead(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustGatewayClient(meta)
 
 	gateway := &goaviatrix.Gateway{
 		GwName: getString(d, "gw_name"),
@@ -670,7 +671,7 @@ func dataSourceAviatrixGatewayRead(d *schema.ResourceData, meta any) error {
 
 		peeringHaGateway := &goaviatrix.Gateway{
 			AccountName: getString(d, "account_name"),
-			GwName:      getString(d, "gw_name") + "-hagw",
+			GwName:      goaviatrix.HaGatewayName(getString(d, "gw_name")),
 		}
 		gwHaGw, _ := client.GetGateway(peeringHaGateway)
 		if gwHaGw != nil {
@@ -687,7 +688,7 @@ func dataSourceAviatrixGatewayRead(d *schema.ResourceData, meta any) error {
 				if len(azureEip) == 3 {
 					mustSet(d, "peering_ha_azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 				} else {
-					log.Printf("[WARN] could not get Azure EIP name and resource group for the Peering HA Gateway %s", gw.GwName)
+					tflog.Warn(ctx, fmt.Sprintf("could not get Azure EIP name and resource group for the Peering HA Gateway %s", gw.GwName))
 				}
 			}
 			if !gw.IsPsfGateway {
@@ -729,11 +730,11 @@ func dataSourceAviatrixGatewayRead(d *schema.ResourceData, meta any) error {
 
 			_, err := client.GetTags(tags)
 			if err != nil {
-				log.Printf("[WARN] Failed to get tags for gateway %s: %v", tags.ResourceName, err)
+				tflog.Warn(ctx, fmt.Sprintf("Failed to get tags for gateway %s: %v", tags.ResourceName, err))
 			}
 			if len(tags.Tags) > 0 {
 				if err := d.Set("tags", tags.Tags); err != nil {
-					log.Printf("[WARN] Error setting tags for gateway %s: %v", tags.ResourceName, err)
+					tflog.Warn(ctx, fmt.Sprintf("Error setting tags for gateway %s: %v", tags.ResourceName, err))
 				}
 			}
 		}
@@ -805,7 +806,7 @@ func dataSourceAviatrixGatewayRead(d *schema.ResourceData, meta any) error {
 			if len(azureEip) == 3 {
 				mustSet(d, "azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 			} else {
-				log.Printf("[WARN] could not get Azure EIP name and resource group for the Gateway %s", gw.GwName)
+				tflog.Warn(ctx, fmt.Sprintf("could not get Azure EIP name and resource group for the Gateway %s", gw.GwName))
 			}
 		}
 	}
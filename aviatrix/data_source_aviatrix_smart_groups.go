@@ -139,7 +139,7 @@ func dataSourceAviatrixSmartGroups() *schema.Resource {
 }
 
 func dataSourceAviatrixSmartGroupsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	smartGroups, err := client.GetSmartGroups(ctx)
 	if err != nil {
@@ -191,6 +191,6 @@ func dataSourceAviatrixSmartGroupsRead(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("couldn't set smart_groups: %s", err)
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return nil
 }
@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAviatrixSpokeGateway() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAviatrixSpokeGatewayRead,
+		ReadWithoutTimeout: withContext(dataSourceAviatrixSpokeGatewayRead),
 
 		Schema: map[string]*schema.Schema{
 			"gw_name": {
@@ -403,8 +404,8 @@ func dataSourceAviatrixSpokeGateway() *schema.Resource {
 	}
 }
 
-func dataSourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func dataSourceAviatrixSpokeGatewayRead(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustGatewayClient(meta)
 
 	gateway := &goaviatrix.Gateway{
 		GwName: getString(d, "gw_name"),
@@ -532,7 +533,7 @@ func dataSourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta any) error
 
 		haGateway := &goaviatrix.Gateway{
 			AccountName: getString(d, "account_name"),
-			GwName:      getString(d, "gw_name") + "-hagw",
+			GwName:      goaviatrix.HaGatewayName(getString(d, "gw_name")),
 		}
 		haGw, _ := client.GetGateway(haGateway)
 		if haGw != nil {
@@ -572,7 +573,7 @@ func dataSourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta any) error
 				if len(azureEip) == 3 {
 					mustSet(d, "ha_azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 				} else {
-					log.Printf("[WARN] could not get Azure EIP name and resource group for the HA Gateway %s", gw.GwName)
+					tflog.Warn(ctx, fmt.Sprintf("could not get Azure EIP name and resource group for the HA Gateway %s", gw.GwName))
 				}
 			}
 		}
@@ -586,11 +587,11 @@ func dataSourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta any) error
 
 			_, err := client.GetTags(tags)
 			if err != nil {
-				log.Printf("[WARN] Failed to get tags for spoke gateway %s: %v", tags.ResourceName, err)
+				tflog.Warn(ctx, fmt.Sprintf("Failed to get tags for spoke gateway %s: %v", tags.ResourceName, err))
 			}
 			if len(tags.Tags) > 0 {
 				if err := d.Set("tags", tags.Tags); err != nil {
-					log.Printf("[WARN] Error setting tags for spoke gateway %s: %v", tags.ResourceName, err)
+					tflog.Warn(ctx, fmt.Sprintf("Error setting tags for spoke gateway %s: %v", tags.ResourceName, err))
 				}
 			}
 		}
@@ -653,7 +654,7 @@ func dataSourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta any) error
 			if len(azureEip) == 3 {
 				mustSet(d, "azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 			} else {
-				log.Printf("[WARN] could not get Azure EIP name and resource group for the Spoke Gateway %s", gw.GwName)
+				tflog.Warn(ctx, fmt.Sprintf("could not get Azure EIP name and resource group for the Spoke Gateway %s", gw.GwName))
 			}
 		}
 		mustSet(d, "private_route_table_config", gw.PrivateRouteTableConfig)
@@ -308,7 +308,7 @@ func dataSourceAviatrixSpokeGateways() *schema.Resource {
 }
 
 func dataSourceAviatrixSpokeGatewaysRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustGatewayClient(meta)
 
 	SpokeGatewayList, err := client.GetSpokeGatewayList(ctx)
 	if err != nil {
@@ -488,6 +488,6 @@ func dataSourceAviatrixSpokeGatewaysRead(ctx context.Context, d *schema.Resource
 	if err = d.Set("gateway_list", result); err != nil {
 		return diag.Errorf("couldn't set gateway_list: %s", err)
 	}
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return nil
 }
@@ -1,12 +1,13 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAviatrixTransitGateway() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAviatrixTransitGatewayRead,
+		ReadWithoutTimeout: withContext(dataSourceAviatrixTransitGatewayRead),
 
 		Schema: map[string]*schema.Schema{
 			"gw_name": {
@@ -507,8 +508,8 @@ func dataSourceAviatrixTransitGateway() *schema.Resource {
 	}
 }
 
-func dataSourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func dataSourceAviatrixTransitGatewayRead(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustGatewayClient(meta)
 
 	gateway := &goaviatrix.Gateway{
 		GwName: getString(d, "gw_name"),
@@ -675,11 +676,11 @@ func dataSourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta any) erro
 
 			_, err := client.GetTags(tags)
 			if err != nil {
-				log.Printf("[WARN] Failed to get tags for transit gateway %s: %v", tags.ResourceName, err)
+				tflog.Warn(ctx, fmt.Sprintf("Failed to get tags for transit gateway %s: %v", tags.ResourceName, err))
 			}
 			if len(tags.Tags) > 0 {
 				if err := d.Set("tags", tags.Tags); err != nil {
-					log.Printf("[WARN] Error setting tags for transit gateway %s: %v", tags.ResourceName, err)
+					tflog.Warn(ctx, fmt.Sprintf("Error setting tags for transit gateway %s: %v", tags.ResourceName, err))
 				}
 			}
 		}
@@ -691,7 +692,7 @@ func dataSourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta any) erro
 
 		haGateway := &goaviatrix.Gateway{
 			AccountName: getString(d, "account_name"),
-			GwName:      getString(d, "gw_name") + "-hagw",
+			GwName:      goaviatrix.HaGatewayName(getString(d, "gw_name")),
 		}
 		haGw, _ := client.GetGateway(haGateway)
 		if haGw != nil {
@@ -732,13 +733,13 @@ func dataSourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta any) erro
 				if len(azureEip) == 3 {
 					mustSet(d, "ha_azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 				} else {
-					log.Printf("[WARN] could not get Azure EIP name and resource group for the HA Gateway %s", gw.GwName)
+					tflog.Warn(ctx, fmt.Sprintf("could not get Azure EIP name and resource group for the HA Gateway %s", gw.GwName))
 				}
 			}
 
 			lanCidr, err := client.GetTransitGatewayLanCidr(gw.HaGw.GwName)
 			if err != nil && !errors.Is(err, goaviatrix.ErrNotFound) {
-				log.Printf("[WARN] Error getting lan cidr for HA transit gateway %s due to %s", gw.HaGw.GwName, err)
+				tflog.Warn(ctx, fmt.Sprintf("Error getting lan cidr for HA transit gateway %s due to %s", gw.HaGw.GwName, err))
 			}
 			mustSet(d, "ha_lan_interface_cidr", lanCidr)
 		}
@@ -860,13 +861,13 @@ func dataSourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta any) erro
 			if len(azureEip) == 3 {
 				mustSet(d, "azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 			} else {
-				log.Printf("[WARN] could not get Azure EIP name and resource group for the Transit Gateway %s", gw.GwName)
+				tflog.Warn(ctx, fmt.Sprintf("could not get Azure EIP name and resource group for the Transit Gateway %s", gw.GwName))
 			}
 		}
 
 		lanCidr, err := client.GetTransitGatewayLanCidr(gw.GwName)
 		if err != nil && !errors.Is(err, goaviatrix.ErrNotFound) {
-			log.Printf("[WARN] Error getting lan cidr for transit gateway %s due to %s", gw.GwName, err)
+			tflog.Warn(ctx, fmt.Sprintf("Error getting lan cidr for transit gateway %s due to %s", gw.GwName, err))
 		}
 		mustSet(d, "lan_interface_cidr", lanCidr)
 	}
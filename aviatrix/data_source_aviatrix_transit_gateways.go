@@ -3,10 +3,10 @@ package aviatrix
 import (
 	"context"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -367,7 +367,7 @@ func dataSourceAviatrixTransitGateways() *schema.Resource {
 }
 
 func dataSourceAviatrixTransitGatewaysRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustGatewayClient(meta)
 
 	TransitGatewayList, err := client.GetTransitGatewayList(ctx)
 	if err != nil {
@@ -546,7 +546,7 @@ func dataSourceAviatrixTransitGatewaysRead(ctx context.Context, d *schema.Resour
 			if len(azureEip) == 3 {
 				transitGateway["azure_eip_name_resource_group"] = fmt.Sprintf("%s:%s", azureEip[0], azureEip[1])
 			} else {
-				log.Printf("[WARN] could not get Azure EIP name and resource group for the Transit Gateway %s", gw.GwName)
+				tflog.Warn(ctx, fmt.Sprintf("could not get Azure EIP name and resource group for the Transit Gateway %s", gw.GwName))
 			}
 		}
 
@@ -556,6 +556,6 @@ func dataSourceAviatrixTransitGatewaysRead(ctx context.Context, d *schema.Resour
 	if err = d.Set("gateway_list", result); err != nil {
 		return diag.Errorf("couldn't set gateway_list: %s", err)
 	}
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return nil
 }
@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,7 +16,7 @@ import (
 
 func dataSourceAviatrixVpc() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAviatrixVpcRead,
+		ReadWithoutTimeout: withContext(dataSourceAviatrixVpcRead),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -185,7 +186,7 @@ func dataSourceAviatrixVpc() *schema.Resource {
 	}
 }
 
-func dataSourceAviatrixVpcRead(d *schema.ResourceData, meta any) error {
+func dataSourceAviatrixVpcRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	vpc := &goaviatrix.Vpc{
@@ -265,7 +266,7 @@ func dataSourceAviatrixVpcRead(d *schema.ResourceData, meta any) error {
 		subnetList = append(subnetList, sub)
 	}
 	if err := d.Set("subnets", subnetList); err != nil {
-		log.Printf("[WARN] Error setting subnets for (%s): %s", d.Id(), err)
+		tflog.Warn(ctx, fmt.Sprintf("Error setting subnets for (%s): %s", d.Id(), err))
 	}
 
 	var privateSubnetList []map[string]string
@@ -287,10 +288,10 @@ func dataSourceAviatrixVpcRead(d *schema.ResourceData, meta any) error {
 		publicSubnetList = append(publicSubnetList, sub)
 	}
 	if err := d.Set("private_subnets", privateSubnetList); err != nil {
-		log.Printf("[WARN] Error setting 'private_subnets' for (%s): %s", d.Id(), err)
+		tflog.Warn(ctx, fmt.Sprintf("Error setting 'private_subnets' for (%s): %s", d.Id(), err))
 	}
 	if err := d.Set("public_subnets", publicSubnetList); err != nil {
-		log.Printf("[WARN] Error setting 'public_subnets' for (%s): %s", d.Id(), err)
+		tflog.Warn(ctx, fmt.Sprintf("Error setting 'public_subnets' for (%s): %s", d.Id(), err))
 	}
 
 	if goaviatrix.IsCloudType(vC.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
@@ -309,7 +310,7 @@ func dataSourceAviatrixVpcRead(d *schema.ResourceData, meta any) error {
 		}
 
 		if err := d.Set("route_tables", rtbs); err != nil {
-			log.Printf("[WARN] Error setting route tables for (%s): %s", d.Id(), err)
+			tflog.Warn(ctx, fmt.Sprintf("Error setting route tables for (%s): %s", d.Id(), err))
 		}
 	}
 
@@ -0,0 +1,778 @@
+package aviatrix
+
+import (
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
+)
+
+func DiffSuppressFuncRtbList1(k, old, new string, d *schema.ResourceData) bool {
+	o, n := d.GetChange("rtb_list1")
+
+	oList, ok := o.([]interface{})
+	if !ok {
+		return false
+	}
+	nList, ok := n.([]interface{})
+	if !ok {
+		return false
+	}
+
+	rtbListOld := goaviatrix.ExpandStringList(oList)
+	rtbListNew := goaviatrix.ExpandStringList(nList)
+
+	return goaviatrix.Equivalent(rtbListOld, rtbListNew)
+}
+
+func DiffSuppressFuncRtbList2(k, old, new string, d *schema.ResourceData) bool {
+	o, n := d.GetChange("rtb_list2")
+
+	oList, ok := o.([]interface{})
+	if !ok {
+		return false
+	}
+	nList, ok := n.([]interface{})
+	if !ok {
+		return false
+	}
+
+	rtbListOld := goaviatrix.ExpandStringList(oList)
+	rtbListNew := goaviatrix.ExpandStringList(nList)
+
+	return goaviatrix.Equivalent(rtbListOld, rtbListNew)
+}
+
+func DiffSuppressFuncAwsTgwPeeringTgwName1(k, old, new string, d *schema.ResourceData) bool {
+	tgwName2Old, _ := d.GetChange("tgw_name2")
+
+	tgw2Cur, ok := d.Get("tgw_name2").(string)
+	if !ok {
+		return false
+	}
+	tgw2Old, ok := tgwName2Old.(string)
+	if !ok {
+		return false
+	}
+
+	return old == tgw2Cur && new == tgw2Old
+}
+
+func DiffSuppressFuncAwsTgwPeeringTgwName2(k, old, new string, d *schema.ResourceData) bool {
+	tgwName1Old, _ := d.GetChange("tgw_name1")
+
+	tgw1Cur, ok := d.Get("tgw_name1").(string)
+	if !ok {
+		return false
+	}
+	tgw1Old, ok := tgwName1Old.(string)
+	if !ok {
+		return false
+	}
+
+	return old == tgw1Cur && new == tgw1Old
+}
+
+func DiffSuppressFuncAwsTgwPeeringDomainConnTgwName1(k, old, new string, d *schema.ResourceData) bool {
+	tgwName2Old, _ := d.GetChange("tgw_name2")
+	domainName1Old, _ := d.GetChange("domain_name1")
+	domainName2Old, _ := d.GetChange("domain_name2")
+
+	tgw2Cur, ok := d.Get("tgw_name2").(string)
+	if !ok {
+		return false
+	}
+	tgw2Old, ok := tgwName2Old.(string)
+	if !ok {
+		return false
+	}
+
+	dn1Cur, ok := d.Get("domain_name1").(string)
+	if !ok {
+		return false
+	}
+	dn2Cur, ok := d.Get("domain_name2").(string)
+	if !ok {
+		return false
+	}
+	dn1Old, ok := domainName1Old.(string)
+	if !ok {
+		return false
+	}
+	dn2Old, ok := domainName2Old.(string)
+	if !ok {
+		return false
+	}
+
+	return old == tgw2Cur &&
+		new == tgw2Old &&
+		dn1Cur == dn2Old &&
+		dn2Cur == dn1Old
+}
+
+func DiffSuppressFuncAwsTgwPeeringDomainConnTgwName2(k, old, new string, d *schema.ResourceData) bool {
+	tgwName1Old, _ := d.GetChange("tgw_name1")
+	domainName1Old, _ := d.GetChange("domain_name1")
+	domainName2Old, _ := d.GetChange("domain_name2")
+
+	tgw1Cur, ok := d.Get("tgw_name1").(string)
+	if !ok {
+		return false
+	}
+	tgw1Old, ok := tgwName1Old.(string)
+	if !ok {
+		return false
+	}
+
+	dn1Cur, ok := d.Get("domain_name1").(string)
+	if !ok {
+		return false
+	}
+	dn2Cur, ok := d.Get("domain_name2").(string)
+	if !ok {
+		return false
+	}
+	dn1Old, ok := domainName1Old.(string)
+	if !ok {
+		return false
+	}
+	dn2Old, ok := domainName2Old.(string)
+	if !ok {
+		return false
+	}
+
+	return old == tgw1Cur &&
+		new == tgw1Old &&
+		dn1Cur == dn2Old &&
+		dn2Cur == dn1Old
+}
+
+func DiffSuppressFuncAwsTgwPeeringDomainConnDomainName1(k, old, new string, d *schema.ResourceData) bool {
+	tgwName1Old, _ := d.GetChange("tgw_name1")
+	tgwName2Old, _ := d.GetChange("tgw_name2")
+	domainName2Old, _ := d.GetChange("domain_name2")
+
+	dn2Cur, ok := d.Get("domain_name2").(string)
+	if !ok {
+		return false
+	}
+	dn2Old, ok := domainName2Old.(string)
+	if !ok {
+		return false
+	}
+
+	tgw1Cur, ok := d.Get("tgw_name1").(string)
+	if !ok {
+		return false
+	}
+	tgw2Cur, ok := d.Get("tgw_name2").(string)
+	if !ok {
+		return false
+	}
+	tgw1Old, ok := tgwName1Old.(string)
+	if !ok {
+		return false
+	}
+	tgw2Old, ok := tgwName2Old.(string)
+	if !ok {
+		return false
+	}
+
+	return old == dn2Cur &&
+		new == dn2Old &&
+		tgw1Cur == tgw2Old &&
+		tgw2Cur == tgw1Old
+}
+
+func DiffSuppressFuncAwsTgwPeeringDomainConnDomainName2(k, old, new string, d *schema.ResourceData) bool {
+	tgwName1Old, _ := d.GetChange("tgw_name1")
+	tgwName2Old, _ := d.GetChange("tgw_name2")
+	domainName1Old, _ := d.GetChange("domain_name1")
+
+	dn1Cur, ok := d.Get("domain_name1").(string)
+	if !ok {
+		return false
+	}
+	dn1Old, ok := domainName1Old.(string)
+	if !ok {
+		return false
+	}
+
+	tgw1Cur, ok := d.Get("tgw_name1").(string)
+	if !ok {
+		return false
+	}
+	tgw2Cur, ok := d.Get("tgw_name2").(string)
+	if !ok {
+		return false
+	}
+	tgw1Old, ok := tgwName1Old.(string)
+	if !ok {
+		return false
+	}
+	tgw2Old, ok := tgwName2Old.(string)
+	if !ok {
+		return false
+	}
+
+	return old == dn1Cur &&
+		new == dn1Old &&
+		tgw1Cur == tgw2Old &&
+		tgw2Cur == tgw1Old
+}
+
+func DiffSuppressFuncEdgeSpokeCoordinate(k, old, new string, d *schema.ResourceData) bool {
+	o, _ := strconv.ParseFloat(old, 64)
+	n, _ := strconv.ParseFloat(new, 64)
+	return math.Round(o*1000000)/1000000 == math.Round(n*1000000)/1000000
+}
+
+func DiffSuppressFuncGatewaySNat(k, old, new string, d *schema.ResourceData) bool {
+	// connection_policy
+	raw := d.Get("connection_policy")
+	connectionPolicy, ok := raw.([]interface{})
+	if !ok {
+		return false
+	}
+	connectionPolicyOld := make([]map[string]interface{}, 0, len(connectionPolicy))
+	for _, policy := range connectionPolicy {
+		pl, ok := policy.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		connectionPolicyOld = append(connectionPolicyOld, pl)
+	}
+
+	// interface_policy
+	raw = d.Get("interface_policy")
+	interfacePolicy, ok := raw.([]interface{})
+	if !ok {
+		return false
+	}
+	interfacePolicyOld := make([]map[string]interface{}, 0, len(interfacePolicy))
+	for _, policy := range interfacePolicy {
+		pl, ok := policy.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		interfacePolicyOld = append(interfacePolicyOld, pl)
+	}
+
+	// snat_policy
+	raw = d.Get("snat_policy")
+	snatPolicies, ok := raw.([]interface{})
+	if !ok {
+		return false
+	}
+
+	connectionPolicyNew := make([]map[string]interface{}, 0)
+	interfacePolicyNew := make([]map[string]interface{}, 0)
+
+	for _, policy := range snatPolicies {
+		pl, ok := policy.(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		conn, ok := pl["connection"].(string)
+		if !ok {
+			return false
+		}
+		iface, ok := pl["interface"].(string)
+		if !ok {
+			return false
+		}
+
+		if conn != "None" {
+			connectionPolicyNew = append(connectionPolicyNew, pl)
+		}
+		if iface != "" {
+			interfacePolicyNew = append(interfacePolicyNew, pl)
+		}
+	}
+
+	return reflect.DeepEqual(connectionPolicyOld, connectionPolicyNew) &&
+		reflect.DeepEqual(interfacePolicyOld, interfacePolicyNew)
+}
+
+func DiffSuppressFuncGatewayDNat(k, old, new string, d *schema.ResourceData) bool {
+	// connection_policy
+	raw := d.Get("connection_policy")
+	connectionPolicy, ok := raw.([]interface{})
+	if !ok {
+		return false
+	}
+	connectionPolicyOld := make([]map[string]interface{}, 0, len(connectionPolicy))
+	for _, policy := range connectionPolicy {
+		pl, ok := policy.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		connectionPolicyOld = append(connectionPolicyOld, pl)
+	}
+
+	// interface_policy
+	raw = d.Get("interface_policy")
+	interfacePolicy, ok := raw.([]interface{})
+	if !ok {
+		return false
+	}
+	interfacePolicyOld := make([]map[string]interface{}, 0, len(interfacePolicy))
+	for _, policy := range interfacePolicy {
+		pl, ok := policy.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		interfacePolicyOld = append(interfacePolicyOld, pl)
+	}
+
+	// dnat_policy
+	raw = d.Get("dnat_policy")
+	dnatPolicies, ok := raw.([]interface{})
+	if !ok {
+		return false
+	}
+
+	connectionPolicyNew := make([]map[string]interface{}, 0)
+	interfacePolicyNew := make([]map[string]interface{}, 0)
+
+	for _, policy := range dnatPolicies {
+		pl, ok := policy.(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		conn, ok := pl["connection"].(string)
+		if !ok {
+			return false
+		}
+		iface, ok := pl["interface"].(string)
+		if !ok {
+			return false
+		}
+
+		if conn != "None" {
+			connectionPolicyNew = append(connectionPolicyNew, pl)
+		}
+		if iface != "" {
+			interfacePolicyNew = append(interfacePolicyNew, pl)
+		}
+	}
+
+	return reflect.DeepEqual(connectionPolicyOld, connectionPolicyNew) &&
+		reflect.DeepEqual(interfacePolicyOld, interfacePolicyNew)
+}
+
+func DiffSuppressFuncLinkHierarchy(k, old, new string, d *schema.ResourceData) bool {
+	lOld, lNew := d.GetChange("links")
+
+	oldList, ok := lOld.([]interface{})
+	if !ok {
+		return false
+	}
+	newList, ok := lNew.([]interface{})
+	if !ok {
+		return false
+	}
+
+	linksOld := make([]map[string]interface{}, 0, len(oldList))
+	for _, l0 := range oldList {
+		l1, ok := l0.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		linksOld = append(linksOld, l1)
+	}
+
+	linksNew := make([]map[string]interface{}, 0, len(newList))
+	for _, l0 := range newList {
+		l1, ok := l0.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		linksNew = append(linksNew, l1)
+	}
+
+	sort.Slice(linksOld, func(i, j int) bool {
+		ni, ok := linksOld[i]["name"].(string)
+		if !ok {
+			return false
+		}
+		nj, ok := linksOld[j]["name"].(string)
+		if !ok {
+			return false
+		}
+		return ni < nj
+	})
+
+	sort.Slice(linksNew, func(i, j int) bool {
+		ni, ok := linksNew[i]["name"].(string)
+		if !ok {
+			return false
+		}
+		nj, ok := linksNew[j]["name"].(string)
+		if !ok {
+			return false
+		}
+		return ni < nj
+	})
+
+	return reflect.DeepEqual(linksOld, linksNew)
+}
+
+func S2CPh1RemoteIdDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	if d.HasChange("ha_enabled") || d.HasChange("enable_single_ip_ha") {
+		return false
+	}
+
+	ip, ok := d.Get("remote_gateway_ip").(string)
+	if !ok {
+		return false
+	}
+	haip, ok := d.Get("backup_remote_gateway_ip").(string)
+	if !ok {
+		return false
+	}
+
+	o, n := d.GetChange("phase1_remote_identifier")
+
+	haEnabled, ok := d.Get("ha_enabled").(bool)
+	if !ok {
+		return false
+	}
+	singleIpHA, ok := d.Get("enable_single_ip_ha").(bool)
+	if !ok {
+		return false
+	}
+
+	oList, ok := o.([]interface{})
+	if !ok {
+		return false
+	}
+	nList, ok := n.([]interface{})
+	if !ok {
+		return false
+	}
+
+	ph1RemoteIdListOld := goaviatrix.ExpandStringList(oList)
+	ph1RemoteIdListNew := goaviatrix.ExpandStringList(nList)
+
+	if len(ph1RemoteIdListOld) != 0 && len(ph1RemoteIdListNew) != 0 {
+		if haEnabled && !singleIpHA {
+			if len(ph1RemoteIdListOld) == 1 && len(ph1RemoteIdListNew) == 1 && ip == haip &&
+				ph1RemoteIdListOld[0] == ip && ph1RemoteIdListNew[0] == ip {
+				return true
+			}
+			if len(ph1RemoteIdListNew) != 2 || len(ph1RemoteIdListOld) != 2 {
+				return false
+			}
+			return ph1RemoteIdListOld[0] == ip && ph1RemoteIdListNew[0] == ip &&
+				strings.TrimSpace(ph1RemoteIdListOld[1]) == haip && strings.TrimSpace(ph1RemoteIdListNew[1]) == haip
+		} else if !haEnabled || singleIpHA {
+			if len(ph1RemoteIdListNew) != 1 {
+				return false
+			}
+			return ph1RemoteIdListOld[0] == ip && ph1RemoteIdListNew[0] == ip
+		}
+	}
+
+	return false
+}
+
+func DiffSuppressFuncRemoteSourceRealCIDRs(k, old, new string, d *schema.ResourceData) bool {
+	o, n := d.GetChange("remote_source_real_cidrs")
+
+	oList, ok := o.([]interface{})
+	if !ok {
+		return false
+	}
+	nList, ok := n.([]interface{})
+	if !ok {
+		return false
+	}
+
+	return goaviatrix.Equivalent(goaviatrix.ExpandStringList(oList), goaviatrix.ExpandStringList(nList))
+}
+
+func DiffSuppressFuncRemoteSourceVirtualCIDRs(k, old, new string, d *schema.ResourceData) bool {
+	o, n := d.GetChange("remote_source_virtual_cidrs")
+
+	oList, ok := o.([]interface{})
+	if !ok {
+		return false
+	}
+	nList, ok := n.([]interface{})
+	if !ok {
+		return false
+	}
+
+	return goaviatrix.Equivalent(goaviatrix.ExpandStringList(oList), goaviatrix.ExpandStringList(nList))
+}
+
+func DiffSuppressFuncRemoteDestinationRealCIDRs(k, old, new string, d *schema.ResourceData) bool {
+	o, n := d.GetChange("remote_destination_real_cidrs")
+
+	oList, ok := o.([]interface{})
+	if !ok {
+		return false
+	}
+	nList, ok := n.([]interface{})
+	if !ok {
+		return false
+	}
+
+	return goaviatrix.Equivalent(goaviatrix.ExpandStringList(oList), goaviatrix.ExpandStringList(nList))
+}
+
+func DiffSuppressFuncRemoteDestinationVirtualCIDRs(k, old, new string, d *schema.ResourceData) bool {
+	o, n := d.GetChange("remote_destination_virtual_cidrs")
+
+	oList, ok := o.([]interface{})
+	if !ok {
+		return false
+	}
+	nList, ok := n.([]interface{})
+	if !ok {
+		return false
+	}
+
+	return goaviatrix.Equivalent(goaviatrix.ExpandStringList(oList), goaviatrix.ExpandStringList(nList))
+}
+
+func DiffSuppressFuncLocalSourceRealCIDRs(k, old, new string, d *schema.ResourceData) bool {
+	o, n := d.GetChange("local_source_real_cidrs")
+
+	oList, ok := o.([]interface{})
+	if !ok {
+		return false
+	}
+	nList, ok := n.([]interface{})
+	if !ok {
+		return false
+	}
+
+	return goaviatrix.Equivalent(goaviatrix.ExpandStringList(oList), goaviatrix.ExpandStringList(nList))
+}
+
+func DiffSuppressFuncLocalSourceVirtualCIDRs(k, old, new string, d *schema.ResourceData) bool {
+	o, n := d.GetChange("local_source_virtual_cidrs")
+
+	oList, ok := o.([]interface{})
+	if !ok {
+		return false
+	}
+	nList, ok := n.([]interface{})
+	if !ok {
+		return false
+	}
+
+	return goaviatrix.Equivalent(goaviatrix.ExpandStringList(oList), goaviatrix.ExpandStringList(nList))
+}
+
+func DiffSuppressFuncLocalDestinationRealCIDRs(k, old, new string, d *schema.ResourceData) bool {
+	o, n := d.GetChange("local_destination_real_cidrs")
+
+	oList, ok := o.([]interface{})
+	if !ok {
+		return false
+	}
+	nList, ok := n.([]interface{})
+	if !ok {
+		return false
+	}
+
+	return goaviatrix.Equivalent(goaviatrix.ExpandStringList(oList), goaviatrix.ExpandStringList(nList))
+}
+
+func DiffSuppressFuncLocalDestinationVirtualCIDRs(k, old, new string, d *schema.ResourceData) bool {
+	o, n := d.GetChange("local_destination_virtual_cidrs")
+
+	oList, ok := o.([]interface{})
+	if !ok {
+		return false
+	}
+	nList, ok := n.([]interface{})
+	if !ok {
+		return false
+	}
+
+	return goaviatrix.Equivalent(goaviatrix.ExpandStringList(oList), goaviatrix.ExpandStringList(nList))
+}
+
+func DiffSuppressFuncRemoteGwLatitude(k, old, new string, d *schema.ResourceData) bool {
+	o, n := d.GetChange("remote_gateway_latitude")
+
+	ov, ok := o.(float64)
+	if !ok {
+		return false
+	}
+	nv, ok := n.(float64)
+	if !ok {
+		return false
+	}
+
+	return math.Abs(ov-nv) < 1
+}
+
+func DiffSuppressFuncRemoteGwLongitude(k, old, new string, d *schema.ResourceData) bool {
+	o, n := d.GetChange("remote_gateway_longitude")
+
+	ov, ok := o.(float64)
+	if !ok {
+		return false
+	}
+	nv, ok := n.(float64)
+	if !ok {
+		return false
+	}
+
+	return math.Abs(ov-nv) < 1
+}
+
+func DiffSuppressFuncBackupRemoteGwLatitude(k, old, new string, d *schema.ResourceData) bool {
+	o, n := d.GetChange("backup_remote_gateway_latitude")
+
+	ov, ok := o.(float64)
+	if !ok {
+		return false
+	}
+	nv, ok := n.(float64)
+	if !ok {
+		return false
+	}
+
+	return math.Abs(ov-nv) < 1
+}
+
+func DiffSuppressFuncBackupRemoteGwLongitude(k, old, new string, d *schema.ResourceData) bool {
+	o, n := d.GetChange("backup_remote_gateway_longitude")
+
+	ov, ok := o.(float64)
+	if !ok {
+		return false
+	}
+	nv, ok := n.(float64)
+	if !ok {
+		return false
+	}
+
+	return math.Abs(ov-nv) < 1
+}
+
+func DiffSuppressFuncEdgeSpokeTransitAttachmentEdgeWanInterfaces(k, old, new string, d *schema.ResourceData) bool {
+	o, n := d.GetChange("edge_wan_interfaces")
+
+	oSet, ok := o.(*schema.Set)
+	if !ok {
+		return false
+	}
+	nSet, ok := n.(*schema.Set)
+	if !ok {
+		return false
+	}
+
+	edgeWanInterfacesOld := goaviatrix.ExpandStringList(oSet.List())
+	edgeWanInterfacesNew := goaviatrix.ExpandStringList(nSet.List())
+
+	defaultWanInterfaces := getStringSet(d, "default_edge_wan_interfaces")
+
+	if (len(edgeWanInterfacesOld) != 0 && goaviatrix.Equivalent(edgeWanInterfacesOld, defaultWanInterfaces) && len(edgeWanInterfacesNew) == 0) ||
+		(len(edgeWanInterfacesOld) == 0 && len(edgeWanInterfacesNew) != 0 && goaviatrix.Equivalent(edgeWanInterfacesNew, defaultWanInterfaces)) {
+		return true
+	}
+
+	return false
+}
+
+func ExternalDeviceConnBgpBfdDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	// In the case where BFD is disabled, we need to *not* suppress any
+	// diffs to "bgp_bfd", otherwise BFD config can be left in the state.
+	// That can break things, as BFD config is not allowed when BFD is
+	// disabled.
+	enabled, ok := d.Get("enable_bfd").(bool)
+	if !enabled || !ok {
+		return false
+	}
+
+	// You might expect that GetChange("bgp_bfd") would return old and
+	// new lists that could be compared. Unfortunately, it doesn't seem to
+	// work that way. The API can report identical values for "bgp_bfd",
+	// (along with HasChange() == false), while at the same time reporting
+	// a change for the first element ("bgp_bfd.0"). Fortunately for us, we
+	// enforce only a single element in bgp_bfd, so we can just check that.
+	// terraform will auto-populate all defaults - including for an empty
+	// list - so all we need to do is compare the two elements.
+	// The strong consensus on the internet is that SDKv2 was simply not
+	// designed for for this sort of thing and the recommended solution
+	// is to migrate to the plugin framework. Unfortunately that's not
+	// a small undertaking.
+	o, n := d.GetChange("bgp_bfd.0")
+	return reflect.DeepEqual(o, n)
+}
+
+func TransitExternalDeviceConnPh1RemoteIdDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	if d.HasChange("ha_enabled") {
+		return false
+	}
+
+	ip, ok := d.Get("remote_gateway_ip").(string)
+	if !ok {
+		return false
+	}
+	ipList := strings.Split(ip, ",")
+
+	haip, ok := d.Get("backup_remote_gateway_ip").(string)
+	if !ok {
+		return false
+	}
+
+	o, n := d.GetChange("phase1_remote_identifier")
+
+	haEnabled, ok := d.Get("ha_enabled").(bool)
+	if !ok {
+		return false
+	}
+
+	oList, ok := o.([]interface{})
+	if !ok {
+		return false
+	}
+	nList, ok := n.([]interface{})
+	if !ok {
+		return false
+	}
+
+	ph1RemoteIdListOld := goaviatrix.ExpandStringList(oList)
+	ph1RemoteIdListNew := goaviatrix.ExpandStringList(nList)
+
+	if len(ph1RemoteIdListOld) != 0 && len(ph1RemoteIdListNew) != 0 {
+		if haEnabled {
+			if len(ph1RemoteIdListNew) != 2 || len(ph1RemoteIdListOld) != 2 {
+				if len(ph1RemoteIdListNew) == 1 && len(ph1RemoteIdListOld) == 1 {
+					return ph1RemoteIdListOld[0] == ipList[0] && ph1RemoteIdListNew[0] == ipList[0]
+				}
+				return false
+			}
+			return ph1RemoteIdListOld[0] == ipList[0] && ph1RemoteIdListNew[0] == ipList[0] &&
+				strings.TrimSpace(ph1RemoteIdListOld[1]) == haip && strings.TrimSpace(ph1RemoteIdListNew[1]) == haip
+		} else {
+			if len(ph1RemoteIdListNew) == 1 && len(ph1RemoteIdListOld) == 1 {
+				return ph1RemoteIdListOld[0] == ipList[0] && ph1RemoteIdListNew[0] == ipList[0]
+			} else if len(ph1RemoteIdListNew) == 2 && len(ph1RemoteIdListOld) == 2 && len(ipList) == 2 {
+				return strings.TrimSpace(ph1RemoteIdListOld[0]) == strings.TrimSpace(ipList[0]) &&
+					strings.TrimSpace(ph1RemoteIdListOld[1]) == strings.TrimSpace(ipList[1]) &&
+					strings.TrimSpace(ph1RemoteIdListNew[0]) == strings.TrimSpace(ipList[0]) &&
+					strings.TrimSpace(ph1RemoteIdListNew[1]) == strings.TrimSpace(ipList[1])
+			} else {
+				return false
+			}
+		}
+	}
+
+	return false
+}
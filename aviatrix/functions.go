@@ -0,0 +1,279 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
+)
+
+// providerFunction is a provider-defined function, called in configuration as
+// provider::aviatrix::<name>(...).
+type providerFunction struct {
+	definition *tfprotov5.Function
+	// call computes the result from the arguments, which have the parameter
+	// types of definition and are known and not null.
+	call func(args []tftypes.Value) (tftypes.Value, *tfprotov5.FunctionError)
+}
+
+// providerFunctions returns the provider-defined functions by name. Each one
+// wraps the Go code the resources use, so modules compute the same values
+// the provider does.
+func providerFunctions() map[string]providerFunction {
+	return map[string]providerFunction{
+		"ha_gateway_name": {
+			definition: &tfprotov5.Function{
+				Summary:     "Name of the HA gateway of a gateway",
+				Description: "Returns the name the Controller gives the HA gateway of the gateway named gw_name.",
+				Parameters: []*tfprotov5.FunctionParameter{
+					{Name: "gw_name", Type: tftypes.String, Description: "Name of the primary gateway."},
+				},
+				Return: &tfprotov5.FunctionReturn{Type: tftypes.String},
+			},
+			call: func(args []tftypes.Value) (tftypes.Value, *tfprotov5.FunctionError) {
+				return tftypes.NewValue(tftypes.String, goaviatrix.HaGatewayName(mustFunctionString(args[0]))), nil
+			},
+		},
+		"gcp_region_from_zone": {
+			definition: &tfprotov5.Function{
+				Summary:     "GCP region of a GCP zone",
+				Description: "Returns the GCP region of zone, e.g. \"us-east1\" for \"us-east1-b\". A value that is not a zone is returned unchanged.",
+				Parameters: []*tfprotov5.FunctionParameter{
+					{Name: "zone", Type: tftypes.String, Description: "GCP zone."},
+				},
+				Return: &tfprotov5.FunctionReturn{Type: tftypes.String},
+			},
+			call: func(args []tftypes.Value) (tftypes.Value, *tfprotov5.FunctionError) {
+				return tftypes.NewValue(tftypes.String, gcpRegionFromZone(mustFunctionString(args[0]))), nil
+			},
+		},
+		"cidr_split_for_gateway": {
+			definition: &tfprotov5.Function{
+				Summary: "Gateway subnets of a VPC CIDR",
+				Description: "Returns the first count /26 segments of the IPv4 CIDR vpc_cidr, in address order. " +
+					"A /26 segment of the VPC is what the Controller requires as the subnet of an Insane Mode gateway and its HA gateway.",
+				Parameters: []*tfprotov5.FunctionParameter{
+					{Name: "vpc_cidr", Type: tftypes.String, Description: "IPv4 CIDR of the VPC, e.g. \"10.1.0.0/16\"."},
+					{Name: "count", Type: tftypes.Number, Description: "Number of gateway subnets to return."},
+				},
+				Return: &tfprotov5.FunctionReturn{Type: tftypes.List{ElementType: tftypes.String}},
+			},
+			call: func(args []tftypes.Value) (tftypes.Value, *tfprotov5.FunctionError) {
+				var count big.Float
+				if err := args[1].As(&count); err != nil || !count.IsInt() {
+					return tftypes.Value{}, functionArgumentError(1, fmt.Errorf("count must be a whole number"))
+				}
+				n, _ := count.Int64()
+				subnets, err := cidrSplitForGateway(mustFunctionString(args[0]), int(n))
+				if err != nil {
+					return tftypes.Value{}, &tfprotov5.FunctionError{Text: err.Error()}
+				}
+				values := make([]tftypes.Value, 0, len(subnets))
+				for _, subnet := range subnets {
+					values = append(values, tftypes.NewValue(tftypes.String, subnet))
+				}
+				return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, values), nil
+			},
+		},
+		"is_cidr_rule": {
+			definition: &tfprotov5.Function{
+				Summary:     "Whether a string is a valid CIDR rule",
+				Description: "Returns true if rule is a canonical IPv4 CIDR optionally followed by \"ge <n>\" and \"le <n>\" qualifiers, as accepted by the provider for prefix lists.",
+				Parameters: []*tfprotov5.FunctionParameter{
+					{Name: "rule", Type: tftypes.String, Description: "CIDR rule, e.g. \"10.0.0.0/8 ge 16 le 24\"."},
+				},
+				Return: &tfprotov5.FunctionReturn{Type: tftypes.Bool},
+			},
+			call: func(args []tftypes.Value) (tftypes.Value, *tfprotov5.FunctionError) {
+				_, errs := ValidateCIDRRule(mustFunctionString(args[0]), "rule")
+				return tftypes.NewValue(tftypes.Bool, len(errs) == 0), nil
+			},
+		},
+		"is_ipv6_cidr": {
+			definition: &tfprotov5.Function{
+				Summary:     "Whether a string is a valid IPv6 CIDR",
+				Description: "Returns true if cidr is an IPv6 CIDR as accepted by the provider for IPv6 gateway and subnet arguments.",
+				Parameters: []*tfprotov5.FunctionParameter{
+					{Name: "cidr", Type: tftypes.String, Description: "IPv6 CIDR."},
+				},
+				Return: &tfprotov5.FunctionReturn{Type: tftypes.Bool},
+			},
+			call: func(args []tftypes.Value) (tftypes.Value, *tfprotov5.FunctionError) {
+				_, errs := validateIPv6CIDR(mustFunctionString(args[0]), "cidr")
+				return tftypes.NewValue(tftypes.Bool, len(errs) == 0), nil
+			},
+		},
+		"smart_group_match_expression": {
+			definition: &tfprotov5.Function{
+				Summary: "Encode a Smart Group match expression",
+				Description: "Returns the match expression the Controller stores for a match_expressions block of aviatrix_smart_group, " +
+					"with tags flattened to \"tags.<key>\" and the external arguments inlined.",
+				Parameters: []*tfprotov5.FunctionParameter{
+					{
+						Name:        "match_expression",
+						Type:        tftypes.DynamicPseudoType,
+						Description: "Object with the arguments of a match_expressions block, e.g. { type = \"vm\", tags = { env = \"prod\" } }.",
+					},
+				},
+				Return: &tfprotov5.FunctionReturn{Type: tftypes.Map{ElementType: tftypes.String}},
+			},
+			call: func(args []tftypes.Value) (tftypes.Value, *tfprotov5.FunctionError) {
+				selectorInfo, err := smartGroupSelectorInfo(args[0])
+				if err != nil {
+					return tftypes.Value{}, functionArgumentError(0, err)
+				}
+				apiMap := goaviatrix.SmartGroupFilterToAPIMap(expandSmartGroupMatchExpression(selectorInfo))
+				values := make(map[string]tftypes.Value, len(apiMap))
+				for k, v := range apiMap {
+					values[k] = tftypes.NewValue(tftypes.String, mustString(v))
+				}
+				return tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, values), nil
+			},
+		},
+	}
+}
+
+// mustFunctionString returns the Go string of a known, non-null string
+// argument.
+func mustFunctionString(v tftypes.Value) string {
+	var s string
+	if err := v.As(&s); err != nil {
+		panic(fmt.Sprintf("internal error: expected string argument: %s", err))
+	}
+	return s
+}
+
+func functionArgumentError(i int64, err error) *tfprotov5.FunctionError {
+	return &tfprotov5.FunctionError{Text: err.Error(), FunctionArgument: &i}
+}
+
+// smartGroupSelectorInfo converts an object or map argument to the map a
+// match_expressions block is read into: strings, plus maps of strings for
+// tags, namespace tags and external arguments. Null attributes are left out.
+func smartGroupSelectorInfo(v tftypes.Value) (map[string]any, error) {
+	if !v.Type().Is(tftypes.Object{}) && !v.Type().Is(tftypes.Map{}) {
+		return nil, fmt.Errorf("match_expression must be an object or a map, got %s", v.Type())
+	}
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		return nil, err
+	}
+	selectorInfo := make(map[string]any, len(attrs))
+	for k, attr := range attrs {
+		if attr.IsNull() {
+			continue
+		}
+		switch k {
+		case goaviatrix.TagsPrefix, goaviatrix.NamespaceTagsPrefix, goaviatrix.ExtArgsPrefix:
+			m, err := smartGroupSelectorInfo(attr)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			for key, value := range m {
+				if _, ok := value.(string); !ok {
+					return nil, fmt.Errorf("%s.%s must be a string", k, key)
+				}
+			}
+			selectorInfo[k] = m
+		default:
+			if !attr.Type().Is(tftypes.String) {
+				return nil, fmt.Errorf("%s must be a string, got %s", k, attr.Type())
+			}
+			selectorInfo[k] = mustFunctionString(attr)
+		}
+	}
+	return selectorInfo, nil
+}
+
+// functionServer adds the provider-defined functions to the protocol server
+// of the SDK provider, which does not support functions itself.
+type functionServer struct {
+	tfprotov5.ProviderServer
+	functions map[string]providerFunction
+}
+
+// ProviderServer returns the protocol server for the provider, including its
+// provider-defined functions.
+func ProviderServer() tfprotov5.ProviderServer {
+	return &functionServer{
+		ProviderServer: schema.NewGRPCProviderServer(Provider()),
+		functions:      providerFunctions(),
+	}
+}
+
+func (s *functionServer) definitions() map[string]*tfprotov5.Function {
+	definitions := make(map[string]*tfprotov5.Function, len(s.functions))
+	for name, f := range s.functions {
+		definitions[name] = f.definition
+	}
+	return definitions
+}
+
+func (s *functionServer) GetMetadata(ctx context.Context, req *tfprotov5.GetMetadataRequest) (*tfprotov5.GetMetadataResponse, error) {
+	resp, err := s.ProviderServer.GetMetadata(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	names := make([]string, 0, len(s.functions))
+	for name := range s.functions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		resp.Functions = append(resp.Functions, tfprotov5.FunctionMetadata{Name: name})
+	}
+	return resp, nil
+}
+
+func (s *functionServer) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	resp, err := s.ProviderServer.GetProviderSchema(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	resp.Functions = s.definitions()
+	return resp, nil
+}
+
+func (s *functionServer) GetFunctions(ctx context.Context, req *tfprotov5.GetFunctionsRequest) (*tfprotov5.GetFunctionsResponse, error) {
+	return &tfprotov5.GetFunctionsResponse{Functions: s.definitions()}, nil
+}
+
+func (s *functionServer) CallFunction(ctx context.Context, req *tfprotov5.CallFunctionRequest) (*tfprotov5.CallFunctionResponse, error) {
+	f, ok := s.functions[req.Name]
+	if !ok {
+		return s.ProviderServer.CallFunction(ctx, req)
+	}
+	params := f.definition.Parameters
+	if len(req.Arguments) != len(params) {
+		return &tfprotov5.CallFunctionResponse{Error: &tfprotov5.FunctionError{
+			Text: fmt.Sprintf("%s takes %d arguments, got %d", req.Name, len(params), len(req.Arguments)),
+		}}, nil
+	}
+	args := make([]tftypes.Value, len(params))
+	for i, param := range params {
+		v, err := req.Arguments[i].Unmarshal(param.Type)
+		if err != nil {
+			return &tfprotov5.CallFunctionResponse{Error: functionArgumentError(int64(i), err)}, nil
+		}
+		if v.IsNull() {
+			return &tfprotov5.CallFunctionResponse{Error: functionArgumentError(int64(i), fmt.Errorf("%s must not be null", param.Name))}, nil
+		}
+		args[i] = v
+	}
+
+	result, funcErr := f.call(args)
+	if funcErr != nil {
+		return &tfprotov5.CallFunctionResponse{Error: funcErr}, nil
+	}
+	dv, err := tfprotov5.NewDynamicValue(f.definition.Return.Type, result)
+	if err != nil {
+		return &tfprotov5.CallFunctionResponse{Error: &tfprotov5.FunctionError{Text: err.Error()}}, nil
+	}
+	return &tfprotov5.CallFunctionResponse{Result: &dv}, nil
+}
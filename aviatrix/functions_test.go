@@ -0,0 +1,134 @@
+package aviatrix
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func callFunction(t *testing.T, name string, returnType, argType tftypes.Type, arg tftypes.Value) (tftypes.Value, *tfprotov5.FunctionError) {
+	t.Helper()
+	dv, err := tfprotov5.NewDynamicValue(argType, arg)
+	require.NoError(t, err)
+	resp, err := ProviderServer().CallFunction(context.Background(), &tfprotov5.CallFunctionRequest{
+		Name:      name,
+		Arguments: []*tfprotov5.DynamicValue{&dv},
+	})
+	require.NoError(t, err)
+	if resp.Error != nil {
+		return tftypes.Value{}, resp.Error
+	}
+	v, err := resp.Result.Unmarshal(returnType)
+	require.NoError(t, err)
+	return v, nil
+}
+
+func TestProviderFunctions_Strings(t *testing.T) {
+	tests := []struct {
+		function string
+		arg      string
+		want     tftypes.Value
+	}{
+		{"ha_gateway_name", "spoke-1", tftypes.NewValue(tftypes.String, "spoke-1-hagw")},
+		{"gcp_region_from_zone", "us-east1-b", tftypes.NewValue(tftypes.String, "us-east1")},
+		{"gcp_region_from_zone", "us-east1", tftypes.NewValue(tftypes.String, "us-east1")},
+		{"is_cidr_rule", "10.0.0.0/8 ge 16 le 24", tftypes.NewValue(tftypes.Bool, true)},
+		{"is_cidr_rule", "10.0.0.1/8", tftypes.NewValue(tftypes.Bool, false)},
+		{"is_ipv6_cidr", "2001:db8::/32", tftypes.NewValue(tftypes.Bool, true)},
+		{"is_ipv6_cidr", "10.0.0.0/16", tftypes.NewValue(tftypes.Bool, false)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.function+"/"+tt.arg, func(t *testing.T) {
+			got, funcErr := callFunction(t, tt.function, tt.want.Type(), tftypes.String, tftypes.NewValue(tftypes.String, tt.arg))
+			require.Nil(t, funcErr)
+			assert.True(t, tt.want.Equal(got), "got %s", got)
+		})
+	}
+}
+
+func TestProviderFunctions_CidrSplitForGateway(t *testing.T) {
+	listType := tftypes.List{ElementType: tftypes.String}
+	call := func(cidr string, count int64) (tftypes.Value, *tfprotov5.FunctionError) {
+		var args []*tfprotov5.DynamicValue
+		for _, v := range []tftypes.Value{tftypes.NewValue(tftypes.String, cidr), tftypes.NewValue(tftypes.Number, count)} {
+			dv, err := tfprotov5.NewDynamicValue(v.Type(), v)
+			require.NoError(t, err)
+			args = append(args, &dv)
+		}
+		resp, err := ProviderServer().CallFunction(context.Background(), &tfprotov5.CallFunctionRequest{Name: "cidr_split_for_gateway", Arguments: args})
+		require.NoError(t, err)
+		if resp.Error != nil {
+			return tftypes.Value{}, resp.Error
+		}
+		v, err := resp.Result.Unmarshal(listType)
+		require.NoError(t, err)
+		return v, nil
+	}
+
+	got, funcErr := call("10.1.0.0/24", 3)
+	require.Nil(t, funcErr)
+	want := tftypes.NewValue(listType, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "10.1.0.0/26"),
+		tftypes.NewValue(tftypes.String, "10.1.0.64/26"),
+		tftypes.NewValue(tftypes.String, "10.1.0.128/26"),
+	})
+	assert.True(t, want.Equal(got), "got %s", got)
+
+	_, funcErr = call("10.1.0.0/24", 5)
+	require.NotNil(t, funcErr)
+	assert.Contains(t, funcErr.Text, "count must be between 0 and 4")
+	_, funcErr = call("10.1.0.1/24", 1)
+	require.NotNil(t, funcErr)
+	assert.Contains(t, funcErr.Text, "is not canonical")
+}
+
+func TestProviderFunctions_SmartGroupMatchExpression(t *testing.T) {
+	tags := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"env": tftypes.String}}
+	argType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"type":   tftypes.String,
+		"region": tftypes.String,
+		"cidr":   tftypes.String,
+		"tags":   tags,
+	}}
+	arg := tftypes.NewValue(argType, map[string]tftypes.Value{
+		"type":   tftypes.NewValue(tftypes.String, "vm"),
+		"region": tftypes.NewValue(tftypes.String, "US East 1"),
+		"cidr":   tftypes.NewValue(tftypes.String, nil),
+		"tags":   tftypes.NewValue(tags, map[string]tftypes.Value{"env": tftypes.NewValue(tftypes.String, "prod")}),
+	})
+	mapType := tftypes.Map{ElementType: tftypes.String}
+
+	got, funcErr := callFunction(t, "smart_group_match_expression", mapType, tftypes.DynamicPseudoType, arg)
+	require.Nil(t, funcErr)
+	want := tftypes.NewValue(mapType, map[string]tftypes.Value{
+		"type":     tftypes.NewValue(tftypes.String, "vm"),
+		"region":   tftypes.NewValue(tftypes.String, "useast1"),
+		"tags.env": tftypes.NewValue(tftypes.String, "prod"),
+	})
+	assert.True(t, want.Equal(got), "got %s", got)
+
+	_, funcErr = callFunction(t, "smart_group_match_expression", mapType, tftypes.DynamicPseudoType, tftypes.NewValue(tftypes.String, "vm"))
+	require.NotNil(t, funcErr)
+	assert.Equal(t, int64(0), *funcErr.FunctionArgument)
+}
+
+func TestProviderServer_AdvertisesFunctions(t *testing.T) {
+	server := ProviderServer()
+
+	schemaResp, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, schemaResp.Functions, "ha_gateway_name")
+	assert.Contains(t, schemaResp.ResourceSchemas, "aviatrix_account")
+
+	metadata, err := server.GetMetadata(context.Background(), &tfprotov5.GetMetadataRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, metadata.Functions, tfprotov5.FunctionMetadata{Name: "smart_group_match_expression"})
+
+	resp, err := server.CallFunction(context.Background(), &tfprotov5.CallFunctionRequest{Name: "unknown"})
+	require.NoError(t, err)
+	assert.Contains(t, resp.Error.Text, "Function Not Found")
+}
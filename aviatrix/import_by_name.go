@@ -0,0 +1,56 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// namedObject is an object of the Controller identified by a UUID with a
+// display name that is unique in practice but not enforced to be.
+type namedObject struct {
+	id   string
+	name string
+}
+
+// importByIDOrName returns an importer accepting either the UUID of an object
+// or its name. list returns all objects of the kind, and kind names them in
+// error messages.
+func importByIDOrName(kind string, list func(ctx context.Context, meta any) ([]namedObject, error)) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		objects, err := list(ctx, meta)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %ss: %w", kind, err)
+		}
+		id, err := resolveIDOrName(kind, d.Id(), objects)
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(id)
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// resolveIDOrName returns the UUID of the object whose UUID or name is
+// idOrName. A UUID takes precedence over a name; a name must match exactly
+// one object.
+func resolveIDOrName(kind, idOrName string, objects []namedObject) (string, error) {
+	var matches []string
+	for _, object := range objects {
+		if object.id == idOrName {
+			return object.id, nil
+		}
+		if object.name == idOrName {
+			matches = append(matches, object.id)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no %s with ID or name %q", kind, idOrName)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("%d %ss are named %q; import by ID instead: %v", len(matches), kind, idOrName, matches)
+	}
+}
@@ -0,0 +1,32 @@
+package aviatrix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveIDOrName(t *testing.T) {
+	objects := []namedObject{
+		{id: "1111", name: "prod"},
+		{id: "2222", name: "dup"},
+		{id: "3333", name: "dup"},
+		{id: "4444", name: "1111"},
+	}
+
+	id, err := resolveIDOrName("profile", "prod", objects)
+	require.NoError(t, err)
+	assert.Equal(t, "1111", id)
+
+	// An ID takes precedence over a name.
+	id, err = resolveIDOrName("profile", "1111", objects)
+	require.NoError(t, err)
+	assert.Equal(t, "1111", id)
+
+	_, err = resolveIDOrName("profile", "dup", objects)
+	assert.ErrorContains(t, err, `2 profiles are named "dup"`)
+
+	_, err = resolveIDOrName("profile", "missing", objects)
+	assert.ErrorContains(t, err, `no profile with ID or name "missing"`)
+}
@@ -0,0 +1,583 @@
+package aviatrix
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zclconf/go-cty/cty"
+
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
+)
+
+// importTarget is an existing Controller object to bring under Terraform: the
+// import block for it and the arguments of the matching resource block.
+type importTarget struct {
+	resourceType string
+	// name is the name of the object, from which the resource name is made.
+	name string
+	id   string
+	// body writes the arguments of the resource block.
+	body func(body *hclwrite.Body)
+}
+
+// importLister lists the objects of one resource type.
+type importLister struct {
+	resourceType string
+	list         func(ctx context.Context, client *goaviatrix.Client) ([]importTarget, error)
+}
+
+// importListers returns the listers of the resource types GenerateImports
+// covers, in the order the resources are written.
+func importListers() []importLister {
+	return []importLister{
+		{"aviatrix_account", listAccountImports},
+		{"aviatrix_transit_gateway", listTransitGatewayImports},
+		{"aviatrix_spoke_gateway", listSpokeGatewayImports},
+		{"aviatrix_transit_gateway_peering", listTransitGatewayPeeringImports},
+		{"aviatrix_site2cloud", listSite2CloudImports},
+		{"aviatrix_segmentation_network_domain", listNetworkDomainImports},
+		{"aviatrix_smart_group", listSmartGroupImports},
+		{"aviatrix_dcf_ruleset", listDCFRulesetImports},
+	}
+}
+
+// GenerateImports writes to w an import block and a resource block for each
+// account, transit and spoke gateway, transit gateway peering, site2cloud
+// connection, network domain, Smart Group and DCF ruleset of the Controller.
+// The resource blocks hold the arguments the list calls return; secrets and
+// other arguments the Controller does not list must be added before applying.
+func GenerateImports(ctx context.Context, client *goaviatrix.Client, w io.Writer) error {
+	f := hclwrite.NewEmptyFile()
+	root := f.Body()
+	names := make(map[string]map[string]bool)
+	for _, lister := range importListers() {
+		targets, err := lister.list(ctx, client)
+		if err != nil {
+			return fmt.Errorf("failed to list %s: %w", lister.resourceType, err)
+		}
+		sort.SliceStable(targets, func(i, j int) bool { return targets[i].id < targets[j].id })
+		if names[lister.resourceType] == nil {
+			names[lister.resourceType] = make(map[string]bool)
+		}
+		for _, target := range targets {
+			name := uniqueImportResourceName(names[lister.resourceType], target.name)
+
+			importBlock := root.AppendNewBlock("import", nil).Body()
+			importBlock.SetAttributeTraversal("to", hcl.Traversal{
+				hcl.TraverseRoot{Name: target.resourceType},
+				hcl.TraverseAttr{Name: name},
+			})
+			importBlock.SetAttributeValue("id", cty.StringVal(target.id))
+			root.AppendNewline()
+
+			target.body(root.AppendNewBlock("resource", []string{target.resourceType, name}).Body())
+			root.AppendNewline()
+		}
+	}
+	_, err := w.Write(hclwrite.Format(f.Bytes()))
+	return err
+}
+
+// GenerateImportsCommand runs the generate-imports command of the provider
+// binary with the command line arguments args. It connects to the Controller
+// as the provider does, configured with the AVIATRIX_* environment variables
+// of the provider block and the flags defined by providerFlags.
+func GenerateImportsCommand(ctx context.Context, args []string, stdout io.Writer) error {
+	p := Provider()
+	flags := flag.NewFlagSet("generate-imports", flag.ContinueOnError)
+	out := flags.String("out", "", "file to write the configuration to instead of standard output")
+	providerConfig := providerFlags(flags, p.Schema)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	raw, err := providerConfig()
+	if err != nil {
+		return err
+	}
+	config := terraform.NewResourceConfigRaw(raw)
+	if diags := p.Validate(config); diags.HasError() {
+		return providerDiagsError(diags)
+	}
+	if diags := p.Configure(ctx, config); diags.HasError() {
+		return providerDiagsError(diags)
+	}
+	client := mustClient(p.Meta())
+
+	if *out == "" {
+		return GenerateImports(ctx, client, stdout)
+	}
+	file, err := os.Create(*out)
+	if err != nil {
+		return err
+	}
+	if err := GenerateImports(ctx, client, file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// providerDiagsError returns the first error of diags with its detail, as
+// Terraform would show it for the provider block.
+func providerDiagsError(diags diag.Diagnostics) error {
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+		if d.Detail == "" {
+			return errors.New(d.Summary)
+		}
+		return fmt.Errorf("%s: %s", d.Summary, d.Detail)
+	}
+	return nil
+}
+
+// providerFlag is the command line flag of a provider argument.
+type providerFlag struct {
+	name   string
+	schema *schema.Schema
+	value  string
+	set    bool
+}
+
+func (f *providerFlag) String() string { return f.value }
+
+func (f *providerFlag) Set(value string) error {
+	f.value, f.set = value, true
+	return nil
+}
+
+func (f *providerFlag) IsBoolFlag() bool { return f.schema.Type == schema.TypeBool }
+
+// providerFlags defines on flags a flag for each argument of the provider
+// block, e.g. -controller-ip for controller_ip. The arguments of a block
+// such as retry are named after the block, e.g. -retry-max-attempts, lists
+// and sets are comma separated and maps are left out. It returns a function
+// building the raw provider configuration from the flags that were set, so
+// the other arguments fall back to their environment variables and defaults
+// as in the provider block.
+func providerFlags(flags *flag.FlagSet, s map[string]*schema.Schema) func() (map[string]any, error) {
+	type block struct {
+		name  string
+		flags map[string]*providerFlag
+	}
+	var blocks []block
+	define := func(prefix string, s map[string]*schema.Schema) map[string]*providerFlag {
+		defined := make(map[string]*providerFlag)
+		for k, v := range s {
+			if v.Type == schema.TypeMap {
+				continue
+			}
+			if _, ok := v.Elem.(*schema.Resource); ok {
+				continue
+			}
+			f := &providerFlag{name: strings.ReplaceAll(prefix+k, "_", "-"), schema: v}
+			flags.Var(f, f.name, v.Description)
+			defined[k] = f
+		}
+		return defined
+	}
+	blocks = append(blocks, block{flags: define("", s)})
+	for k, v := range s {
+		if r, ok := v.Elem.(*schema.Resource); ok && v.MaxItems == 1 {
+			blocks = append(blocks, block{name: k, flags: define(k+"_", r.Schema)})
+		}
+	}
+
+	return func() (map[string]any, error) {
+		raw := make(map[string]any)
+		for _, b := range blocks {
+			values := make(map[string]any)
+			for k, f := range b.flags {
+				if !f.set {
+					continue
+				}
+				value, err := f.raw()
+				if err != nil {
+					return nil, fmt.Errorf("invalid value %q for flag -%s: %w", f.value, f.name, err)
+				}
+				values[k] = value
+			}
+			switch {
+			case b.name == "":
+				maps.Copy(raw, values)
+			case len(values) > 0:
+				raw[b.name] = []any{values}
+			}
+		}
+		return raw, nil
+	}
+}
+
+// raw returns the value of the flag as it would be written in the provider
+// block.
+func (f *providerFlag) raw() (any, error) {
+	switch f.schema.Type {
+	case schema.TypeList, schema.TypeSet:
+		elem, _ := f.schema.Elem.(*schema.Schema)
+		var values []any
+		for _, s := range strings.Split(f.value, ",") {
+			value, err := (&providerFlag{schema: elem, value: strings.TrimSpace(s)}).raw()
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return values, nil
+	case schema.TypeBool:
+		return strconv.ParseBool(f.value)
+	case schema.TypeInt:
+		return strconv.Atoi(f.value)
+	case schema.TypeFloat:
+		return strconv.ParseFloat(f.value, 64)
+	default:
+		return f.value, nil
+	}
+}
+
+// importResourceName returns a Terraform resource name for an object name:
+// the name in lower case with characters other than letters, digits, "_" and
+// "-" replaced by "_", prefixed by "_" if it does not start with a letter.
+func importResourceName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '_' || r == '-' {
+			b.WriteRune(r)
+		} else {
+			b.WriteByte('_')
+		}
+	}
+	s := b.String()
+	if s == "" || s[0] < 'a' || s[0] > 'z' {
+		s = "_" + s
+	}
+	return s
+}
+
+// uniqueImportResourceName returns importResourceName(name), with a numeric
+// suffix if it is already used, and adds it to used.
+func uniqueImportResourceName(used map[string]bool, name string) string {
+	base := importResourceName(name)
+	s := base
+	for i := 2; used[s]; i++ {
+		s = fmt.Sprintf("%s_%d", base, i)
+	}
+	used[s] = true
+	return s
+}
+
+// setStrings sets the string arguments of values that are not empty, in the
+// order given.
+func setStrings(body *hclwrite.Body, values ...string) {
+	for i := 0; i+1 < len(values); i += 2 {
+		if values[i+1] != "" {
+			body.SetAttributeValue(values[i], cty.StringVal(values[i+1]))
+		}
+	}
+}
+
+func listAccountImports(_ context.Context, client *goaviatrix.Client) ([]importTarget, error) {
+	accounts, err := client.ListAccounts()
+	if err != nil {
+		return nil, err
+	}
+	var targets []importTarget
+	for _, account := range accounts {
+		targets = append(targets, importTarget{
+			resourceType: "aviatrix_account",
+			name:         account.AccountName,
+			id:           account.AccountName,
+			body: func(body *hclwrite.Body) {
+				body.SetAttributeValue("account_name", cty.StringVal(account.AccountName))
+				body.SetAttributeValue("cloud_type", cty.NumberIntVal(int64(account.CloudType)))
+				switch account.CloudType {
+				case goaviatrix.AWS:
+					setStrings(body, "aws_account_number", account.AwsAccountNumber)
+					if account.AwsRoleEc2 != "" {
+						body.SetAttributeValue("aws_iam", cty.True)
+						setStrings(body,
+							"aws_role_app", account.AwsRoleApp,
+							"aws_role_ec2", account.AwsRoleEc2,
+						)
+					}
+				case goaviatrix.GCP:
+					setStrings(body, "gcloud_project_id", account.GcloudProjectName)
+				case goaviatrix.Azure:
+					setStrings(body, "arm_subscription_id", account.ArmSubscriptionId)
+				}
+			},
+		})
+	}
+	return targets, nil
+}
+
+func listTransitGatewayImports(ctx context.Context, client *goaviatrix.Client) ([]importTarget, error) {
+	gateways, err := client.GetTransitGatewayList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return gatewayImports("aviatrix_transit_gateway", gateways), nil
+}
+
+func listSpokeGatewayImports(ctx context.Context, client *goaviatrix.Client) ([]importTarget, error) {
+	gateways, err := client.GetSpokeGatewayList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return gatewayImports("aviatrix_spoke_gateway", gateways), nil
+}
+
+// gatewayImports returns the targets of the gateways, leaving out HA gateways,
+// which are imported with their primary gateway.
+func gatewayImports(resourceType string, gateways []goaviatrix.Gateway) []importTarget {
+	primaries := make(map[string]bool, len(gateways))
+	for _, gw := range gateways {
+		primaries[gw.GwName] = true
+	}
+	var targets []importTarget
+	for _, gw := range gateways {
+		if primary, ok := strings.CutSuffix(gw.GwName, goaviatrix.HaGatewaySuffix); ok && primaries[primary] {
+			continue
+		}
+		targets = append(targets, importTarget{
+			resourceType: resourceType,
+			name:         gw.GwName,
+			id:           gw.GwName,
+			body: func(body *hclwrite.Body) {
+				body.SetAttributeValue("cloud_type", cty.NumberIntVal(int64(gw.CloudType)))
+				setStrings(body,
+					"account_name", gw.AccountName,
+					"gw_name", gw.GwName,
+					"vpc_id", strings.Split(gw.VpcID, subnetSeparator)[0],
+					"vpc_reg", gw.VpcRegion,
+					"gw_size", gw.GwSize,
+					"subnet", gw.VpcNet,
+				)
+			},
+		})
+	}
+	return targets
+}
+
+func listTransitGatewayPeeringImports(_ context.Context, client *goaviatrix.Client) ([]importTarget, error) {
+	peerings, err := client.ListTransitGatewayPeerings()
+	if err != nil {
+		return nil, err
+	}
+	var targets []importTarget
+	for _, peering := range peerings {
+		targets = append(targets, importTarget{
+			resourceType: "aviatrix_transit_gateway_peering",
+			name:         peering.TransitGatewayName1 + "_" + peering.TransitGatewayName2,
+			id:           peering.TransitGatewayName1 + "~" + peering.TransitGatewayName2,
+			body: func(body *hclwrite.Body) {
+				setStrings(body,
+					"transit_gateway_name1", peering.TransitGatewayName1,
+					"transit_gateway_name2", peering.TransitGatewayName2,
+				)
+			},
+		})
+	}
+	return targets, nil
+}
+
+func listSite2CloudImports(_ context.Context, client *goaviatrix.Client) ([]importTarget, error) {
+	connections, err := client.ListSite2Cloud()
+	if err != nil {
+		return nil, err
+	}
+	var targets []importTarget
+	for _, conn := range connections {
+		targets = append(targets, importTarget{
+			resourceType: "aviatrix_site2cloud",
+			name:         conn.TunnelName,
+			id:           conn.TunnelName + "~" + conn.VpcID,
+			body: func(body *hclwrite.Body) {
+				setStrings(body,
+					"vpc_id", conn.VpcID,
+					"connection_name", conn.TunnelName,
+					"connection_type", conn.ConnType,
+					"tunnel_type", conn.TunnelType,
+					"primary_cloud_gateway_name", conn.GwName,
+					"remote_gateway_ip", conn.RemoteGwIP,
+					"remote_subnet_cidr", conn.RemoteSubnet,
+					"local_subnet_cidr", conn.LocalSubnet,
+				)
+			},
+		})
+	}
+	return targets, nil
+}
+
+func listNetworkDomainImports(_ context.Context, client *goaviatrix.Client) ([]importTarget, error) {
+	domains, err := client.ListSegmentationSecurityDomains()
+	if err != nil {
+		return nil, err
+	}
+	var targets []importTarget
+	for _, domain := range domains {
+		targets = append(targets, importTarget{
+			resourceType: "aviatrix_segmentation_network_domain",
+			name:         domain,
+			id:           domain,
+			body: func(body *hclwrite.Body) {
+				body.SetAttributeValue("domain_name", cty.StringVal(domain))
+			},
+		})
+	}
+	return targets, nil
+}
+
+func listSmartGroupImports(ctx context.Context, client *goaviatrix.Client) ([]importTarget, error) {
+	smartGroups, err := client.GetSmartGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var targets []importTarget
+	for _, smartGroup := range smartGroups {
+		targets = append(targets, importTarget{
+			resourceType: "aviatrix_smart_group",
+			name:         smartGroup.Name,
+			id:           smartGroup.UUID,
+			body: func(body *hclwrite.Body) {
+				body.SetAttributeValue("name", cty.StringVal(smartGroup.Name))
+				selector := body.AppendNewBlock("selector", nil).Body()
+				for _, filter := range smartGroup.Selector.Expressions {
+					setMatchExpression(selector.AppendNewBlock("match_expressions", nil).Body(), goaviatrix.SmartGroupFilterToResource(filter))
+				}
+			},
+		})
+	}
+	return targets, nil
+}
+
+// setMatchExpression sets the arguments of a match_expressions block from the
+// map the Smart Group resource reads it into.
+func setMatchExpression(body *hclwrite.Body, filterMap map[string]any) {
+	keys := make([]string, 0, len(filterMap))
+	for k := range filterMap {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		switch v := filterMap[k].(type) {
+		case string:
+			if v != "" {
+				body.SetAttributeValue(k, cty.StringVal(v))
+			}
+		case map[string]string:
+			if len(v) > 0 {
+				values := make(map[string]cty.Value, len(v))
+				for key, value := range v {
+					values[key] = cty.StringVal(value)
+				}
+				body.SetAttributeValue(k, cty.MapVal(values))
+			}
+		}
+	}
+}
+
+func listDCFRulesetImports(ctx context.Context, client *goaviatrix.Client) ([]importTarget, error) {
+	policyLists, err := client.ListDCFPolicyLists(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var targets []importTarget
+	for _, policyList := range policyLists {
+		if policyList.SystemResource || isDCFPolicyBlock(policyList) {
+			continue
+		}
+		targets = append(targets, importTarget{
+			resourceType: "aviatrix_dcf_ruleset",
+			name:         policyList.Name,
+			id:           policyList.UUID,
+			body: func(body *hclwrite.Body) {
+				body.SetAttributeValue("name", cty.StringVal(policyList.Name))
+				setStrings(body, "attach_to", policyList.AttachTo)
+				for _, policy := range policyList.Policies {
+					if policy.SystemResource {
+						continue
+					}
+					setDCFRule(body.AppendNewBlock("rules", nil).Body(), policy)
+				}
+			},
+		})
+	}
+	return targets, nil
+}
+
+// isDCFPolicyBlock reports whether a policy list was created by the
+// aviatrix_dcf_policy_block resource rather than as a ruleset.
+func isDCFPolicyBlock(policyList goaviatrix.DCFPolicyList) bool {
+	terraform, ok := policyList.Metadata["terraform"].(map[string]any)
+	return ok && terraform["resource_type"] == "terraform-policy-block"
+}
+
+// setDCFRule sets the arguments of a rules block of aviatrix_dcf_ruleset the
+// way the resource reads the policy.
+func setDCFRule(body *hclwrite.Body, policy goaviatrix.DCFPolicy) {
+	protocol := strings.ToUpper(policy.Protocol)
+	if protocol == "PROTOCOL_UNSPECIFIED" {
+		protocol = "ANY"
+	}
+	setStrings(body,
+		"name", policy.Name,
+		"action", policy.Action,
+	)
+	body.SetAttributeValue("priority", cty.NumberIntVal(int64(policy.Priority)))
+	body.SetAttributeValue("protocol", cty.StringVal(protocol))
+	body.SetAttributeValue("src_smart_groups", ctyStringList(policy.SrcSmartGroups))
+	body.SetAttributeValue("dst_smart_groups", ctyStringList(policy.DstSmartGroups))
+	if len(policy.WebGroups) > 0 {
+		body.SetAttributeValue("web_groups", ctyStringList(policy.WebGroups))
+	}
+	if policy.Logging {
+		body.SetAttributeValue("logging", cty.True)
+	}
+	setStrings(body,
+		"enforcement", policy.Enforcement,
+		"log_profile", policy.LogProfile,
+		"flow_app_requirement", policy.FlowAppRequirement,
+		"decrypt_policy", policy.DecryptPolicy,
+		"tls_profile", policy.TLSProfile,
+		"egress_path", policy.EgressPath,
+	)
+	if policy.ExcludeSgOrchestration {
+		body.SetAttributeValue("exclude_sg_orchestration", cty.True)
+	}
+	if protocol == "ICMP" {
+		return
+	}
+	for _, portRange := range policy.PortRanges {
+		ports := body.AppendNewBlock("port_ranges", nil).Body()
+		ports.SetAttributeValue("lo", cty.NumberIntVal(int64(portRange.Lo)))
+		if portRange.Hi != 0 {
+			ports.SetAttributeValue("hi", cty.NumberIntVal(int64(portRange.Hi)))
+		}
+	}
+}
+
+func ctyStringList(values []string) cty.Value {
+	if len(values) == 0 {
+		return cty.ListValEmpty(cty.String)
+	}
+	list := make([]cty.Value, len(values))
+	for i, v := range values {
+		list[i] = cty.StringVal(v)
+	}
+	return cty.ListVal(list)
+}
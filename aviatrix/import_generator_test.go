@@ -0,0 +1,226 @@
+package aviatrix
+
+import (
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix/controllertest"
+)
+
+func TestGenerateImportsCommand(t *testing.T) {
+	s := testAccFakeController(t)
+	s.HandleAction("list_vpcs_summary", func(p controllertest.Params) (any, error) {
+		gw := map[string]any{"account_name": "aws", "cloud_type": 1, "vpc_id": "vpc-1~~vpc-name", "vpc_region": "us-east-1", "vpc_size": "t3.small", "public_subnet": "10.0.0.0/26"}
+		if p.String("transit_only") == "true" {
+			return []map[string]any{with(gw, "vpc_name", "transit-1"), with(gw, "vpc_name", "transit-1-hagw")}, nil
+		}
+		return []map[string]any{with(gw, "vpc_name", "Spoke 1")}, nil
+	})
+	s.HandleAction("list_inter_transit_gateway_peering", func(controllertest.Params) (any, error) {
+		return [][]map[string]any{{{"gateway1": "transit-1", "gateway2": "transit-2"}}}, nil
+	})
+	s.HandleAction("list_site2cloud_conn", func(controllertest.Params) (any, error) {
+		return map[string]any{"connections": []map[string]any{{
+			"name": "onprem", "vpc_id": "vpc-1", "type": "unmapped", "tunnel_type": "route",
+			"gw_name": "transit-1", "peer_ip": "203.0.113.1", "remote_cidr": "192.168.0.0/16",
+		}}}, nil
+	})
+
+	client, err := goaviatrix.NewClient(s.Username, s.Password, s.Host(), s.Client(), nil)
+	require.NoError(t, err)
+	ctx := context.Background()
+	require.NoError(t, client.CreateAccount(&goaviatrix.Account{AccountName: "aws", CloudType: goaviatrix.AWS, AwsAccountNumber: "123456789012"}))
+	require.NoError(t, client.CreateSegmentationSecurityDomain(&goaviatrix.SegmentationSecurityDomain{DomainName: "prod"}))
+	sgUUID, err := client.CreateSmartGroup(ctx, &goaviatrix.SmartGroup{
+		Name: "web",
+		Selector: goaviatrix.SmartGroupSelector{Expressions: []*goaviatrix.SmartGroupMatchExpression{
+			{Type: "vm", Tags: map[string]string{"env": "prod"}},
+		}},
+	})
+	require.NoError(t, err)
+	rulesetUUID, err := client.CreateDCFPolicyList(ctx, &goaviatrix.DCFPolicyList{
+		Name: "ruleset",
+		Policies: []goaviatrix.DCFPolicy{{
+			Name: "allow-web", Action: "PERMIT", Priority: 10, Protocol: "TCP",
+			SrcSmartGroups: []string{sgUUID}, DstSmartGroups: []string{sgUUID},
+			PortRanges: []goaviatrix.DCFPortRange{{Lo: 443}},
+		}},
+	})
+	require.NoError(t, err)
+
+	out := filepath.Join(t.TempDir(), "imports.tf")
+	require.NoError(t, GenerateImportsCommand(ctx, []string{"-out", out}, nil))
+	got, err := os.ReadFile(out)
+	require.NoError(t, err)
+
+	assert.Equal(t, `import {
+  to = aviatrix_account.aws
+  id = "aws"
+}
+
+resource "aviatrix_account" "aws" {
+  account_name       = "aws"
+  cloud_type         = 1
+  aws_account_number = "123456789012"
+}
+
+import {
+  to = aviatrix_transit_gateway.transit-1
+  id = "transit-1"
+}
+
+resource "aviatrix_transit_gateway" "transit-1" {
+  cloud_type   = 1
+  account_name = "aws"
+  gw_name      = "transit-1"
+  vpc_id       = "vpc-1"
+  vpc_reg      = "us-east-1"
+  gw_size      = "t3.small"
+  subnet       = "10.0.0.0/26"
+}
+
+import {
+  to = aviatrix_spoke_gateway.spoke_1
+  id = "Spoke 1"
+}
+
+resource "aviatrix_spoke_gateway" "spoke_1" {
+  cloud_type   = 1
+  account_name = "aws"
+  gw_name      = "Spoke 1"
+  vpc_id       = "vpc-1"
+  vpc_reg      = "us-east-1"
+  gw_size      = "t3.small"
+  subnet       = "10.0.0.0/26"
+}
+
+import {
+  to = aviatrix_transit_gateway_peering.transit-1_transit-2
+  id = "transit-1~transit-2"
+}
+
+resource "aviatrix_transit_gateway_peering" "transit-1_transit-2" {
+  transit_gateway_name1 = "transit-1"
+  transit_gateway_name2 = "transit-2"
+}
+
+import {
+  to = aviatrix_site2cloud.onprem
+  id = "onprem~vpc-1"
+}
+
+resource "aviatrix_site2cloud" "onprem" {
+  vpc_id                     = "vpc-1"
+  connection_name            = "onprem"
+  connection_type            = "unmapped"
+  tunnel_type                = "route"
+  primary_cloud_gateway_name = "transit-1"
+  remote_gateway_ip          = "203.0.113.1"
+  remote_subnet_cidr         = "192.168.0.0/16"
+}
+
+import {
+  to = aviatrix_segmentation_network_domain.prod
+  id = "prod"
+}
+
+resource "aviatrix_segmentation_network_domain" "prod" {
+  domain_name = "prod"
+}
+
+import {
+  to = aviatrix_smart_group.web
+  id = "`+sgUUID+`"
+}
+
+resource "aviatrix_smart_group" "web" {
+  name = "web"
+  selector {
+    match_expressions {
+      tags = {
+        env = "prod"
+      }
+      type = "vm"
+    }
+  }
+}
+
+import {
+  to = aviatrix_dcf_ruleset.ruleset
+  id = "`+rulesetUUID+`"
+}
+
+resource "aviatrix_dcf_ruleset" "ruleset" {
+  name = "ruleset"
+  rules {
+    name             = "allow-web"
+    action           = "PERMIT"
+    priority         = 10
+    protocol         = "TCP"
+    src_smart_groups = ["`+sgUUID+`"]
+    dst_smart_groups = ["`+sgUUID+`"]
+    port_ranges {
+      lo = 443
+    }
+  }
+}
+
+`, string(got))
+}
+
+func with(m map[string]any, key string, value any) map[string]any {
+	out := make(map[string]any, len(m)+1)
+	for k, v := range m {
+		out[k] = v
+	}
+	out[key] = value
+	return out
+}
+
+func TestUniqueImportResourceName(t *testing.T) {
+	used := map[string]bool{}
+	assert.Equal(t, "gw-1", uniqueImportResourceName(used, "gw-1"))
+	assert.Equal(t, "gw-1_2", uniqueImportResourceName(used, "GW-1"))
+	assert.Equal(t, "_10_0_0_0_16", uniqueImportResourceName(used, "10.0.0.0/16"))
+	assert.Equal(t, "_", uniqueImportResourceName(used, ""))
+}
+
+func TestProviderFlags(t *testing.T) {
+	flags := flag.NewFlagSet("generate-imports", flag.ContinueOnError)
+	providerConfig := providerFlags(flags, Provider().Schema)
+	require.NoError(t, flags.Parse([]string{
+		"-verify-ssl-certificate",
+		"-tls-server-name", "controller.example.com",
+		"-retry-max-attempts", "3",
+		"-retry-retryable-http-codes", "502, 503",
+		"-rate-limit-requests-per-second", "2.5",
+	}))
+
+	raw, err := providerConfig()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"verify_ssl_certificate": true,
+		"tls_server_name":        "controller.example.com",
+		"retry":                  []any{map[string]any{"max_attempts": 3, "retryable_http_codes": []any{502, 503}}},
+		"rate_limit":             []any{map[string]any{"requests_per_second": 2.5}},
+	}, raw)
+
+	require.NoError(t, flags.Parse([]string{"-rate-limit-burst", "many"}))
+	_, err = providerConfig()
+	assert.ErrorContains(t, err, `invalid value "many" for flag -rate-limit-burst`)
+	assert.Nil(t, flags.Lookup("default-tags-tags"), "maps have no flag")
+}
+
+func TestGenerateImportsCommand_ValidatesProviderFlags(t *testing.T) {
+	testAccFakeController(t)
+
+	err := GenerateImportsCommand(context.Background(), []string{"-client-certificate", "cert.pem"}, nil)
+	assert.ErrorContains(t, err, "client_key")
+}
@@ -0,0 +1,111 @@
+package aviatrix
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
+)
+
+// instrumentProvider wraps the operations of every resource and data source
+// of the provider, so that each CRUD operation is traced and the logs of the
+// provider and of goaviatrix for it share its fields and masks.
+func instrumentProvider(p *schema.Provider) *schema.Provider {
+	for name, r := range p.ResourcesMap {
+		instrumentResource(name, r)
+	}
+	for name, r := range p.DataSourcesMap {
+		instrumentResource("data."+name, r)
+	}
+	return p
+}
+
+// instrumentResource wraps the operations of a resource. name is the
+// resource type, prefixed with "data." for a data source.
+func instrumentResource(name string, r *schema.Resource) {
+	r.CreateContext = instrumentCRUD(name, "create", r.CreateContext)
+	r.ReadContext = instrumentCRUD(name, "read", r.ReadContext)
+	r.UpdateContext = instrumentCRUD(name, "update", r.UpdateContext)
+	r.DeleteContext = instrumentCRUD(name, "delete", r.DeleteContext)
+	r.CreateWithoutTimeout = instrumentCRUD(name, "create", r.CreateWithoutTimeout)
+	r.ReadWithoutTimeout = instrumentCRUD(name, "read", r.ReadWithoutTimeout)
+	r.UpdateWithoutTimeout = instrumentCRUD(name, "update", r.UpdateWithoutTimeout)
+	r.DeleteWithoutTimeout = instrumentCRUD(name, "delete", r.DeleteWithoutTimeout)
+	r.Create = instrumentLegacyCRUD(name, "create", r.Create) //nolint:staticcheck // SA1019: deprecated but still used by many resources
+	r.Read = instrumentLegacyCRUD(name, "read", r.Read)       //nolint:staticcheck // SA1019: deprecated but still used by many resources
+	r.Update = instrumentLegacyCRUD(name, "update", r.Update) //nolint:staticcheck // SA1019: deprecated but still used by many resources
+	r.Delete = instrumentLegacyCRUD(name, "delete", r.Delete) //nolint:staticcheck // SA1019: deprecated but still used by many resources
+	if customizeDiff := r.CustomizeDiff; customizeDiff != nil {
+		r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+			return customizeDiff(resourceLogContext(ctx, d.Id(), meta), d, meta)
+		}
+	}
+	if r.Importer != nil && r.Importer.StateContext != nil {
+		importState := r.Importer.StateContext
+		r.Importer.StateContext = func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+			return importState(resourceLogContext(ctx, d.Id(), meta), d, meta)
+		}
+	}
+}
+
+func instrumentCRUD[F ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](name, operation string, f F) F {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		ctx, span := startResourceSpan(ctx, name, operation, d)
+		defer span.End()
+		diags := f(resourceLogContext(ctx, d.Id(), meta), d, meta)
+		endResourceSpan(span, d, diagsError(diags))
+		return diags
+	}
+}
+
+// instrumentLegacyCRUD traces an operation without a context. Its requests
+// to the Controller are not part of its span.
+func instrumentLegacyCRUD[F ~func(*schema.ResourceData, any) error](name, operation string, f F) F {
+	if f == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, meta any) error {
+		_, span := startResourceSpan(context.Background(), name, operation, d)
+		defer span.End()
+		err := f(d, meta)
+		endResourceSpan(span, d, err)
+		return err
+	}
+}
+
+func diagsError(diags diag.Diagnostics) error {
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			return errors.New(d.Summary)
+		}
+	}
+	return nil
+}
+
+func startResourceSpan(ctx context.Context, name, operation string, d *schema.ResourceData) (context.Context, trace.Span) {
+	ctx, span := tracer().Start(ctx, name+"."+operation, trace.WithAttributes(
+		attributeResourceType.String(name),
+		attributeOperation.String(operation),
+	))
+	if id := d.Id(); id != "" {
+		span.SetAttributes(attributeResourceID.String(id))
+	}
+	return ctx, span
+}
+
+func endResourceSpan(span trace.Span, d *schema.ResourceData, err error) {
+	if id := d.Id(); id != "" {
+		span.SetAttributes(attributeResourceID.String(id))
+	}
+	if err != nil {
+		span.SetStatus(codes.Error, goaviatrix.RedactString(err.Error()))
+	}
+}
@@ -0,0 +1,125 @@
+package aviatrix
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
+)
+
+// goaviatrixLogSubsystem is the tflog subsystem the goaviatrix client logs
+// to. Its level is set with the TF_LOG_PROVIDER_AVIATRIX_GOAVIATRIX
+// environment variable.
+const goaviatrixLogSubsystem = "goaviatrix"
+
+// providerLogContext is the context of the provider's own root logger, the
+// same logger the SDK creates for each RPC but without the fields of an RPC.
+// It is where logs without a request context go: state migrations and the
+// goaviatrix calls that take no context.
+var providerLogContext = sync.OnceValue(func() context.Context {
+	return newProviderLogContext(tfsdklog.NewRootProviderLogger(context.Background(),
+		tfsdklog.WithStderrFromInit(),
+		tfsdklog.WithLogName("aviatrix"),
+		tflog.WithLevelFromEnv("TF_LOG_PROVIDER", "aviatrix"),
+	))
+})
+
+// newProviderLogContext adds the masks of the provider and the goaviatrix
+// subsystem to a root provider logger.
+func newProviderLogContext(ctx context.Context) context.Context {
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, goaviatrix.SensitiveLogKeys()...)
+	return withGoaviatrixLogging(ctx)
+}
+
+type goaviatrixLoggingKey struct{}
+
+// withGoaviatrixLogging returns a context whose tflog logger has the
+// goaviatrix subsystem. The subsystem inherits the fields of the root logger,
+// such as the resource type Terraform sets for an RPC.
+func withGoaviatrixLogging(ctx context.Context) context.Context {
+	if ctx.Value(goaviatrixLoggingKey{}) != nil {
+		return ctx
+	}
+	ctx = tflog.NewSubsystem(ctx, goaviatrixLogSubsystem,
+		tflog.WithLevelFromEnv("TF_LOG_PROVIDER_AVIATRIX", goaviatrixLogSubsystem),
+		tflog.WithRootFields(),
+	)
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, goaviatrixLogSubsystem, goaviatrix.SensitiveLogKeys()...)
+	return context.WithValue(ctx, goaviatrixLoggingKey{}, true)
+}
+
+// goaviatrixLogHandler is the slog handler of the goaviatrix client. It
+// writes each record to the goaviatrix subsystem of the tflog logger in the
+// context of the call, or of the provider's root logger when the call has
+// none, e.g. from the client methods without a context argument.
+type goaviatrixLogHandler struct {
+	attrs []slog.Attr
+}
+
+func (goaviatrixLogHandler) Enabled(context.Context, slog.Level) bool {
+	// tflog filters by the level of the subsystem.
+	return true
+}
+
+func (h goaviatrixLogHandler) Handle(ctx context.Context, r slog.Record) error {
+	if ctx == nil || ctx.Value(goaviatrixLoggingKey{}) == nil {
+		ctx = providerLogContext()
+	}
+	fields := make(map[string]any, len(h.attrs)+r.NumAttrs())
+	for _, a := range h.attrs {
+		fields[a.Key] = a.Value.Any()
+	}
+	r.Attrs(func(a slog.Attr) bool {
+		fields[a.Key] = a.Value.Any()
+		return true
+	})
+	switch {
+	case r.Level < slog.LevelDebug:
+		tflog.SubsystemTrace(ctx, goaviatrixLogSubsystem, r.Message, fields)
+	case r.Level < slog.LevelInfo:
+		tflog.SubsystemDebug(ctx, goaviatrixLogSubsystem, r.Message, fields)
+	case r.Level < slog.LevelWarn:
+		tflog.SubsystemInfo(ctx, goaviatrixLogSubsystem, r.Message, fields)
+	case r.Level < slog.LevelError:
+		tflog.SubsystemWarn(ctx, goaviatrixLogSubsystem, r.Message, fields)
+	default:
+		tflog.SubsystemError(ctx, goaviatrixLogSubsystem, r.Message, fields)
+	}
+	return nil
+}
+
+func (h goaviatrixLogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return goaviatrixLogHandler{attrs: append(slices.Clip(h.attrs), attrs...)}
+}
+
+// WithGroup returns h: goaviatrix does not group its attributes.
+func (h goaviatrixLogHandler) WithGroup(string) slog.Handler {
+	return h
+}
+
+// resourceLogContext returns the context to log a CRUD operation of a
+// resource or data source to. Terraform does not send the address of the
+// resource, so its lines carry the resource ID, when it has one, next to the
+// resource type the SDK sets.
+func resourceLogContext(ctx context.Context, id string, meta any) context.Context {
+	var secrets []string
+	if client, ok := meta.(*goaviatrix.Client); ok && client != nil {
+		for _, secret := range []string{client.CID, client.GetCID(), client.Password, client.APIToken} {
+			if secret != "" {
+				secrets = append(secrets, secret)
+			}
+		}
+	}
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, goaviatrix.SensitiveLogKeys()...)
+	ctx = tflog.MaskLogStrings(ctx, secrets...)
+	if id != "" {
+		ctx = tflog.SetField(ctx, "resource_id", id)
+	}
+	ctx = withGoaviatrixLogging(ctx)
+	return tflog.SubsystemMaskLogStrings(ctx, goaviatrixLogSubsystem, secrets...)
+}
@@ -0,0 +1,65 @@
+package aviatrix
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
+)
+
+func TestResourceLogging(t *testing.T) {
+	var output bytes.Buffer
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{"name": {Type: schema.TypeString, Optional: true}},
+		ReadWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			tflog.Info(ctx, "Reading account", map[string]any{"password": "hunter2"})
+			tflog.SubsystemInfo(ctx, goaviatrixLogSubsystem, "Using CID secret-cid")
+			return nil
+		},
+	}
+	instrumentResource("aviatrix_account", r)
+
+	d := r.TestResourceData()
+	d.SetId("account")
+	client := &goaviatrix.Client{CID: "secret-cid"}
+	diags := r.ReadWithoutTimeout(tflogtest.RootLogger(context.Background(), &output), d, client)
+	require.False(t, diags.HasError())
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "account", entries[0]["resource_id"])
+	assert.Equal(t, "***", entries[0]["password"])
+	assert.Equal(t, "provider.goaviatrix", entries[1]["@module"])
+	assert.Equal(t, "account", entries[1]["resource_id"])
+	assert.Equal(t, "Using CID ***", entries[1]["@message"])
+}
+
+func TestGoaviatrixLogHandler(t *testing.T) {
+	var output bytes.Buffer
+	ctx := withGoaviatrixLogging(tflogtest.RootLogger(context.Background(), &output))
+	logger := slog.New(goaviatrixLogHandler{}).With("action", "list_accounts")
+
+	logger.Log(ctx, goaviatrix.LevelTrace, "Sending request", "password", "hunter2")
+	logger.WarnContext(ctx, "Request failed, retrying", "attempt", 1)
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "trace", entries[0]["@level"])
+	assert.Equal(t, "provider.goaviatrix", entries[0]["@module"])
+	assert.Equal(t, "list_accounts", entries[0]["action"])
+	assert.Equal(t, "***", entries[0]["password"])
+	assert.Equal(t, "warn", entries[1]["@level"])
+	assert.Equal(t, "Request failed, retrying", entries[1]["@message"])
+	assert.Equal(t, float64(1), entries[1]["attempt"])
+}
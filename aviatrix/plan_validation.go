@@ -0,0 +1,235 @@
+package aviatrix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
+)
+
+// gatewayPlanAttributes names the arguments of a gateway resource that are
+// validated against the Controller at plan time.
+type gatewayPlanAttributes struct {
+	// sizes are the instance size arguments, e.g. gw_size and ha_gw_size.
+	sizes []string
+	// versions pairs each software version argument with its image version
+	// argument.
+	versions [][2]string
+	// features are the arguments only supported by some cloud types.
+	features []cloudTypeFeature
+}
+
+// cloudTypeFeature is an argument that may only be set for some cloud types.
+// The gateway resources check it both at plan time and when creating the
+// gateway, so both report the same error.
+type cloudTypeFeature struct {
+	attribute  string
+	cloudTypes int
+}
+
+func (f cloudTypeFeature) supports(cloudType int) bool {
+	return goaviatrix.IsCloudType(cloudType, f.cloudTypes)
+}
+
+func (f cloudTypeFeature) unsupportedError() error {
+	return fmt.Errorf("'%s' is only supported for %s", f.attribute, goaviatrix.CloudTypesToString(f.cloudTypes))
+}
+
+// Arguments of the gateway resources that are only supported by some cloud
+// types.
+var (
+	featureEncryptVolume         = cloudTypeFeature{"enable_encrypt_volume", goaviatrix.AWSRelatedCloudTypes}
+	featureMonitorGatewaySubnets = cloudTypeFeature{"enable_monitor_gateway_subnets", goaviatrix.AWSRelatedCloudTypes ^ goaviatrix.AWSChina}
+	featureSpotInstance          = cloudTypeFeature{"enable_spot_instance", goaviatrix.AWSRelatedCloudTypes | goaviatrix.AzureArmRelatedCloudTypes}
+	featureRxQueueSize           = cloudTypeFeature{"rx_queue_size", goaviatrix.AWSRelatedCloudTypes}
+	featurePrivateOob            = cloudTypeFeature{"enable_private_oob", goaviatrix.AWSRelatedCloudTypes}
+	featureGatewayLoadBalancer   = cloudTypeFeature{"enable_gateway_load_balancer", goaviatrix.AWS}
+	featureTransitBgpOverLan     = cloudTypeFeature{"enable_bgp_over_lan", goaviatrix.AzureArmRelatedCloudTypes | goaviatrix.GCP}
+	featureSpokeBgpOverLan       = cloudTypeFeature{"enable_bgp_over_lan", goaviatrix.AzureArmRelatedCloudTypes}
+)
+
+var gatewayPlanChecks = gatewayPlanAttributes{
+	sizes:    []string{"gw_size", "peering_ha_gw_size"},
+	versions: [][2]string{{"software_version", "image_version"}, {"peering_ha_software_version", "peering_ha_image_version"}},
+	features: []cloudTypeFeature{featureEncryptVolume, featureMonitorGatewaySubnets, featureSpotInstance, featureRxQueueSize},
+}
+
+var transitGatewayPlanChecks = gatewayPlanAttributes{
+	sizes:    []string{"gw_size", "ha_gw_size"},
+	versions: [][2]string{{"software_version", "image_version"}, {"ha_software_version", "ha_image_version"}},
+	features: []cloudTypeFeature{
+		featureEncryptVolume, featureMonitorGatewaySubnets, featureSpotInstance, featureRxQueueSize,
+		featureGatewayLoadBalancer, featureTransitBgpOverLan, featurePrivateOob,
+	},
+}
+
+var spokeGatewayPlanChecks = gatewayPlanAttributes{
+	sizes:    []string{"gw_size", "ha_gw_size"},
+	versions: [][2]string{{"software_version", "image_version"}, {"ha_software_version", "ha_image_version"}},
+	features: []cloudTypeFeature{
+		featureEncryptVolume, featureMonitorGatewaySubnets, featureSpotInstance, featureRxQueueSize,
+		featureSpokeBgpOverLan, featurePrivateOob,
+	},
+}
+
+// customizeDiffGatewayCapabilities validates the arguments of a gateway
+// against what its cloud type and the Controller support, so that a plan
+// fails instead of an apply several minutes into creating the gateway. Only
+// arguments that change are checked. The Controller is asked for the regions,
+// sizes and image versions it supports; a query the Controller cannot answer
+// is skipped with a warning rather than blocking the plan.
+func customizeDiffGatewayCapabilities(checks gatewayPlanAttributes) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		if !d.NewValueKnown("cloud_type") {
+			return nil
+		}
+		cloudType := mustInt(d.Get("cloud_type"))
+
+		var errs []error
+		for _, feature := range checks.features {
+			if featureSet(d, feature.attribute) && !feature.supports(cloudType) {
+				errs = append(errs, feature.unsupportedError())
+			}
+		}
+
+		client, ok := meta.(goaviatrix.CapabilityClient)
+		if !ok || client == nil {
+			return errors.Join(errs...)
+		}
+		if !goaviatrix.IsCloudType(cloudType, goaviatrix.EdgeRelatedCloudTypes) {
+			errs = append(errs, validateGatewayPlacement(ctx, d, client, cloudType, checks.sizes))
+		}
+		for _, pair := range checks.versions {
+			errs = append(errs, validateGatewayImageVersion(ctx, d, client, cloudType, pair[0], pair[1]))
+		}
+		return errors.Join(errs...)
+	}
+}
+
+// featureSet reports whether the plan sets a boolean argument to true or any
+// other argument to a non-empty value.
+func featureSet(d *schema.ResourceDiff, attribute string) bool {
+	if !d.HasChange(attribute) || !d.NewValueKnown(attribute) {
+		return false
+	}
+	switch v := d.Get(attribute).(type) {
+	case bool:
+		return v
+	case string:
+		return v != ""
+	default:
+		return false
+	}
+}
+
+// changedKnownString returns the planned value of a string argument if it
+// changes, is known and is not empty.
+func changedKnownString(d *schema.ResourceDiff, attribute string) (string, bool) {
+	if !d.HasChange(attribute) || !d.NewValueKnown(attribute) {
+		return "", false
+	}
+	v := mustString(d.Get(attribute))
+	return v, v != ""
+}
+
+// validateGatewayPlacement checks the region of a new gateway and the sizes
+// of the gateway and its HA gateway.
+func validateGatewayPlacement(ctx context.Context, d *schema.ResourceDiff, client goaviatrix.CapabilityClient, cloudType int, sizeAttributes []string) error {
+	if !d.NewValueKnown("account_name") || !d.NewValueKnown("vpc_reg") {
+		return nil
+	}
+	accountName := mustString(d.Get("account_name"))
+	region := mustString(d.Get("vpc_reg"))
+	if goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes) {
+		// vpc_reg of a GCP gateway is a zone
+		region = gcpRegionFromZone(region)
+	}
+
+	if d.HasChange("vpc_reg") {
+		regions, err := client.ListGatewayRegions(ctx, cloudType, accountName)
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Skipping plan-time validation of vpc_reg: could not list the regions of account %s: %v", accountName, err))
+		} else if len(regions) != 0 && !containsRegion(regions, region) {
+			return fmt.Errorf("region %q is not available to account %q; available regions: %s", region, accountName, strings.Join(regions, ", "))
+		}
+	}
+
+	var sizes []string
+	var sizesErr error
+	for _, attribute := range sizeAttributes {
+		size, ok := changedKnownString(d, attribute)
+		if !ok {
+			continue
+		}
+		if sizes == nil && sizesErr == nil {
+			sizes, sizesErr = client.ListGatewaySizes(ctx, cloudType, accountName, region)
+			if sizesErr != nil {
+				tflog.Warn(ctx, fmt.Sprintf("Skipping plan-time validation of gateway sizes: could not list the sizes of account %s in %s: %v", accountName, region, sizesErr))
+			}
+		}
+		if sizesErr != nil || len(sizes) == 0 {
+			break
+		}
+		if !slices.Contains(sizes, size) {
+			return fmt.Errorf("%q is not a supported %s for account %q in region %q; supported sizes: %s", size, attribute, accountName, region, strings.Join(sizes, ", "))
+		}
+	}
+	return nil
+}
+
+// containsRegion reports whether region is one of regions. Azure regions are
+// written both as display names and as names, e.g. "East US" and "eastus", so
+// case and spaces are ignored.
+func containsRegion(regions []string, region string) bool {
+	normalize := func(s string) string {
+		return strings.ToLower(strings.ReplaceAll(s, " ", ""))
+	}
+	return slices.ContainsFunc(regions, func(r string) bool {
+		return normalize(r) == normalize(region)
+	})
+}
+
+// configured reports whether the configuration sets an argument. Diffs made
+// without a raw configuration, as in unit tests, count every argument as set.
+func configured(d *schema.ResourceDiff, attribute string) bool {
+	config := d.GetRawConfig()
+	return config.IsNull() || !config.GetAttr(attribute).IsNull()
+}
+
+// validateGatewayImageVersion checks that an image version configured together
+// with a software version is the one the Controller pairs with it. Both are
+// computed, so a version kept from the state, e.g. the image version of a
+// gateway being upgraded to a new software version, is not checked. Only an
+// error the Controller classified, e.g. an unsupported software version, fails
+// the plan; any other failure skips the check with a warning.
+func validateGatewayImageVersion(ctx context.Context, d *schema.ResourceDiff, client goaviatrix.CapabilityClient, cloudType int, softwareAttribute, imageAttribute string) error {
+	if !d.HasChanges(softwareAttribute, imageAttribute) || !d.NewValueKnown(softwareAttribute) || !d.NewValueKnown(imageAttribute) {
+		return nil
+	}
+	if !configured(d, softwareAttribute) || !configured(d, imageAttribute) {
+		return nil
+	}
+	softwareVersion := mustString(d.Get(softwareAttribute))
+	imageVersion := mustString(d.Get(imageAttribute))
+	if softwareVersion == "" || imageVersion == "" {
+		return nil
+	}
+	compatible, err := client.GetCompatibleImageVersion(ctx, cloudType, softwareVersion)
+	if err != nil && !goaviatrix.IsClassified(err) {
+		tflog.Warn(ctx, fmt.Sprintf("Skipping plan-time validation of %s: could not get the image version compatible with %s %q: %v", imageAttribute, softwareAttribute, softwareVersion, err))
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not get the image version compatible with %s %q: %w", softwareAttribute, softwareVersion, err)
+	}
+	if compatible != "" && compatible != imageVersion {
+		return fmt.Errorf("%s %q is not compatible with %s %q; the compatible image version is %q", imageAttribute, imageVersion, softwareAttribute, softwareVersion, compatible)
+	}
+	return nil
+}
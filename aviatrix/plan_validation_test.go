@@ -0,0 +1,187 @@
+package aviatrix
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
+)
+
+func testCapabilityClient() *goaviatrix.CapabilityClientMock {
+	return &goaviatrix.CapabilityClientMock{
+		ListGatewayRegionsFunc: func(_ context.Context, _ int, _ string) ([]string, error) {
+			return []string{"us-east-1", "us-west-2"}, nil
+		},
+		ListGatewaySizesFunc: func(_ context.Context, _ int, _, _ string) ([]string, error) {
+			return []string{"t3.small", "t3.medium"}, nil
+		},
+		GetCompatibleImageVersionFunc: func(_ context.Context, _ int, _ string) (string, error) {
+			return "hvm-cloudx-aws-102320", nil
+		},
+	}
+}
+
+// testCapabilitiesResource is a spoke gateway with only the capability checks
+// as CustomizeDiff.
+func testCapabilitiesResource(checks gatewayPlanAttributes) *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceAviatrixSpokeGateway().Schema,
+		CustomizeDiff: customizeDiffGatewayCapabilities(checks),
+	}
+}
+
+func testSpokeGatewayConfig(overrides map[string]any) *terraform.ResourceConfig {
+	config := map[string]any{
+		"cloud_type":   1,
+		"account_name": "aws",
+		"gw_name":      "spoke",
+		"vpc_id":       "vpc-1",
+		"vpc_reg":      "us-east-1",
+		"gw_size":      "t3.small",
+		"subnet":       "10.0.0.0/26",
+	}
+	for k, v := range overrides {
+		config[k] = v
+	}
+	return terraform.NewResourceConfigRaw(config)
+}
+
+func TestGatewayCapabilities(t *testing.T) {
+	tests := []struct {
+		name      string
+		overrides map[string]any
+		wantErr   string
+	}{
+		{name: "valid"},
+		{
+			name:      "unsupported region",
+			overrides: map[string]any{"vpc_reg": "mars-1"},
+			wantErr:   `region "mars-1" is not available to account "aws"; available regions: us-east-1, us-west-2`,
+		},
+		{
+			name:      "unsupported size",
+			overrides: map[string]any{"gw_size": "t3.huge"},
+			wantErr:   `"t3.huge" is not a supported gw_size for account "aws" in region "us-east-1"`,
+		},
+		{
+			name:      "unsupported HA size",
+			overrides: map[string]any{"ha_subnet": "10.0.1.0/26", "ha_gw_size": "t3.huge"},
+			wantErr:   `"t3.huge" is not a supported ha_gw_size`,
+		},
+		{
+			name:      "incompatible image",
+			overrides: map[string]any{"software_version": "7.1", "image_version": "hvm-cloudx-aws-000000"},
+			wantErr:   `image_version "hvm-cloudx-aws-000000" is not compatible with software_version "7.1"; the compatible image version is "hvm-cloudx-aws-102320"`,
+		},
+		{
+			name:      "compatible image",
+			overrides: map[string]any{"software_version": "7.1", "image_version": "hvm-cloudx-aws-102320"},
+		},
+		{
+			name:      "feature of another cloud type",
+			overrides: map[string]any{"enable_bgp": true, "enable_bgp_over_lan": true},
+			wantErr:   "'enable_bgp_over_lan' is only supported for Azure (8), AzureGov (32), AzureChina (2048)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := testCapabilitiesResource(spokeGatewayPlanChecks).Diff(context.Background(), nil, testSpokeGatewayConfig(tt.overrides), testCapabilityClient())
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGatewayCapabilities_GCPZone(t *testing.T) {
+	client := testCapabilityClient()
+	client.ListGatewayRegionsFunc = func(_ context.Context, _ int, _ string) ([]string, error) {
+		return []string{"us-east1"}, nil
+	}
+	config := terraform.NewResourceConfigRaw(map[string]any{
+		"cloud_type":   goaviatrix.GCP,
+		"account_name": "gcp",
+		"gw_name":      "transit",
+		"vpc_id":       "vpc~-~project",
+		"vpc_reg":      "us-east1-b",
+		"gw_size":      "t3.small",
+		"subnet":       "10.0.0.0/26",
+	})
+
+	_, err := testCapabilitiesResource(transitGatewayPlanChecks).Diff(context.Background(), nil, config, client)
+	require.NoError(t, err)
+	require.NotEmpty(t, client.ListGatewaySizesCalls())
+	assert.Equal(t, "us-east1", client.ListGatewaySizesCalls()[0].Region)
+}
+
+func TestGatewayCapabilities_QueryFailureSkipped(t *testing.T) {
+	client := testCapabilityClient()
+	client.ListGatewayRegionsFunc = func(_ context.Context, _ int, _ string) ([]string, error) {
+		return nil, errors.New("unknown action")
+	}
+	client.ListGatewaySizesFunc = func(_ context.Context, _ int, _, _ string) ([]string, error) {
+		return nil, errors.New("unknown action")
+	}
+	client.GetCompatibleImageVersionFunc = func(_ context.Context, _ int, _ string) (string, error) {
+		return "", errors.New("connection reset by peer")
+	}
+
+	_, err := testCapabilitiesResource(spokeGatewayPlanChecks).Diff(context.Background(), nil, testSpokeGatewayConfig(map[string]any{
+		"vpc_reg":          "mars-1",
+		"gw_size":          "t3.huge",
+		"software_version": "7.1",
+		"image_version":    "hvm-cloudx-aws-000000",
+	}), client)
+	assert.NoError(t, err)
+}
+
+func TestGatewayCapabilities_ClassifiedImageVersionError(t *testing.T) {
+	client := testCapabilityClient()
+	client.GetCompatibleImageVersionFunc = func(_ context.Context, _ int, _ string) (string, error) {
+		return "", &goaviatrix.ControllerError{
+			StatusError: goaviatrix.NewStatusError(200, errors.New("software version 1.0 is not supported")),
+			Action:      "get_compatible_image_version",
+			Kind:        goaviatrix.ErrValidation,
+		}
+	}
+
+	_, err := testCapabilitiesResource(spokeGatewayPlanChecks).Diff(context.Background(), nil, testSpokeGatewayConfig(map[string]any{
+		"software_version": "1.0",
+		"image_version":    "hvm-cloudx-aws-000000",
+	}), client)
+	assert.ErrorContains(t, err, `could not get the image version compatible with software_version "1.0": software version 1.0 is not supported`)
+}
+
+func TestGatewayCapabilities_UnchangedNotChecked(t *testing.T) {
+	client := testCapabilityClient()
+	state := &terraform.InstanceState{ID: "spoke", Attributes: map[string]string{
+		"id":               "spoke",
+		"cloud_type":       "1",
+		"account_name":     "aws",
+		"gw_name":          "spoke",
+		"vpc_id":           "vpc-1",
+		"vpc_reg":          "us-east-1",
+		"gw_size":          "t3.small",
+		"subnet":           "10.0.0.0/26",
+		"software_version": "7.0",
+		// ForceNew arguments that would otherwise replace the gateway
+		"enable_bgp":          "false",
+		"enable_bgp_over_lan": "false",
+		"insertion_gateway":   "false",
+		"insane_mode":         "false",
+	}}
+
+	_, err := testCapabilitiesResource(spokeGatewayPlanChecks).Diff(context.Background(), state, testSpokeGatewayConfig(map[string]any{"software_version": "7.1"}), client)
+	require.NoError(t, err)
+	assert.Empty(t, client.ListGatewayRegionsCalls())
+	assert.Empty(t, client.ListGatewaySizesCalls())
+	assert.Empty(t, client.GetCompatibleImageVersionCalls())
+}
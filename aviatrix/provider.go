@@ -1,14 +1,21 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"os"
+	"regexp"
+	"sort"
+	"time"
 
 	_ "embed"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 //go:embed terraform_provider_version.txt
@@ -17,7 +24,7 @@ var supportedVersions = []string{buildVersion}
 
 // Provider returns a schema.Provider for Aviatrix.
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	return instrumentProvider(&schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"controller_ip": {
 				Type:        schema.TypeString,
@@ -26,14 +33,27 @@ func Provider() *schema.Provider {
 			},
 			"username": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: envDefaultFunc("AVIATRIX_USERNAME"),
 			},
 			"password": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: envDefaultFunc("AVIATRIX_PASSWORD"),
 			},
+			"api_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: envDefaultFunc("AVIATRIX_API_TOKEN"),
+				Description: "API token (CID) issued by the Controller, used instead of username and password.",
+			},
+			"api_token_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: envDefaultFunc("AVIATRIX_API_TOKEN_FILE"),
+				Description: "Path to a file holding the API token. The file is checked for a new token every 10 seconds and whenever the Controller rejects the current one, so the token can be rotated.",
+			},
 			"skip_version_validation": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -48,6 +68,40 @@ func Provider() *schema.Provider {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"client_certificate": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"client_key"},
+				Description:  "Client certificate for mutual TLS, as a file path or PEM content.",
+			},
+			"client_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"client_certificate"},
+				Description:  "Private key of the client certificate, as a file path or PEM content.",
+			},
+			"tls_server_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Host name used for SNI and to verify the Controller certificate, when it differs from controller_ip.",
+			},
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with tags to add to every taggable resource.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Tags to add to every taggable resource. Tags set on a resource take precedence.",
+						},
+					},
+				},
+			},
 			"ignore_tags": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -72,6 +126,83 @@ func Provider() *schema.Provider {
 					},
 				},
 			},
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to retry failed Controller API calls.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      5,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Total number of attempts for each API call, including the first.",
+						},
+						"base_backoff": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "500ms",
+							ValidateFunc: validateDuration,
+							Description:  "Initial wait between attempts. Doubles after each failed attempt.",
+						},
+						"max_backoff": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "30s",
+							ValidateFunc: validateDuration,
+							Description:  "Maximum wait between attempts.",
+						},
+						"jitter": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Randomize each wait between half and all of the backoff.",
+						},
+						"retryable_http_codes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntBetween(100, 599)},
+							Description: "HTTP status codes that are retried for every request.",
+						},
+						"retryable_reason_patterns": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+							Description: "Regular expressions matched against the reason of a failed API call. Matching calls are retried.",
+						},
+					},
+				},
+			},
+			"rate_limit": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to limit the rate and concurrency of Controller API calls.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"requests_per_second": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatAtLeast(0),
+							Description:  "Maximum sustained rate of API calls per second, shared by all resources. 0 means unlimited.",
+						},
+						"burst": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "Number of API calls that may be sent at once before requests_per_second applies. Defaults to requests_per_second rounded up.",
+						},
+						"max_in_flight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "Maximum number of API calls waiting for a response at any time. 0 means unlimited.",
+						},
+					},
+				},
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -185,6 +316,7 @@ func Provider() *schema.Provider {
 			"aviatrix_segmentation_network_domain_association":                resourceAviatrixSegmentationNetworkDomainAssociation(),
 			"aviatrix_segmentation_network_domain_connection_policy":          resourceAviatrixSegmentationNetworkDomainConnectionPolicy(),
 			"aviatrix_site2cloud":                                             resourceAviatrixSite2Cloud(),
+			"aviatrix_site2cloud_bulk":                                        resourceAviatrixSite2CloudBulk(),
 			"aviatrix_site2cloud_ca_cert_tag":                                 resourceAviatrixSite2CloudCaCertTag(),
 			"aviatrix_sla_class":                                              resourceAviatrixSLAClass(),
 			"aviatrix_smart_group":                                            resourceAviatrixSmartGroup(),
@@ -219,6 +351,7 @@ func Provider() *schema.Provider {
 			"aviatrix_account":                              dataSourceAviatrixAccount(),
 			"aviatrix_attachment_vrf_status":                dataSourceAviatrixAttachmentVrfStatus(),
 			"aviatrix_caller_identity":                      dataSourceAviatrixCallerIdentity(),
+			"aviatrix_connection_status":                    dataSourceAviatrixConnectionStatus(),
 			"aviatrix_controller_metadata":                  dataSourceAviatrixControllerMetadata(),
 			"aviatrix_web_group":                            dataSourceAviatrixDcfWebgroups(),
 			"aviatrix_dcf_trustbundle":                      dataSourceAviatrixDcfTrustbundle(),
@@ -245,8 +378,8 @@ func Provider() *schema.Provider {
 			"aviatrix_firewall":                             dataSourceAviatrixFirewall(),
 			"aviatrix_firewall_instance_images":             dataSourceAviatrixFirewallInstanceImages(),
 		},
-		ConfigureFunc: aviatrixConfigure,
-	}
+		ConfigureContextFunc: aviatrixConfigure,
+	})
 }
 
 func envDefaultFunc(k string) schema.SchemaDefaultFunc {
@@ -259,45 +392,98 @@ func envDefaultFunc(k string) schema.SchemaDefaultFunc {
 	}
 }
 
-func aviatrixConfigure(d *schema.ResourceData) (any, error) {
-	config := Config{
-		ControllerIP: getString(d, "controller_ip"),
-		Username:     getString(d, "username"),
-		Password:     getString(d, "password"),
-		VerifyCert:   getBool(d, "verify_ssl_certificate"),
-		PathToCACert: getString(d, "path_to_ca_certificate"),
-		IgnoreTags:   expandProviderIgnoreTags(getList(d, "ignore_tags")),
+func aviatrixConfigure(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
+	config, err := providerConfig(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	client, err := config.Client(ctx)
+	if err != nil {
+		return nil, diag.FromErr(err)
 	}
 
 	skipVersionValidation := getBool(d, "skip_version_validation")
 	if skipVersionValidation {
-		return config.Client()
+		return client, nil
 	}
 
-	client, err := config.Client()
+	err = client.ControllerVersionValidation(supportedVersions)
 	if err != nil {
-		return nil, err
+		return nil, diag.Errorf("controller version validation failed: %s", err)
 	}
 
-	err = client.ControllerVersionValidation(supportedVersions)
+	return client, nil
+}
+
+func aviatrixConfigureWithoutVersionValidation(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
+	config, err := providerConfig(d)
 	if err != nil {
-		return nil, errors.New("controller version validation failed: " + err.Error())
+		return nil, diag.FromErr(err)
 	}
 
-	return client, nil
+	client, err := config.Client(ctx)
+	return client, diag.FromErr(err)
 }
 
-func aviatrixConfigureWithoutVersionValidation(d *schema.ResourceData) (any, error) {
+// providerConfig builds the client Config from the provider block.
+func providerConfig(d *schema.ResourceData) (Config, error) {
+	retry, err := expandProviderRetry(getList(d, "retry"))
+	if err != nil {
+		return Config{}, err
+	}
+
 	config := Config{
-		ControllerIP: getString(d, "controller_ip"),
-		Username:     getString(d, "username"),
-		Password:     getString(d, "password"),
-		VerifyCert:   getBool(d, "verify_ssl_certificate"),
-		PathToCACert: getString(d, "path_to_ca_certificate"),
-		IgnoreTags:   expandProviderIgnoreTags(getList(d, "ignore_tags")),
+		ControllerIP:  getString(d, "controller_ip"),
+		Username:      getString(d, "username"),
+		Password:      getString(d, "password"),
+		APIToken:      getString(d, "api_token"),
+		APITokenFile:  getString(d, "api_token_file"),
+		VerifyCert:    getBool(d, "verify_ssl_certificate"),
+		PathToCACert:  getString(d, "path_to_ca_certificate"),
+		ClientCert:    getString(d, "client_certificate"),
+		ClientKey:     getString(d, "client_key"),
+		TLSServerName: getString(d, "tls_server_name"),
+		DefaultTags:   expandProviderDefaultTags(getList(d, "default_tags")),
+		IgnoreTags:    expandProviderIgnoreTags(getList(d, "ignore_tags")),
+		Retry:         retry,
+		RateLimit:     expandProviderRateLimit(getList(d, "rate_limit")),
+	}
+	if config.Cassette, err = cassetteFromEnv(); err != nil {
+		return Config{}, err
 	}
+	if config.APIToken == "" && config.APITokenFile == "" && (config.Username == "" || config.Password == "") {
+		return Config{}, errors.New("username and password are required unless api_token or api_token_file is set")
+	}
+	return config, nil
+}
+
+// cassetteFromEnv returns the cassette set with AVIATRIX_CASSETTE_RECORD or
+// AVIATRIX_CASSETTE_REPLAY, if any.
+func cassetteFromEnv() (*goaviatrix.CassetteOptions, error) {
+	record, replay := os.Getenv("AVIATRIX_CASSETTE_RECORD"), os.Getenv("AVIATRIX_CASSETTE_REPLAY")
+	switch {
+	case record != "" && replay != "":
+		return nil, errors.New("AVIATRIX_CASSETTE_RECORD and AVIATRIX_CASSETTE_REPLAY cannot both be set")
+	case record != "":
+		return &goaviatrix.CassetteOptions{Mode: goaviatrix.CassetteRecord, Path: record}, nil
+	case replay != "":
+		return &goaviatrix.CassetteOptions{Mode: goaviatrix.CassetteReplay, Path: replay}, nil
+	}
+	return nil, nil
+}
 
-	return config.Client()
+func expandProviderDefaultTags(l []any) *goaviatrix.DefaultTagsConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := mustMap(l[0])
+	tags := goaviatrix.KeyValueTags(convertTagsMapToStringMap(mustMap(m["tags"])))
+	if len(tags) == 0 {
+		return nil
+	}
+	return &goaviatrix.DefaultTagsConfig{Tags: tags}
 }
 
 func expandProviderIgnoreTags(l []any) *goaviatrix.IgnoreTagsConfig {
@@ -318,3 +504,53 @@ func expandProviderIgnoreTags(l []any) *goaviatrix.IgnoreTagsConfig {
 
 	return ignoreConfig
 }
+
+func expandProviderRateLimit(l []any) *goaviatrix.RateLimit {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := mustMap(l[0])
+	return &goaviatrix.RateLimit{
+		RequestsPerSecond: mustFloat64(m["requests_per_second"]),
+		Burst:             mustInt(m["burst"]),
+		MaxInFlight:       mustInt(m["max_in_flight"]),
+	}
+}
+
+func expandProviderRetry(l []any) (*goaviatrix.RetryPolicy, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+
+	m := mustMap(l[0])
+	policy := &goaviatrix.RetryPolicy{
+		MaxAttempts: mustInt(m["max_attempts"]),
+		Jitter:      mustBool(m["jitter"]),
+	}
+
+	var err error
+	if policy.Backoff, err = time.ParseDuration(mustString(m["base_backoff"])); err != nil {
+		return nil, fmt.Errorf("invalid retry base_backoff: %w", err)
+	}
+	if policy.MaxBackoff, err = time.ParseDuration(mustString(m["max_backoff"])); err != nil {
+		return nil, fmt.Errorf("invalid retry max_backoff: %w", err)
+	}
+
+	if v, ok := m["retryable_http_codes"].(*schema.Set); ok {
+		for _, code := range v.List() {
+			policy.RetryableStatusCodes = append(policy.RetryableStatusCodes, mustInt(code))
+		}
+		sort.Ints(policy.RetryableStatusCodes)
+	}
+
+	for _, pattern := range mustSlice(m["retryable_reason_patterns"]) {
+		re, err := regexp.Compile(mustString(pattern))
+		if err != nil {
+			return nil, fmt.Errorf("invalid retry reason pattern %q: %w", pattern, err)
+		}
+		policy.RetryableReasons = append(policy.RetryableReasons, re)
+	}
+
+	return policy, nil
+}
@@ -1,9 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix/controllertest"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -32,7 +36,7 @@ func init() {
 	}
 
 	testAccProviderVersionValidation = Provider()
-	testAccProviderVersionValidation.ConfigureFunc = aviatrixConfigureWithoutVersionValidation //nolint:staticcheck // SA1019: test-only provider setup
+	testAccProviderVersionValidation.ConfigureContextFunc = aviatrixConfigureWithoutVersionValidation
 	testAccProvidersVersionValidation = map[string]*schema.Provider{
 		"aviatrix": testAccProviderVersionValidation,
 	}
@@ -52,6 +56,9 @@ func testAccPreCheck(t *testing.T) {
 	if v := os.Getenv("AVIATRIX_CONTROLLER_IP"); v == "" {
 		t.Fatal("AVIATRIX_CONTROLLER_IP must be set for acceptance tests.")
 	}
+	if os.Getenv("AVIATRIX_API_TOKEN") != "" || os.Getenv("AVIATRIX_API_TOKEN_FILE") != "" {
+		return
+	}
 	if v := os.Getenv("AVIATRIX_USERNAME"); v == "" {
 		t.Fatal("AVIATRIX_USERNAME must be set for acceptance tests.")
 	}
@@ -60,6 +67,127 @@ func testAccPreCheck(t *testing.T) {
 	}
 }
 
+// testAccFakeController points the provider at an in-process fake Controller
+// for the rest of the test, so acceptance tests of the resources it simulates
+// run without a real Controller or cloud accounts.
+func testAccFakeController(t *testing.T) *controllertest.Server {
+	t.Helper()
+	s := controllertest.NewServer()
+	t.Cleanup(s.Close)
+	t.Setenv("AVIATRIX_CONTROLLER_IP", s.Host())
+	t.Setenv("AVIATRIX_USERNAME", s.Username)
+	t.Setenv("AVIATRIX_PASSWORD", s.Password)
+	t.Setenv("AVIATRIX_API_TOKEN", "")
+	t.Setenv("AVIATRIX_API_TOKEN_FILE", "")
+	t.Setenv("AVIATRIX_SKIP_VERSION_VALIDATION", "true")
+	t.Setenv("AVIATRIX_CASSETTE_RECORD", "")
+	t.Setenv("AVIATRIX_CASSETTE_REPLAY", "")
+	return s
+}
+
+// testFakeControllerMeta starts a fake Controller with testAccFakeController
+// and returns it with the meta of a provider configured against it, for
+// tests that call CRUD functions directly.
+func testFakeControllerMeta(t *testing.T) (*controllertest.Server, any) {
+	t.Helper()
+	s := testAccFakeController(t)
+	p := Provider()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]any{}))
+	if diags.HasError() {
+		t.Fatalf("configure failed: %v", diags)
+	}
+	return s, p.Meta()
+}
+
+func TestProviderConfigure_FakeController(t *testing.T) {
+	s, meta := testFakeControllerMeta(t)
+	client := mustClient(meta)
+
+	d := schema.TestResourceDataRaw(t, resourceAviatrixSegmentationNetworkDomain().Schema, map[string]any{
+		"domain_name": "prod",
+	})
+	if err := resourceAviatrixSegmentationNetworkDomainCreate(context.Background(), d, client); err != nil {
+		t.Fatalf("create failed: %s", err)
+	}
+	if d.Id() != "prod" {
+		t.Errorf("unexpected id %q", d.Id())
+	}
+	if err := resourceAviatrixSegmentationNetworkDomainDelete(d, client); err != nil {
+		t.Fatalf("delete failed: %s", err)
+	}
+	if err := resourceAviatrixSegmentationNetworkDomainRead(context.Background(), d, client); err != nil || d.Id() != "" {
+		t.Errorf("expected the deleted domain to be removed from state, got id %q, err %v", d.Id(), err)
+	}
+
+	want := []string{"get_api_token", "login", "add_multi_cloud_security_domain",
+		"list_multi_cloud_security_domain_names", "delete_multi_cloud_security_domain", "list_multi_cloud_security_domain_names"}
+	if got := s.Requests(); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("unexpected requests:\n got %v\nwant %v", got, want)
+	}
+}
+
+func TestProviderConfigure_APIToken(t *testing.T) {
+	s := testAccFakeController(t)
+	t.Setenv("AVIATRIX_USERNAME", "")
+	t.Setenv("AVIATRIX_PASSWORD", "")
+	t.Setenv("AVIATRIX_API_TOKEN", s.NewSession())
+
+	p := Provider()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]any{}))
+	if diags.HasError() {
+		t.Fatalf("configure failed: %v", diags)
+	}
+	if _, err := mustClient(p.Meta()).ListSegmentationSecurityDomains(); err != nil {
+		t.Fatalf("API call with token failed: %s", err)
+	}
+	for _, action := range s.Requests() {
+		if action == "login" {
+			t.Errorf("expected no password login, got requests %v", s.Requests())
+		}
+	}
+}
+
+func TestProviderConfigure_Cassette(t *testing.T) {
+	s := testAccFakeController(t)
+	path := filepath.Join(t.TempDir(), "cassette.jsonl")
+	run := func() *schema.ResourceData {
+		p := Provider()
+		diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]any{}))
+		if diags.HasError() {
+			t.Fatalf("configure failed: %v", diags)
+		}
+		client := mustClient(p.Meta())
+		d := schema.TestResourceDataRaw(t, resourceAviatrixSegmentationNetworkDomain().Schema, map[string]any{
+			"domain_name": "prod",
+		})
+		if err := resourceAviatrixSegmentationNetworkDomainCreate(context.Background(), d, client); err != nil {
+			t.Fatalf("create failed: %s", err)
+		}
+		return d
+	}
+
+	t.Setenv("AVIATRIX_CASSETTE_RECORD", path)
+	recorded := run()
+	s.Close()
+
+	t.Setenv("AVIATRIX_CASSETTE_RECORD", "")
+	t.Setenv("AVIATRIX_CASSETTE_REPLAY", path)
+	replayed := run()
+	if replayed.Id() != recorded.Id() {
+		t.Errorf("replayed id %q, recorded %q", replayed.Id(), recorded.Id())
+	}
+}
+
+func TestProviderConfigure_MissingCredentials(t *testing.T) {
+	testAccFakeController(t)
+	t.Setenv("AVIATRIX_PASSWORD", "")
+
+	diags := Provider().Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]any{}))
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "username and password are required") {
+		t.Errorf("expected a missing credentials error, got %v", diags)
+	}
+}
+
 func TestCIDTimeout(t *testing.T) {
 	if os.Getenv("SKIP_CID_EXPIRY") == "yes" {
 		t.Skip("Skipping CID expiry retry test as SKIP_CID_EXPIRY is set")
@@ -97,3 +225,75 @@ func testCID(s *terraform.State) error {
 	fmt.Printf("Found user: %v", rGroup.GroupName)
 	return nil
 }
+
+func TestExpandProviderRetry(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]any{
+		"retry": []any{
+			map[string]any{
+				"max_attempts":              3,
+				"base_backoff":              "1s",
+				"jitter":                    true,
+				"retryable_http_codes":      []any{503, 502},
+				"retryable_reason_patterns": []any{"(?i)busy"},
+			},
+		},
+	})
+
+	policy, err := expandProviderRetry(getList(d, "retry"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if policy.MaxAttempts != 3 || policy.Backoff != time.Second || policy.MaxBackoff != 30*time.Second || !policy.Jitter {
+		t.Errorf("unexpected retry policy: %+v", policy)
+	}
+	if len(policy.RetryableStatusCodes) != 2 || policy.RetryableStatusCodes[0] != 502 {
+		t.Errorf("unexpected retryable codes: %v", policy.RetryableStatusCodes)
+	}
+	if len(policy.RetryableReasons) != 1 || !policy.RetryableReasons[0].MatchString("Controller is BUSY") {
+		t.Errorf("unexpected retryable reasons: %v", policy.RetryableReasons)
+	}
+
+	policy, err = expandProviderRetry(nil)
+	if err != nil || policy != nil {
+		t.Errorf("expected no policy without a retry block, got %+v, %v", policy, err)
+	}
+}
+
+func TestExpandProviderRateLimit(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]any{
+		"rate_limit": []any{
+			map[string]any{
+				"requests_per_second": 2.5,
+				"max_in_flight":       4,
+			},
+		},
+	})
+
+	limit := expandProviderRateLimit(getList(d, "rate_limit"))
+	if limit == nil || limit.RequestsPerSecond != 2.5 || limit.Burst != 0 || limit.MaxInFlight != 4 {
+		t.Errorf("unexpected rate limit: %+v", limit)
+	}
+
+	if limit := expandProviderRateLimit(nil); limit != nil {
+		t.Errorf("expected no rate limit without a rate_limit block, got %+v", limit)
+	}
+}
+
+func TestExpandProviderDefaultTags(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]any{
+		"default_tags": []any{
+			map[string]any{
+				"tags": map[string]any{"owner": "platform", "cost-center": "1234"},
+			},
+		},
+	})
+
+	config := expandProviderDefaultTags(getList(d, "default_tags"))
+	if config == nil || len(config.Tags) != 2 || config.Tags["cost-center"] != "1234" {
+		t.Errorf("unexpected default tags: %+v", config)
+	}
+
+	if config := expandProviderDefaultTags(nil); config != nil {
+		t.Errorf("expected no default tags without a default_tags block, got %+v", config)
+	}
+}
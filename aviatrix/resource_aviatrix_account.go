@@ -4,10 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -15,7 +15,7 @@ import (
 )
 
 func resourceAviatrixAccount() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		CreateWithoutTimeout: resourceAviatrixAccountCreate,
 		ReadWithoutTimeout:   resourceAviatrixAccountRead,
 		UpdateWithoutTimeout: resourceAviatrixAccountUpdate,
@@ -439,10 +439,21 @@ func resourceAviatrixAccount() *schema.Resource {
 			},
 		},
 	}
+	addWriteOnly(r.Schema, "aws_secret_key", "AWS Secret Key.")
+	addWriteOnly(r.Schema, "awsgov_secret_key", "AWS Gov Secret Key.")
+	addWriteOnly(r.Schema, "awschina_secret_key", "AWS China Secret Key.", "awschina_role_app", "awschina_role_ec2")
+	addWriteOnly(r.Schema, "arm_application_key", "Azure Application Key.")
+	addWriteOnly(r.Schema, "azuregov_application_key", "Azure Gov Application Key.")
+	addWriteOnly(r.Schema, "azurechina_application_key", "Azure China Application Key.")
+	addWriteOnly(r.Schema, "alicloud_secret_key", "Alibaba Cloud Secret Key.")
+	addWriteOnly(r.Schema, "edge_zededa_password", "Edge Zededa password.", "edge_csp_password")
+	addWriteOnly(r.Schema, "gcloud_project_credentials", "GCloud Project credentials JSON, uploaded instead of the file at 'gcloud_project_credentials_filepath'.", "gcloud_project_credentials_filepath")
+	addWriteOnly(r.Schema, "oci_api_private_key", "OCI API Private Key in PEM format, uploaded instead of the file at 'oci_api_private_key_filepath'.", "oci_api_private_key_filepath")
+	return r
 }
 
 func resourceAviatrixAccountCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustAccountClient(meta)
 	account := &goaviatrix.Account{
 		AccountName:                           getString(d, "account_name"),
 		CloudType:                             getInt(d, "cloud_type"),
@@ -452,38 +463,40 @@ func resourceAviatrixAccountCreate(ctx context.Context, d *schema.ResourceData,
 		AwsGatewayRoleApp:                     getString(d, "aws_gateway_role_app"),
 		AwsGatewayRoleEc2:                     getString(d, "aws_gateway_role_ec2"),
 		AwsAccessKey:                          getString(d, "aws_access_key"),
-		AwsSecretKey:                          getString(d, "aws_secret_key"),
+		AwsSecretKey:                          getSecret(d, "aws_secret_key"),
 		AwsgovAccountNumber:                   getString(d, "awsgov_account_number"),
 		AwsgovRoleApp:                         getString(d, "awsgov_role_app"),
 		AwsgovRoleEc2:                         getString(d, "awsgov_role_ec2"),
 		AwsgovAccessKey:                       getString(d, "awsgov_access_key"),
-		AwsgovSecretKey:                       getString(d, "awsgov_secret_key"),
+		AwsgovSecretKey:                       getSecret(d, "awsgov_secret_key"),
 		GcloudProjectName:                     getString(d, "gcloud_project_id"),
 		GcloudProjectCredentialsFilepathLocal: getString(d, "gcloud_project_credentials_filepath"),
+		GcloudProjectCredentials:              getWriteOnly(d, "gcloud_project_credentials_wo"),
 		ArmSubscriptionId:                     getString(d, "arm_subscription_id"),
 		ArmApplicationEndpoint:                getString(d, "arm_directory_id"),
 		ArmApplicationClientId:                getString(d, "arm_application_id"),
-		ArmApplicationClientSecret:            getString(d, "arm_application_key"),
+		ArmApplicationClientSecret:            getSecret(d, "arm_application_key"),
 		AzuregovSubscriptionId:                getString(d, "azuregov_subscription_id"),
 		AzuregovApplicationEndpoint:           getString(d, "azuregov_directory_id"),
 		AzuregovApplicationClientId:           getString(d, "azuregov_application_id"),
-		AzuregovApplicationClientSecret:       getString(d, "azuregov_application_key"),
+		AzuregovApplicationClientSecret:       getSecret(d, "azuregov_application_key"),
 		OciTenancyID:                          getString(d, "oci_tenancy_id"),
 		OciUserID:                             getString(d, "oci_user_id"),
 		OciCompartmentID:                      getString(d, "oci_compartment_id"),
 		OciApiPrivateKeyFilePath:              getString(d, "oci_api_private_key_filepath"),
+		OciApiPrivateKey:                      getWriteOnly(d, "oci_api_private_key_wo"),
 		AlicloudAccountId:                     getString(d, "alicloud_account_id"),
 		AlicloudAccessKey:                     getString(d, "alicloud_access_key"),
-		AlicloudSecretKey:                     getString(d, "alicloud_secret_key"),
+		AlicloudSecretKey:                     getSecret(d, "alicloud_secret_key"),
 		AwsChinaAccountNumber:                 getString(d, "awschina_account_number"),
 		AwsChinaRoleApp:                       getString(d, "awschina_role_app"),
 		AwsChinaRoleEc2:                       getString(d, "awschina_role_ec2"),
 		AwsChinaAccessKey:                     getString(d, "awschina_access_key"),
-		AwsChinaSecretKey:                     getString(d, "awschina_secret_key"),
+		AwsChinaSecretKey:                     getSecret(d, "awschina_secret_key"),
 		AzureChinaSubscriptionId:              getString(d, "azurechina_subscription_id"),
 		AzureChinaApplicationEndpoint:         getString(d, "azurechina_directory_id"),
 		AzureChinaApplicationClientId:         getString(d, "azurechina_application_id"),
-		AzureChinaApplicationClientSecret:     getString(d, "azurechina_application_key"),
+		AzureChinaApplicationClientSecret:     getSecret(d, "azurechina_application_key"),
 	}
 
 	edgeAccount := &goaviatrix.EdgeAccount{
@@ -493,9 +506,9 @@ func resourceAviatrixAccountCreate(ctx context.Context, d *schema.ResourceData,
 		EdgeCSPPassword:    getString(d, "edge_csp_password"),
 		EdgeCSPApiEndpoint: getString(d, "edge_csp_api_endpoint"),
 	}
-	if getString(d, "edge_zededa_username") != "" && getString(d, "edge_zededa_password") != "" {
+	if getString(d, "edge_zededa_username") != "" && getSecret(d, "edge_zededa_password") != "" {
 		edgeAccount.EdgeCSPUsername = getString(d, "edge_zededa_username")
-		edgeAccount.EdgeCSPPassword = getString(d, "edge_zededa_password")
+		edgeAccount.EdgeCSPPassword = getSecret(d, "edge_zededa_password")
 	}
 
 	if _, ok := d.GetOk("rbac_groups"); ok {
@@ -562,7 +575,7 @@ func resourceAviatrixAccountCreate(ctx context.Context, d *schema.ResourceData,
 	if !goaviatrix.IsCloudType(account.CloudType, goaviatrix.EDGECSP) && (getString(d, "edge_csp_username") != "" || getString(d, "edge_csp_password") != "") {
 		return diag.Errorf("could not create Aviatrix Account: 'edge_csp_username' and 'edge_csp_password' can only be set when 'cloud_type' is Edge CSP (65536)")
 	}
-	if !goaviatrix.IsCloudType(account.CloudType, goaviatrix.EDGECSP) && (getString(d, "edge_zededa_username") != "" || getString(d, "edge_zededa_password") != "") {
+	if !goaviatrix.IsCloudType(account.CloudType, goaviatrix.EDGECSP) && (getString(d, "edge_zededa_username") != "" || getSecret(d, "edge_zededa_password") != "") {
 		return diag.Errorf("could not create Aviatrix Account: 'edge_zededa_username' and 'edge_zededa_password' can only be set when 'cloud_type' is Edge Zededa (65536)")
 	}
 
@@ -581,7 +594,7 @@ func resourceAviatrixAccountCreate(ctx context.Context, d *schema.ResourceData,
 			return diag.Errorf("aws iam can only be 'true' or 'false'")
 		}
 
-		log.Printf("[INFO] Creating Aviatrix account: %#v", account)
+		tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix account: %#v", goaviatrix.Redact(account)))
 		if awsIam {
 			if account.AwsAccessKey != "" || account.AwsSecretKey != "" {
 				return diag.Errorf("could not create Aviatrix Account: 'aws_access_key' and 'aws_secret_key' can only be set when 'aws_iam' is false and 'cloud_type' is AWS (1)")
@@ -593,8 +606,8 @@ func resourceAviatrixAccountCreate(ctx context.Context, d *schema.ResourceData,
 			if _, ok := d.GetOk("aws_role_ec2"); !ok {
 				account.AwsRoleEc2 = fmt.Sprintf("arn:aws:iam::%s:role/aviatrix-role-ec2", account.AwsAccountNumber)
 			}
-			log.Printf("[TRACE] Reading Aviatrix account aws_role_app: [%s]", getString(d, "aws_role_app"))
-			log.Printf("[TRACE] Reading Aviatrix account aws_role_ec2: [%s]", getString(d, "aws_role_ec2"))
+			tflog.Trace(ctx, fmt.Sprintf("Reading Aviatrix account aws_role_app: [%s]", getString(d, "aws_role_app")))
+			tflog.Trace(ctx, fmt.Sprintf("Reading Aviatrix account aws_role_ec2: [%s]", getString(d, "aws_role_ec2")))
 		} else {
 			if account.AwsRoleApp != "" || account.AwsRoleEc2 != "" {
 				return diag.Errorf("could not create Aviatrix Account: 'aws_role_app' and 'aws_role_ec2' can only be set when 'aws_iam' is true and 'cloud_type' is AWS (1)")
@@ -604,10 +617,10 @@ func resourceAviatrixAccountCreate(ctx context.Context, d *schema.ResourceData,
 			}
 		}
 	} else if account.CloudType == goaviatrix.GCP {
-		if account.GcloudProjectCredentialsFilepathLocal == "" {
-			return diag.Errorf("gcloud project credentials local filepath needed to upload file to controller")
+		if account.GcloudProjectCredentialsFilepathLocal == "" && account.GcloudProjectCredentials == "" {
+			return diag.Errorf("gcloud project credentials local filepath or gcloud_project_credentials_wo needed to upload file to controller")
 		}
-		log.Printf("[INFO] Creating Aviatrix account: %#v", account)
+		tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix account: %#v", goaviatrix.Redact(account)))
 	} else if account.CloudType == goaviatrix.Azure {
 		if account.ArmSubscriptionId == "" {
 			return diag.Errorf("arm subscription id needed for azure cloud")
@@ -631,8 +644,8 @@ func resourceAviatrixAccountCreate(ctx context.Context, d *schema.ResourceData,
 		if account.OciCompartmentID == "" {
 			return diag.Errorf("oci compartment ocid needed for oracle cloud")
 		}
-		if account.OciApiPrivateKeyFilePath == "" {
-			return diag.Errorf("oci api private key filepath needed to upload file to controller")
+		if account.OciApiPrivateKeyFilePath == "" && account.OciApiPrivateKey == "" {
+			return diag.Errorf("oci api private key filepath or oci_api_private_key_wo needed to upload file to controller")
 		}
 	} else if account.CloudType == goaviatrix.AWSGov {
 		if account.AwsgovAccountNumber == "" {
@@ -723,12 +736,12 @@ func resourceAviatrixAccountCreate(ctx context.Context, d *schema.ResourceData,
 		//	return diag.Errorf("edge_csp_password is required to create an Aviatrix account for Edge CSP")
 		//}
 		if !((getString(d, "edge_csp_username") != "" && getString(d, "edge_csp_password") != "") ||
-			(getString(d, "edge_zededa_username") != "" && getString(d, "edge_zededa_password") != "")) {
+			(getString(d, "edge_zededa_username") != "" && getSecret(d, "edge_zededa_password") != "")) {
 			return diag.Errorf("edge_csp_username and edge_csp_password are required to create an Aviatrix account for Edge CSP, " +
 				"edge_zededa_username and edge_zededa_password are required to create an Aviatrix account for Edge Zededa")
 		}
 	} else if goaviatrix.IsCloudType(account.CloudType, goaviatrix.EdgeRelatedCloudTypes) {
-		log.Print("no check is needed to create an Aviatrix account for Edge Equinix, Edge NEO and Edge Megaport")
+		tflog.Info(ctx, "no check is needed to create an Aviatrix account for Edge Equinix, Edge NEO and Edge Megaport")
 	} else {
 		return diag.Errorf("cloud type can only be either AWS (1), GCP (4), Azure (8), OCI (16), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), Alibaba Cloud (8192), AWS Top Secret (16384), AWS Secret (32768), Edge CSP/Zededa (65536), Edge Equinix (524288), Edge Megaport(1048576) or Edge NEO/Platform (262144)")
 	}
@@ -754,9 +767,9 @@ func resourceAviatrixAccountCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAviatrixAccountRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, ok := meta.(goaviatrix.ClientInterface)
+	client, ok := meta.(goaviatrix.AccountClient)
 	if !ok {
-		return diag.Errorf("internal error: meta is not a valid goaviatrix.ClientInterface")
+		return diag.Errorf("internal error: meta is not a valid goaviatrix.AccountClient")
 	}
 	var diags diag.Diagnostics
 
@@ -764,7 +777,7 @@ func resourceAviatrixAccountRead(ctx context.Context, d *schema.ResourceData, me
 	isImport := accountName == ""
 	if isImport {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no account name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no account name received. Import Id is %s", id))
 		mustSet(d, "account_name", id)
 		d.SetId(id)
 	}
@@ -773,7 +786,7 @@ func resourceAviatrixAccountRead(ctx context.Context, d *schema.ResourceData, me
 		AccountName: getString(d, "account_name"),
 	}
 
-	log.Printf("[INFO] Looking for Aviatrix account: %#v", account)
+	tflog.Info(ctx, fmt.Sprintf("Looking for Aviatrix account: %#v", goaviatrix.Redact(account)))
 
 	acc, err := client.GetAccount(account)
 	if err != nil {
@@ -841,7 +854,7 @@ func resourceAviatrixAccountRead(ctx context.Context, d *schema.ResourceData, me
 	} else if acc.CloudType == goaviatrix.EDGECSP {
 		if getString(d, "edge_csp_password") != "" {
 			mustSet(d, "edge_csp_username", acc.EdgeCSPUsername)
-		} else if getString(d, "edge_zededa_password") != "" {
+		} else if getString(d, "edge_zededa_password") != "" || getString(d, "edge_zededa_username") != "" {
 			mustSet(d, "edge_zededa_username", acc.EdgeCSPUsername)
 		} else {
 			mustSet(
@@ -874,7 +887,7 @@ func resourceAviatrixAccountRead(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustAccountClient(meta)
 	defer client.InvalidateCache()
 	account := &goaviatrix.Account{
 		AccountName:                           getString(d, "account_name"),
@@ -885,38 +898,40 @@ func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData,
 		AwsGatewayRoleApp:                     getString(d, "aws_gateway_role_app"),
 		AwsGatewayRoleEc2:                     getString(d, "aws_gateway_role_ec2"),
 		AwsAccessKey:                          getString(d, "aws_access_key"),
-		AwsSecretKey:                          getString(d, "aws_secret_key"),
+		AwsSecretKey:                          getSecret(d, "aws_secret_key"),
 		AwsgovAccountNumber:                   getString(d, "awsgov_account_number"),
 		AwsgovRoleApp:                         getString(d, "awsgov_role_app"),
 		AwsgovRoleEc2:                         getString(d, "awsgov_role_ec2"),
 		AwsgovAccessKey:                       getString(d, "awsgov_access_key"),
-		AwsgovSecretKey:                       getString(d, "awsgov_secret_key"),
+		AwsgovSecretKey:                       getSecret(d, "awsgov_secret_key"),
 		GcloudProjectName:                     getString(d, "gcloud_project_id"),
 		GcloudProjectCredentialsFilepathLocal: getString(d, "gcloud_project_credentials_filepath"),
+		GcloudProjectCredentials:              getWriteOnly(d, "gcloud_project_credentials_wo"),
 		ArmSubscriptionId:                     getString(d, "arm_subscription_id"),
 		ArmApplicationEndpoint:                getString(d, "arm_directory_id"),
 		ArmApplicationClientId:                getString(d, "arm_application_id"),
-		ArmApplicationClientSecret:            getString(d, "arm_application_key"),
+		ArmApplicationClientSecret:            getSecret(d, "arm_application_key"),
 		AzuregovSubscriptionId:                getString(d, "azuregov_subscription_id"),
 		AzuregovApplicationEndpoint:           getString(d, "azuregov_directory_id"),
 		AzuregovApplicationClientId:           getString(d, "azuregov_application_id"),
-		AzuregovApplicationClientSecret:       getString(d, "azuregov_application_key"),
+		AzuregovApplicationClientSecret:       getSecret(d, "azuregov_application_key"),
 		OciTenancyID:                          getString(d, "oci_tenancy_id"),
 		OciUserID:                             getString(d, "oci_user_id"),
 		OciCompartmentID:                      getString(d, "oci_compartment_id"),
 		OciApiPrivateKeyFilePath:              getString(d, "oci_api_private_key_filepath"),
+		OciApiPrivateKey:                      getWriteOnly(d, "oci_api_private_key_wo"),
 		AlicloudAccountId:                     getString(d, "alicloud_account_id"),
 		AlicloudAccessKey:                     getString(d, "alicloud_access_key"),
-		AlicloudSecretKey:                     getString(d, "alicloud_secret_key"),
+		AlicloudSecretKey:                     getSecret(d, "alicloud_secret_key"),
 		AwsChinaAccountNumber:                 getString(d, "awschina_account_number"),
 		AwsChinaRoleApp:                       getString(d, "awschina_role_app"),
 		AwsChinaRoleEc2:                       getString(d, "awschina_role_ec2"),
 		AwsChinaAccessKey:                     getString(d, "awschina_access_key"),
-		AwsChinaSecretKey:                     getString(d, "awschina_secret_key"),
+		AwsChinaSecretKey:                     getSecret(d, "awschina_secret_key"),
 		AzureChinaSubscriptionId:              getString(d, "azurechina_subscription_id"),
 		AzureChinaApplicationEndpoint:         getString(d, "azurechina_directory_id"),
 		AzureChinaApplicationClientId:         getString(d, "azurechina_application_id"),
-		AzureChinaApplicationClientSecret:     getString(d, "azurechina_application_key"),
+		AzureChinaApplicationClientSecret:     getSecret(d, "azurechina_application_key"),
 	}
 
 	edgeAccount := &goaviatrix.EdgeAccount{
@@ -926,9 +941,9 @@ func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData,
 		EdgeCSPPassword:    getString(d, "edge_csp_password"),
 		EdgeCSPApiEndpoint: getString(d, "edge_csp_api_endpoint"),
 	}
-	if getString(d, "edge_zededa_username") != "" && getString(d, "edge_zededa_password") != "" {
+	if getString(d, "edge_zededa_username") != "" && getSecret(d, "edge_zededa_password") != "" {
 		edgeAccount.EdgeCSPUsername = getString(d, "edge_zededa_username")
-		edgeAccount.EdgeCSPPassword = getString(d, "edge_zededa_password")
+		edgeAccount.EdgeCSPPassword = getSecret(d, "edge_zededa_password")
 	}
 
 	awsIam := getBool(d, "aws_iam")
@@ -940,7 +955,7 @@ func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData,
 	awsChinaIam := getBool(d, "awschina_iam")
 	account.AwsChinaIam = strconv.FormatBool(awsChinaIam)
 
-	log.Printf("[INFO] Updating Aviatrix account: %#v", account)
+	tflog.Info(ctx, fmt.Sprintf("Updating Aviatrix account: %#v", goaviatrix.Redact(account)))
 
 	d.Partial(true)
 
@@ -999,7 +1014,7 @@ func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData,
 	if !goaviatrix.IsCloudType(account.CloudType, goaviatrix.EDGECSP) && (getString(d, "edge_csp_username") != "" || getString(d, "edge_csp_password") != "") {
 		return diag.Errorf("could not update Aviatrix Account: 'edge_csp_username' and 'edge_csp_password' can only be set when 'cloud_type' is Edge CSP (65536)")
 	}
-	if !goaviatrix.IsCloudType(account.CloudType, goaviatrix.EDGECSP) && (getString(d, "edge_zededa_username") != "" || getString(d, "edge_zededa_password") != "") {
+	if !goaviatrix.IsCloudType(account.CloudType, goaviatrix.EDGECSP) && (getString(d, "edge_zededa_username") != "" || getSecret(d, "edge_zededa_password") != "") {
 		return diag.Errorf("could not create Aviatrix Account: 'edge_zededa_username' and 'edge_zededa_password' can only be set when 'cloud_type' is Edge Zededa (65536)")
 	}
 
@@ -1010,28 +1025,28 @@ func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData,
 			return diag.Errorf("could not update Aviatrix Account: 'aws_role_app' and 'aws_role_ec2' can only be set when 'aws_iam' is true and 'cloud_type' is AWS (1)")
 		}
 
-		if d.HasChanges("aws_account_number", "aws_access_key", "aws_secret_key", "aws_iam", "aws_role_app", "aws_role_ec2", "aws_gateway_role_app", "aws_gateway_role_ec2") {
+		if d.HasChanges("aws_account_number", "aws_access_key", "aws_secret_key", "aws_secret_key_wo_version", "aws_iam", "aws_role_app", "aws_role_ec2", "aws_gateway_role_app", "aws_gateway_role_ec2") {
 			err := client.UpdateAccount(account)
 			if err != nil {
 				return diag.Errorf("failed to update Aviatrix Account: %s", err)
 			}
 		}
 	} else if account.CloudType == goaviatrix.GCP {
-		if d.HasChange("gcloud_project_id") || d.HasChange("gcloud_project_credentials_filepath") {
+		if d.HasChange("gcloud_project_id") || d.HasChanges("gcloud_project_credentials_filepath", "gcloud_project_credentials_wo_version") {
 			err := client.UpdateGCPAccount(account)
 			if err != nil {
 				return diag.Errorf("failed to update Aviatrix Account: %s", err)
 			}
 		}
 	} else if account.CloudType == goaviatrix.Azure {
-		if d.HasChange("arm_subscription_id") || d.HasChange("arm_directory_id") || d.HasChange("arm_application_id") || d.HasChange("arm_application_key") {
+		if d.HasChange("arm_subscription_id") || d.HasChange("arm_directory_id") || d.HasChange("arm_application_id") || hasSecretChange(d, "arm_application_key") {
 			err := client.UpdateAccount(account)
 			if err != nil {
 				return diag.Errorf("failed to update Aviatrix Account: %s", err)
 			}
 		}
 	} else if account.CloudType == goaviatrix.OCI {
-		if d.HasChange("oci_tenancy_id") || d.HasChange("oci_user_id") || d.HasChange("oci_compartment_id") || d.HasChange("oci_api_private_key_filepath") {
+		if d.HasChange("oci_tenancy_id") || d.HasChange("oci_user_id") || d.HasChange("oci_compartment_id") || d.HasChanges("oci_api_private_key_filepath", "oci_api_private_key_wo_version") {
 			return diag.Errorf("updating OCI account is not supported")
 		}
 	} else if account.CloudType == goaviatrix.AWSGov {
@@ -1041,21 +1056,21 @@ func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData,
 			return diag.Errorf("could not update Aviatrix Account: 'awsgov_role_app' and 'awsgov_role_ec2' can only be set when 'awsgov_iam' is true and 'cloud_type' is AWSGov (256)")
 		}
 
-		if d.HasChanges("awsgov_account_number", "awsgov_access_key", "awsgov_secret_key", "awsgov_iam", "awsgov_role_app", "awsgov_role_ec2", "aws_gateway_role_app", "aws_gateway_role_ec2") {
+		if d.HasChanges("awsgov_account_number", "awsgov_access_key", "awsgov_secret_key", "awsgov_secret_key_wo_version", "awsgov_iam", "awsgov_role_app", "awsgov_role_ec2", "aws_gateway_role_app", "aws_gateway_role_ec2") {
 			err := client.UpdateAccount(account)
 			if err != nil {
 				return diag.Errorf("failed to update Aviatrix Account: %s", err)
 			}
 		}
 	} else if account.CloudType == goaviatrix.AzureGov {
-		if d.HasChanges("azuregov_subscription_id", "azuregov_directory_id", "azuregov_application_id", "azuregov_application_key") {
+		if d.HasChanges("azuregov_subscription_id", "azuregov_directory_id", "azuregov_application_id", "azuregov_application_key", "azuregov_application_key_wo_version") {
 			err := client.UpdateAccount(account)
 			if err != nil {
 				return diag.Errorf("failed to update Azure GOV Aviatrix Account: %v", err)
 			}
 		}
 	} else if goaviatrix.IsCloudType(account.CloudType, goaviatrix.AWSChina) {
-		if d.HasChanges("awschina_iam", "awschina_role_app", "awschina_role_ec2", "awschina_access_key", "awschina_secret_key", "aws_gateway_role_app", "aws_gateway_role_ec2") {
+		if d.HasChanges("awschina_iam", "awschina_role_app", "awschina_role_ec2", "awschina_access_key", "awschina_secret_key", "awschina_secret_key_wo_version", "aws_gateway_role_app", "aws_gateway_role_ec2") {
 			err := client.UpdateAccount(account)
 			if err != nil {
 				return diag.Errorf("failed to update AWSChina Aviatrix Account: %v", err)
@@ -1068,14 +1083,14 @@ func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData,
 			return diag.Errorf("could not update Aviatrix Account: 'awschina_role_app' and 'awschina_role_ec2' can only be set when 'awschina_iam' is true and 'cloud_type' is AWSChina (1024)")
 		}
 
-		if d.HasChanges("azurechina_subscription_id", "azurechina_directory_id", "azurechina_application_id", "azurechina_application_key") {
+		if d.HasChanges("azurechina_subscription_id", "azurechina_directory_id", "azurechina_application_id", "azurechina_application_key", "azurechina_application_key_wo_version") {
 			err := client.UpdateAccount(account)
 			if err != nil {
 				return diag.Errorf("failed to update AzureChina Aviatrix Account: %v", err)
 			}
 		}
 	} else if account.CloudType == goaviatrix.AliCloud {
-		if d.HasChange("alicloud_account_id") || d.HasChange("alicloud_access_key") || d.HasChange("alicloud_secret_key") {
+		if d.HasChange("alicloud_account_id") || d.HasChange("alicloud_access_key") || hasSecretChange(d, "alicloud_secret_key") {
 			err := client.UpdateAccount(account)
 			if err != nil {
 				return diag.Errorf("failed to update Aviatrix Account: %s", err)
@@ -1088,7 +1103,7 @@ func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData,
 				return diag.Errorf("failed to update Edge CSP Account: %s", err)
 			}
 		}
-		if d.HasChange("edge_zededa_username") || d.HasChange("edge_zededa_password") {
+		if d.HasChange("edge_zededa_username") || hasSecretChange(d, "edge_zededa_password") {
 			err := client.UpdateEdgeAccount(edgeAccount)
 			if err != nil {
 				return diag.Errorf("failed to update Edge Zededa Account: %s", err)
@@ -1101,15 +1116,15 @@ func resourceAviatrixAccountUpdate(ctx context.Context, d *schema.ResourceData,
 
 // for now, deleting gcp account will not delete the credential file
 func resourceAviatrixAccountDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client, ok := meta.(goaviatrix.ClientInterface)
+	client, ok := meta.(goaviatrix.AccountClient)
 	if !ok {
-		return diag.Errorf("internal error: meta is not a valid goaviatrix.ClientInterface")
+		return diag.Errorf("internal error: meta is not a valid goaviatrix.AccountClient")
 	}
 	account := &goaviatrix.Account{
 		AccountName: getString(d, "account_name"),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix account: %#v", account)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix account: %#v", goaviatrix.Redact(account)))
 	defer client.InvalidateCache()
 	err := client.DeleteAccount(account)
 	if err != nil {
@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"unicode"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -13,10 +14,10 @@ import (
 
 func resourceAviatrixAccountUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAccountUserCreate,
-		Read:   resourceAviatrixAccountUserRead,
-		Update: resourceAviatrixAccountUserUpdate,
-		Delete: resourceAviatrixAccountUserDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixAccountUserCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixAccountUserRead),
+		UpdateWithoutTimeout: withContext(resourceAviatrixAccountUserUpdate),
+		DeleteWithoutTimeout: withContext(resourceAviatrixAccountUserDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -53,8 +54,8 @@ func resourceAviatrixAccountUser() *schema.Resource {
 	}
 }
 
-func resourceAviatrixAccountUserCreate(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixAccountUserCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustAccountClient(meta)
 
 	user := &goaviatrix.AccountUser{
 		Password: getString(d, "password"),
@@ -62,37 +63,37 @@ func resourceAviatrixAccountUserCreate(d *schema.ResourceData, meta any) error {
 		UserName: getString(d, "username"),
 	}
 
-	log.Printf("[INFO] Creating Aviatrix account user: %#v", user)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix account user: %#v", goaviatrix.Redact(user)))
 
 	d.SetId(user.UserName)
 	flag := false
-	defer func() { _ = resourceAviatrixAccountUserReadIfRequired(d, meta, &flag) }()
+	defer func() { _ = resourceAviatrixAccountUserReadIfRequired(ctx, d, meta, &flag) }()
 
 	err := client.CreateAccountUser(user)
 	if err != nil {
 		return fmt.Errorf("failed to create Aviatrix Account User: %w", err)
 	}
 
-	log.Printf("[DEBUG] Aviatrix account user %s created", user.UserName)
+	tflog.Debug(ctx, fmt.Sprintf("Aviatrix account user %s created", user.UserName))
 
-	return resourceAviatrixAccountUserReadIfRequired(d, meta, &flag)
+	return resourceAviatrixAccountUserReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixAccountUserReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixAccountUserReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixAccountUserRead(d, meta)
+		return resourceAviatrixAccountUserRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixAccountUserRead(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixAccountUserRead(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustAccountClient(meta)
 
 	userName := getString(d, "username")
 	if userName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no gateway name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no gateway name received. Import Id is %s", id))
 		mustSet(d, "username", id)
 		d.SetId(id)
 	}
@@ -101,7 +102,7 @@ func resourceAviatrixAccountUserRead(d *schema.ResourceData, meta any) error {
 		UserName: getString(d, "username"),
 	}
 
-	log.Printf("[INFO] Looking for Aviatrix account user: %#v", user)
+	tflog.Info(ctx, fmt.Sprintf("Looking for Aviatrix account user: %#v", goaviatrix.Redact(user)))
 
 	acc, err := client.GetAccountUser(user)
 	if err != nil {
@@ -120,8 +121,8 @@ func resourceAviatrixAccountUserRead(d *schema.ResourceData, meta any) error {
 	return nil
 }
 
-func resourceAviatrixAccountUserUpdate(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixAccountUserUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustAccountClient(meta)
 
 	user := &goaviatrix.AccountUserEdit{
 		Email:    getString(d, "email"),
@@ -130,7 +131,7 @@ func resourceAviatrixAccountUserUpdate(d *schema.ResourceData, meta any) error {
 
 	d.Partial(true)
 
-	log.Printf("[INFO] Updating Aviatrix account user: %#v", user)
+	tflog.Info(ctx, fmt.Sprintf("Updating Aviatrix account user: %#v", goaviatrix.Redact(user)))
 
 	if d.HasChange("username") {
 		return fmt.Errorf("update username is not allowed")
@@ -150,17 +151,17 @@ func resourceAviatrixAccountUserUpdate(d *schema.ResourceData, meta any) error {
 	}
 
 	d.Partial(false)
-	return resourceAviatrixAccountUserRead(d, meta)
+	return resourceAviatrixAccountUserRead(ctx, d, meta)
 }
 
-func resourceAviatrixAccountUserDelete(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixAccountUserDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustAccountClient(meta)
 
 	user := &goaviatrix.AccountUser{
 		UserName: getString(d, "username"),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix account user: %#v", user)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix account user: %#v", goaviatrix.Redact(user)))
 
 	err := client.DeleteAccountUser(user)
 	if err != nil {
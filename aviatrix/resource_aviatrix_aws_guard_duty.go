@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -14,10 +15,10 @@ import (
 
 func resourceAviatrixAwsGuardDuty() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAwsGuardDutyCreate,
-		Read:   resourceAviatrixAwsGuardDutyRead,
-		Update: resourceAviatrixAwsGuardDutyUpdate,
-		Delete: resourceAviatrixAwsGuardDutyDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixAwsGuardDutyCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixAwsGuardDutyRead),
+		Update:               resourceAviatrixAwsGuardDutyUpdate,
+		Delete:               resourceAviatrixAwsGuardDutyDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -47,7 +48,7 @@ func resourceAviatrixAwsGuardDuty() *schema.Resource {
 	}
 }
 
-func resourceAviatrixAwsGuardDutyCreate(d *schema.ResourceData, meta any) (err error) {
+func resourceAviatrixAwsGuardDutyCreate(ctx context.Context, d *schema.ResourceData, meta any) (err error) {
 	client := mustClient(meta)
 	guardDuty := marshalAwsGuardDutyInput(d)
 
@@ -56,7 +57,7 @@ func resourceAviatrixAwsGuardDutyCreate(d *schema.ResourceData, meta any) (err e
 		return fmt.Errorf("could not enable AWS GuardDuty: %w", err)
 	}
 	d.SetId(guardDuty.ID())
-	defer captureErr(resourceAviatrixAwsGuardDutyRead, d, meta, &err)
+	defer captureErr(ctx, resourceAviatrixAwsGuardDutyRead, d, meta, &err)
 	err = client.UpdateAwsGuardDutyExcludedIPs(guardDuty)
 	if err != nil {
 		return fmt.Errorf("could not set excluded IPs: %w", err)
@@ -64,14 +65,14 @@ func resourceAviatrixAwsGuardDutyCreate(d *schema.ResourceData, meta any) (err e
 	return err
 }
 
-func resourceAviatrixAwsGuardDutyRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAwsGuardDutyRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	accName := getString(d, "account_name")
 	region := getString(d, "region")
 	if accName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no account_name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no account_name received. Import Id is %s", id))
 		parts := strings.Split(id, "~~")
 		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
 			return fmt.Errorf("invalid import ID: %q", id)
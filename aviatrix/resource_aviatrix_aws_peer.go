@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -13,9 +14,9 @@ import (
 
 func resourceAviatrixAWSPeer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAWSPeerCreate,
-		Read:   resourceAviatrixAWSPeerRead,
-		Delete: resourceAviatrixAWSPeerDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixAWSPeerCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixAWSPeerRead),
+		DeleteWithoutTimeout: withContext(resourceAviatrixAWSPeerDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -61,31 +62,31 @@ func resourceAviatrixAWSPeer() *schema.Resource {
 				Type: schema.TypeList,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: goaviatrix.ValidateRtbId,
+					ValidateFunc: ValidateRtbId,
 				},
 				Optional:         true,
 				Computed:         true,
 				ForceNew:         true,
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncRtbList1,
+				DiffSuppressFunc: DiffSuppressFuncRtbList1,
 				Description:      "List of Route table IDs of VPC1.",
 			},
 			"rtb_list2": {
 				Type: schema.TypeList,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: goaviatrix.ValidateRtbId,
+					ValidateFunc: ValidateRtbId,
 				},
 				Optional:         true,
 				Computed:         true,
 				ForceNew:         true,
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncRtbList2,
+				DiffSuppressFunc: DiffSuppressFuncRtbList2,
 				Description:      "List of Route table IDs of VPC2.",
 			},
 		},
 	}
 }
 
-func resourceAviatrixAWSPeerCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAWSPeerCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	awsPeer := &goaviatrix.AWSPeer{
@@ -108,36 +109,36 @@ func resourceAviatrixAWSPeerCreate(d *schema.ResourceData, meta any) error {
 		awsPeer.RtbList2 = "all"
 	}
 
-	log.Printf("[INFO] Creating Aviatrix aws_peer: %#v", awsPeer)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix aws_peer: %#v", goaviatrix.Redact(awsPeer)))
 
 	d.SetId(awsPeer.VpcID1 + "~" + awsPeer.VpcID2)
 	flag := false
-	defer func() { _ = resourceAviatrixAWSPeerReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixAWSPeerReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	_, err := client.CreateAWSPeer(awsPeer)
 	if err != nil {
 		return fmt.Errorf("failed to create Aviatrix AWSPeer: %w", err)
 	}
 
-	return resourceAviatrixAWSPeerReadIfRequired(d, meta, &flag)
+	return resourceAviatrixAWSPeerReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixAWSPeerReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixAWSPeerReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixAWSPeerRead(d, meta)
+		return resourceAviatrixAWSPeerRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixAWSPeerRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAWSPeerRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	vpcID1 := getString(d, "vpc_id1")
 	vpcID2 := getString(d, "vpc_id2")
 	if vpcID1 == "" || vpcID2 == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no vpc id received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no vpc id received. Import Id is %s", id))
 		mustSet(d, "vpc_id1", strings.Split(id, "~")[0])
 		mustSet(d, "vpc_id2", strings.Split(id, "~")[1])
 		d.SetId(id)
@@ -157,7 +158,7 @@ func resourceAviatrixAWSPeerRead(d *schema.ResourceData, meta any) error {
 		return fmt.Errorf("couldn't find Aviatrix AWSPeer: %w", err)
 	}
 
-	log.Printf("[TRACE] Reading aws_peer: %#v", ap)
+	tflog.Trace(ctx, fmt.Sprintf("Reading aws_peer: %#v", goaviatrix.Redact(ap)))
 
 	if ap != nil {
 		mustSet(d, "vpc_id1", ap.VpcID1)
@@ -168,24 +169,24 @@ func resourceAviatrixAWSPeerRead(d *schema.ResourceData, meta any) error {
 		mustSet(d, "vpc_reg2", ap.Region2)
 
 		if err := d.Set("rtb_list1", strings.Split(ap.RtbList1, ",")); err != nil {
-			log.Printf("[WARN] Error setting rtb_list1 for (%s): %s", d.Id(), err)
+			tflog.Warn(ctx, fmt.Sprintf("Error setting rtb_list1 for (%s): %s", d.Id(), err))
 		}
 		if err := d.Set("rtb_list2", strings.Split(ap.RtbList2, ",")); err != nil {
-			log.Printf("[WARN] Error setting rtb_list2 for (%s): %s", d.Id(), err)
+			tflog.Warn(ctx, fmt.Sprintf("Error setting rtb_list2 for (%s): %s", d.Id(), err))
 		}
 	}
 
 	return nil
 }
 
-func resourceAviatrixAWSPeerDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAWSPeerDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 	awsPeer := &goaviatrix.AWSPeer{
 		VpcID1: getString(d, "vpc_id1"),
 		VpcID2: getString(d, "vpc_id2"),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix aws_peer: %#v", awsPeer)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix aws_peer: %#v", goaviatrix.Redact(awsPeer)))
 
 	err := client.DeleteAWSPeer(awsPeer)
 	if err != nil {
@@ -1,10 +1,11 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -13,10 +14,10 @@ import (
 
 func resourceAviatrixAWSTgw() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAWSTgwCreate,
-		Read:   resourceAviatrixAWSTgwRead,
-		Update: resourceAviatrixAWSTgwUpdate,
-		Delete: resourceAviatrixAWSTgwDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixAWSTgwCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixAWSTgwRead),
+		UpdateWithoutTimeout: withContext(resourceAviatrixAWSTgwUpdate),
+		DeleteWithoutTimeout: withContext(resourceAviatrixAWSTgwDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -58,7 +59,7 @@ func resourceAviatrixAWSTgw() *schema.Resource {
 				Required:     true,
 				ForceNew:     true,
 				Description:  "BGP Local ASN (Autonomous System Number), Integer between 1-4294967294.",
-				ValidateFunc: goaviatrix.ValidateASN,
+				ValidateFunc: ValidateASN,
 			},
 			"cloud_type": {
 				Type:         schema.TypeInt,
@@ -98,7 +99,7 @@ func resourceAviatrixAWSTgw() *schema.Resource {
 	}
 }
 
-func resourceAviatrixAWSTgwCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAWSTgwCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	awsTgw := &goaviatrix.AWSTgw{
@@ -125,11 +126,11 @@ func resourceAviatrixAWSTgwCreate(d *schema.ResourceData, meta any) error {
 		return fmt.Errorf("aws side number can't be empty string")
 	}
 
-	log.Printf("[INFO] Creating AWS TGW")
+	tflog.Info(ctx, "Creating AWS TGW")
 
 	d.SetId(awsTgw.Name)
 	flag := false
-	defer func() { _ = resourceAviatrixAWSTgwReadIfRequired(d, meta, &flag) }()
+	defer func() { _ = resourceAviatrixAWSTgwReadIfRequired(ctx, d, meta, &flag) }()
 
 	err1 := client.CreateAWSTgw(awsTgw)
 	if err1 != nil {
@@ -150,24 +151,24 @@ func resourceAviatrixAWSTgwCreate(d *schema.ResourceData, meta any) error {
 		}
 	}
 
-	return resourceAviatrixAWSTgwReadIfRequired(d, meta, &flag)
+	return resourceAviatrixAWSTgwReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixAWSTgwReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixAWSTgwReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixAWSTgwRead(d, meta)
+		return resourceAviatrixAWSTgwRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixAWSTgwRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAWSTgwRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	tgwName := getString(d, "tgw_name")
 	if tgwName == "" {
 		tgwName = d.Id()
-		log.Printf("[DEBUG] Looks like an import, no aws tgw name received. Import Id is %s", tgwName)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no aws tgw name received. Import Id is %s", tgwName))
 		mustSet(d, "tgw_name", tgwName)
 		d.SetId(tgwName)
 	}
@@ -200,8 +201,8 @@ func resourceAviatrixAWSTgwRead(d *schema.ResourceData, meta any) error {
 	return nil
 }
 
-func resourceAviatrixAWSTgwUpdate(d *schema.ResourceData, meta any) error {
-	log.Printf("[INFO] Updating AWS TGW")
+func resourceAviatrixAWSTgwUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
+	tflog.Info(ctx, "Updating AWS TGW")
 
 	client := mustClient(meta)
 	awsTgw := &goaviatrix.AWSTgw{
@@ -242,10 +243,10 @@ func resourceAviatrixAWSTgwUpdate(d *schema.ResourceData, meta any) error {
 
 	d.Partial(false)
 	d.SetId(awsTgw.Name)
-	return resourceAviatrixAWSTgwRead(d, meta)
+	return resourceAviatrixAWSTgwRead(ctx, d, meta)
 }
 
-func resourceAviatrixAWSTgwDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAWSTgwDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 	awsTgw := &goaviatrix.AWSTgw{
 		Name:                      getString(d, "tgw_name"),
@@ -256,7 +257,7 @@ func resourceAviatrixAWSTgwDelete(d *schema.ResourceData, meta any) error {
 		SecurityDomains:           make([]goaviatrix.SecurityDomainRule, 0),
 	}
 
-	log.Printf("[INFO] Deleting AWS TGW")
+	tflog.Info(ctx, "Deleting AWS TGW")
 
 	err := client.DeleteAWSTgw(awsTgw)
 	if err != nil {
@@ -3,9 +3,10 @@ package aviatrix
 import (
 	"context"
 	"errors"
-	"log"
+	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -89,7 +90,7 @@ func resourceAviatrixAwsTgwConnectRead(ctx context.Context, d *schema.ResourceDa
 	tgwName := getString(d, "tgw_name")
 	if connectionName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no aws_tgw_connect connection_name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no aws_tgw_connect connection_name received. Import Id is %s", id))
 		parts := strings.Split(id, "~~")
 		if len(parts) != 2 {
 			return diag.Errorf("Invalid Import ID received for aws_tgw_connect, ID must be in the form tgw_name~~connection_name")
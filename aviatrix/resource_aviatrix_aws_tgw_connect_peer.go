@@ -3,9 +3,10 @@ package aviatrix
 import (
 	"context"
 	"errors"
-	"log"
+	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -130,7 +131,7 @@ func resourceAviatrixAwsTgwConnectPeerRead(ctx context.Context, d *schema.Resour
 	connectPeerName := getString(d, "connect_peer_name")
 	if connectionName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no aws_tgw_connect_peer connection_name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no aws_tgw_connect_peer connection_name received. Import Id is %s", id))
 		parts := strings.Split(id, "~~")
 		if len(parts) != 3 {
 			return diag.Errorf("Invalid Import ID received for aws_tgw_connect_peer, ID must be in the form tgw_name~~connection_name~~connect_peer_name")
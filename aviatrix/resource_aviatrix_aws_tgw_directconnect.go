@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -13,10 +14,10 @@ import (
 
 func resourceAviatrixAWSTgwDirectConnect() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAWSTgwDirectConnectCreate,
-		Read:   resourceAviatrixAWSTgwDirectConnectRead,
-		Update: resourceAviatrixAWSTgwDirectConnectUpdate,
-		Delete: resourceAviatrixAWSTgwDirectConnectDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixAWSTgwDirectConnectCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixAWSTgwDirectConnectRead),
+		UpdateWithoutTimeout: withContext(resourceAviatrixAWSTgwDirectConnectUpdate),
+		DeleteWithoutTimeout: withContext(resourceAviatrixAWSTgwDirectConnectDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -61,7 +62,7 @@ func resourceAviatrixAWSTgwDirectConnect() *schema.Resource {
 	}
 }
 
-func resourceAviatrixAWSTgwDirectConnectCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAWSTgwDirectConnectCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	awsTgwDirectConnect := &goaviatrix.AwsTgwDirectConnect{
@@ -81,25 +82,25 @@ func resourceAviatrixAWSTgwDirectConnectCreate(d *schema.ResourceData, meta any)
 
 	d.SetId(awsTgwDirectConnect.TgwName + "~" + awsTgwDirectConnect.DxGatewayID)
 	flag := false
-	defer func() { _ = resourceAviatrixAWSTgwDirectConnectReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixAWSTgwDirectConnectReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	err := client.CreateAwsTgwDirectConnect(awsTgwDirectConnect)
 	if err != nil {
 		return fmt.Errorf("failed to create Aviatrix AWS TGW Direct Connect: %w", err)
 	}
 
-	return resourceAviatrixAWSTgwDirectConnectReadIfRequired(d, meta, &flag)
+	return resourceAviatrixAWSTgwDirectConnectReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixAWSTgwDirectConnectReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixAWSTgwDirectConnectReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixAWSTgwDirectConnectRead(d, meta)
+		return resourceAviatrixAWSTgwDirectConnectRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixAWSTgwDirectConnectRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAWSTgwDirectConnectRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	tgwName := getString(d, "tgw_name")
@@ -107,9 +108,9 @@ func resourceAviatrixAWSTgwDirectConnectRead(d *schema.ResourceData, meta any) e
 
 	if tgwName == "" || directConnectGatewayID == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import. Import Id is %s", id))
 		if !strings.Contains(id, "~") {
-			log.Printf("[DEBUG] Import Id: %s is invalid", id)
+			tflog.Debug(ctx, fmt.Sprintf("Import Id: %s is invalid", id))
 		}
 		mustSet(d, "tgw_name", strings.Split(id, "~")[0])
 		mustSet(d, "dx_gateway_id", strings.Split(id, "~")[1])
@@ -129,7 +130,7 @@ func resourceAviatrixAWSTgwDirectConnectRead(d *schema.ResourceData, meta any) e
 		}
 		return fmt.Errorf("couldn't find Aviatrix Aws Tgw Direct Connect: %w", err)
 	}
-	log.Printf("[INFO] Found Aviatrix Aws Tgw Direct Connect: %#v", directConnect)
+	tflog.Info(ctx, fmt.Sprintf("Found Aviatrix Aws Tgw Direct Connect: %#v", goaviatrix.Redact(directConnect)))
 	mustSet(d, "tgw_name", directConnect.TgwName)
 	mustSet(d, "directconnect_account_name", directConnect.DirectConnectAccountName)
 	mustSet(d, "dx_gateway_id", directConnect.DxGatewayID)
@@ -146,7 +147,7 @@ func resourceAviatrixAWSTgwDirectConnectRead(d *schema.ResourceData, meta any) e
 	return nil
 }
 
-func resourceAviatrixAWSTgwDirectConnectUpdate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAWSTgwDirectConnectUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	awsTgwDirectConnect := &goaviatrix.AwsTgwDirectConnect{
@@ -156,7 +157,7 @@ func resourceAviatrixAWSTgwDirectConnectUpdate(d *schema.ResourceData, meta any)
 
 	d.Partial(true)
 
-	log.Printf("[INFO] Updating Aviatrix Site2Cloud: %#v", awsTgwDirectConnect)
+	tflog.Info(ctx, fmt.Sprintf("Updating Aviatrix Site2Cloud: %#v", goaviatrix.Redact(awsTgwDirectConnect)))
 	if ok := d.HasChange("allowed_prefix"); ok {
 		awsTgwDirectConnect.AllowedPrefix = getString(d, "allowed_prefix")
 		err := client.UpdateDirectConnAllowedPrefix(awsTgwDirectConnect)
@@ -183,17 +184,17 @@ func resourceAviatrixAWSTgwDirectConnectUpdate(d *schema.ResourceData, meta any)
 	}
 
 	d.Partial(false)
-	return resourceAviatrixAWSTgwDirectConnectRead(d, meta)
+	return resourceAviatrixAWSTgwDirectConnectRead(ctx, d, meta)
 }
 
-func resourceAviatrixAWSTgwDirectConnectDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAWSTgwDirectConnectDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 	awsTgwDirectConnect := &goaviatrix.AwsTgwDirectConnect{
 		TgwName:         getString(d, "tgw_name"),
 		DirectConnectID: getString(d, "dx_gateway_id"),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix AWS TGW Direct Connect: %#v", awsTgwDirectConnect)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix AWS TGW Direct Connect: %#v", goaviatrix.Redact(awsTgwDirectConnect)))
 
 	err := client.DeleteAwsTgwDirectConnect(awsTgwDirectConnect)
 	if err != nil {
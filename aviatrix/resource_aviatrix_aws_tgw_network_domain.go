@@ -3,9 +3,10 @@ package aviatrix
 import (
 	"context"
 	"errors"
-	"log"
+	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -119,7 +120,7 @@ func resourceAviatrixAwsTgwNetworkDomainRead(ctx context.Context, d *schema.Reso
 
 	if name == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import. Import Id is %s", id))
 		parts := strings.Split(id, "~")
 		if len(parts) != 2 {
 			return diag.Errorf("invalid ID, expected ID tgw_name~domain_name, instead got %s", d.Id())
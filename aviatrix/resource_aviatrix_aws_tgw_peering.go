@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -13,9 +14,9 @@ import (
 
 func resourceAviatrixAWSTgwPeering() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAWSTgwPeeringCreate,
-		Read:   resourceAviatrixAWSTgwPeeringRead,
-		Delete: resourceAviatrixAWSTgwPeeringDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixAWSTgwPeeringCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixAWSTgwPeeringRead),
+		DeleteWithoutTimeout: withContext(resourceAviatrixAWSTgwPeeringDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -25,21 +26,21 @@ func resourceAviatrixAWSTgwPeering() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				ForceNew:         true,
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncAwsTgwPeeringTgwName1,
+				DiffSuppressFunc: DiffSuppressFuncAwsTgwPeeringTgwName1,
 				Description:      "Name of the first AWS tgw to make a peer pair.",
 			},
 			"tgw_name2": {
 				Type:             schema.TypeString,
 				Required:         true,
 				ForceNew:         true,
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncAwsTgwPeeringTgwName2,
+				DiffSuppressFunc: DiffSuppressFuncAwsTgwPeeringTgwName2,
 				Description:      "Name of the second AWS tgw to make a peer pair.",
 			},
 		},
 	}
 }
 
-func resourceAviatrixAWSTgwPeeringCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAWSTgwPeeringCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	awsTgwPeering := &goaviatrix.AwsTgwPeering{
@@ -47,29 +48,29 @@ func resourceAviatrixAWSTgwPeeringCreate(d *schema.ResourceData, meta any) error
 		TgwName2: getString(d, "tgw_name2"),
 	}
 
-	log.Printf("[INFO] Creating Aviatrix AWS tgw peering: %#v", awsTgwPeering)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix AWS tgw peering: %#v", goaviatrix.Redact(awsTgwPeering)))
 
 	d.SetId(awsTgwPeering.TgwName1 + "~" + awsTgwPeering.TgwName2)
 	flag := false
-	defer func() { _ = resourceAviatrixAWSTgwPeeringReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixAWSTgwPeeringReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	err := client.CreateAwsTgwPeering(awsTgwPeering)
 	if err != nil {
 		return fmt.Errorf("failed to create Aviatrix AWS tgw peering: %w", err)
 	}
 
-	return resourceAviatrixAWSTgwPeeringReadIfRequired(d, meta, &flag)
+	return resourceAviatrixAWSTgwPeeringReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixAWSTgwPeeringReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixAWSTgwPeeringReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixAWSTgwPeeringRead(d, meta)
+		return resourceAviatrixAWSTgwPeeringRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixAWSTgwPeeringRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAWSTgwPeeringRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	tgwName1 := getString(d, "tgw_name1")
@@ -77,7 +78,7 @@ func resourceAviatrixAWSTgwPeeringRead(d *schema.ResourceData, meta any) error {
 
 	if tgwName1 == "" || tgwName2 == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import. Import Id is %s", id))
 		mustSet(d, "tgw_name1", strings.Split(id, "~")[0])
 		mustSet(d, "tgw_name2", strings.Split(id, "~")[1])
 		d.SetId(id)
@@ -101,7 +102,7 @@ func resourceAviatrixAWSTgwPeeringRead(d *schema.ResourceData, meta any) error {
 	return nil
 }
 
-func resourceAviatrixAWSTgwPeeringDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAWSTgwPeeringDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	awsTgwPeering := &goaviatrix.AwsTgwPeering{
@@ -109,7 +110,7 @@ func resourceAviatrixAWSTgwPeeringDelete(d *schema.ResourceData, meta any) error
 		TgwName2: getString(d, "tgw_name2"),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix AWS tgw peering: %#v", awsTgwPeering)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix AWS tgw peering: %#v", goaviatrix.Redact(awsTgwPeering)))
 
 	err := client.DeleteAwsTgwPeering(awsTgwPeering)
 	if err != nil {
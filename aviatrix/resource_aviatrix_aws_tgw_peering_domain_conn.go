@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -13,9 +14,9 @@ import (
 
 func resourceAviatrixAWSTgwPeeringDomainConn() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAWSTgwPeeringDomainConnCreate,
-		Read:   resourceAviatrixAWSTgwPeeringDomainConnRead,
-		Delete: resourceAviatrixAWSTgwPeeringDomainConnDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixAWSTgwPeeringDomainConnCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixAWSTgwPeeringDomainConnRead),
+		DeleteWithoutTimeout: withContext(resourceAviatrixAWSTgwPeeringDomainConnDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -25,35 +26,35 @@ func resourceAviatrixAWSTgwPeeringDomainConn() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				ForceNew:         true,
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncAwsTgwPeeringDomainConnTgwName1,
+				DiffSuppressFunc: DiffSuppressFuncAwsTgwPeeringDomainConnTgwName1,
 				Description:      "The AWS tgw name of the source domain to make a connection.",
 			},
 			"domain_name1": {
 				Type:             schema.TypeString,
 				Required:         true,
 				ForceNew:         true,
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncAwsTgwPeeringDomainConnDomainName1,
+				DiffSuppressFunc: DiffSuppressFuncAwsTgwPeeringDomainConnDomainName1,
 				Description:      "The name of the source domain to make a connection.",
 			},
 			"tgw_name2": {
 				Type:             schema.TypeString,
 				Required:         true,
 				ForceNew:         true,
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncAwsTgwPeeringDomainConnTgwName2,
+				DiffSuppressFunc: DiffSuppressFuncAwsTgwPeeringDomainConnTgwName2,
 				Description:      "The AWS tgw name of the destination domain to make a connection.",
 			},
 			"domain_name2": {
 				Type:             schema.TypeString,
 				Required:         true,
 				ForceNew:         true,
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncAwsTgwPeeringDomainConnDomainName2,
+				DiffSuppressFunc: DiffSuppressFuncAwsTgwPeeringDomainConnDomainName2,
 				Description:      "The name of the destination domain to make a connection.",
 			},
 		},
 	}
 }
 
-func resourceAviatrixAWSTgwPeeringDomainConnCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAWSTgwPeeringDomainConnCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	domainConn := &goaviatrix.DomainConn{
@@ -63,29 +64,29 @@ func resourceAviatrixAWSTgwPeeringDomainConnCreate(d *schema.ResourceData, meta
 		DomainName2: getString(d, "domain_name2"),
 	}
 
-	log.Printf("[INFO] Creating Aviatrix domain connection between tgw: %s and %s", domainConn.TgwName1, domainConn.TgwName2)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix domain connection between tgw: %s and %s", domainConn.TgwName1, domainConn.TgwName2))
 
 	d.SetId(domainConn.TgwName1 + ":" + domainConn.DomainName1 + "~" + domainConn.TgwName2 + ":" + domainConn.DomainName2)
 	flag := false
-	defer func() { _ = resourceAviatrixAWSTgwPeeringDomainConnReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixAWSTgwPeeringDomainConnReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	err := client.CreateDomainConn(domainConn)
 	if err != nil {
 		return fmt.Errorf("failed to create Aviatrix domain connection between two tgws: %w", err)
 	}
 
-	return resourceAviatrixAWSTgwPeeringDomainConnReadIfRequired(d, meta, &flag)
+	return resourceAviatrixAWSTgwPeeringDomainConnReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixAWSTgwPeeringDomainConnReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixAWSTgwPeeringDomainConnReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixAWSTgwPeeringDomainConnRead(d, meta)
+		return resourceAviatrixAWSTgwPeeringDomainConnRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixAWSTgwPeeringDomainConnRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAWSTgwPeeringDomainConnRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	tgwName1 := getString(d, "tgw_name1")
@@ -95,7 +96,7 @@ func resourceAviatrixAWSTgwPeeringDomainConnRead(d *schema.ResourceData, meta an
 
 	if tgwName1 == "" || domainName1 == "" || tgwName2 == "" || domainName2 == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import. Import Id is %s", id))
 		tgwDomain1 := strings.Split(id, "~")[0]
 		tgwDomain2 := strings.Split(id, "~")[1]
 		mustSet(d, "tgw_name1", strings.Split(tgwDomain1, ":")[0])
@@ -125,7 +126,7 @@ func resourceAviatrixAWSTgwPeeringDomainConnRead(d *schema.ResourceData, meta an
 	return nil
 }
 
-func resourceAviatrixAWSTgwPeeringDomainConnDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAWSTgwPeeringDomainConnDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	domainConn := &goaviatrix.DomainConn{
@@ -135,7 +136,7 @@ func resourceAviatrixAWSTgwPeeringDomainConnDelete(d *schema.ResourceData, meta
 		DomainName2: getString(d, "domain_name2"),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix domain connection: %#v", domainConn)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix domain connection: %#v", goaviatrix.Redact(domainConn)))
 
 	err := client.DeleteDomainConn(domainConn)
 	if err != nil {
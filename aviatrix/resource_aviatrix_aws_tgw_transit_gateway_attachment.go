@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -13,9 +14,9 @@ import (
 
 func resourceAviatrixAwsTgwTransitGatewayAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAwsTgwTransitGatewayAttachmentCreate,
-		Read:   resourceAviatrixAwsTgwTransitGatewayAttachmentRead,
-		Delete: resourceAviatrixAwsTgwTransitGatewayAttachmentDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixAwsTgwTransitGatewayAttachmentCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixAwsTgwTransitGatewayAttachmentRead),
+		Delete:               resourceAviatrixAwsTgwTransitGatewayAttachmentDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -55,7 +56,7 @@ func resourceAviatrixAwsTgwTransitGatewayAttachment() *schema.Resource {
 	}
 }
 
-func resourceAviatrixAwsTgwTransitGatewayAttachmentCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAwsTgwTransitGatewayAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	awsTgwTransitGwAttachment := &goaviatrix.AwsTgwTransitGwAttachment{
@@ -68,25 +69,25 @@ func resourceAviatrixAwsTgwTransitGatewayAttachmentCreate(d *schema.ResourceData
 
 	d.SetId(awsTgwTransitGwAttachment.TgwName + "~" + awsTgwTransitGwAttachment.VpcID)
 	flag := false
-	defer func() { _ = resourceAviatrixAwsTgwTransitGatewayAttachmentReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixAwsTgwTransitGatewayAttachmentReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	err := client.CreateAwsTgwTransitGwAttachment(awsTgwTransitGwAttachment)
 	if err != nil {
 		return fmt.Errorf("failed to create Aviatrix AWS tgw transit gateway Attachment: %w", err)
 	}
 
-	return resourceAviatrixAwsTgwTransitGatewayAttachmentReadIfRequired(d, meta, &flag)
+	return resourceAviatrixAwsTgwTransitGatewayAttachmentReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixAwsTgwTransitGatewayAttachmentReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixAwsTgwTransitGatewayAttachmentReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixAwsTgwTransitGatewayAttachmentRead(d, meta)
+		return resourceAviatrixAwsTgwTransitGatewayAttachmentRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixAwsTgwTransitGatewayAttachmentRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAwsTgwTransitGatewayAttachmentRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	tgwName := getString(d, "tgw_name")
@@ -94,7 +95,7 @@ func resourceAviatrixAwsTgwTransitGatewayAttachmentRead(d *schema.ResourceData,
 
 	if tgwName == "" || vpcID == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no tgw names or vpc ids received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no tgw names or vpc ids received. Import Id is %s", id))
 		mustSet(d, "tgw_name", strings.Split(id, "~")[0])
 		mustSet(d, "vpc_id", strings.Split(id, "~")[1])
 	}
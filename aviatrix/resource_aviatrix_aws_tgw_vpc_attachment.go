@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -13,10 +14,10 @@ import (
 
 func resourceAviatrixAwsTgwVpcAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAwsTgwVpcAttachmentCreate,
-		Read:   resourceAviatrixAwsTgwVpcAttachmentRead,
-		Update: resourceAviatrixAwsTgwVpcAttachmentUpdate,
-		Delete: resourceAviatrixAwsTgwVpcAttachmentDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixAwsTgwVpcAttachmentCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixAwsTgwVpcAttachmentRead),
+		UpdateWithoutTimeout: withContext(resourceAviatrixAwsTgwVpcAttachmentUpdate),
+		Delete:               resourceAviatrixAwsTgwVpcAttachmentDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -95,7 +96,7 @@ func resourceAviatrixAwsTgwVpcAttachment() *schema.Resource {
 	}
 }
 
-func resourceAviatrixAwsTgwVpcAttachmentCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAwsTgwVpcAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	awsTgwVpcAttachment := &goaviatrix.AwsTgwVpcAttachment{
@@ -120,11 +121,11 @@ func resourceAviatrixAwsTgwVpcAttachmentCreate(d *schema.ResourceData, meta any)
 		return fmt.Errorf("could not find Security Domain due to: %w", err)
 	}
 
-	log.Printf("[INFO] Attaching vpc: %s to tgw %s", awsTgwVpcAttachment.VpcID, awsTgwVpcAttachment.TgwName)
+	tflog.Info(ctx, fmt.Sprintf("Attaching vpc: %s to tgw %s", awsTgwVpcAttachment.VpcID, awsTgwVpcAttachment.TgwName))
 
 	d.SetId(awsTgwVpcAttachment.TgwName + "~" + awsTgwVpcAttachment.SecurityDomainName + "~" + awsTgwVpcAttachment.VpcID)
 	flag := false
-	defer func() { _ = resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	if isFirewallSecurityDomain {
 		err = client.CreateAwsTgwVpcAttachmentForFireNet(awsTgwVpcAttachment)
@@ -149,18 +150,18 @@ func resourceAviatrixAwsTgwVpcAttachmentCreate(d *schema.ResourceData, meta any)
 		}
 	}
 
-	return resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(d, meta, &flag)
+	return resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixAwsTgwVpcAttachmentRead(d, meta)
+		return resourceAviatrixAwsTgwVpcAttachmentRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixAwsTgwVpcAttachmentRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAwsTgwVpcAttachmentRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	tgwName := getString(d, "tgw_name")
@@ -168,7 +169,7 @@ func resourceAviatrixAwsTgwVpcAttachmentRead(d *schema.ResourceData, meta any) e
 
 	if tgwName == "" || getString(d, "network_domain_name") == "" || vpcID == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no vpc names received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no vpc names received. Import Id is %s", id))
 		mustSet(d, "tgw_name", strings.Split(id, "~")[0])
 		mustSet(d, "network_domain_name", strings.Split(id, "~")[1])
 		mustSet(d, "vpc_id", strings.Split(id, "~")[2])
@@ -237,9 +238,9 @@ func resourceAviatrixAwsTgwVpcAttachmentRead(d *schema.ResourceData, meta any) e
 	return fmt.Errorf("no Aviatrix Aws Tgw Vpc Attach found")
 }
 
-func resourceAviatrixAwsTgwVpcAttachmentUpdate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAwsTgwVpcAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	flag := false
-	defer func() { _ = resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	client := mustClient(meta)
 
@@ -323,7 +324,7 @@ func resourceAviatrixAwsTgwVpcAttachmentUpdate(d *schema.ResourceData, meta any)
 	}
 
 	d.Partial(false)
-	return resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(d, meta, &flag)
+	return resourceAviatrixAwsTgwVpcAttachmentReadIfRequired(ctx, d, meta, &flag)
 }
 
 func resourceAviatrixAwsTgwVpcAttachmentDelete(d *schema.ResourceData, meta any) error {
@@ -1,13 +1,14 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,10 +18,10 @@ import (
 
 func resourceAviatrixAwsTgwVpnConn() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAwsTgwVpnConnCreate,
-		Read:   resourceAviatrixAwsTgwVpnConnRead,
-		Update: resourceAviatrixAwsTgwVpnConnUpdate,
-		Delete: resourceAviatrixAwsTgwVpnConnDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixAwsTgwVpnConnCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixAwsTgwVpnConnRead),
+		UpdateWithoutTimeout: withContext(resourceAviatrixAwsTgwVpnConnUpdate),
+		DeleteWithoutTimeout: withContext(resourceAviatrixAwsTgwVpnConnDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -64,7 +65,7 @@ func resourceAviatrixAwsTgwVpnConn() *schema.Resource {
 				Optional:     true,
 				ForceNew:     true,
 				Description:  "AWS side as a number. Integer between 1-4294967294. Example: '12'. Required for a dynamic VPN connection.",
-				ValidateFunc: goaviatrix.ValidateASN,
+				ValidateFunc: ValidateASN,
 			},
 			"remote_cidr": {
 				Type:        schema.TypeString,
@@ -171,7 +172,7 @@ func resourceAviatrixAwsTgwVpnConn() *schema.Resource {
 	}
 }
 
-func resourceAviatrixAwsTgwVpnConnCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAwsTgwVpnConnCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	awsTgwVpnConn := &goaviatrix.AwsTgwVpnConn{
@@ -218,7 +219,7 @@ func resourceAviatrixAwsTgwVpnConnCreate(d *schema.ResourceData, meta any) error
 		awsTgwVpnConn.LearnedCidrsApproval = "no"
 	}
 
-	log.Printf("[INFO] Creating Aviatrix AWS TGW VPN Connection: %#v", awsTgwVpnConn)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix AWS TGW VPN Connection: %#v", goaviatrix.Redact(awsTgwVpnConn)))
 
 	vpnID, err := client.CreateAwsTgwVpnConn(awsTgwVpnConn)
 	if err != nil {
@@ -226,10 +227,10 @@ func resourceAviatrixAwsTgwVpnConnCreate(d *schema.ResourceData, meta any) error
 	}
 
 	d.SetId(awsTgwVpnConn.TgwName + "~" + vpnID)
-	return resourceAviatrixAwsTgwVpnConnRead(d, meta)
+	return resourceAviatrixAwsTgwVpnConnRead(ctx, d, meta)
 }
 
-func resourceAviatrixAwsTgwVpnConnRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAwsTgwVpnConnRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	tgwName := getString(d, "tgw_name")
@@ -238,10 +239,10 @@ func resourceAviatrixAwsTgwVpnConnRead(d *schema.ResourceData, meta any) error {
 	if tgwName == "" || vpnID == "" {
 		id := d.Id()
 
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import. Import Id is %s", id))
 
 		if !strings.Contains(id, "~") {
-			log.Printf("[DEBUG] Import Id: %s is invalid", id)
+			tflog.Debug(ctx, fmt.Sprintf("Import Id: %s is invalid", id))
 		}
 		mustSet(d, "tgw_name", strings.Split(id, "~")[0])
 		mustSet(d, "vpn_id", strings.Split(id, "~")[1])
@@ -262,7 +263,7 @@ func resourceAviatrixAwsTgwVpnConnRead(d *schema.ResourceData, meta any) error {
 		}
 		return fmt.Errorf("couldn't find Aviatrix AWS TGW VPN Connection: %w", err)
 	}
-	log.Printf("[INFO] Found Aviatrix AWS TGW VPN Connection: %#v", vpnConn)
+	tflog.Info(ctx, fmt.Sprintf("Found Aviatrix AWS TGW VPN Connection: %#v", goaviatrix.Redact(vpnConn)))
 	mustSet(d, "tgw_name", vpnConn.TgwName)
 	mustSet(d, "route_domain_name", vpnConn.RouteDomainName)
 	mustSet(d, "connection_name", vpnConn.ConnName)
@@ -306,7 +307,7 @@ func resourceAviatrixAwsTgwVpnConnRead(d *schema.ResourceData, meta any) error {
 	return nil
 }
 
-func resourceAviatrixAwsTgwVpnConnUpdate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAwsTgwVpnConnUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	awsTgwVpnConn := &goaviatrix.AwsTgwVpnConn{
@@ -315,7 +316,7 @@ func resourceAviatrixAwsTgwVpnConnUpdate(d *schema.ResourceData, meta any) error
 	}
 
 	d.Partial(true)
-	log.Printf("[INFO] Updating Aviatrix aws tgw vpn connection: %#v", awsTgwVpnConn)
+	tflog.Info(ctx, fmt.Sprintf("Updating Aviatrix aws tgw vpn connection: %#v", goaviatrix.Redact(awsTgwVpnConn)))
 
 	if d.HasChange("enable_learned_cidrs_approval") {
 		if getString(d, "connection_type") == "static" {
@@ -339,24 +340,24 @@ func resourceAviatrixAwsTgwVpnConnUpdate(d *schema.ResourceData, meta any) error
 
 	d.Partial(false)
 	d.SetId(awsTgwVpnConn.TgwName + "~" + awsTgwVpnConn.VpnID)
-	return resourceAviatrixAwsTgwVpnConnRead(d, meta)
+	return resourceAviatrixAwsTgwVpnConnRead(ctx, d, meta)
 }
 
-func resourceAviatrixAwsTgwVpnConnDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAwsTgwVpnConnDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 	awsTgwVpnConn := &goaviatrix.AwsTgwVpnConn{
 		TgwName: getString(d, "tgw_name"),
 		VpnID:   getString(d, "vpn_id"),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix aws_tgw_vpn_conn: %#v", awsTgwVpnConn)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix aws_tgw_vpn_conn: %#v", goaviatrix.Redact(awsTgwVpnConn)))
 
 	err := client.DeleteAwsTgwVpnConn(awsTgwVpnConn)
 
 	time.Sleep(40 * time.Second)
 
 	if err != nil {
-		if strings.Contains(err.Error(), "does not exist") {
+		if errors.Is(err, goaviatrix.ErrNotFound) {
 			return nil
 		}
 
@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -13,9 +14,9 @@ import (
 
 func resourceAviatrixAzurePeer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAzurePeerCreate,
-		Read:   resourceAviatrixAzurePeerRead,
-		Delete: resourceAviatrixAzurePeerDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixAzurePeerCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixAzurePeerRead),
+		DeleteWithoutTimeout: withContext(resourceAviatrixAzurePeerDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -73,7 +74,7 @@ func resourceAviatrixAzurePeer() *schema.Resource {
 	}
 }
 
-func resourceAviatrixAzurePeerCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAzurePeerCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	azurePeer := &goaviatrix.AzurePeer{
@@ -85,36 +86,36 @@ func resourceAviatrixAzurePeerCreate(d *schema.ResourceData, meta any) error {
 		Region2:      getString(d, "vnet_reg2"),
 	}
 
-	log.Printf("[INFO] Creating Aviatrix Azure peer: %#v", azurePeer)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix Azure peer: %#v", goaviatrix.Redact(azurePeer)))
 
 	d.SetId(azurePeer.VNet1 + "~" + azurePeer.VNet2)
 	flag := false
-	defer func() { _ = resourceAviatrixAzurePeerReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixAzurePeerReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	err := client.CreateAzurePeer(azurePeer)
 	if err != nil {
 		return fmt.Errorf("failed to create Aviatrix Azure Peer: %w", err)
 	}
 
-	return resourceAviatrixAzurePeerReadIfRequired(d, meta, &flag)
+	return resourceAviatrixAzurePeerReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixAzurePeerReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixAzurePeerReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixAzurePeerRead(d, meta)
+		return resourceAviatrixAzurePeerRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixAzurePeerRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAzurePeerRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	vNet1 := getString(d, "vnet_name_resource_group1")
 	vNet2 := getString(d, "vnet_name_resource_group2")
 	if vNet1 == "" || vNet2 == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no Azure peer id received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no Azure peer id received. Import Id is %s", id))
 		mustSet(d, "vnet_name_resource_group1", strings.Split(id, "~")[0])
 		mustSet(d, "vnet_name_resource_group2", strings.Split(id, "~")[1])
 		d.SetId(id)
@@ -134,7 +135,7 @@ func resourceAviatrixAzurePeerRead(d *schema.ResourceData, meta any) error {
 		return fmt.Errorf("couldn't find Aviatrix Azure peer: %w", err)
 	}
 
-	log.Printf("[TRACE] Reading azure peer: %#v", azureP)
+	tflog.Trace(ctx, fmt.Sprintf("Reading azure peer: %#v", goaviatrix.Redact(azureP)))
 
 	if azureP != nil {
 		mustSet(d, "vnet_name_resource_group1", azureP.VNet1)
@@ -145,17 +146,17 @@ func resourceAviatrixAzurePeerRead(d *schema.ResourceData, meta any) error {
 		mustSet(d, "vnet_reg2", azureP.Region2)
 
 		if err := d.Set("vnet_cidr1", azureP.VNetCidr1); err != nil {
-			log.Printf("[WARN] Error setting vnet_cidr1 for (%s): %s", d.Id(), err)
+			tflog.Warn(ctx, fmt.Sprintf("Error setting vnet_cidr1 for (%s): %s", d.Id(), err))
 		}
 		if err := d.Set("vnet_cidr2", azureP.VNetCidr2); err != nil {
-			log.Printf("[WARN] Error setting vnet_cidr2 for (%s): %s", d.Id(), err)
+			tflog.Warn(ctx, fmt.Sprintf("Error setting vnet_cidr2 for (%s): %s", d.Id(), err))
 		}
 	}
 
 	return nil
 }
 
-func resourceAviatrixAzurePeerDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAzurePeerDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	azurePeer := &goaviatrix.AzurePeer{
@@ -163,7 +164,7 @@ func resourceAviatrixAzurePeerDelete(d *schema.ResourceData, meta any) error {
 		VNet2: getString(d, "vnet_name_resource_group2"),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix Azure peer: %#v", azurePeer)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix Azure peer: %#v", goaviatrix.Redact(azurePeer)))
 
 	err := client.DeleteAzurePeer(azurePeer)
 	if err != nil {
@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -13,10 +14,10 @@ import (
 
 func resourceAviatrixAzureSpokeNativePeering() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAzureSpokeNativePeeringCreate,
-		Read:   resourceAviatrixAzureSpokeNativePeeringRead,
-		Update: resourceAviatrixAzureSpokeNativePeeringUpdate,
-		Delete: resourceAviatrixAzureSpokeNativePeeringDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixAzureSpokeNativePeeringCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixAzureSpokeNativePeeringRead),
+		UpdateWithoutTimeout: withContext(resourceAviatrixAzureSpokeNativePeeringUpdate),
+		DeleteWithoutTimeout: withContext(resourceAviatrixAzureSpokeNativePeeringDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -57,7 +58,7 @@ func resourceAviatrixAzureSpokeNativePeering() *schema.Resource {
 	}
 }
 
-func resourceAviatrixAzureSpokeNativePeeringCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAzureSpokeNativePeeringCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	azureSpokeNativePeering := &goaviatrix.AzureSpokeNativePeering{
@@ -67,11 +68,11 @@ func resourceAviatrixAzureSpokeNativePeeringCreate(d *schema.ResourceData, meta
 		SpokeVpcID:         getString(d, "spoke_vpc_id"),
 	}
 
-	log.Printf("[INFO] Creating Aviatrix Azure spoke native peering: %#v", azureSpokeNativePeering)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix Azure spoke native peering: %#v", goaviatrix.Redact(azureSpokeNativePeering)))
 
 	d.SetId(azureSpokeNativePeering.TransitGatewayName + "~" + azureSpokeNativePeering.SpokeAccountName + "~" + azureSpokeNativePeering.SpokeVpcID)
 	flag := false
-	defer func() { _ = resourceAviatrixAzureSpokeNativePeeringReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixAzureSpokeNativePeeringReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	err := client.CreateAzureSpokeNativePeering(azureSpokeNativePeering)
 	if err != nil {
@@ -91,18 +92,18 @@ func resourceAviatrixAzureSpokeNativePeeringCreate(d *schema.ResourceData, meta
 			return fmt.Errorf("could not edit private route table config: %w", err)
 		}
 	}
-	return resourceAviatrixAzureSpokeNativePeeringReadIfRequired(d, meta, &flag)
+	return resourceAviatrixAzureSpokeNativePeeringReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixAzureSpokeNativePeeringReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixAzureSpokeNativePeeringReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixAzureSpokeNativePeeringRead(d, meta)
+		return resourceAviatrixAzureSpokeNativePeeringRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixAzureSpokeNativePeeringRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAzureSpokeNativePeeringRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	transitGatewayName := getString(d, "transit_gateway_name")
@@ -111,7 +112,7 @@ func resourceAviatrixAzureSpokeNativePeeringRead(d *schema.ResourceData, meta an
 
 	if transitGatewayName == "" || spokeAccountName == "" || spokeVpcID == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no transit gateway name, or spoke account name, or spoke vpc id received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no transit gateway name, or spoke account name, or spoke vpc id received. Import Id is %s", id))
 		mustSet(d, "transit_gateway_name", strings.Split(id, "~")[0])
 		mustSet(d, "spoke_account_name", strings.Split(id, "~")[1])
 		mustSet(d, "spoke_vpc_id", strings.Split(id, "~")[2])
@@ -142,11 +143,11 @@ func resourceAviatrixAzureSpokeNativePeeringRead(d *schema.ResourceData, meta an
 	return nil
 }
 
-func resourceAviatrixAzureSpokeNativePeeringUpdate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAzureSpokeNativePeeringUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	if d.HasChange("private_route_table_config") {
-		log.Printf("[INFO] resourceAviatrixAzureSpokeNativePeeringUpdate has changed private_route_table_config")
+		tflog.Info(ctx, "resourceAviatrixAzureSpokeNativePeeringUpdate has changed private_route_table_config")
 
 		spokeAccountName := getString(d, "spoke_account_name")
 		spokeVpcID := getString(d, "spoke_vpc_id")
@@ -163,10 +164,10 @@ func resourceAviatrixAzureSpokeNativePeeringUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	return resourceAviatrixAzureSpokeNativePeeringRead(d, meta)
+	return resourceAviatrixAzureSpokeNativePeeringRead(ctx, d, meta)
 }
 
-func resourceAviatrixAzureSpokeNativePeeringDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAzureSpokeNativePeeringDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	azureSpokeNativePeering := &goaviatrix.AzureSpokeNativePeering{
@@ -175,7 +176,7 @@ func resourceAviatrixAzureSpokeNativePeeringDelete(d *schema.ResourceData, meta
 		SpokeVpcID:         getString(d, "spoke_vpc_id"),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix Azure spoke native peering: %#v", azureSpokeNativePeering)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix Azure spoke native peering: %#v", goaviatrix.Redact(azureSpokeNativePeering)))
 
 	err := client.DeleteAzureSpokeNativePeering(azureSpokeNativePeering)
 	if err != nil {
@@ -1,10 +1,11 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -12,9 +13,9 @@ import (
 
 func resourceAviatrixAzureVngConn() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixAzureVngConnCreate,
-		Read:   resourceAviatrixAzureVngConnRead,
-		Delete: resourceAviatrixAzureVngConnDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixAzureVngConnCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixAzureVngConnRead),
+		Delete:               resourceAviatrixAzureVngConnDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -58,38 +59,38 @@ func marshalAzureVngConnInput(d *schema.ResourceData) *goaviatrix.AzureVngConn {
 	}
 }
 
-func resourceAviatrixAzureVngConnCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAzureVngConnCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	azureVngConn := marshalAzureVngConnInput(d)
 
 	d.SetId(azureVngConn.ConnectionName)
 	flag := false
-	defer func() { _ = resourceAviatrixAzureVngConnReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixAzureVngConnReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	if err := client.ConnectAzureVng(azureVngConn); err != nil {
 		return fmt.Errorf("could not connect to azure vng: %w", err)
 	}
 
-	return resourceAviatrixAzureVngConnReadIfRequired(d, meta, &flag)
+	return resourceAviatrixAzureVngConnReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixAzureVngConnReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixAzureVngConnReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixAzureVngConnRead(d, meta)
+		return resourceAviatrixAzureVngConnRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixAzureVngConnRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixAzureVngConnRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	connectionName := getString(d, "connection_name")
 
 	if connectionName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import. Import Id is %s", id))
 		mustSet(d, "connection_name", id)
 		connectionName = id
 	}
@@ -3,9 +3,10 @@ package aviatrix
 import (
 	"context"
 	"errors"
-	"log"
+	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -95,7 +96,7 @@ func resourceAviatrixCentralizedTransitFireNetRead(ctx context.Context, d *schem
 	// handle import
 	if getString(d, "primary_firenet_gw_name") == "" || getString(d, "secondary_firenet_gw_name") == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no primary or secondary gateway name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no primary or secondary gateway name received. Import Id is %s", id))
 		mustSet(d, "primary_firenet_gw_name", strings.Split(id, "~")[0])
 		mustSet(d, "secondary_firenet_gw_name", strings.Split(id, "~")[1])
 		d.SetId(id)
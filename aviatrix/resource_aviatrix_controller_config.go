@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -18,10 +19,10 @@ var validAwsGuardDutyScanningIntervals = []int{5, 10, 15, 30, 60}
 
 func resourceAviatrixControllerConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixControllerConfigCreate,
-		Read:   resourceAviatrixControllerConfigRead,
-		Update: resourceAviatrixControllerConfigUpdate,
-		Delete: resourceAviatrixControllerConfigDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixControllerConfigCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixControllerConfigRead),
+		UpdateWithoutTimeout: withContext(resourceAviatrixControllerConfigUpdate),
+		DeleteWithoutTimeout: withContext(resourceAviatrixControllerConfigDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -177,16 +178,16 @@ func resourceAviatrixControllerConfig() *schema.Resource {
 	}
 }
 
-func resourceAviatrixControllerConfigCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixControllerConfigCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	var err error
 
 	client := mustClient(meta)
 
 	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
 	flag := false
-	defer func() { _ = resourceAviatrixControllerConfigReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixControllerConfigReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
-	log.Printf("[INFO] Configuring Aviatrix controller : %#v", d)
+	tflog.Info(ctx, fmt.Sprintf("Configuring Aviatrix controller : %#v", d))
 
 	backupConfiguration := getBool(d, "backup_configuration")
 	backupCloudType := getInt(d, "backup_cloud_type")
@@ -262,21 +263,21 @@ func resourceAviatrixControllerConfigCreate(d *schema.ResourceData, meta any) er
 		return fmt.Errorf("could not update scanning interval: %w", err)
 	}
 
-	return resourceAviatrixControllerConfigReadIfRequired(d, meta, &flag)
+	return resourceAviatrixControllerConfigReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixControllerConfigReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixControllerConfigReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixControllerConfigRead(d, meta)
+		return resourceAviatrixControllerConfigRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixControllerConfigRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixControllerConfigRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
-	log.Printf("[INFO] Getting controller %s configuration", d.Id())
+	tflog.Info(ctx, fmt.Sprintf("Getting controller %s configuration", d.Id()))
 
 	var versionInfo *goaviatrix.VersionInfo
 	var err error
@@ -350,10 +351,10 @@ func resourceAviatrixControllerConfigRead(d *schema.ResourceData, meta any) erro
 	return nil
 }
 
-func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixControllerConfigUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
-	log.Printf("[INFO] Updating Controller configuration: %#v", d)
+	tflog.Info(ctx, fmt.Sprintf("Updating Controller configuration: %#v", d))
 	d.Partial(true)
 
 	backupConfiguration := getBool(d, "backup_configuration")
@@ -489,17 +490,17 @@ func resourceAviatrixControllerConfigUpdate(d *schema.ResourceData, meta any) er
 	}
 
 	d.Partial(false)
-	return resourceAviatrixControllerConfigRead(d, meta)
+	return resourceAviatrixControllerConfigRead(ctx, d, meta)
 }
 
-func resourceAviatrixControllerConfigDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixControllerConfigDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 	mustSet(d, "backup_configuration", false)
 	cloudnBackupConfig, _ := client.GetCloudnBackupConfig()
 	if cloudnBackupConfig.BackupConfiguration == "yes" {
 		err := client.DisableCloudnBackupConfig()
 		if err != nil {
-			log.Printf("[ERROR] Failed to disable cloudn backup config on controller %s", d.Id())
+			tflog.Error(ctx, fmt.Sprintf("Failed to disable cloudn backup config on controller %s", d.Id()))
 			return err
 		}
 	}
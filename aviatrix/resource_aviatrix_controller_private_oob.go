@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -13,10 +14,10 @@ import (
 
 func resourceAviatrixControllerPrivateOob() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixControllerPrivateOobCreate,
-		Read:   resourceAviatrixControllerPrivateOobRead,
-		Update: resourceAviatrixControllerPrivateOobUpdate,
-		Delete: resourceAviatrixControllerPrivateOobDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixControllerPrivateOobCreate),
+		Read:                 resourceAviatrixControllerPrivateOobRead,
+		UpdateWithoutTimeout: withContext(resourceAviatrixControllerPrivateOobUpdate),
+		Delete:               resourceAviatrixControllerPrivateOobDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -32,12 +33,12 @@ func resourceAviatrixControllerPrivateOob() *schema.Resource {
 	}
 }
 
-func resourceAviatrixControllerPrivateOobCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixControllerPrivateOobCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	enablePrivateOob := getBool(d, "enable_private_oob")
 	if enablePrivateOob {
-		log.Printf("[INFO] Enabling Aviatrix controller private oob")
+		tflog.Info(ctx, "Enabling Aviatrix controller private oob")
 
 		err := client.EnablePrivateOob()
 		if err != nil {
@@ -69,10 +70,10 @@ func resourceAviatrixControllerPrivateOobRead(d *schema.ResourceData, meta any)
 	return nil
 }
 
-func resourceAviatrixControllerPrivateOobUpdate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixControllerPrivateOobUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
-	log.Printf("[INFO] Updating Aviatrix controller private oob")
+	tflog.Info(ctx, "Updating Aviatrix controller private oob")
 
 	if d.HasChange("enable_private_oob") {
 		enablePrivateOob := getBool(d, "enable_private_oob")
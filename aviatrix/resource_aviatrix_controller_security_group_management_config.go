@@ -1,20 +1,20 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
-	log "github.com/sirupsen/logrus"
-
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAviatrixControllerSecurityGroupManagementConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixControllerSecurityGroupManagementConfigCreate,
-		Read:   resourceAviatrixControllerSecurityGroupManagementConfigRead,
-		Update: resourceAviatrixControllerSecurityGroupManagementConfigUpdate,
-		Delete: resourceAviatrixControllerSecurityGroupManagementConfigDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixControllerSecurityGroupManagementConfigCreate),
+		Read:                 resourceAviatrixControllerSecurityGroupManagementConfigRead,
+		UpdateWithoutTimeout: withContext(resourceAviatrixControllerSecurityGroupManagementConfigUpdate),
+		DeleteWithoutTimeout: withContext(resourceAviatrixControllerSecurityGroupManagementConfigDelete),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -40,7 +40,7 @@ func resourceAviatrixControllerSecurityGroupManagementConfig() *schema.Resource
 	}
 }
 
-func resourceAviatrixControllerSecurityGroupManagementConfigCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixControllerSecurityGroupManagementConfigCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	account := getString(d, "account_name")
@@ -52,7 +52,7 @@ func resourceAviatrixControllerSecurityGroupManagementConfigCreate(d *schema.Res
 		}
 		curStatus, _ := client.GetSecurityGroupManagementStatus()
 		if curStatus.State == "Enabled" {
-			log.Printf("[INFO] Security Group Management is already enabled")
+			tflog.Info(ctx, "Security Group Management is already enabled")
 		} else {
 			err := client.EnableSecurityGroupManagement(account)
 			if err != nil {
@@ -65,7 +65,7 @@ func resourceAviatrixControllerSecurityGroupManagementConfigCreate(d *schema.Res
 		}
 		curStatus, _ := client.GetSecurityGroupManagementStatus()
 		if curStatus.State == "Disabled" {
-			log.Printf("[INFO] Security Group Management is already disabled")
+			tflog.Info(ctx, "Security Group Management is already disabled")
 		} else {
 			err := client.DisableSecurityGroupManagement()
 			if err != nil {
@@ -109,7 +109,7 @@ func resourceAviatrixControllerSecurityGroupManagementConfigRead(d *schema.Resou
 	return nil
 }
 
-func resourceAviatrixControllerSecurityGroupManagementConfigUpdate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixControllerSecurityGroupManagementConfigUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	if d.HasChange("account_name") || d.HasChange("enable_security_group_management") {
@@ -126,7 +126,7 @@ func resourceAviatrixControllerSecurityGroupManagementConfigUpdate(d *schema.Res
 				return fmt.Errorf("failed to enable Security Group Management on controller %s: %w", d.Id(), err)
 			}
 		} else {
-			return resourceAviatrixControllerSecurityGroupManagementConfigCreate(d, meta)
+			return resourceAviatrixControllerSecurityGroupManagementConfigCreate(ctx, d, meta)
 		}
 	}
 
@@ -142,12 +142,12 @@ func resourceAviatrixControllerSecurityGroupManagementConfigUpdate(d *schema.Res
 	return resourceAviatrixControllerSecurityGroupManagementConfigRead(d, meta)
 }
 
-func resourceAviatrixControllerSecurityGroupManagementConfigDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixControllerSecurityGroupManagementConfigDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	err := client.UpdateSecurityGroupGatewayEgressCidrs("")
 	if err != nil {
-		log.Printf("[WARN] failed to clear gateway egress CIDRs during delete: %v", err)
+		tflog.Warn(ctx, fmt.Sprintf("failed to clear gateway egress CIDRs during delete: %v", err))
 	}
 
 	return nil
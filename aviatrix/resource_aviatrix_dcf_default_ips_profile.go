@@ -37,7 +37,7 @@ func resourceAviatrixDCFDefaultIpsProfile() *schema.Resource {
 }
 
 func resourceAviatrixDCFDefaultIpsProfileCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	profiles := expandStringList(getSet(d, "default_ips_profile").List())
 
@@ -51,7 +51,7 @@ func resourceAviatrixDCFDefaultIpsProfileCreate(ctx context.Context, d *schema.R
 }
 
 func resourceAviatrixDCFDefaultIpsProfileRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	if d.Id() != "dcf_default_ips_profile" {
 		return diag.Errorf("ID: %s does not match expected ID \"dcf_default_ips_profile\": please provide correct ID for importing", d.Id())
@@ -73,7 +73,7 @@ func resourceAviatrixDCFDefaultIpsProfileRead(ctx context.Context, d *schema.Res
 }
 
 func resourceAviatrixDCFDefaultIpsProfileUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	profiles := expandStringList(getSet(d, "default_ips_profile").List())
 
@@ -86,7 +86,7 @@ func resourceAviatrixDCFDefaultIpsProfileUpdate(ctx context.Context, d *schema.R
 }
 
 func resourceAviatrixDCFDefaultIpsProfileDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	// Need to reset the default IPS profile to the system default, there must be a default profile
 	_, err := client.SetDefaultIpsProfile(ctx, []string{defaultIPSProfileUUID})
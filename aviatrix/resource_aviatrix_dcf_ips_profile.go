@@ -104,7 +104,7 @@ func validateIntrusionActionsKeys(val any, _ string) (warns []string, errs []err
 // IPS Profile CRUD operations
 
 func resourceAviatrixDCFIpsProfileCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	profile := &goaviatrix.IpsProfile{
 		ProfileName:      getString(d, "profile_name"),
@@ -122,7 +122,7 @@ func resourceAviatrixDCFIpsProfileCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceAviatrixDCFIpsProfileRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	profile, err := client.GetIpsProfile(ctx, d.Id())
 	if err != nil {
@@ -141,7 +141,7 @@ func resourceAviatrixDCFIpsProfileRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceAviatrixDCFIpsProfileUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	profile := &goaviatrix.IpsProfile{
 		ProfileName:      getString(d, "profile_name"),
@@ -158,7 +158,7 @@ func resourceAviatrixDCFIpsProfileUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceAviatrixDCFIpsProfileDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	err := client.DeleteIpsProfile(ctx, d.Id())
 	if err != nil {
@@ -57,7 +57,7 @@ func resourceAviatrixDCFIpsRuleFeed() *schema.Resource {
 // IPS Rule Feed CRUD operations
 
 func resourceAviatrixDCFIpsRuleFeedCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	ruleFeed := &goaviatrix.IpsRuleFeed{
 		FeedName:    getString(d, "feed_name"),
@@ -74,7 +74,7 @@ func resourceAviatrixDCFIpsRuleFeedCreate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceAviatrixDCFIpsRuleFeedRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	ruleFeed, err := client.GetIpsRuleFeed(ctx, d.Id())
 	if err != nil {
@@ -97,7 +97,7 @@ func resourceAviatrixDCFIpsRuleFeedUpdate(ctx context.Context, d *schema.Resourc
 		return resourceAviatrixDCFIpsRuleFeedRead(ctx, d, meta)
 	}
 
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	ruleFeed := &goaviatrix.IpsRuleFeed{
 		FeedName:    getString(d, "feed_name"),
@@ -113,7 +113,7 @@ func resourceAviatrixDCFIpsRuleFeedUpdate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceAviatrixDCFIpsRuleFeedDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	err := client.DeleteIpsRuleFeed(ctx, d.Id())
 	if err != nil {
@@ -18,6 +18,9 @@ func resourceAviatrixDCFMitmCa() *schema.Resource {
 		ReadWithoutTimeout:   resourceAviatrixDCFMitmCaRead,
 		UpdateWithoutTimeout: resourceAviatrixDCFMitmCaUpdate,
 		DeleteWithoutTimeout: resourceAviatrixDCFMitmCaDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importByIDOrName("DCF MITM CA", listDCFMitmCas),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -32,14 +35,14 @@ func resourceAviatrixDCFMitmCa() *schema.Resource {
 				Sensitive:        true,
 				ForceNew:         true,
 				ValidateFunc:     validation.StringIsNotWhiteSpace,
-				DiffSuppressFunc: suppressCertificatesContentDiff,
+				DiffSuppressFunc: suppressImportedKeyDiff,
 				Description:      "The private key in PEM format.",
 			},
 			"certificate_chain": {
 				Type:             schema.TypeString,
 				Required:         true,
 				ForceNew:         true,
-				ValidateFunc:     goaviatrix.ValidateCertificates,
+				ValidateFunc:     ValidateCertificates,
 				DiffSuppressFunc: suppressCertificatesContentDiff,
 				Description:      "The certificate chain in PEM format. The first certificate must be a signing CA certificate. It should also match the provided private key.",
 			},
@@ -72,6 +75,28 @@ func resourceAviatrixDCFMitmCa() *schema.Resource {
 	}
 }
 
+func listDCFMitmCas(ctx context.Context, meta any) ([]namedObject, error) {
+	list, err := mustDCFClient(meta).ListDCFMitmCa(ctx)
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]namedObject, 0, len(list.Cas))
+	for _, ca := range list.Cas {
+		objects = append(objects, namedObject{id: ca.CaID, name: ca.Name})
+	}
+	return objects, nil
+}
+
+// suppressImportedKeyDiff suppresses the diff of the key of an imported CA.
+// The Controller never returns the key, so it is empty in the state of an
+// imported CA and is kept as it is rather than replacing the CA.
+func suppressImportedKeyDiff(k, oldContent, newContent string, d *schema.ResourceData) bool {
+	if oldContent == "" && d.Id() != "" {
+		return true
+	}
+	return suppressCertificatesContentDiff(k, oldContent, newContent, d)
+}
+
 func marshalDCFMitmCaInput(d *schema.ResourceData) *goaviatrix.MitmCaItemRequest {
 	return &goaviatrix.MitmCaItemRequest{
 		Name:             getString(d, "name"),
@@ -81,7 +106,7 @@ func marshalDCFMitmCaInput(d *schema.ResourceData) *goaviatrix.MitmCaItemRequest
 }
 
 func resourceAviatrixDCFMitmCaCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	mitmCaRequest := marshalDCFMitmCaInput(d)
 
@@ -97,7 +122,7 @@ func resourceAviatrixDCFMitmCaCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceAviatrixDCFMitmCaRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	caID := d.Id()
 
@@ -126,7 +151,7 @@ func resourceAviatrixDCFMitmCaRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAviatrixDCFMitmCaUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	caID := d.Id()
 
@@ -148,7 +173,7 @@ func resourceAviatrixDCFMitmCaUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceAviatrixDCFMitmCaDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	caID := d.Id()
 
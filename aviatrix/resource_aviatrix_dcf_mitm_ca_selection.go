@@ -31,7 +31,7 @@ func resourceAviatrixDCFMitmCaSelection() *schema.Resource {
 }
 
 func resourceAviatrixCaDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	// Creation means selecting the given CA as the active CA
 	mitmCAID := getString(d, "ca_id")
@@ -44,15 +44,15 @@ func resourceAviatrixCaDeploymentCreate(ctx context.Context, d *schema.ResourceD
 	}
 
 	// Use controller IP as fixed ID (ensures uniqueness per controller)
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return resourceAviatrixCaDeploymentRead(ctx, d, meta)
 }
 
 func resourceAviatrixCaDeploymentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	// Validate ID matches controller
-	expectedID := strings.Replace(client.ControllerIP, ".", "-", -1)
+	expectedID := strings.Replace(client.GetControllerIP(), ".", "-", -1)
 	if d.Id() != expectedID {
 		return diag.Errorf("ID %q does not match controller IP. Please provide correct ID for importing.", d.Id())
 	}
@@ -86,7 +86,7 @@ func resourceAviatrixCaDeploymentRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceAviatrixCaDeploymentUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	mitmCAID := getString(d, "ca_id")
 
@@ -101,7 +101,7 @@ func resourceAviatrixCaDeploymentUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceAviatrixCaDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	mitmCAID := goaviatrix.DCFMITMSystemCAID
 	// Refresh the system CA first
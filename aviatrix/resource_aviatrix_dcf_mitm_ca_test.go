@@ -10,10 +10,60 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
 )
 
+func TestDCFMitmCaImport(t *testing.T) {
+	client := &goaviatrix.ClientInterfaceMock{
+		ListDCFMitmCaFunc: func(ctx context.Context) (*goaviatrix.MitmCaListResponse, error) {
+			return &goaviatrix.MitmCaListResponse{Cas: []goaviatrix.MitmCaResponse{
+				{CaID: goaviatrix.DCFMITMSystemCAID, Name: "system"},
+				{CaID: "ca-1", Name: "corp-ca"},
+			}}, nil
+		},
+	}
+	r := resourceAviatrixDCFMitmCa()
+
+	for _, importID := range []string{"corp-ca", "ca-1"} {
+		d := r.Data(&terraform.InstanceState{ID: importID})
+		imported, err := r.Importer.StateContext(context.Background(), d, client)
+		require.NoError(t, err)
+		require.Len(t, imported, 1)
+		assert.Equal(t, "ca-1", imported[0].Id())
+	}
+
+	d := r.Data(&terraform.InstanceState{ID: "other-ca"})
+	_, err := r.Importer.StateContext(context.Background(), d, client)
+	assert.ErrorContains(t, err, `no DCF MITM CA with ID or name "other-ca"`)
+}
+
+func TestDCFMitmCaImport_KeyNotReplaced(t *testing.T) {
+	r := resourceAviatrixDCFMitmCa()
+	// The state of an imported CA, which has no key.
+	state := &terraform.InstanceState{ID: "ca-1", Attributes: map[string]string{
+		"id":                "ca-1",
+		"name":              "corp-ca",
+		"certificate_chain": "chain",
+	}}
+	config := terraform.NewResourceConfigRaw(map[string]any{
+		"name":              "corp-ca",
+		"key":               "key",
+		"certificate_chain": "chain",
+	})
+
+	diff, err := r.Diff(context.Background(), state, config, nil)
+	require.NoError(t, err)
+	assert.True(t, diff == nil || diff.Empty(), "unexpected diff %v", diff)
+
+	// A new CA still sends its key.
+	diff, err = r.Diff(context.Background(), nil, config, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "key", diff.Attributes["key"].New)
+}
+
 func TestAccAviatrixDCFMitmCa_basic(t *testing.T) {
 	skipAcc := os.Getenv("SKIP_DCF_MITM_CA")
 	if skipAcc == "yes" {
@@ -40,6 +90,20 @@ func TestAccAviatrixDCFMitmCa_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "origin"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The Controller never returns the private key.
+				ImportStateVerifyIgnore: []string{"key"},
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           "test-dcf-mitm-ca",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key"},
+			},
 		},
 	})
 }
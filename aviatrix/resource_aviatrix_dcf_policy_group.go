@@ -241,7 +241,7 @@ func marshalSubPolicyListInput(subPolicyMap map[string]any) (*goaviatrix.DCFSubP
 }
 
 func resourceAviatrixDCFPolicyGroupCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	policyBlock, err := marshalDCFPolicyBlockInput(d)
 	if err != nil {
@@ -259,7 +259,7 @@ func resourceAviatrixDCFPolicyGroupCreate(ctx context.Context, d *schema.Resourc
 
 //nolint:cyclop,funlen
 func resourceAviatrixDCFPolicyGroupRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	uuid := d.Id()
 
@@ -329,7 +329,7 @@ func resourceAviatrixDCFPolicyGroupRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceAviatrixDCFPolicyGroupUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	policyBlock, err := marshalDCFPolicyBlockInput(d)
 	if err != nil {
@@ -345,7 +345,7 @@ func resourceAviatrixDCFPolicyGroupUpdate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceAviatrixDCFPolicyGroupDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	uuid := d.Id()
 
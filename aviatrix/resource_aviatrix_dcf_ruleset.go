@@ -498,7 +498,7 @@ func marshalSmartGroupsInput(policyMap map[string]any, key string) ([]string, er
 }
 
 func resourceAviatrixDCFRulesetCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	policyList, err := marshalDCFRulesetInput(d)
 	if err != nil {
@@ -521,7 +521,7 @@ func resourceAviatrixDCFRulesetCreate(ctx context.Context, d *schema.ResourceDat
 
 //nolint:funlen,cyclop
 func resourceAviatrixDCFRulesetRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	uuid := d.Id()
 
@@ -592,7 +592,7 @@ func resourceAviatrixDCFRulesetRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAviatrixDCFRulesetUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	policyList, err := marshalDCFRulesetInput(d)
 	if err != nil {
@@ -608,7 +608,7 @@ func resourceAviatrixDCFRulesetUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceAviatrixDCFRulesetDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	uuid := d.Id()
 
@@ -79,7 +79,7 @@ func marshalDCFTLSProfileInput(d *schema.ResourceData) (*goaviatrix.TLSProfile,
 }
 
 func resourceAviatrixDCFTLSProfileCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	tlsProfile, err := marshalDCFTLSProfileInput(d)
 	if err != nil {
@@ -96,7 +96,7 @@ func resourceAviatrixDCFTLSProfileCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceAviatrixDCFTLSProfileRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	uuid := d.Id()
 
@@ -135,7 +135,7 @@ func resourceAviatrixDCFTLSProfileRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceAviatrixDCFTLSProfileUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	tlsProfile, err := marshalDCFTLSProfileInput(d)
 	if err != nil {
@@ -152,7 +152,7 @@ func resourceAviatrixDCFTLSProfileUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceAviatrixDCFTLSProfileDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	uuid := d.Id()
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/x509"
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -19,6 +20,9 @@ func resourceAviatrixDCFTrustBundle() *schema.Resource {
 		ReadWithoutTimeout:   resourceAviatrixDCFTrustBundleRead,
 		UpdateWithoutTimeout: resourceAviatrixDCFTrustBundleUpdate,
 		DeleteWithoutTimeout: resourceAviatrixDCFTrustBundleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAviatrixDCFTrustBundleImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"display_name": {
@@ -30,7 +34,7 @@ func resourceAviatrixDCFTrustBundle() *schema.Resource {
 			"bundle_content": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateFunc:     goaviatrix.ValidateCertificates,
+				ValidateFunc:     ValidateCertificates,
 				DiffSuppressFunc: suppressBundleContentDiff,
 				Description:      "The CA bundle content in PEM format.",
 			},
@@ -72,7 +76,7 @@ func marshalDCFTrustBundleInput(d *schema.ResourceData) *goaviatrix.TrustBundleI
 }
 
 func resourceAviatrixDCFTrustBundleCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	trustBundleRequest := marshalDCFTrustBundleInput(d)
 
@@ -88,7 +92,7 @@ func resourceAviatrixDCFTrustBundleCreate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceAviatrixDCFTrustBundleRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	bundleID := d.Id()
 
@@ -112,8 +116,27 @@ func resourceAviatrixDCFTrustBundleRead(ctx context.Context, d *schema.ResourceD
 	return nil
 }
 
+// resourceAviatrixDCFTrustBundleImport imports a trust bundle by its UUID or
+// by its display name.
+func resourceAviatrixDCFTrustBundleImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := mustDCFClient(meta)
+
+	trustBundle, err := client.GetDCFTrustBundleByID(ctx, d.Id())
+	if errors.Is(err, goaviatrix.ErrNotFound) {
+		trustBundle, err = client.GetDCFTrustBundleByName(ctx, d.Id())
+	}
+	if err != nil {
+		if errors.Is(err, goaviatrix.ErrNotFound) {
+			return nil, fmt.Errorf("no DCF Trust Bundle with ID or name %q", d.Id())
+		}
+		return nil, fmt.Errorf("failed to find DCF Trust Bundle %q: %w", d.Id(), err)
+	}
+	d.SetId(trustBundle.BundleID)
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAviatrixDCFTrustBundleUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	bundleID := d.Id()
 
@@ -129,7 +152,7 @@ func resourceAviatrixDCFTrustBundleUpdate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceAviatrixDCFTrustBundleDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	bundleID := d.Id()
 
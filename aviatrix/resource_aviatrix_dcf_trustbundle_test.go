@@ -10,10 +10,42 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
 )
 
+func TestDCFTrustBundleImport(t *testing.T) {
+	client := &goaviatrix.ClientInterfaceMock{
+		GetDCFTrustBundleByIDFunc: func(ctx context.Context, bundleUUID string) (*goaviatrix.DCFTrustBundle, error) {
+			if bundleUUID == "bundle-1" {
+				return &goaviatrix.DCFTrustBundle{BundleID: "bundle-1", DisplayName: "corp"}, nil
+			}
+			return nil, goaviatrix.ErrNotFound
+		},
+		GetDCFTrustBundleByNameFunc: func(ctx context.Context, bundleName string) (*goaviatrix.DCFTrustBundle, error) {
+			if bundleName == "corp" {
+				return &goaviatrix.DCFTrustBundle{BundleID: "bundle-1", DisplayName: "corp"}, nil
+			}
+			return nil, goaviatrix.ErrNotFound
+		},
+	}
+	r := resourceAviatrixDCFTrustBundle()
+
+	for _, importID := range []string{"corp", "bundle-1"} {
+		d := r.Data(&terraform.InstanceState{ID: importID})
+		imported, err := r.Importer.StateContext(context.Background(), d, client)
+		require.NoError(t, err)
+		require.Len(t, imported, 1)
+		assert.Equal(t, "bundle-1", imported[0].Id())
+	}
+
+	d := r.Data(&terraform.InstanceState{ID: "other"})
+	_, err := r.Importer.StateContext(context.Background(), d, client)
+	assert.ErrorContains(t, err, `no DCF Trust Bundle with ID or name "other"`)
+}
+
 func TestAccAviatrixDCFTrustBundle_basic(t *testing.T) {
 	skipAcc := os.Getenv("SKIP_DCF_TRUSTBUNDLE")
 	if skipAcc == "yes" {
@@ -38,6 +70,17 @@ func TestAccAviatrixDCFTrustBundle_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "bundle_content", testCertificateContent()),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "test-dcf-trustbundle",
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -1,10 +1,11 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -13,10 +14,10 @@ import (
 
 func resourceAviatrixDeviceInterfaceConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixDeviceInterfaceConfigCreate,
-		Read:   resourceAviatrixDeviceInterfaceConfigRead,
-		Update: resourceAviatrixDeviceInterfaceConfigUpdate,
-		Delete: resourceAviatrixDeviceInterfaceConfigDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixDeviceInterfaceConfigCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixDeviceInterfaceConfigRead),
+		UpdateWithoutTimeout: withContext(resourceAviatrixDeviceInterfaceConfigUpdate),
+		Delete:               resourceAviatrixDeviceInterfaceConfigDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -51,37 +52,37 @@ func marshalDeviceInterfaceConfigInput(d *schema.ResourceData) *goaviatrix.Devic
 	}
 }
 
-func resourceAviatrixDeviceInterfaceConfigCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixDeviceInterfaceConfigCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	config := marshalDeviceInterfaceConfigInput(d)
 
 	d.SetId(config.DeviceName)
 	flag := false
-	defer func() { _ = resourceAviatrixDeviceInterfaceConfigReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixDeviceInterfaceConfigReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	if err := client.ConfigureDeviceInterfaces(config); err != nil {
 		return fmt.Errorf("could not configure device interfaces: %w", err)
 	}
 
-	return resourceAviatrixDeviceInterfaceConfigReadIfRequired(d, meta, &flag)
+	return resourceAviatrixDeviceInterfaceConfigReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixDeviceInterfaceConfigReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixDeviceInterfaceConfigReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixDeviceInterfaceConfigRead(d, meta)
+		return resourceAviatrixDeviceInterfaceConfigRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixDeviceInterfaceConfigRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixDeviceInterfaceConfigRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	name := getString(d, "device_name")
 	if name == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no device_interface_config device_name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no device_interface_config device_name received. Import Id is %s", id))
 		d.SetId(id)
 		name = id
 	}
@@ -102,7 +103,7 @@ func resourceAviatrixDeviceInterfaceConfigRead(d *schema.ResourceData, meta any)
 	return nil
 }
 
-func resourceAviatrixDeviceInterfaceConfigUpdate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixDeviceInterfaceConfigUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	config := marshalDeviceInterfaceConfigInput(d)
@@ -112,7 +113,7 @@ func resourceAviatrixDeviceInterfaceConfigUpdate(d *schema.ResourceData, meta an
 	}
 
 	d.SetId(config.DeviceName)
-	return resourceAviatrixDeviceInterfaceConfigRead(d, meta)
+	return resourceAviatrixDeviceInterfaceConfigRead(ctx, d, meta)
 }
 
 func resourceAviatrixDeviceInterfaceConfigDelete(d *schema.ResourceData, meta any) error {
@@ -47,7 +47,7 @@ func resourceAviatrixDistributedFirewallingDefaultActionRule() *schema.Resource
 }
 
 func resourceAviatrixDistributedFirewallingDefaultActionRuleUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	action := getString(d, "action")
 
@@ -66,12 +66,12 @@ func resourceAviatrixDistributedFirewallingDefaultActionRuleUpdate(ctx context.C
 		return diag.Errorf("failed to update the default action rule: %v", err)
 	}
 
-	d.SetId(strings.ReplaceAll(client.ControllerIP, ".", "-"))
+	d.SetId(strings.ReplaceAll(client.GetControllerIP(), ".", "-"))
 	return resourceAviatrixDistributedFirewallingDefaultActionRuleRead(ctx, d, meta)
 }
 
 func resourceAviatrixDistributedFirewallingDefaultActionRuleCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	action := getString(d, "action")
 
@@ -90,16 +90,16 @@ func resourceAviatrixDistributedFirewallingDefaultActionRuleCreate(ctx context.C
 		return diag.Errorf("failed to update the default action rule: %v", err)
 	}
 
-	d.SetId(strings.ReplaceAll(client.ControllerIP, ".", "-"))
+	d.SetId(strings.ReplaceAll(client.GetControllerIP(), ".", "-"))
 
 	return resourceAviatrixDistributedFirewallingDefaultActionRuleRead(ctx, d, meta)
 }
 
 func resourceAviatrixDistributedFirewallingDefaultActionRuleRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
-	if d.Id() != strings.ReplaceAll(client.ControllerIP, ".", "-") {
-		return diag.Errorf("ID: %s does not match controller IP %q: please provide correct ID for importing", d.Id(), client.ControllerIP)
+	if d.Id() != strings.ReplaceAll(client.GetControllerIP(), ".", "-") {
+		return diag.Errorf("ID: %s does not match controller IP %q: please provide correct ID for importing", d.Id(), client.GetControllerIP())
 	}
 
 	defaultActionRule, err := client.GetDistributedFirewallingDefaultActionRule(ctx)
@@ -126,12 +126,12 @@ func resourceAviatrixDistributedFirewallingDefaultActionRuleRead(ctx context.Con
 		}
 	}
 
-	d.SetId(strings.ReplaceAll(client.ControllerIP, ".", "-"))
+	d.SetId(strings.ReplaceAll(client.GetControllerIP(), ".", "-"))
 	return nil
 }
 
 func resourceAviatrixDistributedFirewallingDefaultActionRuleDelete(ctx context.Context, _ *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	defaultActionRuleConfig := &goaviatrix.DistributedFirewallingDefaultActionRule{
 		Action:     "PERMIT",
@@ -53,7 +53,7 @@ func resourceAviatrixDistributedFirewallingDeploymentPolicy() *schema.Resource {
 
 func resourceAviatrixDistributedFirewallingDeploymentPolicyCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	setDefaults := getBool(d, "set_defaults")
 
@@ -86,7 +86,7 @@ func resourceAviatrixDistributedFirewallingDeploymentPolicyCreate(ctx context.Co
 		return diag.Errorf("failed to create Aviatrix Distributed Firewalling Deployment Policy: %v", err)
 	}
 
-	d.SetId(strings.ReplaceAll(client.ControllerIP, ".", "-"))
+	d.SetId(strings.ReplaceAll(client.GetControllerIP(), ".", "-"))
 	return append(diags, resourceAviatrixDistributedFirewallingDeploymentPolicyRead(ctx, d, meta)...)
 }
 
@@ -105,10 +105,10 @@ func validateDeploymentPolicyInput(providers *schema.Set, setDefaults bool) (dia
 }
 
 func resourceAviatrixDistributedFirewallingDeploymentPolicyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
-	if d.Id() != strings.ReplaceAll(client.ControllerIP, ".", "-") {
-		return diag.Errorf("ID: %s does not match controller IP %q: please provide correct ID for importing", d.Id(), client.ControllerIP)
+	if d.Id() != strings.ReplaceAll(client.GetControllerIP(), ".", "-") {
+		return diag.Errorf("ID: %s does not match controller IP %q: please provide correct ID for importing", d.Id(), client.GetControllerIP())
 	}
 
 	deploymentPolicy, err := client.GetDistributedFirewallingDeploymentPolicy(ctx)
@@ -128,12 +128,12 @@ func resourceAviatrixDistributedFirewallingDeploymentPolicyRead(ctx context.Cont
 		return diag.Errorf("failed to set 'set_defaults': %v", err)
 	}
 
-	d.SetId(strings.ReplaceAll(client.ControllerIP, ".", "-"))
+	d.SetId(strings.ReplaceAll(client.GetControllerIP(), ".", "-"))
 	return nil
 }
 
 func resourceAviatrixDistributedFirewallingDeploymentPolicyDelete(ctx context.Context, _ *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	// These dummy values are required but will be ignored by the API when SetDefaults=true
 	dummyProviders := []string{
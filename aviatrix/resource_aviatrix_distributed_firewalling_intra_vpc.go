@@ -52,7 +52,7 @@ func resourceAviatrixDistributedFirewallingIntraVpc() *schema.Resource {
 }
 
 func resourceAviatrixDistributedFirewallingIntraVpcCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	vpcList, err := marshalDistributedFirewallingIntraVpcListInput(d)
 	if err != nil {
@@ -66,7 +66,7 @@ func resourceAviatrixDistributedFirewallingIntraVpcCreate(ctx context.Context, d
 	if err != nil {
 		return diag.Errorf("failed to create Distributed-firewalling Intra VPC: %s", err)
 	}
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return resourceAviatrixDistributedFirewallingIntraVpcReadIfRequired(ctx, d, meta, &flag)
 }
 
@@ -79,7 +79,7 @@ func resourceAviatrixDistributedFirewallingIntraVpcReadIfRequired(ctx context.Co
 }
 
 func resourceAviatrixDistributedFirewallingIntraVpcRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	vpcList, err := client.GetDistributedFirewallingIntraVpc(ctx)
 	if err != nil {
@@ -104,12 +104,12 @@ func resourceAviatrixDistributedFirewallingIntraVpcRead(ctx context.Context, d *
 		return diag.Errorf("failed to set vpcs during Distributed-firewalling Intra VPC read: %s\n", err)
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return nil
 }
 
 func resourceAviatrixDistributedFirewallingIntraVpcUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	d.Partial(true)
 	if d.HasChange("vpcs") {
@@ -128,7 +128,7 @@ func resourceAviatrixDistributedFirewallingIntraVpcUpdate(ctx context.Context, d
 }
 
 func resourceAviatrixDistributedFirewallingIntraVpcDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	err := client.DeleteDistributedFirewallingIntraVpc(ctx)
 	if err != nil {
@@ -37,7 +37,7 @@ func resourceAviatrixDistributedFirewallingOriginCertEnforcementConfig() *schema
 }
 
 func resourceAviatrixDistributedFirewallingOriginCertEnforcementConfigCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	enforcementLevel := &goaviatrix.EnforcementLevel{
 		Level: getString(d, "enforcement_level"),
@@ -51,7 +51,7 @@ func resourceAviatrixDistributedFirewallingOriginCertEnforcementConfigCreate(ctx
 		return diag.Errorf("failed to config Distributed-firewalling origin cert enforcement level: %s", err)
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return resourceAviatrixDistributedFirewallingOriginCertEnforcementConfigReadIfRequired(ctx, d, meta, &flag)
 }
 
@@ -64,9 +64,9 @@ func resourceAviatrixDistributedFirewallingOriginCertEnforcementConfigReadIfRequ
 }
 
 func resourceAviatrixDistributedFirewallingOriginCertEnforcementConfigRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
-	if d.Id() != strings.Replace(client.ControllerIP, ".", "-", -1) {
+	if d.Id() != strings.Replace(client.GetControllerIP(), ".", "-", -1) {
 		return diag.Errorf("ID: %s does not match controller IP. Please provide correct ID for importing", d.Id())
 	}
 
@@ -87,12 +87,12 @@ func resourceAviatrixDistributedFirewallingOriginCertEnforcementConfigRead(ctx c
 		mustSet(d, "enforcement_level", "Permissive")
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return nil
 }
 
 func resourceAviatrixDistributedFirewallingOriginCertEnforcementConfigUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	d.Partial(true)
 	if d.HasChange("enforcement_level") {
@@ -111,7 +111,7 @@ func resourceAviatrixDistributedFirewallingOriginCertEnforcementConfigUpdate(ctx
 }
 
 func resourceAviatrixDistributedFirewallingOriginCertEnforcementConfigDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	err := client.DeleteEnforcementLevel(ctx)
 	if err != nil {
@@ -261,7 +261,7 @@ func marshalDistributedFirewallingPolicyListInput(d *schema.ResourceData) (*goav
 }
 
 func resourceAviatrixDistributedFirewallingPolicyListCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	policyList, err := marshalDistributedFirewallingPolicyListInput(d)
 	if err != nil {
@@ -275,7 +275,7 @@ func resourceAviatrixDistributedFirewallingPolicyListCreate(ctx context.Context,
 	if err != nil {
 		return diag.Errorf("failed to create Distributed-firewalling Policy List: %s", err)
 	}
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return resourceAviatrixDistributedFirewallingPolicyListReadIfRequired(ctx, d, meta, &flag)
 }
 
@@ -288,7 +288,7 @@ func resourceAviatrixDistributedFirewallingPolicyListReadIfRequired(ctx context.
 }
 
 func resourceAviatrixDistributedFirewallingPolicyListRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	policyList, err := client.GetDistributedFirewallingPolicyList(ctx)
 	if err != nil {
@@ -347,12 +347,12 @@ func resourceAviatrixDistributedFirewallingPolicyListRead(ctx context.Context, d
 		return diag.Errorf("failed to set policies during Distributed-firewalling Policy List read: %s\n", err)
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return nil
 }
 
 func resourceAviatrixDistributedFirewallingPolicyListUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	d.Partial(true)
 	if d.HasChange("policies") {
@@ -401,7 +401,7 @@ func resourceAviatrixDistributedFirewallingPolicyListCustomizeDiff(_ context.Con
 }
 
 func resourceAviatrixDistributedFirewallingPolicyListDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	err := client.DeleteDistributedFirewallingPolicyList(ctx)
 	if err != nil {
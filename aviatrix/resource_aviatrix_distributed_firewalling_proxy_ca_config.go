@@ -66,7 +66,7 @@ func resourceAviatrixDistributedFirewallingProxyCaConfig() *schema.Resource {
 }
 
 func resourceAviatrixDistributedFirewallingProxyCaConfigCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	proxyCaConfig := &goaviatrix.ProxyCaConfig{
 		CaCert: getString(d, "ca_cert"),
@@ -77,14 +77,14 @@ func resourceAviatrixDistributedFirewallingProxyCaConfigCreate(ctx context.Conte
 		return diag.Errorf("failed to set new Distributed-firewalling proxy ca certificate: %v", err)
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return resourceAviatrixDistributedFirewallingProxyCaConfigRead(ctx, d, meta)
 }
 
 func resourceAviatrixDistributedFirewallingProxyCaConfigRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
-	if d.Id() != strings.Replace(client.ControllerIP, ".", "-", -1) {
+	if d.Id() != strings.Replace(client.GetControllerIP(), ".", "-", -1) {
 		return diag.Errorf("ID: %s does not match controller IP. Please provide correct ID for importing", d.Id())
 	}
 
@@ -107,12 +107,12 @@ func resourceAviatrixDistributedFirewallingProxyCaConfigRead(ctx context.Context
 		mustSet(d, "upload_info", proxyCaCertInstance.UploadInfo)
 	}
 
-	d.SetId(strings.Replace(client.ControllerIP, ".", "-", -1))
+	d.SetId(strings.Replace(client.GetControllerIP(), ".", "-", -1))
 	return nil
 }
 
 func resourceAviatrixDistributedFirewallingProxyCaConfigDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	err := client.DeleteCaCertificate(ctx)
 	if err != nil {
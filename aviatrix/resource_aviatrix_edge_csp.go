@@ -4,10 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -19,14 +19,16 @@ import (
 
 func resourceAviatrixEdgeCSP() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeCSPCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeCSPRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeCSPUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeCSPDelete,
+		CreateContext:      resourceAviatrixEdgeCSPCreate,
+		ReadWithoutTimeout: resourceAviatrixEdgeCSPRead,
+		UpdateContext:      resourceAviatrixEdgeCSPUpdate,
+		DeleteContext:      resourceAviatrixEdgeCSPDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: gatewayTimeouts(),
+
 		Schema: map[string]*schema.Schema{
 			"account_name": {
 				Type:        schema.TypeString,
@@ -100,7 +102,7 @@ func resourceAviatrixEdgeCSP() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				Description:  "Local AS number.",
-				ValidateFunc: goaviatrix.ValidateASN,
+				ValidateFunc: ValidateASN,
 			},
 			"prepend_as_path": {
 				Type:        schema.TypeList,
@@ -108,7 +110,7 @@ func resourceAviatrixEdgeCSP() *schema.Resource {
 				Description: "List of AS numbers to prepend gateway BGP AS_Path field.",
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: goaviatrix.ValidateASN,
+					ValidateFunc: ValidateASN,
 				},
 				MaxItems: 25,
 			},
@@ -193,17 +195,17 @@ func resourceAviatrixEdgeCSP() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				ValidateFunc:     goaviatrix.ValidateEdgeSpokeLatitude,
+				ValidateFunc:     ValidateEdgeSpokeLatitude,
 				Description:      "The latitude of the Edge as a Spoke.",
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncEdgeSpokeCoordinate,
+				DiffSuppressFunc: DiffSuppressFuncEdgeSpokeCoordinate,
 			},
 			"longitude": {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				ValidateFunc:     goaviatrix.ValidateEdgeSpokeLongitude,
+				ValidateFunc:     ValidateEdgeSpokeLongitude,
 				Description:      "The longitude of the Edge as a Spoke.",
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncEdgeSpokeCoordinate,
+				DiffSuppressFunc: DiffSuppressFuncEdgeSpokeCoordinate,
 			},
 			"rx_queue_size": {
 				Type:         schema.TypeString,
@@ -486,7 +488,7 @@ func marshalEdgeCSPInput(d *schema.ResourceData) *goaviatrix.EdgeCSP {
 }
 
 func resourceAviatrixEdgeCSPCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	// read configs
 	edgeCSP := marshalEdgeCSPInput(d)
@@ -668,12 +670,12 @@ func resourceAviatrixEdgeCSPReadIfRequired(ctx context.Context, d *schema.Resour
 }
 
 func resourceAviatrixEdgeCSPRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	// handle import
 	if getString(d, "gw_name") == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no name received. Import Id is %s", id))
 		mustSet(d, "gw_name", id)
 		d.SetId(id)
 	}
@@ -802,7 +804,7 @@ func resourceAviatrixEdgeCSPRead(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceAviatrixEdgeCSPUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	// read configs
 	edgeCSP := marshalEdgeCSPInput(d)
@@ -1015,7 +1017,7 @@ func resourceAviatrixEdgeCSPUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAviatrixEdgeCSPDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	accountName := getString(d, "account_name")
 	gwName := getString(d, "gw_name")
@@ -3,9 +3,10 @@ package aviatrix
 import (
 	"context"
 	"errors"
-	"log"
+	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,14 +16,16 @@ import (
 
 func resourceAviatrixEdgeCSPHa() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeCSPHaCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeCSPHaRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeCSPHaUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeCSPHaDelete,
+		CreateContext:      resourceAviatrixEdgeCSPHaCreate,
+		ReadWithoutTimeout: resourceAviatrixEdgeCSPHaRead,
+		UpdateContext:      resourceAviatrixEdgeCSPHaUpdate,
+		DeleteContext:      resourceAviatrixEdgeCSPHaDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: gatewayTimeouts(),
+
 		Schema: map[string]*schema.Schema{
 			"primary_gw_name": {
 				Type:        schema.TypeString,
@@ -153,7 +156,7 @@ func marshalEdgeCSPHaInput(d *schema.ResourceData) *goaviatrix.EdgeCSPHa {
 }
 
 func resourceAviatrixEdgeCSPHaCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	edgeCSPHa := marshalEdgeCSPHaInput(d)
 
@@ -167,17 +170,17 @@ func resourceAviatrixEdgeCSPHaCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceAviatrixEdgeCSPHaRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	if getString(d, "primary_gw_name") == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
-		parts := strings.Split(id, "-hagw")
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import. Import Id is %s", id))
+		parts := strings.Split(id, goaviatrix.HaGatewaySuffix)
 		mustSet(d, "primary_gw_name", parts[0])
 		d.SetId(id)
 	}
 
-	edgeCSPHaResp, err := client.GetEdgeCSPHa(ctx, getString(d, "primary_gw_name")+"-hagw")
+	edgeCSPHaResp, err := client.GetEdgeCSPHa(ctx, goaviatrix.HaGatewayName(getString(d, "primary_gw_name")))
 	if err != nil {
 		if errors.Is(err, goaviatrix.ErrNotFound) {
 			d.SetId("")
@@ -220,7 +223,7 @@ func resourceAviatrixEdgeCSPHaRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAviatrixEdgeCSPHaUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	edgeCSPHa := marshalEdgeCSPHaInput(d)
 
@@ -245,7 +248,7 @@ func resourceAviatrixEdgeCSPHaUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceAviatrixEdgeCSPHaDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	accountName := getString(d, "account_name")
 
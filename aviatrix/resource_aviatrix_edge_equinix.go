@@ -4,11 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"os"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -20,14 +20,16 @@ import (
 
 func resourceAviatrixEdgeEquinix() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeEquinixCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeEquinixRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeEquinixUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeEquinixDelete,
+		CreateContext:      resourceAviatrixEdgeEquinixCreate,
+		ReadWithoutTimeout: resourceAviatrixEdgeEquinixRead,
+		UpdateContext:      resourceAviatrixEdgeEquinixUpdate,
+		DeleteContext:      resourceAviatrixEdgeEquinixDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: gatewayTimeouts(),
+
 		Schema: map[string]*schema.Schema{
 			"account_name": {
 				Type:        schema.TypeString,
@@ -92,7 +94,7 @@ func resourceAviatrixEdgeEquinix() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				Description:  "Local AS number.",
-				ValidateFunc: goaviatrix.ValidateASN,
+				ValidateFunc: ValidateASN,
 			},
 			"prepend_as_path": {
 				Type:        schema.TypeList,
@@ -100,7 +102,7 @@ func resourceAviatrixEdgeEquinix() *schema.Resource {
 				Description: "List of AS numbers to prepend gateway BGP AS_Path field.",
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: goaviatrix.ValidateASN,
+					ValidateFunc: ValidateASN,
 				},
 				MaxItems: 25,
 			},
@@ -185,17 +187,17 @@ func resourceAviatrixEdgeEquinix() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				ValidateFunc:     goaviatrix.ValidateEdgeSpokeLatitude,
+				ValidateFunc:     ValidateEdgeSpokeLatitude,
 				Description:      "The latitude of the Edge as a Spoke.",
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncEdgeSpokeCoordinate,
+				DiffSuppressFunc: DiffSuppressFuncEdgeSpokeCoordinate,
 			},
 			"longitude": {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				ValidateFunc:     goaviatrix.ValidateEdgeSpokeLongitude,
+				ValidateFunc:     ValidateEdgeSpokeLongitude,
 				Description:      "The longitude of the Edge as a Spoke.",
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncEdgeSpokeCoordinate,
+				DiffSuppressFunc: DiffSuppressFuncEdgeSpokeCoordinate,
 			},
 			"rx_queue_size": {
 				Type:         schema.TypeString,
@@ -484,7 +486,7 @@ func marshalEdgeEquinixInput(d *schema.ResourceData) *goaviatrix.EdgeEquinix {
 }
 
 func resourceAviatrixEdgeEquinixCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	// read configs
 	edgeEquinix := marshalEdgeEquinixInput(d)
@@ -652,7 +654,7 @@ func resourceAviatrixEdgeEquinixCreate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	// set the advertised spoke cidr routes
-	err := editAdvertisedSpokeRoutesWithRetry(client, gatewayForGatewayFunctions, d)
+	err := editAdvertisedSpokeRoutesWithRetry(ctx, client, gatewayForGatewayFunctions, d)
 	if err != nil {
 		return diag.Errorf("failed to edit advertised spoke vpc routes of spoke gateway: %q: %s", gatewayForGatewayFunctions.GwName, err)
 	}
@@ -669,12 +671,12 @@ func resourceAviatrixEdgeEquinixReadIfRequired(ctx context.Context, d *schema.Re
 }
 
 func resourceAviatrixEdgeEquinixRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	// handle import
 	if getString(d, "gw_name") == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no name received. Import Id is %s", id))
 		mustSet(d, "gw_name", id)
 		d.SetId(id)
 	}
@@ -803,7 +805,7 @@ func resourceAviatrixEdgeEquinixRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceAviatrixEdgeEquinixUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	// read configs
 	edgeEquinix := marshalEdgeEquinixInput(d)
@@ -976,7 +978,7 @@ func resourceAviatrixEdgeEquinixUpdate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	if d.HasChange("included_advertised_spoke_routes") {
-		err := editAdvertisedSpokeRoutesWithRetry(client, gatewayForGatewayFunctions, d)
+		err := editAdvertisedSpokeRoutesWithRetry(ctx, client, gatewayForGatewayFunctions, d)
 		if err != nil {
 			return diag.Errorf("could not update included advertised spoke routes during Edge Equinix Gateway update: %v", err)
 		}
@@ -1023,7 +1025,7 @@ func resourceAviatrixEdgeEquinixUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceAviatrixEdgeEquinixDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	accountName := getString(d, "account_name")
 	gwName := getString(d, "gw_name")
@@ -1039,7 +1041,7 @@ func resourceAviatrixEdgeEquinixDelete(ctx context.Context, d *schema.ResourceDa
 
 	err = os.Remove(fileName)
 	if err != nil {
-		log.Printf("[WARN] could not remove the ztp file: %v", err)
+		tflog.Warn(ctx, fmt.Sprintf("could not remove the ztp file: %v", err))
 	}
 
 	return nil
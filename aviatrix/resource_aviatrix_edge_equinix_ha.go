@@ -3,10 +3,11 @@ package aviatrix
 import (
 	"context"
 	"errors"
-	"log"
+	"fmt"
 	"os"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,14 +17,16 @@ import (
 
 func resourceAviatrixEdgeEquinixHa() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeEquinixHaCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeEquinixHaRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeEquinixHaUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeEquinixHaDelete,
+		CreateContext:      resourceAviatrixEdgeEquinixHaCreate,
+		ReadWithoutTimeout: resourceAviatrixEdgeEquinixHaRead,
+		UpdateContext:      resourceAviatrixEdgeEquinixHaUpdate,
+		DeleteContext:      resourceAviatrixEdgeEquinixHaDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: gatewayTimeouts(),
+
 		Schema: map[string]*schema.Schema{
 			"primary_gw_name": {
 				Type:        schema.TypeString,
@@ -181,7 +184,7 @@ func marshalEdgeEquinixHaInput(d *schema.ResourceData) *goaviatrix.EdgeEquinixHa
 }
 
 func resourceAviatrixEdgeEquinixHaCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	edgeEquinixHa := marshalEdgeEquinixHaInput(d)
 
@@ -195,17 +198,17 @@ func resourceAviatrixEdgeEquinixHaCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceAviatrixEdgeEquinixHaRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	if getString(d, "primary_gw_name") == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
-		parts := strings.Split(id, "-hagw")
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import. Import Id is %s", id))
+		parts := strings.Split(id, goaviatrix.HaGatewaySuffix)
 		mustSet(d, "primary_gw_name", parts[0])
 		d.SetId(id)
 	}
 
-	edgeEquinixHaResp, err := client.GetEdgeEquinixHa(ctx, getString(d, "primary_gw_name")+"-hagw")
+	edgeEquinixHaResp, err := client.GetEdgeEquinixHa(ctx, goaviatrix.HaGatewayName(getString(d, "primary_gw_name")))
 	if err != nil {
 		if errors.Is(err, goaviatrix.ErrNotFound) {
 			d.SetId("")
@@ -249,7 +252,7 @@ func resourceAviatrixEdgeEquinixHaRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceAviatrixEdgeEquinixHaUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	edgeEquinixHa := marshalEdgeEquinixHaInput(d)
 
@@ -274,7 +277,7 @@ func resourceAviatrixEdgeEquinixHaUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceAviatrixEdgeEquinixHaDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	edgeEquinixHa := marshalEdgeEquinixHaInput(d)
 	accountName := getString(d, "account_name")
@@ -284,11 +287,11 @@ func resourceAviatrixEdgeEquinixHaDelete(ctx context.Context, d *schema.Resource
 		return diag.Errorf("could not delete Edge Equinix HA: %v", err)
 	}
 
-	fileName := edgeEquinixHa.ZtpFileDownloadPath + "/" + edgeEquinixHa.PrimaryGwName + "-hagw-cloud-init.txt"
+	fileName := edgeEquinixHa.ZtpFileDownloadPath + "/" + goaviatrix.HaGatewayName(edgeEquinixHa.PrimaryGwName) + "-cloud-init.txt"
 
 	err = os.Remove(fileName)
 	if err != nil {
-		log.Printf("[WARN] could not remove the ztp file: %v", err)
+		tflog.Warn(ctx, fmt.Sprintf("could not remove the ztp file: %v", err))
 	}
 
 	return nil
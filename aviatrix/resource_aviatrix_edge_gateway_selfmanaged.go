@@ -4,13 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"os"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -21,14 +21,16 @@ import (
 
 func resourceAviatrixEdgeGatewaySelfmanaged() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeGatewaySelfmanagedCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeGatewaySelfmanagedRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeGatewaySelfmanagedUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeGatewaySelfmanagedDelete,
+		CreateContext:      resourceAviatrixEdgeGatewaySelfmanagedCreate,
+		ReadWithoutTimeout: resourceAviatrixEdgeGatewaySelfmanagedRead,
+		UpdateContext:      resourceAviatrixEdgeGatewaySelfmanagedUpdate,
+		DeleteContext:      resourceAviatrixEdgeGatewaySelfmanagedDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: gatewayTimeouts(),
+
 		Schema: map[string]*schema.Schema{
 			"gw_name": {
 				Type:        schema.TypeString,
@@ -94,7 +96,7 @@ func resourceAviatrixEdgeGatewaySelfmanaged() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				Description:  "Local AS number.",
-				ValidateFunc: goaviatrix.ValidateASN,
+				ValidateFunc: ValidateASN,
 			},
 			"prepend_as_path": {
 				Type:        schema.TypeList,
@@ -102,7 +104,7 @@ func resourceAviatrixEdgeGatewaySelfmanaged() *schema.Resource {
 				Description: "List of AS numbers to prepend gateway BGP AS_Path field.",
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: goaviatrix.ValidateASN,
+					ValidateFunc: ValidateASN,
 				},
 				MaxItems: 25,
 			},
@@ -187,17 +189,17 @@ func resourceAviatrixEdgeGatewaySelfmanaged() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				ValidateFunc:     goaviatrix.ValidateEdgeSpokeLatitude,
+				ValidateFunc:     ValidateEdgeSpokeLatitude,
 				Description:      "The latitude of the Edge as a Spoke.",
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncEdgeSpokeCoordinate,
+				DiffSuppressFunc: DiffSuppressFuncEdgeSpokeCoordinate,
 			},
 			"longitude": {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				ValidateFunc:     goaviatrix.ValidateEdgeSpokeLongitude,
+				ValidateFunc:     ValidateEdgeSpokeLongitude,
 				Description:      "The longitude of the Edge as a Spoke.",
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncEdgeSpokeCoordinate,
+				DiffSuppressFunc: DiffSuppressFuncEdgeSpokeCoordinate,
 			},
 			"rx_queue_size": {
 				Type:         schema.TypeString,
@@ -418,7 +420,7 @@ func marshalEdgeGatewaySelfmanagedInput(d *schema.ResourceData) (*goaviatrix.Edg
 }
 
 func resourceAviatrixEdgeGatewaySelfmanagedCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	// read configs
 	edgeSpoke, err := marshalEdgeGatewaySelfmanagedInput(d)
@@ -569,7 +571,7 @@ func resourceAviatrixEdgeGatewaySelfmanagedCreate(ctx context.Context, d *schema
 	}
 
 	// set the advertised spoke cidr routes
-	err = editAdvertisedSpokeRoutesWithRetry(client, gatewayForGatewayFunctions, d)
+	err = editAdvertisedSpokeRoutesWithRetry(ctx, client, gatewayForGatewayFunctions, d)
 	if err != nil {
 		return diag.Errorf("failed to edit advertised spoke vpc routes of spoke gateway: %q: %s", gatewayForGatewayFunctions.GwName, err)
 	}
@@ -586,12 +588,12 @@ func resourceAviatrixEdgeGatewaySelfmanagedReadIfRequired(ctx context.Context, d
 }
 
 func resourceAviatrixEdgeGatewaySelfmanagedRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	// handle import
 	if getString(d, "gw_name") == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no name received. Import Id is %s", id))
 		mustSet(d, "gw_name", id)
 		d.SetId(id)
 	}
@@ -729,7 +731,7 @@ func resourceAviatrixEdgeGatewaySelfmanagedRead(ctx context.Context, d *schema.R
 }
 
 func resourceAviatrixEdgeGatewaySelfmanagedUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	// read configs
 	edgeSpoke, err := marshalEdgeGatewaySelfmanagedInput(d)
@@ -814,7 +816,7 @@ func resourceAviatrixEdgeGatewaySelfmanagedUpdate(ctx context.Context, d *schema
 	}
 
 	if d.HasChange("included_advertised_spoke_routes") {
-		err := editAdvertisedSpokeRoutesWithRetry(client, gatewayForGatewayFunctions, d)
+		err := editAdvertisedSpokeRoutesWithRetry(ctx, client, gatewayForGatewayFunctions, d)
 		if err != nil {
 			return diag.Errorf("could not update included advertised spoke routes during Edge Gateway Selfmanaged update: %v", err)
 		}
@@ -940,7 +942,7 @@ func resourceAviatrixEdgeGatewaySelfmanagedUpdate(ctx context.Context, d *schema
 }
 
 func resourceAviatrixEdgeGatewaySelfmanagedDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	gwName := getString(d, "gw_name")
 	siteId := getString(d, "site_id")
@@ -962,13 +964,13 @@ func resourceAviatrixEdgeGatewaySelfmanagedDelete(ctx context.Context, d *schema
 
 	err = os.Remove(fileName)
 	if err != nil {
-		log.Printf("[WARN] could not remove the ztp file: %v", err)
+		tflog.Warn(ctx, fmt.Sprintf("could not remove the ztp file: %v", err))
 	}
 
 	return nil
 }
 
-func editAdvertisedSpokeRoutesWithRetry(client *goaviatrix.Client, gatewayForGatewayFunctions *goaviatrix.Gateway, d *schema.ResourceData) error {
+func editAdvertisedSpokeRoutesWithRetry(ctx context.Context, client goaviatrix.EdgeClient, gatewayForGatewayFunctions *goaviatrix.Gateway, d *schema.ResourceData) error {
 	const maxRetries = 30
 	const retryDelay = 10 * time.Second
 
@@ -983,7 +985,7 @@ func editAdvertisedSpokeRoutesWithRetry(client *goaviatrix.Client, gatewayForGat
 	gatewayForGatewayFunctions.AdvertisedSpokeRoutes = includedAdvertisedSpokeRoutes
 	avxerrRegex := regexp.MustCompile(`AVXERR-[A-Z0-9-]+`)
 	for i := 0; ; i++ {
-		log.Printf("[INFO] Editing customized routes advertisement of spoke gateway %q", gatewayForGatewayFunctions.GwName)
+		tflog.Info(ctx, fmt.Sprintf("Editing customized routes advertisement of spoke gateway %q", gatewayForGatewayFunctions.GwName))
 		err := client.EditGatewayAdvertisedCidr(gatewayForGatewayFunctions)
 		if err == nil {
 			break
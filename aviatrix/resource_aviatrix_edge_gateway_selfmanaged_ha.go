@@ -3,10 +3,11 @@ package aviatrix
 import (
 	"context"
 	"errors"
-	"log"
+	"fmt"
 	"os"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -18,14 +19,16 @@ import (
 
 func resourceAviatrixEdgeGatewaySelfmanagedHa() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeGatewaySelfmanagedHaCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeGatewaySelfmanagedHaRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeGatewaySelfmanagedHaUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeGatewaySelfmanagedHaDelete,
+		CreateContext:      resourceAviatrixEdgeGatewaySelfmanagedHaCreate,
+		ReadWithoutTimeout: resourceAviatrixEdgeGatewaySelfmanagedHaRead,
+		UpdateContext:      resourceAviatrixEdgeGatewaySelfmanagedHaUpdate,
+		DeleteContext:      resourceAviatrixEdgeGatewaySelfmanagedHaDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: gatewayTimeouts(),
+
 		Schema: map[string]*schema.Schema{
 			"primary_gw_name": {
 				Type:        schema.TypeString,
@@ -185,7 +188,7 @@ func marshalEdgeGatewaySelfmanagedHaInput(d *schema.ResourceData) *goaviatrix.Ed
 }
 
 func resourceAviatrixEdgeGatewaySelfmanagedHaCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	edgeGatewaySelfmanagedHa := marshalEdgeGatewaySelfmanagedHaInput(d)
 
@@ -199,17 +202,17 @@ func resourceAviatrixEdgeGatewaySelfmanagedHaCreate(ctx context.Context, d *sche
 }
 
 func resourceAviatrixEdgeGatewaySelfmanagedHaRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	if getString(d, "primary_gw_name") == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
-		parts := strings.Split(id, "-hagw")
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import. Import Id is %s", id))
+		parts := strings.Split(id, goaviatrix.HaGatewaySuffix)
 		mustSet(d, "primary_gw_name", parts[0])
 		d.SetId(id)
 	}
 
-	edgeGatewaySelfmanagedHaResp, err := client.GetEdgeVmSelfmanagedHa(ctx, getString(d, "primary_gw_name")+"-hagw")
+	edgeGatewaySelfmanagedHaResp, err := client.GetEdgeVmSelfmanagedHa(ctx, goaviatrix.HaGatewayName(getString(d, "primary_gw_name")))
 	if err != nil {
 		if errors.Is(err, goaviatrix.ErrNotFound) {
 			d.SetId("")
@@ -263,7 +266,7 @@ func resourceAviatrixEdgeGatewaySelfmanagedHaRead(ctx context.Context, d *schema
 }
 
 func resourceAviatrixEdgeGatewaySelfmanagedHaUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	edgeGatewaySelfmanagedHa := marshalEdgeGatewaySelfmanagedHaInput(d)
 
@@ -288,7 +291,7 @@ func resourceAviatrixEdgeGatewaySelfmanagedHaUpdate(ctx context.Context, d *sche
 }
 
 func resourceAviatrixEdgeGatewaySelfmanagedHaDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	err := client.DeleteEdgeSpoke(ctx, d.Id())
 	if err != nil {
@@ -306,7 +309,7 @@ func resourceAviatrixEdgeGatewaySelfmanagedHaDelete(ctx context.Context, d *sche
 
 	err = os.Remove(fileName)
 	if err != nil {
-		log.Printf("[WARN] could not remove the ztp file: %v", err)
+		tflog.Warn(ctx, fmt.Sprintf("could not remove the ztp file: %v", err))
 	}
 
 	return nil
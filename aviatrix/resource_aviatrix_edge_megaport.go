@@ -5,12 +5,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
 	"os"
 	"regexp"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -20,14 +20,16 @@ import (
 
 func resourceAviatrixEdgeMegaport() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeMegaportCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeMegaportRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeMegaportUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeMegaportDelete,
+		CreateContext:      resourceAviatrixEdgeMegaportCreate,
+		ReadWithoutTimeout: resourceAviatrixEdgeMegaportRead,
+		UpdateContext:      resourceAviatrixEdgeMegaportUpdate,
+		DeleteContext:      resourceAviatrixEdgeMegaportDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: gatewayTimeouts(),
+
 		Schema: map[string]*schema.Schema{
 			"account_name": {
 				Type:        schema.TypeString,
@@ -92,7 +94,7 @@ func resourceAviatrixEdgeMegaport() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				Description:  "Local AS number.",
-				ValidateFunc: goaviatrix.ValidateASN,
+				ValidateFunc: ValidateASN,
 			},
 			"prepend_as_path": {
 				Type:        schema.TypeList,
@@ -100,7 +102,7 @@ func resourceAviatrixEdgeMegaport() *schema.Resource {
 				Description: "List of AS numbers to prepend gateway BGP AS_Path field.",
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: goaviatrix.ValidateASN,
+					ValidateFunc: ValidateASN,
 				},
 				MaxItems: 25,
 			},
@@ -182,17 +184,17 @@ func resourceAviatrixEdgeMegaport() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				ValidateFunc:     goaviatrix.ValidateEdgeSpokeLatitude,
+				ValidateFunc:     ValidateEdgeSpokeLatitude,
 				Description:      "The latitude of the Edge as a Spoke.",
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncEdgeSpokeCoordinate,
+				DiffSuppressFunc: DiffSuppressFuncEdgeSpokeCoordinate,
 			},
 			"longitude": {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				ValidateFunc:     goaviatrix.ValidateEdgeSpokeLongitude,
+				ValidateFunc:     ValidateEdgeSpokeLongitude,
 				Description:      "The longitude of the Edge as a Spoke.",
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncEdgeSpokeCoordinate,
+				DiffSuppressFunc: DiffSuppressFuncEdgeSpokeCoordinate,
 			},
 			"rx_queue_size": {
 				Type:         schema.TypeString,
@@ -486,7 +488,7 @@ func marshalEdgeMegaportInput(d *schema.ResourceData) (*goaviatrix.EdgeMegaport,
 }
 
 func resourceAviatrixEdgeMegaportCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	// read configs
 	edgeMegaport, err := marshalEdgeMegaportInput(d)
@@ -656,7 +658,7 @@ func resourceAviatrixEdgeMegaportCreate(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
-	err = editAdvertisedSpokeRoutesWithRetry(client, gatewayForGatewayFunctions, d)
+	err = editAdvertisedSpokeRoutesWithRetry(ctx, client, gatewayForGatewayFunctions, d)
 	if err != nil {
 		return diag.Errorf("failed to edit advertised spoke vpc routes of spoke gateway: %q: %s", gatewayForGatewayFunctions.GwName, err)
 	}
@@ -673,12 +675,12 @@ func resourceAviatrixEdgeMegaportReadIfRequired(ctx context.Context, d *schema.R
 }
 
 func resourceAviatrixEdgeMegaportRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	// handle import
 	if getString(d, "gw_name") == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no name received. Import Id is %s", id))
 		_ = d.Set("gw_name", id)
 		d.SetId(id)
 	}
@@ -719,7 +721,7 @@ func resourceAviatrixEdgeMegaportRead(ctx context.Context, d *schema.ResourceDat
 
 	for key, value := range edgeMegaportFields {
 		if err := d.Set(key, value); err != nil {
-			log.Printf("[WARN] Failed to set %s: %v", key, err)
+			tflog.Warn(ctx, fmt.Sprintf("Failed to set %s: %v", key, err))
 		}
 	}
 
@@ -850,7 +852,7 @@ func resourceAviatrixEdgeMegaportRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceAviatrixEdgeMegaportUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	// read configs
 	edgeMegaport, err := marshalEdgeMegaportInput(d)
@@ -982,7 +984,7 @@ func resourceAviatrixEdgeMegaportUpdate(ctx context.Context, d *schema.ResourceD
 	}
 
 	if d.HasChange("included_advertised_spoke_routes") {
-		err := editAdvertisedSpokeRoutesWithRetry(client, gatewayForGatewayFunctions, d)
+		err := editAdvertisedSpokeRoutesWithRetry(ctx, client, gatewayForGatewayFunctions, d)
 		if err != nil {
 			return diag.Errorf("could not update included advertised spoke routes during Edge Megaport Gateway update: %v", err)
 		}
@@ -1077,7 +1079,7 @@ func resourceAviatrixEdgeMegaportUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceAviatrixEdgeMegaportDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 	accountName := getString(d, "account_name")
 	gwName := getString(d, "gw_name")
 	siteId := getString(d, "site_id")
@@ -1091,7 +1093,7 @@ func resourceAviatrixEdgeMegaportDelete(ctx context.Context, d *schema.ResourceD
 	fileName := ztpFileDownloadPath + "/" + gwName + "-" + siteId + "-cloud-init.txt"
 	err = os.Remove(fileName)
 	if err != nil {
-		log.Printf("[WARN] could not remove the ztp file: %v", err)
+		tflog.Warn(ctx, fmt.Sprintf("could not remove the ztp file: %v", err))
 	}
 
 	return nil
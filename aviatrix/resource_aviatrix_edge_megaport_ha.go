@@ -4,11 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"os"
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,14 +19,16 @@ import (
 
 func resourceAviatrixEdgeMegaportHa() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeMegaportHaCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeMegaportHaRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeMegaportHaUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeMegaportHaDelete,
+		CreateContext:      resourceAviatrixEdgeMegaportHaCreate,
+		ReadWithoutTimeout: resourceAviatrixEdgeMegaportHaRead,
+		UpdateContext:      resourceAviatrixEdgeMegaportHaUpdate,
+		DeleteContext:      resourceAviatrixEdgeMegaportHaDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: gatewayTimeouts(),
+
 		Schema: map[string]*schema.Schema{
 			"primary_gw_name": {
 				Type:        schema.TypeString,
@@ -200,7 +202,7 @@ func assignInterfaceFields(interface1 map[string]any, interface2 *goaviatrix.Edg
 }
 
 func resourceAviatrixEdgeMegaportHaCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	edgeMegaportHa, err := marshalEdgeMegaportHaInput(d)
 	if err != nil {
@@ -217,17 +219,17 @@ func resourceAviatrixEdgeMegaportHaCreate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceAviatrixEdgeMegaportHaRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	if primaryGwName := getString(d, "primary_gw_name"); primaryGwName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
-		parts := strings.Split(id, "-hagw")
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import. Import Id is %s", id))
+		parts := strings.Split(id, goaviatrix.HaGatewaySuffix)
 		_ = d.Set("primary_gw_name", parts[0])
 		d.SetId(id)
 	}
 
-	edgeMegaportHaResp, err := client.GetEdgeMegaportHa(ctx, getString(d, "primary_gw_name")+"-hagw")
+	edgeMegaportHaResp, err := client.GetEdgeMegaportHa(ctx, goaviatrix.HaGatewayName(getString(d, "primary_gw_name")))
 	if err != nil {
 		if errors.Is(err, goaviatrix.ErrNotFound) {
 			d.SetId("")
@@ -269,7 +271,7 @@ func resourceAviatrixEdgeMegaportHaRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceAviatrixEdgeMegaportHaUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	edgeMegaportHa, err := marshalEdgeMegaportHaInput(d)
 	if err != nil {
@@ -297,7 +299,7 @@ func resourceAviatrixEdgeMegaportHaUpdate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceAviatrixEdgeMegaportHaDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 	accountName := getString(d, "account_name")
 	ztpFileDownloadPath := getString(d, "ztp_file_download_path")
 	primaryGwName := getString(d, "primary_gw_name")
@@ -308,9 +310,9 @@ func resourceAviatrixEdgeMegaportHaDelete(ctx context.Context, d *schema.Resourc
 	}
 
 	if ztpFileDownloadPath != "" && primaryGwName != "" {
-		fileName := ztpFileDownloadPath + "/" + primaryGwName + "-hagw-cloud-init.txt"
+		fileName := ztpFileDownloadPath + "/" + goaviatrix.HaGatewayName(primaryGwName) + "-cloud-init.txt"
 		if err := os.Remove(fileName); err != nil {
-			log.Printf("[WARN] could not remove the ztp file: %v", err)
+			tflog.Warn(ctx, fmt.Sprintf("could not remove the ztp file: %v", err))
 		}
 	}
 
@@ -4,10 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -19,14 +19,16 @@ import (
 
 func resourceAviatrixEdgeNEO() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeNEOCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeNEORead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeNEOUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeNEODelete,
+		CreateContext:      resourceAviatrixEdgeNEOCreate,
+		ReadWithoutTimeout: resourceAviatrixEdgeNEORead,
+		UpdateContext:      resourceAviatrixEdgeNEOUpdate,
+		DeleteContext:      resourceAviatrixEdgeNEODelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: gatewayTimeouts(),
+
 		Schema: map[string]*schema.Schema{
 			"account_name": {
 				Type:        schema.TypeString,
@@ -94,7 +96,7 @@ func resourceAviatrixEdgeNEO() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				Description:  "Local AS number.",
-				ValidateFunc: goaviatrix.ValidateASN,
+				ValidateFunc: ValidateASN,
 			},
 			"prepend_as_path": {
 				Type:        schema.TypeList,
@@ -102,7 +104,7 @@ func resourceAviatrixEdgeNEO() *schema.Resource {
 				Description: "List of AS numbers to prepend gateway BGP AS_Path field.",
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: goaviatrix.ValidateASN,
+					ValidateFunc: ValidateASN,
 				},
 				MaxItems: 25,
 			},
@@ -177,17 +179,17 @@ func resourceAviatrixEdgeNEO() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				ValidateFunc:     goaviatrix.ValidateEdgeSpokeLatitude,
+				ValidateFunc:     ValidateEdgeSpokeLatitude,
 				Description:      "The latitude of the Edge as a Spoke.",
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncEdgeSpokeCoordinate,
+				DiffSuppressFunc: DiffSuppressFuncEdgeSpokeCoordinate,
 			},
 			"longitude": {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				ValidateFunc:     goaviatrix.ValidateEdgeSpokeLongitude,
+				ValidateFunc:     ValidateEdgeSpokeLongitude,
 				Description:      "The longitude of the Edge as a Spoke.",
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncEdgeSpokeCoordinate,
+				DiffSuppressFunc: DiffSuppressFuncEdgeSpokeCoordinate,
 			},
 			"rx_queue_size": {
 				Type:         schema.TypeString,
@@ -468,7 +470,7 @@ func marshalEdgeNEOInput(d *schema.ResourceData) *goaviatrix.EdgeNEO {
 }
 
 func resourceAviatrixEdgeNEOCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	// read configs
 	edgeNEO := marshalEdgeNEOInput(d)
@@ -650,12 +652,12 @@ func resourceAviatrixEdgeNEOReadIfRequired(ctx context.Context, d *schema.Resour
 }
 
 func resourceAviatrixEdgeNEORead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	// handle import
 	if getString(d, "gw_name") == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no name received. Import Id is %s", id))
 		mustSet(d, "gw_name", id)
 		d.SetId(id)
 	}
@@ -782,7 +784,7 @@ func resourceAviatrixEdgeNEORead(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceAviatrixEdgeNEOUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	// read configs
 	edgeNEO := marshalEdgeNEOInput(d)
@@ -988,7 +990,7 @@ func resourceAviatrixEdgeNEOUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAviatrixEdgeNEODelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	accountName := getString(d, "account_name")
 	gwName := getString(d, "gw_name")
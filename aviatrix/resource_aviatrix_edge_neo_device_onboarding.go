@@ -3,11 +3,12 @@ package aviatrix
 import (
 	"context"
 	"errors"
-	"log"
+	"fmt"
 	"os"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -147,7 +148,7 @@ func marshalEdgeNEODeviceOnboardingInput(d *schema.ResourceData) *goaviatrix.Edg
 }
 
 func resourceAviatrixEdgeNEODeviceOnboardingCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	edgeNEODevice := marshalEdgeNEODeviceOnboardingInput(d)
 
@@ -205,13 +206,13 @@ func resourceAviatrixEdgeNEODeviceOnboardingReadIfRequired(ctx context.Context,
 }
 
 func resourceAviatrixEdgeNEODeviceOnboardingRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	accountName := getString(d, "account_name")
 	deviceName := getString(d, "device_name")
 	if accountName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no account name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no account name received. Import Id is %s", id))
 		parts := strings.Split(id, "~")
 		if len(parts) != 32 {
 			return diag.Errorf("Invalid Import ID received, ID must be in the format account_name~device_name")
@@ -273,7 +274,7 @@ func resourceAviatrixEdgeNEODeviceOnboardingRead(ctx context.Context, d *schema.
 }
 
 func resourceAviatrixEdgeNEODeviceOnboardingUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	edgeNEODevice := marshalEdgeNEODeviceOnboardingInput(d)
 
@@ -312,7 +313,7 @@ func resourceAviatrixEdgeNEODeviceOnboardingUpdate(ctx context.Context, d *schem
 			fileName := mustString(oldConfigFileDownloadPath) + edgeNEODevice.SerialNumber + "-bootstrap-config.img"
 			err := os.Remove(fileName)
 			if err != nil {
-				log.Printf("[WARN] could not remove the config file: %v", err)
+				tflog.Warn(ctx, fmt.Sprintf("could not remove the config file: %v", err))
 			}
 		}
 	}
@@ -328,7 +329,7 @@ func resourceAviatrixEdgeNEODeviceOnboardingUpdate(ctx context.Context, d *schem
 }
 
 func resourceAviatrixEdgeNEODeviceOnboardingDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	edgeNEODevice := marshalEdgeNEODeviceOnboardingInput(d)
 
@@ -341,7 +342,7 @@ func resourceAviatrixEdgeNEODeviceOnboardingDelete(ctx context.Context, d *schem
 		fileName := edgeNEODevice.ConfigFileDownloadPath + edgeNEODevice.SerialNumber + "-bootstrap-config.img"
 		err = os.Remove(fileName)
 		if err != nil {
-			log.Printf("[WARN] could not remove the config file: %v", err)
+			tflog.Warn(ctx, fmt.Sprintf("could not remove the config file: %v", err))
 		}
 	}
 
@@ -3,9 +3,10 @@ package aviatrix
 import (
 	"context"
 	"errors"
-	"log"
+	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,14 +16,16 @@ import (
 
 func resourceAviatrixEdgeNEOHa() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeNEOHaCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeNEOHaRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeNEOHaUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeNEOHaDelete,
+		CreateContext:      resourceAviatrixEdgeNEOHaCreate,
+		ReadWithoutTimeout: resourceAviatrixEdgeNEOHaRead,
+		UpdateContext:      resourceAviatrixEdgeNEOHaUpdate,
+		DeleteContext:      resourceAviatrixEdgeNEOHaDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: gatewayTimeouts(),
+
 		Schema: map[string]*schema.Schema{
 			"primary_gw_name": {
 				Type:        schema.TypeString,
@@ -153,7 +156,7 @@ func marshalEdgeNEOHaInput(d *schema.ResourceData) *goaviatrix.EdgeNEOHa {
 }
 
 func resourceAviatrixEdgeNEOHaCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	edgeNEOHa := marshalEdgeNEOHaInput(d)
 
@@ -167,17 +170,17 @@ func resourceAviatrixEdgeNEOHaCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceAviatrixEdgeNEOHaRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	if getString(d, "primary_gw_name") == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
-		parts := strings.Split(id, "-hagw")
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import. Import Id is %s", id))
+		parts := strings.Split(id, goaviatrix.HaGatewaySuffix)
 		mustSet(d, "primary_gw_name", parts[0])
 		d.SetId(id)
 	}
 
-	edgeNEOHaResp, err := client.GetEdgeNEOHa(ctx, getString(d, "primary_gw_name")+"-hagw")
+	edgeNEOHaResp, err := client.GetEdgeNEOHa(ctx, goaviatrix.HaGatewayName(getString(d, "primary_gw_name")))
 	if err != nil {
 		if errors.Is(err, goaviatrix.ErrNotFound) {
 			d.SetId("")
@@ -221,7 +224,7 @@ func resourceAviatrixEdgeNEOHaRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAviatrixEdgeNEOHaUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	edgeNEOHa := marshalEdgeNEOHaInput(d)
 
@@ -246,7 +249,7 @@ func resourceAviatrixEdgeNEOHaUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceAviatrixEdgeNEOHaDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	accountName := getString(d, "account_name")
 
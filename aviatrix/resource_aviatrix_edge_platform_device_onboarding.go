@@ -3,11 +3,12 @@ package aviatrix
 import (
 	"context"
 	"errors"
-	"log"
+	"fmt"
 	"os"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -145,7 +146,7 @@ func marshalEdgePlatformDeviceOnboardingInput(d *schema.ResourceData) *goaviatri
 }
 
 func resourceAviatrixEdgePlatformDeviceOnboardingCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	edgeNEODevice := marshalEdgePlatformDeviceOnboardingInput(d)
 
@@ -203,13 +204,13 @@ func resourceAviatrixEdgePlatformDeviceOnboardingReadIfRequired(ctx context.Cont
 }
 
 func resourceAviatrixEdgePlatformDeviceOnboardingRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	accountName := getString(d, "account_name")
 	deviceName := getString(d, "device_name")
 	if accountName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no account name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no account name received. Import Id is %s", id))
 		parts := strings.Split(id, "~")
 		if len(parts) != 2 {
 			return diag.Errorf("Invalid Import ID received, ID must be in the format account_name~device_name")
@@ -271,7 +272,7 @@ func resourceAviatrixEdgePlatformDeviceOnboardingRead(ctx context.Context, d *sc
 }
 
 func resourceAviatrixEdgePlatformDeviceOnboardingUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	edgeNEODevice := marshalEdgePlatformDeviceOnboardingInput(d)
 
@@ -310,7 +311,7 @@ func resourceAviatrixEdgePlatformDeviceOnboardingUpdate(ctx context.Context, d *
 			fileName := mustString(oldConfigFileDownloadPath) + edgeNEODevice.SerialNumber + "-bootstrap-config.img"
 			err := os.Remove(fileName)
 			if err != nil {
-				log.Printf("[WARN] could not remove the config file: %v", err)
+				tflog.Warn(ctx, fmt.Sprintf("could not remove the config file: %v", err))
 			}
 		}
 	}
@@ -326,7 +327,7 @@ func resourceAviatrixEdgePlatformDeviceOnboardingUpdate(ctx context.Context, d *
 }
 
 func resourceAviatrixEdgePlatformDeviceOnboardingDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	edgeNEODevice := marshalEdgePlatformDeviceOnboardingInput(d)
 
@@ -339,7 +340,7 @@ func resourceAviatrixEdgePlatformDeviceOnboardingDelete(ctx context.Context, d *
 		fileName := edgeNEODevice.ConfigFileDownloadPath + edgeNEODevice.SerialNumber + "-bootstrap-config.img"
 		err = os.Remove(fileName)
 		if err != nil {
-			log.Printf("[WARN] could not remove the config file: %v", err)
+			tflog.Warn(ctx, fmt.Sprintf("could not remove the config file: %v", err))
 		}
 	}
 
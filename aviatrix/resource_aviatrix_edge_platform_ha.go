@@ -3,9 +3,10 @@ package aviatrix
 import (
 	"context"
 	"errors"
-	"log"
+	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,14 +16,16 @@ import (
 
 func resourceAviatrixEdgePlatformHa() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgePlatformHaCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgePlatformHaRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgePlatformHaUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgePlatformHaDelete,
+		CreateContext:      resourceAviatrixEdgePlatformHaCreate,
+		ReadWithoutTimeout: resourceAviatrixEdgePlatformHaRead,
+		UpdateContext:      resourceAviatrixEdgePlatformHaUpdate,
+		DeleteContext:      resourceAviatrixEdgePlatformHaDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: gatewayTimeouts(),
+
 		Schema: map[string]*schema.Schema{
 			"primary_gw_name": {
 				Type:        schema.TypeString,
@@ -170,7 +173,7 @@ func marshalEdgePlatformHaInput(d *schema.ResourceData) *goaviatrix.EdgeNEOHa {
 }
 
 func resourceAviatrixEdgePlatformHaCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	edgeNEOHa := marshalEdgePlatformHaInput(d)
 
@@ -184,17 +187,17 @@ func resourceAviatrixEdgePlatformHaCreate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceAviatrixEdgePlatformHaRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	if getString(d, "primary_gw_name") == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
-		parts := strings.Split(id, "-hagw")
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import. Import Id is %s", id))
+		parts := strings.Split(id, goaviatrix.HaGatewaySuffix)
 		mustSet(d, "primary_gw_name", parts[0])
 		d.SetId(id)
 	}
 
-	edgeNEOHaResp, err := client.GetEdgeNEOHa(ctx, getString(d, "primary_gw_name")+"-hagw")
+	edgeNEOHaResp, err := client.GetEdgeNEOHa(ctx, goaviatrix.HaGatewayName(getString(d, "primary_gw_name")))
 	if err != nil {
 		if errors.Is(err, goaviatrix.ErrNotFound) {
 			d.SetId("")
@@ -240,7 +243,7 @@ func resourceAviatrixEdgePlatformHaRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceAviatrixEdgePlatformHaUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	edgeNEOHa := marshalEdgePlatformHaInput(d)
 
@@ -265,7 +268,7 @@ func resourceAviatrixEdgePlatformHaUpdate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceAviatrixEdgePlatformHaDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	accountName := getString(d, "account_name")
 
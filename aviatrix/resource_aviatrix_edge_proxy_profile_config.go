@@ -3,9 +3,10 @@ package aviatrix
 import (
 	"context"
 	"errors"
-	"log"
+	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -96,7 +97,7 @@ func edgePlatformProxyProfileFromProxyProfile(proxy *proxyProfile) *goaviatrix.E
 }
 
 func resourceAviatrixEdgeProxyProfileConfigCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	proxy := marshalEdgeProxyProfileConfigInput(d)
 	createdProxy, err := client.CreateEdgeProxyProfile(ctx, edgePlatformProxyProfileFromProxyProfile(proxy))
@@ -111,7 +112,7 @@ func resourceAviatrixEdgeProxyProfileConfigCreate(ctx context.Context, d *schema
 func resourceAviatrixEdgeProxyProfileConfigUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	proxy := marshalEdgeProxyProfileConfigInput(d)
 
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 	existingProxy, err := client.GetEdgePlatformProxyProfile(ctx, proxy.AccountName, proxy.Name)
 	if err != nil {
 		if errors.Is(err, goaviatrix.ErrNotFound) {
@@ -151,13 +152,13 @@ func resourceAviatrixEdgeProxyProfileConfigUpdate(ctx context.Context, d *schema
 }
 
 func resourceAviatrixEdgeProxyProfileConfigRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	accountName := getString(d, "account_name")
 	proxyProfileName := getString(d, "proxy_profile_name")
 	if accountName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no account name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no account name received. Import Id is %s", id))
 		parts := strings.Split(id, "~")
 		if len(parts) != 2 {
 			return diag.Errorf("Invalid Import ID received, ID must be in the format account_name~proxy_profile_name")
@@ -186,7 +187,7 @@ func resourceAviatrixEdgeProxyProfileConfigRead(ctx context.Context, d *schema.R
 }
 
 func resourceAviatrixEdgeProxyProfileConfigDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	err := client.DeleteEdgePlatformProxyProfile(ctx, getString(d, "account_name"), getString(d, "proxy_profile_name"))
 	if err != nil {
@@ -4,11 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"os"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -20,14 +20,16 @@ import (
 
 func resourceAviatrixEdgeSpoke() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeSpokeCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeSpokeRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeSpokeUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeSpokeDelete,
+		CreateContext:      resourceAviatrixEdgeSpokeCreate,
+		ReadWithoutTimeout: resourceAviatrixEdgeSpokeRead,
+		UpdateContext:      resourceAviatrixEdgeSpokeUpdate,
+		DeleteContext:      resourceAviatrixEdgeSpokeDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: gatewayTimeouts(),
+
 		Schema: map[string]*schema.Schema{
 			"gw_name": {
 				Type:        schema.TypeString,
@@ -93,7 +95,7 @@ func resourceAviatrixEdgeSpoke() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				Description:  "Local AS number.",
-				ValidateFunc: goaviatrix.ValidateASN,
+				ValidateFunc: ValidateASN,
 			},
 			"prepend_as_path": {
 				Type:        schema.TypeList,
@@ -101,7 +103,7 @@ func resourceAviatrixEdgeSpoke() *schema.Resource {
 				Description: "List of AS numbers to prepend gateway BGP AS_Path field.",
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: goaviatrix.ValidateASN,
+					ValidateFunc: ValidateASN,
 				},
 				MaxItems: 25,
 			},
@@ -186,17 +188,17 @@ func resourceAviatrixEdgeSpoke() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				ValidateFunc:     goaviatrix.ValidateEdgeSpokeLatitude,
+				ValidateFunc:     ValidateEdgeSpokeLatitude,
 				Description:      "The latitude of the Edge as a Spoke.",
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncEdgeSpokeCoordinate,
+				DiffSuppressFunc: DiffSuppressFuncEdgeSpokeCoordinate,
 			},
 			"longitude": {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				ValidateFunc:     goaviatrix.ValidateEdgeSpokeLongitude,
+				ValidateFunc:     ValidateEdgeSpokeLongitude,
 				Description:      "The longitude of the Edge as a Spoke.",
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncEdgeSpokeCoordinate,
+				DiffSuppressFunc: DiffSuppressFuncEdgeSpokeCoordinate,
 			},
 			"rx_queue_size": {
 				Type:         schema.TypeString,
@@ -321,7 +323,7 @@ func marshalEdgeSpokeInput(d *schema.ResourceData) *goaviatrix.EdgeSpoke {
 }
 
 func resourceAviatrixEdgeSpokeCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	// read configs
 	edgeSpoke := marshalEdgeSpokeInput(d)
@@ -483,12 +485,12 @@ func resourceAviatrixEdgeSpokeReadIfRequired(ctx context.Context, d *schema.Reso
 }
 
 func resourceAviatrixEdgeSpokeRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	// handle import
 	if getString(d, "gw_name") == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no name received. Import Id is %s", id))
 		mustSet(d, "gw_name", id)
 		d.SetId(id)
 	}
@@ -588,7 +590,7 @@ func resourceAviatrixEdgeSpokeRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAviatrixEdgeSpokeUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	// read configs
 	edgeSpoke := marshalEdgeSpokeInput(d)
@@ -778,7 +780,7 @@ func resourceAviatrixEdgeSpokeUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceAviatrixEdgeSpokeDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	gwName := getString(d, "gw_name")
 	siteId := getString(d, "site_id")
@@ -800,7 +802,7 @@ func resourceAviatrixEdgeSpokeDelete(ctx context.Context, d *schema.ResourceData
 
 	err = os.Remove(fileName)
 	if err != nil {
-		log.Printf("[WARN] could not remove the ztp file: %v", err)
+		tflog.Warn(ctx, fmt.Sprintf("could not remove the ztp file: %v", err))
 	}
 
 	return nil
@@ -4,11 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -53,14 +53,14 @@ func resourceAviatrixEdgeSpokeExternalDeviceConn() *schema.Resource {
 				Required:     true,
 				ForceNew:     true,
 				Description:  "BGP local AS number.",
-				ValidateFunc: goaviatrix.ValidateASN,
+				ValidateFunc: ValidateASN,
 			},
 			"bgp_remote_as_num": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
 				Description:  "BGP remote AS number.",
-				ValidateFunc: goaviatrix.ValidateASN,
+				ValidateFunc: ValidateASN,
 			},
 			"local_lan_ip": {
 				Type:        schema.TypeString,
@@ -137,7 +137,7 @@ func resourceAviatrixEdgeSpokeExternalDeviceConn() *schema.Resource {
 				Default:      "",
 				ForceNew:     false,
 				Description:  "Backup BGP remote ASN (Autonomous System Number). Integer between 1-4294967294.",
-				ValidateFunc: goaviatrix.ValidateASN,
+				ValidateFunc: ValidateASN,
 			},
 			"backup_local_lan_ip": {
 				Type:        schema.TypeString,
@@ -157,7 +157,7 @@ func resourceAviatrixEdgeSpokeExternalDeviceConn() *schema.Resource {
 				Description: "Connection AS Path Prepend customized by specifying AS PATH for a BGP connection.",
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: goaviatrix.ValidateASN,
+					ValidateFunc: ValidateASN,
 				},
 				MaxItems: 25,
 			},
@@ -193,7 +193,7 @@ func resourceAviatrixEdgeSpokeExternalDeviceConn() *schema.Resource {
 				Optional:         true,
 				Description:      "BGP BFD configuration details applied to a BGP session.",
 				MaxItems:         1,
-				DiffSuppressFunc: goaviatrix.ExternalDeviceConnBgpBfdDiffSuppressFunc,
+				DiffSuppressFunc: ExternalDeviceConnBgpBfdDiffSuppressFunc,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"transmit_interval": {
@@ -312,7 +312,7 @@ func marshalEdgeSpokeExternalDeviceConnInput(d *schema.ResourceData) (*goaviatri
 }
 
 func resourceAviatrixEdgeSpokeExternalDeviceConnCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	externalDeviceConn, err := marshalEdgeSpokeExternalDeviceConnInput(d)
 	if err != nil {
@@ -400,7 +400,7 @@ func resourceAviatrixEdgeSpokeExternalDeviceConnCreate(ctx context.Context, d *s
 	for i := 0; ; i++ {
 		if externalDeviceConn.EnableEdgeUnderlay {
 			connName, err = client.CreateEdgeExternalDeviceConn(&edgeExternalDeviceConn)
-			log.Printf("[DEBUG] Created underlay connection %s", connName)
+			tflog.Debug(ctx, fmt.Sprintf("Created underlay connection %s", connName))
 		} else {
 			err = client.CreateExternalDeviceConn(externalDeviceConn)
 		}
@@ -461,14 +461,14 @@ func resourceAviatrixEdgeSpokeExternalDeviceConnReadIfRequired(ctx context.Conte
 }
 
 func resourceAviatrixEdgeSpokeExternalDeviceConnRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	connectionName := getString(d, "connection_name")
 
 	vpcID := getString(d, "site_id")
 	if connectionName == "" || vpcID == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no 'site_id' received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no 'site_id' received. Import Id is %s", id))
 		parts := strings.Split(id, "~")
 		if len(parts) != 3 {
 			return diag.Errorf("expected import ID in the form 'connection_name~site_id~gw_name' instead got %q", id)
@@ -591,7 +591,7 @@ func resourceAviatrixEdgeSpokeExternalDeviceConnRead(ctx context.Context, d *sch
 }
 
 func resourceAviatrixEdgeSpokeExternalDeviceConnUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 	d.Partial(true)
 
 	externalDeviceConn, err := marshalEdgeSpokeExternalDeviceConnInput(d)
@@ -811,7 +811,7 @@ func resourceAviatrixEdgeSpokeExternalDeviceConnUpdate(ctx context.Context, d *s
 }
 
 func resourceAviatrixEdgeSpokeExternalDeviceConnDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	externalDeviceConn, err := marshalEdgeSpokeExternalDeviceConnInput(d)
 	if err != nil {
@@ -882,7 +882,7 @@ func buildEdgeSpokeExternalDeviceConnForHa(d *schema.ResourceData, haGwName stri
 }
 
 func resourceAviatrixEdgeSpokeExternalDeviceConnEnableHa(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 	priGwName := getString(d, "gw_name")
 	// first read primary gateway details to get ha gateway name
 	primaryGateway, err := getGatewayDetails(client, priGwName)
@@ -895,7 +895,7 @@ func resourceAviatrixEdgeSpokeExternalDeviceConnEnableHa(ctx context.Context, d
 		return diag.Errorf("primary gateway %s does not have a HA gateway", priGwName)
 	}
 
-	log.Printf("[INFO] Creating HA external device connection for %s on gateway %s", getString(d, "connection_name"), haGwName)
+	tflog.Info(ctx, fmt.Sprintf("Creating HA external device connection for %s on gateway %s", getString(d, "connection_name"), haGwName))
 	externalDeviceConn, err := buildEdgeSpokeExternalDeviceConnForHa(d, haGwName)
 	if err != nil {
 		return diag.FromErr(err)
@@ -913,7 +913,7 @@ func resourceAviatrixEdgeSpokeExternalDeviceConnEnableHa(ctx context.Context, d
 		if externalDeviceConn.EnableEdgeUnderlay {
 			connName, connErr := client.CreateEdgeExternalDeviceConn(&edgeExternalDeviceConn)
 			err = connErr
-			log.Printf("[DEBUG] Created underlay HA connection %s", connName)
+			tflog.Debug(ctx, fmt.Sprintf("Created underlay HA connection %s", connName))
 		} else {
 			err = client.CreateExternalDeviceConn(externalDeviceConn)
 		}
@@ -959,7 +959,7 @@ func buildEdgeSpokeExternalDeviceConnForDisableHa(d *schema.ResourceData, haGwNa
 }
 
 func resourceAviatrixEdgeSpokeExternalDeviceConnDisableHa(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	priGwName := getString(d, "gw_name")
 	// first read primary gateway details to get ha gateway name
@@ -972,7 +972,7 @@ func resourceAviatrixEdgeSpokeExternalDeviceConnDisableHa(ctx context.Context, d
 	if haGwName == "" {
 		return diag.Errorf("primary gateway %s does not have a HA gateway", priGwName)
 	}
-	log.Printf("[INFO] Deleting HA external device connection for %s on gateway %s", getString(d, "connection_name"), haGwName)
+	tflog.Info(ctx, fmt.Sprintf("Deleting HA external device connection for %s on gateway %s", getString(d, "connection_name"), haGwName))
 	externalDeviceConn, err := buildEdgeSpokeExternalDeviceConnForDisableHa(d, haGwName)
 	if err != nil {
 		return diag.FromErr(err)
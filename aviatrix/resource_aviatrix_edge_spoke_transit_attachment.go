@@ -3,9 +3,10 @@ package aviatrix
 import (
 	"context"
 	"errors"
-	"log"
+	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -75,7 +76,7 @@ func resourceAviatrixEdgeSpokeTransitAttachment() *schema.Resource {
 				Description: "AS Path Prepend customized by specifying AS PATH for a BGP connection. Applies on Edge as a Spoke.",
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: goaviatrix.ValidateASN,
+					ValidateFunc: ValidateASN,
 				},
 				MaxItems: 25,
 			},
@@ -85,7 +86,7 @@ func resourceAviatrixEdgeSpokeTransitAttachment() *schema.Resource {
 				Description: "AS Path Prepend customized by specifying AS PATH for a BGP connection. Applies on transit gateway.",
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: goaviatrix.ValidateASN,
+					ValidateFunc: ValidateASN,
 				},
 				MaxItems: 25,
 			},
@@ -111,7 +112,7 @@ func resourceAviatrixEdgeSpokeTransitAttachment() *schema.Resource {
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncEdgeSpokeTransitAttachmentEdgeWanInterfaces,
+				DiffSuppressFunc: DiffSuppressFuncEdgeSpokeTransitAttachmentEdgeWanInterfaces,
 			},
 			"default_edge_wan_interfaces": {
 				Type:        schema.TypeSet,
@@ -189,7 +190,7 @@ func marshalEdgeSpokeTransitAttachmentInput(d *schema.ResourceData) *goaviatrix.
 }
 
 func resourceAviatrixEdgeSpokeTransitAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	attachment := marshalEdgeSpokeTransitAttachmentInput(d)
 
@@ -286,13 +287,13 @@ func resourceAviatrixEdgeSpokeTransitAttachmentReadIfRequired(ctx context.Contex
 }
 
 func resourceAviatrixEdgeSpokeTransitAttachmentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	spokeGwName := getString(d, "spoke_gw_name")
 	transitGwName := getString(d, "transit_gw_name")
 	if spokeGwName == "" || transitGwName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no spoke_gw_name or transit_gw_name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no spoke_gw_name or transit_gw_name received. Import Id is %s", id))
 		d.SetId(id)
 		parts := strings.Split(id, "~")
 		if len(parts) != 2 {
@@ -413,7 +414,7 @@ func resourceAviatrixEdgeSpokeTransitAttachmentRead(ctx context.Context, d *sche
 }
 
 func resourceAviatrixEdgeSpokeTransitAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	enableInsaneMode := getBool(d, "enable_insane_mode")
 	enableOverPrivateNetwork := getBool(d, "enable_over_private_network")
@@ -474,7 +475,7 @@ func resourceAviatrixEdgeSpokeTransitAttachmentUpdate(ctx context.Context, d *sc
 
 	if d.HasChange("enable_firenet_for_edge") {
 		form := map[string]any{
-			"CID":                     client.CID,
+			"CID":                     client.GetCID(),
 			"action":                  "edit_inter_transit_gateway_peering",
 			"gateway1":                spokeGwName,
 			"gateway2":                transitGwName,
@@ -506,7 +507,7 @@ func resourceAviatrixEdgeSpokeTransitAttachmentUpdate(ctx context.Context, d *sc
 }
 
 func resourceAviatrixEdgeSpokeTransitAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	resolvedSpokeGwName, err := resolveGatewayName(ctx, client, getString(d, "spoke_gw_name"))
 	if err != nil {
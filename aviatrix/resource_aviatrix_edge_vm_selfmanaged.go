@@ -4,11 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"os"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -19,14 +19,16 @@ import (
 
 func resourceAviatrixEdgeVmSelfmanaged() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeVmSelfmanagedCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeVmSelfmanagedRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeVmSelfmanagedUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeVmSelfmanagedDelete,
+		CreateContext:      resourceAviatrixEdgeVmSelfmanagedCreate,
+		ReadWithoutTimeout: resourceAviatrixEdgeVmSelfmanagedRead,
+		UpdateContext:      resourceAviatrixEdgeVmSelfmanagedUpdate,
+		DeleteContext:      resourceAviatrixEdgeVmSelfmanagedDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: gatewayTimeouts(),
+
 		Schema: map[string]*schema.Schema{
 			"gw_name": {
 				Type:        schema.TypeString,
@@ -92,7 +94,7 @@ func resourceAviatrixEdgeVmSelfmanaged() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				Description:  "Local AS number.",
-				ValidateFunc: goaviatrix.ValidateASN,
+				ValidateFunc: ValidateASN,
 			},
 			"prepend_as_path": {
 				Type:        schema.TypeList,
@@ -100,7 +102,7 @@ func resourceAviatrixEdgeVmSelfmanaged() *schema.Resource {
 				Description: "List of AS numbers to prepend gateway BGP AS_Path field.",
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: goaviatrix.ValidateASN,
+					ValidateFunc: ValidateASN,
 				},
 				MaxItems: 25,
 			},
@@ -175,17 +177,17 @@ func resourceAviatrixEdgeVmSelfmanaged() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				ValidateFunc:     goaviatrix.ValidateEdgeSpokeLatitude,
+				ValidateFunc:     ValidateEdgeSpokeLatitude,
 				Description:      "The latitude of the Edge as a Spoke.",
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncEdgeSpokeCoordinate,
+				DiffSuppressFunc: DiffSuppressFuncEdgeSpokeCoordinate,
 			},
 			"longitude": {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				ValidateFunc:     goaviatrix.ValidateEdgeSpokeLongitude,
+				ValidateFunc:     ValidateEdgeSpokeLongitude,
 				Description:      "The longitude of the Edge as a Spoke.",
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncEdgeSpokeCoordinate,
+				DiffSuppressFunc: DiffSuppressFuncEdgeSpokeCoordinate,
 			},
 			"rx_queue_size": {
 				Type:         schema.TypeString,
@@ -309,7 +311,7 @@ func marshalEdgeVmSelfmanagedInput(d *schema.ResourceData) *goaviatrix.EdgeSpoke
 }
 
 func resourceAviatrixEdgeVmSelfmanagedCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	// read configs
 	edgeSpoke := marshalEdgeVmSelfmanagedInput(d)
@@ -464,12 +466,12 @@ func resourceAviatrixEdgeVmSelfmanagedReadIfRequired(ctx context.Context, d *sch
 }
 
 func resourceAviatrixEdgeVmSelfmanagedRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	// handle import
 	if getString(d, "gw_name") == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no name received. Import Id is %s", id))
 		mustSet(d, "gw_name", id)
 		d.SetId(id)
 	}
@@ -568,7 +570,7 @@ func resourceAviatrixEdgeVmSelfmanagedRead(ctx context.Context, d *schema.Resour
 }
 
 func resourceAviatrixEdgeVmSelfmanagedUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	// read configs
 	edgeSpoke := marshalEdgeVmSelfmanagedInput(d)
@@ -751,7 +753,7 @@ func resourceAviatrixEdgeVmSelfmanagedUpdate(ctx context.Context, d *schema.Reso
 }
 
 func resourceAviatrixEdgeVmSelfmanagedDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	gwName := getString(d, "gw_name")
 	siteId := getString(d, "site_id")
@@ -773,7 +775,7 @@ func resourceAviatrixEdgeVmSelfmanagedDelete(ctx context.Context, d *schema.Reso
 
 	err = os.Remove(fileName)
 	if err != nil {
-		log.Printf("[WARN] could not remove the ztp file: %v", err)
+		tflog.Warn(ctx, fmt.Sprintf("could not remove the ztp file: %v", err))
 	}
 
 	return nil
@@ -3,10 +3,11 @@ package aviatrix
 import (
 	"context"
 	"errors"
-	"log"
+	"fmt"
 	"os"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -18,14 +19,16 @@ import (
 
 func resourceAviatrixEdgeVmSelfmanagedHa() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeVmSelfmanagedHaCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeVmSelfmanagedHaRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeVmSelfmanagedHaUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeVmSelfmanagedHaDelete,
+		CreateContext:      resourceAviatrixEdgeVmSelfmanagedHaCreate,
+		ReadWithoutTimeout: resourceAviatrixEdgeVmSelfmanagedHaRead,
+		UpdateContext:      resourceAviatrixEdgeVmSelfmanagedHaUpdate,
+		DeleteContext:      resourceAviatrixEdgeVmSelfmanagedHaDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: gatewayTimeouts(),
+
 		Schema: map[string]*schema.Schema{
 			"primary_gw_name": {
 				Type:        schema.TypeString,
@@ -141,7 +144,7 @@ func marshalEdgeVmSelfmanagedHaInput(d *schema.ResourceData) *goaviatrix.EdgeVmS
 }
 
 func resourceAviatrixEdgeVmSelfmanagedHaCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	edgeVmSelfmanagedHa := marshalEdgeVmSelfmanagedHaInput(d)
 
@@ -155,17 +158,17 @@ func resourceAviatrixEdgeVmSelfmanagedHaCreate(ctx context.Context, d *schema.Re
 }
 
 func resourceAviatrixEdgeVmSelfmanagedHaRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	if getString(d, "primary_gw_name") == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
-		parts := strings.Split(id, "-hagw")
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import. Import Id is %s", id))
+		parts := strings.Split(id, goaviatrix.HaGatewaySuffix)
 		mustSet(d, "primary_gw_name", parts[0])
 		d.SetId(id)
 	}
 
-	edgeVmSelfmanagedHaResp, err := client.GetEdgeVmSelfmanagedHa(ctx, getString(d, "primary_gw_name")+"-hagw")
+	edgeVmSelfmanagedHaResp, err := client.GetEdgeVmSelfmanagedHa(ctx, goaviatrix.HaGatewayName(getString(d, "primary_gw_name")))
 	if err != nil {
 		if errors.Is(err, goaviatrix.ErrNotFound) {
 			d.SetId("")
@@ -213,7 +216,7 @@ func resourceAviatrixEdgeVmSelfmanagedHaRead(ctx context.Context, d *schema.Reso
 }
 
 func resourceAviatrixEdgeVmSelfmanagedHaUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	edgeVmSelfmanagedHa := marshalEdgeVmSelfmanagedHaInput(d)
 
@@ -238,7 +241,7 @@ func resourceAviatrixEdgeVmSelfmanagedHaUpdate(ctx context.Context, d *schema.Re
 }
 
 func resourceAviatrixEdgeVmSelfmanagedHaDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	err := client.DeleteEdgeSpoke(ctx, d.Id())
 	if err != nil {
@@ -256,7 +259,7 @@ func resourceAviatrixEdgeVmSelfmanagedHaDelete(ctx context.Context, d *schema.Re
 
 	err = os.Remove(fileName)
 	if err != nil {
-		log.Printf("[WARN] could not remove the ztp file: %v", err)
+		tflog.Warn(ctx, fmt.Sprintf("could not remove the ztp file: %v", err))
 	}
 
 	return nil
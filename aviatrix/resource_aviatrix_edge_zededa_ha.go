@@ -3,9 +3,10 @@ package aviatrix
 import (
 	"context"
 	"errors"
-	"log"
+	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,14 +16,16 @@ import (
 
 func resourceAviatrixEdgeZededaHa() *schema.Resource {
 	return &schema.Resource{
-		CreateWithoutTimeout: resourceAviatrixEdgeZededaHaCreate,
-		ReadWithoutTimeout:   resourceAviatrixEdgeZededaHaRead,
-		UpdateWithoutTimeout: resourceAviatrixEdgeZededaHaUpdate,
-		DeleteWithoutTimeout: resourceAviatrixEdgeZededaHaDelete,
+		CreateContext:      resourceAviatrixEdgeZededaHaCreate,
+		ReadWithoutTimeout: resourceAviatrixEdgeZededaHaRead,
+		UpdateContext:      resourceAviatrixEdgeZededaHaUpdate,
+		DeleteContext:      resourceAviatrixEdgeZededaHaDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: gatewayTimeouts(),
+
 		Schema: map[string]*schema.Schema{
 			"primary_gw_name": {
 				Type:        schema.TypeString,
@@ -151,7 +154,7 @@ func marshalEdgeZededaHaInput(d *schema.ResourceData) *goaviatrix.EdgeCSPHa {
 }
 
 func resourceAviatrixEdgeZededaHaCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	edgeCSPHa := marshalEdgeZededaHaInput(d)
 
@@ -165,17 +168,17 @@ func resourceAviatrixEdgeZededaHaCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceAviatrixEdgeZededaHaRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	if getString(d, "primary_gw_name") == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
-		parts := strings.Split(id, "-hagw")
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import. Import Id is %s", id))
+		parts := strings.Split(id, goaviatrix.HaGatewaySuffix)
 		mustSet(d, "primary_gw_name", parts[0])
 		d.SetId(id)
 	}
 
-	edgeCSPHaResp, err := client.GetEdgeCSPHa(ctx, getString(d, "primary_gw_name")+"-hagw")
+	edgeCSPHaResp, err := client.GetEdgeCSPHa(ctx, goaviatrix.HaGatewayName(getString(d, "primary_gw_name")))
 	if err != nil {
 		if errors.Is(err, goaviatrix.ErrNotFound) {
 			d.SetId("")
@@ -218,7 +221,7 @@ func resourceAviatrixEdgeZededaHaRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceAviatrixEdgeZededaHaUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	edgeCSPHa := marshalEdgeZededaHaInput(d)
 
@@ -243,7 +246,7 @@ func resourceAviatrixEdgeZededaHaUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceAviatrixEdgeZededaHaDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustEdgeClient(meta)
 
 	accountName := getString(d, "account_name")
 
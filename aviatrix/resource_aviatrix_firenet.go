@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,10 +16,10 @@ import (
 
 func resourceAviatrixFireNet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixFireNetCreate,
-		Read:   resourceAviatrixFireNetRead,
-		Update: resourceAviatrixFireNetUpdate,
-		Delete: resourceAviatrixFireNetDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixFireNetCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixFireNetRead),
+		UpdateWithoutTimeout: withContext(resourceAviatrixFireNetUpdate),
+		DeleteWithoutTimeout: withContext(resourceAviatrixFireNetDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -84,10 +85,10 @@ func resourceAviatrixFireNet() *schema.Resource {
 	}
 }
 
-func resourceAviatrixFireNetCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixFireNetCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
-	log.Printf("[INFO] Creating an Aviatrix Firenet on vpc: %s", d.Get("vpc_id"))
+	tflog.Info(ctx, fmt.Sprintf("Creating an Aviatrix Firenet on vpc: %s", goaviatrix.Redact(d.Get("vpc_id"))))
 
 	fireNet := &goaviatrix.FireNet{
 		VpcID: getString(d, "vpc_id"),
@@ -96,7 +97,7 @@ func resourceAviatrixFireNetCreate(d *schema.ResourceData, meta any) error {
 	d.SetId(fireNet.VpcID)
 
 	flag := false
-	defer func() { _ = resourceAviatrixFireNetReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixFireNetReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	if getString(d, "hashing_algorithm") == "2-Tuple" {
 		fireNet.HashingAlgorithm = getString(d, "hashing_algorithm")
@@ -111,7 +112,7 @@ func resourceAviatrixFireNetCreate(d *schema.ResourceData, meta any) error {
 		err := client.EditFireNetInspection(fireNet)
 		if err != nil {
 			if strings.Contains(err.Error(), "[AVXERR-FIRENET-0011] Unsupported for Egress Transit.") {
-				log.Printf("[INFO] Ignoring error from disabling traffic inspection: %v\n", err)
+				tflog.Info(ctx, fmt.Sprintf("Ignoring error from disabling traffic inspection: %v", err))
 			} else {
 				return fmt.Errorf("couldn't disable inspection due to %w", err)
 			}
@@ -123,7 +124,7 @@ func resourceAviatrixFireNetCreate(d *schema.ResourceData, meta any) error {
 		err := client.EditFireNetEgress(fireNet)
 		if err != nil {
 			if strings.Contains(err.Error(), "[AVXERR-FIRENET-0011] Unsupported for Egress Transit.") {
-				log.Printf("[INFO] Ignoring error from enabling egress: %v\n", err)
+				tflog.Info(ctx, fmt.Sprintf("Ignoring error from enabling egress: %v", err))
 			} else {
 				return fmt.Errorf("couldn't enable egress due to %w", err)
 			}
@@ -167,24 +168,24 @@ func resourceAviatrixFireNetCreate(d *schema.ResourceData, meta any) error {
 		}
 	}
 
-	return resourceAviatrixFireNetReadIfRequired(d, meta, &flag)
+	return resourceAviatrixFireNetReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixFireNetReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixFireNetReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixFireNetRead(d, meta)
+		return resourceAviatrixFireNetRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixFireNetRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixFireNetRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	vpcID := getString(d, "vpc_id")
 	if vpcID == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no vpc_id received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no vpc_id received. Import Id is %s", id))
 		mustSet(d, "vpc_id", id)
 		d.SetId(id)
 	}
@@ -201,7 +202,7 @@ func resourceAviatrixFireNetRead(d *schema.ResourceData, meta any) error {
 		return fmt.Errorf("couldn't find FireNet: %w", err)
 	}
 
-	log.Printf("[INFO] Found FireNet: %#v", fireNetDetail.VpcID)
+	tflog.Info(ctx, fmt.Sprintf("Found FireNet: %#v", fireNetDetail.VpcID))
 	mustSet(d, "vpc_id", fireNetDetail.VpcID)
 	mustSet(d, "hashing_algorithm", fireNetDetail.HashingAlgorithm)
 	mustSet(d, "tgw_segmentation_for_egress_enabled", fireNetDetail.TgwSegmentationForEgress == "yes")
@@ -214,10 +215,10 @@ func resourceAviatrixFireNetRead(d *schema.ResourceData, meta any) error {
 	return nil
 }
 
-func resourceAviatrixFireNetUpdate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixFireNetUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
-	log.Printf("[INFO] Updating Aviatrix FireNet: %#v", getString(d, "vpc_id"))
+	tflog.Info(ctx, fmt.Sprintf("Updating Aviatrix FireNet: %#v", getString(d, "vpc_id")))
 
 	d.Partial(true)
 	if d.HasChange("vpc_id") {
@@ -342,10 +343,10 @@ func resourceAviatrixFireNetUpdate(d *schema.ResourceData, meta any) error {
 	}
 
 	d.Partial(false)
-	return resourceAviatrixFireNetRead(d, meta)
+	return resourceAviatrixFireNetRead(ctx, d, meta)
 }
 
-func resourceAviatrixFireNetDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixFireNetDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	fireNet := &goaviatrix.FireNet{
@@ -371,7 +372,7 @@ func resourceAviatrixFireNetDelete(d *schema.ResourceData, meta any) error {
 		err := client.EditFireNetEgress(fireNet)
 		if err != nil {
 			if strings.Contains(err.Error(), "[AVXERR-FIRENET-0011] Unsupported for Egress Transit.") {
-				log.Printf("[INFO] Ignoring error from disabling egress: %v\n", err)
+				tflog.Info(ctx, fmt.Sprintf("Ignoring error from disabling egress: %v", err))
 			} else {
 				return fmt.Errorf("failed to disable firewall egress on fireNet: %w", err)
 			}
@@ -385,7 +386,7 @@ func resourceAviatrixFireNetDelete(d *schema.ResourceData, meta any) error {
 		}
 	}
 
-	log.Printf("[INFO] Deleting FireNet: %#v", fireNet)
+	tflog.Info(ctx, fmt.Sprintf("Deleting FireNet: %#v", goaviatrix.Redact(fireNet)))
 
 	_, err := client.GetFireNet(fireNet)
 	if err != nil {
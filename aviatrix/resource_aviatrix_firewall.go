@@ -1,10 +1,11 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,10 +15,10 @@ import (
 
 func resourceAviatrixFirewall() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixFirewallCreate,
-		Read:   resourceAviatrixFirewallRead,
-		Update: resourceAviatrixFirewallUpdate,
-		Delete: resourceAviatrixFirewallDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixFirewallCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixFirewallRead),
+		UpdateWithoutTimeout: withContext(resourceAviatrixFirewallUpdate),
+		Delete:               resourceAviatrixFirewallDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -110,7 +111,7 @@ func resourceAviatrixFirewall() *schema.Resource {
 	}
 }
 
-func resourceAviatrixFirewallCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixFirewallCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	firewall := &goaviatrix.Firewall{
@@ -133,11 +134,11 @@ func resourceAviatrixFirewallCreate(d *schema.ResourceData, meta any) error {
 		firewall.PolicyList = policyList
 	}
 
-	log.Printf("[INFO] Creating Aviatrix firewall: %#v", firewall)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix firewall: %#v", goaviatrix.Redact(firewall)))
 
 	d.SetId(firewall.GwName)
 	flag := false
-	defer func() { _ = resourceAviatrixFirewallReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixFirewallReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	// If base_policy or base_log enable is present, set base policy
 	if firewall.BasePolicy == "allow-all" {
@@ -163,24 +164,24 @@ func resourceAviatrixFirewallCreate(d *schema.ResourceData, meta any) error {
 			return fmt.Errorf("failed to set Aviatrix firewall policies for GW %s: %w", firewall.GwName, err)
 		}
 	}
-	return resourceAviatrixFirewallReadIfRequired(d, meta, &flag)
+	return resourceAviatrixFirewallReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixFirewallReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixFirewallReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixFirewallRead(d, meta)
+		return resourceAviatrixFirewallRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixFirewallRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixFirewallRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	gwName := getString(d, "gw_name")
 	if gwName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no gateway name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no gateway name received. Import Id is %s", id))
 		mustSet(d, "gw_name", id)
 		mustSet(d, "manage_firewall_policies", true)
 		d.SetId(id)
@@ -199,7 +200,7 @@ func resourceAviatrixFirewallRead(d *schema.ResourceData, meta any) error {
 		return fmt.Errorf("error fetching policy for gateway %s: %w", firewall.GwName, err)
 	}
 
-	log.Printf("[TRACE] Reading policy for gateway %s: %#v", firewall.GwName, fw)
+	tflog.Trace(ctx, fmt.Sprintf("Reading policy for gateway %s: %#v", firewall.GwName, goaviatrix.Redact(fw)))
 
 	var policiesFromFile []map[string]any
 	if fw != nil {
@@ -222,13 +223,13 @@ func resourceAviatrixFirewallRead(d *schema.ResourceData, meta any) error {
 	// Only write policies to state if the user has enabled in-line policies.
 	if getBool(d, "manage_firewall_policies") {
 		if err := d.Set("policy", policiesFromFile); err != nil {
-			log.Printf("[WARN] Error setting policy for (%s): %s", d.Id(), err)
+			tflog.Warn(ctx, fmt.Sprintf("Error setting policy for (%s): %s", d.Id(), err))
 		}
 	}
 	return nil
 }
 
-func resourceAviatrixFirewallUpdate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixFirewallUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	firewall := &goaviatrix.Firewall{
@@ -237,7 +238,7 @@ func resourceAviatrixFirewallUpdate(d *schema.ResourceData, meta any) error {
 
 	d.Partial(true)
 
-	log.Printf("[INFO] Creating Aviatrix firewall: %#v", firewall)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix firewall: %#v", goaviatrix.Redact(firewall)))
 
 	_, hasSetPolicies := d.GetOk("policy")
 	enabledInlinePolicies := getBool(d, "manage_firewall_policies")
@@ -302,7 +303,7 @@ func resourceAviatrixFirewallUpdate(d *schema.ResourceData, meta any) error {
 	}
 
 	d.Partial(false)
-	return resourceAviatrixFirewallRead(d, meta)
+	return resourceAviatrixFirewallRead(ctx, d, meta)
 }
 
 func resourceAviatrixFirewallDelete(d *schema.ResourceData, meta any) error {
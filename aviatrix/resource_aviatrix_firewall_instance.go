@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -13,14 +14,18 @@ import (
 
 func resourceAviatrixFirewallInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixFirewallInstanceCreate,
-		Read:   resourceAviatrixFirewallInstanceRead,
-		Update: resourceAviatrixFirewallInstanceUpdate,
-		Delete: resourceAviatrixFirewallInstanceDelete,
+		CreateContext:      withContext(resourceAviatrixFirewallInstanceCreate),
+		ReadWithoutTimeout: withContext(resourceAviatrixFirewallInstanceRead),
+		UpdateContext:      withContext(resourceAviatrixFirewallInstanceUpdate),
+		DeleteContext:      withContext(resourceAviatrixFirewallInstanceDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
 
+		CustomizeDiff: customizeDiffTagsAll(firewallInstanceTagCloudTypes),
+
+		Timeouts: gatewayTimeouts(),
+
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Type:             schema.TypeString,
@@ -258,11 +263,12 @@ func resourceAviatrixFirewallInstance() *schema.Resource {
 				Optional:    true,
 				Description: "A map of tags to assign to the firewall instance.",
 			},
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
-func resourceAviatrixFirewallInstanceCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixFirewallInstanceCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	firewallInstance := &goaviatrix.FirewallInstance{
@@ -304,12 +310,12 @@ func resourceAviatrixFirewallInstanceCreate(d *schema.ResourceData, meta any) er
 		var err error
 		cloudType, err = client.GetCloudTypeFromVpcID(firewallInstance.VpcID)
 		if err != nil {
-			log.Printf("[WARN] Could not get cloud_type from vpc_id: %v", err)
+			tflog.Warn(ctx, fmt.Sprintf("Could not get cloud_type from vpc_id: %v", err))
 		}
 	} else {
 		gw, err := client.GetGateway(&goaviatrix.Gateway{GwName: firewallInstance.GwName})
 		if err != nil {
-			log.Printf("[WARN] Could not get cloud_type from firenet_gw_name: %v", err)
+			tflog.Warn(ctx, fmt.Sprintf("Could not get cloud_type from firenet_gw_name: %v", err))
 		} else {
 			cloudType = gw.CloudType
 		}
@@ -336,8 +342,8 @@ func resourceAviatrixFirewallInstanceCreate(d *schema.ResourceData, meta any) er
 	firenetDetail, err := client.GetFireNet(&goaviatrix.FireNet{VpcID: firewallInstance.VpcID})
 	var isNativeGWLBVpc bool
 	if err != nil {
-		log.Printf("[INFO] Could not get FireNet detail for vpc_id(%s) because of (%v),"+
-			" assuming this is a non-GWLB vpc", firewallInstance.VpcID, err)
+		tflog.Info(ctx, fmt.Sprintf("[INFO] Could not get FireNet detail for vpc_id(%s) because of (%v),"+
+			" assuming this is a non-GWLB vpc", firewallInstance.VpcID, err))
 	} else {
 		isNativeGWLBVpc = firenetDetail.NativeGwlb
 	}
@@ -426,7 +432,7 @@ func resourceAviatrixFirewallInstanceCreate(d *schema.ResourceData, meta any) er
 		return fmt.Errorf("'firewall_image_id' is only supported for AWS")
 	}
 
-	tags, err := extractTags(d, cloudType)
+	tags, err := extractTags(d, cloudType, defaultTagsFor(client.GetDefaultTagsConfig(), cloudType, firewallInstanceTagCloudTypes))
 	if err != nil {
 		return fmt.Errorf("error creating tags for firewall instance: %w", err)
 	}
@@ -444,7 +450,7 @@ func resourceAviatrixFirewallInstanceCreate(d *schema.ResourceData, meta any) er
 		return fmt.Errorf("'availability_domain' and 'fault_domain' are only valid for OCI")
 	}
 
-	instanceID, err := client.CreateFirewallInstance(firewallInstance)
+	instanceID, err := client.CreateFirewallInstanceContext(ctx, firewallInstance)
 	if err != nil {
 		if errors.Is(err, goaviatrix.ErrNotFound) {
 			return fmt.Errorf("failed to get firewall instance information")
@@ -453,17 +459,17 @@ func resourceAviatrixFirewallInstanceCreate(d *schema.ResourceData, meta any) er
 	}
 
 	d.SetId(instanceID)
-	return resourceAviatrixFirewallInstanceRead(d, meta)
+	return resourceAviatrixFirewallInstanceRead(ctx, d, meta)
 }
 
-func resourceAviatrixFirewallInstanceRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixFirewallInstanceRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 	ignoreTagsConfig := client.IgnoreTagsConfig
 
 	instanceID := getString(d, "instance_id")
 	if instanceID == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no firewall names received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no firewall names received. Import Id is %s", id))
 		mustSet(d, "instance_id", id)
 		d.SetId(id)
 	}
@@ -481,7 +487,7 @@ func resourceAviatrixFirewallInstanceRead(d *schema.ResourceData, meta any) erro
 		return fmt.Errorf("couldn't find Firewall Instance: %w", err)
 	}
 
-	log.Printf("[INFO] Found Firewall Instance: %#v", firewallInstance)
+	tflog.Info(ctx, fmt.Sprintf("Found Firewall Instance: %#v", goaviatrix.Redact(firewallInstance)))
 
 	cloudType := goaviatrix.VendorToCloudType(fI.CloudVendor)
 	mustSet(d, "cloud_type", cloudType)
@@ -556,12 +562,9 @@ func resourceAviatrixFirewallInstanceRead(d *schema.ResourceData, meta any) erro
 	if fI.UserData != "" {
 		mustSet(d, "user_data", fI.UserData)
 	}
-	if len(fI.Tags) > 0 {
-		tags := goaviatrix.KeyValueTags(fI.Tags).IgnoreConfig(ignoreTagsConfig)
-		err := d.Set("tags", tags)
-		if err != nil {
-			return fmt.Errorf("failed to set tags for firewall_instance on read: %w", err)
-		}
+	tags := goaviatrix.KeyValueTags(fI.Tags).IgnoreConfig(ignoreTagsConfig)
+	if err := setTagsAll(d, tags, client.GetDefaultTagsConfig()); err != nil {
+		return fmt.Errorf("failed to set tags for firewall_instance on read: %w", err)
 	}
 	if fI.FirewallImageId != "" && goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes) {
 		mustSet(d, "firewall_image_id", fI.FirewallImageId)
@@ -574,14 +577,15 @@ func resourceAviatrixFirewallInstanceRead(d *schema.ResourceData, meta any) erro
 	return nil
 }
 
-func resourceAviatrixFirewallInstanceUpdate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixFirewallInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	if d.HasChange("firewall_image_id") {
 		return fmt.Errorf("can not change firewall_image_id")
 	}
 
 	client := mustClient(meta)
-	if d.HasChange("tags") {
-		tags, err := extractTags(d, getInt(d, "cloud_type"))
+	if d.HasChanges("tags", "tags_all") {
+		cloudType := getInt(d, "cloud_type")
+		tags, err := extractTags(d, cloudType, defaultTagsFor(client.GetDefaultTagsConfig(), cloudType, firewallInstanceTagCloudTypes))
 		if err != nil {
 			return fmt.Errorf("failed to extract tags: %w", err)
 		}
@@ -595,17 +599,17 @@ func resourceAviatrixFirewallInstanceUpdate(d *schema.ResourceData, meta any) er
 			TagJson:    tagJSON,
 		}
 
-		log.Printf("[INFO] Updating firewall instance tags: %#v", firewallInstance)
+		tflog.Info(ctx, fmt.Sprintf("Updating firewall instance tags: %#v", goaviatrix.Redact(firewallInstance)))
 
 		err = client.UpdateFirewallInstanceTags(firewallInstance)
 		if err != nil {
 			return fmt.Errorf("failed to update tags for firewall: %w", err)
 		}
 	}
-	return resourceAviatrixFirewallInstanceRead(d, meta)
+	return resourceAviatrixFirewallInstanceRead(ctx, d, meta)
 }
 
-func resourceAviatrixFirewallInstanceDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixFirewallInstanceDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	firewallInstance := &goaviatrix.FirewallInstance{
@@ -616,9 +620,9 @@ func resourceAviatrixFirewallInstanceDelete(d *schema.ResourceData, meta any) er
 		firewallInstance.VpcID = getString(d, "gcp_vpc_id")
 	}
 
-	log.Printf("[INFO] Deleting firewall instance: %#v", firewallInstance)
+	tflog.Info(ctx, fmt.Sprintf("Deleting firewall instance: %#v", goaviatrix.Redact(firewallInstance)))
 
-	err := client.DeleteFirewallInstance(firewallInstance)
+	err := client.DeleteFirewallInstanceContext(ctx, firewallInstance)
 	if err != nil {
 		return fmt.Errorf("failed to delete firewall instance: %w", err)
 	}
@@ -1,12 +1,13 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -17,9 +18,9 @@ const FQDNVendorType = "fqdn_gateway"
 
 func resourceAviatrixFirewallInstanceAssociation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixFirewallInstanceAssociationCreate,
-		Read:   resourceAviatrixFirewallInstanceAssociationRead,
-		Delete: resourceAviatrixFirewallInstanceAssociationDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixFirewallInstanceAssociationCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixFirewallInstanceAssociationRead),
+		Delete:               resourceAviatrixFirewallInstanceAssociationDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -102,7 +103,7 @@ func marshalFirewallInstanceAssociationInput(d *schema.ResourceData) *goaviatrix
 	}
 }
 
-func resourceAviatrixFirewallInstanceAssociationCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixFirewallInstanceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	firewall := marshalFirewallInstanceAssociationInput(d)
@@ -118,7 +119,7 @@ func resourceAviatrixFirewallInstanceAssociationCreate(d *schema.ResourceData, m
 		fwInfo, err := client.GetFirewallInstance(firewall)
 		if err != nil {
 			// Cannot find the firewall instance, likely created outside of Aviatrix controller
-			log.Printf("[INFO] Failed to get firewall details before creating association: %v\n", err)
+			tflog.Info(ctx, fmt.Sprintf("Failed to get firewall details before creating association: %v", err))
 		} else {
 			cloudType = goaviatrix.VendorToCloudType(fwInfo.CloudVendor)
 		}
@@ -137,7 +138,7 @@ func resourceAviatrixFirewallInstanceAssociationCreate(d *schema.ResourceData, m
 	id := fmt.Sprintf("%s~~%s~~%s", firewall.VpcID, firewall.GwName, firewall.InstanceID)
 	d.SetId(id)
 	flag := false
-	defer func() { _ = resourceAviatrixFirewallInstanceAssociationReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixFirewallInstanceAssociationReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	err := client.AssociateFirewallWithFireNet(firewall)
 	if err != nil {
@@ -151,18 +152,18 @@ func resourceAviatrixFirewallInstanceAssociationCreate(d *schema.ResourceData, m
 		}
 	}
 
-	return resourceAviatrixFirewallInstanceAssociationReadIfRequired(d, meta, &flag)
+	return resourceAviatrixFirewallInstanceAssociationReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixFirewallInstanceAssociationReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixFirewallInstanceAssociationReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixFirewallInstanceAssociationRead(d, meta)
+		return resourceAviatrixFirewallInstanceAssociationRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixFirewallInstanceAssociationRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixFirewallInstanceAssociationRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	vpcID := getString(d, "vpc_id")
@@ -170,7 +171,7 @@ func resourceAviatrixFirewallInstanceAssociationRead(d *schema.ResourceData, met
 	instanceID := getString(d, "instance_id")
 	if vpcID == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no vpc_id received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no vpc_id received. Import Id is %s", id))
 
 		parts := strings.Split(id, "~~")
 		if len(parts) != 3 {
@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -13,9 +14,9 @@ import (
 
 func resourceAviatrixFirewallManagementAccess() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixFirewallManagementAccessCreate,
-		Read:   resourceAviatrixFirewallManagementAccessRead,
-		Delete: resourceAviatrixFirewallManagementAccessDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixFirewallManagementAccessCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixFirewallManagementAccessRead),
+		DeleteWithoutTimeout: withContext(resourceAviatrixFirewallManagementAccessDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -37,7 +38,7 @@ func resourceAviatrixFirewallManagementAccess() *schema.Resource {
 	}
 }
 
-func resourceAviatrixFirewallManagementAccessCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixFirewallManagementAccessCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	firewallManagementAccess := &goaviatrix.FirewallManagementAccess{
@@ -45,36 +46,36 @@ func resourceAviatrixFirewallManagementAccessCreate(d *schema.ResourceData, meta
 		ManagementAccessResourceName: getString(d, "management_access_resource_name"),
 	}
 
-	log.Printf("[INFO] Creating Aviatrix firewall management access: %#v", firewallManagementAccess)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix firewall management access: %#v", goaviatrix.Redact(firewallManagementAccess)))
 
 	d.SetId(firewallManagementAccess.TransitFireNetGatewayName + "~" + firewallManagementAccess.ManagementAccessResourceName)
 	flag := false
-	defer func() { _ = resourceAviatrixFirewallManagementAccessReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixFirewallManagementAccessReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	err := client.EditFirewallManagementAccess(firewallManagementAccess)
 	if err != nil {
 		return fmt.Errorf("failed to create Aviatrix firewall management access: %w", err)
 	}
 
-	return resourceAviatrixFirewallManagementAccessReadIfRequired(d, meta, &flag)
+	return resourceAviatrixFirewallManagementAccessReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixFirewallManagementAccessReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixFirewallManagementAccessReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixFirewallManagementAccessRead(d, meta)
+		return resourceAviatrixFirewallManagementAccessRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixFirewallManagementAccessRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixFirewallManagementAccessRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	transitFireNetGatewayName := getString(d, "transit_firenet_gateway_name")
 
 	if transitFireNetGatewayName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no transit firenet gateway name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no transit firenet gateway name received. Import Id is %s", id))
 		mustSet(d, "transit_firenet_gateway_name", strings.Split(id, "~")[0])
 		d.SetId(id)
 	}
@@ -98,7 +99,7 @@ func resourceAviatrixFirewallManagementAccessRead(d *schema.ResourceData, meta a
 	return nil
 }
 
-func resourceAviatrixFirewallManagementAccessDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixFirewallManagementAccessDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	firewallManagementAccess := &goaviatrix.FirewallManagementAccess{
@@ -106,7 +107,7 @@ func resourceAviatrixFirewallManagementAccessDelete(d *schema.ResourceData, meta
 		ManagementAccessResourceName: "no",
 	}
 
-	log.Printf("[INFO] Destroying Aviatrix firewall management access: %#v", firewallManagementAccess)
+	tflog.Info(ctx, fmt.Sprintf("Destroying Aviatrix firewall management access: %#v", goaviatrix.Redact(firewallManagementAccess)))
 
 	err := client.EditFirewallManagementAccess(firewallManagementAccess)
 	if err != nil {
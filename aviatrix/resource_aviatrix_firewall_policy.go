@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,9 +16,9 @@ import (
 
 func resourceAviatrixFirewallPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixFirewallPolicyCreate,
-		Read:   resourceAviatrixFirewallPolicyRead,
-		Delete: resourceAviatrixFirewallPolicyDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixFirewallPolicyCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixFirewallPolicyRead),
+		Delete:               resourceAviatrixFirewallPolicyDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -116,14 +117,14 @@ func marshalFirewallPolicyInput(d *schema.ResourceData) *goaviatrix.Firewall {
 	}
 }
 
-func resourceAviatrixFirewallPolicyCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixFirewallPolicyCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	fw := marshalFirewallPolicyInput(d)
 
 	d.SetId(getFirewallPolicyID(fw))
 	flag := false
-	defer func() { _ = resourceAviatrixFirewallPolicyReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixFirewallPolicyReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 	if fw.PolicyList[0].Position == 0 {
 		if err := client.AddFirewallPolicy(fw); err != nil {
 			return err
@@ -134,18 +135,18 @@ func resourceAviatrixFirewallPolicyCreate(d *schema.ResourceData, meta any) erro
 		}
 	}
 
-	return resourceAviatrixFirewallPolicyReadIfRequired(d, meta, &flag)
+	return resourceAviatrixFirewallPolicyReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixFirewallPolicyReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixFirewallPolicyReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixFirewallPolicyRead(d, meta)
+		return resourceAviatrixFirewallPolicyRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixFirewallPolicyRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixFirewallPolicyRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	gwName := getString(d, "gw_name")
@@ -161,7 +162,7 @@ func resourceAviatrixFirewallPolicyRead(d *schema.ResourceData, meta any) error
 	description := getString(d, "description")
 	if gwName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no firewall_policy received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no firewall_policy received. Import Id is %s", id))
 
 		parts := strings.Split(id, "~")
 		if len(parts) != 6 {
@@ -1,10 +1,11 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -12,10 +13,10 @@ import (
 
 func resourceAviatrixFirewallTag() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixFirewallTagCreate,
-		Read:   resourceAviatrixFirewallTagRead,
-		Update: resourceAviatrixFirewallTagUpdate,
-		Delete: resourceAviatrixFirewallTagDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixFirewallTagCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixFirewallTagRead),
+		UpdateWithoutTimeout: withContext(resourceAviatrixFirewallTagUpdate),
+		Delete:               resourceAviatrixFirewallTagDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -49,7 +50,7 @@ func resourceAviatrixFirewallTag() *schema.Resource {
 	}
 }
 
-func resourceAviatrixFirewallTagCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixFirewallTagCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	firewallTag := &goaviatrix.FirewallTag{
@@ -62,7 +63,7 @@ func resourceAviatrixFirewallTagCreate(d *schema.ResourceData, meta any) error {
 
 	d.SetId(firewallTag.Name)
 	flag := false
-	defer func() { _ = resourceAviatrixFirewallTagReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixFirewallTagReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	err := client.CreateFirewallTag(firewallTag)
 	if err != nil {
@@ -93,24 +94,24 @@ func resourceAviatrixFirewallTagCreate(d *schema.ResourceData, meta any) error {
 		}
 	}
 
-	return resourceAviatrixFirewallTagReadIfRequired(d, meta, &flag)
+	return resourceAviatrixFirewallTagReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixFirewallTagReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixFirewallTagReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixFirewallTagRead(d, meta)
+		return resourceAviatrixFirewallTagRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixFirewallTagRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixFirewallTagRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	fTag := getString(d, "firewall_tag")
 	if fTag == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no firewall tag name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no firewall tag name received. Import Id is %s", id))
 		mustSet(d, "firewall_tag", id)
 		d.SetId(id)
 	}
@@ -127,7 +128,7 @@ func resourceAviatrixFirewallTagRead(d *schema.ResourceData, meta any) error {
 		return fmt.Errorf("error fetching firewall tag %s: %w", firewallTag.Name, err)
 	}
 
-	log.Printf("[TRACE] Reading cidr list for tag %s: %#v", firewallTag.Name, fwt)
+	tflog.Trace(ctx, fmt.Sprintf("Reading cidr list for tag %s: %#v", firewallTag.Name, goaviatrix.Redact(fwt)))
 
 	if fwt != nil {
 		var cidrList []map[string]any
@@ -140,14 +141,14 @@ func resourceAviatrixFirewallTagRead(d *schema.ResourceData, meta any) error {
 		}
 
 		if err := d.Set("cidr_list", cidrList); err != nil {
-			log.Printf("[WARN] Error setting cidr_list for (%s): %s", d.Id(), err)
+			tflog.Warn(ctx, fmt.Sprintf("Error setting cidr_list for (%s): %s", d.Id(), err))
 		}
 	}
 
 	return nil
 }
 
-func resourceAviatrixFirewallTagUpdate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixFirewallTagUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	firewallTag := &goaviatrix.FirewallTag{
@@ -156,7 +157,7 @@ func resourceAviatrixFirewallTagUpdate(d *schema.ResourceData, meta any) error {
 
 	d.Partial(true)
 
-	log.Printf("[INFO] Creating Aviatrix firewall: %#v", firewallTag)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix firewall: %#v", goaviatrix.Redact(firewallTag)))
 
 	// Update cidr list
 	cidrList := getList(d, "cidr_list")
@@ -175,7 +176,7 @@ func resourceAviatrixFirewallTagUpdate(d *schema.ResourceData, meta any) error {
 	}
 
 	d.Partial(false)
-	return resourceAviatrixFirewallTagRead(d, meta)
+	return resourceAviatrixFirewallTagRead(ctx, d, meta)
 }
 
 func resourceAviatrixFirewallTagDelete(d *schema.ResourceData, meta any) error {
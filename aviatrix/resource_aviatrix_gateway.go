@@ -1,12 +1,14 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,14 +18,21 @@ import (
 
 func resourceAviatrixGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixGatewayCreate,
-		Read:   resourceAviatrixGatewayRead,
-		Update: resourceAviatrixGatewayUpdate,
-		Delete: resourceAviatrixGatewayDelete,
+		CreateContext:      withContext(resourceAviatrixGatewayCreate),
+		ReadWithoutTimeout: withContext(resourceAviatrixGatewayRead),
+		UpdateContext:      withContext(resourceAviatrixGatewayUpdate),
+		DeleteContext:      withContext(resourceAviatrixGatewayDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
 
+		CustomizeDiff: customdiff.All(
+			customizeDiffGatewayCapabilities(gatewayPlanChecks),
+			customizeDiffTagsAll(gatewayTagCloudTypes),
+		),
+
+		Timeouts: gatewayTimeouts(),
+
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
 			{
@@ -410,6 +419,7 @@ func resourceAviatrixGateway() *schema.Resource {
 				Optional:    true,
 				Description: "A map of tags to assign to the gateway.",
 			},
+			"tags_all": tagsAllSchema(),
 			"enable_spot_instance": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -603,8 +613,8 @@ func resourceAviatrixGateway() *schema.Resource {
 	}
 }
 
-func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixGatewayCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustGatewayClient(meta)
 
 	gateway := &goaviatrix.Gateway{
 		CloudType:          getInt(d, "cloud_type"),
@@ -895,8 +905,8 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta any) error {
 
 	enableEncryptVolume := getBool(d, "enable_encrypt_volume")
 	customerManagedKeys := getString(d, "customer_managed_keys")
-	if enableEncryptVolume && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-		return fmt.Errorf("'enable_encrypt_volume' is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768) providers")
+	if enableEncryptVolume && !featureEncryptVolume.supports(gateway.CloudType) {
+		return featureEncryptVolume.unsupportedError()
 	}
 	if customerManagedKeys != "" {
 		if !enableEncryptVolume {
@@ -914,19 +924,20 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta any) error {
 		excludedInstances = append(excludedInstances, mustString(v))
 	}
 	// Enable monitor gateway subnets does not work with AWSChina
-	if enableMonitorSubnets && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes^goaviatrix.AWSChina) {
-		return fmt.Errorf("'enable_monitor_gateway_subnets' is only valid for AWS (1), AWSGov (256), AWS Top Secret (16384) or AWS Secret (32768)")
+	if enableMonitorSubnets && !featureMonitorGatewaySubnets.supports(gateway.CloudType) {
+		return featureMonitorGatewaySubnets.unsupportedError()
 	}
 	if !enableMonitorSubnets && len(excludedInstances) != 0 {
 		return fmt.Errorf("'monitor_exclude_list' must be empty if 'enable_monitor_gateway_subnets' is false")
 	}
 
 	_, tagsOk := d.GetOk("tags")
-	if tagsOk {
+	defaultTags := defaultTagsFor(client.GetDefaultTagsConfig(), gateway.CloudType, gatewayTagCloudTypes)
+	if tagsOk || defaultTags != nil {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
 			return errors.New("failed to create gateway: adding tags is only supported for AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
 		}
-		tagsMap, err := extractTags(d, gateway.CloudType)
+		tagsMap, err := extractTags(d, gateway.CloudType, defaultTags)
 		if err != nil {
 			return fmt.Errorf("error creating tags for gateway: %w", err)
 		}
@@ -941,8 +952,8 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta any) error {
 	spotPrice := getString(d, "spot_price")
 	deleteSpot := getBool(d, "delete_spot")
 	if enableSpotInstance {
-		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("enable_spot_instance only supports AWS and Azure related cloud types")
+		if !featureSpotInstance.supports(gateway.CloudType) {
+			return featureSpotInstance.unsupportedError()
 		}
 
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && deleteSpot {
@@ -958,23 +969,23 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta any) error {
 
 	rxQueueSize := getString(d, "rx_queue_size")
 	if rxQueueSize != "" {
-		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-			return fmt.Errorf("rx_queue_size only supports AWS related cloud types")
+		if !featureRxQueueSize.supports(gateway.CloudType) {
+			return featureRxQueueSize.unsupportedError()
 		} else {
 			gateway.RxQueueSize = rxQueueSize
 		}
 	}
 
-	log.Printf("[INFO] Creating Aviatrix gateway: %#v", gateway)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix gateway: %#v", goaviatrix.Redact(gateway)))
 
 	d.SetId(gateway.GwName)
 	flag := false
-	defer func() { _ = resourceAviatrixGatewayReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixGatewayReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	if getBool(d, "enable_public_subnet_filtering") {
-		err := client.CreatePublicSubnetFilteringGateway(gateway)
+		err := client.CreatePublicSubnetFilteringGatewayContext(ctx, gateway)
 		if err != nil {
-			log.Printf("[INFO] failed to create public subnet filtering gateway: %#v", gateway)
+			tflog.Info(ctx, fmt.Sprintf("failed to create public subnet filtering gateway: %#v", goaviatrix.Redact(gateway)))
 			return fmt.Errorf("could not create public subnet filtering gateway: %w", err)
 		}
 		if !getBool(d, "public_subnet_filtering_guard_duty_enforced") {
@@ -984,9 +995,9 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta any) error {
 			}
 		}
 	} else {
-		err := client.CreateGateway(gateway)
+		err := client.CreateGatewayContext(ctx, gateway)
 		if err != nil {
-			log.Printf("[INFO] failed to create Aviatrix gateway: %#v", gateway)
+			tflog.Info(ctx, fmt.Sprintf("failed to create Aviatrix gateway: %#v", goaviatrix.Redact(gateway)))
 			return fmt.Errorf("failed to create Aviatrix gateway: %w", err)
 		}
 	}
@@ -1010,7 +1021,7 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta any) error {
 			SingleAZ: "yes",
 		}
 
-		log.Printf("[INFO] Enable Single AZ GW HA: %#v", singleAZGateway)
+		tflog.Info(ctx, fmt.Sprintf("Enable Single AZ GW HA: %#v", goaviatrix.Redact(singleAZGateway)))
 
 		err := client.EnableSingleAZGateway(singleAZGateway)
 		if err != nil {
@@ -1098,7 +1109,7 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta any) error {
 		}
 
 		if getBool(d, "enable_public_subnet_filtering") {
-			log.Printf("[INFO] Enable public subnet filtering HA: %#v", peeringHaGateway)
+			tflog.Info(ctx, fmt.Sprintf("Enable public subnet filtering HA: %#v", goaviatrix.Redact(peeringHaGateway)))
 			var haRouteTables []string
 			for _, v := range getSet(d, "public_subnet_filtering_ha_route_tables").List() {
 				haRouteTables = append(haRouteTables, mustString(v))
@@ -1110,14 +1121,14 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta any) error {
 				return fmt.Errorf("could not create public subnet filtering gateway HA: %w", err)
 			}
 		} else {
-			log.Printf("[INFO] Enable peering HA: %#v", peeringHaGateway)
+			tflog.Info(ctx, fmt.Sprintf("Enable peering HA: %#v", goaviatrix.Redact(peeringHaGateway)))
 			err := client.EnablePeeringHaGateway(peeringHaGateway)
 			if err != nil {
 				return fmt.Errorf("failed to create peering HA: %w", err)
 			}
 		}
 
-		log.Printf("[INFO] Resizing Peering HA Gateway: %#v", peeringHaGwSize)
+		tflog.Info(ctx, fmt.Sprintf("Resizing Peering HA Gateway: %#v", peeringHaGwSize))
 		if peeringHaGwSize != gateway.VpcSize {
 			if peeringHaGwSize == "" {
 				return fmt.Errorf("a valid non empty peering_ha_gw_size parameter is mandatory for " +
@@ -1125,12 +1136,12 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta any) error {
 			}
 			peeringHaGateway := &goaviatrix.Gateway{
 				CloudType: getInt(d, "cloud_type"),
-				GwName:    getString(d, "gw_name") + "-hagw", // CHECK THE NAME of peering ha gateway in
+				GwName:    goaviatrix.HaGatewayName(getString(d, "gw_name")), // CHECK THE NAME of peering ha gateway in
 				// controller, test out first. just assuming it has that suffix
 			}
 			peeringHaGateway.VpcSize = peeringHaGwSize
-			err := client.UpdateGateway(peeringHaGateway)
-			log.Printf("[INFO] Resizing Peering Ha Gateway size to: %s,", peeringHaGateway.VpcSize)
+			err := client.UpdateGatewayContext(ctx, peeringHaGateway)
+			tflog.Info(ctx, fmt.Sprintf("Resizing Peering Ha Gateway size to: %s,", peeringHaGateway.VpcSize))
 			if err != nil {
 				return fmt.Errorf("failed to update Aviatrix Peering HA Gateway size: %w", err)
 			}
@@ -1143,7 +1154,7 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta any) error {
 			GwName: getString(d, "gw_name"),
 		}
 
-		log.Printf("[INFO] Enable VPC DNS Server: %#v", gwVpcDnsServer)
+		tflog.Info(ctx, fmt.Sprintf("Enable VPC DNS Server: %#v", goaviatrix.Redact(gwVpcDnsServer)))
 
 		err := client.EnableVpcDNSServer(gwVpcDnsServer)
 		if err != nil {
@@ -1154,7 +1165,7 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta any) error {
 	}
 
 	if enableMonitorSubnets {
-		log.Printf("[INFO] Enable Monitor Gateway Subnets")
+		tflog.Info(ctx, "Enable Monitor Gateway Subnets")
 		err := client.EnableMonitorGatewaySubnets(gateway.GwName, excludedInstances)
 		if err != nil {
 			return fmt.Errorf("could not enable monitor gateway subnets: %w", err)
@@ -1185,7 +1196,7 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta any) error {
 			Name:  "Idle timeout",
 			Value: strconv.Itoa(idleTimeoutValue),
 		}
-		log.Printf("[INFO] Enable Modify VPN Config (Idle Timeout)")
+		tflog.Info(ctx, "Enable Modify VPN Config (Idle Timeout)")
 		err := client.EnableVPNConfig(gatewayServer, enableVPNServer)
 		if err != nil {
 			return fmt.Errorf("fail to enable idle timeout: %w", err)
@@ -1212,7 +1223,7 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta any) error {
 			Name:  "Renegotiation interval",
 			Value: strconv.Itoa(renegoIntervalValue),
 		}
-		log.Printf("[INFO] Enable Modify VPN Config (Renegotiation Interval)")
+		tflog.Info(ctx, "Enable Modify VPN Config (Renegotiation Interval)")
 		err := client.EnableVPNConfig(gatewayServer, enableVPNServer)
 		if err != nil {
 			return fmt.Errorf("fail to enable renegotiation interval: %w", err)
@@ -1263,7 +1274,7 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta any) error {
 		}
 		if peeringHaSubnet != "" || peeringHaZone != "" {
 			haGwRxQueueSize := &goaviatrix.Gateway{
-				GwName:      getString(d, "gw_name") + "-hagw",
+				GwName:      goaviatrix.HaGatewayName(getString(d, "gw_name")),
 				RxQueueSize: rxQueueSize,
 			}
 			err := client.SetRxQueueSize(haGwRxQueueSize)
@@ -1273,27 +1284,27 @@ func resourceAviatrixGatewayCreate(d *schema.ResourceData, meta any) error {
 		}
 	}
 
-	return resourceAviatrixGatewayReadIfRequired(d, meta, &flag)
+	return resourceAviatrixGatewayReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixGatewayReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixGatewayReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixGatewayRead(d, meta)
+		return resourceAviatrixGatewayRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixGatewayRead(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
-	ignoreTagsConfig := client.IgnoreTagsConfig
+func resourceAviatrixGatewayRead(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustGatewayClient(meta)
+	ignoreTagsConfig := client.GetIgnoreTagsConfig()
 
 	var isImport bool
 	gwName := getString(d, "gw_name")
 	if gwName == "" {
 		isImport = true
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no gateway name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no gateway name received. Import Id is %s", id))
 		mustSet(d, "gw_name", id)
 		d.SetId(id)
 	}
@@ -1312,7 +1323,7 @@ func resourceAviatrixGatewayRead(d *schema.ResourceData, meta any) error {
 		return fmt.Errorf("couldn't find Aviatrix Gateway %s: %w", gwName, err)
 	}
 
-	log.Printf("[TRACE] reading gateway %s: %#v", getString(d, "gw_name"), gw)
+	tflog.Trace(ctx, fmt.Sprintf("reading gateway %s: %#v", getString(d, "gw_name"), goaviatrix.Redact(gw)))
 	mustSet(d, "cloud_type", gw.CloudType)
 	mustSet(d, "account_name", gw.AccountName)
 	mustSet(d, "gw_name", gw.GwName)
@@ -1349,7 +1360,7 @@ func resourceAviatrixGatewayRead(d *schema.ResourceData, meta any) error {
 		if len(azureEip) == 3 {
 			mustSet(d, "azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 		} else {
-			log.Printf("[WARN] could not get Azure EIP name and resource group for the Gateway %s", gw.GwName)
+			tflog.Warn(ctx, fmt.Sprintf("could not get Azure EIP name and resource group for the Gateway %s", gw.GwName))
 		}
 	}
 
@@ -1483,8 +1494,8 @@ func resourceAviatrixGatewayRead(d *schema.ResourceData, meta any) error {
 
 	if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
 		tags := goaviatrix.KeyValueTags(gw.Tags).IgnoreConfig(ignoreTagsConfig)
-		if err := d.Set("tags", tags); err != nil {
-			log.Printf("[WARN] Error setting tags for (%s): %s", d.Id(), err)
+		if err := setTagsAll(d, tags, client.GetDefaultTagsConfig()); err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Error setting tags for (%s): %s", d.Id(), err))
 		}
 	}
 
@@ -1604,7 +1615,7 @@ func resourceAviatrixGatewayRead(d *schema.ResourceData, meta any) error {
 		if len(azureEip) == 3 {
 			mustSet(d, "peering_ha_azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 		} else {
-			log.Printf("[WARN] could not get Azure EIP name and resource group for the Peering HA Gateway %s", gw.GwName)
+			tflog.Warn(ctx, fmt.Sprintf("could not get Azure EIP name and resource group for the Peering HA Gateway %s", gw.GwName))
 		}
 	}
 
@@ -1639,10 +1650,10 @@ func resourceAviatrixGatewayRead(d *schema.ResourceData, meta any) error {
 	return nil
 }
 
-func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustGatewayClient(meta)
 
-	log.Printf("[INFO] Updating Aviatrix gateway: %#v", getString(d, "gw_name"))
+	tflog.Info(ctx, fmt.Sprintf("Updating Aviatrix gateway: %#v", getString(d, "gw_name")))
 
 	d.Partial(true)
 	if d.HasChange("vpn_access") {
@@ -1739,7 +1750,7 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta any) error {
 
 	peeringHaGateway := &goaviatrix.Gateway{
 		CloudType: getInt(d, "cloud_type"),
-		GwName:    getString(d, "gw_name") + "-hagw",
+		GwName:    goaviatrix.HaGatewayName(getString(d, "gw_name")),
 		VpcSize:   getString(d, "peering_ha_gw_size"),
 	}
 
@@ -1748,7 +1759,7 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta any) error {
 	if d.HasChange("gw_size") {
 		old, _ := d.GetChange("gw_size")
 		primaryGwSize = mustString(old)
-		err := client.UpdateGateway(gateway)
+		err := client.UpdateGatewayContext(ctx, gateway)
 		if err != nil {
 			return fmt.Errorf("failed to update Aviatrix Gateway: %w", err)
 		}
@@ -1877,7 +1888,7 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta any) error {
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
 			return fmt.Errorf("failed to update gateway: adding tags is only supported for AWS (1), Azure (8), AzureGov (32), AWSGov(256) AWSChina (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
 		}
@@ -1888,7 +1899,7 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta any) error {
 			CloudType:    gateway.CloudType,
 		}
 
-		tagsMap, err := extractTags(d, gateway.CloudType)
+		tagsMap, err := extractTags(d, gateway.CloudType, defaultTagsFor(client.GetDefaultTagsConfig(), gateway.CloudType, gatewayTagCloudTypes))
 		if err != nil {
 			return fmt.Errorf("failed to update tags for gateway: %w", err)
 		}
@@ -2016,7 +2027,7 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta any) error {
 				return fmt.Errorf("failed to update vpn cidr: %w", err)
 			}
 		} else {
-			log.Printf("[INFO] can't update vpn cidr because vpn_access is disabled for gateway: %#v", gateway.GwName)
+			tflog.Info(ctx, fmt.Sprintf("can't update vpn cidr because vpn_access is disabled for gateway: %#v", gateway.GwName))
 		}
 	}
 	if d.HasChange("max_vpn_conn") {
@@ -2043,7 +2054,7 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta any) error {
 				return fmt.Errorf("failed to update max vpn connections: %w", err)
 			}
 		} else {
-			log.Printf("[INFO] can't update max vpn connections because vpn is disabled for gateway: %#v", gateway.GwName)
+			tflog.Info(ctx, fmt.Sprintf("can't update max vpn connections because vpn is disabled for gateway: %#v", gateway.GwName))
 		}
 	}
 
@@ -2157,12 +2168,12 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta any) error {
 					return fmt.Errorf("failed to enable Aviatrix public subnet filtering HA gateway: %w", err)
 				}
 			} else if deleteHaGw {
-				err := client.DeletePublicSubnetFilteringGateway(peeringHaGateway)
+				err := client.DeletePublicSubnetFilteringGatewayContext(ctx, peeringHaGateway)
 				if err != nil {
 					return fmt.Errorf("failed to delete Aviatrix public subnet filtering HA gateway: %w", err)
 				}
 			} else if changeHaGw {
-				err := client.DeletePublicSubnetFilteringGateway(peeringHaGateway)
+				err := client.DeletePublicSubnetFilteringGatewayContext(ctx, peeringHaGateway)
 				if err != nil {
 					return fmt.Errorf("failed to delete Aviatrix public subnet filtering HA gateway: %w", err)
 				}
@@ -2186,7 +2197,7 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta any) error {
 				if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.AWSRelatedCloudTypes) {
 					if getString(d, "rx_queue_size") != "" && !d.HasChange("rx_queue_size") {
 						haGwRxQueueSize := &goaviatrix.Gateway{
-							GwName:      getString(d, "gw_name") + "-hagw",
+							GwName:      goaviatrix.HaGatewayName(getString(d, "gw_name")),
 							RxQueueSize: getString(d, "rx_queue_size"),
 						}
 						err := client.SetRxQueueSize(haGwRxQueueSize)
@@ -2196,12 +2207,12 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta any) error {
 					}
 				}
 			} else if deleteHaGw {
-				err := client.DeleteGateway(peeringHaGateway)
+				err := client.DeleteGatewayContext(ctx, peeringHaGateway)
 				if err != nil {
 					return fmt.Errorf("failed to delete Aviatrix peering HA gateway: %w", err)
 				}
 			} else if changeHaGw {
-				err := client.DeleteGateway(peeringHaGateway)
+				err := client.DeleteGatewayContext(ctx, peeringHaGateway)
 				if err != nil {
 					return fmt.Errorf("failed to delete Aviatrix peering HA gateway: %w", err)
 				}
@@ -2234,7 +2245,7 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta any) error {
 		}
 
 		if singleAZ {
-			log.Printf("[INFO] Enable Single AZ GW HA: %#v", singleAZGateway)
+			tflog.Info(ctx, fmt.Sprintf("Enable Single AZ GW HA: %#v", goaviatrix.Redact(singleAZGateway)))
 
 			err := client.EnableSingleAZGateway(singleAZGateway)
 			if err != nil {
@@ -2243,7 +2254,7 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta any) error {
 
 			if haEnabled {
 				singleAZGatewayHA := &goaviatrix.Gateway{
-					GwName: getString(d, "gw_name") + "-hagw",
+					GwName: goaviatrix.HaGatewayName(getString(d, "gw_name")),
 				}
 				err := client.EnableSingleAZGateway(singleAZGatewayHA)
 				if err != nil {
@@ -2251,7 +2262,7 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta any) error {
 				}
 			}
 		} else {
-			log.Printf("[INFO] Disable Single AZ GW HA: %#v", singleAZGateway)
+			tflog.Info(ctx, fmt.Sprintf("Disable Single AZ GW HA: %#v", goaviatrix.Redact(singleAZGateway)))
 			err := client.DisableSingleAZGateway(singleAZGateway)
 			if err != nil {
 				return fmt.Errorf("failed to disable single AZ GW HA for %s: %w", singleAZGateway.GwName, err)
@@ -2259,7 +2270,7 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta any) error {
 
 			if haEnabled {
 				singleAZGatewayHA := &goaviatrix.Gateway{
-					GwName: getString(d, "gw_name") + "-hagw",
+					GwName: goaviatrix.HaGatewayName(getString(d, "gw_name")),
 				}
 				err := client.DisableSingleAZGateway(singleAZGatewayHA)
 				if err != nil {
@@ -2288,8 +2299,8 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta any) error {
 					return fmt.Errorf("a valid non empty peering_ha_gw_size parameter is mandatory for this resource if " +
 						"peering_ha_subnet or peering_ha_zone is set. Example: t2.micro or us-west1-b respectively")
 				}
-				err = client.UpdateGateway(peeringHaGateway)
-				log.Printf("[INFO] Updating Peering HA Gateway size to: %s ", peeringHaGateway.VpcSize)
+				err = client.UpdateGatewayContext(ctx, peeringHaGateway)
+				tflog.Info(ctx, fmt.Sprintf("Updating Peering HA Gateway size to: %s", peeringHaGateway.VpcSize))
 				if err != nil {
 					return fmt.Errorf("failed to update Aviatrix Peering HA Gw size: %w", err)
 				}
@@ -2352,8 +2363,8 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta any) error {
 
 	if d.HasChange("enable_encrypt_volume") {
 		if getBool(d, "enable_encrypt_volume") {
-			if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-				return fmt.Errorf("'enable_encrypt_volume' is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768) provider")
+			if !featureEncryptVolume.supports(gateway.CloudType) {
+				return featureEncryptVolume.unsupportedError()
 			}
 			gwEncVolume := &goaviatrix.Gateway{
 				GwName:              getString(d, "gw_name"),
@@ -2369,7 +2380,7 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta any) error {
 			haEnabled := haSubnet != "" || haZone != ""
 			if haEnabled {
 				gwHAEncVolume := &goaviatrix.Gateway{
-					GwName:              getString(d, "gw_name") + "-hagw",
+					GwName:              goaviatrix.HaGatewayName(getString(d, "gw_name")),
 					CustomerManagedKeys: getString(d, "customer_managed_keys"),
 				}
 				err := client.EnableEncryptVolume(gwHAEncVolume)
@@ -2431,13 +2442,13 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta any) error {
 		}
 		if idleTimeoutValue != -1 {
 			VPNServer.Value = strconv.Itoa(idleTimeoutValue)
-			log.Printf("[INFO] Modify VPN Config (update idle timeout value)")
+			tflog.Info(ctx, "Modify VPN Config (update idle timeout value)")
 			err := client.EnableVPNConfig(gatewayServer, VPNServer)
 			if err != nil {
 				return fmt.Errorf("fail to update idle timeout value due to : %w", err)
 			}
 		} else {
-			log.Printf("[INFO] Modify VPN Config (disable idle timeout)")
+			tflog.Info(ctx, "Modify VPN Config (disable idle timeout)")
 			err := client.DisableVPNConfig(gatewayServer, VPNServer)
 			if err != nil {
 				return fmt.Errorf("fail to disable idle timeout due to : %w", err)
@@ -2457,13 +2468,13 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta any) error {
 		}
 		if renegoIntervalValue != -1 {
 			VPNServer.Value = strconv.Itoa(renegoIntervalValue)
-			log.Printf("[INFO] Modify VPN Config (update renegotiation interval value)")
+			tflog.Info(ctx, "Modify VPN Config (update renegotiation interval value)")
 			err := client.EnableVPNConfig(gatewayServer, VPNServer)
 			if err != nil {
 				return fmt.Errorf("fail to enable renegotiation interval due to : %w", err)
 			}
 		} else {
-			log.Printf("[INFO] Modify VPN Config (disable renegotiation interval)")
+			tflog.Info(ctx, "Modify VPN Config (disable renegotiation interval)")
 			err := client.DisableVPNConfig(gatewayServer, VPNServer)
 			if err != nil {
 				return fmt.Errorf("fail to disable renegotiation interval due to: %w", err)
@@ -2572,7 +2583,7 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta any) error {
 		}
 		if haSubnet != "" || haZone != "" {
 			haGwRxQueueSize := &goaviatrix.Gateway{
-				GwName:      getString(d, "gw_name") + "-hagw",
+				GwName:      goaviatrix.HaGatewayName(getString(d, "gw_name")),
 				RxQueueSize: getString(d, "rx_queue_size"),
 			}
 			err := client.SetRxQueueSize(haGwRxQueueSize)
@@ -2584,11 +2595,11 @@ func resourceAviatrixGatewayUpdate(d *schema.ResourceData, meta any) error {
 
 	d.Partial(false)
 	d.SetId(gateway.GwName)
-	return resourceAviatrixGatewayRead(d, meta)
+	return resourceAviatrixGatewayRead(ctx, d, meta)
 }
 
-func resourceAviatrixGatewayDelete(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixGatewayDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustGatewayClient(meta)
 	gateway := &goaviatrix.Gateway{
 		CloudType: getInt(d, "cloud_type"),
 		GwName:    getString(d, "gw_name"),
@@ -2600,13 +2611,13 @@ func resourceAviatrixGatewayDelete(d *schema.ResourceData, meta any) error {
 	peeringHaZone := getString(d, "peering_ha_zone")
 	if peeringHaSubnet != "" || peeringHaZone != "" {
 		// Delete backup gateway first
-		gateway.GwName += "-hagw"
-		log.Printf("[INFO] Deleting Aviatrix Backup Gateway [-hagw]: %#v", gateway)
+		gateway.GwName = goaviatrix.HaGatewayName(gateway.GwName)
+		tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix Backup Gateway [-hagw]: %#v", goaviatrix.Redact(gateway)))
 
 		if isPublicSubnetFilteringGateway {
-			err = client.DeletePublicSubnetFilteringGateway(gateway)
+			err = client.DeletePublicSubnetFilteringGatewayContext(ctx, gateway)
 		} else {
-			err = client.DeleteGateway(gateway)
+			err = client.DeleteGatewayContext(ctx, gateway)
 		}
 
 		if err != nil {
@@ -2616,12 +2627,12 @@ func resourceAviatrixGatewayDelete(d *schema.ResourceData, meta any) error {
 
 	gateway.GwName = getString(d, "gw_name")
 
-	log.Printf("[INFO] Deleting Aviatrix gateway: %#v", gateway)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix gateway: %#v", goaviatrix.Redact(gateway)))
 
 	if isPublicSubnetFilteringGateway {
-		err = client.DeletePublicSubnetFilteringGateway(gateway)
+		err = client.DeletePublicSubnetFilteringGatewayContext(ctx, gateway)
 	} else {
-		err = client.DeleteGateway(gateway)
+		err = client.DeleteGatewayContext(ctx, gateway)
 	}
 	if err != nil {
 		return fmt.Errorf("failed to delete Aviatrix Gateway: %w", err)
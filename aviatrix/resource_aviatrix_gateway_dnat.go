@@ -1,10 +1,11 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,10 +15,10 @@ import (
 
 func resourceAviatrixGatewayDNat() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixGatewayDNatCreate,
-		Read:   resourceAviatrixGatewayDNatRead,
-		Update: resourceAviatrixGatewayDNatUpdate,
-		Delete: resourceAviatrixGatewayDNatDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixGatewayDNatCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixGatewayDNatRead),
+		UpdateWithoutTimeout: withContext(resourceAviatrixGatewayDNatUpdate),
+		Delete:               resourceAviatrixGatewayDNatDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -32,7 +33,7 @@ func resourceAviatrixGatewayDNat() *schema.Resource {
 			"dnat_policy": {
 				Type:             schema.TypeList,
 				Required:         true,
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncGatewayDNat,
+				DiffSuppressFunc: DiffSuppressFuncGatewayDNat,
 				Description:      "Policy rule to be applied to gateway.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -233,8 +234,8 @@ func resourceAviatrixGatewayDNat() *schema.Resource {
 	}
 }
 
-func resourceAviatrixGatewayDNatCreate(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixGatewayDNatCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustGatewayClient(meta)
 
 	gateway := &goaviatrix.Gateway{
 		GatewayName: getString(d, "gw_name"),
@@ -264,31 +265,31 @@ func resourceAviatrixGatewayDNatCreate(d *schema.ResourceData, meta any) error {
 
 	d.SetId(gateway.GatewayName)
 	flag := false
-	defer func() { _ = resourceAviatrixGatewayDNatReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixGatewayDNatReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	err := client.UpdateDNat(gateway)
 	if err != nil {
 		return fmt.Errorf("failed to update DNAT for gateway(name: %s) due to: %w", gateway.GatewayName, err)
 	}
 
-	return resourceAviatrixGatewayDNatReadIfRequired(d, meta, &flag)
+	return resourceAviatrixGatewayDNatReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixGatewayDNatReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixGatewayDNatReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixGatewayDNatRead(d, meta)
+		return resourceAviatrixGatewayDNatRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixGatewayDNatRead(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixGatewayDNatRead(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustGatewayClient(meta)
 
 	gwName := getString(d, "gw_name")
 	if gwName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no gateway name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no gateway name received. Import Id is %s", id))
 		mustSet(d, "gw_name", id)
 		d.SetId(id)
 	}
@@ -306,7 +307,7 @@ func resourceAviatrixGatewayDNatRead(d *schema.ResourceData, meta any) error {
 		return fmt.Errorf("couldn't find Aviatrix gateway: %w", err)
 	}
 
-	log.Printf("[TRACE] reading gateway %s: %#v", getString(d, "gw_name"), gw)
+	tflog.Trace(ctx, fmt.Sprintf("reading gateway %s: %#v", getString(d, "gw_name"), goaviatrix.Redact(gw)))
 	if gw != nil {
 		mustSet(d, "gw_name", gw.GwName)
 
@@ -344,15 +345,15 @@ func resourceAviatrixGatewayDNatRead(d *schema.ResourceData, meta any) error {
 			}
 
 			if err := d.Set("dnat_policy", dnatPolicy); err != nil {
-				log.Printf("[WARN] Error setting 'dnat_policy' for (%s): %s", d.Id(), err)
+				tflog.Warn(ctx, fmt.Sprintf("Error setting 'dnat_policy' for (%s): %s", d.Id(), err))
 			}
 
 			if err := d.Set("connection_policy", connectionPolicy); err != nil {
-				log.Printf("[WARN] Error setting 'connection_policy' for (%s): %s", d.Id(), err)
+				tflog.Warn(ctx, fmt.Sprintf("Error setting 'connection_policy' for (%s): %s", d.Id(), err))
 			}
 
 			if err := d.Set("interface_policy", interfacePolicy); err != nil {
-				log.Printf("[WARN] Error setting 'interface_policy' for (%s): %s", d.Id(), err)
+				tflog.Warn(ctx, fmt.Sprintf("Error setting 'interface_policy' for (%s): %s", d.Id(), err))
 			}
 
 		} else {
@@ -364,10 +365,10 @@ func resourceAviatrixGatewayDNatRead(d *schema.ResourceData, meta any) error {
 	return nil
 }
 
-func resourceAviatrixGatewayDNatUpdate(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixGatewayDNatUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustGatewayClient(meta)
 
-	log.Printf("[INFO] Updating Aviatrix gateway: %#v", getString(d, "gw_name"))
+	tflog.Info(ctx, fmt.Sprintf("Updating Aviatrix gateway: %#v", getString(d, "gw_name")))
 
 	d.Partial(true)
 	gateway := &goaviatrix.Gateway{
@@ -404,11 +405,11 @@ func resourceAviatrixGatewayDNatUpdate(d *schema.ResourceData, meta any) error {
 
 	d.Partial(false)
 	d.SetId(gateway.GatewayName)
-	return resourceAviatrixGatewayDNatRead(d, meta)
+	return resourceAviatrixGatewayDNatRead(ctx, d, meta)
 }
 
 func resourceAviatrixGatewayDNatDelete(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+	client := mustGatewayClient(meta)
 	gateway := &goaviatrix.Gateway{
 		GatewayName: getString(d, "gw_name"),
 		DnatPolicy:  make([]goaviatrix.PolicyRule, 0),
@@ -1,10 +1,11 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,10 +15,10 @@ import (
 
 func resourceAviatrixGatewaySNat() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixGatewaySNatCreate,
-		Read:   resourceAviatrixGatewaySNatRead,
-		Update: resourceAviatrixGatewaySNatUpdate,
-		Delete: resourceAviatrixGatewaySNatDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixGatewaySNatCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixGatewaySNatRead),
+		UpdateWithoutTimeout: withContext(resourceAviatrixGatewaySNatUpdate),
+		Delete:               resourceAviatrixGatewaySNatDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -40,7 +41,7 @@ func resourceAviatrixGatewaySNat() *schema.Resource {
 				Type:             schema.TypeList,
 				Optional:         true,
 				Default:          nil,
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncGatewaySNat,
+				DiffSuppressFunc: DiffSuppressFuncGatewaySNat,
 				Description:      "Policy rules applied for 'snat_mode'' of 'customized_snat'.'",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -247,8 +248,8 @@ func resourceAviatrixGatewaySNat() *schema.Resource {
 	}
 }
 
-func resourceAviatrixGatewaySNatCreate(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixGatewaySNatCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustGatewayClient(meta)
 
 	gateway := &goaviatrix.Gateway{
 		GatewayName: getString(d, "gw_name"),
@@ -284,31 +285,31 @@ func resourceAviatrixGatewaySNatCreate(d *schema.ResourceData, meta any) error {
 
 	d.SetId(gateway.GatewayName)
 	flag := false
-	defer func() { _ = resourceAviatrixGatewaySNatReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixGatewaySNatReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	err := client.EnableCustomizedSNat(gateway)
 	if err != nil {
 		return fmt.Errorf("failed to configure policies for 'customized_snat' mode due to: %w", err)
 	}
 
-	return resourceAviatrixGatewaySNatReadIfRequired(d, meta, &flag)
+	return resourceAviatrixGatewaySNatReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixGatewaySNatReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixGatewaySNatReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixGatewaySNatRead(d, meta)
+		return resourceAviatrixGatewaySNatRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixGatewaySNatRead(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixGatewaySNatRead(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustGatewayClient(meta)
 
 	gwName := getString(d, "gw_name")
 	if gwName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no gateway name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no gateway name received. Import Id is %s", id))
 		mustSet(d, "gw_name", id)
 		d.SetId(id)
 	}
@@ -326,7 +327,7 @@ func resourceAviatrixGatewaySNatRead(d *schema.ResourceData, meta any) error {
 		return fmt.Errorf("couldn't find Aviatrix gateway: %w", err)
 	}
 
-	log.Printf("[TRACE] reading gateway %s: %#v", getString(d, "gw_name"), gw)
+	tflog.Trace(ctx, fmt.Sprintf("reading gateway %s: %#v", getString(d, "gw_name"), goaviatrix.Redact(gw)))
 	if gw != nil {
 		mustSet(d, "gw_name", gw.GwName)
 
@@ -379,15 +380,15 @@ func resourceAviatrixGatewaySNatRead(d *schema.ResourceData, meta any) error {
 			}
 
 			if err := d.Set("snat_policy", snatPolicy); err != nil {
-				log.Printf("[WARN] Error setting 'snat_policy' for (%s): %s", d.Id(), err)
+				tflog.Warn(ctx, fmt.Sprintf("Error setting 'snat_policy' for (%s): %s", d.Id(), err))
 			}
 
 			if err := d.Set("connection_policy", connectionPolicy); err != nil {
-				log.Printf("[WARN] Error setting 'connection_policy' for (%s): %s", d.Id(), err)
+				tflog.Warn(ctx, fmt.Sprintf("Error setting 'connection_policy' for (%s): %s", d.Id(), err))
 			}
 
 			if err := d.Set("interface_policy", interfacePolicy); err != nil {
-				log.Printf("[WARN] Error setting 'interface_policy' for (%s): %s", d.Id(), err)
+				tflog.Warn(ctx, fmt.Sprintf("Error setting 'interface_policy' for (%s): %s", d.Id(), err))
 			}
 		} else {
 			d.SetId("")
@@ -398,10 +399,10 @@ func resourceAviatrixGatewaySNatRead(d *schema.ResourceData, meta any) error {
 	return nil
 }
 
-func resourceAviatrixGatewaySNatUpdate(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixGatewaySNatUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustGatewayClient(meta)
 
-	log.Printf("[INFO] Updating Aviatrix gateway: %#v", getString(d, "gw_name"))
+	tflog.Info(ctx, fmt.Sprintf("Updating Aviatrix gateway: %#v", getString(d, "gw_name")))
 
 	d.Partial(true)
 	gateway := &goaviatrix.Gateway{
@@ -444,11 +445,11 @@ func resourceAviatrixGatewaySNatUpdate(d *schema.ResourceData, meta any) error {
 
 	d.Partial(false)
 	d.SetId(gateway.GatewayName)
-	return resourceAviatrixGatewaySNatRead(d, meta)
+	return resourceAviatrixGatewaySNatRead(ctx, d, meta)
 }
 
 func resourceAviatrixGatewaySNatDelete(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+	client := mustGatewayClient(meta)
 	gateway := &goaviatrix.Gateway{
 		GatewayName: getString(d, "gw_name"),
 		SnatMode:    "custom",
@@ -4,9 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -15,10 +15,10 @@ import (
 
 func resourceAviatrixGeoVPN() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixGeoVPNCreate,
-		Read:   resourceAviatrixGeoVPNRead,
-		Update: resourceAviatrixGeoVPNUpdate,
-		Delete: resourceAviatrixGeoVPNDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixGeoVPNCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixGeoVPNRead),
+		UpdateWithoutTimeout: withContext(resourceAviatrixGeoVPNUpdate),
+		DeleteWithoutTimeout: withContext(resourceAviatrixGeoVPNDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -61,7 +61,7 @@ func resourceAviatrixGeoVPN() *schema.Resource {
 	}
 }
 
-func resourceAviatrixGeoVPNCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixGeoVPNCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	geoVPN := &goaviatrix.GeoVPN{
@@ -71,7 +71,7 @@ func resourceAviatrixGeoVPNCreate(d *schema.ResourceData, meta any) error {
 		DomainName:  getString(d, "domain_name"),
 	}
 
-	log.Printf("[INFO] Enabling Aviatrix Geo VPN: %#v", geoVPN)
+	tflog.Info(ctx, fmt.Sprintf("Enabling Aviatrix Geo VPN: %#v", goaviatrix.Redact(geoVPN)))
 
 	elbDNSNames := make([]string, 0)
 	for _, elbDNSName := range getList(d, "elb_dns_names") {
@@ -84,7 +84,7 @@ func resourceAviatrixGeoVPNCreate(d *schema.ResourceData, meta any) error {
 
 	d.SetId(geoVPN.ServiceName + "~" + geoVPN.DomainName)
 	flag := false
-	defer func() { _ = resourceAviatrixGeoVPNReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixGeoVPNReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	geoVPN.ElbDNSName = elbDNSNames[0]
 	err := client.EnableGeoVPN(context.Background(), geoVPN)
@@ -100,25 +100,25 @@ func resourceAviatrixGeoVPNCreate(d *schema.ResourceData, meta any) error {
 		}
 	}
 
-	return resourceAviatrixGeoVPNReadIfRequired(d, meta, &flag)
+	return resourceAviatrixGeoVPNReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixGeoVPNReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixGeoVPNReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixGeoVPNRead(d, meta)
+		return resourceAviatrixGeoVPNRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixGeoVPNRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixGeoVPNRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	domainName := getString(d, "domain_name")
 	serviceName := getString(d, "service_name")
 	if domainName == "" || serviceName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no domain name or service name received. Import id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no domain name or service name received. Import id is %s", id))
 		mustSet(d, "cloud_type", goaviatrix.AWS)
 		mustSet(d, "service_name", strings.Split(id, "~")[0])
 		mustSet(d, "domain_name", strings.Split(id, "~")[1])
@@ -144,14 +144,14 @@ func resourceAviatrixGeoVPNRead(d *schema.ResourceData, meta any) error {
 	mustSet(d, "service_name", geoVPNDetail.ServiceName)
 	mustSet(d, "domain_name", geoVPNDetail.DomainName)
 	if err := d.Set("elb_dns_names", strings.Split(geoVPNDetail.ElbDNSName, ",")); err != nil {
-		log.Printf("[WARN] Error setting 'elb_dns_names' for (%s): %s", d.Id(), err)
+		tflog.Warn(ctx, fmt.Sprintf("Error setting 'elb_dns_names' for (%s): %s", d.Id(), err))
 	}
 
 	return nil
 }
 
-func resourceAviatrixGeoVPNUpdate(d *schema.ResourceData, meta any) error {
-	log.Printf("[INFO] Updating Aviatrix Geo VPN")
+func resourceAviatrixGeoVPNUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
+	tflog.Info(ctx, "Updating Aviatrix Geo VPN")
 
 	client := mustClient(meta)
 
@@ -198,17 +198,17 @@ func resourceAviatrixGeoVPNUpdate(d *schema.ResourceData, meta any) error {
 	}
 
 	d.Partial(false)
-	return resourceAviatrixGeoVPNRead(d, meta)
+	return resourceAviatrixGeoVPNRead(ctx, d, meta)
 }
 
-func resourceAviatrixGeoVPNDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixGeoVPNDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	geoVPN := &goaviatrix.GeoVPN{
 		CloudType: getInt(d, "cloud_type"),
 	}
 
-	log.Printf("[INFO] Disabling Aviatrix Geo VPN: %#v", geoVPN)
+	tflog.Info(ctx, fmt.Sprintf("Disabling Aviatrix Geo VPN: %#v", goaviatrix.Redact(geoVPN)))
 
 	err := client.DisableGeoVPN(geoVPN)
 	if err != nil {
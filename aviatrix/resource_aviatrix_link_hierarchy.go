@@ -31,7 +31,7 @@ func resourceAviatrixLinkHierarchy() *schema.Resource {
 				Type:             schema.TypeList,
 				Required:         true,
 				Description:      "List of named links.",
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncLinkHierarchy,
+				DiffSuppressFunc: DiffSuppressFuncLinkHierarchy,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -14,9 +15,9 @@ import (
 
 func resourceAviatrixPeriodicPing() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixPeriodicPingCreate,
-		Read:   resourceAviatrixPeriodicPingRead,
-		Delete: resourceAviatrixPeriodicPingDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixPeriodicPingCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixPeriodicPingRead),
+		Delete:               resourceAviatrixPeriodicPingDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -53,37 +54,37 @@ func marshalPeriodicPingInput(d *schema.ResourceData) *goaviatrix.PeriodicPing {
 	}
 }
 
-func resourceAviatrixPeriodicPingCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixPeriodicPingCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	pp := marshalPeriodicPingInput(d)
 
 	d.SetId(pp.GwName)
 	flag := false
-	defer func() { _ = resourceAviatrixPeriodicPingReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixPeriodicPingReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	if err := client.CreatePeriodicPing(pp); err != nil {
 		return err
 	}
 
-	return resourceAviatrixPeriodicPingReadIfRequired(d, meta, &flag)
+	return resourceAviatrixPeriodicPingReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixPeriodicPingReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixPeriodicPingReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixPeriodicPingRead(d, meta)
+		return resourceAviatrixPeriodicPingRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixPeriodicPingRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixPeriodicPingRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	gwName := getString(d, "gw_name")
 	if gwName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no periodic_ping gw_name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no periodic_ping gw_name received. Import Id is %s", id))
 		d.SetId(id)
 		gwName = id
 	}
@@ -1,10 +1,11 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -12,10 +13,10 @@ import (
 
 func resourceAviatrixRbacGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixRbacGroupCreate,
-		Read:   resourceAviatrixRbacGroupRead,
-		Delete: resourceAviatrixRbacGroupDelete,
-		Update: resourceAviatrixRbacGroupUpdate,
+		CreateWithoutTimeout: withContext(resourceAviatrixRbacGroupCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixRbacGroupRead),
+		DeleteWithoutTimeout: withContext(resourceAviatrixRbacGroupDelete),
+		UpdateWithoutTimeout: withContext(resourceAviatrixRbacGroupUpdate),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -37,7 +38,7 @@ func resourceAviatrixRbacGroup() *schema.Resource {
 	}
 }
 
-func resourceAviatrixRbacGroupCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixRbacGroupCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	groupName := getString(d, "group_name")
@@ -45,18 +46,18 @@ func resourceAviatrixRbacGroupCreate(d *schema.ResourceData, meta any) error {
 		GroupName: groupName,
 	}
 
-	log.Printf("[INFO] Creating Aviatrix RBAC permission group: %#v", group)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix RBAC permission group: %#v", goaviatrix.Redact(group)))
 
 	d.SetId(group.GroupName)
 	flag := false
-	defer func() { _ = resourceAviatrixRbacGroupReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixRbacGroupReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	err := client.CreatePermissionGroup(group)
 	if err != nil {
 		return fmt.Errorf("failed to create Aviatrix RBAC permission group: %w", err)
 	}
 
-	log.Printf("[DEBUG] Aviatrix RBAC permission group %s created", group.GroupName)
+	tflog.Debug(ctx, fmt.Sprintf("Aviatrix RBAC permission group %s created", group.GroupName))
 
 	if getBool(d, "local_login") {
 		err := client.EnableLocalLoginForRBACGroup(groupName)
@@ -65,10 +66,10 @@ func resourceAviatrixRbacGroupCreate(d *schema.ResourceData, meta any) error {
 		}
 	}
 
-	return resourceAviatrixRbacGroupReadIfRequired(d, meta, &flag)
+	return resourceAviatrixRbacGroupReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixRbacGroupUpdate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixRbacGroupUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 	groupName := getString(d, "group_name")
 
@@ -83,24 +84,24 @@ func resourceAviatrixRbacGroupUpdate(d *schema.ResourceData, meta any) error {
 			return fmt.Errorf("failed to disable local_login for Aviatrix RBAC permission group: %w", err)
 		}
 	}
-	return resourceAviatrixRbacGroupRead(d, meta)
+	return resourceAviatrixRbacGroupRead(ctx, d, meta)
 }
 
-func resourceAviatrixRbacGroupReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixRbacGroupReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixRbacGroupRead(d, meta)
+		return resourceAviatrixRbacGroupRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixRbacGroupRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixRbacGroupRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	groupName := getString(d, "group_name")
 	if groupName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no group name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no group name received. Import Id is %s", id))
 		mustSet(d, "group_name", id)
 		d.SetId(id)
 		groupName = id
@@ -110,7 +111,7 @@ func resourceAviatrixRbacGroupRead(d *schema.ResourceData, meta any) error {
 		GroupName: groupName,
 	}
 
-	log.Printf("[INFO] Looking for Aviatrix RBAC permission group: %#v", group)
+	tflog.Info(ctx, fmt.Sprintf("Looking for Aviatrix RBAC permission group: %#v", goaviatrix.Redact(group)))
 
 	rGroup, err := client.GetPermissionGroupDetails(groupName)
 	if err != nil {
@@ -129,14 +130,14 @@ func resourceAviatrixRbacGroupRead(d *schema.ResourceData, meta any) error {
 	return nil
 }
 
-func resourceAviatrixRbacGroupDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixRbacGroupDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	group := &goaviatrix.RbacGroup{
 		GroupName: getString(d, "group_name"),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix RBAC permission group: %#v", group)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix RBAC permission group: %#v", goaviatrix.Redact(group)))
 
 	err := client.DeletePermissionGroup(group)
 	if err != nil {
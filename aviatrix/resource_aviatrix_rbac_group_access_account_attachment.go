@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -13,9 +14,9 @@ import (
 
 func resourceAviatrixRbacGroupAccessAccountAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixRbacGroupAccessAccountAttachmentCreate,
-		Read:   resourceAviatrixRbacGroupAccessAccountAttachmentRead,
-		Delete: resourceAviatrixRbacGroupAccessAccountAttachmentDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixRbacGroupAccessAccountAttachmentCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixRbacGroupAccessAccountAttachmentRead),
+		DeleteWithoutTimeout: withContext(resourceAviatrixRbacGroupAccessAccountAttachmentDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -37,7 +38,7 @@ func resourceAviatrixRbacGroupAccessAccountAttachment() *schema.Resource {
 	}
 }
 
-func resourceAviatrixRbacGroupAccessAccountAttachmentCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixRbacGroupAccessAccountAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	attachment := &goaviatrix.RbacGroupAccessAccountAttachment{
@@ -45,38 +46,38 @@ func resourceAviatrixRbacGroupAccessAccountAttachmentCreate(d *schema.ResourceDa
 		AccessAccountName: getString(d, "access_account_name"),
 	}
 
-	log.Printf("[INFO] Creating Aviatrix RBAC permission group access account attachment: %#v", attachment)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix RBAC permission group access account attachment: %#v", goaviatrix.Redact(attachment)))
 
 	d.SetId(attachment.GroupName + "~" + attachment.AccessAccountName)
 	flag := false
-	defer func() { _ = resourceAviatrixRbacGroupAccessAccountAttachmentReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixRbacGroupAccessAccountAttachmentReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	err := client.CreateRbacGroupAccessAccountAttachment(attachment)
 	if err != nil {
 		return fmt.Errorf("failed to create Aviatrix RBAC permission group access account attachment: %w", err)
 	}
 
-	log.Printf("[DEBUG] Aviatrix RBAC permission group access account attachment created")
+	tflog.Debug(ctx, "Aviatrix RBAC permission group access account attachment created")
 
-	return resourceAviatrixRbacGroupAccessAccountAttachmentReadIfRequired(d, meta, &flag)
+	return resourceAviatrixRbacGroupAccessAccountAttachmentReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixRbacGroupAccessAccountAttachmentReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixRbacGroupAccessAccountAttachmentReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixRbacGroupAccessAccountAttachmentRead(d, meta)
+		return resourceAviatrixRbacGroupAccessAccountAttachmentRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixRbacGroupAccessAccountAttachmentRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixRbacGroupAccessAccountAttachmentRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	groupName := getString(d, "group_name")
 	accessAccountName := getString(d, "access_account_name")
 	if groupName == "" || accessAccountName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no group name or access account name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no group name or access account name received. Import Id is %s", id))
 		mustSet(d, "group_name", strings.Split(id, "~")[0])
 		mustSet(d, "access_account_name", strings.Split(id, "~")[1])
 		d.SetId(id)
@@ -87,7 +88,7 @@ func resourceAviatrixRbacGroupAccessAccountAttachmentRead(d *schema.ResourceData
 		AccessAccountName: getString(d, "access_account_name"),
 	}
 
-	log.Printf("[INFO] Looking for Aviatrix RBAC permission group access account attachment: %#v", attachment)
+	tflog.Info(ctx, fmt.Sprintf("Looking for Aviatrix RBAC permission group access account attachment: %#v", goaviatrix.Redact(attachment)))
 
 	accessAccountAttachment, err := client.GetRbacGroupAccessAccountAttachment(attachment)
 	if err != nil {
@@ -106,7 +107,7 @@ func resourceAviatrixRbacGroupAccessAccountAttachmentRead(d *schema.ResourceData
 	return nil
 }
 
-func resourceAviatrixRbacGroupAccessAccountAttachmentDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixRbacGroupAccessAccountAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	attachment := &goaviatrix.RbacGroupAccessAccountAttachment{
@@ -114,7 +115,7 @@ func resourceAviatrixRbacGroupAccessAccountAttachmentDelete(d *schema.ResourceDa
 		AccessAccountName: getString(d, "access_account_name"),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix RBAC permission group access account attachment: %#v", attachment)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix RBAC permission group access account attachment: %#v", goaviatrix.Redact(attachment)))
 
 	err := client.DeleteRbacGroupAccessAccountAttachment(attachment)
 	if err != nil {
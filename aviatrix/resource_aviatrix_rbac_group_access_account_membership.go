@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"sort"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -13,10 +14,10 @@ import (
 
 func resourceAviatrixRbacGroupAccessAccountMembership() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixRbacGroupAccessAccountMembershipCreate,
-		Read:   resourceAviatrixRbacGroupAccessAccountMembershipRead,
-		Update: resourceAviatrixRbacGroupAccessAccountMembershipUpdate,
-		Delete: resourceAviatrixRbacGroupAccessAccountMembershipDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixRbacGroupAccessAccountMembershipCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixRbacGroupAccessAccountMembershipRead),
+		UpdateWithoutTimeout: withContext(resourceAviatrixRbacGroupAccessAccountMembershipUpdate),
+		Delete:               resourceAviatrixRbacGroupAccessAccountMembershipDelete,
 
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
@@ -53,23 +54,23 @@ func resourceAviatrixRbacGroupAccessAccountMembership() *schema.Resource {
 	}
 }
 
-func resourceAviatrixRbacGroupAccessAccountMembershipCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixRbacGroupAccessAccountMembershipCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	group := getString(d, "group_name")
 	accessAccounts := expandStringSet(getSet(d, "access_account_names"))
 
-	log.Printf("[INFO] Creating (authoritative) access account membership for group %q: %v", group, accessAccounts)
+	tflog.Info(ctx, fmt.Sprintf("Creating (authoritative) access account membership for group %q: %v", group, accessAccounts))
 
 	if err := client.SetRbacGroupAccessAccounts(group, accessAccounts); err != nil {
 		return fmt.Errorf("failed to set access account for RBAC group %q: %w", group, err)
 	}
 
 	d.SetId(group)
-	return resourceAviatrixRbacGroupAccessAccountMembershipRead(d, meta)
+	return resourceAviatrixRbacGroupAccessAccountMembershipRead(ctx, d, meta)
 }
 
-func resourceAviatrixRbacGroupAccessAccountMembershipRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixRbacGroupAccessAccountMembershipRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	group := getString(d, "group_name")
@@ -78,12 +79,12 @@ func resourceAviatrixRbacGroupAccessAccountMembershipRead(d *schema.ResourceData
 		_ = d.Set("group_name", group)
 	}
 
-	log.Printf("[INFO] Reading (authoritative) access account membership for group %q", group)
+	tflog.Info(ctx, fmt.Sprintf("Reading (authoritative) access account membership for group %q", group))
 
 	current, err := client.ListRbacGroupAccessAccounts(group)
 	if err != nil {
 		if errors.Is(err, goaviatrix.ErrNotFound) {
-			log.Printf("[WARN] RBAC group %q not found; removing from state", group)
+			tflog.Warn(ctx, fmt.Sprintf("RBAC group %q not found; removing from state", group))
 			d.SetId("")
 			return nil
 		}
@@ -99,7 +100,7 @@ func resourceAviatrixRbacGroupAccessAccountMembershipRead(d *schema.ResourceData
 	return nil
 }
 
-func resourceAviatrixRbacGroupAccessAccountMembershipUpdate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixRbacGroupAccessAccountMembershipUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 	group := getString(d, "group_name")
 
@@ -110,7 +111,7 @@ func resourceAviatrixRbacGroupAccessAccountMembershipUpdate(d *schema.ResourceDa
 		}
 	}
 
-	return resourceAviatrixRbacGroupAccessAccountMembershipRead(d, meta)
+	return resourceAviatrixRbacGroupAccessAccountMembershipRead(ctx, d, meta)
 }
 
 func resourceAviatrixRbacGroupAccessAccountMembershipDelete(d *schema.ResourceData, meta any) error {
@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,9 +16,9 @@ import (
 
 func resourceAviatrixRbacGroupPermissionAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixRbacGroupPermissionAttachmentCreate,
-		Read:   resourceAviatrixRbacGroupPermissionAttachmentRead,
-		Delete: resourceAviatrixRbacGroupPermissionAttachmentDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixRbacGroupPermissionAttachmentCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixRbacGroupPermissionAttachmentRead),
+		DeleteWithoutTimeout: withContext(resourceAviatrixRbacGroupPermissionAttachmentDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -55,7 +56,7 @@ func resourceAviatrixRbacGroupPermissionAttachment() *schema.Resource {
 	}
 }
 
-func resourceAviatrixRbacGroupPermissionAttachmentCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixRbacGroupPermissionAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	attachment := &goaviatrix.RbacGroupPermissionAttachment{
@@ -63,38 +64,38 @@ func resourceAviatrixRbacGroupPermissionAttachmentCreate(d *schema.ResourceData,
 		PermissionName: getString(d, "permission_name"),
 	}
 
-	log.Printf("[INFO] Creating Aviatrix RBAC group permission attachment: %#v", attachment)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix RBAC group permission attachment: %#v", goaviatrix.Redact(attachment)))
 
 	d.SetId(attachment.GroupName + "~" + attachment.PermissionName)
 	flag := false
-	defer func() { _ = resourceAviatrixRbacGroupPermissionAttachmentReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixRbacGroupPermissionAttachmentReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	err := client.CreateRbacGroupPermissionAttachment(attachment)
 	if err != nil {
 		return fmt.Errorf("failed to create Aviatrix RBAC group permission attachment: %w", err)
 	}
 
-	log.Printf("[DEBUG] Aviatrix RBAC group permission attachment created")
+	tflog.Debug(ctx, "Aviatrix RBAC group permission attachment created")
 
-	return resourceAviatrixRbacGroupPermissionAttachmentReadIfRequired(d, meta, &flag)
+	return resourceAviatrixRbacGroupPermissionAttachmentReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixRbacGroupPermissionAttachmentReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixRbacGroupPermissionAttachmentReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixRbacGroupPermissionAttachmentRead(d, meta)
+		return resourceAviatrixRbacGroupPermissionAttachmentRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixRbacGroupPermissionAttachmentRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixRbacGroupPermissionAttachmentRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	groupName := getString(d, "group_name")
 	permissionName := getString(d, "permission_name")
 	if groupName == "" || permissionName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no group name or permission name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no group name or permission name received. Import Id is %s", id))
 		mustSet(d, "group_name", strings.Split(id, "~")[0])
 		mustSet(d, "permission_name", strings.Split(id, "~")[1])
 		d.SetId(id)
@@ -105,7 +106,7 @@ func resourceAviatrixRbacGroupPermissionAttachmentRead(d *schema.ResourceData, m
 		PermissionName: getString(d, "permission_name"),
 	}
 
-	log.Printf("[INFO] Looking for Aviatrix RBAC group permission attachment: %#v", attachment)
+	tflog.Info(ctx, fmt.Sprintf("Looking for Aviatrix RBAC group permission attachment: %#v", goaviatrix.Redact(attachment)))
 
 	permissionAttachment, err := client.GetRbacGroupPermissionAttachment(attachment)
 	if err != nil {
@@ -124,7 +125,7 @@ func resourceAviatrixRbacGroupPermissionAttachmentRead(d *schema.ResourceData, m
 	return nil
 }
 
-func resourceAviatrixRbacGroupPermissionAttachmentDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixRbacGroupPermissionAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	attachment := &goaviatrix.RbacGroupPermissionAttachment{
@@ -132,7 +133,7 @@ func resourceAviatrixRbacGroupPermissionAttachmentDelete(d *schema.ResourceData,
 		PermissionName: getString(d, "permission_name"),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix RBAC group permission attachment: %#v", attachment)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix RBAC group permission attachment: %#v", goaviatrix.Redact(attachment)))
 
 	err := client.DeleteRbacGroupPermissionAttachment(attachment)
 	if err != nil {
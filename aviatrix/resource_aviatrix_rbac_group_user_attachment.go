@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -13,9 +14,9 @@ import (
 
 func resourceAviatrixRbacGroupUserAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixRbacGroupUserAttachmentCreate,
-		Read:   resourceAviatrixRbacGroupUserAttachmentRead,
-		Delete: resourceAviatrixRbacGroupUserAttachmentDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixRbacGroupUserAttachmentCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixRbacGroupUserAttachmentRead),
+		DeleteWithoutTimeout: withContext(resourceAviatrixRbacGroupUserAttachmentDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -37,7 +38,7 @@ func resourceAviatrixRbacGroupUserAttachment() *schema.Resource {
 	}
 }
 
-func resourceAviatrixRbacGroupUserAttachmentCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixRbacGroupUserAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	attachment := &goaviatrix.RbacGroupUserAttachment{
@@ -45,38 +46,38 @@ func resourceAviatrixRbacGroupUserAttachmentCreate(d *schema.ResourceData, meta
 		UserName:  getString(d, "user_name"),
 	}
 
-	log.Printf("[INFO] Creating Aviatrix RBAC permission group user attachment: %#v", attachment)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix RBAC permission group user attachment: %#v", goaviatrix.Redact(attachment)))
 
 	d.SetId(attachment.GroupName + "~" + attachment.UserName)
 	flag := false
-	defer func() { _ = resourceAviatrixRbacGroupUserAttachmentReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixRbacGroupUserAttachmentReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	err := client.CreateRbacGroupUserAttachment(attachment)
 	if err != nil {
 		return fmt.Errorf("failed to create Aviatrix RBAC permission group user attachment: %w", err)
 	}
 
-	log.Printf("[DEBUG] Aviatrix RBAC permission group user attachment created")
+	tflog.Debug(ctx, "Aviatrix RBAC permission group user attachment created")
 
-	return resourceAviatrixRbacGroupUserAttachmentReadIfRequired(d, meta, &flag)
+	return resourceAviatrixRbacGroupUserAttachmentReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixRbacGroupUserAttachmentReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixRbacGroupUserAttachmentReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixRbacGroupUserAttachmentRead(d, meta)
+		return resourceAviatrixRbacGroupUserAttachmentRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixRbacGroupUserAttachmentRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixRbacGroupUserAttachmentRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	groupName := getString(d, "group_name")
 	userName := getString(d, "user_name")
 	if groupName == "" || userName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no group name or account user name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no group name or account user name received. Import Id is %s", id))
 		mustSet(d, "group_name", strings.Split(id, "~")[0])
 		mustSet(d, "user_name", strings.Split(id, "~")[1])
 		d.SetId(id)
@@ -87,7 +88,7 @@ func resourceAviatrixRbacGroupUserAttachmentRead(d *schema.ResourceData, meta an
 		UserName:  getString(d, "user_name"),
 	}
 
-	log.Printf("[INFO] Looking for Aviatrix RBAC permission group user attachment: %#v", attachment)
+	tflog.Info(ctx, fmt.Sprintf("Looking for Aviatrix RBAC permission group user attachment: %#v", goaviatrix.Redact(attachment)))
 
 	userAttachment, err := client.GetRbacGroupUserAttachment(attachment)
 	if err != nil {
@@ -106,7 +107,7 @@ func resourceAviatrixRbacGroupUserAttachmentRead(d *schema.ResourceData, meta an
 	return nil
 }
 
-func resourceAviatrixRbacGroupUserAttachmentDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixRbacGroupUserAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	attachment := &goaviatrix.RbacGroupUserAttachment{
@@ -114,7 +115,7 @@ func resourceAviatrixRbacGroupUserAttachmentDelete(d *schema.ResourceData, meta
 		UserName:  getString(d, "user_name"),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix RBAC permission group user attachment: %#v", attachment)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix RBAC permission group user attachment: %#v", goaviatrix.Redact(attachment)))
 
 	err := client.DeleteRbacGroupUserAttachment(attachment)
 	if err != nil {
@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"sort"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -13,10 +14,10 @@ import (
 
 func resourceAviatrixRbacGroupUserMembership() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixRbacGroupUserMembershipCreate,
-		Read:   resourceAviatrixRbacGroupUserMembershipRead,
-		Update: resourceAviatrixRbacGroupUserMembershipUpdate,
-		Delete: resourceAviatrixRbacGroupUserMembershipDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixRbacGroupUserMembershipCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixRbacGroupUserMembershipRead),
+		UpdateWithoutTimeout: withContext(resourceAviatrixRbacGroupUserMembershipUpdate),
+		Delete:               resourceAviatrixRbacGroupUserMembershipDelete,
 
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
@@ -53,23 +54,23 @@ func resourceAviatrixRbacGroupUserMembership() *schema.Resource {
 	}
 }
 
-func resourceAviatrixRbacGroupUserMembershipCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixRbacGroupUserMembershipCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	group := getString(d, "group_name")
 	users := expandStringSet(getSet(d, "user_names"))
 
-	log.Printf("[INFO] Creating (authoritative) user membership for group %q: %v", group, users)
+	tflog.Info(ctx, fmt.Sprintf("Creating (authoritative) user membership for group %q: %v", group, users))
 
 	if err := client.SetRbacGroupUsers(group, users); err != nil {
 		return fmt.Errorf("failed to set user for RBAC group %q: %w", group, err)
 	}
 
 	d.SetId(group)
-	return resourceAviatrixRbacGroupUserMembershipRead(d, meta)
+	return resourceAviatrixRbacGroupUserMembershipRead(ctx, d, meta)
 }
 
-func resourceAviatrixRbacGroupUserMembershipRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixRbacGroupUserMembershipRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	group := getString(d, "group_name")
@@ -78,12 +79,12 @@ func resourceAviatrixRbacGroupUserMembershipRead(d *schema.ResourceData, meta an
 		_ = d.Set("group_name", group)
 	}
 
-	log.Printf("[INFO] Reading (authoritative) user membership for group %q", group)
+	tflog.Info(ctx, fmt.Sprintf("Reading (authoritative) user membership for group %q", group))
 
 	current, err := client.ListRbacGroupUsers(group)
 	if err != nil {
 		if errors.Is(err, goaviatrix.ErrNotFound) {
-			log.Printf("[WARN] RBAC group %q not found; removing from state", group)
+			tflog.Warn(ctx, fmt.Sprintf("RBAC group %q not found; removing from state", group))
 			d.SetId("")
 			return nil
 		}
@@ -99,7 +100,7 @@ func resourceAviatrixRbacGroupUserMembershipRead(d *schema.ResourceData, meta an
 	return nil
 }
 
-func resourceAviatrixRbacGroupUserMembershipUpdate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixRbacGroupUserMembershipUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 	group := getString(d, "group_name")
 
@@ -110,7 +111,7 @@ func resourceAviatrixRbacGroupUserMembershipUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	return resourceAviatrixRbacGroupUserMembershipRead(d, meta)
+	return resourceAviatrixRbacGroupUserMembershipRead(ctx, d, meta)
 }
 
 func resourceAviatrixRbacGroupUserMembershipDelete(d *schema.ResourceData, meta any) error {
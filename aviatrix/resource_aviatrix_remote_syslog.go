@@ -1,13 +1,14 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"regexp"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -18,9 +19,9 @@ var remoteSyslogMatcher = regexp.MustCompile(`\bremote_syslog_[0-9]\b`)
 
 func resourceAviatrixRemoteSyslog() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixRemoteSyslogCreate,
-		Read:   resourceAviatrixRemoteSyslogRead,
-		Delete: resourceAviatrixRemoteSyslogDelete,
+		Create:             resourceAviatrixRemoteSyslogCreate,
+		ReadWithoutTimeout: withContext(resourceAviatrixRemoteSyslogRead),
+		Delete:             resourceAviatrixRemoteSyslogDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -167,14 +168,14 @@ func resourceAviatrixRemoteSyslogCreate(d *schema.ResourceData, meta any) error
 	return nil
 }
 
-func resourceAviatrixRemoteSyslogRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixRemoteSyslogRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	server := getString(d, "server")
 
 	if server == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import. Import Id is %s", id))
 
 		match := remoteSyslogMatcher.Match([]byte(id))
 		if !match {
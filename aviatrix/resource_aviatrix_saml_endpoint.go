@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,10 +16,10 @@ import (
 
 func resourceAviatrixSamlEndpoint() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixSamlEndpointCreate,
-		Read:   resourceAviatrixSamlEndpointRead,
-		Delete: resourceAviatrixSamlEndpointDelete,
-		Update: resourceAviatrixSamlEndpointUpdate,
+		CreateWithoutTimeout: withContext(resourceAviatrixSamlEndpointCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixSamlEndpointRead),
+		DeleteWithoutTimeout: withContext(resourceAviatrixSamlEndpointDelete),
+		UpdateWithoutTimeout: withContext(resourceAviatrixSamlEndpointUpdate),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -96,7 +97,7 @@ func resourceAviatrixSamlEndpoint() *schema.Resource {
 	}
 }
 
-func resourceAviatrixSamlEndpointCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixSamlEndpointCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	samlEndpoint, err := GetAviatrixSamlEndpointInput(d)
@@ -106,32 +107,32 @@ func resourceAviatrixSamlEndpointCreate(d *schema.ResourceData, meta any) error
 
 	d.SetId(samlEndpoint.EndPointName)
 	flag := false
-	defer func() { _ = resourceAviatrixSamlEndpointReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixSamlEndpointReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	err = client.CreateSamlEndpoint(samlEndpoint)
 	if err != nil {
 		return fmt.Errorf("failed to create Aviatrix SAML endpoint: %w", err)
 	}
 
-	return resourceAviatrixSamlEndpointReadIfRequired(d, meta, &flag)
+	return resourceAviatrixSamlEndpointReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixSamlEndpointReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixSamlEndpointReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixSamlEndpointRead(d, meta)
+		return resourceAviatrixSamlEndpointRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixSamlEndpointRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixSamlEndpointRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	endpointName := getString(d, "endpoint_name")
 
 	if endpointName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no SAML endpoint names received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no SAML endpoint names received. Import Id is %s", id))
 		mustSet(d, "endpoint_name", id)
 		d.SetId(id)
 	}
@@ -149,7 +150,7 @@ func resourceAviatrixSamlEndpointRead(d *schema.ResourceData, meta any) error {
 		return fmt.Errorf("couldn't find Aviatrix SAML Endpoint: %w", err)
 	}
 
-	log.Printf("[INFO] Found Aviatrix SAML Endpoint: %#v", saml)
+	tflog.Info(ctx, fmt.Sprintf("Found Aviatrix SAML Endpoint: %#v", goaviatrix.Redact(saml)))
 	mustSet(d, "endpoint_name", saml.EndPointName)
 	mustSet(d, "idp_metadata_type", saml.IdpMetadataType)
 	if saml.IdpMetadataType == "URL" {
@@ -175,11 +176,11 @@ func resourceAviatrixSamlEndpointRead(d *schema.ResourceData, meta any) error {
 		rbacGroupsRead := saml.RbacGroupsRead
 		if len(goaviatrix.Difference(rbacGroups, rbacGroupsRead)) == 0 && len(goaviatrix.Difference(rbacGroupsRead, rbacGroups)) == 0 {
 			if err := d.Set("rbac_groups", rbacGroups); err != nil {
-				log.Printf("[WARN] Error setting 'rbac_groups' for (%s): %s", d.Id(), err)
+				tflog.Warn(ctx, fmt.Sprintf("Error setting 'rbac_groups' for (%s): %s", d.Id(), err))
 			}
 		} else {
 			if err := d.Set("rbac_groups", rbacGroupsRead); err != nil {
-				log.Printf("[WARN] Error setting 'rbac_groups' for (%s): %s", d.Id(), err)
+				tflog.Warn(ctx, fmt.Sprintf("Error setting 'rbac_groups' for (%s): %s", d.Id(), err))
 			}
 		}
 	} else {
@@ -190,7 +191,7 @@ func resourceAviatrixSamlEndpointRead(d *schema.ResourceData, meta any) error {
 	return nil
 }
 
-func resourceAviatrixSamlEndpointUpdate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixSamlEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	samlEndpoint, err := GetAviatrixSamlEndpointInput(d)
@@ -203,17 +204,17 @@ func resourceAviatrixSamlEndpointUpdate(d *schema.ResourceData, meta any) error
 	}
 
 	d.SetId(samlEndpoint.EndPointName)
-	return resourceAviatrixSamlEndpointRead(d, meta)
+	return resourceAviatrixSamlEndpointRead(ctx, d, meta)
 }
 
-func resourceAviatrixSamlEndpointDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixSamlEndpointDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	samlEndpoint := &goaviatrix.SamlEndpoint{
 		EndPointName: getString(d, "endpoint_name"),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix SAML Endpoint: %#v", samlEndpoint)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix SAML Endpoint: %#v", goaviatrix.Redact(samlEndpoint)))
 
 	samlEndpoint.EndPointName = getString(d, "endpoint_name")
 
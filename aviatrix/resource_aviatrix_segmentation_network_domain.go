@@ -1,10 +1,11 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -12,9 +13,9 @@ import (
 
 func resourceAviatrixSegmentationNetworkDomain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixSegmentationNetworkDomainCreate,
-		Read:   resourceAviatrixSegmentationNetworkDomainRead,
-		Delete: resourceAviatrixSegmentationNetworkDomainDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixSegmentationNetworkDomainCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixSegmentationNetworkDomainRead),
+		Delete:               resourceAviatrixSegmentationNetworkDomainDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -36,37 +37,37 @@ func marshalSegmentationNetworkDomainInput(d *schema.ResourceData) *goaviatrix.S
 	}
 }
 
-func resourceAviatrixSegmentationNetworkDomainCreate(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixSegmentationNetworkDomainCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustSegmentationClient(meta)
 
 	domain := marshalSegmentationNetworkDomainInput(d)
 
 	d.SetId(domain.DomainName)
 	flag := false
-	defer func() { _ = resourceAviatrixSegmentationNetworkDomainReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixSegmentationNetworkDomainReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	if err := client.CreateSegmentationSecurityDomain(domain); err != nil {
 		return fmt.Errorf("could not create network domain: %w", err)
 	}
 
-	return resourceAviatrixSegmentationNetworkDomainReadIfRequired(d, meta, &flag)
+	return resourceAviatrixSegmentationNetworkDomainReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixSegmentationNetworkDomainReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixSegmentationNetworkDomainReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixSegmentationNetworkDomainRead(d, meta)
+		return resourceAviatrixSegmentationNetworkDomainRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixSegmentationNetworkDomainRead(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixSegmentationNetworkDomainRead(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustSegmentationClient(meta)
 
 	domainName := getString(d, "domain_name")
 	if domainName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no segmentation_network_domain domain_name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no segmentation_network_domain domain_name received. Import Id is %s", id))
 		d.SetId(id)
 		domainName = id
 	}
@@ -89,7 +90,7 @@ func resourceAviatrixSegmentationNetworkDomainRead(d *schema.ResourceData, meta
 }
 
 func resourceAviatrixSegmentationNetworkDomainDelete(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+	client := mustSegmentationClient(meta)
 
 	domain := marshalSegmentationNetworkDomainInput(d)
 
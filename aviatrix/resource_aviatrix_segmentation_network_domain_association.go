@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -13,9 +14,9 @@ import (
 
 func resourceAviatrixSegmentationNetworkDomainAssociation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixSegmentationNetworkDomainAssociationCreate,
-		Read:   resourceAviatrixSegmentationNetworkDomainAssociationRead,
-		Delete: resourceAviatrixSegmentationNetworkDomainAssociationDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixSegmentationNetworkDomainAssociationCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixSegmentationNetworkDomainAssociationRead),
+		Delete:               resourceAviatrixSegmentationNetworkDomainAssociationDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -54,38 +55,38 @@ func marshalSegmentationNetworkDomainAssociationInput(d *schema.ResourceData) *g
 	}
 }
 
-func resourceAviatrixSegmentationNetworkDomainAssociationCreate(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixSegmentationNetworkDomainAssociationCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustSegmentationClient(meta)
 
 	association := marshalSegmentationNetworkDomainAssociationInput(d)
 
 	d.SetId(association.SecurityDomainName + "~" + association.AttachmentName)
 	flag := false
-	defer func() { _ = resourceAviatrixSegmentationNetworkDomainAssociationReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixSegmentationNetworkDomainAssociationReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	if err := client.CreateSegmentationSecurityDomainAssociation(association); err != nil {
 		return fmt.Errorf("could not create segmentation network domain association: %w", err)
 	}
 
-	return resourceAviatrixSegmentationNetworkDomainAssociationReadIfRequired(d, meta, &flag)
+	return resourceAviatrixSegmentationNetworkDomainAssociationReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixSegmentationNetworkDomainAssociationReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixSegmentationNetworkDomainAssociationReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixSegmentationNetworkDomainAssociationRead(d, meta)
+		return resourceAviatrixSegmentationNetworkDomainAssociationRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixSegmentationNetworkDomainAssociationRead(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixSegmentationNetworkDomainAssociationRead(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustSegmentationClient(meta)
 
 	networkDomainName := getString(d, "network_domain_name")
 	attachmentName := getString(d, "attachment_name")
 	if networkDomainName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no network_domain_name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no network_domain_name received. Import Id is %s", id))
 		d.SetId(id)
 		parts := strings.Split(id, "~")
 		networkDomainName = parts[0]
@@ -115,7 +116,7 @@ func resourceAviatrixSegmentationNetworkDomainAssociationRead(d *schema.Resource
 }
 
 func resourceAviatrixSegmentationNetworkDomainAssociationDelete(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+	client := mustSegmentationClient(meta)
 
 	association := marshalSegmentationNetworkDomainAssociationInput(d)
 
@@ -1,12 +1,13 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"slices"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -14,9 +15,9 @@ import (
 
 func resourceAviatrixSegmentationNetworkDomainConnectionPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixSegmentationNetworkDomainConnectionPolicyCreate,
-		Read:   resourceAviatrixSegmentationNetworkDomainConnectionPolicyRead,
-		Delete: resourceAviatrixSegmentationNetworkDomainConnectionPolicyDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixSegmentationNetworkDomainConnectionPolicyCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixSegmentationNetworkDomainConnectionPolicyRead),
+		Delete:               resourceAviatrixSegmentationNetworkDomainConnectionPolicyDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -49,8 +50,8 @@ func marshalSegmentationNetworkDomainConnectionPolicyInput(d *schema.ResourceDat
 	}
 }
 
-func resourceAviatrixSegmentationNetworkDomainConnectionPolicyCreate(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixSegmentationNetworkDomainConnectionPolicyCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustSegmentationClient(meta)
 
 	policy := marshalSegmentationNetworkDomainConnectionPolicyInput(d)
 
@@ -67,31 +68,33 @@ func resourceAviatrixSegmentationNetworkDomainConnectionPolicyCreate(d *schema.R
 
 	d.SetId(policy.Domain1.DomainName + "~" + policy.Domain2.DomainName)
 	flag := false
-	defer func() { _ = resourceAviatrixSegmentationNetworkDomainConnectionPolicyReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() {
+		_ = resourceAviatrixSegmentationNetworkDomainConnectionPolicyReadIfRequired(ctx, d, meta, &flag)
+	}() //nolint:errcheck // read on deferred path
 
 	if err := client.CreateSegmentationSecurityDomainConnectionPolicy(policy); err != nil {
 		return fmt.Errorf("could not create network domain connection policy: %w", err)
 	}
 
-	return resourceAviatrixSegmentationNetworkDomainConnectionPolicyReadIfRequired(d, meta, &flag)
+	return resourceAviatrixSegmentationNetworkDomainConnectionPolicyReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixSegmentationNetworkDomainConnectionPolicyReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixSegmentationNetworkDomainConnectionPolicyReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixSegmentationNetworkDomainConnectionPolicyRead(d, meta)
+		return resourceAviatrixSegmentationNetworkDomainConnectionPolicyRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixSegmentationNetworkDomainConnectionPolicyRead(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixSegmentationNetworkDomainConnectionPolicyRead(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustSegmentationClient(meta)
 
 	domainName1 := getString(d, "domain_name_1")
 	domainName2 := getString(d, "domain_name_2")
 	if domainName1 == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no segmentation_network_domain_connection_policy domain_name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no segmentation_network_domain_connection_policy domain_name received. Import Id is %s", id))
 		d.SetId(id)
 		parts := strings.Split(id, "~")
 		domainName1 = parts[0]
@@ -122,7 +125,7 @@ func resourceAviatrixSegmentationNetworkDomainConnectionPolicyRead(d *schema.Res
 }
 
 func resourceAviatrixSegmentationNetworkDomainConnectionPolicyDelete(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+	client := mustSegmentationClient(meta)
 
 	policy := marshalSegmentationNetworkDomainConnectionPolicyInput(d)
 
@@ -42,6 +42,30 @@ func TestAccAviatrixSegmentationNetworkDomain_basic(t *testing.T) {
 	})
 }
 
+func TestAccAviatrixSegmentationNetworkDomain_fakeController(t *testing.T) {
+	testAccFakeController(t)
+	resourceName := "aviatrix_segmentation_network_domain.test_segmentation_network_domain"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSegmentationNetworkDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentationNetworkDomainBasic("fake"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentationNetworkDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", "segmentation-nd-fake"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccSegmentationNetworkDomainBasic(rName string) string {
 	return fmt.Sprintf(`
 resource "aviatrix_segmentation_network_domain" "test_segmentation_network_domain" {
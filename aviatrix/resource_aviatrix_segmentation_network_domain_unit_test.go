@@ -0,0 +1,64 @@
+package aviatrix
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
+)
+
+func TestResourceAviatrixSegmentationNetworkDomainRead_Import(t *testing.T) {
+	client := &goaviatrix.SegmentationClientMock{
+		GetSegmentationSecurityDomainFunc: func(domain *goaviatrix.SegmentationSecurityDomain) (*goaviatrix.SegmentationSecurityDomain, error) {
+			assert.Equal(t, "prod", domain.DomainName)
+			return &goaviatrix.SegmentationSecurityDomain{DomainName: "prod"}, nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceAviatrixSegmentationNetworkDomain().Schema, map[string]any{})
+	d.SetId("prod")
+	err := resourceAviatrixSegmentationNetworkDomainRead(context.Background(), d, client)
+
+	require.NoError(t, err)
+	assert.Equal(t, "prod", d.Id())
+	assert.Equal(t, "prod", d.Get("domain_name"))
+}
+
+func TestResourceAviatrixSegmentationNetworkDomainRead_RemovesDeletedDomain(t *testing.T) {
+	client := &goaviatrix.SegmentationClientMock{
+		GetSegmentationSecurityDomainFunc: func(domain *goaviatrix.SegmentationSecurityDomain) (*goaviatrix.SegmentationSecurityDomain, error) {
+			return nil, goaviatrix.ErrNotFound
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceAviatrixSegmentationNetworkDomain().Schema, map[string]any{
+		"domain_name": "prod",
+	})
+	d.SetId("prod")
+	err := resourceAviatrixSegmentationNetworkDomainRead(context.Background(), d, client)
+
+	require.NoError(t, err)
+	assert.Empty(t, d.Id())
+}
+
+func TestResourceAviatrixSegmentationNetworkDomainDelete_WhenDeleteFails(t *testing.T) {
+	client := &goaviatrix.SegmentationClientMock{
+		DeleteSegmentationSecurityDomainFunc: func(domain *goaviatrix.SegmentationSecurityDomain) error {
+			assert.Equal(t, "prod", domain.DomainName)
+			return errors.New("controller API failure")
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceAviatrixSegmentationNetworkDomain().Schema, map[string]any{
+		"domain_name": "prod",
+	})
+	err := resourceAviatrixSegmentationNetworkDomainDelete(d, client)
+
+	assert.EqualError(t, err, "could not delete segmentation_network_domain: controller API failure")
+	assert.Len(t, client.DeleteSegmentationSecurityDomainCalls(), 1)
+}
@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -25,11 +26,11 @@ var customMappedAttributeNames = []string{
 }
 
 func resourceAviatrixSite2Cloud() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceAviatrixSite2CloudCreate,
-		Read:   resourceAviatrixSite2CloudRead,
-		Update: resourceAviatrixSite2CloudUpdate,
-		Delete: resourceAviatrixSite2CloudDelete,
+	r := &schema.Resource{
+		CreateWithoutTimeout: withContext(resourceAviatrixSite2CloudCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixSite2CloudRead),
+		UpdateWithoutTimeout: withContext(resourceAviatrixSite2CloudUpdate),
+		DeleteWithoutTimeout: withContext(resourceAviatrixSite2CloudDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -248,28 +249,28 @@ func resourceAviatrixSite2Cloud() *schema.Resource {
 				Type:             schema.TypeFloat,
 				Optional:         true,
 				ForceNew:         true,
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncRemoteGwLatitude,
+				DiffSuppressFunc: DiffSuppressFuncRemoteGwLatitude,
 				Description:      "Latitude of remote gateway.",
 			},
 			"remote_gateway_longitude": {
 				Type:             schema.TypeFloat,
 				Optional:         true,
 				ForceNew:         true,
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncRemoteGwLongitude,
+				DiffSuppressFunc: DiffSuppressFuncRemoteGwLongitude,
 				Description:      "Longitude of remote gateway.",
 			},
 			"backup_remote_gateway_latitude": {
 				Type:             schema.TypeFloat,
 				Optional:         true,
 				ForceNew:         true,
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncBackupRemoteGwLatitude,
+				DiffSuppressFunc: DiffSuppressFuncBackupRemoteGwLatitude,
 				Description:      "Latitude of backup remote gateway.",
 			},
 			"backup_remote_gateway_longitude": {
 				Type:             schema.TypeFloat,
 				Optional:         true,
 				ForceNew:         true,
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncBackupRemoteGwLongitude,
+				DiffSuppressFunc: DiffSuppressFuncBackupRemoteGwLongitude,
 				Description:      "Longitude of backup remote gateway.",
 			},
 			"ssl_server_pool": {
@@ -314,56 +315,56 @@ func resourceAviatrixSite2Cloud() *schema.Resource {
 				Type:             schema.TypeList,
 				Optional:         true,
 				Elem:             &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.IsCIDR},
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncRemoteSourceRealCIDRs,
+				DiffSuppressFunc: DiffSuppressFuncRemoteSourceRealCIDRs,
 				Description:      "Remote Initiated Traffic Source Real CIDRs.",
 			},
 			"remote_source_virtual_cidrs": {
 				Type:             schema.TypeList,
 				Optional:         true,
 				Elem:             &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.IsCIDR},
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncRemoteSourceVirtualCIDRs,
+				DiffSuppressFunc: DiffSuppressFuncRemoteSourceVirtualCIDRs,
 				Description:      "Remote Initiated Traffic Source Virtual CIDRs.",
 			},
 			"remote_destination_real_cidrs": {
 				Type:             schema.TypeList,
 				Optional:         true,
 				Elem:             &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.IsCIDR},
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncRemoteDestinationRealCIDRs,
+				DiffSuppressFunc: DiffSuppressFuncRemoteDestinationRealCIDRs,
 				Description:      "Remote Initiated Traffic Destination Real CIDRs.",
 			},
 			"remote_destination_virtual_cidrs": {
 				Type:             schema.TypeList,
 				Optional:         true,
 				Elem:             &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.IsCIDR},
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncRemoteDestinationVirtualCIDRs,
+				DiffSuppressFunc: DiffSuppressFuncRemoteDestinationVirtualCIDRs,
 				Description:      "Remote Initiated Traffic Destination Virtual CIDRs.",
 			},
 			"local_source_real_cidrs": {
 				Type:             schema.TypeList,
 				Optional:         true,
 				Elem:             &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.IsCIDR},
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncLocalSourceRealCIDRs,
+				DiffSuppressFunc: DiffSuppressFuncLocalSourceRealCIDRs,
 				Description:      "Local Initiated Traffic Source Real CIDRs.",
 			},
 			"local_source_virtual_cidrs": {
 				Type:             schema.TypeList,
 				Optional:         true,
 				Elem:             &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.IsCIDR},
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncLocalSourceVirtualCIDRs,
+				DiffSuppressFunc: DiffSuppressFuncLocalSourceVirtualCIDRs,
 				Description:      "Local Initiated Traffic Source Virtual CIDRs.",
 			},
 			"local_destination_real_cidrs": {
 				Type:             schema.TypeList,
 				Optional:         true,
 				Elem:             &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.IsCIDR},
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncLocalDestinationRealCIDRs,
+				DiffSuppressFunc: DiffSuppressFuncLocalDestinationRealCIDRs,
 				Description:      "Local Initiated Traffic Destination Real CIDRs.",
 			},
 			"local_destination_virtual_cidrs": {
 				Type:             schema.TypeList,
 				Optional:         true,
 				Elem:             &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.IsCIDR},
-				DiffSuppressFunc: goaviatrix.DiffSuppressFuncLocalDestinationVirtualCIDRs,
+				DiffSuppressFunc: DiffSuppressFuncLocalDestinationVirtualCIDRs,
 				Description:      "Local Initiated Traffic Destination Virtual CIDRs.",
 			},
 			"enable_event_triggered_ha": {
@@ -415,9 +416,9 @@ func resourceAviatrixSite2Cloud() *schema.Resource {
 				Optional: true,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: goaviatrix.StringCanBeEmptyButCannotBeWhiteSpace,
+					ValidateFunc: StringCanBeEmptyButCannotBeWhiteSpace,
 				},
-				DiffSuppressFunc: goaviatrix.S2CPh1RemoteIdDiffSuppressFunc,
+				DiffSuppressFunc: S2CPh1RemoteIdDiffSuppressFunc,
 				Description:      "List of phase 1 remote identifier of the IPsec tunnel. This can be configured as a list of any string, including empty string.",
 			},
 			"proxy_id_enabled": {
@@ -428,6 +429,9 @@ func resourceAviatrixSite2Cloud() *schema.Resource {
 			},
 		},
 	}
+	addWriteOnly(r.Schema, "pre_shared_key", "Pre-Shared Key.")
+	addWriteOnly(r.Schema, "backup_pre_shared_key", "Backup Pre-Shared Key.")
+	return r
 }
 
 func getCSVFromStringList(d *schema.ResourceData, attributeName string) string {
@@ -436,62 +440,9 @@ func getCSVFromStringList(d *schema.ResourceData, attributeName string) string {
 	return strings.Join(expandedList, ",")
 }
 
-func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
-
-	s2c := &goaviatrix.Site2Cloud{
-		GwName:                        getString(d, "primary_cloud_gateway_name"),
-		BackupGwName:                  getString(d, "backup_gateway_name"),
-		VpcID:                         getString(d, "vpc_id"),
-		TunnelName:                    getString(d, "connection_name"),
-		ConnType:                      getString(d, "connection_type"),
-		AuthType:                      getString(d, "auth_type"),
-		TunnelType:                    getString(d, "tunnel_type"),
-		CaCertTagName:                 getString(d, "ca_cert_tag_name"),
-		RemoteIdentifier:              getString(d, "remote_identifier"),
-		RemoteGwType:                  getString(d, "remote_gateway_type"),
-		RemoteGwIP:                    getString(d, "remote_gateway_ip"),
-		RemoteGwIP2:                   getString(d, "backup_remote_gateway_ip"),
-		PreSharedKey:                  getString(d, "pre_shared_key"),
-		BackupPreSharedKey:            getString(d, "backup_pre_shared_key"),
-		BackupRemoteIdentifier:        getString(d, "backup_remote_identifier"),
-		RemoteSubnet:                  getString(d, "remote_subnet_cidr"),
-		LocalSubnet:                   getString(d, "local_subnet_cidr"),
-		RemoteSubnetVirtual:           getString(d, "remote_subnet_virtual"),
-		LocalSubnetVirtual:            getString(d, "local_subnet_virtual"),
-		CustomMap:                     getBool(d, "custom_mapped"),
-		RemoteSourceRealCIDRs:         getCSVFromStringList(d, "remote_source_real_cidrs"),
-		RemoteSourceVirtualCIDRs:      getCSVFromStringList(d, "remote_source_virtual_cidrs"),
-		RemoteDestinationRealCIDRs:    getCSVFromStringList(d, "remote_destination_real_cidrs"),
-		RemoteDestinationVirtualCIDRs: getCSVFromStringList(d, "remote_destination_virtual_cidrs"),
-		LocalSourceRealCIDRs:          getCSVFromStringList(d, "local_source_real_cidrs"),
-		LocalSourceVirtualCIDRs:       getCSVFromStringList(d, "local_source_virtual_cidrs"),
-		LocalDestinationRealCIDRs:     getCSVFromStringList(d, "local_destination_real_cidrs"),
-		LocalDestinationVirtualCIDRs:  getCSVFromStringList(d, "local_destination_virtual_cidrs"),
-		LocalTunnelIp:                 getString(d, "local_tunnel_ip"),
-		RemoteTunnelIp:                getString(d, "remote_tunnel_ip"),
-		BackupLocalTunnelIp:           getString(d, "backup_local_tunnel_ip"),
-		BackupRemoteTunnelIp:          getString(d, "backup_remote_tunnel_ip"),
-	}
-
-	s2c.ProxyIdEnabled = getBool(d, "proxy_id_enabled")
-
-	haEnabled := getBool(d, "ha_enabled")
-	if s2c.AuthType == "Cert" {
-		if s2c.CaCertTagName == "" || s2c.RemoteIdentifier == "" {
-			return fmt.Errorf("'ca_cert_tag_name' and 'remote_identifier' are both required for Cert based authentication type")
-		}
-		if haEnabled && s2c.BackupRemoteIdentifier == "" {
-			return fmt.Errorf("'backup_remote_identifier' is required for Cert based authentication type with HA enabled")
-		}
-		s2c.AuthType = "pubkey"
-	} else {
-		if s2c.CaCertTagName != "" || s2c.RemoteIdentifier != "" || s2c.BackupRemoteIdentifier != "" {
-			return fmt.Errorf("'ca_cert_tag_name', 'remote_identifier' and 'backup_remote_identifier' are required to be empty for PSK(Pubkey) based authentication type")
-		}
-	}
-
-	singleIpHA := getBool(d, "enable_single_ip_ha")
+// setSite2CloudHA sets the HA fields of s2c, with the backup remote gateway
+// IP appended to RemoteGwIP as add_site2cloud expects it.
+func setSite2CloudHA(s2c *goaviatrix.Site2Cloud, haEnabled, singleIpHA, activeActive bool) error {
 	if haEnabled {
 		s2c.HAEnabled = "yes"
 		// 22021: Remote GW IP is not required when singleIPHA is enabled as only 1 tunnel is created
@@ -511,61 +462,39 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta any) error {
 				"and 'backup_remote_tunnel_ip' are only valid when HA is enabled")
 		}
 	}
+	if activeActive && !haEnabled {
+		return fmt.Errorf("active_active_ha can't be enabled if HA isn't enabled for site2cloud connection")
+	}
+	return nil
+}
 
+// validateSite2CloudTunnel checks the connection type and tunnel IPs of s2c.
+func validateSite2CloudTunnel(s2c *goaviatrix.Site2Cloud) error {
 	if s2c.TunnelType == "policy" {
 		if s2c.LocalTunnelIp != "" || s2c.RemoteTunnelIp != "" || s2c.BackupLocalTunnelIp != "" || s2c.BackupRemoteTunnelIp != "" {
 			return fmt.Errorf("'local_tunnel_ip', 'remote_tunnel_ip', 'backup_local_tunnel_ip' " +
 				"and 'backup_remote_tunnel_ip' are only valid for route based connection")
 		}
 	}
-
-	activeActive := getBool(d, "enable_active_active")
-	if activeActive && !haEnabled {
-		return fmt.Errorf("active_active_ha can't be enabled if HA isn't enabled for site2cloud connection")
-	}
-
 	if s2c.ConnType != "mapped" && s2c.ConnType != "unmapped" {
 		return fmt.Errorf("'connection_type' should be 'mapped' or 'unmapped'")
 	}
+	return nil
+}
 
-	gateway := &goaviatrix.Gateway{
-		GwName: s2c.GwName,
-	}
-
-	gw, err := client.GetGateway(gateway)
-	if err != nil {
-		if errors.Is(err, goaviatrix.ErrNotFound) {
-			return fmt.Errorf("couldn't find Aviatrix Gateway %s", s2c.GwName)
-		} else {
-			return fmt.Errorf("couldn't find Aviatrix Gateway %s: %w", s2c.GwName, err)
-		}
-	}
-
+// validateSite2CloudGateway checks s2c against its primary gateway gw.
+func validateSite2CloudGateway(gw *goaviatrix.Gateway, s2c *goaviatrix.Site2Cloud, haEnabled, activeActive bool) error {
 	if gw.TransitVpc == "yes" {
 		if s2c.ConnType == "unmapped" && s2c.TunnelType == "policy" && haEnabled && !activeActive {
 			return fmt.Errorf("active_active_ha must be enabled if HA is enabled for transit gateway unmapped policy based site2cloud connection")
 		}
 	}
+	return nil
+}
 
-	if singleIpHA {
-		if !haEnabled {
-			return fmt.Errorf("'enable_single_ip_ha' can't be enabled if HA isn't enabled for site2cloud connection")
-		}
-		if s2c.AuthType == "pubkey" {
-			return fmt.Errorf("single IP HA is only supported for PSK authentication type based site2cloud connection")
-		}
-		if s2c.RemoteGwIP2 != "" && s2c.RemoteGwIP != s2c.RemoteGwIP2 {
-			return fmt.Errorf("'backup_remote_gateway_ip' is required to be empty or the same as 'remote_gateway_ip' when single IP HA is enabled")
-		}
-		if s2c.BackupPreSharedKey != "" && s2c.PreSharedKey != s2c.BackupPreSharedKey {
-			return fmt.Errorf("'backup_pre_shared_key' is required to be empty or the same as 'pre_shared_key' when single IP HA is enabled")
-		}
-		if s2c.BackupLocalTunnelIp != "" || s2c.BackupRemoteTunnelIp != "" {
-			return fmt.Errorf("'backup_local_tunnel_ip' and 'backup_remote_tunnel_ip' are required to be empty when single IP HA is enabled")
-		}
-		s2c.EnableSingleIpHA = true
-	}
-
+// validateSite2CloudSubnets checks the subnets and custom mapped CIDRs of s2c
+// against its connection type.
+func validateSite2CloudSubnets(s2c *goaviatrix.Site2Cloud) error {
 	if !s2c.CustomMap && s2c.RemoteSubnet == "" {
 		return fmt.Errorf("'remote_subnet_cidr' is required unless you are using 'custom_mapped'")
 	}
@@ -588,7 +517,7 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta any) error {
 			"connection type: mapped, unless 'custom_mapped' is enabled")
 	} else if s2c.ConnType == "unmapped" && (s2c.RemoteSubnetVirtual != "" || s2c.LocalSubnetVirtual != "") {
 		return fmt.Errorf("'remote_subnet_virtual' and 'local_subnet_virtual' both should be empty for " +
-			"connection type: ummapped")
+			"connection type: unmapped")
 	}
 	hasSetAnyCustomMapAttribute := s2c.RemoteSourceRealCIDRs != "" || s2c.RemoteSourceVirtualCIDRs != "" ||
 		s2c.RemoteDestinationRealCIDRs != "" || s2c.RemoteDestinationVirtualCIDRs != "" ||
@@ -605,15 +534,12 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta any) error {
 	if s2c.CustomMap && !hasSetAllCustomLocalCIDRs && !hasSetAllCustomRemoteCIDRs {
 		return fmt.Errorf("'custom_mapped' enabled connection requires either all Remote Initiated CIDRs or all Local Initiated CIDRs be provided")
 	}
+	return nil
+}
 
-	s2c.Phase1Auth = getString(d, "phase_1_authentication")
-	s2c.Phase1DhGroups = getString(d, "phase_1_dh_groups")
-	s2c.Phase1Encryption = getString(d, "phase_1_encryption")
-	s2c.Phase2Auth = getString(d, "phase_2_authentication")
-	s2c.Phase2DhGroups = getString(d, "phase_2_dh_groups")
-	s2c.Phase2Encryption = getString(d, "phase_2_encryption")
-
-	customAlgorithms := getBool(d, "custom_algorithms")
+// setSite2CloudAlgorithms checks the phase 1 and phase 2 algorithms of s2c,
+// and sets them to their defaults unless customAlgorithms is enabled.
+func setSite2CloudAlgorithms(s2c *goaviatrix.Site2Cloud, customAlgorithms bool) error {
 	if customAlgorithms {
 		if s2c.Phase1Auth == "" ||
 			s2c.Phase2Auth == "" ||
@@ -663,6 +589,123 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta any) error {
 			s2c.Phase2Encryption = goaviatrix.Phase2EncryptionDefault
 		}
 	}
+	return nil
+}
+
+func resourceAviatrixSite2CloudCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustSite2CloudClient(meta)
+
+	s2c := &goaviatrix.Site2Cloud{
+		GwName:                        getString(d, "primary_cloud_gateway_name"),
+		BackupGwName:                  getString(d, "backup_gateway_name"),
+		VpcID:                         getString(d, "vpc_id"),
+		TunnelName:                    getString(d, "connection_name"),
+		ConnType:                      getString(d, "connection_type"),
+		AuthType:                      getString(d, "auth_type"),
+		TunnelType:                    getString(d, "tunnel_type"),
+		CaCertTagName:                 getString(d, "ca_cert_tag_name"),
+		RemoteIdentifier:              getString(d, "remote_identifier"),
+		RemoteGwType:                  getString(d, "remote_gateway_type"),
+		RemoteGwIP:                    getString(d, "remote_gateway_ip"),
+		RemoteGwIP2:                   getString(d, "backup_remote_gateway_ip"),
+		PreSharedKey:                  getSecret(d, "pre_shared_key"),
+		BackupPreSharedKey:            getSecret(d, "backup_pre_shared_key"),
+		BackupRemoteIdentifier:        getString(d, "backup_remote_identifier"),
+		RemoteSubnet:                  getString(d, "remote_subnet_cidr"),
+		LocalSubnet:                   getString(d, "local_subnet_cidr"),
+		RemoteSubnetVirtual:           getString(d, "remote_subnet_virtual"),
+		LocalSubnetVirtual:            getString(d, "local_subnet_virtual"),
+		CustomMap:                     getBool(d, "custom_mapped"),
+		RemoteSourceRealCIDRs:         getCSVFromStringList(d, "remote_source_real_cidrs"),
+		RemoteSourceVirtualCIDRs:      getCSVFromStringList(d, "remote_source_virtual_cidrs"),
+		RemoteDestinationRealCIDRs:    getCSVFromStringList(d, "remote_destination_real_cidrs"),
+		RemoteDestinationVirtualCIDRs: getCSVFromStringList(d, "remote_destination_virtual_cidrs"),
+		LocalSourceRealCIDRs:          getCSVFromStringList(d, "local_source_real_cidrs"),
+		LocalSourceVirtualCIDRs:       getCSVFromStringList(d, "local_source_virtual_cidrs"),
+		LocalDestinationRealCIDRs:     getCSVFromStringList(d, "local_destination_real_cidrs"),
+		LocalDestinationVirtualCIDRs:  getCSVFromStringList(d, "local_destination_virtual_cidrs"),
+		LocalTunnelIp:                 getString(d, "local_tunnel_ip"),
+		RemoteTunnelIp:                getString(d, "remote_tunnel_ip"),
+		BackupLocalTunnelIp:           getString(d, "backup_local_tunnel_ip"),
+		BackupRemoteTunnelIp:          getString(d, "backup_remote_tunnel_ip"),
+	}
+
+	s2c.ProxyIdEnabled = getBool(d, "proxy_id_enabled")
+
+	haEnabled := getBool(d, "ha_enabled")
+	if s2c.AuthType == "Cert" {
+		if s2c.CaCertTagName == "" || s2c.RemoteIdentifier == "" {
+			return fmt.Errorf("'ca_cert_tag_name' and 'remote_identifier' are both required for Cert based authentication type")
+		}
+		if haEnabled && s2c.BackupRemoteIdentifier == "" {
+			return fmt.Errorf("'backup_remote_identifier' is required for Cert based authentication type with HA enabled")
+		}
+		s2c.AuthType = "pubkey"
+	} else {
+		if s2c.CaCertTagName != "" || s2c.RemoteIdentifier != "" || s2c.BackupRemoteIdentifier != "" {
+			return fmt.Errorf("'ca_cert_tag_name', 'remote_identifier' and 'backup_remote_identifier' are required to be empty for PSK(Pubkey) based authentication type")
+		}
+	}
+
+	singleIpHA := getBool(d, "enable_single_ip_ha")
+	activeActive := getBool(d, "enable_active_active")
+	if err := setSite2CloudHA(s2c, haEnabled, singleIpHA, activeActive); err != nil {
+		return err
+	}
+	if err := validateSite2CloudTunnel(s2c); err != nil {
+		return err
+	}
+
+	gateway := &goaviatrix.Gateway{
+		GwName: s2c.GwName,
+	}
+
+	gw, err := client.GetGateway(gateway)
+	if err != nil {
+		if errors.Is(err, goaviatrix.ErrNotFound) {
+			return fmt.Errorf("couldn't find Aviatrix Gateway %s", s2c.GwName)
+		} else {
+			return fmt.Errorf("couldn't find Aviatrix Gateway %s: %w", s2c.GwName, err)
+		}
+	}
+
+	if err := validateSite2CloudGateway(gw, s2c, haEnabled, activeActive); err != nil {
+		return err
+	}
+
+	if singleIpHA {
+		if !haEnabled {
+			return fmt.Errorf("'enable_single_ip_ha' can't be enabled if HA isn't enabled for site2cloud connection")
+		}
+		if s2c.AuthType == "pubkey" {
+			return fmt.Errorf("single IP HA is only supported for PSK authentication type based site2cloud connection")
+		}
+		if s2c.RemoteGwIP2 != "" && s2c.RemoteGwIP != s2c.RemoteGwIP2 {
+			return fmt.Errorf("'backup_remote_gateway_ip' is required to be empty or the same as 'remote_gateway_ip' when single IP HA is enabled")
+		}
+		if s2c.BackupPreSharedKey != "" && s2c.PreSharedKey != s2c.BackupPreSharedKey {
+			return fmt.Errorf("'backup_pre_shared_key' is required to be empty or the same as 'pre_shared_key' when single IP HA is enabled")
+		}
+		if s2c.BackupLocalTunnelIp != "" || s2c.BackupRemoteTunnelIp != "" {
+			return fmt.Errorf("'backup_local_tunnel_ip' and 'backup_remote_tunnel_ip' are required to be empty when single IP HA is enabled")
+		}
+		s2c.EnableSingleIpHA = true
+	}
+
+	if err := validateSite2CloudSubnets(s2c); err != nil {
+		return err
+	}
+
+	s2c.Phase1Auth = getString(d, "phase_1_authentication")
+	s2c.Phase1DhGroups = getString(d, "phase_1_dh_groups")
+	s2c.Phase1Encryption = getString(d, "phase_1_encryption")
+	s2c.Phase2Auth = getString(d, "phase_2_authentication")
+	s2c.Phase2DhGroups = getString(d, "phase_2_dh_groups")
+	s2c.Phase2Encryption = getString(d, "phase_2_encryption")
+
+	if err := setSite2CloudAlgorithms(s2c, getBool(d, "custom_algorithms")); err != nil {
+		return err
+	}
 
 	enableIKEv2 := getBool(d, "enable_ikev2")
 	if enableIKEv2 {
@@ -736,11 +779,11 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta any) error {
 		return fmt.Errorf("please either set one phase 1 remote ID or none, when HA is disabled or single IP HA is enabled")
 	}
 
-	log.Printf("[INFO] Creating Aviatrix Site2Cloud: %#v", s2c)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix Site2Cloud: %#v", goaviatrix.Redact(s2c)))
 
 	d.SetId(s2c.TunnelName + "~" + s2c.VpcID)
 	flag := false
-	defer func() { _ = resourceAviatrixSite2CloudReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixSite2CloudReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	err = client.CreateSite2Cloud(s2c)
 	if err != nil {
@@ -846,25 +889,25 @@ func resourceAviatrixSite2CloudCreate(d *schema.ResourceData, meta any) error {
 		}
 	}
 
-	return resourceAviatrixSite2CloudReadIfRequired(d, meta, &flag)
+	return resourceAviatrixSite2CloudReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixSite2CloudReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixSite2CloudReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixSite2CloudRead(d, meta)
+		return resourceAviatrixSite2CloudRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixSite2CloudRead(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixSite2CloudRead(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustSite2CloudClient(meta)
 
 	tunnelName := getString(d, "connection_name")
 	vpcID := getString(d, "vpc_id")
 	if tunnelName == "" || vpcID == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no tunnel name or vpc id names received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no tunnel name or vpc id names received. Import Id is %s", id))
 		parts := strings.Split(id, "~")
 		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
 			return fmt.Errorf("invalid import ID format")
@@ -949,7 +992,7 @@ func resourceAviatrixSite2CloudRead(d *schema.ResourceData, meta any) error {
 		if s2c.PrivateRouteEncryption == "true" {
 			mustSet(d, "private_route_encryption", true)
 			if err := d.Set("route_table_list", s2c.RouteTableList); err != nil {
-				log.Printf("[WARN] Error setting route_table_list for (%s): %s", d.Id(), err)
+				tflog.Warn(ctx, fmt.Sprintf("Error setting route_table_list for (%s): %s", d.Id(), err))
 			}
 			mustSet(d, "remote_gateway_latitude", s2c.RemoteGwLatitude)
 			mustSet(d, "remote_gateway_longitude", s2c.RemoteGwLongitude)
@@ -1034,15 +1077,15 @@ func resourceAviatrixSite2CloudRead(d *schema.ResourceData, meta any) error {
 		mustSet(d, "phase1_remote_identifier", ph1RemoteId)
 	}
 
-	log.Printf("[TRACE] Reading Aviatrix Site2Cloud %s: %#v", getString(d, "connection_name"), site2cloud)
-	log.Printf("[TRACE] Reading Aviatrix Site2Cloud connection_type: [%s]", getString(d, "connection_type"))
+	tflog.Trace(ctx, fmt.Sprintf("Reading Aviatrix Site2Cloud %s: %#v", getString(d, "connection_name"), goaviatrix.Redact(site2cloud)))
+	tflog.Trace(ctx, fmt.Sprintf("Reading Aviatrix Site2Cloud connection_type: [%s]", getString(d, "connection_type")))
 
 	d.SetId(site2cloud.TunnelName + "~" + site2cloud.VpcID)
 	return nil
 }
 
-func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixSite2CloudUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustSite2CloudClient(meta)
 
 	editSite2cloud := &goaviatrix.EditSite2Cloud{
 		GwName:   getString(d, "primary_cloud_gateway_name"),
@@ -1051,7 +1094,7 @@ func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta any) error {
 	}
 
 	d.Partial(true)
-	log.Printf("[INFO] Updating Aviatrix Site2Cloud: %#v", editSite2cloud)
+	tflog.Info(ctx, fmt.Sprintf("Updating Aviatrix Site2Cloud: %#v", goaviatrix.Redact(editSite2cloud)))
 
 	if d.HasChange("local_subnet_cidr") {
 		if getBool(d, "custom_mapped") && getString(d, "local_subnet_cidr") != "" {
@@ -1078,7 +1121,7 @@ func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta any) error {
 			return fmt.Errorf("'local_subnet_virtual' is required for connection type: mapped, unless 'custom_mapped' is enabled")
 		}
 		if getString(d, "connection_type") == "unmapped" && getString(d, "local_subnet_virtual") != "" {
-			return fmt.Errorf("'local_subnet_virtual' should be empty for connection type: ummapped")
+			return fmt.Errorf("'local_subnet_virtual' should be empty for connection type: unmapped")
 		}
 		localVirtEdit := &goaviatrix.EditSite2Cloud{
 			GwName:             editSite2cloud.GwName,
@@ -1118,7 +1161,7 @@ func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta any) error {
 			return fmt.Errorf("'remote_subnet_virtual' is required for connection type: mapped, unless 'custom_mapped' is enabled")
 		}
 		if getString(d, "connection_type") == "unmapped" && getString(d, "remote_subnet_virtual") != "" {
-			return fmt.Errorf("'remote_subnet_virtual' should be empty for connection type: ummapped")
+			return fmt.Errorf("'remote_subnet_virtual' should be empty for connection type: unmapped")
 		}
 		remoteVirtEdit := &goaviatrix.EditSite2Cloud{
 			GwName:              editSite2cloud.GwName,
@@ -1355,24 +1398,24 @@ func resourceAviatrixSite2CloudUpdate(d *schema.ResourceData, meta any) error {
 
 	d.Partial(false)
 	d.SetId(editSite2cloud.ConnName + "~" + editSite2cloud.VpcID)
-	return resourceAviatrixSite2CloudRead(d, meta)
+	return resourceAviatrixSite2CloudRead(ctx, d, meta)
 }
 
-func resourceAviatrixSite2CloudDelete(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixSite2CloudDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustSite2CloudClient(meta)
 
 	s2c := &goaviatrix.Site2Cloud{
 		VpcID:      getString(d, "vpc_id"),
 		TunnelName: getString(d, "connection_name"),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix s2c: %#v", s2c)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix s2c: %#v", goaviatrix.Redact(s2c)))
 
 	forwardToTransit := getBool(d, "forward_traffic_to_transit")
 	if forwardToTransit {
 		err := client.DisableSpokeMappedSite2CloudForwarding(s2c)
 		if err != nil {
-			log.Println("[WARN] Failed to disable forwarding to transit:", err)
+			tflog.Warn(ctx, fmt.Sprint("Failed to disable forwarding to transit:", " ", err))
 		}
 	}
 
@@ -0,0 +1,502 @@
+package aviatrix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
+)
+
+// site2CloudBulkSharedAttributes are the aviatrix_site2cloud arguments shared
+// by all connections of an aviatrix_site2cloud_bulk. They keep the
+// validation of aviatrix_site2cloud, but force a new resource when changed.
+var site2CloudBulkSharedAttributes = []string{
+	"vpc_id",
+	"primary_cloud_gateway_name",
+	"backup_gateway_name",
+	"ha_enabled",
+	"remote_gateway_type",
+	"connection_type",
+	"tunnel_type",
+	"custom_algorithms",
+	"phase_1_authentication",
+	"phase_1_dh_groups",
+	"phase_1_encryption",
+	"phase_2_authentication",
+	"phase_2_dh_groups",
+	"phase_2_encryption",
+	"enable_ikev2",
+	"enable_dead_peer_detection",
+	"enable_active_active",
+	"custom_mapped",
+}
+
+// defaultSite2CloudBulkTimeout bounds the creation or deletion of all
+// connections of an aviatrix_site2cloud_bulk. Connections still queued when
+// it expires are skipped.
+const defaultSite2CloudBulkTimeout = 30 * time.Minute
+
+func resourceAviatrixSite2CloudBulk() *schema.Resource {
+	s2cSchema := resourceAviatrixSite2Cloud().Schema
+
+	tunnelSchema := map[string]*schema.Schema{
+		"connection_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Site2Cloud Connection Name.",
+		},
+		"remote_gateway_ip": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Remote Gateway IP.",
+		},
+		"backup_remote_gateway_ip": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "Backup remote gateway IP. Required when HA is enabled.",
+		},
+		"pre_shared_key": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Sensitive:   true,
+			Description: "Pre-Shared Key.",
+		},
+		"backup_pre_shared_key": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Sensitive:   true,
+			Description: "Backup Pre-Shared Key.",
+		},
+		"remote_subnet_cidr": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "Remote Subnet CIDR. Required unless 'custom_mapped' is enabled.",
+		},
+		"local_subnet_cidr": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "Local Subnet CIDR.",
+		},
+		"remote_subnet_virtual": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "Remote Subnet CIDR (Virtual).",
+		},
+		"local_subnet_virtual": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "Local Subnet CIDR (Virtual).",
+		},
+		"local_tunnel_ip": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "Local tunnel IP address.",
+		},
+		"remote_tunnel_ip": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "Remote tunnel IP address.",
+		},
+		"backup_local_tunnel_ip": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "Backup local tunnel IP address.",
+		},
+		"backup_remote_tunnel_ip": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: "Backup remote tunnel IP address.",
+		},
+	}
+	for _, name := range customMappedAttributeNames {
+		tunnelSchema[name] = &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.IsCIDR},
+			Description: s2cSchema[name].Description,
+		}
+	}
+
+	resourceSchema := map[string]*schema.Schema{
+		"max_concurrency": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      10,
+			ValidateFunc: validation.IntBetween(1, 50),
+			Description:  "Maximum number of connections created or deleted at the same time.",
+		},
+		"tunnel": {
+			Type:        schema.TypeSet,
+			Required:    true,
+			Description: "Site2Cloud connections on the gateway pair. A changed tunnel is deleted and created again.",
+			Elem:        &schema.Resource{Schema: tunnelSchema},
+		},
+	}
+	for _, name := range site2CloudBulkSharedAttributes {
+		shared := *s2cSchema[name]
+		shared.ForceNew = true
+		shared.DiffSuppressFunc = nil
+		resourceSchema[name] = &shared
+	}
+
+	return &schema.Resource{
+		CreateContext:      resourceAviatrixSite2CloudBulkCreate,
+		ReadWithoutTimeout: resourceAviatrixSite2CloudBulkRead,
+		UpdateContext:      resourceAviatrixSite2CloudBulkUpdate,
+		DeleteContext:      resourceAviatrixSite2CloudBulkDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultSite2CloudBulkTimeout),
+			Update: schema.DefaultTimeout(defaultSite2CloudBulkTimeout),
+			Delete: schema.DefaultTimeout(defaultSite2CloudBulkTimeout),
+		},
+
+		Schema: resourceSchema,
+	}
+}
+
+// site2CloudBulkSettings are the shared arguments of an
+// aviatrix_site2cloud_bulk, read from its ResourceData before any worker
+// starts, since ResourceData is not safe for concurrent use.
+type site2CloudBulkSettings struct {
+	connection        goaviatrix.Site2Cloud
+	haEnabled         bool
+	activeActive      bool
+	customAlgorithms  bool
+	deadPeerDetection bool
+	maxConcurrency    int
+}
+
+func getSite2CloudBulkSettings(d *schema.ResourceData) site2CloudBulkSettings {
+	settings := site2CloudBulkSettings{
+		connection: goaviatrix.Site2Cloud{
+			GwName:                 getString(d, "primary_cloud_gateway_name"),
+			BackupGwName:           getString(d, "backup_gateway_name"),
+			VpcID:                  getString(d, "vpc_id"),
+			ConnType:               getString(d, "connection_type"),
+			AuthType:               "psk",
+			TunnelType:             getString(d, "tunnel_type"),
+			RemoteGwType:           getString(d, "remote_gateway_type"),
+			CustomMap:              getBool(d, "custom_mapped"),
+			Phase1Auth:             getString(d, "phase_1_authentication"),
+			Phase1DhGroups:         getString(d, "phase_1_dh_groups"),
+			Phase1Encryption:       getString(d, "phase_1_encryption"),
+			Phase2Auth:             getString(d, "phase_2_authentication"),
+			Phase2DhGroups:         getString(d, "phase_2_dh_groups"),
+			Phase2Encryption:       getString(d, "phase_2_encryption"),
+			PrivateRouteEncryption: "false",
+		},
+		haEnabled:         getBool(d, "ha_enabled"),
+		activeActive:      getBool(d, "enable_active_active"),
+		customAlgorithms:  getBool(d, "custom_algorithms"),
+		deadPeerDetection: getBool(d, "enable_dead_peer_detection"),
+		maxConcurrency:    getInt(d, "max_concurrency"),
+	}
+	if getBool(d, "enable_ikev2") {
+		settings.connection.EnableIKEv2 = "true"
+	}
+	return settings
+}
+
+// marshalSite2CloudBulkConnection returns the site2cloud connection to create
+// for a tunnel block, with the shared settings. It is validated as an
+// aviatrix_site2cloud connection would be.
+func marshalSite2CloudBulkConnection(settings site2CloudBulkSettings, conn map[string]any) (*goaviatrix.Site2Cloud, error) {
+	csv := func(name string) string {
+		return strings.Join(goaviatrix.ExpandStringList(mustSlice(conn[name])), ",")
+	}
+	s2c := settings.connection
+	s2c.TunnelName = mustString(conn["connection_name"])
+	s2c.RemoteGwIP = mustString(conn["remote_gateway_ip"])
+	s2c.RemoteGwIP2 = mustString(conn["backup_remote_gateway_ip"])
+	s2c.PreSharedKey = mustString(conn["pre_shared_key"])
+	s2c.BackupPreSharedKey = mustString(conn["backup_pre_shared_key"])
+	s2c.RemoteSubnet = mustString(conn["remote_subnet_cidr"])
+	s2c.LocalSubnet = mustString(conn["local_subnet_cidr"])
+	s2c.RemoteSubnetVirtual = mustString(conn["remote_subnet_virtual"])
+	s2c.LocalSubnetVirtual = mustString(conn["local_subnet_virtual"])
+	s2c.RemoteSourceRealCIDRs = csv("remote_source_real_cidrs")
+	s2c.RemoteSourceVirtualCIDRs = csv("remote_source_virtual_cidrs")
+	s2c.RemoteDestinationRealCIDRs = csv("remote_destination_real_cidrs")
+	s2c.RemoteDestinationVirtualCIDRs = csv("remote_destination_virtual_cidrs")
+	s2c.LocalSourceRealCIDRs = csv("local_source_real_cidrs")
+	s2c.LocalSourceVirtualCIDRs = csv("local_source_virtual_cidrs")
+	s2c.LocalDestinationRealCIDRs = csv("local_destination_real_cidrs")
+	s2c.LocalDestinationVirtualCIDRs = csv("local_destination_virtual_cidrs")
+	s2c.LocalTunnelIp = mustString(conn["local_tunnel_ip"])
+	s2c.RemoteTunnelIp = mustString(conn["remote_tunnel_ip"])
+	s2c.BackupLocalTunnelIp = mustString(conn["backup_local_tunnel_ip"])
+	s2c.BackupRemoteTunnelIp = mustString(conn["backup_remote_tunnel_ip"])
+
+	if err := setSite2CloudHA(&s2c, settings.haEnabled, false, settings.activeActive); err != nil {
+		return nil, err
+	}
+	if err := validateSite2CloudTunnel(&s2c); err != nil {
+		return nil, err
+	}
+	if err := validateSite2CloudSubnets(&s2c); err != nil {
+		return nil, err
+	}
+	if err := setSite2CloudAlgorithms(&s2c, settings.customAlgorithms); err != nil {
+		return nil, err
+	}
+	return &s2c, nil
+}
+
+// marshalSite2CloudBulkConnections returns the site2cloud connections to
+// create for the tunnel blocks conns.
+func marshalSite2CloudBulkConnections(settings site2CloudBulkSettings, conns []any) ([]*goaviatrix.Site2Cloud, error) {
+	names := make(map[string]bool, len(conns))
+	s2cs := make([]*goaviatrix.Site2Cloud, 0, len(conns))
+	for _, raw := range conns {
+		conn := mustMap(raw)
+		s2c, err := marshalSite2CloudBulkConnection(settings, conn)
+		if err != nil {
+			return nil, fmt.Errorf("invalid connection %q: %w", mustString(conn["connection_name"]), err)
+		}
+		if names[s2c.TunnelName] {
+			return nil, fmt.Errorf("duplicate connection %q", s2c.TunnelName)
+		}
+		names[s2c.TunnelName] = true
+		s2cs = append(s2cs, s2c)
+	}
+	return s2cs, nil
+}
+
+// createSite2CloudBulkConnection creates a connection and applies the
+// settings that are not part of add_site2cloud. A connection whose settings
+// fail is deleted again, so that it is created anew on the next apply; it
+// reports whether the connection is left on the Controller.
+func createSite2CloudBulkConnection(ctx context.Context, client goaviatrix.Site2CloudClient, settings site2CloudBulkSettings, s2c *goaviatrix.Site2Cloud) (bool, error) {
+	if err := client.CreateSite2CloudContext(ctx, s2c); err != nil {
+		return false, fmt.Errorf("failed Site2Cloud create: %w", err)
+	}
+	var err error
+	if !settings.deadPeerDetection {
+		if err = client.DisableDeadPeerDetectionContext(ctx, s2c); err != nil {
+			err = fmt.Errorf("failed to disable dead peer detection: %w", err)
+		}
+	}
+	if err == nil && settings.activeActive {
+		if err = client.EnableSite2cloudActiveActiveContext(ctx, s2c); err != nil {
+			err = fmt.Errorf("failed to enable active active HA: %w", err)
+		}
+	}
+	if err == nil {
+		return true, nil
+	}
+	// The connection is deleted again even if the timeout expired while its
+	// settings were applied.
+	if deleteErr := client.DeleteSite2CloudContext(context.WithoutCancel(ctx), s2c); deleteErr != nil {
+		return true, fmt.Errorf("%w; failed to delete the connection again: %w", err, deleteErr)
+	}
+	return false, err
+}
+
+// validateSite2CloudBulkGateway checks the shared settings against the
+// primary gateway, as aviatrix_site2cloud checks each connection.
+func validateSite2CloudBulkGateway(ctx context.Context, client goaviatrix.Site2CloudClient, settings site2CloudBulkSettings) error {
+	gw, err := client.GetGatewayContext(ctx, &goaviatrix.Gateway{GwName: settings.connection.GwName})
+	if err != nil {
+		if errors.Is(err, goaviatrix.ErrNotFound) {
+			return fmt.Errorf("couldn't find Aviatrix Gateway %s", settings.connection.GwName)
+		}
+		return fmt.Errorf("couldn't find Aviatrix Gateway %s: %w", settings.connection.GwName, err)
+	}
+	return validateSite2CloudGateway(gw, &settings.connection, settings.haEnabled, settings.activeActive)
+}
+
+// createSite2CloudBulkConnections creates the connections of conns in a
+// bounded worker pool, and returns the tunnel blocks that were created. A
+// connection that fails is reported as a warning, and is left out of the
+// result so that it is planned as an addition on the next apply.
+func createSite2CloudBulkConnections(ctx context.Context, client goaviatrix.Site2CloudClient, settings site2CloudBulkSettings, conns []any) ([]any, diag.Diagnostics) {
+	s2cs, err := marshalSite2CloudBulkConnections(settings, conns)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if len(s2cs) == 0 {
+		return nil, nil
+	}
+	if err := validateSite2CloudBulkGateway(ctx, client, settings); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	tflog.Info(ctx, fmt.Sprintf("Creating %d Aviatrix Site2Cloud connections on %s", len(s2cs), settings.connection.GwName))
+
+	created := make([]bool, len(s2cs))
+	errs := runConcurrently(ctx, settings.maxConcurrency, len(s2cs), func(i int) error {
+		var err error
+		created[i], err = createSite2CloudBulkConnection(ctx, client, settings, s2cs[i])
+		return err
+	})
+
+	var result []any
+	var diags diag.Diagnostics
+	for i, conn := range conns {
+		if created[i] {
+			result = append(result, conn)
+		}
+		if errs[i] != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Failed to create Site2Cloud connection %q", s2cs[i].TunnelName),
+				Detail:   errs[i].Error(),
+			})
+		}
+	}
+	return result, diags
+}
+
+// deleteSite2CloudBulkConnections deletes the connections of conns in a
+// bounded worker pool, and returns the tunnel blocks that are left.
+func deleteSite2CloudBulkConnections(ctx context.Context, client goaviatrix.Site2CloudClient, settings site2CloudBulkSettings, conns []any) ([]any, error) {
+	tflog.Info(ctx, fmt.Sprintf("Deleting %d Aviatrix Site2Cloud connections on %s", len(conns), settings.connection.GwName))
+
+	names := make([]string, len(conns))
+	for i, conn := range conns {
+		names[i] = mustString(mustMap(conn)["connection_name"])
+	}
+	errs := runConcurrently(ctx, settings.maxConcurrency, len(conns), func(i int) error {
+		s2c := &goaviatrix.Site2Cloud{
+			VpcID:      settings.connection.VpcID,
+			TunnelName: names[i],
+		}
+		if err := client.DeleteSite2CloudContext(ctx, s2c); err != nil && !errors.Is(err, goaviatrix.ErrNotFound) {
+			return fmt.Errorf("failed to delete connection %q: %w", s2c.TunnelName, err)
+		}
+		return nil
+	})
+
+	var remaining []any
+	for i, conn := range conns {
+		if errs[i] != nil {
+			remaining = append(remaining, conn)
+		}
+	}
+	return remaining, errors.Join(errs...)
+}
+
+func resourceAviatrixSite2CloudBulkCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := mustSite2CloudClient(meta)
+	settings := getSite2CloudBulkSettings(d)
+
+	conns := getSet(d, "tunnel").List()
+	if _, err := marshalSite2CloudBulkConnections(settings, conns); err != nil {
+		return diag.FromErr(err)
+	}
+
+	created, diags := createSite2CloudBulkConnections(ctx, client, settings, conns)
+	if diags.HasError() {
+		return diags
+	}
+	if len(created) == 0 && len(diags) > 0 {
+		return append(diags, diag.Errorf("failed to create Site2Cloud connections: none of %d was created", len(conns))...)
+	}
+	d.SetId(settings.connection.VpcID + "~" + settings.connection.GwName)
+	mustSet(d, "tunnel", created)
+
+	return append(diags, resourceAviatrixSite2CloudBulkRead(ctx, d, meta)...)
+}
+
+func resourceAviatrixSite2CloudBulkRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := mustSite2CloudClient(meta)
+
+	connections, err := client.ListSite2CloudContext(ctx)
+	if err != nil {
+		return diag.Errorf("failed to list Site2Cloud connections: %v", err)
+	}
+	vpcID := getString(d, "vpc_id")
+	exists := make(map[string]bool)
+	for _, s2c := range connections {
+		if s2c.VpcID == vpcID {
+			exists[s2c.TunnelName] = true
+		}
+	}
+
+	// The controller does not return pre-shared keys, so connections are
+	// kept as configured and only removed from state when deleted outside
+	// of Terraform.
+	var conns []any
+	for _, conn := range getSet(d, "tunnel").List() {
+		name := mustString(mustMap(conn)["connection_name"])
+		if !exists[name] {
+			tflog.Warn(ctx, fmt.Sprintf("Site2Cloud connection %s not found, removing it from state", name))
+			continue
+		}
+		conns = append(conns, conn)
+	}
+	if err := d.Set("tunnel", conns); err != nil {
+		return diag.Errorf("failed to set tunnel: %v", err)
+	}
+	return nil
+}
+
+func resourceAviatrixSite2CloudBulkUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := mustSite2CloudClient(meta)
+	settings := getSite2CloudBulkSettings(d)
+
+	var diags diag.Diagnostics
+	if d.HasChange("tunnel") {
+		o, n := d.GetChange("tunnel")
+		oldSet, newSet := mustSchemaSet(o), mustSchemaSet(n)
+		added := newSet.Difference(oldSet).List()
+		if _, err := marshalSite2CloudBulkConnections(settings, newSet.List()); err != nil {
+			return diag.FromErr(err)
+		}
+
+		// A changed connection is removed and added under the same name, so
+		// removed connections are deleted before any is created.
+		result := schema.NewSet(oldSet.F, oldSet.List())
+		removed := oldSet.Difference(newSet).List()
+		remaining, err := deleteSite2CloudBulkConnections(ctx, client, settings, removed)
+		for _, conn := range removed {
+			result.Remove(conn)
+		}
+		for _, conn := range remaining {
+			result.Add(conn)
+		}
+		if err != nil {
+			mustSet(d, "tunnel", result.List())
+			return diag.Errorf("failed to update Site2Cloud connections: %v", err)
+		}
+
+		var created []any
+		created, diags = createSite2CloudBulkConnections(ctx, client, settings, added)
+		for _, conn := range created {
+			result.Add(conn)
+		}
+		mustSet(d, "tunnel", result.List())
+	}
+
+	return append(diags, resourceAviatrixSite2CloudBulkRead(ctx, d, meta)...)
+}
+
+func resourceAviatrixSite2CloudBulkDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := mustSite2CloudClient(meta)
+
+	remaining, err := deleteSite2CloudBulkConnections(ctx, client, getSite2CloudBulkSettings(d), getSet(d, "tunnel").List())
+	if err != nil {
+		mustSet(d, "tunnel", remaining)
+		return diag.Errorf("failed to delete Site2Cloud connections: %v", err)
+	}
+	return nil
+}
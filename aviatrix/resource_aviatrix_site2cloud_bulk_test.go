@@ -0,0 +1,251 @@
+package aviatrix
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix/controllertest"
+)
+
+// testAccFakeSite2Cloud simulates the site2cloud actions used by
+// aviatrix_site2cloud_bulk, on spoke gateways. The action named in failing for a connection
+// fails for it.
+func testAccFakeSite2Cloud(s *controllertest.Server, failing map[string]string) map[string]controllertest.Params {
+	connections := make(map[string]controllertest.Params)
+	fail := func(action string, p controllertest.Params) error {
+		if failing[p.String("connection_name")] == action {
+			return fmt.Errorf("remote gateway %s is unreachable", p.String("remote_gateway_ip"))
+		}
+		return nil
+	}
+	s.HandleAction("add_site2cloud", func(p controllertest.Params) (any, error) {
+		name := p.String("connection_name")
+		if err := fail("add_site2cloud", p); err != nil {
+			return nil, err
+		}
+		if _, ok := connections[name]; ok {
+			return nil, fmt.Errorf("connection %s already exists", name)
+		}
+		connections[name] = p
+		return nil, nil
+	})
+	s.HandleAction("disable_dpd_config", func(p controllertest.Params) (any, error) {
+		return nil, fail("disable_dpd_config", connections[p.String("connection_name")])
+	})
+	s.HandleAction("delete_site2cloud_connection", func(p controllertest.Params) (any, error) {
+		name := p.String("connection_name")
+		if _, ok := connections[name]; !ok {
+			return nil, fmt.Errorf("connection %s does not exist", name)
+		}
+		delete(connections, name)
+		return nil, nil
+	})
+	s.HandleAction("list_vpcs_summary", func(p controllertest.Params) (any, error) {
+		return []map[string]any{{"vpc_name": p.String("gateway_name")}}, nil
+	})
+	s.HandleAction("list_site2cloud_conn", func(controllertest.Params) (any, error) {
+		list := []map[string]any{}
+		for name, p := range connections {
+			list = append(list, map[string]any{"name": name, "vpc_id": p.String("vpc_id")})
+		}
+		return map[string]any{"connections": list}, nil
+	})
+	return connections
+}
+
+func testSite2CloudBulkConfig(names ...string) *terraform.ResourceConfig {
+	tunnels := make([]any, 0, len(names))
+	for i, name := range names {
+		tunnels = append(tunnels, map[string]any{
+			"connection_name":    name,
+			"remote_gateway_ip":  fmt.Sprintf("198.51.100.%d", i+1),
+			"pre_shared_key":     "secret",
+			"remote_subnet_cidr": fmt.Sprintf("10.%d.0.0/16", i+1),
+		})
+	}
+	return terraform.NewResourceConfigRaw(map[string]any{
+		"vpc_id":                     "vpc-1",
+		"primary_cloud_gateway_name": "spoke-1",
+		"remote_gateway_type":        "generic",
+		"connection_type":            "unmapped",
+		"tunnel_type":                "policy",
+		"enable_dead_peer_detection": false,
+		"max_concurrency":            4,
+		"tunnel":                     tunnels,
+	})
+}
+
+func sortedKeys(m map[string]controllertest.Params) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestResourceAviatrixSite2CloudBulk(t *testing.T) {
+	s, meta := testFakeControllerMeta(t)
+	failing := map[string]string{"branch-2": "disable_dpd_config", "branch-3": "add_site2cloud"}
+	connections := testAccFakeSite2Cloud(s, failing)
+	r := resourceAviatrixSite2CloudBulk()
+	ctx := context.Background()
+
+	apply := func(state *terraform.InstanceState, config *terraform.ResourceConfig) (*terraform.InstanceState, diag.Diagnostics) {
+		diff, err := r.Diff(ctx, state, config, meta)
+		require.NoError(t, err)
+		return r.Apply(ctx, state, diff, meta)
+	}
+
+	// Failed connections are reported as warnings and left out of state, and
+	// one whose settings failed is deleted again.
+	config := testSite2CloudBulkConfig("branch-1", "branch-2", "branch-3")
+	state, diags := apply(nil, config)
+	require.False(t, diags.HasError(), "apply failed: %v", diags)
+	require.Len(t, diags, 2)
+	var summaries []string
+	for _, d := range diags {
+		assert.Equal(t, diag.Warning, d.Severity)
+		summaries = append(summaries, d.Summary+": "+d.Detail)
+	}
+	sort.Strings(summaries)
+	assert.Equal(t, []string{
+		`Failed to create Site2Cloud connection "branch-2": failed to disable dead peer detection: rest API disable_dpd_config Post failed: remote gateway 198.51.100.2 is unreachable`,
+		`Failed to create Site2Cloud connection "branch-3": failed Site2Cloud create: rest API add_site2cloud Post failed: remote gateway 198.51.100.3 is unreachable`,
+	}, summaries)
+	assert.Equal(t, []string{"branch-1"}, sortedKeys(connections))
+	assert.Equal(t, "vpc-1~spoke-1", state.ID)
+	assert.Equal(t, "1", state.Attributes["tunnel.#"])
+	assert.Equal(t, "AES-256-CBC", connections["branch-1"].String("phase1_encryption"))
+	assert.Equal(t, "no", connections["branch-1"].String("ha_enabled"))
+
+	// The failed connections are planned as additions and created by the
+	// next apply.
+	delete(failing, "branch-2")
+	delete(failing, "branch-3")
+	diff, err := r.Diff(ctx, state, config, meta)
+	require.NoError(t, err)
+	assert.False(t, diff.RequiresNew())
+	state, diags = r.Apply(ctx, state, diff, meta)
+	require.False(t, diags.HasError(), "apply failed: %v", diags)
+	assert.Empty(t, diags)
+	assert.Equal(t, []string{"branch-1", "branch-2", "branch-3"}, sortedKeys(connections))
+	assert.Equal(t, "3", state.Attributes["tunnel.#"])
+
+	// Replacing branch-2 deletes it before branch-4 is created with its
+	// remote gateway IP.
+	state, diags = apply(state, testSite2CloudBulkConfig("branch-1", "branch-4"))
+	require.False(t, diags.HasError(), "apply failed: %v", diags)
+	assert.Equal(t, []string{"branch-1", "branch-4"}, sortedKeys(connections))
+	assert.Equal(t, "198.51.100.2", connections["branch-4"].String("remote_gateway_ip"))
+	assert.Equal(t, "2", state.Attributes["tunnel.#"])
+
+	// A connection deleted outside of Terraform is removed from state.
+	delete(connections, "branch-4")
+	state, diags = r.RefreshWithoutUpgrade(ctx, state, meta)
+	require.False(t, diags.HasError())
+	assert.Equal(t, "1", state.Attributes["tunnel.#"])
+
+	_, diags = r.Apply(ctx, state, &terraform.InstanceDiff{Destroy: true}, meta)
+	require.False(t, diags.HasError())
+	assert.Empty(t, connections)
+}
+
+func TestResourceAviatrixSite2CloudBulk_allFailed(t *testing.T) {
+	s, meta := testFakeControllerMeta(t)
+	connections := testAccFakeSite2Cloud(s, map[string]string{"branch-1": "add_site2cloud"})
+	r := resourceAviatrixSite2CloudBulk()
+
+	diff, err := r.Diff(context.Background(), nil, testSite2CloudBulkConfig("branch-1"), meta)
+	require.NoError(t, err)
+	state, diags := r.Apply(context.Background(), nil, diff, meta)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "failed to create Site2Cloud connections: none of 1 was created", diags[len(diags)-1].Summary)
+	assert.Nil(t, state)
+	assert.Empty(t, connections)
+}
+
+func TestResourceAviatrixSite2CloudBulk_invalidTunnel(t *testing.T) {
+	s, meta := testFakeControllerMeta(t)
+	connections := testAccFakeSite2Cloud(s, nil)
+	r := resourceAviatrixSite2CloudBulk()
+
+	config := testSite2CloudBulkConfig("branch-1", "branch-2")
+	config.Config["ha_enabled"] = true
+	config.Config["backup_gateway_name"] = "spoke-1-hagw"
+	diff, err := r.Diff(context.Background(), nil, config, meta)
+	require.NoError(t, err)
+	_, diags := r.Apply(context.Background(), nil, diff, meta)
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "'backup_gateway_name' and 'backup_remote_gateway_ip' are required when HA is enabled")
+	assert.Empty(t, connections, "no connection should be created when any is invalid")
+}
+
+func TestResourceAviatrixSite2CloudBulkCreate_transitActiveActive(t *testing.T) {
+	client := &goaviatrix.Site2CloudClientMock{
+		GetGatewayContextFunc: func(ctx context.Context, gateway *goaviatrix.Gateway) (*goaviatrix.Gateway, error) {
+			return &goaviatrix.Gateway{GwName: gateway.GwName, TransitVpc: "yes"}, nil
+		},
+	}
+
+	config := testSite2CloudBulkConfig("branch-1", "branch-2")
+	config.Config["ha_enabled"] = true
+	config.Config["backup_gateway_name"] = "spoke-1-hagw"
+	for _, tunnel := range config.Config["tunnel"].([]any) {
+		tunnel.(map[string]any)["backup_remote_gateway_ip"] = "203.0.113.1"
+	}
+	d := schema.TestResourceDataRaw(t, resourceAviatrixSite2CloudBulk().Schema, config.Config)
+	diags := resourceAviatrixSite2CloudBulkCreate(context.Background(), d, client)
+
+	require.True(t, diags.HasError())
+	assert.Equal(t, "active_active_ha must be enabled if HA is enabled for transit gateway unmapped policy based site2cloud connection", diags[0].Summary)
+	assert.Len(t, client.GetGatewayContextCalls(), 1, "the gateway is looked up once for all connections")
+	assert.Empty(t, client.CreateSite2CloudContextCalls())
+}
+
+func TestResourceAviatrixSite2CloudBulkCreate_contextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var client *goaviatrix.Site2CloudClientMock
+	client = &goaviatrix.Site2CloudClientMock{
+		GetGatewayContextFunc: func(ctx context.Context, gateway *goaviatrix.Gateway) (*goaviatrix.Gateway, error) {
+			return &goaviatrix.Gateway{GwName: gateway.GwName}, nil
+		},
+		CreateSite2CloudContextFunc: func(ctx context.Context, site2cloud *goaviatrix.Site2Cloud) error {
+			// The timeout expires while the first connection is created.
+			cancel()
+			return nil
+		},
+		DisableDeadPeerDetectionContextFunc: func(ctx context.Context, site2cloud *goaviatrix.Site2Cloud) error {
+			return nil
+		},
+		ListSite2CloudContextFunc: func(ctx context.Context) ([]goaviatrix.Site2Cloud, error) {
+			var connections []goaviatrix.Site2Cloud
+			for _, call := range client.CreateSite2CloudContextCalls() {
+				connections = append(connections, *call.Site2cloud)
+			}
+			return connections, nil
+		},
+	}
+
+	config := testSite2CloudBulkConfig("branch-1", "branch-2", "branch-3")
+	config.Config["max_concurrency"] = 1
+	d := schema.TestResourceDataRaw(t, resourceAviatrixSite2CloudBulk().Schema, config.Config)
+	diags := resourceAviatrixSite2CloudBulkCreate(ctx, d, client)
+
+	require.False(t, diags.HasError(), "create failed: %v", diags)
+	require.Len(t, diags, 2)
+	for _, d := range diags {
+		assert.Equal(t, context.Canceled.Error(), d.Detail)
+	}
+	assert.Len(t, client.CreateSite2CloudContextCalls(), 1, "queued connections are skipped")
+	assert.Equal(t, 1, d.Get("tunnel.#"))
+}
@@ -3,8 +3,9 @@ package aviatrix
 import (
 	"context"
 	"errors"
-	"log"
+	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -73,7 +74,7 @@ func resourceAviatrixSite2CloudCaCertTag() *schema.Resource {
 }
 
 func resourceAviatrixSite2CloudCaCertTagCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustSite2CloudClient(meta)
 
 	for _, v := range getSet(d, "ca_certificates").List() {
 		certInstance := mustMap(v)
@@ -92,13 +93,13 @@ func resourceAviatrixSite2CloudCaCertTagCreate(ctx context.Context, d *schema.Re
 }
 
 func resourceAviatrixSite2CloudCaCertTagRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustSite2CloudClient(meta)
 
 	tagName := getString(d, "tag_name")
 
 	if tagName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import. Import Id is %s", id))
 		mustSet(d, "tag_name", id)
 		d.SetId(id)
 	}
@@ -130,7 +131,7 @@ func resourceAviatrixSite2CloudCaCertTagRead(ctx context.Context, d *schema.Reso
 		caCertInstances = append(caCertInstances, instanceInfo)
 	}
 	if err := d.Set("ca_certificates", caCertInstances); err != nil {
-		log.Printf("[WARN] Error setting 'ca_certificates' for (%s): %s", d.Id(), err)
+		tflog.Warn(ctx, fmt.Sprintf("Error setting 'ca_certificates' for (%s): %s", d.Id(), err))
 	}
 
 	d.SetId(s2cCaCertTagResp.TagName)
@@ -138,7 +139,7 @@ func resourceAviatrixSite2CloudCaCertTagRead(ctx context.Context, d *schema.Reso
 }
 
 func resourceAviatrixSite2CloudCaCertTagUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustSite2CloudClient(meta)
 	d.Partial(true)
 
 	if d.HasChange("ca_certificates") {
@@ -183,7 +184,7 @@ func resourceAviatrixSite2CloudCaCertTagUpdate(ctx context.Context, d *schema.Re
 }
 
 func resourceAviatrixSite2CloudCaCertTagDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustSite2CloudClient(meta)
 
 	for _, cert := range getSet(d, "ca_certificates").List() {
 		certInstance := mustMap(cert)
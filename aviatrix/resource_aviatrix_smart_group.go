@@ -173,40 +173,46 @@ func marshalSmartGroupInput(d *schema.ResourceData) (*goaviatrix.SmartGroup, err
 		if selectorInterface == nil {
 			return nil, fmt.Errorf("match expressions block cannot be empty")
 		}
-		selectorInfo := mustMap(selectorInterface)
-		filter := goaviatrix.NewSmartGroupMatchExpression(selectorInfo)
-		if goaviatrix.MapContains(selectorInfo, goaviatrix.ExternalKey) {
-			// build a map out of the external arguments
-			if extArgsMap, ok := selectorInfo[goaviatrix.ExtArgsPrefix]; ok {
-				extArgs := make(map[string]string)
-				for key, value := range mustMap(extArgsMap) {
-					extArgs[key] = mustString(value)
-				}
-				filter.ExtArgs = extArgs
+		filter := expandSmartGroupMatchExpression(mustMap(selectorInterface))
+		smartGroup.Selector.Expressions = append(smartGroup.Selector.Expressions, filter)
+	}
+
+	return smartGroup, nil
+}
+
+// expandSmartGroupMatchExpression converts a match_expressions block to the
+// match expression sent to the Controller.
+func expandSmartGroupMatchExpression(selectorInfo map[string]any) *goaviatrix.SmartGroupMatchExpression {
+	filter := goaviatrix.NewSmartGroupMatchExpression(selectorInfo)
+	if goaviatrix.MapContains(selectorInfo, goaviatrix.ExternalKey) {
+		// build a map out of the external arguments
+		if extArgsMap, ok := selectorInfo[goaviatrix.ExtArgsPrefix]; ok {
+			extArgs := make(map[string]string)
+			for key, value := range mustMap(extArgsMap) {
+				extArgs[key] = mustString(value)
 			}
+			filter.ExtArgs = extArgs
 		}
-		if tagsMap, ok := selectorInfo[goaviatrix.TagsPrefix]; ok {
-			tags := make(map[string]string)
-			for key, value := range mustMap(tagsMap) {
-				tags[key] = mustString(value)
-			}
-			filter.Tags = tags
+	}
+	if tagsMap, ok := selectorInfo[goaviatrix.TagsPrefix]; ok {
+		tags := make(map[string]string)
+		for key, value := range mustMap(tagsMap) {
+			tags[key] = mustString(value)
 		}
-		if nsTagsMap, ok := selectorInfo[goaviatrix.NamespaceTagsPrefix]; ok {
-			nsTags := make(map[string]string)
-			for key, value := range mustMap(nsTagsMap) {
-				nsTags[key] = mustString(value)
-			}
-			filter.NamespaceTags = nsTags
+		filter.Tags = tags
+	}
+	if nsTagsMap, ok := selectorInfo[goaviatrix.NamespaceTagsPrefix]; ok {
+		nsTags := make(map[string]string)
+		for key, value := range mustMap(nsTagsMap) {
+			nsTags[key] = mustString(value)
 		}
-		smartGroup.Selector.Expressions = append(smartGroup.Selector.Expressions, filter)
+		filter.NamespaceTags = nsTags
 	}
-
-	return smartGroup, nil
+	return filter
 }
 
 func resourceAviatrixSmartGroupCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	smartGroup, err := marshalSmartGroupInput(d)
 	if err != nil {
@@ -233,7 +239,7 @@ func resourceAviatrixSmartGroupReadIfRequired(ctx context.Context, d *schema.Res
 }
 
 func resourceAviatrixSmartGroupRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	uuid := d.Id()
 	mustSet(d, "uuid", uuid)
@@ -268,7 +274,7 @@ func resourceAviatrixSmartGroupRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAviatrixSmartGroupUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	uuid := d.Id()
 	d.Partial(true)
@@ -289,7 +295,7 @@ func resourceAviatrixSmartGroupUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceAviatrixSmartGroupDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	uuid := d.Id()
 	err := client.DeleteSmartGroup(ctx, uuid)
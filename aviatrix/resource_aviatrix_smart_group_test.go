@@ -51,6 +51,32 @@ func TestAccAviatrixSmartGroup_basic(t *testing.T) {
 	})
 }
 
+func TestAccAviatrixSmartGroup_fakeController(t *testing.T) {
+	testAccFakeController(t)
+	resourceName := "aviatrix_smart_group.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProvidersVersionValidation,
+		CheckDestroy: testAccSmartGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSmartGroupBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSmartGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "test-smart-group"),
+					resource.TestCheckResourceAttr(resourceName, "selector.0.match_expressions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "selector.0.match_expressions.1.tags.k3", "v3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccSmartGroupBasic() string {
 	return `
 resource "aviatrix_smart_group" "test" {
@@ -0,0 +1,43 @@
+package aviatrix
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
+)
+
+func TestResourceAviatrixSmartGroupRead_RemovesDeletedGroup(t *testing.T) {
+	client := &goaviatrix.DCFClientMock{
+		GetSmartGroupFunc: func(ctx context.Context, uuid string) (*goaviatrix.SmartGroup, error) {
+			assert.Equal(t, "sg-uuid", uuid)
+			return nil, goaviatrix.ErrNotFound
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceAviatrixSmartGroup().Schema, map[string]any{})
+	d.SetId("sg-uuid")
+	res := resourceAviatrixSmartGroupRead(context.TODO(), d, client)
+
+	assert.Empty(t, res)
+	assert.Empty(t, d.Id())
+}
+
+func TestResourceAviatrixSmartGroupDelete_CallsDeleteSmartGroup(t *testing.T) {
+	client := &goaviatrix.DCFClientMock{
+		DeleteSmartGroupFunc: func(ctx context.Context, uuid string) error {
+			assert.Equal(t, "sg-uuid", uuid)
+			return nil
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceAviatrixSmartGroup().Schema, map[string]any{})
+	d.SetId("sg-uuid")
+	res := resourceAviatrixSmartGroupDelete(context.TODO(), d, client)
+
+	assert.Empty(t, res)
+	assert.Len(t, client.DeleteSmartGroupCalls(), 1)
+}
@@ -1,13 +1,14 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"net"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,10 +18,10 @@ import (
 
 func resourceAviatrixSpokeExternalDeviceConn() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixSpokeExternalDeviceConnCreate,
-		Read:   resourceAviatrixSpokeExternalDeviceConnRead,
-		Update: resourceAviatrixSpokeExternalDeviceConnUpdate,
-		Delete: resourceAviatrixSpokeExternalDeviceConnDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixSpokeExternalDeviceConnCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixSpokeExternalDeviceConnRead),
+		UpdateWithoutTimeout: withContext(resourceAviatrixSpokeExternalDeviceConnUpdate),
+		DeleteWithoutTimeout: withContext(resourceAviatrixSpokeExternalDeviceConnDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -102,14 +103,14 @@ func resourceAviatrixSpokeExternalDeviceConn() *schema.Resource {
 				Optional:     true,
 				ForceNew:     true,
 				Description:  "BGP local ASN (Autonomous System Number). Integer between 1-4294967294.",
-				ValidateFunc: goaviatrix.ValidateASN,
+				ValidateFunc: ValidateASN,
 			},
 			"bgp_remote_as_num": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
 				Description:  "BGP remote ASN (Autonomous System Number). Integer between 1-4294967294.",
-				ValidateFunc: goaviatrix.ValidateASN,
+				ValidateFunc: ValidateASN,
 			},
 			"remote_subnet": {
 				Type:        schema.TypeString,
@@ -246,7 +247,7 @@ func resourceAviatrixSpokeExternalDeviceConn() *schema.Resource {
 				Default:      "",
 				ForceNew:     true,
 				Description:  "Backup BGP remote ASN (Autonomous System Number). Integer between 1-4294967294.",
-				ValidateFunc: goaviatrix.ValidateASN,
+				ValidateFunc: ValidateASN,
 			},
 			"backup_pre_shared_key": {
 				Type:        schema.TypeString,
@@ -352,9 +353,9 @@ func resourceAviatrixSpokeExternalDeviceConn() *schema.Resource {
 				Optional: true,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: goaviatrix.StringCanBeEmptyButCannotBeWhiteSpace,
+					ValidateFunc: StringCanBeEmptyButCannotBeWhiteSpace,
 				},
-				DiffSuppressFunc: goaviatrix.TransitExternalDeviceConnPh1RemoteIdDiffSuppressFunc,
+				DiffSuppressFunc: TransitExternalDeviceConnPh1RemoteIdDiffSuppressFunc,
 				Description:      "List of phase 1 remote identifier of the IPsec tunnel. This can be configured as a list of any string, including empty string.",
 			},
 			"prepend_as_path": {
@@ -363,7 +364,7 @@ func resourceAviatrixSpokeExternalDeviceConn() *schema.Resource {
 				Description: "Connection AS Path Prepend customized by specifying AS PATH for a BGP connection.",
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: goaviatrix.ValidateASN,
+					ValidateFunc: ValidateASN,
 				},
 				MaxItems: 25,
 			},
@@ -411,7 +412,7 @@ func resourceAviatrixSpokeExternalDeviceConn() *schema.Resource {
 				Optional:         true,
 				Description:      "BGP BFD configuration details applied to a BGP session.",
 				MaxItems:         1,
-				DiffSuppressFunc: goaviatrix.ExternalDeviceConnBgpBfdDiffSuppressFunc,
+				DiffSuppressFunc: ExternalDeviceConnBgpBfdDiffSuppressFunc,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"transmit_interval": {
@@ -500,7 +501,7 @@ func resourceAviatrixSpokeExternalDeviceConn() *schema.Resource {
 	}
 }
 
-func resourceAviatrixSpokeExternalDeviceConnCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixSpokeExternalDeviceConnCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	var bgpSendCommunities *goaviatrix.BgpSendCommunities
@@ -859,7 +860,7 @@ func resourceAviatrixSpokeExternalDeviceConnCreate(d *schema.ResourceData, meta
 	// before the create can leave a phantom state entry when the create fails.
 	d.SetId(externalDeviceConn.ConnectionName + "~" + externalDeviceConn.VpcID)
 	flag := false
-	defer func() { _ = resourceAviatrixSpokeExternalDeviceConnReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixSpokeExternalDeviceConnReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	if setPerConnCommunity {
 		err = client.ConnectionBGPSendCommunities(bgpSendCommunities)
@@ -980,24 +981,24 @@ func resourceAviatrixSpokeExternalDeviceConnCreate(d *schema.ResourceData, meta
 		}
 	}
 
-	return resourceAviatrixSpokeExternalDeviceConnReadIfRequired(d, meta, &flag)
+	return resourceAviatrixSpokeExternalDeviceConnReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixSpokeExternalDeviceConnReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixSpokeExternalDeviceConnReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixSpokeExternalDeviceConnRead(d, meta)
+		return resourceAviatrixSpokeExternalDeviceConnRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixSpokeExternalDeviceConnRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixSpokeExternalDeviceConnRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	connectionName := getString(d, "connection_name")
 	vpcID := getString(d, "vpc_id")
 	if connectionName == "" || vpcID == "" {
-		if err := handleExternalDeviceConnImport(d, client); err != nil {
+		if err := handleExternalDeviceConnImport(ctx, d, client); err != nil {
 			return err
 		}
 		// handleExternalDeviceConnImport clears the ID when the connection no
@@ -1026,7 +1027,7 @@ func resourceAviatrixSpokeExternalDeviceConnRead(d *schema.ResourceData, meta an
 	}
 
 	conn, err := client.GetExternalDeviceConnDetail(externalDeviceConn, localGateway, getBool(d, "ha_enabled"))
-	log.Printf("[TRACE] Reading Aviatrix external device conn: %s : %#v", getString(d, "connection_name"), externalDeviceConn)
+	tflog.Trace(ctx, fmt.Sprintf("Reading Aviatrix external device conn: %s : %#v", getString(d, "connection_name"), goaviatrix.Redact(externalDeviceConn)))
 
 	if err != nil {
 		if errors.Is(err, goaviatrix.ErrNotFound) {
@@ -1227,7 +1228,7 @@ func resourceAviatrixSpokeExternalDeviceConnRead(d *schema.ResourceData, meta an
 	return nil
 }
 
-func resourceAviatrixSpokeExternalDeviceConnUpdate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixSpokeExternalDeviceConnUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 	d.Partial(true)
 
@@ -1571,10 +1572,10 @@ func resourceAviatrixSpokeExternalDeviceConnUpdate(d *schema.ResourceData, meta
 
 	d.Partial(false)
 
-	return resourceAviatrixSpokeExternalDeviceConnRead(d, meta)
+	return resourceAviatrixSpokeExternalDeviceConnRead(ctx, d, meta)
 }
 
-func resourceAviatrixSpokeExternalDeviceConnDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixSpokeExternalDeviceConnDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	externalDeviceConn := &goaviatrix.ExternalDeviceConn{
@@ -1582,7 +1583,7 @@ func resourceAviatrixSpokeExternalDeviceConnDelete(d *schema.ResourceData, meta
 		ConnectionName: getString(d, "connection_name"),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix external device connection: %#v", externalDeviceConn)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix external device connection: %#v", goaviatrix.Redact(externalDeviceConn)))
 
 	err := client.DeleteExternalDeviceConn(externalDeviceConn)
 	if err != nil {
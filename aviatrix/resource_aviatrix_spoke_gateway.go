@@ -4,12 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -19,17 +20,23 @@ const subnetSeparator = "~~"
 
 func resourceAviatrixSpokeGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixSpokeGatewayCreate,
-		Read:   resourceAviatrixSpokeGatewayRead,
-		Update: resourceAviatrixSpokeGatewayUpdate,
-		Delete: resourceAviatrixSpokeGatewayDelete,
+		CreateContext:      withContext(resourceAviatrixSpokeGatewayCreate),
+		ReadWithoutTimeout: withContext(resourceAviatrixSpokeGatewayRead),
+		UpdateContext:      withContext(resourceAviatrixSpokeGatewayUpdate),
+		DeleteContext:      withContext(resourceAviatrixSpokeGatewayDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
 
+		Timeouts: gatewayTimeouts(),
+
 		// CustomizeDiff handles custom diff logic during plan operations:
 		// - Forces resource recreation when IPv6 subnet fields change (if previously set and enable_ipv6 is true)
-		CustomizeDiff: resourceAviatrixSpokeGatewayCustomizeDiff,
+		CustomizeDiff: customdiff.All(
+			resourceAviatrixSpokeGatewayCustomizeDiff,
+			customizeDiffGatewayCapabilities(spokeGatewayPlanChecks),
+			customizeDiffTagsAll(gatewayTagCloudTypes),
+		),
 
 		SchemaVersion: 2,
 		StateUpgraders: []schema.StateUpgrader{
@@ -303,6 +310,7 @@ func resourceAviatrixSpokeGateway() *schema.Resource {
 				Optional:    true,
 				Description: "A map of tags to assign to the spoke gateway.",
 			},
+			"tags_all": tagsAllSchema(),
 			"enable_private_vpc_default_route": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -388,7 +396,7 @@ func resourceAviatrixSpokeGateway() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				Description:  "Changes the Aviatrix BGP Spoke Gateway ASN number before you setup Aviatrix BGP Spoke Gateway connection configurations.",
-				ValidateFunc: goaviatrix.ValidateASN,
+				ValidateFunc: ValidateASN,
 			},
 			"prepend_as_path": {
 				Type:         schema.TypeList,
@@ -397,7 +405,7 @@ func resourceAviatrixSpokeGateway() *schema.Resource {
 				Description:  "List of AS numbers to populate BGP AP_PATH field when it advertises to VGW or peer devices. Only valid for BGP Spoke Gateway",
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: goaviatrix.ValidateASN,
+					ValidateFunc: ValidateASN,
 				},
 			},
 			"bgp_polling_time": {
@@ -790,8 +798,8 @@ func resourceAviatrixSpokeGatewayCustomizeDiff(_ context.Context, d *schema.Reso
 	return nil
 }
 
-func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixSpokeGatewayCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustGatewayClient(meta)
 
 	gateway := &goaviatrix.SpokeVpc{
 		CloudType:              getInt(d, "cloud_type"),
@@ -966,8 +974,8 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta any) error
 
 	enableEncryptVolume := getBool(d, "enable_encrypt_volume")
 	customerManagedKeys := getString(d, "customer_managed_keys")
-	if enableEncryptVolume && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-		return fmt.Errorf("'enable_encrypt_volume' is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
+	if enableEncryptVolume && !featureEncryptVolume.supports(gateway.CloudType) {
+		return featureEncryptVolume.unsupportedError()
 	}
 	if customerManagedKeys != "" {
 		if !enableEncryptVolume {
@@ -985,8 +993,8 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta any) error
 		excludedInstances = append(excludedInstances, mustString(v))
 	}
 	// Enable monitor gateway subnets does not work with AWSChina
-	if enableMonitorSubnets && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes^goaviatrix.AWSChina) {
-		return fmt.Errorf("'enable_monitor_gateway_subnets' is only valid for AWS (1), AWSGov (256), AWS Top Secret (16384) or AWS Secret (32768)")
+	if enableMonitorSubnets && !featureMonitorGatewaySubnets.supports(gateway.CloudType) {
+		return featureMonitorGatewaySubnets.unsupportedError()
 	}
 	if !enableMonitorSubnets && len(excludedInstances) != 0 {
 		return fmt.Errorf("'monitor_exclude_list' must be empty if 'enable_monitor_gateway_subnets' is false")
@@ -996,8 +1004,8 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta any) error
 	if bgpOverLan && !enableBgp {
 		return fmt.Errorf("'enable_bgp' is required to be true to enable bgp over lan")
 	}
-	if bgpOverLan && !(goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes)) {
-		return fmt.Errorf("'enable_bgp_over_lan' is only valid for Azure (8), AzureGov (32) or AzureChina (2048)")
+	if bgpOverLan && !featureSpokeBgpOverLan.supports(gateway.CloudType) {
+		return featureSpokeBgpOverLan.unsupportedError()
 	}
 	bgpLanInterfacesCount, isCountSet := d.GetOk("bgp_lan_interfaces_count")
 	if isCountSet && (!bgpOverLan || !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes)) {
@@ -1017,8 +1025,8 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta any) error
 	haOobAvailabilityZone := getString(d, "ha_oob_availability_zone")
 
 	if enablePrivateOob {
-		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-			return fmt.Errorf("'enable_private_oob' is only valid for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768)")
+		if !featurePrivateOob.supports(gateway.CloudType) {
+			return featurePrivateOob.unsupportedError()
 		}
 
 		if oobAvailabilityZone == "" {
@@ -1069,12 +1077,13 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta any) error
 	}
 
 	_, tagsOk := d.GetOk("tags")
-	if tagsOk {
+	defaultTags := defaultTagsFor(client.GetDefaultTagsConfig(), gateway.CloudType, gatewayTagCloudTypes)
+	if tagsOk || defaultTags != nil {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
 			return errors.New("failed to create spoke gateway: adding tags is only supported for AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), AWS Top Secret (16384) or AWS Secret (32768)")
 		}
 
-		tagsMap, err := extractTags(d, gateway.CloudType)
+		tagsMap, err := extractTags(d, gateway.CloudType, defaultTags)
 		if err != nil {
 			return fmt.Errorf("error creating tags for spoke gateway: %w", err)
 		}
@@ -1101,8 +1110,8 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta any) error
 	spotPrice := getString(d, "spot_price")
 	deleteSpot := getBool(d, "delete_spot")
 	if enableSpotInstance {
-		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("enable_spot_instance only supports AWS and Azure related cloud types")
+		if !featureSpotInstance.supports(gateway.CloudType) {
+			return featureSpotInstance.unsupportedError()
 		}
 
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && deleteSpot {
@@ -1117,8 +1126,8 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta any) error
 	}
 
 	rxQueueSize := getString(d, "rx_queue_size")
-	if rxQueueSize != "" && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-		return fmt.Errorf("rx_queue_size only supports AWS related cloud types")
+	if rxQueueSize != "" && !featureRxQueueSize.supports(gateway.CloudType) {
+		return featureRxQueueSize.unsupportedError()
 	}
 
 	if !enablePrivateOob {
@@ -1198,13 +1207,13 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta any) error
 		}
 	}
 
-	log.Printf("[INFO] Creating Aviatrix Spoke Gateway: %#v", gateway)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix Spoke Gateway: %#v", goaviatrix.Redact(gateway)))
 
 	d.SetId(gateway.GwName)
 	flag := false
-	defer func() { _ = resourceAviatrixSpokeGatewayReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixSpokeGatewayReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
-	err := client.LaunchSpokeVpc(gateway)
+	err := client.LaunchSpokeVpcContext(ctx, gateway)
 	if err != nil {
 		return fmt.Errorf("failed to create Aviatrix Spoke Gateway: %w", err)
 	}
@@ -1215,7 +1224,7 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta any) error
 			SingleAZ: "no",
 		}
 
-		log.Printf("[INFO] Disable Single AZ GW HA: %#v", singleAZGateway)
+		tflog.Info(ctx, fmt.Sprintf("Disable Single AZ GW HA: %#v", goaviatrix.Redact(singleAZGateway)))
 
 		err := client.DisableSingleAZGateway(singleAZGateway)
 		if err != nil {
@@ -1259,7 +1268,7 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta any) error
 	if haSubnet != "" || haZone != "" {
 		spokeHaGw := &goaviatrix.SpokeHaGateway{
 			PrimaryGwName: getString(d, "gw_name"),
-			GwName:        getString(d, "gw_name") + "-hagw",
+			GwName:        goaviatrix.HaGatewayName(getString(d, "gw_name")),
 			Subnet:        haSubnet,
 			Zone:          haZone,
 			Eip:           getString(d, "ha_eip"),
@@ -1322,12 +1331,12 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta any) error
 			spokeHaGw.Subnet = haSubnetTrimmed + subnetSeparator + haSubnetIPv6Cidr
 		}
 
-		_, err := client.CreateSpokeHaGw(spokeHaGw)
+		_, err := client.CreateSpokeHaGwContext(ctx, spokeHaGw)
 		if err != nil {
 			return fmt.Errorf("failed to enable HA Aviatrix Spoke Gateway: %w", err)
 		}
 
-		log.Printf("[INFO]Resizing Spoke HA Gateway: %#v", haGwSize)
+		tflog.Info(ctx, fmt.Sprintf("Resizing Spoke HA Gateway: %#v", haGwSize))
 
 		if haGwSize != gateway.VpcSize {
 			if haGwSize == "" {
@@ -1337,13 +1346,13 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta any) error
 
 			haGateway := &goaviatrix.Gateway{
 				CloudType: getInt(d, "cloud_type"),
-				GwName:    getString(d, "gw_name") + "-hagw",
+				GwName:    goaviatrix.HaGatewayName(getString(d, "gw_name")),
 				VpcSize:   getString(d, "ha_gw_size"),
 			}
 
-			log.Printf("[INFO] Resizing Spoke HA Gateway size to: %s ", haGateway.VpcSize)
+			tflog.Info(ctx, fmt.Sprintf("Resizing Spoke HA Gateway size to: %s", haGateway.VpcSize))
 
-			err := client.UpdateGateway(haGateway)
+			err := client.UpdateGatewayContext(ctx, haGateway)
 			if err != nil {
 				return fmt.Errorf("failed to update Aviatrix Spoke HA Gateway size: %w", err)
 			}
@@ -1357,7 +1366,7 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta any) error
 			GwName: getString(d, "gw_name"),
 		}
 
-		log.Printf("[INFO] Enable VPC DNS Server: %#v", gwVpcDnsServer)
+		tflog.Info(ctx, fmt.Sprintf("Enable VPC DNS Server: %#v", goaviatrix.Redact(gwVpcDnsServer)))
 
 		err := client.EnableVpcDNSServer(gwVpcDnsServer)
 		if err != nil {
@@ -1373,7 +1382,7 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta any) error
 			CustomizedSpokeVpcRoutes: strings.Split(customizedSpokeVpcRoutes, ","),
 		}
 		for i := 0; ; i++ {
-			log.Printf("[INFO] Editing customized routes of spoke gateway: %s ", transitGateway.GwName)
+			tflog.Info(ctx, fmt.Sprintf("Editing customized routes of spoke gateway: %s", transitGateway.GwName))
 			err := client.EditGatewayCustomRoutes(transitGateway)
 			if err == nil {
 				break
@@ -1393,7 +1402,7 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta any) error
 			FilteredSpokeVpcRoutes: strings.Split(filteredSpokeVpcRoutes, ","),
 		}
 		for i := 0; ; i++ {
-			log.Printf("[INFO] Editing filtered routes of spoke gateway: %s ", transitGateway.GwName)
+			tflog.Info(ctx, fmt.Sprintf("Editing filtered routes of spoke gateway: %s", transitGateway.GwName))
 			err := client.EditGatewayFilterRoutes(transitGateway)
 			if err == nil {
 				break
@@ -1413,7 +1422,7 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta any) error
 			AdvertisedSpokeRoutes: strings.Split(includedAdvertisedSpokeRoutes, ","),
 		}
 		for i := 0; ; i++ {
-			log.Printf("[INFO] Editing customized routes advertisement of spoke gateway: %s ", transitGateway.GwName)
+			tflog.Info(ctx, fmt.Sprintf("Editing customized routes advertisement of spoke gateway: %s", transitGateway.GwName))
 			err := client.EditGatewayAdvertisedCidr(transitGateway)
 			if err == nil {
 				break
@@ -1613,7 +1622,7 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta any) error
 		}
 		if haSubnet != "" || haZone != "" {
 			haGwRxQueueSize := &goaviatrix.Gateway{
-				GwName:      getString(d, "gw_name") + "-hagw",
+				GwName:      goaviatrix.HaGatewayName(getString(d, "gw_name")),
 				RxQueueSize: rxQueueSize,
 			}
 			err := client.SetRxQueueSize(haGwRxQueueSize)
@@ -1644,27 +1653,27 @@ func resourceAviatrixSpokeGatewayCreate(d *schema.ResourceData, meta any) error
 		}
 	}
 
-	return resourceAviatrixSpokeGatewayReadIfRequired(d, meta, &flag)
+	return resourceAviatrixSpokeGatewayReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixSpokeGatewayReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixSpokeGatewayReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixSpokeGatewayRead(d, meta)
+		return resourceAviatrixSpokeGatewayRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
-	ignoreTagsConfig := client.IgnoreTagsConfig
+func resourceAviatrixSpokeGatewayRead(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustGatewayClient(meta)
+	ignoreTagsConfig := client.GetIgnoreTagsConfig()
 
 	var isImport bool
 	gwName := getString(d, "gw_name")
 	if gwName == "" {
 		isImport = true
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no gateway name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no gateway name received. Import Id is %s", id))
 		mustSet(d, "gw_name", id)
 		mustSet(d, "manage_ha_gateway", true)
 		d.SetId(id)
@@ -1684,7 +1693,7 @@ func resourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta any) error {
 		return fmt.Errorf("couldn't find Aviatrix Spoke Gateway: %w", err)
 	}
 
-	log.Printf("[TRACE] reading spoke gateway %s: %#v", getString(d, "gw_name"), gw)
+	tflog.Trace(ctx, fmt.Sprintf("reading spoke gateway %s: %#v", getString(d, "gw_name"), goaviatrix.Redact(gw)))
 	mustSet(d, "group_name", gw.GroupName)
 	mustSet(d, "cloud_type", gw.CloudType)
 	mustSet(d, "account_name", gw.AccountName)
@@ -1730,11 +1739,11 @@ func resourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta any) error {
 			return fmt.Errorf("could not get BGP LAN IP info for Azure spoke gateway %s: %w", gateway.GwName, err)
 		}
 		if err = d.Set("bgp_lan_ip_list", bgpLanIpInfo.AzureBgpLanIpList); err != nil {
-			log.Printf("[WARN] could not set bgp_lan_ip_list into state: %s", err)
+			tflog.Warn(ctx, fmt.Sprintf("could not set bgp_lan_ip_list into state: %s", err))
 		}
 		if len(bgpLanIpInfo.AzureHaBgpLanIpList) != 0 {
 			if err = d.Set("ha_bgp_lan_ip_list", bgpLanIpInfo.AzureHaBgpLanIpList); err != nil {
-				log.Printf("[WARN] could not set ha_bgp_lan_ip_list into state: %s", err)
+				tflog.Warn(ctx, fmt.Sprintf("could not set ha_bgp_lan_ip_list into state: %s", err))
 			}
 		} else {
 			mustSet(d, "ha_bgp_lan_ip_list", nil)
@@ -1804,7 +1813,7 @@ func resourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta any) error {
 		if len(azureEip) == 3 {
 			mustSet(d, "azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 		} else {
-			log.Printf("[WARN] could not get Azure EIP name and resource group for the Spoke Gateway %s", gw.GwName)
+			tflog.Warn(ctx, fmt.Sprintf("could not get Azure EIP name and resource group for the Spoke Gateway %s", gw.GwName))
 		}
 	}
 
@@ -1907,8 +1916,8 @@ func resourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta any) error {
 
 	if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
 		tags := goaviatrix.KeyValueTags(gw.Tags).IgnoreConfig(ignoreTagsConfig)
-		if err := d.Set("tags", tags); err != nil {
-			log.Printf("[WARN] Error setting tags for (%s): %s", d.Id(), err)
+		if err := setTagsAll(d, tags, client.GetDefaultTagsConfig()); err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Error setting tags for (%s): %s", d.Id(), err))
 		}
 	}
 
@@ -1987,7 +1996,7 @@ func resourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta any) error {
 			return nil
 		}
 
-		log.Printf("[INFO] Spoke HA Gateway size: %s", gw.HaGw.GwSize)
+		tflog.Info(ctx, fmt.Sprintf("Spoke HA Gateway size: %s", gw.HaGw.GwSize))
 		if goaviatrix.IsCloudType(gw.HaGw.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes|goaviatrix.AliCloudRelatedCloudTypes) {
 			mustSet(d, "ha_subnet", gw.HaGw.VpcNet)
 			if zone := d.Get("ha_zone"); goaviatrix.IsCloudType(gw.HaGw.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && (isImport || mustString(zone) != "") {
@@ -2041,7 +2050,7 @@ func resourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta any) error {
 			if len(azureEip) == 3 {
 				mustSet(d, "ha_azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 			} else {
-				log.Printf("[WARN] could not get Azure EIP name and resource group for the HA Gateway %s", gw.GwName)
+				tflog.Warn(ctx, fmt.Sprintf("could not get Azure EIP name and resource group for the HA Gateway %s", gw.GwName))
 			}
 		}
 	}
@@ -2069,8 +2078,8 @@ func resourceAviatrixSpokeGatewayRead(d *schema.ResourceData, meta any) error {
 	return nil
 }
 
-func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixSpokeGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustGatewayClient(meta)
 
 	gateway := &goaviatrix.Gateway{
 		CloudType: getInt(d, "cloud_type"),
@@ -2098,11 +2107,11 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta any) error
 
 	haGateway := &goaviatrix.Gateway{
 		CloudType: getInt(d, "cloud_type"),
-		GwName:    getString(d, "gw_name") + "-hagw",
+		GwName:    goaviatrix.HaGatewayName(getString(d, "gw_name")),
 		VpcSize:   getString(d, "ha_gw_size"),
 	}
 
-	log.Printf("[INFO] Updating Aviatrix gateway: %#v", gateway)
+	tflog.Info(ctx, fmt.Sprintf("Updating Aviatrix gateway: %#v", goaviatrix.Redact(gateway)))
 
 	d.Partial(true)
 	commSendCurr, commAcceptCurr, err := client.GetGatewayBgpCommunities(gateway.GwName)
@@ -2249,7 +2258,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta any) error
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
 			return fmt.Errorf("error updating spoke gateway: adding tags is only supported for AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
 		}
@@ -2259,7 +2268,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta any) error
 			CloudType:    gateway.CloudType,
 		}
 
-		tagsMap, err := extractTags(d, gateway.CloudType)
+		tagsMap, err := extractTags(d, gateway.CloudType, defaultTagsFor(client.GetDefaultTagsConfig(), gateway.CloudType, gatewayTagCloudTypes))
 		if err != nil {
 			return fmt.Errorf("failed to update tags for spoke gateway: %w", err)
 		}
@@ -2277,7 +2286,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta any) error
 
 	if d.HasChange("gw_size") {
 		gateway.VpcSize = getString(d, "gw_size")
-		err := client.UpdateGateway(gateway)
+		err := client.UpdateGatewayContext(ctx, gateway)
 		if err != nil {
 			return fmt.Errorf("failed to update Aviatrix Spoke Gateway: %w", err)
 		}
@@ -2295,7 +2304,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta any) error
 
 		spokeHaGw := &goaviatrix.SpokeHaGateway{
 			PrimaryGwName: getString(d, "gw_name"),
-			GwName:        getString(d, "gw_name") + "-hagw",
+			GwName:        goaviatrix.HaGatewayName(getString(d, "gw_name")),
 			GwSize:        haGwSize,
 			InsaneMode:    "no",
 		}
@@ -2443,14 +2452,14 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta any) error
 
 		if newHaGwEnabled {
 			// New configuration to enable HA
-			_, err := client.CreateSpokeHaGw(spokeHaGw)
+			_, err := client.CreateSpokeHaGwContext(ctx, spokeHaGw)
 			if err != nil {
 				return fmt.Errorf("failed to enable HA Aviatrix Spoke Gateway: %w", err)
 			}
 			if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
 				if getString(d, "rx_queue_size") != "" && !d.HasChange("rx_queue_size") {
 					haGwRxQueueSize := &goaviatrix.Gateway{
-						GwName:      getString(d, "gw_name") + "-hagw",
+						GwName:      goaviatrix.HaGatewayName(getString(d, "gw_name")),
 						RxQueueSize: getString(d, "rx_queue_size"),
 					}
 					err := client.SetRxQueueSize(haGwRxQueueSize)
@@ -2462,21 +2471,21 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta any) error
 			//}
 		} else if deleteHaGw {
 			// Ha configuration has been deleted
-			err := client.DeleteGateway(haGateway)
+			err := client.DeleteGatewayContext(ctx, haGateway)
 			if err != nil {
 				return fmt.Errorf("failed to delete Aviatrix Spoke HA gateway: %w", err)
 			}
 		} else if changeHaGw {
 			// HA subnet has been modified. Delete older HA GW,
 			// and launch new HA GW in new subnet.
-			err := client.DeleteGateway(haGateway)
+			err := client.DeleteGatewayContext(ctx, haGateway)
 			if err != nil {
 				return fmt.Errorf("failed to delete Aviatrix Spoke HA gateway: %w", err)
 			}
 
 			spokeHaGw.Eip = ""
 
-			_, err = client.CreateSpokeHaGw(spokeHaGw)
+			_, err = client.CreateSpokeHaGwContext(ctx, spokeHaGw)
 			if err != nil {
 				return fmt.Errorf("failed to enable HA Aviatrix Spoke Gateway: %w", err)
 			}
@@ -2501,7 +2510,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta any) error
 		}
 
 		if singleAZ {
-			log.Printf("[INFO] Enable Single AZ GW HA: %#v", singleAZGateway)
+			tflog.Info(ctx, fmt.Sprintf("Enable Single AZ GW HA: %#v", goaviatrix.Redact(singleAZGateway)))
 
 			err := client.EnableSingleAZGateway(singleAZGateway)
 			if err != nil {
@@ -2510,7 +2519,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta any) error
 
 			if haEnabled && manageHaGw {
 				singleAZGatewayHA := &goaviatrix.Gateway{
-					GwName: getString(d, "gw_name") + "-hagw",
+					GwName: goaviatrix.HaGatewayName(getString(d, "gw_name")),
 				}
 				err := client.EnableSingleAZGateway(singleAZGatewayHA)
 				if err != nil {
@@ -2518,7 +2527,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta any) error
 				}
 			}
 		} else {
-			log.Printf("[INFO] Disable Single AZ GW HA: %#v", singleAZGateway)
+			tflog.Info(ctx, fmt.Sprintf("Disable Single AZ GW HA: %#v", goaviatrix.Redact(singleAZGateway)))
 			err := client.DisableSingleAZGateway(singleAZGateway)
 			if err != nil {
 				return fmt.Errorf("failed to disable single AZ GW HA for %s: %w", singleAZGateway.GwName, err)
@@ -2526,7 +2535,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta any) error
 
 			if haEnabled && manageHaGw {
 				singleAZGatewayHA := &goaviatrix.Gateway{
-					GwName: getString(d, "gw_name") + "-hagw",
+					GwName: goaviatrix.HaGatewayName(getString(d, "gw_name")),
 				}
 				err := client.DisableSingleAZGateway(singleAZGatewayHA)
 				if err != nil {
@@ -2549,8 +2558,8 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta any) error
 				return fmt.Errorf("a valid non empty ha_gw_size parameter is mandatory for this resource if " +
 					"ha_subnet or ha_zone is set")
 			}
-			err = client.UpdateGateway(haGateway)
-			log.Printf("[INFO] Updating HA Gateway size to: %s ", haGateway.VpcSize)
+			err = client.UpdateGatewayContext(ctx, haGateway)
+			tflog.Info(ctx, fmt.Sprintf("Updating HA Gateway size to: %s", haGateway.VpcSize))
 			if err != nil {
 				return fmt.Errorf("failed to update Aviatrix Spoke HA Gateway size: %w", err)
 			}
@@ -2632,8 +2641,8 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta any) error
 
 	if d.HasChange("enable_encrypt_volume") {
 		if getBool(d, "enable_encrypt_volume") {
-			if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-				return fmt.Errorf("'enable_encrypt_volume' is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768) providers")
+			if !featureEncryptVolume.supports(gateway.CloudType) {
+				return featureEncryptVolume.unsupportedError()
 			}
 			gwEncVolume := &goaviatrix.Gateway{
 				GwName:              getString(d, "gw_name"),
@@ -2649,7 +2658,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta any) error
 			haEnabled := haSubnet != "" || haZone != ""
 			if haEnabled && manageHaGw {
 				gwHAEncVolume := &goaviatrix.Gateway{
-					GwName:              getString(d, "gw_name") + "-hagw",
+					GwName:              goaviatrix.HaGatewayName(getString(d, "gw_name")),
 					CustomerManagedKeys: getString(d, "customer_managed_keys"),
 				}
 				err := client.EnableEncryptVolume(gwHAEncVolume)
@@ -2674,7 +2683,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta any) error
 				CustomizedSpokeVpcRoutes: newRouteList,
 			}
 			err := client.EditGatewayCustomRoutes(transitGateway)
-			log.Printf("[INFO] Customizeing routes of spoke gateway: %s ", transitGateway.GwName)
+			tflog.Info(ctx, fmt.Sprintf("Customizeing routes of spoke gateway: %s", transitGateway.GwName))
 			if err != nil {
 				return fmt.Errorf("failed to customize spoke vpc routes of spoke gateway: %s due to: %w", transitGateway.GwName, err)
 			}
@@ -2691,7 +2700,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta any) error
 				FilteredSpokeVpcRoutes: newRouteList,
 			}
 			err := client.EditGatewayFilterRoutes(transitGateway)
-			log.Printf("[INFO] Editing filtered spoke vpc routes of spoke gateway: %s ", transitGateway.GwName)
+			tflog.Info(ctx, fmt.Sprintf("Editing filtered spoke vpc routes of spoke gateway: %s", transitGateway.GwName))
 			if err != nil {
 				return fmt.Errorf("failed to edit filtered spoke vpc routes of spoke gateway: %s due to: %w", transitGateway.GwName, err)
 			}
@@ -2708,7 +2717,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta any) error
 				AdvertisedSpokeRoutes: newRouteList,
 			}
 			err := client.EditGatewayAdvertisedCidr(transitGateway)
-			log.Printf("[INFO] Editing included advertised spoke vpc routes of spoke gateway: %s ", transitGateway.GwName)
+			tflog.Info(ctx, fmt.Sprintf("Editing included advertised spoke vpc routes of spoke gateway: %s", transitGateway.GwName))
 			if err != nil {
 				return fmt.Errorf("failed to edit included advertised spoke vpc routes of spoke gateway: %s due to: %w", transitGateway.GwName, err)
 			}
@@ -2988,7 +2997,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta any) error
 			}
 			if haSubnet != "" || haZone != "" {
 				haGwRxQueueSize := &goaviatrix.Gateway{
-					GwName:      getString(d, "gw_name") + "-hagw",
+					GwName:      goaviatrix.HaGatewayName(getString(d, "gw_name")),
 					RxQueueSize: rxQueueSize,
 				}
 				err := client.SetRxQueueSize(haGwRxQueueSize)
@@ -3040,7 +3049,7 @@ func resourceAviatrixSpokeGatewayUpdate(d *schema.ResourceData, meta any) error
 
 	d.Partial(false)
 	d.SetId(gateway.GwName)
-	return resourceAviatrixSpokeGatewayRead(d, meta)
+	return resourceAviatrixSpokeGatewayRead(ctx, d, meta)
 }
 
 // isHADeletionInProgress reports whether the error indicates the controller is
@@ -3052,15 +3061,15 @@ func isHADeletionInProgress(err error) bool {
 		(strings.Contains(msg, "HA Gateway Deletion") || strings.Contains(msg, "Please try again"))
 }
 
-func resourceAviatrixSpokeGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	client := mustClient(meta)
+func resourceAviatrixSpokeGatewayDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustGatewayClient(meta)
 
 	gateway := &goaviatrix.Gateway{
 		CloudType: getInt(d, "cloud_type"),
 		GwName:    getString(d, "gw_name"),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix Spoke Gateway: %#v", gateway)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix Spoke Gateway: %#v", goaviatrix.Redact(gateway)))
 
 	// If HA is enabled, delete HA GW first.
 	if getBool(d, "manage_ha_gateway") {
@@ -3068,8 +3077,8 @@ func resourceAviatrixSpokeGatewayDelete(d *schema.ResourceData, meta interface{}
 		haZone := getString(d, "ha_zone")
 		if haSubnet != "" || haZone != "" {
 			// Delete HA Gw too
-			gateway.GwName += "-hagw"
-			err := client.DeleteGateway(gateway)
+			gateway.GwName = goaviatrix.HaGatewayName(gateway.GwName)
+			err := client.DeleteGatewayContext(ctx, gateway)
 			if err != nil {
 				return fmt.Errorf("failed to delete Aviatrix Spoke HA gateway: %w", err)
 			}
@@ -3081,7 +3090,7 @@ func resourceAviatrixSpokeGatewayDelete(d *schema.ResourceData, meta interface{}
 	// The controller may return "in use for operation HA Gateway Deletion" when
 	// aviatrix_spoke_ha_gateway was deleted separately and cleanup is still in progress.
 	const maxTries = 6
-	err := goaviatrix.Retry(goaviatrix.RetryConfig{
+	err := goaviatrix.RetryContext(ctx, goaviatrix.RetryConfig{
 		MaxTries: maxTries,
 		Backoff:  30 * time.Second,
 		BackoffFunc: func(d time.Duration) time.Duration {
@@ -3089,11 +3098,10 @@ func resourceAviatrixSpokeGatewayDelete(d *schema.ResourceData, meta interface{}
 		},
 		ShouldRetry: isHADeletionInProgress,
 		OnRetry: func(attempt int, backoff time.Duration, err error) {
-			log.Printf("[INFO] Primary gateway still in use for HA deletion, waiting %v before retry (attempt %d/%d)",
-				backoff, attempt, maxTries)
+			tflog.Info(ctx, fmt.Sprintf("Primary gateway still in use for HA deletion, waiting %v before retry (attempt %d/%d)", backoff, attempt, maxTries))
 		},
 	}, func() error {
-		return client.DeleteGateway(gateway)
+		return client.DeleteGatewayContext(ctx, gateway)
 	})
 	if err != nil {
 		return fmt.Errorf("failed to delete Aviatrix Spoke Gateway: %w", err)
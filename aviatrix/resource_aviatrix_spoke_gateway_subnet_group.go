@@ -3,9 +3,10 @@ package aviatrix
 import (
 	"context"
 	"errors"
-	"log"
+	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -64,7 +65,7 @@ func marshalSpokeGatewaySubnetGroupInput(d *schema.ResourceData) *goaviatrix.Spo
 }
 
 func resourceAviatrixSpokeGatewaySubnetGroupCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustGatewayClient(meta)
 
 	spokeGatewaySubnetGroup := marshalSpokeGatewaySubnetGroupInput(d)
 
@@ -94,13 +95,13 @@ func resourceAviatrixSpokeGatewaySubnetGroupReadIfRequired(ctx context.Context,
 }
 
 func resourceAviatrixSpokeGatewaySubnetGroupRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustGatewayClient(meta)
 
 	name := getString(d, "name")
 
 	if name == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import. Import Id is %s", id))
 		parts := strings.Split(id, "~")
 		if len(parts) != 2 {
 			return diag.Errorf("invalid ID, expected ID gw_name~name, instead got %s", d.Id())
@@ -139,7 +140,7 @@ func resourceAviatrixSpokeGatewaySubnetGroupUpdate(ctx context.Context, d *schem
 	flag := false
 	defer resourceAviatrixSpokeGatewaySubnetGroupReadIfRequired(ctx, d, meta, &flag)
 
-	client := mustClient(meta)
+	client := mustGatewayClient(meta)
 
 	if d.HasChange("subnets") {
 		spokeGatewaySubnetGroup := marshalSpokeGatewaySubnetGroupInput(d)
@@ -153,7 +154,7 @@ func resourceAviatrixSpokeGatewaySubnetGroupUpdate(ctx context.Context, d *schem
 }
 
 func resourceAviatrixSpokeGatewaySubnetGroupDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustGatewayClient(meta)
 
 	spokeGatewaySubnetGroup := marshalSpokeGatewaySubnetGroupInput(d)
 
@@ -4,9 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -24,6 +24,8 @@ func resourceAviatrixSpokeGroup() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: gatewayTimeouts(),
+
 		Schema: MergeSchemaMaps(
 			// Required attributes from group schema
 			GroupRequiredSchema(),
@@ -145,7 +147,7 @@ func spokeGroupOptionalSchema() map[string]*schema.Schema {
 			Type:         schema.TypeString,
 			Optional:     true,
 			Description:  "Changes the Aviatrix Spoke Gateway ASN number before you set up Aviatrix Spoke Gateway connection configurations.",
-			ValidateFunc: goaviatrix.ValidateASN,
+			ValidateFunc: ValidateASN,
 		},
 		"prepend_as_path": {
 			Type:         schema.TypeList,
@@ -154,7 +156,7 @@ func spokeGroupOptionalSchema() map[string]*schema.Schema {
 			Description:  "List of AS numbers to populate BGP AP_PATH field when it advertises to VGW or peer devices.",
 			Elem: &schema.Schema{
 				Type:         schema.TypeString,
-				ValidateFunc: goaviatrix.ValidateASN,
+				ValidateFunc: ValidateASN,
 			},
 		},
 		"disable_route_propagation": {
@@ -406,7 +408,7 @@ func validateSpokeGroupConfiguration(spokeGroup *goaviatrix.GatewayGroup) error
 }
 
 // applyBgpCommunities applies BGP communities settings (accept/send) to the spoke group.
-func applyBgpCommunities(ctx context.Context, d *schema.ResourceData, client *goaviatrix.Client, groupName string) error {
+func applyBgpCommunities(ctx context.Context, d *schema.ResourceData, client goaviatrix.GatewayClient, groupName string) error {
 	// Default for both is false, so only set if true
 	acceptComm := getBool(d, "bgp_accept_communities")
 	sendComm := getBool(d, "bgp_send_communities")
@@ -434,16 +436,16 @@ func applyBgpCommunities(ctx context.Context, d *schema.ResourceData, client *go
 // GetOk would treat bool false as "unset" and skip the disable API. On update, the
 // resource's d.HasChange("enable_jumbo_frame") block handles changes.
 // SA1019: GetOkExists is deprecated but required to distinguish unset vs false for optional bool; no SDK alternative yet.
-func applyJumboFrameForGroup(ctx context.Context, d *schema.ResourceData, client *goaviatrix.Client, groupName string, isEdgeGateway bool, groupType string) error {
+func applyJumboFrameForGroup(ctx context.Context, d *schema.ResourceData, client goaviatrix.GatewayClient, groupName string, isEdgeGateway bool, groupType string) error {
 	enableJumboFrame, jumboFrameSet := d.GetOkExists("enable_jumbo_frame") //nolint:staticcheck // SA1019
 	if jumboFrameSet {
 		if v, ok := enableJumboFrame.(bool); ok && v {
-			log.Printf("[INFO] Enabling Jumbo Frame for %s group: %s", groupType, groupName)
+			tflog.Info(ctx, fmt.Sprintf("Enabling Jumbo Frame for %s group: %s", groupType, groupName))
 			if err := client.EnableJumboFrameGatewayGroup(ctx, groupName); err != nil {
 				return fmt.Errorf("failed to enable jumbo frame: %w", err)
 			}
 		} else if ok && !v {
-			log.Printf("[INFO] Disabling Jumbo Frame for %s group: %s", groupType, groupName)
+			tflog.Info(ctx, fmt.Sprintf("Disabling Jumbo Frame for %s group: %s", groupType, groupName))
 			if err := client.DisableJumboFrameGatewayGroup(ctx, groupName); err != nil {
 				return fmt.Errorf("failed to disable jumbo frame: %w", err)
 			}
@@ -451,9 +453,9 @@ func applyJumboFrameForGroup(ctx context.Context, d *schema.ResourceData, client
 	} else {
 		// User didn't set the value; no API call—rely on backend defaults (false for edge, true for CSP).
 		if isEdgeGateway {
-			log.Printf("[INFO] Jumbo Frame not set for edge %s group %s, relying on backend default (disabled)", groupType, groupName)
+			tflog.Info(ctx, fmt.Sprintf("Jumbo Frame not set for edge %s group %s, relying on backend default (disabled)", groupType, groupName))
 		} else {
-			log.Printf("[INFO] Jumbo Frame not set for CSP %s group %s, relying on backend default (enabled)", groupType, groupName)
+			tflog.Info(ctx, fmt.Sprintf("Jumbo Frame not set for CSP %s group %s, relying on backend default (enabled)", groupType, groupName))
 		}
 	}
 	return nil
@@ -461,37 +463,37 @@ func applyJumboFrameForGroup(ctx context.Context, d *schema.ResourceData, client
 
 // applySpokeJumboFrame applies jumbo frame settings based on gateway type.
 // For edge gateways (EDGESPOKE), default is false; for CSP (SPOKE), default is true.
-func applySpokeJumboFrame(ctx context.Context, d *schema.ResourceData, client *goaviatrix.Client, groupName string) error {
+func applySpokeJumboFrame(ctx context.Context, d *schema.ResourceData, client goaviatrix.GatewayClient, groupName string) error {
 	gwType := strings.ToUpper(getString(d, "gw_type"))
 	isEdgeGateway := gwType == "EDGESPOKE"
 	return applyJumboFrameForGroup(ctx, d, client, groupName, isEdgeGateway, "spoke")
 }
 
 // applyFeatureFlags applies feature flags (NAT, VPC DNS Server, IPv6) to the spoke group.
-func applyFeatureFlags(ctx context.Context, d *schema.ResourceData, client *goaviatrix.Client, groupName string) error {
+func applyFeatureFlags(ctx context.Context, d *schema.ResourceData, client goaviatrix.GatewayClient, groupName string) error {
 	if getBool(d, "enable_nat") {
-		log.Printf("[INFO] Enabling NAT for spoke group: %s", groupName)
+		tflog.Info(ctx, fmt.Sprintf("Enabling NAT for spoke group: %s", groupName))
 		if err := client.EnableGatewayGroupSNat(ctx, groupName); err != nil {
 			return fmt.Errorf("failed to enable NAT: %w", err)
 		}
 	}
 
 	if getBool(d, "enable_vpc_dns_server") {
-		log.Printf("[INFO] Enabling VPC DNS Server for spoke group: %s", groupName)
+		tflog.Info(ctx, fmt.Sprintf("Enabling VPC DNS Server for spoke group: %s", groupName))
 		if err := client.EnableGatewayGroupVpcDNSServer(ctx, groupName); err != nil {
 			return fmt.Errorf("failed to enable VPC DNS Server: %w", err)
 		}
 	}
 
 	if getBool(d, "enable_ipv6") {
-		log.Printf("[INFO] Enabling IPv6 for spoke group: %s", groupName)
+		tflog.Info(ctx, fmt.Sprintf("Enabling IPv6 for spoke group: %s", groupName))
 		if err := client.EnableIPv6GatewayGroup(ctx, groupName); err != nil {
 			return fmt.Errorf("failed to enable IPv6: %w", err)
 		}
 	}
 
 	if !getBool(d, "enable_gro_gso") {
-		log.Printf("[INFO] Disabling GRO/GSO for spoke group: %s", groupName)
+		tflog.Info(ctx, fmt.Sprintf("Disabling GRO/GSO for spoke group: %s", groupName))
 		if err := client.DisableGroGsoGatewayGroup(ctx, groupName); err != nil {
 			return fmt.Errorf("failed to disable GRO/GSO: %w", err)
 		}
@@ -501,7 +503,7 @@ func applyFeatureFlags(ctx context.Context, d *schema.ResourceData, client *goav
 }
 
 // applyBgpTimers applies BGP timer settings (polling time, neighbor status polling, hold time) to the spoke group.
-func applyBgpTimers(ctx context.Context, d *schema.ResourceData, client *goaviatrix.Client, groupName string) error {
+func applyBgpTimers(ctx context.Context, d *schema.ResourceData, client goaviatrix.GatewayClient, groupName string) error {
 	bgpPollingTime := getInt(d, "bgp_polling_time")
 	if bgpPollingTime != defaultBgpPollingTime {
 		if err := client.SetBgpPollingTimeGatewayGroup(ctx, groupName, bgpPollingTime); err != nil {
@@ -527,7 +529,7 @@ func applyBgpTimers(ctx context.Context, d *schema.ResourceData, client *goaviat
 }
 
 // applyBgpConfiguration applies BGP configuration (local AS number, prepend AS path, BGP ECMP) to the spoke group.
-func applyBgpConfiguration(ctx context.Context, d *schema.ResourceData, client *goaviatrix.Client, groupName string) error {
+func applyBgpConfiguration(ctx context.Context, d *schema.ResourceData, client goaviatrix.GatewayClient, groupName string) error {
 	if val, ok := d.GetOk("local_as_number"); ok {
 		if err := client.SetLocalASNumberGatewayGroup(ctx, groupName, mustString(val)); err != nil {
 			return fmt.Errorf("failed to set local AS number: %w", err)
@@ -553,7 +555,7 @@ func applyBgpConfiguration(ctx context.Context, d *schema.ResourceData, client *
 }
 
 // applyActiveStandby applies Active-Standby settings to the spoke group.
-func applyActiveStandby(ctx context.Context, d *schema.ResourceData, client *goaviatrix.Client, groupName string) error {
+func applyActiveStandby(ctx context.Context, d *schema.ResourceData, client goaviatrix.GatewayClient, groupName string) error {
 	enableActiveStandby := getBool(d, "enable_active_standby")
 	if !enableActiveStandby {
 		return nil
@@ -574,16 +576,16 @@ func applyActiveStandby(ctx context.Context, d *schema.ResourceData, client *goa
 }
 
 // applySpokeSpecificSettings applies spoke-specific settings (preserve AS path, learned CIDRs, route propagation, etc.) to the spoke group.
-func applySpokeSpecificSettings(ctx context.Context, d *schema.ResourceData, client *goaviatrix.Client, groupName string) error {
+func applySpokeSpecificSettings(ctx context.Context, d *schema.ResourceData, client goaviatrix.GatewayClient, groupName string) error {
 	if getBool(d, "enable_preserve_as_path") {
-		log.Printf("[INFO] Enabling Preserve AS Path for spoke group: %s", groupName)
+		tflog.Info(ctx, fmt.Sprintf("Enabling Preserve AS Path for spoke group: %s", groupName))
 		if err := client.EnableSpokePreserveAsPathGatewayGroup(ctx, groupName); err != nil {
 			return fmt.Errorf("failed to enable Preserve AS Path: %w", err)
 		}
 	}
 
 	if getBool(d, "enable_learned_cidrs_approval") {
-		log.Printf("[INFO] Enabling learned CIDRs approval for spoke group: %s", groupName)
+		tflog.Info(ctx, fmt.Sprintf("Enabling learned CIDRs approval for spoke group: %s", groupName))
 		if err := client.EnableSpokeLearnedCidrsApprovalGatewayGroup(ctx, groupName); err != nil {
 			return fmt.Errorf("failed to enable learned CIDRs approval: %w", err)
 		}
@@ -597,14 +599,14 @@ func applySpokeSpecificSettings(ctx context.Context, d *schema.ResourceData, cli
 	}
 
 	if getBool(d, "enable_private_vpc_default_route") {
-		log.Printf("[INFO] Enabling private VPC default route for spoke group: %s", groupName)
+		tflog.Info(ctx, fmt.Sprintf("Enabling private VPC default route for spoke group: %s", groupName))
 		if err := client.EnablePrivateVpcDefaultRouteGatewayGroup(ctx, groupName); err != nil {
 			return fmt.Errorf("failed to enable private VPC default route: %w", err)
 		}
 	}
 
 	if getBool(d, "enable_skip_public_route_table_update") {
-		log.Printf("[INFO] Enabling skip public route update for spoke group: %s", groupName)
+		tflog.Info(ctx, fmt.Sprintf("Enabling skip public route update for spoke group: %s", groupName))
 		if err := client.EnableSkipPublicRouteUpdateGatewayGroup(ctx, groupName); err != nil {
 			return fmt.Errorf("failed to enable skip public route update: %w", err)
 		}
@@ -629,7 +631,7 @@ func applySpokeSpecificSettings(ctx context.Context, d *schema.ResourceData, cli
 	}
 
 	if getBool(d, "enable_auto_advertise_s2c_cidrs") {
-		log.Printf("[INFO] Enabling auto advertise s2c cidrs for spoke group: %s", groupName)
+		tflog.Info(ctx, fmt.Sprintf("Enabling auto advertise s2c cidrs for spoke group: %s", groupName))
 		if err := client.EnableAutoAdvertiseS2CCidrsGatewayGroup(ctx, groupName); err != nil {
 			return fmt.Errorf("failed to enable auto advertise s2c CIDRs: %w", err)
 		}
@@ -639,21 +641,21 @@ func applySpokeSpecificSettings(ctx context.Context, d *schema.ResourceData, cli
 		spokeBgpManualAdvertiseCidrs := getStringSet(d, "spoke_bgp_manual_advertise_cidrs")
 		cidrs := strings.Join(spokeBgpManualAdvertiseCidrs, ",")
 
-		log.Printf("[INFO] Setting spoke BGP manual advertise CIDRs for spoke group: %s", groupName)
+		tflog.Info(ctx, fmt.Sprintf("Setting spoke BGP manual advertise CIDRs for spoke group: %s", groupName))
 		if err := client.SetSpokeBgpManualAdvertisedNetworksGatewayGroup(ctx, groupName, cidrs); err != nil {
 			return fmt.Errorf("failed to set spoke BGP manual advertise CIDRs: %w", err)
 		}
 	}
 
 	if getBool(d, "disable_route_propagation") {
-		log.Printf("[INFO] Disabling route propagation for spoke group: %s", groupName)
+		tflog.Info(ctx, fmt.Sprintf("Disabling route propagation for spoke group: %s", groupName))
 		if err := client.DisableSpokeOnpremRoutePropagationGatewayGroup(ctx, groupName); err != nil {
 			return fmt.Errorf("failed to disable route propagation: %w", err)
 		}
 	}
 
 	if getBool(d, "enable_global_vpc") {
-		log.Printf("[INFO] Enabling global VPC for spoke group: %s", groupName)
+		tflog.Info(ctx, fmt.Sprintf("Enabling global VPC for spoke group: %s", groupName))
 		if err := client.EnableGlobalVpcGatewayGroup(ctx, groupName); err != nil {
 			return fmt.Errorf("failed to enable global VPC: %w", err)
 		}
@@ -667,7 +669,7 @@ func applySpokeSpecificSettings(ctx context.Context, d *schema.ResourceData, cli
 // ============================================================================
 
 func resourceAviatrixSpokeGroupCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustGatewayClient(meta)
 
 	// approved_learned_cidrs can only be set during update, not during create
 	if approvedLearnedCidrs := getStringSet(d, "approved_learned_cidrs"); len(approvedLearnedCidrs) > 0 {
@@ -689,7 +691,7 @@ func resourceAviatrixSpokeGroupCreate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	// Create the spoke group
-	log.Printf("[INFO] Creating Spoke Group: %#v", spokeGroup)
+	tflog.Info(ctx, fmt.Sprintf("Creating Spoke Group: %#v", goaviatrix.Redact(spokeGroup)))
 	if err := client.CreateGatewayGroup(ctx, spokeGroup); err != nil {
 		return diag.Errorf("failed to create spoke group: %s", err)
 	}
@@ -732,7 +734,7 @@ func resourceAviatrixSpokeGroupCreate(ctx context.Context, d *schema.ResourceDat
 
 //nolint:cyclop,funlen
 func resourceAviatrixSpokeGroupRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustGatewayClient(meta)
 
 	// The resource ID is the group UUID
 	groupUUID := d.Id()
@@ -740,7 +742,7 @@ func resourceAviatrixSpokeGroupRead(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("resource ID (group UUID) is empty")
 	}
 
-	log.Printf("[INFO] Reading Spoke Group: %s", groupUUID)
+	tflog.Info(ctx, fmt.Sprintf("Reading Spoke Group: %s", groupUUID))
 
 	spokeGroup, err := client.GetGatewayGroup(ctx, groupUUID)
 	if err != nil {
@@ -852,12 +854,12 @@ func resourceAviatrixSpokeGroupRead(ctx context.Context, d *schema.ResourceData,
 
 //nolint:cyclop,funlen
 func resourceAviatrixSpokeGroupUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustGatewayClient(meta)
 	groupName := getString(d, "group_name")
 	cloudType := getInt(d, "cloud_type")
 	groupUUID := d.Id()
 
-	log.Printf("[INFO] Updating Spoke Group: %s (UUID: %s)", groupName, groupUUID)
+	tflog.Info(ctx, fmt.Sprintf("Updating Spoke Group: %s (UUID: %s)", groupName, groupUUID))
 
 	// Validations for update
 	if getBool(d, "enable_active_standby_preemptive") && !getBool(d, "enable_active_standby") {
@@ -1306,13 +1308,13 @@ func resourceAviatrixSpokeGroupUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceAviatrixSpokeGroupDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustGatewayClient(meta)
 
 	// The resource ID is the group UUID
 	groupUUID := d.Id()
 	groupName := getString(d, "group_name")
 
-	log.Printf("[INFO] Deleting Spoke Group: %s (UUID: %s)", groupName, groupUUID)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Spoke Group: %s (UUID: %s)", groupName, groupUUID))
 
 	err := client.DeleteGatewayGroup(ctx, groupUUID)
 	if err != nil {
@@ -4,9 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -15,11 +15,11 @@ import (
 
 func resourceAviatrixSpokeHaGateway() *schema.Resource {
 	return &schema.Resource{
-		Create:        resourceAviatrixSpokeHaGatewayCreate,
-		Read:          resourceAviatrixSpokeHaGatewayRead,
-		Update:        resourceAviatrixSpokeHaGatewayUpdate,
-		Delete:        resourceAviatrixSpokeHaGatewayDelete,
-		CustomizeDiff: resourceAviatrixSpokeHaGatewayCustomizeDiff,
+		CreateWithoutTimeout: withContext(resourceAviatrixSpokeHaGatewayCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixSpokeHaGatewayRead),
+		UpdateWithoutTimeout: withContext(resourceAviatrixSpokeHaGatewayUpdate),
+		DeleteWithoutTimeout: withContext(resourceAviatrixSpokeHaGatewayDelete),
+		CustomizeDiff:        resourceAviatrixSpokeHaGatewayCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -164,7 +164,7 @@ func resourceAviatrixSpokeHaGateway() *schema.Resource {
 // silently left the HA gateway on its old size (AVX-36730). Planning the HA
 // gw_size to the primary's current size surfaces that drift and drives a resize
 // on the next apply.
-func resourceAviatrixSpokeHaGatewayCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+func resourceAviatrixSpokeHaGatewayCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
 	// On create the controller initializes gw_size from the primary gateway.
 	if d.Id() == "" {
 		return nil
@@ -186,11 +186,11 @@ func resourceAviatrixSpokeHaGatewayCustomizeDiff(_ context.Context, d *schema.Re
 		return nil
 	}
 
-	client := mustClient(meta)
+	client := mustGatewayClient(meta)
 	primaryGw, err := client.GetGateway(&goaviatrix.Gateway{GwName: primaryGwName})
 	if err != nil {
 		// Don't block planning if the primary can't be read right now.
-		log.Printf("[WARN] spoke ha gateway: could not read primary gateway %q to sync gw_size: %v", primaryGwName, err)
+		tflog.Warn(ctx, fmt.Sprintf("spoke ha gateway: could not read primary gateway %q to sync gw_size: %v", primaryGwName, err))
 		return nil
 	}
 
@@ -202,8 +202,8 @@ func resourceAviatrixSpokeHaGatewayCustomizeDiff(_ context.Context, d *schema.Re
 	return nil
 }
 
-func resourceAviatrixSpokeHaGatewayCreate(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixSpokeHaGatewayCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustGatewayClient(meta)
 
 	gateway := &goaviatrix.SpokeHaGateway{
 		PrimaryGwName:      getString(d, "primary_gw_name"),
@@ -312,36 +312,36 @@ func resourceAviatrixSpokeHaGatewayCreate(d *schema.ResourceData, meta any) erro
 		}
 
 		if singleAZ {
-			log.Printf("[INFO] Enable Single AZ GW HA for spoke HA gateway: %#v", singleAZGateway)
+			tflog.Info(ctx, fmt.Sprintf("Enable Single AZ GW HA for spoke HA gateway: %#v", goaviatrix.Redact(singleAZGateway)))
 			err := client.EnableSingleAZGateway(singleAZGateway)
 			if err != nil {
 				return fmt.Errorf("failed to enable single AZ GW HA for spoke HA gateway %s: %w", spokeHaGwName, err)
 			}
 		} else {
-			log.Printf("[INFO] Disable Single AZ GW HA for spoke HA gateway: %#v", singleAZGateway)
+			tflog.Info(ctx, fmt.Sprintf("Disable Single AZ GW HA for spoke HA gateway: %#v", goaviatrix.Redact(singleAZGateway)))
 			err := client.DisableSingleAZGateway(singleAZGateway)
 			if err != nil {
 				return fmt.Errorf("failed to disable single AZ GW HA for spoke HA gateway %s: %w", spokeHaGwName, err)
 			}
 		}
 	} else {
-		log.Printf("[INFO] single_az_ha not set - inheriting from primary gateway")
+		tflog.Info(ctx, "single_az_ha not set - inheriting from primary gateway")
 	}
 
 	d.SetId(spokeHaGwName)
 	mustSet(d, "gw_name", spokeHaGwName)
-	return resourceAviatrixSpokeHaGatewayRead(d, meta)
+	return resourceAviatrixSpokeHaGatewayRead(ctx, d, meta)
 }
 
-func resourceAviatrixSpokeHaGatewayRead(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixSpokeHaGatewayRead(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustGatewayClient(meta)
 
 	var isImport bool
 	gwName := getString(d, "gw_name")
 	if gwName == "" {
 		isImport = true
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no gateway name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no gateway name received. Import Id is %s", id))
 		mustSet(d, "gw_name", id)
 		d.SetId(id)
 	}
@@ -360,7 +360,7 @@ func resourceAviatrixSpokeHaGatewayRead(d *schema.ResourceData, meta any) error
 		return fmt.Errorf("couldn't find Aviatrix Spoke Gateway: %w", err)
 	}
 
-	log.Printf("[TRACE] reading spoke gateway %s: %#v", getString(d, "gw_name"), gw)
+	tflog.Trace(ctx, fmt.Sprintf("reading spoke gateway %s: %#v", getString(d, "gw_name"), goaviatrix.Redact(gw)))
 	mustSet(d, "primary_gw_name", gw.PrimaryGwName)
 	mustSet(d, "eip", gw.PublicIP)
 	mustSet(d, "subnet", gw.VpcNet)
@@ -389,7 +389,7 @@ func resourceAviatrixSpokeHaGatewayRead(d *schema.ResourceData, meta any) error
 		if len(azureEip) == 3 {
 			mustSet(d, "azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 		} else {
-			log.Printf("[WARN] could not get Azure EIP name and resource group for the Spoke HA Gateway %s", gw.GwName)
+			tflog.Warn(ctx, fmt.Sprintf("could not get Azure EIP name and resource group for the Spoke HA Gateway %s", gw.GwName))
 		}
 	} else if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.OCIRelatedCloudTypes) {
 		mustSet(d, "vpc_reg", gw.VpcRegion)
@@ -418,8 +418,8 @@ func resourceAviatrixSpokeHaGatewayRead(d *schema.ResourceData, meta any) error
 	return nil
 }
 
-func resourceAviatrixSpokeHaGatewayUpdate(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixSpokeHaGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustGatewayClient(meta)
 
 	gateway := &goaviatrix.Gateway{
 		CloudType: getInt(d, "cloud_type"),
@@ -429,7 +429,7 @@ func resourceAviatrixSpokeHaGatewayUpdate(d *schema.ResourceData, meta any) erro
 	if d.HasChange("gw_size") {
 		gateway.GwName = getString(d, "gw_name")
 		gateway.VpcSize = getString(d, "gw_size")
-		err := client.UpdateGateway(gateway)
+		err := client.UpdateGatewayContext(ctx, gateway)
 		if err != nil {
 			return fmt.Errorf("failed to update Aviatrix Spoke HA Gateway %s: %w", gateway.GwName, err)
 		}
@@ -448,13 +448,13 @@ func resourceAviatrixSpokeHaGatewayUpdate(d *schema.ResourceData, meta any) erro
 		}
 
 		if singleAZ {
-			log.Printf("[INFO] Enable Single AZ GW HA: %#v", singleAZGateway)
+			tflog.Info(ctx, fmt.Sprintf("Enable Single AZ GW HA: %#v", goaviatrix.Redact(singleAZGateway)))
 			err := client.EnableSingleAZGateway(singleAZGateway)
 			if err != nil {
 				return fmt.Errorf("failed to enable single AZ GW HA for %s: %w", singleAZGateway.GwName, err)
 			}
 		} else {
-			log.Printf("[INFO] Disable Single AZ GW HA: %#v", singleAZGateway)
+			tflog.Info(ctx, fmt.Sprintf("Disable Single AZ GW HA: %#v", goaviatrix.Redact(singleAZGateway)))
 			err := client.DisableSingleAZGateway(singleAZGateway)
 			if err != nil {
 				return fmt.Errorf("failed to disable single AZ GW HA for %s: %w", singleAZGateway.GwName, err)
@@ -464,18 +464,18 @@ func resourceAviatrixSpokeHaGatewayUpdate(d *schema.ResourceData, meta any) erro
 
 	d.Partial(false)
 	d.SetId(gateway.GwName)
-	return resourceAviatrixSpokeHaGatewayRead(d, meta)
+	return resourceAviatrixSpokeHaGatewayRead(ctx, d, meta)
 }
 
-func resourceAviatrixSpokeHaGatewayDelete(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixSpokeHaGatewayDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustGatewayClient(meta)
 
 	gateway := &goaviatrix.Gateway{
 		CloudType: getInt(d, "cloud_type"),
 		GwName:    getString(d, "gw_name"),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix Spoke Ha Gateway: %#v", gateway)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix Spoke Ha Gateway: %#v", goaviatrix.Redact(gateway)))
 
 	err := client.DeleteGateway(gateway)
 	if err != nil {
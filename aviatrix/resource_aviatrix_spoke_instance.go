@@ -4,10 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -22,7 +23,10 @@ func resourceAviatrixSpokeInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		CustomizeDiff: resourceAviatrixSpokeInstanceCustomizeDiff,
+		CustomizeDiff: customdiff.All(
+			resourceAviatrixSpokeInstanceCustomizeDiff,
+			customizeDiffTagsAll(gatewayTagCloudTypes),
+		),
 
 		Schema: MergeSchemaMaps(
 			// Required attributes
@@ -73,7 +77,7 @@ func resourceAviatrixSpokeInstanceCustomizeDiff(_ context.Context, d *schema.Res
 // ============================================================================
 
 // buildSpokeVpcFromResourceData constructs a SpokeVpc struct from Terraform resource data.
-func buildSpokeVpcFromResourceData(d *schema.ResourceData, gatewayGroup *goaviatrix.GatewayGroup) (*goaviatrix.SpokeVpc, error) {
+func buildSpokeVpcFromResourceData(d *schema.ResourceData, gatewayGroup *goaviatrix.GatewayGroup, defaultTagsConfig *goaviatrix.DefaultTagsConfig) (*goaviatrix.SpokeVpc, error) {
 	spokeGateway := &goaviatrix.SpokeVpc{
 		GroupUUID:             getString(d, "group_uuid"),
 		GwName:                getString(d, "gw_name"),
@@ -112,10 +116,11 @@ func buildSpokeVpcFromResourceData(d *schema.ResourceData, gatewayGroup *goaviat
 	// Private subnet egress target
 	spokeGateway.PrivateSubnetEgressTarget = getString(d, "private_subnet_egress_target")
 
-	// Tags
-	if _, ok := d.GetOk("tags"); ok {
-		tagsMap := mustMap(d.Get("tags"))
-		tagsJSON, err := TagsMapToJson(convertTagsMapToStringMap(tagsMap))
+	// Tags, including the provider default tags
+	defaultTags := defaultTagsFor(defaultTagsConfig, gatewayGroup.CloudType, gatewayTagCloudTypes)
+	if _, ok := d.GetOk("tags"); ok || defaultTags != nil {
+		tagsMap := defaultTags.MergeTags(convertTagsMapToStringMap(mustMap(d.Get("tags"))))
+		tagsJSON, err := TagsMapToJson(tagsMap)
 		if err != nil {
 			return nil, fmt.Errorf("failed to convert tags to JSON: %w", err)
 		}
@@ -263,8 +268,8 @@ func validateSpokeInstanceConfiguration(d *schema.ResourceData, cloudType int, v
 		return fmt.Errorf("'customer_managed_keys' should be empty since Encrypt Volume is not enabled")
 	}
 
-	if enableEncryptVolume && !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes) {
-		return fmt.Errorf("'enable_encrypt_volume' is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
+	if enableEncryptVolume && !featureEncryptVolume.supports(cloudType) {
+		return featureEncryptVolume.unsupportedError()
 	}
 
 	// BGP Over LAN Validation
@@ -272,8 +277,8 @@ func validateSpokeInstanceConfiguration(d *schema.ResourceData, cloudType int, v
 		return fmt.Errorf("'bgp_lan_interfaces_count' requires enable_bgp_over_lan to be true")
 	}
 
-	if enableBgpOverLan && !goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes) {
-		return fmt.Errorf("'enable_bgp_over_lan' is only valid for Azure (8), AzureGov (32) or AzureChina (2048)")
+	if enableBgpOverLan && !featureSpokeBgpOverLan.supports(cloudType) {
+		return featureSpokeBgpOverLan.unsupportedError()
 	}
 
 	// Insertion Gateway Validation
@@ -311,8 +316,8 @@ func validateSpokeInstanceConfiguration(d *schema.ResourceData, cloudType int, v
 
 	// Spot Instance Validation
 	if enableSpotInstance {
-		if !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-			return fmt.Errorf("enable_spot_instance only supports AWS and Azure related cloud types")
+		if !featureSpotInstance.supports(cloudType) {
+			return featureSpotInstance.unsupportedError()
 		}
 
 		if deleteSpot && !goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes) {
@@ -321,8 +326,8 @@ func validateSpokeInstanceConfiguration(d *schema.ResourceData, cloudType int, v
 	}
 
 	// RX Queue Size Validation
-	if rxQueueSize != "" && !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes) {
-		return fmt.Errorf("rx_queue_size only supports AWS related cloud types")
+	if rxQueueSize != "" && !featureRxQueueSize.supports(cloudType) {
+		return featureRxQueueSize.unsupportedError()
 	}
 
 	// OCI Validation
@@ -396,7 +401,7 @@ func resourceAviatrixSpokeInstanceCreate(ctx context.Context, d *schema.Resource
 	}
 
 	// Build the spoke gateway from resource data for CSP
-	spokeGateway, err := buildSpokeVpcFromResourceData(d, gatewayGroup)
+	spokeGateway, err := buildSpokeVpcFromResourceData(d, gatewayGroup, client.GetDefaultTagsConfig())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -445,7 +450,7 @@ func resourceAviatrixSpokeInstanceCreate(ctx context.Context, d *schema.Resource
 		spokeGateway.EncVolume = "no"
 	}
 
-	log.Printf("[INFO] Creating new spoke instance: %#v", spokeGateway)
+	tflog.Info(ctx, fmt.Sprintf("Creating new spoke instance: %#v", goaviatrix.Redact(spokeGateway)))
 	createdGwName, err := client.LaunchSpokeInstance(spokeGateway)
 	if err != nil {
 		return diag.Errorf("failed to create new spoke instance: %s", err)
@@ -533,7 +538,7 @@ func createEdgeSpokeInstance(ctx context.Context, d *schema.ResourceData, client
 		}
 	}
 
-	log.Printf("[INFO] Creating Aviatrix Edge Spoke Instance: %#v", edgeSpoke)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix Edge Spoke Instance: %#v", goaviatrix.Redact(edgeSpoke)))
 
 	err := client.CreateEdgeSpokeInstance(ctx, edgeSpoke)
 	if err != nil {
@@ -609,7 +614,7 @@ func resourceAviatrixSpokeInstanceRead(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("resource ID (gateway name) is empty")
 	}
 
-	log.Printf("[INFO] Reading Spoke Instance: %s", gwName)
+	tflog.Info(ctx, fmt.Sprintf("Reading Spoke Instance: %s", gwName))
 
 	gateway, err := client.GetGateway(&goaviatrix.Gateway{GwName: gwName})
 	if err != nil {
@@ -730,7 +735,9 @@ func resourceAviatrixSpokeInstanceRead(ctx context.Context, d *schema.ResourceDa
 
 	// Tags
 	if gateway.Tags != nil {
-		mustSet(d, "tags", gateway.Tags)
+		if err := setTagsAll(d, gateway.Tags, client.GetDefaultTagsConfig()); err != nil {
+			return diag.Errorf("failed to set tags: %s", err)
+		}
 	}
 
 	return nil
@@ -790,9 +797,11 @@ func readEdgeSpokeInstance(ctx context.Context, d *schema.ResourceData, client *
 	// Tunnel detection time (from base gateway)
 	mustSet(d, "tunnel_detection_time", gateway.TunnelDetectionTime)
 
-	// Tags (from base gateway)
+	// Tags (from base gateway). Default tags are not applied to edge gateways.
 	if gateway.Tags != nil {
-		mustSet(d, "tags", gateway.Tags)
+		if err := setTagsAll(d, gateway.Tags, nil); err != nil {
+			return diag.Errorf("failed to set tags: %s", err)
+		}
 	}
 
 	// Computed attributes from base gateway
@@ -807,7 +816,7 @@ func resourceAviatrixSpokeInstanceUpdate(ctx context.Context, d *schema.Resource
 	client := mustClient(meta)
 	gwName := d.Id()
 
-	log.Printf("[INFO] Updating Spoke Instance: %s", gwName)
+	tflog.Info(ctx, fmt.Sprintf("Updating Spoke Instance: %s", gwName))
 
 	groupUUID := getString(d, "group_uuid")
 
@@ -831,9 +840,9 @@ func resourceAviatrixSpokeInstanceUpdate(ctx context.Context, d *schema.Resource
 
 	// Common updates for both CSP and edge spoke gateways
 	// Tags
-	if d.HasChange("tags") {
-		tagsMap := mustMap(d.Get("tags"))
-		tagsJSON, err := TagsMapToJson(convertTagsMapToStringMap(tagsMap))
+	if d.HasChanges("tags", "tags_all") {
+		tagsMap := defaultTagsFor(client.GetDefaultTagsConfig(), cloudType, gatewayTagCloudTypes).MergeTags(convertTagsMapToStringMap(mustMap(d.Get("tags"))))
+		tagsJSON, err := TagsMapToJson(tagsMap)
 		if err != nil {
 			return diag.Errorf("failed to convert tags to JSON: %s", err)
 		}
@@ -871,7 +880,7 @@ func resourceAviatrixSpokeInstanceUpdate(ctx context.Context, d *schema.Resource
 			GwName:    gwName,
 			VpcSize:   getString(d, "gw_size"),
 		}
-		err := client.UpdateGateway(gateway)
+		err := client.UpdateGatewayContext(ctx, gateway)
 		if err != nil {
 			return diag.Errorf("failed to update gw_size: %s", err)
 		}
@@ -937,14 +946,14 @@ func resourceAviatrixSpokeInstanceUpdate(ctx context.Context, d *schema.Resource
 
 		if getBool(d, "single_az_ha") {
 			singleAZGateway.SingleAZ = "yes"
-			log.Printf("[INFO] Enable Single AZ GW HA: %#v", singleAZGateway)
+			tflog.Info(ctx, fmt.Sprintf("Enable Single AZ GW HA: %#v", goaviatrix.Redact(singleAZGateway)))
 			err := client.EnableSingleAZGateway(singleAZGateway)
 			if err != nil {
 				return diag.Errorf("failed to enable single AZ GW HA: %s", err)
 			}
 		} else {
 			singleAZGateway.SingleAZ = "no"
-			log.Printf("[INFO] Disable Single AZ GW HA: %#v", singleAZGateway)
+			tflog.Info(ctx, fmt.Sprintf("Disable Single AZ GW HA: %#v", goaviatrix.Redact(singleAZGateway)))
 			err := client.DisableSingleAZGateway(singleAZGateway)
 			if err != nil {
 				return diag.Errorf("failed to disable single AZ GW HA: %s", err)
@@ -955,8 +964,8 @@ func resourceAviatrixSpokeInstanceUpdate(ctx context.Context, d *schema.Resource
 	// Encrypt Volume
 	if d.HasChange("enable_encrypt_volume") {
 		if getBool(d, "enable_encrypt_volume") {
-			if !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes) {
-				return diag.Errorf("'enable_encrypt_volume' is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) and AWS Secret (32768)")
+			if !featureEncryptVolume.supports(cloudType) {
+				return diag.FromErr(featureEncryptVolume.unsupportedError())
 			}
 			gwEncVolume := &goaviatrix.Gateway{
 				GwName:              gwName,
@@ -989,7 +998,7 @@ func updateEdgeSpokeInstance(ctx context.Context, d *schema.ResourceData, client
 	// Update interfaces and management egress IP prefix list
 	if d.HasChanges("interfaces", "management_egress_ip_prefix_list") {
 		interfaceList := getSet(d, "interfaces").List()
-		interfacesEncoded, err := getInterfaceDetails(interfaceList, cloudType)
+		interfacesEncoded, err := getInterfaceDetails(ctx, interfaceList, cloudType)
 		if err != nil {
 			return diag.Errorf("failed to get interface details: %v", err)
 		}
@@ -1014,7 +1023,7 @@ func resourceAviatrixSpokeInstanceDelete(ctx context.Context, d *schema.Resource
 	client := mustClient(meta)
 
 	gwName := d.Id()
-	log.Printf("[INFO] Deleting Spoke Instance: %s", gwName)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Spoke Instance: %s", gwName))
 
 	groupUUID := getString(d, "group_uuid")
 
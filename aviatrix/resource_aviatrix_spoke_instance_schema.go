@@ -78,6 +78,7 @@ func spokeInstanceOptionalBasicSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "A map of tags to assign to the spoke gateway.",
 		},
+		"tags_all": tagsAllSchema(),
 		"tunnel_detection_time": {
 			Type:         schema.TypeInt,
 			Optional:     true,
@@ -4,10 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,10 +17,10 @@ import (
 
 func resourceAviatrixSpokeTransitAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixSpokeTransitAttachmentCreate,
-		Read:   resourceAviatrixSpokeTransitAttachmentRead,
-		Update: resourceAviatrixSpokeTransitAttachmentUpdate,
-		Delete: resourceAviatrixSpokeTransitAttachmentDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixSpokeTransitAttachmentCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixSpokeTransitAttachmentRead),
+		UpdateWithoutTimeout: withContext(resourceAviatrixSpokeTransitAttachmentUpdate),
+		DeleteWithoutTimeout: withContext(resourceAviatrixSpokeTransitAttachmentDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -77,7 +77,7 @@ func resourceAviatrixSpokeTransitAttachment() *schema.Resource {
 				Description: "AS Path Prepend customized by specifying AS PATH for a BGP connection. Applies on spoke gateway.",
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: goaviatrix.ValidateASN,
+					ValidateFunc: ValidateASN,
 				},
 				MaxItems: 25,
 			},
@@ -87,7 +87,7 @@ func resourceAviatrixSpokeTransitAttachment() *schema.Resource {
 				Description: "AS Path Prepend customized by specifying AS PATH for a BGP connection. Applies on transit gateway.",
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: goaviatrix.ValidateASN,
+					ValidateFunc: ValidateASN,
 				},
 				MaxItems: 25,
 			},
@@ -139,7 +139,7 @@ func resourceAviatrixSpokeTransitAttachment() *schema.Resource {
 // primary gateway name from the group's details. If name is not a group, it
 // verifies a gateway with that name exists and returns name as-is. Returns
 // an error if neither a matching group nor a gateway is found.
-func resolveGatewayName(ctx context.Context, client *goaviatrix.Client, name string) (string, error) {
+func resolveGatewayName(ctx context.Context, client goaviatrix.GatewayClient, name string) (string, error) {
 	group, err := client.GetGatewayGroupByName(ctx, name)
 	if err == nil {
 		if group.PrimaryGatewayName == "" {
@@ -218,8 +218,7 @@ func resourceAviatrixSpokeTransitAttachmentCustomizeDiff(ctx context.Context, d
  * Returns true if the attachment exists.
  */
 func recoverSpokeTransitAttachment(ctx context.Context, client *goaviatrix.Client, attachment *goaviatrix.SpokeTransitAttachment) bool {
-	log.Printf("[WARN] Timeout/connection error attaching spoke %s to transit %s, checking if attachment was created",
-		attachment.SpokeGwName, attachment.TransitGwName)
+	tflog.Warn(ctx, fmt.Sprintf("Timeout/connection error attaching spoke %s to transit %s, checking if attachment was created", attachment.SpokeGwName, attachment.TransitGwName))
 	checkAttachment := &goaviatrix.SpokeTransitAttachment{
 		SpokeGwName:   attachment.SpokeGwName,
 		TransitGwName: attachment.TransitGwName,
@@ -230,34 +229,30 @@ func recoverSpokeTransitAttachment(ctx context.Context, client *goaviatrix.Clien
 	for checkTries < checkMaxTries {
 		checkTries++
 		if _, readErr := client.GetSpokeTransitAttachmentContext(ctx, checkAttachment); readErr == nil {
-			log.Printf("[INFO] Spoke %s is already attached to transit %s despite timeout, proceeding",
-				attachment.SpokeGwName, attachment.TransitGwName)
+			tflog.Info(ctx, fmt.Sprintf("Spoke %s is already attached to transit %s despite timeout, proceeding", attachment.SpokeGwName, attachment.TransitGwName))
 			return true
 		}
-		log.Printf("[INFO] Attachment not found yet (attempt %d/%d), waiting %v before checking again",
-			checkTries, checkMaxTries, checkBackoff)
+		tflog.Info(ctx, fmt.Sprintf("Attachment not found yet (attempt %d/%d), waiting %v before checking again", checkTries, checkMaxTries, checkBackoff))
 		select {
 		case <-ctx.Done():
-			log.Printf("[WARN] Context cancelled while waiting for attachment recovery")
+			tflog.Warn(ctx, "Context cancelled while waiting for attachment recovery")
 			return false
 		case <-time.After(checkBackoff):
 		}
 	}
 	// Final check after polling
 	if _, readErr := client.GetSpokeTransitAttachmentContext(ctx, checkAttachment); readErr == nil {
-		log.Printf("[INFO] Spoke %s is already attached to transit %s despite timeout, proceeding",
-			attachment.SpokeGwName, attachment.TransitGwName)
+		tflog.Info(ctx, fmt.Sprintf("Spoke %s is already attached to transit %s despite timeout, proceeding", attachment.SpokeGwName, attachment.TransitGwName))
 		return true
 	}
 	return false
 }
 
-func resourceAviatrixSpokeTransitAttachmentCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixSpokeTransitAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	attachment := marshalSpokeTransitAttachmentInput(d)
 
-	ctx := context.Background()
 	resolvedSpokeGwName, err := resolveGatewayName(ctx, client, attachment.SpokeGwName)
 	if err != nil {
 		return fmt.Errorf("could not resolve spoke_gw_name %q: %w", attachment.SpokeGwName, err)
@@ -303,7 +298,7 @@ func resourceAviatrixSpokeTransitAttachmentCreate(d *schema.ResourceData, meta a
 
 	d.SetId(resolvedSpokeGwName + "~" + resolvedTransitGwName)
 	flag := false
-	defer func() { _ = resourceAviatrixSpokeTransitAttachmentReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixSpokeTransitAttachmentReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	timeout := 15 * time.Minute
 	attachCtx, cancel := context.WithTimeout(context.Background(), timeout)
@@ -361,18 +356,18 @@ func resourceAviatrixSpokeTransitAttachmentCreate(d *schema.ResourceData, meta a
 		}
 	}
 
-	return resourceAviatrixSpokeTransitAttachmentReadIfRequired(d, meta, &flag)
+	return resourceAviatrixSpokeTransitAttachmentReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixSpokeTransitAttachmentReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixSpokeTransitAttachmentReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixSpokeTransitAttachmentRead(d, meta)
+		return resourceAviatrixSpokeTransitAttachmentRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixSpokeTransitAttachmentRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixSpokeTransitAttachmentRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	spokeGwName := getString(d, "spoke_gw_name")
@@ -380,7 +375,7 @@ func resourceAviatrixSpokeTransitAttachmentRead(d *schema.ResourceData, meta any
 	isImport := spokeGwName == "" || transitGwName == ""
 	if isImport {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no spoke_gw_name or transit_gw_name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no spoke_gw_name or transit_gw_name received. Import Id is %s", id))
 		d.SetId(id)
 		parts := strings.Split(id, "~")
 		if len(parts) != 2 {
@@ -392,7 +387,6 @@ func resourceAviatrixSpokeTransitAttachmentRead(d *schema.ResourceData, meta any
 		mustSet(d, "transit_gw_name", transitGwName)
 	}
 
-	ctx := context.Background()
 	resolvedSpokeGwName, err := resolveGatewayName(ctx, client, spokeGwName)
 	if err != nil {
 		return fmt.Errorf("could not resolve spoke_gw_name %q: %w", spokeGwName, err)
@@ -500,7 +494,7 @@ func resourceAviatrixSpokeTransitAttachmentRead(d *schema.ResourceData, meta any
 	return nil
 }
 
-func resourceAviatrixSpokeTransitAttachmentUpdate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixSpokeTransitAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	if !getBool(d, "spoke_bgp_enabled") && d.HasChanges("spoke_prepend_as_path", "transit_prepend_as_path") {
@@ -509,7 +503,6 @@ func resourceAviatrixSpokeTransitAttachmentUpdate(d *schema.ResourceData, meta a
 
 	d.Partial(true)
 
-	ctx := context.Background()
 	spokeGwName, err := resolveGatewayName(ctx, client, getString(d, "spoke_gw_name"))
 	if err != nil {
 		return fmt.Errorf("could not resolve spoke_gw_name: %w", err)
@@ -588,13 +581,12 @@ func resourceAviatrixSpokeTransitAttachmentUpdate(d *schema.ResourceData, meta a
 
 	d.Partial(false)
 	d.SetId(spokeGwName + "~" + transitGwName)
-	return resourceAviatrixSpokeTransitAttachmentRead(d, meta)
+	return resourceAviatrixSpokeTransitAttachmentRead(ctx, d, meta)
 }
 
-func resourceAviatrixSpokeTransitAttachmentDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixSpokeTransitAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
-	ctx := context.Background()
 	resolvedSpokeGwName, err := resolveGatewayName(ctx, client, getString(d, "spoke_gw_name"))
 	if err != nil {
 		return fmt.Errorf("could not resolve spoke_gw_name: %w", err)
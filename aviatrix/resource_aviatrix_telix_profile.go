@@ -49,6 +49,9 @@ func resourceAviatrixTelixProfile() *schema.Resource {
 		UpdateWithoutTimeout: resourceAviatrixTelixProfileUpdate,
 		DeleteWithoutTimeout: resourceAviatrixTelixProfileDelete,
 		CustomizeDiff:        validateTelixProfileFilterSources,
+		Importer: &schema.ResourceImporter{
+			StateContext: importByIDOrName("Telix profile", listTelixProfiles),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"display_name": {
@@ -133,7 +136,7 @@ func resourceAviatrixTelixProfile() *schema.Resource {
 													Type:         schema.TypeString,
 													Optional:     true,
 													Sensitive:    true,
-													ValidateFunc: goaviatrix.ValidateCertificates,
+													ValidateFunc: ValidateCertificates,
 													Description:  "PEM-encoded CA certificate used to validate the remote endpoint. Write-only: the controller never returns this value on read. External rotation will not be detected until the value is changed in configuration. See resource documentation.",
 												},
 												"client_certificate_pem": {
@@ -722,6 +725,18 @@ func derefString(s *string) string {
 	return *s
 }
 
+func listTelixProfiles(ctx context.Context, meta any) ([]namedObject, error) {
+	list, err := mustClient(meta).ListTelixProfiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	objects := make([]namedObject, 0, len(list.Profiles))
+	for _, profile := range list.Profiles {
+		objects = append(objects, namedObject{id: profile.ProfileID, name: profile.DisplayName})
+	}
+	return objects, nil
+}
+
 func resourceAviatrixTelixProfileCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := mustClient(meta)
 
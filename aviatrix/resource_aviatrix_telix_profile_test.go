@@ -13,8 +13,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix/controllertest"
 )
 
 const (
@@ -674,6 +677,41 @@ func TestFlattenTelixProfileDetail_NonSensitiveDriftDetected(t *testing.T) {
 	}
 }
 
+// TestTelixProfileImport verifies that a profile can be imported by its
+// display name as well as by its ID, and that the following Read fills the
+// state from the Controller.
+func TestTelixProfileImport(t *testing.T) {
+	s := controllertest.NewServer()
+	t.Cleanup(s.Close)
+	client, err := goaviatrix.NewClient(s.Username, s.Password, s.Host(), s.Client(), nil)
+	require.NoError(t, err)
+	ctx := context.Background()
+	profileID, err := client.CreateTelixProfile(ctx, &goaviatrix.TelixProfileCreateRequest{
+		DisplayName: "dcf-logs",
+		Sources:     []string{goaviatrix.TelixTelemetrySourceDcfLogs},
+		Destination: goaviatrix.TelixDestinationInput{Otlp: goaviatrix.TelixOtlpDestinationInput{
+			Endpoint: "otel-collector.example.com:4317",
+			Protocol: goaviatrix.TelixOtlpProtocolGRPC,
+		}},
+		Scope: goaviatrix.TelixGatewayScope{AllGateways: &goaviatrix.TelixAllGatewaysScope{}},
+	})
+	require.NoError(t, err)
+
+	r := resourceAviatrixTelixProfile()
+	for _, importID := range []string{"dcf-logs", profileID} {
+		d := r.Data(&terraform.InstanceState{ID: importID})
+		imported, err := r.Importer.StateContext(ctx, d, client)
+		require.NoError(t, err)
+		require.Len(t, imported, 1)
+		assert.Equal(t, profileID, imported[0].Id())
+
+		diags := r.ReadWithoutTimeout(ctx, imported[0], client)
+		require.False(t, diags.HasError(), "%v", diags)
+		assert.Equal(t, "dcf-logs", imported[0].Get("display_name"))
+		assert.Equal(t, "otel-collector.example.com:4317", imported[0].Get(telixPathOtlpEndpoint))
+	}
+}
+
 // TestAccAviatrixTelixProfile_lifecycle exercises create, in-place update,
 // non-sensitive TLS field toggle, and destroy for an aviatrix_telix_profile
 // resource. The test framework's implicit "no changes after apply" check
@@ -735,10 +773,32 @@ func TestAccAviatrixTelixProfile_lifecycle(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "destination.0.otlp.0.tls.0.has_client_private_key", "true"),
 				),
 			},
+			// Step 4: Import by ID and by display name. The Controller redacts
+			// headers and TLS material, so they are not in the imported state.
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: testTelixImportStateVerifyIgnore,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           "test-telix-profile-renamed",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: testTelixImportStateVerifyIgnore,
+			},
 		},
 	})
 }
 
+var testTelixImportStateVerifyIgnore = []string{
+	telixPathOtlpHeaders,
+	telixPathTLSCA,
+	telixPathTLSClientCertificatePEM,
+	telixPathTLSClientPrivateKeyPEM,
+}
+
 func testAccTelixProfileWithTLS(displayName string, insecure bool) string {
 	return fmt.Sprintf(`
 resource "aviatrix_telix_profile" "test" {
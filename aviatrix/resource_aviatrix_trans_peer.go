@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -13,9 +14,9 @@ import (
 
 func resourceAviatrixTransPeer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixTransPeerCreate,
-		Read:   resourceAviatrixTransPeerRead,
-		Delete: resourceAviatrixTransPeerDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixTransPeerCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixTransPeerRead),
+		DeleteWithoutTimeout: withContext(resourceAviatrixTransPeerDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -43,7 +44,7 @@ func resourceAviatrixTransPeer() *schema.Resource {
 	}
 }
 
-func resourceAviatrixTransPeerCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixTransPeerCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	transPeer := &goaviatrix.TransPeer{
@@ -52,29 +53,29 @@ func resourceAviatrixTransPeerCreate(d *schema.ResourceData, meta any) error {
 		ReachableCidr: getString(d, "reachable_cidr"),
 	}
 
-	log.Printf("[INFO] Creating Aviatrix transitive peering: %#v", transPeer)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix transitive peering: %#v", goaviatrix.Redact(transPeer)))
 
 	d.SetId(transPeer.Source + "~" + transPeer.Nexthop + "~" + transPeer.ReachableCidr)
 	flag := false
-	defer func() { _ = resourceAviatrixTransPeerReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixTransPeerReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	err := client.CreateTransPeer(transPeer)
 	if err != nil {
 		return fmt.Errorf("failed to create Aviatrix Transitive peering: %w", err)
 	}
 
-	return resourceAviatrixTransPeerReadIfRequired(d, meta, &flag)
+	return resourceAviatrixTransPeerReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixTransPeerReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixTransPeerReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixTransPeerRead(d, meta)
+		return resourceAviatrixTransPeerRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixTransPeerRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixTransPeerRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	sourceGw := getString(d, "source")
@@ -83,8 +84,8 @@ func resourceAviatrixTransPeerRead(d *schema.ResourceData, meta any) error {
 
 	if sourceGw == "" || nestHopGw == "" || reachableCIDR == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no transit gateway names or reachable cidr received. "+
-			"Import Id is %s", id)
+		tflog.Info(ctx, fmt.Sprintf("[DEBUG] Looks like an import, no transit gateway names or reachable cidr received. "+
+			"Import Id is %s", id))
 		mustSet(d, "source", strings.Split(id, "~")[0])
 		mustSet(d, "nexthop", strings.Split(id, "~")[1])
 		mustSet(d, "reachable_cidr", strings.Split(id, "~")[2])
@@ -111,7 +112,7 @@ func resourceAviatrixTransPeerRead(d *schema.ResourceData, meta any) error {
 	return nil
 }
 
-func resourceAviatrixTransPeerDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixTransPeerDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 	transPeer := &goaviatrix.TransPeer{
 		Source:        getString(d, "source"),
@@ -119,7 +120,7 @@ func resourceAviatrixTransPeerDelete(d *schema.ResourceData, meta any) error {
 		ReachableCidr: getString(d, "reachable_cidr"),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix transpeer: %#v", transPeer)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix transpeer: %#v", goaviatrix.Redact(transPeer)))
 
 	err := client.DeleteTransPeer(transPeer)
 	if err != nil {
@@ -1,14 +1,15 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"net"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -30,10 +31,10 @@ var defaultBfdConfig = goaviatrix.BgpBfdConfig{
 
 func resourceAviatrixTransitExternalDeviceConn() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixTransitExternalDeviceConnCreate,
-		Read:   resourceAviatrixTransitExternalDeviceConnRead,
-		Update: resourceAviatrixTransitExternalDeviceConnUpdate,
-		Delete: resourceAviatrixTransitExternalDeviceConnDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixTransitExternalDeviceConnCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixTransitExternalDeviceConnRead),
+		UpdateWithoutTimeout: withContext(resourceAviatrixTransitExternalDeviceConnUpdate),
+		DeleteWithoutTimeout: withContext(resourceAviatrixTransitExternalDeviceConnDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -111,14 +112,14 @@ func resourceAviatrixTransitExternalDeviceConn() *schema.Resource {
 				Optional:     true,
 				ForceNew:     true,
 				Description:  "BGP local ASN (Autonomous System Number). Integer between 1-4294967294.",
-				ValidateFunc: goaviatrix.ValidateASN,
+				ValidateFunc: ValidateASN,
 			},
 			"bgp_remote_as_num": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
 				Description:  "BGP remote ASN (Autonomous System Number). Integer between 1-4294967294.",
-				ValidateFunc: goaviatrix.ValidateASN,
+				ValidateFunc: ValidateASN,
 			},
 			"remote_subnet": {
 				Type:             schema.TypeString,
@@ -255,7 +256,7 @@ func resourceAviatrixTransitExternalDeviceConn() *schema.Resource {
 				Default:      "",
 				ForceNew:     true,
 				Description:  "Backup BGP remote ASN (Autonomous System Number). Integer between 1-4294967294.",
-				ValidateFunc: goaviatrix.ValidateASN,
+				ValidateFunc: ValidateASN,
 			},
 			"backup_pre_shared_key": {
 				Type:        schema.TypeString,
@@ -366,9 +367,9 @@ func resourceAviatrixTransitExternalDeviceConn() *schema.Resource {
 				Optional: true,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: goaviatrix.StringCanBeEmptyButCannotBeWhiteSpace,
+					ValidateFunc: StringCanBeEmptyButCannotBeWhiteSpace,
 				},
-				DiffSuppressFunc: goaviatrix.TransitExternalDeviceConnPh1RemoteIdDiffSuppressFunc,
+				DiffSuppressFunc: TransitExternalDeviceConnPh1RemoteIdDiffSuppressFunc,
 				Description:      "List of phase 1 remote identifier of the IPsec tunnel. This can be configured as a list of any string, including empty string.",
 			},
 			"prepend_as_path": {
@@ -377,7 +378,7 @@ func resourceAviatrixTransitExternalDeviceConn() *schema.Resource {
 				Description: "Connection AS Path Prepend customized by specifying AS PATH for a BGP connection.",
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: goaviatrix.ValidateASN,
+					ValidateFunc: ValidateASN,
 				},
 				MaxItems: 25,
 			},
@@ -431,7 +432,7 @@ func resourceAviatrixTransitExternalDeviceConn() *schema.Resource {
 				Optional:         true,
 				Description:      "BGP BFD configuration details applied to a BGP session.",
 				MaxItems:         1,
-				DiffSuppressFunc: goaviatrix.ExternalDeviceConnBgpBfdDiffSuppressFunc,
+				DiffSuppressFunc: ExternalDeviceConnBgpBfdDiffSuppressFunc,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"transmit_interval": {
@@ -540,7 +541,7 @@ func resourceAviatrixTransitExternalDeviceConn() *schema.Resource {
 	}
 }
 
-func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixTransitExternalDeviceConnCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	var bgpSendCommunities *goaviatrix.BgpSendCommunities
@@ -906,7 +907,7 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 	}
 
 	flag := false
-	defer func() { _ = resourceAviatrixTransitExternalDeviceConnReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixTransitExternalDeviceConnReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	var edgeExternalDeviceConn goaviatrix.EdgeExternalDeviceConn
 	if externalDeviceConn.EnableEdgeUnderlay {
@@ -919,7 +920,7 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 		try++
 		if externalDeviceConn.EnableEdgeUnderlay {
 			connName, err = client.CreateEdgeExternalDeviceConn(&edgeExternalDeviceConn)
-			log.Printf("[DEBUG] Created underlay connection %s", connName)
+			tflog.Debug(ctx, fmt.Sprintf("Created underlay connection %s", connName))
 
 		} else {
 			err = client.CreateExternalDeviceConn(externalDeviceConn)
@@ -1065,24 +1066,24 @@ func resourceAviatrixTransitExternalDeviceConnCreate(d *schema.ResourceData, met
 		}
 	}
 
-	return resourceAviatrixTransitExternalDeviceConnReadIfRequired(d, meta, &flag)
+	return resourceAviatrixTransitExternalDeviceConnReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixTransitExternalDeviceConnReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixTransitExternalDeviceConnReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixTransitExternalDeviceConnRead(d, meta)
+		return resourceAviatrixTransitExternalDeviceConnRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixTransitExternalDeviceConnRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixTransitExternalDeviceConnRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	connectionName := getString(d, "connection_name")
 	vpcID := getString(d, "vpc_id")
 	if connectionName == "" || vpcID == "" {
-		if err := handleExternalDeviceConnImport(d, client); err != nil {
+		if err := handleExternalDeviceConnImport(ctx, d, client); err != nil {
 			return err
 		}
 		// handleExternalDeviceConnImport clears the ID when the connection no
@@ -1104,7 +1105,7 @@ func resourceAviatrixTransitExternalDeviceConnRead(d *schema.ResourceData, meta
 	}
 
 	conn, err := client.GetExternalDeviceConnDetail(externalDeviceConn, localGateway, getBool(d, "ha_enabled"))
-	log.Printf("[TRACE] Reading Aviatrix external device conn: %s : %#v", getString(d, "connection_name"), externalDeviceConn)
+	tflog.Trace(ctx, fmt.Sprintf("Reading Aviatrix external device conn: %s : %#v", getString(d, "connection_name"), goaviatrix.Redact(externalDeviceConn)))
 
 	if err != nil {
 		if errors.Is(err, goaviatrix.ErrNotFound) {
@@ -1333,7 +1334,7 @@ func resourceAviatrixTransitExternalDeviceConnRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixTransitExternalDeviceConnUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 	d.Partial(true)
 
@@ -1700,10 +1701,10 @@ func resourceAviatrixTransitExternalDeviceConnUpdate(d *schema.ResourceData, met
 
 	d.Partial(false)
 
-	return resourceAviatrixTransitExternalDeviceConnRead(d, meta)
+	return resourceAviatrixTransitExternalDeviceConnRead(ctx, d, meta)
 }
 
-func resourceAviatrixTransitExternalDeviceConnDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixTransitExternalDeviceConnDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	externalDeviceConn := &goaviatrix.ExternalDeviceConn{
@@ -1712,7 +1713,7 @@ func resourceAviatrixTransitExternalDeviceConnDelete(d *schema.ResourceData, met
 		EnableEdgeUnderlay: getBool(d, "enable_edge_underlay"),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix external device connection: %#v", externalDeviceConn)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix external device connection: %#v", goaviatrix.Redact(externalDeviceConn)))
 	if externalDeviceConn.EnableEdgeUnderlay {
 		edgeExternalDeviceConn := goaviatrix.EdgeExternalDeviceConn(*externalDeviceConn)
 		edgeExternalDeviceConn.LocalLanIP = getString(d, "local_lan_ip")
@@ -1757,9 +1758,9 @@ func parseExternalDeviceConnImportID(id string) (connectionName, vpcID string, e
 // When the connection no longer exists it clears the ID (d.SetId("")) and
 // returns a nil error, so callers should check d.Id() afterwards and return
 // early when it has been cleared.
-func handleExternalDeviceConnImport(d *schema.ResourceData, client *goaviatrix.Client) error {
+func handleExternalDeviceConnImport(ctx context.Context, d *schema.ResourceData, client *goaviatrix.Client) error {
 	id := d.Id()
-	log.Printf("[DEBUG] Looks like an import, no 'connection_name' or 'vpc_id' received. Import Id is %s", id)
+	tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no 'connection_name' or 'vpc_id' received. Import Id is %s", id))
 	connectionName, vpcID, err := parseExternalDeviceConnImportID(id)
 	if err != nil {
 		return err
@@ -1,6 +1,7 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -865,7 +866,7 @@ func TestHandleExternalDeviceConnImport(t *testing.T) {
 		d := schema.TestResourceDataRaw(t, externalDeviceConnTestSchema(), map[string]any{})
 		d.SetId("no-separator")
 
-		err := handleExternalDeviceConnImport(d, nil)
+		err := handleExternalDeviceConnImport(context.Background(), d, nil)
 
 		assert.Error(t, err)
 	})
@@ -878,7 +879,7 @@ func TestHandleExternalDeviceConnImport(t *testing.T) {
 
 		// gw_name is already known, so the client probe is skipped and nil is
 		// safe.
-		err := handleExternalDeviceConnImport(d, nil)
+		err := handleExternalDeviceConnImport(context.Background(), d, nil)
 
 		assert.NoError(t, err)
 		assert.Equal(t, "conn-1", getString(d, "connection_name"))
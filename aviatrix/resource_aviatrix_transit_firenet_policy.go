@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -13,9 +14,9 @@ import (
 
 func resourceAviatrixTransitFireNetPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixTransitFireNetPolicyCreate,
-		Read:   resourceAviatrixTransitFireNetPolicyRead,
-		Delete: resourceAviatrixTransitFireNetPolicyDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixTransitFireNetPolicyCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixTransitFireNetPolicyRead),
+		DeleteWithoutTimeout: withContext(resourceAviatrixTransitFireNetPolicyDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -37,7 +38,7 @@ func resourceAviatrixTransitFireNetPolicy() *schema.Resource {
 	}
 }
 
-func resourceAviatrixTransitFireNetPolicyCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixTransitFireNetPolicyCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	transitFireNetPolicy := &goaviatrix.TransitFireNetPolicy{
@@ -45,29 +46,29 @@ func resourceAviatrixTransitFireNetPolicyCreate(d *schema.ResourceData, meta any
 		InspectedResourceName:     getString(d, "inspected_resource_name"),
 	}
 
-	log.Printf("[INFO] Creating Aviatrix transit firenet policy: %#v", transitFireNetPolicy)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix transit firenet policy: %#v", goaviatrix.Redact(transitFireNetPolicy)))
 
 	d.SetId(transitFireNetPolicy.TransitFireNetGatewayName + "~" + transitFireNetPolicy.InspectedResourceName)
 	flag := false
-	defer func() { _ = resourceAviatrixTransitFireNetPolicyReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixTransitFireNetPolicyReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	err := client.CreateTransitFireNetPolicy(transitFireNetPolicy)
 	if err != nil {
 		return fmt.Errorf("failed to create Aviatrix transit firenet Policy: %w", err)
 	}
 
-	return resourceAviatrixTransitFireNetPolicyReadIfRequired(d, meta, &flag)
+	return resourceAviatrixTransitFireNetPolicyReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixTransitFireNetPolicyReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixTransitFireNetPolicyReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixTransitFireNetPolicyRead(d, meta)
+		return resourceAviatrixTransitFireNetPolicyRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixTransitFireNetPolicyRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixTransitFireNetPolicyRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	transitFireNetGatewayName := getString(d, "transit_firenet_gateway_name")
@@ -75,7 +76,7 @@ func resourceAviatrixTransitFireNetPolicyRead(d *schema.ResourceData, meta any)
 
 	if transitFireNetGatewayName == "" || inspectedResourceName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no transit firenet name or inspected resource name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no transit firenet name or inspected resource name received. Import Id is %s", id))
 		// Split only on the first "~" since "inspected_resource_name" can itself contain "~~"
 		// (e.g. "SPOKE_SUBNET_GROUP:<gw_name>~~<subnet_group_name>"), and a naive Split on every
 		// "~" would truncate/mangle the inspected resource name and cause import to fail with
@@ -107,7 +108,7 @@ func resourceAviatrixTransitFireNetPolicyRead(d *schema.ResourceData, meta any)
 	return nil
 }
 
-func resourceAviatrixTransitFireNetPolicyDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixTransitFireNetPolicyDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	transitFireNetPolicy := &goaviatrix.TransitFireNetPolicy{
@@ -115,7 +116,7 @@ func resourceAviatrixTransitFireNetPolicyDelete(d *schema.ResourceData, meta any
 		InspectedResourceName:     getString(d, "inspected_resource_name"),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix transit firenet policy %#v", transitFireNetPolicy)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix transit firenet policy %#v", goaviatrix.Redact(transitFireNetPolicy)))
 
 	err := client.DeleteTransitFireNetPolicy(transitFireNetPolicy)
 	if err != nil {
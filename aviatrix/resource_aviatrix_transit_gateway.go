@@ -7,7 +7,6 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
-	"log"
 	"os"
 	"regexp"
 	"sort"
@@ -15,6 +14,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -35,15 +36,21 @@ var typeMapping = map[string]string{
 
 func resourceAviatrixTransitGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixTransitGatewayCreate,
-		Read:   resourceAviatrixTransitGatewayRead,
-		Update: resourceAviatrixTransitGatewayUpdate,
-		Delete: resourceAviatrixTransitGatewayDelete,
+		CreateContext:      withContext(resourceAviatrixTransitGatewayCreate),
+		ReadWithoutTimeout: withContext(resourceAviatrixTransitGatewayRead),
+		UpdateContext:      withContext(resourceAviatrixTransitGatewayUpdate),
+		DeleteContext:      withContext(resourceAviatrixTransitGatewayDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
 
-		CustomizeDiff: resourceAviatrixTransitGatewayCustomizeDiff,
+		Timeouts: gatewayTimeouts(),
+
+		CustomizeDiff: customdiff.All(
+			resourceAviatrixTransitGatewayCustomizeDiff,
+			customizeDiffGatewayCapabilities(transitGatewayPlanChecks),
+			customizeDiffTagsAll(gatewayTagCloudTypes),
+		),
 
 		SchemaVersion: 1,
 		MigrateState:  resourceAviatrixTransitGatewayMigrateState,
@@ -388,7 +395,7 @@ func resourceAviatrixTransitGateway() *schema.Resource {
 				Description:  "List of AS numbers to populate BGP AP_PATH field when it advertises to VGW or peer devices.",
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: goaviatrix.ValidateASN,
+					ValidateFunc: ValidateASN,
 				},
 			},
 			"bgp_ecmp": {
@@ -558,6 +565,7 @@ func resourceAviatrixTransitGateway() *schema.Resource {
 				Optional:    true,
 				Description: "A map of tags to assign to the transit gateway.",
 			},
+			"tags_all": tagsAllSchema(),
 			"enable_spot_instance": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -655,7 +663,7 @@ func resourceAviatrixTransitGateway() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				Description:  "Changes the Aviatrix Transit Gateway ASN number before you setup Aviatrix Transit Gateway connection configurations.",
-				ValidateFunc: goaviatrix.ValidateASN,
+				ValidateFunc: ValidateASN,
 			},
 			"tunnel_detection_time": {
 				Type:         schema.TypeInt,
@@ -1045,8 +1053,8 @@ func resourceAviatrixTransitGatewayCustomizeDiff(_ context.Context, d *schema.Re
 	return nil
 }
 
-func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixTransitGatewayCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustGatewayClient(meta)
 
 	cloudType := getInt(d, "cloud_type")
 	flag := false
@@ -1056,11 +1064,11 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta any) erro
 	}
 	// create edge transit gateway for AEP & Equinix
 	if goaviatrix.IsCloudType(cloudType, goaviatrix.EdgeRelatedCloudTypes) {
-		err := createEdgeTransitGateway(d, client, cloudType)
+		err := createEdgeTransitGateway(ctx, d, client, cloudType)
 		if err != nil {
 			return err
 		}
-		defer func() { _ = resourceAviatrixTransitGatewayReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+		defer func() { _ = resourceAviatrixTransitGatewayReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 	} else {
 		gateway := &goaviatrix.TransitVpc{
 			CloudType:                getInt(d, "cloud_type"),
@@ -1208,8 +1216,8 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta any) erro
 
 		enableEncryptVolume := getBool(d, "enable_encrypt_volume")
 		customerManagedKeys := getString(d, "customer_managed_keys")
-		if enableEncryptVolume && !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes) {
-			return fmt.Errorf("'enable_encrypt_volume' is only supported for AWS (1), AWSGov (256), AWSChina (1024), AWS Top Secret (16384) or AWS Secret (32768) providers")
+		if enableEncryptVolume && !featureEncryptVolume.supports(cloudType) {
+			return featureEncryptVolume.unsupportedError()
 		}
 		if customerManagedKeys != "" {
 			if !enableEncryptVolume {
@@ -1254,8 +1262,8 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta any) erro
 		if enableGatewayLoadBalancer && !enableFireNet && !enableTransitFireNet {
 			return fmt.Errorf("'enable_gateway_load_balancer' is only valid when 'enable_firenet' or 'enable_transit_firenet' is set to true")
 		}
-		if enableGatewayLoadBalancer && !goaviatrix.IsCloudType(cloudType, goaviatrix.AWS) {
-			return fmt.Errorf("'enable_gateway_load_balancer' is only supported by AWS (1)")
+		if enableGatewayLoadBalancer && !featureGatewayLoadBalancer.supports(cloudType) {
+			return featureGatewayLoadBalancer.unsupportedError()
 		}
 		enableEgressTransitFireNet := getBool(d, "enable_egress_transit_firenet")
 		if enableEgressTransitFireNet && !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.OCIRelatedCloudTypes) {
@@ -1289,16 +1297,16 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta any) erro
 			excludedInstances = append(excludedInstances, mustString(v))
 		}
 		// Enable monitor gateway subnets does not work with AWSChina
-		if enableMonitorSubnets && !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes^goaviatrix.AWSChina) {
-			return fmt.Errorf("'enable_monitor_gateway_subnets' is only valid for AWS (1), AWSGov (256), AWS Top Secret (16384) or AWS Secret (32768)")
+		if enableMonitorSubnets && !featureMonitorGatewaySubnets.supports(cloudType) {
+			return featureMonitorGatewaySubnets.unsupportedError()
 		}
 		if !enableMonitorSubnets && len(excludedInstances) != 0 {
 			return fmt.Errorf("'monitor_exclude_list' must be empty if 'enable_monitor_gateway_subnets' is false")
 		}
 
 		bgpOverLan := getBool(d, "enable_bgp_over_lan")
-		if bgpOverLan && !(goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.GCP)) {
-			return fmt.Errorf("'enable_bgp_over_lan' is only valid for GCP (4), Azure (8), AzureGov (32) or AzureChina (2048)")
+		if bgpOverLan && !featureTransitBgpOverLan.supports(cloudType) {
+			return featureTransitBgpOverLan.unsupportedError()
 		}
 		bgpLanInterfacesCount, isCountSet := d.GetOk("bgp_lan_interfaces_count")
 		if isCountSet && (!bgpOverLan || !goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes)) {
@@ -1352,8 +1360,8 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta any) erro
 		haOobAvailabilityZone := getString(d, "ha_oob_availability_zone")
 
 		if enablePrivateOob {
-			if !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes) {
-				return fmt.Errorf("'enable_private_oob' is only valid for AWS (1), AWSGov (256) AWSChina (1024),, AWS Top Secret (16384) or AWS Secret (32768)")
+			if !featurePrivateOob.supports(cloudType) {
+				return featurePrivateOob.unsupportedError()
 			}
 
 			if oobAvailabilityZone == "" {
@@ -1411,11 +1419,12 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta any) erro
 		}
 
 		_, tagsOk := d.GetOk("tags")
-		if tagsOk {
+		defaultTags := defaultTagsFor(client.GetDefaultTagsConfig(), cloudType, gatewayTagCloudTypes)
+		if tagsOk || defaultTags != nil {
 			if !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
 				return errors.New("error creating transit gateway: adding tags is only supported for AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
 			}
-			tagsMap, err := extractTags(d, gateway.CloudType)
+			tagsMap, err := extractTags(d, gateway.CloudType, defaultTags)
 			if err != nil {
 				return fmt.Errorf("error creating tags for transit gateway: %w", err)
 			}
@@ -1439,8 +1448,8 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta any) erro
 		spotPrice := getString(d, "spot_price")
 		deleteSpot := getBool(d, "delete_spot")
 		if enableSpotInstance {
-			if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-				return fmt.Errorf("enable_spot_instance only supports AWS and Azure related cloud types")
+			if !featureSpotInstance.supports(gateway.CloudType) {
+				return featureSpotInstance.unsupportedError()
 			}
 
 			if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && deleteSpot {
@@ -1455,8 +1464,8 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta any) erro
 		}
 
 		rxQueueSize := getString(d, "rx_queue_size")
-		if rxQueueSize != "" && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-			return fmt.Errorf("rx_queue_size only supports AWS related cloud types")
+		if rxQueueSize != "" && !featureRxQueueSize.supports(gateway.CloudType) {
+			return featureRxQueueSize.unsupportedError()
 		}
 
 		if !enablePrivateOob {
@@ -1508,11 +1517,11 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta any) erro
 
 		}
 
-		log.Printf("[INFO] Creating Aviatrix Transit Gateway: %#v", gateway)
+		tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix Transit Gateway: %#v", goaviatrix.Redact(gateway)))
 
 		d.SetId(gateway.GwName)
-		defer func() { _ = resourceAviatrixTransitGatewayReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
-		err := client.LaunchTransitVpc(gateway)
+		defer func() { _ = resourceAviatrixTransitGatewayReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
+		err := client.LaunchTransitVpcContext(ctx, gateway)
 		if err != nil {
 			return fmt.Errorf("failed to create Aviatrix Transit Gateway: %w", err)
 		}
@@ -1523,7 +1532,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta any) erro
 				SingleAZ: "no",
 			}
 
-			log.Printf("[INFO] Disable Single AZ GW HA: %#v", singleAZGateway)
+			tflog.Info(ctx, fmt.Sprintf("Disable Single AZ GW HA: %#v", goaviatrix.Redact(singleAZGateway)))
 
 			err := client.DisableSingleAZGateway(singleAZGateway)
 			if err != nil {
@@ -1571,7 +1580,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta any) erro
 			// Enable HA
 			transitHaGw := &goaviatrix.TransitHaGateway{
 				PrimaryGwName: getString(d, "gw_name"),
-				GwName:        getString(d, "gw_name") + "-hagw",
+				GwName:        goaviatrix.HaGatewayName(getString(d, "gw_name")),
 				Subnet:        haSubnet,
 				Zone:          haZone,
 				Eip:           getString(d, "ha_eip"),
@@ -1639,15 +1648,15 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta any) erro
 				transitHaGw.BgpLanSubnet = strings.Join(haBgpLanSpecifySubnet, ",")
 			}
 
-			log.Printf("[INFO] Enabling HA on Transit Gateway: %#v", haSubnet)
+			tflog.Info(ctx, fmt.Sprintf("Enabling HA on Transit Gateway: %#v", haSubnet))
 
-			_, err := client.CreateTransitHaGw(transitHaGw)
+			_, err := client.CreateTransitHaGwContext(ctx, transitHaGw)
 			if err != nil {
 				return fmt.Errorf("failed to enable HA Aviatrix Transit Gateway: %w", err)
 			}
 
 			// Resize HA Gateway
-			log.Printf("[INFO]Resizing Transit HA Gateway: %#v", haGwSize)
+			tflog.Info(ctx, fmt.Sprintf("Resizing Transit HA Gateway: %#v", haGwSize))
 
 			if haGwSize != gateway.VpcSize {
 				if haGwSize == "" {
@@ -1657,13 +1666,13 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta any) erro
 
 				haGateway := &goaviatrix.Gateway{
 					CloudType: getInt(d, "cloud_type"),
-					GwName:    getString(d, "gw_name") + "-hagw",
+					GwName:    goaviatrix.HaGatewayName(getString(d, "gw_name")),
 					VpcSize:   getString(d, "ha_gw_size"),
 				}
 
-				log.Printf("[INFO] Resizing Transit HA GAteway size to: %s ", haGateway.VpcSize)
+				tflog.Info(ctx, fmt.Sprintf("Resizing Transit HA GAteway size to: %s", haGateway.VpcSize))
 
-				err = client.UpdateGateway(haGateway)
+				err = client.UpdateGatewayContext(ctx, haGateway)
 				if err != nil {
 					return fmt.Errorf("failed to update Aviatrix Transit HA Gateway size: %w", err)
 				}
@@ -1709,7 +1718,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta any) erro
 				GwName: getString(d, "gw_name"),
 			}
 
-			log.Printf("[INFO] Enable VPC DNS Server: %#v", gwVpcDnsServer)
+			tflog.Info(ctx, fmt.Sprintf("Enable VPC DNS Server: %#v", goaviatrix.Redact(gwVpcDnsServer)))
 
 			err := client.EnableVpcDNSServer(gwVpcDnsServer)
 			if err != nil {
@@ -1742,7 +1751,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta any) erro
 				CustomizedSpokeVpcRoutes: strings.Split(customizedSpokeVpcRoutes, ","),
 			}
 			for i := 0; ; i++ {
-				log.Printf("[INFO] Editing customized routes of transit gateway: %s ", transitGateway.GwName)
+				tflog.Info(ctx, fmt.Sprintf("Editing customized routes of transit gateway: %s", transitGateway.GwName))
 				err := client.EditGatewayCustomRoutes(transitGateway)
 				if err == nil {
 					break
@@ -1761,7 +1770,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta any) erro
 				FilteredSpokeVpcRoutes: strings.Split(filteredSpokeVpcRoutes, ","),
 			}
 			for i := 0; ; i++ {
-				log.Printf("[INFO] Editing filtered routes of transit gateway: %s ", transitGateway.GwName)
+				tflog.Info(ctx, fmt.Sprintf("Editing filtered routes of transit gateway: %s", transitGateway.GwName))
 				err := client.EditGatewayFilterRoutes(transitGateway)
 				if err == nil {
 					break
@@ -1780,7 +1789,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta any) erro
 				AdvertisedSpokeRoutes: strings.Split(advertisedSpokeRoutesExclude, ","),
 			}
 			for i := 0; ; i++ {
-				log.Printf("[INFO] Editing customized routes advertisement of transit gateway: %s ", transitGateway.GwName)
+				tflog.Info(ctx, fmt.Sprintf("Editing customized routes advertisement of transit gateway: %s", transitGateway.GwName))
 				err := client.EditGatewayAdvertisedCidr(transitGateway)
 				if err == nil {
 					break
@@ -1986,7 +1995,7 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta any) erro
 			}
 			if haSubnet != "" || haZone != "" {
 				haGwRxQueueSize := &goaviatrix.Gateway{
-					GwName:      getString(d, "gw_name") + "-hagw",
+					GwName:      goaviatrix.HaGatewayName(getString(d, "gw_name")),
 					RxQueueSize: rxQueueSize,
 				}
 				err := client.SetRxQueueSize(haGwRxQueueSize)
@@ -2008,27 +2017,27 @@ func resourceAviatrixTransitGatewayCreate(d *schema.ResourceData, meta any) erro
 		}
 	}
 
-	return resourceAviatrixTransitGatewayReadIfRequired(d, meta, &flag)
+	return resourceAviatrixTransitGatewayReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixTransitGatewayReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixTransitGatewayReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixTransitGatewayRead(d, meta)
+		return resourceAviatrixTransitGatewayRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
-	ignoreTagsConfig := client.IgnoreTagsConfig
+func resourceAviatrixTransitGatewayRead(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustGatewayClient(meta)
+	ignoreTagsConfig := client.GetIgnoreTagsConfig()
 
 	var isImport bool
 	gwName := getString(d, "gw_name")
 	if gwName == "" {
 		isImport = true
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no gateway name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no gateway name received. Import Id is %s", id))
 		mustSet(d, "gw_name", id)
 		gwName = id
 		d.SetId(id)
@@ -2048,7 +2057,7 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta any) error
 		return fmt.Errorf("couldn't find Aviatrix Transit Gateway: %w", err)
 	}
 
-	log.Printf("[TRACE] reading gateway %s: %#v", getString(d, "gw_name"), gw)
+	tflog.Trace(ctx, fmt.Sprintf("reading gateway %s: %#v", getString(d, "gw_name"), goaviatrix.Redact(gw)))
 	mustSet(d, "cloud_type", gw.CloudType)
 	mustSet(d, "account_name", gw.AccountName)
 	mustSet(d, "gw_name", gw.GwName)
@@ -2115,7 +2124,7 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta any) error
 		}
 		// set eip map
 		if gw.EipMap != nil {
-			log.Printf("[TRACE] eip map: %#v", gw.EipMap)
+			tflog.Trace(ctx, fmt.Sprintf("eip map: %#v", goaviatrix.Redact(gw.EipMap)))
 			eipMap, err := setEipMapDetails(gw.EipMap, gw.IfNamesTranslation)
 			if err != nil {
 				return fmt.Errorf("could not set eip map details: %w", err)
@@ -2205,7 +2214,7 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta any) error
 		}
 		// for EAT gateway, set the eip_map, bgp polling time, bgp neighbor status polling time, local_as_number and prepend_as_path after the transit is created. AEP gateways take ~15 mins to be up and running. Set these attributes to gateway default values when the transit is coming up.
 		if err := setGatewayResourceData(d, gw); err != nil {
-			log.Printf("[ERROR] %v", err)
+			tflog.Error(ctx, fmt.Sprintf("%v", err))
 			return err
 		}
 	} else {
@@ -2361,7 +2370,7 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta any) error
 			if len(azureEip) == 3 {
 				mustSet(d, "azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 			} else {
-				log.Printf("[WARN] could not get Azure EIP name and resource group for the Transit Gateway %s", gw.GwName)
+				tflog.Warn(ctx, fmt.Sprintf("could not get Azure EIP name and resource group for the Transit Gateway %s", gw.GwName))
 			}
 		}
 
@@ -2474,14 +2483,14 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta any) error
 
 		lanCidr, err := client.GetTransitGatewayLanCidr(gw.GwName)
 		if err != nil && !errors.Is(err, goaviatrix.ErrNotFound) {
-			log.Printf("[WARN] Error getting lan cidr for transit gateway %s due to %s", gw.GwName, err)
+			tflog.Warn(ctx, fmt.Sprintf("Error getting lan cidr for transit gateway %s due to %s", gw.GwName, err))
 		}
 		mustSet(d, "lan_interface_cidr", lanCidr)
 
 		if goaviatrix.IsCloudType(gw.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
 			tags := goaviatrix.KeyValueTags(gw.Tags).IgnoreConfig(ignoreTagsConfig)
-			if err := d.Set("tags", tags); err != nil {
-				log.Printf("[WARN] Error setting tags for (%s): %s", d.Id(), err)
+			if err := setTagsAll(d, tags, client.GetDefaultTagsConfig()); err != nil {
+				tflog.Warn(ctx, fmt.Sprintf("Error setting tags for (%s): %s", d.Id(), err))
 			}
 		}
 
@@ -2569,7 +2578,7 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta any) error
 		mustSet(d, "ha_security_group_id", gw.HaGw.GwSecurityGroupID)
 		lanCidr, err = client.GetTransitGatewayLanCidr(gw.HaGw.GwName)
 		if err != nil && !errors.Is(err, goaviatrix.ErrNotFound) {
-			log.Printf("[WARN] Error getting lan cidr for HA transit gateway %s due to %s", gw.HaGw.GwName, err)
+			tflog.Warn(ctx, fmt.Sprintf("Error getting lan cidr for HA transit gateway %s due to %s", gw.HaGw.GwName, err))
 		}
 		mustSet(d, "ha_lan_interface_cidr", lanCidr)
 
@@ -2589,15 +2598,15 @@ func resourceAviatrixTransitGatewayRead(d *schema.ResourceData, meta any) error
 			if len(azureEip) == 3 {
 				mustSet(d, "ha_azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 			} else {
-				log.Printf("[WARN] could not get Azure EIP name and resource group for the HA Gateway %s", gw.GwName)
+				tflog.Warn(ctx, fmt.Sprintf("could not get Azure EIP name and resource group for the HA Gateway %s", gw.GwName))
 			}
 		}
 	}
 	return nil
 }
 
-func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixTransitGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustGatewayClient(meta)
 
 	gateway := &goaviatrix.Gateway{
 		CloudType: getInt(d, "cloud_type"),
@@ -2605,10 +2614,10 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta any) erro
 	}
 	haGateway := &goaviatrix.Gateway{
 		CloudType: getInt(d, "cloud_type"),
-		GwName:    getString(d, "gw_name") + "-hagw",
+		GwName:    goaviatrix.HaGatewayName(getString(d, "gw_name")),
 		VpcSize:   getString(d, "ha_gw_size"),
 	}
-	log.Printf("[INFO] Updating Aviatrix Transit Gateway: %#v", gateway)
+	tflog.Info(ctx, fmt.Sprintf("Updating Aviatrix Transit Gateway: %#v", goaviatrix.Redact(gateway)))
 
 	// Clarification : Can the user update EAT interface after its created. Add/Delete EAT interface
 	d.Partial(true)
@@ -2778,7 +2787,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta any) erro
 		d.HasChange("ha_availability_domain") || d.HasChange("ha_fault_domain") {
 		transitHaGw := &goaviatrix.TransitHaGateway{
 			PrimaryGwName: getString(d, "gw_name"),
-			GwName:        getString(d, "gw_name") + "-hagw",
+			GwName:        goaviatrix.HaGatewayName(getString(d, "gw_name")),
 			InsaneMode:    "no",
 		}
 
@@ -2929,14 +2938,14 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta any) erro
 		}
 
 		if newHaGwEnabled {
-			_, err := client.CreateTransitHaGw(transitHaGw)
+			_, err := client.CreateTransitHaGwContext(ctx, transitHaGw)
 			if err != nil {
 				return fmt.Errorf("failed to enable HA Aviatrix Transit Gateway: %w", err)
 			}
 			if goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
 				if getString(d, "rx_queue_size") != "" && !d.HasChange("rx_queue_size") {
 					haGwRxQueueSize := &goaviatrix.Gateway{
-						GwName:      getString(d, "gw_name") + "-hagw",
+						GwName:      goaviatrix.HaGatewayName(getString(d, "gw_name")),
 						RxQueueSize: getString(d, "rx_queue_size"),
 					}
 					err := client.SetRxQueueSize(haGwRxQueueSize)
@@ -2946,18 +2955,18 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta any) erro
 				}
 			}
 		} else if deleteHaGw {
-			err := client.DeleteGateway(haGateway)
+			err := client.DeleteGatewayContext(ctx, haGateway)
 			if err != nil {
 				return fmt.Errorf("failed to delete Aviatrix Transit HA gateway: %w", err)
 			}
 		} else if changeHaGw {
-			err := client.DeleteGateway(haGateway)
+			err := client.DeleteGatewayContext(ctx, haGateway)
 			if err != nil {
 				return fmt.Errorf("failed to delete Aviatrix Transit HA gateway: %w", err)
 			}
 
 			transitHaGw.Eip = ""
-			_, err = client.CreateTransitHaGw(transitHaGw)
+			_, err = client.CreateTransitHaGwContext(ctx, transitHaGw)
 			if err != nil {
 				return fmt.Errorf("failed to enable HA Aviatrix Transit Gateway: %w", err)
 			}
@@ -2980,7 +2989,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta any) erro
 		}
 
 		if singleAZ {
-			log.Printf("[INFO] Enable Single AZ GW HA: %#v", singleAZGateway)
+			tflog.Info(ctx, fmt.Sprintf("Enable Single AZ GW HA: %#v", goaviatrix.Redact(singleAZGateway)))
 
 			err := client.EnableSingleAZGateway(singleAZGateway)
 			if err != nil {
@@ -2989,7 +2998,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta any) erro
 
 			if haEnabled {
 				singleAZGatewayHA := &goaviatrix.Gateway{
-					GwName: getString(d, "gw_name") + "-hagw",
+					GwName: goaviatrix.HaGatewayName(getString(d, "gw_name")),
 				}
 				err := client.EnableSingleAZGateway(singleAZGatewayHA)
 				if err != nil {
@@ -2997,7 +3006,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta any) erro
 				}
 			}
 		} else {
-			log.Printf("[INFO] Disable Single AZ GW HA: %#v", singleAZGateway)
+			tflog.Info(ctx, fmt.Sprintf("Disable Single AZ GW HA: %#v", goaviatrix.Redact(singleAZGateway)))
 			err := client.DisableSingleAZGateway(singleAZGateway)
 			if err != nil {
 				return fmt.Errorf("failed to disable single AZ GW HA for %s: %w", singleAZGateway.GwName, err)
@@ -3005,7 +3014,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta any) erro
 
 			if haEnabled {
 				singleAZGatewayHA := &goaviatrix.Gateway{
-					GwName: getString(d, "gw_name") + "-hagw",
+					GwName: goaviatrix.HaGatewayName(getString(d, "gw_name")),
 				}
 				err := client.DisableSingleAZGateway(singleAZGatewayHA)
 				if err != nil {
@@ -3015,7 +3024,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta any) erro
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
 			return fmt.Errorf("failed to update transit gateway: adding tags is only supported for AWS (1), Azure (8), AWSGov (256), AWSChina (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
 		}
@@ -3025,8 +3034,8 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta any) erro
 			CloudType:    gateway.CloudType,
 		}
 
-		if d.HasChange("tags") {
-			tagsMap, err := extractTags(d, gateway.CloudType)
+		if d.HasChanges("tags", "tags_all") {
+			tagsMap, err := extractTags(d, gateway.CloudType, defaultTagsFor(client.GetDefaultTagsConfig(), gateway.CloudType, gatewayTagCloudTypes))
 			if err != nil {
 				return fmt.Errorf("failed to update tags for transit gateway: %w", err)
 			}
@@ -3071,7 +3080,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta any) erro
 			old, _ := d.GetChange("gw_size")
 			primaryGwSize = mustString(old)
 			gateway.VpcSize = getString(d, "gw_size")
-			err := client.UpdateGateway(gateway)
+			err := client.UpdateGatewayContext(ctx, gateway)
 			if err != nil {
 				return fmt.Errorf("failed to update Aviatrix Transit Gateway: %w", err)
 			}
@@ -3097,8 +3106,8 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta any) erro
 						return fmt.Errorf("a valid non empty ha_gw_size parameter is mandatory for this resource if " +
 							"ha_subnet or ha_zone is set")
 					}
-					err = client.UpdateGateway(haGateway)
-					log.Printf("[INFO] Updating HA Gateway size to: %s ", haGateway.VpcSize)
+					err = client.UpdateGatewayContext(ctx, haGateway)
+					tflog.Info(ctx, fmt.Sprintf("Updating HA Gateway size to: %s", haGateway.VpcSize))
 					if err != nil {
 						return fmt.Errorf("failed to update Aviatrix Transit HA Gateway size: %w", err)
 					}
@@ -3144,7 +3153,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta any) erro
 			interfaceList := getList(d, "interfaces")
 			interfaceList = filterCloudManagedInterfaces(interfaceList, cloudType)
 
-			interfaces, err := getInterfaceDetails(interfaceList, cloudType)
+			interfaces, err := getInterfaceDetails(ctx, interfaceList, cloudType)
 			if err != nil {
 				return fmt.Errorf("failed to get interface details: %w", err)
 			}
@@ -3179,7 +3188,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta any) erro
 			eipMap := getList(d, "eip_map")
 
 			if len(eipMap) > 0 {
-				eipMapList, err := getEipMapDetails(eipMap, wanCount, cloudType)
+				eipMapList, err := getEipMapDetails(ctx, eipMap, wanCount, cloudType)
 				if err != nil {
 					return fmt.Errorf("failed to get the eip map details: %w", err)
 				}
@@ -3189,10 +3198,10 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta any) erro
 
 				if cloudType == goaviatrix.EDGEMEGAPORT {
 					// print eip map for edge mega port
-					log.Printf("[INFO] EIP Map for Edge Mega Port: %#v", eipMapList)
+					tflog.Info(ctx, fmt.Sprintf("EIP Map for Edge Mega Port: %#v", goaviatrix.Redact(eipMapList)))
 					gateway.LogicalEipMap = eipMapList
 					gateway.CloudType = cloudType
-					ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+					ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 					defer cancel()
 					err = client.UpdateEdgeGatewayV2(ctx, gateway)
 					if err != nil {
@@ -3220,7 +3229,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta any) erro
 			// if transitHaGateway already exists then cannot update the backup link info
 			haGateway := &goaviatrix.Gateway{
 				AccountName: getString(d, "account_name"),
-				GwName:      getString(d, "gw_name") + "-hagw",
+				GwName:      goaviatrix.HaGatewayName(getString(d, "gw_name")),
 			}
 			resultHaGw, err := client.GetGateway(haGateway)
 			if err == nil && resultHaGw != nil {
@@ -3228,12 +3237,12 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta any) erro
 			}
 			cloudType := getInt(d, "cloud_type")
 			// create transit ha gw with backup link info
-			transitHaGw, err := getTransitHaGatewayDetails(d, wanCount, cloudType)
+			transitHaGw, err := getTransitHaGatewayDetails(ctx, d, wanCount, cloudType)
 			if err != nil {
 				return fmt.Errorf("failed to get transit ha gateway details: %w", err)
 			}
-			log.Printf("[INFO] Enabling HA on Transit Gateway")
-			_, err = client.CreateTransitHaGw(transitHaGw)
+			tflog.Info(ctx, "Enabling HA on Transit Gateway")
+			_, err = client.CreateTransitHaGwContext(ctx, transitHaGw)
 			if err != nil {
 				return fmt.Errorf("failed to enable HA Aviatrix Transit Gateway: %w", err)
 			}
@@ -3245,12 +3254,12 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta any) erro
 
 			if len(haInterfaceList) > 0 {
 				haInterfaceList = filterCloudManagedInterfaces(haInterfaceList, cloudType)
-				haInterfaces, err := getInterfaceDetails(haInterfaceList, cloudType)
+				haInterfaces, err := getInterfaceDetails(ctx, haInterfaceList, cloudType)
 				if err != nil {
 					return fmt.Errorf("failed to get interface details: %w", err)
 				}
 				gateway := &goaviatrix.TransitVpc{
-					GwName:     getString(d, "gw_name") + "-hagw",
+					GwName:     goaviatrix.HaGatewayName(getString(d, "gw_name")),
 					Interfaces: haInterfaces,
 				}
 				haManagementEgressIPPrefixList := getStringSet(d, "ha_management_egress_ip_prefix_list")
@@ -3263,7 +3272,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta any) erro
 				}
 			} else {
 				// delete the HA gateway if ha_interfaces is empty
-				err := client.DeleteGateway(haGateway)
+				err := client.DeleteGatewayContext(ctx, haGateway)
 				if err != nil {
 					return fmt.Errorf("failed to delete HA gateway: %w", err)
 				}
@@ -3536,7 +3545,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta any) erro
 			old, _ := d.GetChange("gw_size")
 			primaryGwSize = mustString(old)
 			gateway.VpcSize = getString(d, "gw_size")
-			err := client.UpdateGateway(gateway)
+			err := client.UpdateGatewayContext(ctx, gateway)
 			if err != nil {
 				return fmt.Errorf("failed to update Aviatrix Transit Gateway: %w", err)
 			}
@@ -3562,8 +3571,8 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta any) erro
 						return fmt.Errorf("a valid non empty ha_gw_size parameter is mandatory for this resource if " +
 							"ha_subnet or ha_zone is set")
 					}
-					err = client.UpdateGateway(haGateway)
-					log.Printf("[INFO] Updating HA Gateway size to: %s ", haGateway.VpcSize)
+					err = client.UpdateGatewayContext(ctx, haGateway)
+					tflog.Info(ctx, fmt.Sprintf("Updating HA Gateway size to: %s", haGateway.VpcSize))
 					if err != nil {
 						return fmt.Errorf("failed to update Aviatrix Transit HA Gateway size: %w", err)
 					}
@@ -3655,7 +3664,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta any) erro
 			haEnabled := haSubnet != "" || haZone != ""
 			if haEnabled {
 				gwHAEncVolume := &goaviatrix.Gateway{
-					GwName:              getString(d, "gw_name") + "-hagw",
+					GwName:              goaviatrix.HaGatewayName(getString(d, "gw_name")),
 					CustomerManagedKeys: getString(d, "customer_managed_keys"),
 				}
 				err := client.EnableEncryptVolume(gwHAEncVolume)
@@ -3680,7 +3689,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta any) erro
 				CustomizedSpokeVpcRoutes: newRouteList,
 			}
 			err := client.EditGatewayCustomRoutes(transitGateway)
-			log.Printf("[INFO] Customizeing routes of transit gateway: %s ", transitGateway.GwName)
+			tflog.Info(ctx, fmt.Sprintf("Customizeing routes of transit gateway: %s", transitGateway.GwName))
 			if err != nil {
 				return fmt.Errorf("failed to customize spoke vpc routes of transit gateway: %s due to: %w", transitGateway.GwName, err)
 			}
@@ -3697,7 +3706,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta any) erro
 				FilteredSpokeVpcRoutes: newRouteList,
 			}
 			err := client.EditGatewayFilterRoutes(transitGateway)
-			log.Printf("[INFO] Editing filtered spoke vpc routes of transit gateway: %s ", transitGateway.GwName)
+			tflog.Info(ctx, fmt.Sprintf("Editing filtered spoke vpc routes of transit gateway: %s", transitGateway.GwName))
 			if err != nil {
 				return fmt.Errorf("failed to edit filtered spoke vpc routes of transit gateway: %s due to: %w", transitGateway.GwName, err)
 			}
@@ -3714,7 +3723,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta any) erro
 				AdvertisedSpokeRoutes: newRouteList,
 			}
 			err := client.EditGatewayAdvertisedCidr(transitGateway)
-			log.Printf("[INFO] Editing excluded advertised spoke vpc routes of transit gateway: %s ", transitGateway.GwName)
+			tflog.Info(ctx, fmt.Sprintf("Editing excluded advertised spoke vpc routes of transit gateway: %s", transitGateway.GwName))
 			if err != nil {
 				return fmt.Errorf("failed to edit excluded advertised spoke vpc routes of transit gateway: %s due to: %w", transitGateway.GwName, err)
 			}
@@ -3983,7 +3992,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta any) erro
 		}
 		if haSubnet != "" || haZone != "" {
 			haGwRxQueueSize := &goaviatrix.Gateway{
-				GwName:      getString(d, "gw_name") + "-hagw",
+				GwName:      goaviatrix.HaGatewayName(getString(d, "gw_name")),
 				RxQueueSize: getString(d, "rx_queue_size"),
 			}
 			err := client.SetRxQueueSize(haGwRxQueueSize)
@@ -4024,7 +4033,7 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta any) erro
 		}
 		if haSubnet != "" {
 			haGw := &goaviatrix.Gateway{
-				GwName:                getString(d, "gw_name") + "-hagw",
+				GwName:                goaviatrix.HaGatewayName(getString(d, "gw_name")),
 				BgpLanInterfacesCount: getInt(d, "bgp_lan_interfaces_count"),
 			}
 			err := client.ChangeBgpOverLanIntfCnt(haGw)
@@ -4060,11 +4069,11 @@ func resourceAviatrixTransitGatewayUpdate(d *schema.ResourceData, meta any) erro
 	}
 
 	d.Partial(false)
-	return resourceAviatrixTransitGatewayRead(d, meta)
+	return resourceAviatrixTransitGatewayRead(ctx, d, meta)
 }
 
-func resourceAviatrixTransitGatewayDelete(d *schema.ResourceData, meta any) error {
-	client := mustClient(meta)
+func resourceAviatrixTransitGatewayDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
+	client := mustGatewayClient(meta)
 	cloudType := getInt(d, "cloud_type")
 
 	gateway := &goaviatrix.Gateway{
@@ -4072,7 +4081,7 @@ func resourceAviatrixTransitGatewayDelete(d *schema.ResourceData, meta any) erro
 		GwName:    getString(d, "gw_name"),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix Transit Gateway: %#v", gateway)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix Transit Gateway: %#v", goaviatrix.Redact(gateway)))
 
 	enableEgressTransitFirenet := getBool(d, "enable_egress_transit_firenet")
 	if enableEgressTransitFirenet {
@@ -4122,15 +4131,15 @@ func resourceAviatrixTransitGatewayDelete(d *schema.ResourceData, meta any) erro
 	ha_interfaces := getList(d, "ha_interfaces")
 
 	if haSubnet != "" || haZone != "" || (goaviatrix.IsCloudType(cloudType, goaviatrix.EdgeRelatedCloudTypes) && len(ha_interfaces) > 0) {
-		gateway.GwName += "-hagw"
+		gateway.GwName = goaviatrix.HaGatewayName(gateway.GwName)
 
 		try, maxTries, backoff := 0, 2, 500*time.Millisecond
 
 		for {
 			try++
-			err := client.DeleteGateway(gateway)
+			err := client.DeleteGatewayContext(ctx, gateway)
 			if err != nil {
-				if strings.Contains(err.Error(), "not found") {
+				if errors.Is(err, goaviatrix.ErrNotFound) {
 					break
 				}
 
@@ -4160,7 +4169,7 @@ func resourceAviatrixTransitGatewayDelete(d *schema.ResourceData, meta any) erro
 		}
 	}
 	gateway.GwName = getString(d, "gw_name")
-	err := client.DeleteGateway(gateway)
+	err := client.DeleteGatewayContext(ctx, gateway)
 	if err != nil {
 		return fmt.Errorf("failed to delete Aviatrix Edge Transit Gateway: %w", err)
 	}
@@ -4191,7 +4200,7 @@ func deleteZtpFile(gatewayName, vpcID, ztpFileDownloadPath string) error {
 	return nil
 }
 
-func createEdgeTransitGateway(d *schema.ResourceData, client *goaviatrix.Client, cloudType int) error {
+func createEdgeTransitGateway(ctx context.Context, d *schema.ResourceData, client goaviatrix.GatewayClient, cloudType int) error {
 	gateway := &goaviatrix.TransitVpc{
 		CloudType:              getInt(d, "cloud_type"),
 		AccountName:            getString(d, "account_name"),
@@ -4209,7 +4218,7 @@ func createEdgeTransitGateway(d *schema.ResourceData, client *goaviatrix.Client,
 		return fmt.Errorf("at least one interface is required for Edge Transit Gateway")
 	}
 
-	interfacesList, err := getInterfaceDetails(interfaces, cloudType)
+	interfacesList, err := getInterfaceDetails(ctx, interfaces, cloudType)
 	if err != nil {
 		return fmt.Errorf("failed to get the interface details: %w", err)
 	}
@@ -4267,9 +4276,9 @@ func createEdgeTransitGateway(d *schema.ResourceData, client *goaviatrix.Client,
 	}
 
 	// create the transit gateway
-	log.Printf("[INFO] Creating Aviatrix Transit Gateway: %#v", gateway)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix Transit Gateway: %#v", goaviatrix.Redact(gateway)))
 	d.SetId(gateway.GwName)
-	err = client.LaunchTransitVpc(gateway)
+	err = client.LaunchTransitVpcContext(ctx, gateway)
 	if err != nil {
 		return fmt.Errorf("failed to create Aviatrix Transit Gateway: %w", err)
 	}
@@ -4277,14 +4286,14 @@ func createEdgeTransitGateway(d *schema.ResourceData, client *goaviatrix.Client,
 	haInterfaces := getList(d, "ha_interfaces")
 
 	if len(haInterfaces) > 0 {
-		transitHaGw, err := getTransitHaGatewayDetails(d, wanCount, cloudType)
+		transitHaGw, err := getTransitHaGatewayDetails(ctx, d, wanCount, cloudType)
 		if err != nil {
 			return fmt.Errorf("failed to get the HA gateway details: %w", err)
 		}
 
-		log.Printf("[INFO] Creating HA Aviatrix Transit Gateway: %+v", transitHaGw)
+		tflog.Info(ctx, fmt.Sprintf("Creating HA Aviatrix Transit Gateway: %+v", goaviatrix.Redact(transitHaGw)))
 
-		_, err = client.CreateTransitHaGw(transitHaGw)
+		_, err = client.CreateTransitHaGwContext(ctx, transitHaGw)
 		if err != nil {
 			return fmt.Errorf("failed to enable HA Aviatrix Transit Gateway: %w", err)
 		}
@@ -4331,16 +4340,16 @@ func createEdgeTransitGateway(d *schema.ResourceData, client *goaviatrix.Client,
 	eipMap := getList(d, "eip_map")
 
 	if len(eipMap) > 0 {
-		eipMapList, err := getEipMapDetails(eipMap, wanCount, cloudType)
+		eipMapList, err := getEipMapDetails(ctx, eipMap, wanCount, cloudType)
 		if err != nil {
 			return fmt.Errorf("failed to get the eip map details: %w", err)
 		}
 
 		if cloudType == goaviatrix.EDGEMEGAPORT {
 			// print eip map for edge mega port
-			log.Printf("[INFO] EIP Map for Edge Mega Port: %#v", eipMapList)
+			tflog.Info(ctx, fmt.Sprintf("EIP Map for Edge Mega Port: %#v", goaviatrix.Redact(eipMapList)))
 			gateway.LogicalEipMap = eipMapList
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 			defer cancel()
 			// update logical eip map
 			err = client.UpdateEdgeGatewayV2(ctx, gateway)
@@ -4469,7 +4478,7 @@ func isValidInterfaceName(name string) bool {
 	return name != "" && (strings.HasPrefix(name, "wan") || strings.HasPrefix(name, "mgmt"))
 }
 
-func calculateInterfaceName(intfType string, intfIndex, wanCount int) (string, error) {
+func calculateInterfaceName(ctx context.Context, intfType string, intfIndex, wanCount int) (string, error) {
 	var num int
 
 	switch intfType {
@@ -4482,7 +4491,7 @@ func calculateInterfaceName(intfType string, intfIndex, wanCount int) (string, e
 	}
 
 	interfaceName := fmt.Sprintf("eth%d", num)
-	log.Printf("Mapping interface %s%d to port %s", intfType, intfIndex, interfaceName)
+	tflog.Info(ctx, fmt.Sprintf("Mapping interface %s%d to port %s", intfType, intfIndex, interfaceName))
 	return interfaceName, nil
 }
 
@@ -4504,7 +4513,7 @@ func calculateMgmtInterfaceNumber(intfIndex, wanCount int) int {
 }
 
 // get the interface name (ethX) from logical interface name and counts
-func getInterfaceName(logicalIfName string, wanCount int) (string, error) {
+func getInterfaceName(ctx context.Context, logicalIfName string, wanCount int) (string, error) {
 	// check if the logical interface name is valid
 	if !isValidInterfaceName(logicalIfName) {
 		return "", fmt.Errorf("invalid logical interface name: %s", logicalIfName)
@@ -4515,14 +4524,14 @@ func getInterfaceName(logicalIfName string, wanCount int) (string, error) {
 		return "", fmt.Errorf("failed to extract interface type and index: %w", err)
 	}
 
-	interfaceName, err := calculateInterfaceName(intfType, intfIndex, wanCount)
+	interfaceName, err := calculateInterfaceName(ctx, intfType, intfIndex, wanCount)
 	if err != nil {
 		return "", err
 	}
 	return interfaceName, nil
 }
 
-func getEipMapDetails(eipMap []any, wanCount int, cloudType int) (map[string][]goaviatrix.EipMap, error) {
+func getEipMapDetails(ctx context.Context, eipMap []any, wanCount int, cloudType int) (map[string][]goaviatrix.EipMap, error) {
 	// Create a map to structure the EIP data
 	eipMapStructured := make(map[string][]goaviatrix.EipMap)
 
@@ -4542,7 +4551,7 @@ func getEipMapDetails(eipMap []any, wanCount int, cloudType int) (map[string][]g
 			interfaceName = logicalIfName
 		} else {
 			var err error
-			interfaceName, err = getInterfaceName(logicalIfName, wanCount)
+			interfaceName, err = getInterfaceName(ctx, logicalIfName, wanCount)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get the interface name using type and index for eip_map: %w", err)
 			}
@@ -4568,10 +4577,10 @@ func getEipMapDetails(eipMap []any, wanCount int, cloudType int) (map[string][]g
 	return eipMapStructured, nil
 }
 
-func getTransitHaGatewayDetails(d *schema.ResourceData, wanCount int, cloudType int) (*goaviatrix.TransitHaGateway, error) {
+func getTransitHaGatewayDetails(ctx context.Context, d *schema.ResourceData, wanCount int, cloudType int) (*goaviatrix.TransitHaGateway, error) {
 	gwName := getString(d, "gw_name")
 
-	haGwName := gwName + "-hagw"
+	haGwName := goaviatrix.HaGatewayName(gwName)
 	transitHaGw := &goaviatrix.TransitHaGateway{
 		PrimaryGwName:       gwName,
 		GwName:              haGwName,
@@ -4585,7 +4594,7 @@ func getTransitHaGatewayDetails(d *schema.ResourceData, wanCount int, cloudType
 	connectionType := getString(d, "peer_connection_type")
 
 	// get the backup link configuration
-	backupLinkConfig, err := createBackupLinkConfig(gwName, peerBackupLogicalName, connectionType, wanCount, cloudType)
+	backupLinkConfig, err := createBackupLinkConfig(ctx, gwName, peerBackupLogicalName, connectionType, wanCount, cloudType)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create backup link configuration: %w", err)
 	}
@@ -4593,7 +4602,7 @@ func getTransitHaGatewayDetails(d *schema.ResourceData, wanCount int, cloudType
 	// get the HA interfaces
 	haInterfaces := getList(d, "ha_interfaces")
 
-	interfacesList, err := getInterfaceDetails(haInterfaces, cloudType)
+	interfacesList, err := getInterfaceDetails(ctx, haInterfaces, cloudType)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get the interface details for HA Edge Transit Gateway: %w", err)
 	}
@@ -4626,7 +4635,7 @@ func getTransitHaGatewayDetails(d *schema.ResourceData, wanCount int, cloudType
 	return transitHaGw, nil
 }
 
-func createBackupLinkConfig(gwName string, peerBackupLogicalNames []any, connectionType string, wanCount int, cloudType int) (string, error) {
+func createBackupLinkConfig(ctx context.Context, gwName string, peerBackupLogicalNames []any, connectionType string, wanCount int, cloudType int) (string, error) {
 	var peerBackupPorts []string
 	for _, logicalName := range peerBackupLogicalNames {
 		// convert the logical interface name to string
@@ -4634,7 +4643,7 @@ func createBackupLinkConfig(gwName string, peerBackupLogicalNames []any, connect
 		if !ok {
 			return "", fmt.Errorf("peer backup logical interface name is not a string")
 		}
-		portName, err := getInterfaceName(logicalName, wanCount) // returns eth0, eth1, etc.
+		portName, err := getInterfaceName(ctx, logicalName, wanCount) // returns eth0, eth1, etc.
 		if err != nil {
 			return "", fmt.Errorf("failed to get the peer backup port name for logical name %s: %w", logicalName, err)
 		}
@@ -4763,7 +4772,7 @@ func filterCloudManagedInterfaces(interfaces []any, cloudType int) []any {
 	return filtered
 }
 
-func getInterfaceDetails(interfaces []any, cloudType int) (string, error) {
+func getInterfaceDetails(ctx context.Context, interfaces []any, cloudType int) (string, error) {
 	// get the count of WAN and MANAGEMENT interfaces
 	wanCount, err := countInterfaceTypes(interfaces)
 	if err != nil {
@@ -4776,7 +4785,7 @@ func getInterfaceDetails(interfaces []any, cloudType int) (string, error) {
 			return "", fmt.Errorf("interface is not a map[string]interface{}")
 		}
 
-		ifaceData, err := parseInterface(ifaceInfo, wanCount, cloudType)
+		ifaceData, err := parseInterface(ctx, ifaceInfo, wanCount, cloudType)
 		if err != nil {
 			return "", fmt.Errorf("failed to parse interface: %w", err)
 		}
@@ -4791,7 +4800,7 @@ func getInterfaceDetails(interfaces []any, cloudType int) (string, error) {
 	return interfacesEncoded, nil
 }
 
-func parseInterface(ifaceInfo map[string]any, wanCount, cloudType int) (goaviatrix.EdgeTransitInterface, error) {
+func parseInterface(ctx context.Context, ifaceInfo map[string]any, wanCount, cloudType int) (goaviatrix.EdgeTransitInterface, error) {
 	var (
 		logicalIfName, ifaceName, ifaceType, ifaceGatewayIP, ifaceIP, ifacePublicIP, ifaceUnderlayCidr string
 		ifaceDHCP                                                                                      bool
@@ -4803,7 +4812,7 @@ func parseInterface(ifaceInfo map[string]any, wanCount, cloudType int) (goaviatr
 		return goaviatrix.EdgeTransitInterface{}, fmt.Errorf("logical_ifname: %w", err)
 	}
 
-	ifaceName, err = getInterfaceName(logicalIfName, wanCount)
+	ifaceName, err = getInterfaceName(ctx, logicalIfName, wanCount)
 	if err != nil {
 		return goaviatrix.EdgeTransitInterface{}, fmt.Errorf("failed to get the interface name: %w", err)
 	}
@@ -1,30 +1,34 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
-	"log"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
 )
 
 func resourceAviatrixTransitGatewayMigrateState(
 	v int, is *terraform.InstanceState, _ any,
 ) (*terraform.InstanceState, error) {
+	ctx := providerLogContext()
 	switch v {
 	case 0:
-		log.Println("[INFO] Found AVIATRIX Transit Gateway State v0; migrating to v1")
-		return migrateTransitGatewayStateV0toV1(is)
+		tflog.Info(ctx, "Found AVIATRIX Transit Gateway State v0; migrating to v1")
+		return migrateTransitGatewayStateV0toV1(ctx, is)
 	default:
 		return is, fmt.Errorf("unexpected schema version: %d", v)
 	}
 }
 
-func migrateTransitGatewayStateV0toV1(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+func migrateTransitGatewayStateV0toV1(ctx context.Context, is *terraform.InstanceState) (*terraform.InstanceState, error) {
 	if is.Empty() || is.Attributes == nil {
-		log.Println("[DEBUG] Empty Transit Gateway State; nothing to migrate.")
+		tflog.Debug(ctx, "Empty Transit Gateway State; nothing to migrate.")
 		return is, nil
 	}
-	log.Printf("[DEBUG] Attributes before migration: %#v", is.Attributes)
+	tflog.Debug(ctx, fmt.Sprintf("Attributes before migration: %#v", goaviatrix.Redact(is.Attributes)))
 
 	if is.Attributes["enable_firenet_interfaces"] == "true" {
 		is.Attributes["enable_firenet"] = "true"
@@ -33,6 +37,6 @@ func migrateTransitGatewayStateV0toV1(is *terraform.InstanceState) (*terraform.I
 	}
 	delete(is.Attributes, "enable_firenet_interfaces")
 
-	log.Printf("[DEBUG] Attributes after migration: %#v", is.Attributes)
+	tflog.Debug(ctx, fmt.Sprintf("Attributes after migration: %#v", goaviatrix.Redact(is.Attributes)))
 	return is, nil
 }
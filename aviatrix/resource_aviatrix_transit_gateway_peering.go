@@ -4,10 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -16,10 +16,10 @@ import (
 
 func resourceAviatrixTransitGatewayPeering() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixTransitGatewayPeeringCreate,
-		Read:   resourceAviatrixTransitGatewayPeeringRead,
-		Update: resourceAviatrixTransitGatewayPeeringUpdate,
-		Delete: resourceAviatrixTransitGatewayPeeringDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixTransitGatewayPeeringCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixTransitGatewayPeeringRead),
+		UpdateWithoutTimeout: withContext(resourceAviatrixTransitGatewayPeeringUpdate),
+		DeleteWithoutTimeout: withContext(resourceAviatrixTransitGatewayPeeringDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -91,7 +91,7 @@ func resourceAviatrixTransitGatewayPeering() *schema.Resource {
 				Description: "AS Path Prepend customized by specifying AS PATH for a BGP connection. Applies on transit_gateway_name1.",
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: goaviatrix.ValidateASN,
+					ValidateFunc: ValidateASN,
 				},
 				MaxItems: 25,
 			},
@@ -101,7 +101,7 @@ func resourceAviatrixTransitGatewayPeering() *schema.Resource {
 				Description: "AS Path Prepend customized by specifying AS PATH for a BGP connection. Applies on transit_gateway_name2.",
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: goaviatrix.ValidateASN,
+					ValidateFunc: ValidateASN,
 				},
 				MaxItems: 25,
 			},
@@ -202,11 +202,10 @@ func resourceAviatrixTransitGatewayPeering() *schema.Resource {
 	}
 }
 
-func resourceAviatrixTransitGatewayPeeringCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixTransitGatewayPeeringCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 	flag := false
 
-	ctx := context.Background()
 	resolvedGwName1, err := resolveGatewayName(ctx, client, getString(d, "transit_gateway_name1"))
 	if err != nil {
 		return fmt.Errorf("could not resolve transit_gateway_name1 %q: %w", getString(d, "transit_gateway_name1"), err)
@@ -275,7 +274,7 @@ func resourceAviatrixTransitGatewayPeeringCreate(d *schema.ResourceData, meta an
 			return fmt.Errorf("gateway1_logical_ifnames must be a list of strings")
 		}
 		gw1LogicalIfNames := getStringList(d, "gateway1_logical_ifnames")
-		if err := setWanInterfaceNames(gw1LogicalIfNames, gateway1CloudType, gateway1Details, "gateway1", transitGatewayPeering); err != nil {
+		if err := setWanInterfaceNames(ctx, gw1LogicalIfNames, gateway1CloudType, gateway1Details, "gateway1", transitGatewayPeering); err != nil {
 			return err
 		}
 	}
@@ -290,7 +289,7 @@ func resourceAviatrixTransitGatewayPeeringCreate(d *schema.ResourceData, meta an
 			return fmt.Errorf("gateway2_logical_ifnames must be a list of strings")
 		}
 		gw2LogicalIfNames := getStringList(d, "gateway2_logical_ifnames")
-		if err := setWanInterfaceNames(gw2LogicalIfNames, gateway2CloudType, gateway2Details, "gateway2", transitGatewayPeering); err != nil {
+		if err := setWanInterfaceNames(ctx, gw2LogicalIfNames, gateway2CloudType, gateway2Details, "gateway2", transitGatewayPeering); err != nil {
 			return err
 		}
 	}
@@ -301,17 +300,17 @@ func resourceAviatrixTransitGatewayPeeringCreate(d *schema.ResourceData, meta an
 
 	// options only supported for non EAT peerings
 	if setNonEATPeering(gateway1CloudType, gateway2CloudType) {
-		log.Printf("[INFO] Setting non EAT peering options")
+		tflog.Info(ctx, "Setting non EAT peering options")
 		if err := setNonEATPeeringOptions(d, transitGatewayPeering); err != nil {
 			return err
 		}
 	}
 
-	log.Printf("[INFO] Creating Aviatrix Transit Gateway peering: %#v", transitGatewayPeering)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix Transit Gateway peering: %#v", goaviatrix.Redact(transitGatewayPeering)))
 	d.SetId(resolvedGwName1 + "~" + resolvedGwName2)
 	defer func() {
-		if err := resourceAviatrixTransitGatewayPeeringReadIfRequired(d, meta, &flag); err != nil {
-			log.Printf("[ERROR] Failed to read Aviatrix Transit Gateway peering: %v", err)
+		if err := resourceAviatrixTransitGatewayPeeringReadIfRequired(ctx, d, meta, &flag); err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Failed to read Aviatrix Transit Gateway peering: %v", err))
 		}
 	}()
 
@@ -333,18 +332,18 @@ func resourceAviatrixTransitGatewayPeeringCreate(d *schema.ResourceData, meta an
 		return err
 	}
 
-	return resourceAviatrixTransitGatewayPeeringReadIfRequired(d, meta, &flag)
+	return resourceAviatrixTransitGatewayPeeringReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixTransitGatewayPeeringReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixTransitGatewayPeeringReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixTransitGatewayPeeringRead(d, meta)
+		return resourceAviatrixTransitGatewayPeeringRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixTransitGatewayPeeringRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixTransitGatewayPeeringRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	transitGwName1 := getString(d, "transit_gateway_name1")
@@ -352,7 +351,7 @@ func resourceAviatrixTransitGatewayPeeringRead(d *schema.ResourceData, meta any)
 
 	if transitGwName1 == "" || transitGwName2 == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no transit gateway names received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no transit gateway names received. Import Id is %s", id))
 		parts := strings.Split(id, "~")
 		if len(parts) != 2 {
 			return fmt.Errorf("invalid import id expected transit_gateway_name1~transit_gateway_name2")
@@ -364,7 +363,6 @@ func resourceAviatrixTransitGatewayPeeringRead(d *schema.ResourceData, meta any)
 		d.SetId(id)
 	}
 
-	ctx := context.Background()
 	resolvedGwName1, err := resolveGatewayName(ctx, client, transitGwName1)
 	if err != nil {
 		return fmt.Errorf("could not resolve transit_gateway_name1 %q: %w", transitGwName1, err)
@@ -529,12 +527,11 @@ func resourceAviatrixTransitGatewayPeeringRead(d *schema.ResourceData, meta any)
 	return nil
 }
 
-func resourceAviatrixTransitGatewayPeeringUpdate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixTransitGatewayPeeringUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	d.Partial(true)
 
-	ctx := context.Background()
 	resolvedGwName1, err := resolveGatewayName(ctx, client, getString(d, "transit_gateway_name1"))
 	if err != nil {
 		return fmt.Errorf("could not resolve transit_gateway_name1: %w", err)
@@ -586,7 +583,7 @@ func resourceAviatrixTransitGatewayPeeringUpdate(d *schema.ResourceData, meta an
 		transitGatewayPeering.Gateway1ToGateway2FilterCIDRs = strings.Join(gw1ToGw2FilterCIDRs, ",")
 		transitGatewayPeering.Gateway2ToGateway1FilterCIDRs = strings.Join(gw2ToGw1FilterCIDRs, ",")
 
-		log.Printf("[INFO] Updating Aviatrix Transit Gateway peering: %#v", transitGatewayPeering)
+		tflog.Info(ctx, fmt.Sprintf("Updating Aviatrix Transit Gateway peering: %#v", goaviatrix.Redact(transitGatewayPeering)))
 		err := client.UpdateTransitGatewayPeering(transitGatewayPeering)
 		if err != nil {
 			return fmt.Errorf("failed to update Aviatrix Transit Gateway peering: %w", err)
@@ -635,13 +632,12 @@ func resourceAviatrixTransitGatewayPeeringUpdate(d *schema.ResourceData, meta an
 
 	d.Partial(false)
 	d.SetId(transitGatewayPeering.TransitGatewayName1 + "~" + transitGatewayPeering.TransitGatewayName2)
-	return resourceAviatrixTransitGatewayPeeringRead(d, meta)
+	return resourceAviatrixTransitGatewayPeeringRead(ctx, d, meta)
 }
 
-func resourceAviatrixTransitGatewayPeeringDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixTransitGatewayPeeringDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
-	ctx := context.Background()
 	resolvedGwName1, err := resolveGatewayName(ctx, client, getString(d, "transit_gateway_name1"))
 	if err != nil {
 		return fmt.Errorf("could not resolve transit_gateway_name1: %w", err)
@@ -656,7 +652,7 @@ func resourceAviatrixTransitGatewayPeeringDelete(d *schema.ResourceData, meta an
 		TransitGatewayName2: resolvedGwName2,
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix Transit Gateway peering: %#v", transitGatewayPeering)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix Transit Gateway peering: %#v", goaviatrix.Redact(transitGatewayPeering)))
 
 	err = client.DeleteTransitGatewayPeering(transitGatewayPeering)
 	if err != nil {
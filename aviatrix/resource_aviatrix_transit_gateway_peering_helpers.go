@@ -1,10 +1,11 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -36,7 +37,7 @@ func SetWanInterfaces(logicalIfNames []string, reversedInterfaceNames map[string
 }
 
 // setWanInterfaceNames sets the WAN interface names and logical interface names based on the cloud type.
-func setWanInterfaceNames(
+func setWanInterfaceNames(ctx context.Context,
 	logicalIfNames []string,
 	cloudType int,
 	gatewayDetails *goaviatrix.Gateway,
@@ -67,10 +68,10 @@ func setWanInterfaceNames(
 	case goaviatrix.IsCloudType(cloudType, goaviatrix.EDGEMEGAPORT|goaviatrix.EDGESELFMANAGED):
 		if gatewayPrefix == "gateway1" {
 			transitGatewayPeering.Gateway1LogicalIfNames = logicalIfNames
-			log.Printf("[INFO] Gateway1 Logical Interface Names: %#v", transitGatewayPeering.Gateway1LogicalIfNames)
+			tflog.Info(ctx, fmt.Sprintf("Gateway1 Logical Interface Names: %#v", transitGatewayPeering.Gateway1LogicalIfNames))
 		} else {
 			transitGatewayPeering.Gateway2LogicalIfNames = logicalIfNames
-			log.Printf("[INFO] Gateway2 Logical Interface Names: %#v", transitGatewayPeering.Gateway2LogicalIfNames)
+			tflog.Info(ctx, fmt.Sprintf("Gateway2 Logical Interface Names: %#v", transitGatewayPeering.Gateway2LogicalIfNames))
 		}
 	default:
 		return nil
@@ -233,7 +234,7 @@ func setExcludedResources(d *schema.ResourceData, transitGatewayPeering *goaviat
 }
 
 // getGatewayDetails gets the gateway details from the client.
-func getGatewayDetails(client *goaviatrix.Client, gatewayName string) (*goaviatrix.Gateway, error) {
+func getGatewayDetails(client goaviatrix.GatewayClient, gatewayName string) (*goaviatrix.Gateway, error) {
 	gateway := &goaviatrix.Gateway{
 		GwName: gatewayName,
 	}
@@ -1,6 +1,7 @@
 package aviatrix
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
@@ -1409,7 +1410,7 @@ func TestGetInterfaceName(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := getInterfaceName(tt.intfType, tt.wanCount)
+			result, err := getInterfaceName(context.Background(), tt.intfType, tt.wanCount)
 
 			// Check error
 			if err != nil && tt.expectedErr != nil {
@@ -1528,7 +1529,7 @@ func TestGetEipMapDetails(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := getEipMapDetails(tt.eipMap, tt.wanCount, tt.cloudType)
+			result, err := getEipMapDetails(context.Background(), tt.eipMap, tt.wanCount, tt.cloudType)
 
 			// Check for errors
 			if err != nil && tt.expectedErr != nil {
@@ -1565,7 +1566,7 @@ func TestCountInterfaceTypes(t *testing.T) {
 func TestGetInterfaceDetails(t *testing.T) {
 	// get the interface details
 	cloudType := 1048576
-	interfaceDetails, err := getInterfaceDetails(interfaces, cloudType)
+	interfaceDetails, err := getInterfaceDetails(context.Background(), interfaces, cloudType)
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
@@ -2009,7 +2010,7 @@ func TestCreateBackupLinkConfig(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := createBackupLinkConfig(tt.gwName, tt.peerBackupLogicalNames, tt.connectionType, tt.wanCount, tt.cloudType)
+			result, err := createBackupLinkConfig(context.Background(), tt.gwName, tt.peerBackupLogicalNames, tt.connectionType, tt.wanCount, tt.cloudType)
 
 			// Check for expected error
 			if (err != nil || tt.expectedErr != nil) && (err == nil || err.Error() != tt.expectedErr.Error()) {
@@ -2076,7 +2077,7 @@ func TestCalculateInterfaceName(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := calculateInterfaceName(tt.intfType, tt.intfIndex, tt.wanCount)
+			result, err := calculateInterfaceName(context.Background(), tt.intfType, tt.intfIndex, tt.wanCount)
 			if tt.expectErr {
 				if err == nil {
 					t.Errorf("Expected error but got none")
@@ -2206,7 +2207,7 @@ func TestParseInterface(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := parseInterface(tt.ifaceInfo, tt.wanCount, tt.cloudType)
+			result, err := parseInterface(context.Background(), tt.ifaceInfo, tt.wanCount, tt.cloudType)
 
 			if tt.expectErr {
 				assert.Error(t, err)
@@ -4,9 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -24,6 +24,8 @@ func resourceAviatrixTransitGroup() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: gatewayTimeouts(),
+
 		Schema: MergeSchemaMaps(
 			// Required attributes from group schema
 			GroupRequiredSchema(),
@@ -163,7 +165,7 @@ func transitGroupOptionalSchema() map[string]*schema.Schema {
 			Type:         schema.TypeString,
 			Optional:     true,
 			Description:  "Changes the Aviatrix Transit Gateway ASN number before you set up Aviatrix Transit Gateway connection configurations.",
-			ValidateFunc: goaviatrix.ValidateASN,
+			ValidateFunc: ValidateASN,
 		},
 		"prepend_as_path": {
 			Type:        schema.TypeList,
@@ -171,7 +173,7 @@ func transitGroupOptionalSchema() map[string]*schema.Schema {
 			Description: "List of AS numbers to populate BGP AS_PATH field when it advertises to VGW or peer devices.",
 			Elem: &schema.Schema{
 				Type:         schema.TypeString,
-				ValidateFunc: goaviatrix.ValidateASN,
+				ValidateFunc: ValidateASN,
 			},
 		},
 		"enable_preserve_as_path": {
@@ -407,7 +409,7 @@ func validateTransitGroupConfiguration(transitGroup *goaviatrix.GatewayGroup) er
 }
 
 // applyTransitBgpCommunities applies BGP communities settings (accept/send) to the transit group.
-func applyTransitBgpCommunities(ctx context.Context, d *schema.ResourceData, client *goaviatrix.Client, groupName string) error {
+func applyTransitBgpCommunities(ctx context.Context, d *schema.ResourceData, client goaviatrix.GatewayClient, groupName string) error {
 	// Default for both is false, so only set if true
 	acceptComm := getBool(d, "bgp_accept_communities")
 	sendComm := getBool(d, "bgp_send_communities")
@@ -429,37 +431,37 @@ func applyTransitBgpCommunities(ctx context.Context, d *schema.ResourceData, cli
 
 // applyTransitJumboFrame applies jumbo frame settings based on cloud type.
 // For edge gateways, default is false; for CSP gateways, default is true.
-func applyTransitJumboFrame(ctx context.Context, d *schema.ResourceData, client *goaviatrix.Client, groupName string) error {
+func applyTransitJumboFrame(ctx context.Context, d *schema.ResourceData, client goaviatrix.GatewayClient, groupName string) error {
 	cloudType := getInt(d, "cloud_type")
 	isEdgeGateway := goaviatrix.IsCloudType(cloudType, goaviatrix.EdgeRelatedCloudTypes)
 	return applyJumboFrameForGroup(ctx, d, client, groupName, isEdgeGateway, "transit")
 }
 
 // applyTransitFeatureFlags applies feature flags (GRO/GSO, NAT, VPC DNS Server, IPv6) to the transit group.
-func applyTransitFeatureFlags(ctx context.Context, d *schema.ResourceData, client *goaviatrix.Client, groupName string) error {
+func applyTransitFeatureFlags(ctx context.Context, d *schema.ResourceData, client goaviatrix.GatewayClient, groupName string) error {
 	if getBool(d, "enable_nat") {
-		log.Printf("[INFO] Enabling NAT for transit group: %s", groupName)
+		tflog.Info(ctx, fmt.Sprintf("Enabling NAT for transit group: %s", groupName))
 		if err := client.EnableGatewayGroupSNat(ctx, groupName); err != nil {
 			return fmt.Errorf("failed to enable NAT: %w", err)
 		}
 	}
 
 	if getBool(d, "enable_vpc_dns_server") {
-		log.Printf("[INFO] Enabling VPC DNS Server for transit group: %s", groupName)
+		tflog.Info(ctx, fmt.Sprintf("Enabling VPC DNS Server for transit group: %s", groupName))
 		if err := client.EnableGatewayGroupVpcDNSServer(ctx, groupName); err != nil {
 			return fmt.Errorf("failed to enable VPC DNS Server: %w", err)
 		}
 	}
 
 	if getBool(d, "enable_ipv6") {
-		log.Printf("[INFO] Enabling IPv6 for transit group: %s", groupName)
+		tflog.Info(ctx, fmt.Sprintf("Enabling IPv6 for transit group: %s", groupName))
 		if err := client.EnableIPv6GatewayGroup(ctx, groupName); err != nil {
 			return fmt.Errorf("failed to enable IPv6: %w", err)
 		}
 	}
 
 	if !getBool(d, "enable_gro_gso") {
-		log.Printf("[INFO] Disabling GRO/GSO for transit group: %s", groupName)
+		tflog.Info(ctx, fmt.Sprintf("Disabling GRO/GSO for transit group: %s", groupName))
 		if err := client.DisableGroGsoGatewayGroup(ctx, groupName); err != nil {
 			return fmt.Errorf("failed to disable GRO/GSO: %w", err)
 		}
@@ -469,7 +471,7 @@ func applyTransitFeatureFlags(ctx context.Context, d *schema.ResourceData, clien
 }
 
 // applyTransitBgpTimers applies BGP timer settings (polling time, neighbor status polling, hold time) to the transit group.
-func applyTransitBgpTimers(ctx context.Context, d *schema.ResourceData, client *goaviatrix.Client, groupName string) error {
+func applyTransitBgpTimers(ctx context.Context, d *schema.ResourceData, client goaviatrix.GatewayClient, groupName string) error {
 	bgpPollingTime := getInt(d, "bgp_polling_time")
 	if bgpPollingTime != defaultBgpPollingTime {
 		if err := client.SetBgpPollingTimeGatewayGroup(ctx, groupName, bgpPollingTime); err != nil {
@@ -495,7 +497,7 @@ func applyTransitBgpTimers(ctx context.Context, d *schema.ResourceData, client *
 }
 
 // applyTransitBgpConfiguration applies BGP configuration (local AS number, prepend AS path, BGP ECMP) to the transit group.
-func applyTransitBgpConfiguration(ctx context.Context, d *schema.ResourceData, client *goaviatrix.Client, groupName string) error {
+func applyTransitBgpConfiguration(ctx context.Context, d *schema.ResourceData, client goaviatrix.GatewayClient, groupName string) error {
 	if val, ok := d.GetOk("local_as_number"); ok {
 		if err := client.SetLocalASNumberGatewayGroup(ctx, groupName, mustString(val)); err != nil {
 			return fmt.Errorf("failed to set local AS number: %w", err)
@@ -521,7 +523,7 @@ func applyTransitBgpConfiguration(ctx context.Context, d *schema.ResourceData, c
 }
 
 // applyTransitActiveStandby applies Active-Standby settings to the transit group.
-func applyTransitActiveStandby(ctx context.Context, d *schema.ResourceData, client *goaviatrix.Client, groupName string) error {
+func applyTransitActiveStandby(ctx context.Context, d *schema.ResourceData, client goaviatrix.GatewayClient, groupName string) error {
 	enableActiveStandby := getBool(d, "enable_active_standby")
 	if !enableActiveStandby {
 		return nil
@@ -542,20 +544,20 @@ func applyTransitActiveStandby(ctx context.Context, d *schema.ResourceData, clie
 }
 
 // applyTransitFireNetSettings applies FireNet and Transit FireNet settings to the transit group.
-func applyTransitFireNetSettings(ctx context.Context, d *schema.ResourceData, client *goaviatrix.Client, groupName string) error {
+func applyTransitFireNetSettings(ctx context.Context, d *schema.ResourceData, client goaviatrix.GatewayClient, groupName string) error {
 	enableFireNet := getBool(d, "enable_firenet")
 	enableTransitFireNet := getBool(d, "enable_transit_firenet")
 	enableGatewayLoadBalancer := getBool(d, "enable_gateway_load_balancer")
 
 	if enableFireNet {
-		log.Printf("[INFO] Enabling FireNet for transit group: %s", groupName)
+		tflog.Info(ctx, fmt.Sprintf("Enabling FireNet for transit group: %s", groupName))
 		if err := client.EnableFireNetGatewayGroup(ctx, groupName); err != nil {
 			return fmt.Errorf("failed to enable FireNet: %w", err)
 		}
 	}
 
 	if enableTransitFireNet {
-		log.Printf("[INFO] Enabling Transit FireNet for transit group: %s", groupName)
+		tflog.Info(ctx, fmt.Sprintf("Enabling Transit FireNet for transit group: %s", groupName))
 		if enableGatewayLoadBalancer {
 			if err := client.EnableTransitFireNetWithGWLBGatewayGroup(ctx, groupName); err != nil {
 				return fmt.Errorf("failed to enable Transit FireNet with Gateway Load Balancer: %w", err)
@@ -571,16 +573,16 @@ func applyTransitFireNetSettings(ctx context.Context, d *schema.ResourceData, cl
 }
 
 // applyTransitSpecificSettings applies transit-specific settings (preserve AS path, learned CIDRs, connected transit, etc.) to the transit group.
-func applyTransitSpecificSettings(ctx context.Context, d *schema.ResourceData, client *goaviatrix.Client, groupName string) error {
+func applyTransitSpecificSettings(ctx context.Context, d *schema.ResourceData, client goaviatrix.GatewayClient, groupName string) error {
 	if getBool(d, "enable_preserve_as_path") {
-		log.Printf("[INFO] Enabling Preserve AS Path for transit group: %s", groupName)
+		tflog.Info(ctx, fmt.Sprintf("Enabling Preserve AS Path for transit group: %s", groupName))
 		if err := client.EnableTransitPreserveAsPathGatewayGroup(ctx, groupName); err != nil {
 			return fmt.Errorf("failed to enable Preserve AS Path: %w", err)
 		}
 	}
 
 	if getBool(d, "enable_learned_cidrs_approval") {
-		log.Printf("[INFO] Enabling learned CIDRs approval for transit group: %s", groupName)
+		tflog.Info(ctx, fmt.Sprintf("Enabling learned CIDRs approval for transit group: %s", groupName))
 		if err := client.EnableTransitLearnedCidrsApprovalGatewayGroup(ctx, groupName); err != nil {
 			return fmt.Errorf("failed to enable learned CIDRs approval: %w", err)
 		}
@@ -594,60 +596,60 @@ func applyTransitSpecificSettings(ctx context.Context, d *schema.ResourceData, c
 	}
 
 	if getBool(d, "enable_connected_transit") {
-		log.Printf("[INFO] Enabling connected transit for transit group: %s", groupName)
+		tflog.Info(ctx, fmt.Sprintf("Enabling connected transit for transit group: %s", groupName))
 		if err := client.EnableConnectedTransitGatewayGroup(ctx, groupName); err != nil {
 			return fmt.Errorf("failed to enable connected transit: %w", err)
 		}
 	}
 
 	if getBool(d, "enable_segmentation") {
-		log.Printf("[INFO] Enabling segmentation for transit group: %s", groupName)
+		tflog.Info(ctx, fmt.Sprintf("Enabling segmentation for transit group: %s", groupName))
 		if err := client.EnableSegmentationGatewayGroup(ctx, groupName); err != nil {
 			// Ignore "already enabled" error - can happen if group name was reused
 			if !strings.Contains(err.Error(), "already enabled") {
 				return fmt.Errorf("failed to enable segmentation: %w", err)
 			}
-			log.Printf("[INFO] Segmentation already enabled for transit group: %s, continuing", groupName)
+			tflog.Info(ctx, fmt.Sprintf("Segmentation already enabled for transit group: %s, continuing", groupName))
 		}
 	}
 
 	if getBool(d, "enable_advertise_transit_cidr") {
-		log.Printf("[INFO] Enabling advertise transit CIDR for transit group: %s", groupName)
+		tflog.Info(ctx, fmt.Sprintf("Enabling advertise transit CIDR for transit group: %s", groupName))
 		if err := client.EnableAdvertiseTransitCidrGatewayGroup(ctx, groupName); err != nil {
 			return fmt.Errorf("failed to enable advertise transit CIDR: %w", err)
 		}
 	}
 
 	if getBool(d, "enable_multi_tier_transit") {
-		log.Printf("[INFO] Enabling multi-tier transit for transit group: %s", groupName)
+		tflog.Info(ctx, fmt.Sprintf("Enabling multi-tier transit for transit group: %s", groupName))
 		if err := client.EnableMultiTierTransitGatewayGroup(ctx, groupName); err != nil {
 			return fmt.Errorf("failed to enable multi-tier transit: %w", err)
 		}
 	}
 
 	if getBool(d, "enable_transit_summarize_cidr_to_tgw") {
-		log.Printf("[INFO] Enabling transit summarize CIDR to TGW for transit group: %s", groupName)
+		tflog.Info(ctx, fmt.Sprintf("Enabling transit summarize CIDR to TGW for transit group: %s", groupName))
 		if err := client.EnableTransitSummarizeCidrToTgwGatewayGroup(ctx, groupName); err != nil {
 			return fmt.Errorf("failed to enable transit summarize CIDR to TGW: %w", err)
 		}
 	}
 
 	if getBool(d, "enable_hybrid_connection") {
-		log.Printf("[INFO] Enabling hybrid connection for transit group: %s", groupName)
+		tflog.Info(ctx, fmt.Sprintf("Enabling hybrid connection for transit group: %s", groupName))
 		if err := client.EnableHybridConnectionGatewayGroup(ctx, groupName); err != nil {
 			return fmt.Errorf("failed to enable hybrid connection: %w", err)
 		}
 	}
 
 	if getBool(d, "enable_s2c_rx_balancing") {
-		log.Printf("[INFO] Enabling S2C RX balancing for transit group: %s", groupName)
+		tflog.Info(ctx, fmt.Sprintf("Enabling S2C RX balancing for transit group: %s", groupName))
 		if err := client.EnableS2cRxBalancingGatewayGroup(ctx, groupName); err != nil {
 			return fmt.Errorf("failed to enable S2C RX balancing: %w", err)
 		}
 	}
 
 	if getBool(d, "enable_global_vpc") {
-		log.Printf("[INFO] Enabling global VPC for transit group: %s", groupName)
+		tflog.Info(ctx, fmt.Sprintf("Enabling global VPC for transit group: %s", groupName))
 		if err := client.EnableGlobalVpcGatewayGroup(ctx, groupName); err != nil {
 			return fmt.Errorf("failed to enable global VPC: %w", err)
 		}
@@ -661,7 +663,7 @@ func applyTransitSpecificSettings(ctx context.Context, d *schema.ResourceData, c
 // ============================================================================
 
 func resourceAviatrixTransitGroupCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustGatewayClient(meta)
 
 	// approved_learned_cidrs can only be set during update, not during create
 	if approvedLearnedCidrs := getStringSet(d, "approved_learned_cidrs"); len(approvedLearnedCidrs) > 0 {
@@ -677,7 +679,7 @@ func resourceAviatrixTransitGroupCreate(ctx context.Context, d *schema.ResourceD
 	}
 
 	// Create the transit group
-	log.Printf("[INFO] Creating Transit Group: %#v", transitGroup)
+	tflog.Info(ctx, fmt.Sprintf("Creating Transit Group: %#v", goaviatrix.Redact(transitGroup)))
 	if err := client.CreateGatewayGroup(ctx, transitGroup); err != nil {
 		return diag.Errorf("failed to create transit group: %s", err)
 	}
@@ -724,7 +726,7 @@ func resourceAviatrixTransitGroupCreate(ctx context.Context, d *schema.ResourceD
 
 //nolint:cyclop,funlen
 func resourceAviatrixTransitGroupRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustGatewayClient(meta)
 
 	// The resource ID is the group UUID
 	groupUUID := d.Id()
@@ -732,7 +734,7 @@ func resourceAviatrixTransitGroupRead(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("resource ID (group UUID) is empty")
 	}
 
-	log.Printf("[INFO] Reading Transit Group: %s", groupUUID)
+	tflog.Info(ctx, fmt.Sprintf("Reading Transit Group: %s", groupUUID))
 
 	transitGroup, err := client.GetGatewayGroup(ctx, groupUUID)
 	if err != nil {
@@ -841,12 +843,12 @@ func resourceAviatrixTransitGroupRead(ctx context.Context, d *schema.ResourceDat
 
 //nolint:cyclop,funlen
 func resourceAviatrixTransitGroupUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustGatewayClient(meta)
 	groupName := getString(d, "group_name")
 	cloudType := getInt(d, "cloud_type")
 	groupUUID := d.Id()
 
-	log.Printf("[INFO] Updating Transit Group: %s (UUID: %s)", groupName, groupUUID)
+	tflog.Info(ctx, fmt.Sprintf("Updating Transit Group: %s (UUID: %s)", groupName, groupUUID))
 
 	// Validations for update
 	if getBool(d, "enable_active_standby_preemptive") && !getBool(d, "enable_active_standby") {
@@ -1422,19 +1424,19 @@ func resourceAviatrixTransitGroupUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceAviatrixTransitGroupDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustGatewayClient(meta)
 
 	// The resource ID is the group UUID
 	groupUUID := d.Id()
 	groupName := getString(d, "group_name")
 
-	log.Printf("[INFO] Deleting Transit Group: %s (UUID: %s)", groupName, groupUUID)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Transit Group: %s (UUID: %s)", groupName, groupUUID))
 
 	// Disable segmentation before deleting the group to allow re-creation with segmentation enabled
 	if getBool(d, "enable_segmentation") {
-		log.Printf("[INFO] Disabling segmentation for transit group before deletion: %s", groupName)
+		tflog.Info(ctx, fmt.Sprintf("Disabling segmentation for transit group before deletion: %s", groupName))
 		if err := client.DisableSegmentationGatewayGroup(ctx, groupName); err != nil {
-			log.Printf("[WARN] Failed to disable segmentation during transit group deletion: %s", err)
+			tflog.Warn(ctx, fmt.Sprintf("Failed to disable segmentation during transit group deletion: %s", err))
 		}
 	}
 
@@ -5,11 +5,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -24,7 +25,10 @@ func resourceAviatrixTransitInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		CustomizeDiff: resourceAviatrixTransitInstanceCustomizeDiff,
+		CustomizeDiff: customdiff.All(
+			resourceAviatrixTransitInstanceCustomizeDiff,
+			customizeDiffTagsAll(gatewayTagCloudTypes),
+		),
 
 		Schema: transitInstanceSchema(),
 	}
@@ -72,8 +76,7 @@ func resourceAviatrixTransitInstanceCreate(ctx context.Context, d *schema.Resour
 	}
 
 	cloudType := transitGroup.CloudType
-	log.Printf("[DEBUG] Transit group %s: CloudType=%d, AccountName=%s, VpcID=%s, VpcRegion=%s, GwUUIDList=%v",
-		groupUUID, cloudType, transitGroup.AccountName, transitGroup.VpcID, transitGroup.VpcRegion, transitGroup.GwUUIDList)
+	tflog.Debug(ctx, fmt.Sprintf("Transit group %s: CloudType=%d, AccountName=%s, VpcID=%s, VpcRegion=%s, GwUUIDList=%v", groupUUID, cloudType, transitGroup.AccountName, transitGroup.VpcID, transitGroup.VpcRegion, transitGroup.GwUUIDList))
 
 	// Set computed values from the transit group
 	mustSet(d, "group_name", transitGroup.GroupName)
@@ -114,7 +117,7 @@ func resourceAviatrixTransitInstanceCreate(ctx context.Context, d *schema.Resour
 		return diagErr
 	}
 
-	log.Printf("[INFO] Creating Aviatrix Transit Instance: %#v", config.gateway)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix Transit Instance: %#v", goaviatrix.Redact(config.gateway)))
 
 	// Use the create_mct_gateway API which handles both primary and HA based on group state
 	createdGwName, err := client.LaunchTransitInstance(config.gateway)
@@ -133,7 +136,7 @@ func resourceAviatrixTransitInstanceCreate(ctx context.Context, d *schema.Resour
 		return diag.Errorf("failed to get gateway name from API response")
 	}
 
-	if diagErr := configureTransitInstancePostCreate(d, client, config); diagErr != nil {
+	if diagErr := configureTransitInstancePostCreate(ctx, d, client, config); diagErr != nil {
 		return diagErr
 	}
 
@@ -151,7 +154,7 @@ func createEdgeTransitInstance(ctx context.Context, d *schema.ResourceData, clie
 		return fmt.Errorf("at least one interface is required for Edge Transit Instance")
 	}
 
-	interfacesList, err := getInterfaceDetails(interfaces, cloudType)
+	interfacesList, err := getInterfaceDetails(ctx, interfaces, cloudType)
 	if err != nil {
 		return fmt.Errorf("failed to get the interface details: %w", err)
 	}
@@ -203,7 +206,7 @@ func createEdgeTransitInstance(ctx context.Context, d *schema.ResourceData, clie
 		}
 	}
 
-	log.Printf("[INFO] Creating Aviatrix Edge Transit Instance: %#v", gateway)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix Edge Transit Instance: %#v", goaviatrix.Redact(gateway)))
 
 	createdGwName, err := client.LaunchTransitInstance(gateway)
 	if err != nil {
@@ -281,12 +284,12 @@ func buildTransitInstanceConfig(ctx context.Context, d *schema.ResourceData, cli
 
 	// Validate and configure RX queue size
 	rxQueueSize := getString(d, "rx_queue_size")
-	if rxQueueSize != "" && !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes) {
-		return nil, diag.Errorf("rx_queue_size only supports AWS related cloud types")
+	if rxQueueSize != "" && !featureRxQueueSize.supports(gateway.CloudType) {
+		return nil, diag.FromErr(featureRxQueueSize.unsupportedError())
 	}
 
 	// Configure tags
-	if err := configureTransitInstanceTags(d, gateway, cloudType); err != nil {
+	if err := configureTransitInstanceTags(d, gateway, cloudType, client.GetDefaultTagsConfig()); err != nil {
 		return nil, err
 	}
 
@@ -458,8 +461,8 @@ func validateAndConfigureMonitoring(d *schema.ResourceData, cloudType int) (bool
 		excludedInstances = append(excludedInstances, mustString(v))
 	}
 
-	if enableMonitorSubnets && !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes^goaviatrix.AWSChina) {
-		return false, nil, diag.Errorf("'enable_monitor_gateway_subnets' is only valid for AWS (1), AWSGov (256), AWS Top Secret (16384) or AWS Secret (32768)")
+	if enableMonitorSubnets && !featureMonitorGatewaySubnets.supports(cloudType) {
+		return false, nil, diag.FromErr(featureMonitorGatewaySubnets.unsupportedError())
 	}
 	if !enableMonitorSubnets && len(excludedInstances) != 0 {
 		return false, nil, diag.Errorf("'monitor_exclude_list' must be empty if 'enable_monitor_gateway_subnets' is false")
@@ -471,8 +474,8 @@ func validateAndConfigureMonitoring(d *schema.ResourceData, cloudType int) (bool
 // validateAndConfigureBgpOverLan validates and configures BGP over LAN settings
 func validateAndConfigureBgpOverLan(d *schema.ResourceData, gateway *goaviatrix.TransitVpc, cloudType int) diag.Diagnostics {
 	bgpOverLan := getBool(d, "enable_bgp_over_lan")
-	if bgpOverLan && !goaviatrix.IsCloudType(cloudType, goaviatrix.AzureArmRelatedCloudTypes|goaviatrix.GCP) {
-		return diag.Errorf("'enable_bgp_over_lan' is only valid for GCP (4), Azure (8), AzureGov (32) or AzureChina (2048)")
+	if bgpOverLan && !featureTransitBgpOverLan.supports(cloudType) {
+		return diag.FromErr(featureTransitBgpOverLan.unsupportedError())
 	}
 
 	bgpLanInterfacesCount, isCountSet := d.GetOk("bgp_lan_interfaces_count")
@@ -499,8 +502,8 @@ func validateAndConfigureSpotInstance(d *schema.ResourceData, gateway *goaviatri
 	deleteSpot := getBool(d, "delete_spot")
 
 	if enableSpotInstance {
-		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
-			return diag.Errorf("enable_spot_instance only supports AWS and Azure related cloud types")
+		if !featureSpotInstance.supports(gateway.CloudType) {
+			return diag.FromErr(featureSpotInstance.unsupportedError())
 		}
 		if !goaviatrix.IsCloudType(gateway.CloudType, goaviatrix.AzureArmRelatedCloudTypes) && deleteSpot {
 			return diag.Errorf("delete_spot only supports Azure")
@@ -515,14 +518,16 @@ func validateAndConfigureSpotInstance(d *schema.ResourceData, gateway *goaviatri
 	return nil
 }
 
-// configureTransitInstanceTags configures tags for the transit instance
-func configureTransitInstanceTags(d *schema.ResourceData, gateway *goaviatrix.TransitVpc, cloudType int) diag.Diagnostics {
+// configureTransitInstanceTags configures tags for the transit instance,
+// including the provider default tags
+func configureTransitInstanceTags(d *schema.ResourceData, gateway *goaviatrix.TransitVpc, cloudType int, defaultTagsConfig *goaviatrix.DefaultTagsConfig) diag.Diagnostics {
 	_, tagsOk := d.GetOk("tags")
-	if tagsOk {
+	defaultTags := defaultTagsFor(defaultTagsConfig, cloudType, gatewayTagCloudTypes)
+	if tagsOk || defaultTags != nil {
 		if !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
 			return diag.Errorf("error creating transit instance: adding tags is only supported for AWS (1), Azure (8), AzureGov (32), AWSGov (256), AWSChina (1024), AzureChina (2048), AWS Top Secret (16384) and AWS Secret (32768)")
 		}
-		tagsMap, err := extractTags(d, gateway.CloudType)
+		tagsMap, err := extractTags(d, gateway.CloudType, defaultTags)
 		if err != nil {
 			return diag.Errorf("error creating tags for transit instance: %v", err)
 		}
@@ -568,12 +573,12 @@ func configureTransitInstanceEIP(d *schema.ResourceData, gateway *goaviatrix.Tra
 }
 
 // configureTransitInstancePostCreate configures settings after the transit instance is created
-func configureTransitInstancePostCreate(d *schema.ResourceData, client *goaviatrix.Client, config *transitInstanceConfig) diag.Diagnostics {
+func configureTransitInstancePostCreate(ctx context.Context, d *schema.ResourceData, client *goaviatrix.Client, config *transitInstanceConfig) diag.Diagnostics {
 	gwName := getString(d, "gw_name")
 
 	// Disable single AZ HA if not enabled
 	if !config.singleAZ {
-		if err := disableTransitInstanceSingleAZHA(client, gwName); err != nil {
+		if err := disableTransitInstanceSingleAZHA(ctx, client, gwName); err != nil {
 			return err
 		}
 	}
@@ -582,7 +587,7 @@ func configureTransitInstancePostCreate(d *schema.ResourceData, client *goaviatr
 	// controller applies the group's desired state as the primary instance launches.
 
 	// Configure routing settings
-	if err := configureTransitInstanceRouting(client, d, config.gateway); err != nil {
+	if err := configureTransitInstanceRouting(ctx, client, d, config.gateway); err != nil {
 		return err
 	}
 
@@ -615,12 +620,12 @@ func configureTransitInstancePostCreate(d *schema.ResourceData, client *goaviatr
 }
 
 // disableTransitInstanceSingleAZHA disables single AZ HA for the transit instance
-func disableTransitInstanceSingleAZHA(client *goaviatrix.Client, gwName string) diag.Diagnostics {
+func disableTransitInstanceSingleAZHA(ctx context.Context, client *goaviatrix.Client, gwName string) diag.Diagnostics {
 	singleAZGateway := &goaviatrix.Gateway{
 		GwName:   gwName,
 		SingleAZ: "no",
 	}
-	log.Printf("[INFO] Disable Single AZ GW HA: %#v", singleAZGateway)
+	tflog.Info(ctx, fmt.Sprintf("Disable Single AZ GW HA: %#v", goaviatrix.Redact(singleAZGateway)))
 	if err := client.DisableSingleAZGateway(singleAZGateway); err != nil {
 		return diag.Errorf("failed to disable single AZ GW HA: %v", err)
 	}
@@ -628,7 +633,7 @@ func disableTransitInstanceSingleAZHA(client *goaviatrix.Client, gwName string)
 }
 
 // configureTransitInstanceRouting configures routing settings for the transit instance
-func configureTransitInstanceRouting(client *goaviatrix.Client, d *schema.ResourceData, gateway *goaviatrix.TransitVpc) diag.Diagnostics {
+func configureTransitInstanceRouting(ctx context.Context, client *goaviatrix.Client, d *schema.ResourceData, gateway *goaviatrix.TransitVpc) diag.Diagnostics {
 	gwName := getString(d, "gw_name")
 
 	// BGP manual spoke advertise cidrs
@@ -641,12 +646,12 @@ func configureTransitInstanceRouting(client *goaviatrix.Client, d *schema.Resour
 	}
 
 	// Filtered spoke vpc routes
-	if err := configureFilteredSpokeVpcRoutes(client, d, gwName); err != nil {
+	if err := configureFilteredSpokeVpcRoutes(ctx, client, d, gwName); err != nil {
 		return err
 	}
 
 	// Excluded advertised spoke routes
-	if err := configureExcludedAdvertisedSpokeRoutes(client, d, gwName); err != nil {
+	if err := configureExcludedAdvertisedSpokeRoutes(ctx, client, d, gwName); err != nil {
 		return err
 	}
 
@@ -665,7 +670,7 @@ func configureTransitInstanceRouting(client *goaviatrix.Client, d *schema.Resour
 }
 
 // configureFilteredSpokeVpcRoutes configures filtered spoke VPC routes with retry logic
-func configureFilteredSpokeVpcRoutes(client *goaviatrix.Client, d *schema.ResourceData, gwName string) diag.Diagnostics {
+func configureFilteredSpokeVpcRoutes(ctx context.Context, client *goaviatrix.Client, d *schema.ResourceData, gwName string) diag.Diagnostics {
 	filteredSpokeVpcRoutes := getString(d, "filtered_spoke_vpc_routes")
 	if filteredSpokeVpcRoutes == "" {
 		return nil
@@ -677,7 +682,7 @@ func configureFilteredSpokeVpcRoutes(client *goaviatrix.Client, d *schema.Resour
 	}
 
 	for i := 0; ; i++ {
-		log.Printf("[INFO] Editing filtered routes of transit instance: %s ", transitGateway.GwName)
+		tflog.Info(ctx, fmt.Sprintf("Editing filtered routes of transit instance: %s ", transitGateway.GwName))
 		err := client.EditGatewayFilterRoutes(transitGateway)
 		if err == nil {
 			break
@@ -693,7 +698,7 @@ func configureFilteredSpokeVpcRoutes(client *goaviatrix.Client, d *schema.Resour
 }
 
 // configureExcludedAdvertisedSpokeRoutes configures excluded advertised spoke routes with retry logic
-func configureExcludedAdvertisedSpokeRoutes(client *goaviatrix.Client, d *schema.ResourceData, gwName string) diag.Diagnostics {
+func configureExcludedAdvertisedSpokeRoutes(ctx context.Context, client *goaviatrix.Client, d *schema.ResourceData, gwName string) diag.Diagnostics {
 	advertisedSpokeRoutesExclude := getString(d, "excluded_advertised_spoke_routes")
 	if advertisedSpokeRoutesExclude == "" {
 		return nil
@@ -705,7 +710,7 @@ func configureExcludedAdvertisedSpokeRoutes(client *goaviatrix.Client, d *schema
 	}
 
 	for i := 0; ; i++ {
-		log.Printf("[INFO] Editing customized routes advertisement of transit instance: %s ", transitGateway.GwName)
+		tflog.Info(ctx, fmt.Sprintf("Editing customized routes advertisement of transit instance: %s ", transitGateway.GwName))
 		err := client.EditGatewayAdvertisedCidr(transitGateway)
 		if err == nil {
 			break
@@ -729,7 +734,7 @@ func resourceAviatrixTransitInstanceRead(ctx context.Context, d *schema.Resource
 	if gwName == "" {
 		isImport = true
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no gateway name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no gateway name received. Import Id is %s", id))
 		mustSet(d, "gw_name", id)
 		gwName = id
 		d.SetId(id)
@@ -749,7 +754,7 @@ func resourceAviatrixTransitInstanceRead(ctx context.Context, d *schema.Resource
 		return diag.Errorf("couldn't find Aviatrix Transit Instance: %v", err)
 	}
 
-	log.Printf("[TRACE] reading transit instance %s: %#v", getString(d, "gw_name"), gw)
+	tflog.Trace(ctx, fmt.Sprintf("reading transit instance %s: %#v", getString(d, "gw_name"), goaviatrix.Redact(gw)))
 	mustSet(d, "cloud_type", gw.CloudType)
 	mustSet(d, "account_name", gw.AccountName)
 	mustSet(d, "gw_name", gw.GwName)
@@ -795,7 +800,7 @@ func resourceAviatrixTransitInstanceRead(ctx context.Context, d *schema.Resource
 		}
 		// Set eip map
 		if gw.EipMap != nil {
-			log.Printf("[TRACE] eip map: %#v", gw.EipMap)
+			tflog.Trace(ctx, fmt.Sprintf("eip map: %#v", goaviatrix.Redact(gw.EipMap)))
 			eipMap, err := setEipMapDetails(gw.EipMap, gw.IfNamesTranslation)
 			if err != nil {
 				return diag.Errorf("could not set eip map details: %v", err)
@@ -870,7 +875,7 @@ func resourceAviatrixTransitInstanceRead(ctx context.Context, d *schema.Resource
 	// LAN interface CIDR
 	lanCidr, err := client.GetTransitGatewayLanCidr(gw.GwName)
 	if err != nil && !errors.Is(err, goaviatrix.ErrNotFound) {
-		log.Printf("[WARN] Error getting lan cidr for transit instance %s due to %s", gw.GwName, err)
+		tflog.Warn(ctx, fmt.Sprintf("Error getting lan cidr for transit instance %s due to %s", gw.GwName, err))
 	}
 	mustSet(d, "lan_interface_cidr", lanCidr)
 
@@ -890,7 +895,7 @@ func resourceAviatrixTransitInstanceRead(ctx context.Context, d *schema.Resource
 		if len(azureEip) == 3 {
 			mustSet(d, "azure_eip_name_resource_group", fmt.Sprintf("%s:%s", azureEip[0], azureEip[1]))
 		} else {
-			log.Printf("[WARN] could not get Azure EIP name and resource group for the Transit Instance %s", gw.GwName)
+			tflog.Warn(ctx, fmt.Sprintf("could not get Azure EIP name and resource group for the Transit Instance %s", gw.GwName))
 		}
 	}
 
@@ -1005,8 +1010,8 @@ func resourceAviatrixTransitInstanceRead(ctx context.Context, d *schema.Resource
 	// a perpetual "+ tags" plan diff (AVX-79035). This mirrors aviatrix_spoke_instance.
 	if gw.Tags != nil && goaviatrix.IsCloudType(gw.CloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
 		tags := goaviatrix.KeyValueTags(gw.Tags).IgnoreConfig(ignoreTagsConfig)
-		if err := d.Set("tags", tags); err != nil {
-			log.Printf("[WARN] Error setting tags for (%s): %s", d.Id(), err)
+		if err := setTagsAll(d, tags, client.GetDefaultTagsConfig()); err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Error setting tags for (%s): %s", d.Id(), err))
 		}
 	}
 
@@ -1039,7 +1044,7 @@ func resourceAviatrixTransitInstanceUpdate(ctx context.Context, d *schema.Resour
 		CloudType: getInt(d, "cloud_type"),
 		GwName:    getString(d, "gw_name"),
 	}
-	log.Printf("[INFO] Updating Aviatrix Transit Instance: %#v", gateway)
+	tflog.Info(ctx, fmt.Sprintf("Updating Aviatrix Transit Instance: %#v", goaviatrix.Redact(gateway)))
 
 	d.Partial(true)
 
@@ -1056,12 +1061,12 @@ func resourceAviatrixTransitInstanceUpdate(ctx context.Context, d *schema.Resour
 	} else {
 		// CSP transit gateway updates
 		// Update Single AZ HA
-		if err := updateTransitInstanceSingleAZHA(d, client); err != nil {
+		if err := updateTransitInstanceSingleAZHA(ctx, d, client); err != nil {
 			return err
 		}
 
 		// Update GW Size (not supported for edge)
-		if err := updateTransitInstanceSize(d, client, gateway); err != nil {
+		if err := updateTransitInstanceSize(ctx, d, client, gateway); err != nil {
 			return err
 		}
 	}
@@ -1121,7 +1126,7 @@ func validateTransitInstanceUpdateRestrictions(d *schema.ResourceData) diag.Diag
 }
 
 // updateTransitInstanceSingleAZHA updates Single AZ HA setting
-func updateTransitInstanceSingleAZHA(d *schema.ResourceData, client *goaviatrix.Client) diag.Diagnostics {
+func updateTransitInstanceSingleAZHA(ctx context.Context, d *schema.ResourceData, client *goaviatrix.Client) diag.Diagnostics {
 	if !d.HasChange("single_az_ha") {
 		return nil
 	}
@@ -1133,13 +1138,13 @@ func updateTransitInstanceSingleAZHA(d *schema.ResourceData, client *goaviatrix.
 
 	if singleAZ {
 		singleAZGateway.SingleAZ = "yes"
-		log.Printf("[INFO] Enable Single AZ GW HA: %#v", singleAZGateway)
+		tflog.Info(ctx, fmt.Sprintf("Enable Single AZ GW HA: %#v", goaviatrix.Redact(singleAZGateway)))
 		if err := client.EnableSingleAZGateway(singleAZGateway); err != nil {
 			return diag.Errorf("failed to enable single AZ GW HA for %s: %v", singleAZGateway.GwName, err)
 		}
 	} else {
 		singleAZGateway.SingleAZ = "no"
-		log.Printf("[INFO] Disable Single AZ GW HA: %#v", singleAZGateway)
+		tflog.Info(ctx, fmt.Sprintf("Disable Single AZ GW HA: %#v", goaviatrix.Redact(singleAZGateway)))
 		if err := client.DisableSingleAZGateway(singleAZGateway); err != nil {
 			return diag.Errorf("failed to disable single AZ GW HA for %s: %v", singleAZGateway.GwName, err)
 		}
@@ -1150,7 +1155,7 @@ func updateTransitInstanceSingleAZHA(d *schema.ResourceData, client *goaviatrix.
 
 // updateTransitInstanceTags updates tags for the transit instance
 func updateTransitInstanceTags(d *schema.ResourceData, client *goaviatrix.Client, gateway *goaviatrix.Gateway) diag.Diagnostics {
-	if !d.HasChange("tags") {
+	if !d.HasChanges("tags", "tags_all") {
 		return nil
 	}
 
@@ -1164,7 +1169,7 @@ func updateTransitInstanceTags(d *schema.ResourceData, client *goaviatrix.Client
 		CloudType:    gateway.CloudType,
 	}
 
-	tagsMap, err := extractTags(d, gateway.CloudType)
+	tagsMap, err := extractTags(d, gateway.CloudType, defaultTagsFor(client.GetDefaultTagsConfig(), gateway.CloudType, gatewayTagCloudTypes))
 	if err != nil {
 		return diag.Errorf("failed to update tags for transit instance: %v", err)
 	}
@@ -1184,13 +1189,13 @@ func updateTransitInstanceTags(d *schema.ResourceData, client *goaviatrix.Client
 }
 
 // updateTransitInstanceSize updates the gateway size
-func updateTransitInstanceSize(d *schema.ResourceData, client *goaviatrix.Client, gateway *goaviatrix.Gateway) diag.Diagnostics {
+func updateTransitInstanceSize(ctx context.Context, d *schema.ResourceData, client *goaviatrix.Client, gateway *goaviatrix.Gateway) diag.Diagnostics {
 	if !d.HasChange("gw_size") {
 		return nil
 	}
 
 	gateway.VpcSize = getString(d, "gw_size")
-	if err := client.UpdateGateway(gateway); err != nil {
+	if err := client.UpdateGatewayContext(ctx, gateway); err != nil {
 		return diag.Errorf("failed to update Aviatrix Transit Instance size: %v", err)
 	}
 
@@ -1398,7 +1403,7 @@ func updateEdgeTransitInstance(ctx context.Context, d *schema.ResourceData, clie
 	}
 
 	// Update interfaces and management egress IP prefix list
-	if err := updateEdgeTransitInstanceInterfaces(d, client, cloudType, gwName); err != nil {
+	if err := updateEdgeTransitInstanceInterfaces(ctx, d, client, cloudType, gwName); err != nil {
 		return err
 	}
 
@@ -1422,14 +1427,14 @@ func validateEdgeTransitInstanceUpdateRestrictions(d *schema.ResourceData) diag.
 }
 
 // updateEdgeTransitInstanceInterfaces updates edge transit gateway interfaces
-func updateEdgeTransitInstanceInterfaces(d *schema.ResourceData, client *goaviatrix.Client, cloudType int, gwName string) diag.Diagnostics {
+func updateEdgeTransitInstanceInterfaces(ctx context.Context, d *schema.ResourceData, client *goaviatrix.Client, cloudType int, gwName string) diag.Diagnostics {
 	if !d.HasChanges("interfaces", "management_egress_ip_prefix_list") {
 		return nil
 	}
 
 	interfaceList := getSet(d, "interfaces").List()
 	interfaceList = filterCloudManagedInterfaces(interfaceList, cloudType)
-	interfaces, err := getInterfaceDetails(interfaceList, cloudType)
+	interfaces, err := getInterfaceDetails(ctx, interfaceList, cloudType)
 	if err != nil {
 		return diag.Errorf("failed to get interface details: %v", err)
 	}
@@ -1462,7 +1467,7 @@ func updateEdgeTransitInstanceEipMap(ctx context.Context, d *schema.ResourceData
 		return nil
 	}
 
-	eipMapList, err := getEipMapDetails(eipMap, wanCount, cloudType)
+	eipMapList, err := getEipMapDetails(ctx, eipMap, wanCount, cloudType)
 	if err != nil {
 		return diag.Errorf("failed to get the eip map details: %v", err)
 	}
@@ -1472,7 +1477,7 @@ func updateEdgeTransitInstanceEipMap(ctx context.Context, d *schema.ResourceData
 	}
 
 	if cloudType == goaviatrix.EDGEMEGAPORT {
-		log.Printf("[INFO] EIP Map for Edge Mega Port: %#v", eipMapList)
+		tflog.Info(ctx, fmt.Sprintf("EIP Map for Edge Mega Port: %#v", goaviatrix.Redact(eipMapList)))
 		gateway.LogicalEipMap = eipMapList
 		gateway.CloudType = cloudType
 		updateCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
@@ -1502,7 +1507,7 @@ func resourceAviatrixTransitInstanceDelete(ctx context.Context, d *schema.Resour
 		GwName:    getString(d, "gw_name"),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix Transit Instance: %#v", gateway)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix Transit Instance: %#v", goaviatrix.Redact(gateway)))
 
 	err := client.DeleteGateway(gateway)
 	if err != nil {
@@ -109,6 +109,7 @@ func transitInstanceOptionalBasicSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "A map of tags to assign to the transit gateway.",
 		},
+		"tags_all": tagsAllSchema(),
 		"tunnel_detection_time": {
 			Type:         schema.TypeInt,
 			Optional:     true,
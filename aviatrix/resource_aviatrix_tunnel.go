@@ -1,11 +1,12 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -13,10 +14,10 @@ import (
 
 func resourceAviatrixTunnel() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixTunnelCreate,
-		Read:   resourceAviatrixTunnelRead,
-		Update: resourceAviatrixTunnelUpdate,
-		Delete: resourceAviatrixTunnelDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixTunnelCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixTunnelRead),
+		UpdateWithoutTimeout: withContext(resourceAviatrixTunnelUpdate),
+		DeleteWithoutTimeout: withContext(resourceAviatrixTunnelDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -65,7 +66,7 @@ func tunnelID(d *schema.ResourceData) string {
 	return getString(d, "gw_name1") + "~" + getString(d, "gw_name2")
 }
 
-func resourceAviatrixTunnelCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixTunnelCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	tunnel := &goaviatrix.Tunnel{
@@ -83,29 +84,29 @@ func resourceAviatrixTunnelCreate(d *schema.ResourceData, meta any) error {
 		tunnel.EnableHA = "no"
 	}
 
-	log.Printf("[INFO] Creating Aviatrix tunnel: %#v", tunnel)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix tunnel: %#v", goaviatrix.Redact(tunnel)))
 
 	d.SetId(tunnelID(d))
 	flag := false
-	defer func() { _ = resourceAviatrixTunnelReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixTunnelReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	err := client.CreateTunnel(tunnel)
 	if err != nil {
 		return fmt.Errorf("failed to create Aviatrix Tunnel: %w", err)
 	}
 
-	return resourceAviatrixTunnelReadIfRequired(d, meta, &flag)
+	return resourceAviatrixTunnelReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixTunnelReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixTunnelReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixTunnelRead(d, meta)
+		return resourceAviatrixTunnelRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixTunnelRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixTunnelRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	vpcName1 := getString(d, "gw_name1")
@@ -113,7 +114,7 @@ func resourceAviatrixTunnelRead(d *schema.ResourceData, meta any) error {
 
 	if vpcName1 == "" || vpcName2 == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no vpc names received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no vpc names received. Import Id is %s", id))
 		mustSet(d, "gw_name1", strings.Split(id, "~")[0])
 		mustSet(d, "gw_name2", strings.Split(id, "~")[1])
 		d.SetId(id)
@@ -131,7 +132,7 @@ func resourceAviatrixTunnelRead(d *schema.ResourceData, meta any) error {
 		}
 		return fmt.Errorf("couldn't find Aviatrix Tunnel: %w", err)
 	}
-	log.Printf("[INFO] Found Aviatrix tunnel: %#v", tun)
+	tflog.Info(ctx, fmt.Sprintf("Found Aviatrix tunnel: %#v", goaviatrix.Redact(tun)))
 	mustSet(d, "peering_hastatus", tun.PeeringHaStatus)
 	mustSet(d, "peering_state", tun.PeeringState)
 	mustSet(d, "peering_link", tun.PeeringLink)
@@ -146,7 +147,7 @@ func resourceAviatrixTunnelRead(d *schema.ResourceData, meta any) error {
 	return nil
 }
 
-func resourceAviatrixTunnelUpdate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixTunnelUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	tunnel := &goaviatrix.Tunnel{
@@ -157,7 +158,7 @@ func resourceAviatrixTunnelUpdate(d *schema.ResourceData, meta any) error {
 		PeeringLink:     getString(d, "peering_link"),
 	}
 
-	log.Printf("[INFO] Updating Aviatrix tunnel: %#v", tunnel)
+	tflog.Info(ctx, fmt.Sprintf("Updating Aviatrix tunnel: %#v", goaviatrix.Redact(tunnel)))
 
 	err := client.UpdateTunnel(tunnel)
 	if err != nil {
@@ -165,10 +166,10 @@ func resourceAviatrixTunnelUpdate(d *schema.ResourceData, meta any) error {
 	}
 
 	d.SetId(tunnelID(d))
-	return resourceAviatrixTunnelRead(d, meta)
+	return resourceAviatrixTunnelRead(ctx, d, meta)
 }
 
-func resourceAviatrixTunnelDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixTunnelDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	tunnel := &goaviatrix.Tunnel{
@@ -176,12 +177,12 @@ func resourceAviatrixTunnelDelete(d *schema.ResourceData, meta any) error {
 		VpcName2: getString(d, "gw_name2"),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix tunnel: %#v", tunnel)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix tunnel: %#v", goaviatrix.Redact(tunnel)))
 
 	if peeringHaStatus := getString(d, "peering_hastatus"); peeringHaStatus == "active" {
 		// parse the hagw name
-		tunnel.VpcName1 += "-hagw"
-		tunnel.VpcName2 += "-hagw"
+		tunnel.VpcName1 = goaviatrix.HaGatewayName(tunnel.VpcName1)
+		tunnel.VpcName2 = goaviatrix.HaGatewayName(tunnel.VpcName2)
 		err := client.DeleteTunnel(tunnel)
 		if err != nil {
 			return fmt.Errorf("failed to delete Aviatrix HA gateway: %w", err)
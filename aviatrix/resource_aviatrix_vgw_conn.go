@@ -1,12 +1,13 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -15,10 +16,10 @@ import (
 
 func resourceAviatrixVGWConn() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixVGWConnCreate,
-		Read:   resourceAviatrixVGWConnRead,
-		Update: resourceAviatrixVGWConnUpdate,
-		Delete: resourceAviatrixVGWConnDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixVGWConnCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixVGWConnRead),
+		UpdateWithoutTimeout: withContext(resourceAviatrixVGWConnUpdate),
+		DeleteWithoutTimeout: withContext(resourceAviatrixVGWConnDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -68,7 +69,7 @@ func resourceAviatrixVGWConn() *schema.Resource {
 				Required:     true,
 				ForceNew:     true,
 				Description:  "BGP local ASN (Autonomous System Number). Integer between 1-4294967294.",
-				ValidateFunc: goaviatrix.ValidateASN,
+				ValidateFunc: ValidateASN,
 			},
 			"enable_learned_cidrs_approval": {
 				Type:     schema.TypeBool,
@@ -98,7 +99,7 @@ func resourceAviatrixVGWConn() *schema.Resource {
 				Description: "Connection AS Path Prepend customized by specifying AS PATH for a BGP connection.",
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: goaviatrix.ValidateASN,
+					ValidateFunc: ValidateASN,
 				},
 				MaxItems: 25,
 			},
@@ -106,7 +107,7 @@ func resourceAviatrixVGWConn() *schema.Resource {
 	}
 }
 
-func resourceAviatrixVGWConnCreate(d *schema.ResourceData, meta any) (err error) {
+func resourceAviatrixVGWConnCreate(ctx context.Context, d *schema.ResourceData, meta any) (err error) {
 	client := mustClient(meta)
 
 	vgwConn := &goaviatrix.VGWConn{
@@ -119,11 +120,11 @@ func resourceAviatrixVGWConnCreate(d *schema.ResourceData, meta any) (err error)
 		BgpLocalAsNum: getString(d, "bgp_local_as_num"),
 	}
 
-	log.Printf("[INFO] Creating Aviatrix VGW Connection: %#v", vgwConn)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix VGW Connection: %#v", goaviatrix.Redact(vgwConn)))
 
 	d.SetId(vgwConn.ConnName + "~" + vgwConn.VPCId)
 	flag := false
-	defer func() { _ = resourceAviatrixVGWConnReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixVGWConnReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	try, maxTries, backoff := 0, 8, 1000*time.Millisecond
 	for {
@@ -178,25 +179,25 @@ func resourceAviatrixVGWConnCreate(d *schema.ResourceData, meta any) (err error)
 		}
 	}
 
-	return resourceAviatrixVGWConnReadIfRequired(d, meta, &flag)
+	return resourceAviatrixVGWConnReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixVGWConnReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixVGWConnReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixVGWConnRead(d, meta)
+		return resourceAviatrixVGWConnRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixVGWConnRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixVGWConnRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	connName := getString(d, "conn_name")
 	vpcID := getString(d, "vpc_id")
 	if connName == "" || vpcID == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no connection name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no connection name received. Import Id is %s", id))
 		mustSet(d, "conn_name", strings.Split(id, "~")[0])
 		mustSet(d, "vpc_id", strings.Split(id, "~")[1])
 		d.SetId(id)
@@ -214,7 +215,7 @@ func resourceAviatrixVGWConnRead(d *schema.ResourceData, meta any) error {
 		}
 		return fmt.Errorf("couldn't find Aviatrix VGW Connection: %w", err)
 	}
-	log.Printf("[INFO] Found Aviatrix VGW Connection: %#v", vConn)
+	tflog.Info(ctx, fmt.Sprintf("Found Aviatrix VGW Connection: %#v", goaviatrix.Redact(vConn)))
 	mustSet(d, "conn_name", vConn.ConnName)
 	mustSet(d, "gw_name", vConn.GwName)
 	mustSet(d, "vpc_id", vConn.VPCId)
@@ -254,7 +255,7 @@ func resourceAviatrixVGWConnRead(d *schema.ResourceData, meta any) error {
 	return nil
 }
 
-func resourceAviatrixVGWConnUpdate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixVGWConnUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 	d.Partial(true)
 
@@ -311,10 +312,10 @@ func resourceAviatrixVGWConnUpdate(d *schema.ResourceData, meta any) error {
 	}
 
 	d.Partial(false)
-	return resourceAviatrixVGWConnRead(d, meta)
+	return resourceAviatrixVGWConnRead(ctx, d, meta)
 }
 
-func resourceAviatrixVGWConnDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixVGWConnDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	vgwConn := &goaviatrix.VGWConn{
@@ -322,11 +323,11 @@ func resourceAviatrixVGWConnDelete(d *schema.ResourceData, meta any) error {
 		VPCId:    getString(d, "vpc_id"),
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix vgw_conn: %#v", vgwConn)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix vgw_conn: %#v", goaviatrix.Redact(vgwConn)))
 
 	err := client.DeleteVGWConn(vgwConn)
 	if err != nil {
-		if strings.Contains(err.Error(), "does not exist") {
+		if errors.Is(err, goaviatrix.ErrNotFound) {
 			return nil
 		}
 		return fmt.Errorf("failed to delete Aviatrix VGWConn: %w", err)
@@ -1,12 +1,13 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"net"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -14,10 +15,10 @@ import (
 
 func resourceAviatrixVpc() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixVpcCreate,
-		Read:   resourceAviatrixVpcRead,
-		Update: resourceAviatrixVpcUpdate,
-		Delete: resourceAviatrixVpcDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixVpcCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixVpcRead),
+		Update:               resourceAviatrixVpcUpdate,
+		DeleteWithoutTimeout: withContext(resourceAviatrixVpcDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -294,7 +295,7 @@ func resourceAviatrixVpc() *schema.Resource {
 	}
 }
 
-func resourceAviatrixVpcCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixVpcCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	vpc := &goaviatrix.Vpc{
@@ -355,18 +356,18 @@ func resourceAviatrixVpcCreate(d *schema.ResourceData, meta any) error {
 
 	if aviatrixTransitVpc {
 		vpc.AviatrixTransitVpc = "yes"
-		log.Printf("[INFO] Creating a new Aviatrix Transit VPC: %#v", vpc)
+		tflog.Info(ctx, fmt.Sprintf("Creating a new Aviatrix Transit VPC: %#v", goaviatrix.Redact(vpc)))
 	} else {
 		vpc.AviatrixTransitVpc = "no"
 	}
 	if aviatrixFireNetVpc {
 		vpc.AviatrixFireNetVpc = "yes"
-		log.Printf("[INFO] Creating a new Aviatrix FireNet VPC: %#v", vpc)
+		tflog.Info(ctx, fmt.Sprintf("Creating a new Aviatrix FireNet VPC: %#v", goaviatrix.Redact(vpc)))
 	} else {
 		vpc.AviatrixFireNetVpc = "no"
 	}
 	if !aviatrixTransitVpc && !aviatrixFireNetVpc {
-		log.Printf("[INFO] Creating a new VPC: %#v", vpc)
+		tflog.Info(ctx, fmt.Sprintf("Creating a new VPC: %#v", goaviatrix.Redact(vpc)))
 	}
 
 	ipv6Enabled := getBool(d, "enable_ipv6")
@@ -411,7 +412,7 @@ func resourceAviatrixVpcCreate(d *schema.ResourceData, meta any) error {
 			return fmt.Errorf("error creating vpc: enable_ipv6 is not supported, %w", err)
 		}
 		vpc.EnableIpv6 = true
-		log.Printf("[INFO] Enabling IPv6 in VPC: %#v", vpc)
+		tflog.Info(ctx, fmt.Sprintf("Enabling IPv6 in VPC: %#v", goaviatrix.Redact(vpc)))
 
 		// Handle ipv6_access_type for Azure
 		if goaviatrix.IsCloudType(vpc.CloudType, goaviatrix.AzureArmRelatedCloudTypes) {
@@ -443,7 +444,7 @@ func resourceAviatrixVpcCreate(d *schema.ResourceData, meta any) error {
 	d.SetId(vpc.Name)
 
 	// We intentionally call the Read function early here to load 'vpc_id' for later use
-	err = resourceAviatrixVpcRead(d, meta)
+	err = resourceAviatrixVpcRead(ctx, d, meta)
 	if err != nil {
 		return err
 	}
@@ -459,16 +460,16 @@ func resourceAviatrixVpcCreate(d *schema.ResourceData, meta any) error {
 	return nil
 }
 
-func resourceAviatrixVpcRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixVpcRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	vpcName := getString(d, "name")
 	if vpcName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no vpc names received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no vpc names received. Import Id is %s", id))
 		mustSet(d, "name", id)
 		d.SetId(id)
-		return resourceAviatrixVpcRead(d, meta)
+		return resourceAviatrixVpcRead(ctx, d, meta)
 	}
 
 	vpc := &goaviatrix.Vpc{
@@ -484,7 +485,7 @@ func resourceAviatrixVpcRead(d *schema.ResourceData, meta any) error {
 		return fmt.Errorf("couldn't find VPC: %w", err)
 	}
 
-	log.Printf("[INFO] Found VPC: %#v", vpc)
+	tflog.Info(ctx, fmt.Sprintf("Found VPC: %#v", goaviatrix.Redact(vpc)))
 	mustSet(d, "cloud_type", vC.CloudType)
 	mustSet(d, "account_name", vC.AccountName)
 	mustSet(d, "name", vC.Name)
@@ -546,7 +547,7 @@ func resourceAviatrixVpcRead(d *schema.ResourceData, meta any) error {
 
 	subnetsForState := buildSubnetsForState(vC.CloudType, vC.Subnets, getList(d, "subnets"))
 	if err := d.Set("subnets", subnetsForState); err != nil {
-		log.Printf("[WARN] Error setting 'subnets' for (%s): %s", d.Id(), err)
+		tflog.Warn(ctx, fmt.Sprintf("Error setting 'subnets' for (%s): %s", d.Id(), err))
 	}
 
 	var privateSubnets []map[string]any
@@ -560,7 +561,7 @@ func resourceAviatrixVpcRead(d *schema.ResourceData, meta any) error {
 		privateSubnets = append(privateSubnets, subnetInfo)
 	}
 	if err := d.Set("private_subnets", privateSubnets); err != nil {
-		log.Printf("[WARN] Error setting 'private_subnets' for (%s): %s", d.Id(), err)
+		tflog.Warn(ctx, fmt.Sprintf("Error setting 'private_subnets' for (%s): %s", d.Id(), err))
 	}
 
 	var publicSubnets []map[string]any
@@ -574,7 +575,7 @@ func resourceAviatrixVpcRead(d *schema.ResourceData, meta any) error {
 		publicSubnets = append(publicSubnets, subnetInfo)
 	}
 	if err := d.Set("public_subnets", publicSubnets); err != nil {
-		log.Printf("[WARN] Error setting 'public_subnets' for (%s): %s", d.Id(), err)
+		tflog.Warn(ctx, fmt.Sprintf("Error setting 'public_subnets' for (%s): %s", d.Id(), err))
 	}
 
 	d.SetId(vC.Name)
@@ -600,7 +601,7 @@ func resourceAviatrixVpcRead(d *schema.ResourceData, meta any) error {
 		}
 
 		if err := d.Set("route_tables", rtbs); err != nil {
-			log.Printf("[WARN] Error setting route tables for (%s): %s", d.Id(), err)
+			tflog.Warn(ctx, fmt.Sprintf("Error setting route tables for (%s): %s", d.Id(), err))
 		}
 	} else {
 		mustSet(d, "route_tables", []string{})
@@ -726,7 +727,7 @@ func resourceAviatrixVpcUpdate(d *schema.ResourceData, meta any) error {
 	return nil
 }
 
-func resourceAviatrixVpcDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixVpcDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	vpc := &goaviatrix.Vpc{
@@ -735,7 +736,7 @@ func resourceAviatrixVpcDelete(d *schema.ResourceData, meta any) error {
 		VpcID:       getString(d, "vpc_id"),
 	}
 
-	log.Printf("[INFO] Deleting VPC: %#v", vpc)
+	tflog.Info(ctx, fmt.Sprintf("Deleting VPC: %#v", goaviatrix.Redact(vpc)))
 
 	if getBool(d, "enable_native_gwlb") {
 		err := client.DisableNativeAwsGwlbFirenet(vpc)
@@ -1,33 +1,37 @@
 package aviatrix
 
 import (
+	"context"
 	"fmt"
-	"log"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
 )
 
 func resourceAviatrixVpcMigrateState(
 	v int, is *terraform.InstanceState, _ any,
 ) (*terraform.InstanceState, error) {
+	ctx := providerLogContext()
 	switch v {
 	case 0:
-		log.Println("[INFO] Found AVIATRIX Vpc State v0; migrating to v1")
-		return migrateVpcStateV0toV1(is)
+		tflog.Info(ctx, "Found AVIATRIX Vpc State v0; migrating to v1")
+		return migrateVpcStateV0toV1(ctx, is)
 	default:
 		return is, fmt.Errorf("unexpected schema version: %d", v)
 	}
 }
 
-func migrateVpcStateV0toV1(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+func migrateVpcStateV0toV1(ctx context.Context, is *terraform.InstanceState) (*terraform.InstanceState, error) {
 	if is.Empty() || is.Attributes == nil {
-		log.Println("[DEBUG] Empty Vpc State; nothing to migrate.")
+		tflog.Debug(ctx, "Empty Vpc State; nothing to migrate.")
 		return is, nil
 	}
-	log.Printf("[DEBUG] Attributes before migration: %#v", is.Attributes)
+	tflog.Debug(ctx, fmt.Sprintf("Attributes before migration: %#v", goaviatrix.Redact(is.Attributes)))
 
 	is.Attributes["aviatrix_firenet_vpc"] = "false"
 
-	log.Printf("[DEBUG] Attributes after migration: %#v", is.Attributes)
+	tflog.Debug(ctx, fmt.Sprintf("Attributes after migration: %#v", goaviatrix.Redact(is.Attributes)))
 	return is, nil
 }
@@ -1,10 +1,11 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -12,10 +13,10 @@ import (
 
 func resourceAviatrixProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixProfileCreate,
-		Read:   resourceAviatrixProfileRead,
-		Update: resourceAviatrixProfileUpdate,
-		Delete: resourceAviatrixProfileDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixProfileCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixProfileRead),
+		UpdateWithoutTimeout: withContext(resourceAviatrixProfileUpdate),
+		DeleteWithoutTimeout: withContext(resourceAviatrixProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -84,10 +85,10 @@ func resourceAviatrixProfile() *schema.Resource {
 	}
 }
 
-func resourceAviatrixProfileCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixProfileCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
-	log.Printf("[INFO] Creating Aviatrix Profile: %v %T", d.Get("users"), d.Get("users"))
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix Profile: %v %T", goaviatrix.Redact(d.Get("users")), goaviatrix.Redact(d.Get("users"))))
 
 	profile := &goaviatrix.Profile{
 		Name:     getString(d, "name"),
@@ -109,7 +110,7 @@ func resourceAviatrixProfileCreate(d *schema.ResourceData, meta any) error {
 		}
 	}
 
-	log.Printf("[INFO] Creating Aviatrix Profile with users: %v", profile.UserList)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix Profile with users: %v", profile.UserList))
 
 	names := getList(d, "policy")
 	for _, domain := range names {
@@ -129,35 +130,35 @@ func resourceAviatrixProfileCreate(d *schema.ResourceData, meta any) error {
 		}
 	}
 
-	log.Printf("[INFO] Creating Aviatrix Profile with Policy: %v", profile.Policy)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix Profile with Policy: %v", goaviatrix.Redact(profile.Policy)))
 
 	d.SetId(profile.Name)
 	flag := false
-	defer func() { _ = resourceAviatrixProfileReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixProfileReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	err := client.CreateProfile(profile)
 	if err != nil {
 		return fmt.Errorf("failed to create Aviatrix Profile: %w", err)
 	}
 
-	return resourceAviatrixProfileReadIfRequired(d, meta, &flag)
+	return resourceAviatrixProfileReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixProfileReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixProfileReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixProfileRead(d, meta)
+		return resourceAviatrixProfileRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixProfileRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixProfileRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	profileName := getString(d, "name")
 	if profileName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no profile name received. Import Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no profile name received. Import Id is %s", id))
 		mustSet(d, "name", id)
 		mustSet(d, "manage_user_attachment", true)
 		d.SetId(id)
@@ -174,7 +175,7 @@ func resourceAviatrixProfileRead(d *schema.ResourceData, meta any) error {
 	}
 	mustSet(d, "base_rule", profileBase.BaseRule)
 
-	log.Printf("[INFO] Reading Aviatrix Profile: %#v", profile)
+	tflog.Info(ctx, fmt.Sprintf("Reading Aviatrix Profile: %#v", goaviatrix.Redact(profile)))
 	profile, err := client.GetProfile(profile)
 	if err != nil {
 		if errors.Is(err, goaviatrix.ErrNotFound) {
@@ -184,7 +185,7 @@ func resourceAviatrixProfileRead(d *schema.ResourceData, meta any) error {
 		return fmt.Errorf("couldn't find profile: %w", err)
 	}
 	mustSet(d, "name", profile.Name)
-	log.Printf("[TRACE] Profile policy %v", profile.Policy)
+	tflog.Trace(ctx, fmt.Sprintf("Profile policy %v", goaviatrix.Redact(profile.Policy)))
 
 	manageUserAttachment := getBool(d, "manage_user_attachment")
 	if manageUserAttachment {
@@ -197,10 +198,10 @@ func resourceAviatrixProfileRead(d *schema.ResourceData, meta any) error {
 			mustSet(d, "users", users)
 		} else {
 			mustSet(d, "users", profile.UserList)
-			log.Printf("[TRACE] Profile userlistnew %v", profile.UserList)
+			tflog.Trace(ctx, fmt.Sprintf("Profile userlistnew %v", profile.UserList))
 		}
 	}
-	log.Printf("[TRACE] Profile policy %v", profile.Policy)
+	tflog.Trace(ctx, fmt.Sprintf("Profile policy %v", goaviatrix.Redact(profile.Policy)))
 
 	var Policies []map[string]any
 	for _, policy := range profile.Policy {
@@ -213,15 +214,15 @@ func resourceAviatrixProfileRead(d *schema.ResourceData, meta any) error {
 	}
 
 	if err := d.Set("policy", Policies); err != nil {
-		log.Printf("[WARN] Error setting policy for (%s): %s", d.Id(), err)
+		tflog.Warn(ctx, fmt.Sprintf("Error setting policy for (%s): %s", d.Id(), err))
 	}
-	log.Printf("[INFO] Generated policies: %v", Policies)
+	tflog.Info(ctx, fmt.Sprintf("Generated policies: %v", goaviatrix.Redact(Policies)))
 
 	d.SetId(profile.Name)
 	return nil
 }
 
-func resourceAviatrixProfileUpdate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixProfileUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	profile := &goaviatrix.Profile{
@@ -243,7 +244,7 @@ func resourceAviatrixProfileUpdate(d *schema.ResourceData, meta any) error {
 		for _, user := range getList(d, "users") {
 			profile.UserList = append(profile.UserList, mustString(user))
 		}
-		log.Printf("[INFO] Creating Aviatrix Profile with users: %v", profile.UserList)
+		tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix Profile with users: %v", profile.UserList))
 	}
 	names := getList(d, "policy")
 	for _, domain := range names {
@@ -261,7 +262,7 @@ func resourceAviatrixProfileUpdate(d *schema.ResourceData, meta any) error {
 		profile.Policy = append(profile.Policy, *profileRule)
 	}
 
-	log.Printf("[INFO] Reading Aviatrix Profile: %#v", profile)
+	tflog.Info(ctx, fmt.Sprintf("Reading Aviatrix Profile: %#v", goaviatrix.Redact(profile)))
 
 	if d.HasChange("name") {
 		return fmt.Errorf("cannot change name of a profile")
@@ -272,7 +273,7 @@ func resourceAviatrixProfileUpdate(d *schema.ResourceData, meta any) error {
 	if manageUserAttachment {
 		if d.HasChange("users") {
 			oldU, newU := d.GetChange("users")
-			log.Printf("[INFO] Users to be attached : %#v %#v ", oldU, newU)
+			tflog.Info(ctx, fmt.Sprintf("Users to be attached : %#v %#v ", goaviatrix.Redact(oldU), goaviatrix.Redact(newU)))
 
 			if oldU == nil {
 				oldU = new([]any)
@@ -286,7 +287,7 @@ func resourceAviatrixProfileUpdate(d *schema.ResourceData, meta any) error {
 			newUserList := goaviatrix.ExpandStringList(newString)
 			// Attach all the newly added Users
 			toAddUsers := goaviatrix.Difference(newUserList, oldUserList)
-			log.Printf("[INFO] Users to be attached : %#v", toAddUsers)
+			tflog.Info(ctx, fmt.Sprintf("Users to be attached : %#v", toAddUsers))
 			profile.UserList = toAddUsers
 			err := client.AttachUsers(profile)
 			if err != nil {
@@ -294,7 +295,7 @@ func resourceAviatrixProfileUpdate(d *schema.ResourceData, meta any) error {
 			}
 			// Detach all the removed Users
 			toDelGws := goaviatrix.Difference(oldUserList, newUserList)
-			log.Printf("[INFO] Users to be detached : %#v", toDelGws)
+			tflog.Info(ctx, fmt.Sprintf("Users to be detached : %#v", toDelGws))
 			profile.UserList = toDelGws
 			err = client.DetachUsers(profile)
 			if err != nil {
@@ -307,7 +308,7 @@ func resourceAviatrixProfileUpdate(d *schema.ResourceData, meta any) error {
 		}
 	}
 
-	log.Printf("[INFO] Checking for policy changes")
+	tflog.Info(ctx, "Checking for policy changes")
 	if d.HasChange("policy") {
 		err := client.UpdateProfilePolicy(profile)
 		if err != nil {
@@ -319,15 +320,15 @@ func resourceAviatrixProfileUpdate(d *schema.ResourceData, meta any) error {
 	return nil
 }
 
-func resourceAviatrixProfileDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixProfileDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	profile := &goaviatrix.Profile{
 		Name: getString(d, "name"),
 	}
-	log.Printf("[INFO] Deleting Aviatrix Profile: %#v", profile)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix Profile: %#v", goaviatrix.Redact(profile)))
 	if _, ok := d.GetOk("users"); ok {
-		log.Printf("[INFO] Found users: %#v", d.Get("users"))
+		tflog.Info(ctx, fmt.Sprintf("Found users: %#v", goaviatrix.Redact(d.Get("users"))))
 
 		profile.UserList = goaviatrix.ExpandStringList(getList(d, "users"))
 		err := client.DetachUsers(profile)
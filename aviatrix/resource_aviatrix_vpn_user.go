@@ -1,10 +1,11 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -12,10 +13,10 @@ import (
 
 func resourceAviatrixVPNUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixVPNUserCreate,
-		Read:   resourceAviatrixVPNUserRead,
-		Update: resourceAviatrixVPNUserUpdate,
-		Delete: resourceAviatrixVPNUserDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixVPNUserCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixVPNUserRead),
+		UpdateWithoutTimeout: withContext(resourceAviatrixVPNUserUpdate),
+		DeleteWithoutTimeout: withContext(resourceAviatrixVPNUserDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -83,7 +84,7 @@ func resourceAviatrixVPNUser() *schema.Resource {
 	}
 }
 
-func resourceAviatrixVPNUserCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixVPNUserCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	vpnUser := &goaviatrix.VPNUser{
@@ -113,12 +114,12 @@ func resourceAviatrixVPNUserCreate(d *schema.ResourceData, meta any) error {
 		return fmt.Errorf("'manage_user_attachment' is set false. Please empty 'profiles' and manage user attachment in other resource")
 	}
 
-	log.Printf("[INFO] Creating Aviatrix VPN User: %#v", vpnUser)
+	tflog.Info(ctx, fmt.Sprintf("Creating Aviatrix VPN User: %#v", goaviatrix.Redact(vpnUser)))
 
 	d.SetId(vpnUser.UserName)
 
 	flag := false
-	defer func() { _ = resourceAviatrixVPNUserReadIfRequired(d, meta, &flag) }() //nolint:errcheck // read on deferred path
+	defer func() { _ = resourceAviatrixVPNUserReadIfRequired(ctx, d, meta, &flag) }() //nolint:errcheck // read on deferred path
 
 	err := client.CreateVPNUser(vpnUser)
 	if err != nil {
@@ -138,24 +139,24 @@ func resourceAviatrixVPNUserCreate(d *schema.ResourceData, meta any) error {
 		}
 	}
 
-	return resourceAviatrixVPNUserReadIfRequired(d, meta, &flag)
+	return resourceAviatrixVPNUserReadIfRequired(ctx, d, meta, &flag)
 }
 
-func resourceAviatrixVPNUserReadIfRequired(d *schema.ResourceData, meta any, flag *bool) error {
+func resourceAviatrixVPNUserReadIfRequired(ctx context.Context, d *schema.ResourceData, meta any, flag *bool) error {
 	if !(*flag) {
 		*flag = true
-		return resourceAviatrixVPNUserRead(d, meta)
+		return resourceAviatrixVPNUserRead(ctx, d, meta)
 	}
 	return nil
 }
 
-func resourceAviatrixVPNUserRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixVPNUserRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	userName := getString(d, "user_name")
 	if userName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, user_name is empty. Id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, user_name is empty. Id is %s", id))
 		mustSet(d, "user_name", id)
 		mustSet(d, "manage_user_attachment", true)
 		d.SetId(id)
@@ -173,7 +174,7 @@ func resourceAviatrixVPNUserRead(d *schema.ResourceData, meta any) error {
 		return fmt.Errorf("couldn't find Aviatrix VPNUser: %w", err)
 	}
 
-	log.Printf("[TRACE] Reading vpn_user %s: %#v", userName, vu)
+	tflog.Trace(ctx, fmt.Sprintf("Reading vpn_user %s: %#v", userName, goaviatrix.Redact(vu)))
 
 	if vu != nil {
 		if vu.DnsEnabled {
@@ -212,7 +213,7 @@ func resourceAviatrixVPNUserRead(d *schema.ResourceData, meta any) error {
 	return nil
 }
 
-func resourceAviatrixVPNUserUpdate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixVPNUserUpdate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	vpnUser := &goaviatrix.VPNUser{
@@ -273,10 +274,10 @@ func resourceAviatrixVPNUserUpdate(d *schema.ResourceData, meta any) error {
 	}
 
 	d.Partial(false)
-	return resourceAviatrixVPNUserRead(d, meta)
+	return resourceAviatrixVPNUserRead(ctx, d, meta)
 }
 
-func resourceAviatrixVPNUserDelete(d *schema.ResourceData, meta any) error {
+func resourceAviatrixVPNUserDelete(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	vpnUser := &goaviatrix.VPNUser{
@@ -288,7 +289,7 @@ func resourceAviatrixVPNUserDelete(d *schema.ResourceData, meta any) error {
 		vpnUser.DnsEnabled = true
 	}
 
-	log.Printf("[INFO] Deleting Aviatrix VPNUser: %#v", vpnUser)
+	tflog.Info(ctx, fmt.Sprintf("Deleting Aviatrix VPNUser: %#v", goaviatrix.Redact(vpnUser)))
 
 	err := client.DeleteVPNUser(vpnUser)
 	if err != nil {
@@ -1,12 +1,13 @@
 package aviatrix
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
@@ -14,9 +15,9 @@ import (
 
 func resourceAviatrixVPNUserAccelerator() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAviatrixVPNUserAcceleratorCreate,
-		Read:   resourceAviatrixVPNUserAcceleratorRead,
-		Delete: resourceAviatrixVPNUserAcceleratorDelete,
+		CreateWithoutTimeout: withContext(resourceAviatrixVPNUserAcceleratorCreate),
+		ReadWithoutTimeout:   withContext(resourceAviatrixVPNUserAcceleratorRead),
+		Delete:               resourceAviatrixVPNUserAcceleratorDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough, //nolint:staticcheck // SA1019: deprecated but requires structural changes to migrate,
 		},
@@ -32,7 +33,7 @@ func resourceAviatrixVPNUserAccelerator() *schema.Resource {
 	}
 }
 
-func resourceAviatrixVPNUserAcceleratorCreate(d *schema.ResourceData, meta any) error {
+func resourceAviatrixVPNUserAcceleratorCreate(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	elb := getString(d, "elb_name")
@@ -50,9 +51,9 @@ func resourceAviatrixVPNUserAcceleratorCreate(d *schema.ResourceData, meta any)
 		xlr := &goaviatrix.VpnUserXlr{
 			Endpoints: str,
 		}
-		log.Printf("[DEBUG] Endpoint list: %s", xlr.Endpoints)
+		tflog.Debug(ctx, fmt.Sprintf("Endpoint list: %s", xlr.Endpoints))
 
-		log.Printf("[INFO] Creating User Accelerator.")
+		tflog.Info(ctx, "Creating User Accelerator.")
 		var err error
 		for i := 0; ; i++ {
 			err = client.UpdateVpnUserAccelerator(xlr)
@@ -68,24 +69,24 @@ func resourceAviatrixVPNUserAcceleratorCreate(d *schema.ResourceData, meta any)
 	}
 
 	d.SetId(elb)
-	return resourceAviatrixVPNUserAcceleratorRead(d, meta)
+	return resourceAviatrixVPNUserAcceleratorRead(ctx, d, meta)
 }
 
-func resourceAviatrixVPNUserAcceleratorRead(d *schema.ResourceData, meta any) error {
+func resourceAviatrixVPNUserAcceleratorRead(ctx context.Context, d *schema.ResourceData, meta any) error {
 	client := mustClient(meta)
 
 	elbName := getString(d, "elb_name")
 	if elbName == "" {
 		id := d.Id()
-		log.Printf("[DEBUG] Looks like an import, no elb name received. Import id is %s", id)
+		tflog.Debug(ctx, fmt.Sprintf("Looks like an import, no elb name received. Import id is %s", id))
 		mustSet(d, "elb_name", id)
 		elbName = id
 		d.SetId(id)
 	}
 
-	log.Printf("[DEBUG] elbName: %s", elbName)
+	tflog.Debug(ctx, fmt.Sprintf("elbName: %s", elbName))
 
-	log.Printf("[INFO] Reading User Accelerator LB List")
+	tflog.Info(ctx, "Reading User Accelerator LB List")
 
 	elbList, err := client.GetVpnUserAccelerator()
 	if err != nil {
@@ -96,7 +97,7 @@ func resourceAviatrixVPNUserAcceleratorRead(d *schema.ResourceData, meta any) er
 		return fmt.Errorf("unable to read endpoint list for User Accelerator due to %w", err)
 	}
 
-	log.Printf("[DEBUG] elbList: %s", elbList)
+	tflog.Debug(ctx, fmt.Sprintf("elbList: %s", elbList))
 
 	if elbList != nil {
 		if goaviatrix.Contains(elbList, elbName) {
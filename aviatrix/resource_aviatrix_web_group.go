@@ -96,7 +96,7 @@ func marshalWebGroupInput(d *schema.ResourceData) (*goaviatrix.WebGroup, error)
 }
 
 func resourceAviatrixWebGroupCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	webGroup, err := marshalWebGroupInput(d)
 	if err != nil {
@@ -123,7 +123,7 @@ func resourceAviatrixWebGroupReadIfRequired(ctx context.Context, d *schema.Resou
 }
 
 func resourceAviatrixWebGroupRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	uuid := d.Id()
 	mustSet(d, "uuid", uuid)
@@ -162,7 +162,7 @@ func resourceAviatrixWebGroupRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceAviatrixWebGroupUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	uuid := d.Id()
 	d.Partial(true)
@@ -183,7 +183,7 @@ func resourceAviatrixWebGroupUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceAviatrixWebGroupDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := mustClient(meta)
+	client := mustDCFClient(meta)
 
 	uuid := d.Id()
 	err := client.DeleteWebGroup(ctx, uuid)
@@ -0,0 +1,79 @@
+package aviatrix
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
+)
+
+const (
+	// gatewayTagCloudTypes are the clouds whose gateways can be tagged.
+	gatewayTagCloudTypes = goaviatrix.AWSRelatedCloudTypes | goaviatrix.AzureArmRelatedCloudTypes
+	// firewallInstanceTagCloudTypes are the clouds whose firewall instances
+	// can be tagged.
+	firewallInstanceTagCloudTypes = goaviatrix.AWSRelatedCloudTypes | goaviatrix.GCPRelatedCloudTypes | goaviatrix.AzureArmRelatedCloudTypes
+)
+
+// tagsAllSchema is the schema of the computed tags_all attribute of taggable
+// resources.
+func tagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeMap,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Computed:    true,
+		Description: "All tags assigned to the resource, including those inherited from the provider default_tags.",
+	}
+}
+
+// defaultTagsFor returns the provider default tags to apply to a resource of
+// cloudType, or nil if there are none or resources of cloudType cannot be
+// tagged.
+func defaultTagsFor(config *goaviatrix.DefaultTagsConfig, cloudType, cloudTypes int) *goaviatrix.DefaultTagsConfig {
+	if config == nil || len(config.Tags) == 0 || !goaviatrix.IsCloudType(cloudType, cloudTypes) {
+		return nil
+	}
+	return config
+}
+
+// tagsConfigs returns the default and ignored tags configured on the
+// provider. Both are nil when meta is not a configured client, e.g. in unit
+// tests.
+func tagsConfigs(meta any) (*goaviatrix.DefaultTagsConfig, *goaviatrix.IgnoreTagsConfig) {
+	client, ok := meta.(interface {
+		GetDefaultTagsConfig() *goaviatrix.DefaultTagsConfig
+		GetIgnoreTagsConfig() *goaviatrix.IgnoreTagsConfig
+	})
+	if !ok {
+		return nil, nil
+	}
+	return client.GetDefaultTagsConfig(), client.GetIgnoreTagsConfig()
+}
+
+// customizeDiffTagsAll returns a CustomizeDiffFunc that plans tags_all as the
+// provider default tags merged with the resource tags, for resources in the
+// given clouds. A change of the default tags then shows up as a change of
+// tags_all.
+func customizeDiffTagsAll(cloudTypes int) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, meta any) error {
+		if !d.NewValueKnown("tags") || !d.NewValueKnown("cloud_type") {
+			return d.SetNewComputed("tags_all")
+		}
+		defaultTags, ignoreTags := tagsConfigs(meta)
+		tags := goaviatrix.KeyValueTags(convertTagsMapToStringMap(mustMap(d.Get("tags"))))
+		allTags := defaultTagsFor(defaultTags, mustInt(d.Get("cloud_type")), cloudTypes).MergeTags(tags)
+		return d.SetNew("tags_all", map[string]string(allTags.IgnoreConfig(ignoreTags)))
+	}
+}
+
+// setTagsAll stores the tags read from the Controller in tags_all, and in tags
+// without the ones inherited from the provider default tags. A default tag is
+// kept in tags if the resource sets it too.
+func setTagsAll(d *schema.ResourceData, allTags goaviatrix.KeyValueTags, defaultTags *goaviatrix.DefaultTagsConfig) error {
+	configured := goaviatrix.KeyValueTags(convertTagsMapToStringMap(mustMap(d.Get("tags"))))
+	if err := d.Set("tags", allTags.RemoveDefaultConfig(defaultTags, configured)); err != nil {
+		return err
+	}
+	return d.Set("tags_all", allTags)
+}
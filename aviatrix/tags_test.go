@@ -0,0 +1,159 @@
+package aviatrix
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
+)
+
+func taggableTestSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"cloud_type": {Type: schema.TypeInt, Required: true},
+		"tags": {
+			Type:     schema.TypeMap,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+			Optional: true,
+		},
+		"tags_all": tagsAllSchema(),
+	}
+}
+
+func testDefaultTags() *goaviatrix.DefaultTagsConfig {
+	return &goaviatrix.DefaultTagsConfig{Tags: goaviatrix.KeyValueTags{
+		"owner":       "platform",
+		"environment": "prod",
+	}}
+}
+
+func TestCustomizeDiffTagsAll(t *testing.T) {
+	r := &schema.Resource{
+		Schema:        taggableTestSchema(),
+		CustomizeDiff: customizeDiffTagsAll(gatewayTagCloudTypes),
+	}
+	meta := &goaviatrix.Client{
+		DefaultTags:      testDefaultTags(),
+		IgnoreTagsConfig: &goaviatrix.IgnoreTagsConfig{Keys: goaviatrix.KeyValueTags{"owner": ""}},
+	}
+
+	tests := []struct {
+		name      string
+		cloudType int
+		want      map[string]string
+	}{
+		{
+			name:      "ignored defaults dropped for AWS",
+			cloudType: goaviatrix.AWS,
+			want:      map[string]string{"environment": "dev", "app": "web"},
+		},
+		{
+			name:      "defaults not applied to GCP gateways",
+			cloudType: goaviatrix.GCP,
+			want:      map[string]string{"environment": "dev", "app": "web"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]any{
+				"cloud_type": tt.cloudType,
+				"tags":       map[string]any{"environment": "dev", "app": "web"},
+			})
+			diff, err := r.Diff(context.Background(), nil, config, meta)
+			require.NoError(t, err)
+
+			got := map[string]string{}
+			for k, attr := range diff.Attributes {
+				if key, ok := strings.CutPrefix(k, "tags_all."); ok && key != "%" {
+					got[key] = attr.New
+				}
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCustomizeDiffTagsAll_MergesDefaults(t *testing.T) {
+	r := &schema.Resource{
+		Schema:        taggableTestSchema(),
+		CustomizeDiff: customizeDiffTagsAll(gatewayTagCloudTypes),
+	}
+	config := terraform.NewResourceConfigRaw(map[string]any{
+		"cloud_type": goaviatrix.Azure,
+		"tags":       map[string]any{"environment": "dev"},
+	})
+	diff, err := r.Diff(context.Background(), nil, config, &goaviatrix.Client{DefaultTags: testDefaultTags()})
+	require.NoError(t, err)
+
+	assert.Equal(t, "platform", diff.Attributes["tags_all.owner"].New)
+	assert.Equal(t, "dev", diff.Attributes["tags_all.environment"].New)
+
+	// Once applied, the defaults in tags_all do not show up as a change.
+	state := &terraform.InstanceState{
+		ID: "gw",
+		Attributes: map[string]string{
+			"id":                   "gw",
+			"cloud_type":           "8",
+			"tags.%":               "1",
+			"tags.environment":     "dev",
+			"tags_all.%":           "2",
+			"tags_all.environment": "dev",
+			"tags_all.owner":       "platform",
+		},
+	}
+	diff, err = r.Diff(context.Background(), state, config, &goaviatrix.Client{DefaultTags: testDefaultTags()})
+	require.NoError(t, err)
+	assert.True(t, diff.Empty(), "unexpected diff: %#v", diff)
+}
+
+func TestExtractTags_DefaultTags(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, taggableTestSchema(), map[string]any{
+		"cloud_type": goaviatrix.AWS,
+		"tags":       map[string]any{"environment": "dev", "app": "web"},
+	})
+	tags, err := extractTags(d, goaviatrix.AWS, testDefaultTags())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"owner": "platform", "environment": "dev", "app": "web"}, tags)
+
+	d = schema.TestResourceDataRaw(t, taggableTestSchema(), map[string]any{"cloud_type": goaviatrix.AWS})
+	tags, err = extractTags(d, goaviatrix.AWS, testDefaultTags())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"owner": "platform", "environment": "prod"}, tags)
+
+	tags, err = extractTags(d, goaviatrix.AWS, nil)
+	require.NoError(t, err)
+	assert.Nil(t, tags)
+}
+
+func TestDefaultTagsFor(t *testing.T) {
+	assert.NotNil(t, defaultTagsFor(testDefaultTags(), goaviatrix.AWS, gatewayTagCloudTypes))
+	assert.Nil(t, defaultTagsFor(testDefaultTags(), goaviatrix.GCP, gatewayTagCloudTypes))
+	assert.NotNil(t, defaultTagsFor(testDefaultTags(), goaviatrix.GCP, firewallInstanceTagCloudTypes))
+	assert.Nil(t, defaultTagsFor(&goaviatrix.DefaultTagsConfig{}, goaviatrix.AWS, gatewayTagCloudTypes))
+	assert.Nil(t, defaultTagsFor(nil, goaviatrix.AWS, gatewayTagCloudTypes))
+}
+
+func TestSetTagsAll(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, taggableTestSchema(), map[string]any{
+		"cloud_type": goaviatrix.AWS,
+		"tags":       map[string]any{"owner": "platform", "app": "web"},
+	})
+	allTags := goaviatrix.KeyValueTags{"owner": "platform", "environment": "prod", "app": "web"}
+
+	require.NoError(t, setTagsAll(d, allTags, testDefaultTags()))
+
+	// owner matches its default but is set on the resource too, so it stays.
+	assert.Equal(t, map[string]any{"owner": "platform", "app": "web"}, d.Get("tags"))
+	assert.Equal(t, map[string]any{"owner": "platform", "environment": "prod", "app": "web"}, d.Get("tags_all"))
+
+	// Tags removed outside Terraform are cleared, so the next plan adds them
+	// back.
+	require.NoError(t, setTagsAll(d, nil, testDefaultTags()))
+	assert.Empty(t, d.Get("tags"))
+	assert.Empty(t, d.Get("tags_all"))
+}
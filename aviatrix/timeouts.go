@@ -0,0 +1,34 @@
+package aviatrix
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultGatewayTimeout matches how long the Controller's async gateway
+// operations were previously allowed to run (360 polls of 10 seconds).
+const defaultGatewayTimeout = 60 * time.Minute
+
+// gatewayTimeouts returns the Timeouts block shared by resources that launch,
+// resize or tear down gateway instances.
+func gatewayTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(defaultGatewayTimeout),
+		Update: schema.DefaultTimeout(defaultGatewayTimeout),
+		Delete: schema.DefaultTimeout(defaultGatewayTimeout),
+	}
+}
+
+// withContext adapts a CRUD function returning a plain error to the
+// Context-aware signature. Gateway resources register it as CreateContext,
+// UpdateContext and DeleteContext, where ctx has the deadline of the matching
+// Timeouts entry. Every other function is registered as a *WithoutTimeout
+// one, e.g. ReadWithoutTimeout, where ctx has no deadline.
+func withContext(fn func(context.Context, *schema.ResourceData, any) error) func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		return diag.FromErr(fn(ctx, d, meta))
+	}
+}
@@ -0,0 +1,142 @@
+package aviatrix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.38.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the name of the OpenTelemetry tracer of the provider.
+const tracerName = "aviatrix.com/terraform-provider-aviatrix/aviatrix"
+
+// Span attributes of CRUD operations.
+const (
+	attributeResourceType = attribute.Key("tf.resource_type")
+	attributeOperation    = attribute.Key("tf.operation")
+	attributeResourceID   = attribute.Key("tf.resource_id")
+)
+
+// tracesFileEnv names the file the "file" exporter writes spans to.
+const tracesFileEnv = "AVIATRIX_OTEL_TRACES_FILE"
+
+func tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// StartTracing registers a global OpenTelemetry tracer provider when tracing
+// is configured with the standard environment variables, and returns a
+// function that flushes and stops it. Tracing is off unless
+// OTEL_TRACES_EXPORTER names an exporter or an OTLP endpoint is set:
+//
+//   - otlp: OTLP, configured with the OTEL_EXPORTER_OTLP_* variables. The
+//     protocol is http/protobuf unless OTEL_EXPORTER_OTLP_TRACES_PROTOCOL or
+//     OTEL_EXPORTER_OTLP_PROTOCOL is grpc.
+//   - console: JSON to stderr, as stdout carries the plugin protocol.
+//   - file: JSON lines to the file named by AVIATRIX_OTEL_TRACES_FILE.
+//   - none: tracing off.
+//
+// The sampler, batching and resource follow OTEL_TRACES_SAMPLER, OTEL_BSP_*,
+// OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES.
+func StartTracing(ctx context.Context) (func(context.Context) error, error) {
+	exporter, closer, err := newTraceExporter(ctx)
+	if err != nil || exporter == nil {
+		return func(context.Context) error { return nil }, err
+	}
+
+	attrs := []attribute.KeyValue{semconv.ServiceName("terraform-provider-aviatrix")}
+	if version := strings.TrimSpace(Version); version != "" {
+		attrs = append(attrs, semconv.ServiceVersion(version))
+	}
+	res, err := resource.New(ctx, resource.WithAttributes(attrs...), resource.WithFromEnv())
+	if err != nil {
+		return nil, fmt.Errorf("could not create the OpenTelemetry resource: %w", err)
+	}
+
+	spanProcessor := sdktrace.NewBatchSpanProcessor(exporter)
+	if closer != nil {
+		// Write spans to a file as they end, so a run killed before
+		// shutting down still leaves them behind.
+		spanProcessor = sdktrace.NewSimpleSpanProcessor(exporter)
+	}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(spanProcessor),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return func(ctx context.Context) error {
+		err := tp.Shutdown(ctx)
+		if closer != nil {
+			err = errors.Join(err, closer.Close())
+		}
+		return err
+	}, nil
+}
+
+// newTraceExporter returns the exporter configured by the environment, nil
+// if tracing is off, and the file it writes to, if any.
+func newTraceExporter(ctx context.Context) (sdktrace.SpanExporter, io.Closer, error) {
+	name := strings.ToLower(strings.TrimSpace(os.Getenv("OTEL_TRACES_EXPORTER")))
+	if name == "" && (os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != "") {
+		name = "otlp"
+	}
+
+	switch name {
+	case "", "none":
+		return nil, nil, nil
+	case "otlp":
+		protocol := os.Getenv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL")
+		if protocol == "" {
+			protocol = os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL")
+		}
+		var exporter sdktrace.SpanExporter
+		var err error
+		switch protocol {
+		case "", "http/protobuf":
+			exporter, err = otlptracehttp.New(ctx)
+		case "grpc":
+			exporter, err = otlptracegrpc.New(ctx)
+		default:
+			return nil, nil, fmt.Errorf("unsupported OTLP protocol %q: expected http/protobuf or grpc", protocol)
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not create the OTLP trace exporter: %w", err)
+		}
+		return exporter, nil, nil
+	case "console":
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(os.Stderr))
+		return exporter, nil, err
+	case "file":
+		path := os.Getenv(tracesFileEnv)
+		if path == "" {
+			return nil, nil, fmt.Errorf("OTEL_TRACES_EXPORTER is file but %s is not set", tracesFileEnv)
+		}
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not open the traces file: %w", err)
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			_ = f.Close()
+			return nil, nil, err
+		}
+		return exporter, f, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported OTEL_TRACES_EXPORTER %q: expected otlp, console, file or none", name)
+	}
+}
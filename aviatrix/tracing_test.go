@@ -0,0 +1,114 @@
+package aviatrix
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
+)
+
+func setTestTracerProvider(t *testing.T, tp *sdktrace.TracerProvider) {
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+}
+
+func TestInstrumentResource_Spans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	setTestTracerProvider(t, sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{"name": {Type: schema.TypeString, Optional: true}},
+		CreateWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			d.SetId("account")
+			return nil
+		},
+		DeleteWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return diag.Errorf("could not delete account: password=hunter2")
+		},
+	}
+	instrumentResource("aviatrix_account", r)
+	d := r.TestResourceData()
+	client := &goaviatrix.Client{}
+
+	require.False(t, r.CreateWithoutTimeout(context.Background(), d, client).HasError())
+	require.True(t, r.DeleteWithoutTimeout(context.Background(), d, client).HasError())
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "aviatrix_account.create", spans[0].Name())
+	assert.Equal(t, "aviatrix_account.delete", spans[1].Name())
+	for _, span := range spans {
+		attrs := make(map[string]string)
+		for _, kv := range span.Attributes() {
+			attrs[string(kv.Key)] = kv.Value.AsString()
+		}
+		assert.Equal(t, "aviatrix_account", attrs[string(attributeResourceType)])
+		assert.Equal(t, "account", attrs[string(attributeResourceID)])
+	}
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+	assert.Equal(t, "could not delete account: password=***", spans[1].Status().Description)
+}
+
+func TestStartTracing_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.json")
+	t.Setenv("OTEL_TRACES_EXPORTER", "file")
+	t.Setenv(tracesFileEnv, path)
+	setTestTracerProvider(t, sdktrace.NewTracerProvider())
+
+	stop, err := StartTracing(context.Background())
+	require.NoError(t, err)
+	_, span := tracer().Start(context.Background(), "aviatrix_account.read")
+	span.End()
+	require.NoError(t, stop(context.Background()))
+
+	traces, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(traces), `"Name":"aviatrix_account.read"`)
+	assert.Contains(t, string(traces), "terraform-provider-aviatrix")
+}
+
+func TestStartTracing_Disabled(t *testing.T) {
+	t.Setenv("OTEL_TRACES_EXPORTER", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "")
+	previous := otel.GetTracerProvider()
+
+	stop, err := StartTracing(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, stop(context.Background()))
+	assert.Equal(t, previous, otel.GetTracerProvider())
+}
+
+func TestStartTracing_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want string
+	}{
+		{"unknown exporter", map[string]string{"OTEL_TRACES_EXPORTER": "zipkin"}, `unsupported OTEL_TRACES_EXPORTER "zipkin"`},
+		{"file without path", map[string]string{"OTEL_TRACES_EXPORTER": "file", tracesFileEnv: ""}, tracesFileEnv + " is not set"},
+		{"unknown protocol", map[string]string{"OTEL_TRACES_EXPORTER": "otlp", "OTEL_EXPORTER_OTLP_TRACES_PROTOCOL": "http/json"}, `unsupported OTLP protocol "http/json"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			_, err := StartTracing(context.Background())
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
@@ -2,18 +2,21 @@ package aviatrix
 
 import (
 	"context"
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
 	"net"
+	"net/netip"
 	"regexp"
 	"slices"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -68,6 +71,27 @@ func validateCIDR(i any, k string) (warnings []string, errors []error) {
 	return warnings, errors
 }
 
+// validateDuration is a SchemaValidateFunc for Go duration strings such as
+// "500ms" or "2m".
+func validateDuration(i any, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("expected %s to be a valid duration such as \"500ms\" or \"2m\", got: %s", k, v))
+		return warnings, errors
+	}
+	if d < 0 {
+		errors = append(errors, fmt.Errorf("expected %s to not be negative, got: %s", k, v))
+	}
+
+	return warnings, errors
+}
+
 func ValidateIPv6AccessType(i any, k string) (warnings []string, errors []error) {
 	v, ok := i.(string)
 	if !ok {
@@ -136,6 +160,39 @@ func gcpRegionFromZone(zone string) string {
 	return zone[:strings.LastIndex(zone, "-")]
 }
 
+// insaneModeSubnetPrefixLen is the prefix length of the subnet the Controller
+// creates for an Insane Mode gateway from the CIDR segment given as its
+// subnet.
+const insaneModeSubnetPrefixLen = 26
+
+// cidrSplitForGateway returns the first count Insane Mode gateway subnets
+// (/26 segments) of the IPv4 CIDR vpcCIDR, in address order.
+func cidrSplitForGateway(vpcCIDR string, count int) ([]string, error) {
+	prefix, err := netip.ParsePrefix(vpcCIDR)
+	if err != nil || !prefix.Addr().Is4() {
+		return nil, fmt.Errorf("invalid IPv4 CIDR %q", vpcCIDR)
+	}
+	if prefix != prefix.Masked() {
+		return nil, fmt.Errorf("CIDR %q is not canonical; use %q", vpcCIDR, prefix.Masked())
+	}
+	if prefix.Bits() > insaneModeSubnetPrefixLen {
+		return nil, fmt.Errorf("CIDR %q is smaller than a /%d gateway subnet", vpcCIDR, insaneModeSubnetPrefixLen)
+	}
+	available := 1 << (insaneModeSubnetPrefixLen - prefix.Bits())
+	if count < 0 || count > available {
+		return nil, fmt.Errorf("count must be between 0 and %d for CIDR %q, got %d", available, vpcCIDR, count)
+	}
+	subnets := make([]string, 0, count)
+	start := prefix.Addr().As4()
+	base := binary.BigEndian.Uint32(start[:])
+	for i := range count {
+		var addr [4]byte
+		binary.BigEndian.PutUint32(addr[:], base+uint32(i)<<(32-insaneModeSubnetPrefixLen))
+		subnets = append(subnets, netip.PrefixFrom(netip.AddrFrom4(addr), insaneModeSubnetPrefixLen).String())
+	}
+	return subnets, nil
+}
+
 // validateCloudType is a SchemaValidateFunc for Cloud Type parameters.
 func validateCloudType(i any, k string) (warnings []string, errors []error) {
 	return validation.IntInSlice(goaviatrix.GetSupportedClouds())(i, k)
@@ -173,10 +230,10 @@ func DiffSuppressFuncString(k, old, new string, d *schema.ResourceData) bool {
 	return goaviatrix.Equivalent(oldValue, newValue)
 }
 
-func captureErr(fn func(*schema.ResourceData, any) error,
+func captureErr(ctx context.Context, fn func(context.Context, *schema.ResourceData, any) error,
 	d *schema.ResourceData, meta any, err *error,
 ) {
-	*err = fn(d, meta)
+	*err = fn(ctx, d, meta)
 }
 
 func DiffSuppressFuncIgnoreSpaceInString(k, old, new string, d *schema.ResourceData) bool {
@@ -246,15 +303,20 @@ var (
 	gcpTagMatcher   = regexp.MustCompile(`^[\p{Ll}\p{Lo}\p{N}_-]*$`)
 )
 
-func extractTags(d *schema.ResourceData, cloudType int) (map[string]string, error) {
+// extractTags returns the tags of the resource merged into defaultTags, with
+// the resource tags winning. defaultTags may be nil.
+func extractTags(d *schema.ResourceData, cloudType int, defaultTags *goaviatrix.DefaultTagsConfig) (map[string]string, error) {
 	tags, ok := d.GetOk("tags")
-	if !ok {
+	if !ok && defaultTags == nil {
 		return nil, nil
 	}
 	if !goaviatrix.IsCloudType(cloudType, goaviatrix.AWSRelatedCloudTypes|goaviatrix.GCPRelatedCloudTypes|goaviatrix.AzureArmRelatedCloudTypes) {
 		return nil, fmt.Errorf("adding tags is only supported for AWS (1), GCP (4), Azure (8), AWSGov (256), AWSChina (1024) and AzureChina (2048)")
 	}
-	tagsMap := mustMap(tags)
+	tagsMap := defaultTags.MergeTags(goaviatrix.KeyValueTags(convertTagsMapToStringMap(mustMap(tags))))
+	if len(tagsMap) == 0 {
+		return nil, nil
+	}
 	tagsStrMap := make(map[string]string, len(tagsMap))
 	var matcher *regexp.Regexp
 	if goaviatrix.IsCloudType(cloudType, goaviatrix.GCPRelatedCloudTypes) {
@@ -368,23 +430,23 @@ func DiffSuppressFuncDistributedFirewallingPolicyPortRangeHi(k, old, new string,
 }
 
 // sortVersion sorts the firewall_image_version list
-func sortVersion(versionList []string, i, j int, imageName string) bool {
+func sortVersion(ctx context.Context, versionList []string, i, j int, imageName string) bool {
 	if strings.Contains(imageName, "CloudGuard Next-Gen Firewall") {
-		return compareCheckpointVersion(versionList[i], versionList[j], "_")
+		return compareCheckpointVersion(ctx, versionList[i], versionList[j], "_")
 	} else if strings.Contains(imageName, "Check Point CloudGuard IaaS") &&
 		(strings.Contains(imageName, "Next-Gen Firewall with Threat Prevention") ||
 			strings.Contains(imageName, "All-In-One") ||
 			strings.Contains(imageName, "Firewall & Threat Prevention")) {
-		return compareCheckpointVersion(versionList[i], versionList[j], "-")
+		return compareCheckpointVersion(ctx, versionList[i], versionList[j], "-")
 	} else if strings.Contains(imageName, "Palo Alto Networks VM-Series Bundle") ||
 		strings.Contains(imageName, "Palo Alto Networks VM-Series Next Generation Firewall") {
 		version1 := checkPAVMVersionFormat(versionList[i])
 		version2 := checkPAVMVersionFormat(versionList[j])
-		return compareVersion(version1, version2)
+		return compareVersion(ctx, version1, version2)
 	} else {
 		version1 := checkVersionFormat(versionList[i])
 		version2 := checkVersionFormat(versionList[j])
-		return compareVersion(version1, version2)
+		return compareVersion(ctx, version1, version2)
 	}
 }
 
@@ -401,14 +463,14 @@ func sortSize(sizeList []string, i, j int) bool {
 }
 
 // compareCheckpointVersion compares firewall_image_version format like: R81.10-335.883 && R81.10_rev1.0
-func compareCheckpointVersion(version1, version2, flag string) bool {
+func compareCheckpointVersion(ctx context.Context, version1, version2, flag string) bool {
 	versionArray1 := strings.Split(version1, flag)
 	versionArray2 := strings.Split(version2, flag)
 	reg := regexp.MustCompile("[^0-9.-]+")
 	if reg.ReplaceAllString(versionArray1[0], "") == reg.ReplaceAllString(versionArray2[0], "") {
-		return compareVersion(reg.ReplaceAllString(versionArray1[1], ""), reg.ReplaceAllString(versionArray2[1], ""))
+		return compareVersion(ctx, reg.ReplaceAllString(versionArray1[1], ""), reg.ReplaceAllString(versionArray2[1], ""))
 	}
-	return compareVersion(reg.ReplaceAllString(versionArray1[0], ""), reg.ReplaceAllString(versionArray2[0], ""))
+	return compareVersion(ctx, reg.ReplaceAllString(versionArray1[0], ""), reg.ReplaceAllString(versionArray2[0], ""))
 }
 
 // checkPAVMVersionFormat check version list include the PA-VM- format version and Semantic Version, will remove PA-VM- to compare
@@ -420,15 +482,15 @@ func checkPAVMVersionFormat(version string) string {
 }
 
 // compareVersion compares two Semantic Versions
-func compareVersion(version1, version2 string) bool {
+func compareVersion(ctx context.Context, version1, version2 string) bool {
 	v1, err := version.NewVersion(version1)
 	if err != nil {
-		log.Printf("unsupported firewall image version format: %s\n", version1)
+		tflog.Info(ctx, fmt.Sprintf("unsupported firewall image version format: %s", version1))
 		return false
 	}
 	v2, err := version.NewVersion(version2)
 	if err != nil {
-		log.Printf("unsupported firewall image version format: %s\n", version2)
+		tflog.Info(ctx, fmt.Sprintf("unsupported firewall image version format: %s", version2))
 		return false
 	}
 	return v1.GreaterThan(v2)
@@ -764,6 +826,15 @@ func mustInt(v any) int {
 	return i
 }
 
+// mustFloat64 asserts that the given interface is a float64.
+func mustFloat64(v any) float64 {
+	f, ok := v.(float64)
+	if !ok {
+		panic(fmt.Sprintf("internal error: expected float64 but got %T", v))
+	}
+	return f
+}
+
 // mustBool asserts that the given interface is a bool.
 func mustBool(v any) bool {
 	b, ok := v.(bool)
@@ -0,0 +1,114 @@
+package aviatrix
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
+)
+
+func ValidateCertificates(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+	certs, err := goaviatrix.ParseCertificates([]byte(v))
+	if err != nil {
+		return nil, []error{err}
+	}
+	if len(certs) == 0 {
+		return nil, []error{fmt.Errorf("no certificates found in bundle")}
+	}
+	for _, cert := range certs {
+		if !cert.IsCA {
+			return nil, []error{fmt.Errorf("certificate %q is not a CA", cert.Subject.CommonName)}
+		}
+	}
+	return nil, nil
+}
+
+func ValidateEdgeSpokeLatitude(val interface{}, key string) (warns []string, errs []error) {
+	s, ok := val.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("%s must be a string", key))
+		return
+	}
+
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("%s must be a valid number", key))
+		return
+	}
+
+	if v < -90 || v > 90 {
+		errs = append(errs, fmt.Errorf("%s must be between -90 and 90", key))
+	}
+	return
+}
+
+func ValidateEdgeSpokeLongitude(val interface{}, key string) (warns []string, errs []error) {
+	s, ok := val.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("%s must be a string", key))
+		return
+	}
+
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("%s must be a valid number", key))
+		return
+	}
+
+	if v < -180 || v > 180 {
+		errs = append(errs, fmt.Errorf("%s must be between -180 and 180", key))
+	}
+	return
+}
+
+func StringCanBeEmptyButCannotBeWhiteSpace(val interface{}, key string) (warns []string, errs []error) {
+	v, ok := val.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", key)}
+	}
+
+	if v != "" {
+		if strings.TrimSpace(v) == "" {
+			return nil, []error{fmt.Errorf("expected %q to not be an whitespace", key)}
+		}
+	}
+
+	return nil, nil
+}
+
+func ValidateASN(val interface{}, key string) (warns []string, errs []error) {
+	v, ok := val.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("%q must be of type string", key))
+		return
+	}
+
+	asNum, err := strconv.ParseInt(v, 10, 64)
+
+	if err != nil || asNum < int64(1) || asNum > int64(4294967294) {
+		errs = append(errs, fmt.Errorf("%q must be an integer in 1-4294967294, got: %s", key, val))
+		return
+	}
+
+	return warns, errs
+}
+
+func ValidateRtbId(val interface{}, key string) (warns []string, errs []error) {
+	v, ok := val.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("%q must be of type string", key))
+		return
+	}
+
+	if !strings.HasPrefix(v, "rtb-") {
+		errs = append(errs, fmt.Errorf("%q must has a prefix 'rtb-', got: %s", key, val))
+		return
+	}
+
+	return warns, errs
+}
@@ -0,0 +1,69 @@
+package aviatrix
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// addWriteOnly adds to s the write-only argument <name>_wo, sent to the
+// Controller but never stored in state, and <name>_wo_version, whose change
+// sends a new value of <name>_wo. When s already has a secret argument called
+// name, the two conflict and a change of the version forces a new resource if
+// one of name does. <name>_wo also conflicts with any conflictsWith arguments.
+func addWriteOnly(s map[string]*schema.Schema, name, description string, conflictsWith ...string) {
+	wo, version := name+"_wo", name+"_wo_version"
+	forceNew := false
+	if secret, ok := s[name]; ok {
+		secret.ConflictsWith = append(secret.ConflictsWith, wo)
+		conflictsWith = append(conflictsWith, name)
+		forceNew = secret.ForceNew
+	}
+
+	s[wo] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		WriteOnly:     true,
+		ConflictsWith: conflictsWith,
+		Description:   fmt.Sprintf("%s Write-only: never stored in state. Requires Terraform 1.11 or later.", description),
+	}
+	s[version] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ForceNew:     forceNew,
+		RequiredWith: []string{wo},
+		Description:  fmt.Sprintf("Version of '%s'. Change it to send a new value of '%s' to the Controller.", wo, wo),
+	}
+}
+
+// getWriteOnly returns the value of the write-only argument attr in the
+// configuration. Write-only values are only available while the resource is
+// created or updated; it returns "" at any other time.
+func getWriteOnly(d *schema.ResourceData, attr string) string {
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.IsKnown() || !raw.Type().IsObjectType() || !raw.Type().HasAttribute(attr) {
+		return ""
+	}
+	v := raw.GetAttr(attr)
+	if v.IsNull() || !v.IsKnown() || !v.Type().Equals(cty.String) {
+		return ""
+	}
+	return v.AsString()
+}
+
+// getSecret returns the secret argument name, or its write-only counterpart
+// <name>_wo added by addWriteOnly when name is not set.
+func getSecret(d *schema.ResourceData, name string) string {
+	if v := getString(d, name); v != "" {
+		return v
+	}
+	return getWriteOnly(d, name+"_wo")
+}
+
+// hasSecretChange reports whether the secret argument name, or the version of
+// its write-only counterpart, has changed.
+func hasSecretChange(d *schema.ResourceData, name string) bool {
+	return d.HasChanges(name, name+"_wo_version")
+}
@@ -0,0 +1,84 @@
+package aviatrix
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
+)
+
+func TestAddWriteOnly(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"psk":          {Type: schema.TypeString, Optional: true, Sensitive: true, ForceNew: true},
+		"key_filepath": {Type: schema.TypeString, Optional: true},
+	}
+	addWriteOnly(s, "psk", "Pre-Shared Key.")
+	addWriteOnly(s, "key", "Key.", "key_filepath")
+
+	assert.Equal(t, []string{"psk_wo"}, s["psk"].ConflictsWith)
+	assert.True(t, s["psk_wo"].WriteOnly)
+	assert.False(t, s["psk_wo"].ForceNew)
+	assert.Equal(t, []string{"psk"}, s["psk_wo"].ConflictsWith)
+	assert.True(t, s["psk_wo_version"].ForceNew)
+	assert.Equal(t, []string{"psk_wo"}, s["psk_wo_version"].RequiredWith)
+
+	assert.Equal(t, []string{"key_filepath"}, s["key_wo"].ConflictsWith)
+	assert.False(t, s["key_wo_version"].ForceNew)
+	assert.NoError(t, schema.InternalMap(s).InternalValidate(nil))
+}
+
+func TestAccountCreate_WriteOnlySecret(t *testing.T) {
+	client := &goaviatrix.ClientInterfaceMock{
+		CreateAccountFunc: func(account *goaviatrix.Account) error {
+			assert.Equal(t, "AKIA", account.AwsAccessKey)
+			assert.Equal(t, "from-config-only", account.AwsSecretKey)
+			return nil
+		},
+		GetAccountFunc: func(account *goaviatrix.Account) (goaviatrix.Account, error) {
+			return goaviatrix.Account{AccountName: account.AccountName, CloudType: goaviatrix.AWS, AwsAccountNumber: "123456789012"}, nil
+		},
+	}
+	r := resourceAviatrixAccount()
+	config := map[string]any{
+		"account_name":              "aws",
+		"cloud_type":                goaviatrix.AWS,
+		"aws_account_number":        "123456789012",
+		"aws_access_key":            "AKIA",
+		"aws_secret_key_wo":         "from-config-only",
+		"aws_secret_key_wo_version": 1,
+	}
+	diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), client)
+	require.NoError(t, err)
+	diff.RawConfig = cty.ObjectVal(map[string]cty.Value{
+		"aws_secret_key_wo": cty.StringVal("from-config-only"),
+	})
+
+	state, diags := r.Apply(context.Background(), nil, diff, client)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Len(t, client.CreateAccountCalls(), 1)
+	// The SDK nulls aws_secret_key_wo itself before the state is returned to
+	// Terraform; the secret must not leak into any other attribute.
+	assert.Equal(t, "1", state.Attributes["aws_secret_key_wo_version"])
+	assert.Empty(t, state.Attributes["aws_secret_key"])
+}
+
+func TestGetSecret(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"psk": {Type: schema.TypeString, Optional: true},
+	}
+	addWriteOnly(s, "psk", "Pre-Shared Key.")
+
+	d := schema.TestResourceDataRaw(t, s, map[string]any{"psk": "plain"})
+	assert.Equal(t, "plain", getSecret(d, "psk"))
+
+	// Without a raw configuration, e.g. during Read, there is no write-only
+	// value.
+	d = schema.TestResourceDataRaw(t, s, map[string]any{})
+	assert.Empty(t, getSecret(d, "psk"))
+}
@@ -3,10 +3,9 @@ package goaviatrix
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"strconv"
 	"strings"
-
-	log "github.com/sirupsen/logrus"
 )
 
 type Account struct {
@@ -42,6 +41,8 @@ type Account struct {
 	OciUserID                             string   `form:"oci_user_id" json:"oci_user_id,omitempty"`
 	OciCompartmentID                      string   `form:"oci_compartment_id" json:"oci_compartment_id,omitempty"`
 	OciApiPrivateKeyFilePath              string   `form:"oci_api_key_path" json:"oci_api_private_key_filepath,omitempty"`
+	GcloudProjectCredentials              string   `form:"-" json:"-"` // uploaded instead of GcloudProjectCredentialsFilepathLocal when set
+	OciApiPrivateKey                      string   `form:"-" json:"-"` // uploaded instead of OciApiPrivateKeyFilePath when set
 	AzuregovSubscriptionId                string   `form:"azure_gov_subscription_id,omitempty" json:"arm_gov_subscription_id,omitempty"`
 	AzuregovApplicationEndpoint           string   `form:"azure_gov_application_endpoint,omitempty" json:"arm_gov_ad_tenant_id,omitempty"`
 	AzuregovApplicationClientId           string   `form:"azure_gov_application_client_id,omitempty" json:"arm_gov_ad_client_id,omitempty"`
@@ -104,10 +105,7 @@ func (c *Client) CreateGCPAccount(account *Account) error {
 	}
 
 	files := []File{
-		{
-			Path:      account.GcloudProjectCredentialsFilepathLocal,
-			ParamName: "gcloud_project_credentials",
-		},
+		accountFile("gcloud_project_credentials", account.GcloudProjectCredentialsFilepathLocal, account.GcloudProjectCredentials, "credentials.json"),
 	}
 
 	return c.PostFileAPI(params, files, DuplicateBasicCheck)
@@ -126,15 +124,30 @@ func (c *Client) CreateOCIAccount(account *Account) error {
 	}
 
 	files := []File{
-		{
-			Path:      account.OciApiPrivateKeyFilePath,
-			ParamName: "oci_api_key",
-		},
+		accountFile("oci_api_key", account.OciApiPrivateKeyFilePath, account.OciApiPrivateKey, "oci_api_key.pem"),
 	}
 
 	return c.PostFileAPI(params, files, DuplicateBasicCheck)
 }
 
+// accountFile returns the credentials file to upload for an account: content
+// if it is set, so the credentials need not be written to disk, or else the
+// file at path.
+func accountFile(paramName, path, content, fileName string) File {
+	if content != "" {
+		return File{
+			ParamName:      paramName,
+			UseFileContent: true,
+			FileName:       fileName,
+			FileContent:    content,
+		}
+	}
+	return File{
+		Path:      path,
+		ParamName: paramName,
+	}
+}
+
 func (c *Client) InvalidateCache() {
 	c.cacheMutex.Lock()
 	defer c.cacheMutex.Unlock()
@@ -178,11 +191,11 @@ func (c *Client) GetAccount(account *Account) (Account, error) {
 	defer c.cacheMutex.Unlock()
 	for i := range accList {
 		if accList[i].AccountName == account.AccountName {
-			log.Infof("Found Aviatrix Account %s", account.AccountName)
+			c.log(context.Background(), slog.LevelInfo, fmt.Sprintf("Found Aviatrix Account %s", account.AccountName))
 			return accList[i], nil
 		}
 	}
-	log.Errorf("Couldn't find Aviatrix account %s", account.AccountName)
+	c.log(context.Background(), slog.LevelError, fmt.Sprintf("Couldn't find Aviatrix account %s", account.AccountName))
 	return Account{}, ErrNotFound
 }
 
@@ -202,10 +215,7 @@ func (c *Client) UpdateGCPAccount(account *Account) error {
 	}
 
 	files := []File{
-		{
-			Path:      account.GcloudProjectCredentialsFilepathLocal,
-			ParamName: "gcloud_project_credentials",
-		},
+		accountFile("gcloud_project_credentials", account.GcloudProjectCredentialsFilepathLocal, account.GcloudProjectCredentials, "credentials.json"),
 	}
 
 	return c.PostFileAPI(params, files, BasicCheck)
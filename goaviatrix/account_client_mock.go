@@ -0,0 +1,691 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package goaviatrix
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that AccountClientMock does implement AccountClient.
+// If this is not the case, regenerate this file with moq.
+var _ AccountClient = &AccountClientMock{}
+
+// AccountClientMock is a mock implementation of AccountClient.
+//
+//	func TestSomethingThatUsesAccountClient(t *testing.T) {
+//
+//		// make and configure a mocked AccountClient
+//		mockedAccountClient := &AccountClientMock{
+//			AuditAccountFunc: func(ctx context.Context, account *Account) error {
+//				panic("mock out the AuditAccount method")
+//			},
+//			CreateAccountFunc: func(account *Account) error {
+//				panic("mock out the CreateAccount method")
+//			},
+//			CreateAccountUserFunc: func(user *AccountUser) error {
+//				panic("mock out the CreateAccountUser method")
+//			},
+//			CreateEdgeAccountFunc: func(edgeAccount *EdgeAccount) error {
+//				panic("mock out the CreateEdgeAccount method")
+//			},
+//			CreateGCPAccountFunc: func(account *Account) error {
+//				panic("mock out the CreateGCPAccount method")
+//			},
+//			CreateOCIAccountFunc: func(account *Account) error {
+//				panic("mock out the CreateOCIAccount method")
+//			},
+//			DeleteAccountFunc: func(account *Account) error {
+//				panic("mock out the DeleteAccount method")
+//			},
+//			DeleteAccountUserFunc: func(user *AccountUser) error {
+//				panic("mock out the DeleteAccountUser method")
+//			},
+//			GetAccountFunc: func(account *Account) (Account, error) {
+//				panic("mock out the GetAccount method")
+//			},
+//			GetAccountUserFunc: func(user *AccountUser) (*AccountUser, error) {
+//				panic("mock out the GetAccountUser method")
+//			},
+//			InvalidateCacheFunc: func()  {
+//				panic("mock out the InvalidateCache method")
+//			},
+//			UpdateAccountFunc: func(account *Account) error {
+//				panic("mock out the UpdateAccount method")
+//			},
+//			UpdateAccountUserObjectFunc: func(user *AccountUserEdit) error {
+//				panic("mock out the UpdateAccountUserObject method")
+//			},
+//			UpdateEdgeAccountFunc: func(edgeAccount *EdgeAccount) error {
+//				panic("mock out the UpdateEdgeAccount method")
+//			},
+//			UpdateGCPAccountFunc: func(account *Account) error {
+//				panic("mock out the UpdateGCPAccount method")
+//			},
+//		}
+//
+//		// use mockedAccountClient in code that requires AccountClient
+//		// and then make assertions.
+//
+//	}
+type AccountClientMock struct {
+	// AuditAccountFunc mocks the AuditAccount method.
+	AuditAccountFunc func(ctx context.Context, account *Account) error
+
+	// CreateAccountFunc mocks the CreateAccount method.
+	CreateAccountFunc func(account *Account) error
+
+	// CreateAccountUserFunc mocks the CreateAccountUser method.
+	CreateAccountUserFunc func(user *AccountUser) error
+
+	// CreateEdgeAccountFunc mocks the CreateEdgeAccount method.
+	CreateEdgeAccountFunc func(edgeAccount *EdgeAccount) error
+
+	// CreateGCPAccountFunc mocks the CreateGCPAccount method.
+	CreateGCPAccountFunc func(account *Account) error
+
+	// CreateOCIAccountFunc mocks the CreateOCIAccount method.
+	CreateOCIAccountFunc func(account *Account) error
+
+	// DeleteAccountFunc mocks the DeleteAccount method.
+	DeleteAccountFunc func(account *Account) error
+
+	// DeleteAccountUserFunc mocks the DeleteAccountUser method.
+	DeleteAccountUserFunc func(user *AccountUser) error
+
+	// GetAccountFunc mocks the GetAccount method.
+	GetAccountFunc func(account *Account) (Account, error)
+
+	// GetAccountUserFunc mocks the GetAccountUser method.
+	GetAccountUserFunc func(user *AccountUser) (*AccountUser, error)
+
+	// InvalidateCacheFunc mocks the InvalidateCache method.
+	InvalidateCacheFunc func()
+
+	// UpdateAccountFunc mocks the UpdateAccount method.
+	UpdateAccountFunc func(account *Account) error
+
+	// UpdateAccountUserObjectFunc mocks the UpdateAccountUserObject method.
+	UpdateAccountUserObjectFunc func(user *AccountUserEdit) error
+
+	// UpdateEdgeAccountFunc mocks the UpdateEdgeAccount method.
+	UpdateEdgeAccountFunc func(edgeAccount *EdgeAccount) error
+
+	// UpdateGCPAccountFunc mocks the UpdateGCPAccount method.
+	UpdateGCPAccountFunc func(account *Account) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AuditAccount holds details about calls to the AuditAccount method.
+		AuditAccount []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Account is the account argument value.
+			Account *Account
+		}
+		// CreateAccount holds details about calls to the CreateAccount method.
+		CreateAccount []struct {
+			// Account is the account argument value.
+			Account *Account
+		}
+		// CreateAccountUser holds details about calls to the CreateAccountUser method.
+		CreateAccountUser []struct {
+			// User is the user argument value.
+			User *AccountUser
+		}
+		// CreateEdgeAccount holds details about calls to the CreateEdgeAccount method.
+		CreateEdgeAccount []struct {
+			// EdgeAccount is the edgeAccount argument value.
+			EdgeAccount *EdgeAccount
+		}
+		// CreateGCPAccount holds details about calls to the CreateGCPAccount method.
+		CreateGCPAccount []struct {
+			// Account is the account argument value.
+			Account *Account
+		}
+		// CreateOCIAccount holds details about calls to the CreateOCIAccount method.
+		CreateOCIAccount []struct {
+			// Account is the account argument value.
+			Account *Account
+		}
+		// DeleteAccount holds details about calls to the DeleteAccount method.
+		DeleteAccount []struct {
+			// Account is the account argument value.
+			Account *Account
+		}
+		// DeleteAccountUser holds details about calls to the DeleteAccountUser method.
+		DeleteAccountUser []struct {
+			// User is the user argument value.
+			User *AccountUser
+		}
+		// GetAccount holds details about calls to the GetAccount method.
+		GetAccount []struct {
+			// Account is the account argument value.
+			Account *Account
+		}
+		// GetAccountUser holds details about calls to the GetAccountUser method.
+		GetAccountUser []struct {
+			// User is the user argument value.
+			User *AccountUser
+		}
+		// InvalidateCache holds details about calls to the InvalidateCache method.
+		InvalidateCache []struct {
+		}
+		// UpdateAccount holds details about calls to the UpdateAccount method.
+		UpdateAccount []struct {
+			// Account is the account argument value.
+			Account *Account
+		}
+		// UpdateAccountUserObject holds details about calls to the UpdateAccountUserObject method.
+		UpdateAccountUserObject []struct {
+			// User is the user argument value.
+			User *AccountUserEdit
+		}
+		// UpdateEdgeAccount holds details about calls to the UpdateEdgeAccount method.
+		UpdateEdgeAccount []struct {
+			// EdgeAccount is the edgeAccount argument value.
+			EdgeAccount *EdgeAccount
+		}
+		// UpdateGCPAccount holds details about calls to the UpdateGCPAccount method.
+		UpdateGCPAccount []struct {
+			// Account is the account argument value.
+			Account *Account
+		}
+	}
+	lockAuditAccount            sync.RWMutex
+	lockCreateAccount           sync.RWMutex
+	lockCreateAccountUser       sync.RWMutex
+	lockCreateEdgeAccount       sync.RWMutex
+	lockCreateGCPAccount        sync.RWMutex
+	lockCreateOCIAccount        sync.RWMutex
+	lockDeleteAccount           sync.RWMutex
+	lockDeleteAccountUser       sync.RWMutex
+	lockGetAccount              sync.RWMutex
+	lockGetAccountUser          sync.RWMutex
+	lockInvalidateCache         sync.RWMutex
+	lockUpdateAccount           sync.RWMutex
+	lockUpdateAccountUserObject sync.RWMutex
+	lockUpdateEdgeAccount       sync.RWMutex
+	lockUpdateGCPAccount        sync.RWMutex
+}
+
+// AuditAccount calls AuditAccountFunc.
+func (mock *AccountClientMock) AuditAccount(ctx context.Context, account *Account) error {
+	if mock.AuditAccountFunc == nil {
+		panic("AccountClientMock.AuditAccountFunc: method is nil but AccountClient.AuditAccount was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Account *Account
+	}{
+		Ctx:     ctx,
+		Account: account,
+	}
+	mock.lockAuditAccount.Lock()
+	mock.calls.AuditAccount = append(mock.calls.AuditAccount, callInfo)
+	mock.lockAuditAccount.Unlock()
+	return mock.AuditAccountFunc(ctx, account)
+}
+
+// AuditAccountCalls gets all the calls that were made to AuditAccount.
+// Check the length with:
+//
+//	len(mockedAccountClient.AuditAccountCalls())
+func (mock *AccountClientMock) AuditAccountCalls() []struct {
+	Ctx     context.Context
+	Account *Account
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Account *Account
+	}
+	mock.lockAuditAccount.RLock()
+	calls = mock.calls.AuditAccount
+	mock.lockAuditAccount.RUnlock()
+	return calls
+}
+
+// CreateAccount calls CreateAccountFunc.
+func (mock *AccountClientMock) CreateAccount(account *Account) error {
+	if mock.CreateAccountFunc == nil {
+		panic("AccountClientMock.CreateAccountFunc: method is nil but AccountClient.CreateAccount was just called")
+	}
+	callInfo := struct {
+		Account *Account
+	}{
+		Account: account,
+	}
+	mock.lockCreateAccount.Lock()
+	mock.calls.CreateAccount = append(mock.calls.CreateAccount, callInfo)
+	mock.lockCreateAccount.Unlock()
+	return mock.CreateAccountFunc(account)
+}
+
+// CreateAccountCalls gets all the calls that were made to CreateAccount.
+// Check the length with:
+//
+//	len(mockedAccountClient.CreateAccountCalls())
+func (mock *AccountClientMock) CreateAccountCalls() []struct {
+	Account *Account
+} {
+	var calls []struct {
+		Account *Account
+	}
+	mock.lockCreateAccount.RLock()
+	calls = mock.calls.CreateAccount
+	mock.lockCreateAccount.RUnlock()
+	return calls
+}
+
+// CreateAccountUser calls CreateAccountUserFunc.
+func (mock *AccountClientMock) CreateAccountUser(user *AccountUser) error {
+	if mock.CreateAccountUserFunc == nil {
+		panic("AccountClientMock.CreateAccountUserFunc: method is nil but AccountClient.CreateAccountUser was just called")
+	}
+	callInfo := struct {
+		User *AccountUser
+	}{
+		User: user,
+	}
+	mock.lockCreateAccountUser.Lock()
+	mock.calls.CreateAccountUser = append(mock.calls.CreateAccountUser, callInfo)
+	mock.lockCreateAccountUser.Unlock()
+	return mock.CreateAccountUserFunc(user)
+}
+
+// CreateAccountUserCalls gets all the calls that were made to CreateAccountUser.
+// Check the length with:
+//
+//	len(mockedAccountClient.CreateAccountUserCalls())
+func (mock *AccountClientMock) CreateAccountUserCalls() []struct {
+	User *AccountUser
+} {
+	var calls []struct {
+		User *AccountUser
+	}
+	mock.lockCreateAccountUser.RLock()
+	calls = mock.calls.CreateAccountUser
+	mock.lockCreateAccountUser.RUnlock()
+	return calls
+}
+
+// CreateEdgeAccount calls CreateEdgeAccountFunc.
+func (mock *AccountClientMock) CreateEdgeAccount(edgeAccount *EdgeAccount) error {
+	if mock.CreateEdgeAccountFunc == nil {
+		panic("AccountClientMock.CreateEdgeAccountFunc: method is nil but AccountClient.CreateEdgeAccount was just called")
+	}
+	callInfo := struct {
+		EdgeAccount *EdgeAccount
+	}{
+		EdgeAccount: edgeAccount,
+	}
+	mock.lockCreateEdgeAccount.Lock()
+	mock.calls.CreateEdgeAccount = append(mock.calls.CreateEdgeAccount, callInfo)
+	mock.lockCreateEdgeAccount.Unlock()
+	return mock.CreateEdgeAccountFunc(edgeAccount)
+}
+
+// CreateEdgeAccountCalls gets all the calls that were made to CreateEdgeAccount.
+// Check the length with:
+//
+//	len(mockedAccountClient.CreateEdgeAccountCalls())
+func (mock *AccountClientMock) CreateEdgeAccountCalls() []struct {
+	EdgeAccount *EdgeAccount
+} {
+	var calls []struct {
+		EdgeAccount *EdgeAccount
+	}
+	mock.lockCreateEdgeAccount.RLock()
+	calls = mock.calls.CreateEdgeAccount
+	mock.lockCreateEdgeAccount.RUnlock()
+	return calls
+}
+
+// CreateGCPAccount calls CreateGCPAccountFunc.
+func (mock *AccountClientMock) CreateGCPAccount(account *Account) error {
+	if mock.CreateGCPAccountFunc == nil {
+		panic("AccountClientMock.CreateGCPAccountFunc: method is nil but AccountClient.CreateGCPAccount was just called")
+	}
+	callInfo := struct {
+		Account *Account
+	}{
+		Account: account,
+	}
+	mock.lockCreateGCPAccount.Lock()
+	mock.calls.CreateGCPAccount = append(mock.calls.CreateGCPAccount, callInfo)
+	mock.lockCreateGCPAccount.Unlock()
+	return mock.CreateGCPAccountFunc(account)
+}
+
+// CreateGCPAccountCalls gets all the calls that were made to CreateGCPAccount.
+// Check the length with:
+//
+//	len(mockedAccountClient.CreateGCPAccountCalls())
+func (mock *AccountClientMock) CreateGCPAccountCalls() []struct {
+	Account *Account
+} {
+	var calls []struct {
+		Account *Account
+	}
+	mock.lockCreateGCPAccount.RLock()
+	calls = mock.calls.CreateGCPAccount
+	mock.lockCreateGCPAccount.RUnlock()
+	return calls
+}
+
+// CreateOCIAccount calls CreateOCIAccountFunc.
+func (mock *AccountClientMock) CreateOCIAccount(account *Account) error {
+	if mock.CreateOCIAccountFunc == nil {
+		panic("AccountClientMock.CreateOCIAccountFunc: method is nil but AccountClient.CreateOCIAccount was just called")
+	}
+	callInfo := struct {
+		Account *Account
+	}{
+		Account: account,
+	}
+	mock.lockCreateOCIAccount.Lock()
+	mock.calls.CreateOCIAccount = append(mock.calls.CreateOCIAccount, callInfo)
+	mock.lockCreateOCIAccount.Unlock()
+	return mock.CreateOCIAccountFunc(account)
+}
+
+// CreateOCIAccountCalls gets all the calls that were made to CreateOCIAccount.
+// Check the length with:
+//
+//	len(mockedAccountClient.CreateOCIAccountCalls())
+func (mock *AccountClientMock) CreateOCIAccountCalls() []struct {
+	Account *Account
+} {
+	var calls []struct {
+		Account *Account
+	}
+	mock.lockCreateOCIAccount.RLock()
+	calls = mock.calls.CreateOCIAccount
+	mock.lockCreateOCIAccount.RUnlock()
+	return calls
+}
+
+// DeleteAccount calls DeleteAccountFunc.
+func (mock *AccountClientMock) DeleteAccount(account *Account) error {
+	if mock.DeleteAccountFunc == nil {
+		panic("AccountClientMock.DeleteAccountFunc: method is nil but AccountClient.DeleteAccount was just called")
+	}
+	callInfo := struct {
+		Account *Account
+	}{
+		Account: account,
+	}
+	mock.lockDeleteAccount.Lock()
+	mock.calls.DeleteAccount = append(mock.calls.DeleteAccount, callInfo)
+	mock.lockDeleteAccount.Unlock()
+	return mock.DeleteAccountFunc(account)
+}
+
+// DeleteAccountCalls gets all the calls that were made to DeleteAccount.
+// Check the length with:
+//
+//	len(mockedAccountClient.DeleteAccountCalls())
+func (mock *AccountClientMock) DeleteAccountCalls() []struct {
+	Account *Account
+} {
+	var calls []struct {
+		Account *Account
+	}
+	mock.lockDeleteAccount.RLock()
+	calls = mock.calls.DeleteAccount
+	mock.lockDeleteAccount.RUnlock()
+	return calls
+}
+
+// DeleteAccountUser calls DeleteAccountUserFunc.
+func (mock *AccountClientMock) DeleteAccountUser(user *AccountUser) error {
+	if mock.DeleteAccountUserFunc == nil {
+		panic("AccountClientMock.DeleteAccountUserFunc: method is nil but AccountClient.DeleteAccountUser was just called")
+	}
+	callInfo := struct {
+		User *AccountUser
+	}{
+		User: user,
+	}
+	mock.lockDeleteAccountUser.Lock()
+	mock.calls.DeleteAccountUser = append(mock.calls.DeleteAccountUser, callInfo)
+	mock.lockDeleteAccountUser.Unlock()
+	return mock.DeleteAccountUserFunc(user)
+}
+
+// DeleteAccountUserCalls gets all the calls that were made to DeleteAccountUser.
+// Check the length with:
+//
+//	len(mockedAccountClient.DeleteAccountUserCalls())
+func (mock *AccountClientMock) DeleteAccountUserCalls() []struct {
+	User *AccountUser
+} {
+	var calls []struct {
+		User *AccountUser
+	}
+	mock.lockDeleteAccountUser.RLock()
+	calls = mock.calls.DeleteAccountUser
+	mock.lockDeleteAccountUser.RUnlock()
+	return calls
+}
+
+// GetAccount calls GetAccountFunc.
+func (mock *AccountClientMock) GetAccount(account *Account) (Account, error) {
+	if mock.GetAccountFunc == nil {
+		panic("AccountClientMock.GetAccountFunc: method is nil but AccountClient.GetAccount was just called")
+	}
+	callInfo := struct {
+		Account *Account
+	}{
+		Account: account,
+	}
+	mock.lockGetAccount.Lock()
+	mock.calls.GetAccount = append(mock.calls.GetAccount, callInfo)
+	mock.lockGetAccount.Unlock()
+	return mock.GetAccountFunc(account)
+}
+
+// GetAccountCalls gets all the calls that were made to GetAccount.
+// Check the length with:
+//
+//	len(mockedAccountClient.GetAccountCalls())
+func (mock *AccountClientMock) GetAccountCalls() []struct {
+	Account *Account
+} {
+	var calls []struct {
+		Account *Account
+	}
+	mock.lockGetAccount.RLock()
+	calls = mock.calls.GetAccount
+	mock.lockGetAccount.RUnlock()
+	return calls
+}
+
+// GetAccountUser calls GetAccountUserFunc.
+func (mock *AccountClientMock) GetAccountUser(user *AccountUser) (*AccountUser, error) {
+	if mock.GetAccountUserFunc == nil {
+		panic("AccountClientMock.GetAccountUserFunc: method is nil but AccountClient.GetAccountUser was just called")
+	}
+	callInfo := struct {
+		User *AccountUser
+	}{
+		User: user,
+	}
+	mock.lockGetAccountUser.Lock()
+	mock.calls.GetAccountUser = append(mock.calls.GetAccountUser, callInfo)
+	mock.lockGetAccountUser.Unlock()
+	return mock.GetAccountUserFunc(user)
+}
+
+// GetAccountUserCalls gets all the calls that were made to GetAccountUser.
+// Check the length with:
+//
+//	len(mockedAccountClient.GetAccountUserCalls())
+func (mock *AccountClientMock) GetAccountUserCalls() []struct {
+	User *AccountUser
+} {
+	var calls []struct {
+		User *AccountUser
+	}
+	mock.lockGetAccountUser.RLock()
+	calls = mock.calls.GetAccountUser
+	mock.lockGetAccountUser.RUnlock()
+	return calls
+}
+
+// InvalidateCache calls InvalidateCacheFunc.
+func (mock *AccountClientMock) InvalidateCache() {
+	callInfo := struct {
+	}{}
+	mock.lockInvalidateCache.Lock()
+	mock.calls.InvalidateCache = append(mock.calls.InvalidateCache, callInfo)
+	mock.lockInvalidateCache.Unlock()
+	// Invalidating a cache is safe to ignore, so unlike the other methods
+	// this one does not require a Func.
+	if mock.InvalidateCacheFunc != nil {
+		mock.InvalidateCacheFunc()
+	}
+}
+
+// InvalidateCacheCalls gets all the calls that were made to InvalidateCache.
+// Check the length with:
+//
+//	len(mockedAccountClient.InvalidateCacheCalls())
+func (mock *AccountClientMock) InvalidateCacheCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockInvalidateCache.RLock()
+	calls = mock.calls.InvalidateCache
+	mock.lockInvalidateCache.RUnlock()
+	return calls
+}
+
+// UpdateAccount calls UpdateAccountFunc.
+func (mock *AccountClientMock) UpdateAccount(account *Account) error {
+	if mock.UpdateAccountFunc == nil {
+		panic("AccountClientMock.UpdateAccountFunc: method is nil but AccountClient.UpdateAccount was just called")
+	}
+	callInfo := struct {
+		Account *Account
+	}{
+		Account: account,
+	}
+	mock.lockUpdateAccount.Lock()
+	mock.calls.UpdateAccount = append(mock.calls.UpdateAccount, callInfo)
+	mock.lockUpdateAccount.Unlock()
+	return mock.UpdateAccountFunc(account)
+}
+
+// UpdateAccountCalls gets all the calls that were made to UpdateAccount.
+// Check the length with:
+//
+//	len(mockedAccountClient.UpdateAccountCalls())
+func (mock *AccountClientMock) UpdateAccountCalls() []struct {
+	Account *Account
+} {
+	var calls []struct {
+		Account *Account
+	}
+	mock.lockUpdateAccount.RLock()
+	calls = mock.calls.UpdateAccount
+	mock.lockUpdateAccount.RUnlock()
+	return calls
+}
+
+// UpdateAccountUserObject calls UpdateAccountUserObjectFunc.
+func (mock *AccountClientMock) UpdateAccountUserObject(user *AccountUserEdit) error {
+	if mock.UpdateAccountUserObjectFunc == nil {
+		panic("AccountClientMock.UpdateAccountUserObjectFunc: method is nil but AccountClient.UpdateAccountUserObject was just called")
+	}
+	callInfo := struct {
+		User *AccountUserEdit
+	}{
+		User: user,
+	}
+	mock.lockUpdateAccountUserObject.Lock()
+	mock.calls.UpdateAccountUserObject = append(mock.calls.UpdateAccountUserObject, callInfo)
+	mock.lockUpdateAccountUserObject.Unlock()
+	return mock.UpdateAccountUserObjectFunc(user)
+}
+
+// UpdateAccountUserObjectCalls gets all the calls that were made to UpdateAccountUserObject.
+// Check the length with:
+//
+//	len(mockedAccountClient.UpdateAccountUserObjectCalls())
+func (mock *AccountClientMock) UpdateAccountUserObjectCalls() []struct {
+	User *AccountUserEdit
+} {
+	var calls []struct {
+		User *AccountUserEdit
+	}
+	mock.lockUpdateAccountUserObject.RLock()
+	calls = mock.calls.UpdateAccountUserObject
+	mock.lockUpdateAccountUserObject.RUnlock()
+	return calls
+}
+
+// UpdateEdgeAccount calls UpdateEdgeAccountFunc.
+func (mock *AccountClientMock) UpdateEdgeAccount(edgeAccount *EdgeAccount) error {
+	if mock.UpdateEdgeAccountFunc == nil {
+		panic("AccountClientMock.UpdateEdgeAccountFunc: method is nil but AccountClient.UpdateEdgeAccount was just called")
+	}
+	callInfo := struct {
+		EdgeAccount *EdgeAccount
+	}{
+		EdgeAccount: edgeAccount,
+	}
+	mock.lockUpdateEdgeAccount.Lock()
+	mock.calls.UpdateEdgeAccount = append(mock.calls.UpdateEdgeAccount, callInfo)
+	mock.lockUpdateEdgeAccount.Unlock()
+	return mock.UpdateEdgeAccountFunc(edgeAccount)
+}
+
+// UpdateEdgeAccountCalls gets all the calls that were made to UpdateEdgeAccount.
+// Check the length with:
+//
+//	len(mockedAccountClient.UpdateEdgeAccountCalls())
+func (mock *AccountClientMock) UpdateEdgeAccountCalls() []struct {
+	EdgeAccount *EdgeAccount
+} {
+	var calls []struct {
+		EdgeAccount *EdgeAccount
+	}
+	mock.lockUpdateEdgeAccount.RLock()
+	calls = mock.calls.UpdateEdgeAccount
+	mock.lockUpdateEdgeAccount.RUnlock()
+	return calls
+}
+
+// UpdateGCPAccount calls UpdateGCPAccountFunc.
+func (mock *AccountClientMock) UpdateGCPAccount(account *Account) error {
+	if mock.UpdateGCPAccountFunc == nil {
+		panic("AccountClientMock.UpdateGCPAccountFunc: method is nil but AccountClient.UpdateGCPAccount was just called")
+	}
+	callInfo := struct {
+		Account *Account
+	}{
+		Account: account,
+	}
+	mock.lockUpdateGCPAccount.Lock()
+	mock.calls.UpdateGCPAccount = append(mock.calls.UpdateGCPAccount, callInfo)
+	mock.lockUpdateGCPAccount.Unlock()
+	return mock.UpdateGCPAccountFunc(account)
+}
+
+// UpdateGCPAccountCalls gets all the calls that were made to UpdateGCPAccount.
+// Check the length with:
+//
+//	len(mockedAccountClient.UpdateGCPAccountCalls())
+func (mock *AccountClientMock) UpdateGCPAccountCalls() []struct {
+	Account *Account
+} {
+	var calls []struct {
+		Account *Account
+	}
+	mock.lockUpdateGCPAccount.RLock()
+	calls = mock.calls.UpdateGCPAccount
+	mock.lockUpdateGCPAccount.RUnlock()
+	return calls
+}
@@ -1,7 +1,9 @@
 package goaviatrix
 
 import (
-	log "github.com/sirupsen/logrus"
+	"context"
+	"fmt"
+	"log/slog"
 )
 
 type AccountUser struct {
@@ -49,11 +51,11 @@ func (c *Client) GetAccountUser(user *AccountUser) (*AccountUser, error) {
 	users := data.AccountUserList
 	for i := range users {
 		if users[i].UserName == user.UserName {
-			log.Infof("Found Aviatrix user account %s", user.UserName)
+			c.log(context.Background(), slog.LevelInfo, fmt.Sprintf("Found Aviatrix user account %s", user.UserName))
 			return &users[i], nil
 		}
 	}
-	log.Errorf("Couldn't find Aviatrix user account %s", user.UserName)
+	c.log(context.Background(), slog.LevelError, fmt.Sprintf("Couldn't find Aviatrix user account %s", user.UserName))
 	return nil, ErrNotFound
 }
 
@@ -0,0 +1,115 @@
+package goaviatrix
+
+import (
+	"errors"
+	"net/http"
+	"regexp"
+)
+
+// Error kinds for failed Controller calls. Errors returned by the client match
+// at most one of them (or ErrNotFound) with errors.Is.
+var (
+	ErrAlreadyExists    = errors.New("ErrAlreadyExists")
+	ErrConflict         = errors.New("ErrConflict")
+	ErrAuthExpired      = errors.New("ErrAuthExpired")
+	ErrPermissionDenied = errors.New("ErrPermissionDenied")
+	ErrValidation       = errors.New("ErrValidation")
+	ErrTransient        = errors.New("ErrTransient")
+)
+
+// ControllerError is a failed Controller call. It keeps the message of the
+// underlying error, so existing error strings are unchanged.
+type ControllerError struct {
+	StatusError
+	// Action is the v1/v2 action or the v2.5 path that failed.
+	Action string
+	// Reason is the raw reason or message from the Controller.
+	Reason string
+	// Kind is one of the Err* kinds above, or nil if the failure could not be
+	// classified.
+	Kind error
+}
+
+func (e *ControllerError) Is(target error) bool {
+	return e.Kind != nil && target == e.Kind
+}
+
+// As lets errors.As find the embedded StatusError.
+func (e *ControllerError) As(target any) bool {
+	if s, ok := target.(*StatusError); ok {
+		*s = e.StatusError
+		return true
+	}
+	return false
+}
+
+// errorKinds are matched in order against the Controller's reason. Session
+// expiry is checked first since v2.5 reports it as a 403. The patterns are
+// anchored on word boundaries and narrowed to the phrases the Controller uses
+// for each kind, so that a reason that merely mentions e.g. a CIDR, a user or
+// a blocked port is not misclassified; it is better to leave a reason
+// unclassified than to retry or report it as the wrong kind.
+var errorKinds = []struct {
+	kind   error
+	reason *regexp.Regexp
+}{
+	{ErrAuthExpired, regexp.MustCompile(`(?i)\bCID is invalid\b|\binvalid (?:or expired )?CID\b|\binvalid session\b|\bsession \S+ expired\b`)},
+	{ErrNotFound, regexp.MustCompile(`(?i)\bdoes ?n[o']t exist|\bnot found\b|\bno such\b|\bcan ?not find\b|\bcannot be found\b`)},
+	{ErrAlreadyExists, regexp.MustCompile(`(?i)\balready exists?\b`)},
+	{ErrPermissionDenied, regexp.MustCompile(`(?i)\b(?:does not|doesn't) have permission|\bpermission denied\b|\bnot authorized\b|\bunauthorized\b|\baccess denied\b|\bforbidden\b`)},
+	{ErrConflict, regexp.MustCompile(`(?i)\bis (?:currently )?busy\b|\bin progress\b|\b(?:is|are) (?:still |currently )?(?:in use|locked)\b|\btry again later\b|\banother operation\b`)},
+	{ErrTransient, regexp.MustCompile(`(?i)\btimed out\b|\btimeout\b|\btemporarily unavailable\b|\bconnection reset\b|\bservice unavailable\b`)},
+	{ErrValidation, regexp.MustCompile(`(?i)^invalid\b|\b(?:is|are) (?:invalid|not valid|required|not supported|not allowed)\b|\bunsupported\b`)},
+}
+
+// statusKinds classify failures whose reason did not match any errorKinds.
+var statusKinds = map[int]error{
+	http.StatusBadRequest:          ErrValidation,
+	http.StatusUnauthorized:        ErrAuthExpired,
+	http.StatusForbidden:           ErrPermissionDenied,
+	http.StatusNotFound:            ErrNotFound,
+	http.StatusConflict:            ErrConflict,
+	http.StatusLocked:              ErrConflict,
+	http.StatusUnprocessableEntity: ErrValidation,
+	http.StatusTooManyRequests:     ErrTransient,
+	http.StatusBadGateway:          ErrTransient,
+	http.StatusServiceUnavailable:  ErrTransient,
+	http.StatusGatewayTimeout:      ErrTransient,
+}
+
+// errorKind classifies a failure from its reason, falling back to its HTTP
+// status. It returns nil if neither says what went wrong.
+func errorKind(statusCode int, reason string) error {
+	for _, k := range errorKinds {
+		if k.reason.MatchString(reason) {
+			return k.kind
+		}
+	}
+	return statusKinds[statusCode]
+}
+
+// classifyError wraps err, the result of checking a Controller response, in a
+// ControllerError. It returns nil for a nil err and leaves errors that are
+// already classified alone.
+func classifyError(err error, action string, statusCode int, reason string) error {
+	if err == nil {
+		return nil
+	}
+	var ce *ControllerError
+	if errors.As(err, &ce) {
+		return err
+	}
+	return &ControllerError{
+		StatusError: NewStatusError(statusCode, err),
+		Action:      action,
+		Reason:      reason,
+		Kind:        errorKind(statusCode, reason),
+	}
+}
+
+// IsClassified reports whether err is a failed Controller call whose reason or
+// status says what went wrong, i.e. one that matches an Err* kind.
+func IsClassified(err error) bool {
+	var ce *ControllerError
+	return errors.As(err, &ce) && ce.Kind != nil
+}
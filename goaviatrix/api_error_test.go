@@ -0,0 +1,96 @@
+package goaviatrix
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorKind(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		reason     string
+		want       error
+	}{
+		{"v1 session expired", http.StatusOK, "CID is invalid or expired.", ErrAuthExpired},
+		{"v2.5 session expired", http.StatusForbidden, "Invalid CID", ErrAuthExpired},
+		{"does not exist", http.StatusOK, "Gateway spoke-1 does not exist", ErrNotFound},
+		{"not found", http.StatusOK, "App domain not found", ErrNotFound},
+		{"404 without reason", http.StatusNotFound, "", ErrNotFound},
+		{"already exists", http.StatusOK, "Gateway spoke-1 already exists.", ErrAlreadyExists},
+		{"permission", http.StatusOK, "User does not have permission to perform this action", ErrPermissionDenied},
+		{"403 without reason", http.StatusForbidden, "", ErrPermissionDenied},
+		{"busy", http.StatusOK, "Controller is busy, please try again later", ErrConflict},
+		{"in progress", http.StatusOK, "Another operation is in progress for gateway spoke-1", ErrConflict},
+		{"timeout", http.StatusOK, "Request timed out", ErrTransient},
+		{"503", http.StatusServiceUnavailable, "", ErrTransient},
+		{"validation", http.StatusOK, "Invalid gateway size t3.nano", ErrValidation},
+		{"required", http.StatusOK, "Parameter vpc_id is required", ErrValidation},
+		{"invalid CIDR is not an expired session", http.StatusOK, "Invalid CIDR 10.0.0.0/33", ErrValidation},
+		{"invalid in prose", http.StatusOK, "Failed to update gateway: route table invalidated during update", nil},
+		{"in use", http.StatusOK, "Account aws-1 is still in use by gateway spoke-1", ErrConflict},
+		{"in use in prose", http.StatusOK, "Failed to add user admin2 in user group read_only", nil},
+		{"locked in prose", http.StatusOK, "Port 443 is blocked by the security group", nil},
+		{"unclassified", http.StatusOK, "something went wrong", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, errorKind(tt.statusCode, tt.reason))
+		})
+	}
+}
+
+func TestClassifyError(t *testing.T) {
+	assert.NoError(t, classifyError(nil, "get_gateway_info", http.StatusOK, ""))
+
+	err := classifyError(errors.New("rest API get_gateway_info Post failed: Gateway gw does not exist"), "get_gateway_info", http.StatusOK, "Gateway gw does not exist")
+
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.NotErrorIs(t, err, ErrAlreadyExists)
+	assert.Equal(t, "rest API get_gateway_info Post failed: Gateway gw does not exist", err.Error())
+	var ce *ControllerError
+	require.ErrorAs(t, err, &ce)
+	assert.Equal(t, "get_gateway_info", ce.Action)
+	assert.Equal(t, "Gateway gw does not exist", ce.Reason)
+	var se StatusError
+	require.ErrorAs(t, err, &se)
+	assert.Equal(t, http.StatusOK, se.StatusCode())
+
+	assert.Same(t, err, classifyError(err, "other", http.StatusBadRequest, "Invalid"), "already classified errors are left alone")
+}
+
+func TestDuplicateBasicCheckIsAlreadyExists(t *testing.T) {
+	err := DuplicateBasicCheck("add_account", "Post", "Account acc already exists", false)
+
+	assert.ErrorIs(t, err, ErrAlreadyExists)
+	assert.ErrorAs(t, err, &DuplicateError{})
+}
+
+func TestPostAPI_ClassifiesControllerFailure(t *testing.T) {
+	client := newPipelineTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"return": false, "reason": "Gateway gw does not exist"}`))
+	})
+
+	err := client.PostAPI("get_gateway_info", map[string]string{"action": "get_gateway_info"}, BasicCheck)
+
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestGetAPIContext25_ClassifiesStatus(t *testing.T) {
+	client := newPipelineTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "no trust bundle with that UUID"}`))
+	})
+
+	err := client.GetAPIContext25(context.Background(), nil, "dcf/trustbundle/id/x", nil)
+
+	assert.ErrorIs(t, err, ErrNotFound)
+	var se StatusError
+	require.ErrorAs(t, err, &se)
+	assert.Equal(t, http.StatusNotFound, se.StatusCode())
+}
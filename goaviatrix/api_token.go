@@ -0,0 +1,129 @@
+package goaviatrix
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// WithAPIToken makes the client authenticate with a pre-issued API token
+// (a CID issued by the Controller) instead of a username and password.
+func WithAPIToken(token string) ClientOption {
+	return func(c *Client) { c.APIToken = token }
+}
+
+// WithAPITokenFile makes the client read its API token from path. The file is
+// checked for a new token every apiTokenFileCheckInterval and when the
+// Controller rejects the session, so an external process can rotate the
+// token. It takes precedence over WithAPIToken.
+func WithAPITokenFile(path string) ClientOption {
+	return func(c *Client) { c.APITokenFile = path }
+}
+
+// apiTokenFileCheckInterval is how often requests check the token file for
+// a rotated token. A rotation in between is still picked up as soon as the
+// Controller rejects the old token.
+const apiTokenFileCheckInterval = 10 * time.Second
+
+// apiTokenFile remembers the version of the token file last read and when
+// the file was last checked for a new version.
+type apiTokenFile struct {
+	mu      sync.Mutex
+	modTime time.Time
+	size    int64
+	checked time.Time
+}
+
+// usesAPIToken reports whether the client authenticates with an API token
+// rather than a username and password.
+func (c *Client) usesAPIToken() bool {
+	return c.APIToken != "" || c.APITokenFile != ""
+}
+
+// loginWithAPIToken sets the CID from the configured API token. A token that
+// is already in use has been rejected by the Controller, so it is an error
+// unless the token file now holds a new one.
+func (c *Client) loginWithAPIToken() error {
+	token, source := c.APIToken, "the API token"
+	if c.APITokenFile != "" {
+		var err error
+		if token, err = c.readAPITokenFile(); err != nil {
+			return err
+		}
+		source = "the API token in " + c.APITokenFile
+	}
+	if token == c.GetCID() {
+		return fmt.Errorf("%s was rejected by the Controller, issue a new one: %w", source, ErrAuthExpired)
+	}
+	c.setCID(token)
+	return nil
+}
+
+// readAPITokenFile returns the token in c.APITokenFile and records which
+// version of the file it came from.
+func (c *Client) readAPITokenFile() (string, error) {
+	info, err := os.Stat(c.APITokenFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read API token file: %w", err)
+	}
+	data, err := os.ReadFile(c.APITokenFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read API token file: %w", err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("API token file %s is empty", c.APITokenFile)
+	}
+
+	c.tokenFile.mu.Lock()
+	defer c.tokenFile.mu.Unlock()
+	c.tokenFile.modTime = info.ModTime()
+	c.tokenFile.size = info.Size()
+	c.tokenFile.checked = time.Now()
+	return token, nil
+}
+
+// reloadAPITokenFile switches to a rotated token before the old one expires.
+// The file is checked at most once every apiTokenFileCheckInterval. Failures
+// are logged and the current CID is kept, since the file may be mid-rotation.
+func (c *Client) reloadAPITokenFile() {
+	if c.APITokenFile == "" {
+		return
+	}
+	c.tokenFile.mu.Lock()
+	due := time.Since(c.tokenFile.checked) >= apiTokenFileCheckInterval
+	if due {
+		c.tokenFile.checked = time.Now()
+	}
+	c.tokenFile.mu.Unlock()
+	if !due {
+		return
+	}
+
+	info, err := os.Stat(c.APITokenFile)
+	if err != nil {
+		c.log(context.Background(), slog.LevelWarn, fmt.Sprintf("Could not check API token file for a new token: %v", err))
+		return
+	}
+	c.tokenFile.mu.Lock()
+	unchanged := info.ModTime().Equal(c.tokenFile.modTime) && info.Size() == c.tokenFile.size
+	c.tokenFile.mu.Unlock()
+	if unchanged {
+		return
+	}
+
+	token, err := c.readAPITokenFile()
+	if err != nil {
+		c.log(context.Background(), slog.LevelWarn, fmt.Sprintf("Could not reload API token file: %v", err))
+		return
+	}
+	if token == c.GetCID() {
+		return
+	}
+	c.log(context.Background(), slog.LevelInfo, fmt.Sprintf("Using the new API token in %s", c.APITokenFile))
+	c.setCID(token)
+}
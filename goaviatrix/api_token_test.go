@@ -0,0 +1,139 @@
+package goaviatrix
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix/controllertest"
+)
+
+func writeTokenFile(t *testing.T, path, token string, modTime time.Time) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(token+"\n"), 0o600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestAPIToken_SkipsPasswordLogin(t *testing.T) {
+	s := controllertest.NewServer()
+	defer s.Close()
+
+	client, err := NewClient("", "", s.Host(), s.Client(), nil, WithAPIToken(s.NewSession()))
+	require.NoError(t, err)
+	_, err = client.ListSegmentationSecurityDomains()
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"list_multi_cloud_security_domain_names"}, s.Requests())
+}
+
+func TestAPIToken_ExpiredTokenIsAuthError(t *testing.T) {
+	s := controllertest.NewServer()
+	defer s.Close()
+
+	client, err := NewClient("", "", s.Host(), s.Client(), nil, WithAPIToken(s.NewSession()))
+	require.NoError(t, err)
+	s.ExpireSessions()
+
+	_, err = client.ListSegmentationSecurityDomains()
+	require.ErrorIs(t, err, ErrAuthExpired)
+	assert.ErrorContains(t, err, "the API token was rejected by the Controller")
+}
+
+func TestAPITokenFile_PicksUpRotatedToken(t *testing.T) {
+	s := controllertest.NewServer()
+	defer s.Close()
+	path := filepath.Join(t.TempDir(), "token")
+	start := time.Now().Add(-time.Hour)
+	writeTokenFile(t, path, s.NewSession(), start)
+
+	client, err := NewClient("", "", s.Host(), s.Client(), nil, WithAPITokenFile(path))
+	require.NoError(t, err)
+	_, err = client.ListSegmentationSecurityDomains()
+	require.NoError(t, err)
+
+	// The old token stops working after the new one has been written, so
+	// the first call after the check interval must use the new token without
+	// a failed attempt.
+	s.ExpireSessions()
+	newToken := s.NewSession()
+	writeTokenFile(t, path, newToken, start.Add(time.Minute))
+	client.tokenFile.checked = client.tokenFile.checked.Add(-apiTokenFileCheckInterval)
+	_, err = client.ListSegmentationSecurityDomains()
+	require.NoError(t, err)
+	assert.Equal(t, newToken, client.GetCID())
+	assert.Len(t, s.Requests(), 2)
+}
+
+func TestAPITokenFile_RereadWhenSessionExpires(t *testing.T) {
+	s := controllertest.NewServer()
+	defer s.Close()
+	path := filepath.Join(t.TempDir(), "token")
+	start := time.Now().Add(-time.Hour)
+	oldToken := s.NewSession()
+	writeTokenFile(t, path, oldToken, start)
+
+	client, err := NewClient("", "", s.Host(), s.Client(), nil, WithAPITokenFile(path))
+	require.NoError(t, err)
+
+	// Rotate to a token of the same length and keep the modification time,
+	// so the change is only noticed once the Controller rejects the old one.
+	s.ExpireSessions()
+	newToken := s.NewSession()
+	require.Len(t, newToken, len(oldToken))
+	writeTokenFile(t, path, newToken, start)
+
+	_, err = client.ListSegmentationSecurityDomains()
+	require.NoError(t, err)
+	assert.Equal(t, newToken, client.GetCID())
+	assert.Equal(t, 2, len(slices.DeleteFunc(s.Requests(), func(a string) bool { return a != "list_multi_cloud_security_domain_names" })))
+}
+
+func TestAPITokenFile_Errors(t *testing.T) {
+	s := controllertest.NewServer()
+	defer s.Close()
+	dir := t.TempDir()
+
+	_, err := NewClient("", "", s.Host(), s.Client(), nil, WithAPITokenFile(filepath.Join(dir, "missing")))
+	require.ErrorContains(t, err, "failed to read API token file")
+
+	empty := filepath.Join(dir, "empty")
+	require.NoError(t, os.WriteFile(empty, []byte("\n"), 0o600))
+	_, err = NewClient("", "", s.Host(), s.Client(), nil, WithAPITokenFile(empty))
+	require.ErrorContains(t, err, "is empty")
+}
+
+func TestAPITokenFile_ConcurrentRotation(t *testing.T) {
+	s := controllertest.NewServer()
+	defer s.Close()
+	path := filepath.Join(t.TempDir(), "token")
+	start := time.Now().Add(-time.Hour)
+	writeTokenFile(t, path, s.NewSession(), start)
+
+	client, err := NewClient("", "", s.Host(), s.Client(), nil, WithAPITokenFile(path))
+	require.NoError(t, err)
+
+	// Concurrent requests rejected with the old token share one reload of
+	// the file.
+	s.ExpireSessions()
+	newToken := s.NewSession()
+	writeTokenFile(t, path, newToken, start.Add(time.Minute))
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			for range 5 {
+				_, err := client.ListSegmentationSecurityDomains()
+				assert.NoError(t, err)
+			}
+		})
+	}
+	wg.Wait()
+
+	assert.Equal(t, newToken, client.GetCID())
+}
@@ -0,0 +1,153 @@
+package goaviatrix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TaskPoller controls how long and how often the client polls the Controller
+// for the status of an async task (check_task_status).
+type TaskPoller struct {
+	// Interval is the wait before the second poll. Each following wait is
+	// multiplied by Multiplier, up to MaxInterval.
+	Interval    time.Duration
+	MaxInterval time.Duration
+	Multiplier  float64
+	// MaxWait bounds the total wait when ctx has no deadline of its own.
+	MaxWait time.Duration
+	// Logger logs the progress of the wait. The client sets it to its own
+	// logger when it is unset.
+	Logger *slog.Logger
+}
+
+// DefaultTaskPoller polls quickly at first, so short tasks return promptly,
+// and backs off to one poll every 15 seconds for gateway launches. Without a
+// context deadline it gives up after an hour.
+func DefaultTaskPoller() TaskPoller {
+	return TaskPoller{
+		Interval:    2 * time.Second,
+		MaxInterval: 15 * time.Second,
+		Multiplier:  1.5,
+		MaxWait:     60 * time.Minute,
+	}
+}
+
+// TaskTimeoutError is returned when the client stops waiting for an async
+// task, either because ctx was cancelled or its deadline passed. The task may
+// still be running on the Controller.
+type TaskTimeoutError struct {
+	Action    string
+	RequestID string
+	Elapsed   time.Duration
+	Err       error
+}
+
+func (e *TaskTimeoutError) Error() string {
+	reason := "timed out"
+	if errors.Is(e.Err, context.Canceled) {
+		reason = "was cancelled"
+	}
+	return fmt.Sprintf("waiting for %s (request_id %s) %s after %s; the task may still be running on the Controller, "+
+		"check it with check_task_status before retrying", e.Action, e.RequestID, reason, e.Elapsed.Round(time.Second))
+}
+
+func (e *TaskTimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// transientPollError marks a poll failure worth another poll, e.g. an EOF
+// while the Controller restarts a service.
+type transientPollError struct {
+	err error
+}
+
+func (e *transientPollError) Error() string {
+	return e.err.Error()
+}
+
+func (e *transientPollError) Unwrap() error {
+	return e.err
+}
+
+// TaskPollFunc checks the status of an async task once and reports whether it
+// has finished.
+type TaskPollFunc func(ctx context.Context) (done bool, err error)
+
+// Wait calls poll until it reports the task done or fails. It returns a
+// *TaskTimeoutError when ctx is done first.
+func (p TaskPoller) Wait(ctx context.Context, action, requestID string, poll TaskPollFunc) error {
+	if _, ok := ctx.Deadline(); !ok && p.MaxWait > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.MaxWait)
+		defer cancel()
+	}
+	logger := p.Logger
+	if logger == nil {
+		logger = slog.New(slog.DiscardHandler)
+	}
+	logger = logger.With("action", action, "request_id", requestID)
+	ctx, span := tracer(ctx).Start(ctx, "poll "+action, trace.WithAttributes(
+		AttributeAction.String(action),
+		AttributeRequestID.String(requestID),
+	))
+	defer span.End()
+
+	start := time.Now()
+	interval := p.Interval
+	for attempt := 1; ; attempt++ {
+		span.SetAttributes(AttributePolls.Int(attempt))
+		done, err := poll(ctx)
+		var transient *transientPollError
+		switch {
+		case errors.As(err, &transient):
+			logger.WarnContext(ctx, "Polling async task failed, will poll again", "error", RedactString(transient.err.Error()))
+		case err != nil:
+			if ctx.Err() != nil {
+				err = &TaskTimeoutError{Action: action, RequestID: requestID, Elapsed: time.Since(start), Err: ctx.Err()}
+			}
+			span.SetStatus(codes.Error, RedactString(err.Error()))
+			return err
+		case done:
+			logger.DebugContext(ctx, "Async task finished", "elapsed", time.Since(start).Round(time.Second).String())
+			return nil
+		default:
+			logger.InfoContext(ctx, "Waiting for async task", "elapsed", time.Since(start).Round(time.Second).String(), "poll", attempt)
+		}
+
+		if err := sleepContext(ctx, interval); err != nil {
+			timeout := &TaskTimeoutError{Action: action, RequestID: requestID, Elapsed: time.Since(start), Err: err}
+			span.SetStatus(codes.Error, timeout.Error())
+			return timeout
+		}
+		interval = p.next(interval)
+	}
+}
+
+func (p TaskPoller) next(interval time.Duration) time.Duration {
+	if p.Multiplier > 1 {
+		interval = time.Duration(float64(interval) * p.Multiplier)
+	}
+	if p.MaxInterval > 0 && interval > p.MaxInterval {
+		interval = p.MaxInterval
+	}
+	return interval
+}
+
+// sleepContext waits for d, returning early with the context error when ctx
+// is done first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
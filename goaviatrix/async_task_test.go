@@ -0,0 +1,85 @@
+package goaviatrix
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskPoller_IntervalsGrowToMax(t *testing.T) {
+	p := TaskPoller{Interval: 2 * time.Second, MaxInterval: 5 * time.Second, Multiplier: 1.5}
+
+	var got []time.Duration
+	interval := p.Interval
+	for i := 0; i < 4; i++ {
+		interval = p.next(interval)
+		got = append(got, interval)
+	}
+
+	assert.Equal(t, []time.Duration{3 * time.Second, 4500 * time.Millisecond, 5 * time.Second, 5 * time.Second}, got)
+}
+
+func TestTaskPoller_WaitPollsPastTransientErrors(t *testing.T) {
+	p := TaskPoller{Interval: time.Millisecond}
+	polls := 0
+
+	err := p.Wait(context.Background(), "create_gateway", "req-1", func(ctx context.Context) (bool, error) {
+		polls++
+		switch polls {
+		case 1:
+			return false, &transientPollError{err: errors.New("EOF")}
+		case 2:
+			return false, nil
+		default:
+			return true, nil
+		}
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, polls)
+}
+
+func TestTaskPoller_WaitReturnsPollError(t *testing.T) {
+	p := TaskPoller{Interval: time.Millisecond}
+	pollErr := errors.New("rest API create_gateway POST failed: quota exceeded")
+
+	err := p.Wait(context.Background(), "create_gateway", "req-1", func(ctx context.Context) (bool, error) {
+		return false, pollErr
+	})
+
+	assert.ErrorIs(t, err, pollErr)
+}
+
+func TestTaskPoller_WaitReturnsTimeoutError(t *testing.T) {
+	p := TaskPoller{Interval: time.Hour}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := p.Wait(ctx, "create_gateway", "req-1", func(ctx context.Context) (bool, error) {
+		return false, nil
+	})
+
+	var timeoutErr *TaskTimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
+	assert.Equal(t, "create_gateway", timeoutErr.Action)
+	assert.Equal(t, "req-1", timeoutErr.RequestID)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Contains(t, err.Error(), "create_gateway (request_id req-1) was cancelled")
+}
+
+func TestTaskPoller_MaxWaitAppliesWithoutDeadline(t *testing.T) {
+	p := TaskPoller{Interval: time.Hour, MaxWait: 10 * time.Millisecond}
+
+	err := p.Wait(context.Background(), "create_gateway", "req-1", func(ctx context.Context) (bool, error) {
+		return false, nil
+	})
+
+	var timeoutErr *TaskTimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), "timed out")
+}
@@ -2,17 +2,15 @@ package goaviatrix
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/url"
 	"regexp"
 	"strings"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	log "github.com/sirupsen/logrus"
 )
 
 // AWSPeer simple struct to hold aws_peer details
@@ -111,7 +109,7 @@ func (c *Client) GetAWSPeer(awsPeer *AWSPeer) (*AWSPeer, error) {
 		return nil, errors.New("Json Decode list_aws_peerings failed: " + err.Error() + "\n Body: " + bodyString)
 	}
 	if !data.Return {
-		log.Errorf("Couldn't find AWS peering between VPCs %s and %s: %s", awsPeer.VpcID1, awsPeer.VpcID2, data.Reason)
+		c.log(context.Background(), slog.LevelError, fmt.Sprintf("Couldn't find AWS peering between VPCs %s and %s: %s", awsPeer.VpcID1, awsPeer.VpcID2, data.Reason))
 		return nil, errors.New("Rest API list_aws_peerings Get failed: " + data.Reason)
 	}
 	for i := range data.Results.PairLists {
@@ -129,7 +127,7 @@ func (c *Client) GetAWSPeer(awsPeer *AWSPeer) (*AWSPeer, error) {
 			return awsPeer, nil
 		}
 	}
-	log.Errorf("No AWS peering between VPC %s and %s is present.", awsPeer.VpcID1, awsPeer.VpcID2)
+	c.log(context.Background(), slog.LevelError, fmt.Sprintf("No AWS peering between VPC %s and %s is present.", awsPeer.VpcID1, awsPeer.VpcID2))
 	return nil, ErrNotFound
 }
 
@@ -172,39 +170,3 @@ func (c *Client) DeleteAWSPeer(awsPeer *AWSPeer) error {
 	}
 	return nil
 }
-
-func DiffSuppressFuncRtbList1(k, old, new string, d *schema.ResourceData) bool {
-	o, n := d.GetChange("rtb_list1")
-
-	oList, ok := o.([]interface{})
-	if !ok {
-		return false
-	}
-	nList, ok := n.([]interface{})
-	if !ok {
-		return false
-	}
-
-	rtbListOld := ExpandStringList(oList)
-	rtbListNew := ExpandStringList(nList)
-
-	return Equivalent(rtbListOld, rtbListNew)
-}
-
-func DiffSuppressFuncRtbList2(k, old, new string, d *schema.ResourceData) bool {
-	o, n := d.GetChange("rtb_list2")
-
-	oList, ok := o.([]interface{})
-	if !ok {
-		return false
-	}
-	nList, ok := n.([]interface{})
-	if !ok {
-		return false
-	}
-
-	rtbListOld := ExpandStringList(oList)
-	rtbListNew := ExpandStringList(nList)
-
-	return Equivalent(rtbListOld, rtbListNew)
-}
@@ -1,12 +1,12 @@
 package goaviatrix
 
 import (
+	"context"
 	"fmt"
+	"log/slog"
 	"sort"
 	"strconv"
 	"strings"
-
-	log "github.com/sirupsen/logrus"
 )
 
 // AwsTGW simple struct to hold aws_tgw details
@@ -517,7 +517,7 @@ func (c *Client) GetTransitGwFromVpcID(awsTgw *AWSTgw, gateway *Gateway) (*Gatew
 			return gateway, nil
 		}
 	}
-	log.Errorf("Couldn't find transit gateway attached to vpc %s", gateway.VpcID)
+	c.log(context.Background(), slog.LevelError, fmt.Sprintf("Couldn't find transit gateway attached to vpc %s", gateway.VpcID))
 	return nil, ErrNotFound
 }
 
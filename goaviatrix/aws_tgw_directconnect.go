@@ -1,10 +1,11 @@
 package goaviatrix
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"log/slog"
 	"strings"
-
-	log "github.com/sirupsen/logrus"
 )
 
 type AwsTgwDirectConnect struct {
@@ -61,7 +62,7 @@ func (c *Client) GetAwsTgwDirectConnect(awsTgwDirectConnect *AwsTgwDirectConnect
 			awsTgwDirectConnect.SecurityDomainName = allAwsTgwDirectConn[i].SecurityDomainName
 			awsTgwDirectConnect.AllowedPrefix = strings.Join(allAwsTgwDirectConn[i].AllowedPrefix, ",")
 			awsTgwDirectConnect.LearnedCidrsApproval = allAwsTgwDirectConn[i].LearnedCidrsApproval
-			log.Debugf("Found Aws Tgw Direct Conn: %#v", awsTgwDirectConnect)
+			c.log(context.Background(), slog.LevelDebug, fmt.Sprintf("Found Aws Tgw Direct Conn: %#v", Redact(awsTgwDirectConnect)))
 			return awsTgwDirectConnect, nil
 		}
 	}
@@ -1,12 +1,10 @@
 package goaviatrix
 
 import (
+	"context"
 	"fmt"
+	"log/slog"
 	"strings"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	log "github.com/sirupsen/logrus"
 )
 
 type AwsTgwPeering struct {
@@ -51,7 +49,7 @@ func (c *Client) GetAwsTgwPeering(awsTgwPeering *AwsTgwPeering) error {
 		return err
 	}
 	if len(data.Results) == 0 {
-		log.Errorf("Aws tgw peering with tgw: %s and tgw: %s not found", awsTgwPeering.TgwName1, awsTgwPeering.TgwName2)
+		c.log(context.Background(), slog.LevelError, fmt.Sprintf("Aws tgw peering with tgw: %s and tgw: %s not found", awsTgwPeering.TgwName1, awsTgwPeering.TgwName2))
 		return ErrNotFound
 	}
 	peeringList := data.Results
@@ -69,33 +67,3 @@ func (c *Client) DeleteAwsTgwPeering(awsTgwPeering *AwsTgwPeering) error {
 	awsTgwPeering.Async = true
 	return c.PostAsyncAPI(awsTgwPeering.Action, awsTgwPeering, BasicCheck)
 }
-
-func DiffSuppressFuncAwsTgwPeeringTgwName1(k, old, new string, d *schema.ResourceData) bool {
-	tgwName2Old, _ := d.GetChange("tgw_name2")
-
-	tgw2Cur, ok := d.Get("tgw_name2").(string)
-	if !ok {
-		return false
-	}
-	tgw2Old, ok := tgwName2Old.(string)
-	if !ok {
-		return false
-	}
-
-	return old == tgw2Cur && new == tgw2Old
-}
-
-func DiffSuppressFuncAwsTgwPeeringTgwName2(k, old, new string, d *schema.ResourceData) bool {
-	tgwName1Old, _ := d.GetChange("tgw_name1")
-
-	tgw1Cur, ok := d.Get("tgw_name1").(string)
-	if !ok {
-		return false
-	}
-	tgw1Old, ok := tgwName1Old.(string)
-	if !ok {
-		return false
-	}
-
-	return old == tgw1Cur && new == tgw1Old
-}
@@ -3,8 +3,6 @@ package goaviatrix
 import (
 	"fmt"
 	"strings"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 type DomainConn struct {
@@ -81,151 +79,3 @@ func (c *Client) DeleteDomainConn(domainConn *DomainConn) error {
 	}
 	return c.PostAsyncAPI(form["action"], form, BasicCheck)
 }
-
-func DiffSuppressFuncAwsTgwPeeringDomainConnTgwName1(k, old, new string, d *schema.ResourceData) bool {
-	tgwName2Old, _ := d.GetChange("tgw_name2")
-	domainName1Old, _ := d.GetChange("domain_name1")
-	domainName2Old, _ := d.GetChange("domain_name2")
-
-	tgw2Cur, ok := d.Get("tgw_name2").(string)
-	if !ok {
-		return false
-	}
-	tgw2Old, ok := tgwName2Old.(string)
-	if !ok {
-		return false
-	}
-
-	dn1Cur, ok := d.Get("domain_name1").(string)
-	if !ok {
-		return false
-	}
-	dn2Cur, ok := d.Get("domain_name2").(string)
-	if !ok {
-		return false
-	}
-	dn1Old, ok := domainName1Old.(string)
-	if !ok {
-		return false
-	}
-	dn2Old, ok := domainName2Old.(string)
-	if !ok {
-		return false
-	}
-
-	return old == tgw2Cur &&
-		new == tgw2Old &&
-		dn1Cur == dn2Old &&
-		dn2Cur == dn1Old
-}
-
-func DiffSuppressFuncAwsTgwPeeringDomainConnTgwName2(k, old, new string, d *schema.ResourceData) bool {
-	tgwName1Old, _ := d.GetChange("tgw_name1")
-	domainName1Old, _ := d.GetChange("domain_name1")
-	domainName2Old, _ := d.GetChange("domain_name2")
-
-	tgw1Cur, ok := d.Get("tgw_name1").(string)
-	if !ok {
-		return false
-	}
-	tgw1Old, ok := tgwName1Old.(string)
-	if !ok {
-		return false
-	}
-
-	dn1Cur, ok := d.Get("domain_name1").(string)
-	if !ok {
-		return false
-	}
-	dn2Cur, ok := d.Get("domain_name2").(string)
-	if !ok {
-		return false
-	}
-	dn1Old, ok := domainName1Old.(string)
-	if !ok {
-		return false
-	}
-	dn2Old, ok := domainName2Old.(string)
-	if !ok {
-		return false
-	}
-
-	return old == tgw1Cur &&
-		new == tgw1Old &&
-		dn1Cur == dn2Old &&
-		dn2Cur == dn1Old
-}
-
-func DiffSuppressFuncAwsTgwPeeringDomainConnDomainName1(k, old, new string, d *schema.ResourceData) bool {
-	tgwName1Old, _ := d.GetChange("tgw_name1")
-	tgwName2Old, _ := d.GetChange("tgw_name2")
-	domainName2Old, _ := d.GetChange("domain_name2")
-
-	dn2Cur, ok := d.Get("domain_name2").(string)
-	if !ok {
-		return false
-	}
-	dn2Old, ok := domainName2Old.(string)
-	if !ok {
-		return false
-	}
-
-	tgw1Cur, ok := d.Get("tgw_name1").(string)
-	if !ok {
-		return false
-	}
-	tgw2Cur, ok := d.Get("tgw_name2").(string)
-	if !ok {
-		return false
-	}
-	tgw1Old, ok := tgwName1Old.(string)
-	if !ok {
-		return false
-	}
-	tgw2Old, ok := tgwName2Old.(string)
-	if !ok {
-		return false
-	}
-
-	return old == dn2Cur &&
-		new == dn2Old &&
-		tgw1Cur == tgw2Old &&
-		tgw2Cur == tgw1Old
-}
-
-func DiffSuppressFuncAwsTgwPeeringDomainConnDomainName2(k, old, new string, d *schema.ResourceData) bool {
-	tgwName1Old, _ := d.GetChange("tgw_name1")
-	tgwName2Old, _ := d.GetChange("tgw_name2")
-	domainName1Old, _ := d.GetChange("domain_name1")
-
-	dn1Cur, ok := d.Get("domain_name1").(string)
-	if !ok {
-		return false
-	}
-	dn1Old, ok := domainName1Old.(string)
-	if !ok {
-		return false
-	}
-
-	tgw1Cur, ok := d.Get("tgw_name1").(string)
-	if !ok {
-		return false
-	}
-	tgw2Cur, ok := d.Get("tgw_name2").(string)
-	if !ok {
-		return false
-	}
-	tgw1Old, ok := tgwName1Old.(string)
-	if !ok {
-		return false
-	}
-	tgw2Old, ok := tgwName2Old.(string)
-	if !ok {
-		return false
-	}
-
-	return old == dn1Cur &&
-		new == dn1Old &&
-		tgw1Cur == tgw2Old &&
-		tgw2Cur == tgw1Old
-}
@@ -1,13 +1,13 @@
 package goaviatrix
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"log/slog"
 	"strconv"
 	"strings"
-
-	log "github.com/sirupsen/logrus"
 )
 
 // VGWConn simple struct to hold VGW Connection details
@@ -172,7 +172,7 @@ func (c *Client) GetAwsTgwVpnConn(awsTgwVpnConn *AwsTgwVpnConn) (*AwsTgwVpnConn,
 			}
 			awsTgwVpnConn.OnpremASN = asnString
 
-			log.Debugf("Found AwsTgwVpnConn: %#v", awsTgwVpnConn)
+			c.log(context.Background(), slog.LevelDebug, fmt.Sprintf("Found AwsTgwVpnConn: %#v", Redact(awsTgwVpnConn)))
 
 			return awsTgwVpnConn, nil
 		}
@@ -0,0 +1,100 @@
+package goaviatrix
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// capabilityCache memoizes the answers of the Controller to capability
+// queries, such as the sizes a gateway can be launched with, for the life of
+// the client. A Terraform run plans many gateways against the same regions and
+// versions, so each distinct query is sent once; concurrent callers share the
+// request in flight.
+type capabilityCache struct {
+	mu      sync.Mutex
+	results map[string]capabilityResult
+	group   singleflight.Group
+}
+
+type capabilityResult struct {
+	value any
+	err   error
+}
+
+// cachedCapability returns the cached answer for key, or calls fetch and
+// caches its answer. Errors the Controller classified, e.g. a software version
+// it does not support or an action it does not know, are an answer too and
+// are cached, so such a query is asked only once. Other errors, such as a
+// failed connection or a cancelled context, are not.
+func cachedCapability[T any](c *capabilityCache, key string, fetch func() (T, error)) (T, error) {
+	c.mu.Lock()
+	result, ok := c.results[key]
+	c.mu.Unlock()
+	if !ok {
+		value, err, _ := c.group.Do(key, func() (any, error) {
+			value, err := fetch()
+			if err != nil && !IsClassified(err) {
+				return value, err
+			}
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			if c.results == nil {
+				c.results = make(map[string]capabilityResult)
+			}
+			c.results[key] = capabilityResult{value: value, err: err}
+			return value, err
+		})
+		result = capabilityResult{value: value, err: err}
+	}
+	value, _ := result.value.(T)
+	return value, result.err
+}
+
+func capabilityKey(action string, args ...string) string {
+	return action + "\x00" + strings.Join(args, "\x00")
+}
+
+// ListGatewayRegions returns the regions gateways of the account can be
+// launched in.
+func (c *Client) ListGatewayRegions(ctx context.Context, cloudType int, accountName string) ([]string, error) {
+	form := map[string]string{
+		"action":       "list_cloud_regions",
+		"CID":          c.CID,
+		"cloud_type":   strconv.Itoa(cloudType),
+		"account_name": accountName,
+	}
+	return cachedCapability(&c.capabilities, capabilityKey(form["action"], form["cloud_type"], accountName), func() ([]string, error) {
+		var data struct {
+			Results []string `json:"results"`
+		}
+		if err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck); err != nil {
+			return nil, err
+		}
+		return data.Results, nil
+	})
+}
+
+// ListGatewaySizes returns the instance sizes gateways of the account can be
+// launched with in the region.
+func (c *Client) ListGatewaySizes(ctx context.Context, cloudType int, accountName, region string) ([]string, error) {
+	form := map[string]string{
+		"action":       "list_gateway_instance_sizes",
+		"CID":          c.CID,
+		"cloud_type":   strconv.Itoa(cloudType),
+		"account_name": accountName,
+		"region":       region,
+	}
+	return cachedCapability(&c.capabilities, capabilityKey(form["action"], form["cloud_type"], accountName, region), func() ([]string, error) {
+		var data struct {
+			Results []string `json:"results"`
+		}
+		if err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck); err != nil {
+			return nil, err
+		}
+		return data.Results, nil
+	})
+}
@@ -0,0 +1,109 @@
+package goaviatrix
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix/controllertest"
+)
+
+func TestGetCompatibleImageVersion_Cached(t *testing.T) {
+	s := controllertest.NewServer()
+	defer s.Close()
+	var calls atomic.Int32
+	s.HandleAction("get_compatible_image_version", func(p controllertest.Params) (any, error) {
+		calls.Add(1)
+		return map[string]string{"image_version": "hvm-cloudx-aws-" + p.String("software_version")}, nil
+	})
+
+	client, err := NewClient(s.Username, s.Password, s.Host(), s.Client(), nil)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			imageVersion, err := client.GetCompatibleImageVersion(ctx, AWS, "7.1")
+			assert.NoError(t, err)
+			assert.Equal(t, "hvm-cloudx-aws-7.1", imageVersion)
+		})
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), calls.Load())
+
+	imageVersion, err := client.GetCompatibleImageVersion(ctx, AWS, "7.2")
+	require.NoError(t, err)
+	assert.Equal(t, "hvm-cloudx-aws-7.2", imageVersion)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestGetCompatibleImageVersion_ErrorCached(t *testing.T) {
+	s := controllertest.NewServer()
+	defer s.Close()
+	var calls atomic.Int32
+	s.HandleAction("get_compatible_image_version", func(controllertest.Params) (any, error) {
+		calls.Add(1)
+		return nil, errors.New("software version 1.0 is not supported")
+	})
+
+	client, err := NewClient(s.Username, s.Password, s.Host(), s.Client(), nil)
+	require.NoError(t, err)
+
+	for range 2 {
+		_, err := client.GetCompatibleImageVersion(context.Background(), AWS, "1.0")
+		assert.ErrorContains(t, err, "software version 1.0 is not supported")
+	}
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestCachedCapability_ContextErrorNotCached(t *testing.T) {
+	var c capabilityCache
+	var calls int
+	fetch := func() (string, error) {
+		calls++
+		if calls == 1 {
+			return "", context.Canceled
+		}
+		return "ok", nil
+	}
+
+	_, err := cachedCapability(&c, "key", fetch)
+	assert.ErrorIs(t, err, context.Canceled)
+	v, err := cachedCapability(&c, "key", fetch)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", v)
+	v, err = cachedCapability(&c, "key", fetch)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", v)
+	assert.Equal(t, 2, calls)
+}
+
+func TestListGatewayRegions_UnclassifiedErrorNotCached(t *testing.T) {
+	s := controllertest.NewServer()
+	defer s.Close()
+	var calls atomic.Int32
+	s.HandleAction("list_cloud_regions", func(controllertest.Params) (any, error) {
+		if calls.Add(1) == 1 {
+			return nil, errors.New("something went wrong")
+		}
+		return []string{"us-east-1"}, nil
+	})
+
+	client, err := NewClient(s.Username, s.Password, s.Host(), s.Client(), nil)
+	require.NoError(t, err)
+
+	_, err = client.ListGatewayRegions(context.Background(), AWS, "aws")
+	assert.ErrorContains(t, err, "something went wrong")
+	for range 2 {
+		regions, err := client.ListGatewayRegions(context.Background(), AWS, "aws")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"us-east-1"}, regions)
+	}
+	assert.Equal(t, int32(2), calls.Load())
+}
@@ -0,0 +1,205 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package goaviatrix
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that CapabilityClientMock does implement CapabilityClient.
+// If this is not the case, regenerate this file with moq.
+var _ CapabilityClient = &CapabilityClientMock{}
+
+// CapabilityClientMock is a mock implementation of CapabilityClient.
+//
+//	func TestSomethingThatUsesCapabilityClient(t *testing.T) {
+//
+//		// make and configure a mocked CapabilityClient
+//		mockedCapabilityClient := &CapabilityClientMock{
+//			GetCompatibleImageVersionFunc: func(ctx context.Context, cloudType int, softwareVersion string) (string, error) {
+//				panic("mock out the GetCompatibleImageVersion method")
+//			},
+//			ListGatewayRegionsFunc: func(ctx context.Context, cloudType int, accountName string) ([]string, error) {
+//				panic("mock out the ListGatewayRegions method")
+//			},
+//			ListGatewaySizesFunc: func(ctx context.Context, cloudType int, accountName string, region string) ([]string, error) {
+//				panic("mock out the ListGatewaySizes method")
+//			},
+//		}
+//
+//		// use mockedCapabilityClient in code that requires CapabilityClient
+//		// and then make assertions.
+//
+//	}
+type CapabilityClientMock struct {
+	// GetCompatibleImageVersionFunc mocks the GetCompatibleImageVersion method.
+	GetCompatibleImageVersionFunc func(ctx context.Context, cloudType int, softwareVersion string) (string, error)
+
+	// ListGatewayRegionsFunc mocks the ListGatewayRegions method.
+	ListGatewayRegionsFunc func(ctx context.Context, cloudType int, accountName string) ([]string, error)
+
+	// ListGatewaySizesFunc mocks the ListGatewaySizes method.
+	ListGatewaySizesFunc func(ctx context.Context, cloudType int, accountName string, region string) ([]string, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetCompatibleImageVersion holds details about calls to the GetCompatibleImageVersion method.
+		GetCompatibleImageVersion []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CloudType is the cloudType argument value.
+			CloudType int
+			// SoftwareVersion is the softwareVersion argument value.
+			SoftwareVersion string
+		}
+		// ListGatewayRegions holds details about calls to the ListGatewayRegions method.
+		ListGatewayRegions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CloudType is the cloudType argument value.
+			CloudType int
+			// AccountName is the accountName argument value.
+			AccountName string
+		}
+		// ListGatewaySizes holds details about calls to the ListGatewaySizes method.
+		ListGatewaySizes []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CloudType is the cloudType argument value.
+			CloudType int
+			// AccountName is the accountName argument value.
+			AccountName string
+			// Region is the region argument value.
+			Region string
+		}
+	}
+	lockGetCompatibleImageVersion sync.RWMutex
+	lockListGatewayRegions        sync.RWMutex
+	lockListGatewaySizes          sync.RWMutex
+}
+
+// GetCompatibleImageVersion calls GetCompatibleImageVersionFunc.
+func (mock *CapabilityClientMock) GetCompatibleImageVersion(ctx context.Context, cloudType int, softwareVersion string) (string, error) {
+	if mock.GetCompatibleImageVersionFunc == nil {
+		panic("CapabilityClientMock.GetCompatibleImageVersionFunc: method is nil but CapabilityClient.GetCompatibleImageVersion was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		CloudType       int
+		SoftwareVersion string
+	}{
+		Ctx:             ctx,
+		CloudType:       cloudType,
+		SoftwareVersion: softwareVersion,
+	}
+	mock.lockGetCompatibleImageVersion.Lock()
+	mock.calls.GetCompatibleImageVersion = append(mock.calls.GetCompatibleImageVersion, callInfo)
+	mock.lockGetCompatibleImageVersion.Unlock()
+	return mock.GetCompatibleImageVersionFunc(ctx, cloudType, softwareVersion)
+}
+
+// GetCompatibleImageVersionCalls gets all the calls that were made to GetCompatibleImageVersion.
+// Check the length with:
+//
+//	len(mockedCapabilityClient.GetCompatibleImageVersionCalls())
+func (mock *CapabilityClientMock) GetCompatibleImageVersionCalls() []struct {
+	Ctx             context.Context
+	CloudType       int
+	SoftwareVersion string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		CloudType       int
+		SoftwareVersion string
+	}
+	mock.lockGetCompatibleImageVersion.RLock()
+	calls = mock.calls.GetCompatibleImageVersion
+	mock.lockGetCompatibleImageVersion.RUnlock()
+	return calls
+}
+
+// ListGatewayRegions calls ListGatewayRegionsFunc.
+func (mock *CapabilityClientMock) ListGatewayRegions(ctx context.Context, cloudType int, accountName string) ([]string, error) {
+	if mock.ListGatewayRegionsFunc == nil {
+		panic("CapabilityClientMock.ListGatewayRegionsFunc: method is nil but CapabilityClient.ListGatewayRegions was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		CloudType   int
+		AccountName string
+	}{
+		Ctx:         ctx,
+		CloudType:   cloudType,
+		AccountName: accountName,
+	}
+	mock.lockListGatewayRegions.Lock()
+	mock.calls.ListGatewayRegions = append(mock.calls.ListGatewayRegions, callInfo)
+	mock.lockListGatewayRegions.Unlock()
+	return mock.ListGatewayRegionsFunc(ctx, cloudType, accountName)
+}
+
+// ListGatewayRegionsCalls gets all the calls that were made to ListGatewayRegions.
+// Check the length with:
+//
+//	len(mockedCapabilityClient.ListGatewayRegionsCalls())
+func (mock *CapabilityClientMock) ListGatewayRegionsCalls() []struct {
+	Ctx         context.Context
+	CloudType   int
+	AccountName string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		CloudType   int
+		AccountName string
+	}
+	mock.lockListGatewayRegions.RLock()
+	calls = mock.calls.ListGatewayRegions
+	mock.lockListGatewayRegions.RUnlock()
+	return calls
+}
+
+// ListGatewaySizes calls ListGatewaySizesFunc.
+func (mock *CapabilityClientMock) ListGatewaySizes(ctx context.Context, cloudType int, accountName string, region string) ([]string, error) {
+	if mock.ListGatewaySizesFunc == nil {
+		panic("CapabilityClientMock.ListGatewaySizesFunc: method is nil but CapabilityClient.ListGatewaySizes was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		CloudType   int
+		AccountName string
+		Region      string
+	}{
+		Ctx:         ctx,
+		CloudType:   cloudType,
+		AccountName: accountName,
+		Region:      region,
+	}
+	mock.lockListGatewaySizes.Lock()
+	mock.calls.ListGatewaySizes = append(mock.calls.ListGatewaySizes, callInfo)
+	mock.lockListGatewaySizes.Unlock()
+	return mock.ListGatewaySizesFunc(ctx, cloudType, accountName, region)
+}
+
+// ListGatewaySizesCalls gets all the calls that were made to ListGatewaySizes.
+// Check the length with:
+//
+//	len(mockedCapabilityClient.ListGatewaySizesCalls())
+func (mock *CapabilityClientMock) ListGatewaySizesCalls() []struct {
+	Ctx         context.Context
+	CloudType   int
+	AccountName string
+	Region      string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		CloudType   int
+		AccountName string
+		Region      string
+	}
+	mock.lockListGatewaySizes.RLock()
+	calls = mock.calls.ListGatewaySizes
+	mock.lockListGatewaySizes.RUnlock()
+	return calls
+}
@@ -0,0 +1,301 @@
+package goaviatrix
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// CassetteMode selects whether a client records its requests to a cassette
+// or replays them from one.
+type CassetteMode string
+
+const (
+	// CassetteRecord sends requests to the Controller and appends each
+	// request and its response to the cassette.
+	CassetteRecord CassetteMode = "record"
+	// CassetteReplay serves responses from the cassette without contacting
+	// the Controller.
+	CassetteReplay CassetteMode = "replay"
+)
+
+// ErrCassetteMiss is returned in replay mode for a request that has no
+// response left in the cassette.
+var ErrCassetteMiss = errors.New("no matching request in cassette")
+
+// CassetteOptions configure recording or replaying Controller requests.
+type CassetteOptions struct {
+	Mode CassetteMode
+	// Path is the cassette file. Recording replaces it.
+	Path string
+}
+
+// Interaction is a request to the Controller and its response as stored in
+// a cassette, one JSON object per line. Sensitive values are redacted from
+// both, as in logs, and request headers are not stored.
+type Interaction struct {
+	Method     string      `json:"method"`
+	URL        string      `json:"url"`
+	Body       string      `json:"body,omitempty"`
+	StatusCode int         `json:"status_code,omitempty"`
+	Header     http.Header `json:"header,omitempty"`
+	Response   string      `json:"response,omitempty"`
+	// Error is the error of a request that got no response.
+	Error string `json:"error,omitempty"`
+}
+
+// WithCassette records every request of the client and its response to a
+// cassette file, or replays the responses recorded in one with no Controller
+// present, e.g. to reproduce a problem offline or in a regression test.
+//
+// A replayed request is matched to a recorded one with the same method, URL
+// and body, after redaction. A request that does not match exactly, e.g. a
+// multipart upload, gets the first unused response to a request with the
+// same method, path and action.
+func WithCassette(o CassetteOptions) ClientOption {
+	return func(c *Client) { c.Cassette = o }
+}
+
+// cassetteTransport returns the transport that records or replays the
+// requests sent through next.
+func (c *Client) cassetteTransport(next http.RoundTripper) (http.RoundTripper, error) {
+	switch c.Cassette.Mode {
+	case CassetteRecord:
+		f, err := os.OpenFile(c.Cassette.Path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("could not create cassette: %w", err)
+		}
+		if err := f.Close(); err != nil {
+			return nil, fmt.Errorf("could not create cassette: %w", err)
+		}
+		if next == nil {
+			next = http.DefaultTransport
+		}
+		return &cassetteRecorder{next: next, redact: c.redactCassette, path: c.Cassette.Path}, nil
+	case CassetteReplay:
+		interactions, err := ReadCassette(c.Cassette.Path)
+		if err != nil {
+			return nil, err
+		}
+		return &cassetteReplayer{interactions: interactions, used: make([]bool, len(interactions)), redact: c.redactCassette}, nil
+	default:
+		return nil, fmt.Errorf("unknown cassette mode %q: expected %q or %q", c.Cassette.Mode, CassetteRecord, CassetteReplay)
+	}
+}
+
+// redactCassette redacts the sensitive fields and the session of the client
+// from s. The password is only sent in a sensitive field, and replacing it
+// wherever it appears could garble the cassette if it is a common word.
+func (c *Client) redactCassette(s string) string {
+	s = RedactString(s)
+	for _, secret := range []string{c.CID, c.GetCID(), c.APIToken} {
+		if secret != "" && secret != RedactedValue {
+			s = strings.ReplaceAll(s, secret, RedactedValue)
+		}
+	}
+	return s
+}
+
+// ReadCassette reads the interactions recorded in a cassette file.
+func ReadCassette(path string) ([]Interaction, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not open cassette: %w", err)
+	}
+	defer f.Close()
+
+	var interactions []Interaction
+	dec := json.NewDecoder(f)
+	for {
+		var i Interaction
+		if err := dec.Decode(&i); errors.Is(err, io.EOF) {
+			return interactions, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("could not read cassette %s: %w", path, err)
+		}
+		interactions = append(interactions, i)
+	}
+}
+
+// readRequestBody returns the body of req and a copy of req to send instead,
+// since reading the body consumes it.
+func readRequestBody(req *http.Request) (*http.Request, string, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, "", nil
+	}
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, "", err
+	}
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return req, string(body), nil
+}
+
+// cassetteRecorder appends each interaction to the cassette at path. The file
+// is opened for each write rather than kept open, since a provider client is
+// never closed.
+type cassetteRecorder struct {
+	next   http.RoundTripper
+	redact func(string) string
+	path   string
+
+	mu sync.Mutex
+}
+
+func (r *cassetteRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	req, body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	i := Interaction{
+		Method: req.Method,
+		URL:    r.redact(req.URL.RequestURI()),
+		Body:   r.redact(body),
+	}
+
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		i.Error = r.redact(err.Error())
+	} else {
+		respBody, readErr := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(respBody))
+		if readErr != nil {
+			return nil, readErr
+		}
+		i.StatusCode = resp.StatusCode
+		i.Header = resp.Header.Clone()
+		i.Header.Del("Set-Cookie")
+		i.Header.Del("Date")
+		i.Response = r.redact(string(respBody))
+	}
+
+	line, marshalErr := json.Marshal(i)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	// Each interaction is written as it happens, so a run that is killed
+	// still leaves the requests that led up to it.
+	if writeErr := r.write(append(line, '\n')); writeErr != nil {
+		return nil, fmt.Errorf("could not write to cassette: %w", writeErr)
+	}
+	return resp, err
+}
+
+func (r *cassetteRecorder) write(line []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	f, err := os.OpenFile(r.path, os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(line); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+type cassetteReplayer struct {
+	redact func(string) string
+
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+func (r *cassetteReplayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	req, body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	uri, body := r.redact(req.URL.RequestURI()), r.redact(body)
+
+	i := r.next(req.Method, uri, body)
+	if i == nil {
+		return nil, fmt.Errorf("%w: %s %s", ErrCassetteMiss, req.Method, uri)
+	}
+	if i.Error != "" {
+		return nil, errors.New(i.Error)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", i.StatusCode, http.StatusText(i.StatusCode)),
+		StatusCode:    i.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        i.Header.Clone(),
+		Body:          io.NopCloser(strings.NewReader(i.Response)),
+		ContentLength: int64(len(i.Response)),
+		Request:       req,
+	}, nil
+}
+
+// next returns the first unused interaction matching the request, and marks
+// it as used.
+func (r *cassetteReplayer) next(method, uri, body string) *Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	match := -1
+	for n, i := range r.interactions {
+		if !r.used[n] && i.Method == method && i.URL == uri && i.Body == body {
+			match = n
+			break
+		}
+	}
+	if match < 0 {
+		path, action := requestAction(uri, body)
+		for n, i := range r.interactions {
+			if r.used[n] || i.Method != method {
+				continue
+			}
+			if p, a := requestAction(i.URL, i.Body); p == path && a == action {
+				match = n
+				break
+			}
+		}
+	}
+	if match < 0 {
+		return nil
+	}
+	r.used[match] = true
+	return &r.interactions[match]
+}
+
+var multipartAction = regexp.MustCompile(`name="action"\r?\n\r?\n([^\r\n]*)`)
+
+// requestAction returns the path of a request and the API action it calls,
+// if any.
+func requestAction(uri, body string) (string, string) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return uri, ""
+	}
+	if action := u.Query().Get("action"); action != "" {
+		return u.Path, action
+	}
+	var payload struct {
+		Action string `json:"action"`
+	}
+	if json.Unmarshal([]byte(body), &payload) == nil && payload.Action != "" {
+		return u.Path, payload.Action
+	}
+	if m := multipartAction.FindStringSubmatch(body); m != nil {
+		return u.Path, m[1]
+	}
+	if values, err := url.ParseQuery(body); err == nil {
+		return u.Path, values.Get("action")
+	}
+	return u.Path, ""
+}
@@ -0,0 +1,92 @@
+package goaviatrix
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix/controllertest"
+)
+
+func cassetteSession(t *testing.T, client *Client) []string {
+	t.Helper()
+	require.NoError(t, client.CreateAccount(&Account{
+		AccountName:      "aws-acc",
+		CloudType:        AWS,
+		AwsAccountNumber: "123456789012",
+		AwsAccessKey:     "key",
+		AwsSecretKey:     "aws-secret-value",
+	}))
+	require.NoError(t, client.CreateSegmentationSecurityDomain(&SegmentationSecurityDomain{DomainName: "prod"}))
+	domains, err := client.ListSegmentationSecurityDomains()
+	require.NoError(t, err)
+	return domains
+}
+
+func TestCassette_RecordAndReplay(t *testing.T) {
+	s := controllertest.NewServer()
+	s.Password = "controller-password"
+	host := s.Host()
+	path := filepath.Join(t.TempDir(), "cassette.jsonl")
+
+	client, err := NewClient(s.Username, s.Password, host, s.Client(), nil,
+		WithCassette(CassetteOptions{Mode: CassetteRecord, Path: path}))
+	require.NoError(t, err)
+	cid := client.GetCID()
+	recorded := cassetteSession(t, client)
+	s.Close()
+
+	cassette, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(cassette), s.Password)
+	assert.NotContains(t, string(cassette), cid)
+	assert.NotContains(t, string(cassette), "aws-secret-value")
+	interactions, err := ReadCassette(path)
+	require.NoError(t, err)
+	assert.Len(t, interactions, 5)
+
+	client, err = NewClient(s.Username, s.Password, host, nil, nil,
+		WithCassette(CassetteOptions{Mode: CassetteReplay, Path: path}))
+	require.NoError(t, err)
+	assert.Equal(t, recorded, cassetteSession(t, client))
+
+	_, err = client.ListSegmentationSecurityDomains()
+	require.ErrorIs(t, err, ErrCassetteMiss)
+}
+
+func TestCassette_ReplayMatchesByAction(t *testing.T) {
+	r := &cassetteReplayer{
+		redact: RedactString,
+		interactions: []Interaction{
+			{Method: "POST", URL: "/v2/api", Body: "action=upload&name=a", StatusCode: 200, Response: "a"},
+			{Method: "POST", URL: "/v2/api", Body: "action=upload&name=b", StatusCode: 200, Response: "b"},
+		},
+		used: make([]bool, 2),
+	}
+
+	assert.Equal(t, "b", r.next("POST", "/v2/api", "action=upload&name=b").Response)
+	assert.Equal(t, "a", r.next("POST", "/v2/api", "action=upload&name=c").Response)
+	assert.Nil(t, r.next("POST", "/v2/api", "action=upload&name=a"))
+}
+
+func TestRequestAction(t *testing.T) {
+	tests := []struct {
+		uri, body  string
+		wantPath   string
+		wantAction string
+	}{
+		{"/v2/api?action=list_accounts&CID=***", "", "/v2/api", "list_accounts"},
+		{"/v2/api", "CID=***&action=connect_container", "/v2/api", "connect_container"},
+		{"/v2/api", `{"CID":"***","action":"create_gateway"}`, "/v2/api", "create_gateway"},
+		{"/v2/api", "--b\r\nContent-Disposition: form-data; name=\"action\"\r\n\r\nupload_file\r\n--b--", "/v2/api", "upload_file"},
+		{"/v2.5/api/app-domains", "", "/v2.5/api/app-domains", ""},
+	}
+	for _, tt := range tests {
+		path, action := requestAction(tt.uri, tt.body)
+		assert.Equal(t, tt.wantPath, path)
+		assert.Equal(t, tt.wantAction, action)
+	}
+}
@@ -3,23 +3,22 @@ package goaviatrix
 import (
 	"bytes"
 	"context"
-	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"mime/multipart"
 	"net/http"
 	"net/textproto"
 	"net/url"
 	"os"
-	"reflect"
 	"strings"
 	"sync"
-	"time"
+	"sync/atomic"
 
-	"github.com/ajg/form"
-	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/singleflight"
 )
 
 const (
@@ -48,26 +47,50 @@ type APIRequest struct {
 	Action string `form:"action,omitempty" json:"action" url:"action"`
 }
 
+// ClientInterface is the Controller API used by the provider, split into
+// per-domain interfaces so resources and their unit tests depend only on the
+// part they use.
+//
 //go:generate moq -rm -out client_mock.go . ClientInterface
 type ClientInterface interface {
-	DeleteAccount(account *Account) error
-	GetAccount(account *Account) (Account, error)
-	AuditAccount(ctx context.Context, account *Account) error
-	InvalidateCache()
+	AccountClient
+	CapabilityClient
+	DCFClient
+	EdgeClient
+	GatewayClient
+	SegmentationClient
+	Site2CloudClient
 }
 
+var _ ClientInterface = (*Client)(nil)
+
 // Client for accessing the Aviatrix Controller
 type Client struct {
-	ClientInterface
 	HTTPClient       *http.Client
 	Username         string
 	Password         string
+	APIToken         string
+	APITokenFile     string
 	CID              string
 	ControllerIP     string
 	baseURL          string
 	IgnoreTagsConfig *IgnoreTagsConfig
+	DefaultTags      *DefaultTagsConfig
+	RetryPolicy      RetryPolicy
+	TaskPoller       TaskPoller
+	TLS              TLSOptions
+	Cassette         CassetteOptions
+	RateLimit        RateLimit
+	Logger           *slog.Logger
+	TracerProvider   trace.TracerProvider
 	cachedAccounts   []Account
 	cacheMutex       sync.Mutex
+	middlewares      []Middleware
+	tokenFile        apiTokenFile
+	cid              atomic.Pointer[string]
+	throttle         *throttle
+	logins           singleflight.Group
+	capabilities     capabilityCache
 }
 
 type GetApiTokenResp struct {
@@ -87,9 +110,16 @@ func (c *Client) GetApiToken() (string, error) {
 	apiToken["action"] = "get_api_token"
 	apiToken["log_enable"] = true
 
-	log.Infof("Getting API token...")
+	c.log(context.Background(), slog.LevelInfo, "Getting API token...")
 	Url := fmt.Sprintf("https://%s/v2/api", c.ControllerIP)
-	resp, err := c.Get(Url, apiToken)
+	resp, err := c.Do(context.Background(), &Request{
+		Method:   "GET",
+		URL:      Url,
+		Version:  APIv1,
+		Encoding: EncodingForm,
+		Payload:  apiToken,
+		SkipAuth: true,
+	})
 	if err != nil {
 		return "", err
 	}
@@ -101,12 +131,12 @@ func (c *Client) GetApiToken() (string, error) {
 	if !data.Return {
 		return "", errors.New(data.Reason)
 	}
-	log.Tracef("Token is '%s'.", data.Results.ApiToken)
+	c.log(context.Background(), LevelTrace, "Received API token")
 	return data.Results.ApiToken, nil
 }
 
 // Login to the Aviatrix controller with the username/password provided in
-// the client structure.
+// the client structure, or take the CID from the API token when one is set.
 // Arguments:
 //
 //	None
@@ -115,6 +145,10 @@ func (c *Client) GetApiToken() (string, error) {
 //
 //	error - if any
 func (c *Client) Login() error {
+	if c.usesAPIToken() {
+		return c.loginWithAPIToken()
+	}
+
 	ApiToken, err := c.GetApiToken()
 	if err != nil {
 		return err
@@ -126,7 +160,7 @@ func (c *Client) Login() error {
 	account["password"] = c.Password
 
 	Url := fmt.Sprintf("https://%s/v2/api", c.ControllerIP)
-	log.Infof("Parsed Aviatrix login: %s", account["username"])
+	c.log(context.Background(), slog.LevelInfo, fmt.Sprintf("Parsed Aviatrix login: %s", account["username"]))
 	resp, err := c.RequestContextLogin(context.Background(), "POST", Url, account, ApiToken)
 	if err != nil {
 		return err
@@ -139,7 +173,7 @@ func (c *Client) Login() error {
 	if !data.Return {
 		return errors.New(data.Reason)
 	}
-	c.CID = data.CID
+	c.setCID(data.CID)
 	return nil
 }
 
@@ -149,7 +183,7 @@ func (c *Client) LoginForCloudn() error {
 	account["username"] = c.Username
 	account["password"] = c.Password
 
-	log.Infof("Parsed Aviatrix login: %s", account["username"])
+	c.log(context.Background(), slog.LevelInfo, fmt.Sprintf("Parsed Aviatrix login: %s", account["username"]))
 	resp, err := c.Post(c.baseURL, account)
 	if err != nil {
 		return err
@@ -162,8 +196,8 @@ func (c *Client) LoginForCloudn() error {
 	if !data.Return {
 		return errors.New(data.Reason)
 	}
-	log.Tracef("CID is '%s'.", data.CID)
-	c.CID = data.CID
+	c.log(context.Background(), LevelTrace, "Received CID")
+	c.setCID(data.CID)
 	return nil
 }
 
@@ -174,6 +208,7 @@ func (c *Client) LoginForCloudn() error {
 //	password - the controller password
 //	controllerIP - the controller IP/host
 //	HTTPClient - the http client object
+//	opts - optional settings applied before logging in
 //
 // Returns:
 //
@@ -183,11 +218,89 @@ func (c *Client) LoginForCloudn() error {
 // See Also:
 //
 //	init()
-func NewClient(username string, password string, controllerIP string, HTTPClient *http.Client, ignoreTagsConfig *IgnoreTagsConfig) (*Client, error) {
+func NewClient(username string, password string, controllerIP string, HTTPClient *http.Client, ignoreTagsConfig *IgnoreTagsConfig, opts ...ClientOption) (*Client, error) {
 	client := &Client{Username: username, Password: password, HTTPClient: HTTPClient, ControllerIP: controllerIP, IgnoreTagsConfig: ignoreTagsConfig}
+	for _, o := range opts {
+		o(client)
+	}
 	return client.init(controllerIP)
 }
 
+// GetControllerIP returns the Controller host/IP the client is connected to.
+func (c *Client) GetControllerIP() string {
+	return c.ControllerIP
+}
+
+// GetCID returns the current session ID. It starts out as CID and changes
+// whenever the client logs in again, while CID keeps the session ID of the
+// first login: the pipeline replaces it in every request with the current one.
+func (c *Client) GetCID() string {
+	if cid := c.cid.Load(); cid != nil {
+		return *cid
+	}
+	return c.CID
+}
+
+// setCID makes cid the current session ID. It is safe to call while other
+// goroutines send requests.
+func (c *Client) setCID(cid string) {
+	c.cid.Store(&cid)
+}
+
+// GetIgnoreTagsConfig returns the tags the provider was configured to ignore.
+func (c *Client) GetIgnoreTagsConfig() *IgnoreTagsConfig {
+	return c.IgnoreTagsConfig
+}
+
+// GetDefaultTagsConfig returns the tags the provider adds to every taggable
+// resource.
+func (c *Client) GetDefaultTagsConfig() *DefaultTagsConfig {
+	return c.DefaultTags
+}
+
+// ClientOption configures optional Client behavior.
+type ClientOption func(*Client)
+
+// WithRetryPolicy sets the policy used to retry failed controller calls.
+func WithRetryPolicy(p RetryPolicy) ClientOption {
+	return func(c *Client) { c.RetryPolicy = p }
+}
+
+// WithDefaultTags sets the tags the provider adds to every taggable resource.
+func WithDefaultTags(config *DefaultTagsConfig) ClientOption {
+	return func(c *Client) { c.DefaultTags = config }
+}
+
+// WithTaskPoller sets how the client waits for async Controller tasks.
+func WithTaskPoller(p TaskPoller) ClientOption {
+	return func(c *Client) { c.TaskPoller = p }
+}
+
+// taskPoller returns c.TaskPoller, or DefaultTaskPoller if it is unset, with
+// the client's logger unless it has its own.
+func (c *Client) taskPoller() TaskPoller {
+	p := c.TaskPoller
+	if p.Interval <= 0 {
+		p = DefaultTaskPoller()
+		p.Logger = c.TaskPoller.Logger
+	}
+	if p.Logger == nil {
+		p.Logger = c.Logger
+	}
+	return p
+}
+
+// WithTLSOptions sets the TLS settings of the transport created when no
+// HTTP client is passed to NewClient.
+func WithTLSOptions(o TLSOptions) ClientOption {
+	return func(c *Client) { c.TLS = o }
+}
+
+// WithMiddleware adds middlewares to the request pipeline. See Client.Use.
+func WithMiddleware(mw ...Middleware) ClientOption {
+	return func(c *Client) { c.Use(mw...) }
+}
+
 // init initializes the new client with the given controller IP/host.  Logs
 // in to the controller and sets up the http client.
 // Arguments:
@@ -204,20 +317,26 @@ func (c *Client) init(controllerIP string) (*Client, error) {
 	}
 	c.baseURL = "https://" + controllerIP + "/v2/api"
 	if c.HTTPClient == nil {
-		tr := &http.Transport{
-			Proxy: http.ProxyFromEnvironment,
-			// whynosemgrep: we need to support insecure TLS
-			// nosemgrep: problem-based-packs.insecure-transport.go-stdlib.bypass-tls-verification.bypass-tls-verification
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: true, //nolint:gosec
-				MinVersion:         tls.VersionTLS12,
-			},
+		tr, err := NewTransport(c.TLS)
+		if err != nil {
+			return nil, err
 		}
 		c.HTTPClient = &http.Client{Transport: tr}
 	}
+	if c.Cassette.Mode != "" {
+		tr, err := c.cassetteTransport(c.HTTPClient.Transport)
+		if err != nil {
+			return nil, err
+		}
+		httpClient := *c.HTTPClient
+		httpClient.Transport = tr
+		c.HTTPClient = &httpClient
+	}
+	c.throttle = newThrottle(c.RateLimit)
 	if err := c.Login(); err != nil {
 		return nil, err
 	}
+	c.CID = c.GetCID()
 	return c, nil
 }
 
@@ -408,7 +527,7 @@ func (c *Client) PostAsyncAPIContext(ctx context.Context, action string, i inter
 		o(&cfg)
 	}
 
-	log.Printf("[DEBUG] Post AsyncAPI %s: %v", action, i)
+	c.log(ctx, slog.LevelDebug, fmt.Sprintf("Post AsyncAPI %s: %v", action, Redact(i)))
 	resp, err := c.PostContext(ctx, c.baseURL, i)
 	if err != nil {
 		return fmt.Errorf("HTTP POST %s failed: %w", action, err)
@@ -433,7 +552,7 @@ func (c *Client) PostAsyncAPIContext(ctx context.Context, action string, i inter
 		return fmt.Errorf("json decode %s failed: %w (body: %s)", action, err, bodyString)
 	}
 	if !data.Return {
-		return fmt.Errorf("rest API %s POST failed to initiate async action: %s", action, data.Reason)
+		return classifyError(fmt.Errorf("rest API %s POST failed to initiate async action: %s", action, data.Reason), action, resp.StatusCode, data.Reason)
 	}
 
 	// Call the start response hook if provided
@@ -446,35 +565,28 @@ func (c *Client) PostAsyncAPIContext(ctx context.Context, action string, i inter
 
 	requestID := resultsToString(data.Result)
 
-	const maxPoll = 360
-	sleepDuration := time.Second * 10
-	var j int
-	for ; j < maxPoll; j++ {
-		form := cfg.pollPayload(requestID)
-		resp, err = c.PostContext(ctx, c.baseURL, form)
+	return c.taskPoller().Wait(ctx, action, requestID, func(ctx context.Context) (bool, error) {
+		resp, err := c.PostContext(ctx, c.baseURL, cfg.pollPayload(requestID))
 		if err != nil {
 			// Could be transient HTTP error, e.g. EOF error
-			time.Sleep(sleepDuration)
-			continue
+			return false, &transientPollError{err: err}
 		}
 
-		buf = new(bytes.Buffer)
+		buf := new(bytes.Buffer)
 		if _, readErr := buf.ReadFrom(resp.Body); readErr != nil {
 			_ = resp.Body.Close()
-			return fmt.Errorf("read check_task_status body failed: %w", readErr)
+			return false, fmt.Errorf("read check_task_status body failed: %w", readErr)
 		}
 		_ = resp.Body.Close()
 
 		pollBodyString := buf.String()
-		err = json.Unmarshal([]byte(pollBodyString), &data)
-		if err != nil {
+		if err := json.Unmarshal([]byte(pollBodyString), &data); err != nil {
 			// Only check for status codes after trying to parse JSON because we may get an error with a valid JSON body
 			// and that is a valid and actionable response...
 			if resp.StatusCode == http.StatusBadGateway || resp.StatusCode == http.StatusServiceUnavailable {
-				time.Sleep(sleepDuration)
-				continue
+				return false, &transientPollError{err: fmt.Errorf("check_task_status returned %s", resp.Status)}
 			}
-			return fmt.Errorf("decode check_task_status failed: %w\n Body: %s", err, pollBodyString)
+			return false, fmt.Errorf("decode check_task_status failed: %w\n Body: %s", err, pollBodyString)
 		}
 
 		// Call the hook on each poll response to capture fields like ha_gw_name
@@ -487,20 +599,16 @@ func (c *Client) PostAsyncAPIContext(ctx context.Context, action string, i inter
 
 		if !data.Return {
 			if data.Reason != "" && data.Reason != "REQUEST_IN_PROGRESS" {
-				return fmt.Errorf("rest API %s POST failed: %s", action, data.Reason)
+				return false, classifyError(fmt.Errorf("rest API %s POST failed: %s", action, data.Reason), action, resp.StatusCode, data.Reason)
 			}
-
 			// Not done yet
-			time.Sleep(sleepDuration)
-			continue
+			return false, nil
 		}
 
 		// Async API is done, return result of checkFunc
-		return checkFunc(action, "Post", resultsToString(data.Result), data.Return)
-	}
-
-	// Waited for too long and async API never finished
-	return fmt.Errorf("waited %s but upgrade never finished. Please manually verify the upgrade status", maxPoll*sleepDuration)
+		reason := resultsToString(data.Result)
+		return true, classifyError(checkFunc(action, "Post", reason, data.Return), action, resp.StatusCode, reason)
+	})
 }
 
 // checkAPIResp will decode the response and check for any errors with the provided checkFunc
@@ -519,7 +627,7 @@ func checkAPIResp(resp *http.Response, action string, checkFunc CheckAPIResponse
 		return fmt.Errorf("json Decode %q failed: %w\n Body: %s", action, err, bodyForError(body))
 	}
 
-	return checkFunc(action, "Post", data.Reason, data.Return)
+	return classifyError(checkFunc(action, "Post", data.Reason, data.Return), action, resp.StatusCode, data.Reason)
 }
 
 // checkAndReturnAPIResp will decode the response and check for any errors with the provided checkFunc.
@@ -540,7 +648,7 @@ func checkAndReturnAPIResp(resp *http.Response, v interface{}, method, action st
 	}
 
 	if err := checkFunc(action, method, data.Reason, data.Return); err != nil {
-		return err
+		return classifyError(err, action, resp.StatusCode, data.Reason)
 	}
 
 	if err := json.NewDecoder(bytes.NewReader(body)).Decode(&v); err != nil {
@@ -568,6 +676,7 @@ func readBody(r io.Reader) ([]byte, error) {
 }
 
 func bodyForError(body []byte) string {
+	body = []byte(RedactString(string(body)))
 	if len(body) <= maxErrorBody {
 		return string(body)
 	}
@@ -581,7 +690,7 @@ func bodyForError(body []byte) string {
 }
 
 // GetAPIContext makes a GET request to the Aviatrix API
-// If the GET request fails we will retry
+// If the GET request fails the request pipeline will retry it
 // First, we decode into the generic APIResp struct, then check for errors
 // If no errors, we will decode into the user defined structure that is passed in
 func (c *Client) GetAPIContext(
@@ -596,38 +705,9 @@ func (c *Client) GetAPIContext(
 		return fmt.Errorf("could not url encode values for action %q: %w", action, err)
 	}
 
-	try, maxTries := 0, 5
-	backoff := 500 * time.Millisecond
-
-	var resp *http.Response
-	for {
-		try++
-
-		resp, err = c.GetContext(ctx, Url, nil)
-		if err == nil {
-			break
-		}
-
-		if resp != nil && resp.Body != nil {
-			_ = resp.Body.Close()
-		}
-
-		log.WithFields(log.Fields{
-			"try":    try,
-			"action": action,
-			"err":    err.Error(),
-		}).Warnf("HTTP GET request failed")
-
-		if try == maxTries {
-			return fmt.Errorf("HTTP Get %s failed after %d tries: %w", action, try, err)
-		}
-
-		select {
-		case <-time.After(backoff):
-			backoff *= 2
-		case <-ctx.Done():
-			return ctx.Err()
-		}
+	resp, err := c.GetContext(ctx, Url, nil)
+	if err != nil {
+		return fmt.Errorf("HTTP Get %s failed: %w", action, err)
 	}
 
 	if resp == nil || resp.Body == nil {
@@ -651,7 +731,7 @@ func (c *Client) GetAPIContext(
 	}
 
 	if err := checkFunc(action, "Get", data.Reason, data.Return); err != nil {
-		return err
+		return classifyError(err, action, resp.StatusCode, data.Reason)
 	}
 
 	if err := json.Unmarshal(body, v); err != nil {
@@ -699,34 +779,19 @@ type File struct {
 
 // PostFile will encode the files and parameters with multipart form encoding.
 func (c *Client) PostFile(path string, params map[string]string, files []File) (*http.Response, error) {
-	body, contentType, err := encodeMultipartFormData(params, files)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", path, body)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("Content-Type", contentType)
-
-	return c.HTTPClient.Do(req)
+	return c.PostFileContext(context.Background(), path, params, files)
 }
 
 // PostFileContext will encode the files and parameters with multipart form encoding.
 func (c *Client) PostFileContext(ctx context.Context, path string, params map[string]string, files []File) (*http.Response, error) {
-	body, contentType, err := encodeMultipartFormData(params, files)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequestWithContext(ctx, "POST", path, body)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("Content-Type", contentType)
-
-	return c.HTTPClient.Do(req)
+	return c.Do(ctx, &Request{
+		Method:   "POST",
+		URL:      path,
+		Version:  APIv1,
+		Encoding: EncodingMultipart,
+		Params:   params,
+		Files:    files,
+	})
 }
 
 func encodeMultipartFormData(params map[string]string, files []File) (*bytes.Buffer, string, error) {
@@ -805,227 +870,25 @@ func (c *Client) Request(verb string, path string, i interface{}) (*http.Respons
 // RequestContext makes an HTTP request with the given interface being encoded as
 // form data.
 func (c *Client) RequestContext(ctx context.Context, verb string, path string, i interface{}) (*http.Response, error) {
-	log.Tracef("%s %s", verb, path)
-
-	try, maxTries, backoff := 0, 2, 500*time.Millisecond
-	var req *http.Request
-	var err error
-	var data *APIResp
-	var resp *http.Response
-
-	for {
-		try++
-
-		if i != nil {
-			body, err := form.EncodeToValues(i)
-			if err != nil {
-				return nil, err
-			}
-			reader := strings.NewReader(body.Encode())
-			req, err = http.NewRequestWithContext(ctx, verb, path, reader)
-			if err == nil {
-				req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-			}
-		} else {
-			req, err = http.NewRequestWithContext(ctx, verb, path, nil)
-		}
-
-		if err != nil {
-			return nil, err
-		}
-
-		resp, err = c.HTTPClient.Do(req)
-		if err != nil {
-			return resp, err
-		}
-
-		if resp == nil || resp.Body == nil {
-			return resp, fmt.Errorf("nil response/body")
-		}
-
-		body, readErr := readBody(resp.Body)
-		if readErr != nil {
-			_ = resp.Body.Close()
-			return resp, fmt.Errorf("read response body failed: %w", readErr)
-		}
-		_ = resp.Body.Close()
-
-		// Replace resp.Body with a new ReadCloser so that other methods can read the body again.
-		resp.Body = io.NopCloser(bytes.NewReader(body))
-
-		if strings.Contains(resp.Header.Get("Content-Type"), "json") {
-			data = new(APIResp)
-			if err := json.NewDecoder(bytes.NewReader(body)).Decode(data); err != nil {
-				return resp, fmt.Errorf(
-					"json Decode into standard format failed: %w\n Body: %s",
-					err,
-					bodyForError(body),
-				)
-			}
-
-			// Any error not related to expired CID should return
-			if !(strings.Contains(data.Reason, "CID is invalid") || strings.Contains(data.Reason, "Invalid session. Please login again.")) {
-				return resp, err
-			}
-
-			log.Tracef("CID invalid or expired. Trying to login again")
-			if err = c.Login(); err != nil {
-				return resp, err
-			}
-
-			// update the CID value in the object passed
-			if i != nil {
-				// Update CID in POST body
-				v := reflect.ValueOf(i)
-				if v.Kind() == reflect.Map {
-					v.SetMapIndex(reflect.ValueOf("CID"), reflect.ValueOf(c.CID))
-				} else {
-					s := v.Elem()
-					f := s.FieldByName("CID")
-					if f.IsValid() && f.CanSet() {
-						f.SetString(c.CID)
-					}
-				}
-			} else {
-				// Update CID in GET URL
-				Url, err := url.Parse(path)
-				if err != nil {
-					return resp, fmt.Errorf("failed to parse url: %w", err)
-				}
-				query := Url.Query()
-				query["CID"] = []string{c.CID}
-				Url.RawQuery = query.Encode()
-				path = Url.String()
-			}
-
-			log.WithFields(log.Fields{
-				"try": try,
-				"err": "CID is invalid",
-			}).Warnf("HTTP request failed with expired CID")
-
-			if try == maxTries {
-				return resp, fmt.Errorf("%v", data.Reason)
-			}
-			time.Sleep(backoff)
-			// Double the backoff time after each failed try
-			backoff *= 2
-		} else {
-			return resp, nil
-		}
-	}
+	return c.Do(ctx, &Request{
+		Method:   verb,
+		URL:      path,
+		Version:  APIv1,
+		Encoding: EncodingForm,
+		Payload:  i,
+	})
 }
 
+// RequestContextLogin makes a form encoded login request authenticated with the
+// given API token instead of a CID.
 func (c *Client) RequestContextLogin(ctx context.Context, verb string, path string, i interface{}, token string) (*http.Response, error) {
-	log.Tracef("%s %s", verb, path)
-
-	try, maxTries, backoff := 0, 2, 500*time.Millisecond
-	var req *http.Request
-	var err error
-	var data *APIResp
-	var resp *http.Response
-
-	for {
-		try++
-
-		if i != nil {
-			buf := new(bytes.Buffer)
-			if err = form.NewEncoder(buf).Encode(i); err != nil {
-				return nil, err
-			}
-			body := buf.String()
-			log.Tracef("%s %s Body: %s", verb, path, body)
-
-			reader := strings.NewReader(body)
-			req, err = http.NewRequestWithContext(ctx, verb, path, reader)
-			if err == nil {
-				req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-				req.Header.Add("X-Access-Key", token)
-			}
-		} else {
-			req, err = http.NewRequestWithContext(ctx, verb, path, nil)
-		}
-
-		if err != nil {
-			return nil, err
-		}
-
-		resp, err = c.HTTPClient.Do(req)
-		if err != nil {
-			return resp, err
-		}
-
-		if resp == nil || resp.Body == nil {
-			return resp, fmt.Errorf("nil response/body")
-		}
-
-		body, readErr := readBody(resp.Body)
-		if readErr != nil {
-			_ = resp.Body.Close()
-			return resp, fmt.Errorf("read response body failed: %w", readErr)
-		}
-		_ = resp.Body.Close()
-
-		// Replace resp.Body with a new ReadCloser so that other methods can read the body again.
-		resp.Body = io.NopCloser(bytes.NewReader(body))
-
-		if strings.Contains(resp.Header.Get("Content-Type"), "json") {
-			data = new(APIResp)
-			if err := json.NewDecoder(bytes.NewReader(body)).Decode(data); err != nil {
-				return resp, fmt.Errorf(
-					"json Decode into standard format failed: %w\n Body: %s",
-					err,
-					bodyForError(body),
-				)
-			}
-
-			// Any error not related to expired CID should return
-			if !(strings.Contains(data.Reason, "CID is invalid") || strings.Contains(data.Reason, "Invalid session. Please login again.")) {
-				return resp, err
-			}
-
-			log.Tracef("CID invalid or expired. Trying to login again")
-			if err = c.Login(); err != nil {
-				return resp, err
-			}
-
-			// update the CID value in the object passed
-			if i != nil {
-				// Update CID in POST body
-				v := reflect.ValueOf(i)
-				if v.Kind() == reflect.Map {
-					v.SetMapIndex(reflect.ValueOf("CID"), reflect.ValueOf(c.CID))
-				} else {
-					s := v.Elem()
-					f := s.FieldByName("CID")
-					if f.IsValid() && f.CanSet() {
-						f.SetString(c.CID)
-					}
-				}
-			} else {
-				// Update CID in GET URL
-				Url, err := url.Parse(path)
-				if err != nil {
-					return resp, fmt.Errorf("failed to parse url: %w", err)
-				}
-				query := Url.Query()
-				query["CID"] = []string{c.CID}
-				Url.RawQuery = query.Encode()
-				path = Url.String()
-			}
-
-			log.WithFields(log.Fields{
-				"try": try,
-				"err": "CID is invalid",
-			}).Warnf("HTTP request failed with expired CID")
-
-			if try == maxTries {
-				return resp, fmt.Errorf("%v", data.Reason)
-			}
-			time.Sleep(backoff)
-			// Double the backoff time after each failed try
-			backoff *= 2
-		} else {
-			return resp, nil
-		}
-	}
+	return c.Do(ctx, &Request{
+		Method:   verb,
+		URL:      path,
+		Version:  APIv1,
+		Encoding: EncodingForm,
+		Payload:  i,
+		Header:   http.Header{"X-Access-Key": []string{token}},
+		SkipAuth: true,
+	})
 }
@@ -5,13 +5,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 	"strings"
-	"time"
-
-	log "github.com/sirupsen/logrus"
 )
 
 type APIError struct {
@@ -29,9 +25,9 @@ func checkAndReturnAPIResp25(resp *http.Response, v interface{}, method, path st
 	if resp.StatusCode >= 300 || resp.StatusCode < 200 {
 		var apiError APIError
 		if err := json.NewDecoder(strings.NewReader(bodyString)).Decode(&apiError); err != nil {
-			return NewStatusErrorf(resp.StatusCode, "json Decode failed: %w\n Body: %s", err, bodyString)
+			return classifyError(fmt.Errorf("json Decode failed: %w\n Body: %s", err, bodyString), path, resp.StatusCode, "")
 		}
-		return NewStatusErrorf(resp.StatusCode, "HTTP %s %q failed: %s", method, path, apiError.Message)
+		return classifyError(fmt.Errorf("HTTP %s %q failed: %s", method, path, apiError.Message), path, resp.StatusCode, apiError.Message)
 	}
 
 	if v != nil {
@@ -63,38 +59,9 @@ func (c *Client) GetAPIContext25(ctx context.Context, v interface{}, path string
 		return fmt.Errorf("could not url encode values for path %q: %w", path, err)
 	}
 
-	try, maxTries := 0, 5
-	backoff := 500 * time.Millisecond
-
-	var resp *http.Response
-	for {
-		try++
-		resp, err = c.requestContext25(ctx, "GET", Url, nil)
-		if err == nil {
-			break
-		}
-
-		// Some failures still return a response; don't leak it while retrying.
-		if resp != nil && resp.Body != nil {
-			_ = resp.Body.Close()
-		}
-
-		log.WithFields(log.Fields{
-			"try":    try,
-			"action": path,
-			"err":    err.Error(),
-		}).Warnf("HTTP GET request failed")
-
-		if try == maxTries {
-			return NewStatusErrorf(resp.StatusCode, "HTTP Get %s failed: %w", path, err)
-		}
-
-		time.Sleep(backoff)
-		backoff *= 2
-	}
-
-	if resp == nil || resp.Body == nil {
-		return NewStatusErrorf(resp.StatusCode, "HTTP Get %s returned nil response/body", path)
+	resp, err := c.requestContext25(ctx, "GET", Url, nil)
+	if err != nil {
+		return NewStatusErrorf(statusCode(resp), "HTTP Get %s failed: %w", path, err)
 	}
 	defer resp.Body.Close()
 
@@ -131,87 +98,13 @@ func (c *Client) DoAPIContext25(ctx context.Context, verb string, v interface{},
 }
 
 func (c *Client) requestContext25(ctx context.Context, verb string, URL string, i interface{}) (*http.Response, error) {
-	log.Tracef("%s %s", verb, URL)
-
-	try, maxTries, backoff := 0, 2, 500*time.Millisecond
-	var req *http.Request
-	var err error
-	var apiError *APIError
-	var resp *http.Response
-
-	if i != nil {
-		body, err := json.Marshal(i)
-		if err != nil {
-			return nil, err
-		}
-		log.Tracef("%s %s Body: %s", verb, URL, body)
-		reader := bytes.NewReader(body)
-
-		req, err = http.NewRequestWithContext(ctx, verb, URL, reader)
-		if err == nil {
-			req.Header.Set("Content-Type", "application/json")
-		}
-	} else {
-		req, err = http.NewRequestWithContext(ctx, verb, URL, nil)
-	}
-
-	if err != nil {
-		return nil, err
-	}
-
-	for {
-		try++
-
-		// Set CID as Authorization header for v2.5
-		req.Header.Set("Authorization", fmt.Sprintf("cid %s", c.CID))
-
-		resp, err = c.HTTPClient.Do(req)
-		if err != nil {
-			return resp, err
-		}
-
-		if resp.StatusCode == 403 {
-			buf := new(bytes.Buffer)
-			if _, readErr := buf.ReadFrom(resp.Body); readErr != nil {
-				_ = resp.Body.Close()
-				return resp, readErr
-			}
-			_ = resp.Body.Close()
-
-			// Replace resp.Body with new ReadCloser so that other methods can read the buffer again
-			resp.Body = io.NopCloser(buf)
-
-			bodyString := buf.String()
-			apiError = new(APIError)
-			if err := json.NewDecoder(strings.NewReader(bodyString)).Decode(apiError); err != nil {
-				return resp, fmt.Errorf("json Decode into error message failed: %w\n Body: %s", err, bodyString)
-			}
-
-			if !strings.Contains(apiError.Message, "Invalid CID") {
-				log.Debugf("[DEBUG] API Response Error: %s\n", apiError.Message)
-				return resp, err
-			}
-
-			log.Tracef("CID invalid or expired. Trying to login again")
-			if err = c.Login(); err != nil {
-				return resp, err
-			}
-			log.WithFields(log.Fields{
-				"try": try,
-				"err": "CID is invalid",
-			}).Warnf("HTTP request failed with expired CID")
-
-			if try == maxTries {
-				return resp, fmt.Errorf("%v", apiError.Message)
-			}
-			time.Sleep(backoff)
-			// Double the backoff time after each failed try
-			backoff *= 2
-		} else {
-			log.Debugf("[DEBUG] HTTP Response: %v\n", resp)
-			return resp, err
-		}
-	}
+	return c.Do(ctx, &Request{
+		Method:   verb,
+		URL:      URL,
+		Version:  APIv25,
+		Encoding: EncodingJSON,
+		Payload:  i,
+	})
 }
 
 // PostFileContext25 will encode the files and parameters with multipart form encoding.
@@ -230,76 +123,20 @@ func (c *Client) DoAPIFileContext25(ctx context.Context, verb string, path strin
 }
 
 func (c *Client) RequestFileContext25(ctx context.Context, verb string, Url string, params map[string]string, files []File) (*http.Response, error) {
-	log.Tracef("%s %s", verb, Url)
-
-	try, maxTries, backoff := 0, 2, 500*time.Millisecond
-	var req *http.Request
-	var err error
-	var apiError *APIError
-	var resp *http.Response
-
-	body, contentType, err := encodeMultipartFormData(params, files)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err = http.NewRequestWithContext(ctx, verb, Url, body)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("Content-Type", contentType)
-
-	for {
-		try++
-
-		// Set CID as Authorization header for v2.5
-		req.Header.Set("Authorization", fmt.Sprintf("cid %s", c.CID))
-
-		resp, err = c.HTTPClient.Do(req)
-		if err != nil {
-			return resp, err
-		}
-
-		if resp.StatusCode == 403 {
-			buf := new(bytes.Buffer)
-			if _, readErr := buf.ReadFrom(resp.Body); readErr != nil {
-				_ = resp.Body.Close()
-				return resp, readErr
-			}
-			_ = resp.Body.Close()
-
-			// Replace resp.Body with new ReadCloser so that other methods can read the buffer again
-			resp.Body = io.NopCloser(buf)
-
-			bodyString := buf.String()
-			apiError = new(APIError)
-			if err := json.NewDecoder(strings.NewReader(bodyString)).Decode(apiError); err != nil {
-				return resp, fmt.Errorf("json Decode into error message failed: %w\n Body: %s", err, bodyString)
-			}
-
-			if !strings.Contains(apiError.Message, "Invalid CID") {
-				log.Debugf("[DEBUG] API Response Error: %s\n", apiError.Message)
-				return resp, err
-			}
-
-			log.Tracef("CID invalid or expired. Trying to login again")
-			if err = c.Login(); err != nil {
-				return resp, err
-			}
-			log.WithFields(log.Fields{
-				"try": try,
-				"err": "CID is invalid",
-			}).Warnf("HTTP request failed with expired CID")
+	return c.Do(ctx, &Request{
+		Method:   verb,
+		URL:      Url,
+		Version:  APIv25,
+		Encoding: EncodingMultipart,
+		Params:   params,
+		Files:    files,
+	})
+}
 
-			if try == maxTries {
-				return resp, fmt.Errorf("%v", apiError.Message)
-			}
-			time.Sleep(backoff)
-			// Double the backoff time after each failed try
-			backoff *= 2
-		} else {
-			log.Debugf("[DEBUG] HTTP Response: %v\n", resp)
-			return resp, err
-		}
+// statusCode returns the HTTP status of resp, or 0 when there is no response.
+func statusCode(resp *http.Response) int {
+	if resp == nil {
+		return 0
 	}
+	return resp.StatusCode
 }
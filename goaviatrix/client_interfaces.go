@@ -0,0 +1,455 @@
+package goaviatrix
+
+import "context"
+
+// AccountClient is the Controller API used by the account resources.
+//
+//go:generate moq -rm -out account_client_mock.go . AccountClient
+type AccountClient interface {
+	AuditAccount(ctx context.Context, account *Account) error
+	CreateAccount(account *Account) error
+	CreateAccountUser(user *AccountUser) error
+	CreateEdgeAccount(edgeAccount *EdgeAccount) error
+	CreateGCPAccount(account *Account) error
+	CreateOCIAccount(account *Account) error
+	DeleteAccount(account *Account) error
+	DeleteAccountUser(user *AccountUser) error
+	GetAccount(account *Account) (Account, error)
+	GetAccountUser(user *AccountUser) (*AccountUser, error)
+	InvalidateCache()
+	UpdateAccount(account *Account) error
+	UpdateAccountUserObject(user *AccountUserEdit) error
+	UpdateEdgeAccount(edgeAccount *EdgeAccount) error
+	UpdateGCPAccount(account *Account) error
+}
+
+// CapabilityClient is the Controller API used to validate gateway arguments
+// at plan time. Results are cached for the life of the client.
+//
+//go:generate moq -rm -out capability_client_mock.go . CapabilityClient
+type CapabilityClient interface {
+	GetCompatibleImageVersion(ctx context.Context, cloudType int, softwareVersion string) (string, error)
+	ListGatewayRegions(ctx context.Context, cloudType int, accountName string) ([]string, error)
+	ListGatewaySizes(ctx context.Context, cloudType int, accountName, region string) ([]string, error)
+}
+
+// DCFClient is the Controller API used by the distributed cloud firewall
+// (DCF), smart group and web group resources.
+//
+//go:generate moq -rm -out dcf_client_mock.go . DCFClient
+type DCFClient interface {
+	CreateDCFMitmCa(ctx context.Context, mitmCa *MitmCaItemRequest) (string, error)
+	CreateDCFPolicyBlock(ctx context.Context, policyBlock *DCFPolicyBlock) (string, error)
+	CreateDCFPolicyList(ctx context.Context, policyList *DCFPolicyList) (string, error)
+	CreateDCFTrustBundle(ctx context.Context, trustBundle *TrustBundleItemRequest) (string, error)
+	CreateDistributedFirewallingDeploymentPolicy(ctx context.Context, deploymentPolicy *DistributedFirewallingDeploymentPolicy) error
+	CreateDistributedFirewallingIntraVpc(ctx context.Context, vpcList *DistributedFirewallingIntraVpcList) error
+	CreateDistributedFirewallingPolicyList(ctx context.Context, policyList *DistributedFirewallingPolicyList) error
+	CreateIpsProfile(ctx context.Context, profile *IpsProfile) (*IpsProfileCreateResponse, error)
+	CreateIpsRuleFeed(ctx context.Context, ruleFeed *IpsRuleFeed) (*IpsRuleFeedUploadResponse, error)
+	CreateSmartGroup(ctx context.Context, smartGroup *SmartGroup) (string, error)
+	CreateTLSProfile(ctx context.Context, tlsProfile *TLSProfile) (string, error)
+	CreateWebGroup(ctx context.Context, webGroup *WebGroup) (string, error)
+	DeleteCaCertificate(ctx context.Context) error
+	DeleteDCFMitmCa(ctx context.Context, caID string) error
+	DeleteDCFPolicyBlock(ctx context.Context, uuid string) error
+	DeleteDCFPolicyList(ctx context.Context, uuid string) error
+	DeleteDCFTrustBundle(ctx context.Context, bundleUUID string) error
+	DeleteDistributedFirewallingIntraVpc(ctx context.Context) error
+	DeleteDistributedFirewallingPolicyList(ctx context.Context) error
+	DeleteEnforcementLevel(ctx context.Context) error
+	DeleteIpsProfile(ctx context.Context, uuid string) error
+	DeleteIpsRuleFeed(ctx context.Context, uuid string) error
+	DeleteSmartGroup(ctx context.Context, uuid string) error
+	DeleteTLSProfile(ctx context.Context, uuid string) error
+	DeleteWebGroup(ctx context.Context, uuid string) error
+	DisableDistributedFirewalling(ctx context.Context) error
+	EnableDistributedFirewalling(ctx context.Context) error
+	GetCaCertificate(ctx context.Context) (*ProxyCaConfig, error)
+	GetControllerIP() string
+	GetDCFAttachmentPoint(ctx context.Context, name string) (*AttachmentPointResp, error)
+	GetDCFMitmCa(ctx context.Context, caID string) (*MitmCaResponse, error)
+	GetDCFPolicyBlock(ctx context.Context, uuid string) (*DCFPolicyBlock, error)
+	GetDCFPolicyList(ctx context.Context, uuid string) (*DCFPolicyList, error)
+	GetDCFTrustBundleByID(ctx context.Context, bundleUUID string) (*DCFTrustBundle, error)
+	GetDCFTrustBundleByName(ctx context.Context, bundleName string) (*DCFTrustBundle, error)
+	GetDefaultIpsProfile(ctx context.Context) (*DefaultIpsProfileResponse, error)
+	GetDistributedFirewallingDefaultActionRule(ctx context.Context) (*DistributedFirewallingDefaultActionRule, error)
+	GetDistributedFirewallingDeploymentPolicy(ctx context.Context) (*DistributedFirewallingDeploymentPolicy, error)
+	GetDistributedFirewallingIntraVpc(ctx context.Context) (*DistributedFirewallingIntraVpcList, error)
+	GetDistributedFirewallingPolicyList(ctx context.Context) (*DistributedFirewallingPolicyList, error)
+	GetDistributedFirewallingStatus(ctx context.Context) (*DistributedFirewallingConfig, error)
+	GetEnforcementLevel(ctx context.Context) (*EnforcementLevel, error)
+	GetIpsProfile(ctx context.Context, uuid string) (*IpsProfile, error)
+	GetIpsRuleFeed(ctx context.Context, uuid string) (*IpsRuleFeed, error)
+	GetLogProfileByName(ctx context.Context, profileName string) (*LogProfile, error)
+	GetMetaCaCertificate(ctx context.Context) (*ProxyCaCertInstance, error)
+	GetSmartGroup(ctx context.Context, uuid string) (*SmartGroup, error)
+	GetSmartGroups(ctx context.Context) ([]*SmartGroup, error)
+	GetTLSProfile(ctx context.Context, uuidStr string) (*TLSProfileWithID, error)
+	GetTLSProfileByName(ctx context.Context, displayName string) (*TLSProfileWithID, error)
+	GetWebGroup(ctx context.Context, uuid string) (*WebGroup, error)
+	GetWebGroupByName(ctx context.Context, name string) (*WebGroup, error)
+	ListDCFMitmCa(ctx context.Context) (*MitmCaListResponse, error)
+	RefreshDCFMitmSysatemCA(ctx context.Context) error
+	SetDefaultIpsProfile(ctx context.Context, profiles []string) (*DefaultIpsProfileResponse, error)
+	SetEnforcementLevel(ctx context.Context, enforcementLevel *EnforcementLevel) error
+	SetNewCertificate(ctx context.Context, proxyCaConfig *ProxyCaConfig) error
+	UpdateDCFMitmCa(ctx context.Context, caID string, patchRequest *MitmCaPatchRequest) (*MitmCaResponse, error)
+	UpdateDCFPolicyBlock(ctx context.Context, policyBlock *DCFPolicyBlock) error
+	UpdateDCFPolicyList(ctx context.Context, policyList *DCFPolicyList) error
+	UpdateDCFTrustBundle(ctx context.Context, bundleUUID string, trustBundle *TrustBundleItemRequest) error
+	UpdateDistributedFirewallingDefaultActionRule(ctx context.Context, request *DistributedFirewallingDefaultActionRule) error
+	UpdateDistributedFirewallingPolicyList(ctx context.Context, policyList *DistributedFirewallingPolicyList) error
+	UpdateEnforcementLevel(ctx context.Context, enforcementLevel *EnforcementLevel) error
+	UpdateIpsProfile(ctx context.Context, uuid string, profile *IpsProfile) (*IpsProfile, error)
+	UpdateIpsRuleFeed(ctx context.Context, uuid string, ruleFeed *IpsRuleFeed) (*IpsRuleFeed, error)
+	UpdateSmartGroup(ctx context.Context, smartGroup *SmartGroup, uuid string) error
+	UpdateTLSProfile(ctx context.Context, uuid string, tlsProfile *TLSProfile) error
+	UpdateWebGroup(ctx context.Context, webGroup *WebGroup, uuid string) error
+}
+
+// EdgeClient is the Controller API used by the edge gateway resources.
+//
+//go:generate moq -rm -out edge_client_mock.go . EdgeClient
+type EdgeClient interface {
+	GatewayClient
+
+	CreateEdgeCSP(ctx context.Context, edgeCSP *EdgeCSP) error
+	CreateEdgeCSPHa(ctx context.Context, edgeCSPHa *EdgeCSPHa) (string, error)
+	CreateEdgeEquinix(ctx context.Context, edgeEquinix *EdgeEquinix) error
+	CreateEdgeEquinixHa(ctx context.Context, edgeEquinixHa *EdgeEquinixHa) (string, error)
+	CreateEdgeExternalDeviceConn(edgeExternalDeviceConn *EdgeExternalDeviceConn) (string, error)
+	CreateEdgeMegaport(ctx context.Context, edgeMegaport *EdgeMegaport) error
+	CreateEdgeMegaportHa(ctx context.Context, edgeMegaportHa *EdgeMegaportHa) (string, error)
+	CreateEdgeNEO(ctx context.Context, edgeNEO *EdgeNEO) error
+	CreateEdgeNEOHa(ctx context.Context, edgeNEOHa *EdgeNEOHa) (string, error)
+	CreateEdgeProxyProfile(ctx context.Context, edgeNEOProxyProfile *EdgePlatformProxyProfile) (*EdgePlatformProxyProfileResp, error)
+	CreateEdgeSpoke(ctx context.Context, edgeSpoke *EdgeSpoke) error
+	CreateEdgeVmSelfmanagedHa(ctx context.Context, edgeVmSelfmanagedHa *EdgeVmSelfmanagedHa) (string, error)
+	CreateExternalDeviceConn(externalDeviceConn *ExternalDeviceConn) error
+	CreateSpokeTransitAttachment(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) error
+	DeleteEdgeCSP(ctx context.Context, accountName, name string) error
+	DeleteEdgeEquinix(ctx context.Context, accountName, name string) error
+	DeleteEdgeExternalDeviceConn(edgeExternalDeviceConn *EdgeExternalDeviceConn) error
+	DeleteEdgeMegaport(ctx context.Context, accountName, name string) error
+	DeleteEdgeNEO(ctx context.Context, accountName, name string) error
+	DeleteEdgeNEODevice(ctx context.Context, accountName, serialNumber string) error
+	DeleteEdgePlatformProxyProfile(ctx context.Context, accountName, profileName string) error
+	DeleteEdgeSpoke(ctx context.Context, name string) error
+	DeleteExternalDeviceConn(externalDeviceConn *ExternalDeviceConn) error
+	DeleteSpokeTransitAttachment(spokeTransitAttachment *SpokeTransitAttachment) error
+	DisableEdgeSpokeTransitiveRouting(ctx context.Context, name string) error
+	DisableJumboFrameExternalDeviceConn(externalDeviceConn *ExternalDeviceConn) error
+	DownloadEdgeNEOConfigFile(ctx context.Context, edgeNEODevice *EdgeNEODevice) error
+	EditBgpMd5Key(editBgpMd5Key *EditBgpMd5Key) error
+	EditConnectionBgpBfd(externalDeviceConn *ExternalDeviceConn) error
+	EditConnectionBgpMultihop(externalDeviceConn *ExternalDeviceConn) error
+	EditSpokeExternalDeviceConnASPathPrepend(externalDeviceConn *ExternalDeviceConn, prependASPath []string) error
+	EditSpokeTransitAttachmentFilters(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) error
+	EditTransitConnectionASPathPrepend(transitGatewayPeering *TransitGatewayPeering, prependASPath []string) error
+	EditTransitConnectionBGPManualAdvertiseCIDRs(gwName, connName string, cidrs []string) error
+	EnableEdgeSpokeTransitiveRouting(ctx context.Context, name string) error
+	EnableJumboFrameExternalDeviceConn(externalDeviceConn *ExternalDeviceConn) error
+	GetCID() string
+	GetEdgeCSP(ctx context.Context, gwName string) (*EdgeCSPResp, error)
+	GetEdgeCSPHa(ctx context.Context, gwName string) (*EdgeCSPHaResp, error)
+	GetEdgeEquinix(ctx context.Context, gwName string) (*EdgeEquinixResp, error)
+	GetEdgeEquinixHa(ctx context.Context, gwName string) (*EdgeEquinixHaResp, error)
+	GetEdgeGatewayWanIp(ctx context.Context, gwName, wanInterfaceName string) (string, error)
+	GetEdgeMegaport(ctx context.Context, gwName string) (*EdgeMegaportResp, error)
+	GetEdgeMegaportHa(ctx context.Context, gwName string) (*EdgeMegaportHaResp, error)
+	GetEdgeNEO(ctx context.Context, gwName string) (*EdgeNEOResp, error)
+	GetEdgeNEODevice(ctx context.Context, accountName, deviceName string) (*EdgeNEODeviceResp, error)
+	GetEdgeNEOHa(ctx context.Context, gwName string) (*EdgeNEOHaResp, error)
+	GetEdgePlatformProxyProfile(ctx context.Context, accountName, profileName string) (*EdgePlatformProxyProfileResp, error)
+	GetEdgeSpoke(ctx context.Context, gwName string) (*EdgeSpokeResp, error)
+	GetEdgeSpokeTransitAttachment(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) (*SpokeTransitAttachment, error)
+	GetEdgeVmSelfmanagedHa(ctx context.Context, gwName string) (*EdgeVmSelfmanagedHaResp, error)
+	GetExternalDeviceConnDetail(externalDeviceConn *ExternalDeviceConn, localGateway *Gateway, priorHAEnabled bool) (*ExternalDeviceConn, error)
+	OnboardEdgeNEODevice(ctx context.Context, edgeNEODevice *EdgeNEODevice) error
+	PostAPI(action string, d interface{}, checkFunc CheckAPIResponseFunc) error
+	UpdateEdgeCSP(ctx context.Context, edgeCSP *EdgeCSP) error
+	UpdateEdgeCSPHa(ctx context.Context, edgeCSP *EdgeCSP) error
+	UpdateEdgeEquinix(ctx context.Context, edgeEquinix *EdgeEquinix) error
+	UpdateEdgeEquinixHa(ctx context.Context, edgeEquinix *EdgeEquinix) error
+	UpdateEdgeMegaport(ctx context.Context, edgeMegaport *EdgeMegaport) error
+	UpdateEdgeMegaportHa(ctx context.Context, edgeMegaport *EdgeMegaport) error
+	UpdateEdgeNEO(ctx context.Context, edgeNEO *EdgeNEO) error
+	UpdateEdgeNEODevice(ctx context.Context, edgeNEODevice *EdgeNEODevice) error
+	UpdateEdgeNEOHa(ctx context.Context, edgeNEO *EdgeNEO) error
+	UpdateEdgeProxyProfile(ctx context.Context, edgeNEOProxyProfile *EdgePlatformProxyProfileUpdate) error
+	UpdateEdgeSpoke(ctx context.Context, edgeSpoke *EdgeSpoke) error
+	UpdateEdgeSpokeGeoCoordinate(ctx context.Context, edgeSpoke *EdgeSpoke) error
+	UpdateEdgeSpokeTransitPeeringTunnelCount(gateway1, gateway2 string, tunnelCount int) error
+	UpdateEdgeVmSelfmanagedHa(ctx context.Context, edgeVmSelfmanaged *EdgeSpoke) error
+}
+
+// GatewayClient is the Controller API used by the gateway, spoke gateway,
+// transit gateway and gateway group resources.
+//
+//go:generate moq -rm -out gateway_client_mock.go . GatewayClient
+type GatewayClient interface {
+	AddSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error
+	AttachTransitGWForHybrid(gateway *TransitVpc) error
+	ChangeBgpHoldTime(gwName string, holdTime int) error
+	ChangeBgpHoldTimeGatewayGroup(ctx context.Context, groupName string, holdTime int) error
+	ChangeBgpOverLanIntfCnt(gateway *Gateway) error
+	CreateGatewayContext(ctx context.Context, gateway *Gateway) error
+	CreateGatewayGroup(ctx context.Context, spokeGroup *GatewayGroup) error
+	CreatePublicSubnetFilteringGatewayContext(ctx context.Context, gateway *Gateway) error
+	CreateSpokeHaGw(spokeHaGateway *SpokeHaGateway) (string, error)
+	CreateSpokeHaGwContext(ctx context.Context, spokeHaGateway *SpokeHaGateway) (string, error)
+	CreateTransitHaGwContext(ctx context.Context, transitHaGateway *TransitHaGateway) (string, error)
+	DeleteGateway(gateway *Gateway) error
+	DeleteGatewayContext(ctx context.Context, gateway *Gateway) error
+	DeleteGatewayGroup(ctx context.Context, groupUUID string) error
+	DeletePublicSubnetFilteringGatewayContext(ctx context.Context, gateway *Gateway) error
+	DeleteSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error
+	DetachTransitGWForHybrid(gateway *TransitVpc) error
+	DisableActiveStandby(transitGateway *TransitVpc) error
+	DisableActiveStandbyGatewayGroup(ctx context.Context, groupName string) error
+	DisableActiveStandbySpoke(spokeGateway *SpokeVpc) error
+	DisableAdvertiseTransitCidr(transitGw *TransitVpc) error
+	DisableAdvertiseTransitCidrGatewayGroup(ctx context.Context, groupName string) error
+	DisableAutoAdvertiseS2CCidrs(gateway *Gateway) error
+	DisableAutoAdvertiseS2CCidrsGatewayGroup(ctx context.Context, groupName string) error
+	DisableConnectedTransit(gateway *TransitVpc) error
+	DisableConnectedTransitGatewayGroup(ctx context.Context, groupName string) error
+	DisableCustomSNat(gateway *Gateway) error
+	DisableEgressTransitFirenet(transitGateway *TransitVpc) error
+	DisableFireNetGatewayGroup(ctx context.Context, groupName string) error
+	DisableGatewayFireNetInterfaces(gateway *TransitVpc) error
+	DisableGatewayGroupSNat(ctx context.Context, groupName string) error
+	DisableGatewayGroupVpcDNSServer(ctx context.Context, groupName string) error
+	DisableGatewayLoadBalancerGatewayGroup(ctx context.Context, groupName string) error
+	DisableGlobalVpc(gateway *Gateway) error
+	DisableGlobalVpcGatewayGroup(ctx context.Context, groupName string) error
+	DisableGroGso(gateway *Gateway) error
+	DisableGroGsoGatewayGroup(ctx context.Context, groupName string) error
+	DisableGuardDutyEnforcement(gateway *Gateway) error
+	DisableHybridConnectionGatewayGroup(ctx context.Context, groupName string) error
+	DisableIPv6(gateway *Gateway) error
+	DisableIPv6GatewayGroup(ctx context.Context, groupName string) error
+	DisableJumboFrame(gateway *Gateway) error
+	DisableJumboFrameGatewayGroup(ctx context.Context, groupName string) error
+	DisableMonitorGatewaySubnets(gwName string) error
+	DisableMultiTierTransitGatewayGroup(ctx context.Context, groupName string) error
+	DisableMultitierTransit(gwName string) error
+	DisablePrivateVpcDefaultRoute(gw *Gateway) error
+	DisablePrivateVpcDefaultRouteGatewayGroup(ctx context.Context, groupName string) error
+	DisableS2CRxBalancing(gwName string) error
+	DisableS2cRxBalancingGatewayGroup(ctx context.Context, groupName string) error
+	DisableSNat(gateway *Gateway) error
+	DisableSegmentation(transitGateway *TransitVpc) error
+	DisableSegmentationGatewayGroup(ctx context.Context, groupName string) error
+	DisableSingleAZGateway(gateway *Gateway) error
+	DisableSkipPublicRouteUpdate(gw *Gateway) error
+	DisableSkipPublicRouteUpdateGatewayGroup(ctx context.Context, groupName string) error
+	DisableSpokeLearnedCidrsApproval(gateway *SpokeVpc) error
+	DisableSpokeLearnedCidrsApprovalGatewayGroup(ctx context.Context, groupName string) error
+	DisableSpokeOnpremRoutePropagation(spokeGateway *SpokeVpc) error
+	DisableSpokeOnpremRoutePropagationGatewayGroup(ctx context.Context, groupName string) error
+	DisableSpokePreserveAsPath(spokeGateway *SpokeVpc) error
+	DisableSpokePreserveAsPathGatewayGroup(ctx context.Context, groupName string) error
+	DisableSummarizeCidrToTgw(gwName string) error
+	DisableTransitFireNet(gateway *Gateway) error
+	DisableTransitFireNetGatewayGroup(ctx context.Context, groupName string) error
+	DisableTransitLearnedCidrsApproval(gateway *TransitVpc) error
+	DisableTransitLearnedCidrsApprovalGatewayGroup(ctx context.Context, groupName string) error
+	DisableTransitPreserveAsPath(transitGateway *TransitVpc) error
+	DisableTransitPreserveAsPathGatewayGroup(ctx context.Context, groupName string) error
+	DisableTransitSummarizeCidrToTgwGatewayGroup(ctx context.Context, groupName string) error
+	DisableVPNConfig(gateway *Gateway, vpnConfig *VPNConfig) error
+	DisableVpcDNSServer(gateway *Gateway) error
+	DisableVpnNat(gateway *Gateway) error
+	EditDesignatedGateway(gateway *Gateway) error
+	EditGatewayAdvertisedCidr(gateway *Gateway) error
+	EditGatewayCustomRoutes(gateway *Gateway) error
+	EditGatewayFilterRoutes(gateway *Gateway) error
+	EditManagedRouteTables(gateway *Gateway, routeTables []string) error
+	EditManagedRouteTablesForGatewayGroup(ctx context.Context, groupName string, routeTables []string) error
+	EditPrivateRouteTableConfig(gateway *Gateway, routeTables []string) error
+	EditPrivateRouteTableConfigForGatewayGroup(ctx context.Context, groupName string, routeTables []string) error
+	EditPublicSubnetFilteringRouteTableList(gateway *Gateway, routeTables []string) error
+	EnableActiveStandby(transitGateway *TransitVpc) error
+	EnableActiveStandbyGatewayGroup(ctx context.Context, groupName string) error
+	EnableActiveStandbyPreemptive(transitGateway *TransitVpc) error
+	EnableActiveStandbyPreemptiveGatewayGroup(ctx context.Context, groupName string) error
+	EnableActiveStandbyPreemptiveSpoke(spokeGateway *SpokeVpc) error
+	EnableActiveStandbySpoke(spokeGateway *SpokeVpc) error
+	EnableAdvertiseTransitCidr(transitGw *TransitVpc) error
+	EnableAdvertiseTransitCidrGatewayGroup(ctx context.Context, groupName string) error
+	EnableAutoAdvertiseS2CCidrs(gateway *Gateway) error
+	EnableAutoAdvertiseS2CCidrsGatewayGroup(ctx context.Context, groupName string) error
+	EnableConnectedTransit(gateway *TransitVpc) error
+	EnableConnectedTransitGatewayGroup(ctx context.Context, groupName string) error
+	EnableCustomizedSNat(gateway *Gateway) error
+	EnableEgressTransitFirenet(transitGateway *TransitVpc) error
+	EnableEncryptVolume(gateway *Gateway) error
+	EnableFireNetGatewayGroup(ctx context.Context, groupName string) error
+	EnableGatewayFireNetInterfaces(gateway *TransitVpc) error
+	EnableGatewayFireNetInterfacesWithGWLB(gateway *TransitVpc) error
+	EnableGatewayGroupSNat(ctx context.Context, groupName string) error
+	EnableGatewayGroupVpcDNSServer(ctx context.Context, groupName string) error
+	EnableGatewayLoadBalancerGatewayGroup(ctx context.Context, groupName string) error
+	EnableGlobalVpc(gateway *Gateway) error
+	EnableGlobalVpcGatewayGroup(ctx context.Context, groupName string) error
+	EnableGroGso(gateway *Gateway) error
+	EnableGroGsoGatewayGroup(ctx context.Context, groupName string) error
+	EnableGuardDutyEnforcement(gateway *Gateway) error
+	EnableHybridConnectionGatewayGroup(ctx context.Context, groupName string) error
+	EnableIPv6(gateway *Gateway) error
+	EnableIPv6GatewayGroup(ctx context.Context, groupName string) error
+	EnableJumboFrame(gateway *Gateway) error
+	EnableJumboFrameGatewayGroup(ctx context.Context, groupName string) error
+	EnableMonitorGatewaySubnets(gwName string, excludedInstances []string) error
+	EnableMultiTierTransitGatewayGroup(ctx context.Context, groupName string) error
+	EnableMultitierTransit(gwName string) error
+	EnablePeeringHaGateway(gateway *Gateway) error
+	EnablePrivateVpcDefaultRoute(gw *Gateway) error
+	EnablePrivateVpcDefaultRouteGatewayGroup(ctx context.Context, groupName string) error
+	EnablePublicSubnetFilteringHAGateway(gateway *Gateway) error
+	EnableS2CRxBalancing(gwName string) error
+	EnableS2cRxBalancingGatewayGroup(ctx context.Context, groupName string) error
+	EnableSNat(gateway *Gateway) error
+	EnableSegmentation(transitGateway *TransitVpc) error
+	EnableSegmentationGatewayGroup(ctx context.Context, groupName string) error
+	EnableSingleAZGateway(gateway *Gateway) error
+	EnableSkipPublicRouteUpdate(gw *Gateway) error
+	EnableSkipPublicRouteUpdateGatewayGroup(ctx context.Context, groupName string) error
+	EnableSpokeLearnedCidrsApproval(gateway *SpokeVpc) error
+	EnableSpokeLearnedCidrsApprovalGatewayGroup(ctx context.Context, groupName string) error
+	EnableSpokeOnpremRoutePropagation(spokeGateway *SpokeVpc) error
+	EnableSpokeOnpremRoutePropagationGatewayGroup(ctx context.Context, groupName string) error
+	EnableSpokePreserveAsPath(spokeGateway *SpokeVpc) error
+	EnableSpokePreserveAsPathGatewayGroup(ctx context.Context, groupName string) error
+	EnableSummarizeCidrToTgw(gwName string) error
+	EnableTransitFireNet(gateway *Gateway) error
+	EnableTransitFireNetGatewayGroup(ctx context.Context, groupName string) error
+	EnableTransitFireNetWithGWLB(gateway *Gateway) error
+	EnableTransitFireNetWithGWLBGatewayGroup(ctx context.Context, groupName string) error
+	EnableTransitLearnedCidrsApproval(gateway *TransitVpc) error
+	EnableTransitLearnedCidrsApprovalGatewayGroup(ctx context.Context, groupName string) error
+	EnableTransitPreserveAsPath(transitGateway *TransitVpc) error
+	EnableTransitPreserveAsPathGatewayGroup(ctx context.Context, groupName string) error
+	EnableTransitSummarizeCidrToTgwGatewayGroup(ctx context.Context, groupName string) error
+	EnableVPNConfig(gateway *Gateway, vpnConfig *VPNConfig) error
+	EnableVpcDNSServer(gateway *Gateway) error
+	EnableVpnNat(gateway *Gateway) error
+	GetBgpLanIPList(transitGateway *TransitVpc) (*TransitGatewayBgpLanIpInfo, error)
+	GetControllerIP() string
+	GetDefaultTagsConfig() *DefaultTagsConfig
+	GetGateway(gateway *Gateway) (*Gateway, error)
+	GetGatewayBgpCommunities(gwName string) (bool, bool, error)
+	GetGatewayBgpMedToSdnMetric(gwName string) (bool, error)
+	GetGatewayDetail(gateway *Gateway) (*GatewayDetail, error)
+	GetGatewayGroup(ctx context.Context, groupUUID string) (*GatewayGroup, error)
+	GetGatewayGroupByName(ctx context.Context, groupName string) (*GatewayGroup, error)
+	GetGeoVPNName(gateway *Gateway) (*GeoVPN, error)
+	GetGroGsoStatus(gateway *Gateway) (bool, error)
+	GetIgnoreTagsConfig() *IgnoreTagsConfig
+	GetSplitTunnel(splitTunnel *SplitTunnel) (*SplitTunnelUnit, error)
+	GetSpokeGatewayAdvancedConfig(spokeGateway *SpokeVpc) (*SpokeGatewayAdvancedConfig, error)
+	GetSpokeGatewayList(ctx context.Context) ([]Gateway, error)
+	GetSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error
+	GetTags(tags *Tags) ([]string, error)
+	GetTransitGatewayAdvancedConfig(transitGateway *TransitVpc) (*TransitGatewayAdvancedConfig, error)
+	GetTransitGatewayLanCidr(gatewayName string) (string, error)
+	GetTransitGatewayList(ctx context.Context) ([]Gateway, error)
+	GetTunnelDetectionTime(entity string) (int, error)
+	IsTransitFireNetReadyToBeDisabled(gateway *Gateway) error
+	LaunchSpokeVpcContext(ctx context.Context, spoke *SpokeVpc) error
+	LaunchTransitVpcContext(ctx context.Context, gateway *TransitVpc) error
+	ModifySplitTunnel(splitTunnel *SplitTunnel) error
+	ModifyTunnelDetectionTime(entity string, detectionTime int) error
+	SetBgpBfdPollingTime(transitGateway *TransitVpc, newPollingTime int) error
+	SetBgpBfdPollingTimeGatewayGroup(ctx context.Context, groupName string, pollingTime int) error
+	SetBgpBfdPollingTimeSpoke(spokeGateway *SpokeVpc, newPollingTime int) error
+	SetBgpEcmp(transitGateway *TransitVpc, enabled bool) error
+	SetBgpEcmpGatewayGroup(ctx context.Context, groupName string, enable bool) error
+	SetBgpEcmpSpoke(spokeGateway *SpokeVpc, enabled bool) error
+	SetBgpManualSpokeAdvertisedNetworks(transitGw *TransitVpc) error
+	SetBgpPollingTime(transitGateway *TransitVpc, newPollingTime int) error
+	SetBgpPollingTimeGatewayGroup(ctx context.Context, groupName string, pollingTime int) error
+	SetBgpPollingTimeSpoke(spokeGateway *SpokeVpc, newPollingTime int) error
+	SetGatewayBgpCommunitiesAccept(gwName string, acceptComm bool) error
+	SetGatewayBgpCommunitiesSend(gwName string, sendComm bool) error
+	SetGatewayBgpMedToSdnMetric(gwName string, override bool) error
+	SetGatewayGroupBgpCommunitiesAccept(ctx context.Context, groupName string, accept bool) error
+	SetGatewayGroupBgpCommunitiesSend(ctx context.Context, groupName string, send bool) error
+	SetGatewayPhase2Policy(gwName, encPolicy string, pfsPolicy string) error
+	SetLocalASNumber(transitGateway *TransitVpc, localASNumber string) error
+	SetLocalASNumberGatewayGroup(ctx context.Context, groupName, localAsNumber string) error
+	SetLocalASNumberSpoke(spokeGateway *SpokeVpc, localASNumber string) error
+	SetPrependASPath(transitGateway *TransitVpc, prependASPath []string) error
+	SetPrependASPathGatewayGroup(ctx context.Context, groupName string, prependAsPath []string) error
+	SetPrependASPathSpoke(spokeGateway *SpokeVpc, prependASPath []string) error
+	SetRxQueueSize(gateway *Gateway) error
+	SetSpokeBgpManualAdvertisedNetworks(spokeGateway *SpokeVpc) error
+	SetSpokeBgpManualAdvertisedNetworksGatewayGroup(ctx context.Context, groupName, cidrs string) error
+	SetSymmetricRoutingGatewayGroup(ctx context.Context, groupUUID string, enable bool) error
+	SetTransitLearnedCIDRsApprovalMode(gw *TransitVpc, mode string) error
+	SetVpnGatewayAuthentication(gateway *VpnGatewayAuth) error
+	UpdateDNat(gateway *Gateway) error
+	UpdateEdgeGateway(gateway *TransitVpc) error
+	UpdateEdgeGatewayV2(ctx context.Context, gateway *TransitVpc) error
+	UpdateGateway(gateway *Gateway) error
+	UpdateGatewayContext(ctx context.Context, gateway *Gateway) error
+	UpdateGroupInstanceSize(ctx context.Context, groupName, instanceSize string) error
+	UpdateMaxVpnConn(gateway *Gateway) error
+	UpdateSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error
+	UpdateSpokePendingApprovedCidrs(gateway *SpokeVpc) error
+	UpdateSpokePendingApprovedCidrsGatewayGroup(ctx context.Context, groupName string, approvedCidrs []string) error
+	UpdateTags(tags *Tags) error
+	UpdateTransitGatewayCustomizedVpcRoute(gateway string, customizedTransitVpcRoutes []string) error
+	UpdateTransitPendingApprovedCidrs(gateway *TransitVpc) error
+	UpdateTransitPendingApprovedCidrsGatewayGroup(ctx context.Context, groupName string, approvedCidrs []string) error
+	UpdateVpnCidr(gateway *Gateway) error
+}
+
+// SegmentationClient is the Controller API used by the segmentation
+// resources.
+//
+//go:generate moq -rm -out segmentation_client_mock.go . SegmentationClient
+type SegmentationClient interface {
+	CreateSegmentationSecurityDomain(domain *SegmentationSecurityDomain) error
+	CreateSegmentationSecurityDomainAssociation(association *SegmentationSecurityDomainAssociation) error
+	CreateSegmentationSecurityDomainConnectionPolicy(policy *SegmentationSecurityDomainConnectionPolicy) error
+	DeleteSegmentationSecurityDomain(domain *SegmentationSecurityDomain) error
+	DeleteSegmentationSecurityDomainAssociation(association *SegmentationSecurityDomainAssociation) error
+	DeleteSegmentationSecurityDomainConnectionPolicy(policy *SegmentationSecurityDomainConnectionPolicy) error
+	GetSegmentationSecurityDomain(domain *SegmentationSecurityDomain) (*SegmentationSecurityDomain, error)
+	GetSegmentationSecurityDomainAssociation(association *SegmentationSecurityDomainAssociation) (*SegmentationSecurityDomainAssociation, error)
+	GetSegmentationSecurityDomainConnectionPolicy(policy *SegmentationSecurityDomainConnectionPolicy) (*SegmentationSecurityDomainConnectionPolicy, error)
+	ListSegmentationSecurityDomains() ([]string, error)
+}
+
+// Site2CloudClient is the Controller API used by the site2cloud resources.
+//
+//go:generate moq -rm -out site2cloud_client_mock.go . Site2CloudClient
+type Site2CloudClient interface {
+	CreateS2CCaCert(ctx context.Context, s2cCaCert *S2CCaCert) error
+	CreateSite2Cloud(site2cloud *Site2Cloud) error
+	CreateSite2CloudContext(ctx context.Context, site2cloud *Site2Cloud) error
+	DeleteCertInstance(ctx context.Context, caCertInstance *CaCertInstance) error
+	DeleteSite2Cloud(site2cloud *Site2Cloud) error
+	DeleteSite2CloudContext(ctx context.Context, site2cloud *Site2Cloud) error
+	DisableDeadPeerDetection(site2cloud *Site2Cloud) error
+	DisableDeadPeerDetectionContext(ctx context.Context, site2cloud *Site2Cloud) error
+	DisableSite2CloudEventTriggeredHA(vpcID, connectionName string) error
+	DisableSite2cloudActiveActive(site2cloud *Site2Cloud) error
+	DisableSpokeMappedSite2CloudForwarding(site2cloud *Site2Cloud) error
+	EditSite2CloudPhase1LocalIdentifier(s2c *EditSite2Cloud) error
+	EnableDeadPeerDetection(site2cloud *Site2Cloud) error
+	EnableSite2CloudEventTriggeredHA(vpcID, connectionName string) error
+	EnableSite2cloudActiveActive(site2cloud *Site2Cloud) error
+	EnableSite2cloudActiveActiveContext(ctx context.Context, site2cloud *Site2Cloud) error
+	EnableSpokeMappedSite2CloudForwarding(site2cloud *Site2Cloud) error
+	GetGateway(gateway *Gateway) (*Gateway, error)
+	GetGatewayContext(ctx context.Context, gateway *Gateway) (*Gateway, error)
+	GetS2CCaCertTag(ctx context.Context, s2cCaCertTag *S2CCaCertTag) (*S2CCaCertTag, error)
+	GetSite2CloudConnDetail(site2cloud *Site2Cloud) (*Site2Cloud, error)
+	ListSite2CloudContext(ctx context.Context) ([]Site2Cloud, error)
+	UpdateSite2Cloud(site2cloud *EditSite2Cloud) error
+}
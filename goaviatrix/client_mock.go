@@ -18,155 +18,19281 @@ var _ ClientInterface = &ClientInterfaceMock{}
 //
 //		// make and configure a mocked ClientInterface
 //		mockedClientInterface := &ClientInterfaceMock{
+//			AddSpokeGatewaySubnetGroupFunc: func(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error {
+//				panic("mock out the AddSpokeGatewaySubnetGroup method")
+//			},
+//			AttachTransitGWForHybridFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the AttachTransitGWForHybrid method")
+//			},
 //			AuditAccountFunc: func(ctx context.Context, account *Account) error {
 //				panic("mock out the AuditAccount method")
 //			},
+//			ChangeBgpHoldTimeFunc: func(gwName string, holdTime int) error {
+//				panic("mock out the ChangeBgpHoldTime method")
+//			},
+//			ChangeBgpHoldTimeGatewayGroupFunc: func(ctx context.Context, groupName string, holdTime int) error {
+//				panic("mock out the ChangeBgpHoldTimeGatewayGroup method")
+//			},
+//			ChangeBgpOverLanIntfCntFunc: func(gateway *Gateway) error {
+//				panic("mock out the ChangeBgpOverLanIntfCnt method")
+//			},
+//			CreateAccountFunc: func(account *Account) error {
+//				panic("mock out the CreateAccount method")
+//			},
+//			CreateAccountUserFunc: func(user *AccountUser) error {
+//				panic("mock out the CreateAccountUser method")
+//			},
+//			CreateDCFMitmCaFunc: func(ctx context.Context, mitmCa *MitmCaItemRequest) (string, error) {
+//				panic("mock out the CreateDCFMitmCa method")
+//			},
+//			CreateDCFPolicyBlockFunc: func(ctx context.Context, policyBlock *DCFPolicyBlock) (string, error) {
+//				panic("mock out the CreateDCFPolicyBlock method")
+//			},
+//			CreateDCFPolicyListFunc: func(ctx context.Context, policyList *DCFPolicyList) (string, error) {
+//				panic("mock out the CreateDCFPolicyList method")
+//			},
+//			CreateDCFTrustBundleFunc: func(ctx context.Context, trustBundle *TrustBundleItemRequest) (string, error) {
+//				panic("mock out the CreateDCFTrustBundle method")
+//			},
+//			CreateDistributedFirewallingDeploymentPolicyFunc: func(ctx context.Context, deploymentPolicy *DistributedFirewallingDeploymentPolicy) error {
+//				panic("mock out the CreateDistributedFirewallingDeploymentPolicy method")
+//			},
+//			CreateDistributedFirewallingIntraVpcFunc: func(ctx context.Context, vpcList *DistributedFirewallingIntraVpcList) error {
+//				panic("mock out the CreateDistributedFirewallingIntraVpc method")
+//			},
+//			CreateDistributedFirewallingPolicyListFunc: func(ctx context.Context, policyList *DistributedFirewallingPolicyList) error {
+//				panic("mock out the CreateDistributedFirewallingPolicyList method")
+//			},
+//			CreateEdgeAccountFunc: func(edgeAccount *EdgeAccount) error {
+//				panic("mock out the CreateEdgeAccount method")
+//			},
+//			CreateEdgeCSPFunc: func(ctx context.Context, edgeCSP *EdgeCSP) error {
+//				panic("mock out the CreateEdgeCSP method")
+//			},
+//			CreateEdgeCSPHaFunc: func(ctx context.Context, edgeCSPHa *EdgeCSPHa) (string, error) {
+//				panic("mock out the CreateEdgeCSPHa method")
+//			},
+//			CreateEdgeEquinixFunc: func(ctx context.Context, edgeEquinix *EdgeEquinix) error {
+//				panic("mock out the CreateEdgeEquinix method")
+//			},
+//			CreateEdgeEquinixHaFunc: func(ctx context.Context, edgeEquinixHa *EdgeEquinixHa) (string, error) {
+//				panic("mock out the CreateEdgeEquinixHa method")
+//			},
+//			CreateEdgeExternalDeviceConnFunc: func(edgeExternalDeviceConn *EdgeExternalDeviceConn) (string, error) {
+//				panic("mock out the CreateEdgeExternalDeviceConn method")
+//			},
+//			CreateEdgeMegaportFunc: func(ctx context.Context, edgeMegaport *EdgeMegaport) error {
+//				panic("mock out the CreateEdgeMegaport method")
+//			},
+//			CreateEdgeMegaportHaFunc: func(ctx context.Context, edgeMegaportHa *EdgeMegaportHa) (string, error) {
+//				panic("mock out the CreateEdgeMegaportHa method")
+//			},
+//			CreateEdgeNEOFunc: func(ctx context.Context, edgeNEO *EdgeNEO) error {
+//				panic("mock out the CreateEdgeNEO method")
+//			},
+//			CreateEdgeNEOHaFunc: func(ctx context.Context, edgeNEOHa *EdgeNEOHa) (string, error) {
+//				panic("mock out the CreateEdgeNEOHa method")
+//			},
+//			CreateEdgeProxyProfileFunc: func(ctx context.Context, edgeNEOProxyProfile *EdgePlatformProxyProfile) (*EdgePlatformProxyProfileResp, error) {
+//				panic("mock out the CreateEdgeProxyProfile method")
+//			},
+//			CreateEdgeSpokeFunc: func(ctx context.Context, edgeSpoke *EdgeSpoke) error {
+//				panic("mock out the CreateEdgeSpoke method")
+//			},
+//			CreateEdgeVmSelfmanagedHaFunc: func(ctx context.Context, edgeVmSelfmanagedHa *EdgeVmSelfmanagedHa) (string, error) {
+//				panic("mock out the CreateEdgeVmSelfmanagedHa method")
+//			},
+//			CreateExternalDeviceConnFunc: func(externalDeviceConn *ExternalDeviceConn) error {
+//				panic("mock out the CreateExternalDeviceConn method")
+//			},
+//			CreateGCPAccountFunc: func(account *Account) error {
+//				panic("mock out the CreateGCPAccount method")
+//			},
+//			CreateGatewayContextFunc: func(ctx context.Context, gateway *Gateway) error {
+//				panic("mock out the CreateGatewayContext method")
+//			},
+//			CreateGatewayGroupFunc: func(ctx context.Context, spokeGroup *GatewayGroup) error {
+//				panic("mock out the CreateGatewayGroup method")
+//			},
+//			CreateIpsProfileFunc: func(ctx context.Context, profile *IpsProfile) (*IpsProfileCreateResponse, error) {
+//				panic("mock out the CreateIpsProfile method")
+//			},
+//			CreateIpsRuleFeedFunc: func(ctx context.Context, ruleFeed *IpsRuleFeed) (*IpsRuleFeedUploadResponse, error) {
+//				panic("mock out the CreateIpsRuleFeed method")
+//			},
+//			CreateOCIAccountFunc: func(account *Account) error {
+//				panic("mock out the CreateOCIAccount method")
+//			},
+//			CreatePublicSubnetFilteringGatewayContextFunc: func(ctx context.Context, gateway *Gateway) error {
+//				panic("mock out the CreatePublicSubnetFilteringGatewayContext method")
+//			},
+//			CreateS2CCaCertFunc: func(ctx context.Context, s2cCaCert *S2CCaCert) error {
+//				panic("mock out the CreateS2CCaCert method")
+//			},
+//			CreateSegmentationSecurityDomainFunc: func(domain *SegmentationSecurityDomain) error {
+//				panic("mock out the CreateSegmentationSecurityDomain method")
+//			},
+//			CreateSegmentationSecurityDomainAssociationFunc: func(association *SegmentationSecurityDomainAssociation) error {
+//				panic("mock out the CreateSegmentationSecurityDomainAssociation method")
+//			},
+//			CreateSegmentationSecurityDomainConnectionPolicyFunc: func(policy *SegmentationSecurityDomainConnectionPolicy) error {
+//				panic("mock out the CreateSegmentationSecurityDomainConnectionPolicy method")
+//			},
+//			CreateSite2CloudFunc: func(site2cloud *Site2Cloud) error {
+//				panic("mock out the CreateSite2Cloud method")
+//			},
+//			CreateSite2CloudContextFunc: func(ctx context.Context, site2cloud *Site2Cloud) error {
+//				panic("mock out the CreateSite2CloudContext method")
+//			},
+//			CreateSmartGroupFunc: func(ctx context.Context, smartGroup *SmartGroup) (string, error) {
+//				panic("mock out the CreateSmartGroup method")
+//			},
+//			CreateSpokeHaGwFunc: func(spokeHaGateway *SpokeHaGateway) (string, error) {
+//				panic("mock out the CreateSpokeHaGw method")
+//			},
+//			CreateSpokeHaGwContextFunc: func(ctx context.Context, spokeHaGateway *SpokeHaGateway) (string, error) {
+//				panic("mock out the CreateSpokeHaGwContext method")
+//			},
+//			CreateSpokeTransitAttachmentFunc: func(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) error {
+//				panic("mock out the CreateSpokeTransitAttachment method")
+//			},
+//			CreateTLSProfileFunc: func(ctx context.Context, tlsProfile *TLSProfile) (string, error) {
+//				panic("mock out the CreateTLSProfile method")
+//			},
+//			CreateTransitHaGwContextFunc: func(ctx context.Context, transitHaGateway *TransitHaGateway) (string, error) {
+//				panic("mock out the CreateTransitHaGwContext method")
+//			},
+//			CreateWebGroupFunc: func(ctx context.Context, webGroup *WebGroup) (string, error) {
+//				panic("mock out the CreateWebGroup method")
+//			},
 //			DeleteAccountFunc: func(account *Account) error {
 //				panic("mock out the DeleteAccount method")
 //			},
+//			DeleteAccountUserFunc: func(user *AccountUser) error {
+//				panic("mock out the DeleteAccountUser method")
+//			},
+//			DeleteCaCertificateFunc: func(ctx context.Context) error {
+//				panic("mock out the DeleteCaCertificate method")
+//			},
+//			DeleteCertInstanceFunc: func(ctx context.Context, caCertInstance *CaCertInstance) error {
+//				panic("mock out the DeleteCertInstance method")
+//			},
+//			DeleteDCFMitmCaFunc: func(ctx context.Context, caID string) error {
+//				panic("mock out the DeleteDCFMitmCa method")
+//			},
+//			DeleteDCFPolicyBlockFunc: func(ctx context.Context, uuid string) error {
+//				panic("mock out the DeleteDCFPolicyBlock method")
+//			},
+//			DeleteDCFPolicyListFunc: func(ctx context.Context, uuid string) error {
+//				panic("mock out the DeleteDCFPolicyList method")
+//			},
+//			DeleteDCFTrustBundleFunc: func(ctx context.Context, bundleUUID string) error {
+//				panic("mock out the DeleteDCFTrustBundle method")
+//			},
+//			DeleteDistributedFirewallingIntraVpcFunc: func(ctx context.Context) error {
+//				panic("mock out the DeleteDistributedFirewallingIntraVpc method")
+//			},
+//			DeleteDistributedFirewallingPolicyListFunc: func(ctx context.Context) error {
+//				panic("mock out the DeleteDistributedFirewallingPolicyList method")
+//			},
+//			DeleteEdgeCSPFunc: func(ctx context.Context, accountName string, name string) error {
+//				panic("mock out the DeleteEdgeCSP method")
+//			},
+//			DeleteEdgeEquinixFunc: func(ctx context.Context, accountName string, name string) error {
+//				panic("mock out the DeleteEdgeEquinix method")
+//			},
+//			DeleteEdgeExternalDeviceConnFunc: func(edgeExternalDeviceConn *EdgeExternalDeviceConn) error {
+//				panic("mock out the DeleteEdgeExternalDeviceConn method")
+//			},
+//			DeleteEdgeMegaportFunc: func(ctx context.Context, accountName string, name string) error {
+//				panic("mock out the DeleteEdgeMegaport method")
+//			},
+//			DeleteEdgeNEOFunc: func(ctx context.Context, accountName string, name string) error {
+//				panic("mock out the DeleteEdgeNEO method")
+//			},
+//			DeleteEdgeNEODeviceFunc: func(ctx context.Context, accountName string, serialNumber string) error {
+//				panic("mock out the DeleteEdgeNEODevice method")
+//			},
+//			DeleteEdgePlatformProxyProfileFunc: func(ctx context.Context, accountName string, profileName string) error {
+//				panic("mock out the DeleteEdgePlatformProxyProfile method")
+//			},
+//			DeleteEdgeSpokeFunc: func(ctx context.Context, name string) error {
+//				panic("mock out the DeleteEdgeSpoke method")
+//			},
+//			DeleteEnforcementLevelFunc: func(ctx context.Context) error {
+//				panic("mock out the DeleteEnforcementLevel method")
+//			},
+//			DeleteExternalDeviceConnFunc: func(externalDeviceConn *ExternalDeviceConn) error {
+//				panic("mock out the DeleteExternalDeviceConn method")
+//			},
+//			DeleteGatewayFunc: func(gateway *Gateway) error {
+//				panic("mock out the DeleteGateway method")
+//			},
+//			DeleteGatewayContextFunc: func(ctx context.Context, gateway *Gateway) error {
+//				panic("mock out the DeleteGatewayContext method")
+//			},
+//			DeleteGatewayGroupFunc: func(ctx context.Context, groupUUID string) error {
+//				panic("mock out the DeleteGatewayGroup method")
+//			},
+//			DeleteIpsProfileFunc: func(ctx context.Context, uuid string) error {
+//				panic("mock out the DeleteIpsProfile method")
+//			},
+//			DeleteIpsRuleFeedFunc: func(ctx context.Context, uuid string) error {
+//				panic("mock out the DeleteIpsRuleFeed method")
+//			},
+//			DeletePublicSubnetFilteringGatewayContextFunc: func(ctx context.Context, gateway *Gateway) error {
+//				panic("mock out the DeletePublicSubnetFilteringGatewayContext method")
+//			},
+//			DeleteSegmentationSecurityDomainFunc: func(domain *SegmentationSecurityDomain) error {
+//				panic("mock out the DeleteSegmentationSecurityDomain method")
+//			},
+//			DeleteSegmentationSecurityDomainAssociationFunc: func(association *SegmentationSecurityDomainAssociation) error {
+//				panic("mock out the DeleteSegmentationSecurityDomainAssociation method")
+//			},
+//			DeleteSegmentationSecurityDomainConnectionPolicyFunc: func(policy *SegmentationSecurityDomainConnectionPolicy) error {
+//				panic("mock out the DeleteSegmentationSecurityDomainConnectionPolicy method")
+//			},
+//			DeleteSite2CloudFunc: func(site2cloud *Site2Cloud) error {
+//				panic("mock out the DeleteSite2Cloud method")
+//			},
+//			DeleteSite2CloudContextFunc: func(ctx context.Context, site2cloud *Site2Cloud) error {
+//				panic("mock out the DeleteSite2CloudContext method")
+//			},
+//			DeleteSmartGroupFunc: func(ctx context.Context, uuid string) error {
+//				panic("mock out the DeleteSmartGroup method")
+//			},
+//			DeleteSpokeGatewaySubnetGroupFunc: func(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error {
+//				panic("mock out the DeleteSpokeGatewaySubnetGroup method")
+//			},
+//			DeleteSpokeTransitAttachmentFunc: func(spokeTransitAttachment *SpokeTransitAttachment) error {
+//				panic("mock out the DeleteSpokeTransitAttachment method")
+//			},
+//			DeleteTLSProfileFunc: func(ctx context.Context, uuid string) error {
+//				panic("mock out the DeleteTLSProfile method")
+//			},
+//			DeleteWebGroupFunc: func(ctx context.Context, uuid string) error {
+//				panic("mock out the DeleteWebGroup method")
+//			},
+//			DetachTransitGWForHybridFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the DetachTransitGWForHybrid method")
+//			},
+//			DisableActiveStandbyFunc: func(transitGateway *TransitVpc) error {
+//				panic("mock out the DisableActiveStandby method")
+//			},
+//			DisableActiveStandbyGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableActiveStandbyGatewayGroup method")
+//			},
+//			DisableActiveStandbySpokeFunc: func(spokeGateway *SpokeVpc) error {
+//				panic("mock out the DisableActiveStandbySpoke method")
+//			},
+//			DisableAdvertiseTransitCidrFunc: func(transitGw *TransitVpc) error {
+//				panic("mock out the DisableAdvertiseTransitCidr method")
+//			},
+//			DisableAdvertiseTransitCidrGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableAdvertiseTransitCidrGatewayGroup method")
+//			},
+//			DisableAutoAdvertiseS2CCidrsFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableAutoAdvertiseS2CCidrs method")
+//			},
+//			DisableAutoAdvertiseS2CCidrsGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableAutoAdvertiseS2CCidrsGatewayGroup method")
+//			},
+//			DisableConnectedTransitFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the DisableConnectedTransit method")
+//			},
+//			DisableConnectedTransitGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableConnectedTransitGatewayGroup method")
+//			},
+//			DisableCustomSNatFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableCustomSNat method")
+//			},
+//			DisableDeadPeerDetectionFunc: func(site2cloud *Site2Cloud) error {
+//				panic("mock out the DisableDeadPeerDetection method")
+//			},
+//			DisableDeadPeerDetectionContextFunc: func(ctx context.Context, site2cloud *Site2Cloud) error {
+//				panic("mock out the DisableDeadPeerDetectionContext method")
+//			},
+//			DisableDistributedFirewallingFunc: func(ctx context.Context) error {
+//				panic("mock out the DisableDistributedFirewalling method")
+//			},
+//			DisableEdgeSpokeTransitiveRoutingFunc: func(ctx context.Context, name string) error {
+//				panic("mock out the DisableEdgeSpokeTransitiveRouting method")
+//			},
+//			DisableEgressTransitFirenetFunc: func(transitGateway *TransitVpc) error {
+//				panic("mock out the DisableEgressTransitFirenet method")
+//			},
+//			DisableFireNetGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableFireNetGatewayGroup method")
+//			},
+//			DisableGatewayFireNetInterfacesFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the DisableGatewayFireNetInterfaces method")
+//			},
+//			DisableGatewayGroupSNatFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableGatewayGroupSNat method")
+//			},
+//			DisableGatewayGroupVpcDNSServerFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableGatewayGroupVpcDNSServer method")
+//			},
+//			DisableGatewayLoadBalancerGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableGatewayLoadBalancerGatewayGroup method")
+//			},
+//			DisableGlobalVpcFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableGlobalVpc method")
+//			},
+//			DisableGlobalVpcGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableGlobalVpcGatewayGroup method")
+//			},
+//			DisableGroGsoFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableGroGso method")
+//			},
+//			DisableGroGsoGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableGroGsoGatewayGroup method")
+//			},
+//			DisableGuardDutyEnforcementFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableGuardDutyEnforcement method")
+//			},
+//			DisableHybridConnectionGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableHybridConnectionGatewayGroup method")
+//			},
+//			DisableIPv6Func: func(gateway *Gateway) error {
+//				panic("mock out the DisableIPv6 method")
+//			},
+//			DisableIPv6GatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableIPv6GatewayGroup method")
+//			},
+//			DisableJumboFrameFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableJumboFrame method")
+//			},
+//			DisableJumboFrameExternalDeviceConnFunc: func(externalDeviceConn *ExternalDeviceConn) error {
+//				panic("mock out the DisableJumboFrameExternalDeviceConn method")
+//			},
+//			DisableJumboFrameGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableJumboFrameGatewayGroup method")
+//			},
+//			DisableMonitorGatewaySubnetsFunc: func(gwName string) error {
+//				panic("mock out the DisableMonitorGatewaySubnets method")
+//			},
+//			DisableMultiTierTransitGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableMultiTierTransitGatewayGroup method")
+//			},
+//			DisableMultitierTransitFunc: func(gwName string) error {
+//				panic("mock out the DisableMultitierTransit method")
+//			},
+//			DisablePrivateVpcDefaultRouteFunc: func(gw *Gateway) error {
+//				panic("mock out the DisablePrivateVpcDefaultRoute method")
+//			},
+//			DisablePrivateVpcDefaultRouteGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisablePrivateVpcDefaultRouteGatewayGroup method")
+//			},
+//			DisableS2CRxBalancingFunc: func(gwName string) error {
+//				panic("mock out the DisableS2CRxBalancing method")
+//			},
+//			DisableS2cRxBalancingGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableS2cRxBalancingGatewayGroup method")
+//			},
+//			DisableSNatFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableSNat method")
+//			},
+//			DisableSegmentationFunc: func(transitGateway *TransitVpc) error {
+//				panic("mock out the DisableSegmentation method")
+//			},
+//			DisableSegmentationGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableSegmentationGatewayGroup method")
+//			},
+//			DisableSingleAZGatewayFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableSingleAZGateway method")
+//			},
+//			DisableSite2CloudEventTriggeredHAFunc: func(vpcID string, connectionName string) error {
+//				panic("mock out the DisableSite2CloudEventTriggeredHA method")
+//			},
+//			DisableSite2cloudActiveActiveFunc: func(site2cloud *Site2Cloud) error {
+//				panic("mock out the DisableSite2cloudActiveActive method")
+//			},
+//			DisableSkipPublicRouteUpdateFunc: func(gw *Gateway) error {
+//				panic("mock out the DisableSkipPublicRouteUpdate method")
+//			},
+//			DisableSkipPublicRouteUpdateGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableSkipPublicRouteUpdateGatewayGroup method")
+//			},
+//			DisableSpokeLearnedCidrsApprovalFunc: func(gateway *SpokeVpc) error {
+//				panic("mock out the DisableSpokeLearnedCidrsApproval method")
+//			},
+//			DisableSpokeLearnedCidrsApprovalGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableSpokeLearnedCidrsApprovalGatewayGroup method")
+//			},
+//			DisableSpokeMappedSite2CloudForwardingFunc: func(site2cloud *Site2Cloud) error {
+//				panic("mock out the DisableSpokeMappedSite2CloudForwarding method")
+//			},
+//			DisableSpokeOnpremRoutePropagationFunc: func(spokeGateway *SpokeVpc) error {
+//				panic("mock out the DisableSpokeOnpremRoutePropagation method")
+//			},
+//			DisableSpokeOnpremRoutePropagationGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableSpokeOnpremRoutePropagationGatewayGroup method")
+//			},
+//			DisableSpokePreserveAsPathFunc: func(spokeGateway *SpokeVpc) error {
+//				panic("mock out the DisableSpokePreserveAsPath method")
+//			},
+//			DisableSpokePreserveAsPathGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableSpokePreserveAsPathGatewayGroup method")
+//			},
+//			DisableSummarizeCidrToTgwFunc: func(gwName string) error {
+//				panic("mock out the DisableSummarizeCidrToTgw method")
+//			},
+//			DisableTransitFireNetFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableTransitFireNet method")
+//			},
+//			DisableTransitFireNetGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableTransitFireNetGatewayGroup method")
+//			},
+//			DisableTransitLearnedCidrsApprovalFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the DisableTransitLearnedCidrsApproval method")
+//			},
+//			DisableTransitLearnedCidrsApprovalGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableTransitLearnedCidrsApprovalGatewayGroup method")
+//			},
+//			DisableTransitPreserveAsPathFunc: func(transitGateway *TransitVpc) error {
+//				panic("mock out the DisableTransitPreserveAsPath method")
+//			},
+//			DisableTransitPreserveAsPathGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableTransitPreserveAsPathGatewayGroup method")
+//			},
+//			DisableTransitSummarizeCidrToTgwGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableTransitSummarizeCidrToTgwGatewayGroup method")
+//			},
+//			DisableVPNConfigFunc: func(gateway *Gateway, vpnConfig *VPNConfig) error {
+//				panic("mock out the DisableVPNConfig method")
+//			},
+//			DisableVpcDNSServerFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableVpcDNSServer method")
+//			},
+//			DisableVpnNatFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableVpnNat method")
+//			},
+//			DownloadEdgeNEOConfigFileFunc: func(ctx context.Context, edgeNEODevice *EdgeNEODevice) error {
+//				panic("mock out the DownloadEdgeNEOConfigFile method")
+//			},
+//			EditBgpMd5KeyFunc: func(editBgpMd5Key *EditBgpMd5Key) error {
+//				panic("mock out the EditBgpMd5Key method")
+//			},
+//			EditConnectionBgpBfdFunc: func(externalDeviceConn *ExternalDeviceConn) error {
+//				panic("mock out the EditConnectionBgpBfd method")
+//			},
+//			EditConnectionBgpMultihopFunc: func(externalDeviceConn *ExternalDeviceConn) error {
+//				panic("mock out the EditConnectionBgpMultihop method")
+//			},
+//			EditDesignatedGatewayFunc: func(gateway *Gateway) error {
+//				panic("mock out the EditDesignatedGateway method")
+//			},
+//			EditGatewayAdvertisedCidrFunc: func(gateway *Gateway) error {
+//				panic("mock out the EditGatewayAdvertisedCidr method")
+//			},
+//			EditGatewayCustomRoutesFunc: func(gateway *Gateway) error {
+//				panic("mock out the EditGatewayCustomRoutes method")
+//			},
+//			EditGatewayFilterRoutesFunc: func(gateway *Gateway) error {
+//				panic("mock out the EditGatewayFilterRoutes method")
+//			},
+//			EditManagedRouteTablesFunc: func(gateway *Gateway, routeTables []string) error {
+//				panic("mock out the EditManagedRouteTables method")
+//			},
+//			EditManagedRouteTablesForGatewayGroupFunc: func(ctx context.Context, groupName string, routeTables []string) error {
+//				panic("mock out the EditManagedRouteTablesForGatewayGroup method")
+//			},
+//			EditPrivateRouteTableConfigFunc: func(gateway *Gateway, routeTables []string) error {
+//				panic("mock out the EditPrivateRouteTableConfig method")
+//			},
+//			EditPrivateRouteTableConfigForGatewayGroupFunc: func(ctx context.Context, groupName string, routeTables []string) error {
+//				panic("mock out the EditPrivateRouteTableConfigForGatewayGroup method")
+//			},
+//			EditPublicSubnetFilteringRouteTableListFunc: func(gateway *Gateway, routeTables []string) error {
+//				panic("mock out the EditPublicSubnetFilteringRouteTableList method")
+//			},
+//			EditSite2CloudPhase1LocalIdentifierFunc: func(s2c *EditSite2Cloud) error {
+//				panic("mock out the EditSite2CloudPhase1LocalIdentifier method")
+//			},
+//			EditSpokeExternalDeviceConnASPathPrependFunc: func(externalDeviceConn *ExternalDeviceConn, prependASPath []string) error {
+//				panic("mock out the EditSpokeExternalDeviceConnASPathPrepend method")
+//			},
+//			EditSpokeTransitAttachmentFiltersFunc: func(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) error {
+//				panic("mock out the EditSpokeTransitAttachmentFilters method")
+//			},
+//			EditTransitConnectionASPathPrependFunc: func(transitGatewayPeering *TransitGatewayPeering, prependASPath []string) error {
+//				panic("mock out the EditTransitConnectionASPathPrepend method")
+//			},
+//			EditTransitConnectionBGPManualAdvertiseCIDRsFunc: func(gwName string, connName string, cidrs []string) error {
+//				panic("mock out the EditTransitConnectionBGPManualAdvertiseCIDRs method")
+//			},
+//			EnableActiveStandbyFunc: func(transitGateway *TransitVpc) error {
+//				panic("mock out the EnableActiveStandby method")
+//			},
+//			EnableActiveStandbyGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableActiveStandbyGatewayGroup method")
+//			},
+//			EnableActiveStandbyPreemptiveFunc: func(transitGateway *TransitVpc) error {
+//				panic("mock out the EnableActiveStandbyPreemptive method")
+//			},
+//			EnableActiveStandbyPreemptiveGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableActiveStandbyPreemptiveGatewayGroup method")
+//			},
+//			EnableActiveStandbyPreemptiveSpokeFunc: func(spokeGateway *SpokeVpc) error {
+//				panic("mock out the EnableActiveStandbyPreemptiveSpoke method")
+//			},
+//			EnableActiveStandbySpokeFunc: func(spokeGateway *SpokeVpc) error {
+//				panic("mock out the EnableActiveStandbySpoke method")
+//			},
+//			EnableAdvertiseTransitCidrFunc: func(transitGw *TransitVpc) error {
+//				panic("mock out the EnableAdvertiseTransitCidr method")
+//			},
+//			EnableAdvertiseTransitCidrGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableAdvertiseTransitCidrGatewayGroup method")
+//			},
+//			EnableAutoAdvertiseS2CCidrsFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableAutoAdvertiseS2CCidrs method")
+//			},
+//			EnableAutoAdvertiseS2CCidrsGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableAutoAdvertiseS2CCidrsGatewayGroup method")
+//			},
+//			EnableConnectedTransitFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the EnableConnectedTransit method")
+//			},
+//			EnableConnectedTransitGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableConnectedTransitGatewayGroup method")
+//			},
+//			EnableCustomizedSNatFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableCustomizedSNat method")
+//			},
+//			EnableDeadPeerDetectionFunc: func(site2cloud *Site2Cloud) error {
+//				panic("mock out the EnableDeadPeerDetection method")
+//			},
+//			EnableDistributedFirewallingFunc: func(ctx context.Context) error {
+//				panic("mock out the EnableDistributedFirewalling method")
+//			},
+//			EnableEdgeSpokeTransitiveRoutingFunc: func(ctx context.Context, name string) error {
+//				panic("mock out the EnableEdgeSpokeTransitiveRouting method")
+//			},
+//			EnableEgressTransitFirenetFunc: func(transitGateway *TransitVpc) error {
+//				panic("mock out the EnableEgressTransitFirenet method")
+//			},
+//			EnableEncryptVolumeFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableEncryptVolume method")
+//			},
+//			EnableFireNetGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableFireNetGatewayGroup method")
+//			},
+//			EnableGatewayFireNetInterfacesFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the EnableGatewayFireNetInterfaces method")
+//			},
+//			EnableGatewayFireNetInterfacesWithGWLBFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the EnableGatewayFireNetInterfacesWithGWLB method")
+//			},
+//			EnableGatewayGroupSNatFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableGatewayGroupSNat method")
+//			},
+//			EnableGatewayGroupVpcDNSServerFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableGatewayGroupVpcDNSServer method")
+//			},
+//			EnableGatewayLoadBalancerGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableGatewayLoadBalancerGatewayGroup method")
+//			},
+//			EnableGlobalVpcFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableGlobalVpc method")
+//			},
+//			EnableGlobalVpcGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableGlobalVpcGatewayGroup method")
+//			},
+//			EnableGroGsoFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableGroGso method")
+//			},
+//			EnableGroGsoGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableGroGsoGatewayGroup method")
+//			},
+//			EnableGuardDutyEnforcementFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableGuardDutyEnforcement method")
+//			},
+//			EnableHybridConnectionGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableHybridConnectionGatewayGroup method")
+//			},
+//			EnableIPv6Func: func(gateway *Gateway) error {
+//				panic("mock out the EnableIPv6 method")
+//			},
+//			EnableIPv6GatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableIPv6GatewayGroup method")
+//			},
+//			EnableJumboFrameFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableJumboFrame method")
+//			},
+//			EnableJumboFrameExternalDeviceConnFunc: func(externalDeviceConn *ExternalDeviceConn) error {
+//				panic("mock out the EnableJumboFrameExternalDeviceConn method")
+//			},
+//			EnableJumboFrameGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableJumboFrameGatewayGroup method")
+//			},
+//			EnableMonitorGatewaySubnetsFunc: func(gwName string, excludedInstances []string) error {
+//				panic("mock out the EnableMonitorGatewaySubnets method")
+//			},
+//			EnableMultiTierTransitGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableMultiTierTransitGatewayGroup method")
+//			},
+//			EnableMultitierTransitFunc: func(gwName string) error {
+//				panic("mock out the EnableMultitierTransit method")
+//			},
+//			EnablePeeringHaGatewayFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnablePeeringHaGateway method")
+//			},
+//			EnablePrivateVpcDefaultRouteFunc: func(gw *Gateway) error {
+//				panic("mock out the EnablePrivateVpcDefaultRoute method")
+//			},
+//			EnablePrivateVpcDefaultRouteGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnablePrivateVpcDefaultRouteGatewayGroup method")
+//			},
+//			EnablePublicSubnetFilteringHAGatewayFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnablePublicSubnetFilteringHAGateway method")
+//			},
+//			EnableS2CRxBalancingFunc: func(gwName string) error {
+//				panic("mock out the EnableS2CRxBalancing method")
+//			},
+//			EnableS2cRxBalancingGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableS2cRxBalancingGatewayGroup method")
+//			},
+//			EnableSNatFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableSNat method")
+//			},
+//			EnableSegmentationFunc: func(transitGateway *TransitVpc) error {
+//				panic("mock out the EnableSegmentation method")
+//			},
+//			EnableSegmentationGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableSegmentationGatewayGroup method")
+//			},
+//			EnableSingleAZGatewayFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableSingleAZGateway method")
+//			},
+//			EnableSite2CloudEventTriggeredHAFunc: func(vpcID string, connectionName string) error {
+//				panic("mock out the EnableSite2CloudEventTriggeredHA method")
+//			},
+//			EnableSite2cloudActiveActiveFunc: func(site2cloud *Site2Cloud) error {
+//				panic("mock out the EnableSite2cloudActiveActive method")
+//			},
+//			EnableSite2cloudActiveActiveContextFunc: func(ctx context.Context, site2cloud *Site2Cloud) error {
+//				panic("mock out the EnableSite2cloudActiveActiveContext method")
+//			},
+//			EnableSkipPublicRouteUpdateFunc: func(gw *Gateway) error {
+//				panic("mock out the EnableSkipPublicRouteUpdate method")
+//			},
+//			EnableSkipPublicRouteUpdateGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableSkipPublicRouteUpdateGatewayGroup method")
+//			},
+//			EnableSpokeLearnedCidrsApprovalFunc: func(gateway *SpokeVpc) error {
+//				panic("mock out the EnableSpokeLearnedCidrsApproval method")
+//			},
+//			EnableSpokeLearnedCidrsApprovalGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableSpokeLearnedCidrsApprovalGatewayGroup method")
+//			},
+//			EnableSpokeMappedSite2CloudForwardingFunc: func(site2cloud *Site2Cloud) error {
+//				panic("mock out the EnableSpokeMappedSite2CloudForwarding method")
+//			},
+//			EnableSpokeOnpremRoutePropagationFunc: func(spokeGateway *SpokeVpc) error {
+//				panic("mock out the EnableSpokeOnpremRoutePropagation method")
+//			},
+//			EnableSpokeOnpremRoutePropagationGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableSpokeOnpremRoutePropagationGatewayGroup method")
+//			},
+//			EnableSpokePreserveAsPathFunc: func(spokeGateway *SpokeVpc) error {
+//				panic("mock out the EnableSpokePreserveAsPath method")
+//			},
+//			EnableSpokePreserveAsPathGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableSpokePreserveAsPathGatewayGroup method")
+//			},
+//			EnableSummarizeCidrToTgwFunc: func(gwName string) error {
+//				panic("mock out the EnableSummarizeCidrToTgw method")
+//			},
+//			EnableTransitFireNetFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableTransitFireNet method")
+//			},
+//			EnableTransitFireNetGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableTransitFireNetGatewayGroup method")
+//			},
+//			EnableTransitFireNetWithGWLBFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableTransitFireNetWithGWLB method")
+//			},
+//			EnableTransitFireNetWithGWLBGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableTransitFireNetWithGWLBGatewayGroup method")
+//			},
+//			EnableTransitLearnedCidrsApprovalFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the EnableTransitLearnedCidrsApproval method")
+//			},
+//			EnableTransitLearnedCidrsApprovalGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableTransitLearnedCidrsApprovalGatewayGroup method")
+//			},
+//			EnableTransitPreserveAsPathFunc: func(transitGateway *TransitVpc) error {
+//				panic("mock out the EnableTransitPreserveAsPath method")
+//			},
+//			EnableTransitPreserveAsPathGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableTransitPreserveAsPathGatewayGroup method")
+//			},
+//			EnableTransitSummarizeCidrToTgwGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableTransitSummarizeCidrToTgwGatewayGroup method")
+//			},
+//			EnableVPNConfigFunc: func(gateway *Gateway, vpnConfig *VPNConfig) error {
+//				panic("mock out the EnableVPNConfig method")
+//			},
+//			EnableVpcDNSServerFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableVpcDNSServer method")
+//			},
+//			EnableVpnNatFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableVpnNat method")
+//			},
 //			GetAccountFunc: func(account *Account) (Account, error) {
 //				panic("mock out the GetAccount method")
 //			},
-//		}
+//			GetAccountUserFunc: func(user *AccountUser) (*AccountUser, error) {
+//				panic("mock out the GetAccountUser method")
+//			},
+//			GetBgpLanIPListFunc: func(transitGateway *TransitVpc) (*TransitGatewayBgpLanIpInfo, error) {
+//				panic("mock out the GetBgpLanIPList method")
+//			},
+//			GetCIDFunc: func() string {
+//				panic("mock out the GetCID method")
+//			},
+//			GetCaCertificateFunc: func(ctx context.Context) (*ProxyCaConfig, error) {
+//				panic("mock out the GetCaCertificate method")
+//			},
+//			GetCompatibleImageVersionFunc: func(ctx context.Context, cloudType int, softwareVersion string) (string, error) {
+//				panic("mock out the GetCompatibleImageVersion method")
+//			},
+//			GetControllerIPFunc: func() string {
+//				panic("mock out the GetControllerIP method")
+//			},
+//			GetDCFAttachmentPointFunc: func(ctx context.Context, name string) (*AttachmentPointResp, error) {
+//				panic("mock out the GetDCFAttachmentPoint method")
+//			},
+//			GetDCFMitmCaFunc: func(ctx context.Context, caID string) (*MitmCaResponse, error) {
+//				panic("mock out the GetDCFMitmCa method")
+//			},
+//			GetDCFPolicyBlockFunc: func(ctx context.Context, uuid string) (*DCFPolicyBlock, error) {
+//				panic("mock out the GetDCFPolicyBlock method")
+//			},
+//			GetDCFPolicyListFunc: func(ctx context.Context, uuid string) (*DCFPolicyList, error) {
+//				panic("mock out the GetDCFPolicyList method")
+//			},
+//			GetDCFTrustBundleByIDFunc: func(ctx context.Context, bundleUUID string) (*DCFTrustBundle, error) {
+//				panic("mock out the GetDCFTrustBundleByID method")
+//			},
+//			GetDCFTrustBundleByNameFunc: func(ctx context.Context, bundleName string) (*DCFTrustBundle, error) {
+//				panic("mock out the GetDCFTrustBundleByName method")
+//			},
+//			GetDefaultIpsProfileFunc: func(ctx context.Context) (*DefaultIpsProfileResponse, error) {
+//				panic("mock out the GetDefaultIpsProfile method")
+//			},
+//			GetDefaultTagsConfigFunc: func() *DefaultTagsConfig {
+//				panic("mock out the GetDefaultTagsConfig method")
+//			},
+//			GetDistributedFirewallingDefaultActionRuleFunc: func(ctx context.Context) (*DistributedFirewallingDefaultActionRule, error) {
+//				panic("mock out the GetDistributedFirewallingDefaultActionRule method")
+//			},
+//			GetDistributedFirewallingDeploymentPolicyFunc: func(ctx context.Context) (*DistributedFirewallingDeploymentPolicy, error) {
+//				panic("mock out the GetDistributedFirewallingDeploymentPolicy method")
+//			},
+//			GetDistributedFirewallingIntraVpcFunc: func(ctx context.Context) (*DistributedFirewallingIntraVpcList, error) {
+//				panic("mock out the GetDistributedFirewallingIntraVpc method")
+//			},
+//			GetDistributedFirewallingPolicyListFunc: func(ctx context.Context) (*DistributedFirewallingPolicyList, error) {
+//				panic("mock out the GetDistributedFirewallingPolicyList method")
+//			},
+//			GetDistributedFirewallingStatusFunc: func(ctx context.Context) (*DistributedFirewallingConfig, error) {
+//				panic("mock out the GetDistributedFirewallingStatus method")
+//			},
+//			GetEdgeCSPFunc: func(ctx context.Context, gwName string) (*EdgeCSPResp, error) {
+//				panic("mock out the GetEdgeCSP method")
+//			},
+//			GetEdgeCSPHaFunc: func(ctx context.Context, gwName string) (*EdgeCSPHaResp, error) {
+//				panic("mock out the GetEdgeCSPHa method")
+//			},
+//			GetEdgeEquinixFunc: func(ctx context.Context, gwName string) (*EdgeEquinixResp, error) {
+//				panic("mock out the GetEdgeEquinix method")
+//			},
+//			GetEdgeEquinixHaFunc: func(ctx context.Context, gwName string) (*EdgeEquinixHaResp, error) {
+//				panic("mock out the GetEdgeEquinixHa method")
+//			},
+//			GetEdgeGatewayWanIpFunc: func(ctx context.Context, gwName string, wanInterfaceName string) (string, error) {
+//				panic("mock out the GetEdgeGatewayWanIp method")
+//			},
+//			GetEdgeMegaportFunc: func(ctx context.Context, gwName string) (*EdgeMegaportResp, error) {
+//				panic("mock out the GetEdgeMegaport method")
+//			},
+//			GetEdgeMegaportHaFunc: func(ctx context.Context, gwName string) (*EdgeMegaportHaResp, error) {
+//				panic("mock out the GetEdgeMegaportHa method")
+//			},
+//			GetEdgeNEOFunc: func(ctx context.Context, gwName string) (*EdgeNEOResp, error) {
+//				panic("mock out the GetEdgeNEO method")
+//			},
+//			GetEdgeNEODeviceFunc: func(ctx context.Context, accountName string, deviceName string) (*EdgeNEODeviceResp, error) {
+//				panic("mock out the GetEdgeNEODevice method")
+//			},
+//			GetEdgeNEOHaFunc: func(ctx context.Context, gwName string) (*EdgeNEOHaResp, error) {
+//				panic("mock out the GetEdgeNEOHa method")
+//			},
+//			GetEdgePlatformProxyProfileFunc: func(ctx context.Context, accountName string, profileName string) (*EdgePlatformProxyProfileResp, error) {
+//				panic("mock out the GetEdgePlatformProxyProfile method")
+//			},
+//			GetEdgeSpokeFunc: func(ctx context.Context, gwName string) (*EdgeSpokeResp, error) {
+//				panic("mock out the GetEdgeSpoke method")
+//			},
+//			GetEdgeSpokeTransitAttachmentFunc: func(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) (*SpokeTransitAttachment, error) {
+//				panic("mock out the GetEdgeSpokeTransitAttachment method")
+//			},
+//			GetEdgeVmSelfmanagedHaFunc: func(ctx context.Context, gwName string) (*EdgeVmSelfmanagedHaResp, error) {
+//				panic("mock out the GetEdgeVmSelfmanagedHa method")
+//			},
+//			GetEnforcementLevelFunc: func(ctx context.Context) (*EnforcementLevel, error) {
+//				panic("mock out the GetEnforcementLevel method")
+//			},
+//			GetExternalDeviceConnDetailFunc: func(externalDeviceConn *ExternalDeviceConn, localGateway *Gateway, priorHAEnabled bool) (*ExternalDeviceConn, error) {
+//				panic("mock out the GetExternalDeviceConnDetail method")
+//			},
+//			GetGatewayFunc: func(gateway *Gateway) (*Gateway, error) {
+//				panic("mock out the GetGateway method")
+//			},
+//			GetGatewayBgpCommunitiesFunc: func(gwName string) (bool, bool, error) {
+//				panic("mock out the GetGatewayBgpCommunities method")
+//			},
+//			GetGatewayBgpMedToSdnMetricFunc: func(gwName string) (bool, error) {
+//				panic("mock out the GetGatewayBgpMedToSdnMetric method")
+//			},
+//			GetGatewayContextFunc: func(ctx context.Context, gateway *Gateway) (*Gateway, error) {
+//				panic("mock out the GetGatewayContext method")
+//			},
+//			GetGatewayDetailFunc: func(gateway *Gateway) (*GatewayDetail, error) {
+//				panic("mock out the GetGatewayDetail method")
+//			},
+//			GetGatewayGroupFunc: func(ctx context.Context, groupUUID string) (*GatewayGroup, error) {
+//				panic("mock out the GetGatewayGroup method")
+//			},
+//			GetGatewayGroupByNameFunc: func(ctx context.Context, groupName string) (*GatewayGroup, error) {
+//				panic("mock out the GetGatewayGroupByName method")
+//			},
+//			GetGeoVPNNameFunc: func(gateway *Gateway) (*GeoVPN, error) {
+//				panic("mock out the GetGeoVPNName method")
+//			},
+//			GetGroGsoStatusFunc: func(gateway *Gateway) (bool, error) {
+//				panic("mock out the GetGroGsoStatus method")
+//			},
+//			GetIgnoreTagsConfigFunc: func() *IgnoreTagsConfig {
+//				panic("mock out the GetIgnoreTagsConfig method")
+//			},
+//			GetIpsProfileFunc: func(ctx context.Context, uuid string) (*IpsProfile, error) {
+//				panic("mock out the GetIpsProfile method")
+//			},
+//			GetIpsRuleFeedFunc: func(ctx context.Context, uuid string) (*IpsRuleFeed, error) {
+//				panic("mock out the GetIpsRuleFeed method")
+//			},
+//			GetLogProfileByNameFunc: func(ctx context.Context, profileName string) (*LogProfile, error) {
+//				panic("mock out the GetLogProfileByName method")
+//			},
+//			GetMetaCaCertificateFunc: func(ctx context.Context) (*ProxyCaCertInstance, error) {
+//				panic("mock out the GetMetaCaCertificate method")
+//			},
+//			GetS2CCaCertTagFunc: func(ctx context.Context, s2cCaCertTag *S2CCaCertTag) (*S2CCaCertTag, error) {
+//				panic("mock out the GetS2CCaCertTag method")
+//			},
+//			GetSegmentationSecurityDomainFunc: func(domain *SegmentationSecurityDomain) (*SegmentationSecurityDomain, error) {
+//				panic("mock out the GetSegmentationSecurityDomain method")
+//			},
+//			GetSegmentationSecurityDomainAssociationFunc: func(association *SegmentationSecurityDomainAssociation) (*SegmentationSecurityDomainAssociation, error) {
+//				panic("mock out the GetSegmentationSecurityDomainAssociation method")
+//			},
+//			GetSegmentationSecurityDomainConnectionPolicyFunc: func(policy *SegmentationSecurityDomainConnectionPolicy) (*SegmentationSecurityDomainConnectionPolicy, error) {
+//				panic("mock out the GetSegmentationSecurityDomainConnectionPolicy method")
+//			},
+//			GetSite2CloudConnDetailFunc: func(site2cloud *Site2Cloud) (*Site2Cloud, error) {
+//				panic("mock out the GetSite2CloudConnDetail method")
+//			},
+//			GetSmartGroupFunc: func(ctx context.Context, uuid string) (*SmartGroup, error) {
+//				panic("mock out the GetSmartGroup method")
+//			},
+//			GetSmartGroupsFunc: func(ctx context.Context) ([]*SmartGroup, error) {
+//				panic("mock out the GetSmartGroups method")
+//			},
+//			GetSplitTunnelFunc: func(splitTunnel *SplitTunnel) (*SplitTunnelUnit, error) {
+//				panic("mock out the GetSplitTunnel method")
+//			},
+//			GetSpokeGatewayAdvancedConfigFunc: func(spokeGateway *SpokeVpc) (*SpokeGatewayAdvancedConfig, error) {
+//				panic("mock out the GetSpokeGatewayAdvancedConfig method")
+//			},
+//			GetSpokeGatewayListFunc: func(ctx context.Context) ([]Gateway, error) {
+//				panic("mock out the GetSpokeGatewayList method")
+//			},
+//			GetSpokeGatewaySubnetGroupFunc: func(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error {
+//				panic("mock out the GetSpokeGatewaySubnetGroup method")
+//			},
+//			GetTLSProfileFunc: func(ctx context.Context, uuidStr string) (*TLSProfileWithID, error) {
+//				panic("mock out the GetTLSProfile method")
+//			},
+//			GetTLSProfileByNameFunc: func(ctx context.Context, displayName string) (*TLSProfileWithID, error) {
+//				panic("mock out the GetTLSProfileByName method")
+//			},
+//			GetTagsFunc: func(tags *Tags) ([]string, error) {
+//				panic("mock out the GetTags method")
+//			},
+//			GetTransitGatewayAdvancedConfigFunc: func(transitGateway *TransitVpc) (*TransitGatewayAdvancedConfig, error) {
+//				panic("mock out the GetTransitGatewayAdvancedConfig method")
+//			},
+//			GetTransitGatewayLanCidrFunc: func(gatewayName string) (string, error) {
+//				panic("mock out the GetTransitGatewayLanCidr method")
+//			},
+//			GetTransitGatewayListFunc: func(ctx context.Context) ([]Gateway, error) {
+//				panic("mock out the GetTransitGatewayList method")
+//			},
+//			GetTunnelDetectionTimeFunc: func(entity string) (int, error) {
+//				panic("mock out the GetTunnelDetectionTime method")
+//			},
+//			GetWebGroupFunc: func(ctx context.Context, uuid string) (*WebGroup, error) {
+//				panic("mock out the GetWebGroup method")
+//			},
+//			GetWebGroupByNameFunc: func(ctx context.Context, name string) (*WebGroup, error) {
+//				panic("mock out the GetWebGroupByName method")
+//			},
+//			InvalidateCacheFunc: func()  {
+//				panic("mock out the InvalidateCache method")
+//			},
+//			IsTransitFireNetReadyToBeDisabledFunc: func(gateway *Gateway) error {
+//				panic("mock out the IsTransitFireNetReadyToBeDisabled method")
+//			},
+//			LaunchSpokeVpcContextFunc: func(ctx context.Context, spoke *SpokeVpc) error {
+//				panic("mock out the LaunchSpokeVpcContext method")
+//			},
+//			LaunchTransitVpcContextFunc: func(ctx context.Context, gateway *TransitVpc) error {
+//				panic("mock out the LaunchTransitVpcContext method")
+//			},
+//			ListDCFMitmCaFunc: func(ctx context.Context) (*MitmCaListResponse, error) {
+//				panic("mock out the ListDCFMitmCa method")
+//			},
+//			ListGatewayRegionsFunc: func(ctx context.Context, cloudType int, accountName string) ([]string, error) {
+//				panic("mock out the ListGatewayRegions method")
+//			},
+//			ListGatewaySizesFunc: func(ctx context.Context, cloudType int, accountName string, region string) ([]string, error) {
+//				panic("mock out the ListGatewaySizes method")
+//			},
+//			ListSegmentationSecurityDomainsFunc: func() ([]string, error) {
+//				panic("mock out the ListSegmentationSecurityDomains method")
+//			},
+//			ListSite2CloudContextFunc: func(ctx context.Context) ([]Site2Cloud, error) {
+//				panic("mock out the ListSite2CloudContext method")
+//			},
+//			ModifySplitTunnelFunc: func(splitTunnel *SplitTunnel) error {
+//				panic("mock out the ModifySplitTunnel method")
+//			},
+//			ModifyTunnelDetectionTimeFunc: func(entity string, detectionTime int) error {
+//				panic("mock out the ModifyTunnelDetectionTime method")
+//			},
+//			OnboardEdgeNEODeviceFunc: func(ctx context.Context, edgeNEODevice *EdgeNEODevice) error {
+//				panic("mock out the OnboardEdgeNEODevice method")
+//			},
+//			PostAPIFunc: func(action string, d interface{}, checkFunc CheckAPIResponseFunc) error {
+//				panic("mock out the PostAPI method")
+//			},
+//			RefreshDCFMitmSysatemCAFunc: func(ctx context.Context) error {
+//				panic("mock out the RefreshDCFMitmSysatemCA method")
+//			},
+//			SetBgpBfdPollingTimeFunc: func(transitGateway *TransitVpc, newPollingTime int) error {
+//				panic("mock out the SetBgpBfdPollingTime method")
+//			},
+//			SetBgpBfdPollingTimeGatewayGroupFunc: func(ctx context.Context, groupName string, pollingTime int) error {
+//				panic("mock out the SetBgpBfdPollingTimeGatewayGroup method")
+//			},
+//			SetBgpBfdPollingTimeSpokeFunc: func(spokeGateway *SpokeVpc, newPollingTime int) error {
+//				panic("mock out the SetBgpBfdPollingTimeSpoke method")
+//			},
+//			SetBgpEcmpFunc: func(transitGateway *TransitVpc, enabled bool) error {
+//				panic("mock out the SetBgpEcmp method")
+//			},
+//			SetBgpEcmpGatewayGroupFunc: func(ctx context.Context, groupName string, enable bool) error {
+//				panic("mock out the SetBgpEcmpGatewayGroup method")
+//			},
+//			SetBgpEcmpSpokeFunc: func(spokeGateway *SpokeVpc, enabled bool) error {
+//				panic("mock out the SetBgpEcmpSpoke method")
+//			},
+//			SetBgpManualSpokeAdvertisedNetworksFunc: func(transitGw *TransitVpc) error {
+//				panic("mock out the SetBgpManualSpokeAdvertisedNetworks method")
+//			},
+//			SetBgpPollingTimeFunc: func(transitGateway *TransitVpc, newPollingTime int) error {
+//				panic("mock out the SetBgpPollingTime method")
+//			},
+//			SetBgpPollingTimeGatewayGroupFunc: func(ctx context.Context, groupName string, pollingTime int) error {
+//				panic("mock out the SetBgpPollingTimeGatewayGroup method")
+//			},
+//			SetBgpPollingTimeSpokeFunc: func(spokeGateway *SpokeVpc, newPollingTime int) error {
+//				panic("mock out the SetBgpPollingTimeSpoke method")
+//			},
+//			SetDefaultIpsProfileFunc: func(ctx context.Context, profiles []string) (*DefaultIpsProfileResponse, error) {
+//				panic("mock out the SetDefaultIpsProfile method")
+//			},
+//			SetEnforcementLevelFunc: func(ctx context.Context, enforcementLevel *EnforcementLevel) error {
+//				panic("mock out the SetEnforcementLevel method")
+//			},
+//			SetGatewayBgpCommunitiesAcceptFunc: func(gwName string, acceptComm bool) error {
+//				panic("mock out the SetGatewayBgpCommunitiesAccept method")
+//			},
+//			SetGatewayBgpCommunitiesSendFunc: func(gwName string, sendComm bool) error {
+//				panic("mock out the SetGatewayBgpCommunitiesSend method")
+//			},
+//			SetGatewayBgpMedToSdnMetricFunc: func(gwName string, override bool) error {
+//				panic("mock out the SetGatewayBgpMedToSdnMetric method")
+//			},
+//			SetGatewayGroupBgpCommunitiesAcceptFunc: func(ctx context.Context, groupName string, accept bool) error {
+//				panic("mock out the SetGatewayGroupBgpCommunitiesAccept method")
+//			},
+//			SetGatewayGroupBgpCommunitiesSendFunc: func(ctx context.Context, groupName string, send bool) error {
+//				panic("mock out the SetGatewayGroupBgpCommunitiesSend method")
+//			},
+//			SetGatewayPhase2PolicyFunc: func(gwName string, encPolicy string, pfsPolicy string) error {
+//				panic("mock out the SetGatewayPhase2Policy method")
+//			},
+//			SetLocalASNumberFunc: func(transitGateway *TransitVpc, localASNumber string) error {
+//				panic("mock out the SetLocalASNumber method")
+//			},
+//			SetLocalASNumberGatewayGroupFunc: func(ctx context.Context, groupName string, localAsNumber string) error {
+//				panic("mock out the SetLocalASNumberGatewayGroup method")
+//			},
+//			SetLocalASNumberSpokeFunc: func(spokeGateway *SpokeVpc, localASNumber string) error {
+//				panic("mock out the SetLocalASNumberSpoke method")
+//			},
+//			SetNewCertificateFunc: func(ctx context.Context, proxyCaConfig *ProxyCaConfig) error {
+//				panic("mock out the SetNewCertificate method")
+//			},
+//			SetPrependASPathFunc: func(transitGateway *TransitVpc, prependASPath []string) error {
+//				panic("mock out the SetPrependASPath method")
+//			},
+//			SetPrependASPathGatewayGroupFunc: func(ctx context.Context, groupName string, prependAsPath []string) error {
+//				panic("mock out the SetPrependASPathGatewayGroup method")
+//			},
+//			SetPrependASPathSpokeFunc: func(spokeGateway *SpokeVpc, prependASPath []string) error {
+//				panic("mock out the SetPrependASPathSpoke method")
+//			},
+//			SetRxQueueSizeFunc: func(gateway *Gateway) error {
+//				panic("mock out the SetRxQueueSize method")
+//			},
+//			SetSpokeBgpManualAdvertisedNetworksFunc: func(spokeGateway *SpokeVpc) error {
+//				panic("mock out the SetSpokeBgpManualAdvertisedNetworks method")
+//			},
+//			SetSpokeBgpManualAdvertisedNetworksGatewayGroupFunc: func(ctx context.Context, groupName string, cidrs string) error {
+//				panic("mock out the SetSpokeBgpManualAdvertisedNetworksGatewayGroup method")
+//			},
+//			SetSymmetricRoutingGatewayGroupFunc: func(ctx context.Context, groupUUID string, enable bool) error {
+//				panic("mock out the SetSymmetricRoutingGatewayGroup method")
+//			},
+//			SetTransitLearnedCIDRsApprovalModeFunc: func(gw *TransitVpc, mode string) error {
+//				panic("mock out the SetTransitLearnedCIDRsApprovalMode method")
+//			},
+//			SetVpnGatewayAuthenticationFunc: func(gateway *VpnGatewayAuth) error {
+//				panic("mock out the SetVpnGatewayAuthentication method")
+//			},
+//			UpdateAccountFunc: func(account *Account) error {
+//				panic("mock out the UpdateAccount method")
+//			},
+//			UpdateAccountUserObjectFunc: func(user *AccountUserEdit) error {
+//				panic("mock out the UpdateAccountUserObject method")
+//			},
+//			UpdateDCFMitmCaFunc: func(ctx context.Context, caID string, patchRequest *MitmCaPatchRequest) (*MitmCaResponse, error) {
+//				panic("mock out the UpdateDCFMitmCa method")
+//			},
+//			UpdateDCFPolicyBlockFunc: func(ctx context.Context, policyBlock *DCFPolicyBlock) error {
+//				panic("mock out the UpdateDCFPolicyBlock method")
+//			},
+//			UpdateDCFPolicyListFunc: func(ctx context.Context, policyList *DCFPolicyList) error {
+//				panic("mock out the UpdateDCFPolicyList method")
+//			},
+//			UpdateDCFTrustBundleFunc: func(ctx context.Context, bundleUUID string, trustBundle *TrustBundleItemRequest) error {
+//				panic("mock out the UpdateDCFTrustBundle method")
+//			},
+//			UpdateDNatFunc: func(gateway *Gateway) error {
+//				panic("mock out the UpdateDNat method")
+//			},
+//			UpdateDistributedFirewallingDefaultActionRuleFunc: func(ctx context.Context, request *DistributedFirewallingDefaultActionRule) error {
+//				panic("mock out the UpdateDistributedFirewallingDefaultActionRule method")
+//			},
+//			UpdateDistributedFirewallingPolicyListFunc: func(ctx context.Context, policyList *DistributedFirewallingPolicyList) error {
+//				panic("mock out the UpdateDistributedFirewallingPolicyList method")
+//			},
+//			UpdateEdgeAccountFunc: func(edgeAccount *EdgeAccount) error {
+//				panic("mock out the UpdateEdgeAccount method")
+//			},
+//			UpdateEdgeCSPFunc: func(ctx context.Context, edgeCSP *EdgeCSP) error {
+//				panic("mock out the UpdateEdgeCSP method")
+//			},
+//			UpdateEdgeCSPHaFunc: func(ctx context.Context, edgeCSP *EdgeCSP) error {
+//				panic("mock out the UpdateEdgeCSPHa method")
+//			},
+//			UpdateEdgeEquinixFunc: func(ctx context.Context, edgeEquinix *EdgeEquinix) error {
+//				panic("mock out the UpdateEdgeEquinix method")
+//			},
+//			UpdateEdgeEquinixHaFunc: func(ctx context.Context, edgeEquinix *EdgeEquinix) error {
+//				panic("mock out the UpdateEdgeEquinixHa method")
+//			},
+//			UpdateEdgeGatewayFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the UpdateEdgeGateway method")
+//			},
+//			UpdateEdgeGatewayV2Func: func(ctx context.Context, gateway *TransitVpc) error {
+//				panic("mock out the UpdateEdgeGatewayV2 method")
+//			},
+//			UpdateEdgeMegaportFunc: func(ctx context.Context, edgeMegaport *EdgeMegaport) error {
+//				panic("mock out the UpdateEdgeMegaport method")
+//			},
+//			UpdateEdgeMegaportHaFunc: func(ctx context.Context, edgeMegaport *EdgeMegaport) error {
+//				panic("mock out the UpdateEdgeMegaportHa method")
+//			},
+//			UpdateEdgeNEOFunc: func(ctx context.Context, edgeNEO *EdgeNEO) error {
+//				panic("mock out the UpdateEdgeNEO method")
+//			},
+//			UpdateEdgeNEODeviceFunc: func(ctx context.Context, edgeNEODevice *EdgeNEODevice) error {
+//				panic("mock out the UpdateEdgeNEODevice method")
+//			},
+//			UpdateEdgeNEOHaFunc: func(ctx context.Context, edgeNEO *EdgeNEO) error {
+//				panic("mock out the UpdateEdgeNEOHa method")
+//			},
+//			UpdateEdgeProxyProfileFunc: func(ctx context.Context, edgeNEOProxyProfile *EdgePlatformProxyProfileUpdate) error {
+//				panic("mock out the UpdateEdgeProxyProfile method")
+//			},
+//			UpdateEdgeSpokeFunc: func(ctx context.Context, edgeSpoke *EdgeSpoke) error {
+//				panic("mock out the UpdateEdgeSpoke method")
+//			},
+//			UpdateEdgeSpokeGeoCoordinateFunc: func(ctx context.Context, edgeSpoke *EdgeSpoke) error {
+//				panic("mock out the UpdateEdgeSpokeGeoCoordinate method")
+//			},
+//			UpdateEdgeSpokeTransitPeeringTunnelCountFunc: func(gateway1 string, gateway2 string, tunnelCount int) error {
+//				panic("mock out the UpdateEdgeSpokeTransitPeeringTunnelCount method")
+//			},
+//			UpdateEdgeVmSelfmanagedHaFunc: func(ctx context.Context, edgeVmSelfmanaged *EdgeSpoke) error {
+//				panic("mock out the UpdateEdgeVmSelfmanagedHa method")
+//			},
+//			UpdateEnforcementLevelFunc: func(ctx context.Context, enforcementLevel *EnforcementLevel) error {
+//				panic("mock out the UpdateEnforcementLevel method")
+//			},
+//			UpdateGCPAccountFunc: func(account *Account) error {
+//				panic("mock out the UpdateGCPAccount method")
+//			},
+//			UpdateGatewayFunc: func(gateway *Gateway) error {
+//				panic("mock out the UpdateGateway method")
+//			},
+//			UpdateGatewayContextFunc: func(ctx context.Context, gateway *Gateway) error {
+//				panic("mock out the UpdateGatewayContext method")
+//			},
+//			UpdateGroupInstanceSizeFunc: func(ctx context.Context, groupName string, instanceSize string) error {
+//				panic("mock out the UpdateGroupInstanceSize method")
+//			},
+//			UpdateIpsProfileFunc: func(ctx context.Context, uuid string, profile *IpsProfile) (*IpsProfile, error) {
+//				panic("mock out the UpdateIpsProfile method")
+//			},
+//			UpdateIpsRuleFeedFunc: func(ctx context.Context, uuid string, ruleFeed *IpsRuleFeed) (*IpsRuleFeed, error) {
+//				panic("mock out the UpdateIpsRuleFeed method")
+//			},
+//			UpdateMaxVpnConnFunc: func(gateway *Gateway) error {
+//				panic("mock out the UpdateMaxVpnConn method")
+//			},
+//			UpdateSite2CloudFunc: func(site2cloud *EditSite2Cloud) error {
+//				panic("mock out the UpdateSite2Cloud method")
+//			},
+//			UpdateSmartGroupFunc: func(ctx context.Context, smartGroup *SmartGroup, uuid string) error {
+//				panic("mock out the UpdateSmartGroup method")
+//			},
+//			UpdateSpokeGatewaySubnetGroupFunc: func(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error {
+//				panic("mock out the UpdateSpokeGatewaySubnetGroup method")
+//			},
+//			UpdateSpokePendingApprovedCidrsFunc: func(gateway *SpokeVpc) error {
+//				panic("mock out the UpdateSpokePendingApprovedCidrs method")
+//			},
+//			UpdateSpokePendingApprovedCidrsGatewayGroupFunc: func(ctx context.Context, groupName string, approvedCidrs []string) error {
+//				panic("mock out the UpdateSpokePendingApprovedCidrsGatewayGroup method")
+//			},
+//			UpdateTLSProfileFunc: func(ctx context.Context, uuid string, tlsProfile *TLSProfile) error {
+//				panic("mock out the UpdateTLSProfile method")
+//			},
+//			UpdateTagsFunc: func(tags *Tags) error {
+//				panic("mock out the UpdateTags method")
+//			},
+//			UpdateTransitGatewayCustomizedVpcRouteFunc: func(gateway string, customizedTransitVpcRoutes []string) error {
+//				panic("mock out the UpdateTransitGatewayCustomizedVpcRoute method")
+//			},
+//			UpdateTransitPendingApprovedCidrsFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the UpdateTransitPendingApprovedCidrs method")
+//			},
+//			UpdateTransitPendingApprovedCidrsGatewayGroupFunc: func(ctx context.Context, groupName string, approvedCidrs []string) error {
+//				panic("mock out the UpdateTransitPendingApprovedCidrsGatewayGroup method")
+//			},
+//			UpdateVpnCidrFunc: func(gateway *Gateway) error {
+//				panic("mock out the UpdateVpnCidr method")
+//			},
+//			UpdateWebGroupFunc: func(ctx context.Context, webGroup *WebGroup, uuid string) error {
+//				panic("mock out the UpdateWebGroup method")
+//			},
+//		}
+//
+//		// use mockedClientInterface in code that requires ClientInterface
+//		// and then make assertions.
+//
+//	}
+type ClientInterfaceMock struct {
+	// AddSpokeGatewaySubnetGroupFunc mocks the AddSpokeGatewaySubnetGroup method.
+	AddSpokeGatewaySubnetGroupFunc func(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error
+
+	// AttachTransitGWForHybridFunc mocks the AttachTransitGWForHybrid method.
+	AttachTransitGWForHybridFunc func(gateway *TransitVpc) error
+
+	// AuditAccountFunc mocks the AuditAccount method.
+	AuditAccountFunc func(ctx context.Context, account *Account) error
+
+	// ChangeBgpHoldTimeFunc mocks the ChangeBgpHoldTime method.
+	ChangeBgpHoldTimeFunc func(gwName string, holdTime int) error
+
+	// ChangeBgpHoldTimeGatewayGroupFunc mocks the ChangeBgpHoldTimeGatewayGroup method.
+	ChangeBgpHoldTimeGatewayGroupFunc func(ctx context.Context, groupName string, holdTime int) error
+
+	// ChangeBgpOverLanIntfCntFunc mocks the ChangeBgpOverLanIntfCnt method.
+	ChangeBgpOverLanIntfCntFunc func(gateway *Gateway) error
+
+	// CreateAccountFunc mocks the CreateAccount method.
+	CreateAccountFunc func(account *Account) error
+
+	// CreateAccountUserFunc mocks the CreateAccountUser method.
+	CreateAccountUserFunc func(user *AccountUser) error
+
+	// CreateDCFMitmCaFunc mocks the CreateDCFMitmCa method.
+	CreateDCFMitmCaFunc func(ctx context.Context, mitmCa *MitmCaItemRequest) (string, error)
+
+	// CreateDCFPolicyBlockFunc mocks the CreateDCFPolicyBlock method.
+	CreateDCFPolicyBlockFunc func(ctx context.Context, policyBlock *DCFPolicyBlock) (string, error)
+
+	// CreateDCFPolicyListFunc mocks the CreateDCFPolicyList method.
+	CreateDCFPolicyListFunc func(ctx context.Context, policyList *DCFPolicyList) (string, error)
+
+	// CreateDCFTrustBundleFunc mocks the CreateDCFTrustBundle method.
+	CreateDCFTrustBundleFunc func(ctx context.Context, trustBundle *TrustBundleItemRequest) (string, error)
+
+	// CreateDistributedFirewallingDeploymentPolicyFunc mocks the CreateDistributedFirewallingDeploymentPolicy method.
+	CreateDistributedFirewallingDeploymentPolicyFunc func(ctx context.Context, deploymentPolicy *DistributedFirewallingDeploymentPolicy) error
+
+	// CreateDistributedFirewallingIntraVpcFunc mocks the CreateDistributedFirewallingIntraVpc method.
+	CreateDistributedFirewallingIntraVpcFunc func(ctx context.Context, vpcList *DistributedFirewallingIntraVpcList) error
+
+	// CreateDistributedFirewallingPolicyListFunc mocks the CreateDistributedFirewallingPolicyList method.
+	CreateDistributedFirewallingPolicyListFunc func(ctx context.Context, policyList *DistributedFirewallingPolicyList) error
+
+	// CreateEdgeAccountFunc mocks the CreateEdgeAccount method.
+	CreateEdgeAccountFunc func(edgeAccount *EdgeAccount) error
+
+	// CreateEdgeCSPFunc mocks the CreateEdgeCSP method.
+	CreateEdgeCSPFunc func(ctx context.Context, edgeCSP *EdgeCSP) error
+
+	// CreateEdgeCSPHaFunc mocks the CreateEdgeCSPHa method.
+	CreateEdgeCSPHaFunc func(ctx context.Context, edgeCSPHa *EdgeCSPHa) (string, error)
+
+	// CreateEdgeEquinixFunc mocks the CreateEdgeEquinix method.
+	CreateEdgeEquinixFunc func(ctx context.Context, edgeEquinix *EdgeEquinix) error
+
+	// CreateEdgeEquinixHaFunc mocks the CreateEdgeEquinixHa method.
+	CreateEdgeEquinixHaFunc func(ctx context.Context, edgeEquinixHa *EdgeEquinixHa) (string, error)
+
+	// CreateEdgeExternalDeviceConnFunc mocks the CreateEdgeExternalDeviceConn method.
+	CreateEdgeExternalDeviceConnFunc func(edgeExternalDeviceConn *EdgeExternalDeviceConn) (string, error)
+
+	// CreateEdgeMegaportFunc mocks the CreateEdgeMegaport method.
+	CreateEdgeMegaportFunc func(ctx context.Context, edgeMegaport *EdgeMegaport) error
+
+	// CreateEdgeMegaportHaFunc mocks the CreateEdgeMegaportHa method.
+	CreateEdgeMegaportHaFunc func(ctx context.Context, edgeMegaportHa *EdgeMegaportHa) (string, error)
+
+	// CreateEdgeNEOFunc mocks the CreateEdgeNEO method.
+	CreateEdgeNEOFunc func(ctx context.Context, edgeNEO *EdgeNEO) error
+
+	// CreateEdgeNEOHaFunc mocks the CreateEdgeNEOHa method.
+	CreateEdgeNEOHaFunc func(ctx context.Context, edgeNEOHa *EdgeNEOHa) (string, error)
+
+	// CreateEdgeProxyProfileFunc mocks the CreateEdgeProxyProfile method.
+	CreateEdgeProxyProfileFunc func(ctx context.Context, edgeNEOProxyProfile *EdgePlatformProxyProfile) (*EdgePlatformProxyProfileResp, error)
+
+	// CreateEdgeSpokeFunc mocks the CreateEdgeSpoke method.
+	CreateEdgeSpokeFunc func(ctx context.Context, edgeSpoke *EdgeSpoke) error
+
+	// CreateEdgeVmSelfmanagedHaFunc mocks the CreateEdgeVmSelfmanagedHa method.
+	CreateEdgeVmSelfmanagedHaFunc func(ctx context.Context, edgeVmSelfmanagedHa *EdgeVmSelfmanagedHa) (string, error)
+
+	// CreateExternalDeviceConnFunc mocks the CreateExternalDeviceConn method.
+	CreateExternalDeviceConnFunc func(externalDeviceConn *ExternalDeviceConn) error
+
+	// CreateGCPAccountFunc mocks the CreateGCPAccount method.
+	CreateGCPAccountFunc func(account *Account) error
+
+	// CreateGatewayContextFunc mocks the CreateGatewayContext method.
+	CreateGatewayContextFunc func(ctx context.Context, gateway *Gateway) error
+
+	// CreateGatewayGroupFunc mocks the CreateGatewayGroup method.
+	CreateGatewayGroupFunc func(ctx context.Context, spokeGroup *GatewayGroup) error
+
+	// CreateIpsProfileFunc mocks the CreateIpsProfile method.
+	CreateIpsProfileFunc func(ctx context.Context, profile *IpsProfile) (*IpsProfileCreateResponse, error)
+
+	// CreateIpsRuleFeedFunc mocks the CreateIpsRuleFeed method.
+	CreateIpsRuleFeedFunc func(ctx context.Context, ruleFeed *IpsRuleFeed) (*IpsRuleFeedUploadResponse, error)
+
+	// CreateOCIAccountFunc mocks the CreateOCIAccount method.
+	CreateOCIAccountFunc func(account *Account) error
+
+	// CreatePublicSubnetFilteringGatewayContextFunc mocks the CreatePublicSubnetFilteringGatewayContext method.
+	CreatePublicSubnetFilteringGatewayContextFunc func(ctx context.Context, gateway *Gateway) error
+
+	// CreateS2CCaCertFunc mocks the CreateS2CCaCert method.
+	CreateS2CCaCertFunc func(ctx context.Context, s2cCaCert *S2CCaCert) error
+
+	// CreateSegmentationSecurityDomainFunc mocks the CreateSegmentationSecurityDomain method.
+	CreateSegmentationSecurityDomainFunc func(domain *SegmentationSecurityDomain) error
+
+	// CreateSegmentationSecurityDomainAssociationFunc mocks the CreateSegmentationSecurityDomainAssociation method.
+	CreateSegmentationSecurityDomainAssociationFunc func(association *SegmentationSecurityDomainAssociation) error
+
+	// CreateSegmentationSecurityDomainConnectionPolicyFunc mocks the CreateSegmentationSecurityDomainConnectionPolicy method.
+	CreateSegmentationSecurityDomainConnectionPolicyFunc func(policy *SegmentationSecurityDomainConnectionPolicy) error
+
+	// CreateSite2CloudFunc mocks the CreateSite2Cloud method.
+	CreateSite2CloudFunc func(site2cloud *Site2Cloud) error
+
+	// CreateSite2CloudContextFunc mocks the CreateSite2CloudContext method.
+	CreateSite2CloudContextFunc func(ctx context.Context, site2cloud *Site2Cloud) error
+
+	// CreateSmartGroupFunc mocks the CreateSmartGroup method.
+	CreateSmartGroupFunc func(ctx context.Context, smartGroup *SmartGroup) (string, error)
+
+	// CreateSpokeHaGwFunc mocks the CreateSpokeHaGw method.
+	CreateSpokeHaGwFunc func(spokeHaGateway *SpokeHaGateway) (string, error)
+
+	// CreateSpokeHaGwContextFunc mocks the CreateSpokeHaGwContext method.
+	CreateSpokeHaGwContextFunc func(ctx context.Context, spokeHaGateway *SpokeHaGateway) (string, error)
+
+	// CreateSpokeTransitAttachmentFunc mocks the CreateSpokeTransitAttachment method.
+	CreateSpokeTransitAttachmentFunc func(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) error
+
+	// CreateTLSProfileFunc mocks the CreateTLSProfile method.
+	CreateTLSProfileFunc func(ctx context.Context, tlsProfile *TLSProfile) (string, error)
+
+	// CreateTransitHaGwContextFunc mocks the CreateTransitHaGwContext method.
+	CreateTransitHaGwContextFunc func(ctx context.Context, transitHaGateway *TransitHaGateway) (string, error)
+
+	// CreateWebGroupFunc mocks the CreateWebGroup method.
+	CreateWebGroupFunc func(ctx context.Context, webGroup *WebGroup) (string, error)
+
+	// DeleteAccountFunc mocks the DeleteAccount method.
+	DeleteAccountFunc func(account *Account) error
+
+	// DeleteAccountUserFunc mocks the DeleteAccountUser method.
+	DeleteAccountUserFunc func(user *AccountUser) error
+
+	// DeleteCaCertificateFunc mocks the DeleteCaCertificate method.
+	DeleteCaCertificateFunc func(ctx context.Context) error
+
+	// DeleteCertInstanceFunc mocks the DeleteCertInstance method.
+	DeleteCertInstanceFunc func(ctx context.Context, caCertInstance *CaCertInstance) error
+
+	// DeleteDCFMitmCaFunc mocks the DeleteDCFMitmCa method.
+	DeleteDCFMitmCaFunc func(ctx context.Context, caID string) error
+
+	// DeleteDCFPolicyBlockFunc mocks the DeleteDCFPolicyBlock method.
+	DeleteDCFPolicyBlockFunc func(ctx context.Context, uuid string) error
+
+	// DeleteDCFPolicyListFunc mocks the DeleteDCFPolicyList method.
+	DeleteDCFPolicyListFunc func(ctx context.Context, uuid string) error
+
+	// DeleteDCFTrustBundleFunc mocks the DeleteDCFTrustBundle method.
+	DeleteDCFTrustBundleFunc func(ctx context.Context, bundleUUID string) error
+
+	// DeleteDistributedFirewallingIntraVpcFunc mocks the DeleteDistributedFirewallingIntraVpc method.
+	DeleteDistributedFirewallingIntraVpcFunc func(ctx context.Context) error
+
+	// DeleteDistributedFirewallingPolicyListFunc mocks the DeleteDistributedFirewallingPolicyList method.
+	DeleteDistributedFirewallingPolicyListFunc func(ctx context.Context) error
+
+	// DeleteEdgeCSPFunc mocks the DeleteEdgeCSP method.
+	DeleteEdgeCSPFunc func(ctx context.Context, accountName string, name string) error
+
+	// DeleteEdgeEquinixFunc mocks the DeleteEdgeEquinix method.
+	DeleteEdgeEquinixFunc func(ctx context.Context, accountName string, name string) error
+
+	// DeleteEdgeExternalDeviceConnFunc mocks the DeleteEdgeExternalDeviceConn method.
+	DeleteEdgeExternalDeviceConnFunc func(edgeExternalDeviceConn *EdgeExternalDeviceConn) error
+
+	// DeleteEdgeMegaportFunc mocks the DeleteEdgeMegaport method.
+	DeleteEdgeMegaportFunc func(ctx context.Context, accountName string, name string) error
+
+	// DeleteEdgeNEOFunc mocks the DeleteEdgeNEO method.
+	DeleteEdgeNEOFunc func(ctx context.Context, accountName string, name string) error
+
+	// DeleteEdgeNEODeviceFunc mocks the DeleteEdgeNEODevice method.
+	DeleteEdgeNEODeviceFunc func(ctx context.Context, accountName string, serialNumber string) error
+
+	// DeleteEdgePlatformProxyProfileFunc mocks the DeleteEdgePlatformProxyProfile method.
+	DeleteEdgePlatformProxyProfileFunc func(ctx context.Context, accountName string, profileName string) error
+
+	// DeleteEdgeSpokeFunc mocks the DeleteEdgeSpoke method.
+	DeleteEdgeSpokeFunc func(ctx context.Context, name string) error
+
+	// DeleteEnforcementLevelFunc mocks the DeleteEnforcementLevel method.
+	DeleteEnforcementLevelFunc func(ctx context.Context) error
+
+	// DeleteExternalDeviceConnFunc mocks the DeleteExternalDeviceConn method.
+	DeleteExternalDeviceConnFunc func(externalDeviceConn *ExternalDeviceConn) error
+
+	// DeleteGatewayFunc mocks the DeleteGateway method.
+	DeleteGatewayFunc func(gateway *Gateway) error
+
+	// DeleteGatewayContextFunc mocks the DeleteGatewayContext method.
+	DeleteGatewayContextFunc func(ctx context.Context, gateway *Gateway) error
+
+	// DeleteGatewayGroupFunc mocks the DeleteGatewayGroup method.
+	DeleteGatewayGroupFunc func(ctx context.Context, groupUUID string) error
+
+	// DeleteIpsProfileFunc mocks the DeleteIpsProfile method.
+	DeleteIpsProfileFunc func(ctx context.Context, uuid string) error
+
+	// DeleteIpsRuleFeedFunc mocks the DeleteIpsRuleFeed method.
+	DeleteIpsRuleFeedFunc func(ctx context.Context, uuid string) error
+
+	// DeletePublicSubnetFilteringGatewayContextFunc mocks the DeletePublicSubnetFilteringGatewayContext method.
+	DeletePublicSubnetFilteringGatewayContextFunc func(ctx context.Context, gateway *Gateway) error
+
+	// DeleteSegmentationSecurityDomainFunc mocks the DeleteSegmentationSecurityDomain method.
+	DeleteSegmentationSecurityDomainFunc func(domain *SegmentationSecurityDomain) error
+
+	// DeleteSegmentationSecurityDomainAssociationFunc mocks the DeleteSegmentationSecurityDomainAssociation method.
+	DeleteSegmentationSecurityDomainAssociationFunc func(association *SegmentationSecurityDomainAssociation) error
+
+	// DeleteSegmentationSecurityDomainConnectionPolicyFunc mocks the DeleteSegmentationSecurityDomainConnectionPolicy method.
+	DeleteSegmentationSecurityDomainConnectionPolicyFunc func(policy *SegmentationSecurityDomainConnectionPolicy) error
+
+	// DeleteSite2CloudFunc mocks the DeleteSite2Cloud method.
+	DeleteSite2CloudFunc func(site2cloud *Site2Cloud) error
+
+	// DeleteSite2CloudContextFunc mocks the DeleteSite2CloudContext method.
+	DeleteSite2CloudContextFunc func(ctx context.Context, site2cloud *Site2Cloud) error
+
+	// DeleteSmartGroupFunc mocks the DeleteSmartGroup method.
+	DeleteSmartGroupFunc func(ctx context.Context, uuid string) error
+
+	// DeleteSpokeGatewaySubnetGroupFunc mocks the DeleteSpokeGatewaySubnetGroup method.
+	DeleteSpokeGatewaySubnetGroupFunc func(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error
+
+	// DeleteSpokeTransitAttachmentFunc mocks the DeleteSpokeTransitAttachment method.
+	DeleteSpokeTransitAttachmentFunc func(spokeTransitAttachment *SpokeTransitAttachment) error
+
+	// DeleteTLSProfileFunc mocks the DeleteTLSProfile method.
+	DeleteTLSProfileFunc func(ctx context.Context, uuid string) error
+
+	// DeleteWebGroupFunc mocks the DeleteWebGroup method.
+	DeleteWebGroupFunc func(ctx context.Context, uuid string) error
+
+	// DetachTransitGWForHybridFunc mocks the DetachTransitGWForHybrid method.
+	DetachTransitGWForHybridFunc func(gateway *TransitVpc) error
+
+	// DisableActiveStandbyFunc mocks the DisableActiveStandby method.
+	DisableActiveStandbyFunc func(transitGateway *TransitVpc) error
+
+	// DisableActiveStandbyGatewayGroupFunc mocks the DisableActiveStandbyGatewayGroup method.
+	DisableActiveStandbyGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableActiveStandbySpokeFunc mocks the DisableActiveStandbySpoke method.
+	DisableActiveStandbySpokeFunc func(spokeGateway *SpokeVpc) error
+
+	// DisableAdvertiseTransitCidrFunc mocks the DisableAdvertiseTransitCidr method.
+	DisableAdvertiseTransitCidrFunc func(transitGw *TransitVpc) error
+
+	// DisableAdvertiseTransitCidrGatewayGroupFunc mocks the DisableAdvertiseTransitCidrGatewayGroup method.
+	DisableAdvertiseTransitCidrGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableAutoAdvertiseS2CCidrsFunc mocks the DisableAutoAdvertiseS2CCidrs method.
+	DisableAutoAdvertiseS2CCidrsFunc func(gateway *Gateway) error
+
+	// DisableAutoAdvertiseS2CCidrsGatewayGroupFunc mocks the DisableAutoAdvertiseS2CCidrsGatewayGroup method.
+	DisableAutoAdvertiseS2CCidrsGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableConnectedTransitFunc mocks the DisableConnectedTransit method.
+	DisableConnectedTransitFunc func(gateway *TransitVpc) error
+
+	// DisableConnectedTransitGatewayGroupFunc mocks the DisableConnectedTransitGatewayGroup method.
+	DisableConnectedTransitGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableCustomSNatFunc mocks the DisableCustomSNat method.
+	DisableCustomSNatFunc func(gateway *Gateway) error
+
+	// DisableDeadPeerDetectionFunc mocks the DisableDeadPeerDetection method.
+	DisableDeadPeerDetectionFunc func(site2cloud *Site2Cloud) error
+
+	// DisableDeadPeerDetectionContextFunc mocks the DisableDeadPeerDetectionContext method.
+	DisableDeadPeerDetectionContextFunc func(ctx context.Context, site2cloud *Site2Cloud) error
+
+	// DisableDistributedFirewallingFunc mocks the DisableDistributedFirewalling method.
+	DisableDistributedFirewallingFunc func(ctx context.Context) error
+
+	// DisableEdgeSpokeTransitiveRoutingFunc mocks the DisableEdgeSpokeTransitiveRouting method.
+	DisableEdgeSpokeTransitiveRoutingFunc func(ctx context.Context, name string) error
+
+	// DisableEgressTransitFirenetFunc mocks the DisableEgressTransitFirenet method.
+	DisableEgressTransitFirenetFunc func(transitGateway *TransitVpc) error
+
+	// DisableFireNetGatewayGroupFunc mocks the DisableFireNetGatewayGroup method.
+	DisableFireNetGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableGatewayFireNetInterfacesFunc mocks the DisableGatewayFireNetInterfaces method.
+	DisableGatewayFireNetInterfacesFunc func(gateway *TransitVpc) error
+
+	// DisableGatewayGroupSNatFunc mocks the DisableGatewayGroupSNat method.
+	DisableGatewayGroupSNatFunc func(ctx context.Context, groupName string) error
+
+	// DisableGatewayGroupVpcDNSServerFunc mocks the DisableGatewayGroupVpcDNSServer method.
+	DisableGatewayGroupVpcDNSServerFunc func(ctx context.Context, groupName string) error
+
+	// DisableGatewayLoadBalancerGatewayGroupFunc mocks the DisableGatewayLoadBalancerGatewayGroup method.
+	DisableGatewayLoadBalancerGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableGlobalVpcFunc mocks the DisableGlobalVpc method.
+	DisableGlobalVpcFunc func(gateway *Gateway) error
+
+	// DisableGlobalVpcGatewayGroupFunc mocks the DisableGlobalVpcGatewayGroup method.
+	DisableGlobalVpcGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableGroGsoFunc mocks the DisableGroGso method.
+	DisableGroGsoFunc func(gateway *Gateway) error
+
+	// DisableGroGsoGatewayGroupFunc mocks the DisableGroGsoGatewayGroup method.
+	DisableGroGsoGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableGuardDutyEnforcementFunc mocks the DisableGuardDutyEnforcement method.
+	DisableGuardDutyEnforcementFunc func(gateway *Gateway) error
+
+	// DisableHybridConnectionGatewayGroupFunc mocks the DisableHybridConnectionGatewayGroup method.
+	DisableHybridConnectionGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableIPv6Func mocks the DisableIPv6 method.
+	DisableIPv6Func func(gateway *Gateway) error
+
+	// DisableIPv6GatewayGroupFunc mocks the DisableIPv6GatewayGroup method.
+	DisableIPv6GatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableJumboFrameFunc mocks the DisableJumboFrame method.
+	DisableJumboFrameFunc func(gateway *Gateway) error
+
+	// DisableJumboFrameExternalDeviceConnFunc mocks the DisableJumboFrameExternalDeviceConn method.
+	DisableJumboFrameExternalDeviceConnFunc func(externalDeviceConn *ExternalDeviceConn) error
+
+	// DisableJumboFrameGatewayGroupFunc mocks the DisableJumboFrameGatewayGroup method.
+	DisableJumboFrameGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableMonitorGatewaySubnetsFunc mocks the DisableMonitorGatewaySubnets method.
+	DisableMonitorGatewaySubnetsFunc func(gwName string) error
+
+	// DisableMultiTierTransitGatewayGroupFunc mocks the DisableMultiTierTransitGatewayGroup method.
+	DisableMultiTierTransitGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableMultitierTransitFunc mocks the DisableMultitierTransit method.
+	DisableMultitierTransitFunc func(gwName string) error
+
+	// DisablePrivateVpcDefaultRouteFunc mocks the DisablePrivateVpcDefaultRoute method.
+	DisablePrivateVpcDefaultRouteFunc func(gw *Gateway) error
+
+	// DisablePrivateVpcDefaultRouteGatewayGroupFunc mocks the DisablePrivateVpcDefaultRouteGatewayGroup method.
+	DisablePrivateVpcDefaultRouteGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableS2CRxBalancingFunc mocks the DisableS2CRxBalancing method.
+	DisableS2CRxBalancingFunc func(gwName string) error
+
+	// DisableS2cRxBalancingGatewayGroupFunc mocks the DisableS2cRxBalancingGatewayGroup method.
+	DisableS2cRxBalancingGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableSNatFunc mocks the DisableSNat method.
+	DisableSNatFunc func(gateway *Gateway) error
+
+	// DisableSegmentationFunc mocks the DisableSegmentation method.
+	DisableSegmentationFunc func(transitGateway *TransitVpc) error
+
+	// DisableSegmentationGatewayGroupFunc mocks the DisableSegmentationGatewayGroup method.
+	DisableSegmentationGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableSingleAZGatewayFunc mocks the DisableSingleAZGateway method.
+	DisableSingleAZGatewayFunc func(gateway *Gateway) error
+
+	// DisableSite2CloudEventTriggeredHAFunc mocks the DisableSite2CloudEventTriggeredHA method.
+	DisableSite2CloudEventTriggeredHAFunc func(vpcID string, connectionName string) error
+
+	// DisableSite2cloudActiveActiveFunc mocks the DisableSite2cloudActiveActive method.
+	DisableSite2cloudActiveActiveFunc func(site2cloud *Site2Cloud) error
+
+	// DisableSkipPublicRouteUpdateFunc mocks the DisableSkipPublicRouteUpdate method.
+	DisableSkipPublicRouteUpdateFunc func(gw *Gateway) error
+
+	// DisableSkipPublicRouteUpdateGatewayGroupFunc mocks the DisableSkipPublicRouteUpdateGatewayGroup method.
+	DisableSkipPublicRouteUpdateGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableSpokeLearnedCidrsApprovalFunc mocks the DisableSpokeLearnedCidrsApproval method.
+	DisableSpokeLearnedCidrsApprovalFunc func(gateway *SpokeVpc) error
+
+	// DisableSpokeLearnedCidrsApprovalGatewayGroupFunc mocks the DisableSpokeLearnedCidrsApprovalGatewayGroup method.
+	DisableSpokeLearnedCidrsApprovalGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableSpokeMappedSite2CloudForwardingFunc mocks the DisableSpokeMappedSite2CloudForwarding method.
+	DisableSpokeMappedSite2CloudForwardingFunc func(site2cloud *Site2Cloud) error
+
+	// DisableSpokeOnpremRoutePropagationFunc mocks the DisableSpokeOnpremRoutePropagation method.
+	DisableSpokeOnpremRoutePropagationFunc func(spokeGateway *SpokeVpc) error
+
+	// DisableSpokeOnpremRoutePropagationGatewayGroupFunc mocks the DisableSpokeOnpremRoutePropagationGatewayGroup method.
+	DisableSpokeOnpremRoutePropagationGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableSpokePreserveAsPathFunc mocks the DisableSpokePreserveAsPath method.
+	DisableSpokePreserveAsPathFunc func(spokeGateway *SpokeVpc) error
+
+	// DisableSpokePreserveAsPathGatewayGroupFunc mocks the DisableSpokePreserveAsPathGatewayGroup method.
+	DisableSpokePreserveAsPathGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableSummarizeCidrToTgwFunc mocks the DisableSummarizeCidrToTgw method.
+	DisableSummarizeCidrToTgwFunc func(gwName string) error
+
+	// DisableTransitFireNetFunc mocks the DisableTransitFireNet method.
+	DisableTransitFireNetFunc func(gateway *Gateway) error
+
+	// DisableTransitFireNetGatewayGroupFunc mocks the DisableTransitFireNetGatewayGroup method.
+	DisableTransitFireNetGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableTransitLearnedCidrsApprovalFunc mocks the DisableTransitLearnedCidrsApproval method.
+	DisableTransitLearnedCidrsApprovalFunc func(gateway *TransitVpc) error
+
+	// DisableTransitLearnedCidrsApprovalGatewayGroupFunc mocks the DisableTransitLearnedCidrsApprovalGatewayGroup method.
+	DisableTransitLearnedCidrsApprovalGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableTransitPreserveAsPathFunc mocks the DisableTransitPreserveAsPath method.
+	DisableTransitPreserveAsPathFunc func(transitGateway *TransitVpc) error
+
+	// DisableTransitPreserveAsPathGatewayGroupFunc mocks the DisableTransitPreserveAsPathGatewayGroup method.
+	DisableTransitPreserveAsPathGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableTransitSummarizeCidrToTgwGatewayGroupFunc mocks the DisableTransitSummarizeCidrToTgwGatewayGroup method.
+	DisableTransitSummarizeCidrToTgwGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableVPNConfigFunc mocks the DisableVPNConfig method.
+	DisableVPNConfigFunc func(gateway *Gateway, vpnConfig *VPNConfig) error
+
+	// DisableVpcDNSServerFunc mocks the DisableVpcDNSServer method.
+	DisableVpcDNSServerFunc func(gateway *Gateway) error
+
+	// DisableVpnNatFunc mocks the DisableVpnNat method.
+	DisableVpnNatFunc func(gateway *Gateway) error
+
+	// DownloadEdgeNEOConfigFileFunc mocks the DownloadEdgeNEOConfigFile method.
+	DownloadEdgeNEOConfigFileFunc func(ctx context.Context, edgeNEODevice *EdgeNEODevice) error
+
+	// EditBgpMd5KeyFunc mocks the EditBgpMd5Key method.
+	EditBgpMd5KeyFunc func(editBgpMd5Key *EditBgpMd5Key) error
+
+	// EditConnectionBgpBfdFunc mocks the EditConnectionBgpBfd method.
+	EditConnectionBgpBfdFunc func(externalDeviceConn *ExternalDeviceConn) error
+
+	// EditConnectionBgpMultihopFunc mocks the EditConnectionBgpMultihop method.
+	EditConnectionBgpMultihopFunc func(externalDeviceConn *ExternalDeviceConn) error
+
+	// EditDesignatedGatewayFunc mocks the EditDesignatedGateway method.
+	EditDesignatedGatewayFunc func(gateway *Gateway) error
+
+	// EditGatewayAdvertisedCidrFunc mocks the EditGatewayAdvertisedCidr method.
+	EditGatewayAdvertisedCidrFunc func(gateway *Gateway) error
+
+	// EditGatewayCustomRoutesFunc mocks the EditGatewayCustomRoutes method.
+	EditGatewayCustomRoutesFunc func(gateway *Gateway) error
+
+	// EditGatewayFilterRoutesFunc mocks the EditGatewayFilterRoutes method.
+	EditGatewayFilterRoutesFunc func(gateway *Gateway) error
+
+	// EditManagedRouteTablesFunc mocks the EditManagedRouteTables method.
+	EditManagedRouteTablesFunc func(gateway *Gateway, routeTables []string) error
+
+	// EditManagedRouteTablesForGatewayGroupFunc mocks the EditManagedRouteTablesForGatewayGroup method.
+	EditManagedRouteTablesForGatewayGroupFunc func(ctx context.Context, groupName string, routeTables []string) error
+
+	// EditPrivateRouteTableConfigFunc mocks the EditPrivateRouteTableConfig method.
+	EditPrivateRouteTableConfigFunc func(gateway *Gateway, routeTables []string) error
+
+	// EditPrivateRouteTableConfigForGatewayGroupFunc mocks the EditPrivateRouteTableConfigForGatewayGroup method.
+	EditPrivateRouteTableConfigForGatewayGroupFunc func(ctx context.Context, groupName string, routeTables []string) error
+
+	// EditPublicSubnetFilteringRouteTableListFunc mocks the EditPublicSubnetFilteringRouteTableList method.
+	EditPublicSubnetFilteringRouteTableListFunc func(gateway *Gateway, routeTables []string) error
+
+	// EditSite2CloudPhase1LocalIdentifierFunc mocks the EditSite2CloudPhase1LocalIdentifier method.
+	EditSite2CloudPhase1LocalIdentifierFunc func(s2c *EditSite2Cloud) error
+
+	// EditSpokeExternalDeviceConnASPathPrependFunc mocks the EditSpokeExternalDeviceConnASPathPrepend method.
+	EditSpokeExternalDeviceConnASPathPrependFunc func(externalDeviceConn *ExternalDeviceConn, prependASPath []string) error
+
+	// EditSpokeTransitAttachmentFiltersFunc mocks the EditSpokeTransitAttachmentFilters method.
+	EditSpokeTransitAttachmentFiltersFunc func(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) error
+
+	// EditTransitConnectionASPathPrependFunc mocks the EditTransitConnectionASPathPrepend method.
+	EditTransitConnectionASPathPrependFunc func(transitGatewayPeering *TransitGatewayPeering, prependASPath []string) error
+
+	// EditTransitConnectionBGPManualAdvertiseCIDRsFunc mocks the EditTransitConnectionBGPManualAdvertiseCIDRs method.
+	EditTransitConnectionBGPManualAdvertiseCIDRsFunc func(gwName string, connName string, cidrs []string) error
+
+	// EnableActiveStandbyFunc mocks the EnableActiveStandby method.
+	EnableActiveStandbyFunc func(transitGateway *TransitVpc) error
+
+	// EnableActiveStandbyGatewayGroupFunc mocks the EnableActiveStandbyGatewayGroup method.
+	EnableActiveStandbyGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableActiveStandbyPreemptiveFunc mocks the EnableActiveStandbyPreemptive method.
+	EnableActiveStandbyPreemptiveFunc func(transitGateway *TransitVpc) error
+
+	// EnableActiveStandbyPreemptiveGatewayGroupFunc mocks the EnableActiveStandbyPreemptiveGatewayGroup method.
+	EnableActiveStandbyPreemptiveGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableActiveStandbyPreemptiveSpokeFunc mocks the EnableActiveStandbyPreemptiveSpoke method.
+	EnableActiveStandbyPreemptiveSpokeFunc func(spokeGateway *SpokeVpc) error
+
+	// EnableActiveStandbySpokeFunc mocks the EnableActiveStandbySpoke method.
+	EnableActiveStandbySpokeFunc func(spokeGateway *SpokeVpc) error
+
+	// EnableAdvertiseTransitCidrFunc mocks the EnableAdvertiseTransitCidr method.
+	EnableAdvertiseTransitCidrFunc func(transitGw *TransitVpc) error
+
+	// EnableAdvertiseTransitCidrGatewayGroupFunc mocks the EnableAdvertiseTransitCidrGatewayGroup method.
+	EnableAdvertiseTransitCidrGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableAutoAdvertiseS2CCidrsFunc mocks the EnableAutoAdvertiseS2CCidrs method.
+	EnableAutoAdvertiseS2CCidrsFunc func(gateway *Gateway) error
+
+	// EnableAutoAdvertiseS2CCidrsGatewayGroupFunc mocks the EnableAutoAdvertiseS2CCidrsGatewayGroup method.
+	EnableAutoAdvertiseS2CCidrsGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableConnectedTransitFunc mocks the EnableConnectedTransit method.
+	EnableConnectedTransitFunc func(gateway *TransitVpc) error
+
+	// EnableConnectedTransitGatewayGroupFunc mocks the EnableConnectedTransitGatewayGroup method.
+	EnableConnectedTransitGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableCustomizedSNatFunc mocks the EnableCustomizedSNat method.
+	EnableCustomizedSNatFunc func(gateway *Gateway) error
+
+	// EnableDeadPeerDetectionFunc mocks the EnableDeadPeerDetection method.
+	EnableDeadPeerDetectionFunc func(site2cloud *Site2Cloud) error
+
+	// EnableDistributedFirewallingFunc mocks the EnableDistributedFirewalling method.
+	EnableDistributedFirewallingFunc func(ctx context.Context) error
+
+	// EnableEdgeSpokeTransitiveRoutingFunc mocks the EnableEdgeSpokeTransitiveRouting method.
+	EnableEdgeSpokeTransitiveRoutingFunc func(ctx context.Context, name string) error
+
+	// EnableEgressTransitFirenetFunc mocks the EnableEgressTransitFirenet method.
+	EnableEgressTransitFirenetFunc func(transitGateway *TransitVpc) error
+
+	// EnableEncryptVolumeFunc mocks the EnableEncryptVolume method.
+	EnableEncryptVolumeFunc func(gateway *Gateway) error
+
+	// EnableFireNetGatewayGroupFunc mocks the EnableFireNetGatewayGroup method.
+	EnableFireNetGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableGatewayFireNetInterfacesFunc mocks the EnableGatewayFireNetInterfaces method.
+	EnableGatewayFireNetInterfacesFunc func(gateway *TransitVpc) error
+
+	// EnableGatewayFireNetInterfacesWithGWLBFunc mocks the EnableGatewayFireNetInterfacesWithGWLB method.
+	EnableGatewayFireNetInterfacesWithGWLBFunc func(gateway *TransitVpc) error
+
+	// EnableGatewayGroupSNatFunc mocks the EnableGatewayGroupSNat method.
+	EnableGatewayGroupSNatFunc func(ctx context.Context, groupName string) error
+
+	// EnableGatewayGroupVpcDNSServerFunc mocks the EnableGatewayGroupVpcDNSServer method.
+	EnableGatewayGroupVpcDNSServerFunc func(ctx context.Context, groupName string) error
+
+	// EnableGatewayLoadBalancerGatewayGroupFunc mocks the EnableGatewayLoadBalancerGatewayGroup method.
+	EnableGatewayLoadBalancerGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableGlobalVpcFunc mocks the EnableGlobalVpc method.
+	EnableGlobalVpcFunc func(gateway *Gateway) error
+
+	// EnableGlobalVpcGatewayGroupFunc mocks the EnableGlobalVpcGatewayGroup method.
+	EnableGlobalVpcGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableGroGsoFunc mocks the EnableGroGso method.
+	EnableGroGsoFunc func(gateway *Gateway) error
+
+	// EnableGroGsoGatewayGroupFunc mocks the EnableGroGsoGatewayGroup method.
+	EnableGroGsoGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableGuardDutyEnforcementFunc mocks the EnableGuardDutyEnforcement method.
+	EnableGuardDutyEnforcementFunc func(gateway *Gateway) error
+
+	// EnableHybridConnectionGatewayGroupFunc mocks the EnableHybridConnectionGatewayGroup method.
+	EnableHybridConnectionGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableIPv6Func mocks the EnableIPv6 method.
+	EnableIPv6Func func(gateway *Gateway) error
+
+	// EnableIPv6GatewayGroupFunc mocks the EnableIPv6GatewayGroup method.
+	EnableIPv6GatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableJumboFrameFunc mocks the EnableJumboFrame method.
+	EnableJumboFrameFunc func(gateway *Gateway) error
+
+	// EnableJumboFrameExternalDeviceConnFunc mocks the EnableJumboFrameExternalDeviceConn method.
+	EnableJumboFrameExternalDeviceConnFunc func(externalDeviceConn *ExternalDeviceConn) error
+
+	// EnableJumboFrameGatewayGroupFunc mocks the EnableJumboFrameGatewayGroup method.
+	EnableJumboFrameGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableMonitorGatewaySubnetsFunc mocks the EnableMonitorGatewaySubnets method.
+	EnableMonitorGatewaySubnetsFunc func(gwName string, excludedInstances []string) error
+
+	// EnableMultiTierTransitGatewayGroupFunc mocks the EnableMultiTierTransitGatewayGroup method.
+	EnableMultiTierTransitGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableMultitierTransitFunc mocks the EnableMultitierTransit method.
+	EnableMultitierTransitFunc func(gwName string) error
+
+	// EnablePeeringHaGatewayFunc mocks the EnablePeeringHaGateway method.
+	EnablePeeringHaGatewayFunc func(gateway *Gateway) error
+
+	// EnablePrivateVpcDefaultRouteFunc mocks the EnablePrivateVpcDefaultRoute method.
+	EnablePrivateVpcDefaultRouteFunc func(gw *Gateway) error
+
+	// EnablePrivateVpcDefaultRouteGatewayGroupFunc mocks the EnablePrivateVpcDefaultRouteGatewayGroup method.
+	EnablePrivateVpcDefaultRouteGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnablePublicSubnetFilteringHAGatewayFunc mocks the EnablePublicSubnetFilteringHAGateway method.
+	EnablePublicSubnetFilteringHAGatewayFunc func(gateway *Gateway) error
+
+	// EnableS2CRxBalancingFunc mocks the EnableS2CRxBalancing method.
+	EnableS2CRxBalancingFunc func(gwName string) error
+
+	// EnableS2cRxBalancingGatewayGroupFunc mocks the EnableS2cRxBalancingGatewayGroup method.
+	EnableS2cRxBalancingGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableSNatFunc mocks the EnableSNat method.
+	EnableSNatFunc func(gateway *Gateway) error
+
+	// EnableSegmentationFunc mocks the EnableSegmentation method.
+	EnableSegmentationFunc func(transitGateway *TransitVpc) error
+
+	// EnableSegmentationGatewayGroupFunc mocks the EnableSegmentationGatewayGroup method.
+	EnableSegmentationGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableSingleAZGatewayFunc mocks the EnableSingleAZGateway method.
+	EnableSingleAZGatewayFunc func(gateway *Gateway) error
+
+	// EnableSite2CloudEventTriggeredHAFunc mocks the EnableSite2CloudEventTriggeredHA method.
+	EnableSite2CloudEventTriggeredHAFunc func(vpcID string, connectionName string) error
+
+	// EnableSite2cloudActiveActiveFunc mocks the EnableSite2cloudActiveActive method.
+	EnableSite2cloudActiveActiveFunc func(site2cloud *Site2Cloud) error
+
+	// EnableSite2cloudActiveActiveContextFunc mocks the EnableSite2cloudActiveActiveContext method.
+	EnableSite2cloudActiveActiveContextFunc func(ctx context.Context, site2cloud *Site2Cloud) error
+
+	// EnableSkipPublicRouteUpdateFunc mocks the EnableSkipPublicRouteUpdate method.
+	EnableSkipPublicRouteUpdateFunc func(gw *Gateway) error
+
+	// EnableSkipPublicRouteUpdateGatewayGroupFunc mocks the EnableSkipPublicRouteUpdateGatewayGroup method.
+	EnableSkipPublicRouteUpdateGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableSpokeLearnedCidrsApprovalFunc mocks the EnableSpokeLearnedCidrsApproval method.
+	EnableSpokeLearnedCidrsApprovalFunc func(gateway *SpokeVpc) error
+
+	// EnableSpokeLearnedCidrsApprovalGatewayGroupFunc mocks the EnableSpokeLearnedCidrsApprovalGatewayGroup method.
+	EnableSpokeLearnedCidrsApprovalGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableSpokeMappedSite2CloudForwardingFunc mocks the EnableSpokeMappedSite2CloudForwarding method.
+	EnableSpokeMappedSite2CloudForwardingFunc func(site2cloud *Site2Cloud) error
+
+	// EnableSpokeOnpremRoutePropagationFunc mocks the EnableSpokeOnpremRoutePropagation method.
+	EnableSpokeOnpremRoutePropagationFunc func(spokeGateway *SpokeVpc) error
+
+	// EnableSpokeOnpremRoutePropagationGatewayGroupFunc mocks the EnableSpokeOnpremRoutePropagationGatewayGroup method.
+	EnableSpokeOnpremRoutePropagationGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableSpokePreserveAsPathFunc mocks the EnableSpokePreserveAsPath method.
+	EnableSpokePreserveAsPathFunc func(spokeGateway *SpokeVpc) error
+
+	// EnableSpokePreserveAsPathGatewayGroupFunc mocks the EnableSpokePreserveAsPathGatewayGroup method.
+	EnableSpokePreserveAsPathGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableSummarizeCidrToTgwFunc mocks the EnableSummarizeCidrToTgw method.
+	EnableSummarizeCidrToTgwFunc func(gwName string) error
+
+	// EnableTransitFireNetFunc mocks the EnableTransitFireNet method.
+	EnableTransitFireNetFunc func(gateway *Gateway) error
+
+	// EnableTransitFireNetGatewayGroupFunc mocks the EnableTransitFireNetGatewayGroup method.
+	EnableTransitFireNetGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableTransitFireNetWithGWLBFunc mocks the EnableTransitFireNetWithGWLB method.
+	EnableTransitFireNetWithGWLBFunc func(gateway *Gateway) error
+
+	// EnableTransitFireNetWithGWLBGatewayGroupFunc mocks the EnableTransitFireNetWithGWLBGatewayGroup method.
+	EnableTransitFireNetWithGWLBGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableTransitLearnedCidrsApprovalFunc mocks the EnableTransitLearnedCidrsApproval method.
+	EnableTransitLearnedCidrsApprovalFunc func(gateway *TransitVpc) error
+
+	// EnableTransitLearnedCidrsApprovalGatewayGroupFunc mocks the EnableTransitLearnedCidrsApprovalGatewayGroup method.
+	EnableTransitLearnedCidrsApprovalGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableTransitPreserveAsPathFunc mocks the EnableTransitPreserveAsPath method.
+	EnableTransitPreserveAsPathFunc func(transitGateway *TransitVpc) error
+
+	// EnableTransitPreserveAsPathGatewayGroupFunc mocks the EnableTransitPreserveAsPathGatewayGroup method.
+	EnableTransitPreserveAsPathGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableTransitSummarizeCidrToTgwGatewayGroupFunc mocks the EnableTransitSummarizeCidrToTgwGatewayGroup method.
+	EnableTransitSummarizeCidrToTgwGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableVPNConfigFunc mocks the EnableVPNConfig method.
+	EnableVPNConfigFunc func(gateway *Gateway, vpnConfig *VPNConfig) error
+
+	// EnableVpcDNSServerFunc mocks the EnableVpcDNSServer method.
+	EnableVpcDNSServerFunc func(gateway *Gateway) error
+
+	// EnableVpnNatFunc mocks the EnableVpnNat method.
+	EnableVpnNatFunc func(gateway *Gateway) error
+
+	// GetAccountFunc mocks the GetAccount method.
+	GetAccountFunc func(account *Account) (Account, error)
+
+	// GetAccountUserFunc mocks the GetAccountUser method.
+	GetAccountUserFunc func(user *AccountUser) (*AccountUser, error)
+
+	// GetBgpLanIPListFunc mocks the GetBgpLanIPList method.
+	GetBgpLanIPListFunc func(transitGateway *TransitVpc) (*TransitGatewayBgpLanIpInfo, error)
+
+	// GetCIDFunc mocks the GetCID method.
+	GetCIDFunc func() string
+
+	// GetCaCertificateFunc mocks the GetCaCertificate method.
+	GetCaCertificateFunc func(ctx context.Context) (*ProxyCaConfig, error)
+
+	// GetCompatibleImageVersionFunc mocks the GetCompatibleImageVersion method.
+	GetCompatibleImageVersionFunc func(ctx context.Context, cloudType int, softwareVersion string) (string, error)
+
+	// GetControllerIPFunc mocks the GetControllerIP method.
+	GetControllerIPFunc func() string
+
+	// GetDCFAttachmentPointFunc mocks the GetDCFAttachmentPoint method.
+	GetDCFAttachmentPointFunc func(ctx context.Context, name string) (*AttachmentPointResp, error)
+
+	// GetDCFMitmCaFunc mocks the GetDCFMitmCa method.
+	GetDCFMitmCaFunc func(ctx context.Context, caID string) (*MitmCaResponse, error)
+
+	// GetDCFPolicyBlockFunc mocks the GetDCFPolicyBlock method.
+	GetDCFPolicyBlockFunc func(ctx context.Context, uuid string) (*DCFPolicyBlock, error)
+
+	// GetDCFPolicyListFunc mocks the GetDCFPolicyList method.
+	GetDCFPolicyListFunc func(ctx context.Context, uuid string) (*DCFPolicyList, error)
+
+	// GetDCFTrustBundleByIDFunc mocks the GetDCFTrustBundleByID method.
+	GetDCFTrustBundleByIDFunc func(ctx context.Context, bundleUUID string) (*DCFTrustBundle, error)
+
+	// GetDCFTrustBundleByNameFunc mocks the GetDCFTrustBundleByName method.
+	GetDCFTrustBundleByNameFunc func(ctx context.Context, bundleName string) (*DCFTrustBundle, error)
+
+	// GetDefaultIpsProfileFunc mocks the GetDefaultIpsProfile method.
+	GetDefaultIpsProfileFunc func(ctx context.Context) (*DefaultIpsProfileResponse, error)
+
+	// GetDefaultTagsConfigFunc mocks the GetDefaultTagsConfig method.
+	GetDefaultTagsConfigFunc func() *DefaultTagsConfig
+
+	// GetDistributedFirewallingDefaultActionRuleFunc mocks the GetDistributedFirewallingDefaultActionRule method.
+	GetDistributedFirewallingDefaultActionRuleFunc func(ctx context.Context) (*DistributedFirewallingDefaultActionRule, error)
+
+	// GetDistributedFirewallingDeploymentPolicyFunc mocks the GetDistributedFirewallingDeploymentPolicy method.
+	GetDistributedFirewallingDeploymentPolicyFunc func(ctx context.Context) (*DistributedFirewallingDeploymentPolicy, error)
+
+	// GetDistributedFirewallingIntraVpcFunc mocks the GetDistributedFirewallingIntraVpc method.
+	GetDistributedFirewallingIntraVpcFunc func(ctx context.Context) (*DistributedFirewallingIntraVpcList, error)
+
+	// GetDistributedFirewallingPolicyListFunc mocks the GetDistributedFirewallingPolicyList method.
+	GetDistributedFirewallingPolicyListFunc func(ctx context.Context) (*DistributedFirewallingPolicyList, error)
+
+	// GetDistributedFirewallingStatusFunc mocks the GetDistributedFirewallingStatus method.
+	GetDistributedFirewallingStatusFunc func(ctx context.Context) (*DistributedFirewallingConfig, error)
+
+	// GetEdgeCSPFunc mocks the GetEdgeCSP method.
+	GetEdgeCSPFunc func(ctx context.Context, gwName string) (*EdgeCSPResp, error)
+
+	// GetEdgeCSPHaFunc mocks the GetEdgeCSPHa method.
+	GetEdgeCSPHaFunc func(ctx context.Context, gwName string) (*EdgeCSPHaResp, error)
+
+	// GetEdgeEquinixFunc mocks the GetEdgeEquinix method.
+	GetEdgeEquinixFunc func(ctx context.Context, gwName string) (*EdgeEquinixResp, error)
+
+	// GetEdgeEquinixHaFunc mocks the GetEdgeEquinixHa method.
+	GetEdgeEquinixHaFunc func(ctx context.Context, gwName string) (*EdgeEquinixHaResp, error)
+
+	// GetEdgeGatewayWanIpFunc mocks the GetEdgeGatewayWanIp method.
+	GetEdgeGatewayWanIpFunc func(ctx context.Context, gwName string, wanInterfaceName string) (string, error)
+
+	// GetEdgeMegaportFunc mocks the GetEdgeMegaport method.
+	GetEdgeMegaportFunc func(ctx context.Context, gwName string) (*EdgeMegaportResp, error)
+
+	// GetEdgeMegaportHaFunc mocks the GetEdgeMegaportHa method.
+	GetEdgeMegaportHaFunc func(ctx context.Context, gwName string) (*EdgeMegaportHaResp, error)
+
+	// GetEdgeNEOFunc mocks the GetEdgeNEO method.
+	GetEdgeNEOFunc func(ctx context.Context, gwName string) (*EdgeNEOResp, error)
+
+	// GetEdgeNEODeviceFunc mocks the GetEdgeNEODevice method.
+	GetEdgeNEODeviceFunc func(ctx context.Context, accountName string, deviceName string) (*EdgeNEODeviceResp, error)
+
+	// GetEdgeNEOHaFunc mocks the GetEdgeNEOHa method.
+	GetEdgeNEOHaFunc func(ctx context.Context, gwName string) (*EdgeNEOHaResp, error)
+
+	// GetEdgePlatformProxyProfileFunc mocks the GetEdgePlatformProxyProfile method.
+	GetEdgePlatformProxyProfileFunc func(ctx context.Context, accountName string, profileName string) (*EdgePlatformProxyProfileResp, error)
+
+	// GetEdgeSpokeFunc mocks the GetEdgeSpoke method.
+	GetEdgeSpokeFunc func(ctx context.Context, gwName string) (*EdgeSpokeResp, error)
+
+	// GetEdgeSpokeTransitAttachmentFunc mocks the GetEdgeSpokeTransitAttachment method.
+	GetEdgeSpokeTransitAttachmentFunc func(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) (*SpokeTransitAttachment, error)
+
+	// GetEdgeVmSelfmanagedHaFunc mocks the GetEdgeVmSelfmanagedHa method.
+	GetEdgeVmSelfmanagedHaFunc func(ctx context.Context, gwName string) (*EdgeVmSelfmanagedHaResp, error)
+
+	// GetEnforcementLevelFunc mocks the GetEnforcementLevel method.
+	GetEnforcementLevelFunc func(ctx context.Context) (*EnforcementLevel, error)
+
+	// GetExternalDeviceConnDetailFunc mocks the GetExternalDeviceConnDetail method.
+	GetExternalDeviceConnDetailFunc func(externalDeviceConn *ExternalDeviceConn, localGateway *Gateway, priorHAEnabled bool) (*ExternalDeviceConn, error)
+
+	// GetGatewayFunc mocks the GetGateway method.
+	GetGatewayFunc func(gateway *Gateway) (*Gateway, error)
+
+	// GetGatewayBgpCommunitiesFunc mocks the GetGatewayBgpCommunities method.
+	GetGatewayBgpCommunitiesFunc func(gwName string) (bool, bool, error)
+
+	// GetGatewayBgpMedToSdnMetricFunc mocks the GetGatewayBgpMedToSdnMetric method.
+	GetGatewayBgpMedToSdnMetricFunc func(gwName string) (bool, error)
+
+	// GetGatewayContextFunc mocks the GetGatewayContext method.
+	GetGatewayContextFunc func(ctx context.Context, gateway *Gateway) (*Gateway, error)
+
+	// GetGatewayDetailFunc mocks the GetGatewayDetail method.
+	GetGatewayDetailFunc func(gateway *Gateway) (*GatewayDetail, error)
+
+	// GetGatewayGroupFunc mocks the GetGatewayGroup method.
+	GetGatewayGroupFunc func(ctx context.Context, groupUUID string) (*GatewayGroup, error)
+
+	// GetGatewayGroupByNameFunc mocks the GetGatewayGroupByName method.
+	GetGatewayGroupByNameFunc func(ctx context.Context, groupName string) (*GatewayGroup, error)
+
+	// GetGeoVPNNameFunc mocks the GetGeoVPNName method.
+	GetGeoVPNNameFunc func(gateway *Gateway) (*GeoVPN, error)
+
+	// GetGroGsoStatusFunc mocks the GetGroGsoStatus method.
+	GetGroGsoStatusFunc func(gateway *Gateway) (bool, error)
+
+	// GetIgnoreTagsConfigFunc mocks the GetIgnoreTagsConfig method.
+	GetIgnoreTagsConfigFunc func() *IgnoreTagsConfig
+
+	// GetIpsProfileFunc mocks the GetIpsProfile method.
+	GetIpsProfileFunc func(ctx context.Context, uuid string) (*IpsProfile, error)
+
+	// GetIpsRuleFeedFunc mocks the GetIpsRuleFeed method.
+	GetIpsRuleFeedFunc func(ctx context.Context, uuid string) (*IpsRuleFeed, error)
+
+	// GetLogProfileByNameFunc mocks the GetLogProfileByName method.
+	GetLogProfileByNameFunc func(ctx context.Context, profileName string) (*LogProfile, error)
+
+	// GetMetaCaCertificateFunc mocks the GetMetaCaCertificate method.
+	GetMetaCaCertificateFunc func(ctx context.Context) (*ProxyCaCertInstance, error)
+
+	// GetS2CCaCertTagFunc mocks the GetS2CCaCertTag method.
+	GetS2CCaCertTagFunc func(ctx context.Context, s2cCaCertTag *S2CCaCertTag) (*S2CCaCertTag, error)
+
+	// GetSegmentationSecurityDomainFunc mocks the GetSegmentationSecurityDomain method.
+	GetSegmentationSecurityDomainFunc func(domain *SegmentationSecurityDomain) (*SegmentationSecurityDomain, error)
+
+	// GetSegmentationSecurityDomainAssociationFunc mocks the GetSegmentationSecurityDomainAssociation method.
+	GetSegmentationSecurityDomainAssociationFunc func(association *SegmentationSecurityDomainAssociation) (*SegmentationSecurityDomainAssociation, error)
+
+	// GetSegmentationSecurityDomainConnectionPolicyFunc mocks the GetSegmentationSecurityDomainConnectionPolicy method.
+	GetSegmentationSecurityDomainConnectionPolicyFunc func(policy *SegmentationSecurityDomainConnectionPolicy) (*SegmentationSecurityDomainConnectionPolicy, error)
+
+	// GetSite2CloudConnDetailFunc mocks the GetSite2CloudConnDetail method.
+	GetSite2CloudConnDetailFunc func(site2cloud *Site2Cloud) (*Site2Cloud, error)
+
+	// GetSmartGroupFunc mocks the GetSmartGroup method.
+	GetSmartGroupFunc func(ctx context.Context, uuid string) (*SmartGroup, error)
+
+	// GetSmartGroupsFunc mocks the GetSmartGroups method.
+	GetSmartGroupsFunc func(ctx context.Context) ([]*SmartGroup, error)
+
+	// GetSplitTunnelFunc mocks the GetSplitTunnel method.
+	GetSplitTunnelFunc func(splitTunnel *SplitTunnel) (*SplitTunnelUnit, error)
+
+	// GetSpokeGatewayAdvancedConfigFunc mocks the GetSpokeGatewayAdvancedConfig method.
+	GetSpokeGatewayAdvancedConfigFunc func(spokeGateway *SpokeVpc) (*SpokeGatewayAdvancedConfig, error)
+
+	// GetSpokeGatewayListFunc mocks the GetSpokeGatewayList method.
+	GetSpokeGatewayListFunc func(ctx context.Context) ([]Gateway, error)
+
+	// GetSpokeGatewaySubnetGroupFunc mocks the GetSpokeGatewaySubnetGroup method.
+	GetSpokeGatewaySubnetGroupFunc func(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error
+
+	// GetTLSProfileFunc mocks the GetTLSProfile method.
+	GetTLSProfileFunc func(ctx context.Context, uuidStr string) (*TLSProfileWithID, error)
+
+	// GetTLSProfileByNameFunc mocks the GetTLSProfileByName method.
+	GetTLSProfileByNameFunc func(ctx context.Context, displayName string) (*TLSProfileWithID, error)
+
+	// GetTagsFunc mocks the GetTags method.
+	GetTagsFunc func(tags *Tags) ([]string, error)
+
+	// GetTransitGatewayAdvancedConfigFunc mocks the GetTransitGatewayAdvancedConfig method.
+	GetTransitGatewayAdvancedConfigFunc func(transitGateway *TransitVpc) (*TransitGatewayAdvancedConfig, error)
+
+	// GetTransitGatewayLanCidrFunc mocks the GetTransitGatewayLanCidr method.
+	GetTransitGatewayLanCidrFunc func(gatewayName string) (string, error)
+
+	// GetTransitGatewayListFunc mocks the GetTransitGatewayList method.
+	GetTransitGatewayListFunc func(ctx context.Context) ([]Gateway, error)
+
+	// GetTunnelDetectionTimeFunc mocks the GetTunnelDetectionTime method.
+	GetTunnelDetectionTimeFunc func(entity string) (int, error)
+
+	// GetWebGroupFunc mocks the GetWebGroup method.
+	GetWebGroupFunc func(ctx context.Context, uuid string) (*WebGroup, error)
+
+	// GetWebGroupByNameFunc mocks the GetWebGroupByName method.
+	GetWebGroupByNameFunc func(ctx context.Context, name string) (*WebGroup, error)
+
+	// InvalidateCacheFunc mocks the InvalidateCache method.
+	InvalidateCacheFunc func()
+
+	// IsTransitFireNetReadyToBeDisabledFunc mocks the IsTransitFireNetReadyToBeDisabled method.
+	IsTransitFireNetReadyToBeDisabledFunc func(gateway *Gateway) error
+
+	// LaunchSpokeVpcContextFunc mocks the LaunchSpokeVpcContext method.
+	LaunchSpokeVpcContextFunc func(ctx context.Context, spoke *SpokeVpc) error
+
+	// LaunchTransitVpcContextFunc mocks the LaunchTransitVpcContext method.
+	LaunchTransitVpcContextFunc func(ctx context.Context, gateway *TransitVpc) error
+
+	// ListDCFMitmCaFunc mocks the ListDCFMitmCa method.
+	ListDCFMitmCaFunc func(ctx context.Context) (*MitmCaListResponse, error)
+
+	// ListGatewayRegionsFunc mocks the ListGatewayRegions method.
+	ListGatewayRegionsFunc func(ctx context.Context, cloudType int, accountName string) ([]string, error)
+
+	// ListGatewaySizesFunc mocks the ListGatewaySizes method.
+	ListGatewaySizesFunc func(ctx context.Context, cloudType int, accountName string, region string) ([]string, error)
+
+	// ListSegmentationSecurityDomainsFunc mocks the ListSegmentationSecurityDomains method.
+	ListSegmentationSecurityDomainsFunc func() ([]string, error)
+
+	// ListSite2CloudContextFunc mocks the ListSite2CloudContext method.
+	ListSite2CloudContextFunc func(ctx context.Context) ([]Site2Cloud, error)
+
+	// ModifySplitTunnelFunc mocks the ModifySplitTunnel method.
+	ModifySplitTunnelFunc func(splitTunnel *SplitTunnel) error
+
+	// ModifyTunnelDetectionTimeFunc mocks the ModifyTunnelDetectionTime method.
+	ModifyTunnelDetectionTimeFunc func(entity string, detectionTime int) error
+
+	// OnboardEdgeNEODeviceFunc mocks the OnboardEdgeNEODevice method.
+	OnboardEdgeNEODeviceFunc func(ctx context.Context, edgeNEODevice *EdgeNEODevice) error
+
+	// PostAPIFunc mocks the PostAPI method.
+	PostAPIFunc func(action string, d interface{}, checkFunc CheckAPIResponseFunc) error
+
+	// RefreshDCFMitmSysatemCAFunc mocks the RefreshDCFMitmSysatemCA method.
+	RefreshDCFMitmSysatemCAFunc func(ctx context.Context) error
+
+	// SetBgpBfdPollingTimeFunc mocks the SetBgpBfdPollingTime method.
+	SetBgpBfdPollingTimeFunc func(transitGateway *TransitVpc, newPollingTime int) error
+
+	// SetBgpBfdPollingTimeGatewayGroupFunc mocks the SetBgpBfdPollingTimeGatewayGroup method.
+	SetBgpBfdPollingTimeGatewayGroupFunc func(ctx context.Context, groupName string, pollingTime int) error
+
+	// SetBgpBfdPollingTimeSpokeFunc mocks the SetBgpBfdPollingTimeSpoke method.
+	SetBgpBfdPollingTimeSpokeFunc func(spokeGateway *SpokeVpc, newPollingTime int) error
+
+	// SetBgpEcmpFunc mocks the SetBgpEcmp method.
+	SetBgpEcmpFunc func(transitGateway *TransitVpc, enabled bool) error
+
+	// SetBgpEcmpGatewayGroupFunc mocks the SetBgpEcmpGatewayGroup method.
+	SetBgpEcmpGatewayGroupFunc func(ctx context.Context, groupName string, enable bool) error
+
+	// SetBgpEcmpSpokeFunc mocks the SetBgpEcmpSpoke method.
+	SetBgpEcmpSpokeFunc func(spokeGateway *SpokeVpc, enabled bool) error
+
+	// SetBgpManualSpokeAdvertisedNetworksFunc mocks the SetBgpManualSpokeAdvertisedNetworks method.
+	SetBgpManualSpokeAdvertisedNetworksFunc func(transitGw *TransitVpc) error
+
+	// SetBgpPollingTimeFunc mocks the SetBgpPollingTime method.
+	SetBgpPollingTimeFunc func(transitGateway *TransitVpc, newPollingTime int) error
+
+	// SetBgpPollingTimeGatewayGroupFunc mocks the SetBgpPollingTimeGatewayGroup method.
+	SetBgpPollingTimeGatewayGroupFunc func(ctx context.Context, groupName string, pollingTime int) error
+
+	// SetBgpPollingTimeSpokeFunc mocks the SetBgpPollingTimeSpoke method.
+	SetBgpPollingTimeSpokeFunc func(spokeGateway *SpokeVpc, newPollingTime int) error
+
+	// SetDefaultIpsProfileFunc mocks the SetDefaultIpsProfile method.
+	SetDefaultIpsProfileFunc func(ctx context.Context, profiles []string) (*DefaultIpsProfileResponse, error)
+
+	// SetEnforcementLevelFunc mocks the SetEnforcementLevel method.
+	SetEnforcementLevelFunc func(ctx context.Context, enforcementLevel *EnforcementLevel) error
+
+	// SetGatewayBgpCommunitiesAcceptFunc mocks the SetGatewayBgpCommunitiesAccept method.
+	SetGatewayBgpCommunitiesAcceptFunc func(gwName string, acceptComm bool) error
+
+	// SetGatewayBgpCommunitiesSendFunc mocks the SetGatewayBgpCommunitiesSend method.
+	SetGatewayBgpCommunitiesSendFunc func(gwName string, sendComm bool) error
+
+	// SetGatewayBgpMedToSdnMetricFunc mocks the SetGatewayBgpMedToSdnMetric method.
+	SetGatewayBgpMedToSdnMetricFunc func(gwName string, override bool) error
+
+	// SetGatewayGroupBgpCommunitiesAcceptFunc mocks the SetGatewayGroupBgpCommunitiesAccept method.
+	SetGatewayGroupBgpCommunitiesAcceptFunc func(ctx context.Context, groupName string, accept bool) error
+
+	// SetGatewayGroupBgpCommunitiesSendFunc mocks the SetGatewayGroupBgpCommunitiesSend method.
+	SetGatewayGroupBgpCommunitiesSendFunc func(ctx context.Context, groupName string, send bool) error
+
+	// SetGatewayPhase2PolicyFunc mocks the SetGatewayPhase2Policy method.
+	SetGatewayPhase2PolicyFunc func(gwName string, encPolicy string, pfsPolicy string) error
+
+	// SetLocalASNumberFunc mocks the SetLocalASNumber method.
+	SetLocalASNumberFunc func(transitGateway *TransitVpc, localASNumber string) error
+
+	// SetLocalASNumberGatewayGroupFunc mocks the SetLocalASNumberGatewayGroup method.
+	SetLocalASNumberGatewayGroupFunc func(ctx context.Context, groupName string, localAsNumber string) error
+
+	// SetLocalASNumberSpokeFunc mocks the SetLocalASNumberSpoke method.
+	SetLocalASNumberSpokeFunc func(spokeGateway *SpokeVpc, localASNumber string) error
+
+	// SetNewCertificateFunc mocks the SetNewCertificate method.
+	SetNewCertificateFunc func(ctx context.Context, proxyCaConfig *ProxyCaConfig) error
+
+	// SetPrependASPathFunc mocks the SetPrependASPath method.
+	SetPrependASPathFunc func(transitGateway *TransitVpc, prependASPath []string) error
+
+	// SetPrependASPathGatewayGroupFunc mocks the SetPrependASPathGatewayGroup method.
+	SetPrependASPathGatewayGroupFunc func(ctx context.Context, groupName string, prependAsPath []string) error
+
+	// SetPrependASPathSpokeFunc mocks the SetPrependASPathSpoke method.
+	SetPrependASPathSpokeFunc func(spokeGateway *SpokeVpc, prependASPath []string) error
+
+	// SetRxQueueSizeFunc mocks the SetRxQueueSize method.
+	SetRxQueueSizeFunc func(gateway *Gateway) error
+
+	// SetSpokeBgpManualAdvertisedNetworksFunc mocks the SetSpokeBgpManualAdvertisedNetworks method.
+	SetSpokeBgpManualAdvertisedNetworksFunc func(spokeGateway *SpokeVpc) error
+
+	// SetSpokeBgpManualAdvertisedNetworksGatewayGroupFunc mocks the SetSpokeBgpManualAdvertisedNetworksGatewayGroup method.
+	SetSpokeBgpManualAdvertisedNetworksGatewayGroupFunc func(ctx context.Context, groupName string, cidrs string) error
+
+	// SetSymmetricRoutingGatewayGroupFunc mocks the SetSymmetricRoutingGatewayGroup method.
+	SetSymmetricRoutingGatewayGroupFunc func(ctx context.Context, groupUUID string, enable bool) error
+
+	// SetTransitLearnedCIDRsApprovalModeFunc mocks the SetTransitLearnedCIDRsApprovalMode method.
+	SetTransitLearnedCIDRsApprovalModeFunc func(gw *TransitVpc, mode string) error
+
+	// SetVpnGatewayAuthenticationFunc mocks the SetVpnGatewayAuthentication method.
+	SetVpnGatewayAuthenticationFunc func(gateway *VpnGatewayAuth) error
+
+	// UpdateAccountFunc mocks the UpdateAccount method.
+	UpdateAccountFunc func(account *Account) error
+
+	// UpdateAccountUserObjectFunc mocks the UpdateAccountUserObject method.
+	UpdateAccountUserObjectFunc func(user *AccountUserEdit) error
+
+	// UpdateDCFMitmCaFunc mocks the UpdateDCFMitmCa method.
+	UpdateDCFMitmCaFunc func(ctx context.Context, caID string, patchRequest *MitmCaPatchRequest) (*MitmCaResponse, error)
+
+	// UpdateDCFPolicyBlockFunc mocks the UpdateDCFPolicyBlock method.
+	UpdateDCFPolicyBlockFunc func(ctx context.Context, policyBlock *DCFPolicyBlock) error
+
+	// UpdateDCFPolicyListFunc mocks the UpdateDCFPolicyList method.
+	UpdateDCFPolicyListFunc func(ctx context.Context, policyList *DCFPolicyList) error
+
+	// UpdateDCFTrustBundleFunc mocks the UpdateDCFTrustBundle method.
+	UpdateDCFTrustBundleFunc func(ctx context.Context, bundleUUID string, trustBundle *TrustBundleItemRequest) error
+
+	// UpdateDNatFunc mocks the UpdateDNat method.
+	UpdateDNatFunc func(gateway *Gateway) error
+
+	// UpdateDistributedFirewallingDefaultActionRuleFunc mocks the UpdateDistributedFirewallingDefaultActionRule method.
+	UpdateDistributedFirewallingDefaultActionRuleFunc func(ctx context.Context, request *DistributedFirewallingDefaultActionRule) error
+
+	// UpdateDistributedFirewallingPolicyListFunc mocks the UpdateDistributedFirewallingPolicyList method.
+	UpdateDistributedFirewallingPolicyListFunc func(ctx context.Context, policyList *DistributedFirewallingPolicyList) error
+
+	// UpdateEdgeAccountFunc mocks the UpdateEdgeAccount method.
+	UpdateEdgeAccountFunc func(edgeAccount *EdgeAccount) error
+
+	// UpdateEdgeCSPFunc mocks the UpdateEdgeCSP method.
+	UpdateEdgeCSPFunc func(ctx context.Context, edgeCSP *EdgeCSP) error
+
+	// UpdateEdgeCSPHaFunc mocks the UpdateEdgeCSPHa method.
+	UpdateEdgeCSPHaFunc func(ctx context.Context, edgeCSP *EdgeCSP) error
+
+	// UpdateEdgeEquinixFunc mocks the UpdateEdgeEquinix method.
+	UpdateEdgeEquinixFunc func(ctx context.Context, edgeEquinix *EdgeEquinix) error
+
+	// UpdateEdgeEquinixHaFunc mocks the UpdateEdgeEquinixHa method.
+	UpdateEdgeEquinixHaFunc func(ctx context.Context, edgeEquinix *EdgeEquinix) error
+
+	// UpdateEdgeGatewayFunc mocks the UpdateEdgeGateway method.
+	UpdateEdgeGatewayFunc func(gateway *TransitVpc) error
+
+	// UpdateEdgeGatewayV2Func mocks the UpdateEdgeGatewayV2 method.
+	UpdateEdgeGatewayV2Func func(ctx context.Context, gateway *TransitVpc) error
+
+	// UpdateEdgeMegaportFunc mocks the UpdateEdgeMegaport method.
+	UpdateEdgeMegaportFunc func(ctx context.Context, edgeMegaport *EdgeMegaport) error
+
+	// UpdateEdgeMegaportHaFunc mocks the UpdateEdgeMegaportHa method.
+	UpdateEdgeMegaportHaFunc func(ctx context.Context, edgeMegaport *EdgeMegaport) error
+
+	// UpdateEdgeNEOFunc mocks the UpdateEdgeNEO method.
+	UpdateEdgeNEOFunc func(ctx context.Context, edgeNEO *EdgeNEO) error
+
+	// UpdateEdgeNEODeviceFunc mocks the UpdateEdgeNEODevice method.
+	UpdateEdgeNEODeviceFunc func(ctx context.Context, edgeNEODevice *EdgeNEODevice) error
+
+	// UpdateEdgeNEOHaFunc mocks the UpdateEdgeNEOHa method.
+	UpdateEdgeNEOHaFunc func(ctx context.Context, edgeNEO *EdgeNEO) error
+
+	// UpdateEdgeProxyProfileFunc mocks the UpdateEdgeProxyProfile method.
+	UpdateEdgeProxyProfileFunc func(ctx context.Context, edgeNEOProxyProfile *EdgePlatformProxyProfileUpdate) error
+
+	// UpdateEdgeSpokeFunc mocks the UpdateEdgeSpoke method.
+	UpdateEdgeSpokeFunc func(ctx context.Context, edgeSpoke *EdgeSpoke) error
+
+	// UpdateEdgeSpokeGeoCoordinateFunc mocks the UpdateEdgeSpokeGeoCoordinate method.
+	UpdateEdgeSpokeGeoCoordinateFunc func(ctx context.Context, edgeSpoke *EdgeSpoke) error
+
+	// UpdateEdgeSpokeTransitPeeringTunnelCountFunc mocks the UpdateEdgeSpokeTransitPeeringTunnelCount method.
+	UpdateEdgeSpokeTransitPeeringTunnelCountFunc func(gateway1 string, gateway2 string, tunnelCount int) error
+
+	// UpdateEdgeVmSelfmanagedHaFunc mocks the UpdateEdgeVmSelfmanagedHa method.
+	UpdateEdgeVmSelfmanagedHaFunc func(ctx context.Context, edgeVmSelfmanaged *EdgeSpoke) error
+
+	// UpdateEnforcementLevelFunc mocks the UpdateEnforcementLevel method.
+	UpdateEnforcementLevelFunc func(ctx context.Context, enforcementLevel *EnforcementLevel) error
+
+	// UpdateGCPAccountFunc mocks the UpdateGCPAccount method.
+	UpdateGCPAccountFunc func(account *Account) error
+
+	// UpdateGatewayFunc mocks the UpdateGateway method.
+	UpdateGatewayFunc func(gateway *Gateway) error
+
+	// UpdateGatewayContextFunc mocks the UpdateGatewayContext method.
+	UpdateGatewayContextFunc func(ctx context.Context, gateway *Gateway) error
+
+	// UpdateGroupInstanceSizeFunc mocks the UpdateGroupInstanceSize method.
+	UpdateGroupInstanceSizeFunc func(ctx context.Context, groupName string, instanceSize string) error
+
+	// UpdateIpsProfileFunc mocks the UpdateIpsProfile method.
+	UpdateIpsProfileFunc func(ctx context.Context, uuid string, profile *IpsProfile) (*IpsProfile, error)
+
+	// UpdateIpsRuleFeedFunc mocks the UpdateIpsRuleFeed method.
+	UpdateIpsRuleFeedFunc func(ctx context.Context, uuid string, ruleFeed *IpsRuleFeed) (*IpsRuleFeed, error)
+
+	// UpdateMaxVpnConnFunc mocks the UpdateMaxVpnConn method.
+	UpdateMaxVpnConnFunc func(gateway *Gateway) error
+
+	// UpdateSite2CloudFunc mocks the UpdateSite2Cloud method.
+	UpdateSite2CloudFunc func(site2cloud *EditSite2Cloud) error
+
+	// UpdateSmartGroupFunc mocks the UpdateSmartGroup method.
+	UpdateSmartGroupFunc func(ctx context.Context, smartGroup *SmartGroup, uuid string) error
+
+	// UpdateSpokeGatewaySubnetGroupFunc mocks the UpdateSpokeGatewaySubnetGroup method.
+	UpdateSpokeGatewaySubnetGroupFunc func(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error
+
+	// UpdateSpokePendingApprovedCidrsFunc mocks the UpdateSpokePendingApprovedCidrs method.
+	UpdateSpokePendingApprovedCidrsFunc func(gateway *SpokeVpc) error
+
+	// UpdateSpokePendingApprovedCidrsGatewayGroupFunc mocks the UpdateSpokePendingApprovedCidrsGatewayGroup method.
+	UpdateSpokePendingApprovedCidrsGatewayGroupFunc func(ctx context.Context, groupName string, approvedCidrs []string) error
+
+	// UpdateTLSProfileFunc mocks the UpdateTLSProfile method.
+	UpdateTLSProfileFunc func(ctx context.Context, uuid string, tlsProfile *TLSProfile) error
+
+	// UpdateTagsFunc mocks the UpdateTags method.
+	UpdateTagsFunc func(tags *Tags) error
+
+	// UpdateTransitGatewayCustomizedVpcRouteFunc mocks the UpdateTransitGatewayCustomizedVpcRoute method.
+	UpdateTransitGatewayCustomizedVpcRouteFunc func(gateway string, customizedTransitVpcRoutes []string) error
+
+	// UpdateTransitPendingApprovedCidrsFunc mocks the UpdateTransitPendingApprovedCidrs method.
+	UpdateTransitPendingApprovedCidrsFunc func(gateway *TransitVpc) error
+
+	// UpdateTransitPendingApprovedCidrsGatewayGroupFunc mocks the UpdateTransitPendingApprovedCidrsGatewayGroup method.
+	UpdateTransitPendingApprovedCidrsGatewayGroupFunc func(ctx context.Context, groupName string, approvedCidrs []string) error
+
+	// UpdateVpnCidrFunc mocks the UpdateVpnCidr method.
+	UpdateVpnCidrFunc func(gateway *Gateway) error
+
+	// UpdateWebGroupFunc mocks the UpdateWebGroup method.
+	UpdateWebGroupFunc func(ctx context.Context, webGroup *WebGroup, uuid string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddSpokeGatewaySubnetGroup holds details about calls to the AddSpokeGatewaySubnetGroup method.
+		AddSpokeGatewaySubnetGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpokeGatewaySubnetGroup is the spokeGatewaySubnetGroup argument value.
+			SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+		}
+		// AttachTransitGWForHybrid holds details about calls to the AttachTransitGWForHybrid method.
+		AttachTransitGWForHybrid []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// AuditAccount holds details about calls to the AuditAccount method.
+		AuditAccount []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Account is the account argument value.
+			Account *Account
+		}
+		// ChangeBgpHoldTime holds details about calls to the ChangeBgpHoldTime method.
+		ChangeBgpHoldTime []struct {
+			// GwName is the gwName argument value.
+			GwName string
+			// HoldTime is the holdTime argument value.
+			HoldTime int
+		}
+		// ChangeBgpHoldTimeGatewayGroup holds details about calls to the ChangeBgpHoldTimeGatewayGroup method.
+		ChangeBgpHoldTimeGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// HoldTime is the holdTime argument value.
+			HoldTime int
+		}
+		// ChangeBgpOverLanIntfCnt holds details about calls to the ChangeBgpOverLanIntfCnt method.
+		ChangeBgpOverLanIntfCnt []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// CreateAccount holds details about calls to the CreateAccount method.
+		CreateAccount []struct {
+			// Account is the account argument value.
+			Account *Account
+		}
+		// CreateAccountUser holds details about calls to the CreateAccountUser method.
+		CreateAccountUser []struct {
+			// User is the user argument value.
+			User *AccountUser
+		}
+		// CreateDCFMitmCa holds details about calls to the CreateDCFMitmCa method.
+		CreateDCFMitmCa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// MitmCa is the mitmCa argument value.
+			MitmCa *MitmCaItemRequest
+		}
+		// CreateDCFPolicyBlock holds details about calls to the CreateDCFPolicyBlock method.
+		CreateDCFPolicyBlock []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PolicyBlock is the policyBlock argument value.
+			PolicyBlock *DCFPolicyBlock
+		}
+		// CreateDCFPolicyList holds details about calls to the CreateDCFPolicyList method.
+		CreateDCFPolicyList []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PolicyList is the policyList argument value.
+			PolicyList *DCFPolicyList
+		}
+		// CreateDCFTrustBundle holds details about calls to the CreateDCFTrustBundle method.
+		CreateDCFTrustBundle []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TrustBundle is the trustBundle argument value.
+			TrustBundle *TrustBundleItemRequest
+		}
+		// CreateDistributedFirewallingDeploymentPolicy holds details about calls to the CreateDistributedFirewallingDeploymentPolicy method.
+		CreateDistributedFirewallingDeploymentPolicy []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DeploymentPolicy is the deploymentPolicy argument value.
+			DeploymentPolicy *DistributedFirewallingDeploymentPolicy
+		}
+		// CreateDistributedFirewallingIntraVpc holds details about calls to the CreateDistributedFirewallingIntraVpc method.
+		CreateDistributedFirewallingIntraVpc []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// VpcList is the vpcList argument value.
+			VpcList *DistributedFirewallingIntraVpcList
+		}
+		// CreateDistributedFirewallingPolicyList holds details about calls to the CreateDistributedFirewallingPolicyList method.
+		CreateDistributedFirewallingPolicyList []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PolicyList is the policyList argument value.
+			PolicyList *DistributedFirewallingPolicyList
+		}
+		// CreateEdgeAccount holds details about calls to the CreateEdgeAccount method.
+		CreateEdgeAccount []struct {
+			// EdgeAccount is the edgeAccount argument value.
+			EdgeAccount *EdgeAccount
+		}
+		// CreateEdgeCSP holds details about calls to the CreateEdgeCSP method.
+		CreateEdgeCSP []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeCSP is the edgeCSP argument value.
+			EdgeCSP *EdgeCSP
+		}
+		// CreateEdgeCSPHa holds details about calls to the CreateEdgeCSPHa method.
+		CreateEdgeCSPHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeCSPHa is the edgeCSPHa argument value.
+			EdgeCSPHa *EdgeCSPHa
+		}
+		// CreateEdgeEquinix holds details about calls to the CreateEdgeEquinix method.
+		CreateEdgeEquinix []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeEquinix is the edgeEquinix argument value.
+			EdgeEquinix *EdgeEquinix
+		}
+		// CreateEdgeEquinixHa holds details about calls to the CreateEdgeEquinixHa method.
+		CreateEdgeEquinixHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeEquinixHa is the edgeEquinixHa argument value.
+			EdgeEquinixHa *EdgeEquinixHa
+		}
+		// CreateEdgeExternalDeviceConn holds details about calls to the CreateEdgeExternalDeviceConn method.
+		CreateEdgeExternalDeviceConn []struct {
+			// EdgeExternalDeviceConn is the edgeExternalDeviceConn argument value.
+			EdgeExternalDeviceConn *EdgeExternalDeviceConn
+		}
+		// CreateEdgeMegaport holds details about calls to the CreateEdgeMegaport method.
+		CreateEdgeMegaport []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeMegaport is the edgeMegaport argument value.
+			EdgeMegaport *EdgeMegaport
+		}
+		// CreateEdgeMegaportHa holds details about calls to the CreateEdgeMegaportHa method.
+		CreateEdgeMegaportHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeMegaportHa is the edgeMegaportHa argument value.
+			EdgeMegaportHa *EdgeMegaportHa
+		}
+		// CreateEdgeNEO holds details about calls to the CreateEdgeNEO method.
+		CreateEdgeNEO []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeNEO is the edgeNEO argument value.
+			EdgeNEO *EdgeNEO
+		}
+		// CreateEdgeNEOHa holds details about calls to the CreateEdgeNEOHa method.
+		CreateEdgeNEOHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeNEOHa is the edgeNEOHa argument value.
+			EdgeNEOHa *EdgeNEOHa
+		}
+		// CreateEdgeProxyProfile holds details about calls to the CreateEdgeProxyProfile method.
+		CreateEdgeProxyProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeNEOProxyProfile is the edgeNEOProxyProfile argument value.
+			EdgeNEOProxyProfile *EdgePlatformProxyProfile
+		}
+		// CreateEdgeSpoke holds details about calls to the CreateEdgeSpoke method.
+		CreateEdgeSpoke []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeSpoke is the edgeSpoke argument value.
+			EdgeSpoke *EdgeSpoke
+		}
+		// CreateEdgeVmSelfmanagedHa holds details about calls to the CreateEdgeVmSelfmanagedHa method.
+		CreateEdgeVmSelfmanagedHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeVmSelfmanagedHa is the edgeVmSelfmanagedHa argument value.
+			EdgeVmSelfmanagedHa *EdgeVmSelfmanagedHa
+		}
+		// CreateExternalDeviceConn holds details about calls to the CreateExternalDeviceConn method.
+		CreateExternalDeviceConn []struct {
+			// ExternalDeviceConn is the externalDeviceConn argument value.
+			ExternalDeviceConn *ExternalDeviceConn
+		}
+		// CreateGCPAccount holds details about calls to the CreateGCPAccount method.
+		CreateGCPAccount []struct {
+			// Account is the account argument value.
+			Account *Account
+		}
+		// CreateGatewayContext holds details about calls to the CreateGatewayContext method.
+		CreateGatewayContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// CreateGatewayGroup holds details about calls to the CreateGatewayGroup method.
+		CreateGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpokeGroup is the spokeGroup argument value.
+			SpokeGroup *GatewayGroup
+		}
+		// CreateIpsProfile holds details about calls to the CreateIpsProfile method.
+		CreateIpsProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Profile is the profile argument value.
+			Profile *IpsProfile
+		}
+		// CreateIpsRuleFeed holds details about calls to the CreateIpsRuleFeed method.
+		CreateIpsRuleFeed []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RuleFeed is the ruleFeed argument value.
+			RuleFeed *IpsRuleFeed
+		}
+		// CreateOCIAccount holds details about calls to the CreateOCIAccount method.
+		CreateOCIAccount []struct {
+			// Account is the account argument value.
+			Account *Account
+		}
+		// CreatePublicSubnetFilteringGatewayContext holds details about calls to the CreatePublicSubnetFilteringGatewayContext method.
+		CreatePublicSubnetFilteringGatewayContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// CreateS2CCaCert holds details about calls to the CreateS2CCaCert method.
+		CreateS2CCaCert []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S2cCaCert is the s2cCaCert argument value.
+			S2cCaCert *S2CCaCert
+		}
+		// CreateSegmentationSecurityDomain holds details about calls to the CreateSegmentationSecurityDomain method.
+		CreateSegmentationSecurityDomain []struct {
+			// Domain is the domain argument value.
+			Domain *SegmentationSecurityDomain
+		}
+		// CreateSegmentationSecurityDomainAssociation holds details about calls to the CreateSegmentationSecurityDomainAssociation method.
+		CreateSegmentationSecurityDomainAssociation []struct {
+			// Association is the association argument value.
+			Association *SegmentationSecurityDomainAssociation
+		}
+		// CreateSegmentationSecurityDomainConnectionPolicy holds details about calls to the CreateSegmentationSecurityDomainConnectionPolicy method.
+		CreateSegmentationSecurityDomainConnectionPolicy []struct {
+			// Policy is the policy argument value.
+			Policy *SegmentationSecurityDomainConnectionPolicy
+		}
+		// CreateSite2Cloud holds details about calls to the CreateSite2Cloud method.
+		CreateSite2Cloud []struct {
+			// Site2cloud is the site2cloud argument value.
+			Site2cloud *Site2Cloud
+		}
+		// CreateSite2CloudContext holds details about calls to the CreateSite2CloudContext method.
+		CreateSite2CloudContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Site2cloud is the site2cloud argument value.
+			Site2cloud *Site2Cloud
+		}
+		// CreateSmartGroup holds details about calls to the CreateSmartGroup method.
+		CreateSmartGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SmartGroup is the smartGroup argument value.
+			SmartGroup *SmartGroup
+		}
+		// CreateSpokeHaGw holds details about calls to the CreateSpokeHaGw method.
+		CreateSpokeHaGw []struct {
+			// SpokeHaGateway is the spokeHaGateway argument value.
+			SpokeHaGateway *SpokeHaGateway
+		}
+		// CreateSpokeHaGwContext holds details about calls to the CreateSpokeHaGwContext method.
+		CreateSpokeHaGwContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpokeHaGateway is the spokeHaGateway argument value.
+			SpokeHaGateway *SpokeHaGateway
+		}
+		// CreateSpokeTransitAttachment holds details about calls to the CreateSpokeTransitAttachment method.
+		CreateSpokeTransitAttachment []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpokeTransitAttachment is the spokeTransitAttachment argument value.
+			SpokeTransitAttachment *SpokeTransitAttachment
+		}
+		// CreateTLSProfile holds details about calls to the CreateTLSProfile method.
+		CreateTLSProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TlsProfile is the tlsProfile argument value.
+			TlsProfile *TLSProfile
+		}
+		// CreateTransitHaGwContext holds details about calls to the CreateTransitHaGwContext method.
+		CreateTransitHaGwContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TransitHaGateway is the transitHaGateway argument value.
+			TransitHaGateway *TransitHaGateway
+		}
+		// CreateWebGroup holds details about calls to the CreateWebGroup method.
+		CreateWebGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WebGroup is the webGroup argument value.
+			WebGroup *WebGroup
+		}
+		// DeleteAccount holds details about calls to the DeleteAccount method.
+		DeleteAccount []struct {
+			// Account is the account argument value.
+			Account *Account
+		}
+		// DeleteAccountUser holds details about calls to the DeleteAccountUser method.
+		DeleteAccountUser []struct {
+			// User is the user argument value.
+			User *AccountUser
+		}
+		// DeleteCaCertificate holds details about calls to the DeleteCaCertificate method.
+		DeleteCaCertificate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// DeleteCertInstance holds details about calls to the DeleteCertInstance method.
+		DeleteCertInstance []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CaCertInstance is the caCertInstance argument value.
+			CaCertInstance *CaCertInstance
+		}
+		// DeleteDCFMitmCa holds details about calls to the DeleteDCFMitmCa method.
+		DeleteDCFMitmCa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CaID is the caID argument value.
+			CaID string
+		}
+		// DeleteDCFPolicyBlock holds details about calls to the DeleteDCFPolicyBlock method.
+		DeleteDCFPolicyBlock []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+		}
+		// DeleteDCFPolicyList holds details about calls to the DeleteDCFPolicyList method.
+		DeleteDCFPolicyList []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+		}
+		// DeleteDCFTrustBundle holds details about calls to the DeleteDCFTrustBundle method.
+		DeleteDCFTrustBundle []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// BundleUUID is the bundleUUID argument value.
+			BundleUUID string
+		}
+		// DeleteDistributedFirewallingIntraVpc holds details about calls to the DeleteDistributedFirewallingIntraVpc method.
+		DeleteDistributedFirewallingIntraVpc []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// DeleteDistributedFirewallingPolicyList holds details about calls to the DeleteDistributedFirewallingPolicyList method.
+		DeleteDistributedFirewallingPolicyList []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// DeleteEdgeCSP holds details about calls to the DeleteEdgeCSP method.
+		DeleteEdgeCSP []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AccountName is the accountName argument value.
+			AccountName string
+			// Name is the name argument value.
+			Name string
+		}
+		// DeleteEdgeEquinix holds details about calls to the DeleteEdgeEquinix method.
+		DeleteEdgeEquinix []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AccountName is the accountName argument value.
+			AccountName string
+			// Name is the name argument value.
+			Name string
+		}
+		// DeleteEdgeExternalDeviceConn holds details about calls to the DeleteEdgeExternalDeviceConn method.
+		DeleteEdgeExternalDeviceConn []struct {
+			// EdgeExternalDeviceConn is the edgeExternalDeviceConn argument value.
+			EdgeExternalDeviceConn *EdgeExternalDeviceConn
+		}
+		// DeleteEdgeMegaport holds details about calls to the DeleteEdgeMegaport method.
+		DeleteEdgeMegaport []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AccountName is the accountName argument value.
+			AccountName string
+			// Name is the name argument value.
+			Name string
+		}
+		// DeleteEdgeNEO holds details about calls to the DeleteEdgeNEO method.
+		DeleteEdgeNEO []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AccountName is the accountName argument value.
+			AccountName string
+			// Name is the name argument value.
+			Name string
+		}
+		// DeleteEdgeNEODevice holds details about calls to the DeleteEdgeNEODevice method.
+		DeleteEdgeNEODevice []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AccountName is the accountName argument value.
+			AccountName string
+			// SerialNumber is the serialNumber argument value.
+			SerialNumber string
+		}
+		// DeleteEdgePlatformProxyProfile holds details about calls to the DeleteEdgePlatformProxyProfile method.
+		DeleteEdgePlatformProxyProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AccountName is the accountName argument value.
+			AccountName string
+			// ProfileName is the profileName argument value.
+			ProfileName string
+		}
+		// DeleteEdgeSpoke holds details about calls to the DeleteEdgeSpoke method.
+		DeleteEdgeSpoke []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+		}
+		// DeleteEnforcementLevel holds details about calls to the DeleteEnforcementLevel method.
+		DeleteEnforcementLevel []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// DeleteExternalDeviceConn holds details about calls to the DeleteExternalDeviceConn method.
+		DeleteExternalDeviceConn []struct {
+			// ExternalDeviceConn is the externalDeviceConn argument value.
+			ExternalDeviceConn *ExternalDeviceConn
+		}
+		// DeleteGateway holds details about calls to the DeleteGateway method.
+		DeleteGateway []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DeleteGatewayContext holds details about calls to the DeleteGatewayContext method.
+		DeleteGatewayContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DeleteGatewayGroup holds details about calls to the DeleteGatewayGroup method.
+		DeleteGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupUUID is the groupUUID argument value.
+			GroupUUID string
+		}
+		// DeleteIpsProfile holds details about calls to the DeleteIpsProfile method.
+		DeleteIpsProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+		}
+		// DeleteIpsRuleFeed holds details about calls to the DeleteIpsRuleFeed method.
+		DeleteIpsRuleFeed []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+		}
+		// DeletePublicSubnetFilteringGatewayContext holds details about calls to the DeletePublicSubnetFilteringGatewayContext method.
+		DeletePublicSubnetFilteringGatewayContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DeleteSegmentationSecurityDomain holds details about calls to the DeleteSegmentationSecurityDomain method.
+		DeleteSegmentationSecurityDomain []struct {
+			// Domain is the domain argument value.
+			Domain *SegmentationSecurityDomain
+		}
+		// DeleteSegmentationSecurityDomainAssociation holds details about calls to the DeleteSegmentationSecurityDomainAssociation method.
+		DeleteSegmentationSecurityDomainAssociation []struct {
+			// Association is the association argument value.
+			Association *SegmentationSecurityDomainAssociation
+		}
+		// DeleteSegmentationSecurityDomainConnectionPolicy holds details about calls to the DeleteSegmentationSecurityDomainConnectionPolicy method.
+		DeleteSegmentationSecurityDomainConnectionPolicy []struct {
+			// Policy is the policy argument value.
+			Policy *SegmentationSecurityDomainConnectionPolicy
+		}
+		// DeleteSite2Cloud holds details about calls to the DeleteSite2Cloud method.
+		DeleteSite2Cloud []struct {
+			// Site2cloud is the site2cloud argument value.
+			Site2cloud *Site2Cloud
+		}
+		// DeleteSite2CloudContext holds details about calls to the DeleteSite2CloudContext method.
+		DeleteSite2CloudContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Site2cloud is the site2cloud argument value.
+			Site2cloud *Site2Cloud
+		}
+		// DeleteSmartGroup holds details about calls to the DeleteSmartGroup method.
+		DeleteSmartGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+		}
+		// DeleteSpokeGatewaySubnetGroup holds details about calls to the DeleteSpokeGatewaySubnetGroup method.
+		DeleteSpokeGatewaySubnetGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpokeGatewaySubnetGroup is the spokeGatewaySubnetGroup argument value.
+			SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+		}
+		// DeleteSpokeTransitAttachment holds details about calls to the DeleteSpokeTransitAttachment method.
+		DeleteSpokeTransitAttachment []struct {
+			// SpokeTransitAttachment is the spokeTransitAttachment argument value.
+			SpokeTransitAttachment *SpokeTransitAttachment
+		}
+		// DeleteTLSProfile holds details about calls to the DeleteTLSProfile method.
+		DeleteTLSProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+		}
+		// DeleteWebGroup holds details about calls to the DeleteWebGroup method.
+		DeleteWebGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+		}
+		// DetachTransitGWForHybrid holds details about calls to the DetachTransitGWForHybrid method.
+		DetachTransitGWForHybrid []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// DisableActiveStandby holds details about calls to the DisableActiveStandby method.
+		DisableActiveStandby []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// DisableActiveStandbyGatewayGroup holds details about calls to the DisableActiveStandbyGatewayGroup method.
+		DisableActiveStandbyGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableActiveStandbySpoke holds details about calls to the DisableActiveStandbySpoke method.
+		DisableActiveStandbySpoke []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+		}
+		// DisableAdvertiseTransitCidr holds details about calls to the DisableAdvertiseTransitCidr method.
+		DisableAdvertiseTransitCidr []struct {
+			// TransitGw is the transitGw argument value.
+			TransitGw *TransitVpc
+		}
+		// DisableAdvertiseTransitCidrGatewayGroup holds details about calls to the DisableAdvertiseTransitCidrGatewayGroup method.
+		DisableAdvertiseTransitCidrGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableAutoAdvertiseS2CCidrs holds details about calls to the DisableAutoAdvertiseS2CCidrs method.
+		DisableAutoAdvertiseS2CCidrs []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableAutoAdvertiseS2CCidrsGatewayGroup holds details about calls to the DisableAutoAdvertiseS2CCidrsGatewayGroup method.
+		DisableAutoAdvertiseS2CCidrsGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableConnectedTransit holds details about calls to the DisableConnectedTransit method.
+		DisableConnectedTransit []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// DisableConnectedTransitGatewayGroup holds details about calls to the DisableConnectedTransitGatewayGroup method.
+		DisableConnectedTransitGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableCustomSNat holds details about calls to the DisableCustomSNat method.
+		DisableCustomSNat []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableDeadPeerDetection holds details about calls to the DisableDeadPeerDetection method.
+		DisableDeadPeerDetection []struct {
+			// Site2cloud is the site2cloud argument value.
+			Site2cloud *Site2Cloud
+		}
+		// DisableDeadPeerDetectionContext holds details about calls to the DisableDeadPeerDetectionContext method.
+		DisableDeadPeerDetectionContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Site2cloud is the site2cloud argument value.
+			Site2cloud *Site2Cloud
+		}
+		// DisableDistributedFirewalling holds details about calls to the DisableDistributedFirewalling method.
+		DisableDistributedFirewalling []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// DisableEdgeSpokeTransitiveRouting holds details about calls to the DisableEdgeSpokeTransitiveRouting method.
+		DisableEdgeSpokeTransitiveRouting []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+		}
+		// DisableEgressTransitFirenet holds details about calls to the DisableEgressTransitFirenet method.
+		DisableEgressTransitFirenet []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// DisableFireNetGatewayGroup holds details about calls to the DisableFireNetGatewayGroup method.
+		DisableFireNetGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableGatewayFireNetInterfaces holds details about calls to the DisableGatewayFireNetInterfaces method.
+		DisableGatewayFireNetInterfaces []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// DisableGatewayGroupSNat holds details about calls to the DisableGatewayGroupSNat method.
+		DisableGatewayGroupSNat []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableGatewayGroupVpcDNSServer holds details about calls to the DisableGatewayGroupVpcDNSServer method.
+		DisableGatewayGroupVpcDNSServer []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableGatewayLoadBalancerGatewayGroup holds details about calls to the DisableGatewayLoadBalancerGatewayGroup method.
+		DisableGatewayLoadBalancerGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableGlobalVpc holds details about calls to the DisableGlobalVpc method.
+		DisableGlobalVpc []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableGlobalVpcGatewayGroup holds details about calls to the DisableGlobalVpcGatewayGroup method.
+		DisableGlobalVpcGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableGroGso holds details about calls to the DisableGroGso method.
+		DisableGroGso []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableGroGsoGatewayGroup holds details about calls to the DisableGroGsoGatewayGroup method.
+		DisableGroGsoGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableGuardDutyEnforcement holds details about calls to the DisableGuardDutyEnforcement method.
+		DisableGuardDutyEnforcement []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableHybridConnectionGatewayGroup holds details about calls to the DisableHybridConnectionGatewayGroup method.
+		DisableHybridConnectionGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableIPv6 holds details about calls to the DisableIPv6 method.
+		DisableIPv6 []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableIPv6GatewayGroup holds details about calls to the DisableIPv6GatewayGroup method.
+		DisableIPv6GatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableJumboFrame holds details about calls to the DisableJumboFrame method.
+		DisableJumboFrame []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableJumboFrameExternalDeviceConn holds details about calls to the DisableJumboFrameExternalDeviceConn method.
+		DisableJumboFrameExternalDeviceConn []struct {
+			// ExternalDeviceConn is the externalDeviceConn argument value.
+			ExternalDeviceConn *ExternalDeviceConn
+		}
+		// DisableJumboFrameGatewayGroup holds details about calls to the DisableJumboFrameGatewayGroup method.
+		DisableJumboFrameGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableMonitorGatewaySubnets holds details about calls to the DisableMonitorGatewaySubnets method.
+		DisableMonitorGatewaySubnets []struct {
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// DisableMultiTierTransitGatewayGroup holds details about calls to the DisableMultiTierTransitGatewayGroup method.
+		DisableMultiTierTransitGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableMultitierTransit holds details about calls to the DisableMultitierTransit method.
+		DisableMultitierTransit []struct {
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// DisablePrivateVpcDefaultRoute holds details about calls to the DisablePrivateVpcDefaultRoute method.
+		DisablePrivateVpcDefaultRoute []struct {
+			// Gw is the gw argument value.
+			Gw *Gateway
+		}
+		// DisablePrivateVpcDefaultRouteGatewayGroup holds details about calls to the DisablePrivateVpcDefaultRouteGatewayGroup method.
+		DisablePrivateVpcDefaultRouteGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableS2CRxBalancing holds details about calls to the DisableS2CRxBalancing method.
+		DisableS2CRxBalancing []struct {
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// DisableS2cRxBalancingGatewayGroup holds details about calls to the DisableS2cRxBalancingGatewayGroup method.
+		DisableS2cRxBalancingGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableSNat holds details about calls to the DisableSNat method.
+		DisableSNat []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableSegmentation holds details about calls to the DisableSegmentation method.
+		DisableSegmentation []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// DisableSegmentationGatewayGroup holds details about calls to the DisableSegmentationGatewayGroup method.
+		DisableSegmentationGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableSingleAZGateway holds details about calls to the DisableSingleAZGateway method.
+		DisableSingleAZGateway []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableSite2CloudEventTriggeredHA holds details about calls to the DisableSite2CloudEventTriggeredHA method.
+		DisableSite2CloudEventTriggeredHA []struct {
+			// VpcID is the vpcID argument value.
+			VpcID string
+			// ConnectionName is the connectionName argument value.
+			ConnectionName string
+		}
+		// DisableSite2cloudActiveActive holds details about calls to the DisableSite2cloudActiveActive method.
+		DisableSite2cloudActiveActive []struct {
+			// Site2cloud is the site2cloud argument value.
+			Site2cloud *Site2Cloud
+		}
+		// DisableSkipPublicRouteUpdate holds details about calls to the DisableSkipPublicRouteUpdate method.
+		DisableSkipPublicRouteUpdate []struct {
+			// Gw is the gw argument value.
+			Gw *Gateway
+		}
+		// DisableSkipPublicRouteUpdateGatewayGroup holds details about calls to the DisableSkipPublicRouteUpdateGatewayGroup method.
+		DisableSkipPublicRouteUpdateGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableSpokeLearnedCidrsApproval holds details about calls to the DisableSpokeLearnedCidrsApproval method.
+		DisableSpokeLearnedCidrsApproval []struct {
+			// Gateway is the gateway argument value.
+			Gateway *SpokeVpc
+		}
+		// DisableSpokeLearnedCidrsApprovalGatewayGroup holds details about calls to the DisableSpokeLearnedCidrsApprovalGatewayGroup method.
+		DisableSpokeLearnedCidrsApprovalGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableSpokeMappedSite2CloudForwarding holds details about calls to the DisableSpokeMappedSite2CloudForwarding method.
+		DisableSpokeMappedSite2CloudForwarding []struct {
+			// Site2cloud is the site2cloud argument value.
+			Site2cloud *Site2Cloud
+		}
+		// DisableSpokeOnpremRoutePropagation holds details about calls to the DisableSpokeOnpremRoutePropagation method.
+		DisableSpokeOnpremRoutePropagation []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+		}
+		// DisableSpokeOnpremRoutePropagationGatewayGroup holds details about calls to the DisableSpokeOnpremRoutePropagationGatewayGroup method.
+		DisableSpokeOnpremRoutePropagationGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableSpokePreserveAsPath holds details about calls to the DisableSpokePreserveAsPath method.
+		DisableSpokePreserveAsPath []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+		}
+		// DisableSpokePreserveAsPathGatewayGroup holds details about calls to the DisableSpokePreserveAsPathGatewayGroup method.
+		DisableSpokePreserveAsPathGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableSummarizeCidrToTgw holds details about calls to the DisableSummarizeCidrToTgw method.
+		DisableSummarizeCidrToTgw []struct {
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// DisableTransitFireNet holds details about calls to the DisableTransitFireNet method.
+		DisableTransitFireNet []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableTransitFireNetGatewayGroup holds details about calls to the DisableTransitFireNetGatewayGroup method.
+		DisableTransitFireNetGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableTransitLearnedCidrsApproval holds details about calls to the DisableTransitLearnedCidrsApproval method.
+		DisableTransitLearnedCidrsApproval []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// DisableTransitLearnedCidrsApprovalGatewayGroup holds details about calls to the DisableTransitLearnedCidrsApprovalGatewayGroup method.
+		DisableTransitLearnedCidrsApprovalGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableTransitPreserveAsPath holds details about calls to the DisableTransitPreserveAsPath method.
+		DisableTransitPreserveAsPath []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// DisableTransitPreserveAsPathGatewayGroup holds details about calls to the DisableTransitPreserveAsPathGatewayGroup method.
+		DisableTransitPreserveAsPathGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableTransitSummarizeCidrToTgwGatewayGroup holds details about calls to the DisableTransitSummarizeCidrToTgwGatewayGroup method.
+		DisableTransitSummarizeCidrToTgwGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableVPNConfig holds details about calls to the DisableVPNConfig method.
+		DisableVPNConfig []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+			// VpnConfig is the vpnConfig argument value.
+			VpnConfig *VPNConfig
+		}
+		// DisableVpcDNSServer holds details about calls to the DisableVpcDNSServer method.
+		DisableVpcDNSServer []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableVpnNat holds details about calls to the DisableVpnNat method.
+		DisableVpnNat []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DownloadEdgeNEOConfigFile holds details about calls to the DownloadEdgeNEOConfigFile method.
+		DownloadEdgeNEOConfigFile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeNEODevice is the edgeNEODevice argument value.
+			EdgeNEODevice *EdgeNEODevice
+		}
+		// EditBgpMd5Key holds details about calls to the EditBgpMd5Key method.
+		EditBgpMd5Key []struct {
+			// EditBgpMd5Key is the editBgpMd5Key argument value.
+			EditBgpMd5Key *EditBgpMd5Key
+		}
+		// EditConnectionBgpBfd holds details about calls to the EditConnectionBgpBfd method.
+		EditConnectionBgpBfd []struct {
+			// ExternalDeviceConn is the externalDeviceConn argument value.
+			ExternalDeviceConn *ExternalDeviceConn
+		}
+		// EditConnectionBgpMultihop holds details about calls to the EditConnectionBgpMultihop method.
+		EditConnectionBgpMultihop []struct {
+			// ExternalDeviceConn is the externalDeviceConn argument value.
+			ExternalDeviceConn *ExternalDeviceConn
+		}
+		// EditDesignatedGateway holds details about calls to the EditDesignatedGateway method.
+		EditDesignatedGateway []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EditGatewayAdvertisedCidr holds details about calls to the EditGatewayAdvertisedCidr method.
+		EditGatewayAdvertisedCidr []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EditGatewayCustomRoutes holds details about calls to the EditGatewayCustomRoutes method.
+		EditGatewayCustomRoutes []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EditGatewayFilterRoutes holds details about calls to the EditGatewayFilterRoutes method.
+		EditGatewayFilterRoutes []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EditManagedRouteTables holds details about calls to the EditManagedRouteTables method.
+		EditManagedRouteTables []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+			// RouteTables is the routeTables argument value.
+			RouteTables []string
+		}
+		// EditManagedRouteTablesForGatewayGroup holds details about calls to the EditManagedRouteTablesForGatewayGroup method.
+		EditManagedRouteTablesForGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// RouteTables is the routeTables argument value.
+			RouteTables []string
+		}
+		// EditPrivateRouteTableConfig holds details about calls to the EditPrivateRouteTableConfig method.
+		EditPrivateRouteTableConfig []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+			// RouteTables is the routeTables argument value.
+			RouteTables []string
+		}
+		// EditPrivateRouteTableConfigForGatewayGroup holds details about calls to the EditPrivateRouteTableConfigForGatewayGroup method.
+		EditPrivateRouteTableConfigForGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// RouteTables is the routeTables argument value.
+			RouteTables []string
+		}
+		// EditPublicSubnetFilteringRouteTableList holds details about calls to the EditPublicSubnetFilteringRouteTableList method.
+		EditPublicSubnetFilteringRouteTableList []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+			// RouteTables is the routeTables argument value.
+			RouteTables []string
+		}
+		// EditSite2CloudPhase1LocalIdentifier holds details about calls to the EditSite2CloudPhase1LocalIdentifier method.
+		EditSite2CloudPhase1LocalIdentifier []struct {
+			// S2c is the s2c argument value.
+			S2c *EditSite2Cloud
+		}
+		// EditSpokeExternalDeviceConnASPathPrepend holds details about calls to the EditSpokeExternalDeviceConnASPathPrepend method.
+		EditSpokeExternalDeviceConnASPathPrepend []struct {
+			// ExternalDeviceConn is the externalDeviceConn argument value.
+			ExternalDeviceConn *ExternalDeviceConn
+			// PrependASPath is the prependASPath argument value.
+			PrependASPath []string
+		}
+		// EditSpokeTransitAttachmentFilters holds details about calls to the EditSpokeTransitAttachmentFilters method.
+		EditSpokeTransitAttachmentFilters []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpokeTransitAttachment is the spokeTransitAttachment argument value.
+			SpokeTransitAttachment *SpokeTransitAttachment
+		}
+		// EditTransitConnectionASPathPrepend holds details about calls to the EditTransitConnectionASPathPrepend method.
+		EditTransitConnectionASPathPrepend []struct {
+			// TransitGatewayPeering is the transitGatewayPeering argument value.
+			TransitGatewayPeering *TransitGatewayPeering
+			// PrependASPath is the prependASPath argument value.
+			PrependASPath []string
+		}
+		// EditTransitConnectionBGPManualAdvertiseCIDRs holds details about calls to the EditTransitConnectionBGPManualAdvertiseCIDRs method.
+		EditTransitConnectionBGPManualAdvertiseCIDRs []struct {
+			// GwName is the gwName argument value.
+			GwName string
+			// ConnName is the connName argument value.
+			ConnName string
+			// Cidrs is the cidrs argument value.
+			Cidrs []string
+		}
+		// EnableActiveStandby holds details about calls to the EnableActiveStandby method.
+		EnableActiveStandby []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// EnableActiveStandbyGatewayGroup holds details about calls to the EnableActiveStandbyGatewayGroup method.
+		EnableActiveStandbyGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableActiveStandbyPreemptive holds details about calls to the EnableActiveStandbyPreemptive method.
+		EnableActiveStandbyPreemptive []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// EnableActiveStandbyPreemptiveGatewayGroup holds details about calls to the EnableActiveStandbyPreemptiveGatewayGroup method.
+		EnableActiveStandbyPreemptiveGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableActiveStandbyPreemptiveSpoke holds details about calls to the EnableActiveStandbyPreemptiveSpoke method.
+		EnableActiveStandbyPreemptiveSpoke []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+		}
+		// EnableActiveStandbySpoke holds details about calls to the EnableActiveStandbySpoke method.
+		EnableActiveStandbySpoke []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+		}
+		// EnableAdvertiseTransitCidr holds details about calls to the EnableAdvertiseTransitCidr method.
+		EnableAdvertiseTransitCidr []struct {
+			// TransitGw is the transitGw argument value.
+			TransitGw *TransitVpc
+		}
+		// EnableAdvertiseTransitCidrGatewayGroup holds details about calls to the EnableAdvertiseTransitCidrGatewayGroup method.
+		EnableAdvertiseTransitCidrGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableAutoAdvertiseS2CCidrs holds details about calls to the EnableAutoAdvertiseS2CCidrs method.
+		EnableAutoAdvertiseS2CCidrs []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableAutoAdvertiseS2CCidrsGatewayGroup holds details about calls to the EnableAutoAdvertiseS2CCidrsGatewayGroup method.
+		EnableAutoAdvertiseS2CCidrsGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableConnectedTransit holds details about calls to the EnableConnectedTransit method.
+		EnableConnectedTransit []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// EnableConnectedTransitGatewayGroup holds details about calls to the EnableConnectedTransitGatewayGroup method.
+		EnableConnectedTransitGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableCustomizedSNat holds details about calls to the EnableCustomizedSNat method.
+		EnableCustomizedSNat []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableDeadPeerDetection holds details about calls to the EnableDeadPeerDetection method.
+		EnableDeadPeerDetection []struct {
+			// Site2cloud is the site2cloud argument value.
+			Site2cloud *Site2Cloud
+		}
+		// EnableDistributedFirewalling holds details about calls to the EnableDistributedFirewalling method.
+		EnableDistributedFirewalling []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// EnableEdgeSpokeTransitiveRouting holds details about calls to the EnableEdgeSpokeTransitiveRouting method.
+		EnableEdgeSpokeTransitiveRouting []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+		}
+		// EnableEgressTransitFirenet holds details about calls to the EnableEgressTransitFirenet method.
+		EnableEgressTransitFirenet []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// EnableEncryptVolume holds details about calls to the EnableEncryptVolume method.
+		EnableEncryptVolume []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableFireNetGatewayGroup holds details about calls to the EnableFireNetGatewayGroup method.
+		EnableFireNetGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableGatewayFireNetInterfaces holds details about calls to the EnableGatewayFireNetInterfaces method.
+		EnableGatewayFireNetInterfaces []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// EnableGatewayFireNetInterfacesWithGWLB holds details about calls to the EnableGatewayFireNetInterfacesWithGWLB method.
+		EnableGatewayFireNetInterfacesWithGWLB []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// EnableGatewayGroupSNat holds details about calls to the EnableGatewayGroupSNat method.
+		EnableGatewayGroupSNat []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableGatewayGroupVpcDNSServer holds details about calls to the EnableGatewayGroupVpcDNSServer method.
+		EnableGatewayGroupVpcDNSServer []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableGatewayLoadBalancerGatewayGroup holds details about calls to the EnableGatewayLoadBalancerGatewayGroup method.
+		EnableGatewayLoadBalancerGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableGlobalVpc holds details about calls to the EnableGlobalVpc method.
+		EnableGlobalVpc []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableGlobalVpcGatewayGroup holds details about calls to the EnableGlobalVpcGatewayGroup method.
+		EnableGlobalVpcGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableGroGso holds details about calls to the EnableGroGso method.
+		EnableGroGso []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableGroGsoGatewayGroup holds details about calls to the EnableGroGsoGatewayGroup method.
+		EnableGroGsoGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableGuardDutyEnforcement holds details about calls to the EnableGuardDutyEnforcement method.
+		EnableGuardDutyEnforcement []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableHybridConnectionGatewayGroup holds details about calls to the EnableHybridConnectionGatewayGroup method.
+		EnableHybridConnectionGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableIPv6 holds details about calls to the EnableIPv6 method.
+		EnableIPv6 []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableIPv6GatewayGroup holds details about calls to the EnableIPv6GatewayGroup method.
+		EnableIPv6GatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableJumboFrame holds details about calls to the EnableJumboFrame method.
+		EnableJumboFrame []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableJumboFrameExternalDeviceConn holds details about calls to the EnableJumboFrameExternalDeviceConn method.
+		EnableJumboFrameExternalDeviceConn []struct {
+			// ExternalDeviceConn is the externalDeviceConn argument value.
+			ExternalDeviceConn *ExternalDeviceConn
+		}
+		// EnableJumboFrameGatewayGroup holds details about calls to the EnableJumboFrameGatewayGroup method.
+		EnableJumboFrameGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableMonitorGatewaySubnets holds details about calls to the EnableMonitorGatewaySubnets method.
+		EnableMonitorGatewaySubnets []struct {
+			// GwName is the gwName argument value.
+			GwName string
+			// ExcludedInstances is the excludedInstances argument value.
+			ExcludedInstances []string
+		}
+		// EnableMultiTierTransitGatewayGroup holds details about calls to the EnableMultiTierTransitGatewayGroup method.
+		EnableMultiTierTransitGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableMultitierTransit holds details about calls to the EnableMultitierTransit method.
+		EnableMultitierTransit []struct {
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// EnablePeeringHaGateway holds details about calls to the EnablePeeringHaGateway method.
+		EnablePeeringHaGateway []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnablePrivateVpcDefaultRoute holds details about calls to the EnablePrivateVpcDefaultRoute method.
+		EnablePrivateVpcDefaultRoute []struct {
+			// Gw is the gw argument value.
+			Gw *Gateway
+		}
+		// EnablePrivateVpcDefaultRouteGatewayGroup holds details about calls to the EnablePrivateVpcDefaultRouteGatewayGroup method.
+		EnablePrivateVpcDefaultRouteGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnablePublicSubnetFilteringHAGateway holds details about calls to the EnablePublicSubnetFilteringHAGateway method.
+		EnablePublicSubnetFilteringHAGateway []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableS2CRxBalancing holds details about calls to the EnableS2CRxBalancing method.
+		EnableS2CRxBalancing []struct {
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// EnableS2cRxBalancingGatewayGroup holds details about calls to the EnableS2cRxBalancingGatewayGroup method.
+		EnableS2cRxBalancingGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableSNat holds details about calls to the EnableSNat method.
+		EnableSNat []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableSegmentation holds details about calls to the EnableSegmentation method.
+		EnableSegmentation []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// EnableSegmentationGatewayGroup holds details about calls to the EnableSegmentationGatewayGroup method.
+		EnableSegmentationGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableSingleAZGateway holds details about calls to the EnableSingleAZGateway method.
+		EnableSingleAZGateway []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableSite2CloudEventTriggeredHA holds details about calls to the EnableSite2CloudEventTriggeredHA method.
+		EnableSite2CloudEventTriggeredHA []struct {
+			// VpcID is the vpcID argument value.
+			VpcID string
+			// ConnectionName is the connectionName argument value.
+			ConnectionName string
+		}
+		// EnableSite2cloudActiveActive holds details about calls to the EnableSite2cloudActiveActive method.
+		EnableSite2cloudActiveActive []struct {
+			// Site2cloud is the site2cloud argument value.
+			Site2cloud *Site2Cloud
+		}
+		// EnableSite2cloudActiveActiveContext holds details about calls to the EnableSite2cloudActiveActiveContext method.
+		EnableSite2cloudActiveActiveContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Site2cloud is the site2cloud argument value.
+			Site2cloud *Site2Cloud
+		}
+		// EnableSkipPublicRouteUpdate holds details about calls to the EnableSkipPublicRouteUpdate method.
+		EnableSkipPublicRouteUpdate []struct {
+			// Gw is the gw argument value.
+			Gw *Gateway
+		}
+		// EnableSkipPublicRouteUpdateGatewayGroup holds details about calls to the EnableSkipPublicRouteUpdateGatewayGroup method.
+		EnableSkipPublicRouteUpdateGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableSpokeLearnedCidrsApproval holds details about calls to the EnableSpokeLearnedCidrsApproval method.
+		EnableSpokeLearnedCidrsApproval []struct {
+			// Gateway is the gateway argument value.
+			Gateway *SpokeVpc
+		}
+		// EnableSpokeLearnedCidrsApprovalGatewayGroup holds details about calls to the EnableSpokeLearnedCidrsApprovalGatewayGroup method.
+		EnableSpokeLearnedCidrsApprovalGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableSpokeMappedSite2CloudForwarding holds details about calls to the EnableSpokeMappedSite2CloudForwarding method.
+		EnableSpokeMappedSite2CloudForwarding []struct {
+			// Site2cloud is the site2cloud argument value.
+			Site2cloud *Site2Cloud
+		}
+		// EnableSpokeOnpremRoutePropagation holds details about calls to the EnableSpokeOnpremRoutePropagation method.
+		EnableSpokeOnpremRoutePropagation []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+		}
+		// EnableSpokeOnpremRoutePropagationGatewayGroup holds details about calls to the EnableSpokeOnpremRoutePropagationGatewayGroup method.
+		EnableSpokeOnpremRoutePropagationGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableSpokePreserveAsPath holds details about calls to the EnableSpokePreserveAsPath method.
+		EnableSpokePreserveAsPath []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+		}
+		// EnableSpokePreserveAsPathGatewayGroup holds details about calls to the EnableSpokePreserveAsPathGatewayGroup method.
+		EnableSpokePreserveAsPathGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableSummarizeCidrToTgw holds details about calls to the EnableSummarizeCidrToTgw method.
+		EnableSummarizeCidrToTgw []struct {
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// EnableTransitFireNet holds details about calls to the EnableTransitFireNet method.
+		EnableTransitFireNet []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableTransitFireNetGatewayGroup holds details about calls to the EnableTransitFireNetGatewayGroup method.
+		EnableTransitFireNetGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableTransitFireNetWithGWLB holds details about calls to the EnableTransitFireNetWithGWLB method.
+		EnableTransitFireNetWithGWLB []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableTransitFireNetWithGWLBGatewayGroup holds details about calls to the EnableTransitFireNetWithGWLBGatewayGroup method.
+		EnableTransitFireNetWithGWLBGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableTransitLearnedCidrsApproval holds details about calls to the EnableTransitLearnedCidrsApproval method.
+		EnableTransitLearnedCidrsApproval []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// EnableTransitLearnedCidrsApprovalGatewayGroup holds details about calls to the EnableTransitLearnedCidrsApprovalGatewayGroup method.
+		EnableTransitLearnedCidrsApprovalGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableTransitPreserveAsPath holds details about calls to the EnableTransitPreserveAsPath method.
+		EnableTransitPreserveAsPath []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// EnableTransitPreserveAsPathGatewayGroup holds details about calls to the EnableTransitPreserveAsPathGatewayGroup method.
+		EnableTransitPreserveAsPathGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableTransitSummarizeCidrToTgwGatewayGroup holds details about calls to the EnableTransitSummarizeCidrToTgwGatewayGroup method.
+		EnableTransitSummarizeCidrToTgwGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableVPNConfig holds details about calls to the EnableVPNConfig method.
+		EnableVPNConfig []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+			// VpnConfig is the vpnConfig argument value.
+			VpnConfig *VPNConfig
+		}
+		// EnableVpcDNSServer holds details about calls to the EnableVpcDNSServer method.
+		EnableVpcDNSServer []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableVpnNat holds details about calls to the EnableVpnNat method.
+		EnableVpnNat []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// GetAccount holds details about calls to the GetAccount method.
+		GetAccount []struct {
+			// Account is the account argument value.
+			Account *Account
+		}
+		// GetAccountUser holds details about calls to the GetAccountUser method.
+		GetAccountUser []struct {
+			// User is the user argument value.
+			User *AccountUser
+		}
+		// GetBgpLanIPList holds details about calls to the GetBgpLanIPList method.
+		GetBgpLanIPList []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// GetCID holds details about calls to the GetCID method.
+		GetCID []struct {
+		}
+		// GetCaCertificate holds details about calls to the GetCaCertificate method.
+		GetCaCertificate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetCompatibleImageVersion holds details about calls to the GetCompatibleImageVersion method.
+		GetCompatibleImageVersion []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CloudType is the cloudType argument value.
+			CloudType int
+			// SoftwareVersion is the softwareVersion argument value.
+			SoftwareVersion string
+		}
+		// GetControllerIP holds details about calls to the GetControllerIP method.
+		GetControllerIP []struct {
+		}
+		// GetDCFAttachmentPoint holds details about calls to the GetDCFAttachmentPoint method.
+		GetDCFAttachmentPoint []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+		}
+		// GetDCFMitmCa holds details about calls to the GetDCFMitmCa method.
+		GetDCFMitmCa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CaID is the caID argument value.
+			CaID string
+		}
+		// GetDCFPolicyBlock holds details about calls to the GetDCFPolicyBlock method.
+		GetDCFPolicyBlock []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+		}
+		// GetDCFPolicyList holds details about calls to the GetDCFPolicyList method.
+		GetDCFPolicyList []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+		}
+		// GetDCFTrustBundleByID holds details about calls to the GetDCFTrustBundleByID method.
+		GetDCFTrustBundleByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// BundleUUID is the bundleUUID argument value.
+			BundleUUID string
+		}
+		// GetDCFTrustBundleByName holds details about calls to the GetDCFTrustBundleByName method.
+		GetDCFTrustBundleByName []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// BundleName is the bundleName argument value.
+			BundleName string
+		}
+		// GetDefaultIpsProfile holds details about calls to the GetDefaultIpsProfile method.
+		GetDefaultIpsProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetDefaultTagsConfig holds details about calls to the GetDefaultTagsConfig method.
+		GetDefaultTagsConfig []struct {
+		}
+		// GetDistributedFirewallingDefaultActionRule holds details about calls to the GetDistributedFirewallingDefaultActionRule method.
+		GetDistributedFirewallingDefaultActionRule []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetDistributedFirewallingDeploymentPolicy holds details about calls to the GetDistributedFirewallingDeploymentPolicy method.
+		GetDistributedFirewallingDeploymentPolicy []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetDistributedFirewallingIntraVpc holds details about calls to the GetDistributedFirewallingIntraVpc method.
+		GetDistributedFirewallingIntraVpc []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetDistributedFirewallingPolicyList holds details about calls to the GetDistributedFirewallingPolicyList method.
+		GetDistributedFirewallingPolicyList []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetDistributedFirewallingStatus holds details about calls to the GetDistributedFirewallingStatus method.
+		GetDistributedFirewallingStatus []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetEdgeCSP holds details about calls to the GetEdgeCSP method.
+		GetEdgeCSP []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// GetEdgeCSPHa holds details about calls to the GetEdgeCSPHa method.
+		GetEdgeCSPHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// GetEdgeEquinix holds details about calls to the GetEdgeEquinix method.
+		GetEdgeEquinix []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// GetEdgeEquinixHa holds details about calls to the GetEdgeEquinixHa method.
+		GetEdgeEquinixHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// GetEdgeGatewayWanIp holds details about calls to the GetEdgeGatewayWanIp method.
+		GetEdgeGatewayWanIp []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GwName is the gwName argument value.
+			GwName string
+			// WanInterfaceName is the wanInterfaceName argument value.
+			WanInterfaceName string
+		}
+		// GetEdgeMegaport holds details about calls to the GetEdgeMegaport method.
+		GetEdgeMegaport []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// GetEdgeMegaportHa holds details about calls to the GetEdgeMegaportHa method.
+		GetEdgeMegaportHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// GetEdgeNEO holds details about calls to the GetEdgeNEO method.
+		GetEdgeNEO []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// GetEdgeNEODevice holds details about calls to the GetEdgeNEODevice method.
+		GetEdgeNEODevice []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AccountName is the accountName argument value.
+			AccountName string
+			// DeviceName is the deviceName argument value.
+			DeviceName string
+		}
+		// GetEdgeNEOHa holds details about calls to the GetEdgeNEOHa method.
+		GetEdgeNEOHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// GetEdgePlatformProxyProfile holds details about calls to the GetEdgePlatformProxyProfile method.
+		GetEdgePlatformProxyProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AccountName is the accountName argument value.
+			AccountName string
+			// ProfileName is the profileName argument value.
+			ProfileName string
+		}
+		// GetEdgeSpoke holds details about calls to the GetEdgeSpoke method.
+		GetEdgeSpoke []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// GetEdgeSpokeTransitAttachment holds details about calls to the GetEdgeSpokeTransitAttachment method.
+		GetEdgeSpokeTransitAttachment []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpokeTransitAttachment is the spokeTransitAttachment argument value.
+			SpokeTransitAttachment *SpokeTransitAttachment
+		}
+		// GetEdgeVmSelfmanagedHa holds details about calls to the GetEdgeVmSelfmanagedHa method.
+		GetEdgeVmSelfmanagedHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// GetEnforcementLevel holds details about calls to the GetEnforcementLevel method.
+		GetEnforcementLevel []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetExternalDeviceConnDetail holds details about calls to the GetExternalDeviceConnDetail method.
+		GetExternalDeviceConnDetail []struct {
+			// ExternalDeviceConn is the externalDeviceConn argument value.
+			ExternalDeviceConn *ExternalDeviceConn
+			// LocalGateway is the localGateway argument value.
+			LocalGateway *Gateway
+			// PriorHAEnabled is the priorHAEnabled argument value.
+			PriorHAEnabled bool
+		}
+		// GetGateway holds details about calls to the GetGateway method.
+		GetGateway []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// GetGatewayBgpCommunities holds details about calls to the GetGatewayBgpCommunities method.
+		GetGatewayBgpCommunities []struct {
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// GetGatewayBgpMedToSdnMetric holds details about calls to the GetGatewayBgpMedToSdnMetric method.
+		GetGatewayBgpMedToSdnMetric []struct {
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// GetGatewayContext holds details about calls to the GetGatewayContext method.
+		GetGatewayContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// GetGatewayDetail holds details about calls to the GetGatewayDetail method.
+		GetGatewayDetail []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// GetGatewayGroup holds details about calls to the GetGatewayGroup method.
+		GetGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupUUID is the groupUUID argument value.
+			GroupUUID string
+		}
+		// GetGatewayGroupByName holds details about calls to the GetGatewayGroupByName method.
+		GetGatewayGroupByName []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// GetGeoVPNName holds details about calls to the GetGeoVPNName method.
+		GetGeoVPNName []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// GetGroGsoStatus holds details about calls to the GetGroGsoStatus method.
+		GetGroGsoStatus []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// GetIgnoreTagsConfig holds details about calls to the GetIgnoreTagsConfig method.
+		GetIgnoreTagsConfig []struct {
+		}
+		// GetIpsProfile holds details about calls to the GetIpsProfile method.
+		GetIpsProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+		}
+		// GetIpsRuleFeed holds details about calls to the GetIpsRuleFeed method.
+		GetIpsRuleFeed []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+		}
+		// GetLogProfileByName holds details about calls to the GetLogProfileByName method.
+		GetLogProfileByName []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ProfileName is the profileName argument value.
+			ProfileName string
+		}
+		// GetMetaCaCertificate holds details about calls to the GetMetaCaCertificate method.
+		GetMetaCaCertificate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetS2CCaCertTag holds details about calls to the GetS2CCaCertTag method.
+		GetS2CCaCertTag []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S2cCaCertTag is the s2cCaCertTag argument value.
+			S2cCaCertTag *S2CCaCertTag
+		}
+		// GetSegmentationSecurityDomain holds details about calls to the GetSegmentationSecurityDomain method.
+		GetSegmentationSecurityDomain []struct {
+			// Domain is the domain argument value.
+			Domain *SegmentationSecurityDomain
+		}
+		// GetSegmentationSecurityDomainAssociation holds details about calls to the GetSegmentationSecurityDomainAssociation method.
+		GetSegmentationSecurityDomainAssociation []struct {
+			// Association is the association argument value.
+			Association *SegmentationSecurityDomainAssociation
+		}
+		// GetSegmentationSecurityDomainConnectionPolicy holds details about calls to the GetSegmentationSecurityDomainConnectionPolicy method.
+		GetSegmentationSecurityDomainConnectionPolicy []struct {
+			// Policy is the policy argument value.
+			Policy *SegmentationSecurityDomainConnectionPolicy
+		}
+		// GetSite2CloudConnDetail holds details about calls to the GetSite2CloudConnDetail method.
+		GetSite2CloudConnDetail []struct {
+			// Site2cloud is the site2cloud argument value.
+			Site2cloud *Site2Cloud
+		}
+		// GetSmartGroup holds details about calls to the GetSmartGroup method.
+		GetSmartGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+		}
+		// GetSmartGroups holds details about calls to the GetSmartGroups method.
+		GetSmartGroups []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetSplitTunnel holds details about calls to the GetSplitTunnel method.
+		GetSplitTunnel []struct {
+			// SplitTunnel is the splitTunnel argument value.
+			SplitTunnel *SplitTunnel
+		}
+		// GetSpokeGatewayAdvancedConfig holds details about calls to the GetSpokeGatewayAdvancedConfig method.
+		GetSpokeGatewayAdvancedConfig []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+		}
+		// GetSpokeGatewayList holds details about calls to the GetSpokeGatewayList method.
+		GetSpokeGatewayList []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetSpokeGatewaySubnetGroup holds details about calls to the GetSpokeGatewaySubnetGroup method.
+		GetSpokeGatewaySubnetGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpokeGatewaySubnetGroup is the spokeGatewaySubnetGroup argument value.
+			SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+		}
+		// GetTLSProfile holds details about calls to the GetTLSProfile method.
+		GetTLSProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UuidStr is the uuidStr argument value.
+			UuidStr string
+		}
+		// GetTLSProfileByName holds details about calls to the GetTLSProfileByName method.
+		GetTLSProfileByName []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DisplayName is the displayName argument value.
+			DisplayName string
+		}
+		// GetTags holds details about calls to the GetTags method.
+		GetTags []struct {
+			// Tags is the tags argument value.
+			Tags *Tags
+		}
+		// GetTransitGatewayAdvancedConfig holds details about calls to the GetTransitGatewayAdvancedConfig method.
+		GetTransitGatewayAdvancedConfig []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// GetTransitGatewayLanCidr holds details about calls to the GetTransitGatewayLanCidr method.
+		GetTransitGatewayLanCidr []struct {
+			// GatewayName is the gatewayName argument value.
+			GatewayName string
+		}
+		// GetTransitGatewayList holds details about calls to the GetTransitGatewayList method.
+		GetTransitGatewayList []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetTunnelDetectionTime holds details about calls to the GetTunnelDetectionTime method.
+		GetTunnelDetectionTime []struct {
+			// Entity is the entity argument value.
+			Entity string
+		}
+		// GetWebGroup holds details about calls to the GetWebGroup method.
+		GetWebGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+		}
+		// GetWebGroupByName holds details about calls to the GetWebGroupByName method.
+		GetWebGroupByName []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+		}
+		// InvalidateCache holds details about calls to the InvalidateCache method.
+		InvalidateCache []struct {
+		}
+		// IsTransitFireNetReadyToBeDisabled holds details about calls to the IsTransitFireNetReadyToBeDisabled method.
+		IsTransitFireNetReadyToBeDisabled []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// LaunchSpokeVpcContext holds details about calls to the LaunchSpokeVpcContext method.
+		LaunchSpokeVpcContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Spoke is the spoke argument value.
+			Spoke *SpokeVpc
+		}
+		// LaunchTransitVpcContext holds details about calls to the LaunchTransitVpcContext method.
+		LaunchTransitVpcContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// ListDCFMitmCa holds details about calls to the ListDCFMitmCa method.
+		ListDCFMitmCa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// ListGatewayRegions holds details about calls to the ListGatewayRegions method.
+		ListGatewayRegions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CloudType is the cloudType argument value.
+			CloudType int
+			// AccountName is the accountName argument value.
+			AccountName string
+		}
+		// ListGatewaySizes holds details about calls to the ListGatewaySizes method.
+		ListGatewaySizes []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CloudType is the cloudType argument value.
+			CloudType int
+			// AccountName is the accountName argument value.
+			AccountName string
+			// Region is the region argument value.
+			Region string
+		}
+		// ListSegmentationSecurityDomains holds details about calls to the ListSegmentationSecurityDomains method.
+		ListSegmentationSecurityDomains []struct {
+		}
+		// ListSite2CloudContext holds details about calls to the ListSite2CloudContext method.
+		ListSite2CloudContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// ModifySplitTunnel holds details about calls to the ModifySplitTunnel method.
+		ModifySplitTunnel []struct {
+			// SplitTunnel is the splitTunnel argument value.
+			SplitTunnel *SplitTunnel
+		}
+		// ModifyTunnelDetectionTime holds details about calls to the ModifyTunnelDetectionTime method.
+		ModifyTunnelDetectionTime []struct {
+			// Entity is the entity argument value.
+			Entity string
+			// DetectionTime is the detectionTime argument value.
+			DetectionTime int
+		}
+		// OnboardEdgeNEODevice holds details about calls to the OnboardEdgeNEODevice method.
+		OnboardEdgeNEODevice []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeNEODevice is the edgeNEODevice argument value.
+			EdgeNEODevice *EdgeNEODevice
+		}
+		// PostAPI holds details about calls to the PostAPI method.
+		PostAPI []struct {
+			// Action is the action argument value.
+			Action string
+			// D is the d argument value.
+			D interface{}
+			// CheckFunc is the checkFunc argument value.
+			CheckFunc CheckAPIResponseFunc
+		}
+		// RefreshDCFMitmSysatemCA holds details about calls to the RefreshDCFMitmSysatemCA method.
+		RefreshDCFMitmSysatemCA []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// SetBgpBfdPollingTime holds details about calls to the SetBgpBfdPollingTime method.
+		SetBgpBfdPollingTime []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+			// NewPollingTime is the newPollingTime argument value.
+			NewPollingTime int
+		}
+		// SetBgpBfdPollingTimeGatewayGroup holds details about calls to the SetBgpBfdPollingTimeGatewayGroup method.
+		SetBgpBfdPollingTimeGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// PollingTime is the pollingTime argument value.
+			PollingTime int
+		}
+		// SetBgpBfdPollingTimeSpoke holds details about calls to the SetBgpBfdPollingTimeSpoke method.
+		SetBgpBfdPollingTimeSpoke []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+			// NewPollingTime is the newPollingTime argument value.
+			NewPollingTime int
+		}
+		// SetBgpEcmp holds details about calls to the SetBgpEcmp method.
+		SetBgpEcmp []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+			// Enabled is the enabled argument value.
+			Enabled bool
+		}
+		// SetBgpEcmpGatewayGroup holds details about calls to the SetBgpEcmpGatewayGroup method.
+		SetBgpEcmpGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// Enable is the enable argument value.
+			Enable bool
+		}
+		// SetBgpEcmpSpoke holds details about calls to the SetBgpEcmpSpoke method.
+		SetBgpEcmpSpoke []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+			// Enabled is the enabled argument value.
+			Enabled bool
+		}
+		// SetBgpManualSpokeAdvertisedNetworks holds details about calls to the SetBgpManualSpokeAdvertisedNetworks method.
+		SetBgpManualSpokeAdvertisedNetworks []struct {
+			// TransitGw is the transitGw argument value.
+			TransitGw *TransitVpc
+		}
+		// SetBgpPollingTime holds details about calls to the SetBgpPollingTime method.
+		SetBgpPollingTime []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+			// NewPollingTime is the newPollingTime argument value.
+			NewPollingTime int
+		}
+		// SetBgpPollingTimeGatewayGroup holds details about calls to the SetBgpPollingTimeGatewayGroup method.
+		SetBgpPollingTimeGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// PollingTime is the pollingTime argument value.
+			PollingTime int
+		}
+		// SetBgpPollingTimeSpoke holds details about calls to the SetBgpPollingTimeSpoke method.
+		SetBgpPollingTimeSpoke []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+			// NewPollingTime is the newPollingTime argument value.
+			NewPollingTime int
+		}
+		// SetDefaultIpsProfile holds details about calls to the SetDefaultIpsProfile method.
+		SetDefaultIpsProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Profiles is the profiles argument value.
+			Profiles []string
+		}
+		// SetEnforcementLevel holds details about calls to the SetEnforcementLevel method.
+		SetEnforcementLevel []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnforcementLevel is the enforcementLevel argument value.
+			EnforcementLevel *EnforcementLevel
+		}
+		// SetGatewayBgpCommunitiesAccept holds details about calls to the SetGatewayBgpCommunitiesAccept method.
+		SetGatewayBgpCommunitiesAccept []struct {
+			// GwName is the gwName argument value.
+			GwName string
+			// AcceptComm is the acceptComm argument value.
+			AcceptComm bool
+		}
+		// SetGatewayBgpCommunitiesSend holds details about calls to the SetGatewayBgpCommunitiesSend method.
+		SetGatewayBgpCommunitiesSend []struct {
+			// GwName is the gwName argument value.
+			GwName string
+			// SendComm is the sendComm argument value.
+			SendComm bool
+		}
+		// SetGatewayBgpMedToSdnMetric holds details about calls to the SetGatewayBgpMedToSdnMetric method.
+		SetGatewayBgpMedToSdnMetric []struct {
+			// GwName is the gwName argument value.
+			GwName string
+			// Override is the override argument value.
+			Override bool
+		}
+		// SetGatewayGroupBgpCommunitiesAccept holds details about calls to the SetGatewayGroupBgpCommunitiesAccept method.
+		SetGatewayGroupBgpCommunitiesAccept []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// Accept is the accept argument value.
+			Accept bool
+		}
+		// SetGatewayGroupBgpCommunitiesSend holds details about calls to the SetGatewayGroupBgpCommunitiesSend method.
+		SetGatewayGroupBgpCommunitiesSend []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// Send is the send argument value.
+			Send bool
+		}
+		// SetGatewayPhase2Policy holds details about calls to the SetGatewayPhase2Policy method.
+		SetGatewayPhase2Policy []struct {
+			// GwName is the gwName argument value.
+			GwName string
+			// EncPolicy is the encPolicy argument value.
+			EncPolicy string
+			// PfsPolicy is the pfsPolicy argument value.
+			PfsPolicy string
+		}
+		// SetLocalASNumber holds details about calls to the SetLocalASNumber method.
+		SetLocalASNumber []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+			// LocalASNumber is the localASNumber argument value.
+			LocalASNumber string
+		}
+		// SetLocalASNumberGatewayGroup holds details about calls to the SetLocalASNumberGatewayGroup method.
+		SetLocalASNumberGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// LocalAsNumber is the localAsNumber argument value.
+			LocalAsNumber string
+		}
+		// SetLocalASNumberSpoke holds details about calls to the SetLocalASNumberSpoke method.
+		SetLocalASNumberSpoke []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+			// LocalASNumber is the localASNumber argument value.
+			LocalASNumber string
+		}
+		// SetNewCertificate holds details about calls to the SetNewCertificate method.
+		SetNewCertificate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ProxyCaConfig is the proxyCaConfig argument value.
+			ProxyCaConfig *ProxyCaConfig
+		}
+		// SetPrependASPath holds details about calls to the SetPrependASPath method.
+		SetPrependASPath []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+			// PrependASPath is the prependASPath argument value.
+			PrependASPath []string
+		}
+		// SetPrependASPathGatewayGroup holds details about calls to the SetPrependASPathGatewayGroup method.
+		SetPrependASPathGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// PrependAsPath is the prependAsPath argument value.
+			PrependAsPath []string
+		}
+		// SetPrependASPathSpoke holds details about calls to the SetPrependASPathSpoke method.
+		SetPrependASPathSpoke []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+			// PrependASPath is the prependASPath argument value.
+			PrependASPath []string
+		}
+		// SetRxQueueSize holds details about calls to the SetRxQueueSize method.
+		SetRxQueueSize []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// SetSpokeBgpManualAdvertisedNetworks holds details about calls to the SetSpokeBgpManualAdvertisedNetworks method.
+		SetSpokeBgpManualAdvertisedNetworks []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+		}
+		// SetSpokeBgpManualAdvertisedNetworksGatewayGroup holds details about calls to the SetSpokeBgpManualAdvertisedNetworksGatewayGroup method.
+		SetSpokeBgpManualAdvertisedNetworksGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// Cidrs is the cidrs argument value.
+			Cidrs string
+		}
+		// SetSymmetricRoutingGatewayGroup holds details about calls to the SetSymmetricRoutingGatewayGroup method.
+		SetSymmetricRoutingGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupUUID is the groupUUID argument value.
+			GroupUUID string
+			// Enable is the enable argument value.
+			Enable bool
+		}
+		// SetTransitLearnedCIDRsApprovalMode holds details about calls to the SetTransitLearnedCIDRsApprovalMode method.
+		SetTransitLearnedCIDRsApprovalMode []struct {
+			// Gw is the gw argument value.
+			Gw *TransitVpc
+			// Mode is the mode argument value.
+			Mode string
+		}
+		// SetVpnGatewayAuthentication holds details about calls to the SetVpnGatewayAuthentication method.
+		SetVpnGatewayAuthentication []struct {
+			// Gateway is the gateway argument value.
+			Gateway *VpnGatewayAuth
+		}
+		// UpdateAccount holds details about calls to the UpdateAccount method.
+		UpdateAccount []struct {
+			// Account is the account argument value.
+			Account *Account
+		}
+		// UpdateAccountUserObject holds details about calls to the UpdateAccountUserObject method.
+		UpdateAccountUserObject []struct {
+			// User is the user argument value.
+			User *AccountUserEdit
+		}
+		// UpdateDCFMitmCa holds details about calls to the UpdateDCFMitmCa method.
+		UpdateDCFMitmCa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CaID is the caID argument value.
+			CaID string
+			// PatchRequest is the patchRequest argument value.
+			PatchRequest *MitmCaPatchRequest
+		}
+		// UpdateDCFPolicyBlock holds details about calls to the UpdateDCFPolicyBlock method.
+		UpdateDCFPolicyBlock []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PolicyBlock is the policyBlock argument value.
+			PolicyBlock *DCFPolicyBlock
+		}
+		// UpdateDCFPolicyList holds details about calls to the UpdateDCFPolicyList method.
+		UpdateDCFPolicyList []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PolicyList is the policyList argument value.
+			PolicyList *DCFPolicyList
+		}
+		// UpdateDCFTrustBundle holds details about calls to the UpdateDCFTrustBundle method.
+		UpdateDCFTrustBundle []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// BundleUUID is the bundleUUID argument value.
+			BundleUUID string
+			// TrustBundle is the trustBundle argument value.
+			TrustBundle *TrustBundleItemRequest
+		}
+		// UpdateDNat holds details about calls to the UpdateDNat method.
+		UpdateDNat []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// UpdateDistributedFirewallingDefaultActionRule holds details about calls to the UpdateDistributedFirewallingDefaultActionRule method.
+		UpdateDistributedFirewallingDefaultActionRule []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Request is the request argument value.
+			Request *DistributedFirewallingDefaultActionRule
+		}
+		// UpdateDistributedFirewallingPolicyList holds details about calls to the UpdateDistributedFirewallingPolicyList method.
+		UpdateDistributedFirewallingPolicyList []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PolicyList is the policyList argument value.
+			PolicyList *DistributedFirewallingPolicyList
+		}
+		// UpdateEdgeAccount holds details about calls to the UpdateEdgeAccount method.
+		UpdateEdgeAccount []struct {
+			// EdgeAccount is the edgeAccount argument value.
+			EdgeAccount *EdgeAccount
+		}
+		// UpdateEdgeCSP holds details about calls to the UpdateEdgeCSP method.
+		UpdateEdgeCSP []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeCSP is the edgeCSP argument value.
+			EdgeCSP *EdgeCSP
+		}
+		// UpdateEdgeCSPHa holds details about calls to the UpdateEdgeCSPHa method.
+		UpdateEdgeCSPHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeCSP is the edgeCSP argument value.
+			EdgeCSP *EdgeCSP
+		}
+		// UpdateEdgeEquinix holds details about calls to the UpdateEdgeEquinix method.
+		UpdateEdgeEquinix []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeEquinix is the edgeEquinix argument value.
+			EdgeEquinix *EdgeEquinix
+		}
+		// UpdateEdgeEquinixHa holds details about calls to the UpdateEdgeEquinixHa method.
+		UpdateEdgeEquinixHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeEquinix is the edgeEquinix argument value.
+			EdgeEquinix *EdgeEquinix
+		}
+		// UpdateEdgeGateway holds details about calls to the UpdateEdgeGateway method.
+		UpdateEdgeGateway []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// UpdateEdgeGatewayV2 holds details about calls to the UpdateEdgeGatewayV2 method.
+		UpdateEdgeGatewayV2 []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// UpdateEdgeMegaport holds details about calls to the UpdateEdgeMegaport method.
+		UpdateEdgeMegaport []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeMegaport is the edgeMegaport argument value.
+			EdgeMegaport *EdgeMegaport
+		}
+		// UpdateEdgeMegaportHa holds details about calls to the UpdateEdgeMegaportHa method.
+		UpdateEdgeMegaportHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeMegaport is the edgeMegaport argument value.
+			EdgeMegaport *EdgeMegaport
+		}
+		// UpdateEdgeNEO holds details about calls to the UpdateEdgeNEO method.
+		UpdateEdgeNEO []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeNEO is the edgeNEO argument value.
+			EdgeNEO *EdgeNEO
+		}
+		// UpdateEdgeNEODevice holds details about calls to the UpdateEdgeNEODevice method.
+		UpdateEdgeNEODevice []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeNEODevice is the edgeNEODevice argument value.
+			EdgeNEODevice *EdgeNEODevice
+		}
+		// UpdateEdgeNEOHa holds details about calls to the UpdateEdgeNEOHa method.
+		UpdateEdgeNEOHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeNEO is the edgeNEO argument value.
+			EdgeNEO *EdgeNEO
+		}
+		// UpdateEdgeProxyProfile holds details about calls to the UpdateEdgeProxyProfile method.
+		UpdateEdgeProxyProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeNEOProxyProfile is the edgeNEOProxyProfile argument value.
+			EdgeNEOProxyProfile *EdgePlatformProxyProfileUpdate
+		}
+		// UpdateEdgeSpoke holds details about calls to the UpdateEdgeSpoke method.
+		UpdateEdgeSpoke []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeSpoke is the edgeSpoke argument value.
+			EdgeSpoke *EdgeSpoke
+		}
+		// UpdateEdgeSpokeGeoCoordinate holds details about calls to the UpdateEdgeSpokeGeoCoordinate method.
+		UpdateEdgeSpokeGeoCoordinate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeSpoke is the edgeSpoke argument value.
+			EdgeSpoke *EdgeSpoke
+		}
+		// UpdateEdgeSpokeTransitPeeringTunnelCount holds details about calls to the UpdateEdgeSpokeTransitPeeringTunnelCount method.
+		UpdateEdgeSpokeTransitPeeringTunnelCount []struct {
+			// Gateway1 is the gateway1 argument value.
+			Gateway1 string
+			// Gateway2 is the gateway2 argument value.
+			Gateway2 string
+			// TunnelCount is the tunnelCount argument value.
+			TunnelCount int
+		}
+		// UpdateEdgeVmSelfmanagedHa holds details about calls to the UpdateEdgeVmSelfmanagedHa method.
+		UpdateEdgeVmSelfmanagedHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeVmSelfmanaged is the edgeVmSelfmanaged argument value.
+			EdgeVmSelfmanaged *EdgeSpoke
+		}
+		// UpdateEnforcementLevel holds details about calls to the UpdateEnforcementLevel method.
+		UpdateEnforcementLevel []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnforcementLevel is the enforcementLevel argument value.
+			EnforcementLevel *EnforcementLevel
+		}
+		// UpdateGCPAccount holds details about calls to the UpdateGCPAccount method.
+		UpdateGCPAccount []struct {
+			// Account is the account argument value.
+			Account *Account
+		}
+		// UpdateGateway holds details about calls to the UpdateGateway method.
+		UpdateGateway []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// UpdateGatewayContext holds details about calls to the UpdateGatewayContext method.
+		UpdateGatewayContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// UpdateGroupInstanceSize holds details about calls to the UpdateGroupInstanceSize method.
+		UpdateGroupInstanceSize []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// InstanceSize is the instanceSize argument value.
+			InstanceSize string
+		}
+		// UpdateIpsProfile holds details about calls to the UpdateIpsProfile method.
+		UpdateIpsProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+			// Profile is the profile argument value.
+			Profile *IpsProfile
+		}
+		// UpdateIpsRuleFeed holds details about calls to the UpdateIpsRuleFeed method.
+		UpdateIpsRuleFeed []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+			// RuleFeed is the ruleFeed argument value.
+			RuleFeed *IpsRuleFeed
+		}
+		// UpdateMaxVpnConn holds details about calls to the UpdateMaxVpnConn method.
+		UpdateMaxVpnConn []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// UpdateSite2Cloud holds details about calls to the UpdateSite2Cloud method.
+		UpdateSite2Cloud []struct {
+			// Site2cloud is the site2cloud argument value.
+			Site2cloud *EditSite2Cloud
+		}
+		// UpdateSmartGroup holds details about calls to the UpdateSmartGroup method.
+		UpdateSmartGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SmartGroup is the smartGroup argument value.
+			SmartGroup *SmartGroup
+			// UUID is the uuid argument value.
+			UUID string
+		}
+		// UpdateSpokeGatewaySubnetGroup holds details about calls to the UpdateSpokeGatewaySubnetGroup method.
+		UpdateSpokeGatewaySubnetGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpokeGatewaySubnetGroup is the spokeGatewaySubnetGroup argument value.
+			SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+		}
+		// UpdateSpokePendingApprovedCidrs holds details about calls to the UpdateSpokePendingApprovedCidrs method.
+		UpdateSpokePendingApprovedCidrs []struct {
+			// Gateway is the gateway argument value.
+			Gateway *SpokeVpc
+		}
+		// UpdateSpokePendingApprovedCidrsGatewayGroup holds details about calls to the UpdateSpokePendingApprovedCidrsGatewayGroup method.
+		UpdateSpokePendingApprovedCidrsGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// ApprovedCidrs is the approvedCidrs argument value.
+			ApprovedCidrs []string
+		}
+		// UpdateTLSProfile holds details about calls to the UpdateTLSProfile method.
+		UpdateTLSProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+			// TlsProfile is the tlsProfile argument value.
+			TlsProfile *TLSProfile
+		}
+		// UpdateTags holds details about calls to the UpdateTags method.
+		UpdateTags []struct {
+			// Tags is the tags argument value.
+			Tags *Tags
+		}
+		// UpdateTransitGatewayCustomizedVpcRoute holds details about calls to the UpdateTransitGatewayCustomizedVpcRoute method.
+		UpdateTransitGatewayCustomizedVpcRoute []struct {
+			// Gateway is the gateway argument value.
+			Gateway string
+			// CustomizedTransitVpcRoutes is the customizedTransitVpcRoutes argument value.
+			CustomizedTransitVpcRoutes []string
+		}
+		// UpdateTransitPendingApprovedCidrs holds details about calls to the UpdateTransitPendingApprovedCidrs method.
+		UpdateTransitPendingApprovedCidrs []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// UpdateTransitPendingApprovedCidrsGatewayGroup holds details about calls to the UpdateTransitPendingApprovedCidrsGatewayGroup method.
+		UpdateTransitPendingApprovedCidrsGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// ApprovedCidrs is the approvedCidrs argument value.
+			ApprovedCidrs []string
+		}
+		// UpdateVpnCidr holds details about calls to the UpdateVpnCidr method.
+		UpdateVpnCidr []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// UpdateWebGroup holds details about calls to the UpdateWebGroup method.
+		UpdateWebGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WebGroup is the webGroup argument value.
+			WebGroup *WebGroup
+			// UUID is the uuid argument value.
+			UUID string
+		}
+	}
+	lockAddSpokeGatewaySubnetGroup                       sync.RWMutex
+	lockAttachTransitGWForHybrid                         sync.RWMutex
+	lockAuditAccount                                     sync.RWMutex
+	lockChangeBgpHoldTime                                sync.RWMutex
+	lockChangeBgpHoldTimeGatewayGroup                    sync.RWMutex
+	lockChangeBgpOverLanIntfCnt                          sync.RWMutex
+	lockCreateAccount                                    sync.RWMutex
+	lockCreateAccountUser                                sync.RWMutex
+	lockCreateDCFMitmCa                                  sync.RWMutex
+	lockCreateDCFPolicyBlock                             sync.RWMutex
+	lockCreateDCFPolicyList                              sync.RWMutex
+	lockCreateDCFTrustBundle                             sync.RWMutex
+	lockCreateDistributedFirewallingDeploymentPolicy     sync.RWMutex
+	lockCreateDistributedFirewallingIntraVpc             sync.RWMutex
+	lockCreateDistributedFirewallingPolicyList           sync.RWMutex
+	lockCreateEdgeAccount                                sync.RWMutex
+	lockCreateEdgeCSP                                    sync.RWMutex
+	lockCreateEdgeCSPHa                                  sync.RWMutex
+	lockCreateEdgeEquinix                                sync.RWMutex
+	lockCreateEdgeEquinixHa                              sync.RWMutex
+	lockCreateEdgeExternalDeviceConn                     sync.RWMutex
+	lockCreateEdgeMegaport                               sync.RWMutex
+	lockCreateEdgeMegaportHa                             sync.RWMutex
+	lockCreateEdgeNEO                                    sync.RWMutex
+	lockCreateEdgeNEOHa                                  sync.RWMutex
+	lockCreateEdgeProxyProfile                           sync.RWMutex
+	lockCreateEdgeSpoke                                  sync.RWMutex
+	lockCreateEdgeVmSelfmanagedHa                        sync.RWMutex
+	lockCreateExternalDeviceConn                         sync.RWMutex
+	lockCreateGCPAccount                                 sync.RWMutex
+	lockCreateGatewayContext                             sync.RWMutex
+	lockCreateGatewayGroup                               sync.RWMutex
+	lockCreateIpsProfile                                 sync.RWMutex
+	lockCreateIpsRuleFeed                                sync.RWMutex
+	lockCreateOCIAccount                                 sync.RWMutex
+	lockCreatePublicSubnetFilteringGatewayContext        sync.RWMutex
+	lockCreateS2CCaCert                                  sync.RWMutex
+	lockCreateSegmentationSecurityDomain                 sync.RWMutex
+	lockCreateSegmentationSecurityDomainAssociation      sync.RWMutex
+	lockCreateSegmentationSecurityDomainConnectionPolicy sync.RWMutex
+	lockCreateSite2Cloud                                 sync.RWMutex
+	lockCreateSite2CloudContext                          sync.RWMutex
+	lockCreateSmartGroup                                 sync.RWMutex
+	lockCreateSpokeHaGw                                  sync.RWMutex
+	lockCreateSpokeHaGwContext                           sync.RWMutex
+	lockCreateSpokeTransitAttachment                     sync.RWMutex
+	lockCreateTLSProfile                                 sync.RWMutex
+	lockCreateTransitHaGwContext                         sync.RWMutex
+	lockCreateWebGroup                                   sync.RWMutex
+	lockDeleteAccount                                    sync.RWMutex
+	lockDeleteAccountUser                                sync.RWMutex
+	lockDeleteCaCertificate                              sync.RWMutex
+	lockDeleteCertInstance                               sync.RWMutex
+	lockDeleteDCFMitmCa                                  sync.RWMutex
+	lockDeleteDCFPolicyBlock                             sync.RWMutex
+	lockDeleteDCFPolicyList                              sync.RWMutex
+	lockDeleteDCFTrustBundle                             sync.RWMutex
+	lockDeleteDistributedFirewallingIntraVpc             sync.RWMutex
+	lockDeleteDistributedFirewallingPolicyList           sync.RWMutex
+	lockDeleteEdgeCSP                                    sync.RWMutex
+	lockDeleteEdgeEquinix                                sync.RWMutex
+	lockDeleteEdgeExternalDeviceConn                     sync.RWMutex
+	lockDeleteEdgeMegaport                               sync.RWMutex
+	lockDeleteEdgeNEO                                    sync.RWMutex
+	lockDeleteEdgeNEODevice                              sync.RWMutex
+	lockDeleteEdgePlatformProxyProfile                   sync.RWMutex
+	lockDeleteEdgeSpoke                                  sync.RWMutex
+	lockDeleteEnforcementLevel                           sync.RWMutex
+	lockDeleteExternalDeviceConn                         sync.RWMutex
+	lockDeleteGateway                                    sync.RWMutex
+	lockDeleteGatewayContext                             sync.RWMutex
+	lockDeleteGatewayGroup                               sync.RWMutex
+	lockDeleteIpsProfile                                 sync.RWMutex
+	lockDeleteIpsRuleFeed                                sync.RWMutex
+	lockDeletePublicSubnetFilteringGatewayContext        sync.RWMutex
+	lockDeleteSegmentationSecurityDomain                 sync.RWMutex
+	lockDeleteSegmentationSecurityDomainAssociation      sync.RWMutex
+	lockDeleteSegmentationSecurityDomainConnectionPolicy sync.RWMutex
+	lockDeleteSite2Cloud                                 sync.RWMutex
+	lockDeleteSite2CloudContext                          sync.RWMutex
+	lockDeleteSmartGroup                                 sync.RWMutex
+	lockDeleteSpokeGatewaySubnetGroup                    sync.RWMutex
+	lockDeleteSpokeTransitAttachment                     sync.RWMutex
+	lockDeleteTLSProfile                                 sync.RWMutex
+	lockDeleteWebGroup                                   sync.RWMutex
+	lockDetachTransitGWForHybrid                         sync.RWMutex
+	lockDisableActiveStandby                             sync.RWMutex
+	lockDisableActiveStandbyGatewayGroup                 sync.RWMutex
+	lockDisableActiveStandbySpoke                        sync.RWMutex
+	lockDisableAdvertiseTransitCidr                      sync.RWMutex
+	lockDisableAdvertiseTransitCidrGatewayGroup          sync.RWMutex
+	lockDisableAutoAdvertiseS2CCidrs                     sync.RWMutex
+	lockDisableAutoAdvertiseS2CCidrsGatewayGroup         sync.RWMutex
+	lockDisableConnectedTransit                          sync.RWMutex
+	lockDisableConnectedTransitGatewayGroup              sync.RWMutex
+	lockDisableCustomSNat                                sync.RWMutex
+	lockDisableDeadPeerDetection                         sync.RWMutex
+	lockDisableDeadPeerDetectionContext                  sync.RWMutex
+	lockDisableDistributedFirewalling                    sync.RWMutex
+	lockDisableEdgeSpokeTransitiveRouting                sync.RWMutex
+	lockDisableEgressTransitFirenet                      sync.RWMutex
+	lockDisableFireNetGatewayGroup                       sync.RWMutex
+	lockDisableGatewayFireNetInterfaces                  sync.RWMutex
+	lockDisableGatewayGroupSNat                          sync.RWMutex
+	lockDisableGatewayGroupVpcDNSServer                  sync.RWMutex
+	lockDisableGatewayLoadBalancerGatewayGroup           sync.RWMutex
+	lockDisableGlobalVpc                                 sync.RWMutex
+	lockDisableGlobalVpcGatewayGroup                     sync.RWMutex
+	lockDisableGroGso                                    sync.RWMutex
+	lockDisableGroGsoGatewayGroup                        sync.RWMutex
+	lockDisableGuardDutyEnforcement                      sync.RWMutex
+	lockDisableHybridConnectionGatewayGroup              sync.RWMutex
+	lockDisableIPv6                                      sync.RWMutex
+	lockDisableIPv6GatewayGroup                          sync.RWMutex
+	lockDisableJumboFrame                                sync.RWMutex
+	lockDisableJumboFrameExternalDeviceConn              sync.RWMutex
+	lockDisableJumboFrameGatewayGroup                    sync.RWMutex
+	lockDisableMonitorGatewaySubnets                     sync.RWMutex
+	lockDisableMultiTierTransitGatewayGroup              sync.RWMutex
+	lockDisableMultitierTransit                          sync.RWMutex
+	lockDisablePrivateVpcDefaultRoute                    sync.RWMutex
+	lockDisablePrivateVpcDefaultRouteGatewayGroup        sync.RWMutex
+	lockDisableS2CRxBalancing                            sync.RWMutex
+	lockDisableS2cRxBalancingGatewayGroup                sync.RWMutex
+	lockDisableSNat                                      sync.RWMutex
+	lockDisableSegmentation                              sync.RWMutex
+	lockDisableSegmentationGatewayGroup                  sync.RWMutex
+	lockDisableSingleAZGateway                           sync.RWMutex
+	lockDisableSite2CloudEventTriggeredHA                sync.RWMutex
+	lockDisableSite2cloudActiveActive                    sync.RWMutex
+	lockDisableSkipPublicRouteUpdate                     sync.RWMutex
+	lockDisableSkipPublicRouteUpdateGatewayGroup         sync.RWMutex
+	lockDisableSpokeLearnedCidrsApproval                 sync.RWMutex
+	lockDisableSpokeLearnedCidrsApprovalGatewayGroup     sync.RWMutex
+	lockDisableSpokeMappedSite2CloudForwarding           sync.RWMutex
+	lockDisableSpokeOnpremRoutePropagation               sync.RWMutex
+	lockDisableSpokeOnpremRoutePropagationGatewayGroup   sync.RWMutex
+	lockDisableSpokePreserveAsPath                       sync.RWMutex
+	lockDisableSpokePreserveAsPathGatewayGroup           sync.RWMutex
+	lockDisableSummarizeCidrToTgw                        sync.RWMutex
+	lockDisableTransitFireNet                            sync.RWMutex
+	lockDisableTransitFireNetGatewayGroup                sync.RWMutex
+	lockDisableTransitLearnedCidrsApproval               sync.RWMutex
+	lockDisableTransitLearnedCidrsApprovalGatewayGroup   sync.RWMutex
+	lockDisableTransitPreserveAsPath                     sync.RWMutex
+	lockDisableTransitPreserveAsPathGatewayGroup         sync.RWMutex
+	lockDisableTransitSummarizeCidrToTgwGatewayGroup     sync.RWMutex
+	lockDisableVPNConfig                                 sync.RWMutex
+	lockDisableVpcDNSServer                              sync.RWMutex
+	lockDisableVpnNat                                    sync.RWMutex
+	lockDownloadEdgeNEOConfigFile                        sync.RWMutex
+	lockEditBgpMd5Key                                    sync.RWMutex
+	lockEditConnectionBgpBfd                             sync.RWMutex
+	lockEditConnectionBgpMultihop                        sync.RWMutex
+	lockEditDesignatedGateway                            sync.RWMutex
+	lockEditGatewayAdvertisedCidr                        sync.RWMutex
+	lockEditGatewayCustomRoutes                          sync.RWMutex
+	lockEditGatewayFilterRoutes                          sync.RWMutex
+	lockEditManagedRouteTables                           sync.RWMutex
+	lockEditManagedRouteTablesForGatewayGroup            sync.RWMutex
+	lockEditPrivateRouteTableConfig                      sync.RWMutex
+	lockEditPrivateRouteTableConfigForGatewayGroup       sync.RWMutex
+	lockEditPublicSubnetFilteringRouteTableList          sync.RWMutex
+	lockEditSite2CloudPhase1LocalIdentifier              sync.RWMutex
+	lockEditSpokeExternalDeviceConnASPathPrepend         sync.RWMutex
+	lockEditSpokeTransitAttachmentFilters                sync.RWMutex
+	lockEditTransitConnectionASPathPrepend               sync.RWMutex
+	lockEditTransitConnectionBGPManualAdvertiseCIDRs     sync.RWMutex
+	lockEnableActiveStandby                              sync.RWMutex
+	lockEnableActiveStandbyGatewayGroup                  sync.RWMutex
+	lockEnableActiveStandbyPreemptive                    sync.RWMutex
+	lockEnableActiveStandbyPreemptiveGatewayGroup        sync.RWMutex
+	lockEnableActiveStandbyPreemptiveSpoke               sync.RWMutex
+	lockEnableActiveStandbySpoke                         sync.RWMutex
+	lockEnableAdvertiseTransitCidr                       sync.RWMutex
+	lockEnableAdvertiseTransitCidrGatewayGroup           sync.RWMutex
+	lockEnableAutoAdvertiseS2CCidrs                      sync.RWMutex
+	lockEnableAutoAdvertiseS2CCidrsGatewayGroup          sync.RWMutex
+	lockEnableConnectedTransit                           sync.RWMutex
+	lockEnableConnectedTransitGatewayGroup               sync.RWMutex
+	lockEnableCustomizedSNat                             sync.RWMutex
+	lockEnableDeadPeerDetection                          sync.RWMutex
+	lockEnableDistributedFirewalling                     sync.RWMutex
+	lockEnableEdgeSpokeTransitiveRouting                 sync.RWMutex
+	lockEnableEgressTransitFirenet                       sync.RWMutex
+	lockEnableEncryptVolume                              sync.RWMutex
+	lockEnableFireNetGatewayGroup                        sync.RWMutex
+	lockEnableGatewayFireNetInterfaces                   sync.RWMutex
+	lockEnableGatewayFireNetInterfacesWithGWLB           sync.RWMutex
+	lockEnableGatewayGroupSNat                           sync.RWMutex
+	lockEnableGatewayGroupVpcDNSServer                   sync.RWMutex
+	lockEnableGatewayLoadBalancerGatewayGroup            sync.RWMutex
+	lockEnableGlobalVpc                                  sync.RWMutex
+	lockEnableGlobalVpcGatewayGroup                      sync.RWMutex
+	lockEnableGroGso                                     sync.RWMutex
+	lockEnableGroGsoGatewayGroup                         sync.RWMutex
+	lockEnableGuardDutyEnforcement                       sync.RWMutex
+	lockEnableHybridConnectionGatewayGroup               sync.RWMutex
+	lockEnableIPv6                                       sync.RWMutex
+	lockEnableIPv6GatewayGroup                           sync.RWMutex
+	lockEnableJumboFrame                                 sync.RWMutex
+	lockEnableJumboFrameExternalDeviceConn               sync.RWMutex
+	lockEnableJumboFrameGatewayGroup                     sync.RWMutex
+	lockEnableMonitorGatewaySubnets                      sync.RWMutex
+	lockEnableMultiTierTransitGatewayGroup               sync.RWMutex
+	lockEnableMultitierTransit                           sync.RWMutex
+	lockEnablePeeringHaGateway                           sync.RWMutex
+	lockEnablePrivateVpcDefaultRoute                     sync.RWMutex
+	lockEnablePrivateVpcDefaultRouteGatewayGroup         sync.RWMutex
+	lockEnablePublicSubnetFilteringHAGateway             sync.RWMutex
+	lockEnableS2CRxBalancing                             sync.RWMutex
+	lockEnableS2cRxBalancingGatewayGroup                 sync.RWMutex
+	lockEnableSNat                                       sync.RWMutex
+	lockEnableSegmentation                               sync.RWMutex
+	lockEnableSegmentationGatewayGroup                   sync.RWMutex
+	lockEnableSingleAZGateway                            sync.RWMutex
+	lockEnableSite2CloudEventTriggeredHA                 sync.RWMutex
+	lockEnableSite2cloudActiveActive                     sync.RWMutex
+	lockEnableSite2cloudActiveActiveContext              sync.RWMutex
+	lockEnableSkipPublicRouteUpdate                      sync.RWMutex
+	lockEnableSkipPublicRouteUpdateGatewayGroup          sync.RWMutex
+	lockEnableSpokeLearnedCidrsApproval                  sync.RWMutex
+	lockEnableSpokeLearnedCidrsApprovalGatewayGroup      sync.RWMutex
+	lockEnableSpokeMappedSite2CloudForwarding            sync.RWMutex
+	lockEnableSpokeOnpremRoutePropagation                sync.RWMutex
+	lockEnableSpokeOnpremRoutePropagationGatewayGroup    sync.RWMutex
+	lockEnableSpokePreserveAsPath                        sync.RWMutex
+	lockEnableSpokePreserveAsPathGatewayGroup            sync.RWMutex
+	lockEnableSummarizeCidrToTgw                         sync.RWMutex
+	lockEnableTransitFireNet                             sync.RWMutex
+	lockEnableTransitFireNetGatewayGroup                 sync.RWMutex
+	lockEnableTransitFireNetWithGWLB                     sync.RWMutex
+	lockEnableTransitFireNetWithGWLBGatewayGroup         sync.RWMutex
+	lockEnableTransitLearnedCidrsApproval                sync.RWMutex
+	lockEnableTransitLearnedCidrsApprovalGatewayGroup    sync.RWMutex
+	lockEnableTransitPreserveAsPath                      sync.RWMutex
+	lockEnableTransitPreserveAsPathGatewayGroup          sync.RWMutex
+	lockEnableTransitSummarizeCidrToTgwGatewayGroup      sync.RWMutex
+	lockEnableVPNConfig                                  sync.RWMutex
+	lockEnableVpcDNSServer                               sync.RWMutex
+	lockEnableVpnNat                                     sync.RWMutex
+	lockGetAccount                                       sync.RWMutex
+	lockGetAccountUser                                   sync.RWMutex
+	lockGetBgpLanIPList                                  sync.RWMutex
+	lockGetCID                                           sync.RWMutex
+	lockGetCaCertificate                                 sync.RWMutex
+	lockGetCompatibleImageVersion                        sync.RWMutex
+	lockGetControllerIP                                  sync.RWMutex
+	lockGetDCFAttachmentPoint                            sync.RWMutex
+	lockGetDCFMitmCa                                     sync.RWMutex
+	lockGetDCFPolicyBlock                                sync.RWMutex
+	lockGetDCFPolicyList                                 sync.RWMutex
+	lockGetDCFTrustBundleByID                            sync.RWMutex
+	lockGetDCFTrustBundleByName                          sync.RWMutex
+	lockGetDefaultIpsProfile                             sync.RWMutex
+	lockGetDefaultTagsConfig                             sync.RWMutex
+	lockGetDistributedFirewallingDefaultActionRule       sync.RWMutex
+	lockGetDistributedFirewallingDeploymentPolicy        sync.RWMutex
+	lockGetDistributedFirewallingIntraVpc                sync.RWMutex
+	lockGetDistributedFirewallingPolicyList              sync.RWMutex
+	lockGetDistributedFirewallingStatus                  sync.RWMutex
+	lockGetEdgeCSP                                       sync.RWMutex
+	lockGetEdgeCSPHa                                     sync.RWMutex
+	lockGetEdgeEquinix                                   sync.RWMutex
+	lockGetEdgeEquinixHa                                 sync.RWMutex
+	lockGetEdgeGatewayWanIp                              sync.RWMutex
+	lockGetEdgeMegaport                                  sync.RWMutex
+	lockGetEdgeMegaportHa                                sync.RWMutex
+	lockGetEdgeNEO                                       sync.RWMutex
+	lockGetEdgeNEODevice                                 sync.RWMutex
+	lockGetEdgeNEOHa                                     sync.RWMutex
+	lockGetEdgePlatformProxyProfile                      sync.RWMutex
+	lockGetEdgeSpoke                                     sync.RWMutex
+	lockGetEdgeSpokeTransitAttachment                    sync.RWMutex
+	lockGetEdgeVmSelfmanagedHa                           sync.RWMutex
+	lockGetEnforcementLevel                              sync.RWMutex
+	lockGetExternalDeviceConnDetail                      sync.RWMutex
+	lockGetGateway                                       sync.RWMutex
+	lockGetGatewayBgpCommunities                         sync.RWMutex
+	lockGetGatewayBgpMedToSdnMetric                      sync.RWMutex
+	lockGetGatewayContext                                sync.RWMutex
+	lockGetGatewayDetail                                 sync.RWMutex
+	lockGetGatewayGroup                                  sync.RWMutex
+	lockGetGatewayGroupByName                            sync.RWMutex
+	lockGetGeoVPNName                                    sync.RWMutex
+	lockGetGroGsoStatus                                  sync.RWMutex
+	lockGetIgnoreTagsConfig                              sync.RWMutex
+	lockGetIpsProfile                                    sync.RWMutex
+	lockGetIpsRuleFeed                                   sync.RWMutex
+	lockGetLogProfileByName                              sync.RWMutex
+	lockGetMetaCaCertificate                             sync.RWMutex
+	lockGetS2CCaCertTag                                  sync.RWMutex
+	lockGetSegmentationSecurityDomain                    sync.RWMutex
+	lockGetSegmentationSecurityDomainAssociation         sync.RWMutex
+	lockGetSegmentationSecurityDomainConnectionPolicy    sync.RWMutex
+	lockGetSite2CloudConnDetail                          sync.RWMutex
+	lockGetSmartGroup                                    sync.RWMutex
+	lockGetSmartGroups                                   sync.RWMutex
+	lockGetSplitTunnel                                   sync.RWMutex
+	lockGetSpokeGatewayAdvancedConfig                    sync.RWMutex
+	lockGetSpokeGatewayList                              sync.RWMutex
+	lockGetSpokeGatewaySubnetGroup                       sync.RWMutex
+	lockGetTLSProfile                                    sync.RWMutex
+	lockGetTLSProfileByName                              sync.RWMutex
+	lockGetTags                                          sync.RWMutex
+	lockGetTransitGatewayAdvancedConfig                  sync.RWMutex
+	lockGetTransitGatewayLanCidr                         sync.RWMutex
+	lockGetTransitGatewayList                            sync.RWMutex
+	lockGetTunnelDetectionTime                           sync.RWMutex
+	lockGetWebGroup                                      sync.RWMutex
+	lockGetWebGroupByName                                sync.RWMutex
+	lockInvalidateCache                                  sync.RWMutex
+	lockIsTransitFireNetReadyToBeDisabled                sync.RWMutex
+	lockLaunchSpokeVpcContext                            sync.RWMutex
+	lockLaunchTransitVpcContext                          sync.RWMutex
+	lockListDCFMitmCa                                    sync.RWMutex
+	lockListGatewayRegions                               sync.RWMutex
+	lockListGatewaySizes                                 sync.RWMutex
+	lockListSegmentationSecurityDomains                  sync.RWMutex
+	lockListSite2CloudContext                            sync.RWMutex
+	lockModifySplitTunnel                                sync.RWMutex
+	lockModifyTunnelDetectionTime                        sync.RWMutex
+	lockOnboardEdgeNEODevice                             sync.RWMutex
+	lockPostAPI                                          sync.RWMutex
+	lockRefreshDCFMitmSysatemCA                          sync.RWMutex
+	lockSetBgpBfdPollingTime                             sync.RWMutex
+	lockSetBgpBfdPollingTimeGatewayGroup                 sync.RWMutex
+	lockSetBgpBfdPollingTimeSpoke                        sync.RWMutex
+	lockSetBgpEcmp                                       sync.RWMutex
+	lockSetBgpEcmpGatewayGroup                           sync.RWMutex
+	lockSetBgpEcmpSpoke                                  sync.RWMutex
+	lockSetBgpManualSpokeAdvertisedNetworks              sync.RWMutex
+	lockSetBgpPollingTime                                sync.RWMutex
+	lockSetBgpPollingTimeGatewayGroup                    sync.RWMutex
+	lockSetBgpPollingTimeSpoke                           sync.RWMutex
+	lockSetDefaultIpsProfile                             sync.RWMutex
+	lockSetEnforcementLevel                              sync.RWMutex
+	lockSetGatewayBgpCommunitiesAccept                   sync.RWMutex
+	lockSetGatewayBgpCommunitiesSend                     sync.RWMutex
+	lockSetGatewayBgpMedToSdnMetric                      sync.RWMutex
+	lockSetGatewayGroupBgpCommunitiesAccept              sync.RWMutex
+	lockSetGatewayGroupBgpCommunitiesSend                sync.RWMutex
+	lockSetGatewayPhase2Policy                           sync.RWMutex
+	lockSetLocalASNumber                                 sync.RWMutex
+	lockSetLocalASNumberGatewayGroup                     sync.RWMutex
+	lockSetLocalASNumberSpoke                            sync.RWMutex
+	lockSetNewCertificate                                sync.RWMutex
+	lockSetPrependASPath                                 sync.RWMutex
+	lockSetPrependASPathGatewayGroup                     sync.RWMutex
+	lockSetPrependASPathSpoke                            sync.RWMutex
+	lockSetRxQueueSize                                   sync.RWMutex
+	lockSetSpokeBgpManualAdvertisedNetworks              sync.RWMutex
+	lockSetSpokeBgpManualAdvertisedNetworksGatewayGroup  sync.RWMutex
+	lockSetSymmetricRoutingGatewayGroup                  sync.RWMutex
+	lockSetTransitLearnedCIDRsApprovalMode               sync.RWMutex
+	lockSetVpnGatewayAuthentication                      sync.RWMutex
+	lockUpdateAccount                                    sync.RWMutex
+	lockUpdateAccountUserObject                          sync.RWMutex
+	lockUpdateDCFMitmCa                                  sync.RWMutex
+	lockUpdateDCFPolicyBlock                             sync.RWMutex
+	lockUpdateDCFPolicyList                              sync.RWMutex
+	lockUpdateDCFTrustBundle                             sync.RWMutex
+	lockUpdateDNat                                       sync.RWMutex
+	lockUpdateDistributedFirewallingDefaultActionRule    sync.RWMutex
+	lockUpdateDistributedFirewallingPolicyList           sync.RWMutex
+	lockUpdateEdgeAccount                                sync.RWMutex
+	lockUpdateEdgeCSP                                    sync.RWMutex
+	lockUpdateEdgeCSPHa                                  sync.RWMutex
+	lockUpdateEdgeEquinix                                sync.RWMutex
+	lockUpdateEdgeEquinixHa                              sync.RWMutex
+	lockUpdateEdgeGateway                                sync.RWMutex
+	lockUpdateEdgeGatewayV2                              sync.RWMutex
+	lockUpdateEdgeMegaport                               sync.RWMutex
+	lockUpdateEdgeMegaportHa                             sync.RWMutex
+	lockUpdateEdgeNEO                                    sync.RWMutex
+	lockUpdateEdgeNEODevice                              sync.RWMutex
+	lockUpdateEdgeNEOHa                                  sync.RWMutex
+	lockUpdateEdgeProxyProfile                           sync.RWMutex
+	lockUpdateEdgeSpoke                                  sync.RWMutex
+	lockUpdateEdgeSpokeGeoCoordinate                     sync.RWMutex
+	lockUpdateEdgeSpokeTransitPeeringTunnelCount         sync.RWMutex
+	lockUpdateEdgeVmSelfmanagedHa                        sync.RWMutex
+	lockUpdateEnforcementLevel                           sync.RWMutex
+	lockUpdateGCPAccount                                 sync.RWMutex
+	lockUpdateGateway                                    sync.RWMutex
+	lockUpdateGatewayContext                             sync.RWMutex
+	lockUpdateGroupInstanceSize                          sync.RWMutex
+	lockUpdateIpsProfile                                 sync.RWMutex
+	lockUpdateIpsRuleFeed                                sync.RWMutex
+	lockUpdateMaxVpnConn                                 sync.RWMutex
+	lockUpdateSite2Cloud                                 sync.RWMutex
+	lockUpdateSmartGroup                                 sync.RWMutex
+	lockUpdateSpokeGatewaySubnetGroup                    sync.RWMutex
+	lockUpdateSpokePendingApprovedCidrs                  sync.RWMutex
+	lockUpdateSpokePendingApprovedCidrsGatewayGroup      sync.RWMutex
+	lockUpdateTLSProfile                                 sync.RWMutex
+	lockUpdateTags                                       sync.RWMutex
+	lockUpdateTransitGatewayCustomizedVpcRoute           sync.RWMutex
+	lockUpdateTransitPendingApprovedCidrs                sync.RWMutex
+	lockUpdateTransitPendingApprovedCidrsGatewayGroup    sync.RWMutex
+	lockUpdateVpnCidr                                    sync.RWMutex
+	lockUpdateWebGroup                                   sync.RWMutex
+}
+
+// AddSpokeGatewaySubnetGroup calls AddSpokeGatewaySubnetGroupFunc.
+func (mock *ClientInterfaceMock) AddSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error {
+	if mock.AddSpokeGatewaySubnetGroupFunc == nil {
+		panic("ClientInterfaceMock.AddSpokeGatewaySubnetGroupFunc: method is nil but ClientInterface.AddSpokeGatewaySubnetGroup was just called")
+	}
+	callInfo := struct {
+		Ctx                     context.Context
+		SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+	}{
+		Ctx:                     ctx,
+		SpokeGatewaySubnetGroup: spokeGatewaySubnetGroup,
+	}
+	mock.lockAddSpokeGatewaySubnetGroup.Lock()
+	mock.calls.AddSpokeGatewaySubnetGroup = append(mock.calls.AddSpokeGatewaySubnetGroup, callInfo)
+	mock.lockAddSpokeGatewaySubnetGroup.Unlock()
+	return mock.AddSpokeGatewaySubnetGroupFunc(ctx, spokeGatewaySubnetGroup)
+}
+
+// AddSpokeGatewaySubnetGroupCalls gets all the calls that were made to AddSpokeGatewaySubnetGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.AddSpokeGatewaySubnetGroupCalls())
+func (mock *ClientInterfaceMock) AddSpokeGatewaySubnetGroupCalls() []struct {
+	Ctx                     context.Context
+	SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+} {
+	var calls []struct {
+		Ctx                     context.Context
+		SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+	}
+	mock.lockAddSpokeGatewaySubnetGroup.RLock()
+	calls = mock.calls.AddSpokeGatewaySubnetGroup
+	mock.lockAddSpokeGatewaySubnetGroup.RUnlock()
+	return calls
+}
+
+// AttachTransitGWForHybrid calls AttachTransitGWForHybridFunc.
+func (mock *ClientInterfaceMock) AttachTransitGWForHybrid(gateway *TransitVpc) error {
+	if mock.AttachTransitGWForHybridFunc == nil {
+		panic("ClientInterfaceMock.AttachTransitGWForHybridFunc: method is nil but ClientInterface.AttachTransitGWForHybrid was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockAttachTransitGWForHybrid.Lock()
+	mock.calls.AttachTransitGWForHybrid = append(mock.calls.AttachTransitGWForHybrid, callInfo)
+	mock.lockAttachTransitGWForHybrid.Unlock()
+	return mock.AttachTransitGWForHybridFunc(gateway)
+}
+
+// AttachTransitGWForHybridCalls gets all the calls that were made to AttachTransitGWForHybrid.
+// Check the length with:
+//
+//	len(mockedClientInterface.AttachTransitGWForHybridCalls())
+func (mock *ClientInterfaceMock) AttachTransitGWForHybridCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockAttachTransitGWForHybrid.RLock()
+	calls = mock.calls.AttachTransitGWForHybrid
+	mock.lockAttachTransitGWForHybrid.RUnlock()
+	return calls
+}
+
+// AuditAccount calls AuditAccountFunc.
+func (mock *ClientInterfaceMock) AuditAccount(ctx context.Context, account *Account) error {
+	if mock.AuditAccountFunc == nil {
+		panic("ClientInterfaceMock.AuditAccountFunc: method is nil but ClientInterface.AuditAccount was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Account *Account
+	}{
+		Ctx:     ctx,
+		Account: account,
+	}
+	mock.lockAuditAccount.Lock()
+	mock.calls.AuditAccount = append(mock.calls.AuditAccount, callInfo)
+	mock.lockAuditAccount.Unlock()
+	return mock.AuditAccountFunc(ctx, account)
+}
+
+// AuditAccountCalls gets all the calls that were made to AuditAccount.
+// Check the length with:
+//
+//	len(mockedClientInterface.AuditAccountCalls())
+func (mock *ClientInterfaceMock) AuditAccountCalls() []struct {
+	Ctx     context.Context
+	Account *Account
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Account *Account
+	}
+	mock.lockAuditAccount.RLock()
+	calls = mock.calls.AuditAccount
+	mock.lockAuditAccount.RUnlock()
+	return calls
+}
+
+// ChangeBgpHoldTime calls ChangeBgpHoldTimeFunc.
+func (mock *ClientInterfaceMock) ChangeBgpHoldTime(gwName string, holdTime int) error {
+	if mock.ChangeBgpHoldTimeFunc == nil {
+		panic("ClientInterfaceMock.ChangeBgpHoldTimeFunc: method is nil but ClientInterface.ChangeBgpHoldTime was just called")
+	}
+	callInfo := struct {
+		GwName   string
+		HoldTime int
+	}{
+		GwName:   gwName,
+		HoldTime: holdTime,
+	}
+	mock.lockChangeBgpHoldTime.Lock()
+	mock.calls.ChangeBgpHoldTime = append(mock.calls.ChangeBgpHoldTime, callInfo)
+	mock.lockChangeBgpHoldTime.Unlock()
+	return mock.ChangeBgpHoldTimeFunc(gwName, holdTime)
+}
+
+// ChangeBgpHoldTimeCalls gets all the calls that were made to ChangeBgpHoldTime.
+// Check the length with:
+//
+//	len(mockedClientInterface.ChangeBgpHoldTimeCalls())
+func (mock *ClientInterfaceMock) ChangeBgpHoldTimeCalls() []struct {
+	GwName   string
+	HoldTime int
+} {
+	var calls []struct {
+		GwName   string
+		HoldTime int
+	}
+	mock.lockChangeBgpHoldTime.RLock()
+	calls = mock.calls.ChangeBgpHoldTime
+	mock.lockChangeBgpHoldTime.RUnlock()
+	return calls
+}
+
+// ChangeBgpHoldTimeGatewayGroup calls ChangeBgpHoldTimeGatewayGroupFunc.
+func (mock *ClientInterfaceMock) ChangeBgpHoldTimeGatewayGroup(ctx context.Context, groupName string, holdTime int) error {
+	if mock.ChangeBgpHoldTimeGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.ChangeBgpHoldTimeGatewayGroupFunc: method is nil but ClientInterface.ChangeBgpHoldTimeGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+		HoldTime  int
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+		HoldTime:  holdTime,
+	}
+	mock.lockChangeBgpHoldTimeGatewayGroup.Lock()
+	mock.calls.ChangeBgpHoldTimeGatewayGroup = append(mock.calls.ChangeBgpHoldTimeGatewayGroup, callInfo)
+	mock.lockChangeBgpHoldTimeGatewayGroup.Unlock()
+	return mock.ChangeBgpHoldTimeGatewayGroupFunc(ctx, groupName, holdTime)
+}
+
+// ChangeBgpHoldTimeGatewayGroupCalls gets all the calls that were made to ChangeBgpHoldTimeGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.ChangeBgpHoldTimeGatewayGroupCalls())
+func (mock *ClientInterfaceMock) ChangeBgpHoldTimeGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+	HoldTime  int
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+		HoldTime  int
+	}
+	mock.lockChangeBgpHoldTimeGatewayGroup.RLock()
+	calls = mock.calls.ChangeBgpHoldTimeGatewayGroup
+	mock.lockChangeBgpHoldTimeGatewayGroup.RUnlock()
+	return calls
+}
+
+// ChangeBgpOverLanIntfCnt calls ChangeBgpOverLanIntfCntFunc.
+func (mock *ClientInterfaceMock) ChangeBgpOverLanIntfCnt(gateway *Gateway) error {
+	if mock.ChangeBgpOverLanIntfCntFunc == nil {
+		panic("ClientInterfaceMock.ChangeBgpOverLanIntfCntFunc: method is nil but ClientInterface.ChangeBgpOverLanIntfCnt was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockChangeBgpOverLanIntfCnt.Lock()
+	mock.calls.ChangeBgpOverLanIntfCnt = append(mock.calls.ChangeBgpOverLanIntfCnt, callInfo)
+	mock.lockChangeBgpOverLanIntfCnt.Unlock()
+	return mock.ChangeBgpOverLanIntfCntFunc(gateway)
+}
+
+// ChangeBgpOverLanIntfCntCalls gets all the calls that were made to ChangeBgpOverLanIntfCnt.
+// Check the length with:
+//
+//	len(mockedClientInterface.ChangeBgpOverLanIntfCntCalls())
+func (mock *ClientInterfaceMock) ChangeBgpOverLanIntfCntCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockChangeBgpOverLanIntfCnt.RLock()
+	calls = mock.calls.ChangeBgpOverLanIntfCnt
+	mock.lockChangeBgpOverLanIntfCnt.RUnlock()
+	return calls
+}
+
+// CreateAccount calls CreateAccountFunc.
+func (mock *ClientInterfaceMock) CreateAccount(account *Account) error {
+	if mock.CreateAccountFunc == nil {
+		panic("ClientInterfaceMock.CreateAccountFunc: method is nil but ClientInterface.CreateAccount was just called")
+	}
+	callInfo := struct {
+		Account *Account
+	}{
+		Account: account,
+	}
+	mock.lockCreateAccount.Lock()
+	mock.calls.CreateAccount = append(mock.calls.CreateAccount, callInfo)
+	mock.lockCreateAccount.Unlock()
+	return mock.CreateAccountFunc(account)
+}
+
+// CreateAccountCalls gets all the calls that were made to CreateAccount.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateAccountCalls())
+func (mock *ClientInterfaceMock) CreateAccountCalls() []struct {
+	Account *Account
+} {
+	var calls []struct {
+		Account *Account
+	}
+	mock.lockCreateAccount.RLock()
+	calls = mock.calls.CreateAccount
+	mock.lockCreateAccount.RUnlock()
+	return calls
+}
+
+// CreateAccountUser calls CreateAccountUserFunc.
+func (mock *ClientInterfaceMock) CreateAccountUser(user *AccountUser) error {
+	if mock.CreateAccountUserFunc == nil {
+		panic("ClientInterfaceMock.CreateAccountUserFunc: method is nil but ClientInterface.CreateAccountUser was just called")
+	}
+	callInfo := struct {
+		User *AccountUser
+	}{
+		User: user,
+	}
+	mock.lockCreateAccountUser.Lock()
+	mock.calls.CreateAccountUser = append(mock.calls.CreateAccountUser, callInfo)
+	mock.lockCreateAccountUser.Unlock()
+	return mock.CreateAccountUserFunc(user)
+}
+
+// CreateAccountUserCalls gets all the calls that were made to CreateAccountUser.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateAccountUserCalls())
+func (mock *ClientInterfaceMock) CreateAccountUserCalls() []struct {
+	User *AccountUser
+} {
+	var calls []struct {
+		User *AccountUser
+	}
+	mock.lockCreateAccountUser.RLock()
+	calls = mock.calls.CreateAccountUser
+	mock.lockCreateAccountUser.RUnlock()
+	return calls
+}
+
+// CreateDCFMitmCa calls CreateDCFMitmCaFunc.
+func (mock *ClientInterfaceMock) CreateDCFMitmCa(ctx context.Context, mitmCa *MitmCaItemRequest) (string, error) {
+	if mock.CreateDCFMitmCaFunc == nil {
+		panic("ClientInterfaceMock.CreateDCFMitmCaFunc: method is nil but ClientInterface.CreateDCFMitmCa was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		MitmCa *MitmCaItemRequest
+	}{
+		Ctx:    ctx,
+		MitmCa: mitmCa,
+	}
+	mock.lockCreateDCFMitmCa.Lock()
+	mock.calls.CreateDCFMitmCa = append(mock.calls.CreateDCFMitmCa, callInfo)
+	mock.lockCreateDCFMitmCa.Unlock()
+	return mock.CreateDCFMitmCaFunc(ctx, mitmCa)
+}
+
+// CreateDCFMitmCaCalls gets all the calls that were made to CreateDCFMitmCa.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateDCFMitmCaCalls())
+func (mock *ClientInterfaceMock) CreateDCFMitmCaCalls() []struct {
+	Ctx    context.Context
+	MitmCa *MitmCaItemRequest
+} {
+	var calls []struct {
+		Ctx    context.Context
+		MitmCa *MitmCaItemRequest
+	}
+	mock.lockCreateDCFMitmCa.RLock()
+	calls = mock.calls.CreateDCFMitmCa
+	mock.lockCreateDCFMitmCa.RUnlock()
+	return calls
+}
+
+// CreateDCFPolicyBlock calls CreateDCFPolicyBlockFunc.
+func (mock *ClientInterfaceMock) CreateDCFPolicyBlock(ctx context.Context, policyBlock *DCFPolicyBlock) (string, error) {
+	if mock.CreateDCFPolicyBlockFunc == nil {
+		panic("ClientInterfaceMock.CreateDCFPolicyBlockFunc: method is nil but ClientInterface.CreateDCFPolicyBlock was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		PolicyBlock *DCFPolicyBlock
+	}{
+		Ctx:         ctx,
+		PolicyBlock: policyBlock,
+	}
+	mock.lockCreateDCFPolicyBlock.Lock()
+	mock.calls.CreateDCFPolicyBlock = append(mock.calls.CreateDCFPolicyBlock, callInfo)
+	mock.lockCreateDCFPolicyBlock.Unlock()
+	return mock.CreateDCFPolicyBlockFunc(ctx, policyBlock)
+}
+
+// CreateDCFPolicyBlockCalls gets all the calls that were made to CreateDCFPolicyBlock.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateDCFPolicyBlockCalls())
+func (mock *ClientInterfaceMock) CreateDCFPolicyBlockCalls() []struct {
+	Ctx         context.Context
+	PolicyBlock *DCFPolicyBlock
+} {
+	var calls []struct {
+		Ctx         context.Context
+		PolicyBlock *DCFPolicyBlock
+	}
+	mock.lockCreateDCFPolicyBlock.RLock()
+	calls = mock.calls.CreateDCFPolicyBlock
+	mock.lockCreateDCFPolicyBlock.RUnlock()
+	return calls
+}
+
+// CreateDCFPolicyList calls CreateDCFPolicyListFunc.
+func (mock *ClientInterfaceMock) CreateDCFPolicyList(ctx context.Context, policyList *DCFPolicyList) (string, error) {
+	if mock.CreateDCFPolicyListFunc == nil {
+		panic("ClientInterfaceMock.CreateDCFPolicyListFunc: method is nil but ClientInterface.CreateDCFPolicyList was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		PolicyList *DCFPolicyList
+	}{
+		Ctx:        ctx,
+		PolicyList: policyList,
+	}
+	mock.lockCreateDCFPolicyList.Lock()
+	mock.calls.CreateDCFPolicyList = append(mock.calls.CreateDCFPolicyList, callInfo)
+	mock.lockCreateDCFPolicyList.Unlock()
+	return mock.CreateDCFPolicyListFunc(ctx, policyList)
+}
+
+// CreateDCFPolicyListCalls gets all the calls that were made to CreateDCFPolicyList.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateDCFPolicyListCalls())
+func (mock *ClientInterfaceMock) CreateDCFPolicyListCalls() []struct {
+	Ctx        context.Context
+	PolicyList *DCFPolicyList
+} {
+	var calls []struct {
+		Ctx        context.Context
+		PolicyList *DCFPolicyList
+	}
+	mock.lockCreateDCFPolicyList.RLock()
+	calls = mock.calls.CreateDCFPolicyList
+	mock.lockCreateDCFPolicyList.RUnlock()
+	return calls
+}
+
+// CreateDCFTrustBundle calls CreateDCFTrustBundleFunc.
+func (mock *ClientInterfaceMock) CreateDCFTrustBundle(ctx context.Context, trustBundle *TrustBundleItemRequest) (string, error) {
+	if mock.CreateDCFTrustBundleFunc == nil {
+		panic("ClientInterfaceMock.CreateDCFTrustBundleFunc: method is nil but ClientInterface.CreateDCFTrustBundle was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		TrustBundle *TrustBundleItemRequest
+	}{
+		Ctx:         ctx,
+		TrustBundle: trustBundle,
+	}
+	mock.lockCreateDCFTrustBundle.Lock()
+	mock.calls.CreateDCFTrustBundle = append(mock.calls.CreateDCFTrustBundle, callInfo)
+	mock.lockCreateDCFTrustBundle.Unlock()
+	return mock.CreateDCFTrustBundleFunc(ctx, trustBundle)
+}
+
+// CreateDCFTrustBundleCalls gets all the calls that were made to CreateDCFTrustBundle.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateDCFTrustBundleCalls())
+func (mock *ClientInterfaceMock) CreateDCFTrustBundleCalls() []struct {
+	Ctx         context.Context
+	TrustBundle *TrustBundleItemRequest
+} {
+	var calls []struct {
+		Ctx         context.Context
+		TrustBundle *TrustBundleItemRequest
+	}
+	mock.lockCreateDCFTrustBundle.RLock()
+	calls = mock.calls.CreateDCFTrustBundle
+	mock.lockCreateDCFTrustBundle.RUnlock()
+	return calls
+}
+
+// CreateDistributedFirewallingDeploymentPolicy calls CreateDistributedFirewallingDeploymentPolicyFunc.
+func (mock *ClientInterfaceMock) CreateDistributedFirewallingDeploymentPolicy(ctx context.Context, deploymentPolicy *DistributedFirewallingDeploymentPolicy) error {
+	if mock.CreateDistributedFirewallingDeploymentPolicyFunc == nil {
+		panic("ClientInterfaceMock.CreateDistributedFirewallingDeploymentPolicyFunc: method is nil but ClientInterface.CreateDistributedFirewallingDeploymentPolicy was just called")
+	}
+	callInfo := struct {
+		Ctx              context.Context
+		DeploymentPolicy *DistributedFirewallingDeploymentPolicy
+	}{
+		Ctx:              ctx,
+		DeploymentPolicy: deploymentPolicy,
+	}
+	mock.lockCreateDistributedFirewallingDeploymentPolicy.Lock()
+	mock.calls.CreateDistributedFirewallingDeploymentPolicy = append(mock.calls.CreateDistributedFirewallingDeploymentPolicy, callInfo)
+	mock.lockCreateDistributedFirewallingDeploymentPolicy.Unlock()
+	return mock.CreateDistributedFirewallingDeploymentPolicyFunc(ctx, deploymentPolicy)
+}
+
+// CreateDistributedFirewallingDeploymentPolicyCalls gets all the calls that were made to CreateDistributedFirewallingDeploymentPolicy.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateDistributedFirewallingDeploymentPolicyCalls())
+func (mock *ClientInterfaceMock) CreateDistributedFirewallingDeploymentPolicyCalls() []struct {
+	Ctx              context.Context
+	DeploymentPolicy *DistributedFirewallingDeploymentPolicy
+} {
+	var calls []struct {
+		Ctx              context.Context
+		DeploymentPolicy *DistributedFirewallingDeploymentPolicy
+	}
+	mock.lockCreateDistributedFirewallingDeploymentPolicy.RLock()
+	calls = mock.calls.CreateDistributedFirewallingDeploymentPolicy
+	mock.lockCreateDistributedFirewallingDeploymentPolicy.RUnlock()
+	return calls
+}
+
+// CreateDistributedFirewallingIntraVpc calls CreateDistributedFirewallingIntraVpcFunc.
+func (mock *ClientInterfaceMock) CreateDistributedFirewallingIntraVpc(ctx context.Context, vpcList *DistributedFirewallingIntraVpcList) error {
+	if mock.CreateDistributedFirewallingIntraVpcFunc == nil {
+		panic("ClientInterfaceMock.CreateDistributedFirewallingIntraVpcFunc: method is nil but ClientInterface.CreateDistributedFirewallingIntraVpc was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		VpcList *DistributedFirewallingIntraVpcList
+	}{
+		Ctx:     ctx,
+		VpcList: vpcList,
+	}
+	mock.lockCreateDistributedFirewallingIntraVpc.Lock()
+	mock.calls.CreateDistributedFirewallingIntraVpc = append(mock.calls.CreateDistributedFirewallingIntraVpc, callInfo)
+	mock.lockCreateDistributedFirewallingIntraVpc.Unlock()
+	return mock.CreateDistributedFirewallingIntraVpcFunc(ctx, vpcList)
+}
+
+// CreateDistributedFirewallingIntraVpcCalls gets all the calls that were made to CreateDistributedFirewallingIntraVpc.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateDistributedFirewallingIntraVpcCalls())
+func (mock *ClientInterfaceMock) CreateDistributedFirewallingIntraVpcCalls() []struct {
+	Ctx     context.Context
+	VpcList *DistributedFirewallingIntraVpcList
+} {
+	var calls []struct {
+		Ctx     context.Context
+		VpcList *DistributedFirewallingIntraVpcList
+	}
+	mock.lockCreateDistributedFirewallingIntraVpc.RLock()
+	calls = mock.calls.CreateDistributedFirewallingIntraVpc
+	mock.lockCreateDistributedFirewallingIntraVpc.RUnlock()
+	return calls
+}
+
+// CreateDistributedFirewallingPolicyList calls CreateDistributedFirewallingPolicyListFunc.
+func (mock *ClientInterfaceMock) CreateDistributedFirewallingPolicyList(ctx context.Context, policyList *DistributedFirewallingPolicyList) error {
+	if mock.CreateDistributedFirewallingPolicyListFunc == nil {
+		panic("ClientInterfaceMock.CreateDistributedFirewallingPolicyListFunc: method is nil but ClientInterface.CreateDistributedFirewallingPolicyList was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		PolicyList *DistributedFirewallingPolicyList
+	}{
+		Ctx:        ctx,
+		PolicyList: policyList,
+	}
+	mock.lockCreateDistributedFirewallingPolicyList.Lock()
+	mock.calls.CreateDistributedFirewallingPolicyList = append(mock.calls.CreateDistributedFirewallingPolicyList, callInfo)
+	mock.lockCreateDistributedFirewallingPolicyList.Unlock()
+	return mock.CreateDistributedFirewallingPolicyListFunc(ctx, policyList)
+}
+
+// CreateDistributedFirewallingPolicyListCalls gets all the calls that were made to CreateDistributedFirewallingPolicyList.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateDistributedFirewallingPolicyListCalls())
+func (mock *ClientInterfaceMock) CreateDistributedFirewallingPolicyListCalls() []struct {
+	Ctx        context.Context
+	PolicyList *DistributedFirewallingPolicyList
+} {
+	var calls []struct {
+		Ctx        context.Context
+		PolicyList *DistributedFirewallingPolicyList
+	}
+	mock.lockCreateDistributedFirewallingPolicyList.RLock()
+	calls = mock.calls.CreateDistributedFirewallingPolicyList
+	mock.lockCreateDistributedFirewallingPolicyList.RUnlock()
+	return calls
+}
+
+// CreateEdgeAccount calls CreateEdgeAccountFunc.
+func (mock *ClientInterfaceMock) CreateEdgeAccount(edgeAccount *EdgeAccount) error {
+	if mock.CreateEdgeAccountFunc == nil {
+		panic("ClientInterfaceMock.CreateEdgeAccountFunc: method is nil but ClientInterface.CreateEdgeAccount was just called")
+	}
+	callInfo := struct {
+		EdgeAccount *EdgeAccount
+	}{
+		EdgeAccount: edgeAccount,
+	}
+	mock.lockCreateEdgeAccount.Lock()
+	mock.calls.CreateEdgeAccount = append(mock.calls.CreateEdgeAccount, callInfo)
+	mock.lockCreateEdgeAccount.Unlock()
+	return mock.CreateEdgeAccountFunc(edgeAccount)
+}
+
+// CreateEdgeAccountCalls gets all the calls that were made to CreateEdgeAccount.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateEdgeAccountCalls())
+func (mock *ClientInterfaceMock) CreateEdgeAccountCalls() []struct {
+	EdgeAccount *EdgeAccount
+} {
+	var calls []struct {
+		EdgeAccount *EdgeAccount
+	}
+	mock.lockCreateEdgeAccount.RLock()
+	calls = mock.calls.CreateEdgeAccount
+	mock.lockCreateEdgeAccount.RUnlock()
+	return calls
+}
+
+// CreateEdgeCSP calls CreateEdgeCSPFunc.
+func (mock *ClientInterfaceMock) CreateEdgeCSP(ctx context.Context, edgeCSP *EdgeCSP) error {
+	if mock.CreateEdgeCSPFunc == nil {
+		panic("ClientInterfaceMock.CreateEdgeCSPFunc: method is nil but ClientInterface.CreateEdgeCSP was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		EdgeCSP *EdgeCSP
+	}{
+		Ctx:     ctx,
+		EdgeCSP: edgeCSP,
+	}
+	mock.lockCreateEdgeCSP.Lock()
+	mock.calls.CreateEdgeCSP = append(mock.calls.CreateEdgeCSP, callInfo)
+	mock.lockCreateEdgeCSP.Unlock()
+	return mock.CreateEdgeCSPFunc(ctx, edgeCSP)
+}
+
+// CreateEdgeCSPCalls gets all the calls that were made to CreateEdgeCSP.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateEdgeCSPCalls())
+func (mock *ClientInterfaceMock) CreateEdgeCSPCalls() []struct {
+	Ctx     context.Context
+	EdgeCSP *EdgeCSP
+} {
+	var calls []struct {
+		Ctx     context.Context
+		EdgeCSP *EdgeCSP
+	}
+	mock.lockCreateEdgeCSP.RLock()
+	calls = mock.calls.CreateEdgeCSP
+	mock.lockCreateEdgeCSP.RUnlock()
+	return calls
+}
+
+// CreateEdgeCSPHa calls CreateEdgeCSPHaFunc.
+func (mock *ClientInterfaceMock) CreateEdgeCSPHa(ctx context.Context, edgeCSPHa *EdgeCSPHa) (string, error) {
+	if mock.CreateEdgeCSPHaFunc == nil {
+		panic("ClientInterfaceMock.CreateEdgeCSPHaFunc: method is nil but ClientInterface.CreateEdgeCSPHa was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		EdgeCSPHa *EdgeCSPHa
+	}{
+		Ctx:       ctx,
+		EdgeCSPHa: edgeCSPHa,
+	}
+	mock.lockCreateEdgeCSPHa.Lock()
+	mock.calls.CreateEdgeCSPHa = append(mock.calls.CreateEdgeCSPHa, callInfo)
+	mock.lockCreateEdgeCSPHa.Unlock()
+	return mock.CreateEdgeCSPHaFunc(ctx, edgeCSPHa)
+}
+
+// CreateEdgeCSPHaCalls gets all the calls that were made to CreateEdgeCSPHa.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateEdgeCSPHaCalls())
+func (mock *ClientInterfaceMock) CreateEdgeCSPHaCalls() []struct {
+	Ctx       context.Context
+	EdgeCSPHa *EdgeCSPHa
+} {
+	var calls []struct {
+		Ctx       context.Context
+		EdgeCSPHa *EdgeCSPHa
+	}
+	mock.lockCreateEdgeCSPHa.RLock()
+	calls = mock.calls.CreateEdgeCSPHa
+	mock.lockCreateEdgeCSPHa.RUnlock()
+	return calls
+}
+
+// CreateEdgeEquinix calls CreateEdgeEquinixFunc.
+func (mock *ClientInterfaceMock) CreateEdgeEquinix(ctx context.Context, edgeEquinix *EdgeEquinix) error {
+	if mock.CreateEdgeEquinixFunc == nil {
+		panic("ClientInterfaceMock.CreateEdgeEquinixFunc: method is nil but ClientInterface.CreateEdgeEquinix was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		EdgeEquinix *EdgeEquinix
+	}{
+		Ctx:         ctx,
+		EdgeEquinix: edgeEquinix,
+	}
+	mock.lockCreateEdgeEquinix.Lock()
+	mock.calls.CreateEdgeEquinix = append(mock.calls.CreateEdgeEquinix, callInfo)
+	mock.lockCreateEdgeEquinix.Unlock()
+	return mock.CreateEdgeEquinixFunc(ctx, edgeEquinix)
+}
+
+// CreateEdgeEquinixCalls gets all the calls that were made to CreateEdgeEquinix.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateEdgeEquinixCalls())
+func (mock *ClientInterfaceMock) CreateEdgeEquinixCalls() []struct {
+	Ctx         context.Context
+	EdgeEquinix *EdgeEquinix
+} {
+	var calls []struct {
+		Ctx         context.Context
+		EdgeEquinix *EdgeEquinix
+	}
+	mock.lockCreateEdgeEquinix.RLock()
+	calls = mock.calls.CreateEdgeEquinix
+	mock.lockCreateEdgeEquinix.RUnlock()
+	return calls
+}
+
+// CreateEdgeEquinixHa calls CreateEdgeEquinixHaFunc.
+func (mock *ClientInterfaceMock) CreateEdgeEquinixHa(ctx context.Context, edgeEquinixHa *EdgeEquinixHa) (string, error) {
+	if mock.CreateEdgeEquinixHaFunc == nil {
+		panic("ClientInterfaceMock.CreateEdgeEquinixHaFunc: method is nil but ClientInterface.CreateEdgeEquinixHa was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		EdgeEquinixHa *EdgeEquinixHa
+	}{
+		Ctx:           ctx,
+		EdgeEquinixHa: edgeEquinixHa,
+	}
+	mock.lockCreateEdgeEquinixHa.Lock()
+	mock.calls.CreateEdgeEquinixHa = append(mock.calls.CreateEdgeEquinixHa, callInfo)
+	mock.lockCreateEdgeEquinixHa.Unlock()
+	return mock.CreateEdgeEquinixHaFunc(ctx, edgeEquinixHa)
+}
+
+// CreateEdgeEquinixHaCalls gets all the calls that were made to CreateEdgeEquinixHa.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateEdgeEquinixHaCalls())
+func (mock *ClientInterfaceMock) CreateEdgeEquinixHaCalls() []struct {
+	Ctx           context.Context
+	EdgeEquinixHa *EdgeEquinixHa
+} {
+	var calls []struct {
+		Ctx           context.Context
+		EdgeEquinixHa *EdgeEquinixHa
+	}
+	mock.lockCreateEdgeEquinixHa.RLock()
+	calls = mock.calls.CreateEdgeEquinixHa
+	mock.lockCreateEdgeEquinixHa.RUnlock()
+	return calls
+}
+
+// CreateEdgeExternalDeviceConn calls CreateEdgeExternalDeviceConnFunc.
+func (mock *ClientInterfaceMock) CreateEdgeExternalDeviceConn(edgeExternalDeviceConn *EdgeExternalDeviceConn) (string, error) {
+	if mock.CreateEdgeExternalDeviceConnFunc == nil {
+		panic("ClientInterfaceMock.CreateEdgeExternalDeviceConnFunc: method is nil but ClientInterface.CreateEdgeExternalDeviceConn was just called")
+	}
+	callInfo := struct {
+		EdgeExternalDeviceConn *EdgeExternalDeviceConn
+	}{
+		EdgeExternalDeviceConn: edgeExternalDeviceConn,
+	}
+	mock.lockCreateEdgeExternalDeviceConn.Lock()
+	mock.calls.CreateEdgeExternalDeviceConn = append(mock.calls.CreateEdgeExternalDeviceConn, callInfo)
+	mock.lockCreateEdgeExternalDeviceConn.Unlock()
+	return mock.CreateEdgeExternalDeviceConnFunc(edgeExternalDeviceConn)
+}
+
+// CreateEdgeExternalDeviceConnCalls gets all the calls that were made to CreateEdgeExternalDeviceConn.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateEdgeExternalDeviceConnCalls())
+func (mock *ClientInterfaceMock) CreateEdgeExternalDeviceConnCalls() []struct {
+	EdgeExternalDeviceConn *EdgeExternalDeviceConn
+} {
+	var calls []struct {
+		EdgeExternalDeviceConn *EdgeExternalDeviceConn
+	}
+	mock.lockCreateEdgeExternalDeviceConn.RLock()
+	calls = mock.calls.CreateEdgeExternalDeviceConn
+	mock.lockCreateEdgeExternalDeviceConn.RUnlock()
+	return calls
+}
+
+// CreateEdgeMegaport calls CreateEdgeMegaportFunc.
+func (mock *ClientInterfaceMock) CreateEdgeMegaport(ctx context.Context, edgeMegaport *EdgeMegaport) error {
+	if mock.CreateEdgeMegaportFunc == nil {
+		panic("ClientInterfaceMock.CreateEdgeMegaportFunc: method is nil but ClientInterface.CreateEdgeMegaport was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		EdgeMegaport *EdgeMegaport
+	}{
+		Ctx:          ctx,
+		EdgeMegaport: edgeMegaport,
+	}
+	mock.lockCreateEdgeMegaport.Lock()
+	mock.calls.CreateEdgeMegaport = append(mock.calls.CreateEdgeMegaport, callInfo)
+	mock.lockCreateEdgeMegaport.Unlock()
+	return mock.CreateEdgeMegaportFunc(ctx, edgeMegaport)
+}
+
+// CreateEdgeMegaportCalls gets all the calls that were made to CreateEdgeMegaport.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateEdgeMegaportCalls())
+func (mock *ClientInterfaceMock) CreateEdgeMegaportCalls() []struct {
+	Ctx          context.Context
+	EdgeMegaport *EdgeMegaport
+} {
+	var calls []struct {
+		Ctx          context.Context
+		EdgeMegaport *EdgeMegaport
+	}
+	mock.lockCreateEdgeMegaport.RLock()
+	calls = mock.calls.CreateEdgeMegaport
+	mock.lockCreateEdgeMegaport.RUnlock()
+	return calls
+}
+
+// CreateEdgeMegaportHa calls CreateEdgeMegaportHaFunc.
+func (mock *ClientInterfaceMock) CreateEdgeMegaportHa(ctx context.Context, edgeMegaportHa *EdgeMegaportHa) (string, error) {
+	if mock.CreateEdgeMegaportHaFunc == nil {
+		panic("ClientInterfaceMock.CreateEdgeMegaportHaFunc: method is nil but ClientInterface.CreateEdgeMegaportHa was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		EdgeMegaportHa *EdgeMegaportHa
+	}{
+		Ctx:            ctx,
+		EdgeMegaportHa: edgeMegaportHa,
+	}
+	mock.lockCreateEdgeMegaportHa.Lock()
+	mock.calls.CreateEdgeMegaportHa = append(mock.calls.CreateEdgeMegaportHa, callInfo)
+	mock.lockCreateEdgeMegaportHa.Unlock()
+	return mock.CreateEdgeMegaportHaFunc(ctx, edgeMegaportHa)
+}
+
+// CreateEdgeMegaportHaCalls gets all the calls that were made to CreateEdgeMegaportHa.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateEdgeMegaportHaCalls())
+func (mock *ClientInterfaceMock) CreateEdgeMegaportHaCalls() []struct {
+	Ctx            context.Context
+	EdgeMegaportHa *EdgeMegaportHa
+} {
+	var calls []struct {
+		Ctx            context.Context
+		EdgeMegaportHa *EdgeMegaportHa
+	}
+	mock.lockCreateEdgeMegaportHa.RLock()
+	calls = mock.calls.CreateEdgeMegaportHa
+	mock.lockCreateEdgeMegaportHa.RUnlock()
+	return calls
+}
+
+// CreateEdgeNEO calls CreateEdgeNEOFunc.
+func (mock *ClientInterfaceMock) CreateEdgeNEO(ctx context.Context, edgeNEO *EdgeNEO) error {
+	if mock.CreateEdgeNEOFunc == nil {
+		panic("ClientInterfaceMock.CreateEdgeNEOFunc: method is nil but ClientInterface.CreateEdgeNEO was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		EdgeNEO *EdgeNEO
+	}{
+		Ctx:     ctx,
+		EdgeNEO: edgeNEO,
+	}
+	mock.lockCreateEdgeNEO.Lock()
+	mock.calls.CreateEdgeNEO = append(mock.calls.CreateEdgeNEO, callInfo)
+	mock.lockCreateEdgeNEO.Unlock()
+	return mock.CreateEdgeNEOFunc(ctx, edgeNEO)
+}
+
+// CreateEdgeNEOCalls gets all the calls that were made to CreateEdgeNEO.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateEdgeNEOCalls())
+func (mock *ClientInterfaceMock) CreateEdgeNEOCalls() []struct {
+	Ctx     context.Context
+	EdgeNEO *EdgeNEO
+} {
+	var calls []struct {
+		Ctx     context.Context
+		EdgeNEO *EdgeNEO
+	}
+	mock.lockCreateEdgeNEO.RLock()
+	calls = mock.calls.CreateEdgeNEO
+	mock.lockCreateEdgeNEO.RUnlock()
+	return calls
+}
+
+// CreateEdgeNEOHa calls CreateEdgeNEOHaFunc.
+func (mock *ClientInterfaceMock) CreateEdgeNEOHa(ctx context.Context, edgeNEOHa *EdgeNEOHa) (string, error) {
+	if mock.CreateEdgeNEOHaFunc == nil {
+		panic("ClientInterfaceMock.CreateEdgeNEOHaFunc: method is nil but ClientInterface.CreateEdgeNEOHa was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		EdgeNEOHa *EdgeNEOHa
+	}{
+		Ctx:       ctx,
+		EdgeNEOHa: edgeNEOHa,
+	}
+	mock.lockCreateEdgeNEOHa.Lock()
+	mock.calls.CreateEdgeNEOHa = append(mock.calls.CreateEdgeNEOHa, callInfo)
+	mock.lockCreateEdgeNEOHa.Unlock()
+	return mock.CreateEdgeNEOHaFunc(ctx, edgeNEOHa)
+}
+
+// CreateEdgeNEOHaCalls gets all the calls that were made to CreateEdgeNEOHa.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateEdgeNEOHaCalls())
+func (mock *ClientInterfaceMock) CreateEdgeNEOHaCalls() []struct {
+	Ctx       context.Context
+	EdgeNEOHa *EdgeNEOHa
+} {
+	var calls []struct {
+		Ctx       context.Context
+		EdgeNEOHa *EdgeNEOHa
+	}
+	mock.lockCreateEdgeNEOHa.RLock()
+	calls = mock.calls.CreateEdgeNEOHa
+	mock.lockCreateEdgeNEOHa.RUnlock()
+	return calls
+}
+
+// CreateEdgeProxyProfile calls CreateEdgeProxyProfileFunc.
+func (mock *ClientInterfaceMock) CreateEdgeProxyProfile(ctx context.Context, edgeNEOProxyProfile *EdgePlatformProxyProfile) (*EdgePlatformProxyProfileResp, error) {
+	if mock.CreateEdgeProxyProfileFunc == nil {
+		panic("ClientInterfaceMock.CreateEdgeProxyProfileFunc: method is nil but ClientInterface.CreateEdgeProxyProfile was just called")
+	}
+	callInfo := struct {
+		Ctx                 context.Context
+		EdgeNEOProxyProfile *EdgePlatformProxyProfile
+	}{
+		Ctx:                 ctx,
+		EdgeNEOProxyProfile: edgeNEOProxyProfile,
+	}
+	mock.lockCreateEdgeProxyProfile.Lock()
+	mock.calls.CreateEdgeProxyProfile = append(mock.calls.CreateEdgeProxyProfile, callInfo)
+	mock.lockCreateEdgeProxyProfile.Unlock()
+	return mock.CreateEdgeProxyProfileFunc(ctx, edgeNEOProxyProfile)
+}
+
+// CreateEdgeProxyProfileCalls gets all the calls that were made to CreateEdgeProxyProfile.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateEdgeProxyProfileCalls())
+func (mock *ClientInterfaceMock) CreateEdgeProxyProfileCalls() []struct {
+	Ctx                 context.Context
+	EdgeNEOProxyProfile *EdgePlatformProxyProfile
+} {
+	var calls []struct {
+		Ctx                 context.Context
+		EdgeNEOProxyProfile *EdgePlatformProxyProfile
+	}
+	mock.lockCreateEdgeProxyProfile.RLock()
+	calls = mock.calls.CreateEdgeProxyProfile
+	mock.lockCreateEdgeProxyProfile.RUnlock()
+	return calls
+}
+
+// CreateEdgeSpoke calls CreateEdgeSpokeFunc.
+func (mock *ClientInterfaceMock) CreateEdgeSpoke(ctx context.Context, edgeSpoke *EdgeSpoke) error {
+	if mock.CreateEdgeSpokeFunc == nil {
+		panic("ClientInterfaceMock.CreateEdgeSpokeFunc: method is nil but ClientInterface.CreateEdgeSpoke was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		EdgeSpoke *EdgeSpoke
+	}{
+		Ctx:       ctx,
+		EdgeSpoke: edgeSpoke,
+	}
+	mock.lockCreateEdgeSpoke.Lock()
+	mock.calls.CreateEdgeSpoke = append(mock.calls.CreateEdgeSpoke, callInfo)
+	mock.lockCreateEdgeSpoke.Unlock()
+	return mock.CreateEdgeSpokeFunc(ctx, edgeSpoke)
+}
+
+// CreateEdgeSpokeCalls gets all the calls that were made to CreateEdgeSpoke.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateEdgeSpokeCalls())
+func (mock *ClientInterfaceMock) CreateEdgeSpokeCalls() []struct {
+	Ctx       context.Context
+	EdgeSpoke *EdgeSpoke
+} {
+	var calls []struct {
+		Ctx       context.Context
+		EdgeSpoke *EdgeSpoke
+	}
+	mock.lockCreateEdgeSpoke.RLock()
+	calls = mock.calls.CreateEdgeSpoke
+	mock.lockCreateEdgeSpoke.RUnlock()
+	return calls
+}
+
+// CreateEdgeVmSelfmanagedHa calls CreateEdgeVmSelfmanagedHaFunc.
+func (mock *ClientInterfaceMock) CreateEdgeVmSelfmanagedHa(ctx context.Context, edgeVmSelfmanagedHa *EdgeVmSelfmanagedHa) (string, error) {
+	if mock.CreateEdgeVmSelfmanagedHaFunc == nil {
+		panic("ClientInterfaceMock.CreateEdgeVmSelfmanagedHaFunc: method is nil but ClientInterface.CreateEdgeVmSelfmanagedHa was just called")
+	}
+	callInfo := struct {
+		Ctx                 context.Context
+		EdgeVmSelfmanagedHa *EdgeVmSelfmanagedHa
+	}{
+		Ctx:                 ctx,
+		EdgeVmSelfmanagedHa: edgeVmSelfmanagedHa,
+	}
+	mock.lockCreateEdgeVmSelfmanagedHa.Lock()
+	mock.calls.CreateEdgeVmSelfmanagedHa = append(mock.calls.CreateEdgeVmSelfmanagedHa, callInfo)
+	mock.lockCreateEdgeVmSelfmanagedHa.Unlock()
+	return mock.CreateEdgeVmSelfmanagedHaFunc(ctx, edgeVmSelfmanagedHa)
+}
+
+// CreateEdgeVmSelfmanagedHaCalls gets all the calls that were made to CreateEdgeVmSelfmanagedHa.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateEdgeVmSelfmanagedHaCalls())
+func (mock *ClientInterfaceMock) CreateEdgeVmSelfmanagedHaCalls() []struct {
+	Ctx                 context.Context
+	EdgeVmSelfmanagedHa *EdgeVmSelfmanagedHa
+} {
+	var calls []struct {
+		Ctx                 context.Context
+		EdgeVmSelfmanagedHa *EdgeVmSelfmanagedHa
+	}
+	mock.lockCreateEdgeVmSelfmanagedHa.RLock()
+	calls = mock.calls.CreateEdgeVmSelfmanagedHa
+	mock.lockCreateEdgeVmSelfmanagedHa.RUnlock()
+	return calls
+}
+
+// CreateExternalDeviceConn calls CreateExternalDeviceConnFunc.
+func (mock *ClientInterfaceMock) CreateExternalDeviceConn(externalDeviceConn *ExternalDeviceConn) error {
+	if mock.CreateExternalDeviceConnFunc == nil {
+		panic("ClientInterfaceMock.CreateExternalDeviceConnFunc: method is nil but ClientInterface.CreateExternalDeviceConn was just called")
+	}
+	callInfo := struct {
+		ExternalDeviceConn *ExternalDeviceConn
+	}{
+		ExternalDeviceConn: externalDeviceConn,
+	}
+	mock.lockCreateExternalDeviceConn.Lock()
+	mock.calls.CreateExternalDeviceConn = append(mock.calls.CreateExternalDeviceConn, callInfo)
+	mock.lockCreateExternalDeviceConn.Unlock()
+	return mock.CreateExternalDeviceConnFunc(externalDeviceConn)
+}
+
+// CreateExternalDeviceConnCalls gets all the calls that were made to CreateExternalDeviceConn.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateExternalDeviceConnCalls())
+func (mock *ClientInterfaceMock) CreateExternalDeviceConnCalls() []struct {
+	ExternalDeviceConn *ExternalDeviceConn
+} {
+	var calls []struct {
+		ExternalDeviceConn *ExternalDeviceConn
+	}
+	mock.lockCreateExternalDeviceConn.RLock()
+	calls = mock.calls.CreateExternalDeviceConn
+	mock.lockCreateExternalDeviceConn.RUnlock()
+	return calls
+}
+
+// CreateGCPAccount calls CreateGCPAccountFunc.
+func (mock *ClientInterfaceMock) CreateGCPAccount(account *Account) error {
+	if mock.CreateGCPAccountFunc == nil {
+		panic("ClientInterfaceMock.CreateGCPAccountFunc: method is nil but ClientInterface.CreateGCPAccount was just called")
+	}
+	callInfo := struct {
+		Account *Account
+	}{
+		Account: account,
+	}
+	mock.lockCreateGCPAccount.Lock()
+	mock.calls.CreateGCPAccount = append(mock.calls.CreateGCPAccount, callInfo)
+	mock.lockCreateGCPAccount.Unlock()
+	return mock.CreateGCPAccountFunc(account)
+}
+
+// CreateGCPAccountCalls gets all the calls that were made to CreateGCPAccount.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateGCPAccountCalls())
+func (mock *ClientInterfaceMock) CreateGCPAccountCalls() []struct {
+	Account *Account
+} {
+	var calls []struct {
+		Account *Account
+	}
+	mock.lockCreateGCPAccount.RLock()
+	calls = mock.calls.CreateGCPAccount
+	mock.lockCreateGCPAccount.RUnlock()
+	return calls
+}
+
+// CreateGatewayContext calls CreateGatewayContextFunc.
+func (mock *ClientInterfaceMock) CreateGatewayContext(ctx context.Context, gateway *Gateway) error {
+	if mock.CreateGatewayContextFunc == nil {
+		panic("ClientInterfaceMock.CreateGatewayContextFunc: method is nil but ClientInterface.CreateGatewayContext was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}{
+		Ctx:     ctx,
+		Gateway: gateway,
+	}
+	mock.lockCreateGatewayContext.Lock()
+	mock.calls.CreateGatewayContext = append(mock.calls.CreateGatewayContext, callInfo)
+	mock.lockCreateGatewayContext.Unlock()
+	return mock.CreateGatewayContextFunc(ctx, gateway)
+}
+
+// CreateGatewayContextCalls gets all the calls that were made to CreateGatewayContext.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateGatewayContextCalls())
+func (mock *ClientInterfaceMock) CreateGatewayContextCalls() []struct {
+	Ctx     context.Context
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}
+	mock.lockCreateGatewayContext.RLock()
+	calls = mock.calls.CreateGatewayContext
+	mock.lockCreateGatewayContext.RUnlock()
+	return calls
+}
+
+// CreateGatewayGroup calls CreateGatewayGroupFunc.
+func (mock *ClientInterfaceMock) CreateGatewayGroup(ctx context.Context, spokeGroup *GatewayGroup) error {
+	if mock.CreateGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.CreateGatewayGroupFunc: method is nil but ClientInterface.CreateGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		SpokeGroup *GatewayGroup
+	}{
+		Ctx:        ctx,
+		SpokeGroup: spokeGroup,
+	}
+	mock.lockCreateGatewayGroup.Lock()
+	mock.calls.CreateGatewayGroup = append(mock.calls.CreateGatewayGroup, callInfo)
+	mock.lockCreateGatewayGroup.Unlock()
+	return mock.CreateGatewayGroupFunc(ctx, spokeGroup)
+}
+
+// CreateGatewayGroupCalls gets all the calls that were made to CreateGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateGatewayGroupCalls())
+func (mock *ClientInterfaceMock) CreateGatewayGroupCalls() []struct {
+	Ctx        context.Context
+	SpokeGroup *GatewayGroup
+} {
+	var calls []struct {
+		Ctx        context.Context
+		SpokeGroup *GatewayGroup
+	}
+	mock.lockCreateGatewayGroup.RLock()
+	calls = mock.calls.CreateGatewayGroup
+	mock.lockCreateGatewayGroup.RUnlock()
+	return calls
+}
+
+// CreateIpsProfile calls CreateIpsProfileFunc.
+func (mock *ClientInterfaceMock) CreateIpsProfile(ctx context.Context, profile *IpsProfile) (*IpsProfileCreateResponse, error) {
+	if mock.CreateIpsProfileFunc == nil {
+		panic("ClientInterfaceMock.CreateIpsProfileFunc: method is nil but ClientInterface.CreateIpsProfile was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Profile *IpsProfile
+	}{
+		Ctx:     ctx,
+		Profile: profile,
+	}
+	mock.lockCreateIpsProfile.Lock()
+	mock.calls.CreateIpsProfile = append(mock.calls.CreateIpsProfile, callInfo)
+	mock.lockCreateIpsProfile.Unlock()
+	return mock.CreateIpsProfileFunc(ctx, profile)
+}
+
+// CreateIpsProfileCalls gets all the calls that were made to CreateIpsProfile.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateIpsProfileCalls())
+func (mock *ClientInterfaceMock) CreateIpsProfileCalls() []struct {
+	Ctx     context.Context
+	Profile *IpsProfile
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Profile *IpsProfile
+	}
+	mock.lockCreateIpsProfile.RLock()
+	calls = mock.calls.CreateIpsProfile
+	mock.lockCreateIpsProfile.RUnlock()
+	return calls
+}
+
+// CreateIpsRuleFeed calls CreateIpsRuleFeedFunc.
+func (mock *ClientInterfaceMock) CreateIpsRuleFeed(ctx context.Context, ruleFeed *IpsRuleFeed) (*IpsRuleFeedUploadResponse, error) {
+	if mock.CreateIpsRuleFeedFunc == nil {
+		panic("ClientInterfaceMock.CreateIpsRuleFeedFunc: method is nil but ClientInterface.CreateIpsRuleFeed was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		RuleFeed *IpsRuleFeed
+	}{
+		Ctx:      ctx,
+		RuleFeed: ruleFeed,
+	}
+	mock.lockCreateIpsRuleFeed.Lock()
+	mock.calls.CreateIpsRuleFeed = append(mock.calls.CreateIpsRuleFeed, callInfo)
+	mock.lockCreateIpsRuleFeed.Unlock()
+	return mock.CreateIpsRuleFeedFunc(ctx, ruleFeed)
+}
+
+// CreateIpsRuleFeedCalls gets all the calls that were made to CreateIpsRuleFeed.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateIpsRuleFeedCalls())
+func (mock *ClientInterfaceMock) CreateIpsRuleFeedCalls() []struct {
+	Ctx      context.Context
+	RuleFeed *IpsRuleFeed
+} {
+	var calls []struct {
+		Ctx      context.Context
+		RuleFeed *IpsRuleFeed
+	}
+	mock.lockCreateIpsRuleFeed.RLock()
+	calls = mock.calls.CreateIpsRuleFeed
+	mock.lockCreateIpsRuleFeed.RUnlock()
+	return calls
+}
+
+// CreateOCIAccount calls CreateOCIAccountFunc.
+func (mock *ClientInterfaceMock) CreateOCIAccount(account *Account) error {
+	if mock.CreateOCIAccountFunc == nil {
+		panic("ClientInterfaceMock.CreateOCIAccountFunc: method is nil but ClientInterface.CreateOCIAccount was just called")
+	}
+	callInfo := struct {
+		Account *Account
+	}{
+		Account: account,
+	}
+	mock.lockCreateOCIAccount.Lock()
+	mock.calls.CreateOCIAccount = append(mock.calls.CreateOCIAccount, callInfo)
+	mock.lockCreateOCIAccount.Unlock()
+	return mock.CreateOCIAccountFunc(account)
+}
+
+// CreateOCIAccountCalls gets all the calls that were made to CreateOCIAccount.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateOCIAccountCalls())
+func (mock *ClientInterfaceMock) CreateOCIAccountCalls() []struct {
+	Account *Account
+} {
+	var calls []struct {
+		Account *Account
+	}
+	mock.lockCreateOCIAccount.RLock()
+	calls = mock.calls.CreateOCIAccount
+	mock.lockCreateOCIAccount.RUnlock()
+	return calls
+}
+
+// CreatePublicSubnetFilteringGatewayContext calls CreatePublicSubnetFilteringGatewayContextFunc.
+func (mock *ClientInterfaceMock) CreatePublicSubnetFilteringGatewayContext(ctx context.Context, gateway *Gateway) error {
+	if mock.CreatePublicSubnetFilteringGatewayContextFunc == nil {
+		panic("ClientInterfaceMock.CreatePublicSubnetFilteringGatewayContextFunc: method is nil but ClientInterface.CreatePublicSubnetFilteringGatewayContext was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}{
+		Ctx:     ctx,
+		Gateway: gateway,
+	}
+	mock.lockCreatePublicSubnetFilteringGatewayContext.Lock()
+	mock.calls.CreatePublicSubnetFilteringGatewayContext = append(mock.calls.CreatePublicSubnetFilteringGatewayContext, callInfo)
+	mock.lockCreatePublicSubnetFilteringGatewayContext.Unlock()
+	return mock.CreatePublicSubnetFilteringGatewayContextFunc(ctx, gateway)
+}
+
+// CreatePublicSubnetFilteringGatewayContextCalls gets all the calls that were made to CreatePublicSubnetFilteringGatewayContext.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreatePublicSubnetFilteringGatewayContextCalls())
+func (mock *ClientInterfaceMock) CreatePublicSubnetFilteringGatewayContextCalls() []struct {
+	Ctx     context.Context
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}
+	mock.lockCreatePublicSubnetFilteringGatewayContext.RLock()
+	calls = mock.calls.CreatePublicSubnetFilteringGatewayContext
+	mock.lockCreatePublicSubnetFilteringGatewayContext.RUnlock()
+	return calls
+}
+
+// CreateS2CCaCert calls CreateS2CCaCertFunc.
+func (mock *ClientInterfaceMock) CreateS2CCaCert(ctx context.Context, s2cCaCert *S2CCaCert) error {
+	if mock.CreateS2CCaCertFunc == nil {
+		panic("ClientInterfaceMock.CreateS2CCaCertFunc: method is nil but ClientInterface.CreateS2CCaCert was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		S2cCaCert *S2CCaCert
+	}{
+		Ctx:       ctx,
+		S2cCaCert: s2cCaCert,
+	}
+	mock.lockCreateS2CCaCert.Lock()
+	mock.calls.CreateS2CCaCert = append(mock.calls.CreateS2CCaCert, callInfo)
+	mock.lockCreateS2CCaCert.Unlock()
+	return mock.CreateS2CCaCertFunc(ctx, s2cCaCert)
+}
+
+// CreateS2CCaCertCalls gets all the calls that were made to CreateS2CCaCert.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateS2CCaCertCalls())
+func (mock *ClientInterfaceMock) CreateS2CCaCertCalls() []struct {
+	Ctx       context.Context
+	S2cCaCert *S2CCaCert
+} {
+	var calls []struct {
+		Ctx       context.Context
+		S2cCaCert *S2CCaCert
+	}
+	mock.lockCreateS2CCaCert.RLock()
+	calls = mock.calls.CreateS2CCaCert
+	mock.lockCreateS2CCaCert.RUnlock()
+	return calls
+}
+
+// CreateSegmentationSecurityDomain calls CreateSegmentationSecurityDomainFunc.
+func (mock *ClientInterfaceMock) CreateSegmentationSecurityDomain(domain *SegmentationSecurityDomain) error {
+	if mock.CreateSegmentationSecurityDomainFunc == nil {
+		panic("ClientInterfaceMock.CreateSegmentationSecurityDomainFunc: method is nil but ClientInterface.CreateSegmentationSecurityDomain was just called")
+	}
+	callInfo := struct {
+		Domain *SegmentationSecurityDomain
+	}{
+		Domain: domain,
+	}
+	mock.lockCreateSegmentationSecurityDomain.Lock()
+	mock.calls.CreateSegmentationSecurityDomain = append(mock.calls.CreateSegmentationSecurityDomain, callInfo)
+	mock.lockCreateSegmentationSecurityDomain.Unlock()
+	return mock.CreateSegmentationSecurityDomainFunc(domain)
+}
+
+// CreateSegmentationSecurityDomainCalls gets all the calls that were made to CreateSegmentationSecurityDomain.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateSegmentationSecurityDomainCalls())
+func (mock *ClientInterfaceMock) CreateSegmentationSecurityDomainCalls() []struct {
+	Domain *SegmentationSecurityDomain
+} {
+	var calls []struct {
+		Domain *SegmentationSecurityDomain
+	}
+	mock.lockCreateSegmentationSecurityDomain.RLock()
+	calls = mock.calls.CreateSegmentationSecurityDomain
+	mock.lockCreateSegmentationSecurityDomain.RUnlock()
+	return calls
+}
+
+// CreateSegmentationSecurityDomainAssociation calls CreateSegmentationSecurityDomainAssociationFunc.
+func (mock *ClientInterfaceMock) CreateSegmentationSecurityDomainAssociation(association *SegmentationSecurityDomainAssociation) error {
+	if mock.CreateSegmentationSecurityDomainAssociationFunc == nil {
+		panic("ClientInterfaceMock.CreateSegmentationSecurityDomainAssociationFunc: method is nil but ClientInterface.CreateSegmentationSecurityDomainAssociation was just called")
+	}
+	callInfo := struct {
+		Association *SegmentationSecurityDomainAssociation
+	}{
+		Association: association,
+	}
+	mock.lockCreateSegmentationSecurityDomainAssociation.Lock()
+	mock.calls.CreateSegmentationSecurityDomainAssociation = append(mock.calls.CreateSegmentationSecurityDomainAssociation, callInfo)
+	mock.lockCreateSegmentationSecurityDomainAssociation.Unlock()
+	return mock.CreateSegmentationSecurityDomainAssociationFunc(association)
+}
+
+// CreateSegmentationSecurityDomainAssociationCalls gets all the calls that were made to CreateSegmentationSecurityDomainAssociation.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateSegmentationSecurityDomainAssociationCalls())
+func (mock *ClientInterfaceMock) CreateSegmentationSecurityDomainAssociationCalls() []struct {
+	Association *SegmentationSecurityDomainAssociation
+} {
+	var calls []struct {
+		Association *SegmentationSecurityDomainAssociation
+	}
+	mock.lockCreateSegmentationSecurityDomainAssociation.RLock()
+	calls = mock.calls.CreateSegmentationSecurityDomainAssociation
+	mock.lockCreateSegmentationSecurityDomainAssociation.RUnlock()
+	return calls
+}
+
+// CreateSegmentationSecurityDomainConnectionPolicy calls CreateSegmentationSecurityDomainConnectionPolicyFunc.
+func (mock *ClientInterfaceMock) CreateSegmentationSecurityDomainConnectionPolicy(policy *SegmentationSecurityDomainConnectionPolicy) error {
+	if mock.CreateSegmentationSecurityDomainConnectionPolicyFunc == nil {
+		panic("ClientInterfaceMock.CreateSegmentationSecurityDomainConnectionPolicyFunc: method is nil but ClientInterface.CreateSegmentationSecurityDomainConnectionPolicy was just called")
+	}
+	callInfo := struct {
+		Policy *SegmentationSecurityDomainConnectionPolicy
+	}{
+		Policy: policy,
+	}
+	mock.lockCreateSegmentationSecurityDomainConnectionPolicy.Lock()
+	mock.calls.CreateSegmentationSecurityDomainConnectionPolicy = append(mock.calls.CreateSegmentationSecurityDomainConnectionPolicy, callInfo)
+	mock.lockCreateSegmentationSecurityDomainConnectionPolicy.Unlock()
+	return mock.CreateSegmentationSecurityDomainConnectionPolicyFunc(policy)
+}
+
+// CreateSegmentationSecurityDomainConnectionPolicyCalls gets all the calls that were made to CreateSegmentationSecurityDomainConnectionPolicy.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateSegmentationSecurityDomainConnectionPolicyCalls())
+func (mock *ClientInterfaceMock) CreateSegmentationSecurityDomainConnectionPolicyCalls() []struct {
+	Policy *SegmentationSecurityDomainConnectionPolicy
+} {
+	var calls []struct {
+		Policy *SegmentationSecurityDomainConnectionPolicy
+	}
+	mock.lockCreateSegmentationSecurityDomainConnectionPolicy.RLock()
+	calls = mock.calls.CreateSegmentationSecurityDomainConnectionPolicy
+	mock.lockCreateSegmentationSecurityDomainConnectionPolicy.RUnlock()
+	return calls
+}
+
+// CreateSite2Cloud calls CreateSite2CloudFunc.
+func (mock *ClientInterfaceMock) CreateSite2Cloud(site2cloud *Site2Cloud) error {
+	if mock.CreateSite2CloudFunc == nil {
+		panic("ClientInterfaceMock.CreateSite2CloudFunc: method is nil but ClientInterface.CreateSite2Cloud was just called")
+	}
+	callInfo := struct {
+		Site2cloud *Site2Cloud
+	}{
+		Site2cloud: site2cloud,
+	}
+	mock.lockCreateSite2Cloud.Lock()
+	mock.calls.CreateSite2Cloud = append(mock.calls.CreateSite2Cloud, callInfo)
+	mock.lockCreateSite2Cloud.Unlock()
+	return mock.CreateSite2CloudFunc(site2cloud)
+}
+
+// CreateSite2CloudCalls gets all the calls that were made to CreateSite2Cloud.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateSite2CloudCalls())
+func (mock *ClientInterfaceMock) CreateSite2CloudCalls() []struct {
+	Site2cloud *Site2Cloud
+} {
+	var calls []struct {
+		Site2cloud *Site2Cloud
+	}
+	mock.lockCreateSite2Cloud.RLock()
+	calls = mock.calls.CreateSite2Cloud
+	mock.lockCreateSite2Cloud.RUnlock()
+	return calls
+}
+
+// CreateSite2CloudContext calls CreateSite2CloudContextFunc.
+func (mock *ClientInterfaceMock) CreateSite2CloudContext(ctx context.Context, site2cloud *Site2Cloud) error {
+	if mock.CreateSite2CloudContextFunc == nil {
+		panic("ClientInterfaceMock.CreateSite2CloudContextFunc: method is nil but ClientInterface.CreateSite2CloudContext was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Site2cloud *Site2Cloud
+	}{
+		Ctx:        ctx,
+		Site2cloud: site2cloud,
+	}
+	mock.lockCreateSite2CloudContext.Lock()
+	mock.calls.CreateSite2CloudContext = append(mock.calls.CreateSite2CloudContext, callInfo)
+	mock.lockCreateSite2CloudContext.Unlock()
+	return mock.CreateSite2CloudContextFunc(ctx, site2cloud)
+}
+
+// CreateSite2CloudContextCalls gets all the calls that were made to CreateSite2CloudContext.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateSite2CloudContextCalls())
+func (mock *ClientInterfaceMock) CreateSite2CloudContextCalls() []struct {
+	Ctx        context.Context
+	Site2cloud *Site2Cloud
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Site2cloud *Site2Cloud
+	}
+	mock.lockCreateSite2CloudContext.RLock()
+	calls = mock.calls.CreateSite2CloudContext
+	mock.lockCreateSite2CloudContext.RUnlock()
+	return calls
+}
+
+// CreateSmartGroup calls CreateSmartGroupFunc.
+func (mock *ClientInterfaceMock) CreateSmartGroup(ctx context.Context, smartGroup *SmartGroup) (string, error) {
+	if mock.CreateSmartGroupFunc == nil {
+		panic("ClientInterfaceMock.CreateSmartGroupFunc: method is nil but ClientInterface.CreateSmartGroup was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		SmartGroup *SmartGroup
+	}{
+		Ctx:        ctx,
+		SmartGroup: smartGroup,
+	}
+	mock.lockCreateSmartGroup.Lock()
+	mock.calls.CreateSmartGroup = append(mock.calls.CreateSmartGroup, callInfo)
+	mock.lockCreateSmartGroup.Unlock()
+	return mock.CreateSmartGroupFunc(ctx, smartGroup)
+}
+
+// CreateSmartGroupCalls gets all the calls that were made to CreateSmartGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateSmartGroupCalls())
+func (mock *ClientInterfaceMock) CreateSmartGroupCalls() []struct {
+	Ctx        context.Context
+	SmartGroup *SmartGroup
+} {
+	var calls []struct {
+		Ctx        context.Context
+		SmartGroup *SmartGroup
+	}
+	mock.lockCreateSmartGroup.RLock()
+	calls = mock.calls.CreateSmartGroup
+	mock.lockCreateSmartGroup.RUnlock()
+	return calls
+}
+
+// CreateSpokeHaGw calls CreateSpokeHaGwFunc.
+func (mock *ClientInterfaceMock) CreateSpokeHaGw(spokeHaGateway *SpokeHaGateway) (string, error) {
+	if mock.CreateSpokeHaGwFunc == nil {
+		panic("ClientInterfaceMock.CreateSpokeHaGwFunc: method is nil but ClientInterface.CreateSpokeHaGw was just called")
+	}
+	callInfo := struct {
+		SpokeHaGateway *SpokeHaGateway
+	}{
+		SpokeHaGateway: spokeHaGateway,
+	}
+	mock.lockCreateSpokeHaGw.Lock()
+	mock.calls.CreateSpokeHaGw = append(mock.calls.CreateSpokeHaGw, callInfo)
+	mock.lockCreateSpokeHaGw.Unlock()
+	return mock.CreateSpokeHaGwFunc(spokeHaGateway)
+}
+
+// CreateSpokeHaGwCalls gets all the calls that were made to CreateSpokeHaGw.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateSpokeHaGwCalls())
+func (mock *ClientInterfaceMock) CreateSpokeHaGwCalls() []struct {
+	SpokeHaGateway *SpokeHaGateway
+} {
+	var calls []struct {
+		SpokeHaGateway *SpokeHaGateway
+	}
+	mock.lockCreateSpokeHaGw.RLock()
+	calls = mock.calls.CreateSpokeHaGw
+	mock.lockCreateSpokeHaGw.RUnlock()
+	return calls
+}
+
+// CreateSpokeHaGwContext calls CreateSpokeHaGwContextFunc.
+func (mock *ClientInterfaceMock) CreateSpokeHaGwContext(ctx context.Context, spokeHaGateway *SpokeHaGateway) (string, error) {
+	if mock.CreateSpokeHaGwContextFunc == nil {
+		panic("ClientInterfaceMock.CreateSpokeHaGwContextFunc: method is nil but ClientInterface.CreateSpokeHaGwContext was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		SpokeHaGateway *SpokeHaGateway
+	}{
+		Ctx:            ctx,
+		SpokeHaGateway: spokeHaGateway,
+	}
+	mock.lockCreateSpokeHaGwContext.Lock()
+	mock.calls.CreateSpokeHaGwContext = append(mock.calls.CreateSpokeHaGwContext, callInfo)
+	mock.lockCreateSpokeHaGwContext.Unlock()
+	return mock.CreateSpokeHaGwContextFunc(ctx, spokeHaGateway)
+}
+
+// CreateSpokeHaGwContextCalls gets all the calls that were made to CreateSpokeHaGwContext.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateSpokeHaGwContextCalls())
+func (mock *ClientInterfaceMock) CreateSpokeHaGwContextCalls() []struct {
+	Ctx            context.Context
+	SpokeHaGateway *SpokeHaGateway
+} {
+	var calls []struct {
+		Ctx            context.Context
+		SpokeHaGateway *SpokeHaGateway
+	}
+	mock.lockCreateSpokeHaGwContext.RLock()
+	calls = mock.calls.CreateSpokeHaGwContext
+	mock.lockCreateSpokeHaGwContext.RUnlock()
+	return calls
+}
+
+// CreateSpokeTransitAttachment calls CreateSpokeTransitAttachmentFunc.
+func (mock *ClientInterfaceMock) CreateSpokeTransitAttachment(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) error {
+	if mock.CreateSpokeTransitAttachmentFunc == nil {
+		panic("ClientInterfaceMock.CreateSpokeTransitAttachmentFunc: method is nil but ClientInterface.CreateSpokeTransitAttachment was just called")
+	}
+	callInfo := struct {
+		Ctx                    context.Context
+		SpokeTransitAttachment *SpokeTransitAttachment
+	}{
+		Ctx:                    ctx,
+		SpokeTransitAttachment: spokeTransitAttachment,
+	}
+	mock.lockCreateSpokeTransitAttachment.Lock()
+	mock.calls.CreateSpokeTransitAttachment = append(mock.calls.CreateSpokeTransitAttachment, callInfo)
+	mock.lockCreateSpokeTransitAttachment.Unlock()
+	return mock.CreateSpokeTransitAttachmentFunc(ctx, spokeTransitAttachment)
+}
+
+// CreateSpokeTransitAttachmentCalls gets all the calls that were made to CreateSpokeTransitAttachment.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateSpokeTransitAttachmentCalls())
+func (mock *ClientInterfaceMock) CreateSpokeTransitAttachmentCalls() []struct {
+	Ctx                    context.Context
+	SpokeTransitAttachment *SpokeTransitAttachment
+} {
+	var calls []struct {
+		Ctx                    context.Context
+		SpokeTransitAttachment *SpokeTransitAttachment
+	}
+	mock.lockCreateSpokeTransitAttachment.RLock()
+	calls = mock.calls.CreateSpokeTransitAttachment
+	mock.lockCreateSpokeTransitAttachment.RUnlock()
+	return calls
+}
+
+// CreateTLSProfile calls CreateTLSProfileFunc.
+func (mock *ClientInterfaceMock) CreateTLSProfile(ctx context.Context, tlsProfile *TLSProfile) (string, error) {
+	if mock.CreateTLSProfileFunc == nil {
+		panic("ClientInterfaceMock.CreateTLSProfileFunc: method is nil but ClientInterface.CreateTLSProfile was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		TlsProfile *TLSProfile
+	}{
+		Ctx:        ctx,
+		TlsProfile: tlsProfile,
+	}
+	mock.lockCreateTLSProfile.Lock()
+	mock.calls.CreateTLSProfile = append(mock.calls.CreateTLSProfile, callInfo)
+	mock.lockCreateTLSProfile.Unlock()
+	return mock.CreateTLSProfileFunc(ctx, tlsProfile)
+}
+
+// CreateTLSProfileCalls gets all the calls that were made to CreateTLSProfile.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateTLSProfileCalls())
+func (mock *ClientInterfaceMock) CreateTLSProfileCalls() []struct {
+	Ctx        context.Context
+	TlsProfile *TLSProfile
+} {
+	var calls []struct {
+		Ctx        context.Context
+		TlsProfile *TLSProfile
+	}
+	mock.lockCreateTLSProfile.RLock()
+	calls = mock.calls.CreateTLSProfile
+	mock.lockCreateTLSProfile.RUnlock()
+	return calls
+}
+
+// CreateTransitHaGwContext calls CreateTransitHaGwContextFunc.
+func (mock *ClientInterfaceMock) CreateTransitHaGwContext(ctx context.Context, transitHaGateway *TransitHaGateway) (string, error) {
+	if mock.CreateTransitHaGwContextFunc == nil {
+		panic("ClientInterfaceMock.CreateTransitHaGwContextFunc: method is nil but ClientInterface.CreateTransitHaGwContext was just called")
+	}
+	callInfo := struct {
+		Ctx              context.Context
+		TransitHaGateway *TransitHaGateway
+	}{
+		Ctx:              ctx,
+		TransitHaGateway: transitHaGateway,
+	}
+	mock.lockCreateTransitHaGwContext.Lock()
+	mock.calls.CreateTransitHaGwContext = append(mock.calls.CreateTransitHaGwContext, callInfo)
+	mock.lockCreateTransitHaGwContext.Unlock()
+	return mock.CreateTransitHaGwContextFunc(ctx, transitHaGateway)
+}
+
+// CreateTransitHaGwContextCalls gets all the calls that were made to CreateTransitHaGwContext.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateTransitHaGwContextCalls())
+func (mock *ClientInterfaceMock) CreateTransitHaGwContextCalls() []struct {
+	Ctx              context.Context
+	TransitHaGateway *TransitHaGateway
+} {
+	var calls []struct {
+		Ctx              context.Context
+		TransitHaGateway *TransitHaGateway
+	}
+	mock.lockCreateTransitHaGwContext.RLock()
+	calls = mock.calls.CreateTransitHaGwContext
+	mock.lockCreateTransitHaGwContext.RUnlock()
+	return calls
+}
+
+// CreateWebGroup calls CreateWebGroupFunc.
+func (mock *ClientInterfaceMock) CreateWebGroup(ctx context.Context, webGroup *WebGroup) (string, error) {
+	if mock.CreateWebGroupFunc == nil {
+		panic("ClientInterfaceMock.CreateWebGroupFunc: method is nil but ClientInterface.CreateWebGroup was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		WebGroup *WebGroup
+	}{
+		Ctx:      ctx,
+		WebGroup: webGroup,
+	}
+	mock.lockCreateWebGroup.Lock()
+	mock.calls.CreateWebGroup = append(mock.calls.CreateWebGroup, callInfo)
+	mock.lockCreateWebGroup.Unlock()
+	return mock.CreateWebGroupFunc(ctx, webGroup)
+}
+
+// CreateWebGroupCalls gets all the calls that were made to CreateWebGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.CreateWebGroupCalls())
+func (mock *ClientInterfaceMock) CreateWebGroupCalls() []struct {
+	Ctx      context.Context
+	WebGroup *WebGroup
+} {
+	var calls []struct {
+		Ctx      context.Context
+		WebGroup *WebGroup
+	}
+	mock.lockCreateWebGroup.RLock()
+	calls = mock.calls.CreateWebGroup
+	mock.lockCreateWebGroup.RUnlock()
+	return calls
+}
+
+// DeleteAccount calls DeleteAccountFunc.
+func (mock *ClientInterfaceMock) DeleteAccount(account *Account) error {
+	if mock.DeleteAccountFunc == nil {
+		panic("ClientInterfaceMock.DeleteAccountFunc: method is nil but ClientInterface.DeleteAccount was just called")
+	}
+	callInfo := struct {
+		Account *Account
+	}{
+		Account: account,
+	}
+	mock.lockDeleteAccount.Lock()
+	mock.calls.DeleteAccount = append(mock.calls.DeleteAccount, callInfo)
+	mock.lockDeleteAccount.Unlock()
+	return mock.DeleteAccountFunc(account)
+}
+
+// DeleteAccountCalls gets all the calls that were made to DeleteAccount.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteAccountCalls())
+func (mock *ClientInterfaceMock) DeleteAccountCalls() []struct {
+	Account *Account
+} {
+	var calls []struct {
+		Account *Account
+	}
+	mock.lockDeleteAccount.RLock()
+	calls = mock.calls.DeleteAccount
+	mock.lockDeleteAccount.RUnlock()
+	return calls
+}
+
+// DeleteAccountUser calls DeleteAccountUserFunc.
+func (mock *ClientInterfaceMock) DeleteAccountUser(user *AccountUser) error {
+	if mock.DeleteAccountUserFunc == nil {
+		panic("ClientInterfaceMock.DeleteAccountUserFunc: method is nil but ClientInterface.DeleteAccountUser was just called")
+	}
+	callInfo := struct {
+		User *AccountUser
+	}{
+		User: user,
+	}
+	mock.lockDeleteAccountUser.Lock()
+	mock.calls.DeleteAccountUser = append(mock.calls.DeleteAccountUser, callInfo)
+	mock.lockDeleteAccountUser.Unlock()
+	return mock.DeleteAccountUserFunc(user)
+}
+
+// DeleteAccountUserCalls gets all the calls that were made to DeleteAccountUser.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteAccountUserCalls())
+func (mock *ClientInterfaceMock) DeleteAccountUserCalls() []struct {
+	User *AccountUser
+} {
+	var calls []struct {
+		User *AccountUser
+	}
+	mock.lockDeleteAccountUser.RLock()
+	calls = mock.calls.DeleteAccountUser
+	mock.lockDeleteAccountUser.RUnlock()
+	return calls
+}
+
+// DeleteCaCertificate calls DeleteCaCertificateFunc.
+func (mock *ClientInterfaceMock) DeleteCaCertificate(ctx context.Context) error {
+	if mock.DeleteCaCertificateFunc == nil {
+		panic("ClientInterfaceMock.DeleteCaCertificateFunc: method is nil but ClientInterface.DeleteCaCertificate was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockDeleteCaCertificate.Lock()
+	mock.calls.DeleteCaCertificate = append(mock.calls.DeleteCaCertificate, callInfo)
+	mock.lockDeleteCaCertificate.Unlock()
+	return mock.DeleteCaCertificateFunc(ctx)
+}
+
+// DeleteCaCertificateCalls gets all the calls that were made to DeleteCaCertificate.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteCaCertificateCalls())
+func (mock *ClientInterfaceMock) DeleteCaCertificateCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockDeleteCaCertificate.RLock()
+	calls = mock.calls.DeleteCaCertificate
+	mock.lockDeleteCaCertificate.RUnlock()
+	return calls
+}
+
+// DeleteCertInstance calls DeleteCertInstanceFunc.
+func (mock *ClientInterfaceMock) DeleteCertInstance(ctx context.Context, caCertInstance *CaCertInstance) error {
+	if mock.DeleteCertInstanceFunc == nil {
+		panic("ClientInterfaceMock.DeleteCertInstanceFunc: method is nil but ClientInterface.DeleteCertInstance was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		CaCertInstance *CaCertInstance
+	}{
+		Ctx:            ctx,
+		CaCertInstance: caCertInstance,
+	}
+	mock.lockDeleteCertInstance.Lock()
+	mock.calls.DeleteCertInstance = append(mock.calls.DeleteCertInstance, callInfo)
+	mock.lockDeleteCertInstance.Unlock()
+	return mock.DeleteCertInstanceFunc(ctx, caCertInstance)
+}
+
+// DeleteCertInstanceCalls gets all the calls that were made to DeleteCertInstance.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteCertInstanceCalls())
+func (mock *ClientInterfaceMock) DeleteCertInstanceCalls() []struct {
+	Ctx            context.Context
+	CaCertInstance *CaCertInstance
+} {
+	var calls []struct {
+		Ctx            context.Context
+		CaCertInstance *CaCertInstance
+	}
+	mock.lockDeleteCertInstance.RLock()
+	calls = mock.calls.DeleteCertInstance
+	mock.lockDeleteCertInstance.RUnlock()
+	return calls
+}
+
+// DeleteDCFMitmCa calls DeleteDCFMitmCaFunc.
+func (mock *ClientInterfaceMock) DeleteDCFMitmCa(ctx context.Context, caID string) error {
+	if mock.DeleteDCFMitmCaFunc == nil {
+		panic("ClientInterfaceMock.DeleteDCFMitmCaFunc: method is nil but ClientInterface.DeleteDCFMitmCa was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		CaID string
+	}{
+		Ctx:  ctx,
+		CaID: caID,
+	}
+	mock.lockDeleteDCFMitmCa.Lock()
+	mock.calls.DeleteDCFMitmCa = append(mock.calls.DeleteDCFMitmCa, callInfo)
+	mock.lockDeleteDCFMitmCa.Unlock()
+	return mock.DeleteDCFMitmCaFunc(ctx, caID)
+}
+
+// DeleteDCFMitmCaCalls gets all the calls that were made to DeleteDCFMitmCa.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteDCFMitmCaCalls())
+func (mock *ClientInterfaceMock) DeleteDCFMitmCaCalls() []struct {
+	Ctx  context.Context
+	CaID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		CaID string
+	}
+	mock.lockDeleteDCFMitmCa.RLock()
+	calls = mock.calls.DeleteDCFMitmCa
+	mock.lockDeleteDCFMitmCa.RUnlock()
+	return calls
+}
+
+// DeleteDCFPolicyBlock calls DeleteDCFPolicyBlockFunc.
+func (mock *ClientInterfaceMock) DeleteDCFPolicyBlock(ctx context.Context, uuid string) error {
+	if mock.DeleteDCFPolicyBlockFunc == nil {
+		panic("ClientInterfaceMock.DeleteDCFPolicyBlockFunc: method is nil but ClientInterface.DeleteDCFPolicyBlock was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		UUID string
+	}{
+		Ctx:  ctx,
+		UUID: uuid,
+	}
+	mock.lockDeleteDCFPolicyBlock.Lock()
+	mock.calls.DeleteDCFPolicyBlock = append(mock.calls.DeleteDCFPolicyBlock, callInfo)
+	mock.lockDeleteDCFPolicyBlock.Unlock()
+	return mock.DeleteDCFPolicyBlockFunc(ctx, uuid)
+}
+
+// DeleteDCFPolicyBlockCalls gets all the calls that were made to DeleteDCFPolicyBlock.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteDCFPolicyBlockCalls())
+func (mock *ClientInterfaceMock) DeleteDCFPolicyBlockCalls() []struct {
+	Ctx  context.Context
+	UUID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		UUID string
+	}
+	mock.lockDeleteDCFPolicyBlock.RLock()
+	calls = mock.calls.DeleteDCFPolicyBlock
+	mock.lockDeleteDCFPolicyBlock.RUnlock()
+	return calls
+}
+
+// DeleteDCFPolicyList calls DeleteDCFPolicyListFunc.
+func (mock *ClientInterfaceMock) DeleteDCFPolicyList(ctx context.Context, uuid string) error {
+	if mock.DeleteDCFPolicyListFunc == nil {
+		panic("ClientInterfaceMock.DeleteDCFPolicyListFunc: method is nil but ClientInterface.DeleteDCFPolicyList was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		UUID string
+	}{
+		Ctx:  ctx,
+		UUID: uuid,
+	}
+	mock.lockDeleteDCFPolicyList.Lock()
+	mock.calls.DeleteDCFPolicyList = append(mock.calls.DeleteDCFPolicyList, callInfo)
+	mock.lockDeleteDCFPolicyList.Unlock()
+	return mock.DeleteDCFPolicyListFunc(ctx, uuid)
+}
+
+// DeleteDCFPolicyListCalls gets all the calls that were made to DeleteDCFPolicyList.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteDCFPolicyListCalls())
+func (mock *ClientInterfaceMock) DeleteDCFPolicyListCalls() []struct {
+	Ctx  context.Context
+	UUID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		UUID string
+	}
+	mock.lockDeleteDCFPolicyList.RLock()
+	calls = mock.calls.DeleteDCFPolicyList
+	mock.lockDeleteDCFPolicyList.RUnlock()
+	return calls
+}
+
+// DeleteDCFTrustBundle calls DeleteDCFTrustBundleFunc.
+func (mock *ClientInterfaceMock) DeleteDCFTrustBundle(ctx context.Context, bundleUUID string) error {
+	if mock.DeleteDCFTrustBundleFunc == nil {
+		panic("ClientInterfaceMock.DeleteDCFTrustBundleFunc: method is nil but ClientInterface.DeleteDCFTrustBundle was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		BundleUUID string
+	}{
+		Ctx:        ctx,
+		BundleUUID: bundleUUID,
+	}
+	mock.lockDeleteDCFTrustBundle.Lock()
+	mock.calls.DeleteDCFTrustBundle = append(mock.calls.DeleteDCFTrustBundle, callInfo)
+	mock.lockDeleteDCFTrustBundle.Unlock()
+	return mock.DeleteDCFTrustBundleFunc(ctx, bundleUUID)
+}
+
+// DeleteDCFTrustBundleCalls gets all the calls that were made to DeleteDCFTrustBundle.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteDCFTrustBundleCalls())
+func (mock *ClientInterfaceMock) DeleteDCFTrustBundleCalls() []struct {
+	Ctx        context.Context
+	BundleUUID string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		BundleUUID string
+	}
+	mock.lockDeleteDCFTrustBundle.RLock()
+	calls = mock.calls.DeleteDCFTrustBundle
+	mock.lockDeleteDCFTrustBundle.RUnlock()
+	return calls
+}
+
+// DeleteDistributedFirewallingIntraVpc calls DeleteDistributedFirewallingIntraVpcFunc.
+func (mock *ClientInterfaceMock) DeleteDistributedFirewallingIntraVpc(ctx context.Context) error {
+	if mock.DeleteDistributedFirewallingIntraVpcFunc == nil {
+		panic("ClientInterfaceMock.DeleteDistributedFirewallingIntraVpcFunc: method is nil but ClientInterface.DeleteDistributedFirewallingIntraVpc was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockDeleteDistributedFirewallingIntraVpc.Lock()
+	mock.calls.DeleteDistributedFirewallingIntraVpc = append(mock.calls.DeleteDistributedFirewallingIntraVpc, callInfo)
+	mock.lockDeleteDistributedFirewallingIntraVpc.Unlock()
+	return mock.DeleteDistributedFirewallingIntraVpcFunc(ctx)
+}
+
+// DeleteDistributedFirewallingIntraVpcCalls gets all the calls that were made to DeleteDistributedFirewallingIntraVpc.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteDistributedFirewallingIntraVpcCalls())
+func (mock *ClientInterfaceMock) DeleteDistributedFirewallingIntraVpcCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockDeleteDistributedFirewallingIntraVpc.RLock()
+	calls = mock.calls.DeleteDistributedFirewallingIntraVpc
+	mock.lockDeleteDistributedFirewallingIntraVpc.RUnlock()
+	return calls
+}
+
+// DeleteDistributedFirewallingPolicyList calls DeleteDistributedFirewallingPolicyListFunc.
+func (mock *ClientInterfaceMock) DeleteDistributedFirewallingPolicyList(ctx context.Context) error {
+	if mock.DeleteDistributedFirewallingPolicyListFunc == nil {
+		panic("ClientInterfaceMock.DeleteDistributedFirewallingPolicyListFunc: method is nil but ClientInterface.DeleteDistributedFirewallingPolicyList was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockDeleteDistributedFirewallingPolicyList.Lock()
+	mock.calls.DeleteDistributedFirewallingPolicyList = append(mock.calls.DeleteDistributedFirewallingPolicyList, callInfo)
+	mock.lockDeleteDistributedFirewallingPolicyList.Unlock()
+	return mock.DeleteDistributedFirewallingPolicyListFunc(ctx)
+}
+
+// DeleteDistributedFirewallingPolicyListCalls gets all the calls that were made to DeleteDistributedFirewallingPolicyList.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteDistributedFirewallingPolicyListCalls())
+func (mock *ClientInterfaceMock) DeleteDistributedFirewallingPolicyListCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockDeleteDistributedFirewallingPolicyList.RLock()
+	calls = mock.calls.DeleteDistributedFirewallingPolicyList
+	mock.lockDeleteDistributedFirewallingPolicyList.RUnlock()
+	return calls
+}
+
+// DeleteEdgeCSP calls DeleteEdgeCSPFunc.
+func (mock *ClientInterfaceMock) DeleteEdgeCSP(ctx context.Context, accountName string, name string) error {
+	if mock.DeleteEdgeCSPFunc == nil {
+		panic("ClientInterfaceMock.DeleteEdgeCSPFunc: method is nil but ClientInterface.DeleteEdgeCSP was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		AccountName string
+		Name        string
+	}{
+		Ctx:         ctx,
+		AccountName: accountName,
+		Name:        name,
+	}
+	mock.lockDeleteEdgeCSP.Lock()
+	mock.calls.DeleteEdgeCSP = append(mock.calls.DeleteEdgeCSP, callInfo)
+	mock.lockDeleteEdgeCSP.Unlock()
+	return mock.DeleteEdgeCSPFunc(ctx, accountName, name)
+}
+
+// DeleteEdgeCSPCalls gets all the calls that were made to DeleteEdgeCSP.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteEdgeCSPCalls())
+func (mock *ClientInterfaceMock) DeleteEdgeCSPCalls() []struct {
+	Ctx         context.Context
+	AccountName string
+	Name        string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		AccountName string
+		Name        string
+	}
+	mock.lockDeleteEdgeCSP.RLock()
+	calls = mock.calls.DeleteEdgeCSP
+	mock.lockDeleteEdgeCSP.RUnlock()
+	return calls
+}
+
+// DeleteEdgeEquinix calls DeleteEdgeEquinixFunc.
+func (mock *ClientInterfaceMock) DeleteEdgeEquinix(ctx context.Context, accountName string, name string) error {
+	if mock.DeleteEdgeEquinixFunc == nil {
+		panic("ClientInterfaceMock.DeleteEdgeEquinixFunc: method is nil but ClientInterface.DeleteEdgeEquinix was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		AccountName string
+		Name        string
+	}{
+		Ctx:         ctx,
+		AccountName: accountName,
+		Name:        name,
+	}
+	mock.lockDeleteEdgeEquinix.Lock()
+	mock.calls.DeleteEdgeEquinix = append(mock.calls.DeleteEdgeEquinix, callInfo)
+	mock.lockDeleteEdgeEquinix.Unlock()
+	return mock.DeleteEdgeEquinixFunc(ctx, accountName, name)
+}
+
+// DeleteEdgeEquinixCalls gets all the calls that were made to DeleteEdgeEquinix.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteEdgeEquinixCalls())
+func (mock *ClientInterfaceMock) DeleteEdgeEquinixCalls() []struct {
+	Ctx         context.Context
+	AccountName string
+	Name        string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		AccountName string
+		Name        string
+	}
+	mock.lockDeleteEdgeEquinix.RLock()
+	calls = mock.calls.DeleteEdgeEquinix
+	mock.lockDeleteEdgeEquinix.RUnlock()
+	return calls
+}
+
+// DeleteEdgeExternalDeviceConn calls DeleteEdgeExternalDeviceConnFunc.
+func (mock *ClientInterfaceMock) DeleteEdgeExternalDeviceConn(edgeExternalDeviceConn *EdgeExternalDeviceConn) error {
+	if mock.DeleteEdgeExternalDeviceConnFunc == nil {
+		panic("ClientInterfaceMock.DeleteEdgeExternalDeviceConnFunc: method is nil but ClientInterface.DeleteEdgeExternalDeviceConn was just called")
+	}
+	callInfo := struct {
+		EdgeExternalDeviceConn *EdgeExternalDeviceConn
+	}{
+		EdgeExternalDeviceConn: edgeExternalDeviceConn,
+	}
+	mock.lockDeleteEdgeExternalDeviceConn.Lock()
+	mock.calls.DeleteEdgeExternalDeviceConn = append(mock.calls.DeleteEdgeExternalDeviceConn, callInfo)
+	mock.lockDeleteEdgeExternalDeviceConn.Unlock()
+	return mock.DeleteEdgeExternalDeviceConnFunc(edgeExternalDeviceConn)
+}
+
+// DeleteEdgeExternalDeviceConnCalls gets all the calls that were made to DeleteEdgeExternalDeviceConn.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteEdgeExternalDeviceConnCalls())
+func (mock *ClientInterfaceMock) DeleteEdgeExternalDeviceConnCalls() []struct {
+	EdgeExternalDeviceConn *EdgeExternalDeviceConn
+} {
+	var calls []struct {
+		EdgeExternalDeviceConn *EdgeExternalDeviceConn
+	}
+	mock.lockDeleteEdgeExternalDeviceConn.RLock()
+	calls = mock.calls.DeleteEdgeExternalDeviceConn
+	mock.lockDeleteEdgeExternalDeviceConn.RUnlock()
+	return calls
+}
+
+// DeleteEdgeMegaport calls DeleteEdgeMegaportFunc.
+func (mock *ClientInterfaceMock) DeleteEdgeMegaport(ctx context.Context, accountName string, name string) error {
+	if mock.DeleteEdgeMegaportFunc == nil {
+		panic("ClientInterfaceMock.DeleteEdgeMegaportFunc: method is nil but ClientInterface.DeleteEdgeMegaport was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		AccountName string
+		Name        string
+	}{
+		Ctx:         ctx,
+		AccountName: accountName,
+		Name:        name,
+	}
+	mock.lockDeleteEdgeMegaport.Lock()
+	mock.calls.DeleteEdgeMegaport = append(mock.calls.DeleteEdgeMegaport, callInfo)
+	mock.lockDeleteEdgeMegaport.Unlock()
+	return mock.DeleteEdgeMegaportFunc(ctx, accountName, name)
+}
+
+// DeleteEdgeMegaportCalls gets all the calls that were made to DeleteEdgeMegaport.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteEdgeMegaportCalls())
+func (mock *ClientInterfaceMock) DeleteEdgeMegaportCalls() []struct {
+	Ctx         context.Context
+	AccountName string
+	Name        string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		AccountName string
+		Name        string
+	}
+	mock.lockDeleteEdgeMegaport.RLock()
+	calls = mock.calls.DeleteEdgeMegaport
+	mock.lockDeleteEdgeMegaport.RUnlock()
+	return calls
+}
+
+// DeleteEdgeNEO calls DeleteEdgeNEOFunc.
+func (mock *ClientInterfaceMock) DeleteEdgeNEO(ctx context.Context, accountName string, name string) error {
+	if mock.DeleteEdgeNEOFunc == nil {
+		panic("ClientInterfaceMock.DeleteEdgeNEOFunc: method is nil but ClientInterface.DeleteEdgeNEO was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		AccountName string
+		Name        string
+	}{
+		Ctx:         ctx,
+		AccountName: accountName,
+		Name:        name,
+	}
+	mock.lockDeleteEdgeNEO.Lock()
+	mock.calls.DeleteEdgeNEO = append(mock.calls.DeleteEdgeNEO, callInfo)
+	mock.lockDeleteEdgeNEO.Unlock()
+	return mock.DeleteEdgeNEOFunc(ctx, accountName, name)
+}
+
+// DeleteEdgeNEOCalls gets all the calls that were made to DeleteEdgeNEO.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteEdgeNEOCalls())
+func (mock *ClientInterfaceMock) DeleteEdgeNEOCalls() []struct {
+	Ctx         context.Context
+	AccountName string
+	Name        string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		AccountName string
+		Name        string
+	}
+	mock.lockDeleteEdgeNEO.RLock()
+	calls = mock.calls.DeleteEdgeNEO
+	mock.lockDeleteEdgeNEO.RUnlock()
+	return calls
+}
+
+// DeleteEdgeNEODevice calls DeleteEdgeNEODeviceFunc.
+func (mock *ClientInterfaceMock) DeleteEdgeNEODevice(ctx context.Context, accountName string, serialNumber string) error {
+	if mock.DeleteEdgeNEODeviceFunc == nil {
+		panic("ClientInterfaceMock.DeleteEdgeNEODeviceFunc: method is nil but ClientInterface.DeleteEdgeNEODevice was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		AccountName  string
+		SerialNumber string
+	}{
+		Ctx:          ctx,
+		AccountName:  accountName,
+		SerialNumber: serialNumber,
+	}
+	mock.lockDeleteEdgeNEODevice.Lock()
+	mock.calls.DeleteEdgeNEODevice = append(mock.calls.DeleteEdgeNEODevice, callInfo)
+	mock.lockDeleteEdgeNEODevice.Unlock()
+	return mock.DeleteEdgeNEODeviceFunc(ctx, accountName, serialNumber)
+}
+
+// DeleteEdgeNEODeviceCalls gets all the calls that were made to DeleteEdgeNEODevice.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteEdgeNEODeviceCalls())
+func (mock *ClientInterfaceMock) DeleteEdgeNEODeviceCalls() []struct {
+	Ctx          context.Context
+	AccountName  string
+	SerialNumber string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		AccountName  string
+		SerialNumber string
+	}
+	mock.lockDeleteEdgeNEODevice.RLock()
+	calls = mock.calls.DeleteEdgeNEODevice
+	mock.lockDeleteEdgeNEODevice.RUnlock()
+	return calls
+}
+
+// DeleteEdgePlatformProxyProfile calls DeleteEdgePlatformProxyProfileFunc.
+func (mock *ClientInterfaceMock) DeleteEdgePlatformProxyProfile(ctx context.Context, accountName string, profileName string) error {
+	if mock.DeleteEdgePlatformProxyProfileFunc == nil {
+		panic("ClientInterfaceMock.DeleteEdgePlatformProxyProfileFunc: method is nil but ClientInterface.DeleteEdgePlatformProxyProfile was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		AccountName string
+		ProfileName string
+	}{
+		Ctx:         ctx,
+		AccountName: accountName,
+		ProfileName: profileName,
+	}
+	mock.lockDeleteEdgePlatformProxyProfile.Lock()
+	mock.calls.DeleteEdgePlatformProxyProfile = append(mock.calls.DeleteEdgePlatformProxyProfile, callInfo)
+	mock.lockDeleteEdgePlatformProxyProfile.Unlock()
+	return mock.DeleteEdgePlatformProxyProfileFunc(ctx, accountName, profileName)
+}
+
+// DeleteEdgePlatformProxyProfileCalls gets all the calls that were made to DeleteEdgePlatformProxyProfile.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteEdgePlatformProxyProfileCalls())
+func (mock *ClientInterfaceMock) DeleteEdgePlatformProxyProfileCalls() []struct {
+	Ctx         context.Context
+	AccountName string
+	ProfileName string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		AccountName string
+		ProfileName string
+	}
+	mock.lockDeleteEdgePlatformProxyProfile.RLock()
+	calls = mock.calls.DeleteEdgePlatformProxyProfile
+	mock.lockDeleteEdgePlatformProxyProfile.RUnlock()
+	return calls
+}
+
+// DeleteEdgeSpoke calls DeleteEdgeSpokeFunc.
+func (mock *ClientInterfaceMock) DeleteEdgeSpoke(ctx context.Context, name string) error {
+	if mock.DeleteEdgeSpokeFunc == nil {
+		panic("ClientInterfaceMock.DeleteEdgeSpokeFunc: method is nil but ClientInterface.DeleteEdgeSpoke was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+	}{
+		Ctx:  ctx,
+		Name: name,
+	}
+	mock.lockDeleteEdgeSpoke.Lock()
+	mock.calls.DeleteEdgeSpoke = append(mock.calls.DeleteEdgeSpoke, callInfo)
+	mock.lockDeleteEdgeSpoke.Unlock()
+	return mock.DeleteEdgeSpokeFunc(ctx, name)
+}
+
+// DeleteEdgeSpokeCalls gets all the calls that were made to DeleteEdgeSpoke.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteEdgeSpokeCalls())
+func (mock *ClientInterfaceMock) DeleteEdgeSpokeCalls() []struct {
+	Ctx  context.Context
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+	}
+	mock.lockDeleteEdgeSpoke.RLock()
+	calls = mock.calls.DeleteEdgeSpoke
+	mock.lockDeleteEdgeSpoke.RUnlock()
+	return calls
+}
+
+// DeleteEnforcementLevel calls DeleteEnforcementLevelFunc.
+func (mock *ClientInterfaceMock) DeleteEnforcementLevel(ctx context.Context) error {
+	if mock.DeleteEnforcementLevelFunc == nil {
+		panic("ClientInterfaceMock.DeleteEnforcementLevelFunc: method is nil but ClientInterface.DeleteEnforcementLevel was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockDeleteEnforcementLevel.Lock()
+	mock.calls.DeleteEnforcementLevel = append(mock.calls.DeleteEnforcementLevel, callInfo)
+	mock.lockDeleteEnforcementLevel.Unlock()
+	return mock.DeleteEnforcementLevelFunc(ctx)
+}
+
+// DeleteEnforcementLevelCalls gets all the calls that were made to DeleteEnforcementLevel.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteEnforcementLevelCalls())
+func (mock *ClientInterfaceMock) DeleteEnforcementLevelCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockDeleteEnforcementLevel.RLock()
+	calls = mock.calls.DeleteEnforcementLevel
+	mock.lockDeleteEnforcementLevel.RUnlock()
+	return calls
+}
+
+// DeleteExternalDeviceConn calls DeleteExternalDeviceConnFunc.
+func (mock *ClientInterfaceMock) DeleteExternalDeviceConn(externalDeviceConn *ExternalDeviceConn) error {
+	if mock.DeleteExternalDeviceConnFunc == nil {
+		panic("ClientInterfaceMock.DeleteExternalDeviceConnFunc: method is nil but ClientInterface.DeleteExternalDeviceConn was just called")
+	}
+	callInfo := struct {
+		ExternalDeviceConn *ExternalDeviceConn
+	}{
+		ExternalDeviceConn: externalDeviceConn,
+	}
+	mock.lockDeleteExternalDeviceConn.Lock()
+	mock.calls.DeleteExternalDeviceConn = append(mock.calls.DeleteExternalDeviceConn, callInfo)
+	mock.lockDeleteExternalDeviceConn.Unlock()
+	return mock.DeleteExternalDeviceConnFunc(externalDeviceConn)
+}
+
+// DeleteExternalDeviceConnCalls gets all the calls that were made to DeleteExternalDeviceConn.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteExternalDeviceConnCalls())
+func (mock *ClientInterfaceMock) DeleteExternalDeviceConnCalls() []struct {
+	ExternalDeviceConn *ExternalDeviceConn
+} {
+	var calls []struct {
+		ExternalDeviceConn *ExternalDeviceConn
+	}
+	mock.lockDeleteExternalDeviceConn.RLock()
+	calls = mock.calls.DeleteExternalDeviceConn
+	mock.lockDeleteExternalDeviceConn.RUnlock()
+	return calls
+}
+
+// DeleteGateway calls DeleteGatewayFunc.
+func (mock *ClientInterfaceMock) DeleteGateway(gateway *Gateway) error {
+	if mock.DeleteGatewayFunc == nil {
+		panic("ClientInterfaceMock.DeleteGatewayFunc: method is nil but ClientInterface.DeleteGateway was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDeleteGateway.Lock()
+	mock.calls.DeleteGateway = append(mock.calls.DeleteGateway, callInfo)
+	mock.lockDeleteGateway.Unlock()
+	return mock.DeleteGatewayFunc(gateway)
+}
+
+// DeleteGatewayCalls gets all the calls that were made to DeleteGateway.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteGatewayCalls())
+func (mock *ClientInterfaceMock) DeleteGatewayCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDeleteGateway.RLock()
+	calls = mock.calls.DeleteGateway
+	mock.lockDeleteGateway.RUnlock()
+	return calls
+}
+
+// DeleteGatewayContext calls DeleteGatewayContextFunc.
+func (mock *ClientInterfaceMock) DeleteGatewayContext(ctx context.Context, gateway *Gateway) error {
+	if mock.DeleteGatewayContextFunc == nil {
+		panic("ClientInterfaceMock.DeleteGatewayContextFunc: method is nil but ClientInterface.DeleteGatewayContext was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}{
+		Ctx:     ctx,
+		Gateway: gateway,
+	}
+	mock.lockDeleteGatewayContext.Lock()
+	mock.calls.DeleteGatewayContext = append(mock.calls.DeleteGatewayContext, callInfo)
+	mock.lockDeleteGatewayContext.Unlock()
+	return mock.DeleteGatewayContextFunc(ctx, gateway)
+}
+
+// DeleteGatewayContextCalls gets all the calls that were made to DeleteGatewayContext.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteGatewayContextCalls())
+func (mock *ClientInterfaceMock) DeleteGatewayContextCalls() []struct {
+	Ctx     context.Context
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}
+	mock.lockDeleteGatewayContext.RLock()
+	calls = mock.calls.DeleteGatewayContext
+	mock.lockDeleteGatewayContext.RUnlock()
+	return calls
+}
+
+// DeleteGatewayGroup calls DeleteGatewayGroupFunc.
+func (mock *ClientInterfaceMock) DeleteGatewayGroup(ctx context.Context, groupUUID string) error {
+	if mock.DeleteGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.DeleteGatewayGroupFunc: method is nil but ClientInterface.DeleteGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupUUID string
+	}{
+		Ctx:       ctx,
+		GroupUUID: groupUUID,
+	}
+	mock.lockDeleteGatewayGroup.Lock()
+	mock.calls.DeleteGatewayGroup = append(mock.calls.DeleteGatewayGroup, callInfo)
+	mock.lockDeleteGatewayGroup.Unlock()
+	return mock.DeleteGatewayGroupFunc(ctx, groupUUID)
+}
+
+// DeleteGatewayGroupCalls gets all the calls that were made to DeleteGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteGatewayGroupCalls())
+func (mock *ClientInterfaceMock) DeleteGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupUUID string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupUUID string
+	}
+	mock.lockDeleteGatewayGroup.RLock()
+	calls = mock.calls.DeleteGatewayGroup
+	mock.lockDeleteGatewayGroup.RUnlock()
+	return calls
+}
+
+// DeleteIpsProfile calls DeleteIpsProfileFunc.
+func (mock *ClientInterfaceMock) DeleteIpsProfile(ctx context.Context, uuid string) error {
+	if mock.DeleteIpsProfileFunc == nil {
+		panic("ClientInterfaceMock.DeleteIpsProfileFunc: method is nil but ClientInterface.DeleteIpsProfile was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		UUID string
+	}{
+		Ctx:  ctx,
+		UUID: uuid,
+	}
+	mock.lockDeleteIpsProfile.Lock()
+	mock.calls.DeleteIpsProfile = append(mock.calls.DeleteIpsProfile, callInfo)
+	mock.lockDeleteIpsProfile.Unlock()
+	return mock.DeleteIpsProfileFunc(ctx, uuid)
+}
+
+// DeleteIpsProfileCalls gets all the calls that were made to DeleteIpsProfile.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteIpsProfileCalls())
+func (mock *ClientInterfaceMock) DeleteIpsProfileCalls() []struct {
+	Ctx  context.Context
+	UUID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		UUID string
+	}
+	mock.lockDeleteIpsProfile.RLock()
+	calls = mock.calls.DeleteIpsProfile
+	mock.lockDeleteIpsProfile.RUnlock()
+	return calls
+}
+
+// DeleteIpsRuleFeed calls DeleteIpsRuleFeedFunc.
+func (mock *ClientInterfaceMock) DeleteIpsRuleFeed(ctx context.Context, uuid string) error {
+	if mock.DeleteIpsRuleFeedFunc == nil {
+		panic("ClientInterfaceMock.DeleteIpsRuleFeedFunc: method is nil but ClientInterface.DeleteIpsRuleFeed was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		UUID string
+	}{
+		Ctx:  ctx,
+		UUID: uuid,
+	}
+	mock.lockDeleteIpsRuleFeed.Lock()
+	mock.calls.DeleteIpsRuleFeed = append(mock.calls.DeleteIpsRuleFeed, callInfo)
+	mock.lockDeleteIpsRuleFeed.Unlock()
+	return mock.DeleteIpsRuleFeedFunc(ctx, uuid)
+}
+
+// DeleteIpsRuleFeedCalls gets all the calls that were made to DeleteIpsRuleFeed.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteIpsRuleFeedCalls())
+func (mock *ClientInterfaceMock) DeleteIpsRuleFeedCalls() []struct {
+	Ctx  context.Context
+	UUID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		UUID string
+	}
+	mock.lockDeleteIpsRuleFeed.RLock()
+	calls = mock.calls.DeleteIpsRuleFeed
+	mock.lockDeleteIpsRuleFeed.RUnlock()
+	return calls
+}
+
+// DeletePublicSubnetFilteringGatewayContext calls DeletePublicSubnetFilteringGatewayContextFunc.
+func (mock *ClientInterfaceMock) DeletePublicSubnetFilteringGatewayContext(ctx context.Context, gateway *Gateway) error {
+	if mock.DeletePublicSubnetFilteringGatewayContextFunc == nil {
+		panic("ClientInterfaceMock.DeletePublicSubnetFilteringGatewayContextFunc: method is nil but ClientInterface.DeletePublicSubnetFilteringGatewayContext was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}{
+		Ctx:     ctx,
+		Gateway: gateway,
+	}
+	mock.lockDeletePublicSubnetFilteringGatewayContext.Lock()
+	mock.calls.DeletePublicSubnetFilteringGatewayContext = append(mock.calls.DeletePublicSubnetFilteringGatewayContext, callInfo)
+	mock.lockDeletePublicSubnetFilteringGatewayContext.Unlock()
+	return mock.DeletePublicSubnetFilteringGatewayContextFunc(ctx, gateway)
+}
+
+// DeletePublicSubnetFilteringGatewayContextCalls gets all the calls that were made to DeletePublicSubnetFilteringGatewayContext.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeletePublicSubnetFilteringGatewayContextCalls())
+func (mock *ClientInterfaceMock) DeletePublicSubnetFilteringGatewayContextCalls() []struct {
+	Ctx     context.Context
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}
+	mock.lockDeletePublicSubnetFilteringGatewayContext.RLock()
+	calls = mock.calls.DeletePublicSubnetFilteringGatewayContext
+	mock.lockDeletePublicSubnetFilteringGatewayContext.RUnlock()
+	return calls
+}
+
+// DeleteSegmentationSecurityDomain calls DeleteSegmentationSecurityDomainFunc.
+func (mock *ClientInterfaceMock) DeleteSegmentationSecurityDomain(domain *SegmentationSecurityDomain) error {
+	if mock.DeleteSegmentationSecurityDomainFunc == nil {
+		panic("ClientInterfaceMock.DeleteSegmentationSecurityDomainFunc: method is nil but ClientInterface.DeleteSegmentationSecurityDomain was just called")
+	}
+	callInfo := struct {
+		Domain *SegmentationSecurityDomain
+	}{
+		Domain: domain,
+	}
+	mock.lockDeleteSegmentationSecurityDomain.Lock()
+	mock.calls.DeleteSegmentationSecurityDomain = append(mock.calls.DeleteSegmentationSecurityDomain, callInfo)
+	mock.lockDeleteSegmentationSecurityDomain.Unlock()
+	return mock.DeleteSegmentationSecurityDomainFunc(domain)
+}
+
+// DeleteSegmentationSecurityDomainCalls gets all the calls that were made to DeleteSegmentationSecurityDomain.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteSegmentationSecurityDomainCalls())
+func (mock *ClientInterfaceMock) DeleteSegmentationSecurityDomainCalls() []struct {
+	Domain *SegmentationSecurityDomain
+} {
+	var calls []struct {
+		Domain *SegmentationSecurityDomain
+	}
+	mock.lockDeleteSegmentationSecurityDomain.RLock()
+	calls = mock.calls.DeleteSegmentationSecurityDomain
+	mock.lockDeleteSegmentationSecurityDomain.RUnlock()
+	return calls
+}
+
+// DeleteSegmentationSecurityDomainAssociation calls DeleteSegmentationSecurityDomainAssociationFunc.
+func (mock *ClientInterfaceMock) DeleteSegmentationSecurityDomainAssociation(association *SegmentationSecurityDomainAssociation) error {
+	if mock.DeleteSegmentationSecurityDomainAssociationFunc == nil {
+		panic("ClientInterfaceMock.DeleteSegmentationSecurityDomainAssociationFunc: method is nil but ClientInterface.DeleteSegmentationSecurityDomainAssociation was just called")
+	}
+	callInfo := struct {
+		Association *SegmentationSecurityDomainAssociation
+	}{
+		Association: association,
+	}
+	mock.lockDeleteSegmentationSecurityDomainAssociation.Lock()
+	mock.calls.DeleteSegmentationSecurityDomainAssociation = append(mock.calls.DeleteSegmentationSecurityDomainAssociation, callInfo)
+	mock.lockDeleteSegmentationSecurityDomainAssociation.Unlock()
+	return mock.DeleteSegmentationSecurityDomainAssociationFunc(association)
+}
+
+// DeleteSegmentationSecurityDomainAssociationCalls gets all the calls that were made to DeleteSegmentationSecurityDomainAssociation.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteSegmentationSecurityDomainAssociationCalls())
+func (mock *ClientInterfaceMock) DeleteSegmentationSecurityDomainAssociationCalls() []struct {
+	Association *SegmentationSecurityDomainAssociation
+} {
+	var calls []struct {
+		Association *SegmentationSecurityDomainAssociation
+	}
+	mock.lockDeleteSegmentationSecurityDomainAssociation.RLock()
+	calls = mock.calls.DeleteSegmentationSecurityDomainAssociation
+	mock.lockDeleteSegmentationSecurityDomainAssociation.RUnlock()
+	return calls
+}
+
+// DeleteSegmentationSecurityDomainConnectionPolicy calls DeleteSegmentationSecurityDomainConnectionPolicyFunc.
+func (mock *ClientInterfaceMock) DeleteSegmentationSecurityDomainConnectionPolicy(policy *SegmentationSecurityDomainConnectionPolicy) error {
+	if mock.DeleteSegmentationSecurityDomainConnectionPolicyFunc == nil {
+		panic("ClientInterfaceMock.DeleteSegmentationSecurityDomainConnectionPolicyFunc: method is nil but ClientInterface.DeleteSegmentationSecurityDomainConnectionPolicy was just called")
+	}
+	callInfo := struct {
+		Policy *SegmentationSecurityDomainConnectionPolicy
+	}{
+		Policy: policy,
+	}
+	mock.lockDeleteSegmentationSecurityDomainConnectionPolicy.Lock()
+	mock.calls.DeleteSegmentationSecurityDomainConnectionPolicy = append(mock.calls.DeleteSegmentationSecurityDomainConnectionPolicy, callInfo)
+	mock.lockDeleteSegmentationSecurityDomainConnectionPolicy.Unlock()
+	return mock.DeleteSegmentationSecurityDomainConnectionPolicyFunc(policy)
+}
+
+// DeleteSegmentationSecurityDomainConnectionPolicyCalls gets all the calls that were made to DeleteSegmentationSecurityDomainConnectionPolicy.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteSegmentationSecurityDomainConnectionPolicyCalls())
+func (mock *ClientInterfaceMock) DeleteSegmentationSecurityDomainConnectionPolicyCalls() []struct {
+	Policy *SegmentationSecurityDomainConnectionPolicy
+} {
+	var calls []struct {
+		Policy *SegmentationSecurityDomainConnectionPolicy
+	}
+	mock.lockDeleteSegmentationSecurityDomainConnectionPolicy.RLock()
+	calls = mock.calls.DeleteSegmentationSecurityDomainConnectionPolicy
+	mock.lockDeleteSegmentationSecurityDomainConnectionPolicy.RUnlock()
+	return calls
+}
+
+// DeleteSite2Cloud calls DeleteSite2CloudFunc.
+func (mock *ClientInterfaceMock) DeleteSite2Cloud(site2cloud *Site2Cloud) error {
+	if mock.DeleteSite2CloudFunc == nil {
+		panic("ClientInterfaceMock.DeleteSite2CloudFunc: method is nil but ClientInterface.DeleteSite2Cloud was just called")
+	}
+	callInfo := struct {
+		Site2cloud *Site2Cloud
+	}{
+		Site2cloud: site2cloud,
+	}
+	mock.lockDeleteSite2Cloud.Lock()
+	mock.calls.DeleteSite2Cloud = append(mock.calls.DeleteSite2Cloud, callInfo)
+	mock.lockDeleteSite2Cloud.Unlock()
+	return mock.DeleteSite2CloudFunc(site2cloud)
+}
+
+// DeleteSite2CloudCalls gets all the calls that were made to DeleteSite2Cloud.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteSite2CloudCalls())
+func (mock *ClientInterfaceMock) DeleteSite2CloudCalls() []struct {
+	Site2cloud *Site2Cloud
+} {
+	var calls []struct {
+		Site2cloud *Site2Cloud
+	}
+	mock.lockDeleteSite2Cloud.RLock()
+	calls = mock.calls.DeleteSite2Cloud
+	mock.lockDeleteSite2Cloud.RUnlock()
+	return calls
+}
+
+// DeleteSite2CloudContext calls DeleteSite2CloudContextFunc.
+func (mock *ClientInterfaceMock) DeleteSite2CloudContext(ctx context.Context, site2cloud *Site2Cloud) error {
+	if mock.DeleteSite2CloudContextFunc == nil {
+		panic("ClientInterfaceMock.DeleteSite2CloudContextFunc: method is nil but ClientInterface.DeleteSite2CloudContext was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Site2cloud *Site2Cloud
+	}{
+		Ctx:        ctx,
+		Site2cloud: site2cloud,
+	}
+	mock.lockDeleteSite2CloudContext.Lock()
+	mock.calls.DeleteSite2CloudContext = append(mock.calls.DeleteSite2CloudContext, callInfo)
+	mock.lockDeleteSite2CloudContext.Unlock()
+	return mock.DeleteSite2CloudContextFunc(ctx, site2cloud)
+}
+
+// DeleteSite2CloudContextCalls gets all the calls that were made to DeleteSite2CloudContext.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteSite2CloudContextCalls())
+func (mock *ClientInterfaceMock) DeleteSite2CloudContextCalls() []struct {
+	Ctx        context.Context
+	Site2cloud *Site2Cloud
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Site2cloud *Site2Cloud
+	}
+	mock.lockDeleteSite2CloudContext.RLock()
+	calls = mock.calls.DeleteSite2CloudContext
+	mock.lockDeleteSite2CloudContext.RUnlock()
+	return calls
+}
+
+// DeleteSmartGroup calls DeleteSmartGroupFunc.
+func (mock *ClientInterfaceMock) DeleteSmartGroup(ctx context.Context, uuid string) error {
+	if mock.DeleteSmartGroupFunc == nil {
+		panic("ClientInterfaceMock.DeleteSmartGroupFunc: method is nil but ClientInterface.DeleteSmartGroup was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		UUID string
+	}{
+		Ctx:  ctx,
+		UUID: uuid,
+	}
+	mock.lockDeleteSmartGroup.Lock()
+	mock.calls.DeleteSmartGroup = append(mock.calls.DeleteSmartGroup, callInfo)
+	mock.lockDeleteSmartGroup.Unlock()
+	return mock.DeleteSmartGroupFunc(ctx, uuid)
+}
+
+// DeleteSmartGroupCalls gets all the calls that were made to DeleteSmartGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteSmartGroupCalls())
+func (mock *ClientInterfaceMock) DeleteSmartGroupCalls() []struct {
+	Ctx  context.Context
+	UUID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		UUID string
+	}
+	mock.lockDeleteSmartGroup.RLock()
+	calls = mock.calls.DeleteSmartGroup
+	mock.lockDeleteSmartGroup.RUnlock()
+	return calls
+}
+
+// DeleteSpokeGatewaySubnetGroup calls DeleteSpokeGatewaySubnetGroupFunc.
+func (mock *ClientInterfaceMock) DeleteSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error {
+	if mock.DeleteSpokeGatewaySubnetGroupFunc == nil {
+		panic("ClientInterfaceMock.DeleteSpokeGatewaySubnetGroupFunc: method is nil but ClientInterface.DeleteSpokeGatewaySubnetGroup was just called")
+	}
+	callInfo := struct {
+		Ctx                     context.Context
+		SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+	}{
+		Ctx:                     ctx,
+		SpokeGatewaySubnetGroup: spokeGatewaySubnetGroup,
+	}
+	mock.lockDeleteSpokeGatewaySubnetGroup.Lock()
+	mock.calls.DeleteSpokeGatewaySubnetGroup = append(mock.calls.DeleteSpokeGatewaySubnetGroup, callInfo)
+	mock.lockDeleteSpokeGatewaySubnetGroup.Unlock()
+	return mock.DeleteSpokeGatewaySubnetGroupFunc(ctx, spokeGatewaySubnetGroup)
+}
+
+// DeleteSpokeGatewaySubnetGroupCalls gets all the calls that were made to DeleteSpokeGatewaySubnetGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteSpokeGatewaySubnetGroupCalls())
+func (mock *ClientInterfaceMock) DeleteSpokeGatewaySubnetGroupCalls() []struct {
+	Ctx                     context.Context
+	SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+} {
+	var calls []struct {
+		Ctx                     context.Context
+		SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+	}
+	mock.lockDeleteSpokeGatewaySubnetGroup.RLock()
+	calls = mock.calls.DeleteSpokeGatewaySubnetGroup
+	mock.lockDeleteSpokeGatewaySubnetGroup.RUnlock()
+	return calls
+}
+
+// DeleteSpokeTransitAttachment calls DeleteSpokeTransitAttachmentFunc.
+func (mock *ClientInterfaceMock) DeleteSpokeTransitAttachment(spokeTransitAttachment *SpokeTransitAttachment) error {
+	if mock.DeleteSpokeTransitAttachmentFunc == nil {
+		panic("ClientInterfaceMock.DeleteSpokeTransitAttachmentFunc: method is nil but ClientInterface.DeleteSpokeTransitAttachment was just called")
+	}
+	callInfo := struct {
+		SpokeTransitAttachment *SpokeTransitAttachment
+	}{
+		SpokeTransitAttachment: spokeTransitAttachment,
+	}
+	mock.lockDeleteSpokeTransitAttachment.Lock()
+	mock.calls.DeleteSpokeTransitAttachment = append(mock.calls.DeleteSpokeTransitAttachment, callInfo)
+	mock.lockDeleteSpokeTransitAttachment.Unlock()
+	return mock.DeleteSpokeTransitAttachmentFunc(spokeTransitAttachment)
+}
+
+// DeleteSpokeTransitAttachmentCalls gets all the calls that were made to DeleteSpokeTransitAttachment.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteSpokeTransitAttachmentCalls())
+func (mock *ClientInterfaceMock) DeleteSpokeTransitAttachmentCalls() []struct {
+	SpokeTransitAttachment *SpokeTransitAttachment
+} {
+	var calls []struct {
+		SpokeTransitAttachment *SpokeTransitAttachment
+	}
+	mock.lockDeleteSpokeTransitAttachment.RLock()
+	calls = mock.calls.DeleteSpokeTransitAttachment
+	mock.lockDeleteSpokeTransitAttachment.RUnlock()
+	return calls
+}
+
+// DeleteTLSProfile calls DeleteTLSProfileFunc.
+func (mock *ClientInterfaceMock) DeleteTLSProfile(ctx context.Context, uuid string) error {
+	if mock.DeleteTLSProfileFunc == nil {
+		panic("ClientInterfaceMock.DeleteTLSProfileFunc: method is nil but ClientInterface.DeleteTLSProfile was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		UUID string
+	}{
+		Ctx:  ctx,
+		UUID: uuid,
+	}
+	mock.lockDeleteTLSProfile.Lock()
+	mock.calls.DeleteTLSProfile = append(mock.calls.DeleteTLSProfile, callInfo)
+	mock.lockDeleteTLSProfile.Unlock()
+	return mock.DeleteTLSProfileFunc(ctx, uuid)
+}
+
+// DeleteTLSProfileCalls gets all the calls that were made to DeleteTLSProfile.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteTLSProfileCalls())
+func (mock *ClientInterfaceMock) DeleteTLSProfileCalls() []struct {
+	Ctx  context.Context
+	UUID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		UUID string
+	}
+	mock.lockDeleteTLSProfile.RLock()
+	calls = mock.calls.DeleteTLSProfile
+	mock.lockDeleteTLSProfile.RUnlock()
+	return calls
+}
+
+// DeleteWebGroup calls DeleteWebGroupFunc.
+func (mock *ClientInterfaceMock) DeleteWebGroup(ctx context.Context, uuid string) error {
+	if mock.DeleteWebGroupFunc == nil {
+		panic("ClientInterfaceMock.DeleteWebGroupFunc: method is nil but ClientInterface.DeleteWebGroup was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		UUID string
+	}{
+		Ctx:  ctx,
+		UUID: uuid,
+	}
+	mock.lockDeleteWebGroup.Lock()
+	mock.calls.DeleteWebGroup = append(mock.calls.DeleteWebGroup, callInfo)
+	mock.lockDeleteWebGroup.Unlock()
+	return mock.DeleteWebGroupFunc(ctx, uuid)
+}
+
+// DeleteWebGroupCalls gets all the calls that were made to DeleteWebGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.DeleteWebGroupCalls())
+func (mock *ClientInterfaceMock) DeleteWebGroupCalls() []struct {
+	Ctx  context.Context
+	UUID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		UUID string
+	}
+	mock.lockDeleteWebGroup.RLock()
+	calls = mock.calls.DeleteWebGroup
+	mock.lockDeleteWebGroup.RUnlock()
+	return calls
+}
+
+// DetachTransitGWForHybrid calls DetachTransitGWForHybridFunc.
+func (mock *ClientInterfaceMock) DetachTransitGWForHybrid(gateway *TransitVpc) error {
+	if mock.DetachTransitGWForHybridFunc == nil {
+		panic("ClientInterfaceMock.DetachTransitGWForHybridFunc: method is nil but ClientInterface.DetachTransitGWForHybrid was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDetachTransitGWForHybrid.Lock()
+	mock.calls.DetachTransitGWForHybrid = append(mock.calls.DetachTransitGWForHybrid, callInfo)
+	mock.lockDetachTransitGWForHybrid.Unlock()
+	return mock.DetachTransitGWForHybridFunc(gateway)
+}
+
+// DetachTransitGWForHybridCalls gets all the calls that were made to DetachTransitGWForHybrid.
+// Check the length with:
+//
+//	len(mockedClientInterface.DetachTransitGWForHybridCalls())
+func (mock *ClientInterfaceMock) DetachTransitGWForHybridCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockDetachTransitGWForHybrid.RLock()
+	calls = mock.calls.DetachTransitGWForHybrid
+	mock.lockDetachTransitGWForHybrid.RUnlock()
+	return calls
+}
+
+// DisableActiveStandby calls DisableActiveStandbyFunc.
+func (mock *ClientInterfaceMock) DisableActiveStandby(transitGateway *TransitVpc) error {
+	if mock.DisableActiveStandbyFunc == nil {
+		panic("ClientInterfaceMock.DisableActiveStandbyFunc: method is nil but ClientInterface.DisableActiveStandby was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockDisableActiveStandby.Lock()
+	mock.calls.DisableActiveStandby = append(mock.calls.DisableActiveStandby, callInfo)
+	mock.lockDisableActiveStandby.Unlock()
+	return mock.DisableActiveStandbyFunc(transitGateway)
+}
+
+// DisableActiveStandbyCalls gets all the calls that were made to DisableActiveStandby.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableActiveStandbyCalls())
+func (mock *ClientInterfaceMock) DisableActiveStandbyCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockDisableActiveStandby.RLock()
+	calls = mock.calls.DisableActiveStandby
+	mock.lockDisableActiveStandby.RUnlock()
+	return calls
+}
+
+// DisableActiveStandbyGatewayGroup calls DisableActiveStandbyGatewayGroupFunc.
+func (mock *ClientInterfaceMock) DisableActiveStandbyGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableActiveStandbyGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.DisableActiveStandbyGatewayGroupFunc: method is nil but ClientInterface.DisableActiveStandbyGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableActiveStandbyGatewayGroup.Lock()
+	mock.calls.DisableActiveStandbyGatewayGroup = append(mock.calls.DisableActiveStandbyGatewayGroup, callInfo)
+	mock.lockDisableActiveStandbyGatewayGroup.Unlock()
+	return mock.DisableActiveStandbyGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableActiveStandbyGatewayGroupCalls gets all the calls that were made to DisableActiveStandbyGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableActiveStandbyGatewayGroupCalls())
+func (mock *ClientInterfaceMock) DisableActiveStandbyGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableActiveStandbyGatewayGroup.RLock()
+	calls = mock.calls.DisableActiveStandbyGatewayGroup
+	mock.lockDisableActiveStandbyGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableActiveStandbySpoke calls DisableActiveStandbySpokeFunc.
+func (mock *ClientInterfaceMock) DisableActiveStandbySpoke(spokeGateway *SpokeVpc) error {
+	if mock.DisableActiveStandbySpokeFunc == nil {
+		panic("ClientInterfaceMock.DisableActiveStandbySpokeFunc: method is nil but ClientInterface.DisableActiveStandbySpoke was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+	}{
+		SpokeGateway: spokeGateway,
+	}
+	mock.lockDisableActiveStandbySpoke.Lock()
+	mock.calls.DisableActiveStandbySpoke = append(mock.calls.DisableActiveStandbySpoke, callInfo)
+	mock.lockDisableActiveStandbySpoke.Unlock()
+	return mock.DisableActiveStandbySpokeFunc(spokeGateway)
+}
+
+// DisableActiveStandbySpokeCalls gets all the calls that were made to DisableActiveStandbySpoke.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableActiveStandbySpokeCalls())
+func (mock *ClientInterfaceMock) DisableActiveStandbySpokeCalls() []struct {
+	SpokeGateway *SpokeVpc
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+	}
+	mock.lockDisableActiveStandbySpoke.RLock()
+	calls = mock.calls.DisableActiveStandbySpoke
+	mock.lockDisableActiveStandbySpoke.RUnlock()
+	return calls
+}
+
+// DisableAdvertiseTransitCidr calls DisableAdvertiseTransitCidrFunc.
+func (mock *ClientInterfaceMock) DisableAdvertiseTransitCidr(transitGw *TransitVpc) error {
+	if mock.DisableAdvertiseTransitCidrFunc == nil {
+		panic("ClientInterfaceMock.DisableAdvertiseTransitCidrFunc: method is nil but ClientInterface.DisableAdvertiseTransitCidr was just called")
+	}
+	callInfo := struct {
+		TransitGw *TransitVpc
+	}{
+		TransitGw: transitGw,
+	}
+	mock.lockDisableAdvertiseTransitCidr.Lock()
+	mock.calls.DisableAdvertiseTransitCidr = append(mock.calls.DisableAdvertiseTransitCidr, callInfo)
+	mock.lockDisableAdvertiseTransitCidr.Unlock()
+	return mock.DisableAdvertiseTransitCidrFunc(transitGw)
+}
+
+// DisableAdvertiseTransitCidrCalls gets all the calls that were made to DisableAdvertiseTransitCidr.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableAdvertiseTransitCidrCalls())
+func (mock *ClientInterfaceMock) DisableAdvertiseTransitCidrCalls() []struct {
+	TransitGw *TransitVpc
+} {
+	var calls []struct {
+		TransitGw *TransitVpc
+	}
+	mock.lockDisableAdvertiseTransitCidr.RLock()
+	calls = mock.calls.DisableAdvertiseTransitCidr
+	mock.lockDisableAdvertiseTransitCidr.RUnlock()
+	return calls
+}
+
+// DisableAdvertiseTransitCidrGatewayGroup calls DisableAdvertiseTransitCidrGatewayGroupFunc.
+func (mock *ClientInterfaceMock) DisableAdvertiseTransitCidrGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableAdvertiseTransitCidrGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.DisableAdvertiseTransitCidrGatewayGroupFunc: method is nil but ClientInterface.DisableAdvertiseTransitCidrGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableAdvertiseTransitCidrGatewayGroup.Lock()
+	mock.calls.DisableAdvertiseTransitCidrGatewayGroup = append(mock.calls.DisableAdvertiseTransitCidrGatewayGroup, callInfo)
+	mock.lockDisableAdvertiseTransitCidrGatewayGroup.Unlock()
+	return mock.DisableAdvertiseTransitCidrGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableAdvertiseTransitCidrGatewayGroupCalls gets all the calls that were made to DisableAdvertiseTransitCidrGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableAdvertiseTransitCidrGatewayGroupCalls())
+func (mock *ClientInterfaceMock) DisableAdvertiseTransitCidrGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableAdvertiseTransitCidrGatewayGroup.RLock()
+	calls = mock.calls.DisableAdvertiseTransitCidrGatewayGroup
+	mock.lockDisableAdvertiseTransitCidrGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableAutoAdvertiseS2CCidrs calls DisableAutoAdvertiseS2CCidrsFunc.
+func (mock *ClientInterfaceMock) DisableAutoAdvertiseS2CCidrs(gateway *Gateway) error {
+	if mock.DisableAutoAdvertiseS2CCidrsFunc == nil {
+		panic("ClientInterfaceMock.DisableAutoAdvertiseS2CCidrsFunc: method is nil but ClientInterface.DisableAutoAdvertiseS2CCidrs was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableAutoAdvertiseS2CCidrs.Lock()
+	mock.calls.DisableAutoAdvertiseS2CCidrs = append(mock.calls.DisableAutoAdvertiseS2CCidrs, callInfo)
+	mock.lockDisableAutoAdvertiseS2CCidrs.Unlock()
+	return mock.DisableAutoAdvertiseS2CCidrsFunc(gateway)
+}
+
+// DisableAutoAdvertiseS2CCidrsCalls gets all the calls that were made to DisableAutoAdvertiseS2CCidrs.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableAutoAdvertiseS2CCidrsCalls())
+func (mock *ClientInterfaceMock) DisableAutoAdvertiseS2CCidrsCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableAutoAdvertiseS2CCidrs.RLock()
+	calls = mock.calls.DisableAutoAdvertiseS2CCidrs
+	mock.lockDisableAutoAdvertiseS2CCidrs.RUnlock()
+	return calls
+}
+
+// DisableAutoAdvertiseS2CCidrsGatewayGroup calls DisableAutoAdvertiseS2CCidrsGatewayGroupFunc.
+func (mock *ClientInterfaceMock) DisableAutoAdvertiseS2CCidrsGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableAutoAdvertiseS2CCidrsGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.DisableAutoAdvertiseS2CCidrsGatewayGroupFunc: method is nil but ClientInterface.DisableAutoAdvertiseS2CCidrsGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableAutoAdvertiseS2CCidrsGatewayGroup.Lock()
+	mock.calls.DisableAutoAdvertiseS2CCidrsGatewayGroup = append(mock.calls.DisableAutoAdvertiseS2CCidrsGatewayGroup, callInfo)
+	mock.lockDisableAutoAdvertiseS2CCidrsGatewayGroup.Unlock()
+	return mock.DisableAutoAdvertiseS2CCidrsGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableAutoAdvertiseS2CCidrsGatewayGroupCalls gets all the calls that were made to DisableAutoAdvertiseS2CCidrsGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableAutoAdvertiseS2CCidrsGatewayGroupCalls())
+func (mock *ClientInterfaceMock) DisableAutoAdvertiseS2CCidrsGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableAutoAdvertiseS2CCidrsGatewayGroup.RLock()
+	calls = mock.calls.DisableAutoAdvertiseS2CCidrsGatewayGroup
+	mock.lockDisableAutoAdvertiseS2CCidrsGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableConnectedTransit calls DisableConnectedTransitFunc.
+func (mock *ClientInterfaceMock) DisableConnectedTransit(gateway *TransitVpc) error {
+	if mock.DisableConnectedTransitFunc == nil {
+		panic("ClientInterfaceMock.DisableConnectedTransitFunc: method is nil but ClientInterface.DisableConnectedTransit was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableConnectedTransit.Lock()
+	mock.calls.DisableConnectedTransit = append(mock.calls.DisableConnectedTransit, callInfo)
+	mock.lockDisableConnectedTransit.Unlock()
+	return mock.DisableConnectedTransitFunc(gateway)
+}
+
+// DisableConnectedTransitCalls gets all the calls that were made to DisableConnectedTransit.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableConnectedTransitCalls())
+func (mock *ClientInterfaceMock) DisableConnectedTransitCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockDisableConnectedTransit.RLock()
+	calls = mock.calls.DisableConnectedTransit
+	mock.lockDisableConnectedTransit.RUnlock()
+	return calls
+}
+
+// DisableConnectedTransitGatewayGroup calls DisableConnectedTransitGatewayGroupFunc.
+func (mock *ClientInterfaceMock) DisableConnectedTransitGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableConnectedTransitGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.DisableConnectedTransitGatewayGroupFunc: method is nil but ClientInterface.DisableConnectedTransitGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableConnectedTransitGatewayGroup.Lock()
+	mock.calls.DisableConnectedTransitGatewayGroup = append(mock.calls.DisableConnectedTransitGatewayGroup, callInfo)
+	mock.lockDisableConnectedTransitGatewayGroup.Unlock()
+	return mock.DisableConnectedTransitGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableConnectedTransitGatewayGroupCalls gets all the calls that were made to DisableConnectedTransitGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableConnectedTransitGatewayGroupCalls())
+func (mock *ClientInterfaceMock) DisableConnectedTransitGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableConnectedTransitGatewayGroup.RLock()
+	calls = mock.calls.DisableConnectedTransitGatewayGroup
+	mock.lockDisableConnectedTransitGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableCustomSNat calls DisableCustomSNatFunc.
+func (mock *ClientInterfaceMock) DisableCustomSNat(gateway *Gateway) error {
+	if mock.DisableCustomSNatFunc == nil {
+		panic("ClientInterfaceMock.DisableCustomSNatFunc: method is nil but ClientInterface.DisableCustomSNat was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableCustomSNat.Lock()
+	mock.calls.DisableCustomSNat = append(mock.calls.DisableCustomSNat, callInfo)
+	mock.lockDisableCustomSNat.Unlock()
+	return mock.DisableCustomSNatFunc(gateway)
+}
+
+// DisableCustomSNatCalls gets all the calls that were made to DisableCustomSNat.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableCustomSNatCalls())
+func (mock *ClientInterfaceMock) DisableCustomSNatCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableCustomSNat.RLock()
+	calls = mock.calls.DisableCustomSNat
+	mock.lockDisableCustomSNat.RUnlock()
+	return calls
+}
+
+// DisableDeadPeerDetection calls DisableDeadPeerDetectionFunc.
+func (mock *ClientInterfaceMock) DisableDeadPeerDetection(site2cloud *Site2Cloud) error {
+	if mock.DisableDeadPeerDetectionFunc == nil {
+		panic("ClientInterfaceMock.DisableDeadPeerDetectionFunc: method is nil but ClientInterface.DisableDeadPeerDetection was just called")
+	}
+	callInfo := struct {
+		Site2cloud *Site2Cloud
+	}{
+		Site2cloud: site2cloud,
+	}
+	mock.lockDisableDeadPeerDetection.Lock()
+	mock.calls.DisableDeadPeerDetection = append(mock.calls.DisableDeadPeerDetection, callInfo)
+	mock.lockDisableDeadPeerDetection.Unlock()
+	return mock.DisableDeadPeerDetectionFunc(site2cloud)
+}
+
+// DisableDeadPeerDetectionCalls gets all the calls that were made to DisableDeadPeerDetection.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableDeadPeerDetectionCalls())
+func (mock *ClientInterfaceMock) DisableDeadPeerDetectionCalls() []struct {
+	Site2cloud *Site2Cloud
+} {
+	var calls []struct {
+		Site2cloud *Site2Cloud
+	}
+	mock.lockDisableDeadPeerDetection.RLock()
+	calls = mock.calls.DisableDeadPeerDetection
+	mock.lockDisableDeadPeerDetection.RUnlock()
+	return calls
+}
+
+// DisableDeadPeerDetectionContext calls DisableDeadPeerDetectionContextFunc.
+func (mock *ClientInterfaceMock) DisableDeadPeerDetectionContext(ctx context.Context, site2cloud *Site2Cloud) error {
+	if mock.DisableDeadPeerDetectionContextFunc == nil {
+		panic("ClientInterfaceMock.DisableDeadPeerDetectionContextFunc: method is nil but ClientInterface.DisableDeadPeerDetectionContext was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Site2cloud *Site2Cloud
+	}{
+		Ctx:        ctx,
+		Site2cloud: site2cloud,
+	}
+	mock.lockDisableDeadPeerDetectionContext.Lock()
+	mock.calls.DisableDeadPeerDetectionContext = append(mock.calls.DisableDeadPeerDetectionContext, callInfo)
+	mock.lockDisableDeadPeerDetectionContext.Unlock()
+	return mock.DisableDeadPeerDetectionContextFunc(ctx, site2cloud)
+}
+
+// DisableDeadPeerDetectionContextCalls gets all the calls that were made to DisableDeadPeerDetectionContext.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableDeadPeerDetectionContextCalls())
+func (mock *ClientInterfaceMock) DisableDeadPeerDetectionContextCalls() []struct {
+	Ctx        context.Context
+	Site2cloud *Site2Cloud
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Site2cloud *Site2Cloud
+	}
+	mock.lockDisableDeadPeerDetectionContext.RLock()
+	calls = mock.calls.DisableDeadPeerDetectionContext
+	mock.lockDisableDeadPeerDetectionContext.RUnlock()
+	return calls
+}
+
+// DisableDistributedFirewalling calls DisableDistributedFirewallingFunc.
+func (mock *ClientInterfaceMock) DisableDistributedFirewalling(ctx context.Context) error {
+	if mock.DisableDistributedFirewallingFunc == nil {
+		panic("ClientInterfaceMock.DisableDistributedFirewallingFunc: method is nil but ClientInterface.DisableDistributedFirewalling was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockDisableDistributedFirewalling.Lock()
+	mock.calls.DisableDistributedFirewalling = append(mock.calls.DisableDistributedFirewalling, callInfo)
+	mock.lockDisableDistributedFirewalling.Unlock()
+	return mock.DisableDistributedFirewallingFunc(ctx)
+}
+
+// DisableDistributedFirewallingCalls gets all the calls that were made to DisableDistributedFirewalling.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableDistributedFirewallingCalls())
+func (mock *ClientInterfaceMock) DisableDistributedFirewallingCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockDisableDistributedFirewalling.RLock()
+	calls = mock.calls.DisableDistributedFirewalling
+	mock.lockDisableDistributedFirewalling.RUnlock()
+	return calls
+}
+
+// DisableEdgeSpokeTransitiveRouting calls DisableEdgeSpokeTransitiveRoutingFunc.
+func (mock *ClientInterfaceMock) DisableEdgeSpokeTransitiveRouting(ctx context.Context, name string) error {
+	if mock.DisableEdgeSpokeTransitiveRoutingFunc == nil {
+		panic("ClientInterfaceMock.DisableEdgeSpokeTransitiveRoutingFunc: method is nil but ClientInterface.DisableEdgeSpokeTransitiveRouting was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+	}{
+		Ctx:  ctx,
+		Name: name,
+	}
+	mock.lockDisableEdgeSpokeTransitiveRouting.Lock()
+	mock.calls.DisableEdgeSpokeTransitiveRouting = append(mock.calls.DisableEdgeSpokeTransitiveRouting, callInfo)
+	mock.lockDisableEdgeSpokeTransitiveRouting.Unlock()
+	return mock.DisableEdgeSpokeTransitiveRoutingFunc(ctx, name)
+}
+
+// DisableEdgeSpokeTransitiveRoutingCalls gets all the calls that were made to DisableEdgeSpokeTransitiveRouting.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableEdgeSpokeTransitiveRoutingCalls())
+func (mock *ClientInterfaceMock) DisableEdgeSpokeTransitiveRoutingCalls() []struct {
+	Ctx  context.Context
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+	}
+	mock.lockDisableEdgeSpokeTransitiveRouting.RLock()
+	calls = mock.calls.DisableEdgeSpokeTransitiveRouting
+	mock.lockDisableEdgeSpokeTransitiveRouting.RUnlock()
+	return calls
+}
+
+// DisableEgressTransitFirenet calls DisableEgressTransitFirenetFunc.
+func (mock *ClientInterfaceMock) DisableEgressTransitFirenet(transitGateway *TransitVpc) error {
+	if mock.DisableEgressTransitFirenetFunc == nil {
+		panic("ClientInterfaceMock.DisableEgressTransitFirenetFunc: method is nil but ClientInterface.DisableEgressTransitFirenet was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockDisableEgressTransitFirenet.Lock()
+	mock.calls.DisableEgressTransitFirenet = append(mock.calls.DisableEgressTransitFirenet, callInfo)
+	mock.lockDisableEgressTransitFirenet.Unlock()
+	return mock.DisableEgressTransitFirenetFunc(transitGateway)
+}
+
+// DisableEgressTransitFirenetCalls gets all the calls that were made to DisableEgressTransitFirenet.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableEgressTransitFirenetCalls())
+func (mock *ClientInterfaceMock) DisableEgressTransitFirenetCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockDisableEgressTransitFirenet.RLock()
+	calls = mock.calls.DisableEgressTransitFirenet
+	mock.lockDisableEgressTransitFirenet.RUnlock()
+	return calls
+}
+
+// DisableFireNetGatewayGroup calls DisableFireNetGatewayGroupFunc.
+func (mock *ClientInterfaceMock) DisableFireNetGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableFireNetGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.DisableFireNetGatewayGroupFunc: method is nil but ClientInterface.DisableFireNetGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableFireNetGatewayGroup.Lock()
+	mock.calls.DisableFireNetGatewayGroup = append(mock.calls.DisableFireNetGatewayGroup, callInfo)
+	mock.lockDisableFireNetGatewayGroup.Unlock()
+	return mock.DisableFireNetGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableFireNetGatewayGroupCalls gets all the calls that were made to DisableFireNetGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableFireNetGatewayGroupCalls())
+func (mock *ClientInterfaceMock) DisableFireNetGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableFireNetGatewayGroup.RLock()
+	calls = mock.calls.DisableFireNetGatewayGroup
+	mock.lockDisableFireNetGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableGatewayFireNetInterfaces calls DisableGatewayFireNetInterfacesFunc.
+func (mock *ClientInterfaceMock) DisableGatewayFireNetInterfaces(gateway *TransitVpc) error {
+	if mock.DisableGatewayFireNetInterfacesFunc == nil {
+		panic("ClientInterfaceMock.DisableGatewayFireNetInterfacesFunc: method is nil but ClientInterface.DisableGatewayFireNetInterfaces was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableGatewayFireNetInterfaces.Lock()
+	mock.calls.DisableGatewayFireNetInterfaces = append(mock.calls.DisableGatewayFireNetInterfaces, callInfo)
+	mock.lockDisableGatewayFireNetInterfaces.Unlock()
+	return mock.DisableGatewayFireNetInterfacesFunc(gateway)
+}
+
+// DisableGatewayFireNetInterfacesCalls gets all the calls that were made to DisableGatewayFireNetInterfaces.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableGatewayFireNetInterfacesCalls())
+func (mock *ClientInterfaceMock) DisableGatewayFireNetInterfacesCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockDisableGatewayFireNetInterfaces.RLock()
+	calls = mock.calls.DisableGatewayFireNetInterfaces
+	mock.lockDisableGatewayFireNetInterfaces.RUnlock()
+	return calls
+}
+
+// DisableGatewayGroupSNat calls DisableGatewayGroupSNatFunc.
+func (mock *ClientInterfaceMock) DisableGatewayGroupSNat(ctx context.Context, groupName string) error {
+	if mock.DisableGatewayGroupSNatFunc == nil {
+		panic("ClientInterfaceMock.DisableGatewayGroupSNatFunc: method is nil but ClientInterface.DisableGatewayGroupSNat was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableGatewayGroupSNat.Lock()
+	mock.calls.DisableGatewayGroupSNat = append(mock.calls.DisableGatewayGroupSNat, callInfo)
+	mock.lockDisableGatewayGroupSNat.Unlock()
+	return mock.DisableGatewayGroupSNatFunc(ctx, groupName)
+}
+
+// DisableGatewayGroupSNatCalls gets all the calls that were made to DisableGatewayGroupSNat.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableGatewayGroupSNatCalls())
+func (mock *ClientInterfaceMock) DisableGatewayGroupSNatCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableGatewayGroupSNat.RLock()
+	calls = mock.calls.DisableGatewayGroupSNat
+	mock.lockDisableGatewayGroupSNat.RUnlock()
+	return calls
+}
+
+// DisableGatewayGroupVpcDNSServer calls DisableGatewayGroupVpcDNSServerFunc.
+func (mock *ClientInterfaceMock) DisableGatewayGroupVpcDNSServer(ctx context.Context, groupName string) error {
+	if mock.DisableGatewayGroupVpcDNSServerFunc == nil {
+		panic("ClientInterfaceMock.DisableGatewayGroupVpcDNSServerFunc: method is nil but ClientInterface.DisableGatewayGroupVpcDNSServer was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableGatewayGroupVpcDNSServer.Lock()
+	mock.calls.DisableGatewayGroupVpcDNSServer = append(mock.calls.DisableGatewayGroupVpcDNSServer, callInfo)
+	mock.lockDisableGatewayGroupVpcDNSServer.Unlock()
+	return mock.DisableGatewayGroupVpcDNSServerFunc(ctx, groupName)
+}
+
+// DisableGatewayGroupVpcDNSServerCalls gets all the calls that were made to DisableGatewayGroupVpcDNSServer.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableGatewayGroupVpcDNSServerCalls())
+func (mock *ClientInterfaceMock) DisableGatewayGroupVpcDNSServerCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableGatewayGroupVpcDNSServer.RLock()
+	calls = mock.calls.DisableGatewayGroupVpcDNSServer
+	mock.lockDisableGatewayGroupVpcDNSServer.RUnlock()
+	return calls
+}
+
+// DisableGatewayLoadBalancerGatewayGroup calls DisableGatewayLoadBalancerGatewayGroupFunc.
+func (mock *ClientInterfaceMock) DisableGatewayLoadBalancerGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableGatewayLoadBalancerGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.DisableGatewayLoadBalancerGatewayGroupFunc: method is nil but ClientInterface.DisableGatewayLoadBalancerGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableGatewayLoadBalancerGatewayGroup.Lock()
+	mock.calls.DisableGatewayLoadBalancerGatewayGroup = append(mock.calls.DisableGatewayLoadBalancerGatewayGroup, callInfo)
+	mock.lockDisableGatewayLoadBalancerGatewayGroup.Unlock()
+	return mock.DisableGatewayLoadBalancerGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableGatewayLoadBalancerGatewayGroupCalls gets all the calls that were made to DisableGatewayLoadBalancerGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableGatewayLoadBalancerGatewayGroupCalls())
+func (mock *ClientInterfaceMock) DisableGatewayLoadBalancerGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableGatewayLoadBalancerGatewayGroup.RLock()
+	calls = mock.calls.DisableGatewayLoadBalancerGatewayGroup
+	mock.lockDisableGatewayLoadBalancerGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableGlobalVpc calls DisableGlobalVpcFunc.
+func (mock *ClientInterfaceMock) DisableGlobalVpc(gateway *Gateway) error {
+	if mock.DisableGlobalVpcFunc == nil {
+		panic("ClientInterfaceMock.DisableGlobalVpcFunc: method is nil but ClientInterface.DisableGlobalVpc was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableGlobalVpc.Lock()
+	mock.calls.DisableGlobalVpc = append(mock.calls.DisableGlobalVpc, callInfo)
+	mock.lockDisableGlobalVpc.Unlock()
+	return mock.DisableGlobalVpcFunc(gateway)
+}
+
+// DisableGlobalVpcCalls gets all the calls that were made to DisableGlobalVpc.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableGlobalVpcCalls())
+func (mock *ClientInterfaceMock) DisableGlobalVpcCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableGlobalVpc.RLock()
+	calls = mock.calls.DisableGlobalVpc
+	mock.lockDisableGlobalVpc.RUnlock()
+	return calls
+}
+
+// DisableGlobalVpcGatewayGroup calls DisableGlobalVpcGatewayGroupFunc.
+func (mock *ClientInterfaceMock) DisableGlobalVpcGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableGlobalVpcGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.DisableGlobalVpcGatewayGroupFunc: method is nil but ClientInterface.DisableGlobalVpcGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableGlobalVpcGatewayGroup.Lock()
+	mock.calls.DisableGlobalVpcGatewayGroup = append(mock.calls.DisableGlobalVpcGatewayGroup, callInfo)
+	mock.lockDisableGlobalVpcGatewayGroup.Unlock()
+	return mock.DisableGlobalVpcGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableGlobalVpcGatewayGroupCalls gets all the calls that were made to DisableGlobalVpcGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableGlobalVpcGatewayGroupCalls())
+func (mock *ClientInterfaceMock) DisableGlobalVpcGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableGlobalVpcGatewayGroup.RLock()
+	calls = mock.calls.DisableGlobalVpcGatewayGroup
+	mock.lockDisableGlobalVpcGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableGroGso calls DisableGroGsoFunc.
+func (mock *ClientInterfaceMock) DisableGroGso(gateway *Gateway) error {
+	if mock.DisableGroGsoFunc == nil {
+		panic("ClientInterfaceMock.DisableGroGsoFunc: method is nil but ClientInterface.DisableGroGso was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableGroGso.Lock()
+	mock.calls.DisableGroGso = append(mock.calls.DisableGroGso, callInfo)
+	mock.lockDisableGroGso.Unlock()
+	return mock.DisableGroGsoFunc(gateway)
+}
+
+// DisableGroGsoCalls gets all the calls that were made to DisableGroGso.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableGroGsoCalls())
+func (mock *ClientInterfaceMock) DisableGroGsoCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableGroGso.RLock()
+	calls = mock.calls.DisableGroGso
+	mock.lockDisableGroGso.RUnlock()
+	return calls
+}
+
+// DisableGroGsoGatewayGroup calls DisableGroGsoGatewayGroupFunc.
+func (mock *ClientInterfaceMock) DisableGroGsoGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableGroGsoGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.DisableGroGsoGatewayGroupFunc: method is nil but ClientInterface.DisableGroGsoGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableGroGsoGatewayGroup.Lock()
+	mock.calls.DisableGroGsoGatewayGroup = append(mock.calls.DisableGroGsoGatewayGroup, callInfo)
+	mock.lockDisableGroGsoGatewayGroup.Unlock()
+	return mock.DisableGroGsoGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableGroGsoGatewayGroupCalls gets all the calls that were made to DisableGroGsoGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableGroGsoGatewayGroupCalls())
+func (mock *ClientInterfaceMock) DisableGroGsoGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableGroGsoGatewayGroup.RLock()
+	calls = mock.calls.DisableGroGsoGatewayGroup
+	mock.lockDisableGroGsoGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableGuardDutyEnforcement calls DisableGuardDutyEnforcementFunc.
+func (mock *ClientInterfaceMock) DisableGuardDutyEnforcement(gateway *Gateway) error {
+	if mock.DisableGuardDutyEnforcementFunc == nil {
+		panic("ClientInterfaceMock.DisableGuardDutyEnforcementFunc: method is nil but ClientInterface.DisableGuardDutyEnforcement was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableGuardDutyEnforcement.Lock()
+	mock.calls.DisableGuardDutyEnforcement = append(mock.calls.DisableGuardDutyEnforcement, callInfo)
+	mock.lockDisableGuardDutyEnforcement.Unlock()
+	return mock.DisableGuardDutyEnforcementFunc(gateway)
+}
+
+// DisableGuardDutyEnforcementCalls gets all the calls that were made to DisableGuardDutyEnforcement.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableGuardDutyEnforcementCalls())
+func (mock *ClientInterfaceMock) DisableGuardDutyEnforcementCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableGuardDutyEnforcement.RLock()
+	calls = mock.calls.DisableGuardDutyEnforcement
+	mock.lockDisableGuardDutyEnforcement.RUnlock()
+	return calls
+}
+
+// DisableHybridConnectionGatewayGroup calls DisableHybridConnectionGatewayGroupFunc.
+func (mock *ClientInterfaceMock) DisableHybridConnectionGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableHybridConnectionGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.DisableHybridConnectionGatewayGroupFunc: method is nil but ClientInterface.DisableHybridConnectionGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableHybridConnectionGatewayGroup.Lock()
+	mock.calls.DisableHybridConnectionGatewayGroup = append(mock.calls.DisableHybridConnectionGatewayGroup, callInfo)
+	mock.lockDisableHybridConnectionGatewayGroup.Unlock()
+	return mock.DisableHybridConnectionGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableHybridConnectionGatewayGroupCalls gets all the calls that were made to DisableHybridConnectionGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableHybridConnectionGatewayGroupCalls())
+func (mock *ClientInterfaceMock) DisableHybridConnectionGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableHybridConnectionGatewayGroup.RLock()
+	calls = mock.calls.DisableHybridConnectionGatewayGroup
+	mock.lockDisableHybridConnectionGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableIPv6 calls DisableIPv6Func.
+func (mock *ClientInterfaceMock) DisableIPv6(gateway *Gateway) error {
+	if mock.DisableIPv6Func == nil {
+		panic("ClientInterfaceMock.DisableIPv6Func: method is nil but ClientInterface.DisableIPv6 was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableIPv6.Lock()
+	mock.calls.DisableIPv6 = append(mock.calls.DisableIPv6, callInfo)
+	mock.lockDisableIPv6.Unlock()
+	return mock.DisableIPv6Func(gateway)
+}
+
+// DisableIPv6Calls gets all the calls that were made to DisableIPv6.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableIPv6Calls())
+func (mock *ClientInterfaceMock) DisableIPv6Calls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableIPv6.RLock()
+	calls = mock.calls.DisableIPv6
+	mock.lockDisableIPv6.RUnlock()
+	return calls
+}
+
+// DisableIPv6GatewayGroup calls DisableIPv6GatewayGroupFunc.
+func (mock *ClientInterfaceMock) DisableIPv6GatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableIPv6GatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.DisableIPv6GatewayGroupFunc: method is nil but ClientInterface.DisableIPv6GatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableIPv6GatewayGroup.Lock()
+	mock.calls.DisableIPv6GatewayGroup = append(mock.calls.DisableIPv6GatewayGroup, callInfo)
+	mock.lockDisableIPv6GatewayGroup.Unlock()
+	return mock.DisableIPv6GatewayGroupFunc(ctx, groupName)
+}
+
+// DisableIPv6GatewayGroupCalls gets all the calls that were made to DisableIPv6GatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableIPv6GatewayGroupCalls())
+func (mock *ClientInterfaceMock) DisableIPv6GatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableIPv6GatewayGroup.RLock()
+	calls = mock.calls.DisableIPv6GatewayGroup
+	mock.lockDisableIPv6GatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableJumboFrame calls DisableJumboFrameFunc.
+func (mock *ClientInterfaceMock) DisableJumboFrame(gateway *Gateway) error {
+	if mock.DisableJumboFrameFunc == nil {
+		panic("ClientInterfaceMock.DisableJumboFrameFunc: method is nil but ClientInterface.DisableJumboFrame was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableJumboFrame.Lock()
+	mock.calls.DisableJumboFrame = append(mock.calls.DisableJumboFrame, callInfo)
+	mock.lockDisableJumboFrame.Unlock()
+	return mock.DisableJumboFrameFunc(gateway)
+}
+
+// DisableJumboFrameCalls gets all the calls that were made to DisableJumboFrame.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableJumboFrameCalls())
+func (mock *ClientInterfaceMock) DisableJumboFrameCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableJumboFrame.RLock()
+	calls = mock.calls.DisableJumboFrame
+	mock.lockDisableJumboFrame.RUnlock()
+	return calls
+}
+
+// DisableJumboFrameExternalDeviceConn calls DisableJumboFrameExternalDeviceConnFunc.
+func (mock *ClientInterfaceMock) DisableJumboFrameExternalDeviceConn(externalDeviceConn *ExternalDeviceConn) error {
+	if mock.DisableJumboFrameExternalDeviceConnFunc == nil {
+		panic("ClientInterfaceMock.DisableJumboFrameExternalDeviceConnFunc: method is nil but ClientInterface.DisableJumboFrameExternalDeviceConn was just called")
+	}
+	callInfo := struct {
+		ExternalDeviceConn *ExternalDeviceConn
+	}{
+		ExternalDeviceConn: externalDeviceConn,
+	}
+	mock.lockDisableJumboFrameExternalDeviceConn.Lock()
+	mock.calls.DisableJumboFrameExternalDeviceConn = append(mock.calls.DisableJumboFrameExternalDeviceConn, callInfo)
+	mock.lockDisableJumboFrameExternalDeviceConn.Unlock()
+	return mock.DisableJumboFrameExternalDeviceConnFunc(externalDeviceConn)
+}
+
+// DisableJumboFrameExternalDeviceConnCalls gets all the calls that were made to DisableJumboFrameExternalDeviceConn.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableJumboFrameExternalDeviceConnCalls())
+func (mock *ClientInterfaceMock) DisableJumboFrameExternalDeviceConnCalls() []struct {
+	ExternalDeviceConn *ExternalDeviceConn
+} {
+	var calls []struct {
+		ExternalDeviceConn *ExternalDeviceConn
+	}
+	mock.lockDisableJumboFrameExternalDeviceConn.RLock()
+	calls = mock.calls.DisableJumboFrameExternalDeviceConn
+	mock.lockDisableJumboFrameExternalDeviceConn.RUnlock()
+	return calls
+}
+
+// DisableJumboFrameGatewayGroup calls DisableJumboFrameGatewayGroupFunc.
+func (mock *ClientInterfaceMock) DisableJumboFrameGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableJumboFrameGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.DisableJumboFrameGatewayGroupFunc: method is nil but ClientInterface.DisableJumboFrameGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableJumboFrameGatewayGroup.Lock()
+	mock.calls.DisableJumboFrameGatewayGroup = append(mock.calls.DisableJumboFrameGatewayGroup, callInfo)
+	mock.lockDisableJumboFrameGatewayGroup.Unlock()
+	return mock.DisableJumboFrameGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableJumboFrameGatewayGroupCalls gets all the calls that were made to DisableJumboFrameGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableJumboFrameGatewayGroupCalls())
+func (mock *ClientInterfaceMock) DisableJumboFrameGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableJumboFrameGatewayGroup.RLock()
+	calls = mock.calls.DisableJumboFrameGatewayGroup
+	mock.lockDisableJumboFrameGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableMonitorGatewaySubnets calls DisableMonitorGatewaySubnetsFunc.
+func (mock *ClientInterfaceMock) DisableMonitorGatewaySubnets(gwName string) error {
+	if mock.DisableMonitorGatewaySubnetsFunc == nil {
+		panic("ClientInterfaceMock.DisableMonitorGatewaySubnetsFunc: method is nil but ClientInterface.DisableMonitorGatewaySubnets was just called")
+	}
+	callInfo := struct {
+		GwName string
+	}{
+		GwName: gwName,
+	}
+	mock.lockDisableMonitorGatewaySubnets.Lock()
+	mock.calls.DisableMonitorGatewaySubnets = append(mock.calls.DisableMonitorGatewaySubnets, callInfo)
+	mock.lockDisableMonitorGatewaySubnets.Unlock()
+	return mock.DisableMonitorGatewaySubnetsFunc(gwName)
+}
+
+// DisableMonitorGatewaySubnetsCalls gets all the calls that were made to DisableMonitorGatewaySubnets.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableMonitorGatewaySubnetsCalls())
+func (mock *ClientInterfaceMock) DisableMonitorGatewaySubnetsCalls() []struct {
+	GwName string
+} {
+	var calls []struct {
+		GwName string
+	}
+	mock.lockDisableMonitorGatewaySubnets.RLock()
+	calls = mock.calls.DisableMonitorGatewaySubnets
+	mock.lockDisableMonitorGatewaySubnets.RUnlock()
+	return calls
+}
+
+// DisableMultiTierTransitGatewayGroup calls DisableMultiTierTransitGatewayGroupFunc.
+func (mock *ClientInterfaceMock) DisableMultiTierTransitGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableMultiTierTransitGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.DisableMultiTierTransitGatewayGroupFunc: method is nil but ClientInterface.DisableMultiTierTransitGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableMultiTierTransitGatewayGroup.Lock()
+	mock.calls.DisableMultiTierTransitGatewayGroup = append(mock.calls.DisableMultiTierTransitGatewayGroup, callInfo)
+	mock.lockDisableMultiTierTransitGatewayGroup.Unlock()
+	return mock.DisableMultiTierTransitGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableMultiTierTransitGatewayGroupCalls gets all the calls that were made to DisableMultiTierTransitGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableMultiTierTransitGatewayGroupCalls())
+func (mock *ClientInterfaceMock) DisableMultiTierTransitGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableMultiTierTransitGatewayGroup.RLock()
+	calls = mock.calls.DisableMultiTierTransitGatewayGroup
+	mock.lockDisableMultiTierTransitGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableMultitierTransit calls DisableMultitierTransitFunc.
+func (mock *ClientInterfaceMock) DisableMultitierTransit(gwName string) error {
+	if mock.DisableMultitierTransitFunc == nil {
+		panic("ClientInterfaceMock.DisableMultitierTransitFunc: method is nil but ClientInterface.DisableMultitierTransit was just called")
+	}
+	callInfo := struct {
+		GwName string
+	}{
+		GwName: gwName,
+	}
+	mock.lockDisableMultitierTransit.Lock()
+	mock.calls.DisableMultitierTransit = append(mock.calls.DisableMultitierTransit, callInfo)
+	mock.lockDisableMultitierTransit.Unlock()
+	return mock.DisableMultitierTransitFunc(gwName)
+}
+
+// DisableMultitierTransitCalls gets all the calls that were made to DisableMultitierTransit.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableMultitierTransitCalls())
+func (mock *ClientInterfaceMock) DisableMultitierTransitCalls() []struct {
+	GwName string
+} {
+	var calls []struct {
+		GwName string
+	}
+	mock.lockDisableMultitierTransit.RLock()
+	calls = mock.calls.DisableMultitierTransit
+	mock.lockDisableMultitierTransit.RUnlock()
+	return calls
+}
+
+// DisablePrivateVpcDefaultRoute calls DisablePrivateVpcDefaultRouteFunc.
+func (mock *ClientInterfaceMock) DisablePrivateVpcDefaultRoute(gw *Gateway) error {
+	if mock.DisablePrivateVpcDefaultRouteFunc == nil {
+		panic("ClientInterfaceMock.DisablePrivateVpcDefaultRouteFunc: method is nil but ClientInterface.DisablePrivateVpcDefaultRoute was just called")
+	}
+	callInfo := struct {
+		Gw *Gateway
+	}{
+		Gw: gw,
+	}
+	mock.lockDisablePrivateVpcDefaultRoute.Lock()
+	mock.calls.DisablePrivateVpcDefaultRoute = append(mock.calls.DisablePrivateVpcDefaultRoute, callInfo)
+	mock.lockDisablePrivateVpcDefaultRoute.Unlock()
+	return mock.DisablePrivateVpcDefaultRouteFunc(gw)
+}
+
+// DisablePrivateVpcDefaultRouteCalls gets all the calls that were made to DisablePrivateVpcDefaultRoute.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisablePrivateVpcDefaultRouteCalls())
+func (mock *ClientInterfaceMock) DisablePrivateVpcDefaultRouteCalls() []struct {
+	Gw *Gateway
+} {
+	var calls []struct {
+		Gw *Gateway
+	}
+	mock.lockDisablePrivateVpcDefaultRoute.RLock()
+	calls = mock.calls.DisablePrivateVpcDefaultRoute
+	mock.lockDisablePrivateVpcDefaultRoute.RUnlock()
+	return calls
+}
+
+// DisablePrivateVpcDefaultRouteGatewayGroup calls DisablePrivateVpcDefaultRouteGatewayGroupFunc.
+func (mock *ClientInterfaceMock) DisablePrivateVpcDefaultRouteGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisablePrivateVpcDefaultRouteGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.DisablePrivateVpcDefaultRouteGatewayGroupFunc: method is nil but ClientInterface.DisablePrivateVpcDefaultRouteGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisablePrivateVpcDefaultRouteGatewayGroup.Lock()
+	mock.calls.DisablePrivateVpcDefaultRouteGatewayGroup = append(mock.calls.DisablePrivateVpcDefaultRouteGatewayGroup, callInfo)
+	mock.lockDisablePrivateVpcDefaultRouteGatewayGroup.Unlock()
+	return mock.DisablePrivateVpcDefaultRouteGatewayGroupFunc(ctx, groupName)
+}
+
+// DisablePrivateVpcDefaultRouteGatewayGroupCalls gets all the calls that were made to DisablePrivateVpcDefaultRouteGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisablePrivateVpcDefaultRouteGatewayGroupCalls())
+func (mock *ClientInterfaceMock) DisablePrivateVpcDefaultRouteGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisablePrivateVpcDefaultRouteGatewayGroup.RLock()
+	calls = mock.calls.DisablePrivateVpcDefaultRouteGatewayGroup
+	mock.lockDisablePrivateVpcDefaultRouteGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableS2CRxBalancing calls DisableS2CRxBalancingFunc.
+func (mock *ClientInterfaceMock) DisableS2CRxBalancing(gwName string) error {
+	if mock.DisableS2CRxBalancingFunc == nil {
+		panic("ClientInterfaceMock.DisableS2CRxBalancingFunc: method is nil but ClientInterface.DisableS2CRxBalancing was just called")
+	}
+	callInfo := struct {
+		GwName string
+	}{
+		GwName: gwName,
+	}
+	mock.lockDisableS2CRxBalancing.Lock()
+	mock.calls.DisableS2CRxBalancing = append(mock.calls.DisableS2CRxBalancing, callInfo)
+	mock.lockDisableS2CRxBalancing.Unlock()
+	return mock.DisableS2CRxBalancingFunc(gwName)
+}
+
+// DisableS2CRxBalancingCalls gets all the calls that were made to DisableS2CRxBalancing.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableS2CRxBalancingCalls())
+func (mock *ClientInterfaceMock) DisableS2CRxBalancingCalls() []struct {
+	GwName string
+} {
+	var calls []struct {
+		GwName string
+	}
+	mock.lockDisableS2CRxBalancing.RLock()
+	calls = mock.calls.DisableS2CRxBalancing
+	mock.lockDisableS2CRxBalancing.RUnlock()
+	return calls
+}
+
+// DisableS2cRxBalancingGatewayGroup calls DisableS2cRxBalancingGatewayGroupFunc.
+func (mock *ClientInterfaceMock) DisableS2cRxBalancingGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableS2cRxBalancingGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.DisableS2cRxBalancingGatewayGroupFunc: method is nil but ClientInterface.DisableS2cRxBalancingGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableS2cRxBalancingGatewayGroup.Lock()
+	mock.calls.DisableS2cRxBalancingGatewayGroup = append(mock.calls.DisableS2cRxBalancingGatewayGroup, callInfo)
+	mock.lockDisableS2cRxBalancingGatewayGroup.Unlock()
+	return mock.DisableS2cRxBalancingGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableS2cRxBalancingGatewayGroupCalls gets all the calls that were made to DisableS2cRxBalancingGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableS2cRxBalancingGatewayGroupCalls())
+func (mock *ClientInterfaceMock) DisableS2cRxBalancingGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableS2cRxBalancingGatewayGroup.RLock()
+	calls = mock.calls.DisableS2cRxBalancingGatewayGroup
+	mock.lockDisableS2cRxBalancingGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableSNat calls DisableSNatFunc.
+func (mock *ClientInterfaceMock) DisableSNat(gateway *Gateway) error {
+	if mock.DisableSNatFunc == nil {
+		panic("ClientInterfaceMock.DisableSNatFunc: method is nil but ClientInterface.DisableSNat was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableSNat.Lock()
+	mock.calls.DisableSNat = append(mock.calls.DisableSNat, callInfo)
+	mock.lockDisableSNat.Unlock()
+	return mock.DisableSNatFunc(gateway)
+}
+
+// DisableSNatCalls gets all the calls that were made to DisableSNat.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableSNatCalls())
+func (mock *ClientInterfaceMock) DisableSNatCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableSNat.RLock()
+	calls = mock.calls.DisableSNat
+	mock.lockDisableSNat.RUnlock()
+	return calls
+}
+
+// DisableSegmentation calls DisableSegmentationFunc.
+func (mock *ClientInterfaceMock) DisableSegmentation(transitGateway *TransitVpc) error {
+	if mock.DisableSegmentationFunc == nil {
+		panic("ClientInterfaceMock.DisableSegmentationFunc: method is nil but ClientInterface.DisableSegmentation was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockDisableSegmentation.Lock()
+	mock.calls.DisableSegmentation = append(mock.calls.DisableSegmentation, callInfo)
+	mock.lockDisableSegmentation.Unlock()
+	return mock.DisableSegmentationFunc(transitGateway)
+}
+
+// DisableSegmentationCalls gets all the calls that were made to DisableSegmentation.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableSegmentationCalls())
+func (mock *ClientInterfaceMock) DisableSegmentationCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockDisableSegmentation.RLock()
+	calls = mock.calls.DisableSegmentation
+	mock.lockDisableSegmentation.RUnlock()
+	return calls
+}
+
+// DisableSegmentationGatewayGroup calls DisableSegmentationGatewayGroupFunc.
+func (mock *ClientInterfaceMock) DisableSegmentationGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableSegmentationGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.DisableSegmentationGatewayGroupFunc: method is nil but ClientInterface.DisableSegmentationGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableSegmentationGatewayGroup.Lock()
+	mock.calls.DisableSegmentationGatewayGroup = append(mock.calls.DisableSegmentationGatewayGroup, callInfo)
+	mock.lockDisableSegmentationGatewayGroup.Unlock()
+	return mock.DisableSegmentationGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableSegmentationGatewayGroupCalls gets all the calls that were made to DisableSegmentationGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableSegmentationGatewayGroupCalls())
+func (mock *ClientInterfaceMock) DisableSegmentationGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableSegmentationGatewayGroup.RLock()
+	calls = mock.calls.DisableSegmentationGatewayGroup
+	mock.lockDisableSegmentationGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableSingleAZGateway calls DisableSingleAZGatewayFunc.
+func (mock *ClientInterfaceMock) DisableSingleAZGateway(gateway *Gateway) error {
+	if mock.DisableSingleAZGatewayFunc == nil {
+		panic("ClientInterfaceMock.DisableSingleAZGatewayFunc: method is nil but ClientInterface.DisableSingleAZGateway was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableSingleAZGateway.Lock()
+	mock.calls.DisableSingleAZGateway = append(mock.calls.DisableSingleAZGateway, callInfo)
+	mock.lockDisableSingleAZGateway.Unlock()
+	return mock.DisableSingleAZGatewayFunc(gateway)
+}
+
+// DisableSingleAZGatewayCalls gets all the calls that were made to DisableSingleAZGateway.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableSingleAZGatewayCalls())
+func (mock *ClientInterfaceMock) DisableSingleAZGatewayCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableSingleAZGateway.RLock()
+	calls = mock.calls.DisableSingleAZGateway
+	mock.lockDisableSingleAZGateway.RUnlock()
+	return calls
+}
+
+// DisableSite2CloudEventTriggeredHA calls DisableSite2CloudEventTriggeredHAFunc.
+func (mock *ClientInterfaceMock) DisableSite2CloudEventTriggeredHA(vpcID string, connectionName string) error {
+	if mock.DisableSite2CloudEventTriggeredHAFunc == nil {
+		panic("ClientInterfaceMock.DisableSite2CloudEventTriggeredHAFunc: method is nil but ClientInterface.DisableSite2CloudEventTriggeredHA was just called")
+	}
+	callInfo := struct {
+		VpcID          string
+		ConnectionName string
+	}{
+		VpcID:          vpcID,
+		ConnectionName: connectionName,
+	}
+	mock.lockDisableSite2CloudEventTriggeredHA.Lock()
+	mock.calls.DisableSite2CloudEventTriggeredHA = append(mock.calls.DisableSite2CloudEventTriggeredHA, callInfo)
+	mock.lockDisableSite2CloudEventTriggeredHA.Unlock()
+	return mock.DisableSite2CloudEventTriggeredHAFunc(vpcID, connectionName)
+}
+
+// DisableSite2CloudEventTriggeredHACalls gets all the calls that were made to DisableSite2CloudEventTriggeredHA.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableSite2CloudEventTriggeredHACalls())
+func (mock *ClientInterfaceMock) DisableSite2CloudEventTriggeredHACalls() []struct {
+	VpcID          string
+	ConnectionName string
+} {
+	var calls []struct {
+		VpcID          string
+		ConnectionName string
+	}
+	mock.lockDisableSite2CloudEventTriggeredHA.RLock()
+	calls = mock.calls.DisableSite2CloudEventTriggeredHA
+	mock.lockDisableSite2CloudEventTriggeredHA.RUnlock()
+	return calls
+}
+
+// DisableSite2cloudActiveActive calls DisableSite2cloudActiveActiveFunc.
+func (mock *ClientInterfaceMock) DisableSite2cloudActiveActive(site2cloud *Site2Cloud) error {
+	if mock.DisableSite2cloudActiveActiveFunc == nil {
+		panic("ClientInterfaceMock.DisableSite2cloudActiveActiveFunc: method is nil but ClientInterface.DisableSite2cloudActiveActive was just called")
+	}
+	callInfo := struct {
+		Site2cloud *Site2Cloud
+	}{
+		Site2cloud: site2cloud,
+	}
+	mock.lockDisableSite2cloudActiveActive.Lock()
+	mock.calls.DisableSite2cloudActiveActive = append(mock.calls.DisableSite2cloudActiveActive, callInfo)
+	mock.lockDisableSite2cloudActiveActive.Unlock()
+	return mock.DisableSite2cloudActiveActiveFunc(site2cloud)
+}
+
+// DisableSite2cloudActiveActiveCalls gets all the calls that were made to DisableSite2cloudActiveActive.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableSite2cloudActiveActiveCalls())
+func (mock *ClientInterfaceMock) DisableSite2cloudActiveActiveCalls() []struct {
+	Site2cloud *Site2Cloud
+} {
+	var calls []struct {
+		Site2cloud *Site2Cloud
+	}
+	mock.lockDisableSite2cloudActiveActive.RLock()
+	calls = mock.calls.DisableSite2cloudActiveActive
+	mock.lockDisableSite2cloudActiveActive.RUnlock()
+	return calls
+}
+
+// DisableSkipPublicRouteUpdate calls DisableSkipPublicRouteUpdateFunc.
+func (mock *ClientInterfaceMock) DisableSkipPublicRouteUpdate(gw *Gateway) error {
+	if mock.DisableSkipPublicRouteUpdateFunc == nil {
+		panic("ClientInterfaceMock.DisableSkipPublicRouteUpdateFunc: method is nil but ClientInterface.DisableSkipPublicRouteUpdate was just called")
+	}
+	callInfo := struct {
+		Gw *Gateway
+	}{
+		Gw: gw,
+	}
+	mock.lockDisableSkipPublicRouteUpdate.Lock()
+	mock.calls.DisableSkipPublicRouteUpdate = append(mock.calls.DisableSkipPublicRouteUpdate, callInfo)
+	mock.lockDisableSkipPublicRouteUpdate.Unlock()
+	return mock.DisableSkipPublicRouteUpdateFunc(gw)
+}
+
+// DisableSkipPublicRouteUpdateCalls gets all the calls that were made to DisableSkipPublicRouteUpdate.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableSkipPublicRouteUpdateCalls())
+func (mock *ClientInterfaceMock) DisableSkipPublicRouteUpdateCalls() []struct {
+	Gw *Gateway
+} {
+	var calls []struct {
+		Gw *Gateway
+	}
+	mock.lockDisableSkipPublicRouteUpdate.RLock()
+	calls = mock.calls.DisableSkipPublicRouteUpdate
+	mock.lockDisableSkipPublicRouteUpdate.RUnlock()
+	return calls
+}
+
+// DisableSkipPublicRouteUpdateGatewayGroup calls DisableSkipPublicRouteUpdateGatewayGroupFunc.
+func (mock *ClientInterfaceMock) DisableSkipPublicRouteUpdateGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableSkipPublicRouteUpdateGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.DisableSkipPublicRouteUpdateGatewayGroupFunc: method is nil but ClientInterface.DisableSkipPublicRouteUpdateGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableSkipPublicRouteUpdateGatewayGroup.Lock()
+	mock.calls.DisableSkipPublicRouteUpdateGatewayGroup = append(mock.calls.DisableSkipPublicRouteUpdateGatewayGroup, callInfo)
+	mock.lockDisableSkipPublicRouteUpdateGatewayGroup.Unlock()
+	return mock.DisableSkipPublicRouteUpdateGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableSkipPublicRouteUpdateGatewayGroupCalls gets all the calls that were made to DisableSkipPublicRouteUpdateGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableSkipPublicRouteUpdateGatewayGroupCalls())
+func (mock *ClientInterfaceMock) DisableSkipPublicRouteUpdateGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableSkipPublicRouteUpdateGatewayGroup.RLock()
+	calls = mock.calls.DisableSkipPublicRouteUpdateGatewayGroup
+	mock.lockDisableSkipPublicRouteUpdateGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableSpokeLearnedCidrsApproval calls DisableSpokeLearnedCidrsApprovalFunc.
+func (mock *ClientInterfaceMock) DisableSpokeLearnedCidrsApproval(gateway *SpokeVpc) error {
+	if mock.DisableSpokeLearnedCidrsApprovalFunc == nil {
+		panic("ClientInterfaceMock.DisableSpokeLearnedCidrsApprovalFunc: method is nil but ClientInterface.DisableSpokeLearnedCidrsApproval was just called")
+	}
+	callInfo := struct {
+		Gateway *SpokeVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableSpokeLearnedCidrsApproval.Lock()
+	mock.calls.DisableSpokeLearnedCidrsApproval = append(mock.calls.DisableSpokeLearnedCidrsApproval, callInfo)
+	mock.lockDisableSpokeLearnedCidrsApproval.Unlock()
+	return mock.DisableSpokeLearnedCidrsApprovalFunc(gateway)
+}
+
+// DisableSpokeLearnedCidrsApprovalCalls gets all the calls that were made to DisableSpokeLearnedCidrsApproval.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableSpokeLearnedCidrsApprovalCalls())
+func (mock *ClientInterfaceMock) DisableSpokeLearnedCidrsApprovalCalls() []struct {
+	Gateway *SpokeVpc
+} {
+	var calls []struct {
+		Gateway *SpokeVpc
+	}
+	mock.lockDisableSpokeLearnedCidrsApproval.RLock()
+	calls = mock.calls.DisableSpokeLearnedCidrsApproval
+	mock.lockDisableSpokeLearnedCidrsApproval.RUnlock()
+	return calls
+}
+
+// DisableSpokeLearnedCidrsApprovalGatewayGroup calls DisableSpokeLearnedCidrsApprovalGatewayGroupFunc.
+func (mock *ClientInterfaceMock) DisableSpokeLearnedCidrsApprovalGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableSpokeLearnedCidrsApprovalGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.DisableSpokeLearnedCidrsApprovalGatewayGroupFunc: method is nil but ClientInterface.DisableSpokeLearnedCidrsApprovalGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableSpokeLearnedCidrsApprovalGatewayGroup.Lock()
+	mock.calls.DisableSpokeLearnedCidrsApprovalGatewayGroup = append(mock.calls.DisableSpokeLearnedCidrsApprovalGatewayGroup, callInfo)
+	mock.lockDisableSpokeLearnedCidrsApprovalGatewayGroup.Unlock()
+	return mock.DisableSpokeLearnedCidrsApprovalGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableSpokeLearnedCidrsApprovalGatewayGroupCalls gets all the calls that were made to DisableSpokeLearnedCidrsApprovalGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableSpokeLearnedCidrsApprovalGatewayGroupCalls())
+func (mock *ClientInterfaceMock) DisableSpokeLearnedCidrsApprovalGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableSpokeLearnedCidrsApprovalGatewayGroup.RLock()
+	calls = mock.calls.DisableSpokeLearnedCidrsApprovalGatewayGroup
+	mock.lockDisableSpokeLearnedCidrsApprovalGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableSpokeMappedSite2CloudForwarding calls DisableSpokeMappedSite2CloudForwardingFunc.
+func (mock *ClientInterfaceMock) DisableSpokeMappedSite2CloudForwarding(site2cloud *Site2Cloud) error {
+	if mock.DisableSpokeMappedSite2CloudForwardingFunc == nil {
+		panic("ClientInterfaceMock.DisableSpokeMappedSite2CloudForwardingFunc: method is nil but ClientInterface.DisableSpokeMappedSite2CloudForwarding was just called")
+	}
+	callInfo := struct {
+		Site2cloud *Site2Cloud
+	}{
+		Site2cloud: site2cloud,
+	}
+	mock.lockDisableSpokeMappedSite2CloudForwarding.Lock()
+	mock.calls.DisableSpokeMappedSite2CloudForwarding = append(mock.calls.DisableSpokeMappedSite2CloudForwarding, callInfo)
+	mock.lockDisableSpokeMappedSite2CloudForwarding.Unlock()
+	return mock.DisableSpokeMappedSite2CloudForwardingFunc(site2cloud)
+}
+
+// DisableSpokeMappedSite2CloudForwardingCalls gets all the calls that were made to DisableSpokeMappedSite2CloudForwarding.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableSpokeMappedSite2CloudForwardingCalls())
+func (mock *ClientInterfaceMock) DisableSpokeMappedSite2CloudForwardingCalls() []struct {
+	Site2cloud *Site2Cloud
+} {
+	var calls []struct {
+		Site2cloud *Site2Cloud
+	}
+	mock.lockDisableSpokeMappedSite2CloudForwarding.RLock()
+	calls = mock.calls.DisableSpokeMappedSite2CloudForwarding
+	mock.lockDisableSpokeMappedSite2CloudForwarding.RUnlock()
+	return calls
+}
+
+// DisableSpokeOnpremRoutePropagation calls DisableSpokeOnpremRoutePropagationFunc.
+func (mock *ClientInterfaceMock) DisableSpokeOnpremRoutePropagation(spokeGateway *SpokeVpc) error {
+	if mock.DisableSpokeOnpremRoutePropagationFunc == nil {
+		panic("ClientInterfaceMock.DisableSpokeOnpremRoutePropagationFunc: method is nil but ClientInterface.DisableSpokeOnpremRoutePropagation was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+	}{
+		SpokeGateway: spokeGateway,
+	}
+	mock.lockDisableSpokeOnpremRoutePropagation.Lock()
+	mock.calls.DisableSpokeOnpremRoutePropagation = append(mock.calls.DisableSpokeOnpremRoutePropagation, callInfo)
+	mock.lockDisableSpokeOnpremRoutePropagation.Unlock()
+	return mock.DisableSpokeOnpremRoutePropagationFunc(spokeGateway)
+}
+
+// DisableSpokeOnpremRoutePropagationCalls gets all the calls that were made to DisableSpokeOnpremRoutePropagation.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableSpokeOnpremRoutePropagationCalls())
+func (mock *ClientInterfaceMock) DisableSpokeOnpremRoutePropagationCalls() []struct {
+	SpokeGateway *SpokeVpc
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+	}
+	mock.lockDisableSpokeOnpremRoutePropagation.RLock()
+	calls = mock.calls.DisableSpokeOnpremRoutePropagation
+	mock.lockDisableSpokeOnpremRoutePropagation.RUnlock()
+	return calls
+}
+
+// DisableSpokeOnpremRoutePropagationGatewayGroup calls DisableSpokeOnpremRoutePropagationGatewayGroupFunc.
+func (mock *ClientInterfaceMock) DisableSpokeOnpremRoutePropagationGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableSpokeOnpremRoutePropagationGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.DisableSpokeOnpremRoutePropagationGatewayGroupFunc: method is nil but ClientInterface.DisableSpokeOnpremRoutePropagationGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableSpokeOnpremRoutePropagationGatewayGroup.Lock()
+	mock.calls.DisableSpokeOnpremRoutePropagationGatewayGroup = append(mock.calls.DisableSpokeOnpremRoutePropagationGatewayGroup, callInfo)
+	mock.lockDisableSpokeOnpremRoutePropagationGatewayGroup.Unlock()
+	return mock.DisableSpokeOnpremRoutePropagationGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableSpokeOnpremRoutePropagationGatewayGroupCalls gets all the calls that were made to DisableSpokeOnpremRoutePropagationGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableSpokeOnpremRoutePropagationGatewayGroupCalls())
+func (mock *ClientInterfaceMock) DisableSpokeOnpremRoutePropagationGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableSpokeOnpremRoutePropagationGatewayGroup.RLock()
+	calls = mock.calls.DisableSpokeOnpremRoutePropagationGatewayGroup
+	mock.lockDisableSpokeOnpremRoutePropagationGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableSpokePreserveAsPath calls DisableSpokePreserveAsPathFunc.
+func (mock *ClientInterfaceMock) DisableSpokePreserveAsPath(spokeGateway *SpokeVpc) error {
+	if mock.DisableSpokePreserveAsPathFunc == nil {
+		panic("ClientInterfaceMock.DisableSpokePreserveAsPathFunc: method is nil but ClientInterface.DisableSpokePreserveAsPath was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+	}{
+		SpokeGateway: spokeGateway,
+	}
+	mock.lockDisableSpokePreserveAsPath.Lock()
+	mock.calls.DisableSpokePreserveAsPath = append(mock.calls.DisableSpokePreserveAsPath, callInfo)
+	mock.lockDisableSpokePreserveAsPath.Unlock()
+	return mock.DisableSpokePreserveAsPathFunc(spokeGateway)
+}
+
+// DisableSpokePreserveAsPathCalls gets all the calls that were made to DisableSpokePreserveAsPath.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableSpokePreserveAsPathCalls())
+func (mock *ClientInterfaceMock) DisableSpokePreserveAsPathCalls() []struct {
+	SpokeGateway *SpokeVpc
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+	}
+	mock.lockDisableSpokePreserveAsPath.RLock()
+	calls = mock.calls.DisableSpokePreserveAsPath
+	mock.lockDisableSpokePreserveAsPath.RUnlock()
+	return calls
+}
+
+// DisableSpokePreserveAsPathGatewayGroup calls DisableSpokePreserveAsPathGatewayGroupFunc.
+func (mock *ClientInterfaceMock) DisableSpokePreserveAsPathGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableSpokePreserveAsPathGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.DisableSpokePreserveAsPathGatewayGroupFunc: method is nil but ClientInterface.DisableSpokePreserveAsPathGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableSpokePreserveAsPathGatewayGroup.Lock()
+	mock.calls.DisableSpokePreserveAsPathGatewayGroup = append(mock.calls.DisableSpokePreserveAsPathGatewayGroup, callInfo)
+	mock.lockDisableSpokePreserveAsPathGatewayGroup.Unlock()
+	return mock.DisableSpokePreserveAsPathGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableSpokePreserveAsPathGatewayGroupCalls gets all the calls that were made to DisableSpokePreserveAsPathGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableSpokePreserveAsPathGatewayGroupCalls())
+func (mock *ClientInterfaceMock) DisableSpokePreserveAsPathGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableSpokePreserveAsPathGatewayGroup.RLock()
+	calls = mock.calls.DisableSpokePreserveAsPathGatewayGroup
+	mock.lockDisableSpokePreserveAsPathGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableSummarizeCidrToTgw calls DisableSummarizeCidrToTgwFunc.
+func (mock *ClientInterfaceMock) DisableSummarizeCidrToTgw(gwName string) error {
+	if mock.DisableSummarizeCidrToTgwFunc == nil {
+		panic("ClientInterfaceMock.DisableSummarizeCidrToTgwFunc: method is nil but ClientInterface.DisableSummarizeCidrToTgw was just called")
+	}
+	callInfo := struct {
+		GwName string
+	}{
+		GwName: gwName,
+	}
+	mock.lockDisableSummarizeCidrToTgw.Lock()
+	mock.calls.DisableSummarizeCidrToTgw = append(mock.calls.DisableSummarizeCidrToTgw, callInfo)
+	mock.lockDisableSummarizeCidrToTgw.Unlock()
+	return mock.DisableSummarizeCidrToTgwFunc(gwName)
+}
+
+// DisableSummarizeCidrToTgwCalls gets all the calls that were made to DisableSummarizeCidrToTgw.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableSummarizeCidrToTgwCalls())
+func (mock *ClientInterfaceMock) DisableSummarizeCidrToTgwCalls() []struct {
+	GwName string
+} {
+	var calls []struct {
+		GwName string
+	}
+	mock.lockDisableSummarizeCidrToTgw.RLock()
+	calls = mock.calls.DisableSummarizeCidrToTgw
+	mock.lockDisableSummarizeCidrToTgw.RUnlock()
+	return calls
+}
+
+// DisableTransitFireNet calls DisableTransitFireNetFunc.
+func (mock *ClientInterfaceMock) DisableTransitFireNet(gateway *Gateway) error {
+	if mock.DisableTransitFireNetFunc == nil {
+		panic("ClientInterfaceMock.DisableTransitFireNetFunc: method is nil but ClientInterface.DisableTransitFireNet was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableTransitFireNet.Lock()
+	mock.calls.DisableTransitFireNet = append(mock.calls.DisableTransitFireNet, callInfo)
+	mock.lockDisableTransitFireNet.Unlock()
+	return mock.DisableTransitFireNetFunc(gateway)
+}
+
+// DisableTransitFireNetCalls gets all the calls that were made to DisableTransitFireNet.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableTransitFireNetCalls())
+func (mock *ClientInterfaceMock) DisableTransitFireNetCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableTransitFireNet.RLock()
+	calls = mock.calls.DisableTransitFireNet
+	mock.lockDisableTransitFireNet.RUnlock()
+	return calls
+}
+
+// DisableTransitFireNetGatewayGroup calls DisableTransitFireNetGatewayGroupFunc.
+func (mock *ClientInterfaceMock) DisableTransitFireNetGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableTransitFireNetGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.DisableTransitFireNetGatewayGroupFunc: method is nil but ClientInterface.DisableTransitFireNetGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableTransitFireNetGatewayGroup.Lock()
+	mock.calls.DisableTransitFireNetGatewayGroup = append(mock.calls.DisableTransitFireNetGatewayGroup, callInfo)
+	mock.lockDisableTransitFireNetGatewayGroup.Unlock()
+	return mock.DisableTransitFireNetGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableTransitFireNetGatewayGroupCalls gets all the calls that were made to DisableTransitFireNetGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableTransitFireNetGatewayGroupCalls())
+func (mock *ClientInterfaceMock) DisableTransitFireNetGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableTransitFireNetGatewayGroup.RLock()
+	calls = mock.calls.DisableTransitFireNetGatewayGroup
+	mock.lockDisableTransitFireNetGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableTransitLearnedCidrsApproval calls DisableTransitLearnedCidrsApprovalFunc.
+func (mock *ClientInterfaceMock) DisableTransitLearnedCidrsApproval(gateway *TransitVpc) error {
+	if mock.DisableTransitLearnedCidrsApprovalFunc == nil {
+		panic("ClientInterfaceMock.DisableTransitLearnedCidrsApprovalFunc: method is nil but ClientInterface.DisableTransitLearnedCidrsApproval was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableTransitLearnedCidrsApproval.Lock()
+	mock.calls.DisableTransitLearnedCidrsApproval = append(mock.calls.DisableTransitLearnedCidrsApproval, callInfo)
+	mock.lockDisableTransitLearnedCidrsApproval.Unlock()
+	return mock.DisableTransitLearnedCidrsApprovalFunc(gateway)
+}
+
+// DisableTransitLearnedCidrsApprovalCalls gets all the calls that were made to DisableTransitLearnedCidrsApproval.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableTransitLearnedCidrsApprovalCalls())
+func (mock *ClientInterfaceMock) DisableTransitLearnedCidrsApprovalCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockDisableTransitLearnedCidrsApproval.RLock()
+	calls = mock.calls.DisableTransitLearnedCidrsApproval
+	mock.lockDisableTransitLearnedCidrsApproval.RUnlock()
+	return calls
+}
+
+// DisableTransitLearnedCidrsApprovalGatewayGroup calls DisableTransitLearnedCidrsApprovalGatewayGroupFunc.
+func (mock *ClientInterfaceMock) DisableTransitLearnedCidrsApprovalGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableTransitLearnedCidrsApprovalGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.DisableTransitLearnedCidrsApprovalGatewayGroupFunc: method is nil but ClientInterface.DisableTransitLearnedCidrsApprovalGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableTransitLearnedCidrsApprovalGatewayGroup.Lock()
+	mock.calls.DisableTransitLearnedCidrsApprovalGatewayGroup = append(mock.calls.DisableTransitLearnedCidrsApprovalGatewayGroup, callInfo)
+	mock.lockDisableTransitLearnedCidrsApprovalGatewayGroup.Unlock()
+	return mock.DisableTransitLearnedCidrsApprovalGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableTransitLearnedCidrsApprovalGatewayGroupCalls gets all the calls that were made to DisableTransitLearnedCidrsApprovalGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableTransitLearnedCidrsApprovalGatewayGroupCalls())
+func (mock *ClientInterfaceMock) DisableTransitLearnedCidrsApprovalGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableTransitLearnedCidrsApprovalGatewayGroup.RLock()
+	calls = mock.calls.DisableTransitLearnedCidrsApprovalGatewayGroup
+	mock.lockDisableTransitLearnedCidrsApprovalGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableTransitPreserveAsPath calls DisableTransitPreserveAsPathFunc.
+func (mock *ClientInterfaceMock) DisableTransitPreserveAsPath(transitGateway *TransitVpc) error {
+	if mock.DisableTransitPreserveAsPathFunc == nil {
+		panic("ClientInterfaceMock.DisableTransitPreserveAsPathFunc: method is nil but ClientInterface.DisableTransitPreserveAsPath was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockDisableTransitPreserveAsPath.Lock()
+	mock.calls.DisableTransitPreserveAsPath = append(mock.calls.DisableTransitPreserveAsPath, callInfo)
+	mock.lockDisableTransitPreserveAsPath.Unlock()
+	return mock.DisableTransitPreserveAsPathFunc(transitGateway)
+}
+
+// DisableTransitPreserveAsPathCalls gets all the calls that were made to DisableTransitPreserveAsPath.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableTransitPreserveAsPathCalls())
+func (mock *ClientInterfaceMock) DisableTransitPreserveAsPathCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockDisableTransitPreserveAsPath.RLock()
+	calls = mock.calls.DisableTransitPreserveAsPath
+	mock.lockDisableTransitPreserveAsPath.RUnlock()
+	return calls
+}
+
+// DisableTransitPreserveAsPathGatewayGroup calls DisableTransitPreserveAsPathGatewayGroupFunc.
+func (mock *ClientInterfaceMock) DisableTransitPreserveAsPathGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableTransitPreserveAsPathGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.DisableTransitPreserveAsPathGatewayGroupFunc: method is nil but ClientInterface.DisableTransitPreserveAsPathGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableTransitPreserveAsPathGatewayGroup.Lock()
+	mock.calls.DisableTransitPreserveAsPathGatewayGroup = append(mock.calls.DisableTransitPreserveAsPathGatewayGroup, callInfo)
+	mock.lockDisableTransitPreserveAsPathGatewayGroup.Unlock()
+	return mock.DisableTransitPreserveAsPathGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableTransitPreserveAsPathGatewayGroupCalls gets all the calls that were made to DisableTransitPreserveAsPathGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableTransitPreserveAsPathGatewayGroupCalls())
+func (mock *ClientInterfaceMock) DisableTransitPreserveAsPathGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableTransitPreserveAsPathGatewayGroup.RLock()
+	calls = mock.calls.DisableTransitPreserveAsPathGatewayGroup
+	mock.lockDisableTransitPreserveAsPathGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableTransitSummarizeCidrToTgwGatewayGroup calls DisableTransitSummarizeCidrToTgwGatewayGroupFunc.
+func (mock *ClientInterfaceMock) DisableTransitSummarizeCidrToTgwGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableTransitSummarizeCidrToTgwGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.DisableTransitSummarizeCidrToTgwGatewayGroupFunc: method is nil but ClientInterface.DisableTransitSummarizeCidrToTgwGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableTransitSummarizeCidrToTgwGatewayGroup.Lock()
+	mock.calls.DisableTransitSummarizeCidrToTgwGatewayGroup = append(mock.calls.DisableTransitSummarizeCidrToTgwGatewayGroup, callInfo)
+	mock.lockDisableTransitSummarizeCidrToTgwGatewayGroup.Unlock()
+	return mock.DisableTransitSummarizeCidrToTgwGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableTransitSummarizeCidrToTgwGatewayGroupCalls gets all the calls that were made to DisableTransitSummarizeCidrToTgwGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableTransitSummarizeCidrToTgwGatewayGroupCalls())
+func (mock *ClientInterfaceMock) DisableTransitSummarizeCidrToTgwGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableTransitSummarizeCidrToTgwGatewayGroup.RLock()
+	calls = mock.calls.DisableTransitSummarizeCidrToTgwGatewayGroup
+	mock.lockDisableTransitSummarizeCidrToTgwGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableVPNConfig calls DisableVPNConfigFunc.
+func (mock *ClientInterfaceMock) DisableVPNConfig(gateway *Gateway, vpnConfig *VPNConfig) error {
+	if mock.DisableVPNConfigFunc == nil {
+		panic("ClientInterfaceMock.DisableVPNConfigFunc: method is nil but ClientInterface.DisableVPNConfig was just called")
+	}
+	callInfo := struct {
+		Gateway   *Gateway
+		VpnConfig *VPNConfig
+	}{
+		Gateway:   gateway,
+		VpnConfig: vpnConfig,
+	}
+	mock.lockDisableVPNConfig.Lock()
+	mock.calls.DisableVPNConfig = append(mock.calls.DisableVPNConfig, callInfo)
+	mock.lockDisableVPNConfig.Unlock()
+	return mock.DisableVPNConfigFunc(gateway, vpnConfig)
+}
+
+// DisableVPNConfigCalls gets all the calls that were made to DisableVPNConfig.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableVPNConfigCalls())
+func (mock *ClientInterfaceMock) DisableVPNConfigCalls() []struct {
+	Gateway   *Gateway
+	VpnConfig *VPNConfig
+} {
+	var calls []struct {
+		Gateway   *Gateway
+		VpnConfig *VPNConfig
+	}
+	mock.lockDisableVPNConfig.RLock()
+	calls = mock.calls.DisableVPNConfig
+	mock.lockDisableVPNConfig.RUnlock()
+	return calls
+}
+
+// DisableVpcDNSServer calls DisableVpcDNSServerFunc.
+func (mock *ClientInterfaceMock) DisableVpcDNSServer(gateway *Gateway) error {
+	if mock.DisableVpcDNSServerFunc == nil {
+		panic("ClientInterfaceMock.DisableVpcDNSServerFunc: method is nil but ClientInterface.DisableVpcDNSServer was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableVpcDNSServer.Lock()
+	mock.calls.DisableVpcDNSServer = append(mock.calls.DisableVpcDNSServer, callInfo)
+	mock.lockDisableVpcDNSServer.Unlock()
+	return mock.DisableVpcDNSServerFunc(gateway)
+}
+
+// DisableVpcDNSServerCalls gets all the calls that were made to DisableVpcDNSServer.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableVpcDNSServerCalls())
+func (mock *ClientInterfaceMock) DisableVpcDNSServerCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableVpcDNSServer.RLock()
+	calls = mock.calls.DisableVpcDNSServer
+	mock.lockDisableVpcDNSServer.RUnlock()
+	return calls
+}
+
+// DisableVpnNat calls DisableVpnNatFunc.
+func (mock *ClientInterfaceMock) DisableVpnNat(gateway *Gateway) error {
+	if mock.DisableVpnNatFunc == nil {
+		panic("ClientInterfaceMock.DisableVpnNatFunc: method is nil but ClientInterface.DisableVpnNat was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableVpnNat.Lock()
+	mock.calls.DisableVpnNat = append(mock.calls.DisableVpnNat, callInfo)
+	mock.lockDisableVpnNat.Unlock()
+	return mock.DisableVpnNatFunc(gateway)
+}
+
+// DisableVpnNatCalls gets all the calls that were made to DisableVpnNat.
+// Check the length with:
+//
+//	len(mockedClientInterface.DisableVpnNatCalls())
+func (mock *ClientInterfaceMock) DisableVpnNatCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableVpnNat.RLock()
+	calls = mock.calls.DisableVpnNat
+	mock.lockDisableVpnNat.RUnlock()
+	return calls
+}
+
+// DownloadEdgeNEOConfigFile calls DownloadEdgeNEOConfigFileFunc.
+func (mock *ClientInterfaceMock) DownloadEdgeNEOConfigFile(ctx context.Context, edgeNEODevice *EdgeNEODevice) error {
+	if mock.DownloadEdgeNEOConfigFileFunc == nil {
+		panic("ClientInterfaceMock.DownloadEdgeNEOConfigFileFunc: method is nil but ClientInterface.DownloadEdgeNEOConfigFile was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		EdgeNEODevice *EdgeNEODevice
+	}{
+		Ctx:           ctx,
+		EdgeNEODevice: edgeNEODevice,
+	}
+	mock.lockDownloadEdgeNEOConfigFile.Lock()
+	mock.calls.DownloadEdgeNEOConfigFile = append(mock.calls.DownloadEdgeNEOConfigFile, callInfo)
+	mock.lockDownloadEdgeNEOConfigFile.Unlock()
+	return mock.DownloadEdgeNEOConfigFileFunc(ctx, edgeNEODevice)
+}
+
+// DownloadEdgeNEOConfigFileCalls gets all the calls that were made to DownloadEdgeNEOConfigFile.
+// Check the length with:
+//
+//	len(mockedClientInterface.DownloadEdgeNEOConfigFileCalls())
+func (mock *ClientInterfaceMock) DownloadEdgeNEOConfigFileCalls() []struct {
+	Ctx           context.Context
+	EdgeNEODevice *EdgeNEODevice
+} {
+	var calls []struct {
+		Ctx           context.Context
+		EdgeNEODevice *EdgeNEODevice
+	}
+	mock.lockDownloadEdgeNEOConfigFile.RLock()
+	calls = mock.calls.DownloadEdgeNEOConfigFile
+	mock.lockDownloadEdgeNEOConfigFile.RUnlock()
+	return calls
+}
+
+// EditBgpMd5Key calls EditBgpMd5KeyFunc.
+func (mock *ClientInterfaceMock) EditBgpMd5Key(editBgpMd5Key *EditBgpMd5Key) error {
+	if mock.EditBgpMd5KeyFunc == nil {
+		panic("ClientInterfaceMock.EditBgpMd5KeyFunc: method is nil but ClientInterface.EditBgpMd5Key was just called")
+	}
+	callInfo := struct {
+		EditBgpMd5Key *EditBgpMd5Key
+	}{
+		EditBgpMd5Key: editBgpMd5Key,
+	}
+	mock.lockEditBgpMd5Key.Lock()
+	mock.calls.EditBgpMd5Key = append(mock.calls.EditBgpMd5Key, callInfo)
+	mock.lockEditBgpMd5Key.Unlock()
+	return mock.EditBgpMd5KeyFunc(editBgpMd5Key)
+}
+
+// EditBgpMd5KeyCalls gets all the calls that were made to EditBgpMd5Key.
+// Check the length with:
+//
+//	len(mockedClientInterface.EditBgpMd5KeyCalls())
+func (mock *ClientInterfaceMock) EditBgpMd5KeyCalls() []struct {
+	EditBgpMd5Key *EditBgpMd5Key
+} {
+	var calls []struct {
+		EditBgpMd5Key *EditBgpMd5Key
+	}
+	mock.lockEditBgpMd5Key.RLock()
+	calls = mock.calls.EditBgpMd5Key
+	mock.lockEditBgpMd5Key.RUnlock()
+	return calls
+}
+
+// EditConnectionBgpBfd calls EditConnectionBgpBfdFunc.
+func (mock *ClientInterfaceMock) EditConnectionBgpBfd(externalDeviceConn *ExternalDeviceConn) error {
+	if mock.EditConnectionBgpBfdFunc == nil {
+		panic("ClientInterfaceMock.EditConnectionBgpBfdFunc: method is nil but ClientInterface.EditConnectionBgpBfd was just called")
+	}
+	callInfo := struct {
+		ExternalDeviceConn *ExternalDeviceConn
+	}{
+		ExternalDeviceConn: externalDeviceConn,
+	}
+	mock.lockEditConnectionBgpBfd.Lock()
+	mock.calls.EditConnectionBgpBfd = append(mock.calls.EditConnectionBgpBfd, callInfo)
+	mock.lockEditConnectionBgpBfd.Unlock()
+	return mock.EditConnectionBgpBfdFunc(externalDeviceConn)
+}
+
+// EditConnectionBgpBfdCalls gets all the calls that were made to EditConnectionBgpBfd.
+// Check the length with:
+//
+//	len(mockedClientInterface.EditConnectionBgpBfdCalls())
+func (mock *ClientInterfaceMock) EditConnectionBgpBfdCalls() []struct {
+	ExternalDeviceConn *ExternalDeviceConn
+} {
+	var calls []struct {
+		ExternalDeviceConn *ExternalDeviceConn
+	}
+	mock.lockEditConnectionBgpBfd.RLock()
+	calls = mock.calls.EditConnectionBgpBfd
+	mock.lockEditConnectionBgpBfd.RUnlock()
+	return calls
+}
+
+// EditConnectionBgpMultihop calls EditConnectionBgpMultihopFunc.
+func (mock *ClientInterfaceMock) EditConnectionBgpMultihop(externalDeviceConn *ExternalDeviceConn) error {
+	if mock.EditConnectionBgpMultihopFunc == nil {
+		panic("ClientInterfaceMock.EditConnectionBgpMultihopFunc: method is nil but ClientInterface.EditConnectionBgpMultihop was just called")
+	}
+	callInfo := struct {
+		ExternalDeviceConn *ExternalDeviceConn
+	}{
+		ExternalDeviceConn: externalDeviceConn,
+	}
+	mock.lockEditConnectionBgpMultihop.Lock()
+	mock.calls.EditConnectionBgpMultihop = append(mock.calls.EditConnectionBgpMultihop, callInfo)
+	mock.lockEditConnectionBgpMultihop.Unlock()
+	return mock.EditConnectionBgpMultihopFunc(externalDeviceConn)
+}
+
+// EditConnectionBgpMultihopCalls gets all the calls that were made to EditConnectionBgpMultihop.
+// Check the length with:
+//
+//	len(mockedClientInterface.EditConnectionBgpMultihopCalls())
+func (mock *ClientInterfaceMock) EditConnectionBgpMultihopCalls() []struct {
+	ExternalDeviceConn *ExternalDeviceConn
+} {
+	var calls []struct {
+		ExternalDeviceConn *ExternalDeviceConn
+	}
+	mock.lockEditConnectionBgpMultihop.RLock()
+	calls = mock.calls.EditConnectionBgpMultihop
+	mock.lockEditConnectionBgpMultihop.RUnlock()
+	return calls
+}
+
+// EditDesignatedGateway calls EditDesignatedGatewayFunc.
+func (mock *ClientInterfaceMock) EditDesignatedGateway(gateway *Gateway) error {
+	if mock.EditDesignatedGatewayFunc == nil {
+		panic("ClientInterfaceMock.EditDesignatedGatewayFunc: method is nil but ClientInterface.EditDesignatedGateway was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEditDesignatedGateway.Lock()
+	mock.calls.EditDesignatedGateway = append(mock.calls.EditDesignatedGateway, callInfo)
+	mock.lockEditDesignatedGateway.Unlock()
+	return mock.EditDesignatedGatewayFunc(gateway)
+}
+
+// EditDesignatedGatewayCalls gets all the calls that were made to EditDesignatedGateway.
+// Check the length with:
+//
+//	len(mockedClientInterface.EditDesignatedGatewayCalls())
+func (mock *ClientInterfaceMock) EditDesignatedGatewayCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEditDesignatedGateway.RLock()
+	calls = mock.calls.EditDesignatedGateway
+	mock.lockEditDesignatedGateway.RUnlock()
+	return calls
+}
+
+// EditGatewayAdvertisedCidr calls EditGatewayAdvertisedCidrFunc.
+func (mock *ClientInterfaceMock) EditGatewayAdvertisedCidr(gateway *Gateway) error {
+	if mock.EditGatewayAdvertisedCidrFunc == nil {
+		panic("ClientInterfaceMock.EditGatewayAdvertisedCidrFunc: method is nil but ClientInterface.EditGatewayAdvertisedCidr was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEditGatewayAdvertisedCidr.Lock()
+	mock.calls.EditGatewayAdvertisedCidr = append(mock.calls.EditGatewayAdvertisedCidr, callInfo)
+	mock.lockEditGatewayAdvertisedCidr.Unlock()
+	return mock.EditGatewayAdvertisedCidrFunc(gateway)
+}
+
+// EditGatewayAdvertisedCidrCalls gets all the calls that were made to EditGatewayAdvertisedCidr.
+// Check the length with:
+//
+//	len(mockedClientInterface.EditGatewayAdvertisedCidrCalls())
+func (mock *ClientInterfaceMock) EditGatewayAdvertisedCidrCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEditGatewayAdvertisedCidr.RLock()
+	calls = mock.calls.EditGatewayAdvertisedCidr
+	mock.lockEditGatewayAdvertisedCidr.RUnlock()
+	return calls
+}
+
+// EditGatewayCustomRoutes calls EditGatewayCustomRoutesFunc.
+func (mock *ClientInterfaceMock) EditGatewayCustomRoutes(gateway *Gateway) error {
+	if mock.EditGatewayCustomRoutesFunc == nil {
+		panic("ClientInterfaceMock.EditGatewayCustomRoutesFunc: method is nil but ClientInterface.EditGatewayCustomRoutes was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEditGatewayCustomRoutes.Lock()
+	mock.calls.EditGatewayCustomRoutes = append(mock.calls.EditGatewayCustomRoutes, callInfo)
+	mock.lockEditGatewayCustomRoutes.Unlock()
+	return mock.EditGatewayCustomRoutesFunc(gateway)
+}
+
+// EditGatewayCustomRoutesCalls gets all the calls that were made to EditGatewayCustomRoutes.
+// Check the length with:
+//
+//	len(mockedClientInterface.EditGatewayCustomRoutesCalls())
+func (mock *ClientInterfaceMock) EditGatewayCustomRoutesCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEditGatewayCustomRoutes.RLock()
+	calls = mock.calls.EditGatewayCustomRoutes
+	mock.lockEditGatewayCustomRoutes.RUnlock()
+	return calls
+}
+
+// EditGatewayFilterRoutes calls EditGatewayFilterRoutesFunc.
+func (mock *ClientInterfaceMock) EditGatewayFilterRoutes(gateway *Gateway) error {
+	if mock.EditGatewayFilterRoutesFunc == nil {
+		panic("ClientInterfaceMock.EditGatewayFilterRoutesFunc: method is nil but ClientInterface.EditGatewayFilterRoutes was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEditGatewayFilterRoutes.Lock()
+	mock.calls.EditGatewayFilterRoutes = append(mock.calls.EditGatewayFilterRoutes, callInfo)
+	mock.lockEditGatewayFilterRoutes.Unlock()
+	return mock.EditGatewayFilterRoutesFunc(gateway)
+}
+
+// EditGatewayFilterRoutesCalls gets all the calls that were made to EditGatewayFilterRoutes.
+// Check the length with:
+//
+//	len(mockedClientInterface.EditGatewayFilterRoutesCalls())
+func (mock *ClientInterfaceMock) EditGatewayFilterRoutesCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEditGatewayFilterRoutes.RLock()
+	calls = mock.calls.EditGatewayFilterRoutes
+	mock.lockEditGatewayFilterRoutes.RUnlock()
+	return calls
+}
+
+// EditManagedRouteTables calls EditManagedRouteTablesFunc.
+func (mock *ClientInterfaceMock) EditManagedRouteTables(gateway *Gateway, routeTables []string) error {
+	if mock.EditManagedRouteTablesFunc == nil {
+		panic("ClientInterfaceMock.EditManagedRouteTablesFunc: method is nil but ClientInterface.EditManagedRouteTables was just called")
+	}
+	callInfo := struct {
+		Gateway     *Gateway
+		RouteTables []string
+	}{
+		Gateway:     gateway,
+		RouteTables: routeTables,
+	}
+	mock.lockEditManagedRouteTables.Lock()
+	mock.calls.EditManagedRouteTables = append(mock.calls.EditManagedRouteTables, callInfo)
+	mock.lockEditManagedRouteTables.Unlock()
+	return mock.EditManagedRouteTablesFunc(gateway, routeTables)
+}
+
+// EditManagedRouteTablesCalls gets all the calls that were made to EditManagedRouteTables.
+// Check the length with:
+//
+//	len(mockedClientInterface.EditManagedRouteTablesCalls())
+func (mock *ClientInterfaceMock) EditManagedRouteTablesCalls() []struct {
+	Gateway     *Gateway
+	RouteTables []string
+} {
+	var calls []struct {
+		Gateway     *Gateway
+		RouteTables []string
+	}
+	mock.lockEditManagedRouteTables.RLock()
+	calls = mock.calls.EditManagedRouteTables
+	mock.lockEditManagedRouteTables.RUnlock()
+	return calls
+}
+
+// EditManagedRouteTablesForGatewayGroup calls EditManagedRouteTablesForGatewayGroupFunc.
+func (mock *ClientInterfaceMock) EditManagedRouteTablesForGatewayGroup(ctx context.Context, groupName string, routeTables []string) error {
+	if mock.EditManagedRouteTablesForGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.EditManagedRouteTablesForGatewayGroupFunc: method is nil but ClientInterface.EditManagedRouteTablesForGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		GroupName   string
+		RouteTables []string
+	}{
+		Ctx:         ctx,
+		GroupName:   groupName,
+		RouteTables: routeTables,
+	}
+	mock.lockEditManagedRouteTablesForGatewayGroup.Lock()
+	mock.calls.EditManagedRouteTablesForGatewayGroup = append(mock.calls.EditManagedRouteTablesForGatewayGroup, callInfo)
+	mock.lockEditManagedRouteTablesForGatewayGroup.Unlock()
+	return mock.EditManagedRouteTablesForGatewayGroupFunc(ctx, groupName, routeTables)
+}
+
+// EditManagedRouteTablesForGatewayGroupCalls gets all the calls that were made to EditManagedRouteTablesForGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.EditManagedRouteTablesForGatewayGroupCalls())
+func (mock *ClientInterfaceMock) EditManagedRouteTablesForGatewayGroupCalls() []struct {
+	Ctx         context.Context
+	GroupName   string
+	RouteTables []string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		GroupName   string
+		RouteTables []string
+	}
+	mock.lockEditManagedRouteTablesForGatewayGroup.RLock()
+	calls = mock.calls.EditManagedRouteTablesForGatewayGroup
+	mock.lockEditManagedRouteTablesForGatewayGroup.RUnlock()
+	return calls
+}
+
+// EditPrivateRouteTableConfig calls EditPrivateRouteTableConfigFunc.
+func (mock *ClientInterfaceMock) EditPrivateRouteTableConfig(gateway *Gateway, routeTables []string) error {
+	if mock.EditPrivateRouteTableConfigFunc == nil {
+		panic("ClientInterfaceMock.EditPrivateRouteTableConfigFunc: method is nil but ClientInterface.EditPrivateRouteTableConfig was just called")
+	}
+	callInfo := struct {
+		Gateway     *Gateway
+		RouteTables []string
+	}{
+		Gateway:     gateway,
+		RouteTables: routeTables,
+	}
+	mock.lockEditPrivateRouteTableConfig.Lock()
+	mock.calls.EditPrivateRouteTableConfig = append(mock.calls.EditPrivateRouteTableConfig, callInfo)
+	mock.lockEditPrivateRouteTableConfig.Unlock()
+	return mock.EditPrivateRouteTableConfigFunc(gateway, routeTables)
+}
+
+// EditPrivateRouteTableConfigCalls gets all the calls that were made to EditPrivateRouteTableConfig.
+// Check the length with:
+//
+//	len(mockedClientInterface.EditPrivateRouteTableConfigCalls())
+func (mock *ClientInterfaceMock) EditPrivateRouteTableConfigCalls() []struct {
+	Gateway     *Gateway
+	RouteTables []string
+} {
+	var calls []struct {
+		Gateway     *Gateway
+		RouteTables []string
+	}
+	mock.lockEditPrivateRouteTableConfig.RLock()
+	calls = mock.calls.EditPrivateRouteTableConfig
+	mock.lockEditPrivateRouteTableConfig.RUnlock()
+	return calls
+}
+
+// EditPrivateRouteTableConfigForGatewayGroup calls EditPrivateRouteTableConfigForGatewayGroupFunc.
+func (mock *ClientInterfaceMock) EditPrivateRouteTableConfigForGatewayGroup(ctx context.Context, groupName string, routeTables []string) error {
+	if mock.EditPrivateRouteTableConfigForGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.EditPrivateRouteTableConfigForGatewayGroupFunc: method is nil but ClientInterface.EditPrivateRouteTableConfigForGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		GroupName   string
+		RouteTables []string
+	}{
+		Ctx:         ctx,
+		GroupName:   groupName,
+		RouteTables: routeTables,
+	}
+	mock.lockEditPrivateRouteTableConfigForGatewayGroup.Lock()
+	mock.calls.EditPrivateRouteTableConfigForGatewayGroup = append(mock.calls.EditPrivateRouteTableConfigForGatewayGroup, callInfo)
+	mock.lockEditPrivateRouteTableConfigForGatewayGroup.Unlock()
+	return mock.EditPrivateRouteTableConfigForGatewayGroupFunc(ctx, groupName, routeTables)
+}
+
+// EditPrivateRouteTableConfigForGatewayGroupCalls gets all the calls that were made to EditPrivateRouteTableConfigForGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.EditPrivateRouteTableConfigForGatewayGroupCalls())
+func (mock *ClientInterfaceMock) EditPrivateRouteTableConfigForGatewayGroupCalls() []struct {
+	Ctx         context.Context
+	GroupName   string
+	RouteTables []string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		GroupName   string
+		RouteTables []string
+	}
+	mock.lockEditPrivateRouteTableConfigForGatewayGroup.RLock()
+	calls = mock.calls.EditPrivateRouteTableConfigForGatewayGroup
+	mock.lockEditPrivateRouteTableConfigForGatewayGroup.RUnlock()
+	return calls
+}
+
+// EditPublicSubnetFilteringRouteTableList calls EditPublicSubnetFilteringRouteTableListFunc.
+func (mock *ClientInterfaceMock) EditPublicSubnetFilteringRouteTableList(gateway *Gateway, routeTables []string) error {
+	if mock.EditPublicSubnetFilteringRouteTableListFunc == nil {
+		panic("ClientInterfaceMock.EditPublicSubnetFilteringRouteTableListFunc: method is nil but ClientInterface.EditPublicSubnetFilteringRouteTableList was just called")
+	}
+	callInfo := struct {
+		Gateway     *Gateway
+		RouteTables []string
+	}{
+		Gateway:     gateway,
+		RouteTables: routeTables,
+	}
+	mock.lockEditPublicSubnetFilteringRouteTableList.Lock()
+	mock.calls.EditPublicSubnetFilteringRouteTableList = append(mock.calls.EditPublicSubnetFilteringRouteTableList, callInfo)
+	mock.lockEditPublicSubnetFilteringRouteTableList.Unlock()
+	return mock.EditPublicSubnetFilteringRouteTableListFunc(gateway, routeTables)
+}
+
+// EditPublicSubnetFilteringRouteTableListCalls gets all the calls that were made to EditPublicSubnetFilteringRouteTableList.
+// Check the length with:
+//
+//	len(mockedClientInterface.EditPublicSubnetFilteringRouteTableListCalls())
+func (mock *ClientInterfaceMock) EditPublicSubnetFilteringRouteTableListCalls() []struct {
+	Gateway     *Gateway
+	RouteTables []string
+} {
+	var calls []struct {
+		Gateway     *Gateway
+		RouteTables []string
+	}
+	mock.lockEditPublicSubnetFilteringRouteTableList.RLock()
+	calls = mock.calls.EditPublicSubnetFilteringRouteTableList
+	mock.lockEditPublicSubnetFilteringRouteTableList.RUnlock()
+	return calls
+}
+
+// EditSite2CloudPhase1LocalIdentifier calls EditSite2CloudPhase1LocalIdentifierFunc.
+func (mock *ClientInterfaceMock) EditSite2CloudPhase1LocalIdentifier(s2c *EditSite2Cloud) error {
+	if mock.EditSite2CloudPhase1LocalIdentifierFunc == nil {
+		panic("ClientInterfaceMock.EditSite2CloudPhase1LocalIdentifierFunc: method is nil but ClientInterface.EditSite2CloudPhase1LocalIdentifier was just called")
+	}
+	callInfo := struct {
+		S2c *EditSite2Cloud
+	}{
+		S2c: s2c,
+	}
+	mock.lockEditSite2CloudPhase1LocalIdentifier.Lock()
+	mock.calls.EditSite2CloudPhase1LocalIdentifier = append(mock.calls.EditSite2CloudPhase1LocalIdentifier, callInfo)
+	mock.lockEditSite2CloudPhase1LocalIdentifier.Unlock()
+	return mock.EditSite2CloudPhase1LocalIdentifierFunc(s2c)
+}
+
+// EditSite2CloudPhase1LocalIdentifierCalls gets all the calls that were made to EditSite2CloudPhase1LocalIdentifier.
+// Check the length with:
+//
+//	len(mockedClientInterface.EditSite2CloudPhase1LocalIdentifierCalls())
+func (mock *ClientInterfaceMock) EditSite2CloudPhase1LocalIdentifierCalls() []struct {
+	S2c *EditSite2Cloud
+} {
+	var calls []struct {
+		S2c *EditSite2Cloud
+	}
+	mock.lockEditSite2CloudPhase1LocalIdentifier.RLock()
+	calls = mock.calls.EditSite2CloudPhase1LocalIdentifier
+	mock.lockEditSite2CloudPhase1LocalIdentifier.RUnlock()
+	return calls
+}
+
+// EditSpokeExternalDeviceConnASPathPrepend calls EditSpokeExternalDeviceConnASPathPrependFunc.
+func (mock *ClientInterfaceMock) EditSpokeExternalDeviceConnASPathPrepend(externalDeviceConn *ExternalDeviceConn, prependASPath []string) error {
+	if mock.EditSpokeExternalDeviceConnASPathPrependFunc == nil {
+		panic("ClientInterfaceMock.EditSpokeExternalDeviceConnASPathPrependFunc: method is nil but ClientInterface.EditSpokeExternalDeviceConnASPathPrepend was just called")
+	}
+	callInfo := struct {
+		ExternalDeviceConn *ExternalDeviceConn
+		PrependASPath      []string
+	}{
+		ExternalDeviceConn: externalDeviceConn,
+		PrependASPath:      prependASPath,
+	}
+	mock.lockEditSpokeExternalDeviceConnASPathPrepend.Lock()
+	mock.calls.EditSpokeExternalDeviceConnASPathPrepend = append(mock.calls.EditSpokeExternalDeviceConnASPathPrepend, callInfo)
+	mock.lockEditSpokeExternalDeviceConnASPathPrepend.Unlock()
+	return mock.EditSpokeExternalDeviceConnASPathPrependFunc(externalDeviceConn, prependASPath)
+}
+
+// EditSpokeExternalDeviceConnASPathPrependCalls gets all the calls that were made to EditSpokeExternalDeviceConnASPathPrepend.
+// Check the length with:
+//
+//	len(mockedClientInterface.EditSpokeExternalDeviceConnASPathPrependCalls())
+func (mock *ClientInterfaceMock) EditSpokeExternalDeviceConnASPathPrependCalls() []struct {
+	ExternalDeviceConn *ExternalDeviceConn
+	PrependASPath      []string
+} {
+	var calls []struct {
+		ExternalDeviceConn *ExternalDeviceConn
+		PrependASPath      []string
+	}
+	mock.lockEditSpokeExternalDeviceConnASPathPrepend.RLock()
+	calls = mock.calls.EditSpokeExternalDeviceConnASPathPrepend
+	mock.lockEditSpokeExternalDeviceConnASPathPrepend.RUnlock()
+	return calls
+}
+
+// EditSpokeTransitAttachmentFilters calls EditSpokeTransitAttachmentFiltersFunc.
+func (mock *ClientInterfaceMock) EditSpokeTransitAttachmentFilters(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) error {
+	if mock.EditSpokeTransitAttachmentFiltersFunc == nil {
+		panic("ClientInterfaceMock.EditSpokeTransitAttachmentFiltersFunc: method is nil but ClientInterface.EditSpokeTransitAttachmentFilters was just called")
+	}
+	callInfo := struct {
+		Ctx                    context.Context
+		SpokeTransitAttachment *SpokeTransitAttachment
+	}{
+		Ctx:                    ctx,
+		SpokeTransitAttachment: spokeTransitAttachment,
+	}
+	mock.lockEditSpokeTransitAttachmentFilters.Lock()
+	mock.calls.EditSpokeTransitAttachmentFilters = append(mock.calls.EditSpokeTransitAttachmentFilters, callInfo)
+	mock.lockEditSpokeTransitAttachmentFilters.Unlock()
+	return mock.EditSpokeTransitAttachmentFiltersFunc(ctx, spokeTransitAttachment)
+}
+
+// EditSpokeTransitAttachmentFiltersCalls gets all the calls that were made to EditSpokeTransitAttachmentFilters.
+// Check the length with:
+//
+//	len(mockedClientInterface.EditSpokeTransitAttachmentFiltersCalls())
+func (mock *ClientInterfaceMock) EditSpokeTransitAttachmentFiltersCalls() []struct {
+	Ctx                    context.Context
+	SpokeTransitAttachment *SpokeTransitAttachment
+} {
+	var calls []struct {
+		Ctx                    context.Context
+		SpokeTransitAttachment *SpokeTransitAttachment
+	}
+	mock.lockEditSpokeTransitAttachmentFilters.RLock()
+	calls = mock.calls.EditSpokeTransitAttachmentFilters
+	mock.lockEditSpokeTransitAttachmentFilters.RUnlock()
+	return calls
+}
+
+// EditTransitConnectionASPathPrepend calls EditTransitConnectionASPathPrependFunc.
+func (mock *ClientInterfaceMock) EditTransitConnectionASPathPrepend(transitGatewayPeering *TransitGatewayPeering, prependASPath []string) error {
+	if mock.EditTransitConnectionASPathPrependFunc == nil {
+		panic("ClientInterfaceMock.EditTransitConnectionASPathPrependFunc: method is nil but ClientInterface.EditTransitConnectionASPathPrepend was just called")
+	}
+	callInfo := struct {
+		TransitGatewayPeering *TransitGatewayPeering
+		PrependASPath         []string
+	}{
+		TransitGatewayPeering: transitGatewayPeering,
+		PrependASPath:         prependASPath,
+	}
+	mock.lockEditTransitConnectionASPathPrepend.Lock()
+	mock.calls.EditTransitConnectionASPathPrepend = append(mock.calls.EditTransitConnectionASPathPrepend, callInfo)
+	mock.lockEditTransitConnectionASPathPrepend.Unlock()
+	return mock.EditTransitConnectionASPathPrependFunc(transitGatewayPeering, prependASPath)
+}
+
+// EditTransitConnectionASPathPrependCalls gets all the calls that were made to EditTransitConnectionASPathPrepend.
+// Check the length with:
+//
+//	len(mockedClientInterface.EditTransitConnectionASPathPrependCalls())
+func (mock *ClientInterfaceMock) EditTransitConnectionASPathPrependCalls() []struct {
+	TransitGatewayPeering *TransitGatewayPeering
+	PrependASPath         []string
+} {
+	var calls []struct {
+		TransitGatewayPeering *TransitGatewayPeering
+		PrependASPath         []string
+	}
+	mock.lockEditTransitConnectionASPathPrepend.RLock()
+	calls = mock.calls.EditTransitConnectionASPathPrepend
+	mock.lockEditTransitConnectionASPathPrepend.RUnlock()
+	return calls
+}
+
+// EditTransitConnectionBGPManualAdvertiseCIDRs calls EditTransitConnectionBGPManualAdvertiseCIDRsFunc.
+func (mock *ClientInterfaceMock) EditTransitConnectionBGPManualAdvertiseCIDRs(gwName string, connName string, cidrs []string) error {
+	if mock.EditTransitConnectionBGPManualAdvertiseCIDRsFunc == nil {
+		panic("ClientInterfaceMock.EditTransitConnectionBGPManualAdvertiseCIDRsFunc: method is nil but ClientInterface.EditTransitConnectionBGPManualAdvertiseCIDRs was just called")
+	}
+	callInfo := struct {
+		GwName   string
+		ConnName string
+		Cidrs    []string
+	}{
+		GwName:   gwName,
+		ConnName: connName,
+		Cidrs:    cidrs,
+	}
+	mock.lockEditTransitConnectionBGPManualAdvertiseCIDRs.Lock()
+	mock.calls.EditTransitConnectionBGPManualAdvertiseCIDRs = append(mock.calls.EditTransitConnectionBGPManualAdvertiseCIDRs, callInfo)
+	mock.lockEditTransitConnectionBGPManualAdvertiseCIDRs.Unlock()
+	return mock.EditTransitConnectionBGPManualAdvertiseCIDRsFunc(gwName, connName, cidrs)
+}
+
+// EditTransitConnectionBGPManualAdvertiseCIDRsCalls gets all the calls that were made to EditTransitConnectionBGPManualAdvertiseCIDRs.
+// Check the length with:
+//
+//	len(mockedClientInterface.EditTransitConnectionBGPManualAdvertiseCIDRsCalls())
+func (mock *ClientInterfaceMock) EditTransitConnectionBGPManualAdvertiseCIDRsCalls() []struct {
+	GwName   string
+	ConnName string
+	Cidrs    []string
+} {
+	var calls []struct {
+		GwName   string
+		ConnName string
+		Cidrs    []string
+	}
+	mock.lockEditTransitConnectionBGPManualAdvertiseCIDRs.RLock()
+	calls = mock.calls.EditTransitConnectionBGPManualAdvertiseCIDRs
+	mock.lockEditTransitConnectionBGPManualAdvertiseCIDRs.RUnlock()
+	return calls
+}
+
+// EnableActiveStandby calls EnableActiveStandbyFunc.
+func (mock *ClientInterfaceMock) EnableActiveStandby(transitGateway *TransitVpc) error {
+	if mock.EnableActiveStandbyFunc == nil {
+		panic("ClientInterfaceMock.EnableActiveStandbyFunc: method is nil but ClientInterface.EnableActiveStandby was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockEnableActiveStandby.Lock()
+	mock.calls.EnableActiveStandby = append(mock.calls.EnableActiveStandby, callInfo)
+	mock.lockEnableActiveStandby.Unlock()
+	return mock.EnableActiveStandbyFunc(transitGateway)
+}
+
+// EnableActiveStandbyCalls gets all the calls that were made to EnableActiveStandby.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableActiveStandbyCalls())
+func (mock *ClientInterfaceMock) EnableActiveStandbyCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockEnableActiveStandby.RLock()
+	calls = mock.calls.EnableActiveStandby
+	mock.lockEnableActiveStandby.RUnlock()
+	return calls
+}
+
+// EnableActiveStandbyGatewayGroup calls EnableActiveStandbyGatewayGroupFunc.
+func (mock *ClientInterfaceMock) EnableActiveStandbyGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableActiveStandbyGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.EnableActiveStandbyGatewayGroupFunc: method is nil but ClientInterface.EnableActiveStandbyGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableActiveStandbyGatewayGroup.Lock()
+	mock.calls.EnableActiveStandbyGatewayGroup = append(mock.calls.EnableActiveStandbyGatewayGroup, callInfo)
+	mock.lockEnableActiveStandbyGatewayGroup.Unlock()
+	return mock.EnableActiveStandbyGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableActiveStandbyGatewayGroupCalls gets all the calls that were made to EnableActiveStandbyGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableActiveStandbyGatewayGroupCalls())
+func (mock *ClientInterfaceMock) EnableActiveStandbyGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableActiveStandbyGatewayGroup.RLock()
+	calls = mock.calls.EnableActiveStandbyGatewayGroup
+	mock.lockEnableActiveStandbyGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableActiveStandbyPreemptive calls EnableActiveStandbyPreemptiveFunc.
+func (mock *ClientInterfaceMock) EnableActiveStandbyPreemptive(transitGateway *TransitVpc) error {
+	if mock.EnableActiveStandbyPreemptiveFunc == nil {
+		panic("ClientInterfaceMock.EnableActiveStandbyPreemptiveFunc: method is nil but ClientInterface.EnableActiveStandbyPreemptive was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockEnableActiveStandbyPreemptive.Lock()
+	mock.calls.EnableActiveStandbyPreemptive = append(mock.calls.EnableActiveStandbyPreemptive, callInfo)
+	mock.lockEnableActiveStandbyPreemptive.Unlock()
+	return mock.EnableActiveStandbyPreemptiveFunc(transitGateway)
+}
+
+// EnableActiveStandbyPreemptiveCalls gets all the calls that were made to EnableActiveStandbyPreemptive.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableActiveStandbyPreemptiveCalls())
+func (mock *ClientInterfaceMock) EnableActiveStandbyPreemptiveCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockEnableActiveStandbyPreemptive.RLock()
+	calls = mock.calls.EnableActiveStandbyPreemptive
+	mock.lockEnableActiveStandbyPreemptive.RUnlock()
+	return calls
+}
+
+// EnableActiveStandbyPreemptiveGatewayGroup calls EnableActiveStandbyPreemptiveGatewayGroupFunc.
+func (mock *ClientInterfaceMock) EnableActiveStandbyPreemptiveGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableActiveStandbyPreemptiveGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.EnableActiveStandbyPreemptiveGatewayGroupFunc: method is nil but ClientInterface.EnableActiveStandbyPreemptiveGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableActiveStandbyPreemptiveGatewayGroup.Lock()
+	mock.calls.EnableActiveStandbyPreemptiveGatewayGroup = append(mock.calls.EnableActiveStandbyPreemptiveGatewayGroup, callInfo)
+	mock.lockEnableActiveStandbyPreemptiveGatewayGroup.Unlock()
+	return mock.EnableActiveStandbyPreemptiveGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableActiveStandbyPreemptiveGatewayGroupCalls gets all the calls that were made to EnableActiveStandbyPreemptiveGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableActiveStandbyPreemptiveGatewayGroupCalls())
+func (mock *ClientInterfaceMock) EnableActiveStandbyPreemptiveGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableActiveStandbyPreemptiveGatewayGroup.RLock()
+	calls = mock.calls.EnableActiveStandbyPreemptiveGatewayGroup
+	mock.lockEnableActiveStandbyPreemptiveGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableActiveStandbyPreemptiveSpoke calls EnableActiveStandbyPreemptiveSpokeFunc.
+func (mock *ClientInterfaceMock) EnableActiveStandbyPreemptiveSpoke(spokeGateway *SpokeVpc) error {
+	if mock.EnableActiveStandbyPreemptiveSpokeFunc == nil {
+		panic("ClientInterfaceMock.EnableActiveStandbyPreemptiveSpokeFunc: method is nil but ClientInterface.EnableActiveStandbyPreemptiveSpoke was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+	}{
+		SpokeGateway: spokeGateway,
+	}
+	mock.lockEnableActiveStandbyPreemptiveSpoke.Lock()
+	mock.calls.EnableActiveStandbyPreemptiveSpoke = append(mock.calls.EnableActiveStandbyPreemptiveSpoke, callInfo)
+	mock.lockEnableActiveStandbyPreemptiveSpoke.Unlock()
+	return mock.EnableActiveStandbyPreemptiveSpokeFunc(spokeGateway)
+}
+
+// EnableActiveStandbyPreemptiveSpokeCalls gets all the calls that were made to EnableActiveStandbyPreemptiveSpoke.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableActiveStandbyPreemptiveSpokeCalls())
+func (mock *ClientInterfaceMock) EnableActiveStandbyPreemptiveSpokeCalls() []struct {
+	SpokeGateway *SpokeVpc
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+	}
+	mock.lockEnableActiveStandbyPreemptiveSpoke.RLock()
+	calls = mock.calls.EnableActiveStandbyPreemptiveSpoke
+	mock.lockEnableActiveStandbyPreemptiveSpoke.RUnlock()
+	return calls
+}
+
+// EnableActiveStandbySpoke calls EnableActiveStandbySpokeFunc.
+func (mock *ClientInterfaceMock) EnableActiveStandbySpoke(spokeGateway *SpokeVpc) error {
+	if mock.EnableActiveStandbySpokeFunc == nil {
+		panic("ClientInterfaceMock.EnableActiveStandbySpokeFunc: method is nil but ClientInterface.EnableActiveStandbySpoke was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+	}{
+		SpokeGateway: spokeGateway,
+	}
+	mock.lockEnableActiveStandbySpoke.Lock()
+	mock.calls.EnableActiveStandbySpoke = append(mock.calls.EnableActiveStandbySpoke, callInfo)
+	mock.lockEnableActiveStandbySpoke.Unlock()
+	return mock.EnableActiveStandbySpokeFunc(spokeGateway)
+}
+
+// EnableActiveStandbySpokeCalls gets all the calls that were made to EnableActiveStandbySpoke.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableActiveStandbySpokeCalls())
+func (mock *ClientInterfaceMock) EnableActiveStandbySpokeCalls() []struct {
+	SpokeGateway *SpokeVpc
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+	}
+	mock.lockEnableActiveStandbySpoke.RLock()
+	calls = mock.calls.EnableActiveStandbySpoke
+	mock.lockEnableActiveStandbySpoke.RUnlock()
+	return calls
+}
+
+// EnableAdvertiseTransitCidr calls EnableAdvertiseTransitCidrFunc.
+func (mock *ClientInterfaceMock) EnableAdvertiseTransitCidr(transitGw *TransitVpc) error {
+	if mock.EnableAdvertiseTransitCidrFunc == nil {
+		panic("ClientInterfaceMock.EnableAdvertiseTransitCidrFunc: method is nil but ClientInterface.EnableAdvertiseTransitCidr was just called")
+	}
+	callInfo := struct {
+		TransitGw *TransitVpc
+	}{
+		TransitGw: transitGw,
+	}
+	mock.lockEnableAdvertiseTransitCidr.Lock()
+	mock.calls.EnableAdvertiseTransitCidr = append(mock.calls.EnableAdvertiseTransitCidr, callInfo)
+	mock.lockEnableAdvertiseTransitCidr.Unlock()
+	return mock.EnableAdvertiseTransitCidrFunc(transitGw)
+}
+
+// EnableAdvertiseTransitCidrCalls gets all the calls that were made to EnableAdvertiseTransitCidr.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableAdvertiseTransitCidrCalls())
+func (mock *ClientInterfaceMock) EnableAdvertiseTransitCidrCalls() []struct {
+	TransitGw *TransitVpc
+} {
+	var calls []struct {
+		TransitGw *TransitVpc
+	}
+	mock.lockEnableAdvertiseTransitCidr.RLock()
+	calls = mock.calls.EnableAdvertiseTransitCidr
+	mock.lockEnableAdvertiseTransitCidr.RUnlock()
+	return calls
+}
+
+// EnableAdvertiseTransitCidrGatewayGroup calls EnableAdvertiseTransitCidrGatewayGroupFunc.
+func (mock *ClientInterfaceMock) EnableAdvertiseTransitCidrGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableAdvertiseTransitCidrGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.EnableAdvertiseTransitCidrGatewayGroupFunc: method is nil but ClientInterface.EnableAdvertiseTransitCidrGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableAdvertiseTransitCidrGatewayGroup.Lock()
+	mock.calls.EnableAdvertiseTransitCidrGatewayGroup = append(mock.calls.EnableAdvertiseTransitCidrGatewayGroup, callInfo)
+	mock.lockEnableAdvertiseTransitCidrGatewayGroup.Unlock()
+	return mock.EnableAdvertiseTransitCidrGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableAdvertiseTransitCidrGatewayGroupCalls gets all the calls that were made to EnableAdvertiseTransitCidrGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableAdvertiseTransitCidrGatewayGroupCalls())
+func (mock *ClientInterfaceMock) EnableAdvertiseTransitCidrGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableAdvertiseTransitCidrGatewayGroup.RLock()
+	calls = mock.calls.EnableAdvertiseTransitCidrGatewayGroup
+	mock.lockEnableAdvertiseTransitCidrGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableAutoAdvertiseS2CCidrs calls EnableAutoAdvertiseS2CCidrsFunc.
+func (mock *ClientInterfaceMock) EnableAutoAdvertiseS2CCidrs(gateway *Gateway) error {
+	if mock.EnableAutoAdvertiseS2CCidrsFunc == nil {
+		panic("ClientInterfaceMock.EnableAutoAdvertiseS2CCidrsFunc: method is nil but ClientInterface.EnableAutoAdvertiseS2CCidrs was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableAutoAdvertiseS2CCidrs.Lock()
+	mock.calls.EnableAutoAdvertiseS2CCidrs = append(mock.calls.EnableAutoAdvertiseS2CCidrs, callInfo)
+	mock.lockEnableAutoAdvertiseS2CCidrs.Unlock()
+	return mock.EnableAutoAdvertiseS2CCidrsFunc(gateway)
+}
+
+// EnableAutoAdvertiseS2CCidrsCalls gets all the calls that were made to EnableAutoAdvertiseS2CCidrs.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableAutoAdvertiseS2CCidrsCalls())
+func (mock *ClientInterfaceMock) EnableAutoAdvertiseS2CCidrsCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableAutoAdvertiseS2CCidrs.RLock()
+	calls = mock.calls.EnableAutoAdvertiseS2CCidrs
+	mock.lockEnableAutoAdvertiseS2CCidrs.RUnlock()
+	return calls
+}
+
+// EnableAutoAdvertiseS2CCidrsGatewayGroup calls EnableAutoAdvertiseS2CCidrsGatewayGroupFunc.
+func (mock *ClientInterfaceMock) EnableAutoAdvertiseS2CCidrsGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableAutoAdvertiseS2CCidrsGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.EnableAutoAdvertiseS2CCidrsGatewayGroupFunc: method is nil but ClientInterface.EnableAutoAdvertiseS2CCidrsGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableAutoAdvertiseS2CCidrsGatewayGroup.Lock()
+	mock.calls.EnableAutoAdvertiseS2CCidrsGatewayGroup = append(mock.calls.EnableAutoAdvertiseS2CCidrsGatewayGroup, callInfo)
+	mock.lockEnableAutoAdvertiseS2CCidrsGatewayGroup.Unlock()
+	return mock.EnableAutoAdvertiseS2CCidrsGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableAutoAdvertiseS2CCidrsGatewayGroupCalls gets all the calls that were made to EnableAutoAdvertiseS2CCidrsGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableAutoAdvertiseS2CCidrsGatewayGroupCalls())
+func (mock *ClientInterfaceMock) EnableAutoAdvertiseS2CCidrsGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableAutoAdvertiseS2CCidrsGatewayGroup.RLock()
+	calls = mock.calls.EnableAutoAdvertiseS2CCidrsGatewayGroup
+	mock.lockEnableAutoAdvertiseS2CCidrsGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableConnectedTransit calls EnableConnectedTransitFunc.
+func (mock *ClientInterfaceMock) EnableConnectedTransit(gateway *TransitVpc) error {
+	if mock.EnableConnectedTransitFunc == nil {
+		panic("ClientInterfaceMock.EnableConnectedTransitFunc: method is nil but ClientInterface.EnableConnectedTransit was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableConnectedTransit.Lock()
+	mock.calls.EnableConnectedTransit = append(mock.calls.EnableConnectedTransit, callInfo)
+	mock.lockEnableConnectedTransit.Unlock()
+	return mock.EnableConnectedTransitFunc(gateway)
+}
+
+// EnableConnectedTransitCalls gets all the calls that were made to EnableConnectedTransit.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableConnectedTransitCalls())
+func (mock *ClientInterfaceMock) EnableConnectedTransitCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockEnableConnectedTransit.RLock()
+	calls = mock.calls.EnableConnectedTransit
+	mock.lockEnableConnectedTransit.RUnlock()
+	return calls
+}
+
+// EnableConnectedTransitGatewayGroup calls EnableConnectedTransitGatewayGroupFunc.
+func (mock *ClientInterfaceMock) EnableConnectedTransitGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableConnectedTransitGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.EnableConnectedTransitGatewayGroupFunc: method is nil but ClientInterface.EnableConnectedTransitGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableConnectedTransitGatewayGroup.Lock()
+	mock.calls.EnableConnectedTransitGatewayGroup = append(mock.calls.EnableConnectedTransitGatewayGroup, callInfo)
+	mock.lockEnableConnectedTransitGatewayGroup.Unlock()
+	return mock.EnableConnectedTransitGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableConnectedTransitGatewayGroupCalls gets all the calls that were made to EnableConnectedTransitGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableConnectedTransitGatewayGroupCalls())
+func (mock *ClientInterfaceMock) EnableConnectedTransitGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableConnectedTransitGatewayGroup.RLock()
+	calls = mock.calls.EnableConnectedTransitGatewayGroup
+	mock.lockEnableConnectedTransitGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableCustomizedSNat calls EnableCustomizedSNatFunc.
+func (mock *ClientInterfaceMock) EnableCustomizedSNat(gateway *Gateway) error {
+	if mock.EnableCustomizedSNatFunc == nil {
+		panic("ClientInterfaceMock.EnableCustomizedSNatFunc: method is nil but ClientInterface.EnableCustomizedSNat was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableCustomizedSNat.Lock()
+	mock.calls.EnableCustomizedSNat = append(mock.calls.EnableCustomizedSNat, callInfo)
+	mock.lockEnableCustomizedSNat.Unlock()
+	return mock.EnableCustomizedSNatFunc(gateway)
+}
+
+// EnableCustomizedSNatCalls gets all the calls that were made to EnableCustomizedSNat.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableCustomizedSNatCalls())
+func (mock *ClientInterfaceMock) EnableCustomizedSNatCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableCustomizedSNat.RLock()
+	calls = mock.calls.EnableCustomizedSNat
+	mock.lockEnableCustomizedSNat.RUnlock()
+	return calls
+}
+
+// EnableDeadPeerDetection calls EnableDeadPeerDetectionFunc.
+func (mock *ClientInterfaceMock) EnableDeadPeerDetection(site2cloud *Site2Cloud) error {
+	if mock.EnableDeadPeerDetectionFunc == nil {
+		panic("ClientInterfaceMock.EnableDeadPeerDetectionFunc: method is nil but ClientInterface.EnableDeadPeerDetection was just called")
+	}
+	callInfo := struct {
+		Site2cloud *Site2Cloud
+	}{
+		Site2cloud: site2cloud,
+	}
+	mock.lockEnableDeadPeerDetection.Lock()
+	mock.calls.EnableDeadPeerDetection = append(mock.calls.EnableDeadPeerDetection, callInfo)
+	mock.lockEnableDeadPeerDetection.Unlock()
+	return mock.EnableDeadPeerDetectionFunc(site2cloud)
+}
+
+// EnableDeadPeerDetectionCalls gets all the calls that were made to EnableDeadPeerDetection.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableDeadPeerDetectionCalls())
+func (mock *ClientInterfaceMock) EnableDeadPeerDetectionCalls() []struct {
+	Site2cloud *Site2Cloud
+} {
+	var calls []struct {
+		Site2cloud *Site2Cloud
+	}
+	mock.lockEnableDeadPeerDetection.RLock()
+	calls = mock.calls.EnableDeadPeerDetection
+	mock.lockEnableDeadPeerDetection.RUnlock()
+	return calls
+}
+
+// EnableDistributedFirewalling calls EnableDistributedFirewallingFunc.
+func (mock *ClientInterfaceMock) EnableDistributedFirewalling(ctx context.Context) error {
+	if mock.EnableDistributedFirewallingFunc == nil {
+		panic("ClientInterfaceMock.EnableDistributedFirewallingFunc: method is nil but ClientInterface.EnableDistributedFirewalling was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockEnableDistributedFirewalling.Lock()
+	mock.calls.EnableDistributedFirewalling = append(mock.calls.EnableDistributedFirewalling, callInfo)
+	mock.lockEnableDistributedFirewalling.Unlock()
+	return mock.EnableDistributedFirewallingFunc(ctx)
+}
+
+// EnableDistributedFirewallingCalls gets all the calls that were made to EnableDistributedFirewalling.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableDistributedFirewallingCalls())
+func (mock *ClientInterfaceMock) EnableDistributedFirewallingCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockEnableDistributedFirewalling.RLock()
+	calls = mock.calls.EnableDistributedFirewalling
+	mock.lockEnableDistributedFirewalling.RUnlock()
+	return calls
+}
+
+// EnableEdgeSpokeTransitiveRouting calls EnableEdgeSpokeTransitiveRoutingFunc.
+func (mock *ClientInterfaceMock) EnableEdgeSpokeTransitiveRouting(ctx context.Context, name string) error {
+	if mock.EnableEdgeSpokeTransitiveRoutingFunc == nil {
+		panic("ClientInterfaceMock.EnableEdgeSpokeTransitiveRoutingFunc: method is nil but ClientInterface.EnableEdgeSpokeTransitiveRouting was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+	}{
+		Ctx:  ctx,
+		Name: name,
+	}
+	mock.lockEnableEdgeSpokeTransitiveRouting.Lock()
+	mock.calls.EnableEdgeSpokeTransitiveRouting = append(mock.calls.EnableEdgeSpokeTransitiveRouting, callInfo)
+	mock.lockEnableEdgeSpokeTransitiveRouting.Unlock()
+	return mock.EnableEdgeSpokeTransitiveRoutingFunc(ctx, name)
+}
+
+// EnableEdgeSpokeTransitiveRoutingCalls gets all the calls that were made to EnableEdgeSpokeTransitiveRouting.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableEdgeSpokeTransitiveRoutingCalls())
+func (mock *ClientInterfaceMock) EnableEdgeSpokeTransitiveRoutingCalls() []struct {
+	Ctx  context.Context
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+	}
+	mock.lockEnableEdgeSpokeTransitiveRouting.RLock()
+	calls = mock.calls.EnableEdgeSpokeTransitiveRouting
+	mock.lockEnableEdgeSpokeTransitiveRouting.RUnlock()
+	return calls
+}
+
+// EnableEgressTransitFirenet calls EnableEgressTransitFirenetFunc.
+func (mock *ClientInterfaceMock) EnableEgressTransitFirenet(transitGateway *TransitVpc) error {
+	if mock.EnableEgressTransitFirenetFunc == nil {
+		panic("ClientInterfaceMock.EnableEgressTransitFirenetFunc: method is nil but ClientInterface.EnableEgressTransitFirenet was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockEnableEgressTransitFirenet.Lock()
+	mock.calls.EnableEgressTransitFirenet = append(mock.calls.EnableEgressTransitFirenet, callInfo)
+	mock.lockEnableEgressTransitFirenet.Unlock()
+	return mock.EnableEgressTransitFirenetFunc(transitGateway)
+}
+
+// EnableEgressTransitFirenetCalls gets all the calls that were made to EnableEgressTransitFirenet.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableEgressTransitFirenetCalls())
+func (mock *ClientInterfaceMock) EnableEgressTransitFirenetCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockEnableEgressTransitFirenet.RLock()
+	calls = mock.calls.EnableEgressTransitFirenet
+	mock.lockEnableEgressTransitFirenet.RUnlock()
+	return calls
+}
+
+// EnableEncryptVolume calls EnableEncryptVolumeFunc.
+func (mock *ClientInterfaceMock) EnableEncryptVolume(gateway *Gateway) error {
+	if mock.EnableEncryptVolumeFunc == nil {
+		panic("ClientInterfaceMock.EnableEncryptVolumeFunc: method is nil but ClientInterface.EnableEncryptVolume was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableEncryptVolume.Lock()
+	mock.calls.EnableEncryptVolume = append(mock.calls.EnableEncryptVolume, callInfo)
+	mock.lockEnableEncryptVolume.Unlock()
+	return mock.EnableEncryptVolumeFunc(gateway)
+}
+
+// EnableEncryptVolumeCalls gets all the calls that were made to EnableEncryptVolume.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableEncryptVolumeCalls())
+func (mock *ClientInterfaceMock) EnableEncryptVolumeCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableEncryptVolume.RLock()
+	calls = mock.calls.EnableEncryptVolume
+	mock.lockEnableEncryptVolume.RUnlock()
+	return calls
+}
+
+// EnableFireNetGatewayGroup calls EnableFireNetGatewayGroupFunc.
+func (mock *ClientInterfaceMock) EnableFireNetGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableFireNetGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.EnableFireNetGatewayGroupFunc: method is nil but ClientInterface.EnableFireNetGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableFireNetGatewayGroup.Lock()
+	mock.calls.EnableFireNetGatewayGroup = append(mock.calls.EnableFireNetGatewayGroup, callInfo)
+	mock.lockEnableFireNetGatewayGroup.Unlock()
+	return mock.EnableFireNetGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableFireNetGatewayGroupCalls gets all the calls that were made to EnableFireNetGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableFireNetGatewayGroupCalls())
+func (mock *ClientInterfaceMock) EnableFireNetGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableFireNetGatewayGroup.RLock()
+	calls = mock.calls.EnableFireNetGatewayGroup
+	mock.lockEnableFireNetGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableGatewayFireNetInterfaces calls EnableGatewayFireNetInterfacesFunc.
+func (mock *ClientInterfaceMock) EnableGatewayFireNetInterfaces(gateway *TransitVpc) error {
+	if mock.EnableGatewayFireNetInterfacesFunc == nil {
+		panic("ClientInterfaceMock.EnableGatewayFireNetInterfacesFunc: method is nil but ClientInterface.EnableGatewayFireNetInterfaces was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableGatewayFireNetInterfaces.Lock()
+	mock.calls.EnableGatewayFireNetInterfaces = append(mock.calls.EnableGatewayFireNetInterfaces, callInfo)
+	mock.lockEnableGatewayFireNetInterfaces.Unlock()
+	return mock.EnableGatewayFireNetInterfacesFunc(gateway)
+}
+
+// EnableGatewayFireNetInterfacesCalls gets all the calls that were made to EnableGatewayFireNetInterfaces.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableGatewayFireNetInterfacesCalls())
+func (mock *ClientInterfaceMock) EnableGatewayFireNetInterfacesCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockEnableGatewayFireNetInterfaces.RLock()
+	calls = mock.calls.EnableGatewayFireNetInterfaces
+	mock.lockEnableGatewayFireNetInterfaces.RUnlock()
+	return calls
+}
+
+// EnableGatewayFireNetInterfacesWithGWLB calls EnableGatewayFireNetInterfacesWithGWLBFunc.
+func (mock *ClientInterfaceMock) EnableGatewayFireNetInterfacesWithGWLB(gateway *TransitVpc) error {
+	if mock.EnableGatewayFireNetInterfacesWithGWLBFunc == nil {
+		panic("ClientInterfaceMock.EnableGatewayFireNetInterfacesWithGWLBFunc: method is nil but ClientInterface.EnableGatewayFireNetInterfacesWithGWLB was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableGatewayFireNetInterfacesWithGWLB.Lock()
+	mock.calls.EnableGatewayFireNetInterfacesWithGWLB = append(mock.calls.EnableGatewayFireNetInterfacesWithGWLB, callInfo)
+	mock.lockEnableGatewayFireNetInterfacesWithGWLB.Unlock()
+	return mock.EnableGatewayFireNetInterfacesWithGWLBFunc(gateway)
+}
+
+// EnableGatewayFireNetInterfacesWithGWLBCalls gets all the calls that were made to EnableGatewayFireNetInterfacesWithGWLB.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableGatewayFireNetInterfacesWithGWLBCalls())
+func (mock *ClientInterfaceMock) EnableGatewayFireNetInterfacesWithGWLBCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockEnableGatewayFireNetInterfacesWithGWLB.RLock()
+	calls = mock.calls.EnableGatewayFireNetInterfacesWithGWLB
+	mock.lockEnableGatewayFireNetInterfacesWithGWLB.RUnlock()
+	return calls
+}
+
+// EnableGatewayGroupSNat calls EnableGatewayGroupSNatFunc.
+func (mock *ClientInterfaceMock) EnableGatewayGroupSNat(ctx context.Context, groupName string) error {
+	if mock.EnableGatewayGroupSNatFunc == nil {
+		panic("ClientInterfaceMock.EnableGatewayGroupSNatFunc: method is nil but ClientInterface.EnableGatewayGroupSNat was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableGatewayGroupSNat.Lock()
+	mock.calls.EnableGatewayGroupSNat = append(mock.calls.EnableGatewayGroupSNat, callInfo)
+	mock.lockEnableGatewayGroupSNat.Unlock()
+	return mock.EnableGatewayGroupSNatFunc(ctx, groupName)
+}
+
+// EnableGatewayGroupSNatCalls gets all the calls that were made to EnableGatewayGroupSNat.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableGatewayGroupSNatCalls())
+func (mock *ClientInterfaceMock) EnableGatewayGroupSNatCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableGatewayGroupSNat.RLock()
+	calls = mock.calls.EnableGatewayGroupSNat
+	mock.lockEnableGatewayGroupSNat.RUnlock()
+	return calls
+}
+
+// EnableGatewayGroupVpcDNSServer calls EnableGatewayGroupVpcDNSServerFunc.
+func (mock *ClientInterfaceMock) EnableGatewayGroupVpcDNSServer(ctx context.Context, groupName string) error {
+	if mock.EnableGatewayGroupVpcDNSServerFunc == nil {
+		panic("ClientInterfaceMock.EnableGatewayGroupVpcDNSServerFunc: method is nil but ClientInterface.EnableGatewayGroupVpcDNSServer was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableGatewayGroupVpcDNSServer.Lock()
+	mock.calls.EnableGatewayGroupVpcDNSServer = append(mock.calls.EnableGatewayGroupVpcDNSServer, callInfo)
+	mock.lockEnableGatewayGroupVpcDNSServer.Unlock()
+	return mock.EnableGatewayGroupVpcDNSServerFunc(ctx, groupName)
+}
+
+// EnableGatewayGroupVpcDNSServerCalls gets all the calls that were made to EnableGatewayGroupVpcDNSServer.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableGatewayGroupVpcDNSServerCalls())
+func (mock *ClientInterfaceMock) EnableGatewayGroupVpcDNSServerCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableGatewayGroupVpcDNSServer.RLock()
+	calls = mock.calls.EnableGatewayGroupVpcDNSServer
+	mock.lockEnableGatewayGroupVpcDNSServer.RUnlock()
+	return calls
+}
+
+// EnableGatewayLoadBalancerGatewayGroup calls EnableGatewayLoadBalancerGatewayGroupFunc.
+func (mock *ClientInterfaceMock) EnableGatewayLoadBalancerGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableGatewayLoadBalancerGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.EnableGatewayLoadBalancerGatewayGroupFunc: method is nil but ClientInterface.EnableGatewayLoadBalancerGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableGatewayLoadBalancerGatewayGroup.Lock()
+	mock.calls.EnableGatewayLoadBalancerGatewayGroup = append(mock.calls.EnableGatewayLoadBalancerGatewayGroup, callInfo)
+	mock.lockEnableGatewayLoadBalancerGatewayGroup.Unlock()
+	return mock.EnableGatewayLoadBalancerGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableGatewayLoadBalancerGatewayGroupCalls gets all the calls that were made to EnableGatewayLoadBalancerGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableGatewayLoadBalancerGatewayGroupCalls())
+func (mock *ClientInterfaceMock) EnableGatewayLoadBalancerGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableGatewayLoadBalancerGatewayGroup.RLock()
+	calls = mock.calls.EnableGatewayLoadBalancerGatewayGroup
+	mock.lockEnableGatewayLoadBalancerGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableGlobalVpc calls EnableGlobalVpcFunc.
+func (mock *ClientInterfaceMock) EnableGlobalVpc(gateway *Gateway) error {
+	if mock.EnableGlobalVpcFunc == nil {
+		panic("ClientInterfaceMock.EnableGlobalVpcFunc: method is nil but ClientInterface.EnableGlobalVpc was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableGlobalVpc.Lock()
+	mock.calls.EnableGlobalVpc = append(mock.calls.EnableGlobalVpc, callInfo)
+	mock.lockEnableGlobalVpc.Unlock()
+	return mock.EnableGlobalVpcFunc(gateway)
+}
+
+// EnableGlobalVpcCalls gets all the calls that were made to EnableGlobalVpc.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableGlobalVpcCalls())
+func (mock *ClientInterfaceMock) EnableGlobalVpcCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableGlobalVpc.RLock()
+	calls = mock.calls.EnableGlobalVpc
+	mock.lockEnableGlobalVpc.RUnlock()
+	return calls
+}
+
+// EnableGlobalVpcGatewayGroup calls EnableGlobalVpcGatewayGroupFunc.
+func (mock *ClientInterfaceMock) EnableGlobalVpcGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableGlobalVpcGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.EnableGlobalVpcGatewayGroupFunc: method is nil but ClientInterface.EnableGlobalVpcGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableGlobalVpcGatewayGroup.Lock()
+	mock.calls.EnableGlobalVpcGatewayGroup = append(mock.calls.EnableGlobalVpcGatewayGroup, callInfo)
+	mock.lockEnableGlobalVpcGatewayGroup.Unlock()
+	return mock.EnableGlobalVpcGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableGlobalVpcGatewayGroupCalls gets all the calls that were made to EnableGlobalVpcGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableGlobalVpcGatewayGroupCalls())
+func (mock *ClientInterfaceMock) EnableGlobalVpcGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableGlobalVpcGatewayGroup.RLock()
+	calls = mock.calls.EnableGlobalVpcGatewayGroup
+	mock.lockEnableGlobalVpcGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableGroGso calls EnableGroGsoFunc.
+func (mock *ClientInterfaceMock) EnableGroGso(gateway *Gateway) error {
+	if mock.EnableGroGsoFunc == nil {
+		panic("ClientInterfaceMock.EnableGroGsoFunc: method is nil but ClientInterface.EnableGroGso was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableGroGso.Lock()
+	mock.calls.EnableGroGso = append(mock.calls.EnableGroGso, callInfo)
+	mock.lockEnableGroGso.Unlock()
+	return mock.EnableGroGsoFunc(gateway)
+}
+
+// EnableGroGsoCalls gets all the calls that were made to EnableGroGso.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableGroGsoCalls())
+func (mock *ClientInterfaceMock) EnableGroGsoCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableGroGso.RLock()
+	calls = mock.calls.EnableGroGso
+	mock.lockEnableGroGso.RUnlock()
+	return calls
+}
+
+// EnableGroGsoGatewayGroup calls EnableGroGsoGatewayGroupFunc.
+func (mock *ClientInterfaceMock) EnableGroGsoGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableGroGsoGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.EnableGroGsoGatewayGroupFunc: method is nil but ClientInterface.EnableGroGsoGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableGroGsoGatewayGroup.Lock()
+	mock.calls.EnableGroGsoGatewayGroup = append(mock.calls.EnableGroGsoGatewayGroup, callInfo)
+	mock.lockEnableGroGsoGatewayGroup.Unlock()
+	return mock.EnableGroGsoGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableGroGsoGatewayGroupCalls gets all the calls that were made to EnableGroGsoGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableGroGsoGatewayGroupCalls())
+func (mock *ClientInterfaceMock) EnableGroGsoGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableGroGsoGatewayGroup.RLock()
+	calls = mock.calls.EnableGroGsoGatewayGroup
+	mock.lockEnableGroGsoGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableGuardDutyEnforcement calls EnableGuardDutyEnforcementFunc.
+func (mock *ClientInterfaceMock) EnableGuardDutyEnforcement(gateway *Gateway) error {
+	if mock.EnableGuardDutyEnforcementFunc == nil {
+		panic("ClientInterfaceMock.EnableGuardDutyEnforcementFunc: method is nil but ClientInterface.EnableGuardDutyEnforcement was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableGuardDutyEnforcement.Lock()
+	mock.calls.EnableGuardDutyEnforcement = append(mock.calls.EnableGuardDutyEnforcement, callInfo)
+	mock.lockEnableGuardDutyEnforcement.Unlock()
+	return mock.EnableGuardDutyEnforcementFunc(gateway)
+}
+
+// EnableGuardDutyEnforcementCalls gets all the calls that were made to EnableGuardDutyEnforcement.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableGuardDutyEnforcementCalls())
+func (mock *ClientInterfaceMock) EnableGuardDutyEnforcementCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableGuardDutyEnforcement.RLock()
+	calls = mock.calls.EnableGuardDutyEnforcement
+	mock.lockEnableGuardDutyEnforcement.RUnlock()
+	return calls
+}
+
+// EnableHybridConnectionGatewayGroup calls EnableHybridConnectionGatewayGroupFunc.
+func (mock *ClientInterfaceMock) EnableHybridConnectionGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableHybridConnectionGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.EnableHybridConnectionGatewayGroupFunc: method is nil but ClientInterface.EnableHybridConnectionGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableHybridConnectionGatewayGroup.Lock()
+	mock.calls.EnableHybridConnectionGatewayGroup = append(mock.calls.EnableHybridConnectionGatewayGroup, callInfo)
+	mock.lockEnableHybridConnectionGatewayGroup.Unlock()
+	return mock.EnableHybridConnectionGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableHybridConnectionGatewayGroupCalls gets all the calls that were made to EnableHybridConnectionGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableHybridConnectionGatewayGroupCalls())
+func (mock *ClientInterfaceMock) EnableHybridConnectionGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableHybridConnectionGatewayGroup.RLock()
+	calls = mock.calls.EnableHybridConnectionGatewayGroup
+	mock.lockEnableHybridConnectionGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableIPv6 calls EnableIPv6Func.
+func (mock *ClientInterfaceMock) EnableIPv6(gateway *Gateway) error {
+	if mock.EnableIPv6Func == nil {
+		panic("ClientInterfaceMock.EnableIPv6Func: method is nil but ClientInterface.EnableIPv6 was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableIPv6.Lock()
+	mock.calls.EnableIPv6 = append(mock.calls.EnableIPv6, callInfo)
+	mock.lockEnableIPv6.Unlock()
+	return mock.EnableIPv6Func(gateway)
+}
+
+// EnableIPv6Calls gets all the calls that were made to EnableIPv6.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableIPv6Calls())
+func (mock *ClientInterfaceMock) EnableIPv6Calls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableIPv6.RLock()
+	calls = mock.calls.EnableIPv6
+	mock.lockEnableIPv6.RUnlock()
+	return calls
+}
+
+// EnableIPv6GatewayGroup calls EnableIPv6GatewayGroupFunc.
+func (mock *ClientInterfaceMock) EnableIPv6GatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableIPv6GatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.EnableIPv6GatewayGroupFunc: method is nil but ClientInterface.EnableIPv6GatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableIPv6GatewayGroup.Lock()
+	mock.calls.EnableIPv6GatewayGroup = append(mock.calls.EnableIPv6GatewayGroup, callInfo)
+	mock.lockEnableIPv6GatewayGroup.Unlock()
+	return mock.EnableIPv6GatewayGroupFunc(ctx, groupName)
+}
+
+// EnableIPv6GatewayGroupCalls gets all the calls that were made to EnableIPv6GatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableIPv6GatewayGroupCalls())
+func (mock *ClientInterfaceMock) EnableIPv6GatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableIPv6GatewayGroup.RLock()
+	calls = mock.calls.EnableIPv6GatewayGroup
+	mock.lockEnableIPv6GatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableJumboFrame calls EnableJumboFrameFunc.
+func (mock *ClientInterfaceMock) EnableJumboFrame(gateway *Gateway) error {
+	if mock.EnableJumboFrameFunc == nil {
+		panic("ClientInterfaceMock.EnableJumboFrameFunc: method is nil but ClientInterface.EnableJumboFrame was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableJumboFrame.Lock()
+	mock.calls.EnableJumboFrame = append(mock.calls.EnableJumboFrame, callInfo)
+	mock.lockEnableJumboFrame.Unlock()
+	return mock.EnableJumboFrameFunc(gateway)
+}
+
+// EnableJumboFrameCalls gets all the calls that were made to EnableJumboFrame.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableJumboFrameCalls())
+func (mock *ClientInterfaceMock) EnableJumboFrameCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableJumboFrame.RLock()
+	calls = mock.calls.EnableJumboFrame
+	mock.lockEnableJumboFrame.RUnlock()
+	return calls
+}
+
+// EnableJumboFrameExternalDeviceConn calls EnableJumboFrameExternalDeviceConnFunc.
+func (mock *ClientInterfaceMock) EnableJumboFrameExternalDeviceConn(externalDeviceConn *ExternalDeviceConn) error {
+	if mock.EnableJumboFrameExternalDeviceConnFunc == nil {
+		panic("ClientInterfaceMock.EnableJumboFrameExternalDeviceConnFunc: method is nil but ClientInterface.EnableJumboFrameExternalDeviceConn was just called")
+	}
+	callInfo := struct {
+		ExternalDeviceConn *ExternalDeviceConn
+	}{
+		ExternalDeviceConn: externalDeviceConn,
+	}
+	mock.lockEnableJumboFrameExternalDeviceConn.Lock()
+	mock.calls.EnableJumboFrameExternalDeviceConn = append(mock.calls.EnableJumboFrameExternalDeviceConn, callInfo)
+	mock.lockEnableJumboFrameExternalDeviceConn.Unlock()
+	return mock.EnableJumboFrameExternalDeviceConnFunc(externalDeviceConn)
+}
+
+// EnableJumboFrameExternalDeviceConnCalls gets all the calls that were made to EnableJumboFrameExternalDeviceConn.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableJumboFrameExternalDeviceConnCalls())
+func (mock *ClientInterfaceMock) EnableJumboFrameExternalDeviceConnCalls() []struct {
+	ExternalDeviceConn *ExternalDeviceConn
+} {
+	var calls []struct {
+		ExternalDeviceConn *ExternalDeviceConn
+	}
+	mock.lockEnableJumboFrameExternalDeviceConn.RLock()
+	calls = mock.calls.EnableJumboFrameExternalDeviceConn
+	mock.lockEnableJumboFrameExternalDeviceConn.RUnlock()
+	return calls
+}
+
+// EnableJumboFrameGatewayGroup calls EnableJumboFrameGatewayGroupFunc.
+func (mock *ClientInterfaceMock) EnableJumboFrameGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableJumboFrameGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.EnableJumboFrameGatewayGroupFunc: method is nil but ClientInterface.EnableJumboFrameGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableJumboFrameGatewayGroup.Lock()
+	mock.calls.EnableJumboFrameGatewayGroup = append(mock.calls.EnableJumboFrameGatewayGroup, callInfo)
+	mock.lockEnableJumboFrameGatewayGroup.Unlock()
+	return mock.EnableJumboFrameGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableJumboFrameGatewayGroupCalls gets all the calls that were made to EnableJumboFrameGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableJumboFrameGatewayGroupCalls())
+func (mock *ClientInterfaceMock) EnableJumboFrameGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableJumboFrameGatewayGroup.RLock()
+	calls = mock.calls.EnableJumboFrameGatewayGroup
+	mock.lockEnableJumboFrameGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableMonitorGatewaySubnets calls EnableMonitorGatewaySubnetsFunc.
+func (mock *ClientInterfaceMock) EnableMonitorGatewaySubnets(gwName string, excludedInstances []string) error {
+	if mock.EnableMonitorGatewaySubnetsFunc == nil {
+		panic("ClientInterfaceMock.EnableMonitorGatewaySubnetsFunc: method is nil but ClientInterface.EnableMonitorGatewaySubnets was just called")
+	}
+	callInfo := struct {
+		GwName            string
+		ExcludedInstances []string
+	}{
+		GwName:            gwName,
+		ExcludedInstances: excludedInstances,
+	}
+	mock.lockEnableMonitorGatewaySubnets.Lock()
+	mock.calls.EnableMonitorGatewaySubnets = append(mock.calls.EnableMonitorGatewaySubnets, callInfo)
+	mock.lockEnableMonitorGatewaySubnets.Unlock()
+	return mock.EnableMonitorGatewaySubnetsFunc(gwName, excludedInstances)
+}
+
+// EnableMonitorGatewaySubnetsCalls gets all the calls that were made to EnableMonitorGatewaySubnets.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableMonitorGatewaySubnetsCalls())
+func (mock *ClientInterfaceMock) EnableMonitorGatewaySubnetsCalls() []struct {
+	GwName            string
+	ExcludedInstances []string
+} {
+	var calls []struct {
+		GwName            string
+		ExcludedInstances []string
+	}
+	mock.lockEnableMonitorGatewaySubnets.RLock()
+	calls = mock.calls.EnableMonitorGatewaySubnets
+	mock.lockEnableMonitorGatewaySubnets.RUnlock()
+	return calls
+}
+
+// EnableMultiTierTransitGatewayGroup calls EnableMultiTierTransitGatewayGroupFunc.
+func (mock *ClientInterfaceMock) EnableMultiTierTransitGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableMultiTierTransitGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.EnableMultiTierTransitGatewayGroupFunc: method is nil but ClientInterface.EnableMultiTierTransitGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableMultiTierTransitGatewayGroup.Lock()
+	mock.calls.EnableMultiTierTransitGatewayGroup = append(mock.calls.EnableMultiTierTransitGatewayGroup, callInfo)
+	mock.lockEnableMultiTierTransitGatewayGroup.Unlock()
+	return mock.EnableMultiTierTransitGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableMultiTierTransitGatewayGroupCalls gets all the calls that were made to EnableMultiTierTransitGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableMultiTierTransitGatewayGroupCalls())
+func (mock *ClientInterfaceMock) EnableMultiTierTransitGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableMultiTierTransitGatewayGroup.RLock()
+	calls = mock.calls.EnableMultiTierTransitGatewayGroup
+	mock.lockEnableMultiTierTransitGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableMultitierTransit calls EnableMultitierTransitFunc.
+func (mock *ClientInterfaceMock) EnableMultitierTransit(gwName string) error {
+	if mock.EnableMultitierTransitFunc == nil {
+		panic("ClientInterfaceMock.EnableMultitierTransitFunc: method is nil but ClientInterface.EnableMultitierTransit was just called")
+	}
+	callInfo := struct {
+		GwName string
+	}{
+		GwName: gwName,
+	}
+	mock.lockEnableMultitierTransit.Lock()
+	mock.calls.EnableMultitierTransit = append(mock.calls.EnableMultitierTransit, callInfo)
+	mock.lockEnableMultitierTransit.Unlock()
+	return mock.EnableMultitierTransitFunc(gwName)
+}
+
+// EnableMultitierTransitCalls gets all the calls that were made to EnableMultitierTransit.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableMultitierTransitCalls())
+func (mock *ClientInterfaceMock) EnableMultitierTransitCalls() []struct {
+	GwName string
+} {
+	var calls []struct {
+		GwName string
+	}
+	mock.lockEnableMultitierTransit.RLock()
+	calls = mock.calls.EnableMultitierTransit
+	mock.lockEnableMultitierTransit.RUnlock()
+	return calls
+}
+
+// EnablePeeringHaGateway calls EnablePeeringHaGatewayFunc.
+func (mock *ClientInterfaceMock) EnablePeeringHaGateway(gateway *Gateway) error {
+	if mock.EnablePeeringHaGatewayFunc == nil {
+		panic("ClientInterfaceMock.EnablePeeringHaGatewayFunc: method is nil but ClientInterface.EnablePeeringHaGateway was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnablePeeringHaGateway.Lock()
+	mock.calls.EnablePeeringHaGateway = append(mock.calls.EnablePeeringHaGateway, callInfo)
+	mock.lockEnablePeeringHaGateway.Unlock()
+	return mock.EnablePeeringHaGatewayFunc(gateway)
+}
+
+// EnablePeeringHaGatewayCalls gets all the calls that were made to EnablePeeringHaGateway.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnablePeeringHaGatewayCalls())
+func (mock *ClientInterfaceMock) EnablePeeringHaGatewayCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnablePeeringHaGateway.RLock()
+	calls = mock.calls.EnablePeeringHaGateway
+	mock.lockEnablePeeringHaGateway.RUnlock()
+	return calls
+}
+
+// EnablePrivateVpcDefaultRoute calls EnablePrivateVpcDefaultRouteFunc.
+func (mock *ClientInterfaceMock) EnablePrivateVpcDefaultRoute(gw *Gateway) error {
+	if mock.EnablePrivateVpcDefaultRouteFunc == nil {
+		panic("ClientInterfaceMock.EnablePrivateVpcDefaultRouteFunc: method is nil but ClientInterface.EnablePrivateVpcDefaultRoute was just called")
+	}
+	callInfo := struct {
+		Gw *Gateway
+	}{
+		Gw: gw,
+	}
+	mock.lockEnablePrivateVpcDefaultRoute.Lock()
+	mock.calls.EnablePrivateVpcDefaultRoute = append(mock.calls.EnablePrivateVpcDefaultRoute, callInfo)
+	mock.lockEnablePrivateVpcDefaultRoute.Unlock()
+	return mock.EnablePrivateVpcDefaultRouteFunc(gw)
+}
+
+// EnablePrivateVpcDefaultRouteCalls gets all the calls that were made to EnablePrivateVpcDefaultRoute.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnablePrivateVpcDefaultRouteCalls())
+func (mock *ClientInterfaceMock) EnablePrivateVpcDefaultRouteCalls() []struct {
+	Gw *Gateway
+} {
+	var calls []struct {
+		Gw *Gateway
+	}
+	mock.lockEnablePrivateVpcDefaultRoute.RLock()
+	calls = mock.calls.EnablePrivateVpcDefaultRoute
+	mock.lockEnablePrivateVpcDefaultRoute.RUnlock()
+	return calls
+}
+
+// EnablePrivateVpcDefaultRouteGatewayGroup calls EnablePrivateVpcDefaultRouteGatewayGroupFunc.
+func (mock *ClientInterfaceMock) EnablePrivateVpcDefaultRouteGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnablePrivateVpcDefaultRouteGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.EnablePrivateVpcDefaultRouteGatewayGroupFunc: method is nil but ClientInterface.EnablePrivateVpcDefaultRouteGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnablePrivateVpcDefaultRouteGatewayGroup.Lock()
+	mock.calls.EnablePrivateVpcDefaultRouteGatewayGroup = append(mock.calls.EnablePrivateVpcDefaultRouteGatewayGroup, callInfo)
+	mock.lockEnablePrivateVpcDefaultRouteGatewayGroup.Unlock()
+	return mock.EnablePrivateVpcDefaultRouteGatewayGroupFunc(ctx, groupName)
+}
+
+// EnablePrivateVpcDefaultRouteGatewayGroupCalls gets all the calls that were made to EnablePrivateVpcDefaultRouteGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnablePrivateVpcDefaultRouteGatewayGroupCalls())
+func (mock *ClientInterfaceMock) EnablePrivateVpcDefaultRouteGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnablePrivateVpcDefaultRouteGatewayGroup.RLock()
+	calls = mock.calls.EnablePrivateVpcDefaultRouteGatewayGroup
+	mock.lockEnablePrivateVpcDefaultRouteGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnablePublicSubnetFilteringHAGateway calls EnablePublicSubnetFilteringHAGatewayFunc.
+func (mock *ClientInterfaceMock) EnablePublicSubnetFilteringHAGateway(gateway *Gateway) error {
+	if mock.EnablePublicSubnetFilteringHAGatewayFunc == nil {
+		panic("ClientInterfaceMock.EnablePublicSubnetFilteringHAGatewayFunc: method is nil but ClientInterface.EnablePublicSubnetFilteringHAGateway was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnablePublicSubnetFilteringHAGateway.Lock()
+	mock.calls.EnablePublicSubnetFilteringHAGateway = append(mock.calls.EnablePublicSubnetFilteringHAGateway, callInfo)
+	mock.lockEnablePublicSubnetFilteringHAGateway.Unlock()
+	return mock.EnablePublicSubnetFilteringHAGatewayFunc(gateway)
+}
+
+// EnablePublicSubnetFilteringHAGatewayCalls gets all the calls that were made to EnablePublicSubnetFilteringHAGateway.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnablePublicSubnetFilteringHAGatewayCalls())
+func (mock *ClientInterfaceMock) EnablePublicSubnetFilteringHAGatewayCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnablePublicSubnetFilteringHAGateway.RLock()
+	calls = mock.calls.EnablePublicSubnetFilteringHAGateway
+	mock.lockEnablePublicSubnetFilteringHAGateway.RUnlock()
+	return calls
+}
+
+// EnableS2CRxBalancing calls EnableS2CRxBalancingFunc.
+func (mock *ClientInterfaceMock) EnableS2CRxBalancing(gwName string) error {
+	if mock.EnableS2CRxBalancingFunc == nil {
+		panic("ClientInterfaceMock.EnableS2CRxBalancingFunc: method is nil but ClientInterface.EnableS2CRxBalancing was just called")
+	}
+	callInfo := struct {
+		GwName string
+	}{
+		GwName: gwName,
+	}
+	mock.lockEnableS2CRxBalancing.Lock()
+	mock.calls.EnableS2CRxBalancing = append(mock.calls.EnableS2CRxBalancing, callInfo)
+	mock.lockEnableS2CRxBalancing.Unlock()
+	return mock.EnableS2CRxBalancingFunc(gwName)
+}
+
+// EnableS2CRxBalancingCalls gets all the calls that were made to EnableS2CRxBalancing.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableS2CRxBalancingCalls())
+func (mock *ClientInterfaceMock) EnableS2CRxBalancingCalls() []struct {
+	GwName string
+} {
+	var calls []struct {
+		GwName string
+	}
+	mock.lockEnableS2CRxBalancing.RLock()
+	calls = mock.calls.EnableS2CRxBalancing
+	mock.lockEnableS2CRxBalancing.RUnlock()
+	return calls
+}
+
+// EnableS2cRxBalancingGatewayGroup calls EnableS2cRxBalancingGatewayGroupFunc.
+func (mock *ClientInterfaceMock) EnableS2cRxBalancingGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableS2cRxBalancingGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.EnableS2cRxBalancingGatewayGroupFunc: method is nil but ClientInterface.EnableS2cRxBalancingGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableS2cRxBalancingGatewayGroup.Lock()
+	mock.calls.EnableS2cRxBalancingGatewayGroup = append(mock.calls.EnableS2cRxBalancingGatewayGroup, callInfo)
+	mock.lockEnableS2cRxBalancingGatewayGroup.Unlock()
+	return mock.EnableS2cRxBalancingGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableS2cRxBalancingGatewayGroupCalls gets all the calls that were made to EnableS2cRxBalancingGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableS2cRxBalancingGatewayGroupCalls())
+func (mock *ClientInterfaceMock) EnableS2cRxBalancingGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableS2cRxBalancingGatewayGroup.RLock()
+	calls = mock.calls.EnableS2cRxBalancingGatewayGroup
+	mock.lockEnableS2cRxBalancingGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableSNat calls EnableSNatFunc.
+func (mock *ClientInterfaceMock) EnableSNat(gateway *Gateway) error {
+	if mock.EnableSNatFunc == nil {
+		panic("ClientInterfaceMock.EnableSNatFunc: method is nil but ClientInterface.EnableSNat was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableSNat.Lock()
+	mock.calls.EnableSNat = append(mock.calls.EnableSNat, callInfo)
+	mock.lockEnableSNat.Unlock()
+	return mock.EnableSNatFunc(gateway)
+}
+
+// EnableSNatCalls gets all the calls that were made to EnableSNat.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableSNatCalls())
+func (mock *ClientInterfaceMock) EnableSNatCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableSNat.RLock()
+	calls = mock.calls.EnableSNat
+	mock.lockEnableSNat.RUnlock()
+	return calls
+}
+
+// EnableSegmentation calls EnableSegmentationFunc.
+func (mock *ClientInterfaceMock) EnableSegmentation(transitGateway *TransitVpc) error {
+	if mock.EnableSegmentationFunc == nil {
+		panic("ClientInterfaceMock.EnableSegmentationFunc: method is nil but ClientInterface.EnableSegmentation was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockEnableSegmentation.Lock()
+	mock.calls.EnableSegmentation = append(mock.calls.EnableSegmentation, callInfo)
+	mock.lockEnableSegmentation.Unlock()
+	return mock.EnableSegmentationFunc(transitGateway)
+}
+
+// EnableSegmentationCalls gets all the calls that were made to EnableSegmentation.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableSegmentationCalls())
+func (mock *ClientInterfaceMock) EnableSegmentationCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockEnableSegmentation.RLock()
+	calls = mock.calls.EnableSegmentation
+	mock.lockEnableSegmentation.RUnlock()
+	return calls
+}
+
+// EnableSegmentationGatewayGroup calls EnableSegmentationGatewayGroupFunc.
+func (mock *ClientInterfaceMock) EnableSegmentationGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableSegmentationGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.EnableSegmentationGatewayGroupFunc: method is nil but ClientInterface.EnableSegmentationGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableSegmentationGatewayGroup.Lock()
+	mock.calls.EnableSegmentationGatewayGroup = append(mock.calls.EnableSegmentationGatewayGroup, callInfo)
+	mock.lockEnableSegmentationGatewayGroup.Unlock()
+	return mock.EnableSegmentationGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableSegmentationGatewayGroupCalls gets all the calls that were made to EnableSegmentationGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableSegmentationGatewayGroupCalls())
+func (mock *ClientInterfaceMock) EnableSegmentationGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableSegmentationGatewayGroup.RLock()
+	calls = mock.calls.EnableSegmentationGatewayGroup
+	mock.lockEnableSegmentationGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableSingleAZGateway calls EnableSingleAZGatewayFunc.
+func (mock *ClientInterfaceMock) EnableSingleAZGateway(gateway *Gateway) error {
+	if mock.EnableSingleAZGatewayFunc == nil {
+		panic("ClientInterfaceMock.EnableSingleAZGatewayFunc: method is nil but ClientInterface.EnableSingleAZGateway was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableSingleAZGateway.Lock()
+	mock.calls.EnableSingleAZGateway = append(mock.calls.EnableSingleAZGateway, callInfo)
+	mock.lockEnableSingleAZGateway.Unlock()
+	return mock.EnableSingleAZGatewayFunc(gateway)
+}
+
+// EnableSingleAZGatewayCalls gets all the calls that were made to EnableSingleAZGateway.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableSingleAZGatewayCalls())
+func (mock *ClientInterfaceMock) EnableSingleAZGatewayCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableSingleAZGateway.RLock()
+	calls = mock.calls.EnableSingleAZGateway
+	mock.lockEnableSingleAZGateway.RUnlock()
+	return calls
+}
+
+// EnableSite2CloudEventTriggeredHA calls EnableSite2CloudEventTriggeredHAFunc.
+func (mock *ClientInterfaceMock) EnableSite2CloudEventTriggeredHA(vpcID string, connectionName string) error {
+	if mock.EnableSite2CloudEventTriggeredHAFunc == nil {
+		panic("ClientInterfaceMock.EnableSite2CloudEventTriggeredHAFunc: method is nil but ClientInterface.EnableSite2CloudEventTriggeredHA was just called")
+	}
+	callInfo := struct {
+		VpcID          string
+		ConnectionName string
+	}{
+		VpcID:          vpcID,
+		ConnectionName: connectionName,
+	}
+	mock.lockEnableSite2CloudEventTriggeredHA.Lock()
+	mock.calls.EnableSite2CloudEventTriggeredHA = append(mock.calls.EnableSite2CloudEventTriggeredHA, callInfo)
+	mock.lockEnableSite2CloudEventTriggeredHA.Unlock()
+	return mock.EnableSite2CloudEventTriggeredHAFunc(vpcID, connectionName)
+}
+
+// EnableSite2CloudEventTriggeredHACalls gets all the calls that were made to EnableSite2CloudEventTriggeredHA.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableSite2CloudEventTriggeredHACalls())
+func (mock *ClientInterfaceMock) EnableSite2CloudEventTriggeredHACalls() []struct {
+	VpcID          string
+	ConnectionName string
+} {
+	var calls []struct {
+		VpcID          string
+		ConnectionName string
+	}
+	mock.lockEnableSite2CloudEventTriggeredHA.RLock()
+	calls = mock.calls.EnableSite2CloudEventTriggeredHA
+	mock.lockEnableSite2CloudEventTriggeredHA.RUnlock()
+	return calls
+}
+
+// EnableSite2cloudActiveActive calls EnableSite2cloudActiveActiveFunc.
+func (mock *ClientInterfaceMock) EnableSite2cloudActiveActive(site2cloud *Site2Cloud) error {
+	if mock.EnableSite2cloudActiveActiveFunc == nil {
+		panic("ClientInterfaceMock.EnableSite2cloudActiveActiveFunc: method is nil but ClientInterface.EnableSite2cloudActiveActive was just called")
+	}
+	callInfo := struct {
+		Site2cloud *Site2Cloud
+	}{
+		Site2cloud: site2cloud,
+	}
+	mock.lockEnableSite2cloudActiveActive.Lock()
+	mock.calls.EnableSite2cloudActiveActive = append(mock.calls.EnableSite2cloudActiveActive, callInfo)
+	mock.lockEnableSite2cloudActiveActive.Unlock()
+	return mock.EnableSite2cloudActiveActiveFunc(site2cloud)
+}
+
+// EnableSite2cloudActiveActiveCalls gets all the calls that were made to EnableSite2cloudActiveActive.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableSite2cloudActiveActiveCalls())
+func (mock *ClientInterfaceMock) EnableSite2cloudActiveActiveCalls() []struct {
+	Site2cloud *Site2Cloud
+} {
+	var calls []struct {
+		Site2cloud *Site2Cloud
+	}
+	mock.lockEnableSite2cloudActiveActive.RLock()
+	calls = mock.calls.EnableSite2cloudActiveActive
+	mock.lockEnableSite2cloudActiveActive.RUnlock()
+	return calls
+}
+
+// EnableSite2cloudActiveActiveContext calls EnableSite2cloudActiveActiveContextFunc.
+func (mock *ClientInterfaceMock) EnableSite2cloudActiveActiveContext(ctx context.Context, site2cloud *Site2Cloud) error {
+	if mock.EnableSite2cloudActiveActiveContextFunc == nil {
+		panic("ClientInterfaceMock.EnableSite2cloudActiveActiveContextFunc: method is nil but ClientInterface.EnableSite2cloudActiveActiveContext was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Site2cloud *Site2Cloud
+	}{
+		Ctx:        ctx,
+		Site2cloud: site2cloud,
+	}
+	mock.lockEnableSite2cloudActiveActiveContext.Lock()
+	mock.calls.EnableSite2cloudActiveActiveContext = append(mock.calls.EnableSite2cloudActiveActiveContext, callInfo)
+	mock.lockEnableSite2cloudActiveActiveContext.Unlock()
+	return mock.EnableSite2cloudActiveActiveContextFunc(ctx, site2cloud)
+}
+
+// EnableSite2cloudActiveActiveContextCalls gets all the calls that were made to EnableSite2cloudActiveActiveContext.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableSite2cloudActiveActiveContextCalls())
+func (mock *ClientInterfaceMock) EnableSite2cloudActiveActiveContextCalls() []struct {
+	Ctx        context.Context
+	Site2cloud *Site2Cloud
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Site2cloud *Site2Cloud
+	}
+	mock.lockEnableSite2cloudActiveActiveContext.RLock()
+	calls = mock.calls.EnableSite2cloudActiveActiveContext
+	mock.lockEnableSite2cloudActiveActiveContext.RUnlock()
+	return calls
+}
+
+// EnableSkipPublicRouteUpdate calls EnableSkipPublicRouteUpdateFunc.
+func (mock *ClientInterfaceMock) EnableSkipPublicRouteUpdate(gw *Gateway) error {
+	if mock.EnableSkipPublicRouteUpdateFunc == nil {
+		panic("ClientInterfaceMock.EnableSkipPublicRouteUpdateFunc: method is nil but ClientInterface.EnableSkipPublicRouteUpdate was just called")
+	}
+	callInfo := struct {
+		Gw *Gateway
+	}{
+		Gw: gw,
+	}
+	mock.lockEnableSkipPublicRouteUpdate.Lock()
+	mock.calls.EnableSkipPublicRouteUpdate = append(mock.calls.EnableSkipPublicRouteUpdate, callInfo)
+	mock.lockEnableSkipPublicRouteUpdate.Unlock()
+	return mock.EnableSkipPublicRouteUpdateFunc(gw)
+}
+
+// EnableSkipPublicRouteUpdateCalls gets all the calls that were made to EnableSkipPublicRouteUpdate.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableSkipPublicRouteUpdateCalls())
+func (mock *ClientInterfaceMock) EnableSkipPublicRouteUpdateCalls() []struct {
+	Gw *Gateway
+} {
+	var calls []struct {
+		Gw *Gateway
+	}
+	mock.lockEnableSkipPublicRouteUpdate.RLock()
+	calls = mock.calls.EnableSkipPublicRouteUpdate
+	mock.lockEnableSkipPublicRouteUpdate.RUnlock()
+	return calls
+}
+
+// EnableSkipPublicRouteUpdateGatewayGroup calls EnableSkipPublicRouteUpdateGatewayGroupFunc.
+func (mock *ClientInterfaceMock) EnableSkipPublicRouteUpdateGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableSkipPublicRouteUpdateGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.EnableSkipPublicRouteUpdateGatewayGroupFunc: method is nil but ClientInterface.EnableSkipPublicRouteUpdateGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableSkipPublicRouteUpdateGatewayGroup.Lock()
+	mock.calls.EnableSkipPublicRouteUpdateGatewayGroup = append(mock.calls.EnableSkipPublicRouteUpdateGatewayGroup, callInfo)
+	mock.lockEnableSkipPublicRouteUpdateGatewayGroup.Unlock()
+	return mock.EnableSkipPublicRouteUpdateGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableSkipPublicRouteUpdateGatewayGroupCalls gets all the calls that were made to EnableSkipPublicRouteUpdateGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableSkipPublicRouteUpdateGatewayGroupCalls())
+func (mock *ClientInterfaceMock) EnableSkipPublicRouteUpdateGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableSkipPublicRouteUpdateGatewayGroup.RLock()
+	calls = mock.calls.EnableSkipPublicRouteUpdateGatewayGroup
+	mock.lockEnableSkipPublicRouteUpdateGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableSpokeLearnedCidrsApproval calls EnableSpokeLearnedCidrsApprovalFunc.
+func (mock *ClientInterfaceMock) EnableSpokeLearnedCidrsApproval(gateway *SpokeVpc) error {
+	if mock.EnableSpokeLearnedCidrsApprovalFunc == nil {
+		panic("ClientInterfaceMock.EnableSpokeLearnedCidrsApprovalFunc: method is nil but ClientInterface.EnableSpokeLearnedCidrsApproval was just called")
+	}
+	callInfo := struct {
+		Gateway *SpokeVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableSpokeLearnedCidrsApproval.Lock()
+	mock.calls.EnableSpokeLearnedCidrsApproval = append(mock.calls.EnableSpokeLearnedCidrsApproval, callInfo)
+	mock.lockEnableSpokeLearnedCidrsApproval.Unlock()
+	return mock.EnableSpokeLearnedCidrsApprovalFunc(gateway)
+}
+
+// EnableSpokeLearnedCidrsApprovalCalls gets all the calls that were made to EnableSpokeLearnedCidrsApproval.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableSpokeLearnedCidrsApprovalCalls())
+func (mock *ClientInterfaceMock) EnableSpokeLearnedCidrsApprovalCalls() []struct {
+	Gateway *SpokeVpc
+} {
+	var calls []struct {
+		Gateway *SpokeVpc
+	}
+	mock.lockEnableSpokeLearnedCidrsApproval.RLock()
+	calls = mock.calls.EnableSpokeLearnedCidrsApproval
+	mock.lockEnableSpokeLearnedCidrsApproval.RUnlock()
+	return calls
+}
+
+// EnableSpokeLearnedCidrsApprovalGatewayGroup calls EnableSpokeLearnedCidrsApprovalGatewayGroupFunc.
+func (mock *ClientInterfaceMock) EnableSpokeLearnedCidrsApprovalGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableSpokeLearnedCidrsApprovalGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.EnableSpokeLearnedCidrsApprovalGatewayGroupFunc: method is nil but ClientInterface.EnableSpokeLearnedCidrsApprovalGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableSpokeLearnedCidrsApprovalGatewayGroup.Lock()
+	mock.calls.EnableSpokeLearnedCidrsApprovalGatewayGroup = append(mock.calls.EnableSpokeLearnedCidrsApprovalGatewayGroup, callInfo)
+	mock.lockEnableSpokeLearnedCidrsApprovalGatewayGroup.Unlock()
+	return mock.EnableSpokeLearnedCidrsApprovalGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableSpokeLearnedCidrsApprovalGatewayGroupCalls gets all the calls that were made to EnableSpokeLearnedCidrsApprovalGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableSpokeLearnedCidrsApprovalGatewayGroupCalls())
+func (mock *ClientInterfaceMock) EnableSpokeLearnedCidrsApprovalGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableSpokeLearnedCidrsApprovalGatewayGroup.RLock()
+	calls = mock.calls.EnableSpokeLearnedCidrsApprovalGatewayGroup
+	mock.lockEnableSpokeLearnedCidrsApprovalGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableSpokeMappedSite2CloudForwarding calls EnableSpokeMappedSite2CloudForwardingFunc.
+func (mock *ClientInterfaceMock) EnableSpokeMappedSite2CloudForwarding(site2cloud *Site2Cloud) error {
+	if mock.EnableSpokeMappedSite2CloudForwardingFunc == nil {
+		panic("ClientInterfaceMock.EnableSpokeMappedSite2CloudForwardingFunc: method is nil but ClientInterface.EnableSpokeMappedSite2CloudForwarding was just called")
+	}
+	callInfo := struct {
+		Site2cloud *Site2Cloud
+	}{
+		Site2cloud: site2cloud,
+	}
+	mock.lockEnableSpokeMappedSite2CloudForwarding.Lock()
+	mock.calls.EnableSpokeMappedSite2CloudForwarding = append(mock.calls.EnableSpokeMappedSite2CloudForwarding, callInfo)
+	mock.lockEnableSpokeMappedSite2CloudForwarding.Unlock()
+	return mock.EnableSpokeMappedSite2CloudForwardingFunc(site2cloud)
+}
+
+// EnableSpokeMappedSite2CloudForwardingCalls gets all the calls that were made to EnableSpokeMappedSite2CloudForwarding.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableSpokeMappedSite2CloudForwardingCalls())
+func (mock *ClientInterfaceMock) EnableSpokeMappedSite2CloudForwardingCalls() []struct {
+	Site2cloud *Site2Cloud
+} {
+	var calls []struct {
+		Site2cloud *Site2Cloud
+	}
+	mock.lockEnableSpokeMappedSite2CloudForwarding.RLock()
+	calls = mock.calls.EnableSpokeMappedSite2CloudForwarding
+	mock.lockEnableSpokeMappedSite2CloudForwarding.RUnlock()
+	return calls
+}
+
+// EnableSpokeOnpremRoutePropagation calls EnableSpokeOnpremRoutePropagationFunc.
+func (mock *ClientInterfaceMock) EnableSpokeOnpremRoutePropagation(spokeGateway *SpokeVpc) error {
+	if mock.EnableSpokeOnpremRoutePropagationFunc == nil {
+		panic("ClientInterfaceMock.EnableSpokeOnpremRoutePropagationFunc: method is nil but ClientInterface.EnableSpokeOnpremRoutePropagation was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+	}{
+		SpokeGateway: spokeGateway,
+	}
+	mock.lockEnableSpokeOnpremRoutePropagation.Lock()
+	mock.calls.EnableSpokeOnpremRoutePropagation = append(mock.calls.EnableSpokeOnpremRoutePropagation, callInfo)
+	mock.lockEnableSpokeOnpremRoutePropagation.Unlock()
+	return mock.EnableSpokeOnpremRoutePropagationFunc(spokeGateway)
+}
+
+// EnableSpokeOnpremRoutePropagationCalls gets all the calls that were made to EnableSpokeOnpremRoutePropagation.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableSpokeOnpremRoutePropagationCalls())
+func (mock *ClientInterfaceMock) EnableSpokeOnpremRoutePropagationCalls() []struct {
+	SpokeGateway *SpokeVpc
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+	}
+	mock.lockEnableSpokeOnpremRoutePropagation.RLock()
+	calls = mock.calls.EnableSpokeOnpremRoutePropagation
+	mock.lockEnableSpokeOnpremRoutePropagation.RUnlock()
+	return calls
+}
+
+// EnableSpokeOnpremRoutePropagationGatewayGroup calls EnableSpokeOnpremRoutePropagationGatewayGroupFunc.
+func (mock *ClientInterfaceMock) EnableSpokeOnpremRoutePropagationGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableSpokeOnpremRoutePropagationGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.EnableSpokeOnpremRoutePropagationGatewayGroupFunc: method is nil but ClientInterface.EnableSpokeOnpremRoutePropagationGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableSpokeOnpremRoutePropagationGatewayGroup.Lock()
+	mock.calls.EnableSpokeOnpremRoutePropagationGatewayGroup = append(mock.calls.EnableSpokeOnpremRoutePropagationGatewayGroup, callInfo)
+	mock.lockEnableSpokeOnpremRoutePropagationGatewayGroup.Unlock()
+	return mock.EnableSpokeOnpremRoutePropagationGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableSpokeOnpremRoutePropagationGatewayGroupCalls gets all the calls that were made to EnableSpokeOnpremRoutePropagationGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableSpokeOnpremRoutePropagationGatewayGroupCalls())
+func (mock *ClientInterfaceMock) EnableSpokeOnpremRoutePropagationGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableSpokeOnpremRoutePropagationGatewayGroup.RLock()
+	calls = mock.calls.EnableSpokeOnpremRoutePropagationGatewayGroup
+	mock.lockEnableSpokeOnpremRoutePropagationGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableSpokePreserveAsPath calls EnableSpokePreserveAsPathFunc.
+func (mock *ClientInterfaceMock) EnableSpokePreserveAsPath(spokeGateway *SpokeVpc) error {
+	if mock.EnableSpokePreserveAsPathFunc == nil {
+		panic("ClientInterfaceMock.EnableSpokePreserveAsPathFunc: method is nil but ClientInterface.EnableSpokePreserveAsPath was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+	}{
+		SpokeGateway: spokeGateway,
+	}
+	mock.lockEnableSpokePreserveAsPath.Lock()
+	mock.calls.EnableSpokePreserveAsPath = append(mock.calls.EnableSpokePreserveAsPath, callInfo)
+	mock.lockEnableSpokePreserveAsPath.Unlock()
+	return mock.EnableSpokePreserveAsPathFunc(spokeGateway)
+}
+
+// EnableSpokePreserveAsPathCalls gets all the calls that were made to EnableSpokePreserveAsPath.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableSpokePreserveAsPathCalls())
+func (mock *ClientInterfaceMock) EnableSpokePreserveAsPathCalls() []struct {
+	SpokeGateway *SpokeVpc
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+	}
+	mock.lockEnableSpokePreserveAsPath.RLock()
+	calls = mock.calls.EnableSpokePreserveAsPath
+	mock.lockEnableSpokePreserveAsPath.RUnlock()
+	return calls
+}
+
+// EnableSpokePreserveAsPathGatewayGroup calls EnableSpokePreserveAsPathGatewayGroupFunc.
+func (mock *ClientInterfaceMock) EnableSpokePreserveAsPathGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableSpokePreserveAsPathGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.EnableSpokePreserveAsPathGatewayGroupFunc: method is nil but ClientInterface.EnableSpokePreserveAsPathGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableSpokePreserveAsPathGatewayGroup.Lock()
+	mock.calls.EnableSpokePreserveAsPathGatewayGroup = append(mock.calls.EnableSpokePreserveAsPathGatewayGroup, callInfo)
+	mock.lockEnableSpokePreserveAsPathGatewayGroup.Unlock()
+	return mock.EnableSpokePreserveAsPathGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableSpokePreserveAsPathGatewayGroupCalls gets all the calls that were made to EnableSpokePreserveAsPathGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableSpokePreserveAsPathGatewayGroupCalls())
+func (mock *ClientInterfaceMock) EnableSpokePreserveAsPathGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableSpokePreserveAsPathGatewayGroup.RLock()
+	calls = mock.calls.EnableSpokePreserveAsPathGatewayGroup
+	mock.lockEnableSpokePreserveAsPathGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableSummarizeCidrToTgw calls EnableSummarizeCidrToTgwFunc.
+func (mock *ClientInterfaceMock) EnableSummarizeCidrToTgw(gwName string) error {
+	if mock.EnableSummarizeCidrToTgwFunc == nil {
+		panic("ClientInterfaceMock.EnableSummarizeCidrToTgwFunc: method is nil but ClientInterface.EnableSummarizeCidrToTgw was just called")
+	}
+	callInfo := struct {
+		GwName string
+	}{
+		GwName: gwName,
+	}
+	mock.lockEnableSummarizeCidrToTgw.Lock()
+	mock.calls.EnableSummarizeCidrToTgw = append(mock.calls.EnableSummarizeCidrToTgw, callInfo)
+	mock.lockEnableSummarizeCidrToTgw.Unlock()
+	return mock.EnableSummarizeCidrToTgwFunc(gwName)
+}
+
+// EnableSummarizeCidrToTgwCalls gets all the calls that were made to EnableSummarizeCidrToTgw.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableSummarizeCidrToTgwCalls())
+func (mock *ClientInterfaceMock) EnableSummarizeCidrToTgwCalls() []struct {
+	GwName string
+} {
+	var calls []struct {
+		GwName string
+	}
+	mock.lockEnableSummarizeCidrToTgw.RLock()
+	calls = mock.calls.EnableSummarizeCidrToTgw
+	mock.lockEnableSummarizeCidrToTgw.RUnlock()
+	return calls
+}
+
+// EnableTransitFireNet calls EnableTransitFireNetFunc.
+func (mock *ClientInterfaceMock) EnableTransitFireNet(gateway *Gateway) error {
+	if mock.EnableTransitFireNetFunc == nil {
+		panic("ClientInterfaceMock.EnableTransitFireNetFunc: method is nil but ClientInterface.EnableTransitFireNet was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableTransitFireNet.Lock()
+	mock.calls.EnableTransitFireNet = append(mock.calls.EnableTransitFireNet, callInfo)
+	mock.lockEnableTransitFireNet.Unlock()
+	return mock.EnableTransitFireNetFunc(gateway)
+}
+
+// EnableTransitFireNetCalls gets all the calls that were made to EnableTransitFireNet.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableTransitFireNetCalls())
+func (mock *ClientInterfaceMock) EnableTransitFireNetCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableTransitFireNet.RLock()
+	calls = mock.calls.EnableTransitFireNet
+	mock.lockEnableTransitFireNet.RUnlock()
+	return calls
+}
+
+// EnableTransitFireNetGatewayGroup calls EnableTransitFireNetGatewayGroupFunc.
+func (mock *ClientInterfaceMock) EnableTransitFireNetGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableTransitFireNetGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.EnableTransitFireNetGatewayGroupFunc: method is nil but ClientInterface.EnableTransitFireNetGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableTransitFireNetGatewayGroup.Lock()
+	mock.calls.EnableTransitFireNetGatewayGroup = append(mock.calls.EnableTransitFireNetGatewayGroup, callInfo)
+	mock.lockEnableTransitFireNetGatewayGroup.Unlock()
+	return mock.EnableTransitFireNetGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableTransitFireNetGatewayGroupCalls gets all the calls that were made to EnableTransitFireNetGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableTransitFireNetGatewayGroupCalls())
+func (mock *ClientInterfaceMock) EnableTransitFireNetGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableTransitFireNetGatewayGroup.RLock()
+	calls = mock.calls.EnableTransitFireNetGatewayGroup
+	mock.lockEnableTransitFireNetGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableTransitFireNetWithGWLB calls EnableTransitFireNetWithGWLBFunc.
+func (mock *ClientInterfaceMock) EnableTransitFireNetWithGWLB(gateway *Gateway) error {
+	if mock.EnableTransitFireNetWithGWLBFunc == nil {
+		panic("ClientInterfaceMock.EnableTransitFireNetWithGWLBFunc: method is nil but ClientInterface.EnableTransitFireNetWithGWLB was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableTransitFireNetWithGWLB.Lock()
+	mock.calls.EnableTransitFireNetWithGWLB = append(mock.calls.EnableTransitFireNetWithGWLB, callInfo)
+	mock.lockEnableTransitFireNetWithGWLB.Unlock()
+	return mock.EnableTransitFireNetWithGWLBFunc(gateway)
+}
+
+// EnableTransitFireNetWithGWLBCalls gets all the calls that were made to EnableTransitFireNetWithGWLB.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableTransitFireNetWithGWLBCalls())
+func (mock *ClientInterfaceMock) EnableTransitFireNetWithGWLBCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableTransitFireNetWithGWLB.RLock()
+	calls = mock.calls.EnableTransitFireNetWithGWLB
+	mock.lockEnableTransitFireNetWithGWLB.RUnlock()
+	return calls
+}
+
+// EnableTransitFireNetWithGWLBGatewayGroup calls EnableTransitFireNetWithGWLBGatewayGroupFunc.
+func (mock *ClientInterfaceMock) EnableTransitFireNetWithGWLBGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableTransitFireNetWithGWLBGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.EnableTransitFireNetWithGWLBGatewayGroupFunc: method is nil but ClientInterface.EnableTransitFireNetWithGWLBGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableTransitFireNetWithGWLBGatewayGroup.Lock()
+	mock.calls.EnableTransitFireNetWithGWLBGatewayGroup = append(mock.calls.EnableTransitFireNetWithGWLBGatewayGroup, callInfo)
+	mock.lockEnableTransitFireNetWithGWLBGatewayGroup.Unlock()
+	return mock.EnableTransitFireNetWithGWLBGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableTransitFireNetWithGWLBGatewayGroupCalls gets all the calls that were made to EnableTransitFireNetWithGWLBGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableTransitFireNetWithGWLBGatewayGroupCalls())
+func (mock *ClientInterfaceMock) EnableTransitFireNetWithGWLBGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableTransitFireNetWithGWLBGatewayGroup.RLock()
+	calls = mock.calls.EnableTransitFireNetWithGWLBGatewayGroup
+	mock.lockEnableTransitFireNetWithGWLBGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableTransitLearnedCidrsApproval calls EnableTransitLearnedCidrsApprovalFunc.
+func (mock *ClientInterfaceMock) EnableTransitLearnedCidrsApproval(gateway *TransitVpc) error {
+	if mock.EnableTransitLearnedCidrsApprovalFunc == nil {
+		panic("ClientInterfaceMock.EnableTransitLearnedCidrsApprovalFunc: method is nil but ClientInterface.EnableTransitLearnedCidrsApproval was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableTransitLearnedCidrsApproval.Lock()
+	mock.calls.EnableTransitLearnedCidrsApproval = append(mock.calls.EnableTransitLearnedCidrsApproval, callInfo)
+	mock.lockEnableTransitLearnedCidrsApproval.Unlock()
+	return mock.EnableTransitLearnedCidrsApprovalFunc(gateway)
+}
+
+// EnableTransitLearnedCidrsApprovalCalls gets all the calls that were made to EnableTransitLearnedCidrsApproval.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableTransitLearnedCidrsApprovalCalls())
+func (mock *ClientInterfaceMock) EnableTransitLearnedCidrsApprovalCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockEnableTransitLearnedCidrsApproval.RLock()
+	calls = mock.calls.EnableTransitLearnedCidrsApproval
+	mock.lockEnableTransitLearnedCidrsApproval.RUnlock()
+	return calls
+}
+
+// EnableTransitLearnedCidrsApprovalGatewayGroup calls EnableTransitLearnedCidrsApprovalGatewayGroupFunc.
+func (mock *ClientInterfaceMock) EnableTransitLearnedCidrsApprovalGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableTransitLearnedCidrsApprovalGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.EnableTransitLearnedCidrsApprovalGatewayGroupFunc: method is nil but ClientInterface.EnableTransitLearnedCidrsApprovalGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableTransitLearnedCidrsApprovalGatewayGroup.Lock()
+	mock.calls.EnableTransitLearnedCidrsApprovalGatewayGroup = append(mock.calls.EnableTransitLearnedCidrsApprovalGatewayGroup, callInfo)
+	mock.lockEnableTransitLearnedCidrsApprovalGatewayGroup.Unlock()
+	return mock.EnableTransitLearnedCidrsApprovalGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableTransitLearnedCidrsApprovalGatewayGroupCalls gets all the calls that were made to EnableTransitLearnedCidrsApprovalGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableTransitLearnedCidrsApprovalGatewayGroupCalls())
+func (mock *ClientInterfaceMock) EnableTransitLearnedCidrsApprovalGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableTransitLearnedCidrsApprovalGatewayGroup.RLock()
+	calls = mock.calls.EnableTransitLearnedCidrsApprovalGatewayGroup
+	mock.lockEnableTransitLearnedCidrsApprovalGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableTransitPreserveAsPath calls EnableTransitPreserveAsPathFunc.
+func (mock *ClientInterfaceMock) EnableTransitPreserveAsPath(transitGateway *TransitVpc) error {
+	if mock.EnableTransitPreserveAsPathFunc == nil {
+		panic("ClientInterfaceMock.EnableTransitPreserveAsPathFunc: method is nil but ClientInterface.EnableTransitPreserveAsPath was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockEnableTransitPreserveAsPath.Lock()
+	mock.calls.EnableTransitPreserveAsPath = append(mock.calls.EnableTransitPreserveAsPath, callInfo)
+	mock.lockEnableTransitPreserveAsPath.Unlock()
+	return mock.EnableTransitPreserveAsPathFunc(transitGateway)
+}
+
+// EnableTransitPreserveAsPathCalls gets all the calls that were made to EnableTransitPreserveAsPath.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableTransitPreserveAsPathCalls())
+func (mock *ClientInterfaceMock) EnableTransitPreserveAsPathCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockEnableTransitPreserveAsPath.RLock()
+	calls = mock.calls.EnableTransitPreserveAsPath
+	mock.lockEnableTransitPreserveAsPath.RUnlock()
+	return calls
+}
+
+// EnableTransitPreserveAsPathGatewayGroup calls EnableTransitPreserveAsPathGatewayGroupFunc.
+func (mock *ClientInterfaceMock) EnableTransitPreserveAsPathGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableTransitPreserveAsPathGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.EnableTransitPreserveAsPathGatewayGroupFunc: method is nil but ClientInterface.EnableTransitPreserveAsPathGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableTransitPreserveAsPathGatewayGroup.Lock()
+	mock.calls.EnableTransitPreserveAsPathGatewayGroup = append(mock.calls.EnableTransitPreserveAsPathGatewayGroup, callInfo)
+	mock.lockEnableTransitPreserveAsPathGatewayGroup.Unlock()
+	return mock.EnableTransitPreserveAsPathGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableTransitPreserveAsPathGatewayGroupCalls gets all the calls that were made to EnableTransitPreserveAsPathGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableTransitPreserveAsPathGatewayGroupCalls())
+func (mock *ClientInterfaceMock) EnableTransitPreserveAsPathGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableTransitPreserveAsPathGatewayGroup.RLock()
+	calls = mock.calls.EnableTransitPreserveAsPathGatewayGroup
+	mock.lockEnableTransitPreserveAsPathGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableTransitSummarizeCidrToTgwGatewayGroup calls EnableTransitSummarizeCidrToTgwGatewayGroupFunc.
+func (mock *ClientInterfaceMock) EnableTransitSummarizeCidrToTgwGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableTransitSummarizeCidrToTgwGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.EnableTransitSummarizeCidrToTgwGatewayGroupFunc: method is nil but ClientInterface.EnableTransitSummarizeCidrToTgwGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableTransitSummarizeCidrToTgwGatewayGroup.Lock()
+	mock.calls.EnableTransitSummarizeCidrToTgwGatewayGroup = append(mock.calls.EnableTransitSummarizeCidrToTgwGatewayGroup, callInfo)
+	mock.lockEnableTransitSummarizeCidrToTgwGatewayGroup.Unlock()
+	return mock.EnableTransitSummarizeCidrToTgwGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableTransitSummarizeCidrToTgwGatewayGroupCalls gets all the calls that were made to EnableTransitSummarizeCidrToTgwGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableTransitSummarizeCidrToTgwGatewayGroupCalls())
+func (mock *ClientInterfaceMock) EnableTransitSummarizeCidrToTgwGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableTransitSummarizeCidrToTgwGatewayGroup.RLock()
+	calls = mock.calls.EnableTransitSummarizeCidrToTgwGatewayGroup
+	mock.lockEnableTransitSummarizeCidrToTgwGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableVPNConfig calls EnableVPNConfigFunc.
+func (mock *ClientInterfaceMock) EnableVPNConfig(gateway *Gateway, vpnConfig *VPNConfig) error {
+	if mock.EnableVPNConfigFunc == nil {
+		panic("ClientInterfaceMock.EnableVPNConfigFunc: method is nil but ClientInterface.EnableVPNConfig was just called")
+	}
+	callInfo := struct {
+		Gateway   *Gateway
+		VpnConfig *VPNConfig
+	}{
+		Gateway:   gateway,
+		VpnConfig: vpnConfig,
+	}
+	mock.lockEnableVPNConfig.Lock()
+	mock.calls.EnableVPNConfig = append(mock.calls.EnableVPNConfig, callInfo)
+	mock.lockEnableVPNConfig.Unlock()
+	return mock.EnableVPNConfigFunc(gateway, vpnConfig)
+}
+
+// EnableVPNConfigCalls gets all the calls that were made to EnableVPNConfig.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableVPNConfigCalls())
+func (mock *ClientInterfaceMock) EnableVPNConfigCalls() []struct {
+	Gateway   *Gateway
+	VpnConfig *VPNConfig
+} {
+	var calls []struct {
+		Gateway   *Gateway
+		VpnConfig *VPNConfig
+	}
+	mock.lockEnableVPNConfig.RLock()
+	calls = mock.calls.EnableVPNConfig
+	mock.lockEnableVPNConfig.RUnlock()
+	return calls
+}
+
+// EnableVpcDNSServer calls EnableVpcDNSServerFunc.
+func (mock *ClientInterfaceMock) EnableVpcDNSServer(gateway *Gateway) error {
+	if mock.EnableVpcDNSServerFunc == nil {
+		panic("ClientInterfaceMock.EnableVpcDNSServerFunc: method is nil but ClientInterface.EnableVpcDNSServer was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableVpcDNSServer.Lock()
+	mock.calls.EnableVpcDNSServer = append(mock.calls.EnableVpcDNSServer, callInfo)
+	mock.lockEnableVpcDNSServer.Unlock()
+	return mock.EnableVpcDNSServerFunc(gateway)
+}
+
+// EnableVpcDNSServerCalls gets all the calls that were made to EnableVpcDNSServer.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableVpcDNSServerCalls())
+func (mock *ClientInterfaceMock) EnableVpcDNSServerCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableVpcDNSServer.RLock()
+	calls = mock.calls.EnableVpcDNSServer
+	mock.lockEnableVpcDNSServer.RUnlock()
+	return calls
+}
+
+// EnableVpnNat calls EnableVpnNatFunc.
+func (mock *ClientInterfaceMock) EnableVpnNat(gateway *Gateway) error {
+	if mock.EnableVpnNatFunc == nil {
+		panic("ClientInterfaceMock.EnableVpnNatFunc: method is nil but ClientInterface.EnableVpnNat was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableVpnNat.Lock()
+	mock.calls.EnableVpnNat = append(mock.calls.EnableVpnNat, callInfo)
+	mock.lockEnableVpnNat.Unlock()
+	return mock.EnableVpnNatFunc(gateway)
+}
+
+// EnableVpnNatCalls gets all the calls that were made to EnableVpnNat.
+// Check the length with:
+//
+//	len(mockedClientInterface.EnableVpnNatCalls())
+func (mock *ClientInterfaceMock) EnableVpnNatCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableVpnNat.RLock()
+	calls = mock.calls.EnableVpnNat
+	mock.lockEnableVpnNat.RUnlock()
+	return calls
+}
+
+// GetAccount calls GetAccountFunc.
+func (mock *ClientInterfaceMock) GetAccount(account *Account) (Account, error) {
+	if mock.GetAccountFunc == nil {
+		panic("ClientInterfaceMock.GetAccountFunc: method is nil but ClientInterface.GetAccount was just called")
+	}
+	callInfo := struct {
+		Account *Account
+	}{
+		Account: account,
+	}
+	mock.lockGetAccount.Lock()
+	mock.calls.GetAccount = append(mock.calls.GetAccount, callInfo)
+	mock.lockGetAccount.Unlock()
+	return mock.GetAccountFunc(account)
+}
+
+// GetAccountCalls gets all the calls that were made to GetAccount.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetAccountCalls())
+func (mock *ClientInterfaceMock) GetAccountCalls() []struct {
+	Account *Account
+} {
+	var calls []struct {
+		Account *Account
+	}
+	mock.lockGetAccount.RLock()
+	calls = mock.calls.GetAccount
+	mock.lockGetAccount.RUnlock()
+	return calls
+}
+
+// GetAccountUser calls GetAccountUserFunc.
+func (mock *ClientInterfaceMock) GetAccountUser(user *AccountUser) (*AccountUser, error) {
+	if mock.GetAccountUserFunc == nil {
+		panic("ClientInterfaceMock.GetAccountUserFunc: method is nil but ClientInterface.GetAccountUser was just called")
+	}
+	callInfo := struct {
+		User *AccountUser
+	}{
+		User: user,
+	}
+	mock.lockGetAccountUser.Lock()
+	mock.calls.GetAccountUser = append(mock.calls.GetAccountUser, callInfo)
+	mock.lockGetAccountUser.Unlock()
+	return mock.GetAccountUserFunc(user)
+}
+
+// GetAccountUserCalls gets all the calls that were made to GetAccountUser.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetAccountUserCalls())
+func (mock *ClientInterfaceMock) GetAccountUserCalls() []struct {
+	User *AccountUser
+} {
+	var calls []struct {
+		User *AccountUser
+	}
+	mock.lockGetAccountUser.RLock()
+	calls = mock.calls.GetAccountUser
+	mock.lockGetAccountUser.RUnlock()
+	return calls
+}
+
+// GetBgpLanIPList calls GetBgpLanIPListFunc.
+func (mock *ClientInterfaceMock) GetBgpLanIPList(transitGateway *TransitVpc) (*TransitGatewayBgpLanIpInfo, error) {
+	if mock.GetBgpLanIPListFunc == nil {
+		panic("ClientInterfaceMock.GetBgpLanIPListFunc: method is nil but ClientInterface.GetBgpLanIPList was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockGetBgpLanIPList.Lock()
+	mock.calls.GetBgpLanIPList = append(mock.calls.GetBgpLanIPList, callInfo)
+	mock.lockGetBgpLanIPList.Unlock()
+	return mock.GetBgpLanIPListFunc(transitGateway)
+}
+
+// GetBgpLanIPListCalls gets all the calls that were made to GetBgpLanIPList.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetBgpLanIPListCalls())
+func (mock *ClientInterfaceMock) GetBgpLanIPListCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockGetBgpLanIPList.RLock()
+	calls = mock.calls.GetBgpLanIPList
+	mock.lockGetBgpLanIPList.RUnlock()
+	return calls
+}
+
+// GetCID calls GetCIDFunc.
+func (mock *ClientInterfaceMock) GetCID() string {
+	if mock.GetCIDFunc == nil {
+		panic("ClientInterfaceMock.GetCIDFunc: method is nil but ClientInterface.GetCID was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetCID.Lock()
+	mock.calls.GetCID = append(mock.calls.GetCID, callInfo)
+	mock.lockGetCID.Unlock()
+	return mock.GetCIDFunc()
+}
+
+// GetCIDCalls gets all the calls that were made to GetCID.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetCIDCalls())
+func (mock *ClientInterfaceMock) GetCIDCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetCID.RLock()
+	calls = mock.calls.GetCID
+	mock.lockGetCID.RUnlock()
+	return calls
+}
+
+// GetCaCertificate calls GetCaCertificateFunc.
+func (mock *ClientInterfaceMock) GetCaCertificate(ctx context.Context) (*ProxyCaConfig, error) {
+	if mock.GetCaCertificateFunc == nil {
+		panic("ClientInterfaceMock.GetCaCertificateFunc: method is nil but ClientInterface.GetCaCertificate was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetCaCertificate.Lock()
+	mock.calls.GetCaCertificate = append(mock.calls.GetCaCertificate, callInfo)
+	mock.lockGetCaCertificate.Unlock()
+	return mock.GetCaCertificateFunc(ctx)
+}
+
+// GetCaCertificateCalls gets all the calls that were made to GetCaCertificate.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetCaCertificateCalls())
+func (mock *ClientInterfaceMock) GetCaCertificateCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetCaCertificate.RLock()
+	calls = mock.calls.GetCaCertificate
+	mock.lockGetCaCertificate.RUnlock()
+	return calls
+}
+
+// GetCompatibleImageVersion calls GetCompatibleImageVersionFunc.
+func (mock *ClientInterfaceMock) GetCompatibleImageVersion(ctx context.Context, cloudType int, softwareVersion string) (string, error) {
+	if mock.GetCompatibleImageVersionFunc == nil {
+		panic("ClientInterfaceMock.GetCompatibleImageVersionFunc: method is nil but ClientInterface.GetCompatibleImageVersion was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		CloudType       int
+		SoftwareVersion string
+	}{
+		Ctx:             ctx,
+		CloudType:       cloudType,
+		SoftwareVersion: softwareVersion,
+	}
+	mock.lockGetCompatibleImageVersion.Lock()
+	mock.calls.GetCompatibleImageVersion = append(mock.calls.GetCompatibleImageVersion, callInfo)
+	mock.lockGetCompatibleImageVersion.Unlock()
+	return mock.GetCompatibleImageVersionFunc(ctx, cloudType, softwareVersion)
+}
+
+// GetCompatibleImageVersionCalls gets all the calls that were made to GetCompatibleImageVersion.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetCompatibleImageVersionCalls())
+func (mock *ClientInterfaceMock) GetCompatibleImageVersionCalls() []struct {
+	Ctx             context.Context
+	CloudType       int
+	SoftwareVersion string
+} {
+	var calls []struct {
+		Ctx             context.Context
+		CloudType       int
+		SoftwareVersion string
+	}
+	mock.lockGetCompatibleImageVersion.RLock()
+	calls = mock.calls.GetCompatibleImageVersion
+	mock.lockGetCompatibleImageVersion.RUnlock()
+	return calls
+}
+
+// GetControllerIP calls GetControllerIPFunc.
+func (mock *ClientInterfaceMock) GetControllerIP() string {
+	if mock.GetControllerIPFunc == nil {
+		panic("ClientInterfaceMock.GetControllerIPFunc: method is nil but ClientInterface.GetControllerIP was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetControllerIP.Lock()
+	mock.calls.GetControllerIP = append(mock.calls.GetControllerIP, callInfo)
+	mock.lockGetControllerIP.Unlock()
+	return mock.GetControllerIPFunc()
+}
+
+// GetControllerIPCalls gets all the calls that were made to GetControllerIP.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetControllerIPCalls())
+func (mock *ClientInterfaceMock) GetControllerIPCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetControllerIP.RLock()
+	calls = mock.calls.GetControllerIP
+	mock.lockGetControllerIP.RUnlock()
+	return calls
+}
+
+// GetDCFAttachmentPoint calls GetDCFAttachmentPointFunc.
+func (mock *ClientInterfaceMock) GetDCFAttachmentPoint(ctx context.Context, name string) (*AttachmentPointResp, error) {
+	if mock.GetDCFAttachmentPointFunc == nil {
+		panic("ClientInterfaceMock.GetDCFAttachmentPointFunc: method is nil but ClientInterface.GetDCFAttachmentPoint was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+	}{
+		Ctx:  ctx,
+		Name: name,
+	}
+	mock.lockGetDCFAttachmentPoint.Lock()
+	mock.calls.GetDCFAttachmentPoint = append(mock.calls.GetDCFAttachmentPoint, callInfo)
+	mock.lockGetDCFAttachmentPoint.Unlock()
+	return mock.GetDCFAttachmentPointFunc(ctx, name)
+}
+
+// GetDCFAttachmentPointCalls gets all the calls that were made to GetDCFAttachmentPoint.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetDCFAttachmentPointCalls())
+func (mock *ClientInterfaceMock) GetDCFAttachmentPointCalls() []struct {
+	Ctx  context.Context
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+	}
+	mock.lockGetDCFAttachmentPoint.RLock()
+	calls = mock.calls.GetDCFAttachmentPoint
+	mock.lockGetDCFAttachmentPoint.RUnlock()
+	return calls
+}
+
+// GetDCFMitmCa calls GetDCFMitmCaFunc.
+func (mock *ClientInterfaceMock) GetDCFMitmCa(ctx context.Context, caID string) (*MitmCaResponse, error) {
+	if mock.GetDCFMitmCaFunc == nil {
+		panic("ClientInterfaceMock.GetDCFMitmCaFunc: method is nil but ClientInterface.GetDCFMitmCa was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		CaID string
+	}{
+		Ctx:  ctx,
+		CaID: caID,
+	}
+	mock.lockGetDCFMitmCa.Lock()
+	mock.calls.GetDCFMitmCa = append(mock.calls.GetDCFMitmCa, callInfo)
+	mock.lockGetDCFMitmCa.Unlock()
+	return mock.GetDCFMitmCaFunc(ctx, caID)
+}
+
+// GetDCFMitmCaCalls gets all the calls that were made to GetDCFMitmCa.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetDCFMitmCaCalls())
+func (mock *ClientInterfaceMock) GetDCFMitmCaCalls() []struct {
+	Ctx  context.Context
+	CaID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		CaID string
+	}
+	mock.lockGetDCFMitmCa.RLock()
+	calls = mock.calls.GetDCFMitmCa
+	mock.lockGetDCFMitmCa.RUnlock()
+	return calls
+}
+
+// GetDCFPolicyBlock calls GetDCFPolicyBlockFunc.
+func (mock *ClientInterfaceMock) GetDCFPolicyBlock(ctx context.Context, uuid string) (*DCFPolicyBlock, error) {
+	if mock.GetDCFPolicyBlockFunc == nil {
+		panic("ClientInterfaceMock.GetDCFPolicyBlockFunc: method is nil but ClientInterface.GetDCFPolicyBlock was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		UUID string
+	}{
+		Ctx:  ctx,
+		UUID: uuid,
+	}
+	mock.lockGetDCFPolicyBlock.Lock()
+	mock.calls.GetDCFPolicyBlock = append(mock.calls.GetDCFPolicyBlock, callInfo)
+	mock.lockGetDCFPolicyBlock.Unlock()
+	return mock.GetDCFPolicyBlockFunc(ctx, uuid)
+}
+
+// GetDCFPolicyBlockCalls gets all the calls that were made to GetDCFPolicyBlock.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetDCFPolicyBlockCalls())
+func (mock *ClientInterfaceMock) GetDCFPolicyBlockCalls() []struct {
+	Ctx  context.Context
+	UUID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		UUID string
+	}
+	mock.lockGetDCFPolicyBlock.RLock()
+	calls = mock.calls.GetDCFPolicyBlock
+	mock.lockGetDCFPolicyBlock.RUnlock()
+	return calls
+}
+
+// GetDCFPolicyList calls GetDCFPolicyListFunc.
+func (mock *ClientInterfaceMock) GetDCFPolicyList(ctx context.Context, uuid string) (*DCFPolicyList, error) {
+	if mock.GetDCFPolicyListFunc == nil {
+		panic("ClientInterfaceMock.GetDCFPolicyListFunc: method is nil but ClientInterface.GetDCFPolicyList was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		UUID string
+	}{
+		Ctx:  ctx,
+		UUID: uuid,
+	}
+	mock.lockGetDCFPolicyList.Lock()
+	mock.calls.GetDCFPolicyList = append(mock.calls.GetDCFPolicyList, callInfo)
+	mock.lockGetDCFPolicyList.Unlock()
+	return mock.GetDCFPolicyListFunc(ctx, uuid)
+}
+
+// GetDCFPolicyListCalls gets all the calls that were made to GetDCFPolicyList.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetDCFPolicyListCalls())
+func (mock *ClientInterfaceMock) GetDCFPolicyListCalls() []struct {
+	Ctx  context.Context
+	UUID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		UUID string
+	}
+	mock.lockGetDCFPolicyList.RLock()
+	calls = mock.calls.GetDCFPolicyList
+	mock.lockGetDCFPolicyList.RUnlock()
+	return calls
+}
+
+// GetDCFTrustBundleByID calls GetDCFTrustBundleByIDFunc.
+func (mock *ClientInterfaceMock) GetDCFTrustBundleByID(ctx context.Context, bundleUUID string) (*DCFTrustBundle, error) {
+	if mock.GetDCFTrustBundleByIDFunc == nil {
+		panic("ClientInterfaceMock.GetDCFTrustBundleByIDFunc: method is nil but ClientInterface.GetDCFTrustBundleByID was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		BundleUUID string
+	}{
+		Ctx:        ctx,
+		BundleUUID: bundleUUID,
+	}
+	mock.lockGetDCFTrustBundleByID.Lock()
+	mock.calls.GetDCFTrustBundleByID = append(mock.calls.GetDCFTrustBundleByID, callInfo)
+	mock.lockGetDCFTrustBundleByID.Unlock()
+	return mock.GetDCFTrustBundleByIDFunc(ctx, bundleUUID)
+}
+
+// GetDCFTrustBundleByIDCalls gets all the calls that were made to GetDCFTrustBundleByID.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetDCFTrustBundleByIDCalls())
+func (mock *ClientInterfaceMock) GetDCFTrustBundleByIDCalls() []struct {
+	Ctx        context.Context
+	BundleUUID string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		BundleUUID string
+	}
+	mock.lockGetDCFTrustBundleByID.RLock()
+	calls = mock.calls.GetDCFTrustBundleByID
+	mock.lockGetDCFTrustBundleByID.RUnlock()
+	return calls
+}
+
+// GetDCFTrustBundleByName calls GetDCFTrustBundleByNameFunc.
+func (mock *ClientInterfaceMock) GetDCFTrustBundleByName(ctx context.Context, bundleName string) (*DCFTrustBundle, error) {
+	if mock.GetDCFTrustBundleByNameFunc == nil {
+		panic("ClientInterfaceMock.GetDCFTrustBundleByNameFunc: method is nil but ClientInterface.GetDCFTrustBundleByName was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		BundleName string
+	}{
+		Ctx:        ctx,
+		BundleName: bundleName,
+	}
+	mock.lockGetDCFTrustBundleByName.Lock()
+	mock.calls.GetDCFTrustBundleByName = append(mock.calls.GetDCFTrustBundleByName, callInfo)
+	mock.lockGetDCFTrustBundleByName.Unlock()
+	return mock.GetDCFTrustBundleByNameFunc(ctx, bundleName)
+}
+
+// GetDCFTrustBundleByNameCalls gets all the calls that were made to GetDCFTrustBundleByName.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetDCFTrustBundleByNameCalls())
+func (mock *ClientInterfaceMock) GetDCFTrustBundleByNameCalls() []struct {
+	Ctx        context.Context
+	BundleName string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		BundleName string
+	}
+	mock.lockGetDCFTrustBundleByName.RLock()
+	calls = mock.calls.GetDCFTrustBundleByName
+	mock.lockGetDCFTrustBundleByName.RUnlock()
+	return calls
+}
+
+// GetDefaultIpsProfile calls GetDefaultIpsProfileFunc.
+func (mock *ClientInterfaceMock) GetDefaultIpsProfile(ctx context.Context) (*DefaultIpsProfileResponse, error) {
+	if mock.GetDefaultIpsProfileFunc == nil {
+		panic("ClientInterfaceMock.GetDefaultIpsProfileFunc: method is nil but ClientInterface.GetDefaultIpsProfile was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetDefaultIpsProfile.Lock()
+	mock.calls.GetDefaultIpsProfile = append(mock.calls.GetDefaultIpsProfile, callInfo)
+	mock.lockGetDefaultIpsProfile.Unlock()
+	return mock.GetDefaultIpsProfileFunc(ctx)
+}
+
+// GetDefaultIpsProfileCalls gets all the calls that were made to GetDefaultIpsProfile.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetDefaultIpsProfileCalls())
+func (mock *ClientInterfaceMock) GetDefaultIpsProfileCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetDefaultIpsProfile.RLock()
+	calls = mock.calls.GetDefaultIpsProfile
+	mock.lockGetDefaultIpsProfile.RUnlock()
+	return calls
+}
+
+// GetDefaultTagsConfig calls GetDefaultTagsConfigFunc.
+func (mock *ClientInterfaceMock) GetDefaultTagsConfig() *DefaultTagsConfig {
+	if mock.GetDefaultTagsConfigFunc == nil {
+		panic("ClientInterfaceMock.GetDefaultTagsConfigFunc: method is nil but ClientInterface.GetDefaultTagsConfig was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetDefaultTagsConfig.Lock()
+	mock.calls.GetDefaultTagsConfig = append(mock.calls.GetDefaultTagsConfig, callInfo)
+	mock.lockGetDefaultTagsConfig.Unlock()
+	return mock.GetDefaultTagsConfigFunc()
+}
+
+// GetDefaultTagsConfigCalls gets all the calls that were made to GetDefaultTagsConfig.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetDefaultTagsConfigCalls())
+func (mock *ClientInterfaceMock) GetDefaultTagsConfigCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetDefaultTagsConfig.RLock()
+	calls = mock.calls.GetDefaultTagsConfig
+	mock.lockGetDefaultTagsConfig.RUnlock()
+	return calls
+}
+
+// GetDistributedFirewallingDefaultActionRule calls GetDistributedFirewallingDefaultActionRuleFunc.
+func (mock *ClientInterfaceMock) GetDistributedFirewallingDefaultActionRule(ctx context.Context) (*DistributedFirewallingDefaultActionRule, error) {
+	if mock.GetDistributedFirewallingDefaultActionRuleFunc == nil {
+		panic("ClientInterfaceMock.GetDistributedFirewallingDefaultActionRuleFunc: method is nil but ClientInterface.GetDistributedFirewallingDefaultActionRule was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetDistributedFirewallingDefaultActionRule.Lock()
+	mock.calls.GetDistributedFirewallingDefaultActionRule = append(mock.calls.GetDistributedFirewallingDefaultActionRule, callInfo)
+	mock.lockGetDistributedFirewallingDefaultActionRule.Unlock()
+	return mock.GetDistributedFirewallingDefaultActionRuleFunc(ctx)
+}
+
+// GetDistributedFirewallingDefaultActionRuleCalls gets all the calls that were made to GetDistributedFirewallingDefaultActionRule.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetDistributedFirewallingDefaultActionRuleCalls())
+func (mock *ClientInterfaceMock) GetDistributedFirewallingDefaultActionRuleCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetDistributedFirewallingDefaultActionRule.RLock()
+	calls = mock.calls.GetDistributedFirewallingDefaultActionRule
+	mock.lockGetDistributedFirewallingDefaultActionRule.RUnlock()
+	return calls
+}
+
+// GetDistributedFirewallingDeploymentPolicy calls GetDistributedFirewallingDeploymentPolicyFunc.
+func (mock *ClientInterfaceMock) GetDistributedFirewallingDeploymentPolicy(ctx context.Context) (*DistributedFirewallingDeploymentPolicy, error) {
+	if mock.GetDistributedFirewallingDeploymentPolicyFunc == nil {
+		panic("ClientInterfaceMock.GetDistributedFirewallingDeploymentPolicyFunc: method is nil but ClientInterface.GetDistributedFirewallingDeploymentPolicy was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetDistributedFirewallingDeploymentPolicy.Lock()
+	mock.calls.GetDistributedFirewallingDeploymentPolicy = append(mock.calls.GetDistributedFirewallingDeploymentPolicy, callInfo)
+	mock.lockGetDistributedFirewallingDeploymentPolicy.Unlock()
+	return mock.GetDistributedFirewallingDeploymentPolicyFunc(ctx)
+}
+
+// GetDistributedFirewallingDeploymentPolicyCalls gets all the calls that were made to GetDistributedFirewallingDeploymentPolicy.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetDistributedFirewallingDeploymentPolicyCalls())
+func (mock *ClientInterfaceMock) GetDistributedFirewallingDeploymentPolicyCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetDistributedFirewallingDeploymentPolicy.RLock()
+	calls = mock.calls.GetDistributedFirewallingDeploymentPolicy
+	mock.lockGetDistributedFirewallingDeploymentPolicy.RUnlock()
+	return calls
+}
+
+// GetDistributedFirewallingIntraVpc calls GetDistributedFirewallingIntraVpcFunc.
+func (mock *ClientInterfaceMock) GetDistributedFirewallingIntraVpc(ctx context.Context) (*DistributedFirewallingIntraVpcList, error) {
+	if mock.GetDistributedFirewallingIntraVpcFunc == nil {
+		panic("ClientInterfaceMock.GetDistributedFirewallingIntraVpcFunc: method is nil but ClientInterface.GetDistributedFirewallingIntraVpc was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetDistributedFirewallingIntraVpc.Lock()
+	mock.calls.GetDistributedFirewallingIntraVpc = append(mock.calls.GetDistributedFirewallingIntraVpc, callInfo)
+	mock.lockGetDistributedFirewallingIntraVpc.Unlock()
+	return mock.GetDistributedFirewallingIntraVpcFunc(ctx)
+}
+
+// GetDistributedFirewallingIntraVpcCalls gets all the calls that were made to GetDistributedFirewallingIntraVpc.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetDistributedFirewallingIntraVpcCalls())
+func (mock *ClientInterfaceMock) GetDistributedFirewallingIntraVpcCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetDistributedFirewallingIntraVpc.RLock()
+	calls = mock.calls.GetDistributedFirewallingIntraVpc
+	mock.lockGetDistributedFirewallingIntraVpc.RUnlock()
+	return calls
+}
+
+// GetDistributedFirewallingPolicyList calls GetDistributedFirewallingPolicyListFunc.
+func (mock *ClientInterfaceMock) GetDistributedFirewallingPolicyList(ctx context.Context) (*DistributedFirewallingPolicyList, error) {
+	if mock.GetDistributedFirewallingPolicyListFunc == nil {
+		panic("ClientInterfaceMock.GetDistributedFirewallingPolicyListFunc: method is nil but ClientInterface.GetDistributedFirewallingPolicyList was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetDistributedFirewallingPolicyList.Lock()
+	mock.calls.GetDistributedFirewallingPolicyList = append(mock.calls.GetDistributedFirewallingPolicyList, callInfo)
+	mock.lockGetDistributedFirewallingPolicyList.Unlock()
+	return mock.GetDistributedFirewallingPolicyListFunc(ctx)
+}
+
+// GetDistributedFirewallingPolicyListCalls gets all the calls that were made to GetDistributedFirewallingPolicyList.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetDistributedFirewallingPolicyListCalls())
+func (mock *ClientInterfaceMock) GetDistributedFirewallingPolicyListCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetDistributedFirewallingPolicyList.RLock()
+	calls = mock.calls.GetDistributedFirewallingPolicyList
+	mock.lockGetDistributedFirewallingPolicyList.RUnlock()
+	return calls
+}
+
+// GetDistributedFirewallingStatus calls GetDistributedFirewallingStatusFunc.
+func (mock *ClientInterfaceMock) GetDistributedFirewallingStatus(ctx context.Context) (*DistributedFirewallingConfig, error) {
+	if mock.GetDistributedFirewallingStatusFunc == nil {
+		panic("ClientInterfaceMock.GetDistributedFirewallingStatusFunc: method is nil but ClientInterface.GetDistributedFirewallingStatus was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetDistributedFirewallingStatus.Lock()
+	mock.calls.GetDistributedFirewallingStatus = append(mock.calls.GetDistributedFirewallingStatus, callInfo)
+	mock.lockGetDistributedFirewallingStatus.Unlock()
+	return mock.GetDistributedFirewallingStatusFunc(ctx)
+}
+
+// GetDistributedFirewallingStatusCalls gets all the calls that were made to GetDistributedFirewallingStatus.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetDistributedFirewallingStatusCalls())
+func (mock *ClientInterfaceMock) GetDistributedFirewallingStatusCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetDistributedFirewallingStatus.RLock()
+	calls = mock.calls.GetDistributedFirewallingStatus
+	mock.lockGetDistributedFirewallingStatus.RUnlock()
+	return calls
+}
+
+// GetEdgeCSP calls GetEdgeCSPFunc.
+func (mock *ClientInterfaceMock) GetEdgeCSP(ctx context.Context, gwName string) (*EdgeCSPResp, error) {
+	if mock.GetEdgeCSPFunc == nil {
+		panic("ClientInterfaceMock.GetEdgeCSPFunc: method is nil but ClientInterface.GetEdgeCSP was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		GwName string
+	}{
+		Ctx:    ctx,
+		GwName: gwName,
+	}
+	mock.lockGetEdgeCSP.Lock()
+	mock.calls.GetEdgeCSP = append(mock.calls.GetEdgeCSP, callInfo)
+	mock.lockGetEdgeCSP.Unlock()
+	return mock.GetEdgeCSPFunc(ctx, gwName)
+}
+
+// GetEdgeCSPCalls gets all the calls that were made to GetEdgeCSP.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetEdgeCSPCalls())
+func (mock *ClientInterfaceMock) GetEdgeCSPCalls() []struct {
+	Ctx    context.Context
+	GwName string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		GwName string
+	}
+	mock.lockGetEdgeCSP.RLock()
+	calls = mock.calls.GetEdgeCSP
+	mock.lockGetEdgeCSP.RUnlock()
+	return calls
+}
+
+// GetEdgeCSPHa calls GetEdgeCSPHaFunc.
+func (mock *ClientInterfaceMock) GetEdgeCSPHa(ctx context.Context, gwName string) (*EdgeCSPHaResp, error) {
+	if mock.GetEdgeCSPHaFunc == nil {
+		panic("ClientInterfaceMock.GetEdgeCSPHaFunc: method is nil but ClientInterface.GetEdgeCSPHa was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		GwName string
+	}{
+		Ctx:    ctx,
+		GwName: gwName,
+	}
+	mock.lockGetEdgeCSPHa.Lock()
+	mock.calls.GetEdgeCSPHa = append(mock.calls.GetEdgeCSPHa, callInfo)
+	mock.lockGetEdgeCSPHa.Unlock()
+	return mock.GetEdgeCSPHaFunc(ctx, gwName)
+}
+
+// GetEdgeCSPHaCalls gets all the calls that were made to GetEdgeCSPHa.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetEdgeCSPHaCalls())
+func (mock *ClientInterfaceMock) GetEdgeCSPHaCalls() []struct {
+	Ctx    context.Context
+	GwName string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		GwName string
+	}
+	mock.lockGetEdgeCSPHa.RLock()
+	calls = mock.calls.GetEdgeCSPHa
+	mock.lockGetEdgeCSPHa.RUnlock()
+	return calls
+}
+
+// GetEdgeEquinix calls GetEdgeEquinixFunc.
+func (mock *ClientInterfaceMock) GetEdgeEquinix(ctx context.Context, gwName string) (*EdgeEquinixResp, error) {
+	if mock.GetEdgeEquinixFunc == nil {
+		panic("ClientInterfaceMock.GetEdgeEquinixFunc: method is nil but ClientInterface.GetEdgeEquinix was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		GwName string
+	}{
+		Ctx:    ctx,
+		GwName: gwName,
+	}
+	mock.lockGetEdgeEquinix.Lock()
+	mock.calls.GetEdgeEquinix = append(mock.calls.GetEdgeEquinix, callInfo)
+	mock.lockGetEdgeEquinix.Unlock()
+	return mock.GetEdgeEquinixFunc(ctx, gwName)
+}
+
+// GetEdgeEquinixCalls gets all the calls that were made to GetEdgeEquinix.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetEdgeEquinixCalls())
+func (mock *ClientInterfaceMock) GetEdgeEquinixCalls() []struct {
+	Ctx    context.Context
+	GwName string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		GwName string
+	}
+	mock.lockGetEdgeEquinix.RLock()
+	calls = mock.calls.GetEdgeEquinix
+	mock.lockGetEdgeEquinix.RUnlock()
+	return calls
+}
+
+// GetEdgeEquinixHa calls GetEdgeEquinixHaFunc.
+func (mock *ClientInterfaceMock) GetEdgeEquinixHa(ctx context.Context, gwName string) (*EdgeEquinixHaResp, error) {
+	if mock.GetEdgeEquinixHaFunc == nil {
+		panic("ClientInterfaceMock.GetEdgeEquinixHaFunc: method is nil but ClientInterface.GetEdgeEquinixHa was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		GwName string
+	}{
+		Ctx:    ctx,
+		GwName: gwName,
+	}
+	mock.lockGetEdgeEquinixHa.Lock()
+	mock.calls.GetEdgeEquinixHa = append(mock.calls.GetEdgeEquinixHa, callInfo)
+	mock.lockGetEdgeEquinixHa.Unlock()
+	return mock.GetEdgeEquinixHaFunc(ctx, gwName)
+}
+
+// GetEdgeEquinixHaCalls gets all the calls that were made to GetEdgeEquinixHa.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetEdgeEquinixHaCalls())
+func (mock *ClientInterfaceMock) GetEdgeEquinixHaCalls() []struct {
+	Ctx    context.Context
+	GwName string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		GwName string
+	}
+	mock.lockGetEdgeEquinixHa.RLock()
+	calls = mock.calls.GetEdgeEquinixHa
+	mock.lockGetEdgeEquinixHa.RUnlock()
+	return calls
+}
+
+// GetEdgeGatewayWanIp calls GetEdgeGatewayWanIpFunc.
+func (mock *ClientInterfaceMock) GetEdgeGatewayWanIp(ctx context.Context, gwName string, wanInterfaceName string) (string, error) {
+	if mock.GetEdgeGatewayWanIpFunc == nil {
+		panic("ClientInterfaceMock.GetEdgeGatewayWanIpFunc: method is nil but ClientInterface.GetEdgeGatewayWanIp was just called")
+	}
+	callInfo := struct {
+		Ctx              context.Context
+		GwName           string
+		WanInterfaceName string
+	}{
+		Ctx:              ctx,
+		GwName:           gwName,
+		WanInterfaceName: wanInterfaceName,
+	}
+	mock.lockGetEdgeGatewayWanIp.Lock()
+	mock.calls.GetEdgeGatewayWanIp = append(mock.calls.GetEdgeGatewayWanIp, callInfo)
+	mock.lockGetEdgeGatewayWanIp.Unlock()
+	return mock.GetEdgeGatewayWanIpFunc(ctx, gwName, wanInterfaceName)
+}
+
+// GetEdgeGatewayWanIpCalls gets all the calls that were made to GetEdgeGatewayWanIp.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetEdgeGatewayWanIpCalls())
+func (mock *ClientInterfaceMock) GetEdgeGatewayWanIpCalls() []struct {
+	Ctx              context.Context
+	GwName           string
+	WanInterfaceName string
+} {
+	var calls []struct {
+		Ctx              context.Context
+		GwName           string
+		WanInterfaceName string
+	}
+	mock.lockGetEdgeGatewayWanIp.RLock()
+	calls = mock.calls.GetEdgeGatewayWanIp
+	mock.lockGetEdgeGatewayWanIp.RUnlock()
+	return calls
+}
+
+// GetEdgeMegaport calls GetEdgeMegaportFunc.
+func (mock *ClientInterfaceMock) GetEdgeMegaport(ctx context.Context, gwName string) (*EdgeMegaportResp, error) {
+	if mock.GetEdgeMegaportFunc == nil {
+		panic("ClientInterfaceMock.GetEdgeMegaportFunc: method is nil but ClientInterface.GetEdgeMegaport was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		GwName string
+	}{
+		Ctx:    ctx,
+		GwName: gwName,
+	}
+	mock.lockGetEdgeMegaport.Lock()
+	mock.calls.GetEdgeMegaport = append(mock.calls.GetEdgeMegaport, callInfo)
+	mock.lockGetEdgeMegaport.Unlock()
+	return mock.GetEdgeMegaportFunc(ctx, gwName)
+}
+
+// GetEdgeMegaportCalls gets all the calls that were made to GetEdgeMegaport.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetEdgeMegaportCalls())
+func (mock *ClientInterfaceMock) GetEdgeMegaportCalls() []struct {
+	Ctx    context.Context
+	GwName string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		GwName string
+	}
+	mock.lockGetEdgeMegaport.RLock()
+	calls = mock.calls.GetEdgeMegaport
+	mock.lockGetEdgeMegaport.RUnlock()
+	return calls
+}
+
+// GetEdgeMegaportHa calls GetEdgeMegaportHaFunc.
+func (mock *ClientInterfaceMock) GetEdgeMegaportHa(ctx context.Context, gwName string) (*EdgeMegaportHaResp, error) {
+	if mock.GetEdgeMegaportHaFunc == nil {
+		panic("ClientInterfaceMock.GetEdgeMegaportHaFunc: method is nil but ClientInterface.GetEdgeMegaportHa was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		GwName string
+	}{
+		Ctx:    ctx,
+		GwName: gwName,
+	}
+	mock.lockGetEdgeMegaportHa.Lock()
+	mock.calls.GetEdgeMegaportHa = append(mock.calls.GetEdgeMegaportHa, callInfo)
+	mock.lockGetEdgeMegaportHa.Unlock()
+	return mock.GetEdgeMegaportHaFunc(ctx, gwName)
+}
+
+// GetEdgeMegaportHaCalls gets all the calls that were made to GetEdgeMegaportHa.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetEdgeMegaportHaCalls())
+func (mock *ClientInterfaceMock) GetEdgeMegaportHaCalls() []struct {
+	Ctx    context.Context
+	GwName string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		GwName string
+	}
+	mock.lockGetEdgeMegaportHa.RLock()
+	calls = mock.calls.GetEdgeMegaportHa
+	mock.lockGetEdgeMegaportHa.RUnlock()
+	return calls
+}
+
+// GetEdgeNEO calls GetEdgeNEOFunc.
+func (mock *ClientInterfaceMock) GetEdgeNEO(ctx context.Context, gwName string) (*EdgeNEOResp, error) {
+	if mock.GetEdgeNEOFunc == nil {
+		panic("ClientInterfaceMock.GetEdgeNEOFunc: method is nil but ClientInterface.GetEdgeNEO was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		GwName string
+	}{
+		Ctx:    ctx,
+		GwName: gwName,
+	}
+	mock.lockGetEdgeNEO.Lock()
+	mock.calls.GetEdgeNEO = append(mock.calls.GetEdgeNEO, callInfo)
+	mock.lockGetEdgeNEO.Unlock()
+	return mock.GetEdgeNEOFunc(ctx, gwName)
+}
+
+// GetEdgeNEOCalls gets all the calls that were made to GetEdgeNEO.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetEdgeNEOCalls())
+func (mock *ClientInterfaceMock) GetEdgeNEOCalls() []struct {
+	Ctx    context.Context
+	GwName string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		GwName string
+	}
+	mock.lockGetEdgeNEO.RLock()
+	calls = mock.calls.GetEdgeNEO
+	mock.lockGetEdgeNEO.RUnlock()
+	return calls
+}
+
+// GetEdgeNEODevice calls GetEdgeNEODeviceFunc.
+func (mock *ClientInterfaceMock) GetEdgeNEODevice(ctx context.Context, accountName string, deviceName string) (*EdgeNEODeviceResp, error) {
+	if mock.GetEdgeNEODeviceFunc == nil {
+		panic("ClientInterfaceMock.GetEdgeNEODeviceFunc: method is nil but ClientInterface.GetEdgeNEODevice was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		AccountName string
+		DeviceName  string
+	}{
+		Ctx:         ctx,
+		AccountName: accountName,
+		DeviceName:  deviceName,
+	}
+	mock.lockGetEdgeNEODevice.Lock()
+	mock.calls.GetEdgeNEODevice = append(mock.calls.GetEdgeNEODevice, callInfo)
+	mock.lockGetEdgeNEODevice.Unlock()
+	return mock.GetEdgeNEODeviceFunc(ctx, accountName, deviceName)
+}
+
+// GetEdgeNEODeviceCalls gets all the calls that were made to GetEdgeNEODevice.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetEdgeNEODeviceCalls())
+func (mock *ClientInterfaceMock) GetEdgeNEODeviceCalls() []struct {
+	Ctx         context.Context
+	AccountName string
+	DeviceName  string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		AccountName string
+		DeviceName  string
+	}
+	mock.lockGetEdgeNEODevice.RLock()
+	calls = mock.calls.GetEdgeNEODevice
+	mock.lockGetEdgeNEODevice.RUnlock()
+	return calls
+}
+
+// GetEdgeNEOHa calls GetEdgeNEOHaFunc.
+func (mock *ClientInterfaceMock) GetEdgeNEOHa(ctx context.Context, gwName string) (*EdgeNEOHaResp, error) {
+	if mock.GetEdgeNEOHaFunc == nil {
+		panic("ClientInterfaceMock.GetEdgeNEOHaFunc: method is nil but ClientInterface.GetEdgeNEOHa was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		GwName string
+	}{
+		Ctx:    ctx,
+		GwName: gwName,
+	}
+	mock.lockGetEdgeNEOHa.Lock()
+	mock.calls.GetEdgeNEOHa = append(mock.calls.GetEdgeNEOHa, callInfo)
+	mock.lockGetEdgeNEOHa.Unlock()
+	return mock.GetEdgeNEOHaFunc(ctx, gwName)
+}
+
+// GetEdgeNEOHaCalls gets all the calls that were made to GetEdgeNEOHa.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetEdgeNEOHaCalls())
+func (mock *ClientInterfaceMock) GetEdgeNEOHaCalls() []struct {
+	Ctx    context.Context
+	GwName string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		GwName string
+	}
+	mock.lockGetEdgeNEOHa.RLock()
+	calls = mock.calls.GetEdgeNEOHa
+	mock.lockGetEdgeNEOHa.RUnlock()
+	return calls
+}
+
+// GetEdgePlatformProxyProfile calls GetEdgePlatformProxyProfileFunc.
+func (mock *ClientInterfaceMock) GetEdgePlatformProxyProfile(ctx context.Context, accountName string, profileName string) (*EdgePlatformProxyProfileResp, error) {
+	if mock.GetEdgePlatformProxyProfileFunc == nil {
+		panic("ClientInterfaceMock.GetEdgePlatformProxyProfileFunc: method is nil but ClientInterface.GetEdgePlatformProxyProfile was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		AccountName string
+		ProfileName string
+	}{
+		Ctx:         ctx,
+		AccountName: accountName,
+		ProfileName: profileName,
+	}
+	mock.lockGetEdgePlatformProxyProfile.Lock()
+	mock.calls.GetEdgePlatformProxyProfile = append(mock.calls.GetEdgePlatformProxyProfile, callInfo)
+	mock.lockGetEdgePlatformProxyProfile.Unlock()
+	return mock.GetEdgePlatformProxyProfileFunc(ctx, accountName, profileName)
+}
+
+// GetEdgePlatformProxyProfileCalls gets all the calls that were made to GetEdgePlatformProxyProfile.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetEdgePlatformProxyProfileCalls())
+func (mock *ClientInterfaceMock) GetEdgePlatformProxyProfileCalls() []struct {
+	Ctx         context.Context
+	AccountName string
+	ProfileName string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		AccountName string
+		ProfileName string
+	}
+	mock.lockGetEdgePlatformProxyProfile.RLock()
+	calls = mock.calls.GetEdgePlatformProxyProfile
+	mock.lockGetEdgePlatformProxyProfile.RUnlock()
+	return calls
+}
+
+// GetEdgeSpoke calls GetEdgeSpokeFunc.
+func (mock *ClientInterfaceMock) GetEdgeSpoke(ctx context.Context, gwName string) (*EdgeSpokeResp, error) {
+	if mock.GetEdgeSpokeFunc == nil {
+		panic("ClientInterfaceMock.GetEdgeSpokeFunc: method is nil but ClientInterface.GetEdgeSpoke was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		GwName string
+	}{
+		Ctx:    ctx,
+		GwName: gwName,
+	}
+	mock.lockGetEdgeSpoke.Lock()
+	mock.calls.GetEdgeSpoke = append(mock.calls.GetEdgeSpoke, callInfo)
+	mock.lockGetEdgeSpoke.Unlock()
+	return mock.GetEdgeSpokeFunc(ctx, gwName)
+}
+
+// GetEdgeSpokeCalls gets all the calls that were made to GetEdgeSpoke.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetEdgeSpokeCalls())
+func (mock *ClientInterfaceMock) GetEdgeSpokeCalls() []struct {
+	Ctx    context.Context
+	GwName string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		GwName string
+	}
+	mock.lockGetEdgeSpoke.RLock()
+	calls = mock.calls.GetEdgeSpoke
+	mock.lockGetEdgeSpoke.RUnlock()
+	return calls
+}
+
+// GetEdgeSpokeTransitAttachment calls GetEdgeSpokeTransitAttachmentFunc.
+func (mock *ClientInterfaceMock) GetEdgeSpokeTransitAttachment(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) (*SpokeTransitAttachment, error) {
+	if mock.GetEdgeSpokeTransitAttachmentFunc == nil {
+		panic("ClientInterfaceMock.GetEdgeSpokeTransitAttachmentFunc: method is nil but ClientInterface.GetEdgeSpokeTransitAttachment was just called")
+	}
+	callInfo := struct {
+		Ctx                    context.Context
+		SpokeTransitAttachment *SpokeTransitAttachment
+	}{
+		Ctx:                    ctx,
+		SpokeTransitAttachment: spokeTransitAttachment,
+	}
+	mock.lockGetEdgeSpokeTransitAttachment.Lock()
+	mock.calls.GetEdgeSpokeTransitAttachment = append(mock.calls.GetEdgeSpokeTransitAttachment, callInfo)
+	mock.lockGetEdgeSpokeTransitAttachment.Unlock()
+	return mock.GetEdgeSpokeTransitAttachmentFunc(ctx, spokeTransitAttachment)
+}
+
+// GetEdgeSpokeTransitAttachmentCalls gets all the calls that were made to GetEdgeSpokeTransitAttachment.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetEdgeSpokeTransitAttachmentCalls())
+func (mock *ClientInterfaceMock) GetEdgeSpokeTransitAttachmentCalls() []struct {
+	Ctx                    context.Context
+	SpokeTransitAttachment *SpokeTransitAttachment
+} {
+	var calls []struct {
+		Ctx                    context.Context
+		SpokeTransitAttachment *SpokeTransitAttachment
+	}
+	mock.lockGetEdgeSpokeTransitAttachment.RLock()
+	calls = mock.calls.GetEdgeSpokeTransitAttachment
+	mock.lockGetEdgeSpokeTransitAttachment.RUnlock()
+	return calls
+}
+
+// GetEdgeVmSelfmanagedHa calls GetEdgeVmSelfmanagedHaFunc.
+func (mock *ClientInterfaceMock) GetEdgeVmSelfmanagedHa(ctx context.Context, gwName string) (*EdgeVmSelfmanagedHaResp, error) {
+	if mock.GetEdgeVmSelfmanagedHaFunc == nil {
+		panic("ClientInterfaceMock.GetEdgeVmSelfmanagedHaFunc: method is nil but ClientInterface.GetEdgeVmSelfmanagedHa was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		GwName string
+	}{
+		Ctx:    ctx,
+		GwName: gwName,
+	}
+	mock.lockGetEdgeVmSelfmanagedHa.Lock()
+	mock.calls.GetEdgeVmSelfmanagedHa = append(mock.calls.GetEdgeVmSelfmanagedHa, callInfo)
+	mock.lockGetEdgeVmSelfmanagedHa.Unlock()
+	return mock.GetEdgeVmSelfmanagedHaFunc(ctx, gwName)
+}
+
+// GetEdgeVmSelfmanagedHaCalls gets all the calls that were made to GetEdgeVmSelfmanagedHa.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetEdgeVmSelfmanagedHaCalls())
+func (mock *ClientInterfaceMock) GetEdgeVmSelfmanagedHaCalls() []struct {
+	Ctx    context.Context
+	GwName string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		GwName string
+	}
+	mock.lockGetEdgeVmSelfmanagedHa.RLock()
+	calls = mock.calls.GetEdgeVmSelfmanagedHa
+	mock.lockGetEdgeVmSelfmanagedHa.RUnlock()
+	return calls
+}
+
+// GetEnforcementLevel calls GetEnforcementLevelFunc.
+func (mock *ClientInterfaceMock) GetEnforcementLevel(ctx context.Context) (*EnforcementLevel, error) {
+	if mock.GetEnforcementLevelFunc == nil {
+		panic("ClientInterfaceMock.GetEnforcementLevelFunc: method is nil but ClientInterface.GetEnforcementLevel was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetEnforcementLevel.Lock()
+	mock.calls.GetEnforcementLevel = append(mock.calls.GetEnforcementLevel, callInfo)
+	mock.lockGetEnforcementLevel.Unlock()
+	return mock.GetEnforcementLevelFunc(ctx)
+}
+
+// GetEnforcementLevelCalls gets all the calls that were made to GetEnforcementLevel.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetEnforcementLevelCalls())
+func (mock *ClientInterfaceMock) GetEnforcementLevelCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetEnforcementLevel.RLock()
+	calls = mock.calls.GetEnforcementLevel
+	mock.lockGetEnforcementLevel.RUnlock()
+	return calls
+}
+
+// GetExternalDeviceConnDetail calls GetExternalDeviceConnDetailFunc.
+func (mock *ClientInterfaceMock) GetExternalDeviceConnDetail(externalDeviceConn *ExternalDeviceConn, localGateway *Gateway, priorHAEnabled bool) (*ExternalDeviceConn, error) {
+	if mock.GetExternalDeviceConnDetailFunc == nil {
+		panic("ClientInterfaceMock.GetExternalDeviceConnDetailFunc: method is nil but ClientInterface.GetExternalDeviceConnDetail was just called")
+	}
+	callInfo := struct {
+		ExternalDeviceConn *ExternalDeviceConn
+		LocalGateway       *Gateway
+		PriorHAEnabled     bool
+	}{
+		ExternalDeviceConn: externalDeviceConn,
+		LocalGateway:       localGateway,
+		PriorHAEnabled:     priorHAEnabled,
+	}
+	mock.lockGetExternalDeviceConnDetail.Lock()
+	mock.calls.GetExternalDeviceConnDetail = append(mock.calls.GetExternalDeviceConnDetail, callInfo)
+	mock.lockGetExternalDeviceConnDetail.Unlock()
+	return mock.GetExternalDeviceConnDetailFunc(externalDeviceConn, localGateway, priorHAEnabled)
+}
+
+// GetExternalDeviceConnDetailCalls gets all the calls that were made to GetExternalDeviceConnDetail.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetExternalDeviceConnDetailCalls())
+func (mock *ClientInterfaceMock) GetExternalDeviceConnDetailCalls() []struct {
+	ExternalDeviceConn *ExternalDeviceConn
+	LocalGateway       *Gateway
+	PriorHAEnabled     bool
+} {
+	var calls []struct {
+		ExternalDeviceConn *ExternalDeviceConn
+		LocalGateway       *Gateway
+		PriorHAEnabled     bool
+	}
+	mock.lockGetExternalDeviceConnDetail.RLock()
+	calls = mock.calls.GetExternalDeviceConnDetail
+	mock.lockGetExternalDeviceConnDetail.RUnlock()
+	return calls
+}
+
+// GetGateway calls GetGatewayFunc.
+func (mock *ClientInterfaceMock) GetGateway(gateway *Gateway) (*Gateway, error) {
+	if mock.GetGatewayFunc == nil {
+		panic("ClientInterfaceMock.GetGatewayFunc: method is nil but ClientInterface.GetGateway was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockGetGateway.Lock()
+	mock.calls.GetGateway = append(mock.calls.GetGateway, callInfo)
+	mock.lockGetGateway.Unlock()
+	return mock.GetGatewayFunc(gateway)
+}
+
+// GetGatewayCalls gets all the calls that were made to GetGateway.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetGatewayCalls())
+func (mock *ClientInterfaceMock) GetGatewayCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockGetGateway.RLock()
+	calls = mock.calls.GetGateway
+	mock.lockGetGateway.RUnlock()
+	return calls
+}
+
+// GetGatewayBgpCommunities calls GetGatewayBgpCommunitiesFunc.
+func (mock *ClientInterfaceMock) GetGatewayBgpCommunities(gwName string) (bool, bool, error) {
+	if mock.GetGatewayBgpCommunitiesFunc == nil {
+		panic("ClientInterfaceMock.GetGatewayBgpCommunitiesFunc: method is nil but ClientInterface.GetGatewayBgpCommunities was just called")
+	}
+	callInfo := struct {
+		GwName string
+	}{
+		GwName: gwName,
+	}
+	mock.lockGetGatewayBgpCommunities.Lock()
+	mock.calls.GetGatewayBgpCommunities = append(mock.calls.GetGatewayBgpCommunities, callInfo)
+	mock.lockGetGatewayBgpCommunities.Unlock()
+	return mock.GetGatewayBgpCommunitiesFunc(gwName)
+}
+
+// GetGatewayBgpCommunitiesCalls gets all the calls that were made to GetGatewayBgpCommunities.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetGatewayBgpCommunitiesCalls())
+func (mock *ClientInterfaceMock) GetGatewayBgpCommunitiesCalls() []struct {
+	GwName string
+} {
+	var calls []struct {
+		GwName string
+	}
+	mock.lockGetGatewayBgpCommunities.RLock()
+	calls = mock.calls.GetGatewayBgpCommunities
+	mock.lockGetGatewayBgpCommunities.RUnlock()
+	return calls
+}
+
+// GetGatewayBgpMedToSdnMetric calls GetGatewayBgpMedToSdnMetricFunc.
+func (mock *ClientInterfaceMock) GetGatewayBgpMedToSdnMetric(gwName string) (bool, error) {
+	if mock.GetGatewayBgpMedToSdnMetricFunc == nil {
+		panic("ClientInterfaceMock.GetGatewayBgpMedToSdnMetricFunc: method is nil but ClientInterface.GetGatewayBgpMedToSdnMetric was just called")
+	}
+	callInfo := struct {
+		GwName string
+	}{
+		GwName: gwName,
+	}
+	mock.lockGetGatewayBgpMedToSdnMetric.Lock()
+	mock.calls.GetGatewayBgpMedToSdnMetric = append(mock.calls.GetGatewayBgpMedToSdnMetric, callInfo)
+	mock.lockGetGatewayBgpMedToSdnMetric.Unlock()
+	return mock.GetGatewayBgpMedToSdnMetricFunc(gwName)
+}
+
+// GetGatewayBgpMedToSdnMetricCalls gets all the calls that were made to GetGatewayBgpMedToSdnMetric.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetGatewayBgpMedToSdnMetricCalls())
+func (mock *ClientInterfaceMock) GetGatewayBgpMedToSdnMetricCalls() []struct {
+	GwName string
+} {
+	var calls []struct {
+		GwName string
+	}
+	mock.lockGetGatewayBgpMedToSdnMetric.RLock()
+	calls = mock.calls.GetGatewayBgpMedToSdnMetric
+	mock.lockGetGatewayBgpMedToSdnMetric.RUnlock()
+	return calls
+}
+
+// GetGatewayContext calls GetGatewayContextFunc.
+func (mock *ClientInterfaceMock) GetGatewayContext(ctx context.Context, gateway *Gateway) (*Gateway, error) {
+	if mock.GetGatewayContextFunc == nil {
+		panic("ClientInterfaceMock.GetGatewayContextFunc: method is nil but ClientInterface.GetGatewayContext was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}{
+		Ctx:     ctx,
+		Gateway: gateway,
+	}
+	mock.lockGetGatewayContext.Lock()
+	mock.calls.GetGatewayContext = append(mock.calls.GetGatewayContext, callInfo)
+	mock.lockGetGatewayContext.Unlock()
+	return mock.GetGatewayContextFunc(ctx, gateway)
+}
+
+// GetGatewayContextCalls gets all the calls that were made to GetGatewayContext.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetGatewayContextCalls())
+func (mock *ClientInterfaceMock) GetGatewayContextCalls() []struct {
+	Ctx     context.Context
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}
+	mock.lockGetGatewayContext.RLock()
+	calls = mock.calls.GetGatewayContext
+	mock.lockGetGatewayContext.RUnlock()
+	return calls
+}
+
+// GetGatewayDetail calls GetGatewayDetailFunc.
+func (mock *ClientInterfaceMock) GetGatewayDetail(gateway *Gateway) (*GatewayDetail, error) {
+	if mock.GetGatewayDetailFunc == nil {
+		panic("ClientInterfaceMock.GetGatewayDetailFunc: method is nil but ClientInterface.GetGatewayDetail was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockGetGatewayDetail.Lock()
+	mock.calls.GetGatewayDetail = append(mock.calls.GetGatewayDetail, callInfo)
+	mock.lockGetGatewayDetail.Unlock()
+	return mock.GetGatewayDetailFunc(gateway)
+}
+
+// GetGatewayDetailCalls gets all the calls that were made to GetGatewayDetail.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetGatewayDetailCalls())
+func (mock *ClientInterfaceMock) GetGatewayDetailCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockGetGatewayDetail.RLock()
+	calls = mock.calls.GetGatewayDetail
+	mock.lockGetGatewayDetail.RUnlock()
+	return calls
+}
+
+// GetGatewayGroup calls GetGatewayGroupFunc.
+func (mock *ClientInterfaceMock) GetGatewayGroup(ctx context.Context, groupUUID string) (*GatewayGroup, error) {
+	if mock.GetGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.GetGatewayGroupFunc: method is nil but ClientInterface.GetGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupUUID string
+	}{
+		Ctx:       ctx,
+		GroupUUID: groupUUID,
+	}
+	mock.lockGetGatewayGroup.Lock()
+	mock.calls.GetGatewayGroup = append(mock.calls.GetGatewayGroup, callInfo)
+	mock.lockGetGatewayGroup.Unlock()
+	return mock.GetGatewayGroupFunc(ctx, groupUUID)
+}
+
+// GetGatewayGroupCalls gets all the calls that were made to GetGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetGatewayGroupCalls())
+func (mock *ClientInterfaceMock) GetGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupUUID string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupUUID string
+	}
+	mock.lockGetGatewayGroup.RLock()
+	calls = mock.calls.GetGatewayGroup
+	mock.lockGetGatewayGroup.RUnlock()
+	return calls
+}
+
+// GetGatewayGroupByName calls GetGatewayGroupByNameFunc.
+func (mock *ClientInterfaceMock) GetGatewayGroupByName(ctx context.Context, groupName string) (*GatewayGroup, error) {
+	if mock.GetGatewayGroupByNameFunc == nil {
+		panic("ClientInterfaceMock.GetGatewayGroupByNameFunc: method is nil but ClientInterface.GetGatewayGroupByName was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockGetGatewayGroupByName.Lock()
+	mock.calls.GetGatewayGroupByName = append(mock.calls.GetGatewayGroupByName, callInfo)
+	mock.lockGetGatewayGroupByName.Unlock()
+	return mock.GetGatewayGroupByNameFunc(ctx, groupName)
+}
+
+// GetGatewayGroupByNameCalls gets all the calls that were made to GetGatewayGroupByName.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetGatewayGroupByNameCalls())
+func (mock *ClientInterfaceMock) GetGatewayGroupByNameCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockGetGatewayGroupByName.RLock()
+	calls = mock.calls.GetGatewayGroupByName
+	mock.lockGetGatewayGroupByName.RUnlock()
+	return calls
+}
+
+// GetGeoVPNName calls GetGeoVPNNameFunc.
+func (mock *ClientInterfaceMock) GetGeoVPNName(gateway *Gateway) (*GeoVPN, error) {
+	if mock.GetGeoVPNNameFunc == nil {
+		panic("ClientInterfaceMock.GetGeoVPNNameFunc: method is nil but ClientInterface.GetGeoVPNName was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockGetGeoVPNName.Lock()
+	mock.calls.GetGeoVPNName = append(mock.calls.GetGeoVPNName, callInfo)
+	mock.lockGetGeoVPNName.Unlock()
+	return mock.GetGeoVPNNameFunc(gateway)
+}
+
+// GetGeoVPNNameCalls gets all the calls that were made to GetGeoVPNName.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetGeoVPNNameCalls())
+func (mock *ClientInterfaceMock) GetGeoVPNNameCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockGetGeoVPNName.RLock()
+	calls = mock.calls.GetGeoVPNName
+	mock.lockGetGeoVPNName.RUnlock()
+	return calls
+}
+
+// GetGroGsoStatus calls GetGroGsoStatusFunc.
+func (mock *ClientInterfaceMock) GetGroGsoStatus(gateway *Gateway) (bool, error) {
+	if mock.GetGroGsoStatusFunc == nil {
+		panic("ClientInterfaceMock.GetGroGsoStatusFunc: method is nil but ClientInterface.GetGroGsoStatus was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockGetGroGsoStatus.Lock()
+	mock.calls.GetGroGsoStatus = append(mock.calls.GetGroGsoStatus, callInfo)
+	mock.lockGetGroGsoStatus.Unlock()
+	return mock.GetGroGsoStatusFunc(gateway)
+}
+
+// GetGroGsoStatusCalls gets all the calls that were made to GetGroGsoStatus.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetGroGsoStatusCalls())
+func (mock *ClientInterfaceMock) GetGroGsoStatusCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockGetGroGsoStatus.RLock()
+	calls = mock.calls.GetGroGsoStatus
+	mock.lockGetGroGsoStatus.RUnlock()
+	return calls
+}
+
+// GetIgnoreTagsConfig calls GetIgnoreTagsConfigFunc.
+func (mock *ClientInterfaceMock) GetIgnoreTagsConfig() *IgnoreTagsConfig {
+	if mock.GetIgnoreTagsConfigFunc == nil {
+		panic("ClientInterfaceMock.GetIgnoreTagsConfigFunc: method is nil but ClientInterface.GetIgnoreTagsConfig was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetIgnoreTagsConfig.Lock()
+	mock.calls.GetIgnoreTagsConfig = append(mock.calls.GetIgnoreTagsConfig, callInfo)
+	mock.lockGetIgnoreTagsConfig.Unlock()
+	return mock.GetIgnoreTagsConfigFunc()
+}
+
+// GetIgnoreTagsConfigCalls gets all the calls that were made to GetIgnoreTagsConfig.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetIgnoreTagsConfigCalls())
+func (mock *ClientInterfaceMock) GetIgnoreTagsConfigCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetIgnoreTagsConfig.RLock()
+	calls = mock.calls.GetIgnoreTagsConfig
+	mock.lockGetIgnoreTagsConfig.RUnlock()
+	return calls
+}
+
+// GetIpsProfile calls GetIpsProfileFunc.
+func (mock *ClientInterfaceMock) GetIpsProfile(ctx context.Context, uuid string) (*IpsProfile, error) {
+	if mock.GetIpsProfileFunc == nil {
+		panic("ClientInterfaceMock.GetIpsProfileFunc: method is nil but ClientInterface.GetIpsProfile was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		UUID string
+	}{
+		Ctx:  ctx,
+		UUID: uuid,
+	}
+	mock.lockGetIpsProfile.Lock()
+	mock.calls.GetIpsProfile = append(mock.calls.GetIpsProfile, callInfo)
+	mock.lockGetIpsProfile.Unlock()
+	return mock.GetIpsProfileFunc(ctx, uuid)
+}
+
+// GetIpsProfileCalls gets all the calls that were made to GetIpsProfile.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetIpsProfileCalls())
+func (mock *ClientInterfaceMock) GetIpsProfileCalls() []struct {
+	Ctx  context.Context
+	UUID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		UUID string
+	}
+	mock.lockGetIpsProfile.RLock()
+	calls = mock.calls.GetIpsProfile
+	mock.lockGetIpsProfile.RUnlock()
+	return calls
+}
+
+// GetIpsRuleFeed calls GetIpsRuleFeedFunc.
+func (mock *ClientInterfaceMock) GetIpsRuleFeed(ctx context.Context, uuid string) (*IpsRuleFeed, error) {
+	if mock.GetIpsRuleFeedFunc == nil {
+		panic("ClientInterfaceMock.GetIpsRuleFeedFunc: method is nil but ClientInterface.GetIpsRuleFeed was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		UUID string
+	}{
+		Ctx:  ctx,
+		UUID: uuid,
+	}
+	mock.lockGetIpsRuleFeed.Lock()
+	mock.calls.GetIpsRuleFeed = append(mock.calls.GetIpsRuleFeed, callInfo)
+	mock.lockGetIpsRuleFeed.Unlock()
+	return mock.GetIpsRuleFeedFunc(ctx, uuid)
+}
+
+// GetIpsRuleFeedCalls gets all the calls that were made to GetIpsRuleFeed.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetIpsRuleFeedCalls())
+func (mock *ClientInterfaceMock) GetIpsRuleFeedCalls() []struct {
+	Ctx  context.Context
+	UUID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		UUID string
+	}
+	mock.lockGetIpsRuleFeed.RLock()
+	calls = mock.calls.GetIpsRuleFeed
+	mock.lockGetIpsRuleFeed.RUnlock()
+	return calls
+}
+
+// GetLogProfileByName calls GetLogProfileByNameFunc.
+func (mock *ClientInterfaceMock) GetLogProfileByName(ctx context.Context, profileName string) (*LogProfile, error) {
+	if mock.GetLogProfileByNameFunc == nil {
+		panic("ClientInterfaceMock.GetLogProfileByNameFunc: method is nil but ClientInterface.GetLogProfileByName was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		ProfileName string
+	}{
+		Ctx:         ctx,
+		ProfileName: profileName,
+	}
+	mock.lockGetLogProfileByName.Lock()
+	mock.calls.GetLogProfileByName = append(mock.calls.GetLogProfileByName, callInfo)
+	mock.lockGetLogProfileByName.Unlock()
+	return mock.GetLogProfileByNameFunc(ctx, profileName)
+}
+
+// GetLogProfileByNameCalls gets all the calls that were made to GetLogProfileByName.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetLogProfileByNameCalls())
+func (mock *ClientInterfaceMock) GetLogProfileByNameCalls() []struct {
+	Ctx         context.Context
+	ProfileName string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		ProfileName string
+	}
+	mock.lockGetLogProfileByName.RLock()
+	calls = mock.calls.GetLogProfileByName
+	mock.lockGetLogProfileByName.RUnlock()
+	return calls
+}
+
+// GetMetaCaCertificate calls GetMetaCaCertificateFunc.
+func (mock *ClientInterfaceMock) GetMetaCaCertificate(ctx context.Context) (*ProxyCaCertInstance, error) {
+	if mock.GetMetaCaCertificateFunc == nil {
+		panic("ClientInterfaceMock.GetMetaCaCertificateFunc: method is nil but ClientInterface.GetMetaCaCertificate was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetMetaCaCertificate.Lock()
+	mock.calls.GetMetaCaCertificate = append(mock.calls.GetMetaCaCertificate, callInfo)
+	mock.lockGetMetaCaCertificate.Unlock()
+	return mock.GetMetaCaCertificateFunc(ctx)
+}
+
+// GetMetaCaCertificateCalls gets all the calls that were made to GetMetaCaCertificate.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetMetaCaCertificateCalls())
+func (mock *ClientInterfaceMock) GetMetaCaCertificateCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetMetaCaCertificate.RLock()
+	calls = mock.calls.GetMetaCaCertificate
+	mock.lockGetMetaCaCertificate.RUnlock()
+	return calls
+}
+
+// GetS2CCaCertTag calls GetS2CCaCertTagFunc.
+func (mock *ClientInterfaceMock) GetS2CCaCertTag(ctx context.Context, s2cCaCertTag *S2CCaCertTag) (*S2CCaCertTag, error) {
+	if mock.GetS2CCaCertTagFunc == nil {
+		panic("ClientInterfaceMock.GetS2CCaCertTagFunc: method is nil but ClientInterface.GetS2CCaCertTag was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		S2cCaCertTag *S2CCaCertTag
+	}{
+		Ctx:          ctx,
+		S2cCaCertTag: s2cCaCertTag,
+	}
+	mock.lockGetS2CCaCertTag.Lock()
+	mock.calls.GetS2CCaCertTag = append(mock.calls.GetS2CCaCertTag, callInfo)
+	mock.lockGetS2CCaCertTag.Unlock()
+	return mock.GetS2CCaCertTagFunc(ctx, s2cCaCertTag)
+}
+
+// GetS2CCaCertTagCalls gets all the calls that were made to GetS2CCaCertTag.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetS2CCaCertTagCalls())
+func (mock *ClientInterfaceMock) GetS2CCaCertTagCalls() []struct {
+	Ctx          context.Context
+	S2cCaCertTag *S2CCaCertTag
+} {
+	var calls []struct {
+		Ctx          context.Context
+		S2cCaCertTag *S2CCaCertTag
+	}
+	mock.lockGetS2CCaCertTag.RLock()
+	calls = mock.calls.GetS2CCaCertTag
+	mock.lockGetS2CCaCertTag.RUnlock()
+	return calls
+}
+
+// GetSegmentationSecurityDomain calls GetSegmentationSecurityDomainFunc.
+func (mock *ClientInterfaceMock) GetSegmentationSecurityDomain(domain *SegmentationSecurityDomain) (*SegmentationSecurityDomain, error) {
+	if mock.GetSegmentationSecurityDomainFunc == nil {
+		panic("ClientInterfaceMock.GetSegmentationSecurityDomainFunc: method is nil but ClientInterface.GetSegmentationSecurityDomain was just called")
+	}
+	callInfo := struct {
+		Domain *SegmentationSecurityDomain
+	}{
+		Domain: domain,
+	}
+	mock.lockGetSegmentationSecurityDomain.Lock()
+	mock.calls.GetSegmentationSecurityDomain = append(mock.calls.GetSegmentationSecurityDomain, callInfo)
+	mock.lockGetSegmentationSecurityDomain.Unlock()
+	return mock.GetSegmentationSecurityDomainFunc(domain)
+}
+
+// GetSegmentationSecurityDomainCalls gets all the calls that were made to GetSegmentationSecurityDomain.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetSegmentationSecurityDomainCalls())
+func (mock *ClientInterfaceMock) GetSegmentationSecurityDomainCalls() []struct {
+	Domain *SegmentationSecurityDomain
+} {
+	var calls []struct {
+		Domain *SegmentationSecurityDomain
+	}
+	mock.lockGetSegmentationSecurityDomain.RLock()
+	calls = mock.calls.GetSegmentationSecurityDomain
+	mock.lockGetSegmentationSecurityDomain.RUnlock()
+	return calls
+}
+
+// GetSegmentationSecurityDomainAssociation calls GetSegmentationSecurityDomainAssociationFunc.
+func (mock *ClientInterfaceMock) GetSegmentationSecurityDomainAssociation(association *SegmentationSecurityDomainAssociation) (*SegmentationSecurityDomainAssociation, error) {
+	if mock.GetSegmentationSecurityDomainAssociationFunc == nil {
+		panic("ClientInterfaceMock.GetSegmentationSecurityDomainAssociationFunc: method is nil but ClientInterface.GetSegmentationSecurityDomainAssociation was just called")
+	}
+	callInfo := struct {
+		Association *SegmentationSecurityDomainAssociation
+	}{
+		Association: association,
+	}
+	mock.lockGetSegmentationSecurityDomainAssociation.Lock()
+	mock.calls.GetSegmentationSecurityDomainAssociation = append(mock.calls.GetSegmentationSecurityDomainAssociation, callInfo)
+	mock.lockGetSegmentationSecurityDomainAssociation.Unlock()
+	return mock.GetSegmentationSecurityDomainAssociationFunc(association)
+}
+
+// GetSegmentationSecurityDomainAssociationCalls gets all the calls that were made to GetSegmentationSecurityDomainAssociation.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetSegmentationSecurityDomainAssociationCalls())
+func (mock *ClientInterfaceMock) GetSegmentationSecurityDomainAssociationCalls() []struct {
+	Association *SegmentationSecurityDomainAssociation
+} {
+	var calls []struct {
+		Association *SegmentationSecurityDomainAssociation
+	}
+	mock.lockGetSegmentationSecurityDomainAssociation.RLock()
+	calls = mock.calls.GetSegmentationSecurityDomainAssociation
+	mock.lockGetSegmentationSecurityDomainAssociation.RUnlock()
+	return calls
+}
+
+// GetSegmentationSecurityDomainConnectionPolicy calls GetSegmentationSecurityDomainConnectionPolicyFunc.
+func (mock *ClientInterfaceMock) GetSegmentationSecurityDomainConnectionPolicy(policy *SegmentationSecurityDomainConnectionPolicy) (*SegmentationSecurityDomainConnectionPolicy, error) {
+	if mock.GetSegmentationSecurityDomainConnectionPolicyFunc == nil {
+		panic("ClientInterfaceMock.GetSegmentationSecurityDomainConnectionPolicyFunc: method is nil but ClientInterface.GetSegmentationSecurityDomainConnectionPolicy was just called")
+	}
+	callInfo := struct {
+		Policy *SegmentationSecurityDomainConnectionPolicy
+	}{
+		Policy: policy,
+	}
+	mock.lockGetSegmentationSecurityDomainConnectionPolicy.Lock()
+	mock.calls.GetSegmentationSecurityDomainConnectionPolicy = append(mock.calls.GetSegmentationSecurityDomainConnectionPolicy, callInfo)
+	mock.lockGetSegmentationSecurityDomainConnectionPolicy.Unlock()
+	return mock.GetSegmentationSecurityDomainConnectionPolicyFunc(policy)
+}
+
+// GetSegmentationSecurityDomainConnectionPolicyCalls gets all the calls that were made to GetSegmentationSecurityDomainConnectionPolicy.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetSegmentationSecurityDomainConnectionPolicyCalls())
+func (mock *ClientInterfaceMock) GetSegmentationSecurityDomainConnectionPolicyCalls() []struct {
+	Policy *SegmentationSecurityDomainConnectionPolicy
+} {
+	var calls []struct {
+		Policy *SegmentationSecurityDomainConnectionPolicy
+	}
+	mock.lockGetSegmentationSecurityDomainConnectionPolicy.RLock()
+	calls = mock.calls.GetSegmentationSecurityDomainConnectionPolicy
+	mock.lockGetSegmentationSecurityDomainConnectionPolicy.RUnlock()
+	return calls
+}
+
+// GetSite2CloudConnDetail calls GetSite2CloudConnDetailFunc.
+func (mock *ClientInterfaceMock) GetSite2CloudConnDetail(site2cloud *Site2Cloud) (*Site2Cloud, error) {
+	if mock.GetSite2CloudConnDetailFunc == nil {
+		panic("ClientInterfaceMock.GetSite2CloudConnDetailFunc: method is nil but ClientInterface.GetSite2CloudConnDetail was just called")
+	}
+	callInfo := struct {
+		Site2cloud *Site2Cloud
+	}{
+		Site2cloud: site2cloud,
+	}
+	mock.lockGetSite2CloudConnDetail.Lock()
+	mock.calls.GetSite2CloudConnDetail = append(mock.calls.GetSite2CloudConnDetail, callInfo)
+	mock.lockGetSite2CloudConnDetail.Unlock()
+	return mock.GetSite2CloudConnDetailFunc(site2cloud)
+}
+
+// GetSite2CloudConnDetailCalls gets all the calls that were made to GetSite2CloudConnDetail.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetSite2CloudConnDetailCalls())
+func (mock *ClientInterfaceMock) GetSite2CloudConnDetailCalls() []struct {
+	Site2cloud *Site2Cloud
+} {
+	var calls []struct {
+		Site2cloud *Site2Cloud
+	}
+	mock.lockGetSite2CloudConnDetail.RLock()
+	calls = mock.calls.GetSite2CloudConnDetail
+	mock.lockGetSite2CloudConnDetail.RUnlock()
+	return calls
+}
+
+// GetSmartGroup calls GetSmartGroupFunc.
+func (mock *ClientInterfaceMock) GetSmartGroup(ctx context.Context, uuid string) (*SmartGroup, error) {
+	if mock.GetSmartGroupFunc == nil {
+		panic("ClientInterfaceMock.GetSmartGroupFunc: method is nil but ClientInterface.GetSmartGroup was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		UUID string
+	}{
+		Ctx:  ctx,
+		UUID: uuid,
+	}
+	mock.lockGetSmartGroup.Lock()
+	mock.calls.GetSmartGroup = append(mock.calls.GetSmartGroup, callInfo)
+	mock.lockGetSmartGroup.Unlock()
+	return mock.GetSmartGroupFunc(ctx, uuid)
+}
+
+// GetSmartGroupCalls gets all the calls that were made to GetSmartGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetSmartGroupCalls())
+func (mock *ClientInterfaceMock) GetSmartGroupCalls() []struct {
+	Ctx  context.Context
+	UUID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		UUID string
+	}
+	mock.lockGetSmartGroup.RLock()
+	calls = mock.calls.GetSmartGroup
+	mock.lockGetSmartGroup.RUnlock()
+	return calls
+}
+
+// GetSmartGroups calls GetSmartGroupsFunc.
+func (mock *ClientInterfaceMock) GetSmartGroups(ctx context.Context) ([]*SmartGroup, error) {
+	if mock.GetSmartGroupsFunc == nil {
+		panic("ClientInterfaceMock.GetSmartGroupsFunc: method is nil but ClientInterface.GetSmartGroups was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetSmartGroups.Lock()
+	mock.calls.GetSmartGroups = append(mock.calls.GetSmartGroups, callInfo)
+	mock.lockGetSmartGroups.Unlock()
+	return mock.GetSmartGroupsFunc(ctx)
+}
+
+// GetSmartGroupsCalls gets all the calls that were made to GetSmartGroups.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetSmartGroupsCalls())
+func (mock *ClientInterfaceMock) GetSmartGroupsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetSmartGroups.RLock()
+	calls = mock.calls.GetSmartGroups
+	mock.lockGetSmartGroups.RUnlock()
+	return calls
+}
+
+// GetSplitTunnel calls GetSplitTunnelFunc.
+func (mock *ClientInterfaceMock) GetSplitTunnel(splitTunnel *SplitTunnel) (*SplitTunnelUnit, error) {
+	if mock.GetSplitTunnelFunc == nil {
+		panic("ClientInterfaceMock.GetSplitTunnelFunc: method is nil but ClientInterface.GetSplitTunnel was just called")
+	}
+	callInfo := struct {
+		SplitTunnel *SplitTunnel
+	}{
+		SplitTunnel: splitTunnel,
+	}
+	mock.lockGetSplitTunnel.Lock()
+	mock.calls.GetSplitTunnel = append(mock.calls.GetSplitTunnel, callInfo)
+	mock.lockGetSplitTunnel.Unlock()
+	return mock.GetSplitTunnelFunc(splitTunnel)
+}
+
+// GetSplitTunnelCalls gets all the calls that were made to GetSplitTunnel.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetSplitTunnelCalls())
+func (mock *ClientInterfaceMock) GetSplitTunnelCalls() []struct {
+	SplitTunnel *SplitTunnel
+} {
+	var calls []struct {
+		SplitTunnel *SplitTunnel
+	}
+	mock.lockGetSplitTunnel.RLock()
+	calls = mock.calls.GetSplitTunnel
+	mock.lockGetSplitTunnel.RUnlock()
+	return calls
+}
+
+// GetSpokeGatewayAdvancedConfig calls GetSpokeGatewayAdvancedConfigFunc.
+func (mock *ClientInterfaceMock) GetSpokeGatewayAdvancedConfig(spokeGateway *SpokeVpc) (*SpokeGatewayAdvancedConfig, error) {
+	if mock.GetSpokeGatewayAdvancedConfigFunc == nil {
+		panic("ClientInterfaceMock.GetSpokeGatewayAdvancedConfigFunc: method is nil but ClientInterface.GetSpokeGatewayAdvancedConfig was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+	}{
+		SpokeGateway: spokeGateway,
+	}
+	mock.lockGetSpokeGatewayAdvancedConfig.Lock()
+	mock.calls.GetSpokeGatewayAdvancedConfig = append(mock.calls.GetSpokeGatewayAdvancedConfig, callInfo)
+	mock.lockGetSpokeGatewayAdvancedConfig.Unlock()
+	return mock.GetSpokeGatewayAdvancedConfigFunc(spokeGateway)
+}
+
+// GetSpokeGatewayAdvancedConfigCalls gets all the calls that were made to GetSpokeGatewayAdvancedConfig.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetSpokeGatewayAdvancedConfigCalls())
+func (mock *ClientInterfaceMock) GetSpokeGatewayAdvancedConfigCalls() []struct {
+	SpokeGateway *SpokeVpc
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+	}
+	mock.lockGetSpokeGatewayAdvancedConfig.RLock()
+	calls = mock.calls.GetSpokeGatewayAdvancedConfig
+	mock.lockGetSpokeGatewayAdvancedConfig.RUnlock()
+	return calls
+}
+
+// GetSpokeGatewayList calls GetSpokeGatewayListFunc.
+func (mock *ClientInterfaceMock) GetSpokeGatewayList(ctx context.Context) ([]Gateway, error) {
+	if mock.GetSpokeGatewayListFunc == nil {
+		panic("ClientInterfaceMock.GetSpokeGatewayListFunc: method is nil but ClientInterface.GetSpokeGatewayList was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetSpokeGatewayList.Lock()
+	mock.calls.GetSpokeGatewayList = append(mock.calls.GetSpokeGatewayList, callInfo)
+	mock.lockGetSpokeGatewayList.Unlock()
+	return mock.GetSpokeGatewayListFunc(ctx)
+}
+
+// GetSpokeGatewayListCalls gets all the calls that were made to GetSpokeGatewayList.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetSpokeGatewayListCalls())
+func (mock *ClientInterfaceMock) GetSpokeGatewayListCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetSpokeGatewayList.RLock()
+	calls = mock.calls.GetSpokeGatewayList
+	mock.lockGetSpokeGatewayList.RUnlock()
+	return calls
+}
+
+// GetSpokeGatewaySubnetGroup calls GetSpokeGatewaySubnetGroupFunc.
+func (mock *ClientInterfaceMock) GetSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error {
+	if mock.GetSpokeGatewaySubnetGroupFunc == nil {
+		panic("ClientInterfaceMock.GetSpokeGatewaySubnetGroupFunc: method is nil but ClientInterface.GetSpokeGatewaySubnetGroup was just called")
+	}
+	callInfo := struct {
+		Ctx                     context.Context
+		SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+	}{
+		Ctx:                     ctx,
+		SpokeGatewaySubnetGroup: spokeGatewaySubnetGroup,
+	}
+	mock.lockGetSpokeGatewaySubnetGroup.Lock()
+	mock.calls.GetSpokeGatewaySubnetGroup = append(mock.calls.GetSpokeGatewaySubnetGroup, callInfo)
+	mock.lockGetSpokeGatewaySubnetGroup.Unlock()
+	return mock.GetSpokeGatewaySubnetGroupFunc(ctx, spokeGatewaySubnetGroup)
+}
+
+// GetSpokeGatewaySubnetGroupCalls gets all the calls that were made to GetSpokeGatewaySubnetGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetSpokeGatewaySubnetGroupCalls())
+func (mock *ClientInterfaceMock) GetSpokeGatewaySubnetGroupCalls() []struct {
+	Ctx                     context.Context
+	SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+} {
+	var calls []struct {
+		Ctx                     context.Context
+		SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+	}
+	mock.lockGetSpokeGatewaySubnetGroup.RLock()
+	calls = mock.calls.GetSpokeGatewaySubnetGroup
+	mock.lockGetSpokeGatewaySubnetGroup.RUnlock()
+	return calls
+}
+
+// GetTLSProfile calls GetTLSProfileFunc.
+func (mock *ClientInterfaceMock) GetTLSProfile(ctx context.Context, uuidStr string) (*TLSProfileWithID, error) {
+	if mock.GetTLSProfileFunc == nil {
+		panic("ClientInterfaceMock.GetTLSProfileFunc: method is nil but ClientInterface.GetTLSProfile was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		UuidStr string
+	}{
+		Ctx:     ctx,
+		UuidStr: uuidStr,
+	}
+	mock.lockGetTLSProfile.Lock()
+	mock.calls.GetTLSProfile = append(mock.calls.GetTLSProfile, callInfo)
+	mock.lockGetTLSProfile.Unlock()
+	return mock.GetTLSProfileFunc(ctx, uuidStr)
+}
+
+// GetTLSProfileCalls gets all the calls that were made to GetTLSProfile.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetTLSProfileCalls())
+func (mock *ClientInterfaceMock) GetTLSProfileCalls() []struct {
+	Ctx     context.Context
+	UuidStr string
+} {
+	var calls []struct {
+		Ctx     context.Context
+		UuidStr string
+	}
+	mock.lockGetTLSProfile.RLock()
+	calls = mock.calls.GetTLSProfile
+	mock.lockGetTLSProfile.RUnlock()
+	return calls
+}
+
+// GetTLSProfileByName calls GetTLSProfileByNameFunc.
+func (mock *ClientInterfaceMock) GetTLSProfileByName(ctx context.Context, displayName string) (*TLSProfileWithID, error) {
+	if mock.GetTLSProfileByNameFunc == nil {
+		panic("ClientInterfaceMock.GetTLSProfileByNameFunc: method is nil but ClientInterface.GetTLSProfileByName was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		DisplayName string
+	}{
+		Ctx:         ctx,
+		DisplayName: displayName,
+	}
+	mock.lockGetTLSProfileByName.Lock()
+	mock.calls.GetTLSProfileByName = append(mock.calls.GetTLSProfileByName, callInfo)
+	mock.lockGetTLSProfileByName.Unlock()
+	return mock.GetTLSProfileByNameFunc(ctx, displayName)
+}
+
+// GetTLSProfileByNameCalls gets all the calls that were made to GetTLSProfileByName.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetTLSProfileByNameCalls())
+func (mock *ClientInterfaceMock) GetTLSProfileByNameCalls() []struct {
+	Ctx         context.Context
+	DisplayName string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		DisplayName string
+	}
+	mock.lockGetTLSProfileByName.RLock()
+	calls = mock.calls.GetTLSProfileByName
+	mock.lockGetTLSProfileByName.RUnlock()
+	return calls
+}
+
+// GetTags calls GetTagsFunc.
+func (mock *ClientInterfaceMock) GetTags(tags *Tags) ([]string, error) {
+	if mock.GetTagsFunc == nil {
+		panic("ClientInterfaceMock.GetTagsFunc: method is nil but ClientInterface.GetTags was just called")
+	}
+	callInfo := struct {
+		Tags *Tags
+	}{
+		Tags: tags,
+	}
+	mock.lockGetTags.Lock()
+	mock.calls.GetTags = append(mock.calls.GetTags, callInfo)
+	mock.lockGetTags.Unlock()
+	return mock.GetTagsFunc(tags)
+}
+
+// GetTagsCalls gets all the calls that were made to GetTags.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetTagsCalls())
+func (mock *ClientInterfaceMock) GetTagsCalls() []struct {
+	Tags *Tags
+} {
+	var calls []struct {
+		Tags *Tags
+	}
+	mock.lockGetTags.RLock()
+	calls = mock.calls.GetTags
+	mock.lockGetTags.RUnlock()
+	return calls
+}
+
+// GetTransitGatewayAdvancedConfig calls GetTransitGatewayAdvancedConfigFunc.
+func (mock *ClientInterfaceMock) GetTransitGatewayAdvancedConfig(transitGateway *TransitVpc) (*TransitGatewayAdvancedConfig, error) {
+	if mock.GetTransitGatewayAdvancedConfigFunc == nil {
+		panic("ClientInterfaceMock.GetTransitGatewayAdvancedConfigFunc: method is nil but ClientInterface.GetTransitGatewayAdvancedConfig was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockGetTransitGatewayAdvancedConfig.Lock()
+	mock.calls.GetTransitGatewayAdvancedConfig = append(mock.calls.GetTransitGatewayAdvancedConfig, callInfo)
+	mock.lockGetTransitGatewayAdvancedConfig.Unlock()
+	return mock.GetTransitGatewayAdvancedConfigFunc(transitGateway)
+}
+
+// GetTransitGatewayAdvancedConfigCalls gets all the calls that were made to GetTransitGatewayAdvancedConfig.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetTransitGatewayAdvancedConfigCalls())
+func (mock *ClientInterfaceMock) GetTransitGatewayAdvancedConfigCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockGetTransitGatewayAdvancedConfig.RLock()
+	calls = mock.calls.GetTransitGatewayAdvancedConfig
+	mock.lockGetTransitGatewayAdvancedConfig.RUnlock()
+	return calls
+}
+
+// GetTransitGatewayLanCidr calls GetTransitGatewayLanCidrFunc.
+func (mock *ClientInterfaceMock) GetTransitGatewayLanCidr(gatewayName string) (string, error) {
+	if mock.GetTransitGatewayLanCidrFunc == nil {
+		panic("ClientInterfaceMock.GetTransitGatewayLanCidrFunc: method is nil but ClientInterface.GetTransitGatewayLanCidr was just called")
+	}
+	callInfo := struct {
+		GatewayName string
+	}{
+		GatewayName: gatewayName,
+	}
+	mock.lockGetTransitGatewayLanCidr.Lock()
+	mock.calls.GetTransitGatewayLanCidr = append(mock.calls.GetTransitGatewayLanCidr, callInfo)
+	mock.lockGetTransitGatewayLanCidr.Unlock()
+	return mock.GetTransitGatewayLanCidrFunc(gatewayName)
+}
+
+// GetTransitGatewayLanCidrCalls gets all the calls that were made to GetTransitGatewayLanCidr.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetTransitGatewayLanCidrCalls())
+func (mock *ClientInterfaceMock) GetTransitGatewayLanCidrCalls() []struct {
+	GatewayName string
+} {
+	var calls []struct {
+		GatewayName string
+	}
+	mock.lockGetTransitGatewayLanCidr.RLock()
+	calls = mock.calls.GetTransitGatewayLanCidr
+	mock.lockGetTransitGatewayLanCidr.RUnlock()
+	return calls
+}
+
+// GetTransitGatewayList calls GetTransitGatewayListFunc.
+func (mock *ClientInterfaceMock) GetTransitGatewayList(ctx context.Context) ([]Gateway, error) {
+	if mock.GetTransitGatewayListFunc == nil {
+		panic("ClientInterfaceMock.GetTransitGatewayListFunc: method is nil but ClientInterface.GetTransitGatewayList was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetTransitGatewayList.Lock()
+	mock.calls.GetTransitGatewayList = append(mock.calls.GetTransitGatewayList, callInfo)
+	mock.lockGetTransitGatewayList.Unlock()
+	return mock.GetTransitGatewayListFunc(ctx)
+}
+
+// GetTransitGatewayListCalls gets all the calls that were made to GetTransitGatewayList.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetTransitGatewayListCalls())
+func (mock *ClientInterfaceMock) GetTransitGatewayListCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetTransitGatewayList.RLock()
+	calls = mock.calls.GetTransitGatewayList
+	mock.lockGetTransitGatewayList.RUnlock()
+	return calls
+}
+
+// GetTunnelDetectionTime calls GetTunnelDetectionTimeFunc.
+func (mock *ClientInterfaceMock) GetTunnelDetectionTime(entity string) (int, error) {
+	if mock.GetTunnelDetectionTimeFunc == nil {
+		panic("ClientInterfaceMock.GetTunnelDetectionTimeFunc: method is nil but ClientInterface.GetTunnelDetectionTime was just called")
+	}
+	callInfo := struct {
+		Entity string
+	}{
+		Entity: entity,
+	}
+	mock.lockGetTunnelDetectionTime.Lock()
+	mock.calls.GetTunnelDetectionTime = append(mock.calls.GetTunnelDetectionTime, callInfo)
+	mock.lockGetTunnelDetectionTime.Unlock()
+	return mock.GetTunnelDetectionTimeFunc(entity)
+}
+
+// GetTunnelDetectionTimeCalls gets all the calls that were made to GetTunnelDetectionTime.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetTunnelDetectionTimeCalls())
+func (mock *ClientInterfaceMock) GetTunnelDetectionTimeCalls() []struct {
+	Entity string
+} {
+	var calls []struct {
+		Entity string
+	}
+	mock.lockGetTunnelDetectionTime.RLock()
+	calls = mock.calls.GetTunnelDetectionTime
+	mock.lockGetTunnelDetectionTime.RUnlock()
+	return calls
+}
+
+// GetWebGroup calls GetWebGroupFunc.
+func (mock *ClientInterfaceMock) GetWebGroup(ctx context.Context, uuid string) (*WebGroup, error) {
+	if mock.GetWebGroupFunc == nil {
+		panic("ClientInterfaceMock.GetWebGroupFunc: method is nil but ClientInterface.GetWebGroup was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		UUID string
+	}{
+		Ctx:  ctx,
+		UUID: uuid,
+	}
+	mock.lockGetWebGroup.Lock()
+	mock.calls.GetWebGroup = append(mock.calls.GetWebGroup, callInfo)
+	mock.lockGetWebGroup.Unlock()
+	return mock.GetWebGroupFunc(ctx, uuid)
+}
+
+// GetWebGroupCalls gets all the calls that were made to GetWebGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetWebGroupCalls())
+func (mock *ClientInterfaceMock) GetWebGroupCalls() []struct {
+	Ctx  context.Context
+	UUID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		UUID string
+	}
+	mock.lockGetWebGroup.RLock()
+	calls = mock.calls.GetWebGroup
+	mock.lockGetWebGroup.RUnlock()
+	return calls
+}
+
+// GetWebGroupByName calls GetWebGroupByNameFunc.
+func (mock *ClientInterfaceMock) GetWebGroupByName(ctx context.Context, name string) (*WebGroup, error) {
+	if mock.GetWebGroupByNameFunc == nil {
+		panic("ClientInterfaceMock.GetWebGroupByNameFunc: method is nil but ClientInterface.GetWebGroupByName was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+	}{
+		Ctx:  ctx,
+		Name: name,
+	}
+	mock.lockGetWebGroupByName.Lock()
+	mock.calls.GetWebGroupByName = append(mock.calls.GetWebGroupByName, callInfo)
+	mock.lockGetWebGroupByName.Unlock()
+	return mock.GetWebGroupByNameFunc(ctx, name)
+}
+
+// GetWebGroupByNameCalls gets all the calls that were made to GetWebGroupByName.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetWebGroupByNameCalls())
+func (mock *ClientInterfaceMock) GetWebGroupByNameCalls() []struct {
+	Ctx  context.Context
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+	}
+	mock.lockGetWebGroupByName.RLock()
+	calls = mock.calls.GetWebGroupByName
+	mock.lockGetWebGroupByName.RUnlock()
+	return calls
+}
+
+// InvalidateCache calls InvalidateCacheFunc.
+func (mock *ClientInterfaceMock) InvalidateCache() {
+	callInfo := struct {
+	}{}
+	mock.lockInvalidateCache.Lock()
+	mock.calls.InvalidateCache = append(mock.calls.InvalidateCache, callInfo)
+	mock.lockInvalidateCache.Unlock()
+	// Invalidating a cache is safe to ignore, so unlike the other methods
+	// this one does not require a Func.
+	if mock.InvalidateCacheFunc != nil {
+		mock.InvalidateCacheFunc()
+	}
+}
+
+// InvalidateCacheCalls gets all the calls that were made to InvalidateCache.
+// Check the length with:
+//
+//	len(mockedClientInterface.InvalidateCacheCalls())
+func (mock *ClientInterfaceMock) InvalidateCacheCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockInvalidateCache.RLock()
+	calls = mock.calls.InvalidateCache
+	mock.lockInvalidateCache.RUnlock()
+	return calls
+}
+
+// IsTransitFireNetReadyToBeDisabled calls IsTransitFireNetReadyToBeDisabledFunc.
+func (mock *ClientInterfaceMock) IsTransitFireNetReadyToBeDisabled(gateway *Gateway) error {
+	if mock.IsTransitFireNetReadyToBeDisabledFunc == nil {
+		panic("ClientInterfaceMock.IsTransitFireNetReadyToBeDisabledFunc: method is nil but ClientInterface.IsTransitFireNetReadyToBeDisabled was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockIsTransitFireNetReadyToBeDisabled.Lock()
+	mock.calls.IsTransitFireNetReadyToBeDisabled = append(mock.calls.IsTransitFireNetReadyToBeDisabled, callInfo)
+	mock.lockIsTransitFireNetReadyToBeDisabled.Unlock()
+	return mock.IsTransitFireNetReadyToBeDisabledFunc(gateway)
+}
+
+// IsTransitFireNetReadyToBeDisabledCalls gets all the calls that were made to IsTransitFireNetReadyToBeDisabled.
+// Check the length with:
+//
+//	len(mockedClientInterface.IsTransitFireNetReadyToBeDisabledCalls())
+func (mock *ClientInterfaceMock) IsTransitFireNetReadyToBeDisabledCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockIsTransitFireNetReadyToBeDisabled.RLock()
+	calls = mock.calls.IsTransitFireNetReadyToBeDisabled
+	mock.lockIsTransitFireNetReadyToBeDisabled.RUnlock()
+	return calls
+}
+
+// LaunchSpokeVpcContext calls LaunchSpokeVpcContextFunc.
+func (mock *ClientInterfaceMock) LaunchSpokeVpcContext(ctx context.Context, spoke *SpokeVpc) error {
+	if mock.LaunchSpokeVpcContextFunc == nil {
+		panic("ClientInterfaceMock.LaunchSpokeVpcContextFunc: method is nil but ClientInterface.LaunchSpokeVpcContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Spoke *SpokeVpc
+	}{
+		Ctx:   ctx,
+		Spoke: spoke,
+	}
+	mock.lockLaunchSpokeVpcContext.Lock()
+	mock.calls.LaunchSpokeVpcContext = append(mock.calls.LaunchSpokeVpcContext, callInfo)
+	mock.lockLaunchSpokeVpcContext.Unlock()
+	return mock.LaunchSpokeVpcContextFunc(ctx, spoke)
+}
+
+// LaunchSpokeVpcContextCalls gets all the calls that were made to LaunchSpokeVpcContext.
+// Check the length with:
+//
+//	len(mockedClientInterface.LaunchSpokeVpcContextCalls())
+func (mock *ClientInterfaceMock) LaunchSpokeVpcContextCalls() []struct {
+	Ctx   context.Context
+	Spoke *SpokeVpc
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Spoke *SpokeVpc
+	}
+	mock.lockLaunchSpokeVpcContext.RLock()
+	calls = mock.calls.LaunchSpokeVpcContext
+	mock.lockLaunchSpokeVpcContext.RUnlock()
+	return calls
+}
+
+// LaunchTransitVpcContext calls LaunchTransitVpcContextFunc.
+func (mock *ClientInterfaceMock) LaunchTransitVpcContext(ctx context.Context, gateway *TransitVpc) error {
+	if mock.LaunchTransitVpcContextFunc == nil {
+		panic("ClientInterfaceMock.LaunchTransitVpcContextFunc: method is nil but ClientInterface.LaunchTransitVpcContext was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Gateway *TransitVpc
+	}{
+		Ctx:     ctx,
+		Gateway: gateway,
+	}
+	mock.lockLaunchTransitVpcContext.Lock()
+	mock.calls.LaunchTransitVpcContext = append(mock.calls.LaunchTransitVpcContext, callInfo)
+	mock.lockLaunchTransitVpcContext.Unlock()
+	return mock.LaunchTransitVpcContextFunc(ctx, gateway)
+}
+
+// LaunchTransitVpcContextCalls gets all the calls that were made to LaunchTransitVpcContext.
+// Check the length with:
+//
+//	len(mockedClientInterface.LaunchTransitVpcContextCalls())
+func (mock *ClientInterfaceMock) LaunchTransitVpcContextCalls() []struct {
+	Ctx     context.Context
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Gateway *TransitVpc
+	}
+	mock.lockLaunchTransitVpcContext.RLock()
+	calls = mock.calls.LaunchTransitVpcContext
+	mock.lockLaunchTransitVpcContext.RUnlock()
+	return calls
+}
+
+// ListDCFMitmCa calls ListDCFMitmCaFunc.
+func (mock *ClientInterfaceMock) ListDCFMitmCa(ctx context.Context) (*MitmCaListResponse, error) {
+	if mock.ListDCFMitmCaFunc == nil {
+		panic("ClientInterfaceMock.ListDCFMitmCaFunc: method is nil but ClientInterface.ListDCFMitmCa was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListDCFMitmCa.Lock()
+	mock.calls.ListDCFMitmCa = append(mock.calls.ListDCFMitmCa, callInfo)
+	mock.lockListDCFMitmCa.Unlock()
+	return mock.ListDCFMitmCaFunc(ctx)
+}
+
+// ListDCFMitmCaCalls gets all the calls that were made to ListDCFMitmCa.
+// Check the length with:
+//
+//	len(mockedClientInterface.ListDCFMitmCaCalls())
+func (mock *ClientInterfaceMock) ListDCFMitmCaCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListDCFMitmCa.RLock()
+	calls = mock.calls.ListDCFMitmCa
+	mock.lockListDCFMitmCa.RUnlock()
+	return calls
+}
+
+// ListGatewayRegions calls ListGatewayRegionsFunc.
+func (mock *ClientInterfaceMock) ListGatewayRegions(ctx context.Context, cloudType int, accountName string) ([]string, error) {
+	if mock.ListGatewayRegionsFunc == nil {
+		panic("ClientInterfaceMock.ListGatewayRegionsFunc: method is nil but ClientInterface.ListGatewayRegions was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		CloudType   int
+		AccountName string
+	}{
+		Ctx:         ctx,
+		CloudType:   cloudType,
+		AccountName: accountName,
+	}
+	mock.lockListGatewayRegions.Lock()
+	mock.calls.ListGatewayRegions = append(mock.calls.ListGatewayRegions, callInfo)
+	mock.lockListGatewayRegions.Unlock()
+	return mock.ListGatewayRegionsFunc(ctx, cloudType, accountName)
+}
+
+// ListGatewayRegionsCalls gets all the calls that were made to ListGatewayRegions.
+// Check the length with:
+//
+//	len(mockedClientInterface.ListGatewayRegionsCalls())
+func (mock *ClientInterfaceMock) ListGatewayRegionsCalls() []struct {
+	Ctx         context.Context
+	CloudType   int
+	AccountName string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		CloudType   int
+		AccountName string
+	}
+	mock.lockListGatewayRegions.RLock()
+	calls = mock.calls.ListGatewayRegions
+	mock.lockListGatewayRegions.RUnlock()
+	return calls
+}
+
+// ListGatewaySizes calls ListGatewaySizesFunc.
+func (mock *ClientInterfaceMock) ListGatewaySizes(ctx context.Context, cloudType int, accountName string, region string) ([]string, error) {
+	if mock.ListGatewaySizesFunc == nil {
+		panic("ClientInterfaceMock.ListGatewaySizesFunc: method is nil but ClientInterface.ListGatewaySizes was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		CloudType   int
+		AccountName string
+		Region      string
+	}{
+		Ctx:         ctx,
+		CloudType:   cloudType,
+		AccountName: accountName,
+		Region:      region,
+	}
+	mock.lockListGatewaySizes.Lock()
+	mock.calls.ListGatewaySizes = append(mock.calls.ListGatewaySizes, callInfo)
+	mock.lockListGatewaySizes.Unlock()
+	return mock.ListGatewaySizesFunc(ctx, cloudType, accountName, region)
+}
+
+// ListGatewaySizesCalls gets all the calls that were made to ListGatewaySizes.
+// Check the length with:
+//
+//	len(mockedClientInterface.ListGatewaySizesCalls())
+func (mock *ClientInterfaceMock) ListGatewaySizesCalls() []struct {
+	Ctx         context.Context
+	CloudType   int
+	AccountName string
+	Region      string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		CloudType   int
+		AccountName string
+		Region      string
+	}
+	mock.lockListGatewaySizes.RLock()
+	calls = mock.calls.ListGatewaySizes
+	mock.lockListGatewaySizes.RUnlock()
+	return calls
+}
+
+// ListSegmentationSecurityDomains calls ListSegmentationSecurityDomainsFunc.
+func (mock *ClientInterfaceMock) ListSegmentationSecurityDomains() ([]string, error) {
+	if mock.ListSegmentationSecurityDomainsFunc == nil {
+		panic("ClientInterfaceMock.ListSegmentationSecurityDomainsFunc: method is nil but ClientInterface.ListSegmentationSecurityDomains was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockListSegmentationSecurityDomains.Lock()
+	mock.calls.ListSegmentationSecurityDomains = append(mock.calls.ListSegmentationSecurityDomains, callInfo)
+	mock.lockListSegmentationSecurityDomains.Unlock()
+	return mock.ListSegmentationSecurityDomainsFunc()
+}
+
+// ListSegmentationSecurityDomainsCalls gets all the calls that were made to ListSegmentationSecurityDomains.
+// Check the length with:
+//
+//	len(mockedClientInterface.ListSegmentationSecurityDomainsCalls())
+func (mock *ClientInterfaceMock) ListSegmentationSecurityDomainsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockListSegmentationSecurityDomains.RLock()
+	calls = mock.calls.ListSegmentationSecurityDomains
+	mock.lockListSegmentationSecurityDomains.RUnlock()
+	return calls
+}
+
+// ListSite2CloudContext calls ListSite2CloudContextFunc.
+func (mock *ClientInterfaceMock) ListSite2CloudContext(ctx context.Context) ([]Site2Cloud, error) {
+	if mock.ListSite2CloudContextFunc == nil {
+		panic("ClientInterfaceMock.ListSite2CloudContextFunc: method is nil but ClientInterface.ListSite2CloudContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListSite2CloudContext.Lock()
+	mock.calls.ListSite2CloudContext = append(mock.calls.ListSite2CloudContext, callInfo)
+	mock.lockListSite2CloudContext.Unlock()
+	return mock.ListSite2CloudContextFunc(ctx)
+}
+
+// ListSite2CloudContextCalls gets all the calls that were made to ListSite2CloudContext.
+// Check the length with:
+//
+//	len(mockedClientInterface.ListSite2CloudContextCalls())
+func (mock *ClientInterfaceMock) ListSite2CloudContextCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListSite2CloudContext.RLock()
+	calls = mock.calls.ListSite2CloudContext
+	mock.lockListSite2CloudContext.RUnlock()
+	return calls
+}
+
+// ModifySplitTunnel calls ModifySplitTunnelFunc.
+func (mock *ClientInterfaceMock) ModifySplitTunnel(splitTunnel *SplitTunnel) error {
+	if mock.ModifySplitTunnelFunc == nil {
+		panic("ClientInterfaceMock.ModifySplitTunnelFunc: method is nil but ClientInterface.ModifySplitTunnel was just called")
+	}
+	callInfo := struct {
+		SplitTunnel *SplitTunnel
+	}{
+		SplitTunnel: splitTunnel,
+	}
+	mock.lockModifySplitTunnel.Lock()
+	mock.calls.ModifySplitTunnel = append(mock.calls.ModifySplitTunnel, callInfo)
+	mock.lockModifySplitTunnel.Unlock()
+	return mock.ModifySplitTunnelFunc(splitTunnel)
+}
+
+// ModifySplitTunnelCalls gets all the calls that were made to ModifySplitTunnel.
+// Check the length with:
+//
+//	len(mockedClientInterface.ModifySplitTunnelCalls())
+func (mock *ClientInterfaceMock) ModifySplitTunnelCalls() []struct {
+	SplitTunnel *SplitTunnel
+} {
+	var calls []struct {
+		SplitTunnel *SplitTunnel
+	}
+	mock.lockModifySplitTunnel.RLock()
+	calls = mock.calls.ModifySplitTunnel
+	mock.lockModifySplitTunnel.RUnlock()
+	return calls
+}
+
+// ModifyTunnelDetectionTime calls ModifyTunnelDetectionTimeFunc.
+func (mock *ClientInterfaceMock) ModifyTunnelDetectionTime(entity string, detectionTime int) error {
+	if mock.ModifyTunnelDetectionTimeFunc == nil {
+		panic("ClientInterfaceMock.ModifyTunnelDetectionTimeFunc: method is nil but ClientInterface.ModifyTunnelDetectionTime was just called")
+	}
+	callInfo := struct {
+		Entity        string
+		DetectionTime int
+	}{
+		Entity:        entity,
+		DetectionTime: detectionTime,
+	}
+	mock.lockModifyTunnelDetectionTime.Lock()
+	mock.calls.ModifyTunnelDetectionTime = append(mock.calls.ModifyTunnelDetectionTime, callInfo)
+	mock.lockModifyTunnelDetectionTime.Unlock()
+	return mock.ModifyTunnelDetectionTimeFunc(entity, detectionTime)
+}
+
+// ModifyTunnelDetectionTimeCalls gets all the calls that were made to ModifyTunnelDetectionTime.
+// Check the length with:
+//
+//	len(mockedClientInterface.ModifyTunnelDetectionTimeCalls())
+func (mock *ClientInterfaceMock) ModifyTunnelDetectionTimeCalls() []struct {
+	Entity        string
+	DetectionTime int
+} {
+	var calls []struct {
+		Entity        string
+		DetectionTime int
+	}
+	mock.lockModifyTunnelDetectionTime.RLock()
+	calls = mock.calls.ModifyTunnelDetectionTime
+	mock.lockModifyTunnelDetectionTime.RUnlock()
+	return calls
+}
+
+// OnboardEdgeNEODevice calls OnboardEdgeNEODeviceFunc.
+func (mock *ClientInterfaceMock) OnboardEdgeNEODevice(ctx context.Context, edgeNEODevice *EdgeNEODevice) error {
+	if mock.OnboardEdgeNEODeviceFunc == nil {
+		panic("ClientInterfaceMock.OnboardEdgeNEODeviceFunc: method is nil but ClientInterface.OnboardEdgeNEODevice was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		EdgeNEODevice *EdgeNEODevice
+	}{
+		Ctx:           ctx,
+		EdgeNEODevice: edgeNEODevice,
+	}
+	mock.lockOnboardEdgeNEODevice.Lock()
+	mock.calls.OnboardEdgeNEODevice = append(mock.calls.OnboardEdgeNEODevice, callInfo)
+	mock.lockOnboardEdgeNEODevice.Unlock()
+	return mock.OnboardEdgeNEODeviceFunc(ctx, edgeNEODevice)
+}
+
+// OnboardEdgeNEODeviceCalls gets all the calls that were made to OnboardEdgeNEODevice.
+// Check the length with:
+//
+//	len(mockedClientInterface.OnboardEdgeNEODeviceCalls())
+func (mock *ClientInterfaceMock) OnboardEdgeNEODeviceCalls() []struct {
+	Ctx           context.Context
+	EdgeNEODevice *EdgeNEODevice
+} {
+	var calls []struct {
+		Ctx           context.Context
+		EdgeNEODevice *EdgeNEODevice
+	}
+	mock.lockOnboardEdgeNEODevice.RLock()
+	calls = mock.calls.OnboardEdgeNEODevice
+	mock.lockOnboardEdgeNEODevice.RUnlock()
+	return calls
+}
+
+// PostAPI calls PostAPIFunc.
+func (mock *ClientInterfaceMock) PostAPI(action string, d interface{}, checkFunc CheckAPIResponseFunc) error {
+	if mock.PostAPIFunc == nil {
+		panic("ClientInterfaceMock.PostAPIFunc: method is nil but ClientInterface.PostAPI was just called")
+	}
+	callInfo := struct {
+		Action    string
+		D         interface{}
+		CheckFunc CheckAPIResponseFunc
+	}{
+		Action:    action,
+		D:         d,
+		CheckFunc: checkFunc,
+	}
+	mock.lockPostAPI.Lock()
+	mock.calls.PostAPI = append(mock.calls.PostAPI, callInfo)
+	mock.lockPostAPI.Unlock()
+	return mock.PostAPIFunc(action, d, checkFunc)
+}
+
+// PostAPICalls gets all the calls that were made to PostAPI.
+// Check the length with:
+//
+//	len(mockedClientInterface.PostAPICalls())
+func (mock *ClientInterfaceMock) PostAPICalls() []struct {
+	Action    string
+	D         interface{}
+	CheckFunc CheckAPIResponseFunc
+} {
+	var calls []struct {
+		Action    string
+		D         interface{}
+		CheckFunc CheckAPIResponseFunc
+	}
+	mock.lockPostAPI.RLock()
+	calls = mock.calls.PostAPI
+	mock.lockPostAPI.RUnlock()
+	return calls
+}
+
+// RefreshDCFMitmSysatemCA calls RefreshDCFMitmSysatemCAFunc.
+func (mock *ClientInterfaceMock) RefreshDCFMitmSysatemCA(ctx context.Context) error {
+	if mock.RefreshDCFMitmSysatemCAFunc == nil {
+		panic("ClientInterfaceMock.RefreshDCFMitmSysatemCAFunc: method is nil but ClientInterface.RefreshDCFMitmSysatemCA was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockRefreshDCFMitmSysatemCA.Lock()
+	mock.calls.RefreshDCFMitmSysatemCA = append(mock.calls.RefreshDCFMitmSysatemCA, callInfo)
+	mock.lockRefreshDCFMitmSysatemCA.Unlock()
+	return mock.RefreshDCFMitmSysatemCAFunc(ctx)
+}
+
+// RefreshDCFMitmSysatemCACalls gets all the calls that were made to RefreshDCFMitmSysatemCA.
+// Check the length with:
+//
+//	len(mockedClientInterface.RefreshDCFMitmSysatemCACalls())
+func (mock *ClientInterfaceMock) RefreshDCFMitmSysatemCACalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockRefreshDCFMitmSysatemCA.RLock()
+	calls = mock.calls.RefreshDCFMitmSysatemCA
+	mock.lockRefreshDCFMitmSysatemCA.RUnlock()
+	return calls
+}
+
+// SetBgpBfdPollingTime calls SetBgpBfdPollingTimeFunc.
+func (mock *ClientInterfaceMock) SetBgpBfdPollingTime(transitGateway *TransitVpc, newPollingTime int) error {
+	if mock.SetBgpBfdPollingTimeFunc == nil {
+		panic("ClientInterfaceMock.SetBgpBfdPollingTimeFunc: method is nil but ClientInterface.SetBgpBfdPollingTime was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+		NewPollingTime int
+	}{
+		TransitGateway: transitGateway,
+		NewPollingTime: newPollingTime,
+	}
+	mock.lockSetBgpBfdPollingTime.Lock()
+	mock.calls.SetBgpBfdPollingTime = append(mock.calls.SetBgpBfdPollingTime, callInfo)
+	mock.lockSetBgpBfdPollingTime.Unlock()
+	return mock.SetBgpBfdPollingTimeFunc(transitGateway, newPollingTime)
+}
+
+// SetBgpBfdPollingTimeCalls gets all the calls that were made to SetBgpBfdPollingTime.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetBgpBfdPollingTimeCalls())
+func (mock *ClientInterfaceMock) SetBgpBfdPollingTimeCalls() []struct {
+	TransitGateway *TransitVpc
+	NewPollingTime int
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+		NewPollingTime int
+	}
+	mock.lockSetBgpBfdPollingTime.RLock()
+	calls = mock.calls.SetBgpBfdPollingTime
+	mock.lockSetBgpBfdPollingTime.RUnlock()
+	return calls
+}
+
+// SetBgpBfdPollingTimeGatewayGroup calls SetBgpBfdPollingTimeGatewayGroupFunc.
+func (mock *ClientInterfaceMock) SetBgpBfdPollingTimeGatewayGroup(ctx context.Context, groupName string, pollingTime int) error {
+	if mock.SetBgpBfdPollingTimeGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.SetBgpBfdPollingTimeGatewayGroupFunc: method is nil but ClientInterface.SetBgpBfdPollingTimeGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		GroupName   string
+		PollingTime int
+	}{
+		Ctx:         ctx,
+		GroupName:   groupName,
+		PollingTime: pollingTime,
+	}
+	mock.lockSetBgpBfdPollingTimeGatewayGroup.Lock()
+	mock.calls.SetBgpBfdPollingTimeGatewayGroup = append(mock.calls.SetBgpBfdPollingTimeGatewayGroup, callInfo)
+	mock.lockSetBgpBfdPollingTimeGatewayGroup.Unlock()
+	return mock.SetBgpBfdPollingTimeGatewayGroupFunc(ctx, groupName, pollingTime)
+}
+
+// SetBgpBfdPollingTimeGatewayGroupCalls gets all the calls that were made to SetBgpBfdPollingTimeGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetBgpBfdPollingTimeGatewayGroupCalls())
+func (mock *ClientInterfaceMock) SetBgpBfdPollingTimeGatewayGroupCalls() []struct {
+	Ctx         context.Context
+	GroupName   string
+	PollingTime int
+} {
+	var calls []struct {
+		Ctx         context.Context
+		GroupName   string
+		PollingTime int
+	}
+	mock.lockSetBgpBfdPollingTimeGatewayGroup.RLock()
+	calls = mock.calls.SetBgpBfdPollingTimeGatewayGroup
+	mock.lockSetBgpBfdPollingTimeGatewayGroup.RUnlock()
+	return calls
+}
+
+// SetBgpBfdPollingTimeSpoke calls SetBgpBfdPollingTimeSpokeFunc.
+func (mock *ClientInterfaceMock) SetBgpBfdPollingTimeSpoke(spokeGateway *SpokeVpc, newPollingTime int) error {
+	if mock.SetBgpBfdPollingTimeSpokeFunc == nil {
+		panic("ClientInterfaceMock.SetBgpBfdPollingTimeSpokeFunc: method is nil but ClientInterface.SetBgpBfdPollingTimeSpoke was just called")
+	}
+	callInfo := struct {
+		SpokeGateway   *SpokeVpc
+		NewPollingTime int
+	}{
+		SpokeGateway:   spokeGateway,
+		NewPollingTime: newPollingTime,
+	}
+	mock.lockSetBgpBfdPollingTimeSpoke.Lock()
+	mock.calls.SetBgpBfdPollingTimeSpoke = append(mock.calls.SetBgpBfdPollingTimeSpoke, callInfo)
+	mock.lockSetBgpBfdPollingTimeSpoke.Unlock()
+	return mock.SetBgpBfdPollingTimeSpokeFunc(spokeGateway, newPollingTime)
+}
+
+// SetBgpBfdPollingTimeSpokeCalls gets all the calls that were made to SetBgpBfdPollingTimeSpoke.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetBgpBfdPollingTimeSpokeCalls())
+func (mock *ClientInterfaceMock) SetBgpBfdPollingTimeSpokeCalls() []struct {
+	SpokeGateway   *SpokeVpc
+	NewPollingTime int
+} {
+	var calls []struct {
+		SpokeGateway   *SpokeVpc
+		NewPollingTime int
+	}
+	mock.lockSetBgpBfdPollingTimeSpoke.RLock()
+	calls = mock.calls.SetBgpBfdPollingTimeSpoke
+	mock.lockSetBgpBfdPollingTimeSpoke.RUnlock()
+	return calls
+}
+
+// SetBgpEcmp calls SetBgpEcmpFunc.
+func (mock *ClientInterfaceMock) SetBgpEcmp(transitGateway *TransitVpc, enabled bool) error {
+	if mock.SetBgpEcmpFunc == nil {
+		panic("ClientInterfaceMock.SetBgpEcmpFunc: method is nil but ClientInterface.SetBgpEcmp was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+		Enabled        bool
+	}{
+		TransitGateway: transitGateway,
+		Enabled:        enabled,
+	}
+	mock.lockSetBgpEcmp.Lock()
+	mock.calls.SetBgpEcmp = append(mock.calls.SetBgpEcmp, callInfo)
+	mock.lockSetBgpEcmp.Unlock()
+	return mock.SetBgpEcmpFunc(transitGateway, enabled)
+}
+
+// SetBgpEcmpCalls gets all the calls that were made to SetBgpEcmp.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetBgpEcmpCalls())
+func (mock *ClientInterfaceMock) SetBgpEcmpCalls() []struct {
+	TransitGateway *TransitVpc
+	Enabled        bool
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+		Enabled        bool
+	}
+	mock.lockSetBgpEcmp.RLock()
+	calls = mock.calls.SetBgpEcmp
+	mock.lockSetBgpEcmp.RUnlock()
+	return calls
+}
+
+// SetBgpEcmpGatewayGroup calls SetBgpEcmpGatewayGroupFunc.
+func (mock *ClientInterfaceMock) SetBgpEcmpGatewayGroup(ctx context.Context, groupName string, enable bool) error {
+	if mock.SetBgpEcmpGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.SetBgpEcmpGatewayGroupFunc: method is nil but ClientInterface.SetBgpEcmpGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+		Enable    bool
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+		Enable:    enable,
+	}
+	mock.lockSetBgpEcmpGatewayGroup.Lock()
+	mock.calls.SetBgpEcmpGatewayGroup = append(mock.calls.SetBgpEcmpGatewayGroup, callInfo)
+	mock.lockSetBgpEcmpGatewayGroup.Unlock()
+	return mock.SetBgpEcmpGatewayGroupFunc(ctx, groupName, enable)
+}
+
+// SetBgpEcmpGatewayGroupCalls gets all the calls that were made to SetBgpEcmpGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetBgpEcmpGatewayGroupCalls())
+func (mock *ClientInterfaceMock) SetBgpEcmpGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+	Enable    bool
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+		Enable    bool
+	}
+	mock.lockSetBgpEcmpGatewayGroup.RLock()
+	calls = mock.calls.SetBgpEcmpGatewayGroup
+	mock.lockSetBgpEcmpGatewayGroup.RUnlock()
+	return calls
+}
+
+// SetBgpEcmpSpoke calls SetBgpEcmpSpokeFunc.
+func (mock *ClientInterfaceMock) SetBgpEcmpSpoke(spokeGateway *SpokeVpc, enabled bool) error {
+	if mock.SetBgpEcmpSpokeFunc == nil {
+		panic("ClientInterfaceMock.SetBgpEcmpSpokeFunc: method is nil but ClientInterface.SetBgpEcmpSpoke was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+		Enabled      bool
+	}{
+		SpokeGateway: spokeGateway,
+		Enabled:      enabled,
+	}
+	mock.lockSetBgpEcmpSpoke.Lock()
+	mock.calls.SetBgpEcmpSpoke = append(mock.calls.SetBgpEcmpSpoke, callInfo)
+	mock.lockSetBgpEcmpSpoke.Unlock()
+	return mock.SetBgpEcmpSpokeFunc(spokeGateway, enabled)
+}
+
+// SetBgpEcmpSpokeCalls gets all the calls that were made to SetBgpEcmpSpoke.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetBgpEcmpSpokeCalls())
+func (mock *ClientInterfaceMock) SetBgpEcmpSpokeCalls() []struct {
+	SpokeGateway *SpokeVpc
+	Enabled      bool
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+		Enabled      bool
+	}
+	mock.lockSetBgpEcmpSpoke.RLock()
+	calls = mock.calls.SetBgpEcmpSpoke
+	mock.lockSetBgpEcmpSpoke.RUnlock()
+	return calls
+}
+
+// SetBgpManualSpokeAdvertisedNetworks calls SetBgpManualSpokeAdvertisedNetworksFunc.
+func (mock *ClientInterfaceMock) SetBgpManualSpokeAdvertisedNetworks(transitGw *TransitVpc) error {
+	if mock.SetBgpManualSpokeAdvertisedNetworksFunc == nil {
+		panic("ClientInterfaceMock.SetBgpManualSpokeAdvertisedNetworksFunc: method is nil but ClientInterface.SetBgpManualSpokeAdvertisedNetworks was just called")
+	}
+	callInfo := struct {
+		TransitGw *TransitVpc
+	}{
+		TransitGw: transitGw,
+	}
+	mock.lockSetBgpManualSpokeAdvertisedNetworks.Lock()
+	mock.calls.SetBgpManualSpokeAdvertisedNetworks = append(mock.calls.SetBgpManualSpokeAdvertisedNetworks, callInfo)
+	mock.lockSetBgpManualSpokeAdvertisedNetworks.Unlock()
+	return mock.SetBgpManualSpokeAdvertisedNetworksFunc(transitGw)
+}
+
+// SetBgpManualSpokeAdvertisedNetworksCalls gets all the calls that were made to SetBgpManualSpokeAdvertisedNetworks.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetBgpManualSpokeAdvertisedNetworksCalls())
+func (mock *ClientInterfaceMock) SetBgpManualSpokeAdvertisedNetworksCalls() []struct {
+	TransitGw *TransitVpc
+} {
+	var calls []struct {
+		TransitGw *TransitVpc
+	}
+	mock.lockSetBgpManualSpokeAdvertisedNetworks.RLock()
+	calls = mock.calls.SetBgpManualSpokeAdvertisedNetworks
+	mock.lockSetBgpManualSpokeAdvertisedNetworks.RUnlock()
+	return calls
+}
+
+// SetBgpPollingTime calls SetBgpPollingTimeFunc.
+func (mock *ClientInterfaceMock) SetBgpPollingTime(transitGateway *TransitVpc, newPollingTime int) error {
+	if mock.SetBgpPollingTimeFunc == nil {
+		panic("ClientInterfaceMock.SetBgpPollingTimeFunc: method is nil but ClientInterface.SetBgpPollingTime was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+		NewPollingTime int
+	}{
+		TransitGateway: transitGateway,
+		NewPollingTime: newPollingTime,
+	}
+	mock.lockSetBgpPollingTime.Lock()
+	mock.calls.SetBgpPollingTime = append(mock.calls.SetBgpPollingTime, callInfo)
+	mock.lockSetBgpPollingTime.Unlock()
+	return mock.SetBgpPollingTimeFunc(transitGateway, newPollingTime)
+}
+
+// SetBgpPollingTimeCalls gets all the calls that were made to SetBgpPollingTime.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetBgpPollingTimeCalls())
+func (mock *ClientInterfaceMock) SetBgpPollingTimeCalls() []struct {
+	TransitGateway *TransitVpc
+	NewPollingTime int
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+		NewPollingTime int
+	}
+	mock.lockSetBgpPollingTime.RLock()
+	calls = mock.calls.SetBgpPollingTime
+	mock.lockSetBgpPollingTime.RUnlock()
+	return calls
+}
+
+// SetBgpPollingTimeGatewayGroup calls SetBgpPollingTimeGatewayGroupFunc.
+func (mock *ClientInterfaceMock) SetBgpPollingTimeGatewayGroup(ctx context.Context, groupName string, pollingTime int) error {
+	if mock.SetBgpPollingTimeGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.SetBgpPollingTimeGatewayGroupFunc: method is nil but ClientInterface.SetBgpPollingTimeGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		GroupName   string
+		PollingTime int
+	}{
+		Ctx:         ctx,
+		GroupName:   groupName,
+		PollingTime: pollingTime,
+	}
+	mock.lockSetBgpPollingTimeGatewayGroup.Lock()
+	mock.calls.SetBgpPollingTimeGatewayGroup = append(mock.calls.SetBgpPollingTimeGatewayGroup, callInfo)
+	mock.lockSetBgpPollingTimeGatewayGroup.Unlock()
+	return mock.SetBgpPollingTimeGatewayGroupFunc(ctx, groupName, pollingTime)
+}
+
+// SetBgpPollingTimeGatewayGroupCalls gets all the calls that were made to SetBgpPollingTimeGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetBgpPollingTimeGatewayGroupCalls())
+func (mock *ClientInterfaceMock) SetBgpPollingTimeGatewayGroupCalls() []struct {
+	Ctx         context.Context
+	GroupName   string
+	PollingTime int
+} {
+	var calls []struct {
+		Ctx         context.Context
+		GroupName   string
+		PollingTime int
+	}
+	mock.lockSetBgpPollingTimeGatewayGroup.RLock()
+	calls = mock.calls.SetBgpPollingTimeGatewayGroup
+	mock.lockSetBgpPollingTimeGatewayGroup.RUnlock()
+	return calls
+}
+
+// SetBgpPollingTimeSpoke calls SetBgpPollingTimeSpokeFunc.
+func (mock *ClientInterfaceMock) SetBgpPollingTimeSpoke(spokeGateway *SpokeVpc, newPollingTime int) error {
+	if mock.SetBgpPollingTimeSpokeFunc == nil {
+		panic("ClientInterfaceMock.SetBgpPollingTimeSpokeFunc: method is nil but ClientInterface.SetBgpPollingTimeSpoke was just called")
+	}
+	callInfo := struct {
+		SpokeGateway   *SpokeVpc
+		NewPollingTime int
+	}{
+		SpokeGateway:   spokeGateway,
+		NewPollingTime: newPollingTime,
+	}
+	mock.lockSetBgpPollingTimeSpoke.Lock()
+	mock.calls.SetBgpPollingTimeSpoke = append(mock.calls.SetBgpPollingTimeSpoke, callInfo)
+	mock.lockSetBgpPollingTimeSpoke.Unlock()
+	return mock.SetBgpPollingTimeSpokeFunc(spokeGateway, newPollingTime)
+}
+
+// SetBgpPollingTimeSpokeCalls gets all the calls that were made to SetBgpPollingTimeSpoke.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetBgpPollingTimeSpokeCalls())
+func (mock *ClientInterfaceMock) SetBgpPollingTimeSpokeCalls() []struct {
+	SpokeGateway   *SpokeVpc
+	NewPollingTime int
+} {
+	var calls []struct {
+		SpokeGateway   *SpokeVpc
+		NewPollingTime int
+	}
+	mock.lockSetBgpPollingTimeSpoke.RLock()
+	calls = mock.calls.SetBgpPollingTimeSpoke
+	mock.lockSetBgpPollingTimeSpoke.RUnlock()
+	return calls
+}
+
+// SetDefaultIpsProfile calls SetDefaultIpsProfileFunc.
+func (mock *ClientInterfaceMock) SetDefaultIpsProfile(ctx context.Context, profiles []string) (*DefaultIpsProfileResponse, error) {
+	if mock.SetDefaultIpsProfileFunc == nil {
+		panic("ClientInterfaceMock.SetDefaultIpsProfileFunc: method is nil but ClientInterface.SetDefaultIpsProfile was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Profiles []string
+	}{
+		Ctx:      ctx,
+		Profiles: profiles,
+	}
+	mock.lockSetDefaultIpsProfile.Lock()
+	mock.calls.SetDefaultIpsProfile = append(mock.calls.SetDefaultIpsProfile, callInfo)
+	mock.lockSetDefaultIpsProfile.Unlock()
+	return mock.SetDefaultIpsProfileFunc(ctx, profiles)
+}
+
+// SetDefaultIpsProfileCalls gets all the calls that were made to SetDefaultIpsProfile.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetDefaultIpsProfileCalls())
+func (mock *ClientInterfaceMock) SetDefaultIpsProfileCalls() []struct {
+	Ctx      context.Context
+	Profiles []string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Profiles []string
+	}
+	mock.lockSetDefaultIpsProfile.RLock()
+	calls = mock.calls.SetDefaultIpsProfile
+	mock.lockSetDefaultIpsProfile.RUnlock()
+	return calls
+}
+
+// SetEnforcementLevel calls SetEnforcementLevelFunc.
+func (mock *ClientInterfaceMock) SetEnforcementLevel(ctx context.Context, enforcementLevel *EnforcementLevel) error {
+	if mock.SetEnforcementLevelFunc == nil {
+		panic("ClientInterfaceMock.SetEnforcementLevelFunc: method is nil but ClientInterface.SetEnforcementLevel was just called")
+	}
+	callInfo := struct {
+		Ctx              context.Context
+		EnforcementLevel *EnforcementLevel
+	}{
+		Ctx:              ctx,
+		EnforcementLevel: enforcementLevel,
+	}
+	mock.lockSetEnforcementLevel.Lock()
+	mock.calls.SetEnforcementLevel = append(mock.calls.SetEnforcementLevel, callInfo)
+	mock.lockSetEnforcementLevel.Unlock()
+	return mock.SetEnforcementLevelFunc(ctx, enforcementLevel)
+}
+
+// SetEnforcementLevelCalls gets all the calls that were made to SetEnforcementLevel.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetEnforcementLevelCalls())
+func (mock *ClientInterfaceMock) SetEnforcementLevelCalls() []struct {
+	Ctx              context.Context
+	EnforcementLevel *EnforcementLevel
+} {
+	var calls []struct {
+		Ctx              context.Context
+		EnforcementLevel *EnforcementLevel
+	}
+	mock.lockSetEnforcementLevel.RLock()
+	calls = mock.calls.SetEnforcementLevel
+	mock.lockSetEnforcementLevel.RUnlock()
+	return calls
+}
+
+// SetGatewayBgpCommunitiesAccept calls SetGatewayBgpCommunitiesAcceptFunc.
+func (mock *ClientInterfaceMock) SetGatewayBgpCommunitiesAccept(gwName string, acceptComm bool) error {
+	if mock.SetGatewayBgpCommunitiesAcceptFunc == nil {
+		panic("ClientInterfaceMock.SetGatewayBgpCommunitiesAcceptFunc: method is nil but ClientInterface.SetGatewayBgpCommunitiesAccept was just called")
+	}
+	callInfo := struct {
+		GwName     string
+		AcceptComm bool
+	}{
+		GwName:     gwName,
+		AcceptComm: acceptComm,
+	}
+	mock.lockSetGatewayBgpCommunitiesAccept.Lock()
+	mock.calls.SetGatewayBgpCommunitiesAccept = append(mock.calls.SetGatewayBgpCommunitiesAccept, callInfo)
+	mock.lockSetGatewayBgpCommunitiesAccept.Unlock()
+	return mock.SetGatewayBgpCommunitiesAcceptFunc(gwName, acceptComm)
+}
+
+// SetGatewayBgpCommunitiesAcceptCalls gets all the calls that were made to SetGatewayBgpCommunitiesAccept.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetGatewayBgpCommunitiesAcceptCalls())
+func (mock *ClientInterfaceMock) SetGatewayBgpCommunitiesAcceptCalls() []struct {
+	GwName     string
+	AcceptComm bool
+} {
+	var calls []struct {
+		GwName     string
+		AcceptComm bool
+	}
+	mock.lockSetGatewayBgpCommunitiesAccept.RLock()
+	calls = mock.calls.SetGatewayBgpCommunitiesAccept
+	mock.lockSetGatewayBgpCommunitiesAccept.RUnlock()
+	return calls
+}
+
+// SetGatewayBgpCommunitiesSend calls SetGatewayBgpCommunitiesSendFunc.
+func (mock *ClientInterfaceMock) SetGatewayBgpCommunitiesSend(gwName string, sendComm bool) error {
+	if mock.SetGatewayBgpCommunitiesSendFunc == nil {
+		panic("ClientInterfaceMock.SetGatewayBgpCommunitiesSendFunc: method is nil but ClientInterface.SetGatewayBgpCommunitiesSend was just called")
+	}
+	callInfo := struct {
+		GwName   string
+		SendComm bool
+	}{
+		GwName:   gwName,
+		SendComm: sendComm,
+	}
+	mock.lockSetGatewayBgpCommunitiesSend.Lock()
+	mock.calls.SetGatewayBgpCommunitiesSend = append(mock.calls.SetGatewayBgpCommunitiesSend, callInfo)
+	mock.lockSetGatewayBgpCommunitiesSend.Unlock()
+	return mock.SetGatewayBgpCommunitiesSendFunc(gwName, sendComm)
+}
+
+// SetGatewayBgpCommunitiesSendCalls gets all the calls that were made to SetGatewayBgpCommunitiesSend.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetGatewayBgpCommunitiesSendCalls())
+func (mock *ClientInterfaceMock) SetGatewayBgpCommunitiesSendCalls() []struct {
+	GwName   string
+	SendComm bool
+} {
+	var calls []struct {
+		GwName   string
+		SendComm bool
+	}
+	mock.lockSetGatewayBgpCommunitiesSend.RLock()
+	calls = mock.calls.SetGatewayBgpCommunitiesSend
+	mock.lockSetGatewayBgpCommunitiesSend.RUnlock()
+	return calls
+}
+
+// SetGatewayBgpMedToSdnMetric calls SetGatewayBgpMedToSdnMetricFunc.
+func (mock *ClientInterfaceMock) SetGatewayBgpMedToSdnMetric(gwName string, override bool) error {
+	if mock.SetGatewayBgpMedToSdnMetricFunc == nil {
+		panic("ClientInterfaceMock.SetGatewayBgpMedToSdnMetricFunc: method is nil but ClientInterface.SetGatewayBgpMedToSdnMetric was just called")
+	}
+	callInfo := struct {
+		GwName   string
+		Override bool
+	}{
+		GwName:   gwName,
+		Override: override,
+	}
+	mock.lockSetGatewayBgpMedToSdnMetric.Lock()
+	mock.calls.SetGatewayBgpMedToSdnMetric = append(mock.calls.SetGatewayBgpMedToSdnMetric, callInfo)
+	mock.lockSetGatewayBgpMedToSdnMetric.Unlock()
+	return mock.SetGatewayBgpMedToSdnMetricFunc(gwName, override)
+}
+
+// SetGatewayBgpMedToSdnMetricCalls gets all the calls that were made to SetGatewayBgpMedToSdnMetric.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetGatewayBgpMedToSdnMetricCalls())
+func (mock *ClientInterfaceMock) SetGatewayBgpMedToSdnMetricCalls() []struct {
+	GwName   string
+	Override bool
+} {
+	var calls []struct {
+		GwName   string
+		Override bool
+	}
+	mock.lockSetGatewayBgpMedToSdnMetric.RLock()
+	calls = mock.calls.SetGatewayBgpMedToSdnMetric
+	mock.lockSetGatewayBgpMedToSdnMetric.RUnlock()
+	return calls
+}
+
+// SetGatewayGroupBgpCommunitiesAccept calls SetGatewayGroupBgpCommunitiesAcceptFunc.
+func (mock *ClientInterfaceMock) SetGatewayGroupBgpCommunitiesAccept(ctx context.Context, groupName string, accept bool) error {
+	if mock.SetGatewayGroupBgpCommunitiesAcceptFunc == nil {
+		panic("ClientInterfaceMock.SetGatewayGroupBgpCommunitiesAcceptFunc: method is nil but ClientInterface.SetGatewayGroupBgpCommunitiesAccept was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+		Accept    bool
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+		Accept:    accept,
+	}
+	mock.lockSetGatewayGroupBgpCommunitiesAccept.Lock()
+	mock.calls.SetGatewayGroupBgpCommunitiesAccept = append(mock.calls.SetGatewayGroupBgpCommunitiesAccept, callInfo)
+	mock.lockSetGatewayGroupBgpCommunitiesAccept.Unlock()
+	return mock.SetGatewayGroupBgpCommunitiesAcceptFunc(ctx, groupName, accept)
+}
+
+// SetGatewayGroupBgpCommunitiesAcceptCalls gets all the calls that were made to SetGatewayGroupBgpCommunitiesAccept.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetGatewayGroupBgpCommunitiesAcceptCalls())
+func (mock *ClientInterfaceMock) SetGatewayGroupBgpCommunitiesAcceptCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+	Accept    bool
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+		Accept    bool
+	}
+	mock.lockSetGatewayGroupBgpCommunitiesAccept.RLock()
+	calls = mock.calls.SetGatewayGroupBgpCommunitiesAccept
+	mock.lockSetGatewayGroupBgpCommunitiesAccept.RUnlock()
+	return calls
+}
+
+// SetGatewayGroupBgpCommunitiesSend calls SetGatewayGroupBgpCommunitiesSendFunc.
+func (mock *ClientInterfaceMock) SetGatewayGroupBgpCommunitiesSend(ctx context.Context, groupName string, send bool) error {
+	if mock.SetGatewayGroupBgpCommunitiesSendFunc == nil {
+		panic("ClientInterfaceMock.SetGatewayGroupBgpCommunitiesSendFunc: method is nil but ClientInterface.SetGatewayGroupBgpCommunitiesSend was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+		Send      bool
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+		Send:      send,
+	}
+	mock.lockSetGatewayGroupBgpCommunitiesSend.Lock()
+	mock.calls.SetGatewayGroupBgpCommunitiesSend = append(mock.calls.SetGatewayGroupBgpCommunitiesSend, callInfo)
+	mock.lockSetGatewayGroupBgpCommunitiesSend.Unlock()
+	return mock.SetGatewayGroupBgpCommunitiesSendFunc(ctx, groupName, send)
+}
+
+// SetGatewayGroupBgpCommunitiesSendCalls gets all the calls that were made to SetGatewayGroupBgpCommunitiesSend.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetGatewayGroupBgpCommunitiesSendCalls())
+func (mock *ClientInterfaceMock) SetGatewayGroupBgpCommunitiesSendCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+	Send      bool
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+		Send      bool
+	}
+	mock.lockSetGatewayGroupBgpCommunitiesSend.RLock()
+	calls = mock.calls.SetGatewayGroupBgpCommunitiesSend
+	mock.lockSetGatewayGroupBgpCommunitiesSend.RUnlock()
+	return calls
+}
+
+// SetGatewayPhase2Policy calls SetGatewayPhase2PolicyFunc.
+func (mock *ClientInterfaceMock) SetGatewayPhase2Policy(gwName string, encPolicy string, pfsPolicy string) error {
+	if mock.SetGatewayPhase2PolicyFunc == nil {
+		panic("ClientInterfaceMock.SetGatewayPhase2PolicyFunc: method is nil but ClientInterface.SetGatewayPhase2Policy was just called")
+	}
+	callInfo := struct {
+		GwName    string
+		EncPolicy string
+		PfsPolicy string
+	}{
+		GwName:    gwName,
+		EncPolicy: encPolicy,
+		PfsPolicy: pfsPolicy,
+	}
+	mock.lockSetGatewayPhase2Policy.Lock()
+	mock.calls.SetGatewayPhase2Policy = append(mock.calls.SetGatewayPhase2Policy, callInfo)
+	mock.lockSetGatewayPhase2Policy.Unlock()
+	return mock.SetGatewayPhase2PolicyFunc(gwName, encPolicy, pfsPolicy)
+}
+
+// SetGatewayPhase2PolicyCalls gets all the calls that were made to SetGatewayPhase2Policy.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetGatewayPhase2PolicyCalls())
+func (mock *ClientInterfaceMock) SetGatewayPhase2PolicyCalls() []struct {
+	GwName    string
+	EncPolicy string
+	PfsPolicy string
+} {
+	var calls []struct {
+		GwName    string
+		EncPolicy string
+		PfsPolicy string
+	}
+	mock.lockSetGatewayPhase2Policy.RLock()
+	calls = mock.calls.SetGatewayPhase2Policy
+	mock.lockSetGatewayPhase2Policy.RUnlock()
+	return calls
+}
+
+// SetLocalASNumber calls SetLocalASNumberFunc.
+func (mock *ClientInterfaceMock) SetLocalASNumber(transitGateway *TransitVpc, localASNumber string) error {
+	if mock.SetLocalASNumberFunc == nil {
+		panic("ClientInterfaceMock.SetLocalASNumberFunc: method is nil but ClientInterface.SetLocalASNumber was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+		LocalASNumber  string
+	}{
+		TransitGateway: transitGateway,
+		LocalASNumber:  localASNumber,
+	}
+	mock.lockSetLocalASNumber.Lock()
+	mock.calls.SetLocalASNumber = append(mock.calls.SetLocalASNumber, callInfo)
+	mock.lockSetLocalASNumber.Unlock()
+	return mock.SetLocalASNumberFunc(transitGateway, localASNumber)
+}
+
+// SetLocalASNumberCalls gets all the calls that were made to SetLocalASNumber.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetLocalASNumberCalls())
+func (mock *ClientInterfaceMock) SetLocalASNumberCalls() []struct {
+	TransitGateway *TransitVpc
+	LocalASNumber  string
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+		LocalASNumber  string
+	}
+	mock.lockSetLocalASNumber.RLock()
+	calls = mock.calls.SetLocalASNumber
+	mock.lockSetLocalASNumber.RUnlock()
+	return calls
+}
+
+// SetLocalASNumberGatewayGroup calls SetLocalASNumberGatewayGroupFunc.
+func (mock *ClientInterfaceMock) SetLocalASNumberGatewayGroup(ctx context.Context, groupName string, localAsNumber string) error {
+	if mock.SetLocalASNumberGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.SetLocalASNumberGatewayGroupFunc: method is nil but ClientInterface.SetLocalASNumberGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		GroupName     string
+		LocalAsNumber string
+	}{
+		Ctx:           ctx,
+		GroupName:     groupName,
+		LocalAsNumber: localAsNumber,
+	}
+	mock.lockSetLocalASNumberGatewayGroup.Lock()
+	mock.calls.SetLocalASNumberGatewayGroup = append(mock.calls.SetLocalASNumberGatewayGroup, callInfo)
+	mock.lockSetLocalASNumberGatewayGroup.Unlock()
+	return mock.SetLocalASNumberGatewayGroupFunc(ctx, groupName, localAsNumber)
+}
+
+// SetLocalASNumberGatewayGroupCalls gets all the calls that were made to SetLocalASNumberGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetLocalASNumberGatewayGroupCalls())
+func (mock *ClientInterfaceMock) SetLocalASNumberGatewayGroupCalls() []struct {
+	Ctx           context.Context
+	GroupName     string
+	LocalAsNumber string
+} {
+	var calls []struct {
+		Ctx           context.Context
+		GroupName     string
+		LocalAsNumber string
+	}
+	mock.lockSetLocalASNumberGatewayGroup.RLock()
+	calls = mock.calls.SetLocalASNumberGatewayGroup
+	mock.lockSetLocalASNumberGatewayGroup.RUnlock()
+	return calls
+}
+
+// SetLocalASNumberSpoke calls SetLocalASNumberSpokeFunc.
+func (mock *ClientInterfaceMock) SetLocalASNumberSpoke(spokeGateway *SpokeVpc, localASNumber string) error {
+	if mock.SetLocalASNumberSpokeFunc == nil {
+		panic("ClientInterfaceMock.SetLocalASNumberSpokeFunc: method is nil but ClientInterface.SetLocalASNumberSpoke was just called")
+	}
+	callInfo := struct {
+		SpokeGateway  *SpokeVpc
+		LocalASNumber string
+	}{
+		SpokeGateway:  spokeGateway,
+		LocalASNumber: localASNumber,
+	}
+	mock.lockSetLocalASNumberSpoke.Lock()
+	mock.calls.SetLocalASNumberSpoke = append(mock.calls.SetLocalASNumberSpoke, callInfo)
+	mock.lockSetLocalASNumberSpoke.Unlock()
+	return mock.SetLocalASNumberSpokeFunc(spokeGateway, localASNumber)
+}
+
+// SetLocalASNumberSpokeCalls gets all the calls that were made to SetLocalASNumberSpoke.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetLocalASNumberSpokeCalls())
+func (mock *ClientInterfaceMock) SetLocalASNumberSpokeCalls() []struct {
+	SpokeGateway  *SpokeVpc
+	LocalASNumber string
+} {
+	var calls []struct {
+		SpokeGateway  *SpokeVpc
+		LocalASNumber string
+	}
+	mock.lockSetLocalASNumberSpoke.RLock()
+	calls = mock.calls.SetLocalASNumberSpoke
+	mock.lockSetLocalASNumberSpoke.RUnlock()
+	return calls
+}
+
+// SetNewCertificate calls SetNewCertificateFunc.
+func (mock *ClientInterfaceMock) SetNewCertificate(ctx context.Context, proxyCaConfig *ProxyCaConfig) error {
+	if mock.SetNewCertificateFunc == nil {
+		panic("ClientInterfaceMock.SetNewCertificateFunc: method is nil but ClientInterface.SetNewCertificate was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		ProxyCaConfig *ProxyCaConfig
+	}{
+		Ctx:           ctx,
+		ProxyCaConfig: proxyCaConfig,
+	}
+	mock.lockSetNewCertificate.Lock()
+	mock.calls.SetNewCertificate = append(mock.calls.SetNewCertificate, callInfo)
+	mock.lockSetNewCertificate.Unlock()
+	return mock.SetNewCertificateFunc(ctx, proxyCaConfig)
+}
+
+// SetNewCertificateCalls gets all the calls that were made to SetNewCertificate.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetNewCertificateCalls())
+func (mock *ClientInterfaceMock) SetNewCertificateCalls() []struct {
+	Ctx           context.Context
+	ProxyCaConfig *ProxyCaConfig
+} {
+	var calls []struct {
+		Ctx           context.Context
+		ProxyCaConfig *ProxyCaConfig
+	}
+	mock.lockSetNewCertificate.RLock()
+	calls = mock.calls.SetNewCertificate
+	mock.lockSetNewCertificate.RUnlock()
+	return calls
+}
+
+// SetPrependASPath calls SetPrependASPathFunc.
+func (mock *ClientInterfaceMock) SetPrependASPath(transitGateway *TransitVpc, prependASPath []string) error {
+	if mock.SetPrependASPathFunc == nil {
+		panic("ClientInterfaceMock.SetPrependASPathFunc: method is nil but ClientInterface.SetPrependASPath was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+		PrependASPath  []string
+	}{
+		TransitGateway: transitGateway,
+		PrependASPath:  prependASPath,
+	}
+	mock.lockSetPrependASPath.Lock()
+	mock.calls.SetPrependASPath = append(mock.calls.SetPrependASPath, callInfo)
+	mock.lockSetPrependASPath.Unlock()
+	return mock.SetPrependASPathFunc(transitGateway, prependASPath)
+}
+
+// SetPrependASPathCalls gets all the calls that were made to SetPrependASPath.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetPrependASPathCalls())
+func (mock *ClientInterfaceMock) SetPrependASPathCalls() []struct {
+	TransitGateway *TransitVpc
+	PrependASPath  []string
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+		PrependASPath  []string
+	}
+	mock.lockSetPrependASPath.RLock()
+	calls = mock.calls.SetPrependASPath
+	mock.lockSetPrependASPath.RUnlock()
+	return calls
+}
+
+// SetPrependASPathGatewayGroup calls SetPrependASPathGatewayGroupFunc.
+func (mock *ClientInterfaceMock) SetPrependASPathGatewayGroup(ctx context.Context, groupName string, prependAsPath []string) error {
+	if mock.SetPrependASPathGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.SetPrependASPathGatewayGroupFunc: method is nil but ClientInterface.SetPrependASPathGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		GroupName     string
+		PrependAsPath []string
+	}{
+		Ctx:           ctx,
+		GroupName:     groupName,
+		PrependAsPath: prependAsPath,
+	}
+	mock.lockSetPrependASPathGatewayGroup.Lock()
+	mock.calls.SetPrependASPathGatewayGroup = append(mock.calls.SetPrependASPathGatewayGroup, callInfo)
+	mock.lockSetPrependASPathGatewayGroup.Unlock()
+	return mock.SetPrependASPathGatewayGroupFunc(ctx, groupName, prependAsPath)
+}
+
+// SetPrependASPathGatewayGroupCalls gets all the calls that were made to SetPrependASPathGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetPrependASPathGatewayGroupCalls())
+func (mock *ClientInterfaceMock) SetPrependASPathGatewayGroupCalls() []struct {
+	Ctx           context.Context
+	GroupName     string
+	PrependAsPath []string
+} {
+	var calls []struct {
+		Ctx           context.Context
+		GroupName     string
+		PrependAsPath []string
+	}
+	mock.lockSetPrependASPathGatewayGroup.RLock()
+	calls = mock.calls.SetPrependASPathGatewayGroup
+	mock.lockSetPrependASPathGatewayGroup.RUnlock()
+	return calls
+}
+
+// SetPrependASPathSpoke calls SetPrependASPathSpokeFunc.
+func (mock *ClientInterfaceMock) SetPrependASPathSpoke(spokeGateway *SpokeVpc, prependASPath []string) error {
+	if mock.SetPrependASPathSpokeFunc == nil {
+		panic("ClientInterfaceMock.SetPrependASPathSpokeFunc: method is nil but ClientInterface.SetPrependASPathSpoke was just called")
+	}
+	callInfo := struct {
+		SpokeGateway  *SpokeVpc
+		PrependASPath []string
+	}{
+		SpokeGateway:  spokeGateway,
+		PrependASPath: prependASPath,
+	}
+	mock.lockSetPrependASPathSpoke.Lock()
+	mock.calls.SetPrependASPathSpoke = append(mock.calls.SetPrependASPathSpoke, callInfo)
+	mock.lockSetPrependASPathSpoke.Unlock()
+	return mock.SetPrependASPathSpokeFunc(spokeGateway, prependASPath)
+}
+
+// SetPrependASPathSpokeCalls gets all the calls that were made to SetPrependASPathSpoke.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetPrependASPathSpokeCalls())
+func (mock *ClientInterfaceMock) SetPrependASPathSpokeCalls() []struct {
+	SpokeGateway  *SpokeVpc
+	PrependASPath []string
+} {
+	var calls []struct {
+		SpokeGateway  *SpokeVpc
+		PrependASPath []string
+	}
+	mock.lockSetPrependASPathSpoke.RLock()
+	calls = mock.calls.SetPrependASPathSpoke
+	mock.lockSetPrependASPathSpoke.RUnlock()
+	return calls
+}
+
+// SetRxQueueSize calls SetRxQueueSizeFunc.
+func (mock *ClientInterfaceMock) SetRxQueueSize(gateway *Gateway) error {
+	if mock.SetRxQueueSizeFunc == nil {
+		panic("ClientInterfaceMock.SetRxQueueSizeFunc: method is nil but ClientInterface.SetRxQueueSize was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockSetRxQueueSize.Lock()
+	mock.calls.SetRxQueueSize = append(mock.calls.SetRxQueueSize, callInfo)
+	mock.lockSetRxQueueSize.Unlock()
+	return mock.SetRxQueueSizeFunc(gateway)
+}
+
+// SetRxQueueSizeCalls gets all the calls that were made to SetRxQueueSize.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetRxQueueSizeCalls())
+func (mock *ClientInterfaceMock) SetRxQueueSizeCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockSetRxQueueSize.RLock()
+	calls = mock.calls.SetRxQueueSize
+	mock.lockSetRxQueueSize.RUnlock()
+	return calls
+}
+
+// SetSpokeBgpManualAdvertisedNetworks calls SetSpokeBgpManualAdvertisedNetworksFunc.
+func (mock *ClientInterfaceMock) SetSpokeBgpManualAdvertisedNetworks(spokeGateway *SpokeVpc) error {
+	if mock.SetSpokeBgpManualAdvertisedNetworksFunc == nil {
+		panic("ClientInterfaceMock.SetSpokeBgpManualAdvertisedNetworksFunc: method is nil but ClientInterface.SetSpokeBgpManualAdvertisedNetworks was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+	}{
+		SpokeGateway: spokeGateway,
+	}
+	mock.lockSetSpokeBgpManualAdvertisedNetworks.Lock()
+	mock.calls.SetSpokeBgpManualAdvertisedNetworks = append(mock.calls.SetSpokeBgpManualAdvertisedNetworks, callInfo)
+	mock.lockSetSpokeBgpManualAdvertisedNetworks.Unlock()
+	return mock.SetSpokeBgpManualAdvertisedNetworksFunc(spokeGateway)
+}
+
+// SetSpokeBgpManualAdvertisedNetworksCalls gets all the calls that were made to SetSpokeBgpManualAdvertisedNetworks.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetSpokeBgpManualAdvertisedNetworksCalls())
+func (mock *ClientInterfaceMock) SetSpokeBgpManualAdvertisedNetworksCalls() []struct {
+	SpokeGateway *SpokeVpc
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+	}
+	mock.lockSetSpokeBgpManualAdvertisedNetworks.RLock()
+	calls = mock.calls.SetSpokeBgpManualAdvertisedNetworks
+	mock.lockSetSpokeBgpManualAdvertisedNetworks.RUnlock()
+	return calls
+}
+
+// SetSpokeBgpManualAdvertisedNetworksGatewayGroup calls SetSpokeBgpManualAdvertisedNetworksGatewayGroupFunc.
+func (mock *ClientInterfaceMock) SetSpokeBgpManualAdvertisedNetworksGatewayGroup(ctx context.Context, groupName string, cidrs string) error {
+	if mock.SetSpokeBgpManualAdvertisedNetworksGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.SetSpokeBgpManualAdvertisedNetworksGatewayGroupFunc: method is nil but ClientInterface.SetSpokeBgpManualAdvertisedNetworksGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+		Cidrs     string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+		Cidrs:     cidrs,
+	}
+	mock.lockSetSpokeBgpManualAdvertisedNetworksGatewayGroup.Lock()
+	mock.calls.SetSpokeBgpManualAdvertisedNetworksGatewayGroup = append(mock.calls.SetSpokeBgpManualAdvertisedNetworksGatewayGroup, callInfo)
+	mock.lockSetSpokeBgpManualAdvertisedNetworksGatewayGroup.Unlock()
+	return mock.SetSpokeBgpManualAdvertisedNetworksGatewayGroupFunc(ctx, groupName, cidrs)
+}
+
+// SetSpokeBgpManualAdvertisedNetworksGatewayGroupCalls gets all the calls that were made to SetSpokeBgpManualAdvertisedNetworksGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetSpokeBgpManualAdvertisedNetworksGatewayGroupCalls())
+func (mock *ClientInterfaceMock) SetSpokeBgpManualAdvertisedNetworksGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+	Cidrs     string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+		Cidrs     string
+	}
+	mock.lockSetSpokeBgpManualAdvertisedNetworksGatewayGroup.RLock()
+	calls = mock.calls.SetSpokeBgpManualAdvertisedNetworksGatewayGroup
+	mock.lockSetSpokeBgpManualAdvertisedNetworksGatewayGroup.RUnlock()
+	return calls
+}
+
+// SetSymmetricRoutingGatewayGroup calls SetSymmetricRoutingGatewayGroupFunc.
+func (mock *ClientInterfaceMock) SetSymmetricRoutingGatewayGroup(ctx context.Context, groupUUID string, enable bool) error {
+	if mock.SetSymmetricRoutingGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.SetSymmetricRoutingGatewayGroupFunc: method is nil but ClientInterface.SetSymmetricRoutingGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupUUID string
+		Enable    bool
+	}{
+		Ctx:       ctx,
+		GroupUUID: groupUUID,
+		Enable:    enable,
+	}
+	mock.lockSetSymmetricRoutingGatewayGroup.Lock()
+	mock.calls.SetSymmetricRoutingGatewayGroup = append(mock.calls.SetSymmetricRoutingGatewayGroup, callInfo)
+	mock.lockSetSymmetricRoutingGatewayGroup.Unlock()
+	return mock.SetSymmetricRoutingGatewayGroupFunc(ctx, groupUUID, enable)
+}
+
+// SetSymmetricRoutingGatewayGroupCalls gets all the calls that were made to SetSymmetricRoutingGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetSymmetricRoutingGatewayGroupCalls())
+func (mock *ClientInterfaceMock) SetSymmetricRoutingGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupUUID string
+	Enable    bool
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupUUID string
+		Enable    bool
+	}
+	mock.lockSetSymmetricRoutingGatewayGroup.RLock()
+	calls = mock.calls.SetSymmetricRoutingGatewayGroup
+	mock.lockSetSymmetricRoutingGatewayGroup.RUnlock()
+	return calls
+}
+
+// SetTransitLearnedCIDRsApprovalMode calls SetTransitLearnedCIDRsApprovalModeFunc.
+func (mock *ClientInterfaceMock) SetTransitLearnedCIDRsApprovalMode(gw *TransitVpc, mode string) error {
+	if mock.SetTransitLearnedCIDRsApprovalModeFunc == nil {
+		panic("ClientInterfaceMock.SetTransitLearnedCIDRsApprovalModeFunc: method is nil but ClientInterface.SetTransitLearnedCIDRsApprovalMode was just called")
+	}
+	callInfo := struct {
+		Gw   *TransitVpc
+		Mode string
+	}{
+		Gw:   gw,
+		Mode: mode,
+	}
+	mock.lockSetTransitLearnedCIDRsApprovalMode.Lock()
+	mock.calls.SetTransitLearnedCIDRsApprovalMode = append(mock.calls.SetTransitLearnedCIDRsApprovalMode, callInfo)
+	mock.lockSetTransitLearnedCIDRsApprovalMode.Unlock()
+	return mock.SetTransitLearnedCIDRsApprovalModeFunc(gw, mode)
+}
+
+// SetTransitLearnedCIDRsApprovalModeCalls gets all the calls that were made to SetTransitLearnedCIDRsApprovalMode.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetTransitLearnedCIDRsApprovalModeCalls())
+func (mock *ClientInterfaceMock) SetTransitLearnedCIDRsApprovalModeCalls() []struct {
+	Gw   *TransitVpc
+	Mode string
+} {
+	var calls []struct {
+		Gw   *TransitVpc
+		Mode string
+	}
+	mock.lockSetTransitLearnedCIDRsApprovalMode.RLock()
+	calls = mock.calls.SetTransitLearnedCIDRsApprovalMode
+	mock.lockSetTransitLearnedCIDRsApprovalMode.RUnlock()
+	return calls
+}
+
+// SetVpnGatewayAuthentication calls SetVpnGatewayAuthenticationFunc.
+func (mock *ClientInterfaceMock) SetVpnGatewayAuthentication(gateway *VpnGatewayAuth) error {
+	if mock.SetVpnGatewayAuthenticationFunc == nil {
+		panic("ClientInterfaceMock.SetVpnGatewayAuthenticationFunc: method is nil but ClientInterface.SetVpnGatewayAuthentication was just called")
+	}
+	callInfo := struct {
+		Gateway *VpnGatewayAuth
+	}{
+		Gateway: gateway,
+	}
+	mock.lockSetVpnGatewayAuthentication.Lock()
+	mock.calls.SetVpnGatewayAuthentication = append(mock.calls.SetVpnGatewayAuthentication, callInfo)
+	mock.lockSetVpnGatewayAuthentication.Unlock()
+	return mock.SetVpnGatewayAuthenticationFunc(gateway)
+}
+
+// SetVpnGatewayAuthenticationCalls gets all the calls that were made to SetVpnGatewayAuthentication.
+// Check the length with:
+//
+//	len(mockedClientInterface.SetVpnGatewayAuthenticationCalls())
+func (mock *ClientInterfaceMock) SetVpnGatewayAuthenticationCalls() []struct {
+	Gateway *VpnGatewayAuth
+} {
+	var calls []struct {
+		Gateway *VpnGatewayAuth
+	}
+	mock.lockSetVpnGatewayAuthentication.RLock()
+	calls = mock.calls.SetVpnGatewayAuthentication
+	mock.lockSetVpnGatewayAuthentication.RUnlock()
+	return calls
+}
+
+// UpdateAccount calls UpdateAccountFunc.
+func (mock *ClientInterfaceMock) UpdateAccount(account *Account) error {
+	if mock.UpdateAccountFunc == nil {
+		panic("ClientInterfaceMock.UpdateAccountFunc: method is nil but ClientInterface.UpdateAccount was just called")
+	}
+	callInfo := struct {
+		Account *Account
+	}{
+		Account: account,
+	}
+	mock.lockUpdateAccount.Lock()
+	mock.calls.UpdateAccount = append(mock.calls.UpdateAccount, callInfo)
+	mock.lockUpdateAccount.Unlock()
+	return mock.UpdateAccountFunc(account)
+}
+
+// UpdateAccountCalls gets all the calls that were made to UpdateAccount.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateAccountCalls())
+func (mock *ClientInterfaceMock) UpdateAccountCalls() []struct {
+	Account *Account
+} {
+	var calls []struct {
+		Account *Account
+	}
+	mock.lockUpdateAccount.RLock()
+	calls = mock.calls.UpdateAccount
+	mock.lockUpdateAccount.RUnlock()
+	return calls
+}
+
+// UpdateAccountUserObject calls UpdateAccountUserObjectFunc.
+func (mock *ClientInterfaceMock) UpdateAccountUserObject(user *AccountUserEdit) error {
+	if mock.UpdateAccountUserObjectFunc == nil {
+		panic("ClientInterfaceMock.UpdateAccountUserObjectFunc: method is nil but ClientInterface.UpdateAccountUserObject was just called")
+	}
+	callInfo := struct {
+		User *AccountUserEdit
+	}{
+		User: user,
+	}
+	mock.lockUpdateAccountUserObject.Lock()
+	mock.calls.UpdateAccountUserObject = append(mock.calls.UpdateAccountUserObject, callInfo)
+	mock.lockUpdateAccountUserObject.Unlock()
+	return mock.UpdateAccountUserObjectFunc(user)
+}
+
+// UpdateAccountUserObjectCalls gets all the calls that were made to UpdateAccountUserObject.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateAccountUserObjectCalls())
+func (mock *ClientInterfaceMock) UpdateAccountUserObjectCalls() []struct {
+	User *AccountUserEdit
+} {
+	var calls []struct {
+		User *AccountUserEdit
+	}
+	mock.lockUpdateAccountUserObject.RLock()
+	calls = mock.calls.UpdateAccountUserObject
+	mock.lockUpdateAccountUserObject.RUnlock()
+	return calls
+}
+
+// UpdateDCFMitmCa calls UpdateDCFMitmCaFunc.
+func (mock *ClientInterfaceMock) UpdateDCFMitmCa(ctx context.Context, caID string, patchRequest *MitmCaPatchRequest) (*MitmCaResponse, error) {
+	if mock.UpdateDCFMitmCaFunc == nil {
+		panic("ClientInterfaceMock.UpdateDCFMitmCaFunc: method is nil but ClientInterface.UpdateDCFMitmCa was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CaID         string
+		PatchRequest *MitmCaPatchRequest
+	}{
+		Ctx:          ctx,
+		CaID:         caID,
+		PatchRequest: patchRequest,
+	}
+	mock.lockUpdateDCFMitmCa.Lock()
+	mock.calls.UpdateDCFMitmCa = append(mock.calls.UpdateDCFMitmCa, callInfo)
+	mock.lockUpdateDCFMitmCa.Unlock()
+	return mock.UpdateDCFMitmCaFunc(ctx, caID, patchRequest)
+}
+
+// UpdateDCFMitmCaCalls gets all the calls that were made to UpdateDCFMitmCa.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateDCFMitmCaCalls())
+func (mock *ClientInterfaceMock) UpdateDCFMitmCaCalls() []struct {
+	Ctx          context.Context
+	CaID         string
+	PatchRequest *MitmCaPatchRequest
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CaID         string
+		PatchRequest *MitmCaPatchRequest
+	}
+	mock.lockUpdateDCFMitmCa.RLock()
+	calls = mock.calls.UpdateDCFMitmCa
+	mock.lockUpdateDCFMitmCa.RUnlock()
+	return calls
+}
+
+// UpdateDCFPolicyBlock calls UpdateDCFPolicyBlockFunc.
+func (mock *ClientInterfaceMock) UpdateDCFPolicyBlock(ctx context.Context, policyBlock *DCFPolicyBlock) error {
+	if mock.UpdateDCFPolicyBlockFunc == nil {
+		panic("ClientInterfaceMock.UpdateDCFPolicyBlockFunc: method is nil but ClientInterface.UpdateDCFPolicyBlock was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		PolicyBlock *DCFPolicyBlock
+	}{
+		Ctx:         ctx,
+		PolicyBlock: policyBlock,
+	}
+	mock.lockUpdateDCFPolicyBlock.Lock()
+	mock.calls.UpdateDCFPolicyBlock = append(mock.calls.UpdateDCFPolicyBlock, callInfo)
+	mock.lockUpdateDCFPolicyBlock.Unlock()
+	return mock.UpdateDCFPolicyBlockFunc(ctx, policyBlock)
+}
+
+// UpdateDCFPolicyBlockCalls gets all the calls that were made to UpdateDCFPolicyBlock.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateDCFPolicyBlockCalls())
+func (mock *ClientInterfaceMock) UpdateDCFPolicyBlockCalls() []struct {
+	Ctx         context.Context
+	PolicyBlock *DCFPolicyBlock
+} {
+	var calls []struct {
+		Ctx         context.Context
+		PolicyBlock *DCFPolicyBlock
+	}
+	mock.lockUpdateDCFPolicyBlock.RLock()
+	calls = mock.calls.UpdateDCFPolicyBlock
+	mock.lockUpdateDCFPolicyBlock.RUnlock()
+	return calls
+}
+
+// UpdateDCFPolicyList calls UpdateDCFPolicyListFunc.
+func (mock *ClientInterfaceMock) UpdateDCFPolicyList(ctx context.Context, policyList *DCFPolicyList) error {
+	if mock.UpdateDCFPolicyListFunc == nil {
+		panic("ClientInterfaceMock.UpdateDCFPolicyListFunc: method is nil but ClientInterface.UpdateDCFPolicyList was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		PolicyList *DCFPolicyList
+	}{
+		Ctx:        ctx,
+		PolicyList: policyList,
+	}
+	mock.lockUpdateDCFPolicyList.Lock()
+	mock.calls.UpdateDCFPolicyList = append(mock.calls.UpdateDCFPolicyList, callInfo)
+	mock.lockUpdateDCFPolicyList.Unlock()
+	return mock.UpdateDCFPolicyListFunc(ctx, policyList)
+}
+
+// UpdateDCFPolicyListCalls gets all the calls that were made to UpdateDCFPolicyList.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateDCFPolicyListCalls())
+func (mock *ClientInterfaceMock) UpdateDCFPolicyListCalls() []struct {
+	Ctx        context.Context
+	PolicyList *DCFPolicyList
+} {
+	var calls []struct {
+		Ctx        context.Context
+		PolicyList *DCFPolicyList
+	}
+	mock.lockUpdateDCFPolicyList.RLock()
+	calls = mock.calls.UpdateDCFPolicyList
+	mock.lockUpdateDCFPolicyList.RUnlock()
+	return calls
+}
+
+// UpdateDCFTrustBundle calls UpdateDCFTrustBundleFunc.
+func (mock *ClientInterfaceMock) UpdateDCFTrustBundle(ctx context.Context, bundleUUID string, trustBundle *TrustBundleItemRequest) error {
+	if mock.UpdateDCFTrustBundleFunc == nil {
+		panic("ClientInterfaceMock.UpdateDCFTrustBundleFunc: method is nil but ClientInterface.UpdateDCFTrustBundle was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		BundleUUID  string
+		TrustBundle *TrustBundleItemRequest
+	}{
+		Ctx:         ctx,
+		BundleUUID:  bundleUUID,
+		TrustBundle: trustBundle,
+	}
+	mock.lockUpdateDCFTrustBundle.Lock()
+	mock.calls.UpdateDCFTrustBundle = append(mock.calls.UpdateDCFTrustBundle, callInfo)
+	mock.lockUpdateDCFTrustBundle.Unlock()
+	return mock.UpdateDCFTrustBundleFunc(ctx, bundleUUID, trustBundle)
+}
+
+// UpdateDCFTrustBundleCalls gets all the calls that were made to UpdateDCFTrustBundle.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateDCFTrustBundleCalls())
+func (mock *ClientInterfaceMock) UpdateDCFTrustBundleCalls() []struct {
+	Ctx         context.Context
+	BundleUUID  string
+	TrustBundle *TrustBundleItemRequest
+} {
+	var calls []struct {
+		Ctx         context.Context
+		BundleUUID  string
+		TrustBundle *TrustBundleItemRequest
+	}
+	mock.lockUpdateDCFTrustBundle.RLock()
+	calls = mock.calls.UpdateDCFTrustBundle
+	mock.lockUpdateDCFTrustBundle.RUnlock()
+	return calls
+}
+
+// UpdateDNat calls UpdateDNatFunc.
+func (mock *ClientInterfaceMock) UpdateDNat(gateway *Gateway) error {
+	if mock.UpdateDNatFunc == nil {
+		panic("ClientInterfaceMock.UpdateDNatFunc: method is nil but ClientInterface.UpdateDNat was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockUpdateDNat.Lock()
+	mock.calls.UpdateDNat = append(mock.calls.UpdateDNat, callInfo)
+	mock.lockUpdateDNat.Unlock()
+	return mock.UpdateDNatFunc(gateway)
+}
+
+// UpdateDNatCalls gets all the calls that were made to UpdateDNat.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateDNatCalls())
+func (mock *ClientInterfaceMock) UpdateDNatCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockUpdateDNat.RLock()
+	calls = mock.calls.UpdateDNat
+	mock.lockUpdateDNat.RUnlock()
+	return calls
+}
+
+// UpdateDistributedFirewallingDefaultActionRule calls UpdateDistributedFirewallingDefaultActionRuleFunc.
+func (mock *ClientInterfaceMock) UpdateDistributedFirewallingDefaultActionRule(ctx context.Context, request *DistributedFirewallingDefaultActionRule) error {
+	if mock.UpdateDistributedFirewallingDefaultActionRuleFunc == nil {
+		panic("ClientInterfaceMock.UpdateDistributedFirewallingDefaultActionRuleFunc: method is nil but ClientInterface.UpdateDistributedFirewallingDefaultActionRule was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Request *DistributedFirewallingDefaultActionRule
+	}{
+		Ctx:     ctx,
+		Request: request,
+	}
+	mock.lockUpdateDistributedFirewallingDefaultActionRule.Lock()
+	mock.calls.UpdateDistributedFirewallingDefaultActionRule = append(mock.calls.UpdateDistributedFirewallingDefaultActionRule, callInfo)
+	mock.lockUpdateDistributedFirewallingDefaultActionRule.Unlock()
+	return mock.UpdateDistributedFirewallingDefaultActionRuleFunc(ctx, request)
+}
+
+// UpdateDistributedFirewallingDefaultActionRuleCalls gets all the calls that were made to UpdateDistributedFirewallingDefaultActionRule.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateDistributedFirewallingDefaultActionRuleCalls())
+func (mock *ClientInterfaceMock) UpdateDistributedFirewallingDefaultActionRuleCalls() []struct {
+	Ctx     context.Context
+	Request *DistributedFirewallingDefaultActionRule
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Request *DistributedFirewallingDefaultActionRule
+	}
+	mock.lockUpdateDistributedFirewallingDefaultActionRule.RLock()
+	calls = mock.calls.UpdateDistributedFirewallingDefaultActionRule
+	mock.lockUpdateDistributedFirewallingDefaultActionRule.RUnlock()
+	return calls
+}
+
+// UpdateDistributedFirewallingPolicyList calls UpdateDistributedFirewallingPolicyListFunc.
+func (mock *ClientInterfaceMock) UpdateDistributedFirewallingPolicyList(ctx context.Context, policyList *DistributedFirewallingPolicyList) error {
+	if mock.UpdateDistributedFirewallingPolicyListFunc == nil {
+		panic("ClientInterfaceMock.UpdateDistributedFirewallingPolicyListFunc: method is nil but ClientInterface.UpdateDistributedFirewallingPolicyList was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		PolicyList *DistributedFirewallingPolicyList
+	}{
+		Ctx:        ctx,
+		PolicyList: policyList,
+	}
+	mock.lockUpdateDistributedFirewallingPolicyList.Lock()
+	mock.calls.UpdateDistributedFirewallingPolicyList = append(mock.calls.UpdateDistributedFirewallingPolicyList, callInfo)
+	mock.lockUpdateDistributedFirewallingPolicyList.Unlock()
+	return mock.UpdateDistributedFirewallingPolicyListFunc(ctx, policyList)
+}
+
+// UpdateDistributedFirewallingPolicyListCalls gets all the calls that were made to UpdateDistributedFirewallingPolicyList.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateDistributedFirewallingPolicyListCalls())
+func (mock *ClientInterfaceMock) UpdateDistributedFirewallingPolicyListCalls() []struct {
+	Ctx        context.Context
+	PolicyList *DistributedFirewallingPolicyList
+} {
+	var calls []struct {
+		Ctx        context.Context
+		PolicyList *DistributedFirewallingPolicyList
+	}
+	mock.lockUpdateDistributedFirewallingPolicyList.RLock()
+	calls = mock.calls.UpdateDistributedFirewallingPolicyList
+	mock.lockUpdateDistributedFirewallingPolicyList.RUnlock()
+	return calls
+}
+
+// UpdateEdgeAccount calls UpdateEdgeAccountFunc.
+func (mock *ClientInterfaceMock) UpdateEdgeAccount(edgeAccount *EdgeAccount) error {
+	if mock.UpdateEdgeAccountFunc == nil {
+		panic("ClientInterfaceMock.UpdateEdgeAccountFunc: method is nil but ClientInterface.UpdateEdgeAccount was just called")
+	}
+	callInfo := struct {
+		EdgeAccount *EdgeAccount
+	}{
+		EdgeAccount: edgeAccount,
+	}
+	mock.lockUpdateEdgeAccount.Lock()
+	mock.calls.UpdateEdgeAccount = append(mock.calls.UpdateEdgeAccount, callInfo)
+	mock.lockUpdateEdgeAccount.Unlock()
+	return mock.UpdateEdgeAccountFunc(edgeAccount)
+}
+
+// UpdateEdgeAccountCalls gets all the calls that were made to UpdateEdgeAccount.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateEdgeAccountCalls())
+func (mock *ClientInterfaceMock) UpdateEdgeAccountCalls() []struct {
+	EdgeAccount *EdgeAccount
+} {
+	var calls []struct {
+		EdgeAccount *EdgeAccount
+	}
+	mock.lockUpdateEdgeAccount.RLock()
+	calls = mock.calls.UpdateEdgeAccount
+	mock.lockUpdateEdgeAccount.RUnlock()
+	return calls
+}
+
+// UpdateEdgeCSP calls UpdateEdgeCSPFunc.
+func (mock *ClientInterfaceMock) UpdateEdgeCSP(ctx context.Context, edgeCSP *EdgeCSP) error {
+	if mock.UpdateEdgeCSPFunc == nil {
+		panic("ClientInterfaceMock.UpdateEdgeCSPFunc: method is nil but ClientInterface.UpdateEdgeCSP was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		EdgeCSP *EdgeCSP
+	}{
+		Ctx:     ctx,
+		EdgeCSP: edgeCSP,
+	}
+	mock.lockUpdateEdgeCSP.Lock()
+	mock.calls.UpdateEdgeCSP = append(mock.calls.UpdateEdgeCSP, callInfo)
+	mock.lockUpdateEdgeCSP.Unlock()
+	return mock.UpdateEdgeCSPFunc(ctx, edgeCSP)
+}
+
+// UpdateEdgeCSPCalls gets all the calls that were made to UpdateEdgeCSP.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateEdgeCSPCalls())
+func (mock *ClientInterfaceMock) UpdateEdgeCSPCalls() []struct {
+	Ctx     context.Context
+	EdgeCSP *EdgeCSP
+} {
+	var calls []struct {
+		Ctx     context.Context
+		EdgeCSP *EdgeCSP
+	}
+	mock.lockUpdateEdgeCSP.RLock()
+	calls = mock.calls.UpdateEdgeCSP
+	mock.lockUpdateEdgeCSP.RUnlock()
+	return calls
+}
+
+// UpdateEdgeCSPHa calls UpdateEdgeCSPHaFunc.
+func (mock *ClientInterfaceMock) UpdateEdgeCSPHa(ctx context.Context, edgeCSP *EdgeCSP) error {
+	if mock.UpdateEdgeCSPHaFunc == nil {
+		panic("ClientInterfaceMock.UpdateEdgeCSPHaFunc: method is nil but ClientInterface.UpdateEdgeCSPHa was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		EdgeCSP *EdgeCSP
+	}{
+		Ctx:     ctx,
+		EdgeCSP: edgeCSP,
+	}
+	mock.lockUpdateEdgeCSPHa.Lock()
+	mock.calls.UpdateEdgeCSPHa = append(mock.calls.UpdateEdgeCSPHa, callInfo)
+	mock.lockUpdateEdgeCSPHa.Unlock()
+	return mock.UpdateEdgeCSPHaFunc(ctx, edgeCSP)
+}
+
+// UpdateEdgeCSPHaCalls gets all the calls that were made to UpdateEdgeCSPHa.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateEdgeCSPHaCalls())
+func (mock *ClientInterfaceMock) UpdateEdgeCSPHaCalls() []struct {
+	Ctx     context.Context
+	EdgeCSP *EdgeCSP
+} {
+	var calls []struct {
+		Ctx     context.Context
+		EdgeCSP *EdgeCSP
+	}
+	mock.lockUpdateEdgeCSPHa.RLock()
+	calls = mock.calls.UpdateEdgeCSPHa
+	mock.lockUpdateEdgeCSPHa.RUnlock()
+	return calls
+}
+
+// UpdateEdgeEquinix calls UpdateEdgeEquinixFunc.
+func (mock *ClientInterfaceMock) UpdateEdgeEquinix(ctx context.Context, edgeEquinix *EdgeEquinix) error {
+	if mock.UpdateEdgeEquinixFunc == nil {
+		panic("ClientInterfaceMock.UpdateEdgeEquinixFunc: method is nil but ClientInterface.UpdateEdgeEquinix was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		EdgeEquinix *EdgeEquinix
+	}{
+		Ctx:         ctx,
+		EdgeEquinix: edgeEquinix,
+	}
+	mock.lockUpdateEdgeEquinix.Lock()
+	mock.calls.UpdateEdgeEquinix = append(mock.calls.UpdateEdgeEquinix, callInfo)
+	mock.lockUpdateEdgeEquinix.Unlock()
+	return mock.UpdateEdgeEquinixFunc(ctx, edgeEquinix)
+}
+
+// UpdateEdgeEquinixCalls gets all the calls that were made to UpdateEdgeEquinix.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateEdgeEquinixCalls())
+func (mock *ClientInterfaceMock) UpdateEdgeEquinixCalls() []struct {
+	Ctx         context.Context
+	EdgeEquinix *EdgeEquinix
+} {
+	var calls []struct {
+		Ctx         context.Context
+		EdgeEquinix *EdgeEquinix
+	}
+	mock.lockUpdateEdgeEquinix.RLock()
+	calls = mock.calls.UpdateEdgeEquinix
+	mock.lockUpdateEdgeEquinix.RUnlock()
+	return calls
+}
+
+// UpdateEdgeEquinixHa calls UpdateEdgeEquinixHaFunc.
+func (mock *ClientInterfaceMock) UpdateEdgeEquinixHa(ctx context.Context, edgeEquinix *EdgeEquinix) error {
+	if mock.UpdateEdgeEquinixHaFunc == nil {
+		panic("ClientInterfaceMock.UpdateEdgeEquinixHaFunc: method is nil but ClientInterface.UpdateEdgeEquinixHa was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		EdgeEquinix *EdgeEquinix
+	}{
+		Ctx:         ctx,
+		EdgeEquinix: edgeEquinix,
+	}
+	mock.lockUpdateEdgeEquinixHa.Lock()
+	mock.calls.UpdateEdgeEquinixHa = append(mock.calls.UpdateEdgeEquinixHa, callInfo)
+	mock.lockUpdateEdgeEquinixHa.Unlock()
+	return mock.UpdateEdgeEquinixHaFunc(ctx, edgeEquinix)
+}
+
+// UpdateEdgeEquinixHaCalls gets all the calls that were made to UpdateEdgeEquinixHa.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateEdgeEquinixHaCalls())
+func (mock *ClientInterfaceMock) UpdateEdgeEquinixHaCalls() []struct {
+	Ctx         context.Context
+	EdgeEquinix *EdgeEquinix
+} {
+	var calls []struct {
+		Ctx         context.Context
+		EdgeEquinix *EdgeEquinix
+	}
+	mock.lockUpdateEdgeEquinixHa.RLock()
+	calls = mock.calls.UpdateEdgeEquinixHa
+	mock.lockUpdateEdgeEquinixHa.RUnlock()
+	return calls
+}
+
+// UpdateEdgeGateway calls UpdateEdgeGatewayFunc.
+func (mock *ClientInterfaceMock) UpdateEdgeGateway(gateway *TransitVpc) error {
+	if mock.UpdateEdgeGatewayFunc == nil {
+		panic("ClientInterfaceMock.UpdateEdgeGatewayFunc: method is nil but ClientInterface.UpdateEdgeGateway was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockUpdateEdgeGateway.Lock()
+	mock.calls.UpdateEdgeGateway = append(mock.calls.UpdateEdgeGateway, callInfo)
+	mock.lockUpdateEdgeGateway.Unlock()
+	return mock.UpdateEdgeGatewayFunc(gateway)
+}
+
+// UpdateEdgeGatewayCalls gets all the calls that were made to UpdateEdgeGateway.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateEdgeGatewayCalls())
+func (mock *ClientInterfaceMock) UpdateEdgeGatewayCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockUpdateEdgeGateway.RLock()
+	calls = mock.calls.UpdateEdgeGateway
+	mock.lockUpdateEdgeGateway.RUnlock()
+	return calls
+}
+
+// UpdateEdgeGatewayV2 calls UpdateEdgeGatewayV2Func.
+func (mock *ClientInterfaceMock) UpdateEdgeGatewayV2(ctx context.Context, gateway *TransitVpc) error {
+	if mock.UpdateEdgeGatewayV2Func == nil {
+		panic("ClientInterfaceMock.UpdateEdgeGatewayV2Func: method is nil but ClientInterface.UpdateEdgeGatewayV2 was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Gateway *TransitVpc
+	}{
+		Ctx:     ctx,
+		Gateway: gateway,
+	}
+	mock.lockUpdateEdgeGatewayV2.Lock()
+	mock.calls.UpdateEdgeGatewayV2 = append(mock.calls.UpdateEdgeGatewayV2, callInfo)
+	mock.lockUpdateEdgeGatewayV2.Unlock()
+	return mock.UpdateEdgeGatewayV2Func(ctx, gateway)
+}
+
+// UpdateEdgeGatewayV2Calls gets all the calls that were made to UpdateEdgeGatewayV2.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateEdgeGatewayV2Calls())
+func (mock *ClientInterfaceMock) UpdateEdgeGatewayV2Calls() []struct {
+	Ctx     context.Context
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Gateway *TransitVpc
+	}
+	mock.lockUpdateEdgeGatewayV2.RLock()
+	calls = mock.calls.UpdateEdgeGatewayV2
+	mock.lockUpdateEdgeGatewayV2.RUnlock()
+	return calls
+}
+
+// UpdateEdgeMegaport calls UpdateEdgeMegaportFunc.
+func (mock *ClientInterfaceMock) UpdateEdgeMegaport(ctx context.Context, edgeMegaport *EdgeMegaport) error {
+	if mock.UpdateEdgeMegaportFunc == nil {
+		panic("ClientInterfaceMock.UpdateEdgeMegaportFunc: method is nil but ClientInterface.UpdateEdgeMegaport was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		EdgeMegaport *EdgeMegaport
+	}{
+		Ctx:          ctx,
+		EdgeMegaport: edgeMegaport,
+	}
+	mock.lockUpdateEdgeMegaport.Lock()
+	mock.calls.UpdateEdgeMegaport = append(mock.calls.UpdateEdgeMegaport, callInfo)
+	mock.lockUpdateEdgeMegaport.Unlock()
+	return mock.UpdateEdgeMegaportFunc(ctx, edgeMegaport)
+}
+
+// UpdateEdgeMegaportCalls gets all the calls that were made to UpdateEdgeMegaport.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateEdgeMegaportCalls())
+func (mock *ClientInterfaceMock) UpdateEdgeMegaportCalls() []struct {
+	Ctx          context.Context
+	EdgeMegaport *EdgeMegaport
+} {
+	var calls []struct {
+		Ctx          context.Context
+		EdgeMegaport *EdgeMegaport
+	}
+	mock.lockUpdateEdgeMegaport.RLock()
+	calls = mock.calls.UpdateEdgeMegaport
+	mock.lockUpdateEdgeMegaport.RUnlock()
+	return calls
+}
+
+// UpdateEdgeMegaportHa calls UpdateEdgeMegaportHaFunc.
+func (mock *ClientInterfaceMock) UpdateEdgeMegaportHa(ctx context.Context, edgeMegaport *EdgeMegaport) error {
+	if mock.UpdateEdgeMegaportHaFunc == nil {
+		panic("ClientInterfaceMock.UpdateEdgeMegaportHaFunc: method is nil but ClientInterface.UpdateEdgeMegaportHa was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		EdgeMegaport *EdgeMegaport
+	}{
+		Ctx:          ctx,
+		EdgeMegaport: edgeMegaport,
+	}
+	mock.lockUpdateEdgeMegaportHa.Lock()
+	mock.calls.UpdateEdgeMegaportHa = append(mock.calls.UpdateEdgeMegaportHa, callInfo)
+	mock.lockUpdateEdgeMegaportHa.Unlock()
+	return mock.UpdateEdgeMegaportHaFunc(ctx, edgeMegaport)
+}
+
+// UpdateEdgeMegaportHaCalls gets all the calls that were made to UpdateEdgeMegaportHa.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateEdgeMegaportHaCalls())
+func (mock *ClientInterfaceMock) UpdateEdgeMegaportHaCalls() []struct {
+	Ctx          context.Context
+	EdgeMegaport *EdgeMegaport
+} {
+	var calls []struct {
+		Ctx          context.Context
+		EdgeMegaport *EdgeMegaport
+	}
+	mock.lockUpdateEdgeMegaportHa.RLock()
+	calls = mock.calls.UpdateEdgeMegaportHa
+	mock.lockUpdateEdgeMegaportHa.RUnlock()
+	return calls
+}
+
+// UpdateEdgeNEO calls UpdateEdgeNEOFunc.
+func (mock *ClientInterfaceMock) UpdateEdgeNEO(ctx context.Context, edgeNEO *EdgeNEO) error {
+	if mock.UpdateEdgeNEOFunc == nil {
+		panic("ClientInterfaceMock.UpdateEdgeNEOFunc: method is nil but ClientInterface.UpdateEdgeNEO was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		EdgeNEO *EdgeNEO
+	}{
+		Ctx:     ctx,
+		EdgeNEO: edgeNEO,
+	}
+	mock.lockUpdateEdgeNEO.Lock()
+	mock.calls.UpdateEdgeNEO = append(mock.calls.UpdateEdgeNEO, callInfo)
+	mock.lockUpdateEdgeNEO.Unlock()
+	return mock.UpdateEdgeNEOFunc(ctx, edgeNEO)
+}
+
+// UpdateEdgeNEOCalls gets all the calls that were made to UpdateEdgeNEO.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateEdgeNEOCalls())
+func (mock *ClientInterfaceMock) UpdateEdgeNEOCalls() []struct {
+	Ctx     context.Context
+	EdgeNEO *EdgeNEO
+} {
+	var calls []struct {
+		Ctx     context.Context
+		EdgeNEO *EdgeNEO
+	}
+	mock.lockUpdateEdgeNEO.RLock()
+	calls = mock.calls.UpdateEdgeNEO
+	mock.lockUpdateEdgeNEO.RUnlock()
+	return calls
+}
+
+// UpdateEdgeNEODevice calls UpdateEdgeNEODeviceFunc.
+func (mock *ClientInterfaceMock) UpdateEdgeNEODevice(ctx context.Context, edgeNEODevice *EdgeNEODevice) error {
+	if mock.UpdateEdgeNEODeviceFunc == nil {
+		panic("ClientInterfaceMock.UpdateEdgeNEODeviceFunc: method is nil but ClientInterface.UpdateEdgeNEODevice was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		EdgeNEODevice *EdgeNEODevice
+	}{
+		Ctx:           ctx,
+		EdgeNEODevice: edgeNEODevice,
+	}
+	mock.lockUpdateEdgeNEODevice.Lock()
+	mock.calls.UpdateEdgeNEODevice = append(mock.calls.UpdateEdgeNEODevice, callInfo)
+	mock.lockUpdateEdgeNEODevice.Unlock()
+	return mock.UpdateEdgeNEODeviceFunc(ctx, edgeNEODevice)
+}
+
+// UpdateEdgeNEODeviceCalls gets all the calls that were made to UpdateEdgeNEODevice.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateEdgeNEODeviceCalls())
+func (mock *ClientInterfaceMock) UpdateEdgeNEODeviceCalls() []struct {
+	Ctx           context.Context
+	EdgeNEODevice *EdgeNEODevice
+} {
+	var calls []struct {
+		Ctx           context.Context
+		EdgeNEODevice *EdgeNEODevice
+	}
+	mock.lockUpdateEdgeNEODevice.RLock()
+	calls = mock.calls.UpdateEdgeNEODevice
+	mock.lockUpdateEdgeNEODevice.RUnlock()
+	return calls
+}
+
+// UpdateEdgeNEOHa calls UpdateEdgeNEOHaFunc.
+func (mock *ClientInterfaceMock) UpdateEdgeNEOHa(ctx context.Context, edgeNEO *EdgeNEO) error {
+	if mock.UpdateEdgeNEOHaFunc == nil {
+		panic("ClientInterfaceMock.UpdateEdgeNEOHaFunc: method is nil but ClientInterface.UpdateEdgeNEOHa was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		EdgeNEO *EdgeNEO
+	}{
+		Ctx:     ctx,
+		EdgeNEO: edgeNEO,
+	}
+	mock.lockUpdateEdgeNEOHa.Lock()
+	mock.calls.UpdateEdgeNEOHa = append(mock.calls.UpdateEdgeNEOHa, callInfo)
+	mock.lockUpdateEdgeNEOHa.Unlock()
+	return mock.UpdateEdgeNEOHaFunc(ctx, edgeNEO)
+}
+
+// UpdateEdgeNEOHaCalls gets all the calls that were made to UpdateEdgeNEOHa.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateEdgeNEOHaCalls())
+func (mock *ClientInterfaceMock) UpdateEdgeNEOHaCalls() []struct {
+	Ctx     context.Context
+	EdgeNEO *EdgeNEO
+} {
+	var calls []struct {
+		Ctx     context.Context
+		EdgeNEO *EdgeNEO
+	}
+	mock.lockUpdateEdgeNEOHa.RLock()
+	calls = mock.calls.UpdateEdgeNEOHa
+	mock.lockUpdateEdgeNEOHa.RUnlock()
+	return calls
+}
+
+// UpdateEdgeProxyProfile calls UpdateEdgeProxyProfileFunc.
+func (mock *ClientInterfaceMock) UpdateEdgeProxyProfile(ctx context.Context, edgeNEOProxyProfile *EdgePlatformProxyProfileUpdate) error {
+	if mock.UpdateEdgeProxyProfileFunc == nil {
+		panic("ClientInterfaceMock.UpdateEdgeProxyProfileFunc: method is nil but ClientInterface.UpdateEdgeProxyProfile was just called")
+	}
+	callInfo := struct {
+		Ctx                 context.Context
+		EdgeNEOProxyProfile *EdgePlatformProxyProfileUpdate
+	}{
+		Ctx:                 ctx,
+		EdgeNEOProxyProfile: edgeNEOProxyProfile,
+	}
+	mock.lockUpdateEdgeProxyProfile.Lock()
+	mock.calls.UpdateEdgeProxyProfile = append(mock.calls.UpdateEdgeProxyProfile, callInfo)
+	mock.lockUpdateEdgeProxyProfile.Unlock()
+	return mock.UpdateEdgeProxyProfileFunc(ctx, edgeNEOProxyProfile)
+}
+
+// UpdateEdgeProxyProfileCalls gets all the calls that were made to UpdateEdgeProxyProfile.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateEdgeProxyProfileCalls())
+func (mock *ClientInterfaceMock) UpdateEdgeProxyProfileCalls() []struct {
+	Ctx                 context.Context
+	EdgeNEOProxyProfile *EdgePlatformProxyProfileUpdate
+} {
+	var calls []struct {
+		Ctx                 context.Context
+		EdgeNEOProxyProfile *EdgePlatformProxyProfileUpdate
+	}
+	mock.lockUpdateEdgeProxyProfile.RLock()
+	calls = mock.calls.UpdateEdgeProxyProfile
+	mock.lockUpdateEdgeProxyProfile.RUnlock()
+	return calls
+}
+
+// UpdateEdgeSpoke calls UpdateEdgeSpokeFunc.
+func (mock *ClientInterfaceMock) UpdateEdgeSpoke(ctx context.Context, edgeSpoke *EdgeSpoke) error {
+	if mock.UpdateEdgeSpokeFunc == nil {
+		panic("ClientInterfaceMock.UpdateEdgeSpokeFunc: method is nil but ClientInterface.UpdateEdgeSpoke was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		EdgeSpoke *EdgeSpoke
+	}{
+		Ctx:       ctx,
+		EdgeSpoke: edgeSpoke,
+	}
+	mock.lockUpdateEdgeSpoke.Lock()
+	mock.calls.UpdateEdgeSpoke = append(mock.calls.UpdateEdgeSpoke, callInfo)
+	mock.lockUpdateEdgeSpoke.Unlock()
+	return mock.UpdateEdgeSpokeFunc(ctx, edgeSpoke)
+}
+
+// UpdateEdgeSpokeCalls gets all the calls that were made to UpdateEdgeSpoke.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateEdgeSpokeCalls())
+func (mock *ClientInterfaceMock) UpdateEdgeSpokeCalls() []struct {
+	Ctx       context.Context
+	EdgeSpoke *EdgeSpoke
+} {
+	var calls []struct {
+		Ctx       context.Context
+		EdgeSpoke *EdgeSpoke
+	}
+	mock.lockUpdateEdgeSpoke.RLock()
+	calls = mock.calls.UpdateEdgeSpoke
+	mock.lockUpdateEdgeSpoke.RUnlock()
+	return calls
+}
+
+// UpdateEdgeSpokeGeoCoordinate calls UpdateEdgeSpokeGeoCoordinateFunc.
+func (mock *ClientInterfaceMock) UpdateEdgeSpokeGeoCoordinate(ctx context.Context, edgeSpoke *EdgeSpoke) error {
+	if mock.UpdateEdgeSpokeGeoCoordinateFunc == nil {
+		panic("ClientInterfaceMock.UpdateEdgeSpokeGeoCoordinateFunc: method is nil but ClientInterface.UpdateEdgeSpokeGeoCoordinate was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		EdgeSpoke *EdgeSpoke
+	}{
+		Ctx:       ctx,
+		EdgeSpoke: edgeSpoke,
+	}
+	mock.lockUpdateEdgeSpokeGeoCoordinate.Lock()
+	mock.calls.UpdateEdgeSpokeGeoCoordinate = append(mock.calls.UpdateEdgeSpokeGeoCoordinate, callInfo)
+	mock.lockUpdateEdgeSpokeGeoCoordinate.Unlock()
+	return mock.UpdateEdgeSpokeGeoCoordinateFunc(ctx, edgeSpoke)
+}
+
+// UpdateEdgeSpokeGeoCoordinateCalls gets all the calls that were made to UpdateEdgeSpokeGeoCoordinate.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateEdgeSpokeGeoCoordinateCalls())
+func (mock *ClientInterfaceMock) UpdateEdgeSpokeGeoCoordinateCalls() []struct {
+	Ctx       context.Context
+	EdgeSpoke *EdgeSpoke
+} {
+	var calls []struct {
+		Ctx       context.Context
+		EdgeSpoke *EdgeSpoke
+	}
+	mock.lockUpdateEdgeSpokeGeoCoordinate.RLock()
+	calls = mock.calls.UpdateEdgeSpokeGeoCoordinate
+	mock.lockUpdateEdgeSpokeGeoCoordinate.RUnlock()
+	return calls
+}
+
+// UpdateEdgeSpokeTransitPeeringTunnelCount calls UpdateEdgeSpokeTransitPeeringTunnelCountFunc.
+func (mock *ClientInterfaceMock) UpdateEdgeSpokeTransitPeeringTunnelCount(gateway1 string, gateway2 string, tunnelCount int) error {
+	if mock.UpdateEdgeSpokeTransitPeeringTunnelCountFunc == nil {
+		panic("ClientInterfaceMock.UpdateEdgeSpokeTransitPeeringTunnelCountFunc: method is nil but ClientInterface.UpdateEdgeSpokeTransitPeeringTunnelCount was just called")
+	}
+	callInfo := struct {
+		Gateway1    string
+		Gateway2    string
+		TunnelCount int
+	}{
+		Gateway1:    gateway1,
+		Gateway2:    gateway2,
+		TunnelCount: tunnelCount,
+	}
+	mock.lockUpdateEdgeSpokeTransitPeeringTunnelCount.Lock()
+	mock.calls.UpdateEdgeSpokeTransitPeeringTunnelCount = append(mock.calls.UpdateEdgeSpokeTransitPeeringTunnelCount, callInfo)
+	mock.lockUpdateEdgeSpokeTransitPeeringTunnelCount.Unlock()
+	return mock.UpdateEdgeSpokeTransitPeeringTunnelCountFunc(gateway1, gateway2, tunnelCount)
+}
+
+// UpdateEdgeSpokeTransitPeeringTunnelCountCalls gets all the calls that were made to UpdateEdgeSpokeTransitPeeringTunnelCount.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateEdgeSpokeTransitPeeringTunnelCountCalls())
+func (mock *ClientInterfaceMock) UpdateEdgeSpokeTransitPeeringTunnelCountCalls() []struct {
+	Gateway1    string
+	Gateway2    string
+	TunnelCount int
+} {
+	var calls []struct {
+		Gateway1    string
+		Gateway2    string
+		TunnelCount int
+	}
+	mock.lockUpdateEdgeSpokeTransitPeeringTunnelCount.RLock()
+	calls = mock.calls.UpdateEdgeSpokeTransitPeeringTunnelCount
+	mock.lockUpdateEdgeSpokeTransitPeeringTunnelCount.RUnlock()
+	return calls
+}
+
+// UpdateEdgeVmSelfmanagedHa calls UpdateEdgeVmSelfmanagedHaFunc.
+func (mock *ClientInterfaceMock) UpdateEdgeVmSelfmanagedHa(ctx context.Context, edgeVmSelfmanaged *EdgeSpoke) error {
+	if mock.UpdateEdgeVmSelfmanagedHaFunc == nil {
+		panic("ClientInterfaceMock.UpdateEdgeVmSelfmanagedHaFunc: method is nil but ClientInterface.UpdateEdgeVmSelfmanagedHa was just called")
+	}
+	callInfo := struct {
+		Ctx               context.Context
+		EdgeVmSelfmanaged *EdgeSpoke
+	}{
+		Ctx:               ctx,
+		EdgeVmSelfmanaged: edgeVmSelfmanaged,
+	}
+	mock.lockUpdateEdgeVmSelfmanagedHa.Lock()
+	mock.calls.UpdateEdgeVmSelfmanagedHa = append(mock.calls.UpdateEdgeVmSelfmanagedHa, callInfo)
+	mock.lockUpdateEdgeVmSelfmanagedHa.Unlock()
+	return mock.UpdateEdgeVmSelfmanagedHaFunc(ctx, edgeVmSelfmanaged)
+}
+
+// UpdateEdgeVmSelfmanagedHaCalls gets all the calls that were made to UpdateEdgeVmSelfmanagedHa.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateEdgeVmSelfmanagedHaCalls())
+func (mock *ClientInterfaceMock) UpdateEdgeVmSelfmanagedHaCalls() []struct {
+	Ctx               context.Context
+	EdgeVmSelfmanaged *EdgeSpoke
+} {
+	var calls []struct {
+		Ctx               context.Context
+		EdgeVmSelfmanaged *EdgeSpoke
+	}
+	mock.lockUpdateEdgeVmSelfmanagedHa.RLock()
+	calls = mock.calls.UpdateEdgeVmSelfmanagedHa
+	mock.lockUpdateEdgeVmSelfmanagedHa.RUnlock()
+	return calls
+}
+
+// UpdateEnforcementLevel calls UpdateEnforcementLevelFunc.
+func (mock *ClientInterfaceMock) UpdateEnforcementLevel(ctx context.Context, enforcementLevel *EnforcementLevel) error {
+	if mock.UpdateEnforcementLevelFunc == nil {
+		panic("ClientInterfaceMock.UpdateEnforcementLevelFunc: method is nil but ClientInterface.UpdateEnforcementLevel was just called")
+	}
+	callInfo := struct {
+		Ctx              context.Context
+		EnforcementLevel *EnforcementLevel
+	}{
+		Ctx:              ctx,
+		EnforcementLevel: enforcementLevel,
+	}
+	mock.lockUpdateEnforcementLevel.Lock()
+	mock.calls.UpdateEnforcementLevel = append(mock.calls.UpdateEnforcementLevel, callInfo)
+	mock.lockUpdateEnforcementLevel.Unlock()
+	return mock.UpdateEnforcementLevelFunc(ctx, enforcementLevel)
+}
+
+// UpdateEnforcementLevelCalls gets all the calls that were made to UpdateEnforcementLevel.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateEnforcementLevelCalls())
+func (mock *ClientInterfaceMock) UpdateEnforcementLevelCalls() []struct {
+	Ctx              context.Context
+	EnforcementLevel *EnforcementLevel
+} {
+	var calls []struct {
+		Ctx              context.Context
+		EnforcementLevel *EnforcementLevel
+	}
+	mock.lockUpdateEnforcementLevel.RLock()
+	calls = mock.calls.UpdateEnforcementLevel
+	mock.lockUpdateEnforcementLevel.RUnlock()
+	return calls
+}
+
+// UpdateGCPAccount calls UpdateGCPAccountFunc.
+func (mock *ClientInterfaceMock) UpdateGCPAccount(account *Account) error {
+	if mock.UpdateGCPAccountFunc == nil {
+		panic("ClientInterfaceMock.UpdateGCPAccountFunc: method is nil but ClientInterface.UpdateGCPAccount was just called")
+	}
+	callInfo := struct {
+		Account *Account
+	}{
+		Account: account,
+	}
+	mock.lockUpdateGCPAccount.Lock()
+	mock.calls.UpdateGCPAccount = append(mock.calls.UpdateGCPAccount, callInfo)
+	mock.lockUpdateGCPAccount.Unlock()
+	return mock.UpdateGCPAccountFunc(account)
+}
+
+// UpdateGCPAccountCalls gets all the calls that were made to UpdateGCPAccount.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateGCPAccountCalls())
+func (mock *ClientInterfaceMock) UpdateGCPAccountCalls() []struct {
+	Account *Account
+} {
+	var calls []struct {
+		Account *Account
+	}
+	mock.lockUpdateGCPAccount.RLock()
+	calls = mock.calls.UpdateGCPAccount
+	mock.lockUpdateGCPAccount.RUnlock()
+	return calls
+}
+
+// UpdateGateway calls UpdateGatewayFunc.
+func (mock *ClientInterfaceMock) UpdateGateway(gateway *Gateway) error {
+	if mock.UpdateGatewayFunc == nil {
+		panic("ClientInterfaceMock.UpdateGatewayFunc: method is nil but ClientInterface.UpdateGateway was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockUpdateGateway.Lock()
+	mock.calls.UpdateGateway = append(mock.calls.UpdateGateway, callInfo)
+	mock.lockUpdateGateway.Unlock()
+	return mock.UpdateGatewayFunc(gateway)
+}
+
+// UpdateGatewayCalls gets all the calls that were made to UpdateGateway.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateGatewayCalls())
+func (mock *ClientInterfaceMock) UpdateGatewayCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockUpdateGateway.RLock()
+	calls = mock.calls.UpdateGateway
+	mock.lockUpdateGateway.RUnlock()
+	return calls
+}
+
+// UpdateGatewayContext calls UpdateGatewayContextFunc.
+func (mock *ClientInterfaceMock) UpdateGatewayContext(ctx context.Context, gateway *Gateway) error {
+	if mock.UpdateGatewayContextFunc == nil {
+		panic("ClientInterfaceMock.UpdateGatewayContextFunc: method is nil but ClientInterface.UpdateGatewayContext was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}{
+		Ctx:     ctx,
+		Gateway: gateway,
+	}
+	mock.lockUpdateGatewayContext.Lock()
+	mock.calls.UpdateGatewayContext = append(mock.calls.UpdateGatewayContext, callInfo)
+	mock.lockUpdateGatewayContext.Unlock()
+	return mock.UpdateGatewayContextFunc(ctx, gateway)
+}
+
+// UpdateGatewayContextCalls gets all the calls that were made to UpdateGatewayContext.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateGatewayContextCalls())
+func (mock *ClientInterfaceMock) UpdateGatewayContextCalls() []struct {
+	Ctx     context.Context
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}
+	mock.lockUpdateGatewayContext.RLock()
+	calls = mock.calls.UpdateGatewayContext
+	mock.lockUpdateGatewayContext.RUnlock()
+	return calls
+}
+
+// UpdateGroupInstanceSize calls UpdateGroupInstanceSizeFunc.
+func (mock *ClientInterfaceMock) UpdateGroupInstanceSize(ctx context.Context, groupName string, instanceSize string) error {
+	if mock.UpdateGroupInstanceSizeFunc == nil {
+		panic("ClientInterfaceMock.UpdateGroupInstanceSizeFunc: method is nil but ClientInterface.UpdateGroupInstanceSize was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		GroupName    string
+		InstanceSize string
+	}{
+		Ctx:          ctx,
+		GroupName:    groupName,
+		InstanceSize: instanceSize,
+	}
+	mock.lockUpdateGroupInstanceSize.Lock()
+	mock.calls.UpdateGroupInstanceSize = append(mock.calls.UpdateGroupInstanceSize, callInfo)
+	mock.lockUpdateGroupInstanceSize.Unlock()
+	return mock.UpdateGroupInstanceSizeFunc(ctx, groupName, instanceSize)
+}
+
+// UpdateGroupInstanceSizeCalls gets all the calls that were made to UpdateGroupInstanceSize.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateGroupInstanceSizeCalls())
+func (mock *ClientInterfaceMock) UpdateGroupInstanceSizeCalls() []struct {
+	Ctx          context.Context
+	GroupName    string
+	InstanceSize string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		GroupName    string
+		InstanceSize string
+	}
+	mock.lockUpdateGroupInstanceSize.RLock()
+	calls = mock.calls.UpdateGroupInstanceSize
+	mock.lockUpdateGroupInstanceSize.RUnlock()
+	return calls
+}
+
+// UpdateIpsProfile calls UpdateIpsProfileFunc.
+func (mock *ClientInterfaceMock) UpdateIpsProfile(ctx context.Context, uuid string, profile *IpsProfile) (*IpsProfile, error) {
+	if mock.UpdateIpsProfileFunc == nil {
+		panic("ClientInterfaceMock.UpdateIpsProfileFunc: method is nil but ClientInterface.UpdateIpsProfile was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		UUID    string
+		Profile *IpsProfile
+	}{
+		Ctx:     ctx,
+		UUID:    uuid,
+		Profile: profile,
+	}
+	mock.lockUpdateIpsProfile.Lock()
+	mock.calls.UpdateIpsProfile = append(mock.calls.UpdateIpsProfile, callInfo)
+	mock.lockUpdateIpsProfile.Unlock()
+	return mock.UpdateIpsProfileFunc(ctx, uuid, profile)
+}
+
+// UpdateIpsProfileCalls gets all the calls that were made to UpdateIpsProfile.
+// Check the length with:
 //
-//		// use mockedClientInterface in code that requires ClientInterface
-//		// and then make assertions.
+//	len(mockedClientInterface.UpdateIpsProfileCalls())
+func (mock *ClientInterfaceMock) UpdateIpsProfileCalls() []struct {
+	Ctx     context.Context
+	UUID    string
+	Profile *IpsProfile
+} {
+	var calls []struct {
+		Ctx     context.Context
+		UUID    string
+		Profile *IpsProfile
+	}
+	mock.lockUpdateIpsProfile.RLock()
+	calls = mock.calls.UpdateIpsProfile
+	mock.lockUpdateIpsProfile.RUnlock()
+	return calls
+}
+
+// UpdateIpsRuleFeed calls UpdateIpsRuleFeedFunc.
+func (mock *ClientInterfaceMock) UpdateIpsRuleFeed(ctx context.Context, uuid string, ruleFeed *IpsRuleFeed) (*IpsRuleFeed, error) {
+	if mock.UpdateIpsRuleFeedFunc == nil {
+		panic("ClientInterfaceMock.UpdateIpsRuleFeedFunc: method is nil but ClientInterface.UpdateIpsRuleFeed was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		UUID     string
+		RuleFeed *IpsRuleFeed
+	}{
+		Ctx:      ctx,
+		UUID:     uuid,
+		RuleFeed: ruleFeed,
+	}
+	mock.lockUpdateIpsRuleFeed.Lock()
+	mock.calls.UpdateIpsRuleFeed = append(mock.calls.UpdateIpsRuleFeed, callInfo)
+	mock.lockUpdateIpsRuleFeed.Unlock()
+	return mock.UpdateIpsRuleFeedFunc(ctx, uuid, ruleFeed)
+}
+
+// UpdateIpsRuleFeedCalls gets all the calls that were made to UpdateIpsRuleFeed.
+// Check the length with:
 //
-//	}
-type ClientInterfaceMock struct {
-	// AuditAccountFunc mocks the AuditAccount method.
-	AuditAccountFunc func(ctx context.Context, account *Account) error
+//	len(mockedClientInterface.UpdateIpsRuleFeedCalls())
+func (mock *ClientInterfaceMock) UpdateIpsRuleFeedCalls() []struct {
+	Ctx      context.Context
+	UUID     string
+	RuleFeed *IpsRuleFeed
+} {
+	var calls []struct {
+		Ctx      context.Context
+		UUID     string
+		RuleFeed *IpsRuleFeed
+	}
+	mock.lockUpdateIpsRuleFeed.RLock()
+	calls = mock.calls.UpdateIpsRuleFeed
+	mock.lockUpdateIpsRuleFeed.RUnlock()
+	return calls
+}
 
-	// DeleteAccountFunc mocks the DeleteAccount method.
-	DeleteAccountFunc func(account *Account) error
+// UpdateMaxVpnConn calls UpdateMaxVpnConnFunc.
+func (mock *ClientInterfaceMock) UpdateMaxVpnConn(gateway *Gateway) error {
+	if mock.UpdateMaxVpnConnFunc == nil {
+		panic("ClientInterfaceMock.UpdateMaxVpnConnFunc: method is nil but ClientInterface.UpdateMaxVpnConn was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockUpdateMaxVpnConn.Lock()
+	mock.calls.UpdateMaxVpnConn = append(mock.calls.UpdateMaxVpnConn, callInfo)
+	mock.lockUpdateMaxVpnConn.Unlock()
+	return mock.UpdateMaxVpnConnFunc(gateway)
+}
 
-	// GetAccountFunc mocks the GetAccount method.
-	GetAccountFunc func(account *Account) (Account, error)
+// UpdateMaxVpnConnCalls gets all the calls that were made to UpdateMaxVpnConn.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateMaxVpnConnCalls())
+func (mock *ClientInterfaceMock) UpdateMaxVpnConnCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockUpdateMaxVpnConn.RLock()
+	calls = mock.calls.UpdateMaxVpnConn
+	mock.lockUpdateMaxVpnConn.RUnlock()
+	return calls
+}
 
-	// calls tracks calls to the methods.
-	calls struct {
-		// AuditAccount holds details about calls to the AuditAccount method.
-		AuditAccount []struct {
-			// Ctx is the ctx argument value.
-			Ctx context.Context
-			// Account is the account argument value.
-			Account *Account
-		}
-		// DeleteAccount holds details about calls to the DeleteAccount method.
-		DeleteAccount []struct {
-			// Account is the account argument value.
-			Account *Account
-		}
-		// GetAccount holds details about calls to the GetAccount method.
-		GetAccount []struct {
-			// Account is the account argument value.
-			Account *Account
-		}
+// UpdateSite2Cloud calls UpdateSite2CloudFunc.
+func (mock *ClientInterfaceMock) UpdateSite2Cloud(site2cloud *EditSite2Cloud) error {
+	if mock.UpdateSite2CloudFunc == nil {
+		panic("ClientInterfaceMock.UpdateSite2CloudFunc: method is nil but ClientInterface.UpdateSite2Cloud was just called")
 	}
-	lockAuditAccount  sync.RWMutex
-	lockDeleteAccount sync.RWMutex
-	lockGetAccount    sync.RWMutex
+	callInfo := struct {
+		Site2cloud *EditSite2Cloud
+	}{
+		Site2cloud: site2cloud,
+	}
+	mock.lockUpdateSite2Cloud.Lock()
+	mock.calls.UpdateSite2Cloud = append(mock.calls.UpdateSite2Cloud, callInfo)
+	mock.lockUpdateSite2Cloud.Unlock()
+	return mock.UpdateSite2CloudFunc(site2cloud)
 }
 
-// AuditAccount calls AuditAccountFunc.
-func (mock *ClientInterfaceMock) AuditAccount(ctx context.Context, account *Account) error {
-	if mock.AuditAccountFunc == nil {
-		panic("ClientInterfaceMock.AuditAccountFunc: method is nil but ClientInterface.AuditAccount was just called")
+// UpdateSite2CloudCalls gets all the calls that were made to UpdateSite2Cloud.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateSite2CloudCalls())
+func (mock *ClientInterfaceMock) UpdateSite2CloudCalls() []struct {
+	Site2cloud *EditSite2Cloud
+} {
+	var calls []struct {
+		Site2cloud *EditSite2Cloud
+	}
+	mock.lockUpdateSite2Cloud.RLock()
+	calls = mock.calls.UpdateSite2Cloud
+	mock.lockUpdateSite2Cloud.RUnlock()
+	return calls
+}
+
+// UpdateSmartGroup calls UpdateSmartGroupFunc.
+func (mock *ClientInterfaceMock) UpdateSmartGroup(ctx context.Context, smartGroup *SmartGroup, uuid string) error {
+	if mock.UpdateSmartGroupFunc == nil {
+		panic("ClientInterfaceMock.UpdateSmartGroupFunc: method is nil but ClientInterface.UpdateSmartGroup was just called")
 	}
 	callInfo := struct {
-		Ctx     context.Context
-		Account *Account
+		Ctx        context.Context
+		SmartGroup *SmartGroup
+		UUID       string
 	}{
-		Ctx:     ctx,
-		Account: account,
+		Ctx:        ctx,
+		SmartGroup: smartGroup,
+		UUID:       uuid,
 	}
-	mock.lockAuditAccount.Lock()
-	mock.calls.AuditAccount = append(mock.calls.AuditAccount, callInfo)
-	mock.lockAuditAccount.Unlock()
-	return mock.AuditAccountFunc(ctx, account)
+	mock.lockUpdateSmartGroup.Lock()
+	mock.calls.UpdateSmartGroup = append(mock.calls.UpdateSmartGroup, callInfo)
+	mock.lockUpdateSmartGroup.Unlock()
+	return mock.UpdateSmartGroupFunc(ctx, smartGroup, uuid)
 }
 
-func (mock *ClientInterfaceMock) InvalidateCache() {
+// UpdateSmartGroupCalls gets all the calls that were made to UpdateSmartGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateSmartGroupCalls())
+func (mock *ClientInterfaceMock) UpdateSmartGroupCalls() []struct {
+	Ctx        context.Context
+	SmartGroup *SmartGroup
+	UUID       string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		SmartGroup *SmartGroup
+		UUID       string
+	}
+	mock.lockUpdateSmartGroup.RLock()
+	calls = mock.calls.UpdateSmartGroup
+	mock.lockUpdateSmartGroup.RUnlock()
+	return calls
 }
 
-// AuditAccountCalls gets all the calls that were made to AuditAccount.
+// UpdateSpokeGatewaySubnetGroup calls UpdateSpokeGatewaySubnetGroupFunc.
+func (mock *ClientInterfaceMock) UpdateSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error {
+	if mock.UpdateSpokeGatewaySubnetGroupFunc == nil {
+		panic("ClientInterfaceMock.UpdateSpokeGatewaySubnetGroupFunc: method is nil but ClientInterface.UpdateSpokeGatewaySubnetGroup was just called")
+	}
+	callInfo := struct {
+		Ctx                     context.Context
+		SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+	}{
+		Ctx:                     ctx,
+		SpokeGatewaySubnetGroup: spokeGatewaySubnetGroup,
+	}
+	mock.lockUpdateSpokeGatewaySubnetGroup.Lock()
+	mock.calls.UpdateSpokeGatewaySubnetGroup = append(mock.calls.UpdateSpokeGatewaySubnetGroup, callInfo)
+	mock.lockUpdateSpokeGatewaySubnetGroup.Unlock()
+	return mock.UpdateSpokeGatewaySubnetGroupFunc(ctx, spokeGatewaySubnetGroup)
+}
+
+// UpdateSpokeGatewaySubnetGroupCalls gets all the calls that were made to UpdateSpokeGatewaySubnetGroup.
 // Check the length with:
 //
-//	len(mockedClientInterface.AuditAccountCalls())
-func (mock *ClientInterfaceMock) AuditAccountCalls() []struct {
-	Ctx     context.Context
-	Account *Account
+//	len(mockedClientInterface.UpdateSpokeGatewaySubnetGroupCalls())
+func (mock *ClientInterfaceMock) UpdateSpokeGatewaySubnetGroupCalls() []struct {
+	Ctx                     context.Context
+	SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
 } {
 	var calls []struct {
-		Ctx     context.Context
-		Account *Account
+		Ctx                     context.Context
+		SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
 	}
-	mock.lockAuditAccount.RLock()
-	calls = mock.calls.AuditAccount
-	mock.lockAuditAccount.RUnlock()
+	mock.lockUpdateSpokeGatewaySubnetGroup.RLock()
+	calls = mock.calls.UpdateSpokeGatewaySubnetGroup
+	mock.lockUpdateSpokeGatewaySubnetGroup.RUnlock()
 	return calls
 }
 
-// DeleteAccount calls DeleteAccountFunc.
-func (mock *ClientInterfaceMock) DeleteAccount(account *Account) error {
-	if mock.DeleteAccountFunc == nil {
-		panic("ClientInterfaceMock.DeleteAccountFunc: method is nil but ClientInterface.DeleteAccount was just called")
+// UpdateSpokePendingApprovedCidrs calls UpdateSpokePendingApprovedCidrsFunc.
+func (mock *ClientInterfaceMock) UpdateSpokePendingApprovedCidrs(gateway *SpokeVpc) error {
+	if mock.UpdateSpokePendingApprovedCidrsFunc == nil {
+		panic("ClientInterfaceMock.UpdateSpokePendingApprovedCidrsFunc: method is nil but ClientInterface.UpdateSpokePendingApprovedCidrs was just called")
 	}
 	callInfo := struct {
-		Account *Account
+		Gateway *SpokeVpc
 	}{
-		Account: account,
+		Gateway: gateway,
 	}
-	mock.lockDeleteAccount.Lock()
-	mock.calls.DeleteAccount = append(mock.calls.DeleteAccount, callInfo)
-	mock.lockDeleteAccount.Unlock()
-	return mock.DeleteAccountFunc(account)
+	mock.lockUpdateSpokePendingApprovedCidrs.Lock()
+	mock.calls.UpdateSpokePendingApprovedCidrs = append(mock.calls.UpdateSpokePendingApprovedCidrs, callInfo)
+	mock.lockUpdateSpokePendingApprovedCidrs.Unlock()
+	return mock.UpdateSpokePendingApprovedCidrsFunc(gateway)
 }
 
-// DeleteAccountCalls gets all the calls that were made to DeleteAccount.
+// UpdateSpokePendingApprovedCidrsCalls gets all the calls that were made to UpdateSpokePendingApprovedCidrs.
 // Check the length with:
 //
-//	len(mockedClientInterface.DeleteAccountCalls())
-func (mock *ClientInterfaceMock) DeleteAccountCalls() []struct {
-	Account *Account
+//	len(mockedClientInterface.UpdateSpokePendingApprovedCidrsCalls())
+func (mock *ClientInterfaceMock) UpdateSpokePendingApprovedCidrsCalls() []struct {
+	Gateway *SpokeVpc
 } {
 	var calls []struct {
-		Account *Account
+		Gateway *SpokeVpc
 	}
-	mock.lockDeleteAccount.RLock()
-	calls = mock.calls.DeleteAccount
-	mock.lockDeleteAccount.RUnlock()
+	mock.lockUpdateSpokePendingApprovedCidrs.RLock()
+	calls = mock.calls.UpdateSpokePendingApprovedCidrs
+	mock.lockUpdateSpokePendingApprovedCidrs.RUnlock()
 	return calls
 }
 
-// GetAccount calls GetAccountFunc.
-func (mock *ClientInterfaceMock) GetAccount(account *Account) (Account, error) {
-	if mock.GetAccountFunc == nil {
-		panic("ClientInterfaceMock.GetAccountFunc: method is nil but ClientInterface.GetAccount was just called")
+// UpdateSpokePendingApprovedCidrsGatewayGroup calls UpdateSpokePendingApprovedCidrsGatewayGroupFunc.
+func (mock *ClientInterfaceMock) UpdateSpokePendingApprovedCidrsGatewayGroup(ctx context.Context, groupName string, approvedCidrs []string) error {
+	if mock.UpdateSpokePendingApprovedCidrsGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.UpdateSpokePendingApprovedCidrsGatewayGroupFunc: method is nil but ClientInterface.UpdateSpokePendingApprovedCidrsGatewayGroup was just called")
 	}
 	callInfo := struct {
-		Account *Account
+		Ctx           context.Context
+		GroupName     string
+		ApprovedCidrs []string
 	}{
-		Account: account,
+		Ctx:           ctx,
+		GroupName:     groupName,
+		ApprovedCidrs: approvedCidrs,
 	}
-	mock.lockGetAccount.Lock()
-	mock.calls.GetAccount = append(mock.calls.GetAccount, callInfo)
-	mock.lockGetAccount.Unlock()
-	return mock.GetAccountFunc(account)
+	mock.lockUpdateSpokePendingApprovedCidrsGatewayGroup.Lock()
+	mock.calls.UpdateSpokePendingApprovedCidrsGatewayGroup = append(mock.calls.UpdateSpokePendingApprovedCidrsGatewayGroup, callInfo)
+	mock.lockUpdateSpokePendingApprovedCidrsGatewayGroup.Unlock()
+	return mock.UpdateSpokePendingApprovedCidrsGatewayGroupFunc(ctx, groupName, approvedCidrs)
 }
 
-// GetAccountCalls gets all the calls that were made to GetAccount.
+// UpdateSpokePendingApprovedCidrsGatewayGroupCalls gets all the calls that were made to UpdateSpokePendingApprovedCidrsGatewayGroup.
 // Check the length with:
 //
-//	len(mockedClientInterface.GetAccountCalls())
-func (mock *ClientInterfaceMock) GetAccountCalls() []struct {
-	Account *Account
+//	len(mockedClientInterface.UpdateSpokePendingApprovedCidrsGatewayGroupCalls())
+func (mock *ClientInterfaceMock) UpdateSpokePendingApprovedCidrsGatewayGroupCalls() []struct {
+	Ctx           context.Context
+	GroupName     string
+	ApprovedCidrs []string
 } {
 	var calls []struct {
-		Account *Account
+		Ctx           context.Context
+		GroupName     string
+		ApprovedCidrs []string
 	}
-	mock.lockGetAccount.RLock()
-	calls = mock.calls.GetAccount
-	mock.lockGetAccount.RUnlock()
+	mock.lockUpdateSpokePendingApprovedCidrsGatewayGroup.RLock()
+	calls = mock.calls.UpdateSpokePendingApprovedCidrsGatewayGroup
+	mock.lockUpdateSpokePendingApprovedCidrsGatewayGroup.RUnlock()
+	return calls
+}
+
+// UpdateTLSProfile calls UpdateTLSProfileFunc.
+func (mock *ClientInterfaceMock) UpdateTLSProfile(ctx context.Context, uuid string, tlsProfile *TLSProfile) error {
+	if mock.UpdateTLSProfileFunc == nil {
+		panic("ClientInterfaceMock.UpdateTLSProfileFunc: method is nil but ClientInterface.UpdateTLSProfile was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		UUID       string
+		TlsProfile *TLSProfile
+	}{
+		Ctx:        ctx,
+		UUID:       uuid,
+		TlsProfile: tlsProfile,
+	}
+	mock.lockUpdateTLSProfile.Lock()
+	mock.calls.UpdateTLSProfile = append(mock.calls.UpdateTLSProfile, callInfo)
+	mock.lockUpdateTLSProfile.Unlock()
+	return mock.UpdateTLSProfileFunc(ctx, uuid, tlsProfile)
+}
+
+// UpdateTLSProfileCalls gets all the calls that were made to UpdateTLSProfile.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateTLSProfileCalls())
+func (mock *ClientInterfaceMock) UpdateTLSProfileCalls() []struct {
+	Ctx        context.Context
+	UUID       string
+	TlsProfile *TLSProfile
+} {
+	var calls []struct {
+		Ctx        context.Context
+		UUID       string
+		TlsProfile *TLSProfile
+	}
+	mock.lockUpdateTLSProfile.RLock()
+	calls = mock.calls.UpdateTLSProfile
+	mock.lockUpdateTLSProfile.RUnlock()
+	return calls
+}
+
+// UpdateTags calls UpdateTagsFunc.
+func (mock *ClientInterfaceMock) UpdateTags(tags *Tags) error {
+	if mock.UpdateTagsFunc == nil {
+		panic("ClientInterfaceMock.UpdateTagsFunc: method is nil but ClientInterface.UpdateTags was just called")
+	}
+	callInfo := struct {
+		Tags *Tags
+	}{
+		Tags: tags,
+	}
+	mock.lockUpdateTags.Lock()
+	mock.calls.UpdateTags = append(mock.calls.UpdateTags, callInfo)
+	mock.lockUpdateTags.Unlock()
+	return mock.UpdateTagsFunc(tags)
+}
+
+// UpdateTagsCalls gets all the calls that were made to UpdateTags.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateTagsCalls())
+func (mock *ClientInterfaceMock) UpdateTagsCalls() []struct {
+	Tags *Tags
+} {
+	var calls []struct {
+		Tags *Tags
+	}
+	mock.lockUpdateTags.RLock()
+	calls = mock.calls.UpdateTags
+	mock.lockUpdateTags.RUnlock()
+	return calls
+}
+
+// UpdateTransitGatewayCustomizedVpcRoute calls UpdateTransitGatewayCustomizedVpcRouteFunc.
+func (mock *ClientInterfaceMock) UpdateTransitGatewayCustomizedVpcRoute(gateway string, customizedTransitVpcRoutes []string) error {
+	if mock.UpdateTransitGatewayCustomizedVpcRouteFunc == nil {
+		panic("ClientInterfaceMock.UpdateTransitGatewayCustomizedVpcRouteFunc: method is nil but ClientInterface.UpdateTransitGatewayCustomizedVpcRoute was just called")
+	}
+	callInfo := struct {
+		Gateway                    string
+		CustomizedTransitVpcRoutes []string
+	}{
+		Gateway:                    gateway,
+		CustomizedTransitVpcRoutes: customizedTransitVpcRoutes,
+	}
+	mock.lockUpdateTransitGatewayCustomizedVpcRoute.Lock()
+	mock.calls.UpdateTransitGatewayCustomizedVpcRoute = append(mock.calls.UpdateTransitGatewayCustomizedVpcRoute, callInfo)
+	mock.lockUpdateTransitGatewayCustomizedVpcRoute.Unlock()
+	return mock.UpdateTransitGatewayCustomizedVpcRouteFunc(gateway, customizedTransitVpcRoutes)
+}
+
+// UpdateTransitGatewayCustomizedVpcRouteCalls gets all the calls that were made to UpdateTransitGatewayCustomizedVpcRoute.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateTransitGatewayCustomizedVpcRouteCalls())
+func (mock *ClientInterfaceMock) UpdateTransitGatewayCustomizedVpcRouteCalls() []struct {
+	Gateway                    string
+	CustomizedTransitVpcRoutes []string
+} {
+	var calls []struct {
+		Gateway                    string
+		CustomizedTransitVpcRoutes []string
+	}
+	mock.lockUpdateTransitGatewayCustomizedVpcRoute.RLock()
+	calls = mock.calls.UpdateTransitGatewayCustomizedVpcRoute
+	mock.lockUpdateTransitGatewayCustomizedVpcRoute.RUnlock()
+	return calls
+}
+
+// UpdateTransitPendingApprovedCidrs calls UpdateTransitPendingApprovedCidrsFunc.
+func (mock *ClientInterfaceMock) UpdateTransitPendingApprovedCidrs(gateway *TransitVpc) error {
+	if mock.UpdateTransitPendingApprovedCidrsFunc == nil {
+		panic("ClientInterfaceMock.UpdateTransitPendingApprovedCidrsFunc: method is nil but ClientInterface.UpdateTransitPendingApprovedCidrs was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockUpdateTransitPendingApprovedCidrs.Lock()
+	mock.calls.UpdateTransitPendingApprovedCidrs = append(mock.calls.UpdateTransitPendingApprovedCidrs, callInfo)
+	mock.lockUpdateTransitPendingApprovedCidrs.Unlock()
+	return mock.UpdateTransitPendingApprovedCidrsFunc(gateway)
+}
+
+// UpdateTransitPendingApprovedCidrsCalls gets all the calls that were made to UpdateTransitPendingApprovedCidrs.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateTransitPendingApprovedCidrsCalls())
+func (mock *ClientInterfaceMock) UpdateTransitPendingApprovedCidrsCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockUpdateTransitPendingApprovedCidrs.RLock()
+	calls = mock.calls.UpdateTransitPendingApprovedCidrs
+	mock.lockUpdateTransitPendingApprovedCidrs.RUnlock()
+	return calls
+}
+
+// UpdateTransitPendingApprovedCidrsGatewayGroup calls UpdateTransitPendingApprovedCidrsGatewayGroupFunc.
+func (mock *ClientInterfaceMock) UpdateTransitPendingApprovedCidrsGatewayGroup(ctx context.Context, groupName string, approvedCidrs []string) error {
+	if mock.UpdateTransitPendingApprovedCidrsGatewayGroupFunc == nil {
+		panic("ClientInterfaceMock.UpdateTransitPendingApprovedCidrsGatewayGroupFunc: method is nil but ClientInterface.UpdateTransitPendingApprovedCidrsGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		GroupName     string
+		ApprovedCidrs []string
+	}{
+		Ctx:           ctx,
+		GroupName:     groupName,
+		ApprovedCidrs: approvedCidrs,
+	}
+	mock.lockUpdateTransitPendingApprovedCidrsGatewayGroup.Lock()
+	mock.calls.UpdateTransitPendingApprovedCidrsGatewayGroup = append(mock.calls.UpdateTransitPendingApprovedCidrsGatewayGroup, callInfo)
+	mock.lockUpdateTransitPendingApprovedCidrsGatewayGroup.Unlock()
+	return mock.UpdateTransitPendingApprovedCidrsGatewayGroupFunc(ctx, groupName, approvedCidrs)
+}
+
+// UpdateTransitPendingApprovedCidrsGatewayGroupCalls gets all the calls that were made to UpdateTransitPendingApprovedCidrsGatewayGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateTransitPendingApprovedCidrsGatewayGroupCalls())
+func (mock *ClientInterfaceMock) UpdateTransitPendingApprovedCidrsGatewayGroupCalls() []struct {
+	Ctx           context.Context
+	GroupName     string
+	ApprovedCidrs []string
+} {
+	var calls []struct {
+		Ctx           context.Context
+		GroupName     string
+		ApprovedCidrs []string
+	}
+	mock.lockUpdateTransitPendingApprovedCidrsGatewayGroup.RLock()
+	calls = mock.calls.UpdateTransitPendingApprovedCidrsGatewayGroup
+	mock.lockUpdateTransitPendingApprovedCidrsGatewayGroup.RUnlock()
+	return calls
+}
+
+// UpdateVpnCidr calls UpdateVpnCidrFunc.
+func (mock *ClientInterfaceMock) UpdateVpnCidr(gateway *Gateway) error {
+	if mock.UpdateVpnCidrFunc == nil {
+		panic("ClientInterfaceMock.UpdateVpnCidrFunc: method is nil but ClientInterface.UpdateVpnCidr was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockUpdateVpnCidr.Lock()
+	mock.calls.UpdateVpnCidr = append(mock.calls.UpdateVpnCidr, callInfo)
+	mock.lockUpdateVpnCidr.Unlock()
+	return mock.UpdateVpnCidrFunc(gateway)
+}
+
+// UpdateVpnCidrCalls gets all the calls that were made to UpdateVpnCidr.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateVpnCidrCalls())
+func (mock *ClientInterfaceMock) UpdateVpnCidrCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockUpdateVpnCidr.RLock()
+	calls = mock.calls.UpdateVpnCidr
+	mock.lockUpdateVpnCidr.RUnlock()
+	return calls
+}
+
+// UpdateWebGroup calls UpdateWebGroupFunc.
+func (mock *ClientInterfaceMock) UpdateWebGroup(ctx context.Context, webGroup *WebGroup, uuid string) error {
+	if mock.UpdateWebGroupFunc == nil {
+		panic("ClientInterfaceMock.UpdateWebGroupFunc: method is nil but ClientInterface.UpdateWebGroup was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		WebGroup *WebGroup
+		UUID     string
+	}{
+		Ctx:      ctx,
+		WebGroup: webGroup,
+		UUID:     uuid,
+	}
+	mock.lockUpdateWebGroup.Lock()
+	mock.calls.UpdateWebGroup = append(mock.calls.UpdateWebGroup, callInfo)
+	mock.lockUpdateWebGroup.Unlock()
+	return mock.UpdateWebGroupFunc(ctx, webGroup, uuid)
+}
+
+// UpdateWebGroupCalls gets all the calls that were made to UpdateWebGroup.
+// Check the length with:
+//
+//	len(mockedClientInterface.UpdateWebGroupCalls())
+func (mock *ClientInterfaceMock) UpdateWebGroupCalls() []struct {
+	Ctx      context.Context
+	WebGroup *WebGroup
+	UUID     string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		WebGroup *WebGroup
+		UUID     string
+	}
+	mock.lockUpdateWebGroup.RLock()
+	calls = mock.calls.UpdateWebGroup
+	mock.lockUpdateWebGroup.RUnlock()
 	return calls
 }
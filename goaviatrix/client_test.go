@@ -0,0 +1,35 @@
+package goaviatrix
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostAsyncAPIContext_StopsAtDeadline(t *testing.T) {
+	polls := 0
+	client := newPipelineTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Form.Get("action") == "check_task_status" {
+			polls++
+			_, _ = w.Write([]byte(`{"return": false, "reason": "REQUEST_IN_PROGRESS"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"return": true, "results": "request-1"}`))
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := client.PostAsyncAPIContext(ctx, "create_gateway", map[string]string{"action": "create_gateway"}, BasicCheck)
+
+	var timeoutErr *TaskTimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
+	assert.Equal(t, "request-1", timeoutErr.RequestID)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 1, polls)
+	assert.Less(t, time.Since(start), 5*time.Second, "the poll interval must not outlast the deadline")
+}
@@ -285,7 +285,7 @@ func (c *Client) GetAPIContextCloudnTransitGatewayAttachment(
 	}
 
 	if err := checkFunc(action, "Get", data.Reason, data.Return); err != nil {
-		return err
+		return classifyError(err, action, resp.StatusCode, data.Reason)
 	}
 
 	if err := myUnmarshal(bodyBytes, v); err != nil {
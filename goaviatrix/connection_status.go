@@ -0,0 +1,112 @@
+package goaviatrix
+
+import (
+	"context"
+)
+
+// Site2CloudConnStatus is the live state of the tunnels of a site2cloud
+// connection, as opposed to its configuration.
+type Site2CloudConnStatus struct {
+	// GwName is the primary gateway of the connection.
+	GwName string
+	// HaGwName is the HA gateway of GwName, as listed in its hagw_details,
+	// and is empty if it has none. Tunnels on it are on the HA side.
+	HaGwName string
+	Tunnels  []TunnelInfo
+}
+
+// GetSite2CloudConnStatus returns the tunnels of a site2cloud connection,
+// including a transit external device connection, with the status the
+// Controller reports for each in get_site2cloud_conn_detail.
+func (c *Client) GetSite2CloudConnStatus(ctx context.Context, connName, vpcID string) (*Site2CloudConnStatus, error) {
+	form := map[string]string{
+		"CID":       c.CID,
+		"action":    "get_site2cloud_conn_detail",
+		"conn_name": connName,
+		"vpc_id":    vpcID,
+	}
+	var data Site2CloudConnDetailResp
+	if err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck); err != nil {
+		return nil, err
+	}
+	haGwName, err := c.haGatewayName(ctx, data.Results.Connections.GwName)
+	if err != nil {
+		return nil, err
+	}
+	return &Site2CloudConnStatus{
+		GwName:   data.Results.Connections.GwName,
+		HaGwName: haGwName,
+		Tunnels:  data.Results.Connections.Tunnels,
+	}, nil
+}
+
+// PeeringStatus is the live state of an encrypted peering between two
+// gateways, such as a transit gateway peering or a spoke-transit attachment.
+type PeeringStatus struct {
+	// GwName is the gateway the peering was looked up with.
+	GwName string
+	// HaGwName is the HA gateway of GwName, as listed in its hagw_details,
+	// and is empty if it has none.
+	HaGwName string
+	// State and HaState are the peering_state and peering_ha_status of the
+	// peering in list_peer_vpc_pairs.
+	State   string
+	HaState string
+	Link    string
+}
+
+// GetPeeringStatus returns the state of the encrypted peering between
+// gateway1 and gateway2 from list_peer_vpc_pairs, which lists transit gateway
+// peerings and spoke-transit attachments along with the peerings created by
+// CreateTunnel. Each gateway may be given by its own or its group name, in
+// either order. ErrNotFound is returned if they are not peered.
+func (c *Client) GetPeeringStatus(ctx context.Context, gateway1, gateway2 string) (*PeeringStatus, error) {
+	form := map[string]string{
+		"CID":    c.CID,
+		"action": "list_peer_vpc_pairs",
+	}
+	var data TunnelListResp
+	if err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck); err != nil {
+		return nil, err
+	}
+
+	grpName1 := c.resolveGroupName(gateway1)
+	grpName2 := c.resolveGroupName(gateway2)
+	for _, pair := range data.Results.PairList {
+		if !matchesTunnelPair(pair.VpcName1, pair.VpcName2, gateway1, gateway2, grpName1, grpName2) {
+			continue
+		}
+		haGwName, err := c.haGatewayName(ctx, gateway1)
+		if err != nil {
+			return nil, err
+		}
+		return &PeeringStatus{
+			GwName:   gateway1,
+			HaGwName: haGwName,
+			State:    pair.PeeringState,
+			HaState:  pair.PeeringHaStatus,
+			Link:     pair.PeeringLink,
+		}, nil
+	}
+	return nil, ErrNotFound
+}
+
+// haGatewayName returns the name of the HA gateway of gwName from the
+// hagw_details of list_vpcs_summary, or "" if it has none.
+func (c *Client) haGatewayName(ctx context.Context, gwName string) (string, error) {
+	form := map[string]string{
+		"CID":          c.CID,
+		"action":       "list_vpcs_summary",
+		"gateway_name": gwName,
+	}
+	var data GatewayListResp
+	if err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck); err != nil {
+		return "", err
+	}
+	for i := range data.Results {
+		if data.Results[i].GwName == gwName {
+			return data.Results[i].HaGw.GwName, nil
+		}
+	}
+	return "", ErrNotFound
+}
@@ -329,7 +329,7 @@ func (c *Client) PostAsyncAPIContextSetCertDomain(
 		return fmt.Errorf("json Decode %s failed: %w\nBody: %s", action, err, string(body))
 	}
 	if !kickoff.Return {
-		return fmt.Errorf("rest API %s POST failed to initiate async action: %s", action, kickoff.Reason)
+		return classifyError(fmt.Errorf("rest API %s POST failed to initiate async action: %s", action, kickoff.Reason), action, resp.StatusCode, kickoff.Reason)
 	}
 
 	requestID := kickoff.Result
@@ -339,10 +339,7 @@ func (c *Client) PostAsyncAPIContextSetCertDomain(
 		"request_id": requestID,
 	}
 
-	const maxPoll = 360
-	sleepDuration := 10 * time.Second
-
-	for j := 0; j < maxPoll; j++ {
+	return c.taskPoller().Wait(ctx, action, requestID, func(ctx context.Context) (bool, error) {
 		r, err := c.PostContext(ctx, c.baseURL, form)
 		if err != nil {
 			// Could be transient HTTP error, e.g. EOF.
@@ -350,23 +347,16 @@ func (c *Client) PostAsyncAPIContextSetCertDomain(
 			if r != nil && r.Body != nil {
 				_ = r.Body.Close()
 			}
-
-			select {
-			case <-time.After(sleepDuration):
-				continue
-			case <-ctx.Done():
-				return ctx.Err()
-			}
+			return false, &transientPollError{err: err}
 		}
-		resp = r
 
-		body, err = readAndClose(resp)
+		body, err := readAndClose(r)
 		if err != nil {
-			return fmt.Errorf("read check_task_status body failed: %w", err)
+			return false, fmt.Errorf("read check_task_status body failed: %w", err)
 		}
 
-		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-			return fmt.Errorf("check_task_status returned %s\nBody: %s", resp.Status, string(body))
+		if r.StatusCode < 200 || r.StatusCode >= 300 {
+			return false, fmt.Errorf("check_task_status returned %s\nBody: %s", r.Status, string(body))
 		}
 
 		var status struct {
@@ -374,28 +364,17 @@ func (c *Client) PostAsyncAPIContextSetCertDomain(
 			Reason string `json:"reason"`
 		}
 		if err := json.Unmarshal(body, &status); err != nil {
-			return fmt.Errorf("decode check_task_status failed: %w\nBody: %s", err, string(body))
+			return false, fmt.Errorf("decode check_task_status failed: %w\nBody: %s", err, string(body))
 		}
 
 		if !status.Return {
 			if status.Reason != "REQUEST_IN_PROGRESS" {
-				return fmt.Errorf("rest API %s POST failed: %s", action, status.Reason)
+				return false, classifyError(fmt.Errorf("rest API %s POST failed: %s", action, status.Reason), action, r.StatusCode, status.Reason)
 			}
-
 			// Not done yet
-			select {
-			case <-time.After(sleepDuration):
-				continue
-			case <-ctx.Done():
-				return ctx.Err()
-			}
+			return false, nil
 		}
 
-		return checkFunc(action, "Post", "", status.Return)
-	}
-
-	return fmt.Errorf(
-		"waited %s but upgrade never finished. Please manually verify the upgrade status",
-		time.Duration(maxPoll)*sleepDuration,
-	)
+		return true, checkFunc(action, "Post", "", status.Return)
+	})
 }
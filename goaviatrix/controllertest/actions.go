@@ -0,0 +1,215 @@
+package controllertest
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+type fieldKind int
+
+const (
+	stringField fieldKind = iota
+	intField
+)
+
+// field maps a request parameter to the key the Controller uses for it in
+// responses, which is often different.
+type field struct {
+	param string
+	key   string
+	kind  fieldKind
+}
+
+// accountFields are the setup_account_profile parameters kept and returned
+// by list_accounts. Credentials are never returned.
+var accountFields = []field{
+	{"account_name", "account_name", stringField},
+	{"cloud_type", "cloud_type", intField},
+	{"aws_account_number", "account_number", stringField},
+	{"aws_role_arn", "aws_role_arn", stringField},
+	{"aws_role_ec2", "aws_role_ec2", stringField},
+	{"aws_gateway_role_app", "aws_gateway_role_app", stringField},
+	{"aws_gateway_role_ec2", "aws_gateway_role_ec2", stringField},
+	{"gcloud_project_name", "project", stringField},
+	{"arm_subscription_id", "arm_subscription_id", stringField},
+}
+
+// gatewayFields are the gateway creation parameters kept and returned by
+// list_vpcs_summary and get_gateway_info.
+var gatewayFields = []field{
+	{"gw_name", "vpc_name", stringField},
+	{"account_name", "account_name", stringField},
+	{"cloud_type", "cloud_type", intField},
+	{"vpc_id", "vpc_id", stringField},
+	{"vpc_region", "vpc_region", stringField},
+	{"gw_size", "vpc_size", stringField},
+	{"gw_subnet", "public_subnet", stringField},
+}
+
+// apply copies the fields set in p into obj.
+func apply(obj map[string]any, p Params, fields []field) error {
+	for _, f := range fields {
+		if _, ok := p[f.param]; !ok {
+			continue
+		}
+		v := p.String(f.param)
+		if f.kind == stringField {
+			obj[f.key] = v
+			continue
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("%s must be an integer, got %q", f.param, v)
+		}
+		obj[f.key] = n
+	}
+	return nil
+}
+
+func (s *Server) registerActions() {
+	s.actions = map[string]ActionFunc{
+		"list_version_info": s.listVersionInfo,
+
+		"setup_account_profile":  s.createAccount,
+		"edit_account_profile":   s.editAccount,
+		"delete_account_profile": s.deleteAccount,
+		"list_accounts":          s.listAccounts,
+
+		"connect_container":                 s.createGateway,
+		"create_multicloud_primary_gateway": s.createGateway,
+		"delete_container":                  s.deleteGateway,
+		"list_vpcs_summary":                 s.listGateways,
+		"get_gateway_info":                  s.getGateway,
+
+		"add_multi_cloud_security_domain":        s.createDomain,
+		"delete_multi_cloud_security_domain":     s.deleteDomain,
+		"list_multi_cloud_security_domain_names": s.listDomains,
+	}
+}
+
+func (s *Server) listVersionInfo(Params) (any, error) {
+	return map[string]any{"current_version": s.Version, "previous_version": ""}, nil
+}
+
+func (s *Server) createAccount(p Params) (any, error) {
+	name := p.String("account_name")
+	if name == "" {
+		return nil, fmt.Errorf("account_name is required")
+	}
+	if _, ok := s.accounts[name]; ok {
+		return nil, fmt.Errorf("account %s already exists", name)
+	}
+	account := map[string]any{}
+	if err := apply(account, p, accountFields); err != nil {
+		return nil, err
+	}
+	s.accounts[name] = account
+	return fmt.Sprintf("An email confirmation has been sent for account %s.", name), nil
+}
+
+func (s *Server) editAccount(p Params) (any, error) {
+	name := p.String("account_name")
+	account, ok := s.accounts[name]
+	if !ok {
+		return nil, fmt.Errorf("account %s does not exist", name)
+	}
+	return nil, apply(account, p, accountFields)
+}
+
+func (s *Server) deleteAccount(p Params) (any, error) {
+	name := p.String("account_name")
+	if _, ok := s.accounts[name]; !ok {
+		return nil, fmt.Errorf("account %s does not exist", name)
+	}
+	delete(s.accounts, name)
+	return fmt.Sprintf("Account %s has been deleted.", name), nil
+}
+
+func (s *Server) listAccounts(Params) (any, error) {
+	return map[string]any{"account_list": sortedValues(s.accounts)}, nil
+}
+
+func (s *Server) createGateway(p Params) (any, error) {
+	name := p.String("gw_name")
+	if name == "" {
+		return nil, fmt.Errorf("gw_name is required")
+	}
+	if _, ok := s.gateways[name]; ok {
+		return nil, fmt.Errorf("gateway %s already exists", name)
+	}
+	if _, ok := s.accounts[p.String("account_name")]; !ok {
+		return nil, fmt.Errorf("account %s does not exist", p.String("account_name"))
+	}
+	n := len(s.gateways) + 1
+	gateway := map[string]any{
+		"vpc_state":  "up",
+		"public_ip":  fmt.Sprintf("192.0.2.%d", n),
+		"private_ip": fmt.Sprintf("10.0.0.%d", n),
+	}
+	if err := apply(gateway, p, gatewayFields); err != nil {
+		return nil, err
+	}
+	s.gateways[name] = gateway
+	return fmt.Sprintf("Gateway %s has been created.", name), nil
+}
+
+func (s *Server) deleteGateway(p Params) (any, error) {
+	name := p.String("gw_name")
+	if _, ok := s.gateways[name]; !ok {
+		return nil, fmt.Errorf("gateway %s does not exist", name)
+	}
+	delete(s.gateways, name)
+	return fmt.Sprintf("Gateway %s has been deleted.", name), nil
+}
+
+// listGateways lists every gateway, or only gateway_name when it is set.
+func (s *Server) listGateways(p Params) (any, error) {
+	if name := p.String("gateway_name"); name != "" {
+		gateway, ok := s.gateways[name]
+		if !ok {
+			return []map[string]any{}, nil
+		}
+		return []map[string]any{gateway}, nil
+	}
+	return sortedValues(s.gateways), nil
+}
+
+func (s *Server) getGateway(p Params) (any, error) {
+	name := p.String("gateway_name")
+	gateway, ok := s.gateways[name]
+	if !ok {
+		return nil, fmt.Errorf("gateway %s does not exist", name)
+	}
+	return gateway, nil
+}
+
+func (s *Server) createDomain(p Params) (any, error) {
+	name := p.String("domain_name")
+	if name == "" {
+		return nil, fmt.Errorf("domain_name is required")
+	}
+	if s.domains[name] {
+		return nil, fmt.Errorf("network domain %s already exists", name)
+	}
+	s.domains[name] = true
+	return nil, nil
+}
+
+func (s *Server) deleteDomain(p Params) (any, error) {
+	name := p.String("domain_name")
+	if !s.domains[name] {
+		return nil, fmt.Errorf("network domain %s does not exist", name)
+	}
+	delete(s.domains, name)
+	return nil, nil
+}
+
+func (s *Server) listDomains(Params) (any, error) {
+	names := make([]string, 0, len(s.domains))
+	for name := range s.domains {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
@@ -0,0 +1,131 @@
+package controllertest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// collection is a v2.5 REST collection, e.g. app-domains, whose members are
+// addressed by UUID.
+type collection struct {
+	// noun names a member in error messages.
+	noun string
+	// listKey is the key holding the members in the response to a GET of
+	// the whole collection.
+	listKey string
+	// idKey is the key holding the UUID of a member.
+	idKey string
+	order []string
+	items map[string]map[string]any
+}
+
+func newCollection(noun, listKey, idKey string) *collection {
+	return &collection{noun: noun, listKey: listKey, idKey: idKey, items: make(map[string]map[string]any)}
+}
+
+func (c *collection) list() []map[string]any {
+	items := make([]map[string]any, 0, len(c.order))
+	for _, uuid := range c.order {
+		items = append(items, c.items[uuid])
+	}
+	return items
+}
+
+func (c *collection) remove(uuid string) {
+	delete(c.items, uuid)
+	for i, u := range c.order {
+		if u == uuid {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			return
+		}
+	}
+}
+
+// serveREST serves the v2.5 API, authenticated with an "Authorization: cid
+// <CID>" header.
+func (s *Server) serveREST(w http.ResponseWriter, r *http.Request, path string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, r.Method+" "+path)
+
+	cid, _ := strings.CutPrefix(r.Header.Get("Authorization"), "cid ")
+	if !s.sessions[cid] {
+		writeJSON(w, http.StatusForbidden, map[string]any{"message": "Invalid CID"})
+		return
+	}
+
+	name, uuid := path, ""
+	c, ok := s.collections[name]
+	if !ok {
+		if i := strings.LastIndex(path, "/"); i >= 0 {
+			name, uuid = path[:i], path[i+1:]
+			c, ok = s.collections[name]
+		}
+	}
+	if !ok {
+		writeRESTError(w, http.StatusNotFound, fmt.Sprintf("path %s is not supported by the fake Controller", path))
+		return
+	}
+
+	var body map[string]any
+	if r.Method == http.MethodPost || r.Method == http.MethodPut || r.Method == http.MethodPatch {
+		data, err := io.ReadAll(r.Body)
+		if err == nil {
+			err = json.Unmarshal(data, &body)
+		}
+		if err == nil && body == nil {
+			err = fmt.Errorf("expected a JSON object")
+		}
+		if err != nil {
+			writeRESTError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+			return
+		}
+	}
+
+	if uuid == "" {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, map[string]any{c.listKey: c.list()})
+		case http.MethodPost:
+			uuid = s.newID("uuid")
+			body[c.idKey] = uuid
+			c.items[uuid] = body
+			c.order = append(c.order, uuid)
+			writeJSON(w, http.StatusOK, body)
+		default:
+			writeRESTError(w, http.StatusMethodNotAllowed, fmt.Sprintf("%s %s is not allowed", r.Method, path))
+		}
+		return
+	}
+
+	item, ok := c.items[uuid]
+	if !ok {
+		writeRESTError(w, http.StatusNotFound, c.noun+" not found")
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, item)
+	case http.MethodPut:
+		body[c.idKey] = uuid
+		c.items[uuid] = body
+		writeJSON(w, http.StatusOK, body)
+	case http.MethodPatch:
+		for k, v := range body {
+			item[k] = v
+		}
+		writeJSON(w, http.StatusOK, item)
+	case http.MethodDelete:
+		c.remove(uuid)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeRESTError(w, http.StatusMethodNotAllowed, fmt.Sprintf("%s %s is not allowed", r.Method, path))
+	}
+}
+
+func writeRESTError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]any{"message": message})
+}
@@ -0,0 +1,351 @@
+// Package controllertest provides an in-process fake Aviatrix Controller for
+// tests that cannot reach a real one.
+//
+// The fake speaks the form actions and JSON actions served on /v1/api and
+// /v2/api, the v2.5 REST API and the check_task_status flow used by async
+// actions. It keeps accounts, gateways, network domains, smart groups, DCF
+// rulesets and Telix profiles in memory. Actions it does not know can be added with
+// Server.HandleAction.
+package controllertest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	// DefaultUsername and DefaultPassword are the credentials accepted by a
+	// new Server.
+	DefaultUsername = "admin"
+	DefaultPassword = "password"
+	// DefaultVersion is the Controller version reported by list_version_info.
+	DefaultVersion = "8.0.0-1000.0"
+
+	apiToken = "controllertest-api-token"
+)
+
+// Params are the parameters of a v1/v2 action. Form values are strings (or
+// []string when repeated); JSON values keep their decoded type.
+type Params map[string]any
+
+// String returns the parameter k formatted as a string, or "" if it is unset.
+func (p Params) String(k string) string {
+	switch v := p[k].(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []string:
+		if len(v) == 0 {
+			return ""
+		}
+		return v[0]
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// ActionFunc handles one v1/v2 action. The returned value is sent back as
+// "results"; a non-nil error is sent back as the "reason" of a failed call.
+type ActionFunc func(p Params) (any, error)
+
+// Server is a fake Controller listening on a local TLS port.
+type Server struct {
+	*httptest.Server
+
+	// Username and Password are the credentials accepted by login.
+	Username string
+	Password string
+	// Version is the Controller version reported by list_version_info.
+	Version string
+	// TaskPolls is the number of check_task_status calls that report an
+	// async task as still in progress before it finishes.
+	TaskPolls int
+
+	mu          sync.Mutex
+	nextID      int
+	sessions    map[string]bool
+	actions     map[string]ActionFunc
+	tasks       map[string]*task
+	accounts    map[string]map[string]any
+	gateways    map[string]map[string]any
+	domains     map[string]bool
+	collections map[string]*collection
+	requests    []string
+}
+
+type task struct {
+	polls   int
+	results any
+	err     error
+}
+
+// NewServer starts a fake Controller with no objects. Callers should Close it
+// when done.
+func NewServer() *Server {
+	s := &Server{
+		Username:  DefaultUsername,
+		Password:  DefaultPassword,
+		Version:   DefaultVersion,
+		TaskPolls: 1,
+		sessions:  make(map[string]bool),
+		tasks:     make(map[string]*task),
+		accounts:  make(map[string]map[string]any),
+		gateways:  make(map[string]map[string]any),
+		domains:   make(map[string]bool),
+		collections: map[string]*collection{
+			"app-domains":           newCollection("App domain", "app_domains", "uuid"),
+			"microseg/policy-list3": newCollection("Policy list", "policy_lists", "uuid"),
+			"telix/profile":         newCollection("Telix profile", "profiles", "profile_id"),
+		},
+	}
+	s.registerActions()
+	s.Server = httptest.NewTLSServer(s)
+	return s
+}
+
+// Host returns the host:port to use as the Controller IP.
+func (s *Server) Host() string {
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		panic(fmt.Sprintf("controllertest: bad server URL %q: %v", s.URL, err))
+	}
+	return u.Host
+}
+
+// HandleAction registers fn for a v1/v2 action, replacing any built-in
+// handler. fn is called with the server lock held and must not call back
+// into the Server.
+func (s *Server) HandleAction(action string, fn ActionFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.actions[action] = fn
+}
+
+// NewSession issues a CID without a login, like an API token issued to a
+// service account.
+func (s *Server) NewSession() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cid := s.newID("cid")
+	s.sessions[cid] = true
+	return cid
+}
+
+// ExpireSessions invalidates every CID issued so far, as a Controller restart
+// or session timeout would.
+func (s *Server) ExpireSessions() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions = make(map[string]bool)
+}
+
+// Requests returns the actions and v2.5 paths served so far, in order.
+// v2.5 entries are prefixed with their HTTP method, e.g. "GET app-domains".
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+func (s *Server) newID(prefix string) string {
+	s.nextID++
+	return fmt.Sprintf("%s-%d", prefix, s.nextID)
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == "/v1/api" || r.URL.Path == "/v2/api":
+		s.serveAction(w, r)
+	case strings.HasPrefix(r.URL.Path, "/v2.5/api/"):
+		s.serveREST(w, r, strings.TrimPrefix(r.URL.Path, "/v2.5/api/"))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// serveAction serves the v1 form and v2 JSON actions.
+func (s *Server) serveAction(w http.ResponseWriter, r *http.Request) {
+	isJSON := isJSONRequest(r)
+	p, err := readParams(r, isJSON)
+	if err != nil {
+		writeFailure(w, err.Error())
+		return
+	}
+	action := p.String("action")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, action)
+
+	switch action {
+	case "get_api_token":
+		writeResult(w, map[string]any{"api_token": apiToken}, nil)
+		return
+	case "login":
+		if p.String("username") != s.Username || p.String("password") != s.Password {
+			writeFailure(w, "Invalid username or password.")
+			return
+		}
+		cid := s.newID("cid")
+		s.sessions[cid] = true
+		writeJSON(w, http.StatusOK, map[string]any{"return": true, "CID": cid, "results": "User login:" + s.Username})
+		return
+	}
+
+	if cid := p.String("CID"); !s.sessions[cid] {
+		reason := "CID is invalid or expired."
+		if isJSON {
+			reason = fmt.Sprintf("Session %s expired", cid)
+		}
+		writeFailure(w, reason)
+		return
+	}
+
+	if action == "check_task_status" {
+		s.checkTaskStatus(w, p.String("request_id"))
+		return
+	}
+
+	fn, ok := s.actions[action]
+	if !ok {
+		writeFailure(w, fmt.Sprintf("action %q is not supported by the fake Controller", action))
+		return
+	}
+	results, err := fn(p)
+	if isAsync(p) {
+		requestID := s.newID("request")
+		s.tasks[requestID] = &task{results: results, err: err}
+		writeResult(w, requestID, nil)
+		return
+	}
+	writeResult(w, results, err)
+}
+
+// checkTaskStatus reports an async task as in progress for TaskPolls polls,
+// then returns its outcome.
+func (s *Server) checkTaskStatus(w http.ResponseWriter, requestID string) {
+	t, ok := s.tasks[requestID]
+	if !ok {
+		writeFailure(w, fmt.Sprintf("request_id %s does not exist", requestID))
+		return
+	}
+	if t.polls < s.TaskPolls {
+		t.polls++
+		writeFailure(w, "REQUEST_IN_PROGRESS")
+		return
+	}
+	delete(s.tasks, requestID)
+	writeResult(w, t.results, t.err)
+}
+
+func isJSONRequest(r *http.Request) bool {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return mediaType == "application/json"
+}
+
+// readParams merges the URL query with the request body, which is form
+// encoded (even on GET, as for get_api_token), multipart or JSON.
+func readParams(r *http.Request, isJSON bool) (Params, error) {
+	p := Params{}
+	addValues(p, r.URL.Query())
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if len(body) == 0 {
+		return p, nil
+	}
+	if isJSON {
+		var m map[string]any
+		if err := json.Unmarshal(body, &m); err != nil {
+			return nil, fmt.Errorf("invalid JSON body: %w", err)
+		}
+		for k, v := range m {
+			p[k] = v
+		}
+		return p, nil
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == "multipart/form-data" {
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			return nil, err
+		}
+		addValues(p, r.MultipartForm.Value)
+		return p, nil
+	}
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, err
+	}
+	addValues(p, values)
+	return p, nil
+}
+
+func addValues(p Params, values map[string][]string) {
+	for k, v := range values {
+		if len(v) == 1 {
+			p[k] = v[0]
+		} else {
+			p[k] = v
+		}
+	}
+}
+
+func isAsync(p Params) bool {
+	switch v := p["async"].(type) {
+	case bool:
+		return v
+	default:
+		return p.String("async") == "true"
+	}
+}
+
+func writeResult(w http.ResponseWriter, results any, err error) {
+	if err != nil {
+		writeFailure(w, err.Error())
+		return
+	}
+	if results == nil {
+		results = "success"
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"return": true, "results": results})
+}
+
+// writeFailure sends a failed v1/v2 response. Like the Controller, it uses
+// HTTP 200 and reports the failure in the body.
+func writeFailure(w http.ResponseWriter, reason string) {
+	writeJSON(w, http.StatusOK, map[string]any{"return": false, "reason": reason})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// sortedValues returns the values of m ordered by key.
+func sortedValues(m map[string]map[string]any) []map[string]any {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	values := make([]map[string]any, 0, len(keys))
+	for _, k := range keys {
+		values = append(values, m[k])
+	}
+	return values
+}
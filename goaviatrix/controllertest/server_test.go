@@ -0,0 +1,231 @@
+package controllertest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix"
+)
+
+func newTestClient(t *testing.T) (*Server, *goaviatrix.Client) {
+	t.Helper()
+	s := NewServer()
+	t.Cleanup(s.Close)
+	client, err := goaviatrix.NewClient(s.Username, s.Password, s.Host(), s.Client(), nil,
+		goaviatrix.WithTaskPoller(goaviatrix.TaskPoller{Interval: time.Millisecond}))
+	require.NoError(t, err)
+	return s, client
+}
+
+func TestServer_Login(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	_, err := goaviatrix.NewClient(s.Username, "wrong", s.Host(), s.Client(), nil)
+	require.ErrorContains(t, err, "Invalid username or password")
+
+	client, err := goaviatrix.NewClient(s.Username, s.Password, s.Host(), s.Client(), nil)
+	require.NoError(t, err)
+	version, err := client.GetCurrentVersion()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultVersion, version)
+}
+
+func TestServer_ExpiredSessionIsRenewed(t *testing.T) {
+	s, client := newTestClient(t)
+	oldCID := client.GetCID()
+
+	s.ExpireSessions()
+	_, err := client.ListSegmentationSecurityDomains()
+	require.NoError(t, err)
+	assert.NotEqual(t, oldCID, client.GetCID())
+}
+
+func TestServer_Accounts(t *testing.T) {
+	_, client := newTestClient(t)
+	account := &goaviatrix.Account{
+		AccountName:      "aws-acc",
+		CloudType:        goaviatrix.AWS,
+		AwsAccountNumber: "123456789012",
+		AwsAccessKey:     "key",
+		AwsSecretKey:     "secret",
+	}
+
+	require.NoError(t, client.CreateAccount(account))
+	err := client.CreateAccount(account)
+	require.ErrorIs(t, err, goaviatrix.ErrAlreadyExists)
+
+	client.InvalidateCache()
+	got, err := client.GetAccount(&goaviatrix.Account{AccountName: "aws-acc"})
+	require.NoError(t, err)
+	assert.Equal(t, goaviatrix.AWS, got.CloudType)
+	assert.Equal(t, "123456789012", got.AwsAccountNumber)
+	assert.Empty(t, got.AwsSecretKey)
+
+	require.NoError(t, client.DeleteAccount(account))
+	client.InvalidateCache()
+	_, err = client.GetAccount(account)
+	require.ErrorIs(t, err, goaviatrix.ErrNotFound)
+}
+
+func TestServer_AsyncGateway(t *testing.T) {
+	s, client := newTestClient(t)
+	s.TaskPolls = 2
+	ctx := context.Background()
+	require.NoError(t, client.CreateAccount(&goaviatrix.Account{AccountName: "aws-acc", CloudType: goaviatrix.AWS}))
+
+	gateway := &goaviatrix.Gateway{
+		GwName:      "gw1",
+		AccountName: "aws-acc",
+		CloudType:   goaviatrix.AWS,
+		VpcID:       "vpc-1",
+		VpcRegion:   "us-east-1",
+		VpcNet:      "10.0.0.0/24",
+	}
+	require.NoError(t, client.CreateGatewayContext(ctx, gateway))
+	assert.Equal(t, []string{"connect_container", "check_task_status", "check_task_status", "check_task_status"}, s.Requests()[3:])
+
+	got, err := client.GetGateway(&goaviatrix.Gateway{GwName: "gw1"})
+	require.NoError(t, err)
+	assert.Equal(t, "vpc-1", got.VpcID)
+	assert.Equal(t, "10.0.0.0/24", got.VpcNet)
+	assert.NotEmpty(t, got.PublicIP)
+
+	detail, err := client.GetGatewayDetail(&goaviatrix.Gateway{GwName: "gw1"})
+	require.NoError(t, err)
+	assert.Equal(t, "gw1", detail.GwName)
+
+	err = client.CreateGatewayContext(ctx, gateway)
+	require.ErrorIs(t, err, goaviatrix.ErrAlreadyExists)
+
+	require.NoError(t, client.DeleteGatewayContext(ctx, gateway))
+	_, err = client.GetGateway(gateway)
+	require.ErrorIs(t, err, goaviatrix.ErrNotFound)
+}
+
+func TestServer_AsyncTaskHonoursContext(t *testing.T) {
+	s, client := newTestClient(t)
+	s.TaskPolls = 1 << 30
+	require.NoError(t, client.CreateAccount(&goaviatrix.Account{AccountName: "aws-acc", CloudType: goaviatrix.AWS}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := client.CreateGatewayContext(ctx, &goaviatrix.Gateway{GwName: "gw1", AccountName: "aws-acc", CloudType: goaviatrix.AWS})
+	var timeoutErr *goaviatrix.TaskTimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
+	assert.Equal(t, "connect_container", timeoutErr.Action)
+}
+
+func TestServer_NetworkDomains(t *testing.T) {
+	_, client := newTestClient(t)
+	domain := &goaviatrix.SegmentationSecurityDomain{DomainName: "prod"}
+
+	require.NoError(t, client.CreateSegmentationSecurityDomain(domain))
+	require.NoError(t, client.CreateSegmentationSecurityDomain(&goaviatrix.SegmentationSecurityDomain{DomainName: "dev"}))
+	names, err := client.ListSegmentationSecurityDomains()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dev", "prod"}, names)
+
+	require.NoError(t, client.DeleteSegmentationSecurityDomain(domain))
+	_, err = client.GetSegmentationSecurityDomain(domain)
+	require.ErrorIs(t, err, goaviatrix.ErrNotFound)
+	err = client.DeleteSegmentationSecurityDomain(domain)
+	require.ErrorIs(t, err, goaviatrix.ErrNotFound)
+}
+
+func TestServer_SmartGroups(t *testing.T) {
+	_, client := newTestClient(t)
+	ctx := context.Background()
+	smartGroup := &goaviatrix.SmartGroup{
+		Name: "web",
+		Selector: goaviatrix.SmartGroupSelector{
+			Expressions: []*goaviatrix.SmartGroupMatchExpression{{CIDR: "10.0.0.0/16"}},
+		},
+	}
+
+	uuid, err := client.CreateSmartGroup(ctx, smartGroup)
+	require.NoError(t, err)
+	require.NotEmpty(t, uuid)
+
+	got, err := client.GetSmartGroup(ctx, uuid)
+	require.NoError(t, err)
+	assert.Equal(t, "web", got.Name)
+	require.Len(t, got.Selector.Expressions, 1)
+	assert.Equal(t, "10.0.0.0/16", got.Selector.Expressions[0].CIDR)
+
+	smartGroup.Name = "web-renamed"
+	require.NoError(t, client.UpdateSmartGroup(ctx, smartGroup, uuid))
+	all, err := client.GetSmartGroups(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.Equal(t, "web-renamed", all[0].Name)
+
+	require.NoError(t, client.DeleteSmartGroup(ctx, uuid))
+	_, err = client.GetSmartGroup(ctx, uuid)
+	require.ErrorIs(t, err, goaviatrix.ErrNotFound)
+}
+
+func TestServer_DCFPolicyLists(t *testing.T) {
+	_, client := newTestClient(t)
+	ctx := context.Background()
+	policyList := &goaviatrix.DCFPolicyList{
+		Name: "ruleset",
+		Policies: []goaviatrix.DCFPolicy{{
+			Name:     "allow-web",
+			Action:   "PERMIT",
+			Priority: 10,
+			Protocol: "TCP",
+		}},
+	}
+
+	uuid, err := client.CreateDCFPolicyList(ctx, policyList)
+	require.NoError(t, err)
+
+	got, err := client.GetDCFPolicyList(ctx, uuid)
+	require.NoError(t, err)
+	assert.Equal(t, uuid, got.UUID)
+	require.Len(t, got.Policies, 1)
+	assert.Equal(t, "allow-web", got.Policies[0].Name)
+
+	lists, err := client.ListDCFPolicyLists(ctx)
+	require.NoError(t, err)
+	require.Len(t, lists, 1)
+	assert.Equal(t, uuid, lists[0].UUID)
+
+	got.Policies[0].Priority = 20
+	require.NoError(t, client.UpdateDCFPolicyList(ctx, got))
+	got, err = client.GetDCFPolicyList(ctx, uuid)
+	require.NoError(t, err)
+	assert.Equal(t, 20, got.Policies[0].Priority)
+
+	require.NoError(t, client.DeleteDCFPolicyList(ctx, uuid))
+	_, err = client.GetDCFPolicyList(ctx, uuid)
+	require.ErrorIs(t, err, goaviatrix.ErrNotFound)
+}
+
+func TestServer_HandleAction(t *testing.T) {
+	s, client := newTestClient(t)
+	s.HandleAction("get_controller_hostname", func(Params) (any, error) {
+		return "fake-controller", nil
+	})
+
+	var data struct {
+		Results string `json:"results"`
+	}
+	err := client.GetAPI(&data, "get_controller_hostname", map[string]string{
+		"CID":    client.GetCID(),
+		"action": "get_controller_hostname",
+	}, goaviatrix.BasicCheck)
+	require.NoError(t, err)
+	assert.Equal(t, "fake-controller", data.Results)
+
+	err = client.PostAPI("no_such_action", map[string]string{
+		"CID":    client.GetCID(),
+		"action": "no_such_action",
+	}, goaviatrix.BasicCheck)
+	require.ErrorContains(t, err, "not supported by the fake Controller")
+}
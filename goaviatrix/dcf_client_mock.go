@@ -0,0 +1,3402 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package goaviatrix
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that DCFClientMock does implement DCFClient.
+// If this is not the case, regenerate this file with moq.
+var _ DCFClient = &DCFClientMock{}
+
+// DCFClientMock is a mock implementation of DCFClient.
+//
+//	func TestSomethingThatUsesDCFClient(t *testing.T) {
+//
+//		// make and configure a mocked DCFClient
+//		mockedDCFClient := &DCFClientMock{
+//			CreateDCFMitmCaFunc: func(ctx context.Context, mitmCa *MitmCaItemRequest) (string, error) {
+//				panic("mock out the CreateDCFMitmCa method")
+//			},
+//			CreateDCFPolicyBlockFunc: func(ctx context.Context, policyBlock *DCFPolicyBlock) (string, error) {
+//				panic("mock out the CreateDCFPolicyBlock method")
+//			},
+//			CreateDCFPolicyListFunc: func(ctx context.Context, policyList *DCFPolicyList) (string, error) {
+//				panic("mock out the CreateDCFPolicyList method")
+//			},
+//			CreateDCFTrustBundleFunc: func(ctx context.Context, trustBundle *TrustBundleItemRequest) (string, error) {
+//				panic("mock out the CreateDCFTrustBundle method")
+//			},
+//			CreateDistributedFirewallingDeploymentPolicyFunc: func(ctx context.Context, deploymentPolicy *DistributedFirewallingDeploymentPolicy) error {
+//				panic("mock out the CreateDistributedFirewallingDeploymentPolicy method")
+//			},
+//			CreateDistributedFirewallingIntraVpcFunc: func(ctx context.Context, vpcList *DistributedFirewallingIntraVpcList) error {
+//				panic("mock out the CreateDistributedFirewallingIntraVpc method")
+//			},
+//			CreateDistributedFirewallingPolicyListFunc: func(ctx context.Context, policyList *DistributedFirewallingPolicyList) error {
+//				panic("mock out the CreateDistributedFirewallingPolicyList method")
+//			},
+//			CreateIpsProfileFunc: func(ctx context.Context, profile *IpsProfile) (*IpsProfileCreateResponse, error) {
+//				panic("mock out the CreateIpsProfile method")
+//			},
+//			CreateIpsRuleFeedFunc: func(ctx context.Context, ruleFeed *IpsRuleFeed) (*IpsRuleFeedUploadResponse, error) {
+//				panic("mock out the CreateIpsRuleFeed method")
+//			},
+//			CreateSmartGroupFunc: func(ctx context.Context, smartGroup *SmartGroup) (string, error) {
+//				panic("mock out the CreateSmartGroup method")
+//			},
+//			CreateTLSProfileFunc: func(ctx context.Context, tlsProfile *TLSProfile) (string, error) {
+//				panic("mock out the CreateTLSProfile method")
+//			},
+//			CreateWebGroupFunc: func(ctx context.Context, webGroup *WebGroup) (string, error) {
+//				panic("mock out the CreateWebGroup method")
+//			},
+//			DeleteCaCertificateFunc: func(ctx context.Context) error {
+//				panic("mock out the DeleteCaCertificate method")
+//			},
+//			DeleteDCFMitmCaFunc: func(ctx context.Context, caID string) error {
+//				panic("mock out the DeleteDCFMitmCa method")
+//			},
+//			DeleteDCFPolicyBlockFunc: func(ctx context.Context, uuid string) error {
+//				panic("mock out the DeleteDCFPolicyBlock method")
+//			},
+//			DeleteDCFPolicyListFunc: func(ctx context.Context, uuid string) error {
+//				panic("mock out the DeleteDCFPolicyList method")
+//			},
+//			DeleteDCFTrustBundleFunc: func(ctx context.Context, bundleUUID string) error {
+//				panic("mock out the DeleteDCFTrustBundle method")
+//			},
+//			DeleteDistributedFirewallingIntraVpcFunc: func(ctx context.Context) error {
+//				panic("mock out the DeleteDistributedFirewallingIntraVpc method")
+//			},
+//			DeleteDistributedFirewallingPolicyListFunc: func(ctx context.Context) error {
+//				panic("mock out the DeleteDistributedFirewallingPolicyList method")
+//			},
+//			DeleteEnforcementLevelFunc: func(ctx context.Context) error {
+//				panic("mock out the DeleteEnforcementLevel method")
+//			},
+//			DeleteIpsProfileFunc: func(ctx context.Context, uuid string) error {
+//				panic("mock out the DeleteIpsProfile method")
+//			},
+//			DeleteIpsRuleFeedFunc: func(ctx context.Context, uuid string) error {
+//				panic("mock out the DeleteIpsRuleFeed method")
+//			},
+//			DeleteSmartGroupFunc: func(ctx context.Context, uuid string) error {
+//				panic("mock out the DeleteSmartGroup method")
+//			},
+//			DeleteTLSProfileFunc: func(ctx context.Context, uuid string) error {
+//				panic("mock out the DeleteTLSProfile method")
+//			},
+//			DeleteWebGroupFunc: func(ctx context.Context, uuid string) error {
+//				panic("mock out the DeleteWebGroup method")
+//			},
+//			DisableDistributedFirewallingFunc: func(ctx context.Context) error {
+//				panic("mock out the DisableDistributedFirewalling method")
+//			},
+//			EnableDistributedFirewallingFunc: func(ctx context.Context) error {
+//				panic("mock out the EnableDistributedFirewalling method")
+//			},
+//			GetCaCertificateFunc: func(ctx context.Context) (*ProxyCaConfig, error) {
+//				panic("mock out the GetCaCertificate method")
+//			},
+//			GetControllerIPFunc: func() string {
+//				panic("mock out the GetControllerIP method")
+//			},
+//			GetDCFAttachmentPointFunc: func(ctx context.Context, name string) (*AttachmentPointResp, error) {
+//				panic("mock out the GetDCFAttachmentPoint method")
+//			},
+//			GetDCFMitmCaFunc: func(ctx context.Context, caID string) (*MitmCaResponse, error) {
+//				panic("mock out the GetDCFMitmCa method")
+//			},
+//			GetDCFPolicyBlockFunc: func(ctx context.Context, uuid string) (*DCFPolicyBlock, error) {
+//				panic("mock out the GetDCFPolicyBlock method")
+//			},
+//			GetDCFPolicyListFunc: func(ctx context.Context, uuid string) (*DCFPolicyList, error) {
+//				panic("mock out the GetDCFPolicyList method")
+//			},
+//			GetDCFTrustBundleByIDFunc: func(ctx context.Context, bundleUUID string) (*DCFTrustBundle, error) {
+//				panic("mock out the GetDCFTrustBundleByID method")
+//			},
+//			GetDCFTrustBundleByNameFunc: func(ctx context.Context, bundleName string) (*DCFTrustBundle, error) {
+//				panic("mock out the GetDCFTrustBundleByName method")
+//			},
+//			GetDefaultIpsProfileFunc: func(ctx context.Context) (*DefaultIpsProfileResponse, error) {
+//				panic("mock out the GetDefaultIpsProfile method")
+//			},
+//			GetDistributedFirewallingDefaultActionRuleFunc: func(ctx context.Context) (*DistributedFirewallingDefaultActionRule, error) {
+//				panic("mock out the GetDistributedFirewallingDefaultActionRule method")
+//			},
+//			GetDistributedFirewallingDeploymentPolicyFunc: func(ctx context.Context) (*DistributedFirewallingDeploymentPolicy, error) {
+//				panic("mock out the GetDistributedFirewallingDeploymentPolicy method")
+//			},
+//			GetDistributedFirewallingIntraVpcFunc: func(ctx context.Context) (*DistributedFirewallingIntraVpcList, error) {
+//				panic("mock out the GetDistributedFirewallingIntraVpc method")
+//			},
+//			GetDistributedFirewallingPolicyListFunc: func(ctx context.Context) (*DistributedFirewallingPolicyList, error) {
+//				panic("mock out the GetDistributedFirewallingPolicyList method")
+//			},
+//			GetDistributedFirewallingStatusFunc: func(ctx context.Context) (*DistributedFirewallingConfig, error) {
+//				panic("mock out the GetDistributedFirewallingStatus method")
+//			},
+//			GetEnforcementLevelFunc: func(ctx context.Context) (*EnforcementLevel, error) {
+//				panic("mock out the GetEnforcementLevel method")
+//			},
+//			GetIpsProfileFunc: func(ctx context.Context, uuid string) (*IpsProfile, error) {
+//				panic("mock out the GetIpsProfile method")
+//			},
+//			GetIpsRuleFeedFunc: func(ctx context.Context, uuid string) (*IpsRuleFeed, error) {
+//				panic("mock out the GetIpsRuleFeed method")
+//			},
+//			GetLogProfileByNameFunc: func(ctx context.Context, profileName string) (*LogProfile, error) {
+//				panic("mock out the GetLogProfileByName method")
+//			},
+//			GetMetaCaCertificateFunc: func(ctx context.Context) (*ProxyCaCertInstance, error) {
+//				panic("mock out the GetMetaCaCertificate method")
+//			},
+//			GetSmartGroupFunc: func(ctx context.Context, uuid string) (*SmartGroup, error) {
+//				panic("mock out the GetSmartGroup method")
+//			},
+//			GetSmartGroupsFunc: func(ctx context.Context) ([]*SmartGroup, error) {
+//				panic("mock out the GetSmartGroups method")
+//			},
+//			GetTLSProfileFunc: func(ctx context.Context, uuidStr string) (*TLSProfileWithID, error) {
+//				panic("mock out the GetTLSProfile method")
+//			},
+//			GetTLSProfileByNameFunc: func(ctx context.Context, displayName string) (*TLSProfileWithID, error) {
+//				panic("mock out the GetTLSProfileByName method")
+//			},
+//			GetWebGroupFunc: func(ctx context.Context, uuid string) (*WebGroup, error) {
+//				panic("mock out the GetWebGroup method")
+//			},
+//			GetWebGroupByNameFunc: func(ctx context.Context, name string) (*WebGroup, error) {
+//				panic("mock out the GetWebGroupByName method")
+//			},
+//			ListDCFMitmCaFunc: func(ctx context.Context) (*MitmCaListResponse, error) {
+//				panic("mock out the ListDCFMitmCa method")
+//			},
+//			RefreshDCFMitmSysatemCAFunc: func(ctx context.Context) error {
+//				panic("mock out the RefreshDCFMitmSysatemCA method")
+//			},
+//			SetDefaultIpsProfileFunc: func(ctx context.Context, profiles []string) (*DefaultIpsProfileResponse, error) {
+//				panic("mock out the SetDefaultIpsProfile method")
+//			},
+//			SetEnforcementLevelFunc: func(ctx context.Context, enforcementLevel *EnforcementLevel) error {
+//				panic("mock out the SetEnforcementLevel method")
+//			},
+//			SetNewCertificateFunc: func(ctx context.Context, proxyCaConfig *ProxyCaConfig) error {
+//				panic("mock out the SetNewCertificate method")
+//			},
+//			UpdateDCFMitmCaFunc: func(ctx context.Context, caID string, patchRequest *MitmCaPatchRequest) (*MitmCaResponse, error) {
+//				panic("mock out the UpdateDCFMitmCa method")
+//			},
+//			UpdateDCFPolicyBlockFunc: func(ctx context.Context, policyBlock *DCFPolicyBlock) error {
+//				panic("mock out the UpdateDCFPolicyBlock method")
+//			},
+//			UpdateDCFPolicyListFunc: func(ctx context.Context, policyList *DCFPolicyList) error {
+//				panic("mock out the UpdateDCFPolicyList method")
+//			},
+//			UpdateDCFTrustBundleFunc: func(ctx context.Context, bundleUUID string, trustBundle *TrustBundleItemRequest) error {
+//				panic("mock out the UpdateDCFTrustBundle method")
+//			},
+//			UpdateDistributedFirewallingDefaultActionRuleFunc: func(ctx context.Context, request *DistributedFirewallingDefaultActionRule) error {
+//				panic("mock out the UpdateDistributedFirewallingDefaultActionRule method")
+//			},
+//			UpdateDistributedFirewallingPolicyListFunc: func(ctx context.Context, policyList *DistributedFirewallingPolicyList) error {
+//				panic("mock out the UpdateDistributedFirewallingPolicyList method")
+//			},
+//			UpdateEnforcementLevelFunc: func(ctx context.Context, enforcementLevel *EnforcementLevel) error {
+//				panic("mock out the UpdateEnforcementLevel method")
+//			},
+//			UpdateIpsProfileFunc: func(ctx context.Context, uuid string, profile *IpsProfile) (*IpsProfile, error) {
+//				panic("mock out the UpdateIpsProfile method")
+//			},
+//			UpdateIpsRuleFeedFunc: func(ctx context.Context, uuid string, ruleFeed *IpsRuleFeed) (*IpsRuleFeed, error) {
+//				panic("mock out the UpdateIpsRuleFeed method")
+//			},
+//			UpdateSmartGroupFunc: func(ctx context.Context, smartGroup *SmartGroup, uuid string) error {
+//				panic("mock out the UpdateSmartGroup method")
+//			},
+//			UpdateTLSProfileFunc: func(ctx context.Context, uuid string, tlsProfile *TLSProfile) error {
+//				panic("mock out the UpdateTLSProfile method")
+//			},
+//			UpdateWebGroupFunc: func(ctx context.Context, webGroup *WebGroup, uuid string) error {
+//				panic("mock out the UpdateWebGroup method")
+//			},
+//		}
+//
+//		// use mockedDCFClient in code that requires DCFClient
+//		// and then make assertions.
+//
+//	}
+type DCFClientMock struct {
+	// CreateDCFMitmCaFunc mocks the CreateDCFMitmCa method.
+	CreateDCFMitmCaFunc func(ctx context.Context, mitmCa *MitmCaItemRequest) (string, error)
+
+	// CreateDCFPolicyBlockFunc mocks the CreateDCFPolicyBlock method.
+	CreateDCFPolicyBlockFunc func(ctx context.Context, policyBlock *DCFPolicyBlock) (string, error)
+
+	// CreateDCFPolicyListFunc mocks the CreateDCFPolicyList method.
+	CreateDCFPolicyListFunc func(ctx context.Context, policyList *DCFPolicyList) (string, error)
+
+	// CreateDCFTrustBundleFunc mocks the CreateDCFTrustBundle method.
+	CreateDCFTrustBundleFunc func(ctx context.Context, trustBundle *TrustBundleItemRequest) (string, error)
+
+	// CreateDistributedFirewallingDeploymentPolicyFunc mocks the CreateDistributedFirewallingDeploymentPolicy method.
+	CreateDistributedFirewallingDeploymentPolicyFunc func(ctx context.Context, deploymentPolicy *DistributedFirewallingDeploymentPolicy) error
+
+	// CreateDistributedFirewallingIntraVpcFunc mocks the CreateDistributedFirewallingIntraVpc method.
+	CreateDistributedFirewallingIntraVpcFunc func(ctx context.Context, vpcList *DistributedFirewallingIntraVpcList) error
+
+	// CreateDistributedFirewallingPolicyListFunc mocks the CreateDistributedFirewallingPolicyList method.
+	CreateDistributedFirewallingPolicyListFunc func(ctx context.Context, policyList *DistributedFirewallingPolicyList) error
+
+	// CreateIpsProfileFunc mocks the CreateIpsProfile method.
+	CreateIpsProfileFunc func(ctx context.Context, profile *IpsProfile) (*IpsProfileCreateResponse, error)
+
+	// CreateIpsRuleFeedFunc mocks the CreateIpsRuleFeed method.
+	CreateIpsRuleFeedFunc func(ctx context.Context, ruleFeed *IpsRuleFeed) (*IpsRuleFeedUploadResponse, error)
+
+	// CreateSmartGroupFunc mocks the CreateSmartGroup method.
+	CreateSmartGroupFunc func(ctx context.Context, smartGroup *SmartGroup) (string, error)
+
+	// CreateTLSProfileFunc mocks the CreateTLSProfile method.
+	CreateTLSProfileFunc func(ctx context.Context, tlsProfile *TLSProfile) (string, error)
+
+	// CreateWebGroupFunc mocks the CreateWebGroup method.
+	CreateWebGroupFunc func(ctx context.Context, webGroup *WebGroup) (string, error)
+
+	// DeleteCaCertificateFunc mocks the DeleteCaCertificate method.
+	DeleteCaCertificateFunc func(ctx context.Context) error
+
+	// DeleteDCFMitmCaFunc mocks the DeleteDCFMitmCa method.
+	DeleteDCFMitmCaFunc func(ctx context.Context, caID string) error
+
+	// DeleteDCFPolicyBlockFunc mocks the DeleteDCFPolicyBlock method.
+	DeleteDCFPolicyBlockFunc func(ctx context.Context, uuid string) error
+
+	// DeleteDCFPolicyListFunc mocks the DeleteDCFPolicyList method.
+	DeleteDCFPolicyListFunc func(ctx context.Context, uuid string) error
+
+	// DeleteDCFTrustBundleFunc mocks the DeleteDCFTrustBundle method.
+	DeleteDCFTrustBundleFunc func(ctx context.Context, bundleUUID string) error
+
+	// DeleteDistributedFirewallingIntraVpcFunc mocks the DeleteDistributedFirewallingIntraVpc method.
+	DeleteDistributedFirewallingIntraVpcFunc func(ctx context.Context) error
+
+	// DeleteDistributedFirewallingPolicyListFunc mocks the DeleteDistributedFirewallingPolicyList method.
+	DeleteDistributedFirewallingPolicyListFunc func(ctx context.Context) error
+
+	// DeleteEnforcementLevelFunc mocks the DeleteEnforcementLevel method.
+	DeleteEnforcementLevelFunc func(ctx context.Context) error
+
+	// DeleteIpsProfileFunc mocks the DeleteIpsProfile method.
+	DeleteIpsProfileFunc func(ctx context.Context, uuid string) error
+
+	// DeleteIpsRuleFeedFunc mocks the DeleteIpsRuleFeed method.
+	DeleteIpsRuleFeedFunc func(ctx context.Context, uuid string) error
+
+	// DeleteSmartGroupFunc mocks the DeleteSmartGroup method.
+	DeleteSmartGroupFunc func(ctx context.Context, uuid string) error
+
+	// DeleteTLSProfileFunc mocks the DeleteTLSProfile method.
+	DeleteTLSProfileFunc func(ctx context.Context, uuid string) error
+
+	// DeleteWebGroupFunc mocks the DeleteWebGroup method.
+	DeleteWebGroupFunc func(ctx context.Context, uuid string) error
+
+	// DisableDistributedFirewallingFunc mocks the DisableDistributedFirewalling method.
+	DisableDistributedFirewallingFunc func(ctx context.Context) error
+
+	// EnableDistributedFirewallingFunc mocks the EnableDistributedFirewalling method.
+	EnableDistributedFirewallingFunc func(ctx context.Context) error
+
+	// GetCaCertificateFunc mocks the GetCaCertificate method.
+	GetCaCertificateFunc func(ctx context.Context) (*ProxyCaConfig, error)
+
+	// GetControllerIPFunc mocks the GetControllerIP method.
+	GetControllerIPFunc func() string
+
+	// GetDCFAttachmentPointFunc mocks the GetDCFAttachmentPoint method.
+	GetDCFAttachmentPointFunc func(ctx context.Context, name string) (*AttachmentPointResp, error)
+
+	// GetDCFMitmCaFunc mocks the GetDCFMitmCa method.
+	GetDCFMitmCaFunc func(ctx context.Context, caID string) (*MitmCaResponse, error)
+
+	// GetDCFPolicyBlockFunc mocks the GetDCFPolicyBlock method.
+	GetDCFPolicyBlockFunc func(ctx context.Context, uuid string) (*DCFPolicyBlock, error)
+
+	// GetDCFPolicyListFunc mocks the GetDCFPolicyList method.
+	GetDCFPolicyListFunc func(ctx context.Context, uuid string) (*DCFPolicyList, error)
+
+	// GetDCFTrustBundleByIDFunc mocks the GetDCFTrustBundleByID method.
+	GetDCFTrustBundleByIDFunc func(ctx context.Context, bundleUUID string) (*DCFTrustBundle, error)
+
+	// GetDCFTrustBundleByNameFunc mocks the GetDCFTrustBundleByName method.
+	GetDCFTrustBundleByNameFunc func(ctx context.Context, bundleName string) (*DCFTrustBundle, error)
+
+	// GetDefaultIpsProfileFunc mocks the GetDefaultIpsProfile method.
+	GetDefaultIpsProfileFunc func(ctx context.Context) (*DefaultIpsProfileResponse, error)
+
+	// GetDistributedFirewallingDefaultActionRuleFunc mocks the GetDistributedFirewallingDefaultActionRule method.
+	GetDistributedFirewallingDefaultActionRuleFunc func(ctx context.Context) (*DistributedFirewallingDefaultActionRule, error)
+
+	// GetDistributedFirewallingDeploymentPolicyFunc mocks the GetDistributedFirewallingDeploymentPolicy method.
+	GetDistributedFirewallingDeploymentPolicyFunc func(ctx context.Context) (*DistributedFirewallingDeploymentPolicy, error)
+
+	// GetDistributedFirewallingIntraVpcFunc mocks the GetDistributedFirewallingIntraVpc method.
+	GetDistributedFirewallingIntraVpcFunc func(ctx context.Context) (*DistributedFirewallingIntraVpcList, error)
+
+	// GetDistributedFirewallingPolicyListFunc mocks the GetDistributedFirewallingPolicyList method.
+	GetDistributedFirewallingPolicyListFunc func(ctx context.Context) (*DistributedFirewallingPolicyList, error)
+
+	// GetDistributedFirewallingStatusFunc mocks the GetDistributedFirewallingStatus method.
+	GetDistributedFirewallingStatusFunc func(ctx context.Context) (*DistributedFirewallingConfig, error)
+
+	// GetEnforcementLevelFunc mocks the GetEnforcementLevel method.
+	GetEnforcementLevelFunc func(ctx context.Context) (*EnforcementLevel, error)
+
+	// GetIpsProfileFunc mocks the GetIpsProfile method.
+	GetIpsProfileFunc func(ctx context.Context, uuid string) (*IpsProfile, error)
+
+	// GetIpsRuleFeedFunc mocks the GetIpsRuleFeed method.
+	GetIpsRuleFeedFunc func(ctx context.Context, uuid string) (*IpsRuleFeed, error)
+
+	// GetLogProfileByNameFunc mocks the GetLogProfileByName method.
+	GetLogProfileByNameFunc func(ctx context.Context, profileName string) (*LogProfile, error)
+
+	// GetMetaCaCertificateFunc mocks the GetMetaCaCertificate method.
+	GetMetaCaCertificateFunc func(ctx context.Context) (*ProxyCaCertInstance, error)
+
+	// GetSmartGroupFunc mocks the GetSmartGroup method.
+	GetSmartGroupFunc func(ctx context.Context, uuid string) (*SmartGroup, error)
+
+	// GetSmartGroupsFunc mocks the GetSmartGroups method.
+	GetSmartGroupsFunc func(ctx context.Context) ([]*SmartGroup, error)
+
+	// GetTLSProfileFunc mocks the GetTLSProfile method.
+	GetTLSProfileFunc func(ctx context.Context, uuidStr string) (*TLSProfileWithID, error)
+
+	// GetTLSProfileByNameFunc mocks the GetTLSProfileByName method.
+	GetTLSProfileByNameFunc func(ctx context.Context, displayName string) (*TLSProfileWithID, error)
+
+	// GetWebGroupFunc mocks the GetWebGroup method.
+	GetWebGroupFunc func(ctx context.Context, uuid string) (*WebGroup, error)
+
+	// GetWebGroupByNameFunc mocks the GetWebGroupByName method.
+	GetWebGroupByNameFunc func(ctx context.Context, name string) (*WebGroup, error)
+
+	// ListDCFMitmCaFunc mocks the ListDCFMitmCa method.
+	ListDCFMitmCaFunc func(ctx context.Context) (*MitmCaListResponse, error)
+
+	// RefreshDCFMitmSysatemCAFunc mocks the RefreshDCFMitmSysatemCA method.
+	RefreshDCFMitmSysatemCAFunc func(ctx context.Context) error
+
+	// SetDefaultIpsProfileFunc mocks the SetDefaultIpsProfile method.
+	SetDefaultIpsProfileFunc func(ctx context.Context, profiles []string) (*DefaultIpsProfileResponse, error)
+
+	// SetEnforcementLevelFunc mocks the SetEnforcementLevel method.
+	SetEnforcementLevelFunc func(ctx context.Context, enforcementLevel *EnforcementLevel) error
+
+	// SetNewCertificateFunc mocks the SetNewCertificate method.
+	SetNewCertificateFunc func(ctx context.Context, proxyCaConfig *ProxyCaConfig) error
+
+	// UpdateDCFMitmCaFunc mocks the UpdateDCFMitmCa method.
+	UpdateDCFMitmCaFunc func(ctx context.Context, caID string, patchRequest *MitmCaPatchRequest) (*MitmCaResponse, error)
+
+	// UpdateDCFPolicyBlockFunc mocks the UpdateDCFPolicyBlock method.
+	UpdateDCFPolicyBlockFunc func(ctx context.Context, policyBlock *DCFPolicyBlock) error
+
+	// UpdateDCFPolicyListFunc mocks the UpdateDCFPolicyList method.
+	UpdateDCFPolicyListFunc func(ctx context.Context, policyList *DCFPolicyList) error
+
+	// UpdateDCFTrustBundleFunc mocks the UpdateDCFTrustBundle method.
+	UpdateDCFTrustBundleFunc func(ctx context.Context, bundleUUID string, trustBundle *TrustBundleItemRequest) error
+
+	// UpdateDistributedFirewallingDefaultActionRuleFunc mocks the UpdateDistributedFirewallingDefaultActionRule method.
+	UpdateDistributedFirewallingDefaultActionRuleFunc func(ctx context.Context, request *DistributedFirewallingDefaultActionRule) error
+
+	// UpdateDistributedFirewallingPolicyListFunc mocks the UpdateDistributedFirewallingPolicyList method.
+	UpdateDistributedFirewallingPolicyListFunc func(ctx context.Context, policyList *DistributedFirewallingPolicyList) error
+
+	// UpdateEnforcementLevelFunc mocks the UpdateEnforcementLevel method.
+	UpdateEnforcementLevelFunc func(ctx context.Context, enforcementLevel *EnforcementLevel) error
+
+	// UpdateIpsProfileFunc mocks the UpdateIpsProfile method.
+	UpdateIpsProfileFunc func(ctx context.Context, uuid string, profile *IpsProfile) (*IpsProfile, error)
+
+	// UpdateIpsRuleFeedFunc mocks the UpdateIpsRuleFeed method.
+	UpdateIpsRuleFeedFunc func(ctx context.Context, uuid string, ruleFeed *IpsRuleFeed) (*IpsRuleFeed, error)
+
+	// UpdateSmartGroupFunc mocks the UpdateSmartGroup method.
+	UpdateSmartGroupFunc func(ctx context.Context, smartGroup *SmartGroup, uuid string) error
+
+	// UpdateTLSProfileFunc mocks the UpdateTLSProfile method.
+	UpdateTLSProfileFunc func(ctx context.Context, uuid string, tlsProfile *TLSProfile) error
+
+	// UpdateWebGroupFunc mocks the UpdateWebGroup method.
+	UpdateWebGroupFunc func(ctx context.Context, webGroup *WebGroup, uuid string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateDCFMitmCa holds details about calls to the CreateDCFMitmCa method.
+		CreateDCFMitmCa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// MitmCa is the mitmCa argument value.
+			MitmCa *MitmCaItemRequest
+		}
+		// CreateDCFPolicyBlock holds details about calls to the CreateDCFPolicyBlock method.
+		CreateDCFPolicyBlock []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PolicyBlock is the policyBlock argument value.
+			PolicyBlock *DCFPolicyBlock
+		}
+		// CreateDCFPolicyList holds details about calls to the CreateDCFPolicyList method.
+		CreateDCFPolicyList []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PolicyList is the policyList argument value.
+			PolicyList *DCFPolicyList
+		}
+		// CreateDCFTrustBundle holds details about calls to the CreateDCFTrustBundle method.
+		CreateDCFTrustBundle []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TrustBundle is the trustBundle argument value.
+			TrustBundle *TrustBundleItemRequest
+		}
+		// CreateDistributedFirewallingDeploymentPolicy holds details about calls to the CreateDistributedFirewallingDeploymentPolicy method.
+		CreateDistributedFirewallingDeploymentPolicy []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DeploymentPolicy is the deploymentPolicy argument value.
+			DeploymentPolicy *DistributedFirewallingDeploymentPolicy
+		}
+		// CreateDistributedFirewallingIntraVpc holds details about calls to the CreateDistributedFirewallingIntraVpc method.
+		CreateDistributedFirewallingIntraVpc []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// VpcList is the vpcList argument value.
+			VpcList *DistributedFirewallingIntraVpcList
+		}
+		// CreateDistributedFirewallingPolicyList holds details about calls to the CreateDistributedFirewallingPolicyList method.
+		CreateDistributedFirewallingPolicyList []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PolicyList is the policyList argument value.
+			PolicyList *DistributedFirewallingPolicyList
+		}
+		// CreateIpsProfile holds details about calls to the CreateIpsProfile method.
+		CreateIpsProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Profile is the profile argument value.
+			Profile *IpsProfile
+		}
+		// CreateIpsRuleFeed holds details about calls to the CreateIpsRuleFeed method.
+		CreateIpsRuleFeed []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RuleFeed is the ruleFeed argument value.
+			RuleFeed *IpsRuleFeed
+		}
+		// CreateSmartGroup holds details about calls to the CreateSmartGroup method.
+		CreateSmartGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SmartGroup is the smartGroup argument value.
+			SmartGroup *SmartGroup
+		}
+		// CreateTLSProfile holds details about calls to the CreateTLSProfile method.
+		CreateTLSProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TlsProfile is the tlsProfile argument value.
+			TlsProfile *TLSProfile
+		}
+		// CreateWebGroup holds details about calls to the CreateWebGroup method.
+		CreateWebGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WebGroup is the webGroup argument value.
+			WebGroup *WebGroup
+		}
+		// DeleteCaCertificate holds details about calls to the DeleteCaCertificate method.
+		DeleteCaCertificate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// DeleteDCFMitmCa holds details about calls to the DeleteDCFMitmCa method.
+		DeleteDCFMitmCa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CaID is the caID argument value.
+			CaID string
+		}
+		// DeleteDCFPolicyBlock holds details about calls to the DeleteDCFPolicyBlock method.
+		DeleteDCFPolicyBlock []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+		}
+		// DeleteDCFPolicyList holds details about calls to the DeleteDCFPolicyList method.
+		DeleteDCFPolicyList []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+		}
+		// DeleteDCFTrustBundle holds details about calls to the DeleteDCFTrustBundle method.
+		DeleteDCFTrustBundle []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// BundleUUID is the bundleUUID argument value.
+			BundleUUID string
+		}
+		// DeleteDistributedFirewallingIntraVpc holds details about calls to the DeleteDistributedFirewallingIntraVpc method.
+		DeleteDistributedFirewallingIntraVpc []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// DeleteDistributedFirewallingPolicyList holds details about calls to the DeleteDistributedFirewallingPolicyList method.
+		DeleteDistributedFirewallingPolicyList []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// DeleteEnforcementLevel holds details about calls to the DeleteEnforcementLevel method.
+		DeleteEnforcementLevel []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// DeleteIpsProfile holds details about calls to the DeleteIpsProfile method.
+		DeleteIpsProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+		}
+		// DeleteIpsRuleFeed holds details about calls to the DeleteIpsRuleFeed method.
+		DeleteIpsRuleFeed []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+		}
+		// DeleteSmartGroup holds details about calls to the DeleteSmartGroup method.
+		DeleteSmartGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+		}
+		// DeleteTLSProfile holds details about calls to the DeleteTLSProfile method.
+		DeleteTLSProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+		}
+		// DeleteWebGroup holds details about calls to the DeleteWebGroup method.
+		DeleteWebGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+		}
+		// DisableDistributedFirewalling holds details about calls to the DisableDistributedFirewalling method.
+		DisableDistributedFirewalling []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// EnableDistributedFirewalling holds details about calls to the EnableDistributedFirewalling method.
+		EnableDistributedFirewalling []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetCaCertificate holds details about calls to the GetCaCertificate method.
+		GetCaCertificate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetControllerIP holds details about calls to the GetControllerIP method.
+		GetControllerIP []struct {
+		}
+		// GetDCFAttachmentPoint holds details about calls to the GetDCFAttachmentPoint method.
+		GetDCFAttachmentPoint []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+		}
+		// GetDCFMitmCa holds details about calls to the GetDCFMitmCa method.
+		GetDCFMitmCa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CaID is the caID argument value.
+			CaID string
+		}
+		// GetDCFPolicyBlock holds details about calls to the GetDCFPolicyBlock method.
+		GetDCFPolicyBlock []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+		}
+		// GetDCFPolicyList holds details about calls to the GetDCFPolicyList method.
+		GetDCFPolicyList []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+		}
+		// GetDCFTrustBundleByID holds details about calls to the GetDCFTrustBundleByID method.
+		GetDCFTrustBundleByID []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// BundleUUID is the bundleUUID argument value.
+			BundleUUID string
+		}
+		// GetDCFTrustBundleByName holds details about calls to the GetDCFTrustBundleByName method.
+		GetDCFTrustBundleByName []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// BundleName is the bundleName argument value.
+			BundleName string
+		}
+		// GetDefaultIpsProfile holds details about calls to the GetDefaultIpsProfile method.
+		GetDefaultIpsProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetDistributedFirewallingDefaultActionRule holds details about calls to the GetDistributedFirewallingDefaultActionRule method.
+		GetDistributedFirewallingDefaultActionRule []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetDistributedFirewallingDeploymentPolicy holds details about calls to the GetDistributedFirewallingDeploymentPolicy method.
+		GetDistributedFirewallingDeploymentPolicy []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetDistributedFirewallingIntraVpc holds details about calls to the GetDistributedFirewallingIntraVpc method.
+		GetDistributedFirewallingIntraVpc []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetDistributedFirewallingPolicyList holds details about calls to the GetDistributedFirewallingPolicyList method.
+		GetDistributedFirewallingPolicyList []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetDistributedFirewallingStatus holds details about calls to the GetDistributedFirewallingStatus method.
+		GetDistributedFirewallingStatus []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetEnforcementLevel holds details about calls to the GetEnforcementLevel method.
+		GetEnforcementLevel []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetIpsProfile holds details about calls to the GetIpsProfile method.
+		GetIpsProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+		}
+		// GetIpsRuleFeed holds details about calls to the GetIpsRuleFeed method.
+		GetIpsRuleFeed []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+		}
+		// GetLogProfileByName holds details about calls to the GetLogProfileByName method.
+		GetLogProfileByName []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ProfileName is the profileName argument value.
+			ProfileName string
+		}
+		// GetMetaCaCertificate holds details about calls to the GetMetaCaCertificate method.
+		GetMetaCaCertificate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetSmartGroup holds details about calls to the GetSmartGroup method.
+		GetSmartGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+		}
+		// GetSmartGroups holds details about calls to the GetSmartGroups method.
+		GetSmartGroups []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetTLSProfile holds details about calls to the GetTLSProfile method.
+		GetTLSProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UuidStr is the uuidStr argument value.
+			UuidStr string
+		}
+		// GetTLSProfileByName holds details about calls to the GetTLSProfileByName method.
+		GetTLSProfileByName []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DisplayName is the displayName argument value.
+			DisplayName string
+		}
+		// GetWebGroup holds details about calls to the GetWebGroup method.
+		GetWebGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+		}
+		// GetWebGroupByName holds details about calls to the GetWebGroupByName method.
+		GetWebGroupByName []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+		}
+		// ListDCFMitmCa holds details about calls to the ListDCFMitmCa method.
+		ListDCFMitmCa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// RefreshDCFMitmSysatemCA holds details about calls to the RefreshDCFMitmSysatemCA method.
+		RefreshDCFMitmSysatemCA []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// SetDefaultIpsProfile holds details about calls to the SetDefaultIpsProfile method.
+		SetDefaultIpsProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Profiles is the profiles argument value.
+			Profiles []string
+		}
+		// SetEnforcementLevel holds details about calls to the SetEnforcementLevel method.
+		SetEnforcementLevel []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnforcementLevel is the enforcementLevel argument value.
+			EnforcementLevel *EnforcementLevel
+		}
+		// SetNewCertificate holds details about calls to the SetNewCertificate method.
+		SetNewCertificate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ProxyCaConfig is the proxyCaConfig argument value.
+			ProxyCaConfig *ProxyCaConfig
+		}
+		// UpdateDCFMitmCa holds details about calls to the UpdateDCFMitmCa method.
+		UpdateDCFMitmCa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CaID is the caID argument value.
+			CaID string
+			// PatchRequest is the patchRequest argument value.
+			PatchRequest *MitmCaPatchRequest
+		}
+		// UpdateDCFPolicyBlock holds details about calls to the UpdateDCFPolicyBlock method.
+		UpdateDCFPolicyBlock []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PolicyBlock is the policyBlock argument value.
+			PolicyBlock *DCFPolicyBlock
+		}
+		// UpdateDCFPolicyList holds details about calls to the UpdateDCFPolicyList method.
+		UpdateDCFPolicyList []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PolicyList is the policyList argument value.
+			PolicyList *DCFPolicyList
+		}
+		// UpdateDCFTrustBundle holds details about calls to the UpdateDCFTrustBundle method.
+		UpdateDCFTrustBundle []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// BundleUUID is the bundleUUID argument value.
+			BundleUUID string
+			// TrustBundle is the trustBundle argument value.
+			TrustBundle *TrustBundleItemRequest
+		}
+		// UpdateDistributedFirewallingDefaultActionRule holds details about calls to the UpdateDistributedFirewallingDefaultActionRule method.
+		UpdateDistributedFirewallingDefaultActionRule []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Request is the request argument value.
+			Request *DistributedFirewallingDefaultActionRule
+		}
+		// UpdateDistributedFirewallingPolicyList holds details about calls to the UpdateDistributedFirewallingPolicyList method.
+		UpdateDistributedFirewallingPolicyList []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PolicyList is the policyList argument value.
+			PolicyList *DistributedFirewallingPolicyList
+		}
+		// UpdateEnforcementLevel holds details about calls to the UpdateEnforcementLevel method.
+		UpdateEnforcementLevel []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EnforcementLevel is the enforcementLevel argument value.
+			EnforcementLevel *EnforcementLevel
+		}
+		// UpdateIpsProfile holds details about calls to the UpdateIpsProfile method.
+		UpdateIpsProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+			// Profile is the profile argument value.
+			Profile *IpsProfile
+		}
+		// UpdateIpsRuleFeed holds details about calls to the UpdateIpsRuleFeed method.
+		UpdateIpsRuleFeed []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+			// RuleFeed is the ruleFeed argument value.
+			RuleFeed *IpsRuleFeed
+		}
+		// UpdateSmartGroup holds details about calls to the UpdateSmartGroup method.
+		UpdateSmartGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SmartGroup is the smartGroup argument value.
+			SmartGroup *SmartGroup
+			// UUID is the uuid argument value.
+			UUID string
+		}
+		// UpdateTLSProfile holds details about calls to the UpdateTLSProfile method.
+		UpdateTLSProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UUID is the uuid argument value.
+			UUID string
+			// TlsProfile is the tlsProfile argument value.
+			TlsProfile *TLSProfile
+		}
+		// UpdateWebGroup holds details about calls to the UpdateWebGroup method.
+		UpdateWebGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WebGroup is the webGroup argument value.
+			WebGroup *WebGroup
+			// UUID is the uuid argument value.
+			UUID string
+		}
+	}
+	lockCreateDCFMitmCa                               sync.RWMutex
+	lockCreateDCFPolicyBlock                          sync.RWMutex
+	lockCreateDCFPolicyList                           sync.RWMutex
+	lockCreateDCFTrustBundle                          sync.RWMutex
+	lockCreateDistributedFirewallingDeploymentPolicy  sync.RWMutex
+	lockCreateDistributedFirewallingIntraVpc          sync.RWMutex
+	lockCreateDistributedFirewallingPolicyList        sync.RWMutex
+	lockCreateIpsProfile                              sync.RWMutex
+	lockCreateIpsRuleFeed                             sync.RWMutex
+	lockCreateSmartGroup                              sync.RWMutex
+	lockCreateTLSProfile                              sync.RWMutex
+	lockCreateWebGroup                                sync.RWMutex
+	lockDeleteCaCertificate                           sync.RWMutex
+	lockDeleteDCFMitmCa                               sync.RWMutex
+	lockDeleteDCFPolicyBlock                          sync.RWMutex
+	lockDeleteDCFPolicyList                           sync.RWMutex
+	lockDeleteDCFTrustBundle                          sync.RWMutex
+	lockDeleteDistributedFirewallingIntraVpc          sync.RWMutex
+	lockDeleteDistributedFirewallingPolicyList        sync.RWMutex
+	lockDeleteEnforcementLevel                        sync.RWMutex
+	lockDeleteIpsProfile                              sync.RWMutex
+	lockDeleteIpsRuleFeed                             sync.RWMutex
+	lockDeleteSmartGroup                              sync.RWMutex
+	lockDeleteTLSProfile                              sync.RWMutex
+	lockDeleteWebGroup                                sync.RWMutex
+	lockDisableDistributedFirewalling                 sync.RWMutex
+	lockEnableDistributedFirewalling                  sync.RWMutex
+	lockGetCaCertificate                              sync.RWMutex
+	lockGetControllerIP                               sync.RWMutex
+	lockGetDCFAttachmentPoint                         sync.RWMutex
+	lockGetDCFMitmCa                                  sync.RWMutex
+	lockGetDCFPolicyBlock                             sync.RWMutex
+	lockGetDCFPolicyList                              sync.RWMutex
+	lockGetDCFTrustBundleByID                         sync.RWMutex
+	lockGetDCFTrustBundleByName                       sync.RWMutex
+	lockGetDefaultIpsProfile                          sync.RWMutex
+	lockGetDistributedFirewallingDefaultActionRule    sync.RWMutex
+	lockGetDistributedFirewallingDeploymentPolicy     sync.RWMutex
+	lockGetDistributedFirewallingIntraVpc             sync.RWMutex
+	lockGetDistributedFirewallingPolicyList           sync.RWMutex
+	lockGetDistributedFirewallingStatus               sync.RWMutex
+	lockGetEnforcementLevel                           sync.RWMutex
+	lockGetIpsProfile                                 sync.RWMutex
+	lockGetIpsRuleFeed                                sync.RWMutex
+	lockGetLogProfileByName                           sync.RWMutex
+	lockGetMetaCaCertificate                          sync.RWMutex
+	lockGetSmartGroup                                 sync.RWMutex
+	lockGetSmartGroups                                sync.RWMutex
+	lockGetTLSProfile                                 sync.RWMutex
+	lockGetTLSProfileByName                           sync.RWMutex
+	lockGetWebGroup                                   sync.RWMutex
+	lockGetWebGroupByName                             sync.RWMutex
+	lockListDCFMitmCa                                 sync.RWMutex
+	lockRefreshDCFMitmSysatemCA                       sync.RWMutex
+	lockSetDefaultIpsProfile                          sync.RWMutex
+	lockSetEnforcementLevel                           sync.RWMutex
+	lockSetNewCertificate                             sync.RWMutex
+	lockUpdateDCFMitmCa                               sync.RWMutex
+	lockUpdateDCFPolicyBlock                          sync.RWMutex
+	lockUpdateDCFPolicyList                           sync.RWMutex
+	lockUpdateDCFTrustBundle                          sync.RWMutex
+	lockUpdateDistributedFirewallingDefaultActionRule sync.RWMutex
+	lockUpdateDistributedFirewallingPolicyList        sync.RWMutex
+	lockUpdateEnforcementLevel                        sync.RWMutex
+	lockUpdateIpsProfile                              sync.RWMutex
+	lockUpdateIpsRuleFeed                             sync.RWMutex
+	lockUpdateSmartGroup                              sync.RWMutex
+	lockUpdateTLSProfile                              sync.RWMutex
+	lockUpdateWebGroup                                sync.RWMutex
+}
+
+// CreateDCFMitmCa calls CreateDCFMitmCaFunc.
+func (mock *DCFClientMock) CreateDCFMitmCa(ctx context.Context, mitmCa *MitmCaItemRequest) (string, error) {
+	if mock.CreateDCFMitmCaFunc == nil {
+		panic("DCFClientMock.CreateDCFMitmCaFunc: method is nil but DCFClient.CreateDCFMitmCa was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		MitmCa *MitmCaItemRequest
+	}{
+		Ctx:    ctx,
+		MitmCa: mitmCa,
+	}
+	mock.lockCreateDCFMitmCa.Lock()
+	mock.calls.CreateDCFMitmCa = append(mock.calls.CreateDCFMitmCa, callInfo)
+	mock.lockCreateDCFMitmCa.Unlock()
+	return mock.CreateDCFMitmCaFunc(ctx, mitmCa)
+}
+
+// CreateDCFMitmCaCalls gets all the calls that were made to CreateDCFMitmCa.
+// Check the length with:
+//
+//	len(mockedDCFClient.CreateDCFMitmCaCalls())
+func (mock *DCFClientMock) CreateDCFMitmCaCalls() []struct {
+	Ctx    context.Context
+	MitmCa *MitmCaItemRequest
+} {
+	var calls []struct {
+		Ctx    context.Context
+		MitmCa *MitmCaItemRequest
+	}
+	mock.lockCreateDCFMitmCa.RLock()
+	calls = mock.calls.CreateDCFMitmCa
+	mock.lockCreateDCFMitmCa.RUnlock()
+	return calls
+}
+
+// CreateDCFPolicyBlock calls CreateDCFPolicyBlockFunc.
+func (mock *DCFClientMock) CreateDCFPolicyBlock(ctx context.Context, policyBlock *DCFPolicyBlock) (string, error) {
+	if mock.CreateDCFPolicyBlockFunc == nil {
+		panic("DCFClientMock.CreateDCFPolicyBlockFunc: method is nil but DCFClient.CreateDCFPolicyBlock was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		PolicyBlock *DCFPolicyBlock
+	}{
+		Ctx:         ctx,
+		PolicyBlock: policyBlock,
+	}
+	mock.lockCreateDCFPolicyBlock.Lock()
+	mock.calls.CreateDCFPolicyBlock = append(mock.calls.CreateDCFPolicyBlock, callInfo)
+	mock.lockCreateDCFPolicyBlock.Unlock()
+	return mock.CreateDCFPolicyBlockFunc(ctx, policyBlock)
+}
+
+// CreateDCFPolicyBlockCalls gets all the calls that were made to CreateDCFPolicyBlock.
+// Check the length with:
+//
+//	len(mockedDCFClient.CreateDCFPolicyBlockCalls())
+func (mock *DCFClientMock) CreateDCFPolicyBlockCalls() []struct {
+	Ctx         context.Context
+	PolicyBlock *DCFPolicyBlock
+} {
+	var calls []struct {
+		Ctx         context.Context
+		PolicyBlock *DCFPolicyBlock
+	}
+	mock.lockCreateDCFPolicyBlock.RLock()
+	calls = mock.calls.CreateDCFPolicyBlock
+	mock.lockCreateDCFPolicyBlock.RUnlock()
+	return calls
+}
+
+// CreateDCFPolicyList calls CreateDCFPolicyListFunc.
+func (mock *DCFClientMock) CreateDCFPolicyList(ctx context.Context, policyList *DCFPolicyList) (string, error) {
+	if mock.CreateDCFPolicyListFunc == nil {
+		panic("DCFClientMock.CreateDCFPolicyListFunc: method is nil but DCFClient.CreateDCFPolicyList was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		PolicyList *DCFPolicyList
+	}{
+		Ctx:        ctx,
+		PolicyList: policyList,
+	}
+	mock.lockCreateDCFPolicyList.Lock()
+	mock.calls.CreateDCFPolicyList = append(mock.calls.CreateDCFPolicyList, callInfo)
+	mock.lockCreateDCFPolicyList.Unlock()
+	return mock.CreateDCFPolicyListFunc(ctx, policyList)
+}
+
+// CreateDCFPolicyListCalls gets all the calls that were made to CreateDCFPolicyList.
+// Check the length with:
+//
+//	len(mockedDCFClient.CreateDCFPolicyListCalls())
+func (mock *DCFClientMock) CreateDCFPolicyListCalls() []struct {
+	Ctx        context.Context
+	PolicyList *DCFPolicyList
+} {
+	var calls []struct {
+		Ctx        context.Context
+		PolicyList *DCFPolicyList
+	}
+	mock.lockCreateDCFPolicyList.RLock()
+	calls = mock.calls.CreateDCFPolicyList
+	mock.lockCreateDCFPolicyList.RUnlock()
+	return calls
+}
+
+// CreateDCFTrustBundle calls CreateDCFTrustBundleFunc.
+func (mock *DCFClientMock) CreateDCFTrustBundle(ctx context.Context, trustBundle *TrustBundleItemRequest) (string, error) {
+	if mock.CreateDCFTrustBundleFunc == nil {
+		panic("DCFClientMock.CreateDCFTrustBundleFunc: method is nil but DCFClient.CreateDCFTrustBundle was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		TrustBundle *TrustBundleItemRequest
+	}{
+		Ctx:         ctx,
+		TrustBundle: trustBundle,
+	}
+	mock.lockCreateDCFTrustBundle.Lock()
+	mock.calls.CreateDCFTrustBundle = append(mock.calls.CreateDCFTrustBundle, callInfo)
+	mock.lockCreateDCFTrustBundle.Unlock()
+	return mock.CreateDCFTrustBundleFunc(ctx, trustBundle)
+}
+
+// CreateDCFTrustBundleCalls gets all the calls that were made to CreateDCFTrustBundle.
+// Check the length with:
+//
+//	len(mockedDCFClient.CreateDCFTrustBundleCalls())
+func (mock *DCFClientMock) CreateDCFTrustBundleCalls() []struct {
+	Ctx         context.Context
+	TrustBundle *TrustBundleItemRequest
+} {
+	var calls []struct {
+		Ctx         context.Context
+		TrustBundle *TrustBundleItemRequest
+	}
+	mock.lockCreateDCFTrustBundle.RLock()
+	calls = mock.calls.CreateDCFTrustBundle
+	mock.lockCreateDCFTrustBundle.RUnlock()
+	return calls
+}
+
+// CreateDistributedFirewallingDeploymentPolicy calls CreateDistributedFirewallingDeploymentPolicyFunc.
+func (mock *DCFClientMock) CreateDistributedFirewallingDeploymentPolicy(ctx context.Context, deploymentPolicy *DistributedFirewallingDeploymentPolicy) error {
+	if mock.CreateDistributedFirewallingDeploymentPolicyFunc == nil {
+		panic("DCFClientMock.CreateDistributedFirewallingDeploymentPolicyFunc: method is nil but DCFClient.CreateDistributedFirewallingDeploymentPolicy was just called")
+	}
+	callInfo := struct {
+		Ctx              context.Context
+		DeploymentPolicy *DistributedFirewallingDeploymentPolicy
+	}{
+		Ctx:              ctx,
+		DeploymentPolicy: deploymentPolicy,
+	}
+	mock.lockCreateDistributedFirewallingDeploymentPolicy.Lock()
+	mock.calls.CreateDistributedFirewallingDeploymentPolicy = append(mock.calls.CreateDistributedFirewallingDeploymentPolicy, callInfo)
+	mock.lockCreateDistributedFirewallingDeploymentPolicy.Unlock()
+	return mock.CreateDistributedFirewallingDeploymentPolicyFunc(ctx, deploymentPolicy)
+}
+
+// CreateDistributedFirewallingDeploymentPolicyCalls gets all the calls that were made to CreateDistributedFirewallingDeploymentPolicy.
+// Check the length with:
+//
+//	len(mockedDCFClient.CreateDistributedFirewallingDeploymentPolicyCalls())
+func (mock *DCFClientMock) CreateDistributedFirewallingDeploymentPolicyCalls() []struct {
+	Ctx              context.Context
+	DeploymentPolicy *DistributedFirewallingDeploymentPolicy
+} {
+	var calls []struct {
+		Ctx              context.Context
+		DeploymentPolicy *DistributedFirewallingDeploymentPolicy
+	}
+	mock.lockCreateDistributedFirewallingDeploymentPolicy.RLock()
+	calls = mock.calls.CreateDistributedFirewallingDeploymentPolicy
+	mock.lockCreateDistributedFirewallingDeploymentPolicy.RUnlock()
+	return calls
+}
+
+// CreateDistributedFirewallingIntraVpc calls CreateDistributedFirewallingIntraVpcFunc.
+func (mock *DCFClientMock) CreateDistributedFirewallingIntraVpc(ctx context.Context, vpcList *DistributedFirewallingIntraVpcList) error {
+	if mock.CreateDistributedFirewallingIntraVpcFunc == nil {
+		panic("DCFClientMock.CreateDistributedFirewallingIntraVpcFunc: method is nil but DCFClient.CreateDistributedFirewallingIntraVpc was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		VpcList *DistributedFirewallingIntraVpcList
+	}{
+		Ctx:     ctx,
+		VpcList: vpcList,
+	}
+	mock.lockCreateDistributedFirewallingIntraVpc.Lock()
+	mock.calls.CreateDistributedFirewallingIntraVpc = append(mock.calls.CreateDistributedFirewallingIntraVpc, callInfo)
+	mock.lockCreateDistributedFirewallingIntraVpc.Unlock()
+	return mock.CreateDistributedFirewallingIntraVpcFunc(ctx, vpcList)
+}
+
+// CreateDistributedFirewallingIntraVpcCalls gets all the calls that were made to CreateDistributedFirewallingIntraVpc.
+// Check the length with:
+//
+//	len(mockedDCFClient.CreateDistributedFirewallingIntraVpcCalls())
+func (mock *DCFClientMock) CreateDistributedFirewallingIntraVpcCalls() []struct {
+	Ctx     context.Context
+	VpcList *DistributedFirewallingIntraVpcList
+} {
+	var calls []struct {
+		Ctx     context.Context
+		VpcList *DistributedFirewallingIntraVpcList
+	}
+	mock.lockCreateDistributedFirewallingIntraVpc.RLock()
+	calls = mock.calls.CreateDistributedFirewallingIntraVpc
+	mock.lockCreateDistributedFirewallingIntraVpc.RUnlock()
+	return calls
+}
+
+// CreateDistributedFirewallingPolicyList calls CreateDistributedFirewallingPolicyListFunc.
+func (mock *DCFClientMock) CreateDistributedFirewallingPolicyList(ctx context.Context, policyList *DistributedFirewallingPolicyList) error {
+	if mock.CreateDistributedFirewallingPolicyListFunc == nil {
+		panic("DCFClientMock.CreateDistributedFirewallingPolicyListFunc: method is nil but DCFClient.CreateDistributedFirewallingPolicyList was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		PolicyList *DistributedFirewallingPolicyList
+	}{
+		Ctx:        ctx,
+		PolicyList: policyList,
+	}
+	mock.lockCreateDistributedFirewallingPolicyList.Lock()
+	mock.calls.CreateDistributedFirewallingPolicyList = append(mock.calls.CreateDistributedFirewallingPolicyList, callInfo)
+	mock.lockCreateDistributedFirewallingPolicyList.Unlock()
+	return mock.CreateDistributedFirewallingPolicyListFunc(ctx, policyList)
+}
+
+// CreateDistributedFirewallingPolicyListCalls gets all the calls that were made to CreateDistributedFirewallingPolicyList.
+// Check the length with:
+//
+//	len(mockedDCFClient.CreateDistributedFirewallingPolicyListCalls())
+func (mock *DCFClientMock) CreateDistributedFirewallingPolicyListCalls() []struct {
+	Ctx        context.Context
+	PolicyList *DistributedFirewallingPolicyList
+} {
+	var calls []struct {
+		Ctx        context.Context
+		PolicyList *DistributedFirewallingPolicyList
+	}
+	mock.lockCreateDistributedFirewallingPolicyList.RLock()
+	calls = mock.calls.CreateDistributedFirewallingPolicyList
+	mock.lockCreateDistributedFirewallingPolicyList.RUnlock()
+	return calls
+}
+
+// CreateIpsProfile calls CreateIpsProfileFunc.
+func (mock *DCFClientMock) CreateIpsProfile(ctx context.Context, profile *IpsProfile) (*IpsProfileCreateResponse, error) {
+	if mock.CreateIpsProfileFunc == nil {
+		panic("DCFClientMock.CreateIpsProfileFunc: method is nil but DCFClient.CreateIpsProfile was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Profile *IpsProfile
+	}{
+		Ctx:     ctx,
+		Profile: profile,
+	}
+	mock.lockCreateIpsProfile.Lock()
+	mock.calls.CreateIpsProfile = append(mock.calls.CreateIpsProfile, callInfo)
+	mock.lockCreateIpsProfile.Unlock()
+	return mock.CreateIpsProfileFunc(ctx, profile)
+}
+
+// CreateIpsProfileCalls gets all the calls that were made to CreateIpsProfile.
+// Check the length with:
+//
+//	len(mockedDCFClient.CreateIpsProfileCalls())
+func (mock *DCFClientMock) CreateIpsProfileCalls() []struct {
+	Ctx     context.Context
+	Profile *IpsProfile
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Profile *IpsProfile
+	}
+	mock.lockCreateIpsProfile.RLock()
+	calls = mock.calls.CreateIpsProfile
+	mock.lockCreateIpsProfile.RUnlock()
+	return calls
+}
+
+// CreateIpsRuleFeed calls CreateIpsRuleFeedFunc.
+func (mock *DCFClientMock) CreateIpsRuleFeed(ctx context.Context, ruleFeed *IpsRuleFeed) (*IpsRuleFeedUploadResponse, error) {
+	if mock.CreateIpsRuleFeedFunc == nil {
+		panic("DCFClientMock.CreateIpsRuleFeedFunc: method is nil but DCFClient.CreateIpsRuleFeed was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		RuleFeed *IpsRuleFeed
+	}{
+		Ctx:      ctx,
+		RuleFeed: ruleFeed,
+	}
+	mock.lockCreateIpsRuleFeed.Lock()
+	mock.calls.CreateIpsRuleFeed = append(mock.calls.CreateIpsRuleFeed, callInfo)
+	mock.lockCreateIpsRuleFeed.Unlock()
+	return mock.CreateIpsRuleFeedFunc(ctx, ruleFeed)
+}
+
+// CreateIpsRuleFeedCalls gets all the calls that were made to CreateIpsRuleFeed.
+// Check the length with:
+//
+//	len(mockedDCFClient.CreateIpsRuleFeedCalls())
+func (mock *DCFClientMock) CreateIpsRuleFeedCalls() []struct {
+	Ctx      context.Context
+	RuleFeed *IpsRuleFeed
+} {
+	var calls []struct {
+		Ctx      context.Context
+		RuleFeed *IpsRuleFeed
+	}
+	mock.lockCreateIpsRuleFeed.RLock()
+	calls = mock.calls.CreateIpsRuleFeed
+	mock.lockCreateIpsRuleFeed.RUnlock()
+	return calls
+}
+
+// CreateSmartGroup calls CreateSmartGroupFunc.
+func (mock *DCFClientMock) CreateSmartGroup(ctx context.Context, smartGroup *SmartGroup) (string, error) {
+	if mock.CreateSmartGroupFunc == nil {
+		panic("DCFClientMock.CreateSmartGroupFunc: method is nil but DCFClient.CreateSmartGroup was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		SmartGroup *SmartGroup
+	}{
+		Ctx:        ctx,
+		SmartGroup: smartGroup,
+	}
+	mock.lockCreateSmartGroup.Lock()
+	mock.calls.CreateSmartGroup = append(mock.calls.CreateSmartGroup, callInfo)
+	mock.lockCreateSmartGroup.Unlock()
+	return mock.CreateSmartGroupFunc(ctx, smartGroup)
+}
+
+// CreateSmartGroupCalls gets all the calls that were made to CreateSmartGroup.
+// Check the length with:
+//
+//	len(mockedDCFClient.CreateSmartGroupCalls())
+func (mock *DCFClientMock) CreateSmartGroupCalls() []struct {
+	Ctx        context.Context
+	SmartGroup *SmartGroup
+} {
+	var calls []struct {
+		Ctx        context.Context
+		SmartGroup *SmartGroup
+	}
+	mock.lockCreateSmartGroup.RLock()
+	calls = mock.calls.CreateSmartGroup
+	mock.lockCreateSmartGroup.RUnlock()
+	return calls
+}
+
+// CreateTLSProfile calls CreateTLSProfileFunc.
+func (mock *DCFClientMock) CreateTLSProfile(ctx context.Context, tlsProfile *TLSProfile) (string, error) {
+	if mock.CreateTLSProfileFunc == nil {
+		panic("DCFClientMock.CreateTLSProfileFunc: method is nil but DCFClient.CreateTLSProfile was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		TlsProfile *TLSProfile
+	}{
+		Ctx:        ctx,
+		TlsProfile: tlsProfile,
+	}
+	mock.lockCreateTLSProfile.Lock()
+	mock.calls.CreateTLSProfile = append(mock.calls.CreateTLSProfile, callInfo)
+	mock.lockCreateTLSProfile.Unlock()
+	return mock.CreateTLSProfileFunc(ctx, tlsProfile)
+}
+
+// CreateTLSProfileCalls gets all the calls that were made to CreateTLSProfile.
+// Check the length with:
+//
+//	len(mockedDCFClient.CreateTLSProfileCalls())
+func (mock *DCFClientMock) CreateTLSProfileCalls() []struct {
+	Ctx        context.Context
+	TlsProfile *TLSProfile
+} {
+	var calls []struct {
+		Ctx        context.Context
+		TlsProfile *TLSProfile
+	}
+	mock.lockCreateTLSProfile.RLock()
+	calls = mock.calls.CreateTLSProfile
+	mock.lockCreateTLSProfile.RUnlock()
+	return calls
+}
+
+// CreateWebGroup calls CreateWebGroupFunc.
+func (mock *DCFClientMock) CreateWebGroup(ctx context.Context, webGroup *WebGroup) (string, error) {
+	if mock.CreateWebGroupFunc == nil {
+		panic("DCFClientMock.CreateWebGroupFunc: method is nil but DCFClient.CreateWebGroup was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		WebGroup *WebGroup
+	}{
+		Ctx:      ctx,
+		WebGroup: webGroup,
+	}
+	mock.lockCreateWebGroup.Lock()
+	mock.calls.CreateWebGroup = append(mock.calls.CreateWebGroup, callInfo)
+	mock.lockCreateWebGroup.Unlock()
+	return mock.CreateWebGroupFunc(ctx, webGroup)
+}
+
+// CreateWebGroupCalls gets all the calls that were made to CreateWebGroup.
+// Check the length with:
+//
+//	len(mockedDCFClient.CreateWebGroupCalls())
+func (mock *DCFClientMock) CreateWebGroupCalls() []struct {
+	Ctx      context.Context
+	WebGroup *WebGroup
+} {
+	var calls []struct {
+		Ctx      context.Context
+		WebGroup *WebGroup
+	}
+	mock.lockCreateWebGroup.RLock()
+	calls = mock.calls.CreateWebGroup
+	mock.lockCreateWebGroup.RUnlock()
+	return calls
+}
+
+// DeleteCaCertificate calls DeleteCaCertificateFunc.
+func (mock *DCFClientMock) DeleteCaCertificate(ctx context.Context) error {
+	if mock.DeleteCaCertificateFunc == nil {
+		panic("DCFClientMock.DeleteCaCertificateFunc: method is nil but DCFClient.DeleteCaCertificate was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockDeleteCaCertificate.Lock()
+	mock.calls.DeleteCaCertificate = append(mock.calls.DeleteCaCertificate, callInfo)
+	mock.lockDeleteCaCertificate.Unlock()
+	return mock.DeleteCaCertificateFunc(ctx)
+}
+
+// DeleteCaCertificateCalls gets all the calls that were made to DeleteCaCertificate.
+// Check the length with:
+//
+//	len(mockedDCFClient.DeleteCaCertificateCalls())
+func (mock *DCFClientMock) DeleteCaCertificateCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockDeleteCaCertificate.RLock()
+	calls = mock.calls.DeleteCaCertificate
+	mock.lockDeleteCaCertificate.RUnlock()
+	return calls
+}
+
+// DeleteDCFMitmCa calls DeleteDCFMitmCaFunc.
+func (mock *DCFClientMock) DeleteDCFMitmCa(ctx context.Context, caID string) error {
+	if mock.DeleteDCFMitmCaFunc == nil {
+		panic("DCFClientMock.DeleteDCFMitmCaFunc: method is nil but DCFClient.DeleteDCFMitmCa was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		CaID string
+	}{
+		Ctx:  ctx,
+		CaID: caID,
+	}
+	mock.lockDeleteDCFMitmCa.Lock()
+	mock.calls.DeleteDCFMitmCa = append(mock.calls.DeleteDCFMitmCa, callInfo)
+	mock.lockDeleteDCFMitmCa.Unlock()
+	return mock.DeleteDCFMitmCaFunc(ctx, caID)
+}
+
+// DeleteDCFMitmCaCalls gets all the calls that were made to DeleteDCFMitmCa.
+// Check the length with:
+//
+//	len(mockedDCFClient.DeleteDCFMitmCaCalls())
+func (mock *DCFClientMock) DeleteDCFMitmCaCalls() []struct {
+	Ctx  context.Context
+	CaID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		CaID string
+	}
+	mock.lockDeleteDCFMitmCa.RLock()
+	calls = mock.calls.DeleteDCFMitmCa
+	mock.lockDeleteDCFMitmCa.RUnlock()
+	return calls
+}
+
+// DeleteDCFPolicyBlock calls DeleteDCFPolicyBlockFunc.
+func (mock *DCFClientMock) DeleteDCFPolicyBlock(ctx context.Context, uuid string) error {
+	if mock.DeleteDCFPolicyBlockFunc == nil {
+		panic("DCFClientMock.DeleteDCFPolicyBlockFunc: method is nil but DCFClient.DeleteDCFPolicyBlock was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		UUID string
+	}{
+		Ctx:  ctx,
+		UUID: uuid,
+	}
+	mock.lockDeleteDCFPolicyBlock.Lock()
+	mock.calls.DeleteDCFPolicyBlock = append(mock.calls.DeleteDCFPolicyBlock, callInfo)
+	mock.lockDeleteDCFPolicyBlock.Unlock()
+	return mock.DeleteDCFPolicyBlockFunc(ctx, uuid)
+}
+
+// DeleteDCFPolicyBlockCalls gets all the calls that were made to DeleteDCFPolicyBlock.
+// Check the length with:
+//
+//	len(mockedDCFClient.DeleteDCFPolicyBlockCalls())
+func (mock *DCFClientMock) DeleteDCFPolicyBlockCalls() []struct {
+	Ctx  context.Context
+	UUID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		UUID string
+	}
+	mock.lockDeleteDCFPolicyBlock.RLock()
+	calls = mock.calls.DeleteDCFPolicyBlock
+	mock.lockDeleteDCFPolicyBlock.RUnlock()
+	return calls
+}
+
+// DeleteDCFPolicyList calls DeleteDCFPolicyListFunc.
+func (mock *DCFClientMock) DeleteDCFPolicyList(ctx context.Context, uuid string) error {
+	if mock.DeleteDCFPolicyListFunc == nil {
+		panic("DCFClientMock.DeleteDCFPolicyListFunc: method is nil but DCFClient.DeleteDCFPolicyList was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		UUID string
+	}{
+		Ctx:  ctx,
+		UUID: uuid,
+	}
+	mock.lockDeleteDCFPolicyList.Lock()
+	mock.calls.DeleteDCFPolicyList = append(mock.calls.DeleteDCFPolicyList, callInfo)
+	mock.lockDeleteDCFPolicyList.Unlock()
+	return mock.DeleteDCFPolicyListFunc(ctx, uuid)
+}
+
+// DeleteDCFPolicyListCalls gets all the calls that were made to DeleteDCFPolicyList.
+// Check the length with:
+//
+//	len(mockedDCFClient.DeleteDCFPolicyListCalls())
+func (mock *DCFClientMock) DeleteDCFPolicyListCalls() []struct {
+	Ctx  context.Context
+	UUID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		UUID string
+	}
+	mock.lockDeleteDCFPolicyList.RLock()
+	calls = mock.calls.DeleteDCFPolicyList
+	mock.lockDeleteDCFPolicyList.RUnlock()
+	return calls
+}
+
+// DeleteDCFTrustBundle calls DeleteDCFTrustBundleFunc.
+func (mock *DCFClientMock) DeleteDCFTrustBundle(ctx context.Context, bundleUUID string) error {
+	if mock.DeleteDCFTrustBundleFunc == nil {
+		panic("DCFClientMock.DeleteDCFTrustBundleFunc: method is nil but DCFClient.DeleteDCFTrustBundle was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		BundleUUID string
+	}{
+		Ctx:        ctx,
+		BundleUUID: bundleUUID,
+	}
+	mock.lockDeleteDCFTrustBundle.Lock()
+	mock.calls.DeleteDCFTrustBundle = append(mock.calls.DeleteDCFTrustBundle, callInfo)
+	mock.lockDeleteDCFTrustBundle.Unlock()
+	return mock.DeleteDCFTrustBundleFunc(ctx, bundleUUID)
+}
+
+// DeleteDCFTrustBundleCalls gets all the calls that were made to DeleteDCFTrustBundle.
+// Check the length with:
+//
+//	len(mockedDCFClient.DeleteDCFTrustBundleCalls())
+func (mock *DCFClientMock) DeleteDCFTrustBundleCalls() []struct {
+	Ctx        context.Context
+	BundleUUID string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		BundleUUID string
+	}
+	mock.lockDeleteDCFTrustBundle.RLock()
+	calls = mock.calls.DeleteDCFTrustBundle
+	mock.lockDeleteDCFTrustBundle.RUnlock()
+	return calls
+}
+
+// DeleteDistributedFirewallingIntraVpc calls DeleteDistributedFirewallingIntraVpcFunc.
+func (mock *DCFClientMock) DeleteDistributedFirewallingIntraVpc(ctx context.Context) error {
+	if mock.DeleteDistributedFirewallingIntraVpcFunc == nil {
+		panic("DCFClientMock.DeleteDistributedFirewallingIntraVpcFunc: method is nil but DCFClient.DeleteDistributedFirewallingIntraVpc was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockDeleteDistributedFirewallingIntraVpc.Lock()
+	mock.calls.DeleteDistributedFirewallingIntraVpc = append(mock.calls.DeleteDistributedFirewallingIntraVpc, callInfo)
+	mock.lockDeleteDistributedFirewallingIntraVpc.Unlock()
+	return mock.DeleteDistributedFirewallingIntraVpcFunc(ctx)
+}
+
+// DeleteDistributedFirewallingIntraVpcCalls gets all the calls that were made to DeleteDistributedFirewallingIntraVpc.
+// Check the length with:
+//
+//	len(mockedDCFClient.DeleteDistributedFirewallingIntraVpcCalls())
+func (mock *DCFClientMock) DeleteDistributedFirewallingIntraVpcCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockDeleteDistributedFirewallingIntraVpc.RLock()
+	calls = mock.calls.DeleteDistributedFirewallingIntraVpc
+	mock.lockDeleteDistributedFirewallingIntraVpc.RUnlock()
+	return calls
+}
+
+// DeleteDistributedFirewallingPolicyList calls DeleteDistributedFirewallingPolicyListFunc.
+func (mock *DCFClientMock) DeleteDistributedFirewallingPolicyList(ctx context.Context) error {
+	if mock.DeleteDistributedFirewallingPolicyListFunc == nil {
+		panic("DCFClientMock.DeleteDistributedFirewallingPolicyListFunc: method is nil but DCFClient.DeleteDistributedFirewallingPolicyList was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockDeleteDistributedFirewallingPolicyList.Lock()
+	mock.calls.DeleteDistributedFirewallingPolicyList = append(mock.calls.DeleteDistributedFirewallingPolicyList, callInfo)
+	mock.lockDeleteDistributedFirewallingPolicyList.Unlock()
+	return mock.DeleteDistributedFirewallingPolicyListFunc(ctx)
+}
+
+// DeleteDistributedFirewallingPolicyListCalls gets all the calls that were made to DeleteDistributedFirewallingPolicyList.
+// Check the length with:
+//
+//	len(mockedDCFClient.DeleteDistributedFirewallingPolicyListCalls())
+func (mock *DCFClientMock) DeleteDistributedFirewallingPolicyListCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockDeleteDistributedFirewallingPolicyList.RLock()
+	calls = mock.calls.DeleteDistributedFirewallingPolicyList
+	mock.lockDeleteDistributedFirewallingPolicyList.RUnlock()
+	return calls
+}
+
+// DeleteEnforcementLevel calls DeleteEnforcementLevelFunc.
+func (mock *DCFClientMock) DeleteEnforcementLevel(ctx context.Context) error {
+	if mock.DeleteEnforcementLevelFunc == nil {
+		panic("DCFClientMock.DeleteEnforcementLevelFunc: method is nil but DCFClient.DeleteEnforcementLevel was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockDeleteEnforcementLevel.Lock()
+	mock.calls.DeleteEnforcementLevel = append(mock.calls.DeleteEnforcementLevel, callInfo)
+	mock.lockDeleteEnforcementLevel.Unlock()
+	return mock.DeleteEnforcementLevelFunc(ctx)
+}
+
+// DeleteEnforcementLevelCalls gets all the calls that were made to DeleteEnforcementLevel.
+// Check the length with:
+//
+//	len(mockedDCFClient.DeleteEnforcementLevelCalls())
+func (mock *DCFClientMock) DeleteEnforcementLevelCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockDeleteEnforcementLevel.RLock()
+	calls = mock.calls.DeleteEnforcementLevel
+	mock.lockDeleteEnforcementLevel.RUnlock()
+	return calls
+}
+
+// DeleteIpsProfile calls DeleteIpsProfileFunc.
+func (mock *DCFClientMock) DeleteIpsProfile(ctx context.Context, uuid string) error {
+	if mock.DeleteIpsProfileFunc == nil {
+		panic("DCFClientMock.DeleteIpsProfileFunc: method is nil but DCFClient.DeleteIpsProfile was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		UUID string
+	}{
+		Ctx:  ctx,
+		UUID: uuid,
+	}
+	mock.lockDeleteIpsProfile.Lock()
+	mock.calls.DeleteIpsProfile = append(mock.calls.DeleteIpsProfile, callInfo)
+	mock.lockDeleteIpsProfile.Unlock()
+	return mock.DeleteIpsProfileFunc(ctx, uuid)
+}
+
+// DeleteIpsProfileCalls gets all the calls that were made to DeleteIpsProfile.
+// Check the length with:
+//
+//	len(mockedDCFClient.DeleteIpsProfileCalls())
+func (mock *DCFClientMock) DeleteIpsProfileCalls() []struct {
+	Ctx  context.Context
+	UUID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		UUID string
+	}
+	mock.lockDeleteIpsProfile.RLock()
+	calls = mock.calls.DeleteIpsProfile
+	mock.lockDeleteIpsProfile.RUnlock()
+	return calls
+}
+
+// DeleteIpsRuleFeed calls DeleteIpsRuleFeedFunc.
+func (mock *DCFClientMock) DeleteIpsRuleFeed(ctx context.Context, uuid string) error {
+	if mock.DeleteIpsRuleFeedFunc == nil {
+		panic("DCFClientMock.DeleteIpsRuleFeedFunc: method is nil but DCFClient.DeleteIpsRuleFeed was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		UUID string
+	}{
+		Ctx:  ctx,
+		UUID: uuid,
+	}
+	mock.lockDeleteIpsRuleFeed.Lock()
+	mock.calls.DeleteIpsRuleFeed = append(mock.calls.DeleteIpsRuleFeed, callInfo)
+	mock.lockDeleteIpsRuleFeed.Unlock()
+	return mock.DeleteIpsRuleFeedFunc(ctx, uuid)
+}
+
+// DeleteIpsRuleFeedCalls gets all the calls that were made to DeleteIpsRuleFeed.
+// Check the length with:
+//
+//	len(mockedDCFClient.DeleteIpsRuleFeedCalls())
+func (mock *DCFClientMock) DeleteIpsRuleFeedCalls() []struct {
+	Ctx  context.Context
+	UUID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		UUID string
+	}
+	mock.lockDeleteIpsRuleFeed.RLock()
+	calls = mock.calls.DeleteIpsRuleFeed
+	mock.lockDeleteIpsRuleFeed.RUnlock()
+	return calls
+}
+
+// DeleteSmartGroup calls DeleteSmartGroupFunc.
+func (mock *DCFClientMock) DeleteSmartGroup(ctx context.Context, uuid string) error {
+	if mock.DeleteSmartGroupFunc == nil {
+		panic("DCFClientMock.DeleteSmartGroupFunc: method is nil but DCFClient.DeleteSmartGroup was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		UUID string
+	}{
+		Ctx:  ctx,
+		UUID: uuid,
+	}
+	mock.lockDeleteSmartGroup.Lock()
+	mock.calls.DeleteSmartGroup = append(mock.calls.DeleteSmartGroup, callInfo)
+	mock.lockDeleteSmartGroup.Unlock()
+	return mock.DeleteSmartGroupFunc(ctx, uuid)
+}
+
+// DeleteSmartGroupCalls gets all the calls that were made to DeleteSmartGroup.
+// Check the length with:
+//
+//	len(mockedDCFClient.DeleteSmartGroupCalls())
+func (mock *DCFClientMock) DeleteSmartGroupCalls() []struct {
+	Ctx  context.Context
+	UUID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		UUID string
+	}
+	mock.lockDeleteSmartGroup.RLock()
+	calls = mock.calls.DeleteSmartGroup
+	mock.lockDeleteSmartGroup.RUnlock()
+	return calls
+}
+
+// DeleteTLSProfile calls DeleteTLSProfileFunc.
+func (mock *DCFClientMock) DeleteTLSProfile(ctx context.Context, uuid string) error {
+	if mock.DeleteTLSProfileFunc == nil {
+		panic("DCFClientMock.DeleteTLSProfileFunc: method is nil but DCFClient.DeleteTLSProfile was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		UUID string
+	}{
+		Ctx:  ctx,
+		UUID: uuid,
+	}
+	mock.lockDeleteTLSProfile.Lock()
+	mock.calls.DeleteTLSProfile = append(mock.calls.DeleteTLSProfile, callInfo)
+	mock.lockDeleteTLSProfile.Unlock()
+	return mock.DeleteTLSProfileFunc(ctx, uuid)
+}
+
+// DeleteTLSProfileCalls gets all the calls that were made to DeleteTLSProfile.
+// Check the length with:
+//
+//	len(mockedDCFClient.DeleteTLSProfileCalls())
+func (mock *DCFClientMock) DeleteTLSProfileCalls() []struct {
+	Ctx  context.Context
+	UUID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		UUID string
+	}
+	mock.lockDeleteTLSProfile.RLock()
+	calls = mock.calls.DeleteTLSProfile
+	mock.lockDeleteTLSProfile.RUnlock()
+	return calls
+}
+
+// DeleteWebGroup calls DeleteWebGroupFunc.
+func (mock *DCFClientMock) DeleteWebGroup(ctx context.Context, uuid string) error {
+	if mock.DeleteWebGroupFunc == nil {
+		panic("DCFClientMock.DeleteWebGroupFunc: method is nil but DCFClient.DeleteWebGroup was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		UUID string
+	}{
+		Ctx:  ctx,
+		UUID: uuid,
+	}
+	mock.lockDeleteWebGroup.Lock()
+	mock.calls.DeleteWebGroup = append(mock.calls.DeleteWebGroup, callInfo)
+	mock.lockDeleteWebGroup.Unlock()
+	return mock.DeleteWebGroupFunc(ctx, uuid)
+}
+
+// DeleteWebGroupCalls gets all the calls that were made to DeleteWebGroup.
+// Check the length with:
+//
+//	len(mockedDCFClient.DeleteWebGroupCalls())
+func (mock *DCFClientMock) DeleteWebGroupCalls() []struct {
+	Ctx  context.Context
+	UUID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		UUID string
+	}
+	mock.lockDeleteWebGroup.RLock()
+	calls = mock.calls.DeleteWebGroup
+	mock.lockDeleteWebGroup.RUnlock()
+	return calls
+}
+
+// DisableDistributedFirewalling calls DisableDistributedFirewallingFunc.
+func (mock *DCFClientMock) DisableDistributedFirewalling(ctx context.Context) error {
+	if mock.DisableDistributedFirewallingFunc == nil {
+		panic("DCFClientMock.DisableDistributedFirewallingFunc: method is nil but DCFClient.DisableDistributedFirewalling was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockDisableDistributedFirewalling.Lock()
+	mock.calls.DisableDistributedFirewalling = append(mock.calls.DisableDistributedFirewalling, callInfo)
+	mock.lockDisableDistributedFirewalling.Unlock()
+	return mock.DisableDistributedFirewallingFunc(ctx)
+}
+
+// DisableDistributedFirewallingCalls gets all the calls that were made to DisableDistributedFirewalling.
+// Check the length with:
+//
+//	len(mockedDCFClient.DisableDistributedFirewallingCalls())
+func (mock *DCFClientMock) DisableDistributedFirewallingCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockDisableDistributedFirewalling.RLock()
+	calls = mock.calls.DisableDistributedFirewalling
+	mock.lockDisableDistributedFirewalling.RUnlock()
+	return calls
+}
+
+// EnableDistributedFirewalling calls EnableDistributedFirewallingFunc.
+func (mock *DCFClientMock) EnableDistributedFirewalling(ctx context.Context) error {
+	if mock.EnableDistributedFirewallingFunc == nil {
+		panic("DCFClientMock.EnableDistributedFirewallingFunc: method is nil but DCFClient.EnableDistributedFirewalling was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockEnableDistributedFirewalling.Lock()
+	mock.calls.EnableDistributedFirewalling = append(mock.calls.EnableDistributedFirewalling, callInfo)
+	mock.lockEnableDistributedFirewalling.Unlock()
+	return mock.EnableDistributedFirewallingFunc(ctx)
+}
+
+// EnableDistributedFirewallingCalls gets all the calls that were made to EnableDistributedFirewalling.
+// Check the length with:
+//
+//	len(mockedDCFClient.EnableDistributedFirewallingCalls())
+func (mock *DCFClientMock) EnableDistributedFirewallingCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockEnableDistributedFirewalling.RLock()
+	calls = mock.calls.EnableDistributedFirewalling
+	mock.lockEnableDistributedFirewalling.RUnlock()
+	return calls
+}
+
+// GetCaCertificate calls GetCaCertificateFunc.
+func (mock *DCFClientMock) GetCaCertificate(ctx context.Context) (*ProxyCaConfig, error) {
+	if mock.GetCaCertificateFunc == nil {
+		panic("DCFClientMock.GetCaCertificateFunc: method is nil but DCFClient.GetCaCertificate was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetCaCertificate.Lock()
+	mock.calls.GetCaCertificate = append(mock.calls.GetCaCertificate, callInfo)
+	mock.lockGetCaCertificate.Unlock()
+	return mock.GetCaCertificateFunc(ctx)
+}
+
+// GetCaCertificateCalls gets all the calls that were made to GetCaCertificate.
+// Check the length with:
+//
+//	len(mockedDCFClient.GetCaCertificateCalls())
+func (mock *DCFClientMock) GetCaCertificateCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetCaCertificate.RLock()
+	calls = mock.calls.GetCaCertificate
+	mock.lockGetCaCertificate.RUnlock()
+	return calls
+}
+
+// GetControllerIP calls GetControllerIPFunc.
+func (mock *DCFClientMock) GetControllerIP() string {
+	if mock.GetControllerIPFunc == nil {
+		panic("DCFClientMock.GetControllerIPFunc: method is nil but DCFClient.GetControllerIP was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetControllerIP.Lock()
+	mock.calls.GetControllerIP = append(mock.calls.GetControllerIP, callInfo)
+	mock.lockGetControllerIP.Unlock()
+	return mock.GetControllerIPFunc()
+}
+
+// GetControllerIPCalls gets all the calls that were made to GetControllerIP.
+// Check the length with:
+//
+//	len(mockedDCFClient.GetControllerIPCalls())
+func (mock *DCFClientMock) GetControllerIPCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetControllerIP.RLock()
+	calls = mock.calls.GetControllerIP
+	mock.lockGetControllerIP.RUnlock()
+	return calls
+}
+
+// GetDCFAttachmentPoint calls GetDCFAttachmentPointFunc.
+func (mock *DCFClientMock) GetDCFAttachmentPoint(ctx context.Context, name string) (*AttachmentPointResp, error) {
+	if mock.GetDCFAttachmentPointFunc == nil {
+		panic("DCFClientMock.GetDCFAttachmentPointFunc: method is nil but DCFClient.GetDCFAttachmentPoint was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+	}{
+		Ctx:  ctx,
+		Name: name,
+	}
+	mock.lockGetDCFAttachmentPoint.Lock()
+	mock.calls.GetDCFAttachmentPoint = append(mock.calls.GetDCFAttachmentPoint, callInfo)
+	mock.lockGetDCFAttachmentPoint.Unlock()
+	return mock.GetDCFAttachmentPointFunc(ctx, name)
+}
+
+// GetDCFAttachmentPointCalls gets all the calls that were made to GetDCFAttachmentPoint.
+// Check the length with:
+//
+//	len(mockedDCFClient.GetDCFAttachmentPointCalls())
+func (mock *DCFClientMock) GetDCFAttachmentPointCalls() []struct {
+	Ctx  context.Context
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+	}
+	mock.lockGetDCFAttachmentPoint.RLock()
+	calls = mock.calls.GetDCFAttachmentPoint
+	mock.lockGetDCFAttachmentPoint.RUnlock()
+	return calls
+}
+
+// GetDCFMitmCa calls GetDCFMitmCaFunc.
+func (mock *DCFClientMock) GetDCFMitmCa(ctx context.Context, caID string) (*MitmCaResponse, error) {
+	if mock.GetDCFMitmCaFunc == nil {
+		panic("DCFClientMock.GetDCFMitmCaFunc: method is nil but DCFClient.GetDCFMitmCa was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		CaID string
+	}{
+		Ctx:  ctx,
+		CaID: caID,
+	}
+	mock.lockGetDCFMitmCa.Lock()
+	mock.calls.GetDCFMitmCa = append(mock.calls.GetDCFMitmCa, callInfo)
+	mock.lockGetDCFMitmCa.Unlock()
+	return mock.GetDCFMitmCaFunc(ctx, caID)
+}
+
+// GetDCFMitmCaCalls gets all the calls that were made to GetDCFMitmCa.
+// Check the length with:
+//
+//	len(mockedDCFClient.GetDCFMitmCaCalls())
+func (mock *DCFClientMock) GetDCFMitmCaCalls() []struct {
+	Ctx  context.Context
+	CaID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		CaID string
+	}
+	mock.lockGetDCFMitmCa.RLock()
+	calls = mock.calls.GetDCFMitmCa
+	mock.lockGetDCFMitmCa.RUnlock()
+	return calls
+}
+
+// GetDCFPolicyBlock calls GetDCFPolicyBlockFunc.
+func (mock *DCFClientMock) GetDCFPolicyBlock(ctx context.Context, uuid string) (*DCFPolicyBlock, error) {
+	if mock.GetDCFPolicyBlockFunc == nil {
+		panic("DCFClientMock.GetDCFPolicyBlockFunc: method is nil but DCFClient.GetDCFPolicyBlock was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		UUID string
+	}{
+		Ctx:  ctx,
+		UUID: uuid,
+	}
+	mock.lockGetDCFPolicyBlock.Lock()
+	mock.calls.GetDCFPolicyBlock = append(mock.calls.GetDCFPolicyBlock, callInfo)
+	mock.lockGetDCFPolicyBlock.Unlock()
+	return mock.GetDCFPolicyBlockFunc(ctx, uuid)
+}
+
+// GetDCFPolicyBlockCalls gets all the calls that were made to GetDCFPolicyBlock.
+// Check the length with:
+//
+//	len(mockedDCFClient.GetDCFPolicyBlockCalls())
+func (mock *DCFClientMock) GetDCFPolicyBlockCalls() []struct {
+	Ctx  context.Context
+	UUID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		UUID string
+	}
+	mock.lockGetDCFPolicyBlock.RLock()
+	calls = mock.calls.GetDCFPolicyBlock
+	mock.lockGetDCFPolicyBlock.RUnlock()
+	return calls
+}
+
+// GetDCFPolicyList calls GetDCFPolicyListFunc.
+func (mock *DCFClientMock) GetDCFPolicyList(ctx context.Context, uuid string) (*DCFPolicyList, error) {
+	if mock.GetDCFPolicyListFunc == nil {
+		panic("DCFClientMock.GetDCFPolicyListFunc: method is nil but DCFClient.GetDCFPolicyList was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		UUID string
+	}{
+		Ctx:  ctx,
+		UUID: uuid,
+	}
+	mock.lockGetDCFPolicyList.Lock()
+	mock.calls.GetDCFPolicyList = append(mock.calls.GetDCFPolicyList, callInfo)
+	mock.lockGetDCFPolicyList.Unlock()
+	return mock.GetDCFPolicyListFunc(ctx, uuid)
+}
+
+// GetDCFPolicyListCalls gets all the calls that were made to GetDCFPolicyList.
+// Check the length with:
+//
+//	len(mockedDCFClient.GetDCFPolicyListCalls())
+func (mock *DCFClientMock) GetDCFPolicyListCalls() []struct {
+	Ctx  context.Context
+	UUID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		UUID string
+	}
+	mock.lockGetDCFPolicyList.RLock()
+	calls = mock.calls.GetDCFPolicyList
+	mock.lockGetDCFPolicyList.RUnlock()
+	return calls
+}
+
+// GetDCFTrustBundleByID calls GetDCFTrustBundleByIDFunc.
+func (mock *DCFClientMock) GetDCFTrustBundleByID(ctx context.Context, bundleUUID string) (*DCFTrustBundle, error) {
+	if mock.GetDCFTrustBundleByIDFunc == nil {
+		panic("DCFClientMock.GetDCFTrustBundleByIDFunc: method is nil but DCFClient.GetDCFTrustBundleByID was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		BundleUUID string
+	}{
+		Ctx:        ctx,
+		BundleUUID: bundleUUID,
+	}
+	mock.lockGetDCFTrustBundleByID.Lock()
+	mock.calls.GetDCFTrustBundleByID = append(mock.calls.GetDCFTrustBundleByID, callInfo)
+	mock.lockGetDCFTrustBundleByID.Unlock()
+	return mock.GetDCFTrustBundleByIDFunc(ctx, bundleUUID)
+}
+
+// GetDCFTrustBundleByIDCalls gets all the calls that were made to GetDCFTrustBundleByID.
+// Check the length with:
+//
+//	len(mockedDCFClient.GetDCFTrustBundleByIDCalls())
+func (mock *DCFClientMock) GetDCFTrustBundleByIDCalls() []struct {
+	Ctx        context.Context
+	BundleUUID string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		BundleUUID string
+	}
+	mock.lockGetDCFTrustBundleByID.RLock()
+	calls = mock.calls.GetDCFTrustBundleByID
+	mock.lockGetDCFTrustBundleByID.RUnlock()
+	return calls
+}
+
+// GetDCFTrustBundleByName calls GetDCFTrustBundleByNameFunc.
+func (mock *DCFClientMock) GetDCFTrustBundleByName(ctx context.Context, bundleName string) (*DCFTrustBundle, error) {
+	if mock.GetDCFTrustBundleByNameFunc == nil {
+		panic("DCFClientMock.GetDCFTrustBundleByNameFunc: method is nil but DCFClient.GetDCFTrustBundleByName was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		BundleName string
+	}{
+		Ctx:        ctx,
+		BundleName: bundleName,
+	}
+	mock.lockGetDCFTrustBundleByName.Lock()
+	mock.calls.GetDCFTrustBundleByName = append(mock.calls.GetDCFTrustBundleByName, callInfo)
+	mock.lockGetDCFTrustBundleByName.Unlock()
+	return mock.GetDCFTrustBundleByNameFunc(ctx, bundleName)
+}
+
+// GetDCFTrustBundleByNameCalls gets all the calls that were made to GetDCFTrustBundleByName.
+// Check the length with:
+//
+//	len(mockedDCFClient.GetDCFTrustBundleByNameCalls())
+func (mock *DCFClientMock) GetDCFTrustBundleByNameCalls() []struct {
+	Ctx        context.Context
+	BundleName string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		BundleName string
+	}
+	mock.lockGetDCFTrustBundleByName.RLock()
+	calls = mock.calls.GetDCFTrustBundleByName
+	mock.lockGetDCFTrustBundleByName.RUnlock()
+	return calls
+}
+
+// GetDefaultIpsProfile calls GetDefaultIpsProfileFunc.
+func (mock *DCFClientMock) GetDefaultIpsProfile(ctx context.Context) (*DefaultIpsProfileResponse, error) {
+	if mock.GetDefaultIpsProfileFunc == nil {
+		panic("DCFClientMock.GetDefaultIpsProfileFunc: method is nil but DCFClient.GetDefaultIpsProfile was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetDefaultIpsProfile.Lock()
+	mock.calls.GetDefaultIpsProfile = append(mock.calls.GetDefaultIpsProfile, callInfo)
+	mock.lockGetDefaultIpsProfile.Unlock()
+	return mock.GetDefaultIpsProfileFunc(ctx)
+}
+
+// GetDefaultIpsProfileCalls gets all the calls that were made to GetDefaultIpsProfile.
+// Check the length with:
+//
+//	len(mockedDCFClient.GetDefaultIpsProfileCalls())
+func (mock *DCFClientMock) GetDefaultIpsProfileCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetDefaultIpsProfile.RLock()
+	calls = mock.calls.GetDefaultIpsProfile
+	mock.lockGetDefaultIpsProfile.RUnlock()
+	return calls
+}
+
+// GetDistributedFirewallingDefaultActionRule calls GetDistributedFirewallingDefaultActionRuleFunc.
+func (mock *DCFClientMock) GetDistributedFirewallingDefaultActionRule(ctx context.Context) (*DistributedFirewallingDefaultActionRule, error) {
+	if mock.GetDistributedFirewallingDefaultActionRuleFunc == nil {
+		panic("DCFClientMock.GetDistributedFirewallingDefaultActionRuleFunc: method is nil but DCFClient.GetDistributedFirewallingDefaultActionRule was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetDistributedFirewallingDefaultActionRule.Lock()
+	mock.calls.GetDistributedFirewallingDefaultActionRule = append(mock.calls.GetDistributedFirewallingDefaultActionRule, callInfo)
+	mock.lockGetDistributedFirewallingDefaultActionRule.Unlock()
+	return mock.GetDistributedFirewallingDefaultActionRuleFunc(ctx)
+}
+
+// GetDistributedFirewallingDefaultActionRuleCalls gets all the calls that were made to GetDistributedFirewallingDefaultActionRule.
+// Check the length with:
+//
+//	len(mockedDCFClient.GetDistributedFirewallingDefaultActionRuleCalls())
+func (mock *DCFClientMock) GetDistributedFirewallingDefaultActionRuleCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetDistributedFirewallingDefaultActionRule.RLock()
+	calls = mock.calls.GetDistributedFirewallingDefaultActionRule
+	mock.lockGetDistributedFirewallingDefaultActionRule.RUnlock()
+	return calls
+}
+
+// GetDistributedFirewallingDeploymentPolicy calls GetDistributedFirewallingDeploymentPolicyFunc.
+func (mock *DCFClientMock) GetDistributedFirewallingDeploymentPolicy(ctx context.Context) (*DistributedFirewallingDeploymentPolicy, error) {
+	if mock.GetDistributedFirewallingDeploymentPolicyFunc == nil {
+		panic("DCFClientMock.GetDistributedFirewallingDeploymentPolicyFunc: method is nil but DCFClient.GetDistributedFirewallingDeploymentPolicy was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetDistributedFirewallingDeploymentPolicy.Lock()
+	mock.calls.GetDistributedFirewallingDeploymentPolicy = append(mock.calls.GetDistributedFirewallingDeploymentPolicy, callInfo)
+	mock.lockGetDistributedFirewallingDeploymentPolicy.Unlock()
+	return mock.GetDistributedFirewallingDeploymentPolicyFunc(ctx)
+}
+
+// GetDistributedFirewallingDeploymentPolicyCalls gets all the calls that were made to GetDistributedFirewallingDeploymentPolicy.
+// Check the length with:
+//
+//	len(mockedDCFClient.GetDistributedFirewallingDeploymentPolicyCalls())
+func (mock *DCFClientMock) GetDistributedFirewallingDeploymentPolicyCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetDistributedFirewallingDeploymentPolicy.RLock()
+	calls = mock.calls.GetDistributedFirewallingDeploymentPolicy
+	mock.lockGetDistributedFirewallingDeploymentPolicy.RUnlock()
+	return calls
+}
+
+// GetDistributedFirewallingIntraVpc calls GetDistributedFirewallingIntraVpcFunc.
+func (mock *DCFClientMock) GetDistributedFirewallingIntraVpc(ctx context.Context) (*DistributedFirewallingIntraVpcList, error) {
+	if mock.GetDistributedFirewallingIntraVpcFunc == nil {
+		panic("DCFClientMock.GetDistributedFirewallingIntraVpcFunc: method is nil but DCFClient.GetDistributedFirewallingIntraVpc was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetDistributedFirewallingIntraVpc.Lock()
+	mock.calls.GetDistributedFirewallingIntraVpc = append(mock.calls.GetDistributedFirewallingIntraVpc, callInfo)
+	mock.lockGetDistributedFirewallingIntraVpc.Unlock()
+	return mock.GetDistributedFirewallingIntraVpcFunc(ctx)
+}
+
+// GetDistributedFirewallingIntraVpcCalls gets all the calls that were made to GetDistributedFirewallingIntraVpc.
+// Check the length with:
+//
+//	len(mockedDCFClient.GetDistributedFirewallingIntraVpcCalls())
+func (mock *DCFClientMock) GetDistributedFirewallingIntraVpcCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetDistributedFirewallingIntraVpc.RLock()
+	calls = mock.calls.GetDistributedFirewallingIntraVpc
+	mock.lockGetDistributedFirewallingIntraVpc.RUnlock()
+	return calls
+}
+
+// GetDistributedFirewallingPolicyList calls GetDistributedFirewallingPolicyListFunc.
+func (mock *DCFClientMock) GetDistributedFirewallingPolicyList(ctx context.Context) (*DistributedFirewallingPolicyList, error) {
+	if mock.GetDistributedFirewallingPolicyListFunc == nil {
+		panic("DCFClientMock.GetDistributedFirewallingPolicyListFunc: method is nil but DCFClient.GetDistributedFirewallingPolicyList was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetDistributedFirewallingPolicyList.Lock()
+	mock.calls.GetDistributedFirewallingPolicyList = append(mock.calls.GetDistributedFirewallingPolicyList, callInfo)
+	mock.lockGetDistributedFirewallingPolicyList.Unlock()
+	return mock.GetDistributedFirewallingPolicyListFunc(ctx)
+}
+
+// GetDistributedFirewallingPolicyListCalls gets all the calls that were made to GetDistributedFirewallingPolicyList.
+// Check the length with:
+//
+//	len(mockedDCFClient.GetDistributedFirewallingPolicyListCalls())
+func (mock *DCFClientMock) GetDistributedFirewallingPolicyListCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetDistributedFirewallingPolicyList.RLock()
+	calls = mock.calls.GetDistributedFirewallingPolicyList
+	mock.lockGetDistributedFirewallingPolicyList.RUnlock()
+	return calls
+}
+
+// GetDistributedFirewallingStatus calls GetDistributedFirewallingStatusFunc.
+func (mock *DCFClientMock) GetDistributedFirewallingStatus(ctx context.Context) (*DistributedFirewallingConfig, error) {
+	if mock.GetDistributedFirewallingStatusFunc == nil {
+		panic("DCFClientMock.GetDistributedFirewallingStatusFunc: method is nil but DCFClient.GetDistributedFirewallingStatus was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetDistributedFirewallingStatus.Lock()
+	mock.calls.GetDistributedFirewallingStatus = append(mock.calls.GetDistributedFirewallingStatus, callInfo)
+	mock.lockGetDistributedFirewallingStatus.Unlock()
+	return mock.GetDistributedFirewallingStatusFunc(ctx)
+}
+
+// GetDistributedFirewallingStatusCalls gets all the calls that were made to GetDistributedFirewallingStatus.
+// Check the length with:
+//
+//	len(mockedDCFClient.GetDistributedFirewallingStatusCalls())
+func (mock *DCFClientMock) GetDistributedFirewallingStatusCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetDistributedFirewallingStatus.RLock()
+	calls = mock.calls.GetDistributedFirewallingStatus
+	mock.lockGetDistributedFirewallingStatus.RUnlock()
+	return calls
+}
+
+// GetEnforcementLevel calls GetEnforcementLevelFunc.
+func (mock *DCFClientMock) GetEnforcementLevel(ctx context.Context) (*EnforcementLevel, error) {
+	if mock.GetEnforcementLevelFunc == nil {
+		panic("DCFClientMock.GetEnforcementLevelFunc: method is nil but DCFClient.GetEnforcementLevel was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetEnforcementLevel.Lock()
+	mock.calls.GetEnforcementLevel = append(mock.calls.GetEnforcementLevel, callInfo)
+	mock.lockGetEnforcementLevel.Unlock()
+	return mock.GetEnforcementLevelFunc(ctx)
+}
+
+// GetEnforcementLevelCalls gets all the calls that were made to GetEnforcementLevel.
+// Check the length with:
+//
+//	len(mockedDCFClient.GetEnforcementLevelCalls())
+func (mock *DCFClientMock) GetEnforcementLevelCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetEnforcementLevel.RLock()
+	calls = mock.calls.GetEnforcementLevel
+	mock.lockGetEnforcementLevel.RUnlock()
+	return calls
+}
+
+// GetIpsProfile calls GetIpsProfileFunc.
+func (mock *DCFClientMock) GetIpsProfile(ctx context.Context, uuid string) (*IpsProfile, error) {
+	if mock.GetIpsProfileFunc == nil {
+		panic("DCFClientMock.GetIpsProfileFunc: method is nil but DCFClient.GetIpsProfile was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		UUID string
+	}{
+		Ctx:  ctx,
+		UUID: uuid,
+	}
+	mock.lockGetIpsProfile.Lock()
+	mock.calls.GetIpsProfile = append(mock.calls.GetIpsProfile, callInfo)
+	mock.lockGetIpsProfile.Unlock()
+	return mock.GetIpsProfileFunc(ctx, uuid)
+}
+
+// GetIpsProfileCalls gets all the calls that were made to GetIpsProfile.
+// Check the length with:
+//
+//	len(mockedDCFClient.GetIpsProfileCalls())
+func (mock *DCFClientMock) GetIpsProfileCalls() []struct {
+	Ctx  context.Context
+	UUID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		UUID string
+	}
+	mock.lockGetIpsProfile.RLock()
+	calls = mock.calls.GetIpsProfile
+	mock.lockGetIpsProfile.RUnlock()
+	return calls
+}
+
+// GetIpsRuleFeed calls GetIpsRuleFeedFunc.
+func (mock *DCFClientMock) GetIpsRuleFeed(ctx context.Context, uuid string) (*IpsRuleFeed, error) {
+	if mock.GetIpsRuleFeedFunc == nil {
+		panic("DCFClientMock.GetIpsRuleFeedFunc: method is nil but DCFClient.GetIpsRuleFeed was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		UUID string
+	}{
+		Ctx:  ctx,
+		UUID: uuid,
+	}
+	mock.lockGetIpsRuleFeed.Lock()
+	mock.calls.GetIpsRuleFeed = append(mock.calls.GetIpsRuleFeed, callInfo)
+	mock.lockGetIpsRuleFeed.Unlock()
+	return mock.GetIpsRuleFeedFunc(ctx, uuid)
+}
+
+// GetIpsRuleFeedCalls gets all the calls that were made to GetIpsRuleFeed.
+// Check the length with:
+//
+//	len(mockedDCFClient.GetIpsRuleFeedCalls())
+func (mock *DCFClientMock) GetIpsRuleFeedCalls() []struct {
+	Ctx  context.Context
+	UUID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		UUID string
+	}
+	mock.lockGetIpsRuleFeed.RLock()
+	calls = mock.calls.GetIpsRuleFeed
+	mock.lockGetIpsRuleFeed.RUnlock()
+	return calls
+}
+
+// GetLogProfileByName calls GetLogProfileByNameFunc.
+func (mock *DCFClientMock) GetLogProfileByName(ctx context.Context, profileName string) (*LogProfile, error) {
+	if mock.GetLogProfileByNameFunc == nil {
+		panic("DCFClientMock.GetLogProfileByNameFunc: method is nil but DCFClient.GetLogProfileByName was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		ProfileName string
+	}{
+		Ctx:         ctx,
+		ProfileName: profileName,
+	}
+	mock.lockGetLogProfileByName.Lock()
+	mock.calls.GetLogProfileByName = append(mock.calls.GetLogProfileByName, callInfo)
+	mock.lockGetLogProfileByName.Unlock()
+	return mock.GetLogProfileByNameFunc(ctx, profileName)
+}
+
+// GetLogProfileByNameCalls gets all the calls that were made to GetLogProfileByName.
+// Check the length with:
+//
+//	len(mockedDCFClient.GetLogProfileByNameCalls())
+func (mock *DCFClientMock) GetLogProfileByNameCalls() []struct {
+	Ctx         context.Context
+	ProfileName string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		ProfileName string
+	}
+	mock.lockGetLogProfileByName.RLock()
+	calls = mock.calls.GetLogProfileByName
+	mock.lockGetLogProfileByName.RUnlock()
+	return calls
+}
+
+// GetMetaCaCertificate calls GetMetaCaCertificateFunc.
+func (mock *DCFClientMock) GetMetaCaCertificate(ctx context.Context) (*ProxyCaCertInstance, error) {
+	if mock.GetMetaCaCertificateFunc == nil {
+		panic("DCFClientMock.GetMetaCaCertificateFunc: method is nil but DCFClient.GetMetaCaCertificate was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetMetaCaCertificate.Lock()
+	mock.calls.GetMetaCaCertificate = append(mock.calls.GetMetaCaCertificate, callInfo)
+	mock.lockGetMetaCaCertificate.Unlock()
+	return mock.GetMetaCaCertificateFunc(ctx)
+}
+
+// GetMetaCaCertificateCalls gets all the calls that were made to GetMetaCaCertificate.
+// Check the length with:
+//
+//	len(mockedDCFClient.GetMetaCaCertificateCalls())
+func (mock *DCFClientMock) GetMetaCaCertificateCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetMetaCaCertificate.RLock()
+	calls = mock.calls.GetMetaCaCertificate
+	mock.lockGetMetaCaCertificate.RUnlock()
+	return calls
+}
+
+// GetSmartGroup calls GetSmartGroupFunc.
+func (mock *DCFClientMock) GetSmartGroup(ctx context.Context, uuid string) (*SmartGroup, error) {
+	if mock.GetSmartGroupFunc == nil {
+		panic("DCFClientMock.GetSmartGroupFunc: method is nil but DCFClient.GetSmartGroup was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		UUID string
+	}{
+		Ctx:  ctx,
+		UUID: uuid,
+	}
+	mock.lockGetSmartGroup.Lock()
+	mock.calls.GetSmartGroup = append(mock.calls.GetSmartGroup, callInfo)
+	mock.lockGetSmartGroup.Unlock()
+	return mock.GetSmartGroupFunc(ctx, uuid)
+}
+
+// GetSmartGroupCalls gets all the calls that were made to GetSmartGroup.
+// Check the length with:
+//
+//	len(mockedDCFClient.GetSmartGroupCalls())
+func (mock *DCFClientMock) GetSmartGroupCalls() []struct {
+	Ctx  context.Context
+	UUID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		UUID string
+	}
+	mock.lockGetSmartGroup.RLock()
+	calls = mock.calls.GetSmartGroup
+	mock.lockGetSmartGroup.RUnlock()
+	return calls
+}
+
+// GetSmartGroups calls GetSmartGroupsFunc.
+func (mock *DCFClientMock) GetSmartGroups(ctx context.Context) ([]*SmartGroup, error) {
+	if mock.GetSmartGroupsFunc == nil {
+		panic("DCFClientMock.GetSmartGroupsFunc: method is nil but DCFClient.GetSmartGroups was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetSmartGroups.Lock()
+	mock.calls.GetSmartGroups = append(mock.calls.GetSmartGroups, callInfo)
+	mock.lockGetSmartGroups.Unlock()
+	return mock.GetSmartGroupsFunc(ctx)
+}
+
+// GetSmartGroupsCalls gets all the calls that were made to GetSmartGroups.
+// Check the length with:
+//
+//	len(mockedDCFClient.GetSmartGroupsCalls())
+func (mock *DCFClientMock) GetSmartGroupsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetSmartGroups.RLock()
+	calls = mock.calls.GetSmartGroups
+	mock.lockGetSmartGroups.RUnlock()
+	return calls
+}
+
+// GetTLSProfile calls GetTLSProfileFunc.
+func (mock *DCFClientMock) GetTLSProfile(ctx context.Context, uuidStr string) (*TLSProfileWithID, error) {
+	if mock.GetTLSProfileFunc == nil {
+		panic("DCFClientMock.GetTLSProfileFunc: method is nil but DCFClient.GetTLSProfile was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		UuidStr string
+	}{
+		Ctx:     ctx,
+		UuidStr: uuidStr,
+	}
+	mock.lockGetTLSProfile.Lock()
+	mock.calls.GetTLSProfile = append(mock.calls.GetTLSProfile, callInfo)
+	mock.lockGetTLSProfile.Unlock()
+	return mock.GetTLSProfileFunc(ctx, uuidStr)
+}
+
+// GetTLSProfileCalls gets all the calls that were made to GetTLSProfile.
+// Check the length with:
+//
+//	len(mockedDCFClient.GetTLSProfileCalls())
+func (mock *DCFClientMock) GetTLSProfileCalls() []struct {
+	Ctx     context.Context
+	UuidStr string
+} {
+	var calls []struct {
+		Ctx     context.Context
+		UuidStr string
+	}
+	mock.lockGetTLSProfile.RLock()
+	calls = mock.calls.GetTLSProfile
+	mock.lockGetTLSProfile.RUnlock()
+	return calls
+}
+
+// GetTLSProfileByName calls GetTLSProfileByNameFunc.
+func (mock *DCFClientMock) GetTLSProfileByName(ctx context.Context, displayName string) (*TLSProfileWithID, error) {
+	if mock.GetTLSProfileByNameFunc == nil {
+		panic("DCFClientMock.GetTLSProfileByNameFunc: method is nil but DCFClient.GetTLSProfileByName was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		DisplayName string
+	}{
+		Ctx:         ctx,
+		DisplayName: displayName,
+	}
+	mock.lockGetTLSProfileByName.Lock()
+	mock.calls.GetTLSProfileByName = append(mock.calls.GetTLSProfileByName, callInfo)
+	mock.lockGetTLSProfileByName.Unlock()
+	return mock.GetTLSProfileByNameFunc(ctx, displayName)
+}
+
+// GetTLSProfileByNameCalls gets all the calls that were made to GetTLSProfileByName.
+// Check the length with:
+//
+//	len(mockedDCFClient.GetTLSProfileByNameCalls())
+func (mock *DCFClientMock) GetTLSProfileByNameCalls() []struct {
+	Ctx         context.Context
+	DisplayName string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		DisplayName string
+	}
+	mock.lockGetTLSProfileByName.RLock()
+	calls = mock.calls.GetTLSProfileByName
+	mock.lockGetTLSProfileByName.RUnlock()
+	return calls
+}
+
+// GetWebGroup calls GetWebGroupFunc.
+func (mock *DCFClientMock) GetWebGroup(ctx context.Context, uuid string) (*WebGroup, error) {
+	if mock.GetWebGroupFunc == nil {
+		panic("DCFClientMock.GetWebGroupFunc: method is nil but DCFClient.GetWebGroup was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		UUID string
+	}{
+		Ctx:  ctx,
+		UUID: uuid,
+	}
+	mock.lockGetWebGroup.Lock()
+	mock.calls.GetWebGroup = append(mock.calls.GetWebGroup, callInfo)
+	mock.lockGetWebGroup.Unlock()
+	return mock.GetWebGroupFunc(ctx, uuid)
+}
+
+// GetWebGroupCalls gets all the calls that were made to GetWebGroup.
+// Check the length with:
+//
+//	len(mockedDCFClient.GetWebGroupCalls())
+func (mock *DCFClientMock) GetWebGroupCalls() []struct {
+	Ctx  context.Context
+	UUID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		UUID string
+	}
+	mock.lockGetWebGroup.RLock()
+	calls = mock.calls.GetWebGroup
+	mock.lockGetWebGroup.RUnlock()
+	return calls
+}
+
+// GetWebGroupByName calls GetWebGroupByNameFunc.
+func (mock *DCFClientMock) GetWebGroupByName(ctx context.Context, name string) (*WebGroup, error) {
+	if mock.GetWebGroupByNameFunc == nil {
+		panic("DCFClientMock.GetWebGroupByNameFunc: method is nil but DCFClient.GetWebGroupByName was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+	}{
+		Ctx:  ctx,
+		Name: name,
+	}
+	mock.lockGetWebGroupByName.Lock()
+	mock.calls.GetWebGroupByName = append(mock.calls.GetWebGroupByName, callInfo)
+	mock.lockGetWebGroupByName.Unlock()
+	return mock.GetWebGroupByNameFunc(ctx, name)
+}
+
+// GetWebGroupByNameCalls gets all the calls that were made to GetWebGroupByName.
+// Check the length with:
+//
+//	len(mockedDCFClient.GetWebGroupByNameCalls())
+func (mock *DCFClientMock) GetWebGroupByNameCalls() []struct {
+	Ctx  context.Context
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+	}
+	mock.lockGetWebGroupByName.RLock()
+	calls = mock.calls.GetWebGroupByName
+	mock.lockGetWebGroupByName.RUnlock()
+	return calls
+}
+
+// ListDCFMitmCa calls ListDCFMitmCaFunc.
+func (mock *DCFClientMock) ListDCFMitmCa(ctx context.Context) (*MitmCaListResponse, error) {
+	if mock.ListDCFMitmCaFunc == nil {
+		panic("DCFClientMock.ListDCFMitmCaFunc: method is nil but DCFClient.ListDCFMitmCa was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListDCFMitmCa.Lock()
+	mock.calls.ListDCFMitmCa = append(mock.calls.ListDCFMitmCa, callInfo)
+	mock.lockListDCFMitmCa.Unlock()
+	return mock.ListDCFMitmCaFunc(ctx)
+}
+
+// ListDCFMitmCaCalls gets all the calls that were made to ListDCFMitmCa.
+// Check the length with:
+//
+//	len(mockedDCFClient.ListDCFMitmCaCalls())
+func (mock *DCFClientMock) ListDCFMitmCaCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListDCFMitmCa.RLock()
+	calls = mock.calls.ListDCFMitmCa
+	mock.lockListDCFMitmCa.RUnlock()
+	return calls
+}
+
+// RefreshDCFMitmSysatemCA calls RefreshDCFMitmSysatemCAFunc.
+func (mock *DCFClientMock) RefreshDCFMitmSysatemCA(ctx context.Context) error {
+	if mock.RefreshDCFMitmSysatemCAFunc == nil {
+		panic("DCFClientMock.RefreshDCFMitmSysatemCAFunc: method is nil but DCFClient.RefreshDCFMitmSysatemCA was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockRefreshDCFMitmSysatemCA.Lock()
+	mock.calls.RefreshDCFMitmSysatemCA = append(mock.calls.RefreshDCFMitmSysatemCA, callInfo)
+	mock.lockRefreshDCFMitmSysatemCA.Unlock()
+	return mock.RefreshDCFMitmSysatemCAFunc(ctx)
+}
+
+// RefreshDCFMitmSysatemCACalls gets all the calls that were made to RefreshDCFMitmSysatemCA.
+// Check the length with:
+//
+//	len(mockedDCFClient.RefreshDCFMitmSysatemCACalls())
+func (mock *DCFClientMock) RefreshDCFMitmSysatemCACalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockRefreshDCFMitmSysatemCA.RLock()
+	calls = mock.calls.RefreshDCFMitmSysatemCA
+	mock.lockRefreshDCFMitmSysatemCA.RUnlock()
+	return calls
+}
+
+// SetDefaultIpsProfile calls SetDefaultIpsProfileFunc.
+func (mock *DCFClientMock) SetDefaultIpsProfile(ctx context.Context, profiles []string) (*DefaultIpsProfileResponse, error) {
+	if mock.SetDefaultIpsProfileFunc == nil {
+		panic("DCFClientMock.SetDefaultIpsProfileFunc: method is nil but DCFClient.SetDefaultIpsProfile was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Profiles []string
+	}{
+		Ctx:      ctx,
+		Profiles: profiles,
+	}
+	mock.lockSetDefaultIpsProfile.Lock()
+	mock.calls.SetDefaultIpsProfile = append(mock.calls.SetDefaultIpsProfile, callInfo)
+	mock.lockSetDefaultIpsProfile.Unlock()
+	return mock.SetDefaultIpsProfileFunc(ctx, profiles)
+}
+
+// SetDefaultIpsProfileCalls gets all the calls that were made to SetDefaultIpsProfile.
+// Check the length with:
+//
+//	len(mockedDCFClient.SetDefaultIpsProfileCalls())
+func (mock *DCFClientMock) SetDefaultIpsProfileCalls() []struct {
+	Ctx      context.Context
+	Profiles []string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Profiles []string
+	}
+	mock.lockSetDefaultIpsProfile.RLock()
+	calls = mock.calls.SetDefaultIpsProfile
+	mock.lockSetDefaultIpsProfile.RUnlock()
+	return calls
+}
+
+// SetEnforcementLevel calls SetEnforcementLevelFunc.
+func (mock *DCFClientMock) SetEnforcementLevel(ctx context.Context, enforcementLevel *EnforcementLevel) error {
+	if mock.SetEnforcementLevelFunc == nil {
+		panic("DCFClientMock.SetEnforcementLevelFunc: method is nil but DCFClient.SetEnforcementLevel was just called")
+	}
+	callInfo := struct {
+		Ctx              context.Context
+		EnforcementLevel *EnforcementLevel
+	}{
+		Ctx:              ctx,
+		EnforcementLevel: enforcementLevel,
+	}
+	mock.lockSetEnforcementLevel.Lock()
+	mock.calls.SetEnforcementLevel = append(mock.calls.SetEnforcementLevel, callInfo)
+	mock.lockSetEnforcementLevel.Unlock()
+	return mock.SetEnforcementLevelFunc(ctx, enforcementLevel)
+}
+
+// SetEnforcementLevelCalls gets all the calls that were made to SetEnforcementLevel.
+// Check the length with:
+//
+//	len(mockedDCFClient.SetEnforcementLevelCalls())
+func (mock *DCFClientMock) SetEnforcementLevelCalls() []struct {
+	Ctx              context.Context
+	EnforcementLevel *EnforcementLevel
+} {
+	var calls []struct {
+		Ctx              context.Context
+		EnforcementLevel *EnforcementLevel
+	}
+	mock.lockSetEnforcementLevel.RLock()
+	calls = mock.calls.SetEnforcementLevel
+	mock.lockSetEnforcementLevel.RUnlock()
+	return calls
+}
+
+// SetNewCertificate calls SetNewCertificateFunc.
+func (mock *DCFClientMock) SetNewCertificate(ctx context.Context, proxyCaConfig *ProxyCaConfig) error {
+	if mock.SetNewCertificateFunc == nil {
+		panic("DCFClientMock.SetNewCertificateFunc: method is nil but DCFClient.SetNewCertificate was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		ProxyCaConfig *ProxyCaConfig
+	}{
+		Ctx:           ctx,
+		ProxyCaConfig: proxyCaConfig,
+	}
+	mock.lockSetNewCertificate.Lock()
+	mock.calls.SetNewCertificate = append(mock.calls.SetNewCertificate, callInfo)
+	mock.lockSetNewCertificate.Unlock()
+	return mock.SetNewCertificateFunc(ctx, proxyCaConfig)
+}
+
+// SetNewCertificateCalls gets all the calls that were made to SetNewCertificate.
+// Check the length with:
+//
+//	len(mockedDCFClient.SetNewCertificateCalls())
+func (mock *DCFClientMock) SetNewCertificateCalls() []struct {
+	Ctx           context.Context
+	ProxyCaConfig *ProxyCaConfig
+} {
+	var calls []struct {
+		Ctx           context.Context
+		ProxyCaConfig *ProxyCaConfig
+	}
+	mock.lockSetNewCertificate.RLock()
+	calls = mock.calls.SetNewCertificate
+	mock.lockSetNewCertificate.RUnlock()
+	return calls
+}
+
+// UpdateDCFMitmCa calls UpdateDCFMitmCaFunc.
+func (mock *DCFClientMock) UpdateDCFMitmCa(ctx context.Context, caID string, patchRequest *MitmCaPatchRequest) (*MitmCaResponse, error) {
+	if mock.UpdateDCFMitmCaFunc == nil {
+		panic("DCFClientMock.UpdateDCFMitmCaFunc: method is nil but DCFClient.UpdateDCFMitmCa was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		CaID         string
+		PatchRequest *MitmCaPatchRequest
+	}{
+		Ctx:          ctx,
+		CaID:         caID,
+		PatchRequest: patchRequest,
+	}
+	mock.lockUpdateDCFMitmCa.Lock()
+	mock.calls.UpdateDCFMitmCa = append(mock.calls.UpdateDCFMitmCa, callInfo)
+	mock.lockUpdateDCFMitmCa.Unlock()
+	return mock.UpdateDCFMitmCaFunc(ctx, caID, patchRequest)
+}
+
+// UpdateDCFMitmCaCalls gets all the calls that were made to UpdateDCFMitmCa.
+// Check the length with:
+//
+//	len(mockedDCFClient.UpdateDCFMitmCaCalls())
+func (mock *DCFClientMock) UpdateDCFMitmCaCalls() []struct {
+	Ctx          context.Context
+	CaID         string
+	PatchRequest *MitmCaPatchRequest
+} {
+	var calls []struct {
+		Ctx          context.Context
+		CaID         string
+		PatchRequest *MitmCaPatchRequest
+	}
+	mock.lockUpdateDCFMitmCa.RLock()
+	calls = mock.calls.UpdateDCFMitmCa
+	mock.lockUpdateDCFMitmCa.RUnlock()
+	return calls
+}
+
+// UpdateDCFPolicyBlock calls UpdateDCFPolicyBlockFunc.
+func (mock *DCFClientMock) UpdateDCFPolicyBlock(ctx context.Context, policyBlock *DCFPolicyBlock) error {
+	if mock.UpdateDCFPolicyBlockFunc == nil {
+		panic("DCFClientMock.UpdateDCFPolicyBlockFunc: method is nil but DCFClient.UpdateDCFPolicyBlock was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		PolicyBlock *DCFPolicyBlock
+	}{
+		Ctx:         ctx,
+		PolicyBlock: policyBlock,
+	}
+	mock.lockUpdateDCFPolicyBlock.Lock()
+	mock.calls.UpdateDCFPolicyBlock = append(mock.calls.UpdateDCFPolicyBlock, callInfo)
+	mock.lockUpdateDCFPolicyBlock.Unlock()
+	return mock.UpdateDCFPolicyBlockFunc(ctx, policyBlock)
+}
+
+// UpdateDCFPolicyBlockCalls gets all the calls that were made to UpdateDCFPolicyBlock.
+// Check the length with:
+//
+//	len(mockedDCFClient.UpdateDCFPolicyBlockCalls())
+func (mock *DCFClientMock) UpdateDCFPolicyBlockCalls() []struct {
+	Ctx         context.Context
+	PolicyBlock *DCFPolicyBlock
+} {
+	var calls []struct {
+		Ctx         context.Context
+		PolicyBlock *DCFPolicyBlock
+	}
+	mock.lockUpdateDCFPolicyBlock.RLock()
+	calls = mock.calls.UpdateDCFPolicyBlock
+	mock.lockUpdateDCFPolicyBlock.RUnlock()
+	return calls
+}
+
+// UpdateDCFPolicyList calls UpdateDCFPolicyListFunc.
+func (mock *DCFClientMock) UpdateDCFPolicyList(ctx context.Context, policyList *DCFPolicyList) error {
+	if mock.UpdateDCFPolicyListFunc == nil {
+		panic("DCFClientMock.UpdateDCFPolicyListFunc: method is nil but DCFClient.UpdateDCFPolicyList was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		PolicyList *DCFPolicyList
+	}{
+		Ctx:        ctx,
+		PolicyList: policyList,
+	}
+	mock.lockUpdateDCFPolicyList.Lock()
+	mock.calls.UpdateDCFPolicyList = append(mock.calls.UpdateDCFPolicyList, callInfo)
+	mock.lockUpdateDCFPolicyList.Unlock()
+	return mock.UpdateDCFPolicyListFunc(ctx, policyList)
+}
+
+// UpdateDCFPolicyListCalls gets all the calls that were made to UpdateDCFPolicyList.
+// Check the length with:
+//
+//	len(mockedDCFClient.UpdateDCFPolicyListCalls())
+func (mock *DCFClientMock) UpdateDCFPolicyListCalls() []struct {
+	Ctx        context.Context
+	PolicyList *DCFPolicyList
+} {
+	var calls []struct {
+		Ctx        context.Context
+		PolicyList *DCFPolicyList
+	}
+	mock.lockUpdateDCFPolicyList.RLock()
+	calls = mock.calls.UpdateDCFPolicyList
+	mock.lockUpdateDCFPolicyList.RUnlock()
+	return calls
+}
+
+// UpdateDCFTrustBundle calls UpdateDCFTrustBundleFunc.
+func (mock *DCFClientMock) UpdateDCFTrustBundle(ctx context.Context, bundleUUID string, trustBundle *TrustBundleItemRequest) error {
+	if mock.UpdateDCFTrustBundleFunc == nil {
+		panic("DCFClientMock.UpdateDCFTrustBundleFunc: method is nil but DCFClient.UpdateDCFTrustBundle was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		BundleUUID  string
+		TrustBundle *TrustBundleItemRequest
+	}{
+		Ctx:         ctx,
+		BundleUUID:  bundleUUID,
+		TrustBundle: trustBundle,
+	}
+	mock.lockUpdateDCFTrustBundle.Lock()
+	mock.calls.UpdateDCFTrustBundle = append(mock.calls.UpdateDCFTrustBundle, callInfo)
+	mock.lockUpdateDCFTrustBundle.Unlock()
+	return mock.UpdateDCFTrustBundleFunc(ctx, bundleUUID, trustBundle)
+}
+
+// UpdateDCFTrustBundleCalls gets all the calls that were made to UpdateDCFTrustBundle.
+// Check the length with:
+//
+//	len(mockedDCFClient.UpdateDCFTrustBundleCalls())
+func (mock *DCFClientMock) UpdateDCFTrustBundleCalls() []struct {
+	Ctx         context.Context
+	BundleUUID  string
+	TrustBundle *TrustBundleItemRequest
+} {
+	var calls []struct {
+		Ctx         context.Context
+		BundleUUID  string
+		TrustBundle *TrustBundleItemRequest
+	}
+	mock.lockUpdateDCFTrustBundle.RLock()
+	calls = mock.calls.UpdateDCFTrustBundle
+	mock.lockUpdateDCFTrustBundle.RUnlock()
+	return calls
+}
+
+// UpdateDistributedFirewallingDefaultActionRule calls UpdateDistributedFirewallingDefaultActionRuleFunc.
+func (mock *DCFClientMock) UpdateDistributedFirewallingDefaultActionRule(ctx context.Context, request *DistributedFirewallingDefaultActionRule) error {
+	if mock.UpdateDistributedFirewallingDefaultActionRuleFunc == nil {
+		panic("DCFClientMock.UpdateDistributedFirewallingDefaultActionRuleFunc: method is nil but DCFClient.UpdateDistributedFirewallingDefaultActionRule was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Request *DistributedFirewallingDefaultActionRule
+	}{
+		Ctx:     ctx,
+		Request: request,
+	}
+	mock.lockUpdateDistributedFirewallingDefaultActionRule.Lock()
+	mock.calls.UpdateDistributedFirewallingDefaultActionRule = append(mock.calls.UpdateDistributedFirewallingDefaultActionRule, callInfo)
+	mock.lockUpdateDistributedFirewallingDefaultActionRule.Unlock()
+	return mock.UpdateDistributedFirewallingDefaultActionRuleFunc(ctx, request)
+}
+
+// UpdateDistributedFirewallingDefaultActionRuleCalls gets all the calls that were made to UpdateDistributedFirewallingDefaultActionRule.
+// Check the length with:
+//
+//	len(mockedDCFClient.UpdateDistributedFirewallingDefaultActionRuleCalls())
+func (mock *DCFClientMock) UpdateDistributedFirewallingDefaultActionRuleCalls() []struct {
+	Ctx     context.Context
+	Request *DistributedFirewallingDefaultActionRule
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Request *DistributedFirewallingDefaultActionRule
+	}
+	mock.lockUpdateDistributedFirewallingDefaultActionRule.RLock()
+	calls = mock.calls.UpdateDistributedFirewallingDefaultActionRule
+	mock.lockUpdateDistributedFirewallingDefaultActionRule.RUnlock()
+	return calls
+}
+
+// UpdateDistributedFirewallingPolicyList calls UpdateDistributedFirewallingPolicyListFunc.
+func (mock *DCFClientMock) UpdateDistributedFirewallingPolicyList(ctx context.Context, policyList *DistributedFirewallingPolicyList) error {
+	if mock.UpdateDistributedFirewallingPolicyListFunc == nil {
+		panic("DCFClientMock.UpdateDistributedFirewallingPolicyListFunc: method is nil but DCFClient.UpdateDistributedFirewallingPolicyList was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		PolicyList *DistributedFirewallingPolicyList
+	}{
+		Ctx:        ctx,
+		PolicyList: policyList,
+	}
+	mock.lockUpdateDistributedFirewallingPolicyList.Lock()
+	mock.calls.UpdateDistributedFirewallingPolicyList = append(mock.calls.UpdateDistributedFirewallingPolicyList, callInfo)
+	mock.lockUpdateDistributedFirewallingPolicyList.Unlock()
+	return mock.UpdateDistributedFirewallingPolicyListFunc(ctx, policyList)
+}
+
+// UpdateDistributedFirewallingPolicyListCalls gets all the calls that were made to UpdateDistributedFirewallingPolicyList.
+// Check the length with:
+//
+//	len(mockedDCFClient.UpdateDistributedFirewallingPolicyListCalls())
+func (mock *DCFClientMock) UpdateDistributedFirewallingPolicyListCalls() []struct {
+	Ctx        context.Context
+	PolicyList *DistributedFirewallingPolicyList
+} {
+	var calls []struct {
+		Ctx        context.Context
+		PolicyList *DistributedFirewallingPolicyList
+	}
+	mock.lockUpdateDistributedFirewallingPolicyList.RLock()
+	calls = mock.calls.UpdateDistributedFirewallingPolicyList
+	mock.lockUpdateDistributedFirewallingPolicyList.RUnlock()
+	return calls
+}
+
+// UpdateEnforcementLevel calls UpdateEnforcementLevelFunc.
+func (mock *DCFClientMock) UpdateEnforcementLevel(ctx context.Context, enforcementLevel *EnforcementLevel) error {
+	if mock.UpdateEnforcementLevelFunc == nil {
+		panic("DCFClientMock.UpdateEnforcementLevelFunc: method is nil but DCFClient.UpdateEnforcementLevel was just called")
+	}
+	callInfo := struct {
+		Ctx              context.Context
+		EnforcementLevel *EnforcementLevel
+	}{
+		Ctx:              ctx,
+		EnforcementLevel: enforcementLevel,
+	}
+	mock.lockUpdateEnforcementLevel.Lock()
+	mock.calls.UpdateEnforcementLevel = append(mock.calls.UpdateEnforcementLevel, callInfo)
+	mock.lockUpdateEnforcementLevel.Unlock()
+	return mock.UpdateEnforcementLevelFunc(ctx, enforcementLevel)
+}
+
+// UpdateEnforcementLevelCalls gets all the calls that were made to UpdateEnforcementLevel.
+// Check the length with:
+//
+//	len(mockedDCFClient.UpdateEnforcementLevelCalls())
+func (mock *DCFClientMock) UpdateEnforcementLevelCalls() []struct {
+	Ctx              context.Context
+	EnforcementLevel *EnforcementLevel
+} {
+	var calls []struct {
+		Ctx              context.Context
+		EnforcementLevel *EnforcementLevel
+	}
+	mock.lockUpdateEnforcementLevel.RLock()
+	calls = mock.calls.UpdateEnforcementLevel
+	mock.lockUpdateEnforcementLevel.RUnlock()
+	return calls
+}
+
+// UpdateIpsProfile calls UpdateIpsProfileFunc.
+func (mock *DCFClientMock) UpdateIpsProfile(ctx context.Context, uuid string, profile *IpsProfile) (*IpsProfile, error) {
+	if mock.UpdateIpsProfileFunc == nil {
+		panic("DCFClientMock.UpdateIpsProfileFunc: method is nil but DCFClient.UpdateIpsProfile was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		UUID    string
+		Profile *IpsProfile
+	}{
+		Ctx:     ctx,
+		UUID:    uuid,
+		Profile: profile,
+	}
+	mock.lockUpdateIpsProfile.Lock()
+	mock.calls.UpdateIpsProfile = append(mock.calls.UpdateIpsProfile, callInfo)
+	mock.lockUpdateIpsProfile.Unlock()
+	return mock.UpdateIpsProfileFunc(ctx, uuid, profile)
+}
+
+// UpdateIpsProfileCalls gets all the calls that were made to UpdateIpsProfile.
+// Check the length with:
+//
+//	len(mockedDCFClient.UpdateIpsProfileCalls())
+func (mock *DCFClientMock) UpdateIpsProfileCalls() []struct {
+	Ctx     context.Context
+	UUID    string
+	Profile *IpsProfile
+} {
+	var calls []struct {
+		Ctx     context.Context
+		UUID    string
+		Profile *IpsProfile
+	}
+	mock.lockUpdateIpsProfile.RLock()
+	calls = mock.calls.UpdateIpsProfile
+	mock.lockUpdateIpsProfile.RUnlock()
+	return calls
+}
+
+// UpdateIpsRuleFeed calls UpdateIpsRuleFeedFunc.
+func (mock *DCFClientMock) UpdateIpsRuleFeed(ctx context.Context, uuid string, ruleFeed *IpsRuleFeed) (*IpsRuleFeed, error) {
+	if mock.UpdateIpsRuleFeedFunc == nil {
+		panic("DCFClientMock.UpdateIpsRuleFeedFunc: method is nil but DCFClient.UpdateIpsRuleFeed was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		UUID     string
+		RuleFeed *IpsRuleFeed
+	}{
+		Ctx:      ctx,
+		UUID:     uuid,
+		RuleFeed: ruleFeed,
+	}
+	mock.lockUpdateIpsRuleFeed.Lock()
+	mock.calls.UpdateIpsRuleFeed = append(mock.calls.UpdateIpsRuleFeed, callInfo)
+	mock.lockUpdateIpsRuleFeed.Unlock()
+	return mock.UpdateIpsRuleFeedFunc(ctx, uuid, ruleFeed)
+}
+
+// UpdateIpsRuleFeedCalls gets all the calls that were made to UpdateIpsRuleFeed.
+// Check the length with:
+//
+//	len(mockedDCFClient.UpdateIpsRuleFeedCalls())
+func (mock *DCFClientMock) UpdateIpsRuleFeedCalls() []struct {
+	Ctx      context.Context
+	UUID     string
+	RuleFeed *IpsRuleFeed
+} {
+	var calls []struct {
+		Ctx      context.Context
+		UUID     string
+		RuleFeed *IpsRuleFeed
+	}
+	mock.lockUpdateIpsRuleFeed.RLock()
+	calls = mock.calls.UpdateIpsRuleFeed
+	mock.lockUpdateIpsRuleFeed.RUnlock()
+	return calls
+}
+
+// UpdateSmartGroup calls UpdateSmartGroupFunc.
+func (mock *DCFClientMock) UpdateSmartGroup(ctx context.Context, smartGroup *SmartGroup, uuid string) error {
+	if mock.UpdateSmartGroupFunc == nil {
+		panic("DCFClientMock.UpdateSmartGroupFunc: method is nil but DCFClient.UpdateSmartGroup was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		SmartGroup *SmartGroup
+		UUID       string
+	}{
+		Ctx:        ctx,
+		SmartGroup: smartGroup,
+		UUID:       uuid,
+	}
+	mock.lockUpdateSmartGroup.Lock()
+	mock.calls.UpdateSmartGroup = append(mock.calls.UpdateSmartGroup, callInfo)
+	mock.lockUpdateSmartGroup.Unlock()
+	return mock.UpdateSmartGroupFunc(ctx, smartGroup, uuid)
+}
+
+// UpdateSmartGroupCalls gets all the calls that were made to UpdateSmartGroup.
+// Check the length with:
+//
+//	len(mockedDCFClient.UpdateSmartGroupCalls())
+func (mock *DCFClientMock) UpdateSmartGroupCalls() []struct {
+	Ctx        context.Context
+	SmartGroup *SmartGroup
+	UUID       string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		SmartGroup *SmartGroup
+		UUID       string
+	}
+	mock.lockUpdateSmartGroup.RLock()
+	calls = mock.calls.UpdateSmartGroup
+	mock.lockUpdateSmartGroup.RUnlock()
+	return calls
+}
+
+// UpdateTLSProfile calls UpdateTLSProfileFunc.
+func (mock *DCFClientMock) UpdateTLSProfile(ctx context.Context, uuid string, tlsProfile *TLSProfile) error {
+	if mock.UpdateTLSProfileFunc == nil {
+		panic("DCFClientMock.UpdateTLSProfileFunc: method is nil but DCFClient.UpdateTLSProfile was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		UUID       string
+		TlsProfile *TLSProfile
+	}{
+		Ctx:        ctx,
+		UUID:       uuid,
+		TlsProfile: tlsProfile,
+	}
+	mock.lockUpdateTLSProfile.Lock()
+	mock.calls.UpdateTLSProfile = append(mock.calls.UpdateTLSProfile, callInfo)
+	mock.lockUpdateTLSProfile.Unlock()
+	return mock.UpdateTLSProfileFunc(ctx, uuid, tlsProfile)
+}
+
+// UpdateTLSProfileCalls gets all the calls that were made to UpdateTLSProfile.
+// Check the length with:
+//
+//	len(mockedDCFClient.UpdateTLSProfileCalls())
+func (mock *DCFClientMock) UpdateTLSProfileCalls() []struct {
+	Ctx        context.Context
+	UUID       string
+	TlsProfile *TLSProfile
+} {
+	var calls []struct {
+		Ctx        context.Context
+		UUID       string
+		TlsProfile *TLSProfile
+	}
+	mock.lockUpdateTLSProfile.RLock()
+	calls = mock.calls.UpdateTLSProfile
+	mock.lockUpdateTLSProfile.RUnlock()
+	return calls
+}
+
+// UpdateWebGroup calls UpdateWebGroupFunc.
+func (mock *DCFClientMock) UpdateWebGroup(ctx context.Context, webGroup *WebGroup, uuid string) error {
+	if mock.UpdateWebGroupFunc == nil {
+		panic("DCFClientMock.UpdateWebGroupFunc: method is nil but DCFClient.UpdateWebGroup was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		WebGroup *WebGroup
+		UUID     string
+	}{
+		Ctx:      ctx,
+		WebGroup: webGroup,
+		UUID:     uuid,
+	}
+	mock.lockUpdateWebGroup.Lock()
+	mock.calls.UpdateWebGroup = append(mock.calls.UpdateWebGroup, callInfo)
+	mock.lockUpdateWebGroup.Unlock()
+	return mock.UpdateWebGroupFunc(ctx, webGroup, uuid)
+}
+
+// UpdateWebGroupCalls gets all the calls that were made to UpdateWebGroup.
+// Check the length with:
+//
+//	len(mockedDCFClient.UpdateWebGroupCalls())
+func (mock *DCFClientMock) UpdateWebGroupCalls() []struct {
+	Ctx      context.Context
+	WebGroup *WebGroup
+	UUID     string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		WebGroup *WebGroup
+		UUID     string
+	}
+	mock.lockUpdateWebGroup.RLock()
+	calls = mock.calls.UpdateWebGroup
+	mock.lockUpdateWebGroup.RUnlock()
+	return calls
+}
@@ -2,9 +2,9 @@ package goaviatrix
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
-	"strings"
 	"time"
 )
 
@@ -84,7 +84,7 @@ func (c *Client) GetDCFMitmCa(ctx context.Context, caID string) (*MitmCaResponse
 	var mitmCa MitmCaResponse
 	err = c.GetAPIContext25(ctx, &mitmCa, endpoint, nil)
 	if err != nil {
-		if strings.Contains(err.Error(), "does not exist") || strings.Contains(err.Error(), "not found") {
+		if errors.Is(err, ErrNotFound) {
 			return nil, ErrNotFound
 		}
 		return nil, err
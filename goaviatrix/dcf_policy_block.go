@@ -3,6 +3,7 @@ package goaviatrix
 import (
 	"context"
 	"fmt"
+	"log/slog"
 )
 
 type DCFPolicyBlock struct {
@@ -33,7 +34,7 @@ func (c *Client) CreateDCFPolicyBlock(ctx context.Context, policyBlock *DCFPolic
 
 	for _, sp := range policyBlock.SubPolicies {
 		if sp.AttachmentPoint != nil && sp.AttachmentPoint.Name != "" {
-			fmt.Printf("Processing subpolicy: %s\n", sp.AttachmentPoint.Name)
+			c.log(ctx, slog.LevelDebug, fmt.Sprintf("Processing subpolicy: %s", sp.AttachmentPoint.Name))
 			attachmentPoint, err := c.GetDCFAttachmentPoint(ctx, sp.AttachmentPoint.Name)
 			if err != nil {
 				return "", err
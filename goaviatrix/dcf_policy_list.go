@@ -77,6 +77,22 @@ func (c *Client) GetDCFPolicyList(ctx context.Context, uuid string) (*DCFPolicyL
 	return &policyList, nil
 }
 
+// ListDCFPolicyLists returns all DCF policy lists, including system policy
+// lists and the policy lists of policy blocks.
+func (c *Client) ListDCFPolicyLists(ctx context.Context) ([]DCFPolicyList, error) {
+	endpoint := "microseg/policy-list3"
+
+	var data struct {
+		PolicyLists []DCFPolicyList `json:"policy_lists"`
+	}
+	err := c.GetAPIContext25(ctx, &data, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return data.PolicyLists, nil
+}
+
 func (c *Client) UpdateDCFPolicyList(ctx context.Context, policyList *DCFPolicyList) error {
 	endpoint := fmt.Sprintf("microseg/policy-list3/%s", policyList.UUID)
 	return c.PutAPIContext25(ctx, endpoint, policyList)
@@ -5,9 +5,9 @@ import (
 	"context"
 	"crypto/x509"
 	"encoding/pem"
+	"errors"
 	"fmt"
 	"net/url"
-	"strings"
 	"time"
 )
 
@@ -54,7 +54,7 @@ func (c *Client) GetDCFTrustBundleByID(ctx context.Context, bundleUUID string) (
 	var trustBundle DCFTrustBundle
 	err = c.GetAPIContext25(ctx, &trustBundle, endpoint, nil)
 	if err != nil {
-		if strings.Contains(err.Error(), "does not exist") || strings.Contains(err.Error(), "not found") {
+		if errors.Is(err, ErrNotFound) {
 			return nil, ErrNotFound
 		}
 		return nil, err
@@ -73,7 +73,7 @@ func (c *Client) GetDCFTrustBundleByName(ctx context.Context, bundleName string)
 	var trustBundle DCFTrustBundle
 	err = c.GetAPIContext25(ctx, &trustBundle, endpoint, nil)
 	if err != nil {
-		if strings.Contains(err.Error(), "does not exist") || strings.Contains(err.Error(), "not found") {
+		if errors.Is(err, ErrNotFound) {
 			return nil, ErrNotFound
 		}
 		return nil, err
@@ -104,26 +104,6 @@ func (c *Client) DeleteDCFTrustBundle(ctx context.Context, bundleUUID string) er
 	return c.DeleteAPIContext25(ctx, endpoint, nil)
 }
 
-func ValidateCertificates(i interface{}, k string) ([]string, []error) {
-	v, ok := i.(string)
-	if !ok {
-		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
-	}
-	certs, err := ParseCertificates([]byte(v))
-	if err != nil {
-		return nil, []error{err}
-	}
-	if len(certs) == 0 {
-		return nil, []error{fmt.Errorf("no certificates found in bundle")}
-	}
-	for _, cert := range certs {
-		if !cert.IsCA {
-			return nil, []error{fmt.Errorf("certificate %q is not a CA", cert.Subject.CommonName)}
-		}
-	}
-	return nil, nil
-}
-
 func ParseCertificates(remain []byte) ([]*x509.Certificate, error) {
 	// Remove UTF-8 BOM if present using standard library
 	utf8BOM := []byte{0xEF, 0xBB, 0xBF}
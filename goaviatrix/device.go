@@ -1,10 +1,10 @@
 package goaviatrix
 
 import (
+	"context"
 	"fmt"
+	"log/slog"
 	"strings"
-
-	log "github.com/sirupsen/logrus"
 )
 
 // Device represents a device used in CloudWAN
@@ -63,7 +63,7 @@ func (c *Client) GetDevice(d *Device) (*Device, error) {
 		}
 	}
 	if foundDevice == nil {
-		log.Errorf("Could not find Aviatrix device %s", d.Name)
+		c.log(context.Background(), slog.LevelError, fmt.Sprintf("Could not find Aviatrix device %s", d.Name))
 		return nil, ErrNotFound
 	}
 
@@ -0,0 +1,29 @@
+// Package goaviatrix is a Go client for the Aviatrix Controller API.
+//
+// A Client logs in to the Controller with NewClient, then calls its actions,
+// e.g. Client.CreateGateway, retrying failed calls, renewing expired sessions
+// and waiting for asynchronous tasks on the way. The package does not depend
+// on Terraform: the schema helpers of the Terraform provider, such as diff
+// suppression and validation functions, live in the provider.
+//
+// Logs are written with log/slog to the logger set with WithLogger, which
+// gets the context of each call, and are discarded without one. Spans are
+// recorded with OpenTelemetry; see WithTracerProvider.
+//
+// The package is a Go module of its own,
+// aviatrix.com/terraform-provider-aviatrix/goaviatrix, versioned with
+// goaviatrix/vX.Y.Z tags independently of the provider. Its API follows
+// semantic versioning: breaking changes only come with a new major version.
+//
+// The controllertest package provides a fake Controller to test code that
+// uses the client without a real one.
+package goaviatrix
+
+// SDKVersion is the version of the goaviatrix module. It is bumped with each
+// goaviatrix/vX.Y.Z tag and identifies the client in the User-Agent header,
+// e.g. in the Controller's access logs.
+const SDKVersion = "1.0.0"
+
+// UserAgent is the User-Agent header sent with requests, unless a transport
+// of the HTTP client replaces it.
+const UserAgent = "goaviatrix/" + SDKVersion
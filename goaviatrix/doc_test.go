@@ -0,0 +1,22 @@
+package goaviatrix
+
+import (
+	"go/build"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDoesNotImportTerraform keeps goaviatrix and its fake Controller usable
+// as a Controller SDK outside of Terraform.
+func TestDoesNotImportTerraform(t *testing.T) {
+	for _, dir := range []string{".", "controllertest"} {
+		pkg, err := build.ImportDir(dir, 0)
+		require.NoError(t, err)
+		for _, path := range pkg.Imports {
+			assert.False(t, strings.HasPrefix(path, "github.com/hashicorp/terraform-"), "%s imports %s", pkg.ImportPath, path)
+		}
+	}
+}
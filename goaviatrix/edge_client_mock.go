@@ -0,0 +1,13669 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package goaviatrix
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that EdgeClientMock does implement EdgeClient.
+// If this is not the case, regenerate this file with moq.
+var _ EdgeClient = &EdgeClientMock{}
+
+// EdgeClientMock is a mock implementation of EdgeClient.
+//
+//	func TestSomethingThatUsesEdgeClient(t *testing.T) {
+//
+//		// make and configure a mocked EdgeClient
+//		mockedEdgeClient := &EdgeClientMock{
+//			AddSpokeGatewaySubnetGroupFunc: func(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error {
+//				panic("mock out the AddSpokeGatewaySubnetGroup method")
+//			},
+//			AttachTransitGWForHybridFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the AttachTransitGWForHybrid method")
+//			},
+//			ChangeBgpHoldTimeFunc: func(gwName string, holdTime int) error {
+//				panic("mock out the ChangeBgpHoldTime method")
+//			},
+//			ChangeBgpHoldTimeGatewayGroupFunc: func(ctx context.Context, groupName string, holdTime int) error {
+//				panic("mock out the ChangeBgpHoldTimeGatewayGroup method")
+//			},
+//			ChangeBgpOverLanIntfCntFunc: func(gateway *Gateway) error {
+//				panic("mock out the ChangeBgpOverLanIntfCnt method")
+//			},
+//			CreateEdgeCSPFunc: func(ctx context.Context, edgeCSP *EdgeCSP) error {
+//				panic("mock out the CreateEdgeCSP method")
+//			},
+//			CreateEdgeCSPHaFunc: func(ctx context.Context, edgeCSPHa *EdgeCSPHa) (string, error) {
+//				panic("mock out the CreateEdgeCSPHa method")
+//			},
+//			CreateEdgeEquinixFunc: func(ctx context.Context, edgeEquinix *EdgeEquinix) error {
+//				panic("mock out the CreateEdgeEquinix method")
+//			},
+//			CreateEdgeEquinixHaFunc: func(ctx context.Context, edgeEquinixHa *EdgeEquinixHa) (string, error) {
+//				panic("mock out the CreateEdgeEquinixHa method")
+//			},
+//			CreateEdgeExternalDeviceConnFunc: func(edgeExternalDeviceConn *EdgeExternalDeviceConn) (string, error) {
+//				panic("mock out the CreateEdgeExternalDeviceConn method")
+//			},
+//			CreateEdgeMegaportFunc: func(ctx context.Context, edgeMegaport *EdgeMegaport) error {
+//				panic("mock out the CreateEdgeMegaport method")
+//			},
+//			CreateEdgeMegaportHaFunc: func(ctx context.Context, edgeMegaportHa *EdgeMegaportHa) (string, error) {
+//				panic("mock out the CreateEdgeMegaportHa method")
+//			},
+//			CreateEdgeNEOFunc: func(ctx context.Context, edgeNEO *EdgeNEO) error {
+//				panic("mock out the CreateEdgeNEO method")
+//			},
+//			CreateEdgeNEOHaFunc: func(ctx context.Context, edgeNEOHa *EdgeNEOHa) (string, error) {
+//				panic("mock out the CreateEdgeNEOHa method")
+//			},
+//			CreateEdgeProxyProfileFunc: func(ctx context.Context, edgeNEOProxyProfile *EdgePlatformProxyProfile) (*EdgePlatformProxyProfileResp, error) {
+//				panic("mock out the CreateEdgeProxyProfile method")
+//			},
+//			CreateEdgeSpokeFunc: func(ctx context.Context, edgeSpoke *EdgeSpoke) error {
+//				panic("mock out the CreateEdgeSpoke method")
+//			},
+//			CreateEdgeVmSelfmanagedHaFunc: func(ctx context.Context, edgeVmSelfmanagedHa *EdgeVmSelfmanagedHa) (string, error) {
+//				panic("mock out the CreateEdgeVmSelfmanagedHa method")
+//			},
+//			CreateExternalDeviceConnFunc: func(externalDeviceConn *ExternalDeviceConn) error {
+//				panic("mock out the CreateExternalDeviceConn method")
+//			},
+//			CreateGatewayContextFunc: func(ctx context.Context, gateway *Gateway) error {
+//				panic("mock out the CreateGatewayContext method")
+//			},
+//			CreateGatewayGroupFunc: func(ctx context.Context, spokeGroup *GatewayGroup) error {
+//				panic("mock out the CreateGatewayGroup method")
+//			},
+//			CreatePublicSubnetFilteringGatewayContextFunc: func(ctx context.Context, gateway *Gateway) error {
+//				panic("mock out the CreatePublicSubnetFilteringGatewayContext method")
+//			},
+//			CreateSpokeHaGwFunc: func(spokeHaGateway *SpokeHaGateway) (string, error) {
+//				panic("mock out the CreateSpokeHaGw method")
+//			},
+//			CreateSpokeHaGwContextFunc: func(ctx context.Context, spokeHaGateway *SpokeHaGateway) (string, error) {
+//				panic("mock out the CreateSpokeHaGwContext method")
+//			},
+//			CreateSpokeTransitAttachmentFunc: func(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) error {
+//				panic("mock out the CreateSpokeTransitAttachment method")
+//			},
+//			CreateTransitHaGwContextFunc: func(ctx context.Context, transitHaGateway *TransitHaGateway) (string, error) {
+//				panic("mock out the CreateTransitHaGwContext method")
+//			},
+//			DeleteEdgeCSPFunc: func(ctx context.Context, accountName string, name string) error {
+//				panic("mock out the DeleteEdgeCSP method")
+//			},
+//			DeleteEdgeEquinixFunc: func(ctx context.Context, accountName string, name string) error {
+//				panic("mock out the DeleteEdgeEquinix method")
+//			},
+//			DeleteEdgeExternalDeviceConnFunc: func(edgeExternalDeviceConn *EdgeExternalDeviceConn) error {
+//				panic("mock out the DeleteEdgeExternalDeviceConn method")
+//			},
+//			DeleteEdgeMegaportFunc: func(ctx context.Context, accountName string, name string) error {
+//				panic("mock out the DeleteEdgeMegaport method")
+//			},
+//			DeleteEdgeNEOFunc: func(ctx context.Context, accountName string, name string) error {
+//				panic("mock out the DeleteEdgeNEO method")
+//			},
+//			DeleteEdgeNEODeviceFunc: func(ctx context.Context, accountName string, serialNumber string) error {
+//				panic("mock out the DeleteEdgeNEODevice method")
+//			},
+//			DeleteEdgePlatformProxyProfileFunc: func(ctx context.Context, accountName string, profileName string) error {
+//				panic("mock out the DeleteEdgePlatformProxyProfile method")
+//			},
+//			DeleteEdgeSpokeFunc: func(ctx context.Context, name string) error {
+//				panic("mock out the DeleteEdgeSpoke method")
+//			},
+//			DeleteExternalDeviceConnFunc: func(externalDeviceConn *ExternalDeviceConn) error {
+//				panic("mock out the DeleteExternalDeviceConn method")
+//			},
+//			DeleteGatewayFunc: func(gateway *Gateway) error {
+//				panic("mock out the DeleteGateway method")
+//			},
+//			DeleteGatewayContextFunc: func(ctx context.Context, gateway *Gateway) error {
+//				panic("mock out the DeleteGatewayContext method")
+//			},
+//			DeleteGatewayGroupFunc: func(ctx context.Context, groupUUID string) error {
+//				panic("mock out the DeleteGatewayGroup method")
+//			},
+//			DeletePublicSubnetFilteringGatewayContextFunc: func(ctx context.Context, gateway *Gateway) error {
+//				panic("mock out the DeletePublicSubnetFilteringGatewayContext method")
+//			},
+//			DeleteSpokeGatewaySubnetGroupFunc: func(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error {
+//				panic("mock out the DeleteSpokeGatewaySubnetGroup method")
+//			},
+//			DeleteSpokeTransitAttachmentFunc: func(spokeTransitAttachment *SpokeTransitAttachment) error {
+//				panic("mock out the DeleteSpokeTransitAttachment method")
+//			},
+//			DetachTransitGWForHybridFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the DetachTransitGWForHybrid method")
+//			},
+//			DisableActiveStandbyFunc: func(transitGateway *TransitVpc) error {
+//				panic("mock out the DisableActiveStandby method")
+//			},
+//			DisableActiveStandbyGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableActiveStandbyGatewayGroup method")
+//			},
+//			DisableActiveStandbySpokeFunc: func(spokeGateway *SpokeVpc) error {
+//				panic("mock out the DisableActiveStandbySpoke method")
+//			},
+//			DisableAdvertiseTransitCidrFunc: func(transitGw *TransitVpc) error {
+//				panic("mock out the DisableAdvertiseTransitCidr method")
+//			},
+//			DisableAdvertiseTransitCidrGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableAdvertiseTransitCidrGatewayGroup method")
+//			},
+//			DisableAutoAdvertiseS2CCidrsFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableAutoAdvertiseS2CCidrs method")
+//			},
+//			DisableAutoAdvertiseS2CCidrsGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableAutoAdvertiseS2CCidrsGatewayGroup method")
+//			},
+//			DisableConnectedTransitFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the DisableConnectedTransit method")
+//			},
+//			DisableConnectedTransitGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableConnectedTransitGatewayGroup method")
+//			},
+//			DisableCustomSNatFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableCustomSNat method")
+//			},
+//			DisableEdgeSpokeTransitiveRoutingFunc: func(ctx context.Context, name string) error {
+//				panic("mock out the DisableEdgeSpokeTransitiveRouting method")
+//			},
+//			DisableEgressTransitFirenetFunc: func(transitGateway *TransitVpc) error {
+//				panic("mock out the DisableEgressTransitFirenet method")
+//			},
+//			DisableFireNetGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableFireNetGatewayGroup method")
+//			},
+//			DisableGatewayFireNetInterfacesFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the DisableGatewayFireNetInterfaces method")
+//			},
+//			DisableGatewayGroupSNatFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableGatewayGroupSNat method")
+//			},
+//			DisableGatewayGroupVpcDNSServerFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableGatewayGroupVpcDNSServer method")
+//			},
+//			DisableGatewayLoadBalancerGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableGatewayLoadBalancerGatewayGroup method")
+//			},
+//			DisableGlobalVpcFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableGlobalVpc method")
+//			},
+//			DisableGlobalVpcGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableGlobalVpcGatewayGroup method")
+//			},
+//			DisableGroGsoFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableGroGso method")
+//			},
+//			DisableGroGsoGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableGroGsoGatewayGroup method")
+//			},
+//			DisableGuardDutyEnforcementFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableGuardDutyEnforcement method")
+//			},
+//			DisableHybridConnectionGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableHybridConnectionGatewayGroup method")
+//			},
+//			DisableIPv6Func: func(gateway *Gateway) error {
+//				panic("mock out the DisableIPv6 method")
+//			},
+//			DisableIPv6GatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableIPv6GatewayGroup method")
+//			},
+//			DisableJumboFrameFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableJumboFrame method")
+//			},
+//			DisableJumboFrameExternalDeviceConnFunc: func(externalDeviceConn *ExternalDeviceConn) error {
+//				panic("mock out the DisableJumboFrameExternalDeviceConn method")
+//			},
+//			DisableJumboFrameGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableJumboFrameGatewayGroup method")
+//			},
+//			DisableMonitorGatewaySubnetsFunc: func(gwName string) error {
+//				panic("mock out the DisableMonitorGatewaySubnets method")
+//			},
+//			DisableMultiTierTransitGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableMultiTierTransitGatewayGroup method")
+//			},
+//			DisableMultitierTransitFunc: func(gwName string) error {
+//				panic("mock out the DisableMultitierTransit method")
+//			},
+//			DisablePrivateVpcDefaultRouteFunc: func(gw *Gateway) error {
+//				panic("mock out the DisablePrivateVpcDefaultRoute method")
+//			},
+//			DisablePrivateVpcDefaultRouteGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisablePrivateVpcDefaultRouteGatewayGroup method")
+//			},
+//			DisableS2CRxBalancingFunc: func(gwName string) error {
+//				panic("mock out the DisableS2CRxBalancing method")
+//			},
+//			DisableS2cRxBalancingGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableS2cRxBalancingGatewayGroup method")
+//			},
+//			DisableSNatFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableSNat method")
+//			},
+//			DisableSegmentationFunc: func(transitGateway *TransitVpc) error {
+//				panic("mock out the DisableSegmentation method")
+//			},
+//			DisableSegmentationGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableSegmentationGatewayGroup method")
+//			},
+//			DisableSingleAZGatewayFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableSingleAZGateway method")
+//			},
+//			DisableSkipPublicRouteUpdateFunc: func(gw *Gateway) error {
+//				panic("mock out the DisableSkipPublicRouteUpdate method")
+//			},
+//			DisableSkipPublicRouteUpdateGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableSkipPublicRouteUpdateGatewayGroup method")
+//			},
+//			DisableSpokeLearnedCidrsApprovalFunc: func(gateway *SpokeVpc) error {
+//				panic("mock out the DisableSpokeLearnedCidrsApproval method")
+//			},
+//			DisableSpokeLearnedCidrsApprovalGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableSpokeLearnedCidrsApprovalGatewayGroup method")
+//			},
+//			DisableSpokeOnpremRoutePropagationFunc: func(spokeGateway *SpokeVpc) error {
+//				panic("mock out the DisableSpokeOnpremRoutePropagation method")
+//			},
+//			DisableSpokeOnpremRoutePropagationGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableSpokeOnpremRoutePropagationGatewayGroup method")
+//			},
+//			DisableSpokePreserveAsPathFunc: func(spokeGateway *SpokeVpc) error {
+//				panic("mock out the DisableSpokePreserveAsPath method")
+//			},
+//			DisableSpokePreserveAsPathGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableSpokePreserveAsPathGatewayGroup method")
+//			},
+//			DisableSummarizeCidrToTgwFunc: func(gwName string) error {
+//				panic("mock out the DisableSummarizeCidrToTgw method")
+//			},
+//			DisableTransitFireNetFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableTransitFireNet method")
+//			},
+//			DisableTransitFireNetGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableTransitFireNetGatewayGroup method")
+//			},
+//			DisableTransitLearnedCidrsApprovalFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the DisableTransitLearnedCidrsApproval method")
+//			},
+//			DisableTransitLearnedCidrsApprovalGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableTransitLearnedCidrsApprovalGatewayGroup method")
+//			},
+//			DisableTransitPreserveAsPathFunc: func(transitGateway *TransitVpc) error {
+//				panic("mock out the DisableTransitPreserveAsPath method")
+//			},
+//			DisableTransitPreserveAsPathGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableTransitPreserveAsPathGatewayGroup method")
+//			},
+//			DisableTransitSummarizeCidrToTgwGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableTransitSummarizeCidrToTgwGatewayGroup method")
+//			},
+//			DisableVPNConfigFunc: func(gateway *Gateway, vpnConfig *VPNConfig) error {
+//				panic("mock out the DisableVPNConfig method")
+//			},
+//			DisableVpcDNSServerFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableVpcDNSServer method")
+//			},
+//			DisableVpnNatFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableVpnNat method")
+//			},
+//			DownloadEdgeNEOConfigFileFunc: func(ctx context.Context, edgeNEODevice *EdgeNEODevice) error {
+//				panic("mock out the DownloadEdgeNEOConfigFile method")
+//			},
+//			EditBgpMd5KeyFunc: func(editBgpMd5Key *EditBgpMd5Key) error {
+//				panic("mock out the EditBgpMd5Key method")
+//			},
+//			EditConnectionBgpBfdFunc: func(externalDeviceConn *ExternalDeviceConn) error {
+//				panic("mock out the EditConnectionBgpBfd method")
+//			},
+//			EditConnectionBgpMultihopFunc: func(externalDeviceConn *ExternalDeviceConn) error {
+//				panic("mock out the EditConnectionBgpMultihop method")
+//			},
+//			EditDesignatedGatewayFunc: func(gateway *Gateway) error {
+//				panic("mock out the EditDesignatedGateway method")
+//			},
+//			EditGatewayAdvertisedCidrFunc: func(gateway *Gateway) error {
+//				panic("mock out the EditGatewayAdvertisedCidr method")
+//			},
+//			EditGatewayCustomRoutesFunc: func(gateway *Gateway) error {
+//				panic("mock out the EditGatewayCustomRoutes method")
+//			},
+//			EditGatewayFilterRoutesFunc: func(gateway *Gateway) error {
+//				panic("mock out the EditGatewayFilterRoutes method")
+//			},
+//			EditManagedRouteTablesFunc: func(gateway *Gateway, routeTables []string) error {
+//				panic("mock out the EditManagedRouteTables method")
+//			},
+//			EditManagedRouteTablesForGatewayGroupFunc: func(ctx context.Context, groupName string, routeTables []string) error {
+//				panic("mock out the EditManagedRouteTablesForGatewayGroup method")
+//			},
+//			EditPrivateRouteTableConfigFunc: func(gateway *Gateway, routeTables []string) error {
+//				panic("mock out the EditPrivateRouteTableConfig method")
+//			},
+//			EditPrivateRouteTableConfigForGatewayGroupFunc: func(ctx context.Context, groupName string, routeTables []string) error {
+//				panic("mock out the EditPrivateRouteTableConfigForGatewayGroup method")
+//			},
+//			EditPublicSubnetFilteringRouteTableListFunc: func(gateway *Gateway, routeTables []string) error {
+//				panic("mock out the EditPublicSubnetFilteringRouteTableList method")
+//			},
+//			EditSpokeExternalDeviceConnASPathPrependFunc: func(externalDeviceConn *ExternalDeviceConn, prependASPath []string) error {
+//				panic("mock out the EditSpokeExternalDeviceConnASPathPrepend method")
+//			},
+//			EditSpokeTransitAttachmentFiltersFunc: func(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) error {
+//				panic("mock out the EditSpokeTransitAttachmentFilters method")
+//			},
+//			EditTransitConnectionASPathPrependFunc: func(transitGatewayPeering *TransitGatewayPeering, prependASPath []string) error {
+//				panic("mock out the EditTransitConnectionASPathPrepend method")
+//			},
+//			EditTransitConnectionBGPManualAdvertiseCIDRsFunc: func(gwName string, connName string, cidrs []string) error {
+//				panic("mock out the EditTransitConnectionBGPManualAdvertiseCIDRs method")
+//			},
+//			EnableActiveStandbyFunc: func(transitGateway *TransitVpc) error {
+//				panic("mock out the EnableActiveStandby method")
+//			},
+//			EnableActiveStandbyGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableActiveStandbyGatewayGroup method")
+//			},
+//			EnableActiveStandbyPreemptiveFunc: func(transitGateway *TransitVpc) error {
+//				panic("mock out the EnableActiveStandbyPreemptive method")
+//			},
+//			EnableActiveStandbyPreemptiveGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableActiveStandbyPreemptiveGatewayGroup method")
+//			},
+//			EnableActiveStandbyPreemptiveSpokeFunc: func(spokeGateway *SpokeVpc) error {
+//				panic("mock out the EnableActiveStandbyPreemptiveSpoke method")
+//			},
+//			EnableActiveStandbySpokeFunc: func(spokeGateway *SpokeVpc) error {
+//				panic("mock out the EnableActiveStandbySpoke method")
+//			},
+//			EnableAdvertiseTransitCidrFunc: func(transitGw *TransitVpc) error {
+//				panic("mock out the EnableAdvertiseTransitCidr method")
+//			},
+//			EnableAdvertiseTransitCidrGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableAdvertiseTransitCidrGatewayGroup method")
+//			},
+//			EnableAutoAdvertiseS2CCidrsFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableAutoAdvertiseS2CCidrs method")
+//			},
+//			EnableAutoAdvertiseS2CCidrsGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableAutoAdvertiseS2CCidrsGatewayGroup method")
+//			},
+//			EnableConnectedTransitFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the EnableConnectedTransit method")
+//			},
+//			EnableConnectedTransitGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableConnectedTransitGatewayGroup method")
+//			},
+//			EnableCustomizedSNatFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableCustomizedSNat method")
+//			},
+//			EnableEdgeSpokeTransitiveRoutingFunc: func(ctx context.Context, name string) error {
+//				panic("mock out the EnableEdgeSpokeTransitiveRouting method")
+//			},
+//			EnableEgressTransitFirenetFunc: func(transitGateway *TransitVpc) error {
+//				panic("mock out the EnableEgressTransitFirenet method")
+//			},
+//			EnableEncryptVolumeFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableEncryptVolume method")
+//			},
+//			EnableFireNetGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableFireNetGatewayGroup method")
+//			},
+//			EnableGatewayFireNetInterfacesFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the EnableGatewayFireNetInterfaces method")
+//			},
+//			EnableGatewayFireNetInterfacesWithGWLBFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the EnableGatewayFireNetInterfacesWithGWLB method")
+//			},
+//			EnableGatewayGroupSNatFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableGatewayGroupSNat method")
+//			},
+//			EnableGatewayGroupVpcDNSServerFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableGatewayGroupVpcDNSServer method")
+//			},
+//			EnableGatewayLoadBalancerGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableGatewayLoadBalancerGatewayGroup method")
+//			},
+//			EnableGlobalVpcFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableGlobalVpc method")
+//			},
+//			EnableGlobalVpcGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableGlobalVpcGatewayGroup method")
+//			},
+//			EnableGroGsoFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableGroGso method")
+//			},
+//			EnableGroGsoGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableGroGsoGatewayGroup method")
+//			},
+//			EnableGuardDutyEnforcementFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableGuardDutyEnforcement method")
+//			},
+//			EnableHybridConnectionGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableHybridConnectionGatewayGroup method")
+//			},
+//			EnableIPv6Func: func(gateway *Gateway) error {
+//				panic("mock out the EnableIPv6 method")
+//			},
+//			EnableIPv6GatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableIPv6GatewayGroup method")
+//			},
+//			EnableJumboFrameFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableJumboFrame method")
+//			},
+//			EnableJumboFrameExternalDeviceConnFunc: func(externalDeviceConn *ExternalDeviceConn) error {
+//				panic("mock out the EnableJumboFrameExternalDeviceConn method")
+//			},
+//			EnableJumboFrameGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableJumboFrameGatewayGroup method")
+//			},
+//			EnableMonitorGatewaySubnetsFunc: func(gwName string, excludedInstances []string) error {
+//				panic("mock out the EnableMonitorGatewaySubnets method")
+//			},
+//			EnableMultiTierTransitGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableMultiTierTransitGatewayGroup method")
+//			},
+//			EnableMultitierTransitFunc: func(gwName string) error {
+//				panic("mock out the EnableMultitierTransit method")
+//			},
+//			EnablePeeringHaGatewayFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnablePeeringHaGateway method")
+//			},
+//			EnablePrivateVpcDefaultRouteFunc: func(gw *Gateway) error {
+//				panic("mock out the EnablePrivateVpcDefaultRoute method")
+//			},
+//			EnablePrivateVpcDefaultRouteGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnablePrivateVpcDefaultRouteGatewayGroup method")
+//			},
+//			EnablePublicSubnetFilteringHAGatewayFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnablePublicSubnetFilteringHAGateway method")
+//			},
+//			EnableS2CRxBalancingFunc: func(gwName string) error {
+//				panic("mock out the EnableS2CRxBalancing method")
+//			},
+//			EnableS2cRxBalancingGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableS2cRxBalancingGatewayGroup method")
+//			},
+//			EnableSNatFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableSNat method")
+//			},
+//			EnableSegmentationFunc: func(transitGateway *TransitVpc) error {
+//				panic("mock out the EnableSegmentation method")
+//			},
+//			EnableSegmentationGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableSegmentationGatewayGroup method")
+//			},
+//			EnableSingleAZGatewayFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableSingleAZGateway method")
+//			},
+//			EnableSkipPublicRouteUpdateFunc: func(gw *Gateway) error {
+//				panic("mock out the EnableSkipPublicRouteUpdate method")
+//			},
+//			EnableSkipPublicRouteUpdateGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableSkipPublicRouteUpdateGatewayGroup method")
+//			},
+//			EnableSpokeLearnedCidrsApprovalFunc: func(gateway *SpokeVpc) error {
+//				panic("mock out the EnableSpokeLearnedCidrsApproval method")
+//			},
+//			EnableSpokeLearnedCidrsApprovalGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableSpokeLearnedCidrsApprovalGatewayGroup method")
+//			},
+//			EnableSpokeOnpremRoutePropagationFunc: func(spokeGateway *SpokeVpc) error {
+//				panic("mock out the EnableSpokeOnpremRoutePropagation method")
+//			},
+//			EnableSpokeOnpremRoutePropagationGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableSpokeOnpremRoutePropagationGatewayGroup method")
+//			},
+//			EnableSpokePreserveAsPathFunc: func(spokeGateway *SpokeVpc) error {
+//				panic("mock out the EnableSpokePreserveAsPath method")
+//			},
+//			EnableSpokePreserveAsPathGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableSpokePreserveAsPathGatewayGroup method")
+//			},
+//			EnableSummarizeCidrToTgwFunc: func(gwName string) error {
+//				panic("mock out the EnableSummarizeCidrToTgw method")
+//			},
+//			EnableTransitFireNetFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableTransitFireNet method")
+//			},
+//			EnableTransitFireNetGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableTransitFireNetGatewayGroup method")
+//			},
+//			EnableTransitFireNetWithGWLBFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableTransitFireNetWithGWLB method")
+//			},
+//			EnableTransitFireNetWithGWLBGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableTransitFireNetWithGWLBGatewayGroup method")
+//			},
+//			EnableTransitLearnedCidrsApprovalFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the EnableTransitLearnedCidrsApproval method")
+//			},
+//			EnableTransitLearnedCidrsApprovalGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableTransitLearnedCidrsApprovalGatewayGroup method")
+//			},
+//			EnableTransitPreserveAsPathFunc: func(transitGateway *TransitVpc) error {
+//				panic("mock out the EnableTransitPreserveAsPath method")
+//			},
+//			EnableTransitPreserveAsPathGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableTransitPreserveAsPathGatewayGroup method")
+//			},
+//			EnableTransitSummarizeCidrToTgwGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableTransitSummarizeCidrToTgwGatewayGroup method")
+//			},
+//			EnableVPNConfigFunc: func(gateway *Gateway, vpnConfig *VPNConfig) error {
+//				panic("mock out the EnableVPNConfig method")
+//			},
+//			EnableVpcDNSServerFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableVpcDNSServer method")
+//			},
+//			EnableVpnNatFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableVpnNat method")
+//			},
+//			GetBgpLanIPListFunc: func(transitGateway *TransitVpc) (*TransitGatewayBgpLanIpInfo, error) {
+//				panic("mock out the GetBgpLanIPList method")
+//			},
+//			GetCIDFunc: func() string {
+//				panic("mock out the GetCID method")
+//			},
+//			GetControllerIPFunc: func() string {
+//				panic("mock out the GetControllerIP method")
+//			},
+//			GetDefaultTagsConfigFunc: func() *DefaultTagsConfig {
+//				panic("mock out the GetDefaultTagsConfig method")
+//			},
+//			GetEdgeCSPFunc: func(ctx context.Context, gwName string) (*EdgeCSPResp, error) {
+//				panic("mock out the GetEdgeCSP method")
+//			},
+//			GetEdgeCSPHaFunc: func(ctx context.Context, gwName string) (*EdgeCSPHaResp, error) {
+//				panic("mock out the GetEdgeCSPHa method")
+//			},
+//			GetEdgeEquinixFunc: func(ctx context.Context, gwName string) (*EdgeEquinixResp, error) {
+//				panic("mock out the GetEdgeEquinix method")
+//			},
+//			GetEdgeEquinixHaFunc: func(ctx context.Context, gwName string) (*EdgeEquinixHaResp, error) {
+//				panic("mock out the GetEdgeEquinixHa method")
+//			},
+//			GetEdgeGatewayWanIpFunc: func(ctx context.Context, gwName string, wanInterfaceName string) (string, error) {
+//				panic("mock out the GetEdgeGatewayWanIp method")
+//			},
+//			GetEdgeMegaportFunc: func(ctx context.Context, gwName string) (*EdgeMegaportResp, error) {
+//				panic("mock out the GetEdgeMegaport method")
+//			},
+//			GetEdgeMegaportHaFunc: func(ctx context.Context, gwName string) (*EdgeMegaportHaResp, error) {
+//				panic("mock out the GetEdgeMegaportHa method")
+//			},
+//			GetEdgeNEOFunc: func(ctx context.Context, gwName string) (*EdgeNEOResp, error) {
+//				panic("mock out the GetEdgeNEO method")
+//			},
+//			GetEdgeNEODeviceFunc: func(ctx context.Context, accountName string, deviceName string) (*EdgeNEODeviceResp, error) {
+//				panic("mock out the GetEdgeNEODevice method")
+//			},
+//			GetEdgeNEOHaFunc: func(ctx context.Context, gwName string) (*EdgeNEOHaResp, error) {
+//				panic("mock out the GetEdgeNEOHa method")
+//			},
+//			GetEdgePlatformProxyProfileFunc: func(ctx context.Context, accountName string, profileName string) (*EdgePlatformProxyProfileResp, error) {
+//				panic("mock out the GetEdgePlatformProxyProfile method")
+//			},
+//			GetEdgeSpokeFunc: func(ctx context.Context, gwName string) (*EdgeSpokeResp, error) {
+//				panic("mock out the GetEdgeSpoke method")
+//			},
+//			GetEdgeSpokeTransitAttachmentFunc: func(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) (*SpokeTransitAttachment, error) {
+//				panic("mock out the GetEdgeSpokeTransitAttachment method")
+//			},
+//			GetEdgeVmSelfmanagedHaFunc: func(ctx context.Context, gwName string) (*EdgeVmSelfmanagedHaResp, error) {
+//				panic("mock out the GetEdgeVmSelfmanagedHa method")
+//			},
+//			GetExternalDeviceConnDetailFunc: func(externalDeviceConn *ExternalDeviceConn, localGateway *Gateway, priorHAEnabled bool) (*ExternalDeviceConn, error) {
+//				panic("mock out the GetExternalDeviceConnDetail method")
+//			},
+//			GetGatewayFunc: func(gateway *Gateway) (*Gateway, error) {
+//				panic("mock out the GetGateway method")
+//			},
+//			GetGatewayBgpCommunitiesFunc: func(gwName string) (bool, bool, error) {
+//				panic("mock out the GetGatewayBgpCommunities method")
+//			},
+//			GetGatewayBgpMedToSdnMetricFunc: func(gwName string) (bool, error) {
+//				panic("mock out the GetGatewayBgpMedToSdnMetric method")
+//			},
+//			GetGatewayDetailFunc: func(gateway *Gateway) (*GatewayDetail, error) {
+//				panic("mock out the GetGatewayDetail method")
+//			},
+//			GetGatewayGroupFunc: func(ctx context.Context, groupUUID string) (*GatewayGroup, error) {
+//				panic("mock out the GetGatewayGroup method")
+//			},
+//			GetGatewayGroupByNameFunc: func(ctx context.Context, groupName string) (*GatewayGroup, error) {
+//				panic("mock out the GetGatewayGroupByName method")
+//			},
+//			GetGeoVPNNameFunc: func(gateway *Gateway) (*GeoVPN, error) {
+//				panic("mock out the GetGeoVPNName method")
+//			},
+//			GetGroGsoStatusFunc: func(gateway *Gateway) (bool, error) {
+//				panic("mock out the GetGroGsoStatus method")
+//			},
+//			GetIgnoreTagsConfigFunc: func() *IgnoreTagsConfig {
+//				panic("mock out the GetIgnoreTagsConfig method")
+//			},
+//			GetSplitTunnelFunc: func(splitTunnel *SplitTunnel) (*SplitTunnelUnit, error) {
+//				panic("mock out the GetSplitTunnel method")
+//			},
+//			GetSpokeGatewayAdvancedConfigFunc: func(spokeGateway *SpokeVpc) (*SpokeGatewayAdvancedConfig, error) {
+//				panic("mock out the GetSpokeGatewayAdvancedConfig method")
+//			},
+//			GetSpokeGatewayListFunc: func(ctx context.Context) ([]Gateway, error) {
+//				panic("mock out the GetSpokeGatewayList method")
+//			},
+//			GetSpokeGatewaySubnetGroupFunc: func(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error {
+//				panic("mock out the GetSpokeGatewaySubnetGroup method")
+//			},
+//			GetTagsFunc: func(tags *Tags) ([]string, error) {
+//				panic("mock out the GetTags method")
+//			},
+//			GetTransitGatewayAdvancedConfigFunc: func(transitGateway *TransitVpc) (*TransitGatewayAdvancedConfig, error) {
+//				panic("mock out the GetTransitGatewayAdvancedConfig method")
+//			},
+//			GetTransitGatewayLanCidrFunc: func(gatewayName string) (string, error) {
+//				panic("mock out the GetTransitGatewayLanCidr method")
+//			},
+//			GetTransitGatewayListFunc: func(ctx context.Context) ([]Gateway, error) {
+//				panic("mock out the GetTransitGatewayList method")
+//			},
+//			GetTunnelDetectionTimeFunc: func(entity string) (int, error) {
+//				panic("mock out the GetTunnelDetectionTime method")
+//			},
+//			IsTransitFireNetReadyToBeDisabledFunc: func(gateway *Gateway) error {
+//				panic("mock out the IsTransitFireNetReadyToBeDisabled method")
+//			},
+//			LaunchSpokeVpcContextFunc: func(ctx context.Context, spoke *SpokeVpc) error {
+//				panic("mock out the LaunchSpokeVpcContext method")
+//			},
+//			LaunchTransitVpcContextFunc: func(ctx context.Context, gateway *TransitVpc) error {
+//				panic("mock out the LaunchTransitVpcContext method")
+//			},
+//			ModifySplitTunnelFunc: func(splitTunnel *SplitTunnel) error {
+//				panic("mock out the ModifySplitTunnel method")
+//			},
+//			ModifyTunnelDetectionTimeFunc: func(entity string, detectionTime int) error {
+//				panic("mock out the ModifyTunnelDetectionTime method")
+//			},
+//			OnboardEdgeNEODeviceFunc: func(ctx context.Context, edgeNEODevice *EdgeNEODevice) error {
+//				panic("mock out the OnboardEdgeNEODevice method")
+//			},
+//			PostAPIFunc: func(action string, d interface{}, checkFunc CheckAPIResponseFunc) error {
+//				panic("mock out the PostAPI method")
+//			},
+//			SetBgpBfdPollingTimeFunc: func(transitGateway *TransitVpc, newPollingTime int) error {
+//				panic("mock out the SetBgpBfdPollingTime method")
+//			},
+//			SetBgpBfdPollingTimeGatewayGroupFunc: func(ctx context.Context, groupName string, pollingTime int) error {
+//				panic("mock out the SetBgpBfdPollingTimeGatewayGroup method")
+//			},
+//			SetBgpBfdPollingTimeSpokeFunc: func(spokeGateway *SpokeVpc, newPollingTime int) error {
+//				panic("mock out the SetBgpBfdPollingTimeSpoke method")
+//			},
+//			SetBgpEcmpFunc: func(transitGateway *TransitVpc, enabled bool) error {
+//				panic("mock out the SetBgpEcmp method")
+//			},
+//			SetBgpEcmpGatewayGroupFunc: func(ctx context.Context, groupName string, enable bool) error {
+//				panic("mock out the SetBgpEcmpGatewayGroup method")
+//			},
+//			SetBgpEcmpSpokeFunc: func(spokeGateway *SpokeVpc, enabled bool) error {
+//				panic("mock out the SetBgpEcmpSpoke method")
+//			},
+//			SetBgpManualSpokeAdvertisedNetworksFunc: func(transitGw *TransitVpc) error {
+//				panic("mock out the SetBgpManualSpokeAdvertisedNetworks method")
+//			},
+//			SetBgpPollingTimeFunc: func(transitGateway *TransitVpc, newPollingTime int) error {
+//				panic("mock out the SetBgpPollingTime method")
+//			},
+//			SetBgpPollingTimeGatewayGroupFunc: func(ctx context.Context, groupName string, pollingTime int) error {
+//				panic("mock out the SetBgpPollingTimeGatewayGroup method")
+//			},
+//			SetBgpPollingTimeSpokeFunc: func(spokeGateway *SpokeVpc, newPollingTime int) error {
+//				panic("mock out the SetBgpPollingTimeSpoke method")
+//			},
+//			SetGatewayBgpCommunitiesAcceptFunc: func(gwName string, acceptComm bool) error {
+//				panic("mock out the SetGatewayBgpCommunitiesAccept method")
+//			},
+//			SetGatewayBgpCommunitiesSendFunc: func(gwName string, sendComm bool) error {
+//				panic("mock out the SetGatewayBgpCommunitiesSend method")
+//			},
+//			SetGatewayBgpMedToSdnMetricFunc: func(gwName string, override bool) error {
+//				panic("mock out the SetGatewayBgpMedToSdnMetric method")
+//			},
+//			SetGatewayGroupBgpCommunitiesAcceptFunc: func(ctx context.Context, groupName string, accept bool) error {
+//				panic("mock out the SetGatewayGroupBgpCommunitiesAccept method")
+//			},
+//			SetGatewayGroupBgpCommunitiesSendFunc: func(ctx context.Context, groupName string, send bool) error {
+//				panic("mock out the SetGatewayGroupBgpCommunitiesSend method")
+//			},
+//			SetGatewayPhase2PolicyFunc: func(gwName string, encPolicy string, pfsPolicy string) error {
+//				panic("mock out the SetGatewayPhase2Policy method")
+//			},
+//			SetLocalASNumberFunc: func(transitGateway *TransitVpc, localASNumber string) error {
+//				panic("mock out the SetLocalASNumber method")
+//			},
+//			SetLocalASNumberGatewayGroupFunc: func(ctx context.Context, groupName string, localAsNumber string) error {
+//				panic("mock out the SetLocalASNumberGatewayGroup method")
+//			},
+//			SetLocalASNumberSpokeFunc: func(spokeGateway *SpokeVpc, localASNumber string) error {
+//				panic("mock out the SetLocalASNumberSpoke method")
+//			},
+//			SetPrependASPathFunc: func(transitGateway *TransitVpc, prependASPath []string) error {
+//				panic("mock out the SetPrependASPath method")
+//			},
+//			SetPrependASPathGatewayGroupFunc: func(ctx context.Context, groupName string, prependAsPath []string) error {
+//				panic("mock out the SetPrependASPathGatewayGroup method")
+//			},
+//			SetPrependASPathSpokeFunc: func(spokeGateway *SpokeVpc, prependASPath []string) error {
+//				panic("mock out the SetPrependASPathSpoke method")
+//			},
+//			SetRxQueueSizeFunc: func(gateway *Gateway) error {
+//				panic("mock out the SetRxQueueSize method")
+//			},
+//			SetSpokeBgpManualAdvertisedNetworksFunc: func(spokeGateway *SpokeVpc) error {
+//				panic("mock out the SetSpokeBgpManualAdvertisedNetworks method")
+//			},
+//			SetSpokeBgpManualAdvertisedNetworksGatewayGroupFunc: func(ctx context.Context, groupName string, cidrs string) error {
+//				panic("mock out the SetSpokeBgpManualAdvertisedNetworksGatewayGroup method")
+//			},
+//			SetSymmetricRoutingGatewayGroupFunc: func(ctx context.Context, groupUUID string, enable bool) error {
+//				panic("mock out the SetSymmetricRoutingGatewayGroup method")
+//			},
+//			SetTransitLearnedCIDRsApprovalModeFunc: func(gw *TransitVpc, mode string) error {
+//				panic("mock out the SetTransitLearnedCIDRsApprovalMode method")
+//			},
+//			SetVpnGatewayAuthenticationFunc: func(gateway *VpnGatewayAuth) error {
+//				panic("mock out the SetVpnGatewayAuthentication method")
+//			},
+//			UpdateDNatFunc: func(gateway *Gateway) error {
+//				panic("mock out the UpdateDNat method")
+//			},
+//			UpdateEdgeCSPFunc: func(ctx context.Context, edgeCSP *EdgeCSP) error {
+//				panic("mock out the UpdateEdgeCSP method")
+//			},
+//			UpdateEdgeCSPHaFunc: func(ctx context.Context, edgeCSP *EdgeCSP) error {
+//				panic("mock out the UpdateEdgeCSPHa method")
+//			},
+//			UpdateEdgeEquinixFunc: func(ctx context.Context, edgeEquinix *EdgeEquinix) error {
+//				panic("mock out the UpdateEdgeEquinix method")
+//			},
+//			UpdateEdgeEquinixHaFunc: func(ctx context.Context, edgeEquinix *EdgeEquinix) error {
+//				panic("mock out the UpdateEdgeEquinixHa method")
+//			},
+//			UpdateEdgeGatewayFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the UpdateEdgeGateway method")
+//			},
+//			UpdateEdgeGatewayV2Func: func(ctx context.Context, gateway *TransitVpc) error {
+//				panic("mock out the UpdateEdgeGatewayV2 method")
+//			},
+//			UpdateEdgeMegaportFunc: func(ctx context.Context, edgeMegaport *EdgeMegaport) error {
+//				panic("mock out the UpdateEdgeMegaport method")
+//			},
+//			UpdateEdgeMegaportHaFunc: func(ctx context.Context, edgeMegaport *EdgeMegaport) error {
+//				panic("mock out the UpdateEdgeMegaportHa method")
+//			},
+//			UpdateEdgeNEOFunc: func(ctx context.Context, edgeNEO *EdgeNEO) error {
+//				panic("mock out the UpdateEdgeNEO method")
+//			},
+//			UpdateEdgeNEODeviceFunc: func(ctx context.Context, edgeNEODevice *EdgeNEODevice) error {
+//				panic("mock out the UpdateEdgeNEODevice method")
+//			},
+//			UpdateEdgeNEOHaFunc: func(ctx context.Context, edgeNEO *EdgeNEO) error {
+//				panic("mock out the UpdateEdgeNEOHa method")
+//			},
+//			UpdateEdgeProxyProfileFunc: func(ctx context.Context, edgeNEOProxyProfile *EdgePlatformProxyProfileUpdate) error {
+//				panic("mock out the UpdateEdgeProxyProfile method")
+//			},
+//			UpdateEdgeSpokeFunc: func(ctx context.Context, edgeSpoke *EdgeSpoke) error {
+//				panic("mock out the UpdateEdgeSpoke method")
+//			},
+//			UpdateEdgeSpokeGeoCoordinateFunc: func(ctx context.Context, edgeSpoke *EdgeSpoke) error {
+//				panic("mock out the UpdateEdgeSpokeGeoCoordinate method")
+//			},
+//			UpdateEdgeSpokeTransitPeeringTunnelCountFunc: func(gateway1 string, gateway2 string, tunnelCount int) error {
+//				panic("mock out the UpdateEdgeSpokeTransitPeeringTunnelCount method")
+//			},
+//			UpdateEdgeVmSelfmanagedHaFunc: func(ctx context.Context, edgeVmSelfmanaged *EdgeSpoke) error {
+//				panic("mock out the UpdateEdgeVmSelfmanagedHa method")
+//			},
+//			UpdateGatewayFunc: func(gateway *Gateway) error {
+//				panic("mock out the UpdateGateway method")
+//			},
+//			UpdateGatewayContextFunc: func(ctx context.Context, gateway *Gateway) error {
+//				panic("mock out the UpdateGatewayContext method")
+//			},
+//			UpdateGroupInstanceSizeFunc: func(ctx context.Context, groupName string, instanceSize string) error {
+//				panic("mock out the UpdateGroupInstanceSize method")
+//			},
+//			UpdateMaxVpnConnFunc: func(gateway *Gateway) error {
+//				panic("mock out the UpdateMaxVpnConn method")
+//			},
+//			UpdateSpokeGatewaySubnetGroupFunc: func(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error {
+//				panic("mock out the UpdateSpokeGatewaySubnetGroup method")
+//			},
+//			UpdateSpokePendingApprovedCidrsFunc: func(gateway *SpokeVpc) error {
+//				panic("mock out the UpdateSpokePendingApprovedCidrs method")
+//			},
+//			UpdateSpokePendingApprovedCidrsGatewayGroupFunc: func(ctx context.Context, groupName string, approvedCidrs []string) error {
+//				panic("mock out the UpdateSpokePendingApprovedCidrsGatewayGroup method")
+//			},
+//			UpdateTagsFunc: func(tags *Tags) error {
+//				panic("mock out the UpdateTags method")
+//			},
+//			UpdateTransitGatewayCustomizedVpcRouteFunc: func(gateway string, customizedTransitVpcRoutes []string) error {
+//				panic("mock out the UpdateTransitGatewayCustomizedVpcRoute method")
+//			},
+//			UpdateTransitPendingApprovedCidrsFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the UpdateTransitPendingApprovedCidrs method")
+//			},
+//			UpdateTransitPendingApprovedCidrsGatewayGroupFunc: func(ctx context.Context, groupName string, approvedCidrs []string) error {
+//				panic("mock out the UpdateTransitPendingApprovedCidrsGatewayGroup method")
+//			},
+//			UpdateVpnCidrFunc: func(gateway *Gateway) error {
+//				panic("mock out the UpdateVpnCidr method")
+//			},
+//		}
+//
+//		// use mockedEdgeClient in code that requires EdgeClient
+//		// and then make assertions.
+//
+//	}
+type EdgeClientMock struct {
+	// AddSpokeGatewaySubnetGroupFunc mocks the AddSpokeGatewaySubnetGroup method.
+	AddSpokeGatewaySubnetGroupFunc func(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error
+
+	// AttachTransitGWForHybridFunc mocks the AttachTransitGWForHybrid method.
+	AttachTransitGWForHybridFunc func(gateway *TransitVpc) error
+
+	// ChangeBgpHoldTimeFunc mocks the ChangeBgpHoldTime method.
+	ChangeBgpHoldTimeFunc func(gwName string, holdTime int) error
+
+	// ChangeBgpHoldTimeGatewayGroupFunc mocks the ChangeBgpHoldTimeGatewayGroup method.
+	ChangeBgpHoldTimeGatewayGroupFunc func(ctx context.Context, groupName string, holdTime int) error
+
+	// ChangeBgpOverLanIntfCntFunc mocks the ChangeBgpOverLanIntfCnt method.
+	ChangeBgpOverLanIntfCntFunc func(gateway *Gateway) error
+
+	// CreateEdgeCSPFunc mocks the CreateEdgeCSP method.
+	CreateEdgeCSPFunc func(ctx context.Context, edgeCSP *EdgeCSP) error
+
+	// CreateEdgeCSPHaFunc mocks the CreateEdgeCSPHa method.
+	CreateEdgeCSPHaFunc func(ctx context.Context, edgeCSPHa *EdgeCSPHa) (string, error)
+
+	// CreateEdgeEquinixFunc mocks the CreateEdgeEquinix method.
+	CreateEdgeEquinixFunc func(ctx context.Context, edgeEquinix *EdgeEquinix) error
+
+	// CreateEdgeEquinixHaFunc mocks the CreateEdgeEquinixHa method.
+	CreateEdgeEquinixHaFunc func(ctx context.Context, edgeEquinixHa *EdgeEquinixHa) (string, error)
+
+	// CreateEdgeExternalDeviceConnFunc mocks the CreateEdgeExternalDeviceConn method.
+	CreateEdgeExternalDeviceConnFunc func(edgeExternalDeviceConn *EdgeExternalDeviceConn) (string, error)
+
+	// CreateEdgeMegaportFunc mocks the CreateEdgeMegaport method.
+	CreateEdgeMegaportFunc func(ctx context.Context, edgeMegaport *EdgeMegaport) error
+
+	// CreateEdgeMegaportHaFunc mocks the CreateEdgeMegaportHa method.
+	CreateEdgeMegaportHaFunc func(ctx context.Context, edgeMegaportHa *EdgeMegaportHa) (string, error)
+
+	// CreateEdgeNEOFunc mocks the CreateEdgeNEO method.
+	CreateEdgeNEOFunc func(ctx context.Context, edgeNEO *EdgeNEO) error
+
+	// CreateEdgeNEOHaFunc mocks the CreateEdgeNEOHa method.
+	CreateEdgeNEOHaFunc func(ctx context.Context, edgeNEOHa *EdgeNEOHa) (string, error)
+
+	// CreateEdgeProxyProfileFunc mocks the CreateEdgeProxyProfile method.
+	CreateEdgeProxyProfileFunc func(ctx context.Context, edgeNEOProxyProfile *EdgePlatformProxyProfile) (*EdgePlatformProxyProfileResp, error)
+
+	// CreateEdgeSpokeFunc mocks the CreateEdgeSpoke method.
+	CreateEdgeSpokeFunc func(ctx context.Context, edgeSpoke *EdgeSpoke) error
+
+	// CreateEdgeVmSelfmanagedHaFunc mocks the CreateEdgeVmSelfmanagedHa method.
+	CreateEdgeVmSelfmanagedHaFunc func(ctx context.Context, edgeVmSelfmanagedHa *EdgeVmSelfmanagedHa) (string, error)
+
+	// CreateExternalDeviceConnFunc mocks the CreateExternalDeviceConn method.
+	CreateExternalDeviceConnFunc func(externalDeviceConn *ExternalDeviceConn) error
+
+	// CreateGatewayContextFunc mocks the CreateGatewayContext method.
+	CreateGatewayContextFunc func(ctx context.Context, gateway *Gateway) error
+
+	// CreateGatewayGroupFunc mocks the CreateGatewayGroup method.
+	CreateGatewayGroupFunc func(ctx context.Context, spokeGroup *GatewayGroup) error
+
+	// CreatePublicSubnetFilteringGatewayContextFunc mocks the CreatePublicSubnetFilteringGatewayContext method.
+	CreatePublicSubnetFilteringGatewayContextFunc func(ctx context.Context, gateway *Gateway) error
+
+	// CreateSpokeHaGwFunc mocks the CreateSpokeHaGw method.
+	CreateSpokeHaGwFunc func(spokeHaGateway *SpokeHaGateway) (string, error)
+
+	// CreateSpokeHaGwContextFunc mocks the CreateSpokeHaGwContext method.
+	CreateSpokeHaGwContextFunc func(ctx context.Context, spokeHaGateway *SpokeHaGateway) (string, error)
+
+	// CreateSpokeTransitAttachmentFunc mocks the CreateSpokeTransitAttachment method.
+	CreateSpokeTransitAttachmentFunc func(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) error
+
+	// CreateTransitHaGwContextFunc mocks the CreateTransitHaGwContext method.
+	CreateTransitHaGwContextFunc func(ctx context.Context, transitHaGateway *TransitHaGateway) (string, error)
+
+	// DeleteEdgeCSPFunc mocks the DeleteEdgeCSP method.
+	DeleteEdgeCSPFunc func(ctx context.Context, accountName string, name string) error
+
+	// DeleteEdgeEquinixFunc mocks the DeleteEdgeEquinix method.
+	DeleteEdgeEquinixFunc func(ctx context.Context, accountName string, name string) error
+
+	// DeleteEdgeExternalDeviceConnFunc mocks the DeleteEdgeExternalDeviceConn method.
+	DeleteEdgeExternalDeviceConnFunc func(edgeExternalDeviceConn *EdgeExternalDeviceConn) error
+
+	// DeleteEdgeMegaportFunc mocks the DeleteEdgeMegaport method.
+	DeleteEdgeMegaportFunc func(ctx context.Context, accountName string, name string) error
+
+	// DeleteEdgeNEOFunc mocks the DeleteEdgeNEO method.
+	DeleteEdgeNEOFunc func(ctx context.Context, accountName string, name string) error
+
+	// DeleteEdgeNEODeviceFunc mocks the DeleteEdgeNEODevice method.
+	DeleteEdgeNEODeviceFunc func(ctx context.Context, accountName string, serialNumber string) error
+
+	// DeleteEdgePlatformProxyProfileFunc mocks the DeleteEdgePlatformProxyProfile method.
+	DeleteEdgePlatformProxyProfileFunc func(ctx context.Context, accountName string, profileName string) error
+
+	// DeleteEdgeSpokeFunc mocks the DeleteEdgeSpoke method.
+	DeleteEdgeSpokeFunc func(ctx context.Context, name string) error
+
+	// DeleteExternalDeviceConnFunc mocks the DeleteExternalDeviceConn method.
+	DeleteExternalDeviceConnFunc func(externalDeviceConn *ExternalDeviceConn) error
+
+	// DeleteGatewayFunc mocks the DeleteGateway method.
+	DeleteGatewayFunc func(gateway *Gateway) error
+
+	// DeleteGatewayContextFunc mocks the DeleteGatewayContext method.
+	DeleteGatewayContextFunc func(ctx context.Context, gateway *Gateway) error
+
+	// DeleteGatewayGroupFunc mocks the DeleteGatewayGroup method.
+	DeleteGatewayGroupFunc func(ctx context.Context, groupUUID string) error
+
+	// DeletePublicSubnetFilteringGatewayContextFunc mocks the DeletePublicSubnetFilteringGatewayContext method.
+	DeletePublicSubnetFilteringGatewayContextFunc func(ctx context.Context, gateway *Gateway) error
+
+	// DeleteSpokeGatewaySubnetGroupFunc mocks the DeleteSpokeGatewaySubnetGroup method.
+	DeleteSpokeGatewaySubnetGroupFunc func(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error
+
+	// DeleteSpokeTransitAttachmentFunc mocks the DeleteSpokeTransitAttachment method.
+	DeleteSpokeTransitAttachmentFunc func(spokeTransitAttachment *SpokeTransitAttachment) error
+
+	// DetachTransitGWForHybridFunc mocks the DetachTransitGWForHybrid method.
+	DetachTransitGWForHybridFunc func(gateway *TransitVpc) error
+
+	// DisableActiveStandbyFunc mocks the DisableActiveStandby method.
+	DisableActiveStandbyFunc func(transitGateway *TransitVpc) error
+
+	// DisableActiveStandbyGatewayGroupFunc mocks the DisableActiveStandbyGatewayGroup method.
+	DisableActiveStandbyGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableActiveStandbySpokeFunc mocks the DisableActiveStandbySpoke method.
+	DisableActiveStandbySpokeFunc func(spokeGateway *SpokeVpc) error
+
+	// DisableAdvertiseTransitCidrFunc mocks the DisableAdvertiseTransitCidr method.
+	DisableAdvertiseTransitCidrFunc func(transitGw *TransitVpc) error
+
+	// DisableAdvertiseTransitCidrGatewayGroupFunc mocks the DisableAdvertiseTransitCidrGatewayGroup method.
+	DisableAdvertiseTransitCidrGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableAutoAdvertiseS2CCidrsFunc mocks the DisableAutoAdvertiseS2CCidrs method.
+	DisableAutoAdvertiseS2CCidrsFunc func(gateway *Gateway) error
+
+	// DisableAutoAdvertiseS2CCidrsGatewayGroupFunc mocks the DisableAutoAdvertiseS2CCidrsGatewayGroup method.
+	DisableAutoAdvertiseS2CCidrsGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableConnectedTransitFunc mocks the DisableConnectedTransit method.
+	DisableConnectedTransitFunc func(gateway *TransitVpc) error
+
+	// DisableConnectedTransitGatewayGroupFunc mocks the DisableConnectedTransitGatewayGroup method.
+	DisableConnectedTransitGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableCustomSNatFunc mocks the DisableCustomSNat method.
+	DisableCustomSNatFunc func(gateway *Gateway) error
+
+	// DisableEdgeSpokeTransitiveRoutingFunc mocks the DisableEdgeSpokeTransitiveRouting method.
+	DisableEdgeSpokeTransitiveRoutingFunc func(ctx context.Context, name string) error
+
+	// DisableEgressTransitFirenetFunc mocks the DisableEgressTransitFirenet method.
+	DisableEgressTransitFirenetFunc func(transitGateway *TransitVpc) error
+
+	// DisableFireNetGatewayGroupFunc mocks the DisableFireNetGatewayGroup method.
+	DisableFireNetGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableGatewayFireNetInterfacesFunc mocks the DisableGatewayFireNetInterfaces method.
+	DisableGatewayFireNetInterfacesFunc func(gateway *TransitVpc) error
+
+	// DisableGatewayGroupSNatFunc mocks the DisableGatewayGroupSNat method.
+	DisableGatewayGroupSNatFunc func(ctx context.Context, groupName string) error
+
+	// DisableGatewayGroupVpcDNSServerFunc mocks the DisableGatewayGroupVpcDNSServer method.
+	DisableGatewayGroupVpcDNSServerFunc func(ctx context.Context, groupName string) error
+
+	// DisableGatewayLoadBalancerGatewayGroupFunc mocks the DisableGatewayLoadBalancerGatewayGroup method.
+	DisableGatewayLoadBalancerGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableGlobalVpcFunc mocks the DisableGlobalVpc method.
+	DisableGlobalVpcFunc func(gateway *Gateway) error
+
+	// DisableGlobalVpcGatewayGroupFunc mocks the DisableGlobalVpcGatewayGroup method.
+	DisableGlobalVpcGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableGroGsoFunc mocks the DisableGroGso method.
+	DisableGroGsoFunc func(gateway *Gateway) error
+
+	// DisableGroGsoGatewayGroupFunc mocks the DisableGroGsoGatewayGroup method.
+	DisableGroGsoGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableGuardDutyEnforcementFunc mocks the DisableGuardDutyEnforcement method.
+	DisableGuardDutyEnforcementFunc func(gateway *Gateway) error
+
+	// DisableHybridConnectionGatewayGroupFunc mocks the DisableHybridConnectionGatewayGroup method.
+	DisableHybridConnectionGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableIPv6Func mocks the DisableIPv6 method.
+	DisableIPv6Func func(gateway *Gateway) error
+
+	// DisableIPv6GatewayGroupFunc mocks the DisableIPv6GatewayGroup method.
+	DisableIPv6GatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableJumboFrameFunc mocks the DisableJumboFrame method.
+	DisableJumboFrameFunc func(gateway *Gateway) error
+
+	// DisableJumboFrameExternalDeviceConnFunc mocks the DisableJumboFrameExternalDeviceConn method.
+	DisableJumboFrameExternalDeviceConnFunc func(externalDeviceConn *ExternalDeviceConn) error
+
+	// DisableJumboFrameGatewayGroupFunc mocks the DisableJumboFrameGatewayGroup method.
+	DisableJumboFrameGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableMonitorGatewaySubnetsFunc mocks the DisableMonitorGatewaySubnets method.
+	DisableMonitorGatewaySubnetsFunc func(gwName string) error
+
+	// DisableMultiTierTransitGatewayGroupFunc mocks the DisableMultiTierTransitGatewayGroup method.
+	DisableMultiTierTransitGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableMultitierTransitFunc mocks the DisableMultitierTransit method.
+	DisableMultitierTransitFunc func(gwName string) error
+
+	// DisablePrivateVpcDefaultRouteFunc mocks the DisablePrivateVpcDefaultRoute method.
+	DisablePrivateVpcDefaultRouteFunc func(gw *Gateway) error
+
+	// DisablePrivateVpcDefaultRouteGatewayGroupFunc mocks the DisablePrivateVpcDefaultRouteGatewayGroup method.
+	DisablePrivateVpcDefaultRouteGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableS2CRxBalancingFunc mocks the DisableS2CRxBalancing method.
+	DisableS2CRxBalancingFunc func(gwName string) error
+
+	// DisableS2cRxBalancingGatewayGroupFunc mocks the DisableS2cRxBalancingGatewayGroup method.
+	DisableS2cRxBalancingGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableSNatFunc mocks the DisableSNat method.
+	DisableSNatFunc func(gateway *Gateway) error
+
+	// DisableSegmentationFunc mocks the DisableSegmentation method.
+	DisableSegmentationFunc func(transitGateway *TransitVpc) error
+
+	// DisableSegmentationGatewayGroupFunc mocks the DisableSegmentationGatewayGroup method.
+	DisableSegmentationGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableSingleAZGatewayFunc mocks the DisableSingleAZGateway method.
+	DisableSingleAZGatewayFunc func(gateway *Gateway) error
+
+	// DisableSkipPublicRouteUpdateFunc mocks the DisableSkipPublicRouteUpdate method.
+	DisableSkipPublicRouteUpdateFunc func(gw *Gateway) error
+
+	// DisableSkipPublicRouteUpdateGatewayGroupFunc mocks the DisableSkipPublicRouteUpdateGatewayGroup method.
+	DisableSkipPublicRouteUpdateGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableSpokeLearnedCidrsApprovalFunc mocks the DisableSpokeLearnedCidrsApproval method.
+	DisableSpokeLearnedCidrsApprovalFunc func(gateway *SpokeVpc) error
+
+	// DisableSpokeLearnedCidrsApprovalGatewayGroupFunc mocks the DisableSpokeLearnedCidrsApprovalGatewayGroup method.
+	DisableSpokeLearnedCidrsApprovalGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableSpokeOnpremRoutePropagationFunc mocks the DisableSpokeOnpremRoutePropagation method.
+	DisableSpokeOnpremRoutePropagationFunc func(spokeGateway *SpokeVpc) error
+
+	// DisableSpokeOnpremRoutePropagationGatewayGroupFunc mocks the DisableSpokeOnpremRoutePropagationGatewayGroup method.
+	DisableSpokeOnpremRoutePropagationGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableSpokePreserveAsPathFunc mocks the DisableSpokePreserveAsPath method.
+	DisableSpokePreserveAsPathFunc func(spokeGateway *SpokeVpc) error
+
+	// DisableSpokePreserveAsPathGatewayGroupFunc mocks the DisableSpokePreserveAsPathGatewayGroup method.
+	DisableSpokePreserveAsPathGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableSummarizeCidrToTgwFunc mocks the DisableSummarizeCidrToTgw method.
+	DisableSummarizeCidrToTgwFunc func(gwName string) error
+
+	// DisableTransitFireNetFunc mocks the DisableTransitFireNet method.
+	DisableTransitFireNetFunc func(gateway *Gateway) error
+
+	// DisableTransitFireNetGatewayGroupFunc mocks the DisableTransitFireNetGatewayGroup method.
+	DisableTransitFireNetGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableTransitLearnedCidrsApprovalFunc mocks the DisableTransitLearnedCidrsApproval method.
+	DisableTransitLearnedCidrsApprovalFunc func(gateway *TransitVpc) error
+
+	// DisableTransitLearnedCidrsApprovalGatewayGroupFunc mocks the DisableTransitLearnedCidrsApprovalGatewayGroup method.
+	DisableTransitLearnedCidrsApprovalGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableTransitPreserveAsPathFunc mocks the DisableTransitPreserveAsPath method.
+	DisableTransitPreserveAsPathFunc func(transitGateway *TransitVpc) error
+
+	// DisableTransitPreserveAsPathGatewayGroupFunc mocks the DisableTransitPreserveAsPathGatewayGroup method.
+	DisableTransitPreserveAsPathGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableTransitSummarizeCidrToTgwGatewayGroupFunc mocks the DisableTransitSummarizeCidrToTgwGatewayGroup method.
+	DisableTransitSummarizeCidrToTgwGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableVPNConfigFunc mocks the DisableVPNConfig method.
+	DisableVPNConfigFunc func(gateway *Gateway, vpnConfig *VPNConfig) error
+
+	// DisableVpcDNSServerFunc mocks the DisableVpcDNSServer method.
+	DisableVpcDNSServerFunc func(gateway *Gateway) error
+
+	// DisableVpnNatFunc mocks the DisableVpnNat method.
+	DisableVpnNatFunc func(gateway *Gateway) error
+
+	// DownloadEdgeNEOConfigFileFunc mocks the DownloadEdgeNEOConfigFile method.
+	DownloadEdgeNEOConfigFileFunc func(ctx context.Context, edgeNEODevice *EdgeNEODevice) error
+
+	// EditBgpMd5KeyFunc mocks the EditBgpMd5Key method.
+	EditBgpMd5KeyFunc func(editBgpMd5Key *EditBgpMd5Key) error
+
+	// EditConnectionBgpBfdFunc mocks the EditConnectionBgpBfd method.
+	EditConnectionBgpBfdFunc func(externalDeviceConn *ExternalDeviceConn) error
+
+	// EditConnectionBgpMultihopFunc mocks the EditConnectionBgpMultihop method.
+	EditConnectionBgpMultihopFunc func(externalDeviceConn *ExternalDeviceConn) error
+
+	// EditDesignatedGatewayFunc mocks the EditDesignatedGateway method.
+	EditDesignatedGatewayFunc func(gateway *Gateway) error
+
+	// EditGatewayAdvertisedCidrFunc mocks the EditGatewayAdvertisedCidr method.
+	EditGatewayAdvertisedCidrFunc func(gateway *Gateway) error
+
+	// EditGatewayCustomRoutesFunc mocks the EditGatewayCustomRoutes method.
+	EditGatewayCustomRoutesFunc func(gateway *Gateway) error
+
+	// EditGatewayFilterRoutesFunc mocks the EditGatewayFilterRoutes method.
+	EditGatewayFilterRoutesFunc func(gateway *Gateway) error
+
+	// EditManagedRouteTablesFunc mocks the EditManagedRouteTables method.
+	EditManagedRouteTablesFunc func(gateway *Gateway, routeTables []string) error
+
+	// EditManagedRouteTablesForGatewayGroupFunc mocks the EditManagedRouteTablesForGatewayGroup method.
+	EditManagedRouteTablesForGatewayGroupFunc func(ctx context.Context, groupName string, routeTables []string) error
+
+	// EditPrivateRouteTableConfigFunc mocks the EditPrivateRouteTableConfig method.
+	EditPrivateRouteTableConfigFunc func(gateway *Gateway, routeTables []string) error
+
+	// EditPrivateRouteTableConfigForGatewayGroupFunc mocks the EditPrivateRouteTableConfigForGatewayGroup method.
+	EditPrivateRouteTableConfigForGatewayGroupFunc func(ctx context.Context, groupName string, routeTables []string) error
+
+	// EditPublicSubnetFilteringRouteTableListFunc mocks the EditPublicSubnetFilteringRouteTableList method.
+	EditPublicSubnetFilteringRouteTableListFunc func(gateway *Gateway, routeTables []string) error
+
+	// EditSpokeExternalDeviceConnASPathPrependFunc mocks the EditSpokeExternalDeviceConnASPathPrepend method.
+	EditSpokeExternalDeviceConnASPathPrependFunc func(externalDeviceConn *ExternalDeviceConn, prependASPath []string) error
+
+	// EditSpokeTransitAttachmentFiltersFunc mocks the EditSpokeTransitAttachmentFilters method.
+	EditSpokeTransitAttachmentFiltersFunc func(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) error
+
+	// EditTransitConnectionASPathPrependFunc mocks the EditTransitConnectionASPathPrepend method.
+	EditTransitConnectionASPathPrependFunc func(transitGatewayPeering *TransitGatewayPeering, prependASPath []string) error
+
+	// EditTransitConnectionBGPManualAdvertiseCIDRsFunc mocks the EditTransitConnectionBGPManualAdvertiseCIDRs method.
+	EditTransitConnectionBGPManualAdvertiseCIDRsFunc func(gwName string, connName string, cidrs []string) error
+
+	// EnableActiveStandbyFunc mocks the EnableActiveStandby method.
+	EnableActiveStandbyFunc func(transitGateway *TransitVpc) error
+
+	// EnableActiveStandbyGatewayGroupFunc mocks the EnableActiveStandbyGatewayGroup method.
+	EnableActiveStandbyGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableActiveStandbyPreemptiveFunc mocks the EnableActiveStandbyPreemptive method.
+	EnableActiveStandbyPreemptiveFunc func(transitGateway *TransitVpc) error
+
+	// EnableActiveStandbyPreemptiveGatewayGroupFunc mocks the EnableActiveStandbyPreemptiveGatewayGroup method.
+	EnableActiveStandbyPreemptiveGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableActiveStandbyPreemptiveSpokeFunc mocks the EnableActiveStandbyPreemptiveSpoke method.
+	EnableActiveStandbyPreemptiveSpokeFunc func(spokeGateway *SpokeVpc) error
+
+	// EnableActiveStandbySpokeFunc mocks the EnableActiveStandbySpoke method.
+	EnableActiveStandbySpokeFunc func(spokeGateway *SpokeVpc) error
+
+	// EnableAdvertiseTransitCidrFunc mocks the EnableAdvertiseTransitCidr method.
+	EnableAdvertiseTransitCidrFunc func(transitGw *TransitVpc) error
+
+	// EnableAdvertiseTransitCidrGatewayGroupFunc mocks the EnableAdvertiseTransitCidrGatewayGroup method.
+	EnableAdvertiseTransitCidrGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableAutoAdvertiseS2CCidrsFunc mocks the EnableAutoAdvertiseS2CCidrs method.
+	EnableAutoAdvertiseS2CCidrsFunc func(gateway *Gateway) error
+
+	// EnableAutoAdvertiseS2CCidrsGatewayGroupFunc mocks the EnableAutoAdvertiseS2CCidrsGatewayGroup method.
+	EnableAutoAdvertiseS2CCidrsGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableConnectedTransitFunc mocks the EnableConnectedTransit method.
+	EnableConnectedTransitFunc func(gateway *TransitVpc) error
+
+	// EnableConnectedTransitGatewayGroupFunc mocks the EnableConnectedTransitGatewayGroup method.
+	EnableConnectedTransitGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableCustomizedSNatFunc mocks the EnableCustomizedSNat method.
+	EnableCustomizedSNatFunc func(gateway *Gateway) error
+
+	// EnableEdgeSpokeTransitiveRoutingFunc mocks the EnableEdgeSpokeTransitiveRouting method.
+	EnableEdgeSpokeTransitiveRoutingFunc func(ctx context.Context, name string) error
+
+	// EnableEgressTransitFirenetFunc mocks the EnableEgressTransitFirenet method.
+	EnableEgressTransitFirenetFunc func(transitGateway *TransitVpc) error
+
+	// EnableEncryptVolumeFunc mocks the EnableEncryptVolume method.
+	EnableEncryptVolumeFunc func(gateway *Gateway) error
+
+	// EnableFireNetGatewayGroupFunc mocks the EnableFireNetGatewayGroup method.
+	EnableFireNetGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableGatewayFireNetInterfacesFunc mocks the EnableGatewayFireNetInterfaces method.
+	EnableGatewayFireNetInterfacesFunc func(gateway *TransitVpc) error
+
+	// EnableGatewayFireNetInterfacesWithGWLBFunc mocks the EnableGatewayFireNetInterfacesWithGWLB method.
+	EnableGatewayFireNetInterfacesWithGWLBFunc func(gateway *TransitVpc) error
+
+	// EnableGatewayGroupSNatFunc mocks the EnableGatewayGroupSNat method.
+	EnableGatewayGroupSNatFunc func(ctx context.Context, groupName string) error
+
+	// EnableGatewayGroupVpcDNSServerFunc mocks the EnableGatewayGroupVpcDNSServer method.
+	EnableGatewayGroupVpcDNSServerFunc func(ctx context.Context, groupName string) error
+
+	// EnableGatewayLoadBalancerGatewayGroupFunc mocks the EnableGatewayLoadBalancerGatewayGroup method.
+	EnableGatewayLoadBalancerGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableGlobalVpcFunc mocks the EnableGlobalVpc method.
+	EnableGlobalVpcFunc func(gateway *Gateway) error
+
+	// EnableGlobalVpcGatewayGroupFunc mocks the EnableGlobalVpcGatewayGroup method.
+	EnableGlobalVpcGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableGroGsoFunc mocks the EnableGroGso method.
+	EnableGroGsoFunc func(gateway *Gateway) error
+
+	// EnableGroGsoGatewayGroupFunc mocks the EnableGroGsoGatewayGroup method.
+	EnableGroGsoGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableGuardDutyEnforcementFunc mocks the EnableGuardDutyEnforcement method.
+	EnableGuardDutyEnforcementFunc func(gateway *Gateway) error
+
+	// EnableHybridConnectionGatewayGroupFunc mocks the EnableHybridConnectionGatewayGroup method.
+	EnableHybridConnectionGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableIPv6Func mocks the EnableIPv6 method.
+	EnableIPv6Func func(gateway *Gateway) error
+
+	// EnableIPv6GatewayGroupFunc mocks the EnableIPv6GatewayGroup method.
+	EnableIPv6GatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableJumboFrameFunc mocks the EnableJumboFrame method.
+	EnableJumboFrameFunc func(gateway *Gateway) error
+
+	// EnableJumboFrameExternalDeviceConnFunc mocks the EnableJumboFrameExternalDeviceConn method.
+	EnableJumboFrameExternalDeviceConnFunc func(externalDeviceConn *ExternalDeviceConn) error
+
+	// EnableJumboFrameGatewayGroupFunc mocks the EnableJumboFrameGatewayGroup method.
+	EnableJumboFrameGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableMonitorGatewaySubnetsFunc mocks the EnableMonitorGatewaySubnets method.
+	EnableMonitorGatewaySubnetsFunc func(gwName string, excludedInstances []string) error
+
+	// EnableMultiTierTransitGatewayGroupFunc mocks the EnableMultiTierTransitGatewayGroup method.
+	EnableMultiTierTransitGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableMultitierTransitFunc mocks the EnableMultitierTransit method.
+	EnableMultitierTransitFunc func(gwName string) error
+
+	// EnablePeeringHaGatewayFunc mocks the EnablePeeringHaGateway method.
+	EnablePeeringHaGatewayFunc func(gateway *Gateway) error
+
+	// EnablePrivateVpcDefaultRouteFunc mocks the EnablePrivateVpcDefaultRoute method.
+	EnablePrivateVpcDefaultRouteFunc func(gw *Gateway) error
+
+	// EnablePrivateVpcDefaultRouteGatewayGroupFunc mocks the EnablePrivateVpcDefaultRouteGatewayGroup method.
+	EnablePrivateVpcDefaultRouteGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnablePublicSubnetFilteringHAGatewayFunc mocks the EnablePublicSubnetFilteringHAGateway method.
+	EnablePublicSubnetFilteringHAGatewayFunc func(gateway *Gateway) error
+
+	// EnableS2CRxBalancingFunc mocks the EnableS2CRxBalancing method.
+	EnableS2CRxBalancingFunc func(gwName string) error
+
+	// EnableS2cRxBalancingGatewayGroupFunc mocks the EnableS2cRxBalancingGatewayGroup method.
+	EnableS2cRxBalancingGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableSNatFunc mocks the EnableSNat method.
+	EnableSNatFunc func(gateway *Gateway) error
+
+	// EnableSegmentationFunc mocks the EnableSegmentation method.
+	EnableSegmentationFunc func(transitGateway *TransitVpc) error
+
+	// EnableSegmentationGatewayGroupFunc mocks the EnableSegmentationGatewayGroup method.
+	EnableSegmentationGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableSingleAZGatewayFunc mocks the EnableSingleAZGateway method.
+	EnableSingleAZGatewayFunc func(gateway *Gateway) error
+
+	// EnableSkipPublicRouteUpdateFunc mocks the EnableSkipPublicRouteUpdate method.
+	EnableSkipPublicRouteUpdateFunc func(gw *Gateway) error
+
+	// EnableSkipPublicRouteUpdateGatewayGroupFunc mocks the EnableSkipPublicRouteUpdateGatewayGroup method.
+	EnableSkipPublicRouteUpdateGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableSpokeLearnedCidrsApprovalFunc mocks the EnableSpokeLearnedCidrsApproval method.
+	EnableSpokeLearnedCidrsApprovalFunc func(gateway *SpokeVpc) error
+
+	// EnableSpokeLearnedCidrsApprovalGatewayGroupFunc mocks the EnableSpokeLearnedCidrsApprovalGatewayGroup method.
+	EnableSpokeLearnedCidrsApprovalGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableSpokeOnpremRoutePropagationFunc mocks the EnableSpokeOnpremRoutePropagation method.
+	EnableSpokeOnpremRoutePropagationFunc func(spokeGateway *SpokeVpc) error
+
+	// EnableSpokeOnpremRoutePropagationGatewayGroupFunc mocks the EnableSpokeOnpremRoutePropagationGatewayGroup method.
+	EnableSpokeOnpremRoutePropagationGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableSpokePreserveAsPathFunc mocks the EnableSpokePreserveAsPath method.
+	EnableSpokePreserveAsPathFunc func(spokeGateway *SpokeVpc) error
+
+	// EnableSpokePreserveAsPathGatewayGroupFunc mocks the EnableSpokePreserveAsPathGatewayGroup method.
+	EnableSpokePreserveAsPathGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableSummarizeCidrToTgwFunc mocks the EnableSummarizeCidrToTgw method.
+	EnableSummarizeCidrToTgwFunc func(gwName string) error
+
+	// EnableTransitFireNetFunc mocks the EnableTransitFireNet method.
+	EnableTransitFireNetFunc func(gateway *Gateway) error
+
+	// EnableTransitFireNetGatewayGroupFunc mocks the EnableTransitFireNetGatewayGroup method.
+	EnableTransitFireNetGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableTransitFireNetWithGWLBFunc mocks the EnableTransitFireNetWithGWLB method.
+	EnableTransitFireNetWithGWLBFunc func(gateway *Gateway) error
+
+	// EnableTransitFireNetWithGWLBGatewayGroupFunc mocks the EnableTransitFireNetWithGWLBGatewayGroup method.
+	EnableTransitFireNetWithGWLBGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableTransitLearnedCidrsApprovalFunc mocks the EnableTransitLearnedCidrsApproval method.
+	EnableTransitLearnedCidrsApprovalFunc func(gateway *TransitVpc) error
+
+	// EnableTransitLearnedCidrsApprovalGatewayGroupFunc mocks the EnableTransitLearnedCidrsApprovalGatewayGroup method.
+	EnableTransitLearnedCidrsApprovalGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableTransitPreserveAsPathFunc mocks the EnableTransitPreserveAsPath method.
+	EnableTransitPreserveAsPathFunc func(transitGateway *TransitVpc) error
+
+	// EnableTransitPreserveAsPathGatewayGroupFunc mocks the EnableTransitPreserveAsPathGatewayGroup method.
+	EnableTransitPreserveAsPathGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableTransitSummarizeCidrToTgwGatewayGroupFunc mocks the EnableTransitSummarizeCidrToTgwGatewayGroup method.
+	EnableTransitSummarizeCidrToTgwGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableVPNConfigFunc mocks the EnableVPNConfig method.
+	EnableVPNConfigFunc func(gateway *Gateway, vpnConfig *VPNConfig) error
+
+	// EnableVpcDNSServerFunc mocks the EnableVpcDNSServer method.
+	EnableVpcDNSServerFunc func(gateway *Gateway) error
+
+	// EnableVpnNatFunc mocks the EnableVpnNat method.
+	EnableVpnNatFunc func(gateway *Gateway) error
+
+	// GetBgpLanIPListFunc mocks the GetBgpLanIPList method.
+	GetBgpLanIPListFunc func(transitGateway *TransitVpc) (*TransitGatewayBgpLanIpInfo, error)
+
+	// GetCIDFunc mocks the GetCID method.
+	GetCIDFunc func() string
+
+	// GetControllerIPFunc mocks the GetControllerIP method.
+	GetControllerIPFunc func() string
+
+	// GetDefaultTagsConfigFunc mocks the GetDefaultTagsConfig method.
+	GetDefaultTagsConfigFunc func() *DefaultTagsConfig
+
+	// GetEdgeCSPFunc mocks the GetEdgeCSP method.
+	GetEdgeCSPFunc func(ctx context.Context, gwName string) (*EdgeCSPResp, error)
+
+	// GetEdgeCSPHaFunc mocks the GetEdgeCSPHa method.
+	GetEdgeCSPHaFunc func(ctx context.Context, gwName string) (*EdgeCSPHaResp, error)
+
+	// GetEdgeEquinixFunc mocks the GetEdgeEquinix method.
+	GetEdgeEquinixFunc func(ctx context.Context, gwName string) (*EdgeEquinixResp, error)
+
+	// GetEdgeEquinixHaFunc mocks the GetEdgeEquinixHa method.
+	GetEdgeEquinixHaFunc func(ctx context.Context, gwName string) (*EdgeEquinixHaResp, error)
+
+	// GetEdgeGatewayWanIpFunc mocks the GetEdgeGatewayWanIp method.
+	GetEdgeGatewayWanIpFunc func(ctx context.Context, gwName string, wanInterfaceName string) (string, error)
+
+	// GetEdgeMegaportFunc mocks the GetEdgeMegaport method.
+	GetEdgeMegaportFunc func(ctx context.Context, gwName string) (*EdgeMegaportResp, error)
+
+	// GetEdgeMegaportHaFunc mocks the GetEdgeMegaportHa method.
+	GetEdgeMegaportHaFunc func(ctx context.Context, gwName string) (*EdgeMegaportHaResp, error)
+
+	// GetEdgeNEOFunc mocks the GetEdgeNEO method.
+	GetEdgeNEOFunc func(ctx context.Context, gwName string) (*EdgeNEOResp, error)
+
+	// GetEdgeNEODeviceFunc mocks the GetEdgeNEODevice method.
+	GetEdgeNEODeviceFunc func(ctx context.Context, accountName string, deviceName string) (*EdgeNEODeviceResp, error)
+
+	// GetEdgeNEOHaFunc mocks the GetEdgeNEOHa method.
+	GetEdgeNEOHaFunc func(ctx context.Context, gwName string) (*EdgeNEOHaResp, error)
+
+	// GetEdgePlatformProxyProfileFunc mocks the GetEdgePlatformProxyProfile method.
+	GetEdgePlatformProxyProfileFunc func(ctx context.Context, accountName string, profileName string) (*EdgePlatformProxyProfileResp, error)
+
+	// GetEdgeSpokeFunc mocks the GetEdgeSpoke method.
+	GetEdgeSpokeFunc func(ctx context.Context, gwName string) (*EdgeSpokeResp, error)
+
+	// GetEdgeSpokeTransitAttachmentFunc mocks the GetEdgeSpokeTransitAttachment method.
+	GetEdgeSpokeTransitAttachmentFunc func(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) (*SpokeTransitAttachment, error)
+
+	// GetEdgeVmSelfmanagedHaFunc mocks the GetEdgeVmSelfmanagedHa method.
+	GetEdgeVmSelfmanagedHaFunc func(ctx context.Context, gwName string) (*EdgeVmSelfmanagedHaResp, error)
+
+	// GetExternalDeviceConnDetailFunc mocks the GetExternalDeviceConnDetail method.
+	GetExternalDeviceConnDetailFunc func(externalDeviceConn *ExternalDeviceConn, localGateway *Gateway, priorHAEnabled bool) (*ExternalDeviceConn, error)
+
+	// GetGatewayFunc mocks the GetGateway method.
+	GetGatewayFunc func(gateway *Gateway) (*Gateway, error)
+
+	// GetGatewayBgpCommunitiesFunc mocks the GetGatewayBgpCommunities method.
+	GetGatewayBgpCommunitiesFunc func(gwName string) (bool, bool, error)
+
+	// GetGatewayBgpMedToSdnMetricFunc mocks the GetGatewayBgpMedToSdnMetric method.
+	GetGatewayBgpMedToSdnMetricFunc func(gwName string) (bool, error)
+
+	// GetGatewayDetailFunc mocks the GetGatewayDetail method.
+	GetGatewayDetailFunc func(gateway *Gateway) (*GatewayDetail, error)
+
+	// GetGatewayGroupFunc mocks the GetGatewayGroup method.
+	GetGatewayGroupFunc func(ctx context.Context, groupUUID string) (*GatewayGroup, error)
+
+	// GetGatewayGroupByNameFunc mocks the GetGatewayGroupByName method.
+	GetGatewayGroupByNameFunc func(ctx context.Context, groupName string) (*GatewayGroup, error)
+
+	// GetGeoVPNNameFunc mocks the GetGeoVPNName method.
+	GetGeoVPNNameFunc func(gateway *Gateway) (*GeoVPN, error)
+
+	// GetGroGsoStatusFunc mocks the GetGroGsoStatus method.
+	GetGroGsoStatusFunc func(gateway *Gateway) (bool, error)
+
+	// GetIgnoreTagsConfigFunc mocks the GetIgnoreTagsConfig method.
+	GetIgnoreTagsConfigFunc func() *IgnoreTagsConfig
+
+	// GetSplitTunnelFunc mocks the GetSplitTunnel method.
+	GetSplitTunnelFunc func(splitTunnel *SplitTunnel) (*SplitTunnelUnit, error)
+
+	// GetSpokeGatewayAdvancedConfigFunc mocks the GetSpokeGatewayAdvancedConfig method.
+	GetSpokeGatewayAdvancedConfigFunc func(spokeGateway *SpokeVpc) (*SpokeGatewayAdvancedConfig, error)
+
+	// GetSpokeGatewayListFunc mocks the GetSpokeGatewayList method.
+	GetSpokeGatewayListFunc func(ctx context.Context) ([]Gateway, error)
+
+	// GetSpokeGatewaySubnetGroupFunc mocks the GetSpokeGatewaySubnetGroup method.
+	GetSpokeGatewaySubnetGroupFunc func(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error
+
+	// GetTagsFunc mocks the GetTags method.
+	GetTagsFunc func(tags *Tags) ([]string, error)
+
+	// GetTransitGatewayAdvancedConfigFunc mocks the GetTransitGatewayAdvancedConfig method.
+	GetTransitGatewayAdvancedConfigFunc func(transitGateway *TransitVpc) (*TransitGatewayAdvancedConfig, error)
+
+	// GetTransitGatewayLanCidrFunc mocks the GetTransitGatewayLanCidr method.
+	GetTransitGatewayLanCidrFunc func(gatewayName string) (string, error)
+
+	// GetTransitGatewayListFunc mocks the GetTransitGatewayList method.
+	GetTransitGatewayListFunc func(ctx context.Context) ([]Gateway, error)
+
+	// GetTunnelDetectionTimeFunc mocks the GetTunnelDetectionTime method.
+	GetTunnelDetectionTimeFunc func(entity string) (int, error)
+
+	// IsTransitFireNetReadyToBeDisabledFunc mocks the IsTransitFireNetReadyToBeDisabled method.
+	IsTransitFireNetReadyToBeDisabledFunc func(gateway *Gateway) error
+
+	// LaunchSpokeVpcContextFunc mocks the LaunchSpokeVpcContext method.
+	LaunchSpokeVpcContextFunc func(ctx context.Context, spoke *SpokeVpc) error
+
+	// LaunchTransitVpcContextFunc mocks the LaunchTransitVpcContext method.
+	LaunchTransitVpcContextFunc func(ctx context.Context, gateway *TransitVpc) error
+
+	// ModifySplitTunnelFunc mocks the ModifySplitTunnel method.
+	ModifySplitTunnelFunc func(splitTunnel *SplitTunnel) error
+
+	// ModifyTunnelDetectionTimeFunc mocks the ModifyTunnelDetectionTime method.
+	ModifyTunnelDetectionTimeFunc func(entity string, detectionTime int) error
+
+	// OnboardEdgeNEODeviceFunc mocks the OnboardEdgeNEODevice method.
+	OnboardEdgeNEODeviceFunc func(ctx context.Context, edgeNEODevice *EdgeNEODevice) error
+
+	// PostAPIFunc mocks the PostAPI method.
+	PostAPIFunc func(action string, d interface{}, checkFunc CheckAPIResponseFunc) error
+
+	// SetBgpBfdPollingTimeFunc mocks the SetBgpBfdPollingTime method.
+	SetBgpBfdPollingTimeFunc func(transitGateway *TransitVpc, newPollingTime int) error
+
+	// SetBgpBfdPollingTimeGatewayGroupFunc mocks the SetBgpBfdPollingTimeGatewayGroup method.
+	SetBgpBfdPollingTimeGatewayGroupFunc func(ctx context.Context, groupName string, pollingTime int) error
+
+	// SetBgpBfdPollingTimeSpokeFunc mocks the SetBgpBfdPollingTimeSpoke method.
+	SetBgpBfdPollingTimeSpokeFunc func(spokeGateway *SpokeVpc, newPollingTime int) error
+
+	// SetBgpEcmpFunc mocks the SetBgpEcmp method.
+	SetBgpEcmpFunc func(transitGateway *TransitVpc, enabled bool) error
+
+	// SetBgpEcmpGatewayGroupFunc mocks the SetBgpEcmpGatewayGroup method.
+	SetBgpEcmpGatewayGroupFunc func(ctx context.Context, groupName string, enable bool) error
+
+	// SetBgpEcmpSpokeFunc mocks the SetBgpEcmpSpoke method.
+	SetBgpEcmpSpokeFunc func(spokeGateway *SpokeVpc, enabled bool) error
+
+	// SetBgpManualSpokeAdvertisedNetworksFunc mocks the SetBgpManualSpokeAdvertisedNetworks method.
+	SetBgpManualSpokeAdvertisedNetworksFunc func(transitGw *TransitVpc) error
+
+	// SetBgpPollingTimeFunc mocks the SetBgpPollingTime method.
+	SetBgpPollingTimeFunc func(transitGateway *TransitVpc, newPollingTime int) error
+
+	// SetBgpPollingTimeGatewayGroupFunc mocks the SetBgpPollingTimeGatewayGroup method.
+	SetBgpPollingTimeGatewayGroupFunc func(ctx context.Context, groupName string, pollingTime int) error
+
+	// SetBgpPollingTimeSpokeFunc mocks the SetBgpPollingTimeSpoke method.
+	SetBgpPollingTimeSpokeFunc func(spokeGateway *SpokeVpc, newPollingTime int) error
+
+	// SetGatewayBgpCommunitiesAcceptFunc mocks the SetGatewayBgpCommunitiesAccept method.
+	SetGatewayBgpCommunitiesAcceptFunc func(gwName string, acceptComm bool) error
+
+	// SetGatewayBgpCommunitiesSendFunc mocks the SetGatewayBgpCommunitiesSend method.
+	SetGatewayBgpCommunitiesSendFunc func(gwName string, sendComm bool) error
+
+	// SetGatewayBgpMedToSdnMetricFunc mocks the SetGatewayBgpMedToSdnMetric method.
+	SetGatewayBgpMedToSdnMetricFunc func(gwName string, override bool) error
+
+	// SetGatewayGroupBgpCommunitiesAcceptFunc mocks the SetGatewayGroupBgpCommunitiesAccept method.
+	SetGatewayGroupBgpCommunitiesAcceptFunc func(ctx context.Context, groupName string, accept bool) error
+
+	// SetGatewayGroupBgpCommunitiesSendFunc mocks the SetGatewayGroupBgpCommunitiesSend method.
+	SetGatewayGroupBgpCommunitiesSendFunc func(ctx context.Context, groupName string, send bool) error
+
+	// SetGatewayPhase2PolicyFunc mocks the SetGatewayPhase2Policy method.
+	SetGatewayPhase2PolicyFunc func(gwName string, encPolicy string, pfsPolicy string) error
+
+	// SetLocalASNumberFunc mocks the SetLocalASNumber method.
+	SetLocalASNumberFunc func(transitGateway *TransitVpc, localASNumber string) error
+
+	// SetLocalASNumberGatewayGroupFunc mocks the SetLocalASNumberGatewayGroup method.
+	SetLocalASNumberGatewayGroupFunc func(ctx context.Context, groupName string, localAsNumber string) error
+
+	// SetLocalASNumberSpokeFunc mocks the SetLocalASNumberSpoke method.
+	SetLocalASNumberSpokeFunc func(spokeGateway *SpokeVpc, localASNumber string) error
+
+	// SetPrependASPathFunc mocks the SetPrependASPath method.
+	SetPrependASPathFunc func(transitGateway *TransitVpc, prependASPath []string) error
+
+	// SetPrependASPathGatewayGroupFunc mocks the SetPrependASPathGatewayGroup method.
+	SetPrependASPathGatewayGroupFunc func(ctx context.Context, groupName string, prependAsPath []string) error
+
+	// SetPrependASPathSpokeFunc mocks the SetPrependASPathSpoke method.
+	SetPrependASPathSpokeFunc func(spokeGateway *SpokeVpc, prependASPath []string) error
+
+	// SetRxQueueSizeFunc mocks the SetRxQueueSize method.
+	SetRxQueueSizeFunc func(gateway *Gateway) error
+
+	// SetSpokeBgpManualAdvertisedNetworksFunc mocks the SetSpokeBgpManualAdvertisedNetworks method.
+	SetSpokeBgpManualAdvertisedNetworksFunc func(spokeGateway *SpokeVpc) error
+
+	// SetSpokeBgpManualAdvertisedNetworksGatewayGroupFunc mocks the SetSpokeBgpManualAdvertisedNetworksGatewayGroup method.
+	SetSpokeBgpManualAdvertisedNetworksGatewayGroupFunc func(ctx context.Context, groupName string, cidrs string) error
+
+	// SetSymmetricRoutingGatewayGroupFunc mocks the SetSymmetricRoutingGatewayGroup method.
+	SetSymmetricRoutingGatewayGroupFunc func(ctx context.Context, groupUUID string, enable bool) error
+
+	// SetTransitLearnedCIDRsApprovalModeFunc mocks the SetTransitLearnedCIDRsApprovalMode method.
+	SetTransitLearnedCIDRsApprovalModeFunc func(gw *TransitVpc, mode string) error
+
+	// SetVpnGatewayAuthenticationFunc mocks the SetVpnGatewayAuthentication method.
+	SetVpnGatewayAuthenticationFunc func(gateway *VpnGatewayAuth) error
+
+	// UpdateDNatFunc mocks the UpdateDNat method.
+	UpdateDNatFunc func(gateway *Gateway) error
+
+	// UpdateEdgeCSPFunc mocks the UpdateEdgeCSP method.
+	UpdateEdgeCSPFunc func(ctx context.Context, edgeCSP *EdgeCSP) error
+
+	// UpdateEdgeCSPHaFunc mocks the UpdateEdgeCSPHa method.
+	UpdateEdgeCSPHaFunc func(ctx context.Context, edgeCSP *EdgeCSP) error
+
+	// UpdateEdgeEquinixFunc mocks the UpdateEdgeEquinix method.
+	UpdateEdgeEquinixFunc func(ctx context.Context, edgeEquinix *EdgeEquinix) error
+
+	// UpdateEdgeEquinixHaFunc mocks the UpdateEdgeEquinixHa method.
+	UpdateEdgeEquinixHaFunc func(ctx context.Context, edgeEquinix *EdgeEquinix) error
+
+	// UpdateEdgeGatewayFunc mocks the UpdateEdgeGateway method.
+	UpdateEdgeGatewayFunc func(gateway *TransitVpc) error
+
+	// UpdateEdgeGatewayV2Func mocks the UpdateEdgeGatewayV2 method.
+	UpdateEdgeGatewayV2Func func(ctx context.Context, gateway *TransitVpc) error
+
+	// UpdateEdgeMegaportFunc mocks the UpdateEdgeMegaport method.
+	UpdateEdgeMegaportFunc func(ctx context.Context, edgeMegaport *EdgeMegaport) error
+
+	// UpdateEdgeMegaportHaFunc mocks the UpdateEdgeMegaportHa method.
+	UpdateEdgeMegaportHaFunc func(ctx context.Context, edgeMegaport *EdgeMegaport) error
+
+	// UpdateEdgeNEOFunc mocks the UpdateEdgeNEO method.
+	UpdateEdgeNEOFunc func(ctx context.Context, edgeNEO *EdgeNEO) error
+
+	// UpdateEdgeNEODeviceFunc mocks the UpdateEdgeNEODevice method.
+	UpdateEdgeNEODeviceFunc func(ctx context.Context, edgeNEODevice *EdgeNEODevice) error
+
+	// UpdateEdgeNEOHaFunc mocks the UpdateEdgeNEOHa method.
+	UpdateEdgeNEOHaFunc func(ctx context.Context, edgeNEO *EdgeNEO) error
+
+	// UpdateEdgeProxyProfileFunc mocks the UpdateEdgeProxyProfile method.
+	UpdateEdgeProxyProfileFunc func(ctx context.Context, edgeNEOProxyProfile *EdgePlatformProxyProfileUpdate) error
+
+	// UpdateEdgeSpokeFunc mocks the UpdateEdgeSpoke method.
+	UpdateEdgeSpokeFunc func(ctx context.Context, edgeSpoke *EdgeSpoke) error
+
+	// UpdateEdgeSpokeGeoCoordinateFunc mocks the UpdateEdgeSpokeGeoCoordinate method.
+	UpdateEdgeSpokeGeoCoordinateFunc func(ctx context.Context, edgeSpoke *EdgeSpoke) error
+
+	// UpdateEdgeSpokeTransitPeeringTunnelCountFunc mocks the UpdateEdgeSpokeTransitPeeringTunnelCount method.
+	UpdateEdgeSpokeTransitPeeringTunnelCountFunc func(gateway1 string, gateway2 string, tunnelCount int) error
+
+	// UpdateEdgeVmSelfmanagedHaFunc mocks the UpdateEdgeVmSelfmanagedHa method.
+	UpdateEdgeVmSelfmanagedHaFunc func(ctx context.Context, edgeVmSelfmanaged *EdgeSpoke) error
+
+	// UpdateGatewayFunc mocks the UpdateGateway method.
+	UpdateGatewayFunc func(gateway *Gateway) error
+
+	// UpdateGatewayContextFunc mocks the UpdateGatewayContext method.
+	UpdateGatewayContextFunc func(ctx context.Context, gateway *Gateway) error
+
+	// UpdateGroupInstanceSizeFunc mocks the UpdateGroupInstanceSize method.
+	UpdateGroupInstanceSizeFunc func(ctx context.Context, groupName string, instanceSize string) error
+
+	// UpdateMaxVpnConnFunc mocks the UpdateMaxVpnConn method.
+	UpdateMaxVpnConnFunc func(gateway *Gateway) error
+
+	// UpdateSpokeGatewaySubnetGroupFunc mocks the UpdateSpokeGatewaySubnetGroup method.
+	UpdateSpokeGatewaySubnetGroupFunc func(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error
+
+	// UpdateSpokePendingApprovedCidrsFunc mocks the UpdateSpokePendingApprovedCidrs method.
+	UpdateSpokePendingApprovedCidrsFunc func(gateway *SpokeVpc) error
+
+	// UpdateSpokePendingApprovedCidrsGatewayGroupFunc mocks the UpdateSpokePendingApprovedCidrsGatewayGroup method.
+	UpdateSpokePendingApprovedCidrsGatewayGroupFunc func(ctx context.Context, groupName string, approvedCidrs []string) error
+
+	// UpdateTagsFunc mocks the UpdateTags method.
+	UpdateTagsFunc func(tags *Tags) error
+
+	// UpdateTransitGatewayCustomizedVpcRouteFunc mocks the UpdateTransitGatewayCustomizedVpcRoute method.
+	UpdateTransitGatewayCustomizedVpcRouteFunc func(gateway string, customizedTransitVpcRoutes []string) error
+
+	// UpdateTransitPendingApprovedCidrsFunc mocks the UpdateTransitPendingApprovedCidrs method.
+	UpdateTransitPendingApprovedCidrsFunc func(gateway *TransitVpc) error
+
+	// UpdateTransitPendingApprovedCidrsGatewayGroupFunc mocks the UpdateTransitPendingApprovedCidrsGatewayGroup method.
+	UpdateTransitPendingApprovedCidrsGatewayGroupFunc func(ctx context.Context, groupName string, approvedCidrs []string) error
+
+	// UpdateVpnCidrFunc mocks the UpdateVpnCidr method.
+	UpdateVpnCidrFunc func(gateway *Gateway) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddSpokeGatewaySubnetGroup holds details about calls to the AddSpokeGatewaySubnetGroup method.
+		AddSpokeGatewaySubnetGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpokeGatewaySubnetGroup is the spokeGatewaySubnetGroup argument value.
+			SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+		}
+		// AttachTransitGWForHybrid holds details about calls to the AttachTransitGWForHybrid method.
+		AttachTransitGWForHybrid []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// ChangeBgpHoldTime holds details about calls to the ChangeBgpHoldTime method.
+		ChangeBgpHoldTime []struct {
+			// GwName is the gwName argument value.
+			GwName string
+			// HoldTime is the holdTime argument value.
+			HoldTime int
+		}
+		// ChangeBgpHoldTimeGatewayGroup holds details about calls to the ChangeBgpHoldTimeGatewayGroup method.
+		ChangeBgpHoldTimeGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// HoldTime is the holdTime argument value.
+			HoldTime int
+		}
+		// ChangeBgpOverLanIntfCnt holds details about calls to the ChangeBgpOverLanIntfCnt method.
+		ChangeBgpOverLanIntfCnt []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// CreateEdgeCSP holds details about calls to the CreateEdgeCSP method.
+		CreateEdgeCSP []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeCSP is the edgeCSP argument value.
+			EdgeCSP *EdgeCSP
+		}
+		// CreateEdgeCSPHa holds details about calls to the CreateEdgeCSPHa method.
+		CreateEdgeCSPHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeCSPHa is the edgeCSPHa argument value.
+			EdgeCSPHa *EdgeCSPHa
+		}
+		// CreateEdgeEquinix holds details about calls to the CreateEdgeEquinix method.
+		CreateEdgeEquinix []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeEquinix is the edgeEquinix argument value.
+			EdgeEquinix *EdgeEquinix
+		}
+		// CreateEdgeEquinixHa holds details about calls to the CreateEdgeEquinixHa method.
+		CreateEdgeEquinixHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeEquinixHa is the edgeEquinixHa argument value.
+			EdgeEquinixHa *EdgeEquinixHa
+		}
+		// CreateEdgeExternalDeviceConn holds details about calls to the CreateEdgeExternalDeviceConn method.
+		CreateEdgeExternalDeviceConn []struct {
+			// EdgeExternalDeviceConn is the edgeExternalDeviceConn argument value.
+			EdgeExternalDeviceConn *EdgeExternalDeviceConn
+		}
+		// CreateEdgeMegaport holds details about calls to the CreateEdgeMegaport method.
+		CreateEdgeMegaport []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeMegaport is the edgeMegaport argument value.
+			EdgeMegaport *EdgeMegaport
+		}
+		// CreateEdgeMegaportHa holds details about calls to the CreateEdgeMegaportHa method.
+		CreateEdgeMegaportHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeMegaportHa is the edgeMegaportHa argument value.
+			EdgeMegaportHa *EdgeMegaportHa
+		}
+		// CreateEdgeNEO holds details about calls to the CreateEdgeNEO method.
+		CreateEdgeNEO []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeNEO is the edgeNEO argument value.
+			EdgeNEO *EdgeNEO
+		}
+		// CreateEdgeNEOHa holds details about calls to the CreateEdgeNEOHa method.
+		CreateEdgeNEOHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeNEOHa is the edgeNEOHa argument value.
+			EdgeNEOHa *EdgeNEOHa
+		}
+		// CreateEdgeProxyProfile holds details about calls to the CreateEdgeProxyProfile method.
+		CreateEdgeProxyProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeNEOProxyProfile is the edgeNEOProxyProfile argument value.
+			EdgeNEOProxyProfile *EdgePlatformProxyProfile
+		}
+		// CreateEdgeSpoke holds details about calls to the CreateEdgeSpoke method.
+		CreateEdgeSpoke []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeSpoke is the edgeSpoke argument value.
+			EdgeSpoke *EdgeSpoke
+		}
+		// CreateEdgeVmSelfmanagedHa holds details about calls to the CreateEdgeVmSelfmanagedHa method.
+		CreateEdgeVmSelfmanagedHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeVmSelfmanagedHa is the edgeVmSelfmanagedHa argument value.
+			EdgeVmSelfmanagedHa *EdgeVmSelfmanagedHa
+		}
+		// CreateExternalDeviceConn holds details about calls to the CreateExternalDeviceConn method.
+		CreateExternalDeviceConn []struct {
+			// ExternalDeviceConn is the externalDeviceConn argument value.
+			ExternalDeviceConn *ExternalDeviceConn
+		}
+		// CreateGatewayContext holds details about calls to the CreateGatewayContext method.
+		CreateGatewayContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// CreateGatewayGroup holds details about calls to the CreateGatewayGroup method.
+		CreateGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpokeGroup is the spokeGroup argument value.
+			SpokeGroup *GatewayGroup
+		}
+		// CreatePublicSubnetFilteringGatewayContext holds details about calls to the CreatePublicSubnetFilteringGatewayContext method.
+		CreatePublicSubnetFilteringGatewayContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// CreateSpokeHaGw holds details about calls to the CreateSpokeHaGw method.
+		CreateSpokeHaGw []struct {
+			// SpokeHaGateway is the spokeHaGateway argument value.
+			SpokeHaGateway *SpokeHaGateway
+		}
+		// CreateSpokeHaGwContext holds details about calls to the CreateSpokeHaGwContext method.
+		CreateSpokeHaGwContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpokeHaGateway is the spokeHaGateway argument value.
+			SpokeHaGateway *SpokeHaGateway
+		}
+		// CreateSpokeTransitAttachment holds details about calls to the CreateSpokeTransitAttachment method.
+		CreateSpokeTransitAttachment []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpokeTransitAttachment is the spokeTransitAttachment argument value.
+			SpokeTransitAttachment *SpokeTransitAttachment
+		}
+		// CreateTransitHaGwContext holds details about calls to the CreateTransitHaGwContext method.
+		CreateTransitHaGwContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TransitHaGateway is the transitHaGateway argument value.
+			TransitHaGateway *TransitHaGateway
+		}
+		// DeleteEdgeCSP holds details about calls to the DeleteEdgeCSP method.
+		DeleteEdgeCSP []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AccountName is the accountName argument value.
+			AccountName string
+			// Name is the name argument value.
+			Name string
+		}
+		// DeleteEdgeEquinix holds details about calls to the DeleteEdgeEquinix method.
+		DeleteEdgeEquinix []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AccountName is the accountName argument value.
+			AccountName string
+			// Name is the name argument value.
+			Name string
+		}
+		// DeleteEdgeExternalDeviceConn holds details about calls to the DeleteEdgeExternalDeviceConn method.
+		DeleteEdgeExternalDeviceConn []struct {
+			// EdgeExternalDeviceConn is the edgeExternalDeviceConn argument value.
+			EdgeExternalDeviceConn *EdgeExternalDeviceConn
+		}
+		// DeleteEdgeMegaport holds details about calls to the DeleteEdgeMegaport method.
+		DeleteEdgeMegaport []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AccountName is the accountName argument value.
+			AccountName string
+			// Name is the name argument value.
+			Name string
+		}
+		// DeleteEdgeNEO holds details about calls to the DeleteEdgeNEO method.
+		DeleteEdgeNEO []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AccountName is the accountName argument value.
+			AccountName string
+			// Name is the name argument value.
+			Name string
+		}
+		// DeleteEdgeNEODevice holds details about calls to the DeleteEdgeNEODevice method.
+		DeleteEdgeNEODevice []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AccountName is the accountName argument value.
+			AccountName string
+			// SerialNumber is the serialNumber argument value.
+			SerialNumber string
+		}
+		// DeleteEdgePlatformProxyProfile holds details about calls to the DeleteEdgePlatformProxyProfile method.
+		DeleteEdgePlatformProxyProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AccountName is the accountName argument value.
+			AccountName string
+			// ProfileName is the profileName argument value.
+			ProfileName string
+		}
+		// DeleteEdgeSpoke holds details about calls to the DeleteEdgeSpoke method.
+		DeleteEdgeSpoke []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+		}
+		// DeleteExternalDeviceConn holds details about calls to the DeleteExternalDeviceConn method.
+		DeleteExternalDeviceConn []struct {
+			// ExternalDeviceConn is the externalDeviceConn argument value.
+			ExternalDeviceConn *ExternalDeviceConn
+		}
+		// DeleteGateway holds details about calls to the DeleteGateway method.
+		DeleteGateway []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DeleteGatewayContext holds details about calls to the DeleteGatewayContext method.
+		DeleteGatewayContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DeleteGatewayGroup holds details about calls to the DeleteGatewayGroup method.
+		DeleteGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupUUID is the groupUUID argument value.
+			GroupUUID string
+		}
+		// DeletePublicSubnetFilteringGatewayContext holds details about calls to the DeletePublicSubnetFilteringGatewayContext method.
+		DeletePublicSubnetFilteringGatewayContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DeleteSpokeGatewaySubnetGroup holds details about calls to the DeleteSpokeGatewaySubnetGroup method.
+		DeleteSpokeGatewaySubnetGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpokeGatewaySubnetGroup is the spokeGatewaySubnetGroup argument value.
+			SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+		}
+		// DeleteSpokeTransitAttachment holds details about calls to the DeleteSpokeTransitAttachment method.
+		DeleteSpokeTransitAttachment []struct {
+			// SpokeTransitAttachment is the spokeTransitAttachment argument value.
+			SpokeTransitAttachment *SpokeTransitAttachment
+		}
+		// DetachTransitGWForHybrid holds details about calls to the DetachTransitGWForHybrid method.
+		DetachTransitGWForHybrid []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// DisableActiveStandby holds details about calls to the DisableActiveStandby method.
+		DisableActiveStandby []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// DisableActiveStandbyGatewayGroup holds details about calls to the DisableActiveStandbyGatewayGroup method.
+		DisableActiveStandbyGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableActiveStandbySpoke holds details about calls to the DisableActiveStandbySpoke method.
+		DisableActiveStandbySpoke []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+		}
+		// DisableAdvertiseTransitCidr holds details about calls to the DisableAdvertiseTransitCidr method.
+		DisableAdvertiseTransitCidr []struct {
+			// TransitGw is the transitGw argument value.
+			TransitGw *TransitVpc
+		}
+		// DisableAdvertiseTransitCidrGatewayGroup holds details about calls to the DisableAdvertiseTransitCidrGatewayGroup method.
+		DisableAdvertiseTransitCidrGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableAutoAdvertiseS2CCidrs holds details about calls to the DisableAutoAdvertiseS2CCidrs method.
+		DisableAutoAdvertiseS2CCidrs []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableAutoAdvertiseS2CCidrsGatewayGroup holds details about calls to the DisableAutoAdvertiseS2CCidrsGatewayGroup method.
+		DisableAutoAdvertiseS2CCidrsGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableConnectedTransit holds details about calls to the DisableConnectedTransit method.
+		DisableConnectedTransit []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// DisableConnectedTransitGatewayGroup holds details about calls to the DisableConnectedTransitGatewayGroup method.
+		DisableConnectedTransitGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableCustomSNat holds details about calls to the DisableCustomSNat method.
+		DisableCustomSNat []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableEdgeSpokeTransitiveRouting holds details about calls to the DisableEdgeSpokeTransitiveRouting method.
+		DisableEdgeSpokeTransitiveRouting []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+		}
+		// DisableEgressTransitFirenet holds details about calls to the DisableEgressTransitFirenet method.
+		DisableEgressTransitFirenet []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// DisableFireNetGatewayGroup holds details about calls to the DisableFireNetGatewayGroup method.
+		DisableFireNetGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableGatewayFireNetInterfaces holds details about calls to the DisableGatewayFireNetInterfaces method.
+		DisableGatewayFireNetInterfaces []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// DisableGatewayGroupSNat holds details about calls to the DisableGatewayGroupSNat method.
+		DisableGatewayGroupSNat []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableGatewayGroupVpcDNSServer holds details about calls to the DisableGatewayGroupVpcDNSServer method.
+		DisableGatewayGroupVpcDNSServer []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableGatewayLoadBalancerGatewayGroup holds details about calls to the DisableGatewayLoadBalancerGatewayGroup method.
+		DisableGatewayLoadBalancerGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableGlobalVpc holds details about calls to the DisableGlobalVpc method.
+		DisableGlobalVpc []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableGlobalVpcGatewayGroup holds details about calls to the DisableGlobalVpcGatewayGroup method.
+		DisableGlobalVpcGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableGroGso holds details about calls to the DisableGroGso method.
+		DisableGroGso []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableGroGsoGatewayGroup holds details about calls to the DisableGroGsoGatewayGroup method.
+		DisableGroGsoGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableGuardDutyEnforcement holds details about calls to the DisableGuardDutyEnforcement method.
+		DisableGuardDutyEnforcement []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableHybridConnectionGatewayGroup holds details about calls to the DisableHybridConnectionGatewayGroup method.
+		DisableHybridConnectionGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableIPv6 holds details about calls to the DisableIPv6 method.
+		DisableIPv6 []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableIPv6GatewayGroup holds details about calls to the DisableIPv6GatewayGroup method.
+		DisableIPv6GatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableJumboFrame holds details about calls to the DisableJumboFrame method.
+		DisableJumboFrame []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableJumboFrameExternalDeviceConn holds details about calls to the DisableJumboFrameExternalDeviceConn method.
+		DisableJumboFrameExternalDeviceConn []struct {
+			// ExternalDeviceConn is the externalDeviceConn argument value.
+			ExternalDeviceConn *ExternalDeviceConn
+		}
+		// DisableJumboFrameGatewayGroup holds details about calls to the DisableJumboFrameGatewayGroup method.
+		DisableJumboFrameGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableMonitorGatewaySubnets holds details about calls to the DisableMonitorGatewaySubnets method.
+		DisableMonitorGatewaySubnets []struct {
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// DisableMultiTierTransitGatewayGroup holds details about calls to the DisableMultiTierTransitGatewayGroup method.
+		DisableMultiTierTransitGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableMultitierTransit holds details about calls to the DisableMultitierTransit method.
+		DisableMultitierTransit []struct {
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// DisablePrivateVpcDefaultRoute holds details about calls to the DisablePrivateVpcDefaultRoute method.
+		DisablePrivateVpcDefaultRoute []struct {
+			// Gw is the gw argument value.
+			Gw *Gateway
+		}
+		// DisablePrivateVpcDefaultRouteGatewayGroup holds details about calls to the DisablePrivateVpcDefaultRouteGatewayGroup method.
+		DisablePrivateVpcDefaultRouteGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableS2CRxBalancing holds details about calls to the DisableS2CRxBalancing method.
+		DisableS2CRxBalancing []struct {
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// DisableS2cRxBalancingGatewayGroup holds details about calls to the DisableS2cRxBalancingGatewayGroup method.
+		DisableS2cRxBalancingGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableSNat holds details about calls to the DisableSNat method.
+		DisableSNat []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableSegmentation holds details about calls to the DisableSegmentation method.
+		DisableSegmentation []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// DisableSegmentationGatewayGroup holds details about calls to the DisableSegmentationGatewayGroup method.
+		DisableSegmentationGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableSingleAZGateway holds details about calls to the DisableSingleAZGateway method.
+		DisableSingleAZGateway []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableSkipPublicRouteUpdate holds details about calls to the DisableSkipPublicRouteUpdate method.
+		DisableSkipPublicRouteUpdate []struct {
+			// Gw is the gw argument value.
+			Gw *Gateway
+		}
+		// DisableSkipPublicRouteUpdateGatewayGroup holds details about calls to the DisableSkipPublicRouteUpdateGatewayGroup method.
+		DisableSkipPublicRouteUpdateGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableSpokeLearnedCidrsApproval holds details about calls to the DisableSpokeLearnedCidrsApproval method.
+		DisableSpokeLearnedCidrsApproval []struct {
+			// Gateway is the gateway argument value.
+			Gateway *SpokeVpc
+		}
+		// DisableSpokeLearnedCidrsApprovalGatewayGroup holds details about calls to the DisableSpokeLearnedCidrsApprovalGatewayGroup method.
+		DisableSpokeLearnedCidrsApprovalGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableSpokeOnpremRoutePropagation holds details about calls to the DisableSpokeOnpremRoutePropagation method.
+		DisableSpokeOnpremRoutePropagation []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+		}
+		// DisableSpokeOnpremRoutePropagationGatewayGroup holds details about calls to the DisableSpokeOnpremRoutePropagationGatewayGroup method.
+		DisableSpokeOnpremRoutePropagationGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableSpokePreserveAsPath holds details about calls to the DisableSpokePreserveAsPath method.
+		DisableSpokePreserveAsPath []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+		}
+		// DisableSpokePreserveAsPathGatewayGroup holds details about calls to the DisableSpokePreserveAsPathGatewayGroup method.
+		DisableSpokePreserveAsPathGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableSummarizeCidrToTgw holds details about calls to the DisableSummarizeCidrToTgw method.
+		DisableSummarizeCidrToTgw []struct {
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// DisableTransitFireNet holds details about calls to the DisableTransitFireNet method.
+		DisableTransitFireNet []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableTransitFireNetGatewayGroup holds details about calls to the DisableTransitFireNetGatewayGroup method.
+		DisableTransitFireNetGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableTransitLearnedCidrsApproval holds details about calls to the DisableTransitLearnedCidrsApproval method.
+		DisableTransitLearnedCidrsApproval []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// DisableTransitLearnedCidrsApprovalGatewayGroup holds details about calls to the DisableTransitLearnedCidrsApprovalGatewayGroup method.
+		DisableTransitLearnedCidrsApprovalGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableTransitPreserveAsPath holds details about calls to the DisableTransitPreserveAsPath method.
+		DisableTransitPreserveAsPath []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// DisableTransitPreserveAsPathGatewayGroup holds details about calls to the DisableTransitPreserveAsPathGatewayGroup method.
+		DisableTransitPreserveAsPathGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableTransitSummarizeCidrToTgwGatewayGroup holds details about calls to the DisableTransitSummarizeCidrToTgwGatewayGroup method.
+		DisableTransitSummarizeCidrToTgwGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableVPNConfig holds details about calls to the DisableVPNConfig method.
+		DisableVPNConfig []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+			// VpnConfig is the vpnConfig argument value.
+			VpnConfig *VPNConfig
+		}
+		// DisableVpcDNSServer holds details about calls to the DisableVpcDNSServer method.
+		DisableVpcDNSServer []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableVpnNat holds details about calls to the DisableVpnNat method.
+		DisableVpnNat []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DownloadEdgeNEOConfigFile holds details about calls to the DownloadEdgeNEOConfigFile method.
+		DownloadEdgeNEOConfigFile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeNEODevice is the edgeNEODevice argument value.
+			EdgeNEODevice *EdgeNEODevice
+		}
+		// EditBgpMd5Key holds details about calls to the EditBgpMd5Key method.
+		EditBgpMd5Key []struct {
+			// EditBgpMd5Key is the editBgpMd5Key argument value.
+			EditBgpMd5Key *EditBgpMd5Key
+		}
+		// EditConnectionBgpBfd holds details about calls to the EditConnectionBgpBfd method.
+		EditConnectionBgpBfd []struct {
+			// ExternalDeviceConn is the externalDeviceConn argument value.
+			ExternalDeviceConn *ExternalDeviceConn
+		}
+		// EditConnectionBgpMultihop holds details about calls to the EditConnectionBgpMultihop method.
+		EditConnectionBgpMultihop []struct {
+			// ExternalDeviceConn is the externalDeviceConn argument value.
+			ExternalDeviceConn *ExternalDeviceConn
+		}
+		// EditDesignatedGateway holds details about calls to the EditDesignatedGateway method.
+		EditDesignatedGateway []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EditGatewayAdvertisedCidr holds details about calls to the EditGatewayAdvertisedCidr method.
+		EditGatewayAdvertisedCidr []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EditGatewayCustomRoutes holds details about calls to the EditGatewayCustomRoutes method.
+		EditGatewayCustomRoutes []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EditGatewayFilterRoutes holds details about calls to the EditGatewayFilterRoutes method.
+		EditGatewayFilterRoutes []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EditManagedRouteTables holds details about calls to the EditManagedRouteTables method.
+		EditManagedRouteTables []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+			// RouteTables is the routeTables argument value.
+			RouteTables []string
+		}
+		// EditManagedRouteTablesForGatewayGroup holds details about calls to the EditManagedRouteTablesForGatewayGroup method.
+		EditManagedRouteTablesForGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// RouteTables is the routeTables argument value.
+			RouteTables []string
+		}
+		// EditPrivateRouteTableConfig holds details about calls to the EditPrivateRouteTableConfig method.
+		EditPrivateRouteTableConfig []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+			// RouteTables is the routeTables argument value.
+			RouteTables []string
+		}
+		// EditPrivateRouteTableConfigForGatewayGroup holds details about calls to the EditPrivateRouteTableConfigForGatewayGroup method.
+		EditPrivateRouteTableConfigForGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// RouteTables is the routeTables argument value.
+			RouteTables []string
+		}
+		// EditPublicSubnetFilteringRouteTableList holds details about calls to the EditPublicSubnetFilteringRouteTableList method.
+		EditPublicSubnetFilteringRouteTableList []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+			// RouteTables is the routeTables argument value.
+			RouteTables []string
+		}
+		// EditSpokeExternalDeviceConnASPathPrepend holds details about calls to the EditSpokeExternalDeviceConnASPathPrepend method.
+		EditSpokeExternalDeviceConnASPathPrepend []struct {
+			// ExternalDeviceConn is the externalDeviceConn argument value.
+			ExternalDeviceConn *ExternalDeviceConn
+			// PrependASPath is the prependASPath argument value.
+			PrependASPath []string
+		}
+		// EditSpokeTransitAttachmentFilters holds details about calls to the EditSpokeTransitAttachmentFilters method.
+		EditSpokeTransitAttachmentFilters []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpokeTransitAttachment is the spokeTransitAttachment argument value.
+			SpokeTransitAttachment *SpokeTransitAttachment
+		}
+		// EditTransitConnectionASPathPrepend holds details about calls to the EditTransitConnectionASPathPrepend method.
+		EditTransitConnectionASPathPrepend []struct {
+			// TransitGatewayPeering is the transitGatewayPeering argument value.
+			TransitGatewayPeering *TransitGatewayPeering
+			// PrependASPath is the prependASPath argument value.
+			PrependASPath []string
+		}
+		// EditTransitConnectionBGPManualAdvertiseCIDRs holds details about calls to the EditTransitConnectionBGPManualAdvertiseCIDRs method.
+		EditTransitConnectionBGPManualAdvertiseCIDRs []struct {
+			// GwName is the gwName argument value.
+			GwName string
+			// ConnName is the connName argument value.
+			ConnName string
+			// Cidrs is the cidrs argument value.
+			Cidrs []string
+		}
+		// EnableActiveStandby holds details about calls to the EnableActiveStandby method.
+		EnableActiveStandby []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// EnableActiveStandbyGatewayGroup holds details about calls to the EnableActiveStandbyGatewayGroup method.
+		EnableActiveStandbyGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableActiveStandbyPreemptive holds details about calls to the EnableActiveStandbyPreemptive method.
+		EnableActiveStandbyPreemptive []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// EnableActiveStandbyPreemptiveGatewayGroup holds details about calls to the EnableActiveStandbyPreemptiveGatewayGroup method.
+		EnableActiveStandbyPreemptiveGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableActiveStandbyPreemptiveSpoke holds details about calls to the EnableActiveStandbyPreemptiveSpoke method.
+		EnableActiveStandbyPreemptiveSpoke []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+		}
+		// EnableActiveStandbySpoke holds details about calls to the EnableActiveStandbySpoke method.
+		EnableActiveStandbySpoke []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+		}
+		// EnableAdvertiseTransitCidr holds details about calls to the EnableAdvertiseTransitCidr method.
+		EnableAdvertiseTransitCidr []struct {
+			// TransitGw is the transitGw argument value.
+			TransitGw *TransitVpc
+		}
+		// EnableAdvertiseTransitCidrGatewayGroup holds details about calls to the EnableAdvertiseTransitCidrGatewayGroup method.
+		EnableAdvertiseTransitCidrGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableAutoAdvertiseS2CCidrs holds details about calls to the EnableAutoAdvertiseS2CCidrs method.
+		EnableAutoAdvertiseS2CCidrs []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableAutoAdvertiseS2CCidrsGatewayGroup holds details about calls to the EnableAutoAdvertiseS2CCidrsGatewayGroup method.
+		EnableAutoAdvertiseS2CCidrsGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableConnectedTransit holds details about calls to the EnableConnectedTransit method.
+		EnableConnectedTransit []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// EnableConnectedTransitGatewayGroup holds details about calls to the EnableConnectedTransitGatewayGroup method.
+		EnableConnectedTransitGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableCustomizedSNat holds details about calls to the EnableCustomizedSNat method.
+		EnableCustomizedSNat []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableEdgeSpokeTransitiveRouting holds details about calls to the EnableEdgeSpokeTransitiveRouting method.
+		EnableEdgeSpokeTransitiveRouting []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+		}
+		// EnableEgressTransitFirenet holds details about calls to the EnableEgressTransitFirenet method.
+		EnableEgressTransitFirenet []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// EnableEncryptVolume holds details about calls to the EnableEncryptVolume method.
+		EnableEncryptVolume []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableFireNetGatewayGroup holds details about calls to the EnableFireNetGatewayGroup method.
+		EnableFireNetGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableGatewayFireNetInterfaces holds details about calls to the EnableGatewayFireNetInterfaces method.
+		EnableGatewayFireNetInterfaces []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// EnableGatewayFireNetInterfacesWithGWLB holds details about calls to the EnableGatewayFireNetInterfacesWithGWLB method.
+		EnableGatewayFireNetInterfacesWithGWLB []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// EnableGatewayGroupSNat holds details about calls to the EnableGatewayGroupSNat method.
+		EnableGatewayGroupSNat []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableGatewayGroupVpcDNSServer holds details about calls to the EnableGatewayGroupVpcDNSServer method.
+		EnableGatewayGroupVpcDNSServer []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableGatewayLoadBalancerGatewayGroup holds details about calls to the EnableGatewayLoadBalancerGatewayGroup method.
+		EnableGatewayLoadBalancerGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableGlobalVpc holds details about calls to the EnableGlobalVpc method.
+		EnableGlobalVpc []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableGlobalVpcGatewayGroup holds details about calls to the EnableGlobalVpcGatewayGroup method.
+		EnableGlobalVpcGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableGroGso holds details about calls to the EnableGroGso method.
+		EnableGroGso []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableGroGsoGatewayGroup holds details about calls to the EnableGroGsoGatewayGroup method.
+		EnableGroGsoGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableGuardDutyEnforcement holds details about calls to the EnableGuardDutyEnforcement method.
+		EnableGuardDutyEnforcement []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableHybridConnectionGatewayGroup holds details about calls to the EnableHybridConnectionGatewayGroup method.
+		EnableHybridConnectionGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableIPv6 holds details about calls to the EnableIPv6 method.
+		EnableIPv6 []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableIPv6GatewayGroup holds details about calls to the EnableIPv6GatewayGroup method.
+		EnableIPv6GatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableJumboFrame holds details about calls to the EnableJumboFrame method.
+		EnableJumboFrame []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableJumboFrameExternalDeviceConn holds details about calls to the EnableJumboFrameExternalDeviceConn method.
+		EnableJumboFrameExternalDeviceConn []struct {
+			// ExternalDeviceConn is the externalDeviceConn argument value.
+			ExternalDeviceConn *ExternalDeviceConn
+		}
+		// EnableJumboFrameGatewayGroup holds details about calls to the EnableJumboFrameGatewayGroup method.
+		EnableJumboFrameGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableMonitorGatewaySubnets holds details about calls to the EnableMonitorGatewaySubnets method.
+		EnableMonitorGatewaySubnets []struct {
+			// GwName is the gwName argument value.
+			GwName string
+			// ExcludedInstances is the excludedInstances argument value.
+			ExcludedInstances []string
+		}
+		// EnableMultiTierTransitGatewayGroup holds details about calls to the EnableMultiTierTransitGatewayGroup method.
+		EnableMultiTierTransitGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableMultitierTransit holds details about calls to the EnableMultitierTransit method.
+		EnableMultitierTransit []struct {
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// EnablePeeringHaGateway holds details about calls to the EnablePeeringHaGateway method.
+		EnablePeeringHaGateway []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnablePrivateVpcDefaultRoute holds details about calls to the EnablePrivateVpcDefaultRoute method.
+		EnablePrivateVpcDefaultRoute []struct {
+			// Gw is the gw argument value.
+			Gw *Gateway
+		}
+		// EnablePrivateVpcDefaultRouteGatewayGroup holds details about calls to the EnablePrivateVpcDefaultRouteGatewayGroup method.
+		EnablePrivateVpcDefaultRouteGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnablePublicSubnetFilteringHAGateway holds details about calls to the EnablePublicSubnetFilteringHAGateway method.
+		EnablePublicSubnetFilteringHAGateway []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableS2CRxBalancing holds details about calls to the EnableS2CRxBalancing method.
+		EnableS2CRxBalancing []struct {
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// EnableS2cRxBalancingGatewayGroup holds details about calls to the EnableS2cRxBalancingGatewayGroup method.
+		EnableS2cRxBalancingGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableSNat holds details about calls to the EnableSNat method.
+		EnableSNat []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableSegmentation holds details about calls to the EnableSegmentation method.
+		EnableSegmentation []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// EnableSegmentationGatewayGroup holds details about calls to the EnableSegmentationGatewayGroup method.
+		EnableSegmentationGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableSingleAZGateway holds details about calls to the EnableSingleAZGateway method.
+		EnableSingleAZGateway []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableSkipPublicRouteUpdate holds details about calls to the EnableSkipPublicRouteUpdate method.
+		EnableSkipPublicRouteUpdate []struct {
+			// Gw is the gw argument value.
+			Gw *Gateway
+		}
+		// EnableSkipPublicRouteUpdateGatewayGroup holds details about calls to the EnableSkipPublicRouteUpdateGatewayGroup method.
+		EnableSkipPublicRouteUpdateGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableSpokeLearnedCidrsApproval holds details about calls to the EnableSpokeLearnedCidrsApproval method.
+		EnableSpokeLearnedCidrsApproval []struct {
+			// Gateway is the gateway argument value.
+			Gateway *SpokeVpc
+		}
+		// EnableSpokeLearnedCidrsApprovalGatewayGroup holds details about calls to the EnableSpokeLearnedCidrsApprovalGatewayGroup method.
+		EnableSpokeLearnedCidrsApprovalGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableSpokeOnpremRoutePropagation holds details about calls to the EnableSpokeOnpremRoutePropagation method.
+		EnableSpokeOnpremRoutePropagation []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+		}
+		// EnableSpokeOnpremRoutePropagationGatewayGroup holds details about calls to the EnableSpokeOnpremRoutePropagationGatewayGroup method.
+		EnableSpokeOnpremRoutePropagationGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableSpokePreserveAsPath holds details about calls to the EnableSpokePreserveAsPath method.
+		EnableSpokePreserveAsPath []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+		}
+		// EnableSpokePreserveAsPathGatewayGroup holds details about calls to the EnableSpokePreserveAsPathGatewayGroup method.
+		EnableSpokePreserveAsPathGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableSummarizeCidrToTgw holds details about calls to the EnableSummarizeCidrToTgw method.
+		EnableSummarizeCidrToTgw []struct {
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// EnableTransitFireNet holds details about calls to the EnableTransitFireNet method.
+		EnableTransitFireNet []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableTransitFireNetGatewayGroup holds details about calls to the EnableTransitFireNetGatewayGroup method.
+		EnableTransitFireNetGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableTransitFireNetWithGWLB holds details about calls to the EnableTransitFireNetWithGWLB method.
+		EnableTransitFireNetWithGWLB []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableTransitFireNetWithGWLBGatewayGroup holds details about calls to the EnableTransitFireNetWithGWLBGatewayGroup method.
+		EnableTransitFireNetWithGWLBGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableTransitLearnedCidrsApproval holds details about calls to the EnableTransitLearnedCidrsApproval method.
+		EnableTransitLearnedCidrsApproval []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// EnableTransitLearnedCidrsApprovalGatewayGroup holds details about calls to the EnableTransitLearnedCidrsApprovalGatewayGroup method.
+		EnableTransitLearnedCidrsApprovalGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableTransitPreserveAsPath holds details about calls to the EnableTransitPreserveAsPath method.
+		EnableTransitPreserveAsPath []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// EnableTransitPreserveAsPathGatewayGroup holds details about calls to the EnableTransitPreserveAsPathGatewayGroup method.
+		EnableTransitPreserveAsPathGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableTransitSummarizeCidrToTgwGatewayGroup holds details about calls to the EnableTransitSummarizeCidrToTgwGatewayGroup method.
+		EnableTransitSummarizeCidrToTgwGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableVPNConfig holds details about calls to the EnableVPNConfig method.
+		EnableVPNConfig []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+			// VpnConfig is the vpnConfig argument value.
+			VpnConfig *VPNConfig
+		}
+		// EnableVpcDNSServer holds details about calls to the EnableVpcDNSServer method.
+		EnableVpcDNSServer []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableVpnNat holds details about calls to the EnableVpnNat method.
+		EnableVpnNat []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// GetBgpLanIPList holds details about calls to the GetBgpLanIPList method.
+		GetBgpLanIPList []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// GetCID holds details about calls to the GetCID method.
+		GetCID []struct {
+		}
+		// GetControllerIP holds details about calls to the GetControllerIP method.
+		GetControllerIP []struct {
+		}
+		// GetDefaultTagsConfig holds details about calls to the GetDefaultTagsConfig method.
+		GetDefaultTagsConfig []struct {
+		}
+		// GetEdgeCSP holds details about calls to the GetEdgeCSP method.
+		GetEdgeCSP []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// GetEdgeCSPHa holds details about calls to the GetEdgeCSPHa method.
+		GetEdgeCSPHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// GetEdgeEquinix holds details about calls to the GetEdgeEquinix method.
+		GetEdgeEquinix []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// GetEdgeEquinixHa holds details about calls to the GetEdgeEquinixHa method.
+		GetEdgeEquinixHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// GetEdgeGatewayWanIp holds details about calls to the GetEdgeGatewayWanIp method.
+		GetEdgeGatewayWanIp []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GwName is the gwName argument value.
+			GwName string
+			// WanInterfaceName is the wanInterfaceName argument value.
+			WanInterfaceName string
+		}
+		// GetEdgeMegaport holds details about calls to the GetEdgeMegaport method.
+		GetEdgeMegaport []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// GetEdgeMegaportHa holds details about calls to the GetEdgeMegaportHa method.
+		GetEdgeMegaportHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// GetEdgeNEO holds details about calls to the GetEdgeNEO method.
+		GetEdgeNEO []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// GetEdgeNEODevice holds details about calls to the GetEdgeNEODevice method.
+		GetEdgeNEODevice []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AccountName is the accountName argument value.
+			AccountName string
+			// DeviceName is the deviceName argument value.
+			DeviceName string
+		}
+		// GetEdgeNEOHa holds details about calls to the GetEdgeNEOHa method.
+		GetEdgeNEOHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// GetEdgePlatformProxyProfile holds details about calls to the GetEdgePlatformProxyProfile method.
+		GetEdgePlatformProxyProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AccountName is the accountName argument value.
+			AccountName string
+			// ProfileName is the profileName argument value.
+			ProfileName string
+		}
+		// GetEdgeSpoke holds details about calls to the GetEdgeSpoke method.
+		GetEdgeSpoke []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// GetEdgeSpokeTransitAttachment holds details about calls to the GetEdgeSpokeTransitAttachment method.
+		GetEdgeSpokeTransitAttachment []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpokeTransitAttachment is the spokeTransitAttachment argument value.
+			SpokeTransitAttachment *SpokeTransitAttachment
+		}
+		// GetEdgeVmSelfmanagedHa holds details about calls to the GetEdgeVmSelfmanagedHa method.
+		GetEdgeVmSelfmanagedHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// GetExternalDeviceConnDetail holds details about calls to the GetExternalDeviceConnDetail method.
+		GetExternalDeviceConnDetail []struct {
+			// ExternalDeviceConn is the externalDeviceConn argument value.
+			ExternalDeviceConn *ExternalDeviceConn
+			// LocalGateway is the localGateway argument value.
+			LocalGateway *Gateway
+			// PriorHAEnabled is the priorHAEnabled argument value.
+			PriorHAEnabled bool
+		}
+		// GetGateway holds details about calls to the GetGateway method.
+		GetGateway []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// GetGatewayBgpCommunities holds details about calls to the GetGatewayBgpCommunities method.
+		GetGatewayBgpCommunities []struct {
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// GetGatewayBgpMedToSdnMetric holds details about calls to the GetGatewayBgpMedToSdnMetric method.
+		GetGatewayBgpMedToSdnMetric []struct {
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// GetGatewayDetail holds details about calls to the GetGatewayDetail method.
+		GetGatewayDetail []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// GetGatewayGroup holds details about calls to the GetGatewayGroup method.
+		GetGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupUUID is the groupUUID argument value.
+			GroupUUID string
+		}
+		// GetGatewayGroupByName holds details about calls to the GetGatewayGroupByName method.
+		GetGatewayGroupByName []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// GetGeoVPNName holds details about calls to the GetGeoVPNName method.
+		GetGeoVPNName []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// GetGroGsoStatus holds details about calls to the GetGroGsoStatus method.
+		GetGroGsoStatus []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// GetIgnoreTagsConfig holds details about calls to the GetIgnoreTagsConfig method.
+		GetIgnoreTagsConfig []struct {
+		}
+		// GetSplitTunnel holds details about calls to the GetSplitTunnel method.
+		GetSplitTunnel []struct {
+			// SplitTunnel is the splitTunnel argument value.
+			SplitTunnel *SplitTunnel
+		}
+		// GetSpokeGatewayAdvancedConfig holds details about calls to the GetSpokeGatewayAdvancedConfig method.
+		GetSpokeGatewayAdvancedConfig []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+		}
+		// GetSpokeGatewayList holds details about calls to the GetSpokeGatewayList method.
+		GetSpokeGatewayList []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetSpokeGatewaySubnetGroup holds details about calls to the GetSpokeGatewaySubnetGroup method.
+		GetSpokeGatewaySubnetGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpokeGatewaySubnetGroup is the spokeGatewaySubnetGroup argument value.
+			SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+		}
+		// GetTags holds details about calls to the GetTags method.
+		GetTags []struct {
+			// Tags is the tags argument value.
+			Tags *Tags
+		}
+		// GetTransitGatewayAdvancedConfig holds details about calls to the GetTransitGatewayAdvancedConfig method.
+		GetTransitGatewayAdvancedConfig []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// GetTransitGatewayLanCidr holds details about calls to the GetTransitGatewayLanCidr method.
+		GetTransitGatewayLanCidr []struct {
+			// GatewayName is the gatewayName argument value.
+			GatewayName string
+		}
+		// GetTransitGatewayList holds details about calls to the GetTransitGatewayList method.
+		GetTransitGatewayList []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetTunnelDetectionTime holds details about calls to the GetTunnelDetectionTime method.
+		GetTunnelDetectionTime []struct {
+			// Entity is the entity argument value.
+			Entity string
+		}
+		// IsTransitFireNetReadyToBeDisabled holds details about calls to the IsTransitFireNetReadyToBeDisabled method.
+		IsTransitFireNetReadyToBeDisabled []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// LaunchSpokeVpcContext holds details about calls to the LaunchSpokeVpcContext method.
+		LaunchSpokeVpcContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Spoke is the spoke argument value.
+			Spoke *SpokeVpc
+		}
+		// LaunchTransitVpcContext holds details about calls to the LaunchTransitVpcContext method.
+		LaunchTransitVpcContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// ModifySplitTunnel holds details about calls to the ModifySplitTunnel method.
+		ModifySplitTunnel []struct {
+			// SplitTunnel is the splitTunnel argument value.
+			SplitTunnel *SplitTunnel
+		}
+		// ModifyTunnelDetectionTime holds details about calls to the ModifyTunnelDetectionTime method.
+		ModifyTunnelDetectionTime []struct {
+			// Entity is the entity argument value.
+			Entity string
+			// DetectionTime is the detectionTime argument value.
+			DetectionTime int
+		}
+		// OnboardEdgeNEODevice holds details about calls to the OnboardEdgeNEODevice method.
+		OnboardEdgeNEODevice []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeNEODevice is the edgeNEODevice argument value.
+			EdgeNEODevice *EdgeNEODevice
+		}
+		// PostAPI holds details about calls to the PostAPI method.
+		PostAPI []struct {
+			// Action is the action argument value.
+			Action string
+			// D is the d argument value.
+			D interface{}
+			// CheckFunc is the checkFunc argument value.
+			CheckFunc CheckAPIResponseFunc
+		}
+		// SetBgpBfdPollingTime holds details about calls to the SetBgpBfdPollingTime method.
+		SetBgpBfdPollingTime []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+			// NewPollingTime is the newPollingTime argument value.
+			NewPollingTime int
+		}
+		// SetBgpBfdPollingTimeGatewayGroup holds details about calls to the SetBgpBfdPollingTimeGatewayGroup method.
+		SetBgpBfdPollingTimeGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// PollingTime is the pollingTime argument value.
+			PollingTime int
+		}
+		// SetBgpBfdPollingTimeSpoke holds details about calls to the SetBgpBfdPollingTimeSpoke method.
+		SetBgpBfdPollingTimeSpoke []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+			// NewPollingTime is the newPollingTime argument value.
+			NewPollingTime int
+		}
+		// SetBgpEcmp holds details about calls to the SetBgpEcmp method.
+		SetBgpEcmp []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+			// Enabled is the enabled argument value.
+			Enabled bool
+		}
+		// SetBgpEcmpGatewayGroup holds details about calls to the SetBgpEcmpGatewayGroup method.
+		SetBgpEcmpGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// Enable is the enable argument value.
+			Enable bool
+		}
+		// SetBgpEcmpSpoke holds details about calls to the SetBgpEcmpSpoke method.
+		SetBgpEcmpSpoke []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+			// Enabled is the enabled argument value.
+			Enabled bool
+		}
+		// SetBgpManualSpokeAdvertisedNetworks holds details about calls to the SetBgpManualSpokeAdvertisedNetworks method.
+		SetBgpManualSpokeAdvertisedNetworks []struct {
+			// TransitGw is the transitGw argument value.
+			TransitGw *TransitVpc
+		}
+		// SetBgpPollingTime holds details about calls to the SetBgpPollingTime method.
+		SetBgpPollingTime []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+			// NewPollingTime is the newPollingTime argument value.
+			NewPollingTime int
+		}
+		// SetBgpPollingTimeGatewayGroup holds details about calls to the SetBgpPollingTimeGatewayGroup method.
+		SetBgpPollingTimeGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// PollingTime is the pollingTime argument value.
+			PollingTime int
+		}
+		// SetBgpPollingTimeSpoke holds details about calls to the SetBgpPollingTimeSpoke method.
+		SetBgpPollingTimeSpoke []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+			// NewPollingTime is the newPollingTime argument value.
+			NewPollingTime int
+		}
+		// SetGatewayBgpCommunitiesAccept holds details about calls to the SetGatewayBgpCommunitiesAccept method.
+		SetGatewayBgpCommunitiesAccept []struct {
+			// GwName is the gwName argument value.
+			GwName string
+			// AcceptComm is the acceptComm argument value.
+			AcceptComm bool
+		}
+		// SetGatewayBgpCommunitiesSend holds details about calls to the SetGatewayBgpCommunitiesSend method.
+		SetGatewayBgpCommunitiesSend []struct {
+			// GwName is the gwName argument value.
+			GwName string
+			// SendComm is the sendComm argument value.
+			SendComm bool
+		}
+		// SetGatewayBgpMedToSdnMetric holds details about calls to the SetGatewayBgpMedToSdnMetric method.
+		SetGatewayBgpMedToSdnMetric []struct {
+			// GwName is the gwName argument value.
+			GwName string
+			// Override is the override argument value.
+			Override bool
+		}
+		// SetGatewayGroupBgpCommunitiesAccept holds details about calls to the SetGatewayGroupBgpCommunitiesAccept method.
+		SetGatewayGroupBgpCommunitiesAccept []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// Accept is the accept argument value.
+			Accept bool
+		}
+		// SetGatewayGroupBgpCommunitiesSend holds details about calls to the SetGatewayGroupBgpCommunitiesSend method.
+		SetGatewayGroupBgpCommunitiesSend []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// Send is the send argument value.
+			Send bool
+		}
+		// SetGatewayPhase2Policy holds details about calls to the SetGatewayPhase2Policy method.
+		SetGatewayPhase2Policy []struct {
+			// GwName is the gwName argument value.
+			GwName string
+			// EncPolicy is the encPolicy argument value.
+			EncPolicy string
+			// PfsPolicy is the pfsPolicy argument value.
+			PfsPolicy string
+		}
+		// SetLocalASNumber holds details about calls to the SetLocalASNumber method.
+		SetLocalASNumber []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+			// LocalASNumber is the localASNumber argument value.
+			LocalASNumber string
+		}
+		// SetLocalASNumberGatewayGroup holds details about calls to the SetLocalASNumberGatewayGroup method.
+		SetLocalASNumberGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// LocalAsNumber is the localAsNumber argument value.
+			LocalAsNumber string
+		}
+		// SetLocalASNumberSpoke holds details about calls to the SetLocalASNumberSpoke method.
+		SetLocalASNumberSpoke []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+			// LocalASNumber is the localASNumber argument value.
+			LocalASNumber string
+		}
+		// SetPrependASPath holds details about calls to the SetPrependASPath method.
+		SetPrependASPath []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+			// PrependASPath is the prependASPath argument value.
+			PrependASPath []string
+		}
+		// SetPrependASPathGatewayGroup holds details about calls to the SetPrependASPathGatewayGroup method.
+		SetPrependASPathGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// PrependAsPath is the prependAsPath argument value.
+			PrependAsPath []string
+		}
+		// SetPrependASPathSpoke holds details about calls to the SetPrependASPathSpoke method.
+		SetPrependASPathSpoke []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+			// PrependASPath is the prependASPath argument value.
+			PrependASPath []string
+		}
+		// SetRxQueueSize holds details about calls to the SetRxQueueSize method.
+		SetRxQueueSize []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// SetSpokeBgpManualAdvertisedNetworks holds details about calls to the SetSpokeBgpManualAdvertisedNetworks method.
+		SetSpokeBgpManualAdvertisedNetworks []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+		}
+		// SetSpokeBgpManualAdvertisedNetworksGatewayGroup holds details about calls to the SetSpokeBgpManualAdvertisedNetworksGatewayGroup method.
+		SetSpokeBgpManualAdvertisedNetworksGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// Cidrs is the cidrs argument value.
+			Cidrs string
+		}
+		// SetSymmetricRoutingGatewayGroup holds details about calls to the SetSymmetricRoutingGatewayGroup method.
+		SetSymmetricRoutingGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupUUID is the groupUUID argument value.
+			GroupUUID string
+			// Enable is the enable argument value.
+			Enable bool
+		}
+		// SetTransitLearnedCIDRsApprovalMode holds details about calls to the SetTransitLearnedCIDRsApprovalMode method.
+		SetTransitLearnedCIDRsApprovalMode []struct {
+			// Gw is the gw argument value.
+			Gw *TransitVpc
+			// Mode is the mode argument value.
+			Mode string
+		}
+		// SetVpnGatewayAuthentication holds details about calls to the SetVpnGatewayAuthentication method.
+		SetVpnGatewayAuthentication []struct {
+			// Gateway is the gateway argument value.
+			Gateway *VpnGatewayAuth
+		}
+		// UpdateDNat holds details about calls to the UpdateDNat method.
+		UpdateDNat []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// UpdateEdgeCSP holds details about calls to the UpdateEdgeCSP method.
+		UpdateEdgeCSP []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeCSP is the edgeCSP argument value.
+			EdgeCSP *EdgeCSP
+		}
+		// UpdateEdgeCSPHa holds details about calls to the UpdateEdgeCSPHa method.
+		UpdateEdgeCSPHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeCSP is the edgeCSP argument value.
+			EdgeCSP *EdgeCSP
+		}
+		// UpdateEdgeEquinix holds details about calls to the UpdateEdgeEquinix method.
+		UpdateEdgeEquinix []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeEquinix is the edgeEquinix argument value.
+			EdgeEquinix *EdgeEquinix
+		}
+		// UpdateEdgeEquinixHa holds details about calls to the UpdateEdgeEquinixHa method.
+		UpdateEdgeEquinixHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeEquinix is the edgeEquinix argument value.
+			EdgeEquinix *EdgeEquinix
+		}
+		// UpdateEdgeGateway holds details about calls to the UpdateEdgeGateway method.
+		UpdateEdgeGateway []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// UpdateEdgeGatewayV2 holds details about calls to the UpdateEdgeGatewayV2 method.
+		UpdateEdgeGatewayV2 []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// UpdateEdgeMegaport holds details about calls to the UpdateEdgeMegaport method.
+		UpdateEdgeMegaport []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeMegaport is the edgeMegaport argument value.
+			EdgeMegaport *EdgeMegaport
+		}
+		// UpdateEdgeMegaportHa holds details about calls to the UpdateEdgeMegaportHa method.
+		UpdateEdgeMegaportHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeMegaport is the edgeMegaport argument value.
+			EdgeMegaport *EdgeMegaport
+		}
+		// UpdateEdgeNEO holds details about calls to the UpdateEdgeNEO method.
+		UpdateEdgeNEO []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeNEO is the edgeNEO argument value.
+			EdgeNEO *EdgeNEO
+		}
+		// UpdateEdgeNEODevice holds details about calls to the UpdateEdgeNEODevice method.
+		UpdateEdgeNEODevice []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeNEODevice is the edgeNEODevice argument value.
+			EdgeNEODevice *EdgeNEODevice
+		}
+		// UpdateEdgeNEOHa holds details about calls to the UpdateEdgeNEOHa method.
+		UpdateEdgeNEOHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeNEO is the edgeNEO argument value.
+			EdgeNEO *EdgeNEO
+		}
+		// UpdateEdgeProxyProfile holds details about calls to the UpdateEdgeProxyProfile method.
+		UpdateEdgeProxyProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeNEOProxyProfile is the edgeNEOProxyProfile argument value.
+			EdgeNEOProxyProfile *EdgePlatformProxyProfileUpdate
+		}
+		// UpdateEdgeSpoke holds details about calls to the UpdateEdgeSpoke method.
+		UpdateEdgeSpoke []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeSpoke is the edgeSpoke argument value.
+			EdgeSpoke *EdgeSpoke
+		}
+		// UpdateEdgeSpokeGeoCoordinate holds details about calls to the UpdateEdgeSpokeGeoCoordinate method.
+		UpdateEdgeSpokeGeoCoordinate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeSpoke is the edgeSpoke argument value.
+			EdgeSpoke *EdgeSpoke
+		}
+		// UpdateEdgeSpokeTransitPeeringTunnelCount holds details about calls to the UpdateEdgeSpokeTransitPeeringTunnelCount method.
+		UpdateEdgeSpokeTransitPeeringTunnelCount []struct {
+			// Gateway1 is the gateway1 argument value.
+			Gateway1 string
+			// Gateway2 is the gateway2 argument value.
+			Gateway2 string
+			// TunnelCount is the tunnelCount argument value.
+			TunnelCount int
+		}
+		// UpdateEdgeVmSelfmanagedHa holds details about calls to the UpdateEdgeVmSelfmanagedHa method.
+		UpdateEdgeVmSelfmanagedHa []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EdgeVmSelfmanaged is the edgeVmSelfmanaged argument value.
+			EdgeVmSelfmanaged *EdgeSpoke
+		}
+		// UpdateGateway holds details about calls to the UpdateGateway method.
+		UpdateGateway []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// UpdateGatewayContext holds details about calls to the UpdateGatewayContext method.
+		UpdateGatewayContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// UpdateGroupInstanceSize holds details about calls to the UpdateGroupInstanceSize method.
+		UpdateGroupInstanceSize []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// InstanceSize is the instanceSize argument value.
+			InstanceSize string
+		}
+		// UpdateMaxVpnConn holds details about calls to the UpdateMaxVpnConn method.
+		UpdateMaxVpnConn []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// UpdateSpokeGatewaySubnetGroup holds details about calls to the UpdateSpokeGatewaySubnetGroup method.
+		UpdateSpokeGatewaySubnetGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpokeGatewaySubnetGroup is the spokeGatewaySubnetGroup argument value.
+			SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+		}
+		// UpdateSpokePendingApprovedCidrs holds details about calls to the UpdateSpokePendingApprovedCidrs method.
+		UpdateSpokePendingApprovedCidrs []struct {
+			// Gateway is the gateway argument value.
+			Gateway *SpokeVpc
+		}
+		// UpdateSpokePendingApprovedCidrsGatewayGroup holds details about calls to the UpdateSpokePendingApprovedCidrsGatewayGroup method.
+		UpdateSpokePendingApprovedCidrsGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// ApprovedCidrs is the approvedCidrs argument value.
+			ApprovedCidrs []string
+		}
+		// UpdateTags holds details about calls to the UpdateTags method.
+		UpdateTags []struct {
+			// Tags is the tags argument value.
+			Tags *Tags
+		}
+		// UpdateTransitGatewayCustomizedVpcRoute holds details about calls to the UpdateTransitGatewayCustomizedVpcRoute method.
+		UpdateTransitGatewayCustomizedVpcRoute []struct {
+			// Gateway is the gateway argument value.
+			Gateway string
+			// CustomizedTransitVpcRoutes is the customizedTransitVpcRoutes argument value.
+			CustomizedTransitVpcRoutes []string
+		}
+		// UpdateTransitPendingApprovedCidrs holds details about calls to the UpdateTransitPendingApprovedCidrs method.
+		UpdateTransitPendingApprovedCidrs []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// UpdateTransitPendingApprovedCidrsGatewayGroup holds details about calls to the UpdateTransitPendingApprovedCidrsGatewayGroup method.
+		UpdateTransitPendingApprovedCidrsGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// ApprovedCidrs is the approvedCidrs argument value.
+			ApprovedCidrs []string
+		}
+		// UpdateVpnCidr holds details about calls to the UpdateVpnCidr method.
+		UpdateVpnCidr []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+	}
+	lockAddSpokeGatewaySubnetGroup                      sync.RWMutex
+	lockAttachTransitGWForHybrid                        sync.RWMutex
+	lockChangeBgpHoldTime                               sync.RWMutex
+	lockChangeBgpHoldTimeGatewayGroup                   sync.RWMutex
+	lockChangeBgpOverLanIntfCnt                         sync.RWMutex
+	lockCreateEdgeCSP                                   sync.RWMutex
+	lockCreateEdgeCSPHa                                 sync.RWMutex
+	lockCreateEdgeEquinix                               sync.RWMutex
+	lockCreateEdgeEquinixHa                             sync.RWMutex
+	lockCreateEdgeExternalDeviceConn                    sync.RWMutex
+	lockCreateEdgeMegaport                              sync.RWMutex
+	lockCreateEdgeMegaportHa                            sync.RWMutex
+	lockCreateEdgeNEO                                   sync.RWMutex
+	lockCreateEdgeNEOHa                                 sync.RWMutex
+	lockCreateEdgeProxyProfile                          sync.RWMutex
+	lockCreateEdgeSpoke                                 sync.RWMutex
+	lockCreateEdgeVmSelfmanagedHa                       sync.RWMutex
+	lockCreateExternalDeviceConn                        sync.RWMutex
+	lockCreateGatewayContext                            sync.RWMutex
+	lockCreateGatewayGroup                              sync.RWMutex
+	lockCreatePublicSubnetFilteringGatewayContext       sync.RWMutex
+	lockCreateSpokeHaGw                                 sync.RWMutex
+	lockCreateSpokeHaGwContext                          sync.RWMutex
+	lockCreateSpokeTransitAttachment                    sync.RWMutex
+	lockCreateTransitHaGwContext                        sync.RWMutex
+	lockDeleteEdgeCSP                                   sync.RWMutex
+	lockDeleteEdgeEquinix                               sync.RWMutex
+	lockDeleteEdgeExternalDeviceConn                    sync.RWMutex
+	lockDeleteEdgeMegaport                              sync.RWMutex
+	lockDeleteEdgeNEO                                   sync.RWMutex
+	lockDeleteEdgeNEODevice                             sync.RWMutex
+	lockDeleteEdgePlatformProxyProfile                  sync.RWMutex
+	lockDeleteEdgeSpoke                                 sync.RWMutex
+	lockDeleteExternalDeviceConn                        sync.RWMutex
+	lockDeleteGateway                                   sync.RWMutex
+	lockDeleteGatewayContext                            sync.RWMutex
+	lockDeleteGatewayGroup                              sync.RWMutex
+	lockDeletePublicSubnetFilteringGatewayContext       sync.RWMutex
+	lockDeleteSpokeGatewaySubnetGroup                   sync.RWMutex
+	lockDeleteSpokeTransitAttachment                    sync.RWMutex
+	lockDetachTransitGWForHybrid                        sync.RWMutex
+	lockDisableActiveStandby                            sync.RWMutex
+	lockDisableActiveStandbyGatewayGroup                sync.RWMutex
+	lockDisableActiveStandbySpoke                       sync.RWMutex
+	lockDisableAdvertiseTransitCidr                     sync.RWMutex
+	lockDisableAdvertiseTransitCidrGatewayGroup         sync.RWMutex
+	lockDisableAutoAdvertiseS2CCidrs                    sync.RWMutex
+	lockDisableAutoAdvertiseS2CCidrsGatewayGroup        sync.RWMutex
+	lockDisableConnectedTransit                         sync.RWMutex
+	lockDisableConnectedTransitGatewayGroup             sync.RWMutex
+	lockDisableCustomSNat                               sync.RWMutex
+	lockDisableEdgeSpokeTransitiveRouting               sync.RWMutex
+	lockDisableEgressTransitFirenet                     sync.RWMutex
+	lockDisableFireNetGatewayGroup                      sync.RWMutex
+	lockDisableGatewayFireNetInterfaces                 sync.RWMutex
+	lockDisableGatewayGroupSNat                         sync.RWMutex
+	lockDisableGatewayGroupVpcDNSServer                 sync.RWMutex
+	lockDisableGatewayLoadBalancerGatewayGroup          sync.RWMutex
+	lockDisableGlobalVpc                                sync.RWMutex
+	lockDisableGlobalVpcGatewayGroup                    sync.RWMutex
+	lockDisableGroGso                                   sync.RWMutex
+	lockDisableGroGsoGatewayGroup                       sync.RWMutex
+	lockDisableGuardDutyEnforcement                     sync.RWMutex
+	lockDisableHybridConnectionGatewayGroup             sync.RWMutex
+	lockDisableIPv6                                     sync.RWMutex
+	lockDisableIPv6GatewayGroup                         sync.RWMutex
+	lockDisableJumboFrame                               sync.RWMutex
+	lockDisableJumboFrameExternalDeviceConn             sync.RWMutex
+	lockDisableJumboFrameGatewayGroup                   sync.RWMutex
+	lockDisableMonitorGatewaySubnets                    sync.RWMutex
+	lockDisableMultiTierTransitGatewayGroup             sync.RWMutex
+	lockDisableMultitierTransit                         sync.RWMutex
+	lockDisablePrivateVpcDefaultRoute                   sync.RWMutex
+	lockDisablePrivateVpcDefaultRouteGatewayGroup       sync.RWMutex
+	lockDisableS2CRxBalancing                           sync.RWMutex
+	lockDisableS2cRxBalancingGatewayGroup               sync.RWMutex
+	lockDisableSNat                                     sync.RWMutex
+	lockDisableSegmentation                             sync.RWMutex
+	lockDisableSegmentationGatewayGroup                 sync.RWMutex
+	lockDisableSingleAZGateway                          sync.RWMutex
+	lockDisableSkipPublicRouteUpdate                    sync.RWMutex
+	lockDisableSkipPublicRouteUpdateGatewayGroup        sync.RWMutex
+	lockDisableSpokeLearnedCidrsApproval                sync.RWMutex
+	lockDisableSpokeLearnedCidrsApprovalGatewayGroup    sync.RWMutex
+	lockDisableSpokeOnpremRoutePropagation              sync.RWMutex
+	lockDisableSpokeOnpremRoutePropagationGatewayGroup  sync.RWMutex
+	lockDisableSpokePreserveAsPath                      sync.RWMutex
+	lockDisableSpokePreserveAsPathGatewayGroup          sync.RWMutex
+	lockDisableSummarizeCidrToTgw                       sync.RWMutex
+	lockDisableTransitFireNet                           sync.RWMutex
+	lockDisableTransitFireNetGatewayGroup               sync.RWMutex
+	lockDisableTransitLearnedCidrsApproval              sync.RWMutex
+	lockDisableTransitLearnedCidrsApprovalGatewayGroup  sync.RWMutex
+	lockDisableTransitPreserveAsPath                    sync.RWMutex
+	lockDisableTransitPreserveAsPathGatewayGroup        sync.RWMutex
+	lockDisableTransitSummarizeCidrToTgwGatewayGroup    sync.RWMutex
+	lockDisableVPNConfig                                sync.RWMutex
+	lockDisableVpcDNSServer                             sync.RWMutex
+	lockDisableVpnNat                                   sync.RWMutex
+	lockDownloadEdgeNEOConfigFile                       sync.RWMutex
+	lockEditBgpMd5Key                                   sync.RWMutex
+	lockEditConnectionBgpBfd                            sync.RWMutex
+	lockEditConnectionBgpMultihop                       sync.RWMutex
+	lockEditDesignatedGateway                           sync.RWMutex
+	lockEditGatewayAdvertisedCidr                       sync.RWMutex
+	lockEditGatewayCustomRoutes                         sync.RWMutex
+	lockEditGatewayFilterRoutes                         sync.RWMutex
+	lockEditManagedRouteTables                          sync.RWMutex
+	lockEditManagedRouteTablesForGatewayGroup           sync.RWMutex
+	lockEditPrivateRouteTableConfig                     sync.RWMutex
+	lockEditPrivateRouteTableConfigForGatewayGroup      sync.RWMutex
+	lockEditPublicSubnetFilteringRouteTableList         sync.RWMutex
+	lockEditSpokeExternalDeviceConnASPathPrepend        sync.RWMutex
+	lockEditSpokeTransitAttachmentFilters               sync.RWMutex
+	lockEditTransitConnectionASPathPrepend              sync.RWMutex
+	lockEditTransitConnectionBGPManualAdvertiseCIDRs    sync.RWMutex
+	lockEnableActiveStandby                             sync.RWMutex
+	lockEnableActiveStandbyGatewayGroup                 sync.RWMutex
+	lockEnableActiveStandbyPreemptive                   sync.RWMutex
+	lockEnableActiveStandbyPreemptiveGatewayGroup       sync.RWMutex
+	lockEnableActiveStandbyPreemptiveSpoke              sync.RWMutex
+	lockEnableActiveStandbySpoke                        sync.RWMutex
+	lockEnableAdvertiseTransitCidr                      sync.RWMutex
+	lockEnableAdvertiseTransitCidrGatewayGroup          sync.RWMutex
+	lockEnableAutoAdvertiseS2CCidrs                     sync.RWMutex
+	lockEnableAutoAdvertiseS2CCidrsGatewayGroup         sync.RWMutex
+	lockEnableConnectedTransit                          sync.RWMutex
+	lockEnableConnectedTransitGatewayGroup              sync.RWMutex
+	lockEnableCustomizedSNat                            sync.RWMutex
+	lockEnableEdgeSpokeTransitiveRouting                sync.RWMutex
+	lockEnableEgressTransitFirenet                      sync.RWMutex
+	lockEnableEncryptVolume                             sync.RWMutex
+	lockEnableFireNetGatewayGroup                       sync.RWMutex
+	lockEnableGatewayFireNetInterfaces                  sync.RWMutex
+	lockEnableGatewayFireNetInterfacesWithGWLB          sync.RWMutex
+	lockEnableGatewayGroupSNat                          sync.RWMutex
+	lockEnableGatewayGroupVpcDNSServer                  sync.RWMutex
+	lockEnableGatewayLoadBalancerGatewayGroup           sync.RWMutex
+	lockEnableGlobalVpc                                 sync.RWMutex
+	lockEnableGlobalVpcGatewayGroup                     sync.RWMutex
+	lockEnableGroGso                                    sync.RWMutex
+	lockEnableGroGsoGatewayGroup                        sync.RWMutex
+	lockEnableGuardDutyEnforcement                      sync.RWMutex
+	lockEnableHybridConnectionGatewayGroup              sync.RWMutex
+	lockEnableIPv6                                      sync.RWMutex
+	lockEnableIPv6GatewayGroup                          sync.RWMutex
+	lockEnableJumboFrame                                sync.RWMutex
+	lockEnableJumboFrameExternalDeviceConn              sync.RWMutex
+	lockEnableJumboFrameGatewayGroup                    sync.RWMutex
+	lockEnableMonitorGatewaySubnets                     sync.RWMutex
+	lockEnableMultiTierTransitGatewayGroup              sync.RWMutex
+	lockEnableMultitierTransit                          sync.RWMutex
+	lockEnablePeeringHaGateway                          sync.RWMutex
+	lockEnablePrivateVpcDefaultRoute                    sync.RWMutex
+	lockEnablePrivateVpcDefaultRouteGatewayGroup        sync.RWMutex
+	lockEnablePublicSubnetFilteringHAGateway            sync.RWMutex
+	lockEnableS2CRxBalancing                            sync.RWMutex
+	lockEnableS2cRxBalancingGatewayGroup                sync.RWMutex
+	lockEnableSNat                                      sync.RWMutex
+	lockEnableSegmentation                              sync.RWMutex
+	lockEnableSegmentationGatewayGroup                  sync.RWMutex
+	lockEnableSingleAZGateway                           sync.RWMutex
+	lockEnableSkipPublicRouteUpdate                     sync.RWMutex
+	lockEnableSkipPublicRouteUpdateGatewayGroup         sync.RWMutex
+	lockEnableSpokeLearnedCidrsApproval                 sync.RWMutex
+	lockEnableSpokeLearnedCidrsApprovalGatewayGroup     sync.RWMutex
+	lockEnableSpokeOnpremRoutePropagation               sync.RWMutex
+	lockEnableSpokeOnpremRoutePropagationGatewayGroup   sync.RWMutex
+	lockEnableSpokePreserveAsPath                       sync.RWMutex
+	lockEnableSpokePreserveAsPathGatewayGroup           sync.RWMutex
+	lockEnableSummarizeCidrToTgw                        sync.RWMutex
+	lockEnableTransitFireNet                            sync.RWMutex
+	lockEnableTransitFireNetGatewayGroup                sync.RWMutex
+	lockEnableTransitFireNetWithGWLB                    sync.RWMutex
+	lockEnableTransitFireNetWithGWLBGatewayGroup        sync.RWMutex
+	lockEnableTransitLearnedCidrsApproval               sync.RWMutex
+	lockEnableTransitLearnedCidrsApprovalGatewayGroup   sync.RWMutex
+	lockEnableTransitPreserveAsPath                     sync.RWMutex
+	lockEnableTransitPreserveAsPathGatewayGroup         sync.RWMutex
+	lockEnableTransitSummarizeCidrToTgwGatewayGroup     sync.RWMutex
+	lockEnableVPNConfig                                 sync.RWMutex
+	lockEnableVpcDNSServer                              sync.RWMutex
+	lockEnableVpnNat                                    sync.RWMutex
+	lockGetBgpLanIPList                                 sync.RWMutex
+	lockGetCID                                          sync.RWMutex
+	lockGetControllerIP                                 sync.RWMutex
+	lockGetDefaultTagsConfig                            sync.RWMutex
+	lockGetEdgeCSP                                      sync.RWMutex
+	lockGetEdgeCSPHa                                    sync.RWMutex
+	lockGetEdgeEquinix                                  sync.RWMutex
+	lockGetEdgeEquinixHa                                sync.RWMutex
+	lockGetEdgeGatewayWanIp                             sync.RWMutex
+	lockGetEdgeMegaport                                 sync.RWMutex
+	lockGetEdgeMegaportHa                               sync.RWMutex
+	lockGetEdgeNEO                                      sync.RWMutex
+	lockGetEdgeNEODevice                                sync.RWMutex
+	lockGetEdgeNEOHa                                    sync.RWMutex
+	lockGetEdgePlatformProxyProfile                     sync.RWMutex
+	lockGetEdgeSpoke                                    sync.RWMutex
+	lockGetEdgeSpokeTransitAttachment                   sync.RWMutex
+	lockGetEdgeVmSelfmanagedHa                          sync.RWMutex
+	lockGetExternalDeviceConnDetail                     sync.RWMutex
+	lockGetGateway                                      sync.RWMutex
+	lockGetGatewayBgpCommunities                        sync.RWMutex
+	lockGetGatewayBgpMedToSdnMetric                     sync.RWMutex
+	lockGetGatewayDetail                                sync.RWMutex
+	lockGetGatewayGroup                                 sync.RWMutex
+	lockGetGatewayGroupByName                           sync.RWMutex
+	lockGetGeoVPNName                                   sync.RWMutex
+	lockGetGroGsoStatus                                 sync.RWMutex
+	lockGetIgnoreTagsConfig                             sync.RWMutex
+	lockGetSplitTunnel                                  sync.RWMutex
+	lockGetSpokeGatewayAdvancedConfig                   sync.RWMutex
+	lockGetSpokeGatewayList                             sync.RWMutex
+	lockGetSpokeGatewaySubnetGroup                      sync.RWMutex
+	lockGetTags                                         sync.RWMutex
+	lockGetTransitGatewayAdvancedConfig                 sync.RWMutex
+	lockGetTransitGatewayLanCidr                        sync.RWMutex
+	lockGetTransitGatewayList                           sync.RWMutex
+	lockGetTunnelDetectionTime                          sync.RWMutex
+	lockIsTransitFireNetReadyToBeDisabled               sync.RWMutex
+	lockLaunchSpokeVpcContext                           sync.RWMutex
+	lockLaunchTransitVpcContext                         sync.RWMutex
+	lockModifySplitTunnel                               sync.RWMutex
+	lockModifyTunnelDetectionTime                       sync.RWMutex
+	lockOnboardEdgeNEODevice                            sync.RWMutex
+	lockPostAPI                                         sync.RWMutex
+	lockSetBgpBfdPollingTime                            sync.RWMutex
+	lockSetBgpBfdPollingTimeGatewayGroup                sync.RWMutex
+	lockSetBgpBfdPollingTimeSpoke                       sync.RWMutex
+	lockSetBgpEcmp                                      sync.RWMutex
+	lockSetBgpEcmpGatewayGroup                          sync.RWMutex
+	lockSetBgpEcmpSpoke                                 sync.RWMutex
+	lockSetBgpManualSpokeAdvertisedNetworks             sync.RWMutex
+	lockSetBgpPollingTime                               sync.RWMutex
+	lockSetBgpPollingTimeGatewayGroup                   sync.RWMutex
+	lockSetBgpPollingTimeSpoke                          sync.RWMutex
+	lockSetGatewayBgpCommunitiesAccept                  sync.RWMutex
+	lockSetGatewayBgpCommunitiesSend                    sync.RWMutex
+	lockSetGatewayBgpMedToSdnMetric                     sync.RWMutex
+	lockSetGatewayGroupBgpCommunitiesAccept             sync.RWMutex
+	lockSetGatewayGroupBgpCommunitiesSend               sync.RWMutex
+	lockSetGatewayPhase2Policy                          sync.RWMutex
+	lockSetLocalASNumber                                sync.RWMutex
+	lockSetLocalASNumberGatewayGroup                    sync.RWMutex
+	lockSetLocalASNumberSpoke                           sync.RWMutex
+	lockSetPrependASPath                                sync.RWMutex
+	lockSetPrependASPathGatewayGroup                    sync.RWMutex
+	lockSetPrependASPathSpoke                           sync.RWMutex
+	lockSetRxQueueSize                                  sync.RWMutex
+	lockSetSpokeBgpManualAdvertisedNetworks             sync.RWMutex
+	lockSetSpokeBgpManualAdvertisedNetworksGatewayGroup sync.RWMutex
+	lockSetSymmetricRoutingGatewayGroup                 sync.RWMutex
+	lockSetTransitLearnedCIDRsApprovalMode              sync.RWMutex
+	lockSetVpnGatewayAuthentication                     sync.RWMutex
+	lockUpdateDNat                                      sync.RWMutex
+	lockUpdateEdgeCSP                                   sync.RWMutex
+	lockUpdateEdgeCSPHa                                 sync.RWMutex
+	lockUpdateEdgeEquinix                               sync.RWMutex
+	lockUpdateEdgeEquinixHa                             sync.RWMutex
+	lockUpdateEdgeGateway                               sync.RWMutex
+	lockUpdateEdgeGatewayV2                             sync.RWMutex
+	lockUpdateEdgeMegaport                              sync.RWMutex
+	lockUpdateEdgeMegaportHa                            sync.RWMutex
+	lockUpdateEdgeNEO                                   sync.RWMutex
+	lockUpdateEdgeNEODevice                             sync.RWMutex
+	lockUpdateEdgeNEOHa                                 sync.RWMutex
+	lockUpdateEdgeProxyProfile                          sync.RWMutex
+	lockUpdateEdgeSpoke                                 sync.RWMutex
+	lockUpdateEdgeSpokeGeoCoordinate                    sync.RWMutex
+	lockUpdateEdgeSpokeTransitPeeringTunnelCount        sync.RWMutex
+	lockUpdateEdgeVmSelfmanagedHa                       sync.RWMutex
+	lockUpdateGateway                                   sync.RWMutex
+	lockUpdateGatewayContext                            sync.RWMutex
+	lockUpdateGroupInstanceSize                         sync.RWMutex
+	lockUpdateMaxVpnConn                                sync.RWMutex
+	lockUpdateSpokeGatewaySubnetGroup                   sync.RWMutex
+	lockUpdateSpokePendingApprovedCidrs                 sync.RWMutex
+	lockUpdateSpokePendingApprovedCidrsGatewayGroup     sync.RWMutex
+	lockUpdateTags                                      sync.RWMutex
+	lockUpdateTransitGatewayCustomizedVpcRoute          sync.RWMutex
+	lockUpdateTransitPendingApprovedCidrs               sync.RWMutex
+	lockUpdateTransitPendingApprovedCidrsGatewayGroup   sync.RWMutex
+	lockUpdateVpnCidr                                   sync.RWMutex
+}
+
+// AddSpokeGatewaySubnetGroup calls AddSpokeGatewaySubnetGroupFunc.
+func (mock *EdgeClientMock) AddSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error {
+	if mock.AddSpokeGatewaySubnetGroupFunc == nil {
+		panic("EdgeClientMock.AddSpokeGatewaySubnetGroupFunc: method is nil but EdgeClient.AddSpokeGatewaySubnetGroup was just called")
+	}
+	callInfo := struct {
+		Ctx                     context.Context
+		SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+	}{
+		Ctx:                     ctx,
+		SpokeGatewaySubnetGroup: spokeGatewaySubnetGroup,
+	}
+	mock.lockAddSpokeGatewaySubnetGroup.Lock()
+	mock.calls.AddSpokeGatewaySubnetGroup = append(mock.calls.AddSpokeGatewaySubnetGroup, callInfo)
+	mock.lockAddSpokeGatewaySubnetGroup.Unlock()
+	return mock.AddSpokeGatewaySubnetGroupFunc(ctx, spokeGatewaySubnetGroup)
+}
+
+// AddSpokeGatewaySubnetGroupCalls gets all the calls that were made to AddSpokeGatewaySubnetGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.AddSpokeGatewaySubnetGroupCalls())
+func (mock *EdgeClientMock) AddSpokeGatewaySubnetGroupCalls() []struct {
+	Ctx                     context.Context
+	SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+} {
+	var calls []struct {
+		Ctx                     context.Context
+		SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+	}
+	mock.lockAddSpokeGatewaySubnetGroup.RLock()
+	calls = mock.calls.AddSpokeGatewaySubnetGroup
+	mock.lockAddSpokeGatewaySubnetGroup.RUnlock()
+	return calls
+}
+
+// AttachTransitGWForHybrid calls AttachTransitGWForHybridFunc.
+func (mock *EdgeClientMock) AttachTransitGWForHybrid(gateway *TransitVpc) error {
+	if mock.AttachTransitGWForHybridFunc == nil {
+		panic("EdgeClientMock.AttachTransitGWForHybridFunc: method is nil but EdgeClient.AttachTransitGWForHybrid was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockAttachTransitGWForHybrid.Lock()
+	mock.calls.AttachTransitGWForHybrid = append(mock.calls.AttachTransitGWForHybrid, callInfo)
+	mock.lockAttachTransitGWForHybrid.Unlock()
+	return mock.AttachTransitGWForHybridFunc(gateway)
+}
+
+// AttachTransitGWForHybridCalls gets all the calls that were made to AttachTransitGWForHybrid.
+// Check the length with:
+//
+//	len(mockedEdgeClient.AttachTransitGWForHybridCalls())
+func (mock *EdgeClientMock) AttachTransitGWForHybridCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockAttachTransitGWForHybrid.RLock()
+	calls = mock.calls.AttachTransitGWForHybrid
+	mock.lockAttachTransitGWForHybrid.RUnlock()
+	return calls
+}
+
+// ChangeBgpHoldTime calls ChangeBgpHoldTimeFunc.
+func (mock *EdgeClientMock) ChangeBgpHoldTime(gwName string, holdTime int) error {
+	if mock.ChangeBgpHoldTimeFunc == nil {
+		panic("EdgeClientMock.ChangeBgpHoldTimeFunc: method is nil but EdgeClient.ChangeBgpHoldTime was just called")
+	}
+	callInfo := struct {
+		GwName   string
+		HoldTime int
+	}{
+		GwName:   gwName,
+		HoldTime: holdTime,
+	}
+	mock.lockChangeBgpHoldTime.Lock()
+	mock.calls.ChangeBgpHoldTime = append(mock.calls.ChangeBgpHoldTime, callInfo)
+	mock.lockChangeBgpHoldTime.Unlock()
+	return mock.ChangeBgpHoldTimeFunc(gwName, holdTime)
+}
+
+// ChangeBgpHoldTimeCalls gets all the calls that were made to ChangeBgpHoldTime.
+// Check the length with:
+//
+//	len(mockedEdgeClient.ChangeBgpHoldTimeCalls())
+func (mock *EdgeClientMock) ChangeBgpHoldTimeCalls() []struct {
+	GwName   string
+	HoldTime int
+} {
+	var calls []struct {
+		GwName   string
+		HoldTime int
+	}
+	mock.lockChangeBgpHoldTime.RLock()
+	calls = mock.calls.ChangeBgpHoldTime
+	mock.lockChangeBgpHoldTime.RUnlock()
+	return calls
+}
+
+// ChangeBgpHoldTimeGatewayGroup calls ChangeBgpHoldTimeGatewayGroupFunc.
+func (mock *EdgeClientMock) ChangeBgpHoldTimeGatewayGroup(ctx context.Context, groupName string, holdTime int) error {
+	if mock.ChangeBgpHoldTimeGatewayGroupFunc == nil {
+		panic("EdgeClientMock.ChangeBgpHoldTimeGatewayGroupFunc: method is nil but EdgeClient.ChangeBgpHoldTimeGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+		HoldTime  int
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+		HoldTime:  holdTime,
+	}
+	mock.lockChangeBgpHoldTimeGatewayGroup.Lock()
+	mock.calls.ChangeBgpHoldTimeGatewayGroup = append(mock.calls.ChangeBgpHoldTimeGatewayGroup, callInfo)
+	mock.lockChangeBgpHoldTimeGatewayGroup.Unlock()
+	return mock.ChangeBgpHoldTimeGatewayGroupFunc(ctx, groupName, holdTime)
+}
+
+// ChangeBgpHoldTimeGatewayGroupCalls gets all the calls that were made to ChangeBgpHoldTimeGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.ChangeBgpHoldTimeGatewayGroupCalls())
+func (mock *EdgeClientMock) ChangeBgpHoldTimeGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+	HoldTime  int
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+		HoldTime  int
+	}
+	mock.lockChangeBgpHoldTimeGatewayGroup.RLock()
+	calls = mock.calls.ChangeBgpHoldTimeGatewayGroup
+	mock.lockChangeBgpHoldTimeGatewayGroup.RUnlock()
+	return calls
+}
+
+// ChangeBgpOverLanIntfCnt calls ChangeBgpOverLanIntfCntFunc.
+func (mock *EdgeClientMock) ChangeBgpOverLanIntfCnt(gateway *Gateway) error {
+	if mock.ChangeBgpOverLanIntfCntFunc == nil {
+		panic("EdgeClientMock.ChangeBgpOverLanIntfCntFunc: method is nil but EdgeClient.ChangeBgpOverLanIntfCnt was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockChangeBgpOverLanIntfCnt.Lock()
+	mock.calls.ChangeBgpOverLanIntfCnt = append(mock.calls.ChangeBgpOverLanIntfCnt, callInfo)
+	mock.lockChangeBgpOverLanIntfCnt.Unlock()
+	return mock.ChangeBgpOverLanIntfCntFunc(gateway)
+}
+
+// ChangeBgpOverLanIntfCntCalls gets all the calls that were made to ChangeBgpOverLanIntfCnt.
+// Check the length with:
+//
+//	len(mockedEdgeClient.ChangeBgpOverLanIntfCntCalls())
+func (mock *EdgeClientMock) ChangeBgpOverLanIntfCntCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockChangeBgpOverLanIntfCnt.RLock()
+	calls = mock.calls.ChangeBgpOverLanIntfCnt
+	mock.lockChangeBgpOverLanIntfCnt.RUnlock()
+	return calls
+}
+
+// CreateEdgeCSP calls CreateEdgeCSPFunc.
+func (mock *EdgeClientMock) CreateEdgeCSP(ctx context.Context, edgeCSP *EdgeCSP) error {
+	if mock.CreateEdgeCSPFunc == nil {
+		panic("EdgeClientMock.CreateEdgeCSPFunc: method is nil but EdgeClient.CreateEdgeCSP was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		EdgeCSP *EdgeCSP
+	}{
+		Ctx:     ctx,
+		EdgeCSP: edgeCSP,
+	}
+	mock.lockCreateEdgeCSP.Lock()
+	mock.calls.CreateEdgeCSP = append(mock.calls.CreateEdgeCSP, callInfo)
+	mock.lockCreateEdgeCSP.Unlock()
+	return mock.CreateEdgeCSPFunc(ctx, edgeCSP)
+}
+
+// CreateEdgeCSPCalls gets all the calls that were made to CreateEdgeCSP.
+// Check the length with:
+//
+//	len(mockedEdgeClient.CreateEdgeCSPCalls())
+func (mock *EdgeClientMock) CreateEdgeCSPCalls() []struct {
+	Ctx     context.Context
+	EdgeCSP *EdgeCSP
+} {
+	var calls []struct {
+		Ctx     context.Context
+		EdgeCSP *EdgeCSP
+	}
+	mock.lockCreateEdgeCSP.RLock()
+	calls = mock.calls.CreateEdgeCSP
+	mock.lockCreateEdgeCSP.RUnlock()
+	return calls
+}
+
+// CreateEdgeCSPHa calls CreateEdgeCSPHaFunc.
+func (mock *EdgeClientMock) CreateEdgeCSPHa(ctx context.Context, edgeCSPHa *EdgeCSPHa) (string, error) {
+	if mock.CreateEdgeCSPHaFunc == nil {
+		panic("EdgeClientMock.CreateEdgeCSPHaFunc: method is nil but EdgeClient.CreateEdgeCSPHa was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		EdgeCSPHa *EdgeCSPHa
+	}{
+		Ctx:       ctx,
+		EdgeCSPHa: edgeCSPHa,
+	}
+	mock.lockCreateEdgeCSPHa.Lock()
+	mock.calls.CreateEdgeCSPHa = append(mock.calls.CreateEdgeCSPHa, callInfo)
+	mock.lockCreateEdgeCSPHa.Unlock()
+	return mock.CreateEdgeCSPHaFunc(ctx, edgeCSPHa)
+}
+
+// CreateEdgeCSPHaCalls gets all the calls that were made to CreateEdgeCSPHa.
+// Check the length with:
+//
+//	len(mockedEdgeClient.CreateEdgeCSPHaCalls())
+func (mock *EdgeClientMock) CreateEdgeCSPHaCalls() []struct {
+	Ctx       context.Context
+	EdgeCSPHa *EdgeCSPHa
+} {
+	var calls []struct {
+		Ctx       context.Context
+		EdgeCSPHa *EdgeCSPHa
+	}
+	mock.lockCreateEdgeCSPHa.RLock()
+	calls = mock.calls.CreateEdgeCSPHa
+	mock.lockCreateEdgeCSPHa.RUnlock()
+	return calls
+}
+
+// CreateEdgeEquinix calls CreateEdgeEquinixFunc.
+func (mock *EdgeClientMock) CreateEdgeEquinix(ctx context.Context, edgeEquinix *EdgeEquinix) error {
+	if mock.CreateEdgeEquinixFunc == nil {
+		panic("EdgeClientMock.CreateEdgeEquinixFunc: method is nil but EdgeClient.CreateEdgeEquinix was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		EdgeEquinix *EdgeEquinix
+	}{
+		Ctx:         ctx,
+		EdgeEquinix: edgeEquinix,
+	}
+	mock.lockCreateEdgeEquinix.Lock()
+	mock.calls.CreateEdgeEquinix = append(mock.calls.CreateEdgeEquinix, callInfo)
+	mock.lockCreateEdgeEquinix.Unlock()
+	return mock.CreateEdgeEquinixFunc(ctx, edgeEquinix)
+}
+
+// CreateEdgeEquinixCalls gets all the calls that were made to CreateEdgeEquinix.
+// Check the length with:
+//
+//	len(mockedEdgeClient.CreateEdgeEquinixCalls())
+func (mock *EdgeClientMock) CreateEdgeEquinixCalls() []struct {
+	Ctx         context.Context
+	EdgeEquinix *EdgeEquinix
+} {
+	var calls []struct {
+		Ctx         context.Context
+		EdgeEquinix *EdgeEquinix
+	}
+	mock.lockCreateEdgeEquinix.RLock()
+	calls = mock.calls.CreateEdgeEquinix
+	mock.lockCreateEdgeEquinix.RUnlock()
+	return calls
+}
+
+// CreateEdgeEquinixHa calls CreateEdgeEquinixHaFunc.
+func (mock *EdgeClientMock) CreateEdgeEquinixHa(ctx context.Context, edgeEquinixHa *EdgeEquinixHa) (string, error) {
+	if mock.CreateEdgeEquinixHaFunc == nil {
+		panic("EdgeClientMock.CreateEdgeEquinixHaFunc: method is nil but EdgeClient.CreateEdgeEquinixHa was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		EdgeEquinixHa *EdgeEquinixHa
+	}{
+		Ctx:           ctx,
+		EdgeEquinixHa: edgeEquinixHa,
+	}
+	mock.lockCreateEdgeEquinixHa.Lock()
+	mock.calls.CreateEdgeEquinixHa = append(mock.calls.CreateEdgeEquinixHa, callInfo)
+	mock.lockCreateEdgeEquinixHa.Unlock()
+	return mock.CreateEdgeEquinixHaFunc(ctx, edgeEquinixHa)
+}
+
+// CreateEdgeEquinixHaCalls gets all the calls that were made to CreateEdgeEquinixHa.
+// Check the length with:
+//
+//	len(mockedEdgeClient.CreateEdgeEquinixHaCalls())
+func (mock *EdgeClientMock) CreateEdgeEquinixHaCalls() []struct {
+	Ctx           context.Context
+	EdgeEquinixHa *EdgeEquinixHa
+} {
+	var calls []struct {
+		Ctx           context.Context
+		EdgeEquinixHa *EdgeEquinixHa
+	}
+	mock.lockCreateEdgeEquinixHa.RLock()
+	calls = mock.calls.CreateEdgeEquinixHa
+	mock.lockCreateEdgeEquinixHa.RUnlock()
+	return calls
+}
+
+// CreateEdgeExternalDeviceConn calls CreateEdgeExternalDeviceConnFunc.
+func (mock *EdgeClientMock) CreateEdgeExternalDeviceConn(edgeExternalDeviceConn *EdgeExternalDeviceConn) (string, error) {
+	if mock.CreateEdgeExternalDeviceConnFunc == nil {
+		panic("EdgeClientMock.CreateEdgeExternalDeviceConnFunc: method is nil but EdgeClient.CreateEdgeExternalDeviceConn was just called")
+	}
+	callInfo := struct {
+		EdgeExternalDeviceConn *EdgeExternalDeviceConn
+	}{
+		EdgeExternalDeviceConn: edgeExternalDeviceConn,
+	}
+	mock.lockCreateEdgeExternalDeviceConn.Lock()
+	mock.calls.CreateEdgeExternalDeviceConn = append(mock.calls.CreateEdgeExternalDeviceConn, callInfo)
+	mock.lockCreateEdgeExternalDeviceConn.Unlock()
+	return mock.CreateEdgeExternalDeviceConnFunc(edgeExternalDeviceConn)
+}
+
+// CreateEdgeExternalDeviceConnCalls gets all the calls that were made to CreateEdgeExternalDeviceConn.
+// Check the length with:
+//
+//	len(mockedEdgeClient.CreateEdgeExternalDeviceConnCalls())
+func (mock *EdgeClientMock) CreateEdgeExternalDeviceConnCalls() []struct {
+	EdgeExternalDeviceConn *EdgeExternalDeviceConn
+} {
+	var calls []struct {
+		EdgeExternalDeviceConn *EdgeExternalDeviceConn
+	}
+	mock.lockCreateEdgeExternalDeviceConn.RLock()
+	calls = mock.calls.CreateEdgeExternalDeviceConn
+	mock.lockCreateEdgeExternalDeviceConn.RUnlock()
+	return calls
+}
+
+// CreateEdgeMegaport calls CreateEdgeMegaportFunc.
+func (mock *EdgeClientMock) CreateEdgeMegaport(ctx context.Context, edgeMegaport *EdgeMegaport) error {
+	if mock.CreateEdgeMegaportFunc == nil {
+		panic("EdgeClientMock.CreateEdgeMegaportFunc: method is nil but EdgeClient.CreateEdgeMegaport was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		EdgeMegaport *EdgeMegaport
+	}{
+		Ctx:          ctx,
+		EdgeMegaport: edgeMegaport,
+	}
+	mock.lockCreateEdgeMegaport.Lock()
+	mock.calls.CreateEdgeMegaport = append(mock.calls.CreateEdgeMegaport, callInfo)
+	mock.lockCreateEdgeMegaport.Unlock()
+	return mock.CreateEdgeMegaportFunc(ctx, edgeMegaport)
+}
+
+// CreateEdgeMegaportCalls gets all the calls that were made to CreateEdgeMegaport.
+// Check the length with:
+//
+//	len(mockedEdgeClient.CreateEdgeMegaportCalls())
+func (mock *EdgeClientMock) CreateEdgeMegaportCalls() []struct {
+	Ctx          context.Context
+	EdgeMegaport *EdgeMegaport
+} {
+	var calls []struct {
+		Ctx          context.Context
+		EdgeMegaport *EdgeMegaport
+	}
+	mock.lockCreateEdgeMegaport.RLock()
+	calls = mock.calls.CreateEdgeMegaport
+	mock.lockCreateEdgeMegaport.RUnlock()
+	return calls
+}
+
+// CreateEdgeMegaportHa calls CreateEdgeMegaportHaFunc.
+func (mock *EdgeClientMock) CreateEdgeMegaportHa(ctx context.Context, edgeMegaportHa *EdgeMegaportHa) (string, error) {
+	if mock.CreateEdgeMegaportHaFunc == nil {
+		panic("EdgeClientMock.CreateEdgeMegaportHaFunc: method is nil but EdgeClient.CreateEdgeMegaportHa was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		EdgeMegaportHa *EdgeMegaportHa
+	}{
+		Ctx:            ctx,
+		EdgeMegaportHa: edgeMegaportHa,
+	}
+	mock.lockCreateEdgeMegaportHa.Lock()
+	mock.calls.CreateEdgeMegaportHa = append(mock.calls.CreateEdgeMegaportHa, callInfo)
+	mock.lockCreateEdgeMegaportHa.Unlock()
+	return mock.CreateEdgeMegaportHaFunc(ctx, edgeMegaportHa)
+}
+
+// CreateEdgeMegaportHaCalls gets all the calls that were made to CreateEdgeMegaportHa.
+// Check the length with:
+//
+//	len(mockedEdgeClient.CreateEdgeMegaportHaCalls())
+func (mock *EdgeClientMock) CreateEdgeMegaportHaCalls() []struct {
+	Ctx            context.Context
+	EdgeMegaportHa *EdgeMegaportHa
+} {
+	var calls []struct {
+		Ctx            context.Context
+		EdgeMegaportHa *EdgeMegaportHa
+	}
+	mock.lockCreateEdgeMegaportHa.RLock()
+	calls = mock.calls.CreateEdgeMegaportHa
+	mock.lockCreateEdgeMegaportHa.RUnlock()
+	return calls
+}
+
+// CreateEdgeNEO calls CreateEdgeNEOFunc.
+func (mock *EdgeClientMock) CreateEdgeNEO(ctx context.Context, edgeNEO *EdgeNEO) error {
+	if mock.CreateEdgeNEOFunc == nil {
+		panic("EdgeClientMock.CreateEdgeNEOFunc: method is nil but EdgeClient.CreateEdgeNEO was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		EdgeNEO *EdgeNEO
+	}{
+		Ctx:     ctx,
+		EdgeNEO: edgeNEO,
+	}
+	mock.lockCreateEdgeNEO.Lock()
+	mock.calls.CreateEdgeNEO = append(mock.calls.CreateEdgeNEO, callInfo)
+	mock.lockCreateEdgeNEO.Unlock()
+	return mock.CreateEdgeNEOFunc(ctx, edgeNEO)
+}
+
+// CreateEdgeNEOCalls gets all the calls that were made to CreateEdgeNEO.
+// Check the length with:
+//
+//	len(mockedEdgeClient.CreateEdgeNEOCalls())
+func (mock *EdgeClientMock) CreateEdgeNEOCalls() []struct {
+	Ctx     context.Context
+	EdgeNEO *EdgeNEO
+} {
+	var calls []struct {
+		Ctx     context.Context
+		EdgeNEO *EdgeNEO
+	}
+	mock.lockCreateEdgeNEO.RLock()
+	calls = mock.calls.CreateEdgeNEO
+	mock.lockCreateEdgeNEO.RUnlock()
+	return calls
+}
+
+// CreateEdgeNEOHa calls CreateEdgeNEOHaFunc.
+func (mock *EdgeClientMock) CreateEdgeNEOHa(ctx context.Context, edgeNEOHa *EdgeNEOHa) (string, error) {
+	if mock.CreateEdgeNEOHaFunc == nil {
+		panic("EdgeClientMock.CreateEdgeNEOHaFunc: method is nil but EdgeClient.CreateEdgeNEOHa was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		EdgeNEOHa *EdgeNEOHa
+	}{
+		Ctx:       ctx,
+		EdgeNEOHa: edgeNEOHa,
+	}
+	mock.lockCreateEdgeNEOHa.Lock()
+	mock.calls.CreateEdgeNEOHa = append(mock.calls.CreateEdgeNEOHa, callInfo)
+	mock.lockCreateEdgeNEOHa.Unlock()
+	return mock.CreateEdgeNEOHaFunc(ctx, edgeNEOHa)
+}
+
+// CreateEdgeNEOHaCalls gets all the calls that were made to CreateEdgeNEOHa.
+// Check the length with:
+//
+//	len(mockedEdgeClient.CreateEdgeNEOHaCalls())
+func (mock *EdgeClientMock) CreateEdgeNEOHaCalls() []struct {
+	Ctx       context.Context
+	EdgeNEOHa *EdgeNEOHa
+} {
+	var calls []struct {
+		Ctx       context.Context
+		EdgeNEOHa *EdgeNEOHa
+	}
+	mock.lockCreateEdgeNEOHa.RLock()
+	calls = mock.calls.CreateEdgeNEOHa
+	mock.lockCreateEdgeNEOHa.RUnlock()
+	return calls
+}
+
+// CreateEdgeProxyProfile calls CreateEdgeProxyProfileFunc.
+func (mock *EdgeClientMock) CreateEdgeProxyProfile(ctx context.Context, edgeNEOProxyProfile *EdgePlatformProxyProfile) (*EdgePlatformProxyProfileResp, error) {
+	if mock.CreateEdgeProxyProfileFunc == nil {
+		panic("EdgeClientMock.CreateEdgeProxyProfileFunc: method is nil but EdgeClient.CreateEdgeProxyProfile was just called")
+	}
+	callInfo := struct {
+		Ctx                 context.Context
+		EdgeNEOProxyProfile *EdgePlatformProxyProfile
+	}{
+		Ctx:                 ctx,
+		EdgeNEOProxyProfile: edgeNEOProxyProfile,
+	}
+	mock.lockCreateEdgeProxyProfile.Lock()
+	mock.calls.CreateEdgeProxyProfile = append(mock.calls.CreateEdgeProxyProfile, callInfo)
+	mock.lockCreateEdgeProxyProfile.Unlock()
+	return mock.CreateEdgeProxyProfileFunc(ctx, edgeNEOProxyProfile)
+}
+
+// CreateEdgeProxyProfileCalls gets all the calls that were made to CreateEdgeProxyProfile.
+// Check the length with:
+//
+//	len(mockedEdgeClient.CreateEdgeProxyProfileCalls())
+func (mock *EdgeClientMock) CreateEdgeProxyProfileCalls() []struct {
+	Ctx                 context.Context
+	EdgeNEOProxyProfile *EdgePlatformProxyProfile
+} {
+	var calls []struct {
+		Ctx                 context.Context
+		EdgeNEOProxyProfile *EdgePlatformProxyProfile
+	}
+	mock.lockCreateEdgeProxyProfile.RLock()
+	calls = mock.calls.CreateEdgeProxyProfile
+	mock.lockCreateEdgeProxyProfile.RUnlock()
+	return calls
+}
+
+// CreateEdgeSpoke calls CreateEdgeSpokeFunc.
+func (mock *EdgeClientMock) CreateEdgeSpoke(ctx context.Context, edgeSpoke *EdgeSpoke) error {
+	if mock.CreateEdgeSpokeFunc == nil {
+		panic("EdgeClientMock.CreateEdgeSpokeFunc: method is nil but EdgeClient.CreateEdgeSpoke was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		EdgeSpoke *EdgeSpoke
+	}{
+		Ctx:       ctx,
+		EdgeSpoke: edgeSpoke,
+	}
+	mock.lockCreateEdgeSpoke.Lock()
+	mock.calls.CreateEdgeSpoke = append(mock.calls.CreateEdgeSpoke, callInfo)
+	mock.lockCreateEdgeSpoke.Unlock()
+	return mock.CreateEdgeSpokeFunc(ctx, edgeSpoke)
+}
+
+// CreateEdgeSpokeCalls gets all the calls that were made to CreateEdgeSpoke.
+// Check the length with:
+//
+//	len(mockedEdgeClient.CreateEdgeSpokeCalls())
+func (mock *EdgeClientMock) CreateEdgeSpokeCalls() []struct {
+	Ctx       context.Context
+	EdgeSpoke *EdgeSpoke
+} {
+	var calls []struct {
+		Ctx       context.Context
+		EdgeSpoke *EdgeSpoke
+	}
+	mock.lockCreateEdgeSpoke.RLock()
+	calls = mock.calls.CreateEdgeSpoke
+	mock.lockCreateEdgeSpoke.RUnlock()
+	return calls
+}
+
+// CreateEdgeVmSelfmanagedHa calls CreateEdgeVmSelfmanagedHaFunc.
+func (mock *EdgeClientMock) CreateEdgeVmSelfmanagedHa(ctx context.Context, edgeVmSelfmanagedHa *EdgeVmSelfmanagedHa) (string, error) {
+	if mock.CreateEdgeVmSelfmanagedHaFunc == nil {
+		panic("EdgeClientMock.CreateEdgeVmSelfmanagedHaFunc: method is nil but EdgeClient.CreateEdgeVmSelfmanagedHa was just called")
+	}
+	callInfo := struct {
+		Ctx                 context.Context
+		EdgeVmSelfmanagedHa *EdgeVmSelfmanagedHa
+	}{
+		Ctx:                 ctx,
+		EdgeVmSelfmanagedHa: edgeVmSelfmanagedHa,
+	}
+	mock.lockCreateEdgeVmSelfmanagedHa.Lock()
+	mock.calls.CreateEdgeVmSelfmanagedHa = append(mock.calls.CreateEdgeVmSelfmanagedHa, callInfo)
+	mock.lockCreateEdgeVmSelfmanagedHa.Unlock()
+	return mock.CreateEdgeVmSelfmanagedHaFunc(ctx, edgeVmSelfmanagedHa)
+}
+
+// CreateEdgeVmSelfmanagedHaCalls gets all the calls that were made to CreateEdgeVmSelfmanagedHa.
+// Check the length with:
+//
+//	len(mockedEdgeClient.CreateEdgeVmSelfmanagedHaCalls())
+func (mock *EdgeClientMock) CreateEdgeVmSelfmanagedHaCalls() []struct {
+	Ctx                 context.Context
+	EdgeVmSelfmanagedHa *EdgeVmSelfmanagedHa
+} {
+	var calls []struct {
+		Ctx                 context.Context
+		EdgeVmSelfmanagedHa *EdgeVmSelfmanagedHa
+	}
+	mock.lockCreateEdgeVmSelfmanagedHa.RLock()
+	calls = mock.calls.CreateEdgeVmSelfmanagedHa
+	mock.lockCreateEdgeVmSelfmanagedHa.RUnlock()
+	return calls
+}
+
+// CreateExternalDeviceConn calls CreateExternalDeviceConnFunc.
+func (mock *EdgeClientMock) CreateExternalDeviceConn(externalDeviceConn *ExternalDeviceConn) error {
+	if mock.CreateExternalDeviceConnFunc == nil {
+		panic("EdgeClientMock.CreateExternalDeviceConnFunc: method is nil but EdgeClient.CreateExternalDeviceConn was just called")
+	}
+	callInfo := struct {
+		ExternalDeviceConn *ExternalDeviceConn
+	}{
+		ExternalDeviceConn: externalDeviceConn,
+	}
+	mock.lockCreateExternalDeviceConn.Lock()
+	mock.calls.CreateExternalDeviceConn = append(mock.calls.CreateExternalDeviceConn, callInfo)
+	mock.lockCreateExternalDeviceConn.Unlock()
+	return mock.CreateExternalDeviceConnFunc(externalDeviceConn)
+}
+
+// CreateExternalDeviceConnCalls gets all the calls that were made to CreateExternalDeviceConn.
+// Check the length with:
+//
+//	len(mockedEdgeClient.CreateExternalDeviceConnCalls())
+func (mock *EdgeClientMock) CreateExternalDeviceConnCalls() []struct {
+	ExternalDeviceConn *ExternalDeviceConn
+} {
+	var calls []struct {
+		ExternalDeviceConn *ExternalDeviceConn
+	}
+	mock.lockCreateExternalDeviceConn.RLock()
+	calls = mock.calls.CreateExternalDeviceConn
+	mock.lockCreateExternalDeviceConn.RUnlock()
+	return calls
+}
+
+// CreateGatewayContext calls CreateGatewayContextFunc.
+func (mock *EdgeClientMock) CreateGatewayContext(ctx context.Context, gateway *Gateway) error {
+	if mock.CreateGatewayContextFunc == nil {
+		panic("EdgeClientMock.CreateGatewayContextFunc: method is nil but EdgeClient.CreateGatewayContext was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}{
+		Ctx:     ctx,
+		Gateway: gateway,
+	}
+	mock.lockCreateGatewayContext.Lock()
+	mock.calls.CreateGatewayContext = append(mock.calls.CreateGatewayContext, callInfo)
+	mock.lockCreateGatewayContext.Unlock()
+	return mock.CreateGatewayContextFunc(ctx, gateway)
+}
+
+// CreateGatewayContextCalls gets all the calls that were made to CreateGatewayContext.
+// Check the length with:
+//
+//	len(mockedEdgeClient.CreateGatewayContextCalls())
+func (mock *EdgeClientMock) CreateGatewayContextCalls() []struct {
+	Ctx     context.Context
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}
+	mock.lockCreateGatewayContext.RLock()
+	calls = mock.calls.CreateGatewayContext
+	mock.lockCreateGatewayContext.RUnlock()
+	return calls
+}
+
+// CreateGatewayGroup calls CreateGatewayGroupFunc.
+func (mock *EdgeClientMock) CreateGatewayGroup(ctx context.Context, spokeGroup *GatewayGroup) error {
+	if mock.CreateGatewayGroupFunc == nil {
+		panic("EdgeClientMock.CreateGatewayGroupFunc: method is nil but EdgeClient.CreateGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		SpokeGroup *GatewayGroup
+	}{
+		Ctx:        ctx,
+		SpokeGroup: spokeGroup,
+	}
+	mock.lockCreateGatewayGroup.Lock()
+	mock.calls.CreateGatewayGroup = append(mock.calls.CreateGatewayGroup, callInfo)
+	mock.lockCreateGatewayGroup.Unlock()
+	return mock.CreateGatewayGroupFunc(ctx, spokeGroup)
+}
+
+// CreateGatewayGroupCalls gets all the calls that were made to CreateGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.CreateGatewayGroupCalls())
+func (mock *EdgeClientMock) CreateGatewayGroupCalls() []struct {
+	Ctx        context.Context
+	SpokeGroup *GatewayGroup
+} {
+	var calls []struct {
+		Ctx        context.Context
+		SpokeGroup *GatewayGroup
+	}
+	mock.lockCreateGatewayGroup.RLock()
+	calls = mock.calls.CreateGatewayGroup
+	mock.lockCreateGatewayGroup.RUnlock()
+	return calls
+}
+
+// CreatePublicSubnetFilteringGatewayContext calls CreatePublicSubnetFilteringGatewayContextFunc.
+func (mock *EdgeClientMock) CreatePublicSubnetFilteringGatewayContext(ctx context.Context, gateway *Gateway) error {
+	if mock.CreatePublicSubnetFilteringGatewayContextFunc == nil {
+		panic("EdgeClientMock.CreatePublicSubnetFilteringGatewayContextFunc: method is nil but EdgeClient.CreatePublicSubnetFilteringGatewayContext was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}{
+		Ctx:     ctx,
+		Gateway: gateway,
+	}
+	mock.lockCreatePublicSubnetFilteringGatewayContext.Lock()
+	mock.calls.CreatePublicSubnetFilteringGatewayContext = append(mock.calls.CreatePublicSubnetFilteringGatewayContext, callInfo)
+	mock.lockCreatePublicSubnetFilteringGatewayContext.Unlock()
+	return mock.CreatePublicSubnetFilteringGatewayContextFunc(ctx, gateway)
+}
+
+// CreatePublicSubnetFilteringGatewayContextCalls gets all the calls that were made to CreatePublicSubnetFilteringGatewayContext.
+// Check the length with:
+//
+//	len(mockedEdgeClient.CreatePublicSubnetFilteringGatewayContextCalls())
+func (mock *EdgeClientMock) CreatePublicSubnetFilteringGatewayContextCalls() []struct {
+	Ctx     context.Context
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}
+	mock.lockCreatePublicSubnetFilteringGatewayContext.RLock()
+	calls = mock.calls.CreatePublicSubnetFilteringGatewayContext
+	mock.lockCreatePublicSubnetFilteringGatewayContext.RUnlock()
+	return calls
+}
+
+// CreateSpokeHaGw calls CreateSpokeHaGwFunc.
+func (mock *EdgeClientMock) CreateSpokeHaGw(spokeHaGateway *SpokeHaGateway) (string, error) {
+	if mock.CreateSpokeHaGwFunc == nil {
+		panic("EdgeClientMock.CreateSpokeHaGwFunc: method is nil but EdgeClient.CreateSpokeHaGw was just called")
+	}
+	callInfo := struct {
+		SpokeHaGateway *SpokeHaGateway
+	}{
+		SpokeHaGateway: spokeHaGateway,
+	}
+	mock.lockCreateSpokeHaGw.Lock()
+	mock.calls.CreateSpokeHaGw = append(mock.calls.CreateSpokeHaGw, callInfo)
+	mock.lockCreateSpokeHaGw.Unlock()
+	return mock.CreateSpokeHaGwFunc(spokeHaGateway)
+}
+
+// CreateSpokeHaGwCalls gets all the calls that were made to CreateSpokeHaGw.
+// Check the length with:
+//
+//	len(mockedEdgeClient.CreateSpokeHaGwCalls())
+func (mock *EdgeClientMock) CreateSpokeHaGwCalls() []struct {
+	SpokeHaGateway *SpokeHaGateway
+} {
+	var calls []struct {
+		SpokeHaGateway *SpokeHaGateway
+	}
+	mock.lockCreateSpokeHaGw.RLock()
+	calls = mock.calls.CreateSpokeHaGw
+	mock.lockCreateSpokeHaGw.RUnlock()
+	return calls
+}
+
+// CreateSpokeHaGwContext calls CreateSpokeHaGwContextFunc.
+func (mock *EdgeClientMock) CreateSpokeHaGwContext(ctx context.Context, spokeHaGateway *SpokeHaGateway) (string, error) {
+	if mock.CreateSpokeHaGwContextFunc == nil {
+		panic("EdgeClientMock.CreateSpokeHaGwContextFunc: method is nil but EdgeClient.CreateSpokeHaGwContext was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		SpokeHaGateway *SpokeHaGateway
+	}{
+		Ctx:            ctx,
+		SpokeHaGateway: spokeHaGateway,
+	}
+	mock.lockCreateSpokeHaGwContext.Lock()
+	mock.calls.CreateSpokeHaGwContext = append(mock.calls.CreateSpokeHaGwContext, callInfo)
+	mock.lockCreateSpokeHaGwContext.Unlock()
+	return mock.CreateSpokeHaGwContextFunc(ctx, spokeHaGateway)
+}
+
+// CreateSpokeHaGwContextCalls gets all the calls that were made to CreateSpokeHaGwContext.
+// Check the length with:
+//
+//	len(mockedEdgeClient.CreateSpokeHaGwContextCalls())
+func (mock *EdgeClientMock) CreateSpokeHaGwContextCalls() []struct {
+	Ctx            context.Context
+	SpokeHaGateway *SpokeHaGateway
+} {
+	var calls []struct {
+		Ctx            context.Context
+		SpokeHaGateway *SpokeHaGateway
+	}
+	mock.lockCreateSpokeHaGwContext.RLock()
+	calls = mock.calls.CreateSpokeHaGwContext
+	mock.lockCreateSpokeHaGwContext.RUnlock()
+	return calls
+}
+
+// CreateSpokeTransitAttachment calls CreateSpokeTransitAttachmentFunc.
+func (mock *EdgeClientMock) CreateSpokeTransitAttachment(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) error {
+	if mock.CreateSpokeTransitAttachmentFunc == nil {
+		panic("EdgeClientMock.CreateSpokeTransitAttachmentFunc: method is nil but EdgeClient.CreateSpokeTransitAttachment was just called")
+	}
+	callInfo := struct {
+		Ctx                    context.Context
+		SpokeTransitAttachment *SpokeTransitAttachment
+	}{
+		Ctx:                    ctx,
+		SpokeTransitAttachment: spokeTransitAttachment,
+	}
+	mock.lockCreateSpokeTransitAttachment.Lock()
+	mock.calls.CreateSpokeTransitAttachment = append(mock.calls.CreateSpokeTransitAttachment, callInfo)
+	mock.lockCreateSpokeTransitAttachment.Unlock()
+	return mock.CreateSpokeTransitAttachmentFunc(ctx, spokeTransitAttachment)
+}
+
+// CreateSpokeTransitAttachmentCalls gets all the calls that were made to CreateSpokeTransitAttachment.
+// Check the length with:
+//
+//	len(mockedEdgeClient.CreateSpokeTransitAttachmentCalls())
+func (mock *EdgeClientMock) CreateSpokeTransitAttachmentCalls() []struct {
+	Ctx                    context.Context
+	SpokeTransitAttachment *SpokeTransitAttachment
+} {
+	var calls []struct {
+		Ctx                    context.Context
+		SpokeTransitAttachment *SpokeTransitAttachment
+	}
+	mock.lockCreateSpokeTransitAttachment.RLock()
+	calls = mock.calls.CreateSpokeTransitAttachment
+	mock.lockCreateSpokeTransitAttachment.RUnlock()
+	return calls
+}
+
+// CreateTransitHaGwContext calls CreateTransitHaGwContextFunc.
+func (mock *EdgeClientMock) CreateTransitHaGwContext(ctx context.Context, transitHaGateway *TransitHaGateway) (string, error) {
+	if mock.CreateTransitHaGwContextFunc == nil {
+		panic("EdgeClientMock.CreateTransitHaGwContextFunc: method is nil but EdgeClient.CreateTransitHaGwContext was just called")
+	}
+	callInfo := struct {
+		Ctx              context.Context
+		TransitHaGateway *TransitHaGateway
+	}{
+		Ctx:              ctx,
+		TransitHaGateway: transitHaGateway,
+	}
+	mock.lockCreateTransitHaGwContext.Lock()
+	mock.calls.CreateTransitHaGwContext = append(mock.calls.CreateTransitHaGwContext, callInfo)
+	mock.lockCreateTransitHaGwContext.Unlock()
+	return mock.CreateTransitHaGwContextFunc(ctx, transitHaGateway)
+}
+
+// CreateTransitHaGwContextCalls gets all the calls that were made to CreateTransitHaGwContext.
+// Check the length with:
+//
+//	len(mockedEdgeClient.CreateTransitHaGwContextCalls())
+func (mock *EdgeClientMock) CreateTransitHaGwContextCalls() []struct {
+	Ctx              context.Context
+	TransitHaGateway *TransitHaGateway
+} {
+	var calls []struct {
+		Ctx              context.Context
+		TransitHaGateway *TransitHaGateway
+	}
+	mock.lockCreateTransitHaGwContext.RLock()
+	calls = mock.calls.CreateTransitHaGwContext
+	mock.lockCreateTransitHaGwContext.RUnlock()
+	return calls
+}
+
+// DeleteEdgeCSP calls DeleteEdgeCSPFunc.
+func (mock *EdgeClientMock) DeleteEdgeCSP(ctx context.Context, accountName string, name string) error {
+	if mock.DeleteEdgeCSPFunc == nil {
+		panic("EdgeClientMock.DeleteEdgeCSPFunc: method is nil but EdgeClient.DeleteEdgeCSP was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		AccountName string
+		Name        string
+	}{
+		Ctx:         ctx,
+		AccountName: accountName,
+		Name:        name,
+	}
+	mock.lockDeleteEdgeCSP.Lock()
+	mock.calls.DeleteEdgeCSP = append(mock.calls.DeleteEdgeCSP, callInfo)
+	mock.lockDeleteEdgeCSP.Unlock()
+	return mock.DeleteEdgeCSPFunc(ctx, accountName, name)
+}
+
+// DeleteEdgeCSPCalls gets all the calls that were made to DeleteEdgeCSP.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DeleteEdgeCSPCalls())
+func (mock *EdgeClientMock) DeleteEdgeCSPCalls() []struct {
+	Ctx         context.Context
+	AccountName string
+	Name        string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		AccountName string
+		Name        string
+	}
+	mock.lockDeleteEdgeCSP.RLock()
+	calls = mock.calls.DeleteEdgeCSP
+	mock.lockDeleteEdgeCSP.RUnlock()
+	return calls
+}
+
+// DeleteEdgeEquinix calls DeleteEdgeEquinixFunc.
+func (mock *EdgeClientMock) DeleteEdgeEquinix(ctx context.Context, accountName string, name string) error {
+	if mock.DeleteEdgeEquinixFunc == nil {
+		panic("EdgeClientMock.DeleteEdgeEquinixFunc: method is nil but EdgeClient.DeleteEdgeEquinix was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		AccountName string
+		Name        string
+	}{
+		Ctx:         ctx,
+		AccountName: accountName,
+		Name:        name,
+	}
+	mock.lockDeleteEdgeEquinix.Lock()
+	mock.calls.DeleteEdgeEquinix = append(mock.calls.DeleteEdgeEquinix, callInfo)
+	mock.lockDeleteEdgeEquinix.Unlock()
+	return mock.DeleteEdgeEquinixFunc(ctx, accountName, name)
+}
+
+// DeleteEdgeEquinixCalls gets all the calls that were made to DeleteEdgeEquinix.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DeleteEdgeEquinixCalls())
+func (mock *EdgeClientMock) DeleteEdgeEquinixCalls() []struct {
+	Ctx         context.Context
+	AccountName string
+	Name        string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		AccountName string
+		Name        string
+	}
+	mock.lockDeleteEdgeEquinix.RLock()
+	calls = mock.calls.DeleteEdgeEquinix
+	mock.lockDeleteEdgeEquinix.RUnlock()
+	return calls
+}
+
+// DeleteEdgeExternalDeviceConn calls DeleteEdgeExternalDeviceConnFunc.
+func (mock *EdgeClientMock) DeleteEdgeExternalDeviceConn(edgeExternalDeviceConn *EdgeExternalDeviceConn) error {
+	if mock.DeleteEdgeExternalDeviceConnFunc == nil {
+		panic("EdgeClientMock.DeleteEdgeExternalDeviceConnFunc: method is nil but EdgeClient.DeleteEdgeExternalDeviceConn was just called")
+	}
+	callInfo := struct {
+		EdgeExternalDeviceConn *EdgeExternalDeviceConn
+	}{
+		EdgeExternalDeviceConn: edgeExternalDeviceConn,
+	}
+	mock.lockDeleteEdgeExternalDeviceConn.Lock()
+	mock.calls.DeleteEdgeExternalDeviceConn = append(mock.calls.DeleteEdgeExternalDeviceConn, callInfo)
+	mock.lockDeleteEdgeExternalDeviceConn.Unlock()
+	return mock.DeleteEdgeExternalDeviceConnFunc(edgeExternalDeviceConn)
+}
+
+// DeleteEdgeExternalDeviceConnCalls gets all the calls that were made to DeleteEdgeExternalDeviceConn.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DeleteEdgeExternalDeviceConnCalls())
+func (mock *EdgeClientMock) DeleteEdgeExternalDeviceConnCalls() []struct {
+	EdgeExternalDeviceConn *EdgeExternalDeviceConn
+} {
+	var calls []struct {
+		EdgeExternalDeviceConn *EdgeExternalDeviceConn
+	}
+	mock.lockDeleteEdgeExternalDeviceConn.RLock()
+	calls = mock.calls.DeleteEdgeExternalDeviceConn
+	mock.lockDeleteEdgeExternalDeviceConn.RUnlock()
+	return calls
+}
+
+// DeleteEdgeMegaport calls DeleteEdgeMegaportFunc.
+func (mock *EdgeClientMock) DeleteEdgeMegaport(ctx context.Context, accountName string, name string) error {
+	if mock.DeleteEdgeMegaportFunc == nil {
+		panic("EdgeClientMock.DeleteEdgeMegaportFunc: method is nil but EdgeClient.DeleteEdgeMegaport was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		AccountName string
+		Name        string
+	}{
+		Ctx:         ctx,
+		AccountName: accountName,
+		Name:        name,
+	}
+	mock.lockDeleteEdgeMegaport.Lock()
+	mock.calls.DeleteEdgeMegaport = append(mock.calls.DeleteEdgeMegaport, callInfo)
+	mock.lockDeleteEdgeMegaport.Unlock()
+	return mock.DeleteEdgeMegaportFunc(ctx, accountName, name)
+}
+
+// DeleteEdgeMegaportCalls gets all the calls that were made to DeleteEdgeMegaport.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DeleteEdgeMegaportCalls())
+func (mock *EdgeClientMock) DeleteEdgeMegaportCalls() []struct {
+	Ctx         context.Context
+	AccountName string
+	Name        string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		AccountName string
+		Name        string
+	}
+	mock.lockDeleteEdgeMegaport.RLock()
+	calls = mock.calls.DeleteEdgeMegaport
+	mock.lockDeleteEdgeMegaport.RUnlock()
+	return calls
+}
+
+// DeleteEdgeNEO calls DeleteEdgeNEOFunc.
+func (mock *EdgeClientMock) DeleteEdgeNEO(ctx context.Context, accountName string, name string) error {
+	if mock.DeleteEdgeNEOFunc == nil {
+		panic("EdgeClientMock.DeleteEdgeNEOFunc: method is nil but EdgeClient.DeleteEdgeNEO was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		AccountName string
+		Name        string
+	}{
+		Ctx:         ctx,
+		AccountName: accountName,
+		Name:        name,
+	}
+	mock.lockDeleteEdgeNEO.Lock()
+	mock.calls.DeleteEdgeNEO = append(mock.calls.DeleteEdgeNEO, callInfo)
+	mock.lockDeleteEdgeNEO.Unlock()
+	return mock.DeleteEdgeNEOFunc(ctx, accountName, name)
+}
+
+// DeleteEdgeNEOCalls gets all the calls that were made to DeleteEdgeNEO.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DeleteEdgeNEOCalls())
+func (mock *EdgeClientMock) DeleteEdgeNEOCalls() []struct {
+	Ctx         context.Context
+	AccountName string
+	Name        string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		AccountName string
+		Name        string
+	}
+	mock.lockDeleteEdgeNEO.RLock()
+	calls = mock.calls.DeleteEdgeNEO
+	mock.lockDeleteEdgeNEO.RUnlock()
+	return calls
+}
+
+// DeleteEdgeNEODevice calls DeleteEdgeNEODeviceFunc.
+func (mock *EdgeClientMock) DeleteEdgeNEODevice(ctx context.Context, accountName string, serialNumber string) error {
+	if mock.DeleteEdgeNEODeviceFunc == nil {
+		panic("EdgeClientMock.DeleteEdgeNEODeviceFunc: method is nil but EdgeClient.DeleteEdgeNEODevice was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		AccountName  string
+		SerialNumber string
+	}{
+		Ctx:          ctx,
+		AccountName:  accountName,
+		SerialNumber: serialNumber,
+	}
+	mock.lockDeleteEdgeNEODevice.Lock()
+	mock.calls.DeleteEdgeNEODevice = append(mock.calls.DeleteEdgeNEODevice, callInfo)
+	mock.lockDeleteEdgeNEODevice.Unlock()
+	return mock.DeleteEdgeNEODeviceFunc(ctx, accountName, serialNumber)
+}
+
+// DeleteEdgeNEODeviceCalls gets all the calls that were made to DeleteEdgeNEODevice.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DeleteEdgeNEODeviceCalls())
+func (mock *EdgeClientMock) DeleteEdgeNEODeviceCalls() []struct {
+	Ctx          context.Context
+	AccountName  string
+	SerialNumber string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		AccountName  string
+		SerialNumber string
+	}
+	mock.lockDeleteEdgeNEODevice.RLock()
+	calls = mock.calls.DeleteEdgeNEODevice
+	mock.lockDeleteEdgeNEODevice.RUnlock()
+	return calls
+}
+
+// DeleteEdgePlatformProxyProfile calls DeleteEdgePlatformProxyProfileFunc.
+func (mock *EdgeClientMock) DeleteEdgePlatformProxyProfile(ctx context.Context, accountName string, profileName string) error {
+	if mock.DeleteEdgePlatformProxyProfileFunc == nil {
+		panic("EdgeClientMock.DeleteEdgePlatformProxyProfileFunc: method is nil but EdgeClient.DeleteEdgePlatformProxyProfile was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		AccountName string
+		ProfileName string
+	}{
+		Ctx:         ctx,
+		AccountName: accountName,
+		ProfileName: profileName,
+	}
+	mock.lockDeleteEdgePlatformProxyProfile.Lock()
+	mock.calls.DeleteEdgePlatformProxyProfile = append(mock.calls.DeleteEdgePlatformProxyProfile, callInfo)
+	mock.lockDeleteEdgePlatformProxyProfile.Unlock()
+	return mock.DeleteEdgePlatformProxyProfileFunc(ctx, accountName, profileName)
+}
+
+// DeleteEdgePlatformProxyProfileCalls gets all the calls that were made to DeleteEdgePlatformProxyProfile.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DeleteEdgePlatformProxyProfileCalls())
+func (mock *EdgeClientMock) DeleteEdgePlatformProxyProfileCalls() []struct {
+	Ctx         context.Context
+	AccountName string
+	ProfileName string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		AccountName string
+		ProfileName string
+	}
+	mock.lockDeleteEdgePlatformProxyProfile.RLock()
+	calls = mock.calls.DeleteEdgePlatformProxyProfile
+	mock.lockDeleteEdgePlatformProxyProfile.RUnlock()
+	return calls
+}
+
+// DeleteEdgeSpoke calls DeleteEdgeSpokeFunc.
+func (mock *EdgeClientMock) DeleteEdgeSpoke(ctx context.Context, name string) error {
+	if mock.DeleteEdgeSpokeFunc == nil {
+		panic("EdgeClientMock.DeleteEdgeSpokeFunc: method is nil but EdgeClient.DeleteEdgeSpoke was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+	}{
+		Ctx:  ctx,
+		Name: name,
+	}
+	mock.lockDeleteEdgeSpoke.Lock()
+	mock.calls.DeleteEdgeSpoke = append(mock.calls.DeleteEdgeSpoke, callInfo)
+	mock.lockDeleteEdgeSpoke.Unlock()
+	return mock.DeleteEdgeSpokeFunc(ctx, name)
+}
+
+// DeleteEdgeSpokeCalls gets all the calls that were made to DeleteEdgeSpoke.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DeleteEdgeSpokeCalls())
+func (mock *EdgeClientMock) DeleteEdgeSpokeCalls() []struct {
+	Ctx  context.Context
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+	}
+	mock.lockDeleteEdgeSpoke.RLock()
+	calls = mock.calls.DeleteEdgeSpoke
+	mock.lockDeleteEdgeSpoke.RUnlock()
+	return calls
+}
+
+// DeleteExternalDeviceConn calls DeleteExternalDeviceConnFunc.
+func (mock *EdgeClientMock) DeleteExternalDeviceConn(externalDeviceConn *ExternalDeviceConn) error {
+	if mock.DeleteExternalDeviceConnFunc == nil {
+		panic("EdgeClientMock.DeleteExternalDeviceConnFunc: method is nil but EdgeClient.DeleteExternalDeviceConn was just called")
+	}
+	callInfo := struct {
+		ExternalDeviceConn *ExternalDeviceConn
+	}{
+		ExternalDeviceConn: externalDeviceConn,
+	}
+	mock.lockDeleteExternalDeviceConn.Lock()
+	mock.calls.DeleteExternalDeviceConn = append(mock.calls.DeleteExternalDeviceConn, callInfo)
+	mock.lockDeleteExternalDeviceConn.Unlock()
+	return mock.DeleteExternalDeviceConnFunc(externalDeviceConn)
+}
+
+// DeleteExternalDeviceConnCalls gets all the calls that were made to DeleteExternalDeviceConn.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DeleteExternalDeviceConnCalls())
+func (mock *EdgeClientMock) DeleteExternalDeviceConnCalls() []struct {
+	ExternalDeviceConn *ExternalDeviceConn
+} {
+	var calls []struct {
+		ExternalDeviceConn *ExternalDeviceConn
+	}
+	mock.lockDeleteExternalDeviceConn.RLock()
+	calls = mock.calls.DeleteExternalDeviceConn
+	mock.lockDeleteExternalDeviceConn.RUnlock()
+	return calls
+}
+
+// DeleteGateway calls DeleteGatewayFunc.
+func (mock *EdgeClientMock) DeleteGateway(gateway *Gateway) error {
+	if mock.DeleteGatewayFunc == nil {
+		panic("EdgeClientMock.DeleteGatewayFunc: method is nil but EdgeClient.DeleteGateway was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDeleteGateway.Lock()
+	mock.calls.DeleteGateway = append(mock.calls.DeleteGateway, callInfo)
+	mock.lockDeleteGateway.Unlock()
+	return mock.DeleteGatewayFunc(gateway)
+}
+
+// DeleteGatewayCalls gets all the calls that were made to DeleteGateway.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DeleteGatewayCalls())
+func (mock *EdgeClientMock) DeleteGatewayCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDeleteGateway.RLock()
+	calls = mock.calls.DeleteGateway
+	mock.lockDeleteGateway.RUnlock()
+	return calls
+}
+
+// DeleteGatewayContext calls DeleteGatewayContextFunc.
+func (mock *EdgeClientMock) DeleteGatewayContext(ctx context.Context, gateway *Gateway) error {
+	if mock.DeleteGatewayContextFunc == nil {
+		panic("EdgeClientMock.DeleteGatewayContextFunc: method is nil but EdgeClient.DeleteGatewayContext was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}{
+		Ctx:     ctx,
+		Gateway: gateway,
+	}
+	mock.lockDeleteGatewayContext.Lock()
+	mock.calls.DeleteGatewayContext = append(mock.calls.DeleteGatewayContext, callInfo)
+	mock.lockDeleteGatewayContext.Unlock()
+	return mock.DeleteGatewayContextFunc(ctx, gateway)
+}
+
+// DeleteGatewayContextCalls gets all the calls that were made to DeleteGatewayContext.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DeleteGatewayContextCalls())
+func (mock *EdgeClientMock) DeleteGatewayContextCalls() []struct {
+	Ctx     context.Context
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}
+	mock.lockDeleteGatewayContext.RLock()
+	calls = mock.calls.DeleteGatewayContext
+	mock.lockDeleteGatewayContext.RUnlock()
+	return calls
+}
+
+// DeleteGatewayGroup calls DeleteGatewayGroupFunc.
+func (mock *EdgeClientMock) DeleteGatewayGroup(ctx context.Context, groupUUID string) error {
+	if mock.DeleteGatewayGroupFunc == nil {
+		panic("EdgeClientMock.DeleteGatewayGroupFunc: method is nil but EdgeClient.DeleteGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupUUID string
+	}{
+		Ctx:       ctx,
+		GroupUUID: groupUUID,
+	}
+	mock.lockDeleteGatewayGroup.Lock()
+	mock.calls.DeleteGatewayGroup = append(mock.calls.DeleteGatewayGroup, callInfo)
+	mock.lockDeleteGatewayGroup.Unlock()
+	return mock.DeleteGatewayGroupFunc(ctx, groupUUID)
+}
+
+// DeleteGatewayGroupCalls gets all the calls that were made to DeleteGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DeleteGatewayGroupCalls())
+func (mock *EdgeClientMock) DeleteGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupUUID string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupUUID string
+	}
+	mock.lockDeleteGatewayGroup.RLock()
+	calls = mock.calls.DeleteGatewayGroup
+	mock.lockDeleteGatewayGroup.RUnlock()
+	return calls
+}
+
+// DeletePublicSubnetFilteringGatewayContext calls DeletePublicSubnetFilteringGatewayContextFunc.
+func (mock *EdgeClientMock) DeletePublicSubnetFilteringGatewayContext(ctx context.Context, gateway *Gateway) error {
+	if mock.DeletePublicSubnetFilteringGatewayContextFunc == nil {
+		panic("EdgeClientMock.DeletePublicSubnetFilteringGatewayContextFunc: method is nil but EdgeClient.DeletePublicSubnetFilteringGatewayContext was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}{
+		Ctx:     ctx,
+		Gateway: gateway,
+	}
+	mock.lockDeletePublicSubnetFilteringGatewayContext.Lock()
+	mock.calls.DeletePublicSubnetFilteringGatewayContext = append(mock.calls.DeletePublicSubnetFilteringGatewayContext, callInfo)
+	mock.lockDeletePublicSubnetFilteringGatewayContext.Unlock()
+	return mock.DeletePublicSubnetFilteringGatewayContextFunc(ctx, gateway)
+}
+
+// DeletePublicSubnetFilteringGatewayContextCalls gets all the calls that were made to DeletePublicSubnetFilteringGatewayContext.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DeletePublicSubnetFilteringGatewayContextCalls())
+func (mock *EdgeClientMock) DeletePublicSubnetFilteringGatewayContextCalls() []struct {
+	Ctx     context.Context
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}
+	mock.lockDeletePublicSubnetFilteringGatewayContext.RLock()
+	calls = mock.calls.DeletePublicSubnetFilteringGatewayContext
+	mock.lockDeletePublicSubnetFilteringGatewayContext.RUnlock()
+	return calls
+}
+
+// DeleteSpokeGatewaySubnetGroup calls DeleteSpokeGatewaySubnetGroupFunc.
+func (mock *EdgeClientMock) DeleteSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error {
+	if mock.DeleteSpokeGatewaySubnetGroupFunc == nil {
+		panic("EdgeClientMock.DeleteSpokeGatewaySubnetGroupFunc: method is nil but EdgeClient.DeleteSpokeGatewaySubnetGroup was just called")
+	}
+	callInfo := struct {
+		Ctx                     context.Context
+		SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+	}{
+		Ctx:                     ctx,
+		SpokeGatewaySubnetGroup: spokeGatewaySubnetGroup,
+	}
+	mock.lockDeleteSpokeGatewaySubnetGroup.Lock()
+	mock.calls.DeleteSpokeGatewaySubnetGroup = append(mock.calls.DeleteSpokeGatewaySubnetGroup, callInfo)
+	mock.lockDeleteSpokeGatewaySubnetGroup.Unlock()
+	return mock.DeleteSpokeGatewaySubnetGroupFunc(ctx, spokeGatewaySubnetGroup)
+}
+
+// DeleteSpokeGatewaySubnetGroupCalls gets all the calls that were made to DeleteSpokeGatewaySubnetGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DeleteSpokeGatewaySubnetGroupCalls())
+func (mock *EdgeClientMock) DeleteSpokeGatewaySubnetGroupCalls() []struct {
+	Ctx                     context.Context
+	SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+} {
+	var calls []struct {
+		Ctx                     context.Context
+		SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+	}
+	mock.lockDeleteSpokeGatewaySubnetGroup.RLock()
+	calls = mock.calls.DeleteSpokeGatewaySubnetGroup
+	mock.lockDeleteSpokeGatewaySubnetGroup.RUnlock()
+	return calls
+}
+
+// DeleteSpokeTransitAttachment calls DeleteSpokeTransitAttachmentFunc.
+func (mock *EdgeClientMock) DeleteSpokeTransitAttachment(spokeTransitAttachment *SpokeTransitAttachment) error {
+	if mock.DeleteSpokeTransitAttachmentFunc == nil {
+		panic("EdgeClientMock.DeleteSpokeTransitAttachmentFunc: method is nil but EdgeClient.DeleteSpokeTransitAttachment was just called")
+	}
+	callInfo := struct {
+		SpokeTransitAttachment *SpokeTransitAttachment
+	}{
+		SpokeTransitAttachment: spokeTransitAttachment,
+	}
+	mock.lockDeleteSpokeTransitAttachment.Lock()
+	mock.calls.DeleteSpokeTransitAttachment = append(mock.calls.DeleteSpokeTransitAttachment, callInfo)
+	mock.lockDeleteSpokeTransitAttachment.Unlock()
+	return mock.DeleteSpokeTransitAttachmentFunc(spokeTransitAttachment)
+}
+
+// DeleteSpokeTransitAttachmentCalls gets all the calls that were made to DeleteSpokeTransitAttachment.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DeleteSpokeTransitAttachmentCalls())
+func (mock *EdgeClientMock) DeleteSpokeTransitAttachmentCalls() []struct {
+	SpokeTransitAttachment *SpokeTransitAttachment
+} {
+	var calls []struct {
+		SpokeTransitAttachment *SpokeTransitAttachment
+	}
+	mock.lockDeleteSpokeTransitAttachment.RLock()
+	calls = mock.calls.DeleteSpokeTransitAttachment
+	mock.lockDeleteSpokeTransitAttachment.RUnlock()
+	return calls
+}
+
+// DetachTransitGWForHybrid calls DetachTransitGWForHybridFunc.
+func (mock *EdgeClientMock) DetachTransitGWForHybrid(gateway *TransitVpc) error {
+	if mock.DetachTransitGWForHybridFunc == nil {
+		panic("EdgeClientMock.DetachTransitGWForHybridFunc: method is nil but EdgeClient.DetachTransitGWForHybrid was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDetachTransitGWForHybrid.Lock()
+	mock.calls.DetachTransitGWForHybrid = append(mock.calls.DetachTransitGWForHybrid, callInfo)
+	mock.lockDetachTransitGWForHybrid.Unlock()
+	return mock.DetachTransitGWForHybridFunc(gateway)
+}
+
+// DetachTransitGWForHybridCalls gets all the calls that were made to DetachTransitGWForHybrid.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DetachTransitGWForHybridCalls())
+func (mock *EdgeClientMock) DetachTransitGWForHybridCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockDetachTransitGWForHybrid.RLock()
+	calls = mock.calls.DetachTransitGWForHybrid
+	mock.lockDetachTransitGWForHybrid.RUnlock()
+	return calls
+}
+
+// DisableActiveStandby calls DisableActiveStandbyFunc.
+func (mock *EdgeClientMock) DisableActiveStandby(transitGateway *TransitVpc) error {
+	if mock.DisableActiveStandbyFunc == nil {
+		panic("EdgeClientMock.DisableActiveStandbyFunc: method is nil but EdgeClient.DisableActiveStandby was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockDisableActiveStandby.Lock()
+	mock.calls.DisableActiveStandby = append(mock.calls.DisableActiveStandby, callInfo)
+	mock.lockDisableActiveStandby.Unlock()
+	return mock.DisableActiveStandbyFunc(transitGateway)
+}
+
+// DisableActiveStandbyCalls gets all the calls that were made to DisableActiveStandby.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableActiveStandbyCalls())
+func (mock *EdgeClientMock) DisableActiveStandbyCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockDisableActiveStandby.RLock()
+	calls = mock.calls.DisableActiveStandby
+	mock.lockDisableActiveStandby.RUnlock()
+	return calls
+}
+
+// DisableActiveStandbyGatewayGroup calls DisableActiveStandbyGatewayGroupFunc.
+func (mock *EdgeClientMock) DisableActiveStandbyGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableActiveStandbyGatewayGroupFunc == nil {
+		panic("EdgeClientMock.DisableActiveStandbyGatewayGroupFunc: method is nil but EdgeClient.DisableActiveStandbyGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableActiveStandbyGatewayGroup.Lock()
+	mock.calls.DisableActiveStandbyGatewayGroup = append(mock.calls.DisableActiveStandbyGatewayGroup, callInfo)
+	mock.lockDisableActiveStandbyGatewayGroup.Unlock()
+	return mock.DisableActiveStandbyGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableActiveStandbyGatewayGroupCalls gets all the calls that were made to DisableActiveStandbyGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableActiveStandbyGatewayGroupCalls())
+func (mock *EdgeClientMock) DisableActiveStandbyGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableActiveStandbyGatewayGroup.RLock()
+	calls = mock.calls.DisableActiveStandbyGatewayGroup
+	mock.lockDisableActiveStandbyGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableActiveStandbySpoke calls DisableActiveStandbySpokeFunc.
+func (mock *EdgeClientMock) DisableActiveStandbySpoke(spokeGateway *SpokeVpc) error {
+	if mock.DisableActiveStandbySpokeFunc == nil {
+		panic("EdgeClientMock.DisableActiveStandbySpokeFunc: method is nil but EdgeClient.DisableActiveStandbySpoke was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+	}{
+		SpokeGateway: spokeGateway,
+	}
+	mock.lockDisableActiveStandbySpoke.Lock()
+	mock.calls.DisableActiveStandbySpoke = append(mock.calls.DisableActiveStandbySpoke, callInfo)
+	mock.lockDisableActiveStandbySpoke.Unlock()
+	return mock.DisableActiveStandbySpokeFunc(spokeGateway)
+}
+
+// DisableActiveStandbySpokeCalls gets all the calls that were made to DisableActiveStandbySpoke.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableActiveStandbySpokeCalls())
+func (mock *EdgeClientMock) DisableActiveStandbySpokeCalls() []struct {
+	SpokeGateway *SpokeVpc
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+	}
+	mock.lockDisableActiveStandbySpoke.RLock()
+	calls = mock.calls.DisableActiveStandbySpoke
+	mock.lockDisableActiveStandbySpoke.RUnlock()
+	return calls
+}
+
+// DisableAdvertiseTransitCidr calls DisableAdvertiseTransitCidrFunc.
+func (mock *EdgeClientMock) DisableAdvertiseTransitCidr(transitGw *TransitVpc) error {
+	if mock.DisableAdvertiseTransitCidrFunc == nil {
+		panic("EdgeClientMock.DisableAdvertiseTransitCidrFunc: method is nil but EdgeClient.DisableAdvertiseTransitCidr was just called")
+	}
+	callInfo := struct {
+		TransitGw *TransitVpc
+	}{
+		TransitGw: transitGw,
+	}
+	mock.lockDisableAdvertiseTransitCidr.Lock()
+	mock.calls.DisableAdvertiseTransitCidr = append(mock.calls.DisableAdvertiseTransitCidr, callInfo)
+	mock.lockDisableAdvertiseTransitCidr.Unlock()
+	return mock.DisableAdvertiseTransitCidrFunc(transitGw)
+}
+
+// DisableAdvertiseTransitCidrCalls gets all the calls that were made to DisableAdvertiseTransitCidr.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableAdvertiseTransitCidrCalls())
+func (mock *EdgeClientMock) DisableAdvertiseTransitCidrCalls() []struct {
+	TransitGw *TransitVpc
+} {
+	var calls []struct {
+		TransitGw *TransitVpc
+	}
+	mock.lockDisableAdvertiseTransitCidr.RLock()
+	calls = mock.calls.DisableAdvertiseTransitCidr
+	mock.lockDisableAdvertiseTransitCidr.RUnlock()
+	return calls
+}
+
+// DisableAdvertiseTransitCidrGatewayGroup calls DisableAdvertiseTransitCidrGatewayGroupFunc.
+func (mock *EdgeClientMock) DisableAdvertiseTransitCidrGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableAdvertiseTransitCidrGatewayGroupFunc == nil {
+		panic("EdgeClientMock.DisableAdvertiseTransitCidrGatewayGroupFunc: method is nil but EdgeClient.DisableAdvertiseTransitCidrGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableAdvertiseTransitCidrGatewayGroup.Lock()
+	mock.calls.DisableAdvertiseTransitCidrGatewayGroup = append(mock.calls.DisableAdvertiseTransitCidrGatewayGroup, callInfo)
+	mock.lockDisableAdvertiseTransitCidrGatewayGroup.Unlock()
+	return mock.DisableAdvertiseTransitCidrGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableAdvertiseTransitCidrGatewayGroupCalls gets all the calls that were made to DisableAdvertiseTransitCidrGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableAdvertiseTransitCidrGatewayGroupCalls())
+func (mock *EdgeClientMock) DisableAdvertiseTransitCidrGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableAdvertiseTransitCidrGatewayGroup.RLock()
+	calls = mock.calls.DisableAdvertiseTransitCidrGatewayGroup
+	mock.lockDisableAdvertiseTransitCidrGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableAutoAdvertiseS2CCidrs calls DisableAutoAdvertiseS2CCidrsFunc.
+func (mock *EdgeClientMock) DisableAutoAdvertiseS2CCidrs(gateway *Gateway) error {
+	if mock.DisableAutoAdvertiseS2CCidrsFunc == nil {
+		panic("EdgeClientMock.DisableAutoAdvertiseS2CCidrsFunc: method is nil but EdgeClient.DisableAutoAdvertiseS2CCidrs was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableAutoAdvertiseS2CCidrs.Lock()
+	mock.calls.DisableAutoAdvertiseS2CCidrs = append(mock.calls.DisableAutoAdvertiseS2CCidrs, callInfo)
+	mock.lockDisableAutoAdvertiseS2CCidrs.Unlock()
+	return mock.DisableAutoAdvertiseS2CCidrsFunc(gateway)
+}
+
+// DisableAutoAdvertiseS2CCidrsCalls gets all the calls that were made to DisableAutoAdvertiseS2CCidrs.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableAutoAdvertiseS2CCidrsCalls())
+func (mock *EdgeClientMock) DisableAutoAdvertiseS2CCidrsCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableAutoAdvertiseS2CCidrs.RLock()
+	calls = mock.calls.DisableAutoAdvertiseS2CCidrs
+	mock.lockDisableAutoAdvertiseS2CCidrs.RUnlock()
+	return calls
+}
+
+// DisableAutoAdvertiseS2CCidrsGatewayGroup calls DisableAutoAdvertiseS2CCidrsGatewayGroupFunc.
+func (mock *EdgeClientMock) DisableAutoAdvertiseS2CCidrsGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableAutoAdvertiseS2CCidrsGatewayGroupFunc == nil {
+		panic("EdgeClientMock.DisableAutoAdvertiseS2CCidrsGatewayGroupFunc: method is nil but EdgeClient.DisableAutoAdvertiseS2CCidrsGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableAutoAdvertiseS2CCidrsGatewayGroup.Lock()
+	mock.calls.DisableAutoAdvertiseS2CCidrsGatewayGroup = append(mock.calls.DisableAutoAdvertiseS2CCidrsGatewayGroup, callInfo)
+	mock.lockDisableAutoAdvertiseS2CCidrsGatewayGroup.Unlock()
+	return mock.DisableAutoAdvertiseS2CCidrsGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableAutoAdvertiseS2CCidrsGatewayGroupCalls gets all the calls that were made to DisableAutoAdvertiseS2CCidrsGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableAutoAdvertiseS2CCidrsGatewayGroupCalls())
+func (mock *EdgeClientMock) DisableAutoAdvertiseS2CCidrsGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableAutoAdvertiseS2CCidrsGatewayGroup.RLock()
+	calls = mock.calls.DisableAutoAdvertiseS2CCidrsGatewayGroup
+	mock.lockDisableAutoAdvertiseS2CCidrsGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableConnectedTransit calls DisableConnectedTransitFunc.
+func (mock *EdgeClientMock) DisableConnectedTransit(gateway *TransitVpc) error {
+	if mock.DisableConnectedTransitFunc == nil {
+		panic("EdgeClientMock.DisableConnectedTransitFunc: method is nil but EdgeClient.DisableConnectedTransit was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableConnectedTransit.Lock()
+	mock.calls.DisableConnectedTransit = append(mock.calls.DisableConnectedTransit, callInfo)
+	mock.lockDisableConnectedTransit.Unlock()
+	return mock.DisableConnectedTransitFunc(gateway)
+}
+
+// DisableConnectedTransitCalls gets all the calls that were made to DisableConnectedTransit.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableConnectedTransitCalls())
+func (mock *EdgeClientMock) DisableConnectedTransitCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockDisableConnectedTransit.RLock()
+	calls = mock.calls.DisableConnectedTransit
+	mock.lockDisableConnectedTransit.RUnlock()
+	return calls
+}
+
+// DisableConnectedTransitGatewayGroup calls DisableConnectedTransitGatewayGroupFunc.
+func (mock *EdgeClientMock) DisableConnectedTransitGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableConnectedTransitGatewayGroupFunc == nil {
+		panic("EdgeClientMock.DisableConnectedTransitGatewayGroupFunc: method is nil but EdgeClient.DisableConnectedTransitGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableConnectedTransitGatewayGroup.Lock()
+	mock.calls.DisableConnectedTransitGatewayGroup = append(mock.calls.DisableConnectedTransitGatewayGroup, callInfo)
+	mock.lockDisableConnectedTransitGatewayGroup.Unlock()
+	return mock.DisableConnectedTransitGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableConnectedTransitGatewayGroupCalls gets all the calls that were made to DisableConnectedTransitGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableConnectedTransitGatewayGroupCalls())
+func (mock *EdgeClientMock) DisableConnectedTransitGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableConnectedTransitGatewayGroup.RLock()
+	calls = mock.calls.DisableConnectedTransitGatewayGroup
+	mock.lockDisableConnectedTransitGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableCustomSNat calls DisableCustomSNatFunc.
+func (mock *EdgeClientMock) DisableCustomSNat(gateway *Gateway) error {
+	if mock.DisableCustomSNatFunc == nil {
+		panic("EdgeClientMock.DisableCustomSNatFunc: method is nil but EdgeClient.DisableCustomSNat was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableCustomSNat.Lock()
+	mock.calls.DisableCustomSNat = append(mock.calls.DisableCustomSNat, callInfo)
+	mock.lockDisableCustomSNat.Unlock()
+	return mock.DisableCustomSNatFunc(gateway)
+}
+
+// DisableCustomSNatCalls gets all the calls that were made to DisableCustomSNat.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableCustomSNatCalls())
+func (mock *EdgeClientMock) DisableCustomSNatCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableCustomSNat.RLock()
+	calls = mock.calls.DisableCustomSNat
+	mock.lockDisableCustomSNat.RUnlock()
+	return calls
+}
+
+// DisableEdgeSpokeTransitiveRouting calls DisableEdgeSpokeTransitiveRoutingFunc.
+func (mock *EdgeClientMock) DisableEdgeSpokeTransitiveRouting(ctx context.Context, name string) error {
+	if mock.DisableEdgeSpokeTransitiveRoutingFunc == nil {
+		panic("EdgeClientMock.DisableEdgeSpokeTransitiveRoutingFunc: method is nil but EdgeClient.DisableEdgeSpokeTransitiveRouting was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+	}{
+		Ctx:  ctx,
+		Name: name,
+	}
+	mock.lockDisableEdgeSpokeTransitiveRouting.Lock()
+	mock.calls.DisableEdgeSpokeTransitiveRouting = append(mock.calls.DisableEdgeSpokeTransitiveRouting, callInfo)
+	mock.lockDisableEdgeSpokeTransitiveRouting.Unlock()
+	return mock.DisableEdgeSpokeTransitiveRoutingFunc(ctx, name)
+}
+
+// DisableEdgeSpokeTransitiveRoutingCalls gets all the calls that were made to DisableEdgeSpokeTransitiveRouting.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableEdgeSpokeTransitiveRoutingCalls())
+func (mock *EdgeClientMock) DisableEdgeSpokeTransitiveRoutingCalls() []struct {
+	Ctx  context.Context
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+	}
+	mock.lockDisableEdgeSpokeTransitiveRouting.RLock()
+	calls = mock.calls.DisableEdgeSpokeTransitiveRouting
+	mock.lockDisableEdgeSpokeTransitiveRouting.RUnlock()
+	return calls
+}
+
+// DisableEgressTransitFirenet calls DisableEgressTransitFirenetFunc.
+func (mock *EdgeClientMock) DisableEgressTransitFirenet(transitGateway *TransitVpc) error {
+	if mock.DisableEgressTransitFirenetFunc == nil {
+		panic("EdgeClientMock.DisableEgressTransitFirenetFunc: method is nil but EdgeClient.DisableEgressTransitFirenet was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockDisableEgressTransitFirenet.Lock()
+	mock.calls.DisableEgressTransitFirenet = append(mock.calls.DisableEgressTransitFirenet, callInfo)
+	mock.lockDisableEgressTransitFirenet.Unlock()
+	return mock.DisableEgressTransitFirenetFunc(transitGateway)
+}
+
+// DisableEgressTransitFirenetCalls gets all the calls that were made to DisableEgressTransitFirenet.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableEgressTransitFirenetCalls())
+func (mock *EdgeClientMock) DisableEgressTransitFirenetCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockDisableEgressTransitFirenet.RLock()
+	calls = mock.calls.DisableEgressTransitFirenet
+	mock.lockDisableEgressTransitFirenet.RUnlock()
+	return calls
+}
+
+// DisableFireNetGatewayGroup calls DisableFireNetGatewayGroupFunc.
+func (mock *EdgeClientMock) DisableFireNetGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableFireNetGatewayGroupFunc == nil {
+		panic("EdgeClientMock.DisableFireNetGatewayGroupFunc: method is nil but EdgeClient.DisableFireNetGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableFireNetGatewayGroup.Lock()
+	mock.calls.DisableFireNetGatewayGroup = append(mock.calls.DisableFireNetGatewayGroup, callInfo)
+	mock.lockDisableFireNetGatewayGroup.Unlock()
+	return mock.DisableFireNetGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableFireNetGatewayGroupCalls gets all the calls that were made to DisableFireNetGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableFireNetGatewayGroupCalls())
+func (mock *EdgeClientMock) DisableFireNetGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableFireNetGatewayGroup.RLock()
+	calls = mock.calls.DisableFireNetGatewayGroup
+	mock.lockDisableFireNetGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableGatewayFireNetInterfaces calls DisableGatewayFireNetInterfacesFunc.
+func (mock *EdgeClientMock) DisableGatewayFireNetInterfaces(gateway *TransitVpc) error {
+	if mock.DisableGatewayFireNetInterfacesFunc == nil {
+		panic("EdgeClientMock.DisableGatewayFireNetInterfacesFunc: method is nil but EdgeClient.DisableGatewayFireNetInterfaces was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableGatewayFireNetInterfaces.Lock()
+	mock.calls.DisableGatewayFireNetInterfaces = append(mock.calls.DisableGatewayFireNetInterfaces, callInfo)
+	mock.lockDisableGatewayFireNetInterfaces.Unlock()
+	return mock.DisableGatewayFireNetInterfacesFunc(gateway)
+}
+
+// DisableGatewayFireNetInterfacesCalls gets all the calls that were made to DisableGatewayFireNetInterfaces.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableGatewayFireNetInterfacesCalls())
+func (mock *EdgeClientMock) DisableGatewayFireNetInterfacesCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockDisableGatewayFireNetInterfaces.RLock()
+	calls = mock.calls.DisableGatewayFireNetInterfaces
+	mock.lockDisableGatewayFireNetInterfaces.RUnlock()
+	return calls
+}
+
+// DisableGatewayGroupSNat calls DisableGatewayGroupSNatFunc.
+func (mock *EdgeClientMock) DisableGatewayGroupSNat(ctx context.Context, groupName string) error {
+	if mock.DisableGatewayGroupSNatFunc == nil {
+		panic("EdgeClientMock.DisableGatewayGroupSNatFunc: method is nil but EdgeClient.DisableGatewayGroupSNat was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableGatewayGroupSNat.Lock()
+	mock.calls.DisableGatewayGroupSNat = append(mock.calls.DisableGatewayGroupSNat, callInfo)
+	mock.lockDisableGatewayGroupSNat.Unlock()
+	return mock.DisableGatewayGroupSNatFunc(ctx, groupName)
+}
+
+// DisableGatewayGroupSNatCalls gets all the calls that were made to DisableGatewayGroupSNat.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableGatewayGroupSNatCalls())
+func (mock *EdgeClientMock) DisableGatewayGroupSNatCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableGatewayGroupSNat.RLock()
+	calls = mock.calls.DisableGatewayGroupSNat
+	mock.lockDisableGatewayGroupSNat.RUnlock()
+	return calls
+}
+
+// DisableGatewayGroupVpcDNSServer calls DisableGatewayGroupVpcDNSServerFunc.
+func (mock *EdgeClientMock) DisableGatewayGroupVpcDNSServer(ctx context.Context, groupName string) error {
+	if mock.DisableGatewayGroupVpcDNSServerFunc == nil {
+		panic("EdgeClientMock.DisableGatewayGroupVpcDNSServerFunc: method is nil but EdgeClient.DisableGatewayGroupVpcDNSServer was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableGatewayGroupVpcDNSServer.Lock()
+	mock.calls.DisableGatewayGroupVpcDNSServer = append(mock.calls.DisableGatewayGroupVpcDNSServer, callInfo)
+	mock.lockDisableGatewayGroupVpcDNSServer.Unlock()
+	return mock.DisableGatewayGroupVpcDNSServerFunc(ctx, groupName)
+}
+
+// DisableGatewayGroupVpcDNSServerCalls gets all the calls that were made to DisableGatewayGroupVpcDNSServer.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableGatewayGroupVpcDNSServerCalls())
+func (mock *EdgeClientMock) DisableGatewayGroupVpcDNSServerCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableGatewayGroupVpcDNSServer.RLock()
+	calls = mock.calls.DisableGatewayGroupVpcDNSServer
+	mock.lockDisableGatewayGroupVpcDNSServer.RUnlock()
+	return calls
+}
+
+// DisableGatewayLoadBalancerGatewayGroup calls DisableGatewayLoadBalancerGatewayGroupFunc.
+func (mock *EdgeClientMock) DisableGatewayLoadBalancerGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableGatewayLoadBalancerGatewayGroupFunc == nil {
+		panic("EdgeClientMock.DisableGatewayLoadBalancerGatewayGroupFunc: method is nil but EdgeClient.DisableGatewayLoadBalancerGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableGatewayLoadBalancerGatewayGroup.Lock()
+	mock.calls.DisableGatewayLoadBalancerGatewayGroup = append(mock.calls.DisableGatewayLoadBalancerGatewayGroup, callInfo)
+	mock.lockDisableGatewayLoadBalancerGatewayGroup.Unlock()
+	return mock.DisableGatewayLoadBalancerGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableGatewayLoadBalancerGatewayGroupCalls gets all the calls that were made to DisableGatewayLoadBalancerGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableGatewayLoadBalancerGatewayGroupCalls())
+func (mock *EdgeClientMock) DisableGatewayLoadBalancerGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableGatewayLoadBalancerGatewayGroup.RLock()
+	calls = mock.calls.DisableGatewayLoadBalancerGatewayGroup
+	mock.lockDisableGatewayLoadBalancerGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableGlobalVpc calls DisableGlobalVpcFunc.
+func (mock *EdgeClientMock) DisableGlobalVpc(gateway *Gateway) error {
+	if mock.DisableGlobalVpcFunc == nil {
+		panic("EdgeClientMock.DisableGlobalVpcFunc: method is nil but EdgeClient.DisableGlobalVpc was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableGlobalVpc.Lock()
+	mock.calls.DisableGlobalVpc = append(mock.calls.DisableGlobalVpc, callInfo)
+	mock.lockDisableGlobalVpc.Unlock()
+	return mock.DisableGlobalVpcFunc(gateway)
+}
+
+// DisableGlobalVpcCalls gets all the calls that were made to DisableGlobalVpc.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableGlobalVpcCalls())
+func (mock *EdgeClientMock) DisableGlobalVpcCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableGlobalVpc.RLock()
+	calls = mock.calls.DisableGlobalVpc
+	mock.lockDisableGlobalVpc.RUnlock()
+	return calls
+}
+
+// DisableGlobalVpcGatewayGroup calls DisableGlobalVpcGatewayGroupFunc.
+func (mock *EdgeClientMock) DisableGlobalVpcGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableGlobalVpcGatewayGroupFunc == nil {
+		panic("EdgeClientMock.DisableGlobalVpcGatewayGroupFunc: method is nil but EdgeClient.DisableGlobalVpcGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableGlobalVpcGatewayGroup.Lock()
+	mock.calls.DisableGlobalVpcGatewayGroup = append(mock.calls.DisableGlobalVpcGatewayGroup, callInfo)
+	mock.lockDisableGlobalVpcGatewayGroup.Unlock()
+	return mock.DisableGlobalVpcGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableGlobalVpcGatewayGroupCalls gets all the calls that were made to DisableGlobalVpcGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableGlobalVpcGatewayGroupCalls())
+func (mock *EdgeClientMock) DisableGlobalVpcGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableGlobalVpcGatewayGroup.RLock()
+	calls = mock.calls.DisableGlobalVpcGatewayGroup
+	mock.lockDisableGlobalVpcGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableGroGso calls DisableGroGsoFunc.
+func (mock *EdgeClientMock) DisableGroGso(gateway *Gateway) error {
+	if mock.DisableGroGsoFunc == nil {
+		panic("EdgeClientMock.DisableGroGsoFunc: method is nil but EdgeClient.DisableGroGso was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableGroGso.Lock()
+	mock.calls.DisableGroGso = append(mock.calls.DisableGroGso, callInfo)
+	mock.lockDisableGroGso.Unlock()
+	return mock.DisableGroGsoFunc(gateway)
+}
+
+// DisableGroGsoCalls gets all the calls that were made to DisableGroGso.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableGroGsoCalls())
+func (mock *EdgeClientMock) DisableGroGsoCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableGroGso.RLock()
+	calls = mock.calls.DisableGroGso
+	mock.lockDisableGroGso.RUnlock()
+	return calls
+}
+
+// DisableGroGsoGatewayGroup calls DisableGroGsoGatewayGroupFunc.
+func (mock *EdgeClientMock) DisableGroGsoGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableGroGsoGatewayGroupFunc == nil {
+		panic("EdgeClientMock.DisableGroGsoGatewayGroupFunc: method is nil but EdgeClient.DisableGroGsoGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableGroGsoGatewayGroup.Lock()
+	mock.calls.DisableGroGsoGatewayGroup = append(mock.calls.DisableGroGsoGatewayGroup, callInfo)
+	mock.lockDisableGroGsoGatewayGroup.Unlock()
+	return mock.DisableGroGsoGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableGroGsoGatewayGroupCalls gets all the calls that were made to DisableGroGsoGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableGroGsoGatewayGroupCalls())
+func (mock *EdgeClientMock) DisableGroGsoGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableGroGsoGatewayGroup.RLock()
+	calls = mock.calls.DisableGroGsoGatewayGroup
+	mock.lockDisableGroGsoGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableGuardDutyEnforcement calls DisableGuardDutyEnforcementFunc.
+func (mock *EdgeClientMock) DisableGuardDutyEnforcement(gateway *Gateway) error {
+	if mock.DisableGuardDutyEnforcementFunc == nil {
+		panic("EdgeClientMock.DisableGuardDutyEnforcementFunc: method is nil but EdgeClient.DisableGuardDutyEnforcement was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableGuardDutyEnforcement.Lock()
+	mock.calls.DisableGuardDutyEnforcement = append(mock.calls.DisableGuardDutyEnforcement, callInfo)
+	mock.lockDisableGuardDutyEnforcement.Unlock()
+	return mock.DisableGuardDutyEnforcementFunc(gateway)
+}
+
+// DisableGuardDutyEnforcementCalls gets all the calls that were made to DisableGuardDutyEnforcement.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableGuardDutyEnforcementCalls())
+func (mock *EdgeClientMock) DisableGuardDutyEnforcementCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableGuardDutyEnforcement.RLock()
+	calls = mock.calls.DisableGuardDutyEnforcement
+	mock.lockDisableGuardDutyEnforcement.RUnlock()
+	return calls
+}
+
+// DisableHybridConnectionGatewayGroup calls DisableHybridConnectionGatewayGroupFunc.
+func (mock *EdgeClientMock) DisableHybridConnectionGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableHybridConnectionGatewayGroupFunc == nil {
+		panic("EdgeClientMock.DisableHybridConnectionGatewayGroupFunc: method is nil but EdgeClient.DisableHybridConnectionGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableHybridConnectionGatewayGroup.Lock()
+	mock.calls.DisableHybridConnectionGatewayGroup = append(mock.calls.DisableHybridConnectionGatewayGroup, callInfo)
+	mock.lockDisableHybridConnectionGatewayGroup.Unlock()
+	return mock.DisableHybridConnectionGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableHybridConnectionGatewayGroupCalls gets all the calls that were made to DisableHybridConnectionGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableHybridConnectionGatewayGroupCalls())
+func (mock *EdgeClientMock) DisableHybridConnectionGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableHybridConnectionGatewayGroup.RLock()
+	calls = mock.calls.DisableHybridConnectionGatewayGroup
+	mock.lockDisableHybridConnectionGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableIPv6 calls DisableIPv6Func.
+func (mock *EdgeClientMock) DisableIPv6(gateway *Gateway) error {
+	if mock.DisableIPv6Func == nil {
+		panic("EdgeClientMock.DisableIPv6Func: method is nil but EdgeClient.DisableIPv6 was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableIPv6.Lock()
+	mock.calls.DisableIPv6 = append(mock.calls.DisableIPv6, callInfo)
+	mock.lockDisableIPv6.Unlock()
+	return mock.DisableIPv6Func(gateway)
+}
+
+// DisableIPv6Calls gets all the calls that were made to DisableIPv6.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableIPv6Calls())
+func (mock *EdgeClientMock) DisableIPv6Calls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableIPv6.RLock()
+	calls = mock.calls.DisableIPv6
+	mock.lockDisableIPv6.RUnlock()
+	return calls
+}
+
+// DisableIPv6GatewayGroup calls DisableIPv6GatewayGroupFunc.
+func (mock *EdgeClientMock) DisableIPv6GatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableIPv6GatewayGroupFunc == nil {
+		panic("EdgeClientMock.DisableIPv6GatewayGroupFunc: method is nil but EdgeClient.DisableIPv6GatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableIPv6GatewayGroup.Lock()
+	mock.calls.DisableIPv6GatewayGroup = append(mock.calls.DisableIPv6GatewayGroup, callInfo)
+	mock.lockDisableIPv6GatewayGroup.Unlock()
+	return mock.DisableIPv6GatewayGroupFunc(ctx, groupName)
+}
+
+// DisableIPv6GatewayGroupCalls gets all the calls that were made to DisableIPv6GatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableIPv6GatewayGroupCalls())
+func (mock *EdgeClientMock) DisableIPv6GatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableIPv6GatewayGroup.RLock()
+	calls = mock.calls.DisableIPv6GatewayGroup
+	mock.lockDisableIPv6GatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableJumboFrame calls DisableJumboFrameFunc.
+func (mock *EdgeClientMock) DisableJumboFrame(gateway *Gateway) error {
+	if mock.DisableJumboFrameFunc == nil {
+		panic("EdgeClientMock.DisableJumboFrameFunc: method is nil but EdgeClient.DisableJumboFrame was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableJumboFrame.Lock()
+	mock.calls.DisableJumboFrame = append(mock.calls.DisableJumboFrame, callInfo)
+	mock.lockDisableJumboFrame.Unlock()
+	return mock.DisableJumboFrameFunc(gateway)
+}
+
+// DisableJumboFrameCalls gets all the calls that were made to DisableJumboFrame.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableJumboFrameCalls())
+func (mock *EdgeClientMock) DisableJumboFrameCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableJumboFrame.RLock()
+	calls = mock.calls.DisableJumboFrame
+	mock.lockDisableJumboFrame.RUnlock()
+	return calls
+}
+
+// DisableJumboFrameExternalDeviceConn calls DisableJumboFrameExternalDeviceConnFunc.
+func (mock *EdgeClientMock) DisableJumboFrameExternalDeviceConn(externalDeviceConn *ExternalDeviceConn) error {
+	if mock.DisableJumboFrameExternalDeviceConnFunc == nil {
+		panic("EdgeClientMock.DisableJumboFrameExternalDeviceConnFunc: method is nil but EdgeClient.DisableJumboFrameExternalDeviceConn was just called")
+	}
+	callInfo := struct {
+		ExternalDeviceConn *ExternalDeviceConn
+	}{
+		ExternalDeviceConn: externalDeviceConn,
+	}
+	mock.lockDisableJumboFrameExternalDeviceConn.Lock()
+	mock.calls.DisableJumboFrameExternalDeviceConn = append(mock.calls.DisableJumboFrameExternalDeviceConn, callInfo)
+	mock.lockDisableJumboFrameExternalDeviceConn.Unlock()
+	return mock.DisableJumboFrameExternalDeviceConnFunc(externalDeviceConn)
+}
+
+// DisableJumboFrameExternalDeviceConnCalls gets all the calls that were made to DisableJumboFrameExternalDeviceConn.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableJumboFrameExternalDeviceConnCalls())
+func (mock *EdgeClientMock) DisableJumboFrameExternalDeviceConnCalls() []struct {
+	ExternalDeviceConn *ExternalDeviceConn
+} {
+	var calls []struct {
+		ExternalDeviceConn *ExternalDeviceConn
+	}
+	mock.lockDisableJumboFrameExternalDeviceConn.RLock()
+	calls = mock.calls.DisableJumboFrameExternalDeviceConn
+	mock.lockDisableJumboFrameExternalDeviceConn.RUnlock()
+	return calls
+}
+
+// DisableJumboFrameGatewayGroup calls DisableJumboFrameGatewayGroupFunc.
+func (mock *EdgeClientMock) DisableJumboFrameGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableJumboFrameGatewayGroupFunc == nil {
+		panic("EdgeClientMock.DisableJumboFrameGatewayGroupFunc: method is nil but EdgeClient.DisableJumboFrameGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableJumboFrameGatewayGroup.Lock()
+	mock.calls.DisableJumboFrameGatewayGroup = append(mock.calls.DisableJumboFrameGatewayGroup, callInfo)
+	mock.lockDisableJumboFrameGatewayGroup.Unlock()
+	return mock.DisableJumboFrameGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableJumboFrameGatewayGroupCalls gets all the calls that were made to DisableJumboFrameGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableJumboFrameGatewayGroupCalls())
+func (mock *EdgeClientMock) DisableJumboFrameGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableJumboFrameGatewayGroup.RLock()
+	calls = mock.calls.DisableJumboFrameGatewayGroup
+	mock.lockDisableJumboFrameGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableMonitorGatewaySubnets calls DisableMonitorGatewaySubnetsFunc.
+func (mock *EdgeClientMock) DisableMonitorGatewaySubnets(gwName string) error {
+	if mock.DisableMonitorGatewaySubnetsFunc == nil {
+		panic("EdgeClientMock.DisableMonitorGatewaySubnetsFunc: method is nil but EdgeClient.DisableMonitorGatewaySubnets was just called")
+	}
+	callInfo := struct {
+		GwName string
+	}{
+		GwName: gwName,
+	}
+	mock.lockDisableMonitorGatewaySubnets.Lock()
+	mock.calls.DisableMonitorGatewaySubnets = append(mock.calls.DisableMonitorGatewaySubnets, callInfo)
+	mock.lockDisableMonitorGatewaySubnets.Unlock()
+	return mock.DisableMonitorGatewaySubnetsFunc(gwName)
+}
+
+// DisableMonitorGatewaySubnetsCalls gets all the calls that were made to DisableMonitorGatewaySubnets.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableMonitorGatewaySubnetsCalls())
+func (mock *EdgeClientMock) DisableMonitorGatewaySubnetsCalls() []struct {
+	GwName string
+} {
+	var calls []struct {
+		GwName string
+	}
+	mock.lockDisableMonitorGatewaySubnets.RLock()
+	calls = mock.calls.DisableMonitorGatewaySubnets
+	mock.lockDisableMonitorGatewaySubnets.RUnlock()
+	return calls
+}
+
+// DisableMultiTierTransitGatewayGroup calls DisableMultiTierTransitGatewayGroupFunc.
+func (mock *EdgeClientMock) DisableMultiTierTransitGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableMultiTierTransitGatewayGroupFunc == nil {
+		panic("EdgeClientMock.DisableMultiTierTransitGatewayGroupFunc: method is nil but EdgeClient.DisableMultiTierTransitGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableMultiTierTransitGatewayGroup.Lock()
+	mock.calls.DisableMultiTierTransitGatewayGroup = append(mock.calls.DisableMultiTierTransitGatewayGroup, callInfo)
+	mock.lockDisableMultiTierTransitGatewayGroup.Unlock()
+	return mock.DisableMultiTierTransitGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableMultiTierTransitGatewayGroupCalls gets all the calls that were made to DisableMultiTierTransitGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableMultiTierTransitGatewayGroupCalls())
+func (mock *EdgeClientMock) DisableMultiTierTransitGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableMultiTierTransitGatewayGroup.RLock()
+	calls = mock.calls.DisableMultiTierTransitGatewayGroup
+	mock.lockDisableMultiTierTransitGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableMultitierTransit calls DisableMultitierTransitFunc.
+func (mock *EdgeClientMock) DisableMultitierTransit(gwName string) error {
+	if mock.DisableMultitierTransitFunc == nil {
+		panic("EdgeClientMock.DisableMultitierTransitFunc: method is nil but EdgeClient.DisableMultitierTransit was just called")
+	}
+	callInfo := struct {
+		GwName string
+	}{
+		GwName: gwName,
+	}
+	mock.lockDisableMultitierTransit.Lock()
+	mock.calls.DisableMultitierTransit = append(mock.calls.DisableMultitierTransit, callInfo)
+	mock.lockDisableMultitierTransit.Unlock()
+	return mock.DisableMultitierTransitFunc(gwName)
+}
+
+// DisableMultitierTransitCalls gets all the calls that were made to DisableMultitierTransit.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableMultitierTransitCalls())
+func (mock *EdgeClientMock) DisableMultitierTransitCalls() []struct {
+	GwName string
+} {
+	var calls []struct {
+		GwName string
+	}
+	mock.lockDisableMultitierTransit.RLock()
+	calls = mock.calls.DisableMultitierTransit
+	mock.lockDisableMultitierTransit.RUnlock()
+	return calls
+}
+
+// DisablePrivateVpcDefaultRoute calls DisablePrivateVpcDefaultRouteFunc.
+func (mock *EdgeClientMock) DisablePrivateVpcDefaultRoute(gw *Gateway) error {
+	if mock.DisablePrivateVpcDefaultRouteFunc == nil {
+		panic("EdgeClientMock.DisablePrivateVpcDefaultRouteFunc: method is nil but EdgeClient.DisablePrivateVpcDefaultRoute was just called")
+	}
+	callInfo := struct {
+		Gw *Gateway
+	}{
+		Gw: gw,
+	}
+	mock.lockDisablePrivateVpcDefaultRoute.Lock()
+	mock.calls.DisablePrivateVpcDefaultRoute = append(mock.calls.DisablePrivateVpcDefaultRoute, callInfo)
+	mock.lockDisablePrivateVpcDefaultRoute.Unlock()
+	return mock.DisablePrivateVpcDefaultRouteFunc(gw)
+}
+
+// DisablePrivateVpcDefaultRouteCalls gets all the calls that were made to DisablePrivateVpcDefaultRoute.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisablePrivateVpcDefaultRouteCalls())
+func (mock *EdgeClientMock) DisablePrivateVpcDefaultRouteCalls() []struct {
+	Gw *Gateway
+} {
+	var calls []struct {
+		Gw *Gateway
+	}
+	mock.lockDisablePrivateVpcDefaultRoute.RLock()
+	calls = mock.calls.DisablePrivateVpcDefaultRoute
+	mock.lockDisablePrivateVpcDefaultRoute.RUnlock()
+	return calls
+}
+
+// DisablePrivateVpcDefaultRouteGatewayGroup calls DisablePrivateVpcDefaultRouteGatewayGroupFunc.
+func (mock *EdgeClientMock) DisablePrivateVpcDefaultRouteGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisablePrivateVpcDefaultRouteGatewayGroupFunc == nil {
+		panic("EdgeClientMock.DisablePrivateVpcDefaultRouteGatewayGroupFunc: method is nil but EdgeClient.DisablePrivateVpcDefaultRouteGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisablePrivateVpcDefaultRouteGatewayGroup.Lock()
+	mock.calls.DisablePrivateVpcDefaultRouteGatewayGroup = append(mock.calls.DisablePrivateVpcDefaultRouteGatewayGroup, callInfo)
+	mock.lockDisablePrivateVpcDefaultRouteGatewayGroup.Unlock()
+	return mock.DisablePrivateVpcDefaultRouteGatewayGroupFunc(ctx, groupName)
+}
+
+// DisablePrivateVpcDefaultRouteGatewayGroupCalls gets all the calls that were made to DisablePrivateVpcDefaultRouteGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisablePrivateVpcDefaultRouteGatewayGroupCalls())
+func (mock *EdgeClientMock) DisablePrivateVpcDefaultRouteGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisablePrivateVpcDefaultRouteGatewayGroup.RLock()
+	calls = mock.calls.DisablePrivateVpcDefaultRouteGatewayGroup
+	mock.lockDisablePrivateVpcDefaultRouteGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableS2CRxBalancing calls DisableS2CRxBalancingFunc.
+func (mock *EdgeClientMock) DisableS2CRxBalancing(gwName string) error {
+	if mock.DisableS2CRxBalancingFunc == nil {
+		panic("EdgeClientMock.DisableS2CRxBalancingFunc: method is nil but EdgeClient.DisableS2CRxBalancing was just called")
+	}
+	callInfo := struct {
+		GwName string
+	}{
+		GwName: gwName,
+	}
+	mock.lockDisableS2CRxBalancing.Lock()
+	mock.calls.DisableS2CRxBalancing = append(mock.calls.DisableS2CRxBalancing, callInfo)
+	mock.lockDisableS2CRxBalancing.Unlock()
+	return mock.DisableS2CRxBalancingFunc(gwName)
+}
+
+// DisableS2CRxBalancingCalls gets all the calls that were made to DisableS2CRxBalancing.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableS2CRxBalancingCalls())
+func (mock *EdgeClientMock) DisableS2CRxBalancingCalls() []struct {
+	GwName string
+} {
+	var calls []struct {
+		GwName string
+	}
+	mock.lockDisableS2CRxBalancing.RLock()
+	calls = mock.calls.DisableS2CRxBalancing
+	mock.lockDisableS2CRxBalancing.RUnlock()
+	return calls
+}
+
+// DisableS2cRxBalancingGatewayGroup calls DisableS2cRxBalancingGatewayGroupFunc.
+func (mock *EdgeClientMock) DisableS2cRxBalancingGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableS2cRxBalancingGatewayGroupFunc == nil {
+		panic("EdgeClientMock.DisableS2cRxBalancingGatewayGroupFunc: method is nil but EdgeClient.DisableS2cRxBalancingGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableS2cRxBalancingGatewayGroup.Lock()
+	mock.calls.DisableS2cRxBalancingGatewayGroup = append(mock.calls.DisableS2cRxBalancingGatewayGroup, callInfo)
+	mock.lockDisableS2cRxBalancingGatewayGroup.Unlock()
+	return mock.DisableS2cRxBalancingGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableS2cRxBalancingGatewayGroupCalls gets all the calls that were made to DisableS2cRxBalancingGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableS2cRxBalancingGatewayGroupCalls())
+func (mock *EdgeClientMock) DisableS2cRxBalancingGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableS2cRxBalancingGatewayGroup.RLock()
+	calls = mock.calls.DisableS2cRxBalancingGatewayGroup
+	mock.lockDisableS2cRxBalancingGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableSNat calls DisableSNatFunc.
+func (mock *EdgeClientMock) DisableSNat(gateway *Gateway) error {
+	if mock.DisableSNatFunc == nil {
+		panic("EdgeClientMock.DisableSNatFunc: method is nil but EdgeClient.DisableSNat was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableSNat.Lock()
+	mock.calls.DisableSNat = append(mock.calls.DisableSNat, callInfo)
+	mock.lockDisableSNat.Unlock()
+	return mock.DisableSNatFunc(gateway)
+}
+
+// DisableSNatCalls gets all the calls that were made to DisableSNat.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableSNatCalls())
+func (mock *EdgeClientMock) DisableSNatCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableSNat.RLock()
+	calls = mock.calls.DisableSNat
+	mock.lockDisableSNat.RUnlock()
+	return calls
+}
+
+// DisableSegmentation calls DisableSegmentationFunc.
+func (mock *EdgeClientMock) DisableSegmentation(transitGateway *TransitVpc) error {
+	if mock.DisableSegmentationFunc == nil {
+		panic("EdgeClientMock.DisableSegmentationFunc: method is nil but EdgeClient.DisableSegmentation was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockDisableSegmentation.Lock()
+	mock.calls.DisableSegmentation = append(mock.calls.DisableSegmentation, callInfo)
+	mock.lockDisableSegmentation.Unlock()
+	return mock.DisableSegmentationFunc(transitGateway)
+}
+
+// DisableSegmentationCalls gets all the calls that were made to DisableSegmentation.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableSegmentationCalls())
+func (mock *EdgeClientMock) DisableSegmentationCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockDisableSegmentation.RLock()
+	calls = mock.calls.DisableSegmentation
+	mock.lockDisableSegmentation.RUnlock()
+	return calls
+}
+
+// DisableSegmentationGatewayGroup calls DisableSegmentationGatewayGroupFunc.
+func (mock *EdgeClientMock) DisableSegmentationGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableSegmentationGatewayGroupFunc == nil {
+		panic("EdgeClientMock.DisableSegmentationGatewayGroupFunc: method is nil but EdgeClient.DisableSegmentationGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableSegmentationGatewayGroup.Lock()
+	mock.calls.DisableSegmentationGatewayGroup = append(mock.calls.DisableSegmentationGatewayGroup, callInfo)
+	mock.lockDisableSegmentationGatewayGroup.Unlock()
+	return mock.DisableSegmentationGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableSegmentationGatewayGroupCalls gets all the calls that were made to DisableSegmentationGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableSegmentationGatewayGroupCalls())
+func (mock *EdgeClientMock) DisableSegmentationGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableSegmentationGatewayGroup.RLock()
+	calls = mock.calls.DisableSegmentationGatewayGroup
+	mock.lockDisableSegmentationGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableSingleAZGateway calls DisableSingleAZGatewayFunc.
+func (mock *EdgeClientMock) DisableSingleAZGateway(gateway *Gateway) error {
+	if mock.DisableSingleAZGatewayFunc == nil {
+		panic("EdgeClientMock.DisableSingleAZGatewayFunc: method is nil but EdgeClient.DisableSingleAZGateway was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableSingleAZGateway.Lock()
+	mock.calls.DisableSingleAZGateway = append(mock.calls.DisableSingleAZGateway, callInfo)
+	mock.lockDisableSingleAZGateway.Unlock()
+	return mock.DisableSingleAZGatewayFunc(gateway)
+}
+
+// DisableSingleAZGatewayCalls gets all the calls that were made to DisableSingleAZGateway.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableSingleAZGatewayCalls())
+func (mock *EdgeClientMock) DisableSingleAZGatewayCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableSingleAZGateway.RLock()
+	calls = mock.calls.DisableSingleAZGateway
+	mock.lockDisableSingleAZGateway.RUnlock()
+	return calls
+}
+
+// DisableSkipPublicRouteUpdate calls DisableSkipPublicRouteUpdateFunc.
+func (mock *EdgeClientMock) DisableSkipPublicRouteUpdate(gw *Gateway) error {
+	if mock.DisableSkipPublicRouteUpdateFunc == nil {
+		panic("EdgeClientMock.DisableSkipPublicRouteUpdateFunc: method is nil but EdgeClient.DisableSkipPublicRouteUpdate was just called")
+	}
+	callInfo := struct {
+		Gw *Gateway
+	}{
+		Gw: gw,
+	}
+	mock.lockDisableSkipPublicRouteUpdate.Lock()
+	mock.calls.DisableSkipPublicRouteUpdate = append(mock.calls.DisableSkipPublicRouteUpdate, callInfo)
+	mock.lockDisableSkipPublicRouteUpdate.Unlock()
+	return mock.DisableSkipPublicRouteUpdateFunc(gw)
+}
+
+// DisableSkipPublicRouteUpdateCalls gets all the calls that were made to DisableSkipPublicRouteUpdate.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableSkipPublicRouteUpdateCalls())
+func (mock *EdgeClientMock) DisableSkipPublicRouteUpdateCalls() []struct {
+	Gw *Gateway
+} {
+	var calls []struct {
+		Gw *Gateway
+	}
+	mock.lockDisableSkipPublicRouteUpdate.RLock()
+	calls = mock.calls.DisableSkipPublicRouteUpdate
+	mock.lockDisableSkipPublicRouteUpdate.RUnlock()
+	return calls
+}
+
+// DisableSkipPublicRouteUpdateGatewayGroup calls DisableSkipPublicRouteUpdateGatewayGroupFunc.
+func (mock *EdgeClientMock) DisableSkipPublicRouteUpdateGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableSkipPublicRouteUpdateGatewayGroupFunc == nil {
+		panic("EdgeClientMock.DisableSkipPublicRouteUpdateGatewayGroupFunc: method is nil but EdgeClient.DisableSkipPublicRouteUpdateGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableSkipPublicRouteUpdateGatewayGroup.Lock()
+	mock.calls.DisableSkipPublicRouteUpdateGatewayGroup = append(mock.calls.DisableSkipPublicRouteUpdateGatewayGroup, callInfo)
+	mock.lockDisableSkipPublicRouteUpdateGatewayGroup.Unlock()
+	return mock.DisableSkipPublicRouteUpdateGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableSkipPublicRouteUpdateGatewayGroupCalls gets all the calls that were made to DisableSkipPublicRouteUpdateGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableSkipPublicRouteUpdateGatewayGroupCalls())
+func (mock *EdgeClientMock) DisableSkipPublicRouteUpdateGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableSkipPublicRouteUpdateGatewayGroup.RLock()
+	calls = mock.calls.DisableSkipPublicRouteUpdateGatewayGroup
+	mock.lockDisableSkipPublicRouteUpdateGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableSpokeLearnedCidrsApproval calls DisableSpokeLearnedCidrsApprovalFunc.
+func (mock *EdgeClientMock) DisableSpokeLearnedCidrsApproval(gateway *SpokeVpc) error {
+	if mock.DisableSpokeLearnedCidrsApprovalFunc == nil {
+		panic("EdgeClientMock.DisableSpokeLearnedCidrsApprovalFunc: method is nil but EdgeClient.DisableSpokeLearnedCidrsApproval was just called")
+	}
+	callInfo := struct {
+		Gateway *SpokeVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableSpokeLearnedCidrsApproval.Lock()
+	mock.calls.DisableSpokeLearnedCidrsApproval = append(mock.calls.DisableSpokeLearnedCidrsApproval, callInfo)
+	mock.lockDisableSpokeLearnedCidrsApproval.Unlock()
+	return mock.DisableSpokeLearnedCidrsApprovalFunc(gateway)
+}
+
+// DisableSpokeLearnedCidrsApprovalCalls gets all the calls that were made to DisableSpokeLearnedCidrsApproval.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableSpokeLearnedCidrsApprovalCalls())
+func (mock *EdgeClientMock) DisableSpokeLearnedCidrsApprovalCalls() []struct {
+	Gateway *SpokeVpc
+} {
+	var calls []struct {
+		Gateway *SpokeVpc
+	}
+	mock.lockDisableSpokeLearnedCidrsApproval.RLock()
+	calls = mock.calls.DisableSpokeLearnedCidrsApproval
+	mock.lockDisableSpokeLearnedCidrsApproval.RUnlock()
+	return calls
+}
+
+// DisableSpokeLearnedCidrsApprovalGatewayGroup calls DisableSpokeLearnedCidrsApprovalGatewayGroupFunc.
+func (mock *EdgeClientMock) DisableSpokeLearnedCidrsApprovalGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableSpokeLearnedCidrsApprovalGatewayGroupFunc == nil {
+		panic("EdgeClientMock.DisableSpokeLearnedCidrsApprovalGatewayGroupFunc: method is nil but EdgeClient.DisableSpokeLearnedCidrsApprovalGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableSpokeLearnedCidrsApprovalGatewayGroup.Lock()
+	mock.calls.DisableSpokeLearnedCidrsApprovalGatewayGroup = append(mock.calls.DisableSpokeLearnedCidrsApprovalGatewayGroup, callInfo)
+	mock.lockDisableSpokeLearnedCidrsApprovalGatewayGroup.Unlock()
+	return mock.DisableSpokeLearnedCidrsApprovalGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableSpokeLearnedCidrsApprovalGatewayGroupCalls gets all the calls that were made to DisableSpokeLearnedCidrsApprovalGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableSpokeLearnedCidrsApprovalGatewayGroupCalls())
+func (mock *EdgeClientMock) DisableSpokeLearnedCidrsApprovalGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableSpokeLearnedCidrsApprovalGatewayGroup.RLock()
+	calls = mock.calls.DisableSpokeLearnedCidrsApprovalGatewayGroup
+	mock.lockDisableSpokeLearnedCidrsApprovalGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableSpokeOnpremRoutePropagation calls DisableSpokeOnpremRoutePropagationFunc.
+func (mock *EdgeClientMock) DisableSpokeOnpremRoutePropagation(spokeGateway *SpokeVpc) error {
+	if mock.DisableSpokeOnpremRoutePropagationFunc == nil {
+		panic("EdgeClientMock.DisableSpokeOnpremRoutePropagationFunc: method is nil but EdgeClient.DisableSpokeOnpremRoutePropagation was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+	}{
+		SpokeGateway: spokeGateway,
+	}
+	mock.lockDisableSpokeOnpremRoutePropagation.Lock()
+	mock.calls.DisableSpokeOnpremRoutePropagation = append(mock.calls.DisableSpokeOnpremRoutePropagation, callInfo)
+	mock.lockDisableSpokeOnpremRoutePropagation.Unlock()
+	return mock.DisableSpokeOnpremRoutePropagationFunc(spokeGateway)
+}
+
+// DisableSpokeOnpremRoutePropagationCalls gets all the calls that were made to DisableSpokeOnpremRoutePropagation.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableSpokeOnpremRoutePropagationCalls())
+func (mock *EdgeClientMock) DisableSpokeOnpremRoutePropagationCalls() []struct {
+	SpokeGateway *SpokeVpc
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+	}
+	mock.lockDisableSpokeOnpremRoutePropagation.RLock()
+	calls = mock.calls.DisableSpokeOnpremRoutePropagation
+	mock.lockDisableSpokeOnpremRoutePropagation.RUnlock()
+	return calls
+}
+
+// DisableSpokeOnpremRoutePropagationGatewayGroup calls DisableSpokeOnpremRoutePropagationGatewayGroupFunc.
+func (mock *EdgeClientMock) DisableSpokeOnpremRoutePropagationGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableSpokeOnpremRoutePropagationGatewayGroupFunc == nil {
+		panic("EdgeClientMock.DisableSpokeOnpremRoutePropagationGatewayGroupFunc: method is nil but EdgeClient.DisableSpokeOnpremRoutePropagationGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableSpokeOnpremRoutePropagationGatewayGroup.Lock()
+	mock.calls.DisableSpokeOnpremRoutePropagationGatewayGroup = append(mock.calls.DisableSpokeOnpremRoutePropagationGatewayGroup, callInfo)
+	mock.lockDisableSpokeOnpremRoutePropagationGatewayGroup.Unlock()
+	return mock.DisableSpokeOnpremRoutePropagationGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableSpokeOnpremRoutePropagationGatewayGroupCalls gets all the calls that were made to DisableSpokeOnpremRoutePropagationGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableSpokeOnpremRoutePropagationGatewayGroupCalls())
+func (mock *EdgeClientMock) DisableSpokeOnpremRoutePropagationGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableSpokeOnpremRoutePropagationGatewayGroup.RLock()
+	calls = mock.calls.DisableSpokeOnpremRoutePropagationGatewayGroup
+	mock.lockDisableSpokeOnpremRoutePropagationGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableSpokePreserveAsPath calls DisableSpokePreserveAsPathFunc.
+func (mock *EdgeClientMock) DisableSpokePreserveAsPath(spokeGateway *SpokeVpc) error {
+	if mock.DisableSpokePreserveAsPathFunc == nil {
+		panic("EdgeClientMock.DisableSpokePreserveAsPathFunc: method is nil but EdgeClient.DisableSpokePreserveAsPath was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+	}{
+		SpokeGateway: spokeGateway,
+	}
+	mock.lockDisableSpokePreserveAsPath.Lock()
+	mock.calls.DisableSpokePreserveAsPath = append(mock.calls.DisableSpokePreserveAsPath, callInfo)
+	mock.lockDisableSpokePreserveAsPath.Unlock()
+	return mock.DisableSpokePreserveAsPathFunc(spokeGateway)
+}
+
+// DisableSpokePreserveAsPathCalls gets all the calls that were made to DisableSpokePreserveAsPath.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableSpokePreserveAsPathCalls())
+func (mock *EdgeClientMock) DisableSpokePreserveAsPathCalls() []struct {
+	SpokeGateway *SpokeVpc
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+	}
+	mock.lockDisableSpokePreserveAsPath.RLock()
+	calls = mock.calls.DisableSpokePreserveAsPath
+	mock.lockDisableSpokePreserveAsPath.RUnlock()
+	return calls
+}
+
+// DisableSpokePreserveAsPathGatewayGroup calls DisableSpokePreserveAsPathGatewayGroupFunc.
+func (mock *EdgeClientMock) DisableSpokePreserveAsPathGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableSpokePreserveAsPathGatewayGroupFunc == nil {
+		panic("EdgeClientMock.DisableSpokePreserveAsPathGatewayGroupFunc: method is nil but EdgeClient.DisableSpokePreserveAsPathGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableSpokePreserveAsPathGatewayGroup.Lock()
+	mock.calls.DisableSpokePreserveAsPathGatewayGroup = append(mock.calls.DisableSpokePreserveAsPathGatewayGroup, callInfo)
+	mock.lockDisableSpokePreserveAsPathGatewayGroup.Unlock()
+	return mock.DisableSpokePreserveAsPathGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableSpokePreserveAsPathGatewayGroupCalls gets all the calls that were made to DisableSpokePreserveAsPathGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableSpokePreserveAsPathGatewayGroupCalls())
+func (mock *EdgeClientMock) DisableSpokePreserveAsPathGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableSpokePreserveAsPathGatewayGroup.RLock()
+	calls = mock.calls.DisableSpokePreserveAsPathGatewayGroup
+	mock.lockDisableSpokePreserveAsPathGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableSummarizeCidrToTgw calls DisableSummarizeCidrToTgwFunc.
+func (mock *EdgeClientMock) DisableSummarizeCidrToTgw(gwName string) error {
+	if mock.DisableSummarizeCidrToTgwFunc == nil {
+		panic("EdgeClientMock.DisableSummarizeCidrToTgwFunc: method is nil but EdgeClient.DisableSummarizeCidrToTgw was just called")
+	}
+	callInfo := struct {
+		GwName string
+	}{
+		GwName: gwName,
+	}
+	mock.lockDisableSummarizeCidrToTgw.Lock()
+	mock.calls.DisableSummarizeCidrToTgw = append(mock.calls.DisableSummarizeCidrToTgw, callInfo)
+	mock.lockDisableSummarizeCidrToTgw.Unlock()
+	return mock.DisableSummarizeCidrToTgwFunc(gwName)
+}
+
+// DisableSummarizeCidrToTgwCalls gets all the calls that were made to DisableSummarizeCidrToTgw.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableSummarizeCidrToTgwCalls())
+func (mock *EdgeClientMock) DisableSummarizeCidrToTgwCalls() []struct {
+	GwName string
+} {
+	var calls []struct {
+		GwName string
+	}
+	mock.lockDisableSummarizeCidrToTgw.RLock()
+	calls = mock.calls.DisableSummarizeCidrToTgw
+	mock.lockDisableSummarizeCidrToTgw.RUnlock()
+	return calls
+}
+
+// DisableTransitFireNet calls DisableTransitFireNetFunc.
+func (mock *EdgeClientMock) DisableTransitFireNet(gateway *Gateway) error {
+	if mock.DisableTransitFireNetFunc == nil {
+		panic("EdgeClientMock.DisableTransitFireNetFunc: method is nil but EdgeClient.DisableTransitFireNet was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableTransitFireNet.Lock()
+	mock.calls.DisableTransitFireNet = append(mock.calls.DisableTransitFireNet, callInfo)
+	mock.lockDisableTransitFireNet.Unlock()
+	return mock.DisableTransitFireNetFunc(gateway)
+}
+
+// DisableTransitFireNetCalls gets all the calls that were made to DisableTransitFireNet.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableTransitFireNetCalls())
+func (mock *EdgeClientMock) DisableTransitFireNetCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableTransitFireNet.RLock()
+	calls = mock.calls.DisableTransitFireNet
+	mock.lockDisableTransitFireNet.RUnlock()
+	return calls
+}
+
+// DisableTransitFireNetGatewayGroup calls DisableTransitFireNetGatewayGroupFunc.
+func (mock *EdgeClientMock) DisableTransitFireNetGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableTransitFireNetGatewayGroupFunc == nil {
+		panic("EdgeClientMock.DisableTransitFireNetGatewayGroupFunc: method is nil but EdgeClient.DisableTransitFireNetGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableTransitFireNetGatewayGroup.Lock()
+	mock.calls.DisableTransitFireNetGatewayGroup = append(mock.calls.DisableTransitFireNetGatewayGroup, callInfo)
+	mock.lockDisableTransitFireNetGatewayGroup.Unlock()
+	return mock.DisableTransitFireNetGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableTransitFireNetGatewayGroupCalls gets all the calls that were made to DisableTransitFireNetGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableTransitFireNetGatewayGroupCalls())
+func (mock *EdgeClientMock) DisableTransitFireNetGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableTransitFireNetGatewayGroup.RLock()
+	calls = mock.calls.DisableTransitFireNetGatewayGroup
+	mock.lockDisableTransitFireNetGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableTransitLearnedCidrsApproval calls DisableTransitLearnedCidrsApprovalFunc.
+func (mock *EdgeClientMock) DisableTransitLearnedCidrsApproval(gateway *TransitVpc) error {
+	if mock.DisableTransitLearnedCidrsApprovalFunc == nil {
+		panic("EdgeClientMock.DisableTransitLearnedCidrsApprovalFunc: method is nil but EdgeClient.DisableTransitLearnedCidrsApproval was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableTransitLearnedCidrsApproval.Lock()
+	mock.calls.DisableTransitLearnedCidrsApproval = append(mock.calls.DisableTransitLearnedCidrsApproval, callInfo)
+	mock.lockDisableTransitLearnedCidrsApproval.Unlock()
+	return mock.DisableTransitLearnedCidrsApprovalFunc(gateway)
+}
+
+// DisableTransitLearnedCidrsApprovalCalls gets all the calls that were made to DisableTransitLearnedCidrsApproval.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableTransitLearnedCidrsApprovalCalls())
+func (mock *EdgeClientMock) DisableTransitLearnedCidrsApprovalCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockDisableTransitLearnedCidrsApproval.RLock()
+	calls = mock.calls.DisableTransitLearnedCidrsApproval
+	mock.lockDisableTransitLearnedCidrsApproval.RUnlock()
+	return calls
+}
+
+// DisableTransitLearnedCidrsApprovalGatewayGroup calls DisableTransitLearnedCidrsApprovalGatewayGroupFunc.
+func (mock *EdgeClientMock) DisableTransitLearnedCidrsApprovalGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableTransitLearnedCidrsApprovalGatewayGroupFunc == nil {
+		panic("EdgeClientMock.DisableTransitLearnedCidrsApprovalGatewayGroupFunc: method is nil but EdgeClient.DisableTransitLearnedCidrsApprovalGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableTransitLearnedCidrsApprovalGatewayGroup.Lock()
+	mock.calls.DisableTransitLearnedCidrsApprovalGatewayGroup = append(mock.calls.DisableTransitLearnedCidrsApprovalGatewayGroup, callInfo)
+	mock.lockDisableTransitLearnedCidrsApprovalGatewayGroup.Unlock()
+	return mock.DisableTransitLearnedCidrsApprovalGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableTransitLearnedCidrsApprovalGatewayGroupCalls gets all the calls that were made to DisableTransitLearnedCidrsApprovalGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableTransitLearnedCidrsApprovalGatewayGroupCalls())
+func (mock *EdgeClientMock) DisableTransitLearnedCidrsApprovalGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableTransitLearnedCidrsApprovalGatewayGroup.RLock()
+	calls = mock.calls.DisableTransitLearnedCidrsApprovalGatewayGroup
+	mock.lockDisableTransitLearnedCidrsApprovalGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableTransitPreserveAsPath calls DisableTransitPreserveAsPathFunc.
+func (mock *EdgeClientMock) DisableTransitPreserveAsPath(transitGateway *TransitVpc) error {
+	if mock.DisableTransitPreserveAsPathFunc == nil {
+		panic("EdgeClientMock.DisableTransitPreserveAsPathFunc: method is nil but EdgeClient.DisableTransitPreserveAsPath was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockDisableTransitPreserveAsPath.Lock()
+	mock.calls.DisableTransitPreserveAsPath = append(mock.calls.DisableTransitPreserveAsPath, callInfo)
+	mock.lockDisableTransitPreserveAsPath.Unlock()
+	return mock.DisableTransitPreserveAsPathFunc(transitGateway)
+}
+
+// DisableTransitPreserveAsPathCalls gets all the calls that were made to DisableTransitPreserveAsPath.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableTransitPreserveAsPathCalls())
+func (mock *EdgeClientMock) DisableTransitPreserveAsPathCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockDisableTransitPreserveAsPath.RLock()
+	calls = mock.calls.DisableTransitPreserveAsPath
+	mock.lockDisableTransitPreserveAsPath.RUnlock()
+	return calls
+}
+
+// DisableTransitPreserveAsPathGatewayGroup calls DisableTransitPreserveAsPathGatewayGroupFunc.
+func (mock *EdgeClientMock) DisableTransitPreserveAsPathGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableTransitPreserveAsPathGatewayGroupFunc == nil {
+		panic("EdgeClientMock.DisableTransitPreserveAsPathGatewayGroupFunc: method is nil but EdgeClient.DisableTransitPreserveAsPathGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableTransitPreserveAsPathGatewayGroup.Lock()
+	mock.calls.DisableTransitPreserveAsPathGatewayGroup = append(mock.calls.DisableTransitPreserveAsPathGatewayGroup, callInfo)
+	mock.lockDisableTransitPreserveAsPathGatewayGroup.Unlock()
+	return mock.DisableTransitPreserveAsPathGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableTransitPreserveAsPathGatewayGroupCalls gets all the calls that were made to DisableTransitPreserveAsPathGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableTransitPreserveAsPathGatewayGroupCalls())
+func (mock *EdgeClientMock) DisableTransitPreserveAsPathGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableTransitPreserveAsPathGatewayGroup.RLock()
+	calls = mock.calls.DisableTransitPreserveAsPathGatewayGroup
+	mock.lockDisableTransitPreserveAsPathGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableTransitSummarizeCidrToTgwGatewayGroup calls DisableTransitSummarizeCidrToTgwGatewayGroupFunc.
+func (mock *EdgeClientMock) DisableTransitSummarizeCidrToTgwGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableTransitSummarizeCidrToTgwGatewayGroupFunc == nil {
+		panic("EdgeClientMock.DisableTransitSummarizeCidrToTgwGatewayGroupFunc: method is nil but EdgeClient.DisableTransitSummarizeCidrToTgwGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableTransitSummarizeCidrToTgwGatewayGroup.Lock()
+	mock.calls.DisableTransitSummarizeCidrToTgwGatewayGroup = append(mock.calls.DisableTransitSummarizeCidrToTgwGatewayGroup, callInfo)
+	mock.lockDisableTransitSummarizeCidrToTgwGatewayGroup.Unlock()
+	return mock.DisableTransitSummarizeCidrToTgwGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableTransitSummarizeCidrToTgwGatewayGroupCalls gets all the calls that were made to DisableTransitSummarizeCidrToTgwGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableTransitSummarizeCidrToTgwGatewayGroupCalls())
+func (mock *EdgeClientMock) DisableTransitSummarizeCidrToTgwGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableTransitSummarizeCidrToTgwGatewayGroup.RLock()
+	calls = mock.calls.DisableTransitSummarizeCidrToTgwGatewayGroup
+	mock.lockDisableTransitSummarizeCidrToTgwGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableVPNConfig calls DisableVPNConfigFunc.
+func (mock *EdgeClientMock) DisableVPNConfig(gateway *Gateway, vpnConfig *VPNConfig) error {
+	if mock.DisableVPNConfigFunc == nil {
+		panic("EdgeClientMock.DisableVPNConfigFunc: method is nil but EdgeClient.DisableVPNConfig was just called")
+	}
+	callInfo := struct {
+		Gateway   *Gateway
+		VpnConfig *VPNConfig
+	}{
+		Gateway:   gateway,
+		VpnConfig: vpnConfig,
+	}
+	mock.lockDisableVPNConfig.Lock()
+	mock.calls.DisableVPNConfig = append(mock.calls.DisableVPNConfig, callInfo)
+	mock.lockDisableVPNConfig.Unlock()
+	return mock.DisableVPNConfigFunc(gateway, vpnConfig)
+}
+
+// DisableVPNConfigCalls gets all the calls that were made to DisableVPNConfig.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableVPNConfigCalls())
+func (mock *EdgeClientMock) DisableVPNConfigCalls() []struct {
+	Gateway   *Gateway
+	VpnConfig *VPNConfig
+} {
+	var calls []struct {
+		Gateway   *Gateway
+		VpnConfig *VPNConfig
+	}
+	mock.lockDisableVPNConfig.RLock()
+	calls = mock.calls.DisableVPNConfig
+	mock.lockDisableVPNConfig.RUnlock()
+	return calls
+}
+
+// DisableVpcDNSServer calls DisableVpcDNSServerFunc.
+func (mock *EdgeClientMock) DisableVpcDNSServer(gateway *Gateway) error {
+	if mock.DisableVpcDNSServerFunc == nil {
+		panic("EdgeClientMock.DisableVpcDNSServerFunc: method is nil but EdgeClient.DisableVpcDNSServer was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableVpcDNSServer.Lock()
+	mock.calls.DisableVpcDNSServer = append(mock.calls.DisableVpcDNSServer, callInfo)
+	mock.lockDisableVpcDNSServer.Unlock()
+	return mock.DisableVpcDNSServerFunc(gateway)
+}
+
+// DisableVpcDNSServerCalls gets all the calls that were made to DisableVpcDNSServer.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableVpcDNSServerCalls())
+func (mock *EdgeClientMock) DisableVpcDNSServerCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableVpcDNSServer.RLock()
+	calls = mock.calls.DisableVpcDNSServer
+	mock.lockDisableVpcDNSServer.RUnlock()
+	return calls
+}
+
+// DisableVpnNat calls DisableVpnNatFunc.
+func (mock *EdgeClientMock) DisableVpnNat(gateway *Gateway) error {
+	if mock.DisableVpnNatFunc == nil {
+		panic("EdgeClientMock.DisableVpnNatFunc: method is nil but EdgeClient.DisableVpnNat was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableVpnNat.Lock()
+	mock.calls.DisableVpnNat = append(mock.calls.DisableVpnNat, callInfo)
+	mock.lockDisableVpnNat.Unlock()
+	return mock.DisableVpnNatFunc(gateway)
+}
+
+// DisableVpnNatCalls gets all the calls that were made to DisableVpnNat.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DisableVpnNatCalls())
+func (mock *EdgeClientMock) DisableVpnNatCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableVpnNat.RLock()
+	calls = mock.calls.DisableVpnNat
+	mock.lockDisableVpnNat.RUnlock()
+	return calls
+}
+
+// DownloadEdgeNEOConfigFile calls DownloadEdgeNEOConfigFileFunc.
+func (mock *EdgeClientMock) DownloadEdgeNEOConfigFile(ctx context.Context, edgeNEODevice *EdgeNEODevice) error {
+	if mock.DownloadEdgeNEOConfigFileFunc == nil {
+		panic("EdgeClientMock.DownloadEdgeNEOConfigFileFunc: method is nil but EdgeClient.DownloadEdgeNEOConfigFile was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		EdgeNEODevice *EdgeNEODevice
+	}{
+		Ctx:           ctx,
+		EdgeNEODevice: edgeNEODevice,
+	}
+	mock.lockDownloadEdgeNEOConfigFile.Lock()
+	mock.calls.DownloadEdgeNEOConfigFile = append(mock.calls.DownloadEdgeNEOConfigFile, callInfo)
+	mock.lockDownloadEdgeNEOConfigFile.Unlock()
+	return mock.DownloadEdgeNEOConfigFileFunc(ctx, edgeNEODevice)
+}
+
+// DownloadEdgeNEOConfigFileCalls gets all the calls that were made to DownloadEdgeNEOConfigFile.
+// Check the length with:
+//
+//	len(mockedEdgeClient.DownloadEdgeNEOConfigFileCalls())
+func (mock *EdgeClientMock) DownloadEdgeNEOConfigFileCalls() []struct {
+	Ctx           context.Context
+	EdgeNEODevice *EdgeNEODevice
+} {
+	var calls []struct {
+		Ctx           context.Context
+		EdgeNEODevice *EdgeNEODevice
+	}
+	mock.lockDownloadEdgeNEOConfigFile.RLock()
+	calls = mock.calls.DownloadEdgeNEOConfigFile
+	mock.lockDownloadEdgeNEOConfigFile.RUnlock()
+	return calls
+}
+
+// EditBgpMd5Key calls EditBgpMd5KeyFunc.
+func (mock *EdgeClientMock) EditBgpMd5Key(editBgpMd5Key *EditBgpMd5Key) error {
+	if mock.EditBgpMd5KeyFunc == nil {
+		panic("EdgeClientMock.EditBgpMd5KeyFunc: method is nil but EdgeClient.EditBgpMd5Key was just called")
+	}
+	callInfo := struct {
+		EditBgpMd5Key *EditBgpMd5Key
+	}{
+		EditBgpMd5Key: editBgpMd5Key,
+	}
+	mock.lockEditBgpMd5Key.Lock()
+	mock.calls.EditBgpMd5Key = append(mock.calls.EditBgpMd5Key, callInfo)
+	mock.lockEditBgpMd5Key.Unlock()
+	return mock.EditBgpMd5KeyFunc(editBgpMd5Key)
+}
+
+// EditBgpMd5KeyCalls gets all the calls that were made to EditBgpMd5Key.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EditBgpMd5KeyCalls())
+func (mock *EdgeClientMock) EditBgpMd5KeyCalls() []struct {
+	EditBgpMd5Key *EditBgpMd5Key
+} {
+	var calls []struct {
+		EditBgpMd5Key *EditBgpMd5Key
+	}
+	mock.lockEditBgpMd5Key.RLock()
+	calls = mock.calls.EditBgpMd5Key
+	mock.lockEditBgpMd5Key.RUnlock()
+	return calls
+}
+
+// EditConnectionBgpBfd calls EditConnectionBgpBfdFunc.
+func (mock *EdgeClientMock) EditConnectionBgpBfd(externalDeviceConn *ExternalDeviceConn) error {
+	if mock.EditConnectionBgpBfdFunc == nil {
+		panic("EdgeClientMock.EditConnectionBgpBfdFunc: method is nil but EdgeClient.EditConnectionBgpBfd was just called")
+	}
+	callInfo := struct {
+		ExternalDeviceConn *ExternalDeviceConn
+	}{
+		ExternalDeviceConn: externalDeviceConn,
+	}
+	mock.lockEditConnectionBgpBfd.Lock()
+	mock.calls.EditConnectionBgpBfd = append(mock.calls.EditConnectionBgpBfd, callInfo)
+	mock.lockEditConnectionBgpBfd.Unlock()
+	return mock.EditConnectionBgpBfdFunc(externalDeviceConn)
+}
+
+// EditConnectionBgpBfdCalls gets all the calls that were made to EditConnectionBgpBfd.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EditConnectionBgpBfdCalls())
+func (mock *EdgeClientMock) EditConnectionBgpBfdCalls() []struct {
+	ExternalDeviceConn *ExternalDeviceConn
+} {
+	var calls []struct {
+		ExternalDeviceConn *ExternalDeviceConn
+	}
+	mock.lockEditConnectionBgpBfd.RLock()
+	calls = mock.calls.EditConnectionBgpBfd
+	mock.lockEditConnectionBgpBfd.RUnlock()
+	return calls
+}
+
+// EditConnectionBgpMultihop calls EditConnectionBgpMultihopFunc.
+func (mock *EdgeClientMock) EditConnectionBgpMultihop(externalDeviceConn *ExternalDeviceConn) error {
+	if mock.EditConnectionBgpMultihopFunc == nil {
+		panic("EdgeClientMock.EditConnectionBgpMultihopFunc: method is nil but EdgeClient.EditConnectionBgpMultihop was just called")
+	}
+	callInfo := struct {
+		ExternalDeviceConn *ExternalDeviceConn
+	}{
+		ExternalDeviceConn: externalDeviceConn,
+	}
+	mock.lockEditConnectionBgpMultihop.Lock()
+	mock.calls.EditConnectionBgpMultihop = append(mock.calls.EditConnectionBgpMultihop, callInfo)
+	mock.lockEditConnectionBgpMultihop.Unlock()
+	return mock.EditConnectionBgpMultihopFunc(externalDeviceConn)
+}
+
+// EditConnectionBgpMultihopCalls gets all the calls that were made to EditConnectionBgpMultihop.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EditConnectionBgpMultihopCalls())
+func (mock *EdgeClientMock) EditConnectionBgpMultihopCalls() []struct {
+	ExternalDeviceConn *ExternalDeviceConn
+} {
+	var calls []struct {
+		ExternalDeviceConn *ExternalDeviceConn
+	}
+	mock.lockEditConnectionBgpMultihop.RLock()
+	calls = mock.calls.EditConnectionBgpMultihop
+	mock.lockEditConnectionBgpMultihop.RUnlock()
+	return calls
+}
+
+// EditDesignatedGateway calls EditDesignatedGatewayFunc.
+func (mock *EdgeClientMock) EditDesignatedGateway(gateway *Gateway) error {
+	if mock.EditDesignatedGatewayFunc == nil {
+		panic("EdgeClientMock.EditDesignatedGatewayFunc: method is nil but EdgeClient.EditDesignatedGateway was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEditDesignatedGateway.Lock()
+	mock.calls.EditDesignatedGateway = append(mock.calls.EditDesignatedGateway, callInfo)
+	mock.lockEditDesignatedGateway.Unlock()
+	return mock.EditDesignatedGatewayFunc(gateway)
+}
+
+// EditDesignatedGatewayCalls gets all the calls that were made to EditDesignatedGateway.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EditDesignatedGatewayCalls())
+func (mock *EdgeClientMock) EditDesignatedGatewayCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEditDesignatedGateway.RLock()
+	calls = mock.calls.EditDesignatedGateway
+	mock.lockEditDesignatedGateway.RUnlock()
+	return calls
+}
+
+// EditGatewayAdvertisedCidr calls EditGatewayAdvertisedCidrFunc.
+func (mock *EdgeClientMock) EditGatewayAdvertisedCidr(gateway *Gateway) error {
+	if mock.EditGatewayAdvertisedCidrFunc == nil {
+		panic("EdgeClientMock.EditGatewayAdvertisedCidrFunc: method is nil but EdgeClient.EditGatewayAdvertisedCidr was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEditGatewayAdvertisedCidr.Lock()
+	mock.calls.EditGatewayAdvertisedCidr = append(mock.calls.EditGatewayAdvertisedCidr, callInfo)
+	mock.lockEditGatewayAdvertisedCidr.Unlock()
+	return mock.EditGatewayAdvertisedCidrFunc(gateway)
+}
+
+// EditGatewayAdvertisedCidrCalls gets all the calls that were made to EditGatewayAdvertisedCidr.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EditGatewayAdvertisedCidrCalls())
+func (mock *EdgeClientMock) EditGatewayAdvertisedCidrCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEditGatewayAdvertisedCidr.RLock()
+	calls = mock.calls.EditGatewayAdvertisedCidr
+	mock.lockEditGatewayAdvertisedCidr.RUnlock()
+	return calls
+}
+
+// EditGatewayCustomRoutes calls EditGatewayCustomRoutesFunc.
+func (mock *EdgeClientMock) EditGatewayCustomRoutes(gateway *Gateway) error {
+	if mock.EditGatewayCustomRoutesFunc == nil {
+		panic("EdgeClientMock.EditGatewayCustomRoutesFunc: method is nil but EdgeClient.EditGatewayCustomRoutes was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEditGatewayCustomRoutes.Lock()
+	mock.calls.EditGatewayCustomRoutes = append(mock.calls.EditGatewayCustomRoutes, callInfo)
+	mock.lockEditGatewayCustomRoutes.Unlock()
+	return mock.EditGatewayCustomRoutesFunc(gateway)
+}
+
+// EditGatewayCustomRoutesCalls gets all the calls that were made to EditGatewayCustomRoutes.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EditGatewayCustomRoutesCalls())
+func (mock *EdgeClientMock) EditGatewayCustomRoutesCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEditGatewayCustomRoutes.RLock()
+	calls = mock.calls.EditGatewayCustomRoutes
+	mock.lockEditGatewayCustomRoutes.RUnlock()
+	return calls
+}
+
+// EditGatewayFilterRoutes calls EditGatewayFilterRoutesFunc.
+func (mock *EdgeClientMock) EditGatewayFilterRoutes(gateway *Gateway) error {
+	if mock.EditGatewayFilterRoutesFunc == nil {
+		panic("EdgeClientMock.EditGatewayFilterRoutesFunc: method is nil but EdgeClient.EditGatewayFilterRoutes was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEditGatewayFilterRoutes.Lock()
+	mock.calls.EditGatewayFilterRoutes = append(mock.calls.EditGatewayFilterRoutes, callInfo)
+	mock.lockEditGatewayFilterRoutes.Unlock()
+	return mock.EditGatewayFilterRoutesFunc(gateway)
+}
+
+// EditGatewayFilterRoutesCalls gets all the calls that were made to EditGatewayFilterRoutes.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EditGatewayFilterRoutesCalls())
+func (mock *EdgeClientMock) EditGatewayFilterRoutesCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEditGatewayFilterRoutes.RLock()
+	calls = mock.calls.EditGatewayFilterRoutes
+	mock.lockEditGatewayFilterRoutes.RUnlock()
+	return calls
+}
+
+// EditManagedRouteTables calls EditManagedRouteTablesFunc.
+func (mock *EdgeClientMock) EditManagedRouteTables(gateway *Gateway, routeTables []string) error {
+	if mock.EditManagedRouteTablesFunc == nil {
+		panic("EdgeClientMock.EditManagedRouteTablesFunc: method is nil but EdgeClient.EditManagedRouteTables was just called")
+	}
+	callInfo := struct {
+		Gateway     *Gateway
+		RouteTables []string
+	}{
+		Gateway:     gateway,
+		RouteTables: routeTables,
+	}
+	mock.lockEditManagedRouteTables.Lock()
+	mock.calls.EditManagedRouteTables = append(mock.calls.EditManagedRouteTables, callInfo)
+	mock.lockEditManagedRouteTables.Unlock()
+	return mock.EditManagedRouteTablesFunc(gateway, routeTables)
+}
+
+// EditManagedRouteTablesCalls gets all the calls that were made to EditManagedRouteTables.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EditManagedRouteTablesCalls())
+func (mock *EdgeClientMock) EditManagedRouteTablesCalls() []struct {
+	Gateway     *Gateway
+	RouteTables []string
+} {
+	var calls []struct {
+		Gateway     *Gateway
+		RouteTables []string
+	}
+	mock.lockEditManagedRouteTables.RLock()
+	calls = mock.calls.EditManagedRouteTables
+	mock.lockEditManagedRouteTables.RUnlock()
+	return calls
+}
+
+// EditManagedRouteTablesForGatewayGroup calls EditManagedRouteTablesForGatewayGroupFunc.
+func (mock *EdgeClientMock) EditManagedRouteTablesForGatewayGroup(ctx context.Context, groupName string, routeTables []string) error {
+	if mock.EditManagedRouteTablesForGatewayGroupFunc == nil {
+		panic("EdgeClientMock.EditManagedRouteTablesForGatewayGroupFunc: method is nil but EdgeClient.EditManagedRouteTablesForGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		GroupName   string
+		RouteTables []string
+	}{
+		Ctx:         ctx,
+		GroupName:   groupName,
+		RouteTables: routeTables,
+	}
+	mock.lockEditManagedRouteTablesForGatewayGroup.Lock()
+	mock.calls.EditManagedRouteTablesForGatewayGroup = append(mock.calls.EditManagedRouteTablesForGatewayGroup, callInfo)
+	mock.lockEditManagedRouteTablesForGatewayGroup.Unlock()
+	return mock.EditManagedRouteTablesForGatewayGroupFunc(ctx, groupName, routeTables)
+}
+
+// EditManagedRouteTablesForGatewayGroupCalls gets all the calls that were made to EditManagedRouteTablesForGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EditManagedRouteTablesForGatewayGroupCalls())
+func (mock *EdgeClientMock) EditManagedRouteTablesForGatewayGroupCalls() []struct {
+	Ctx         context.Context
+	GroupName   string
+	RouteTables []string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		GroupName   string
+		RouteTables []string
+	}
+	mock.lockEditManagedRouteTablesForGatewayGroup.RLock()
+	calls = mock.calls.EditManagedRouteTablesForGatewayGroup
+	mock.lockEditManagedRouteTablesForGatewayGroup.RUnlock()
+	return calls
+}
+
+// EditPrivateRouteTableConfig calls EditPrivateRouteTableConfigFunc.
+func (mock *EdgeClientMock) EditPrivateRouteTableConfig(gateway *Gateway, routeTables []string) error {
+	if mock.EditPrivateRouteTableConfigFunc == nil {
+		panic("EdgeClientMock.EditPrivateRouteTableConfigFunc: method is nil but EdgeClient.EditPrivateRouteTableConfig was just called")
+	}
+	callInfo := struct {
+		Gateway     *Gateway
+		RouteTables []string
+	}{
+		Gateway:     gateway,
+		RouteTables: routeTables,
+	}
+	mock.lockEditPrivateRouteTableConfig.Lock()
+	mock.calls.EditPrivateRouteTableConfig = append(mock.calls.EditPrivateRouteTableConfig, callInfo)
+	mock.lockEditPrivateRouteTableConfig.Unlock()
+	return mock.EditPrivateRouteTableConfigFunc(gateway, routeTables)
+}
+
+// EditPrivateRouteTableConfigCalls gets all the calls that were made to EditPrivateRouteTableConfig.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EditPrivateRouteTableConfigCalls())
+func (mock *EdgeClientMock) EditPrivateRouteTableConfigCalls() []struct {
+	Gateway     *Gateway
+	RouteTables []string
+} {
+	var calls []struct {
+		Gateway     *Gateway
+		RouteTables []string
+	}
+	mock.lockEditPrivateRouteTableConfig.RLock()
+	calls = mock.calls.EditPrivateRouteTableConfig
+	mock.lockEditPrivateRouteTableConfig.RUnlock()
+	return calls
+}
+
+// EditPrivateRouteTableConfigForGatewayGroup calls EditPrivateRouteTableConfigForGatewayGroupFunc.
+func (mock *EdgeClientMock) EditPrivateRouteTableConfigForGatewayGroup(ctx context.Context, groupName string, routeTables []string) error {
+	if mock.EditPrivateRouteTableConfigForGatewayGroupFunc == nil {
+		panic("EdgeClientMock.EditPrivateRouteTableConfigForGatewayGroupFunc: method is nil but EdgeClient.EditPrivateRouteTableConfigForGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		GroupName   string
+		RouteTables []string
+	}{
+		Ctx:         ctx,
+		GroupName:   groupName,
+		RouteTables: routeTables,
+	}
+	mock.lockEditPrivateRouteTableConfigForGatewayGroup.Lock()
+	mock.calls.EditPrivateRouteTableConfigForGatewayGroup = append(mock.calls.EditPrivateRouteTableConfigForGatewayGroup, callInfo)
+	mock.lockEditPrivateRouteTableConfigForGatewayGroup.Unlock()
+	return mock.EditPrivateRouteTableConfigForGatewayGroupFunc(ctx, groupName, routeTables)
+}
+
+// EditPrivateRouteTableConfigForGatewayGroupCalls gets all the calls that were made to EditPrivateRouteTableConfigForGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EditPrivateRouteTableConfigForGatewayGroupCalls())
+func (mock *EdgeClientMock) EditPrivateRouteTableConfigForGatewayGroupCalls() []struct {
+	Ctx         context.Context
+	GroupName   string
+	RouteTables []string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		GroupName   string
+		RouteTables []string
+	}
+	mock.lockEditPrivateRouteTableConfigForGatewayGroup.RLock()
+	calls = mock.calls.EditPrivateRouteTableConfigForGatewayGroup
+	mock.lockEditPrivateRouteTableConfigForGatewayGroup.RUnlock()
+	return calls
+}
+
+// EditPublicSubnetFilteringRouteTableList calls EditPublicSubnetFilteringRouteTableListFunc.
+func (mock *EdgeClientMock) EditPublicSubnetFilteringRouteTableList(gateway *Gateway, routeTables []string) error {
+	if mock.EditPublicSubnetFilteringRouteTableListFunc == nil {
+		panic("EdgeClientMock.EditPublicSubnetFilteringRouteTableListFunc: method is nil but EdgeClient.EditPublicSubnetFilteringRouteTableList was just called")
+	}
+	callInfo := struct {
+		Gateway     *Gateway
+		RouteTables []string
+	}{
+		Gateway:     gateway,
+		RouteTables: routeTables,
+	}
+	mock.lockEditPublicSubnetFilteringRouteTableList.Lock()
+	mock.calls.EditPublicSubnetFilteringRouteTableList = append(mock.calls.EditPublicSubnetFilteringRouteTableList, callInfo)
+	mock.lockEditPublicSubnetFilteringRouteTableList.Unlock()
+	return mock.EditPublicSubnetFilteringRouteTableListFunc(gateway, routeTables)
+}
+
+// EditPublicSubnetFilteringRouteTableListCalls gets all the calls that were made to EditPublicSubnetFilteringRouteTableList.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EditPublicSubnetFilteringRouteTableListCalls())
+func (mock *EdgeClientMock) EditPublicSubnetFilteringRouteTableListCalls() []struct {
+	Gateway     *Gateway
+	RouteTables []string
+} {
+	var calls []struct {
+		Gateway     *Gateway
+		RouteTables []string
+	}
+	mock.lockEditPublicSubnetFilteringRouteTableList.RLock()
+	calls = mock.calls.EditPublicSubnetFilteringRouteTableList
+	mock.lockEditPublicSubnetFilteringRouteTableList.RUnlock()
+	return calls
+}
+
+// EditSpokeExternalDeviceConnASPathPrepend calls EditSpokeExternalDeviceConnASPathPrependFunc.
+func (mock *EdgeClientMock) EditSpokeExternalDeviceConnASPathPrepend(externalDeviceConn *ExternalDeviceConn, prependASPath []string) error {
+	if mock.EditSpokeExternalDeviceConnASPathPrependFunc == nil {
+		panic("EdgeClientMock.EditSpokeExternalDeviceConnASPathPrependFunc: method is nil but EdgeClient.EditSpokeExternalDeviceConnASPathPrepend was just called")
+	}
+	callInfo := struct {
+		ExternalDeviceConn *ExternalDeviceConn
+		PrependASPath      []string
+	}{
+		ExternalDeviceConn: externalDeviceConn,
+		PrependASPath:      prependASPath,
+	}
+	mock.lockEditSpokeExternalDeviceConnASPathPrepend.Lock()
+	mock.calls.EditSpokeExternalDeviceConnASPathPrepend = append(mock.calls.EditSpokeExternalDeviceConnASPathPrepend, callInfo)
+	mock.lockEditSpokeExternalDeviceConnASPathPrepend.Unlock()
+	return mock.EditSpokeExternalDeviceConnASPathPrependFunc(externalDeviceConn, prependASPath)
+}
+
+// EditSpokeExternalDeviceConnASPathPrependCalls gets all the calls that were made to EditSpokeExternalDeviceConnASPathPrepend.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EditSpokeExternalDeviceConnASPathPrependCalls())
+func (mock *EdgeClientMock) EditSpokeExternalDeviceConnASPathPrependCalls() []struct {
+	ExternalDeviceConn *ExternalDeviceConn
+	PrependASPath      []string
+} {
+	var calls []struct {
+		ExternalDeviceConn *ExternalDeviceConn
+		PrependASPath      []string
+	}
+	mock.lockEditSpokeExternalDeviceConnASPathPrepend.RLock()
+	calls = mock.calls.EditSpokeExternalDeviceConnASPathPrepend
+	mock.lockEditSpokeExternalDeviceConnASPathPrepend.RUnlock()
+	return calls
+}
+
+// EditSpokeTransitAttachmentFilters calls EditSpokeTransitAttachmentFiltersFunc.
+func (mock *EdgeClientMock) EditSpokeTransitAttachmentFilters(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) error {
+	if mock.EditSpokeTransitAttachmentFiltersFunc == nil {
+		panic("EdgeClientMock.EditSpokeTransitAttachmentFiltersFunc: method is nil but EdgeClient.EditSpokeTransitAttachmentFilters was just called")
+	}
+	callInfo := struct {
+		Ctx                    context.Context
+		SpokeTransitAttachment *SpokeTransitAttachment
+	}{
+		Ctx:                    ctx,
+		SpokeTransitAttachment: spokeTransitAttachment,
+	}
+	mock.lockEditSpokeTransitAttachmentFilters.Lock()
+	mock.calls.EditSpokeTransitAttachmentFilters = append(mock.calls.EditSpokeTransitAttachmentFilters, callInfo)
+	mock.lockEditSpokeTransitAttachmentFilters.Unlock()
+	return mock.EditSpokeTransitAttachmentFiltersFunc(ctx, spokeTransitAttachment)
+}
+
+// EditSpokeTransitAttachmentFiltersCalls gets all the calls that were made to EditSpokeTransitAttachmentFilters.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EditSpokeTransitAttachmentFiltersCalls())
+func (mock *EdgeClientMock) EditSpokeTransitAttachmentFiltersCalls() []struct {
+	Ctx                    context.Context
+	SpokeTransitAttachment *SpokeTransitAttachment
+} {
+	var calls []struct {
+		Ctx                    context.Context
+		SpokeTransitAttachment *SpokeTransitAttachment
+	}
+	mock.lockEditSpokeTransitAttachmentFilters.RLock()
+	calls = mock.calls.EditSpokeTransitAttachmentFilters
+	mock.lockEditSpokeTransitAttachmentFilters.RUnlock()
+	return calls
+}
+
+// EditTransitConnectionASPathPrepend calls EditTransitConnectionASPathPrependFunc.
+func (mock *EdgeClientMock) EditTransitConnectionASPathPrepend(transitGatewayPeering *TransitGatewayPeering, prependASPath []string) error {
+	if mock.EditTransitConnectionASPathPrependFunc == nil {
+		panic("EdgeClientMock.EditTransitConnectionASPathPrependFunc: method is nil but EdgeClient.EditTransitConnectionASPathPrepend was just called")
+	}
+	callInfo := struct {
+		TransitGatewayPeering *TransitGatewayPeering
+		PrependASPath         []string
+	}{
+		TransitGatewayPeering: transitGatewayPeering,
+		PrependASPath:         prependASPath,
+	}
+	mock.lockEditTransitConnectionASPathPrepend.Lock()
+	mock.calls.EditTransitConnectionASPathPrepend = append(mock.calls.EditTransitConnectionASPathPrepend, callInfo)
+	mock.lockEditTransitConnectionASPathPrepend.Unlock()
+	return mock.EditTransitConnectionASPathPrependFunc(transitGatewayPeering, prependASPath)
+}
+
+// EditTransitConnectionASPathPrependCalls gets all the calls that were made to EditTransitConnectionASPathPrepend.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EditTransitConnectionASPathPrependCalls())
+func (mock *EdgeClientMock) EditTransitConnectionASPathPrependCalls() []struct {
+	TransitGatewayPeering *TransitGatewayPeering
+	PrependASPath         []string
+} {
+	var calls []struct {
+		TransitGatewayPeering *TransitGatewayPeering
+		PrependASPath         []string
+	}
+	mock.lockEditTransitConnectionASPathPrepend.RLock()
+	calls = mock.calls.EditTransitConnectionASPathPrepend
+	mock.lockEditTransitConnectionASPathPrepend.RUnlock()
+	return calls
+}
+
+// EditTransitConnectionBGPManualAdvertiseCIDRs calls EditTransitConnectionBGPManualAdvertiseCIDRsFunc.
+func (mock *EdgeClientMock) EditTransitConnectionBGPManualAdvertiseCIDRs(gwName string, connName string, cidrs []string) error {
+	if mock.EditTransitConnectionBGPManualAdvertiseCIDRsFunc == nil {
+		panic("EdgeClientMock.EditTransitConnectionBGPManualAdvertiseCIDRsFunc: method is nil but EdgeClient.EditTransitConnectionBGPManualAdvertiseCIDRs was just called")
+	}
+	callInfo := struct {
+		GwName   string
+		ConnName string
+		Cidrs    []string
+	}{
+		GwName:   gwName,
+		ConnName: connName,
+		Cidrs:    cidrs,
+	}
+	mock.lockEditTransitConnectionBGPManualAdvertiseCIDRs.Lock()
+	mock.calls.EditTransitConnectionBGPManualAdvertiseCIDRs = append(mock.calls.EditTransitConnectionBGPManualAdvertiseCIDRs, callInfo)
+	mock.lockEditTransitConnectionBGPManualAdvertiseCIDRs.Unlock()
+	return mock.EditTransitConnectionBGPManualAdvertiseCIDRsFunc(gwName, connName, cidrs)
+}
+
+// EditTransitConnectionBGPManualAdvertiseCIDRsCalls gets all the calls that were made to EditTransitConnectionBGPManualAdvertiseCIDRs.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EditTransitConnectionBGPManualAdvertiseCIDRsCalls())
+func (mock *EdgeClientMock) EditTransitConnectionBGPManualAdvertiseCIDRsCalls() []struct {
+	GwName   string
+	ConnName string
+	Cidrs    []string
+} {
+	var calls []struct {
+		GwName   string
+		ConnName string
+		Cidrs    []string
+	}
+	mock.lockEditTransitConnectionBGPManualAdvertiseCIDRs.RLock()
+	calls = mock.calls.EditTransitConnectionBGPManualAdvertiseCIDRs
+	mock.lockEditTransitConnectionBGPManualAdvertiseCIDRs.RUnlock()
+	return calls
+}
+
+// EnableActiveStandby calls EnableActiveStandbyFunc.
+func (mock *EdgeClientMock) EnableActiveStandby(transitGateway *TransitVpc) error {
+	if mock.EnableActiveStandbyFunc == nil {
+		panic("EdgeClientMock.EnableActiveStandbyFunc: method is nil but EdgeClient.EnableActiveStandby was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockEnableActiveStandby.Lock()
+	mock.calls.EnableActiveStandby = append(mock.calls.EnableActiveStandby, callInfo)
+	mock.lockEnableActiveStandby.Unlock()
+	return mock.EnableActiveStandbyFunc(transitGateway)
+}
+
+// EnableActiveStandbyCalls gets all the calls that were made to EnableActiveStandby.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableActiveStandbyCalls())
+func (mock *EdgeClientMock) EnableActiveStandbyCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockEnableActiveStandby.RLock()
+	calls = mock.calls.EnableActiveStandby
+	mock.lockEnableActiveStandby.RUnlock()
+	return calls
+}
+
+// EnableActiveStandbyGatewayGroup calls EnableActiveStandbyGatewayGroupFunc.
+func (mock *EdgeClientMock) EnableActiveStandbyGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableActiveStandbyGatewayGroupFunc == nil {
+		panic("EdgeClientMock.EnableActiveStandbyGatewayGroupFunc: method is nil but EdgeClient.EnableActiveStandbyGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableActiveStandbyGatewayGroup.Lock()
+	mock.calls.EnableActiveStandbyGatewayGroup = append(mock.calls.EnableActiveStandbyGatewayGroup, callInfo)
+	mock.lockEnableActiveStandbyGatewayGroup.Unlock()
+	return mock.EnableActiveStandbyGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableActiveStandbyGatewayGroupCalls gets all the calls that were made to EnableActiveStandbyGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableActiveStandbyGatewayGroupCalls())
+func (mock *EdgeClientMock) EnableActiveStandbyGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableActiveStandbyGatewayGroup.RLock()
+	calls = mock.calls.EnableActiveStandbyGatewayGroup
+	mock.lockEnableActiveStandbyGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableActiveStandbyPreemptive calls EnableActiveStandbyPreemptiveFunc.
+func (mock *EdgeClientMock) EnableActiveStandbyPreemptive(transitGateway *TransitVpc) error {
+	if mock.EnableActiveStandbyPreemptiveFunc == nil {
+		panic("EdgeClientMock.EnableActiveStandbyPreemptiveFunc: method is nil but EdgeClient.EnableActiveStandbyPreemptive was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockEnableActiveStandbyPreemptive.Lock()
+	mock.calls.EnableActiveStandbyPreemptive = append(mock.calls.EnableActiveStandbyPreemptive, callInfo)
+	mock.lockEnableActiveStandbyPreemptive.Unlock()
+	return mock.EnableActiveStandbyPreemptiveFunc(transitGateway)
+}
+
+// EnableActiveStandbyPreemptiveCalls gets all the calls that were made to EnableActiveStandbyPreemptive.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableActiveStandbyPreemptiveCalls())
+func (mock *EdgeClientMock) EnableActiveStandbyPreemptiveCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockEnableActiveStandbyPreemptive.RLock()
+	calls = mock.calls.EnableActiveStandbyPreemptive
+	mock.lockEnableActiveStandbyPreemptive.RUnlock()
+	return calls
+}
+
+// EnableActiveStandbyPreemptiveGatewayGroup calls EnableActiveStandbyPreemptiveGatewayGroupFunc.
+func (mock *EdgeClientMock) EnableActiveStandbyPreemptiveGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableActiveStandbyPreemptiveGatewayGroupFunc == nil {
+		panic("EdgeClientMock.EnableActiveStandbyPreemptiveGatewayGroupFunc: method is nil but EdgeClient.EnableActiveStandbyPreemptiveGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableActiveStandbyPreemptiveGatewayGroup.Lock()
+	mock.calls.EnableActiveStandbyPreemptiveGatewayGroup = append(mock.calls.EnableActiveStandbyPreemptiveGatewayGroup, callInfo)
+	mock.lockEnableActiveStandbyPreemptiveGatewayGroup.Unlock()
+	return mock.EnableActiveStandbyPreemptiveGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableActiveStandbyPreemptiveGatewayGroupCalls gets all the calls that were made to EnableActiveStandbyPreemptiveGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableActiveStandbyPreemptiveGatewayGroupCalls())
+func (mock *EdgeClientMock) EnableActiveStandbyPreemptiveGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableActiveStandbyPreemptiveGatewayGroup.RLock()
+	calls = mock.calls.EnableActiveStandbyPreemptiveGatewayGroup
+	mock.lockEnableActiveStandbyPreemptiveGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableActiveStandbyPreemptiveSpoke calls EnableActiveStandbyPreemptiveSpokeFunc.
+func (mock *EdgeClientMock) EnableActiveStandbyPreemptiveSpoke(spokeGateway *SpokeVpc) error {
+	if mock.EnableActiveStandbyPreemptiveSpokeFunc == nil {
+		panic("EdgeClientMock.EnableActiveStandbyPreemptiveSpokeFunc: method is nil but EdgeClient.EnableActiveStandbyPreemptiveSpoke was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+	}{
+		SpokeGateway: spokeGateway,
+	}
+	mock.lockEnableActiveStandbyPreemptiveSpoke.Lock()
+	mock.calls.EnableActiveStandbyPreemptiveSpoke = append(mock.calls.EnableActiveStandbyPreemptiveSpoke, callInfo)
+	mock.lockEnableActiveStandbyPreemptiveSpoke.Unlock()
+	return mock.EnableActiveStandbyPreemptiveSpokeFunc(spokeGateway)
+}
+
+// EnableActiveStandbyPreemptiveSpokeCalls gets all the calls that were made to EnableActiveStandbyPreemptiveSpoke.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableActiveStandbyPreemptiveSpokeCalls())
+func (mock *EdgeClientMock) EnableActiveStandbyPreemptiveSpokeCalls() []struct {
+	SpokeGateway *SpokeVpc
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+	}
+	mock.lockEnableActiveStandbyPreemptiveSpoke.RLock()
+	calls = mock.calls.EnableActiveStandbyPreemptiveSpoke
+	mock.lockEnableActiveStandbyPreemptiveSpoke.RUnlock()
+	return calls
+}
+
+// EnableActiveStandbySpoke calls EnableActiveStandbySpokeFunc.
+func (mock *EdgeClientMock) EnableActiveStandbySpoke(spokeGateway *SpokeVpc) error {
+	if mock.EnableActiveStandbySpokeFunc == nil {
+		panic("EdgeClientMock.EnableActiveStandbySpokeFunc: method is nil but EdgeClient.EnableActiveStandbySpoke was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+	}{
+		SpokeGateway: spokeGateway,
+	}
+	mock.lockEnableActiveStandbySpoke.Lock()
+	mock.calls.EnableActiveStandbySpoke = append(mock.calls.EnableActiveStandbySpoke, callInfo)
+	mock.lockEnableActiveStandbySpoke.Unlock()
+	return mock.EnableActiveStandbySpokeFunc(spokeGateway)
+}
+
+// EnableActiveStandbySpokeCalls gets all the calls that were made to EnableActiveStandbySpoke.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableActiveStandbySpokeCalls())
+func (mock *EdgeClientMock) EnableActiveStandbySpokeCalls() []struct {
+	SpokeGateway *SpokeVpc
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+	}
+	mock.lockEnableActiveStandbySpoke.RLock()
+	calls = mock.calls.EnableActiveStandbySpoke
+	mock.lockEnableActiveStandbySpoke.RUnlock()
+	return calls
+}
+
+// EnableAdvertiseTransitCidr calls EnableAdvertiseTransitCidrFunc.
+func (mock *EdgeClientMock) EnableAdvertiseTransitCidr(transitGw *TransitVpc) error {
+	if mock.EnableAdvertiseTransitCidrFunc == nil {
+		panic("EdgeClientMock.EnableAdvertiseTransitCidrFunc: method is nil but EdgeClient.EnableAdvertiseTransitCidr was just called")
+	}
+	callInfo := struct {
+		TransitGw *TransitVpc
+	}{
+		TransitGw: transitGw,
+	}
+	mock.lockEnableAdvertiseTransitCidr.Lock()
+	mock.calls.EnableAdvertiseTransitCidr = append(mock.calls.EnableAdvertiseTransitCidr, callInfo)
+	mock.lockEnableAdvertiseTransitCidr.Unlock()
+	return mock.EnableAdvertiseTransitCidrFunc(transitGw)
+}
+
+// EnableAdvertiseTransitCidrCalls gets all the calls that were made to EnableAdvertiseTransitCidr.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableAdvertiseTransitCidrCalls())
+func (mock *EdgeClientMock) EnableAdvertiseTransitCidrCalls() []struct {
+	TransitGw *TransitVpc
+} {
+	var calls []struct {
+		TransitGw *TransitVpc
+	}
+	mock.lockEnableAdvertiseTransitCidr.RLock()
+	calls = mock.calls.EnableAdvertiseTransitCidr
+	mock.lockEnableAdvertiseTransitCidr.RUnlock()
+	return calls
+}
+
+// EnableAdvertiseTransitCidrGatewayGroup calls EnableAdvertiseTransitCidrGatewayGroupFunc.
+func (mock *EdgeClientMock) EnableAdvertiseTransitCidrGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableAdvertiseTransitCidrGatewayGroupFunc == nil {
+		panic("EdgeClientMock.EnableAdvertiseTransitCidrGatewayGroupFunc: method is nil but EdgeClient.EnableAdvertiseTransitCidrGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableAdvertiseTransitCidrGatewayGroup.Lock()
+	mock.calls.EnableAdvertiseTransitCidrGatewayGroup = append(mock.calls.EnableAdvertiseTransitCidrGatewayGroup, callInfo)
+	mock.lockEnableAdvertiseTransitCidrGatewayGroup.Unlock()
+	return mock.EnableAdvertiseTransitCidrGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableAdvertiseTransitCidrGatewayGroupCalls gets all the calls that were made to EnableAdvertiseTransitCidrGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableAdvertiseTransitCidrGatewayGroupCalls())
+func (mock *EdgeClientMock) EnableAdvertiseTransitCidrGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableAdvertiseTransitCidrGatewayGroup.RLock()
+	calls = mock.calls.EnableAdvertiseTransitCidrGatewayGroup
+	mock.lockEnableAdvertiseTransitCidrGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableAutoAdvertiseS2CCidrs calls EnableAutoAdvertiseS2CCidrsFunc.
+func (mock *EdgeClientMock) EnableAutoAdvertiseS2CCidrs(gateway *Gateway) error {
+	if mock.EnableAutoAdvertiseS2CCidrsFunc == nil {
+		panic("EdgeClientMock.EnableAutoAdvertiseS2CCidrsFunc: method is nil but EdgeClient.EnableAutoAdvertiseS2CCidrs was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableAutoAdvertiseS2CCidrs.Lock()
+	mock.calls.EnableAutoAdvertiseS2CCidrs = append(mock.calls.EnableAutoAdvertiseS2CCidrs, callInfo)
+	mock.lockEnableAutoAdvertiseS2CCidrs.Unlock()
+	return mock.EnableAutoAdvertiseS2CCidrsFunc(gateway)
+}
+
+// EnableAutoAdvertiseS2CCidrsCalls gets all the calls that were made to EnableAutoAdvertiseS2CCidrs.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableAutoAdvertiseS2CCidrsCalls())
+func (mock *EdgeClientMock) EnableAutoAdvertiseS2CCidrsCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableAutoAdvertiseS2CCidrs.RLock()
+	calls = mock.calls.EnableAutoAdvertiseS2CCidrs
+	mock.lockEnableAutoAdvertiseS2CCidrs.RUnlock()
+	return calls
+}
+
+// EnableAutoAdvertiseS2CCidrsGatewayGroup calls EnableAutoAdvertiseS2CCidrsGatewayGroupFunc.
+func (mock *EdgeClientMock) EnableAutoAdvertiseS2CCidrsGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableAutoAdvertiseS2CCidrsGatewayGroupFunc == nil {
+		panic("EdgeClientMock.EnableAutoAdvertiseS2CCidrsGatewayGroupFunc: method is nil but EdgeClient.EnableAutoAdvertiseS2CCidrsGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableAutoAdvertiseS2CCidrsGatewayGroup.Lock()
+	mock.calls.EnableAutoAdvertiseS2CCidrsGatewayGroup = append(mock.calls.EnableAutoAdvertiseS2CCidrsGatewayGroup, callInfo)
+	mock.lockEnableAutoAdvertiseS2CCidrsGatewayGroup.Unlock()
+	return mock.EnableAutoAdvertiseS2CCidrsGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableAutoAdvertiseS2CCidrsGatewayGroupCalls gets all the calls that were made to EnableAutoAdvertiseS2CCidrsGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableAutoAdvertiseS2CCidrsGatewayGroupCalls())
+func (mock *EdgeClientMock) EnableAutoAdvertiseS2CCidrsGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableAutoAdvertiseS2CCidrsGatewayGroup.RLock()
+	calls = mock.calls.EnableAutoAdvertiseS2CCidrsGatewayGroup
+	mock.lockEnableAutoAdvertiseS2CCidrsGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableConnectedTransit calls EnableConnectedTransitFunc.
+func (mock *EdgeClientMock) EnableConnectedTransit(gateway *TransitVpc) error {
+	if mock.EnableConnectedTransitFunc == nil {
+		panic("EdgeClientMock.EnableConnectedTransitFunc: method is nil but EdgeClient.EnableConnectedTransit was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableConnectedTransit.Lock()
+	mock.calls.EnableConnectedTransit = append(mock.calls.EnableConnectedTransit, callInfo)
+	mock.lockEnableConnectedTransit.Unlock()
+	return mock.EnableConnectedTransitFunc(gateway)
+}
+
+// EnableConnectedTransitCalls gets all the calls that were made to EnableConnectedTransit.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableConnectedTransitCalls())
+func (mock *EdgeClientMock) EnableConnectedTransitCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockEnableConnectedTransit.RLock()
+	calls = mock.calls.EnableConnectedTransit
+	mock.lockEnableConnectedTransit.RUnlock()
+	return calls
+}
+
+// EnableConnectedTransitGatewayGroup calls EnableConnectedTransitGatewayGroupFunc.
+func (mock *EdgeClientMock) EnableConnectedTransitGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableConnectedTransitGatewayGroupFunc == nil {
+		panic("EdgeClientMock.EnableConnectedTransitGatewayGroupFunc: method is nil but EdgeClient.EnableConnectedTransitGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableConnectedTransitGatewayGroup.Lock()
+	mock.calls.EnableConnectedTransitGatewayGroup = append(mock.calls.EnableConnectedTransitGatewayGroup, callInfo)
+	mock.lockEnableConnectedTransitGatewayGroup.Unlock()
+	return mock.EnableConnectedTransitGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableConnectedTransitGatewayGroupCalls gets all the calls that were made to EnableConnectedTransitGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableConnectedTransitGatewayGroupCalls())
+func (mock *EdgeClientMock) EnableConnectedTransitGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableConnectedTransitGatewayGroup.RLock()
+	calls = mock.calls.EnableConnectedTransitGatewayGroup
+	mock.lockEnableConnectedTransitGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableCustomizedSNat calls EnableCustomizedSNatFunc.
+func (mock *EdgeClientMock) EnableCustomizedSNat(gateway *Gateway) error {
+	if mock.EnableCustomizedSNatFunc == nil {
+		panic("EdgeClientMock.EnableCustomizedSNatFunc: method is nil but EdgeClient.EnableCustomizedSNat was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableCustomizedSNat.Lock()
+	mock.calls.EnableCustomizedSNat = append(mock.calls.EnableCustomizedSNat, callInfo)
+	mock.lockEnableCustomizedSNat.Unlock()
+	return mock.EnableCustomizedSNatFunc(gateway)
+}
+
+// EnableCustomizedSNatCalls gets all the calls that were made to EnableCustomizedSNat.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableCustomizedSNatCalls())
+func (mock *EdgeClientMock) EnableCustomizedSNatCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableCustomizedSNat.RLock()
+	calls = mock.calls.EnableCustomizedSNat
+	mock.lockEnableCustomizedSNat.RUnlock()
+	return calls
+}
+
+// EnableEdgeSpokeTransitiveRouting calls EnableEdgeSpokeTransitiveRoutingFunc.
+func (mock *EdgeClientMock) EnableEdgeSpokeTransitiveRouting(ctx context.Context, name string) error {
+	if mock.EnableEdgeSpokeTransitiveRoutingFunc == nil {
+		panic("EdgeClientMock.EnableEdgeSpokeTransitiveRoutingFunc: method is nil but EdgeClient.EnableEdgeSpokeTransitiveRouting was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Name string
+	}{
+		Ctx:  ctx,
+		Name: name,
+	}
+	mock.lockEnableEdgeSpokeTransitiveRouting.Lock()
+	mock.calls.EnableEdgeSpokeTransitiveRouting = append(mock.calls.EnableEdgeSpokeTransitiveRouting, callInfo)
+	mock.lockEnableEdgeSpokeTransitiveRouting.Unlock()
+	return mock.EnableEdgeSpokeTransitiveRoutingFunc(ctx, name)
+}
+
+// EnableEdgeSpokeTransitiveRoutingCalls gets all the calls that were made to EnableEdgeSpokeTransitiveRouting.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableEdgeSpokeTransitiveRoutingCalls())
+func (mock *EdgeClientMock) EnableEdgeSpokeTransitiveRoutingCalls() []struct {
+	Ctx  context.Context
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Name string
+	}
+	mock.lockEnableEdgeSpokeTransitiveRouting.RLock()
+	calls = mock.calls.EnableEdgeSpokeTransitiveRouting
+	mock.lockEnableEdgeSpokeTransitiveRouting.RUnlock()
+	return calls
+}
+
+// EnableEgressTransitFirenet calls EnableEgressTransitFirenetFunc.
+func (mock *EdgeClientMock) EnableEgressTransitFirenet(transitGateway *TransitVpc) error {
+	if mock.EnableEgressTransitFirenetFunc == nil {
+		panic("EdgeClientMock.EnableEgressTransitFirenetFunc: method is nil but EdgeClient.EnableEgressTransitFirenet was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockEnableEgressTransitFirenet.Lock()
+	mock.calls.EnableEgressTransitFirenet = append(mock.calls.EnableEgressTransitFirenet, callInfo)
+	mock.lockEnableEgressTransitFirenet.Unlock()
+	return mock.EnableEgressTransitFirenetFunc(transitGateway)
+}
+
+// EnableEgressTransitFirenetCalls gets all the calls that were made to EnableEgressTransitFirenet.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableEgressTransitFirenetCalls())
+func (mock *EdgeClientMock) EnableEgressTransitFirenetCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockEnableEgressTransitFirenet.RLock()
+	calls = mock.calls.EnableEgressTransitFirenet
+	mock.lockEnableEgressTransitFirenet.RUnlock()
+	return calls
+}
+
+// EnableEncryptVolume calls EnableEncryptVolumeFunc.
+func (mock *EdgeClientMock) EnableEncryptVolume(gateway *Gateway) error {
+	if mock.EnableEncryptVolumeFunc == nil {
+		panic("EdgeClientMock.EnableEncryptVolumeFunc: method is nil but EdgeClient.EnableEncryptVolume was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableEncryptVolume.Lock()
+	mock.calls.EnableEncryptVolume = append(mock.calls.EnableEncryptVolume, callInfo)
+	mock.lockEnableEncryptVolume.Unlock()
+	return mock.EnableEncryptVolumeFunc(gateway)
+}
+
+// EnableEncryptVolumeCalls gets all the calls that were made to EnableEncryptVolume.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableEncryptVolumeCalls())
+func (mock *EdgeClientMock) EnableEncryptVolumeCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableEncryptVolume.RLock()
+	calls = mock.calls.EnableEncryptVolume
+	mock.lockEnableEncryptVolume.RUnlock()
+	return calls
+}
+
+// EnableFireNetGatewayGroup calls EnableFireNetGatewayGroupFunc.
+func (mock *EdgeClientMock) EnableFireNetGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableFireNetGatewayGroupFunc == nil {
+		panic("EdgeClientMock.EnableFireNetGatewayGroupFunc: method is nil but EdgeClient.EnableFireNetGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableFireNetGatewayGroup.Lock()
+	mock.calls.EnableFireNetGatewayGroup = append(mock.calls.EnableFireNetGatewayGroup, callInfo)
+	mock.lockEnableFireNetGatewayGroup.Unlock()
+	return mock.EnableFireNetGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableFireNetGatewayGroupCalls gets all the calls that were made to EnableFireNetGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableFireNetGatewayGroupCalls())
+func (mock *EdgeClientMock) EnableFireNetGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableFireNetGatewayGroup.RLock()
+	calls = mock.calls.EnableFireNetGatewayGroup
+	mock.lockEnableFireNetGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableGatewayFireNetInterfaces calls EnableGatewayFireNetInterfacesFunc.
+func (mock *EdgeClientMock) EnableGatewayFireNetInterfaces(gateway *TransitVpc) error {
+	if mock.EnableGatewayFireNetInterfacesFunc == nil {
+		panic("EdgeClientMock.EnableGatewayFireNetInterfacesFunc: method is nil but EdgeClient.EnableGatewayFireNetInterfaces was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableGatewayFireNetInterfaces.Lock()
+	mock.calls.EnableGatewayFireNetInterfaces = append(mock.calls.EnableGatewayFireNetInterfaces, callInfo)
+	mock.lockEnableGatewayFireNetInterfaces.Unlock()
+	return mock.EnableGatewayFireNetInterfacesFunc(gateway)
+}
+
+// EnableGatewayFireNetInterfacesCalls gets all the calls that were made to EnableGatewayFireNetInterfaces.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableGatewayFireNetInterfacesCalls())
+func (mock *EdgeClientMock) EnableGatewayFireNetInterfacesCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockEnableGatewayFireNetInterfaces.RLock()
+	calls = mock.calls.EnableGatewayFireNetInterfaces
+	mock.lockEnableGatewayFireNetInterfaces.RUnlock()
+	return calls
+}
+
+// EnableGatewayFireNetInterfacesWithGWLB calls EnableGatewayFireNetInterfacesWithGWLBFunc.
+func (mock *EdgeClientMock) EnableGatewayFireNetInterfacesWithGWLB(gateway *TransitVpc) error {
+	if mock.EnableGatewayFireNetInterfacesWithGWLBFunc == nil {
+		panic("EdgeClientMock.EnableGatewayFireNetInterfacesWithGWLBFunc: method is nil but EdgeClient.EnableGatewayFireNetInterfacesWithGWLB was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableGatewayFireNetInterfacesWithGWLB.Lock()
+	mock.calls.EnableGatewayFireNetInterfacesWithGWLB = append(mock.calls.EnableGatewayFireNetInterfacesWithGWLB, callInfo)
+	mock.lockEnableGatewayFireNetInterfacesWithGWLB.Unlock()
+	return mock.EnableGatewayFireNetInterfacesWithGWLBFunc(gateway)
+}
+
+// EnableGatewayFireNetInterfacesWithGWLBCalls gets all the calls that were made to EnableGatewayFireNetInterfacesWithGWLB.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableGatewayFireNetInterfacesWithGWLBCalls())
+func (mock *EdgeClientMock) EnableGatewayFireNetInterfacesWithGWLBCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockEnableGatewayFireNetInterfacesWithGWLB.RLock()
+	calls = mock.calls.EnableGatewayFireNetInterfacesWithGWLB
+	mock.lockEnableGatewayFireNetInterfacesWithGWLB.RUnlock()
+	return calls
+}
+
+// EnableGatewayGroupSNat calls EnableGatewayGroupSNatFunc.
+func (mock *EdgeClientMock) EnableGatewayGroupSNat(ctx context.Context, groupName string) error {
+	if mock.EnableGatewayGroupSNatFunc == nil {
+		panic("EdgeClientMock.EnableGatewayGroupSNatFunc: method is nil but EdgeClient.EnableGatewayGroupSNat was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableGatewayGroupSNat.Lock()
+	mock.calls.EnableGatewayGroupSNat = append(mock.calls.EnableGatewayGroupSNat, callInfo)
+	mock.lockEnableGatewayGroupSNat.Unlock()
+	return mock.EnableGatewayGroupSNatFunc(ctx, groupName)
+}
+
+// EnableGatewayGroupSNatCalls gets all the calls that were made to EnableGatewayGroupSNat.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableGatewayGroupSNatCalls())
+func (mock *EdgeClientMock) EnableGatewayGroupSNatCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableGatewayGroupSNat.RLock()
+	calls = mock.calls.EnableGatewayGroupSNat
+	mock.lockEnableGatewayGroupSNat.RUnlock()
+	return calls
+}
+
+// EnableGatewayGroupVpcDNSServer calls EnableGatewayGroupVpcDNSServerFunc.
+func (mock *EdgeClientMock) EnableGatewayGroupVpcDNSServer(ctx context.Context, groupName string) error {
+	if mock.EnableGatewayGroupVpcDNSServerFunc == nil {
+		panic("EdgeClientMock.EnableGatewayGroupVpcDNSServerFunc: method is nil but EdgeClient.EnableGatewayGroupVpcDNSServer was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableGatewayGroupVpcDNSServer.Lock()
+	mock.calls.EnableGatewayGroupVpcDNSServer = append(mock.calls.EnableGatewayGroupVpcDNSServer, callInfo)
+	mock.lockEnableGatewayGroupVpcDNSServer.Unlock()
+	return mock.EnableGatewayGroupVpcDNSServerFunc(ctx, groupName)
+}
+
+// EnableGatewayGroupVpcDNSServerCalls gets all the calls that were made to EnableGatewayGroupVpcDNSServer.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableGatewayGroupVpcDNSServerCalls())
+func (mock *EdgeClientMock) EnableGatewayGroupVpcDNSServerCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableGatewayGroupVpcDNSServer.RLock()
+	calls = mock.calls.EnableGatewayGroupVpcDNSServer
+	mock.lockEnableGatewayGroupVpcDNSServer.RUnlock()
+	return calls
+}
+
+// EnableGatewayLoadBalancerGatewayGroup calls EnableGatewayLoadBalancerGatewayGroupFunc.
+func (mock *EdgeClientMock) EnableGatewayLoadBalancerGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableGatewayLoadBalancerGatewayGroupFunc == nil {
+		panic("EdgeClientMock.EnableGatewayLoadBalancerGatewayGroupFunc: method is nil but EdgeClient.EnableGatewayLoadBalancerGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableGatewayLoadBalancerGatewayGroup.Lock()
+	mock.calls.EnableGatewayLoadBalancerGatewayGroup = append(mock.calls.EnableGatewayLoadBalancerGatewayGroup, callInfo)
+	mock.lockEnableGatewayLoadBalancerGatewayGroup.Unlock()
+	return mock.EnableGatewayLoadBalancerGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableGatewayLoadBalancerGatewayGroupCalls gets all the calls that were made to EnableGatewayLoadBalancerGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableGatewayLoadBalancerGatewayGroupCalls())
+func (mock *EdgeClientMock) EnableGatewayLoadBalancerGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableGatewayLoadBalancerGatewayGroup.RLock()
+	calls = mock.calls.EnableGatewayLoadBalancerGatewayGroup
+	mock.lockEnableGatewayLoadBalancerGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableGlobalVpc calls EnableGlobalVpcFunc.
+func (mock *EdgeClientMock) EnableGlobalVpc(gateway *Gateway) error {
+	if mock.EnableGlobalVpcFunc == nil {
+		panic("EdgeClientMock.EnableGlobalVpcFunc: method is nil but EdgeClient.EnableGlobalVpc was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableGlobalVpc.Lock()
+	mock.calls.EnableGlobalVpc = append(mock.calls.EnableGlobalVpc, callInfo)
+	mock.lockEnableGlobalVpc.Unlock()
+	return mock.EnableGlobalVpcFunc(gateway)
+}
+
+// EnableGlobalVpcCalls gets all the calls that were made to EnableGlobalVpc.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableGlobalVpcCalls())
+func (mock *EdgeClientMock) EnableGlobalVpcCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableGlobalVpc.RLock()
+	calls = mock.calls.EnableGlobalVpc
+	mock.lockEnableGlobalVpc.RUnlock()
+	return calls
+}
+
+// EnableGlobalVpcGatewayGroup calls EnableGlobalVpcGatewayGroupFunc.
+func (mock *EdgeClientMock) EnableGlobalVpcGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableGlobalVpcGatewayGroupFunc == nil {
+		panic("EdgeClientMock.EnableGlobalVpcGatewayGroupFunc: method is nil but EdgeClient.EnableGlobalVpcGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableGlobalVpcGatewayGroup.Lock()
+	mock.calls.EnableGlobalVpcGatewayGroup = append(mock.calls.EnableGlobalVpcGatewayGroup, callInfo)
+	mock.lockEnableGlobalVpcGatewayGroup.Unlock()
+	return mock.EnableGlobalVpcGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableGlobalVpcGatewayGroupCalls gets all the calls that were made to EnableGlobalVpcGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableGlobalVpcGatewayGroupCalls())
+func (mock *EdgeClientMock) EnableGlobalVpcGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableGlobalVpcGatewayGroup.RLock()
+	calls = mock.calls.EnableGlobalVpcGatewayGroup
+	mock.lockEnableGlobalVpcGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableGroGso calls EnableGroGsoFunc.
+func (mock *EdgeClientMock) EnableGroGso(gateway *Gateway) error {
+	if mock.EnableGroGsoFunc == nil {
+		panic("EdgeClientMock.EnableGroGsoFunc: method is nil but EdgeClient.EnableGroGso was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableGroGso.Lock()
+	mock.calls.EnableGroGso = append(mock.calls.EnableGroGso, callInfo)
+	mock.lockEnableGroGso.Unlock()
+	return mock.EnableGroGsoFunc(gateway)
+}
+
+// EnableGroGsoCalls gets all the calls that were made to EnableGroGso.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableGroGsoCalls())
+func (mock *EdgeClientMock) EnableGroGsoCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableGroGso.RLock()
+	calls = mock.calls.EnableGroGso
+	mock.lockEnableGroGso.RUnlock()
+	return calls
+}
+
+// EnableGroGsoGatewayGroup calls EnableGroGsoGatewayGroupFunc.
+func (mock *EdgeClientMock) EnableGroGsoGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableGroGsoGatewayGroupFunc == nil {
+		panic("EdgeClientMock.EnableGroGsoGatewayGroupFunc: method is nil but EdgeClient.EnableGroGsoGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableGroGsoGatewayGroup.Lock()
+	mock.calls.EnableGroGsoGatewayGroup = append(mock.calls.EnableGroGsoGatewayGroup, callInfo)
+	mock.lockEnableGroGsoGatewayGroup.Unlock()
+	return mock.EnableGroGsoGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableGroGsoGatewayGroupCalls gets all the calls that were made to EnableGroGsoGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableGroGsoGatewayGroupCalls())
+func (mock *EdgeClientMock) EnableGroGsoGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableGroGsoGatewayGroup.RLock()
+	calls = mock.calls.EnableGroGsoGatewayGroup
+	mock.lockEnableGroGsoGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableGuardDutyEnforcement calls EnableGuardDutyEnforcementFunc.
+func (mock *EdgeClientMock) EnableGuardDutyEnforcement(gateway *Gateway) error {
+	if mock.EnableGuardDutyEnforcementFunc == nil {
+		panic("EdgeClientMock.EnableGuardDutyEnforcementFunc: method is nil but EdgeClient.EnableGuardDutyEnforcement was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableGuardDutyEnforcement.Lock()
+	mock.calls.EnableGuardDutyEnforcement = append(mock.calls.EnableGuardDutyEnforcement, callInfo)
+	mock.lockEnableGuardDutyEnforcement.Unlock()
+	return mock.EnableGuardDutyEnforcementFunc(gateway)
+}
+
+// EnableGuardDutyEnforcementCalls gets all the calls that were made to EnableGuardDutyEnforcement.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableGuardDutyEnforcementCalls())
+func (mock *EdgeClientMock) EnableGuardDutyEnforcementCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableGuardDutyEnforcement.RLock()
+	calls = mock.calls.EnableGuardDutyEnforcement
+	mock.lockEnableGuardDutyEnforcement.RUnlock()
+	return calls
+}
+
+// EnableHybridConnectionGatewayGroup calls EnableHybridConnectionGatewayGroupFunc.
+func (mock *EdgeClientMock) EnableHybridConnectionGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableHybridConnectionGatewayGroupFunc == nil {
+		panic("EdgeClientMock.EnableHybridConnectionGatewayGroupFunc: method is nil but EdgeClient.EnableHybridConnectionGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableHybridConnectionGatewayGroup.Lock()
+	mock.calls.EnableHybridConnectionGatewayGroup = append(mock.calls.EnableHybridConnectionGatewayGroup, callInfo)
+	mock.lockEnableHybridConnectionGatewayGroup.Unlock()
+	return mock.EnableHybridConnectionGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableHybridConnectionGatewayGroupCalls gets all the calls that were made to EnableHybridConnectionGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableHybridConnectionGatewayGroupCalls())
+func (mock *EdgeClientMock) EnableHybridConnectionGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableHybridConnectionGatewayGroup.RLock()
+	calls = mock.calls.EnableHybridConnectionGatewayGroup
+	mock.lockEnableHybridConnectionGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableIPv6 calls EnableIPv6Func.
+func (mock *EdgeClientMock) EnableIPv6(gateway *Gateway) error {
+	if mock.EnableIPv6Func == nil {
+		panic("EdgeClientMock.EnableIPv6Func: method is nil but EdgeClient.EnableIPv6 was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableIPv6.Lock()
+	mock.calls.EnableIPv6 = append(mock.calls.EnableIPv6, callInfo)
+	mock.lockEnableIPv6.Unlock()
+	return mock.EnableIPv6Func(gateway)
+}
+
+// EnableIPv6Calls gets all the calls that were made to EnableIPv6.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableIPv6Calls())
+func (mock *EdgeClientMock) EnableIPv6Calls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableIPv6.RLock()
+	calls = mock.calls.EnableIPv6
+	mock.lockEnableIPv6.RUnlock()
+	return calls
+}
+
+// EnableIPv6GatewayGroup calls EnableIPv6GatewayGroupFunc.
+func (mock *EdgeClientMock) EnableIPv6GatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableIPv6GatewayGroupFunc == nil {
+		panic("EdgeClientMock.EnableIPv6GatewayGroupFunc: method is nil but EdgeClient.EnableIPv6GatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableIPv6GatewayGroup.Lock()
+	mock.calls.EnableIPv6GatewayGroup = append(mock.calls.EnableIPv6GatewayGroup, callInfo)
+	mock.lockEnableIPv6GatewayGroup.Unlock()
+	return mock.EnableIPv6GatewayGroupFunc(ctx, groupName)
+}
+
+// EnableIPv6GatewayGroupCalls gets all the calls that were made to EnableIPv6GatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableIPv6GatewayGroupCalls())
+func (mock *EdgeClientMock) EnableIPv6GatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableIPv6GatewayGroup.RLock()
+	calls = mock.calls.EnableIPv6GatewayGroup
+	mock.lockEnableIPv6GatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableJumboFrame calls EnableJumboFrameFunc.
+func (mock *EdgeClientMock) EnableJumboFrame(gateway *Gateway) error {
+	if mock.EnableJumboFrameFunc == nil {
+		panic("EdgeClientMock.EnableJumboFrameFunc: method is nil but EdgeClient.EnableJumboFrame was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableJumboFrame.Lock()
+	mock.calls.EnableJumboFrame = append(mock.calls.EnableJumboFrame, callInfo)
+	mock.lockEnableJumboFrame.Unlock()
+	return mock.EnableJumboFrameFunc(gateway)
+}
+
+// EnableJumboFrameCalls gets all the calls that were made to EnableJumboFrame.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableJumboFrameCalls())
+func (mock *EdgeClientMock) EnableJumboFrameCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableJumboFrame.RLock()
+	calls = mock.calls.EnableJumboFrame
+	mock.lockEnableJumboFrame.RUnlock()
+	return calls
+}
+
+// EnableJumboFrameExternalDeviceConn calls EnableJumboFrameExternalDeviceConnFunc.
+func (mock *EdgeClientMock) EnableJumboFrameExternalDeviceConn(externalDeviceConn *ExternalDeviceConn) error {
+	if mock.EnableJumboFrameExternalDeviceConnFunc == nil {
+		panic("EdgeClientMock.EnableJumboFrameExternalDeviceConnFunc: method is nil but EdgeClient.EnableJumboFrameExternalDeviceConn was just called")
+	}
+	callInfo := struct {
+		ExternalDeviceConn *ExternalDeviceConn
+	}{
+		ExternalDeviceConn: externalDeviceConn,
+	}
+	mock.lockEnableJumboFrameExternalDeviceConn.Lock()
+	mock.calls.EnableJumboFrameExternalDeviceConn = append(mock.calls.EnableJumboFrameExternalDeviceConn, callInfo)
+	mock.lockEnableJumboFrameExternalDeviceConn.Unlock()
+	return mock.EnableJumboFrameExternalDeviceConnFunc(externalDeviceConn)
+}
+
+// EnableJumboFrameExternalDeviceConnCalls gets all the calls that were made to EnableJumboFrameExternalDeviceConn.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableJumboFrameExternalDeviceConnCalls())
+func (mock *EdgeClientMock) EnableJumboFrameExternalDeviceConnCalls() []struct {
+	ExternalDeviceConn *ExternalDeviceConn
+} {
+	var calls []struct {
+		ExternalDeviceConn *ExternalDeviceConn
+	}
+	mock.lockEnableJumboFrameExternalDeviceConn.RLock()
+	calls = mock.calls.EnableJumboFrameExternalDeviceConn
+	mock.lockEnableJumboFrameExternalDeviceConn.RUnlock()
+	return calls
+}
+
+// EnableJumboFrameGatewayGroup calls EnableJumboFrameGatewayGroupFunc.
+func (mock *EdgeClientMock) EnableJumboFrameGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableJumboFrameGatewayGroupFunc == nil {
+		panic("EdgeClientMock.EnableJumboFrameGatewayGroupFunc: method is nil but EdgeClient.EnableJumboFrameGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableJumboFrameGatewayGroup.Lock()
+	mock.calls.EnableJumboFrameGatewayGroup = append(mock.calls.EnableJumboFrameGatewayGroup, callInfo)
+	mock.lockEnableJumboFrameGatewayGroup.Unlock()
+	return mock.EnableJumboFrameGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableJumboFrameGatewayGroupCalls gets all the calls that were made to EnableJumboFrameGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableJumboFrameGatewayGroupCalls())
+func (mock *EdgeClientMock) EnableJumboFrameGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableJumboFrameGatewayGroup.RLock()
+	calls = mock.calls.EnableJumboFrameGatewayGroup
+	mock.lockEnableJumboFrameGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableMonitorGatewaySubnets calls EnableMonitorGatewaySubnetsFunc.
+func (mock *EdgeClientMock) EnableMonitorGatewaySubnets(gwName string, excludedInstances []string) error {
+	if mock.EnableMonitorGatewaySubnetsFunc == nil {
+		panic("EdgeClientMock.EnableMonitorGatewaySubnetsFunc: method is nil but EdgeClient.EnableMonitorGatewaySubnets was just called")
+	}
+	callInfo := struct {
+		GwName            string
+		ExcludedInstances []string
+	}{
+		GwName:            gwName,
+		ExcludedInstances: excludedInstances,
+	}
+	mock.lockEnableMonitorGatewaySubnets.Lock()
+	mock.calls.EnableMonitorGatewaySubnets = append(mock.calls.EnableMonitorGatewaySubnets, callInfo)
+	mock.lockEnableMonitorGatewaySubnets.Unlock()
+	return mock.EnableMonitorGatewaySubnetsFunc(gwName, excludedInstances)
+}
+
+// EnableMonitorGatewaySubnetsCalls gets all the calls that were made to EnableMonitorGatewaySubnets.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableMonitorGatewaySubnetsCalls())
+func (mock *EdgeClientMock) EnableMonitorGatewaySubnetsCalls() []struct {
+	GwName            string
+	ExcludedInstances []string
+} {
+	var calls []struct {
+		GwName            string
+		ExcludedInstances []string
+	}
+	mock.lockEnableMonitorGatewaySubnets.RLock()
+	calls = mock.calls.EnableMonitorGatewaySubnets
+	mock.lockEnableMonitorGatewaySubnets.RUnlock()
+	return calls
+}
+
+// EnableMultiTierTransitGatewayGroup calls EnableMultiTierTransitGatewayGroupFunc.
+func (mock *EdgeClientMock) EnableMultiTierTransitGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableMultiTierTransitGatewayGroupFunc == nil {
+		panic("EdgeClientMock.EnableMultiTierTransitGatewayGroupFunc: method is nil but EdgeClient.EnableMultiTierTransitGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableMultiTierTransitGatewayGroup.Lock()
+	mock.calls.EnableMultiTierTransitGatewayGroup = append(mock.calls.EnableMultiTierTransitGatewayGroup, callInfo)
+	mock.lockEnableMultiTierTransitGatewayGroup.Unlock()
+	return mock.EnableMultiTierTransitGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableMultiTierTransitGatewayGroupCalls gets all the calls that were made to EnableMultiTierTransitGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableMultiTierTransitGatewayGroupCalls())
+func (mock *EdgeClientMock) EnableMultiTierTransitGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableMultiTierTransitGatewayGroup.RLock()
+	calls = mock.calls.EnableMultiTierTransitGatewayGroup
+	mock.lockEnableMultiTierTransitGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableMultitierTransit calls EnableMultitierTransitFunc.
+func (mock *EdgeClientMock) EnableMultitierTransit(gwName string) error {
+	if mock.EnableMultitierTransitFunc == nil {
+		panic("EdgeClientMock.EnableMultitierTransitFunc: method is nil but EdgeClient.EnableMultitierTransit was just called")
+	}
+	callInfo := struct {
+		GwName string
+	}{
+		GwName: gwName,
+	}
+	mock.lockEnableMultitierTransit.Lock()
+	mock.calls.EnableMultitierTransit = append(mock.calls.EnableMultitierTransit, callInfo)
+	mock.lockEnableMultitierTransit.Unlock()
+	return mock.EnableMultitierTransitFunc(gwName)
+}
+
+// EnableMultitierTransitCalls gets all the calls that were made to EnableMultitierTransit.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableMultitierTransitCalls())
+func (mock *EdgeClientMock) EnableMultitierTransitCalls() []struct {
+	GwName string
+} {
+	var calls []struct {
+		GwName string
+	}
+	mock.lockEnableMultitierTransit.RLock()
+	calls = mock.calls.EnableMultitierTransit
+	mock.lockEnableMultitierTransit.RUnlock()
+	return calls
+}
+
+// EnablePeeringHaGateway calls EnablePeeringHaGatewayFunc.
+func (mock *EdgeClientMock) EnablePeeringHaGateway(gateway *Gateway) error {
+	if mock.EnablePeeringHaGatewayFunc == nil {
+		panic("EdgeClientMock.EnablePeeringHaGatewayFunc: method is nil but EdgeClient.EnablePeeringHaGateway was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnablePeeringHaGateway.Lock()
+	mock.calls.EnablePeeringHaGateway = append(mock.calls.EnablePeeringHaGateway, callInfo)
+	mock.lockEnablePeeringHaGateway.Unlock()
+	return mock.EnablePeeringHaGatewayFunc(gateway)
+}
+
+// EnablePeeringHaGatewayCalls gets all the calls that were made to EnablePeeringHaGateway.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnablePeeringHaGatewayCalls())
+func (mock *EdgeClientMock) EnablePeeringHaGatewayCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnablePeeringHaGateway.RLock()
+	calls = mock.calls.EnablePeeringHaGateway
+	mock.lockEnablePeeringHaGateway.RUnlock()
+	return calls
+}
+
+// EnablePrivateVpcDefaultRoute calls EnablePrivateVpcDefaultRouteFunc.
+func (mock *EdgeClientMock) EnablePrivateVpcDefaultRoute(gw *Gateway) error {
+	if mock.EnablePrivateVpcDefaultRouteFunc == nil {
+		panic("EdgeClientMock.EnablePrivateVpcDefaultRouteFunc: method is nil but EdgeClient.EnablePrivateVpcDefaultRoute was just called")
+	}
+	callInfo := struct {
+		Gw *Gateway
+	}{
+		Gw: gw,
+	}
+	mock.lockEnablePrivateVpcDefaultRoute.Lock()
+	mock.calls.EnablePrivateVpcDefaultRoute = append(mock.calls.EnablePrivateVpcDefaultRoute, callInfo)
+	mock.lockEnablePrivateVpcDefaultRoute.Unlock()
+	return mock.EnablePrivateVpcDefaultRouteFunc(gw)
+}
+
+// EnablePrivateVpcDefaultRouteCalls gets all the calls that were made to EnablePrivateVpcDefaultRoute.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnablePrivateVpcDefaultRouteCalls())
+func (mock *EdgeClientMock) EnablePrivateVpcDefaultRouteCalls() []struct {
+	Gw *Gateway
+} {
+	var calls []struct {
+		Gw *Gateway
+	}
+	mock.lockEnablePrivateVpcDefaultRoute.RLock()
+	calls = mock.calls.EnablePrivateVpcDefaultRoute
+	mock.lockEnablePrivateVpcDefaultRoute.RUnlock()
+	return calls
+}
+
+// EnablePrivateVpcDefaultRouteGatewayGroup calls EnablePrivateVpcDefaultRouteGatewayGroupFunc.
+func (mock *EdgeClientMock) EnablePrivateVpcDefaultRouteGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnablePrivateVpcDefaultRouteGatewayGroupFunc == nil {
+		panic("EdgeClientMock.EnablePrivateVpcDefaultRouteGatewayGroupFunc: method is nil but EdgeClient.EnablePrivateVpcDefaultRouteGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnablePrivateVpcDefaultRouteGatewayGroup.Lock()
+	mock.calls.EnablePrivateVpcDefaultRouteGatewayGroup = append(mock.calls.EnablePrivateVpcDefaultRouteGatewayGroup, callInfo)
+	mock.lockEnablePrivateVpcDefaultRouteGatewayGroup.Unlock()
+	return mock.EnablePrivateVpcDefaultRouteGatewayGroupFunc(ctx, groupName)
+}
+
+// EnablePrivateVpcDefaultRouteGatewayGroupCalls gets all the calls that were made to EnablePrivateVpcDefaultRouteGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnablePrivateVpcDefaultRouteGatewayGroupCalls())
+func (mock *EdgeClientMock) EnablePrivateVpcDefaultRouteGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnablePrivateVpcDefaultRouteGatewayGroup.RLock()
+	calls = mock.calls.EnablePrivateVpcDefaultRouteGatewayGroup
+	mock.lockEnablePrivateVpcDefaultRouteGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnablePublicSubnetFilteringHAGateway calls EnablePublicSubnetFilteringHAGatewayFunc.
+func (mock *EdgeClientMock) EnablePublicSubnetFilteringHAGateway(gateway *Gateway) error {
+	if mock.EnablePublicSubnetFilteringHAGatewayFunc == nil {
+		panic("EdgeClientMock.EnablePublicSubnetFilteringHAGatewayFunc: method is nil but EdgeClient.EnablePublicSubnetFilteringHAGateway was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnablePublicSubnetFilteringHAGateway.Lock()
+	mock.calls.EnablePublicSubnetFilteringHAGateway = append(mock.calls.EnablePublicSubnetFilteringHAGateway, callInfo)
+	mock.lockEnablePublicSubnetFilteringHAGateway.Unlock()
+	return mock.EnablePublicSubnetFilteringHAGatewayFunc(gateway)
+}
+
+// EnablePublicSubnetFilteringHAGatewayCalls gets all the calls that were made to EnablePublicSubnetFilteringHAGateway.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnablePublicSubnetFilteringHAGatewayCalls())
+func (mock *EdgeClientMock) EnablePublicSubnetFilteringHAGatewayCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnablePublicSubnetFilteringHAGateway.RLock()
+	calls = mock.calls.EnablePublicSubnetFilteringHAGateway
+	mock.lockEnablePublicSubnetFilteringHAGateway.RUnlock()
+	return calls
+}
+
+// EnableS2CRxBalancing calls EnableS2CRxBalancingFunc.
+func (mock *EdgeClientMock) EnableS2CRxBalancing(gwName string) error {
+	if mock.EnableS2CRxBalancingFunc == nil {
+		panic("EdgeClientMock.EnableS2CRxBalancingFunc: method is nil but EdgeClient.EnableS2CRxBalancing was just called")
+	}
+	callInfo := struct {
+		GwName string
+	}{
+		GwName: gwName,
+	}
+	mock.lockEnableS2CRxBalancing.Lock()
+	mock.calls.EnableS2CRxBalancing = append(mock.calls.EnableS2CRxBalancing, callInfo)
+	mock.lockEnableS2CRxBalancing.Unlock()
+	return mock.EnableS2CRxBalancingFunc(gwName)
+}
+
+// EnableS2CRxBalancingCalls gets all the calls that were made to EnableS2CRxBalancing.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableS2CRxBalancingCalls())
+func (mock *EdgeClientMock) EnableS2CRxBalancingCalls() []struct {
+	GwName string
+} {
+	var calls []struct {
+		GwName string
+	}
+	mock.lockEnableS2CRxBalancing.RLock()
+	calls = mock.calls.EnableS2CRxBalancing
+	mock.lockEnableS2CRxBalancing.RUnlock()
+	return calls
+}
+
+// EnableS2cRxBalancingGatewayGroup calls EnableS2cRxBalancingGatewayGroupFunc.
+func (mock *EdgeClientMock) EnableS2cRxBalancingGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableS2cRxBalancingGatewayGroupFunc == nil {
+		panic("EdgeClientMock.EnableS2cRxBalancingGatewayGroupFunc: method is nil but EdgeClient.EnableS2cRxBalancingGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableS2cRxBalancingGatewayGroup.Lock()
+	mock.calls.EnableS2cRxBalancingGatewayGroup = append(mock.calls.EnableS2cRxBalancingGatewayGroup, callInfo)
+	mock.lockEnableS2cRxBalancingGatewayGroup.Unlock()
+	return mock.EnableS2cRxBalancingGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableS2cRxBalancingGatewayGroupCalls gets all the calls that were made to EnableS2cRxBalancingGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableS2cRxBalancingGatewayGroupCalls())
+func (mock *EdgeClientMock) EnableS2cRxBalancingGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableS2cRxBalancingGatewayGroup.RLock()
+	calls = mock.calls.EnableS2cRxBalancingGatewayGroup
+	mock.lockEnableS2cRxBalancingGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableSNat calls EnableSNatFunc.
+func (mock *EdgeClientMock) EnableSNat(gateway *Gateway) error {
+	if mock.EnableSNatFunc == nil {
+		panic("EdgeClientMock.EnableSNatFunc: method is nil but EdgeClient.EnableSNat was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableSNat.Lock()
+	mock.calls.EnableSNat = append(mock.calls.EnableSNat, callInfo)
+	mock.lockEnableSNat.Unlock()
+	return mock.EnableSNatFunc(gateway)
+}
+
+// EnableSNatCalls gets all the calls that were made to EnableSNat.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableSNatCalls())
+func (mock *EdgeClientMock) EnableSNatCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableSNat.RLock()
+	calls = mock.calls.EnableSNat
+	mock.lockEnableSNat.RUnlock()
+	return calls
+}
+
+// EnableSegmentation calls EnableSegmentationFunc.
+func (mock *EdgeClientMock) EnableSegmentation(transitGateway *TransitVpc) error {
+	if mock.EnableSegmentationFunc == nil {
+		panic("EdgeClientMock.EnableSegmentationFunc: method is nil but EdgeClient.EnableSegmentation was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockEnableSegmentation.Lock()
+	mock.calls.EnableSegmentation = append(mock.calls.EnableSegmentation, callInfo)
+	mock.lockEnableSegmentation.Unlock()
+	return mock.EnableSegmentationFunc(transitGateway)
+}
+
+// EnableSegmentationCalls gets all the calls that were made to EnableSegmentation.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableSegmentationCalls())
+func (mock *EdgeClientMock) EnableSegmentationCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockEnableSegmentation.RLock()
+	calls = mock.calls.EnableSegmentation
+	mock.lockEnableSegmentation.RUnlock()
+	return calls
+}
+
+// EnableSegmentationGatewayGroup calls EnableSegmentationGatewayGroupFunc.
+func (mock *EdgeClientMock) EnableSegmentationGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableSegmentationGatewayGroupFunc == nil {
+		panic("EdgeClientMock.EnableSegmentationGatewayGroupFunc: method is nil but EdgeClient.EnableSegmentationGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableSegmentationGatewayGroup.Lock()
+	mock.calls.EnableSegmentationGatewayGroup = append(mock.calls.EnableSegmentationGatewayGroup, callInfo)
+	mock.lockEnableSegmentationGatewayGroup.Unlock()
+	return mock.EnableSegmentationGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableSegmentationGatewayGroupCalls gets all the calls that were made to EnableSegmentationGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableSegmentationGatewayGroupCalls())
+func (mock *EdgeClientMock) EnableSegmentationGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableSegmentationGatewayGroup.RLock()
+	calls = mock.calls.EnableSegmentationGatewayGroup
+	mock.lockEnableSegmentationGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableSingleAZGateway calls EnableSingleAZGatewayFunc.
+func (mock *EdgeClientMock) EnableSingleAZGateway(gateway *Gateway) error {
+	if mock.EnableSingleAZGatewayFunc == nil {
+		panic("EdgeClientMock.EnableSingleAZGatewayFunc: method is nil but EdgeClient.EnableSingleAZGateway was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableSingleAZGateway.Lock()
+	mock.calls.EnableSingleAZGateway = append(mock.calls.EnableSingleAZGateway, callInfo)
+	mock.lockEnableSingleAZGateway.Unlock()
+	return mock.EnableSingleAZGatewayFunc(gateway)
+}
+
+// EnableSingleAZGatewayCalls gets all the calls that were made to EnableSingleAZGateway.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableSingleAZGatewayCalls())
+func (mock *EdgeClientMock) EnableSingleAZGatewayCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableSingleAZGateway.RLock()
+	calls = mock.calls.EnableSingleAZGateway
+	mock.lockEnableSingleAZGateway.RUnlock()
+	return calls
+}
+
+// EnableSkipPublicRouteUpdate calls EnableSkipPublicRouteUpdateFunc.
+func (mock *EdgeClientMock) EnableSkipPublicRouteUpdate(gw *Gateway) error {
+	if mock.EnableSkipPublicRouteUpdateFunc == nil {
+		panic("EdgeClientMock.EnableSkipPublicRouteUpdateFunc: method is nil but EdgeClient.EnableSkipPublicRouteUpdate was just called")
+	}
+	callInfo := struct {
+		Gw *Gateway
+	}{
+		Gw: gw,
+	}
+	mock.lockEnableSkipPublicRouteUpdate.Lock()
+	mock.calls.EnableSkipPublicRouteUpdate = append(mock.calls.EnableSkipPublicRouteUpdate, callInfo)
+	mock.lockEnableSkipPublicRouteUpdate.Unlock()
+	return mock.EnableSkipPublicRouteUpdateFunc(gw)
+}
+
+// EnableSkipPublicRouteUpdateCalls gets all the calls that were made to EnableSkipPublicRouteUpdate.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableSkipPublicRouteUpdateCalls())
+func (mock *EdgeClientMock) EnableSkipPublicRouteUpdateCalls() []struct {
+	Gw *Gateway
+} {
+	var calls []struct {
+		Gw *Gateway
+	}
+	mock.lockEnableSkipPublicRouteUpdate.RLock()
+	calls = mock.calls.EnableSkipPublicRouteUpdate
+	mock.lockEnableSkipPublicRouteUpdate.RUnlock()
+	return calls
+}
+
+// EnableSkipPublicRouteUpdateGatewayGroup calls EnableSkipPublicRouteUpdateGatewayGroupFunc.
+func (mock *EdgeClientMock) EnableSkipPublicRouteUpdateGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableSkipPublicRouteUpdateGatewayGroupFunc == nil {
+		panic("EdgeClientMock.EnableSkipPublicRouteUpdateGatewayGroupFunc: method is nil but EdgeClient.EnableSkipPublicRouteUpdateGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableSkipPublicRouteUpdateGatewayGroup.Lock()
+	mock.calls.EnableSkipPublicRouteUpdateGatewayGroup = append(mock.calls.EnableSkipPublicRouteUpdateGatewayGroup, callInfo)
+	mock.lockEnableSkipPublicRouteUpdateGatewayGroup.Unlock()
+	return mock.EnableSkipPublicRouteUpdateGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableSkipPublicRouteUpdateGatewayGroupCalls gets all the calls that were made to EnableSkipPublicRouteUpdateGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableSkipPublicRouteUpdateGatewayGroupCalls())
+func (mock *EdgeClientMock) EnableSkipPublicRouteUpdateGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableSkipPublicRouteUpdateGatewayGroup.RLock()
+	calls = mock.calls.EnableSkipPublicRouteUpdateGatewayGroup
+	mock.lockEnableSkipPublicRouteUpdateGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableSpokeLearnedCidrsApproval calls EnableSpokeLearnedCidrsApprovalFunc.
+func (mock *EdgeClientMock) EnableSpokeLearnedCidrsApproval(gateway *SpokeVpc) error {
+	if mock.EnableSpokeLearnedCidrsApprovalFunc == nil {
+		panic("EdgeClientMock.EnableSpokeLearnedCidrsApprovalFunc: method is nil but EdgeClient.EnableSpokeLearnedCidrsApproval was just called")
+	}
+	callInfo := struct {
+		Gateway *SpokeVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableSpokeLearnedCidrsApproval.Lock()
+	mock.calls.EnableSpokeLearnedCidrsApproval = append(mock.calls.EnableSpokeLearnedCidrsApproval, callInfo)
+	mock.lockEnableSpokeLearnedCidrsApproval.Unlock()
+	return mock.EnableSpokeLearnedCidrsApprovalFunc(gateway)
+}
+
+// EnableSpokeLearnedCidrsApprovalCalls gets all the calls that were made to EnableSpokeLearnedCidrsApproval.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableSpokeLearnedCidrsApprovalCalls())
+func (mock *EdgeClientMock) EnableSpokeLearnedCidrsApprovalCalls() []struct {
+	Gateway *SpokeVpc
+} {
+	var calls []struct {
+		Gateway *SpokeVpc
+	}
+	mock.lockEnableSpokeLearnedCidrsApproval.RLock()
+	calls = mock.calls.EnableSpokeLearnedCidrsApproval
+	mock.lockEnableSpokeLearnedCidrsApproval.RUnlock()
+	return calls
+}
+
+// EnableSpokeLearnedCidrsApprovalGatewayGroup calls EnableSpokeLearnedCidrsApprovalGatewayGroupFunc.
+func (mock *EdgeClientMock) EnableSpokeLearnedCidrsApprovalGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableSpokeLearnedCidrsApprovalGatewayGroupFunc == nil {
+		panic("EdgeClientMock.EnableSpokeLearnedCidrsApprovalGatewayGroupFunc: method is nil but EdgeClient.EnableSpokeLearnedCidrsApprovalGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableSpokeLearnedCidrsApprovalGatewayGroup.Lock()
+	mock.calls.EnableSpokeLearnedCidrsApprovalGatewayGroup = append(mock.calls.EnableSpokeLearnedCidrsApprovalGatewayGroup, callInfo)
+	mock.lockEnableSpokeLearnedCidrsApprovalGatewayGroup.Unlock()
+	return mock.EnableSpokeLearnedCidrsApprovalGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableSpokeLearnedCidrsApprovalGatewayGroupCalls gets all the calls that were made to EnableSpokeLearnedCidrsApprovalGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableSpokeLearnedCidrsApprovalGatewayGroupCalls())
+func (mock *EdgeClientMock) EnableSpokeLearnedCidrsApprovalGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableSpokeLearnedCidrsApprovalGatewayGroup.RLock()
+	calls = mock.calls.EnableSpokeLearnedCidrsApprovalGatewayGroup
+	mock.lockEnableSpokeLearnedCidrsApprovalGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableSpokeOnpremRoutePropagation calls EnableSpokeOnpremRoutePropagationFunc.
+func (mock *EdgeClientMock) EnableSpokeOnpremRoutePropagation(spokeGateway *SpokeVpc) error {
+	if mock.EnableSpokeOnpremRoutePropagationFunc == nil {
+		panic("EdgeClientMock.EnableSpokeOnpremRoutePropagationFunc: method is nil but EdgeClient.EnableSpokeOnpremRoutePropagation was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+	}{
+		SpokeGateway: spokeGateway,
+	}
+	mock.lockEnableSpokeOnpremRoutePropagation.Lock()
+	mock.calls.EnableSpokeOnpremRoutePropagation = append(mock.calls.EnableSpokeOnpremRoutePropagation, callInfo)
+	mock.lockEnableSpokeOnpremRoutePropagation.Unlock()
+	return mock.EnableSpokeOnpremRoutePropagationFunc(spokeGateway)
+}
+
+// EnableSpokeOnpremRoutePropagationCalls gets all the calls that were made to EnableSpokeOnpremRoutePropagation.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableSpokeOnpremRoutePropagationCalls())
+func (mock *EdgeClientMock) EnableSpokeOnpremRoutePropagationCalls() []struct {
+	SpokeGateway *SpokeVpc
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+	}
+	mock.lockEnableSpokeOnpremRoutePropagation.RLock()
+	calls = mock.calls.EnableSpokeOnpremRoutePropagation
+	mock.lockEnableSpokeOnpremRoutePropagation.RUnlock()
+	return calls
+}
+
+// EnableSpokeOnpremRoutePropagationGatewayGroup calls EnableSpokeOnpremRoutePropagationGatewayGroupFunc.
+func (mock *EdgeClientMock) EnableSpokeOnpremRoutePropagationGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableSpokeOnpremRoutePropagationGatewayGroupFunc == nil {
+		panic("EdgeClientMock.EnableSpokeOnpremRoutePropagationGatewayGroupFunc: method is nil but EdgeClient.EnableSpokeOnpremRoutePropagationGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableSpokeOnpremRoutePropagationGatewayGroup.Lock()
+	mock.calls.EnableSpokeOnpremRoutePropagationGatewayGroup = append(mock.calls.EnableSpokeOnpremRoutePropagationGatewayGroup, callInfo)
+	mock.lockEnableSpokeOnpremRoutePropagationGatewayGroup.Unlock()
+	return mock.EnableSpokeOnpremRoutePropagationGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableSpokeOnpremRoutePropagationGatewayGroupCalls gets all the calls that were made to EnableSpokeOnpremRoutePropagationGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableSpokeOnpremRoutePropagationGatewayGroupCalls())
+func (mock *EdgeClientMock) EnableSpokeOnpremRoutePropagationGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableSpokeOnpremRoutePropagationGatewayGroup.RLock()
+	calls = mock.calls.EnableSpokeOnpremRoutePropagationGatewayGroup
+	mock.lockEnableSpokeOnpremRoutePropagationGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableSpokePreserveAsPath calls EnableSpokePreserveAsPathFunc.
+func (mock *EdgeClientMock) EnableSpokePreserveAsPath(spokeGateway *SpokeVpc) error {
+	if mock.EnableSpokePreserveAsPathFunc == nil {
+		panic("EdgeClientMock.EnableSpokePreserveAsPathFunc: method is nil but EdgeClient.EnableSpokePreserveAsPath was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+	}{
+		SpokeGateway: spokeGateway,
+	}
+	mock.lockEnableSpokePreserveAsPath.Lock()
+	mock.calls.EnableSpokePreserveAsPath = append(mock.calls.EnableSpokePreserveAsPath, callInfo)
+	mock.lockEnableSpokePreserveAsPath.Unlock()
+	return mock.EnableSpokePreserveAsPathFunc(spokeGateway)
+}
+
+// EnableSpokePreserveAsPathCalls gets all the calls that were made to EnableSpokePreserveAsPath.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableSpokePreserveAsPathCalls())
+func (mock *EdgeClientMock) EnableSpokePreserveAsPathCalls() []struct {
+	SpokeGateway *SpokeVpc
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+	}
+	mock.lockEnableSpokePreserveAsPath.RLock()
+	calls = mock.calls.EnableSpokePreserveAsPath
+	mock.lockEnableSpokePreserveAsPath.RUnlock()
+	return calls
+}
+
+// EnableSpokePreserveAsPathGatewayGroup calls EnableSpokePreserveAsPathGatewayGroupFunc.
+func (mock *EdgeClientMock) EnableSpokePreserveAsPathGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableSpokePreserveAsPathGatewayGroupFunc == nil {
+		panic("EdgeClientMock.EnableSpokePreserveAsPathGatewayGroupFunc: method is nil but EdgeClient.EnableSpokePreserveAsPathGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableSpokePreserveAsPathGatewayGroup.Lock()
+	mock.calls.EnableSpokePreserveAsPathGatewayGroup = append(mock.calls.EnableSpokePreserveAsPathGatewayGroup, callInfo)
+	mock.lockEnableSpokePreserveAsPathGatewayGroup.Unlock()
+	return mock.EnableSpokePreserveAsPathGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableSpokePreserveAsPathGatewayGroupCalls gets all the calls that were made to EnableSpokePreserveAsPathGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableSpokePreserveAsPathGatewayGroupCalls())
+func (mock *EdgeClientMock) EnableSpokePreserveAsPathGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableSpokePreserveAsPathGatewayGroup.RLock()
+	calls = mock.calls.EnableSpokePreserveAsPathGatewayGroup
+	mock.lockEnableSpokePreserveAsPathGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableSummarizeCidrToTgw calls EnableSummarizeCidrToTgwFunc.
+func (mock *EdgeClientMock) EnableSummarizeCidrToTgw(gwName string) error {
+	if mock.EnableSummarizeCidrToTgwFunc == nil {
+		panic("EdgeClientMock.EnableSummarizeCidrToTgwFunc: method is nil but EdgeClient.EnableSummarizeCidrToTgw was just called")
+	}
+	callInfo := struct {
+		GwName string
+	}{
+		GwName: gwName,
+	}
+	mock.lockEnableSummarizeCidrToTgw.Lock()
+	mock.calls.EnableSummarizeCidrToTgw = append(mock.calls.EnableSummarizeCidrToTgw, callInfo)
+	mock.lockEnableSummarizeCidrToTgw.Unlock()
+	return mock.EnableSummarizeCidrToTgwFunc(gwName)
+}
+
+// EnableSummarizeCidrToTgwCalls gets all the calls that were made to EnableSummarizeCidrToTgw.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableSummarizeCidrToTgwCalls())
+func (mock *EdgeClientMock) EnableSummarizeCidrToTgwCalls() []struct {
+	GwName string
+} {
+	var calls []struct {
+		GwName string
+	}
+	mock.lockEnableSummarizeCidrToTgw.RLock()
+	calls = mock.calls.EnableSummarizeCidrToTgw
+	mock.lockEnableSummarizeCidrToTgw.RUnlock()
+	return calls
+}
+
+// EnableTransitFireNet calls EnableTransitFireNetFunc.
+func (mock *EdgeClientMock) EnableTransitFireNet(gateway *Gateway) error {
+	if mock.EnableTransitFireNetFunc == nil {
+		panic("EdgeClientMock.EnableTransitFireNetFunc: method is nil but EdgeClient.EnableTransitFireNet was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableTransitFireNet.Lock()
+	mock.calls.EnableTransitFireNet = append(mock.calls.EnableTransitFireNet, callInfo)
+	mock.lockEnableTransitFireNet.Unlock()
+	return mock.EnableTransitFireNetFunc(gateway)
+}
+
+// EnableTransitFireNetCalls gets all the calls that were made to EnableTransitFireNet.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableTransitFireNetCalls())
+func (mock *EdgeClientMock) EnableTransitFireNetCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableTransitFireNet.RLock()
+	calls = mock.calls.EnableTransitFireNet
+	mock.lockEnableTransitFireNet.RUnlock()
+	return calls
+}
+
+// EnableTransitFireNetGatewayGroup calls EnableTransitFireNetGatewayGroupFunc.
+func (mock *EdgeClientMock) EnableTransitFireNetGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableTransitFireNetGatewayGroupFunc == nil {
+		panic("EdgeClientMock.EnableTransitFireNetGatewayGroupFunc: method is nil but EdgeClient.EnableTransitFireNetGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableTransitFireNetGatewayGroup.Lock()
+	mock.calls.EnableTransitFireNetGatewayGroup = append(mock.calls.EnableTransitFireNetGatewayGroup, callInfo)
+	mock.lockEnableTransitFireNetGatewayGroup.Unlock()
+	return mock.EnableTransitFireNetGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableTransitFireNetGatewayGroupCalls gets all the calls that were made to EnableTransitFireNetGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableTransitFireNetGatewayGroupCalls())
+func (mock *EdgeClientMock) EnableTransitFireNetGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableTransitFireNetGatewayGroup.RLock()
+	calls = mock.calls.EnableTransitFireNetGatewayGroup
+	mock.lockEnableTransitFireNetGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableTransitFireNetWithGWLB calls EnableTransitFireNetWithGWLBFunc.
+func (mock *EdgeClientMock) EnableTransitFireNetWithGWLB(gateway *Gateway) error {
+	if mock.EnableTransitFireNetWithGWLBFunc == nil {
+		panic("EdgeClientMock.EnableTransitFireNetWithGWLBFunc: method is nil but EdgeClient.EnableTransitFireNetWithGWLB was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableTransitFireNetWithGWLB.Lock()
+	mock.calls.EnableTransitFireNetWithGWLB = append(mock.calls.EnableTransitFireNetWithGWLB, callInfo)
+	mock.lockEnableTransitFireNetWithGWLB.Unlock()
+	return mock.EnableTransitFireNetWithGWLBFunc(gateway)
+}
+
+// EnableTransitFireNetWithGWLBCalls gets all the calls that were made to EnableTransitFireNetWithGWLB.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableTransitFireNetWithGWLBCalls())
+func (mock *EdgeClientMock) EnableTransitFireNetWithGWLBCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableTransitFireNetWithGWLB.RLock()
+	calls = mock.calls.EnableTransitFireNetWithGWLB
+	mock.lockEnableTransitFireNetWithGWLB.RUnlock()
+	return calls
+}
+
+// EnableTransitFireNetWithGWLBGatewayGroup calls EnableTransitFireNetWithGWLBGatewayGroupFunc.
+func (mock *EdgeClientMock) EnableTransitFireNetWithGWLBGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableTransitFireNetWithGWLBGatewayGroupFunc == nil {
+		panic("EdgeClientMock.EnableTransitFireNetWithGWLBGatewayGroupFunc: method is nil but EdgeClient.EnableTransitFireNetWithGWLBGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableTransitFireNetWithGWLBGatewayGroup.Lock()
+	mock.calls.EnableTransitFireNetWithGWLBGatewayGroup = append(mock.calls.EnableTransitFireNetWithGWLBGatewayGroup, callInfo)
+	mock.lockEnableTransitFireNetWithGWLBGatewayGroup.Unlock()
+	return mock.EnableTransitFireNetWithGWLBGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableTransitFireNetWithGWLBGatewayGroupCalls gets all the calls that were made to EnableTransitFireNetWithGWLBGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableTransitFireNetWithGWLBGatewayGroupCalls())
+func (mock *EdgeClientMock) EnableTransitFireNetWithGWLBGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableTransitFireNetWithGWLBGatewayGroup.RLock()
+	calls = mock.calls.EnableTransitFireNetWithGWLBGatewayGroup
+	mock.lockEnableTransitFireNetWithGWLBGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableTransitLearnedCidrsApproval calls EnableTransitLearnedCidrsApprovalFunc.
+func (mock *EdgeClientMock) EnableTransitLearnedCidrsApproval(gateway *TransitVpc) error {
+	if mock.EnableTransitLearnedCidrsApprovalFunc == nil {
+		panic("EdgeClientMock.EnableTransitLearnedCidrsApprovalFunc: method is nil but EdgeClient.EnableTransitLearnedCidrsApproval was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableTransitLearnedCidrsApproval.Lock()
+	mock.calls.EnableTransitLearnedCidrsApproval = append(mock.calls.EnableTransitLearnedCidrsApproval, callInfo)
+	mock.lockEnableTransitLearnedCidrsApproval.Unlock()
+	return mock.EnableTransitLearnedCidrsApprovalFunc(gateway)
+}
+
+// EnableTransitLearnedCidrsApprovalCalls gets all the calls that were made to EnableTransitLearnedCidrsApproval.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableTransitLearnedCidrsApprovalCalls())
+func (mock *EdgeClientMock) EnableTransitLearnedCidrsApprovalCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockEnableTransitLearnedCidrsApproval.RLock()
+	calls = mock.calls.EnableTransitLearnedCidrsApproval
+	mock.lockEnableTransitLearnedCidrsApproval.RUnlock()
+	return calls
+}
+
+// EnableTransitLearnedCidrsApprovalGatewayGroup calls EnableTransitLearnedCidrsApprovalGatewayGroupFunc.
+func (mock *EdgeClientMock) EnableTransitLearnedCidrsApprovalGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableTransitLearnedCidrsApprovalGatewayGroupFunc == nil {
+		panic("EdgeClientMock.EnableTransitLearnedCidrsApprovalGatewayGroupFunc: method is nil but EdgeClient.EnableTransitLearnedCidrsApprovalGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableTransitLearnedCidrsApprovalGatewayGroup.Lock()
+	mock.calls.EnableTransitLearnedCidrsApprovalGatewayGroup = append(mock.calls.EnableTransitLearnedCidrsApprovalGatewayGroup, callInfo)
+	mock.lockEnableTransitLearnedCidrsApprovalGatewayGroup.Unlock()
+	return mock.EnableTransitLearnedCidrsApprovalGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableTransitLearnedCidrsApprovalGatewayGroupCalls gets all the calls that were made to EnableTransitLearnedCidrsApprovalGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableTransitLearnedCidrsApprovalGatewayGroupCalls())
+func (mock *EdgeClientMock) EnableTransitLearnedCidrsApprovalGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableTransitLearnedCidrsApprovalGatewayGroup.RLock()
+	calls = mock.calls.EnableTransitLearnedCidrsApprovalGatewayGroup
+	mock.lockEnableTransitLearnedCidrsApprovalGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableTransitPreserveAsPath calls EnableTransitPreserveAsPathFunc.
+func (mock *EdgeClientMock) EnableTransitPreserveAsPath(transitGateway *TransitVpc) error {
+	if mock.EnableTransitPreserveAsPathFunc == nil {
+		panic("EdgeClientMock.EnableTransitPreserveAsPathFunc: method is nil but EdgeClient.EnableTransitPreserveAsPath was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockEnableTransitPreserveAsPath.Lock()
+	mock.calls.EnableTransitPreserveAsPath = append(mock.calls.EnableTransitPreserveAsPath, callInfo)
+	mock.lockEnableTransitPreserveAsPath.Unlock()
+	return mock.EnableTransitPreserveAsPathFunc(transitGateway)
+}
+
+// EnableTransitPreserveAsPathCalls gets all the calls that were made to EnableTransitPreserveAsPath.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableTransitPreserveAsPathCalls())
+func (mock *EdgeClientMock) EnableTransitPreserveAsPathCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockEnableTransitPreserveAsPath.RLock()
+	calls = mock.calls.EnableTransitPreserveAsPath
+	mock.lockEnableTransitPreserveAsPath.RUnlock()
+	return calls
+}
+
+// EnableTransitPreserveAsPathGatewayGroup calls EnableTransitPreserveAsPathGatewayGroupFunc.
+func (mock *EdgeClientMock) EnableTransitPreserveAsPathGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableTransitPreserveAsPathGatewayGroupFunc == nil {
+		panic("EdgeClientMock.EnableTransitPreserveAsPathGatewayGroupFunc: method is nil but EdgeClient.EnableTransitPreserveAsPathGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableTransitPreserveAsPathGatewayGroup.Lock()
+	mock.calls.EnableTransitPreserveAsPathGatewayGroup = append(mock.calls.EnableTransitPreserveAsPathGatewayGroup, callInfo)
+	mock.lockEnableTransitPreserveAsPathGatewayGroup.Unlock()
+	return mock.EnableTransitPreserveAsPathGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableTransitPreserveAsPathGatewayGroupCalls gets all the calls that were made to EnableTransitPreserveAsPathGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableTransitPreserveAsPathGatewayGroupCalls())
+func (mock *EdgeClientMock) EnableTransitPreserveAsPathGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableTransitPreserveAsPathGatewayGroup.RLock()
+	calls = mock.calls.EnableTransitPreserveAsPathGatewayGroup
+	mock.lockEnableTransitPreserveAsPathGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableTransitSummarizeCidrToTgwGatewayGroup calls EnableTransitSummarizeCidrToTgwGatewayGroupFunc.
+func (mock *EdgeClientMock) EnableTransitSummarizeCidrToTgwGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableTransitSummarizeCidrToTgwGatewayGroupFunc == nil {
+		panic("EdgeClientMock.EnableTransitSummarizeCidrToTgwGatewayGroupFunc: method is nil but EdgeClient.EnableTransitSummarizeCidrToTgwGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableTransitSummarizeCidrToTgwGatewayGroup.Lock()
+	mock.calls.EnableTransitSummarizeCidrToTgwGatewayGroup = append(mock.calls.EnableTransitSummarizeCidrToTgwGatewayGroup, callInfo)
+	mock.lockEnableTransitSummarizeCidrToTgwGatewayGroup.Unlock()
+	return mock.EnableTransitSummarizeCidrToTgwGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableTransitSummarizeCidrToTgwGatewayGroupCalls gets all the calls that were made to EnableTransitSummarizeCidrToTgwGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableTransitSummarizeCidrToTgwGatewayGroupCalls())
+func (mock *EdgeClientMock) EnableTransitSummarizeCidrToTgwGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableTransitSummarizeCidrToTgwGatewayGroup.RLock()
+	calls = mock.calls.EnableTransitSummarizeCidrToTgwGatewayGroup
+	mock.lockEnableTransitSummarizeCidrToTgwGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableVPNConfig calls EnableVPNConfigFunc.
+func (mock *EdgeClientMock) EnableVPNConfig(gateway *Gateway, vpnConfig *VPNConfig) error {
+	if mock.EnableVPNConfigFunc == nil {
+		panic("EdgeClientMock.EnableVPNConfigFunc: method is nil but EdgeClient.EnableVPNConfig was just called")
+	}
+	callInfo := struct {
+		Gateway   *Gateway
+		VpnConfig *VPNConfig
+	}{
+		Gateway:   gateway,
+		VpnConfig: vpnConfig,
+	}
+	mock.lockEnableVPNConfig.Lock()
+	mock.calls.EnableVPNConfig = append(mock.calls.EnableVPNConfig, callInfo)
+	mock.lockEnableVPNConfig.Unlock()
+	return mock.EnableVPNConfigFunc(gateway, vpnConfig)
+}
+
+// EnableVPNConfigCalls gets all the calls that were made to EnableVPNConfig.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableVPNConfigCalls())
+func (mock *EdgeClientMock) EnableVPNConfigCalls() []struct {
+	Gateway   *Gateway
+	VpnConfig *VPNConfig
+} {
+	var calls []struct {
+		Gateway   *Gateway
+		VpnConfig *VPNConfig
+	}
+	mock.lockEnableVPNConfig.RLock()
+	calls = mock.calls.EnableVPNConfig
+	mock.lockEnableVPNConfig.RUnlock()
+	return calls
+}
+
+// EnableVpcDNSServer calls EnableVpcDNSServerFunc.
+func (mock *EdgeClientMock) EnableVpcDNSServer(gateway *Gateway) error {
+	if mock.EnableVpcDNSServerFunc == nil {
+		panic("EdgeClientMock.EnableVpcDNSServerFunc: method is nil but EdgeClient.EnableVpcDNSServer was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableVpcDNSServer.Lock()
+	mock.calls.EnableVpcDNSServer = append(mock.calls.EnableVpcDNSServer, callInfo)
+	mock.lockEnableVpcDNSServer.Unlock()
+	return mock.EnableVpcDNSServerFunc(gateway)
+}
+
+// EnableVpcDNSServerCalls gets all the calls that were made to EnableVpcDNSServer.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableVpcDNSServerCalls())
+func (mock *EdgeClientMock) EnableVpcDNSServerCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableVpcDNSServer.RLock()
+	calls = mock.calls.EnableVpcDNSServer
+	mock.lockEnableVpcDNSServer.RUnlock()
+	return calls
+}
+
+// EnableVpnNat calls EnableVpnNatFunc.
+func (mock *EdgeClientMock) EnableVpnNat(gateway *Gateway) error {
+	if mock.EnableVpnNatFunc == nil {
+		panic("EdgeClientMock.EnableVpnNatFunc: method is nil but EdgeClient.EnableVpnNat was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableVpnNat.Lock()
+	mock.calls.EnableVpnNat = append(mock.calls.EnableVpnNat, callInfo)
+	mock.lockEnableVpnNat.Unlock()
+	return mock.EnableVpnNatFunc(gateway)
+}
+
+// EnableVpnNatCalls gets all the calls that were made to EnableVpnNat.
+// Check the length with:
+//
+//	len(mockedEdgeClient.EnableVpnNatCalls())
+func (mock *EdgeClientMock) EnableVpnNatCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableVpnNat.RLock()
+	calls = mock.calls.EnableVpnNat
+	mock.lockEnableVpnNat.RUnlock()
+	return calls
+}
+
+// GetBgpLanIPList calls GetBgpLanIPListFunc.
+func (mock *EdgeClientMock) GetBgpLanIPList(transitGateway *TransitVpc) (*TransitGatewayBgpLanIpInfo, error) {
+	if mock.GetBgpLanIPListFunc == nil {
+		panic("EdgeClientMock.GetBgpLanIPListFunc: method is nil but EdgeClient.GetBgpLanIPList was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockGetBgpLanIPList.Lock()
+	mock.calls.GetBgpLanIPList = append(mock.calls.GetBgpLanIPList, callInfo)
+	mock.lockGetBgpLanIPList.Unlock()
+	return mock.GetBgpLanIPListFunc(transitGateway)
+}
+
+// GetBgpLanIPListCalls gets all the calls that were made to GetBgpLanIPList.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetBgpLanIPListCalls())
+func (mock *EdgeClientMock) GetBgpLanIPListCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockGetBgpLanIPList.RLock()
+	calls = mock.calls.GetBgpLanIPList
+	mock.lockGetBgpLanIPList.RUnlock()
+	return calls
+}
+
+// GetCID calls GetCIDFunc.
+func (mock *EdgeClientMock) GetCID() string {
+	if mock.GetCIDFunc == nil {
+		panic("EdgeClientMock.GetCIDFunc: method is nil but EdgeClient.GetCID was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetCID.Lock()
+	mock.calls.GetCID = append(mock.calls.GetCID, callInfo)
+	mock.lockGetCID.Unlock()
+	return mock.GetCIDFunc()
+}
+
+// GetCIDCalls gets all the calls that were made to GetCID.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetCIDCalls())
+func (mock *EdgeClientMock) GetCIDCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetCID.RLock()
+	calls = mock.calls.GetCID
+	mock.lockGetCID.RUnlock()
+	return calls
+}
+
+// GetControllerIP calls GetControllerIPFunc.
+func (mock *EdgeClientMock) GetControllerIP() string {
+	if mock.GetControllerIPFunc == nil {
+		panic("EdgeClientMock.GetControllerIPFunc: method is nil but EdgeClient.GetControllerIP was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetControllerIP.Lock()
+	mock.calls.GetControllerIP = append(mock.calls.GetControllerIP, callInfo)
+	mock.lockGetControllerIP.Unlock()
+	return mock.GetControllerIPFunc()
+}
+
+// GetControllerIPCalls gets all the calls that were made to GetControllerIP.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetControllerIPCalls())
+func (mock *EdgeClientMock) GetControllerIPCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetControllerIP.RLock()
+	calls = mock.calls.GetControllerIP
+	mock.lockGetControllerIP.RUnlock()
+	return calls
+}
+
+// GetDefaultTagsConfig calls GetDefaultTagsConfigFunc.
+func (mock *EdgeClientMock) GetDefaultTagsConfig() *DefaultTagsConfig {
+	if mock.GetDefaultTagsConfigFunc == nil {
+		panic("EdgeClientMock.GetDefaultTagsConfigFunc: method is nil but EdgeClient.GetDefaultTagsConfig was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetDefaultTagsConfig.Lock()
+	mock.calls.GetDefaultTagsConfig = append(mock.calls.GetDefaultTagsConfig, callInfo)
+	mock.lockGetDefaultTagsConfig.Unlock()
+	return mock.GetDefaultTagsConfigFunc()
+}
+
+// GetDefaultTagsConfigCalls gets all the calls that were made to GetDefaultTagsConfig.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetDefaultTagsConfigCalls())
+func (mock *EdgeClientMock) GetDefaultTagsConfigCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetDefaultTagsConfig.RLock()
+	calls = mock.calls.GetDefaultTagsConfig
+	mock.lockGetDefaultTagsConfig.RUnlock()
+	return calls
+}
+
+// GetEdgeCSP calls GetEdgeCSPFunc.
+func (mock *EdgeClientMock) GetEdgeCSP(ctx context.Context, gwName string) (*EdgeCSPResp, error) {
+	if mock.GetEdgeCSPFunc == nil {
+		panic("EdgeClientMock.GetEdgeCSPFunc: method is nil but EdgeClient.GetEdgeCSP was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		GwName string
+	}{
+		Ctx:    ctx,
+		GwName: gwName,
+	}
+	mock.lockGetEdgeCSP.Lock()
+	mock.calls.GetEdgeCSP = append(mock.calls.GetEdgeCSP, callInfo)
+	mock.lockGetEdgeCSP.Unlock()
+	return mock.GetEdgeCSPFunc(ctx, gwName)
+}
+
+// GetEdgeCSPCalls gets all the calls that were made to GetEdgeCSP.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetEdgeCSPCalls())
+func (mock *EdgeClientMock) GetEdgeCSPCalls() []struct {
+	Ctx    context.Context
+	GwName string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		GwName string
+	}
+	mock.lockGetEdgeCSP.RLock()
+	calls = mock.calls.GetEdgeCSP
+	mock.lockGetEdgeCSP.RUnlock()
+	return calls
+}
+
+// GetEdgeCSPHa calls GetEdgeCSPHaFunc.
+func (mock *EdgeClientMock) GetEdgeCSPHa(ctx context.Context, gwName string) (*EdgeCSPHaResp, error) {
+	if mock.GetEdgeCSPHaFunc == nil {
+		panic("EdgeClientMock.GetEdgeCSPHaFunc: method is nil but EdgeClient.GetEdgeCSPHa was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		GwName string
+	}{
+		Ctx:    ctx,
+		GwName: gwName,
+	}
+	mock.lockGetEdgeCSPHa.Lock()
+	mock.calls.GetEdgeCSPHa = append(mock.calls.GetEdgeCSPHa, callInfo)
+	mock.lockGetEdgeCSPHa.Unlock()
+	return mock.GetEdgeCSPHaFunc(ctx, gwName)
+}
+
+// GetEdgeCSPHaCalls gets all the calls that were made to GetEdgeCSPHa.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetEdgeCSPHaCalls())
+func (mock *EdgeClientMock) GetEdgeCSPHaCalls() []struct {
+	Ctx    context.Context
+	GwName string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		GwName string
+	}
+	mock.lockGetEdgeCSPHa.RLock()
+	calls = mock.calls.GetEdgeCSPHa
+	mock.lockGetEdgeCSPHa.RUnlock()
+	return calls
+}
+
+// GetEdgeEquinix calls GetEdgeEquinixFunc.
+func (mock *EdgeClientMock) GetEdgeEquinix(ctx context.Context, gwName string) (*EdgeEquinixResp, error) {
+	if mock.GetEdgeEquinixFunc == nil {
+		panic("EdgeClientMock.GetEdgeEquinixFunc: method is nil but EdgeClient.GetEdgeEquinix was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		GwName string
+	}{
+		Ctx:    ctx,
+		GwName: gwName,
+	}
+	mock.lockGetEdgeEquinix.Lock()
+	mock.calls.GetEdgeEquinix = append(mock.calls.GetEdgeEquinix, callInfo)
+	mock.lockGetEdgeEquinix.Unlock()
+	return mock.GetEdgeEquinixFunc(ctx, gwName)
+}
+
+// GetEdgeEquinixCalls gets all the calls that were made to GetEdgeEquinix.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetEdgeEquinixCalls())
+func (mock *EdgeClientMock) GetEdgeEquinixCalls() []struct {
+	Ctx    context.Context
+	GwName string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		GwName string
+	}
+	mock.lockGetEdgeEquinix.RLock()
+	calls = mock.calls.GetEdgeEquinix
+	mock.lockGetEdgeEquinix.RUnlock()
+	return calls
+}
+
+// GetEdgeEquinixHa calls GetEdgeEquinixHaFunc.
+func (mock *EdgeClientMock) GetEdgeEquinixHa(ctx context.Context, gwName string) (*EdgeEquinixHaResp, error) {
+	if mock.GetEdgeEquinixHaFunc == nil {
+		panic("EdgeClientMock.GetEdgeEquinixHaFunc: method is nil but EdgeClient.GetEdgeEquinixHa was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		GwName string
+	}{
+		Ctx:    ctx,
+		GwName: gwName,
+	}
+	mock.lockGetEdgeEquinixHa.Lock()
+	mock.calls.GetEdgeEquinixHa = append(mock.calls.GetEdgeEquinixHa, callInfo)
+	mock.lockGetEdgeEquinixHa.Unlock()
+	return mock.GetEdgeEquinixHaFunc(ctx, gwName)
+}
+
+// GetEdgeEquinixHaCalls gets all the calls that were made to GetEdgeEquinixHa.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetEdgeEquinixHaCalls())
+func (mock *EdgeClientMock) GetEdgeEquinixHaCalls() []struct {
+	Ctx    context.Context
+	GwName string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		GwName string
+	}
+	mock.lockGetEdgeEquinixHa.RLock()
+	calls = mock.calls.GetEdgeEquinixHa
+	mock.lockGetEdgeEquinixHa.RUnlock()
+	return calls
+}
+
+// GetEdgeGatewayWanIp calls GetEdgeGatewayWanIpFunc.
+func (mock *EdgeClientMock) GetEdgeGatewayWanIp(ctx context.Context, gwName string, wanInterfaceName string) (string, error) {
+	if mock.GetEdgeGatewayWanIpFunc == nil {
+		panic("EdgeClientMock.GetEdgeGatewayWanIpFunc: method is nil but EdgeClient.GetEdgeGatewayWanIp was just called")
+	}
+	callInfo := struct {
+		Ctx              context.Context
+		GwName           string
+		WanInterfaceName string
+	}{
+		Ctx:              ctx,
+		GwName:           gwName,
+		WanInterfaceName: wanInterfaceName,
+	}
+	mock.lockGetEdgeGatewayWanIp.Lock()
+	mock.calls.GetEdgeGatewayWanIp = append(mock.calls.GetEdgeGatewayWanIp, callInfo)
+	mock.lockGetEdgeGatewayWanIp.Unlock()
+	return mock.GetEdgeGatewayWanIpFunc(ctx, gwName, wanInterfaceName)
+}
+
+// GetEdgeGatewayWanIpCalls gets all the calls that were made to GetEdgeGatewayWanIp.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetEdgeGatewayWanIpCalls())
+func (mock *EdgeClientMock) GetEdgeGatewayWanIpCalls() []struct {
+	Ctx              context.Context
+	GwName           string
+	WanInterfaceName string
+} {
+	var calls []struct {
+		Ctx              context.Context
+		GwName           string
+		WanInterfaceName string
+	}
+	mock.lockGetEdgeGatewayWanIp.RLock()
+	calls = mock.calls.GetEdgeGatewayWanIp
+	mock.lockGetEdgeGatewayWanIp.RUnlock()
+	return calls
+}
+
+// GetEdgeMegaport calls GetEdgeMegaportFunc.
+func (mock *EdgeClientMock) GetEdgeMegaport(ctx context.Context, gwName string) (*EdgeMegaportResp, error) {
+	if mock.GetEdgeMegaportFunc == nil {
+		panic("EdgeClientMock.GetEdgeMegaportFunc: method is nil but EdgeClient.GetEdgeMegaport was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		GwName string
+	}{
+		Ctx:    ctx,
+		GwName: gwName,
+	}
+	mock.lockGetEdgeMegaport.Lock()
+	mock.calls.GetEdgeMegaport = append(mock.calls.GetEdgeMegaport, callInfo)
+	mock.lockGetEdgeMegaport.Unlock()
+	return mock.GetEdgeMegaportFunc(ctx, gwName)
+}
+
+// GetEdgeMegaportCalls gets all the calls that were made to GetEdgeMegaport.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetEdgeMegaportCalls())
+func (mock *EdgeClientMock) GetEdgeMegaportCalls() []struct {
+	Ctx    context.Context
+	GwName string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		GwName string
+	}
+	mock.lockGetEdgeMegaport.RLock()
+	calls = mock.calls.GetEdgeMegaport
+	mock.lockGetEdgeMegaport.RUnlock()
+	return calls
+}
+
+// GetEdgeMegaportHa calls GetEdgeMegaportHaFunc.
+func (mock *EdgeClientMock) GetEdgeMegaportHa(ctx context.Context, gwName string) (*EdgeMegaportHaResp, error) {
+	if mock.GetEdgeMegaportHaFunc == nil {
+		panic("EdgeClientMock.GetEdgeMegaportHaFunc: method is nil but EdgeClient.GetEdgeMegaportHa was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		GwName string
+	}{
+		Ctx:    ctx,
+		GwName: gwName,
+	}
+	mock.lockGetEdgeMegaportHa.Lock()
+	mock.calls.GetEdgeMegaportHa = append(mock.calls.GetEdgeMegaportHa, callInfo)
+	mock.lockGetEdgeMegaportHa.Unlock()
+	return mock.GetEdgeMegaportHaFunc(ctx, gwName)
+}
+
+// GetEdgeMegaportHaCalls gets all the calls that were made to GetEdgeMegaportHa.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetEdgeMegaportHaCalls())
+func (mock *EdgeClientMock) GetEdgeMegaportHaCalls() []struct {
+	Ctx    context.Context
+	GwName string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		GwName string
+	}
+	mock.lockGetEdgeMegaportHa.RLock()
+	calls = mock.calls.GetEdgeMegaportHa
+	mock.lockGetEdgeMegaportHa.RUnlock()
+	return calls
+}
+
+// GetEdgeNEO calls GetEdgeNEOFunc.
+func (mock *EdgeClientMock) GetEdgeNEO(ctx context.Context, gwName string) (*EdgeNEOResp, error) {
+	if mock.GetEdgeNEOFunc == nil {
+		panic("EdgeClientMock.GetEdgeNEOFunc: method is nil but EdgeClient.GetEdgeNEO was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		GwName string
+	}{
+		Ctx:    ctx,
+		GwName: gwName,
+	}
+	mock.lockGetEdgeNEO.Lock()
+	mock.calls.GetEdgeNEO = append(mock.calls.GetEdgeNEO, callInfo)
+	mock.lockGetEdgeNEO.Unlock()
+	return mock.GetEdgeNEOFunc(ctx, gwName)
+}
+
+// GetEdgeNEOCalls gets all the calls that were made to GetEdgeNEO.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetEdgeNEOCalls())
+func (mock *EdgeClientMock) GetEdgeNEOCalls() []struct {
+	Ctx    context.Context
+	GwName string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		GwName string
+	}
+	mock.lockGetEdgeNEO.RLock()
+	calls = mock.calls.GetEdgeNEO
+	mock.lockGetEdgeNEO.RUnlock()
+	return calls
+}
+
+// GetEdgeNEODevice calls GetEdgeNEODeviceFunc.
+func (mock *EdgeClientMock) GetEdgeNEODevice(ctx context.Context, accountName string, deviceName string) (*EdgeNEODeviceResp, error) {
+	if mock.GetEdgeNEODeviceFunc == nil {
+		panic("EdgeClientMock.GetEdgeNEODeviceFunc: method is nil but EdgeClient.GetEdgeNEODevice was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		AccountName string
+		DeviceName  string
+	}{
+		Ctx:         ctx,
+		AccountName: accountName,
+		DeviceName:  deviceName,
+	}
+	mock.lockGetEdgeNEODevice.Lock()
+	mock.calls.GetEdgeNEODevice = append(mock.calls.GetEdgeNEODevice, callInfo)
+	mock.lockGetEdgeNEODevice.Unlock()
+	return mock.GetEdgeNEODeviceFunc(ctx, accountName, deviceName)
+}
+
+// GetEdgeNEODeviceCalls gets all the calls that were made to GetEdgeNEODevice.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetEdgeNEODeviceCalls())
+func (mock *EdgeClientMock) GetEdgeNEODeviceCalls() []struct {
+	Ctx         context.Context
+	AccountName string
+	DeviceName  string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		AccountName string
+		DeviceName  string
+	}
+	mock.lockGetEdgeNEODevice.RLock()
+	calls = mock.calls.GetEdgeNEODevice
+	mock.lockGetEdgeNEODevice.RUnlock()
+	return calls
+}
+
+// GetEdgeNEOHa calls GetEdgeNEOHaFunc.
+func (mock *EdgeClientMock) GetEdgeNEOHa(ctx context.Context, gwName string) (*EdgeNEOHaResp, error) {
+	if mock.GetEdgeNEOHaFunc == nil {
+		panic("EdgeClientMock.GetEdgeNEOHaFunc: method is nil but EdgeClient.GetEdgeNEOHa was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		GwName string
+	}{
+		Ctx:    ctx,
+		GwName: gwName,
+	}
+	mock.lockGetEdgeNEOHa.Lock()
+	mock.calls.GetEdgeNEOHa = append(mock.calls.GetEdgeNEOHa, callInfo)
+	mock.lockGetEdgeNEOHa.Unlock()
+	return mock.GetEdgeNEOHaFunc(ctx, gwName)
+}
+
+// GetEdgeNEOHaCalls gets all the calls that were made to GetEdgeNEOHa.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetEdgeNEOHaCalls())
+func (mock *EdgeClientMock) GetEdgeNEOHaCalls() []struct {
+	Ctx    context.Context
+	GwName string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		GwName string
+	}
+	mock.lockGetEdgeNEOHa.RLock()
+	calls = mock.calls.GetEdgeNEOHa
+	mock.lockGetEdgeNEOHa.RUnlock()
+	return calls
+}
+
+// GetEdgePlatformProxyProfile calls GetEdgePlatformProxyProfileFunc.
+func (mock *EdgeClientMock) GetEdgePlatformProxyProfile(ctx context.Context, accountName string, profileName string) (*EdgePlatformProxyProfileResp, error) {
+	if mock.GetEdgePlatformProxyProfileFunc == nil {
+		panic("EdgeClientMock.GetEdgePlatformProxyProfileFunc: method is nil but EdgeClient.GetEdgePlatformProxyProfile was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		AccountName string
+		ProfileName string
+	}{
+		Ctx:         ctx,
+		AccountName: accountName,
+		ProfileName: profileName,
+	}
+	mock.lockGetEdgePlatformProxyProfile.Lock()
+	mock.calls.GetEdgePlatformProxyProfile = append(mock.calls.GetEdgePlatformProxyProfile, callInfo)
+	mock.lockGetEdgePlatformProxyProfile.Unlock()
+	return mock.GetEdgePlatformProxyProfileFunc(ctx, accountName, profileName)
+}
+
+// GetEdgePlatformProxyProfileCalls gets all the calls that were made to GetEdgePlatformProxyProfile.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetEdgePlatformProxyProfileCalls())
+func (mock *EdgeClientMock) GetEdgePlatformProxyProfileCalls() []struct {
+	Ctx         context.Context
+	AccountName string
+	ProfileName string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		AccountName string
+		ProfileName string
+	}
+	mock.lockGetEdgePlatformProxyProfile.RLock()
+	calls = mock.calls.GetEdgePlatformProxyProfile
+	mock.lockGetEdgePlatformProxyProfile.RUnlock()
+	return calls
+}
+
+// GetEdgeSpoke calls GetEdgeSpokeFunc.
+func (mock *EdgeClientMock) GetEdgeSpoke(ctx context.Context, gwName string) (*EdgeSpokeResp, error) {
+	if mock.GetEdgeSpokeFunc == nil {
+		panic("EdgeClientMock.GetEdgeSpokeFunc: method is nil but EdgeClient.GetEdgeSpoke was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		GwName string
+	}{
+		Ctx:    ctx,
+		GwName: gwName,
+	}
+	mock.lockGetEdgeSpoke.Lock()
+	mock.calls.GetEdgeSpoke = append(mock.calls.GetEdgeSpoke, callInfo)
+	mock.lockGetEdgeSpoke.Unlock()
+	return mock.GetEdgeSpokeFunc(ctx, gwName)
+}
+
+// GetEdgeSpokeCalls gets all the calls that were made to GetEdgeSpoke.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetEdgeSpokeCalls())
+func (mock *EdgeClientMock) GetEdgeSpokeCalls() []struct {
+	Ctx    context.Context
+	GwName string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		GwName string
+	}
+	mock.lockGetEdgeSpoke.RLock()
+	calls = mock.calls.GetEdgeSpoke
+	mock.lockGetEdgeSpoke.RUnlock()
+	return calls
+}
+
+// GetEdgeSpokeTransitAttachment calls GetEdgeSpokeTransitAttachmentFunc.
+func (mock *EdgeClientMock) GetEdgeSpokeTransitAttachment(ctx context.Context, spokeTransitAttachment *SpokeTransitAttachment) (*SpokeTransitAttachment, error) {
+	if mock.GetEdgeSpokeTransitAttachmentFunc == nil {
+		panic("EdgeClientMock.GetEdgeSpokeTransitAttachmentFunc: method is nil but EdgeClient.GetEdgeSpokeTransitAttachment was just called")
+	}
+	callInfo := struct {
+		Ctx                    context.Context
+		SpokeTransitAttachment *SpokeTransitAttachment
+	}{
+		Ctx:                    ctx,
+		SpokeTransitAttachment: spokeTransitAttachment,
+	}
+	mock.lockGetEdgeSpokeTransitAttachment.Lock()
+	mock.calls.GetEdgeSpokeTransitAttachment = append(mock.calls.GetEdgeSpokeTransitAttachment, callInfo)
+	mock.lockGetEdgeSpokeTransitAttachment.Unlock()
+	return mock.GetEdgeSpokeTransitAttachmentFunc(ctx, spokeTransitAttachment)
+}
+
+// GetEdgeSpokeTransitAttachmentCalls gets all the calls that were made to GetEdgeSpokeTransitAttachment.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetEdgeSpokeTransitAttachmentCalls())
+func (mock *EdgeClientMock) GetEdgeSpokeTransitAttachmentCalls() []struct {
+	Ctx                    context.Context
+	SpokeTransitAttachment *SpokeTransitAttachment
+} {
+	var calls []struct {
+		Ctx                    context.Context
+		SpokeTransitAttachment *SpokeTransitAttachment
+	}
+	mock.lockGetEdgeSpokeTransitAttachment.RLock()
+	calls = mock.calls.GetEdgeSpokeTransitAttachment
+	mock.lockGetEdgeSpokeTransitAttachment.RUnlock()
+	return calls
+}
+
+// GetEdgeVmSelfmanagedHa calls GetEdgeVmSelfmanagedHaFunc.
+func (mock *EdgeClientMock) GetEdgeVmSelfmanagedHa(ctx context.Context, gwName string) (*EdgeVmSelfmanagedHaResp, error) {
+	if mock.GetEdgeVmSelfmanagedHaFunc == nil {
+		panic("EdgeClientMock.GetEdgeVmSelfmanagedHaFunc: method is nil but EdgeClient.GetEdgeVmSelfmanagedHa was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		GwName string
+	}{
+		Ctx:    ctx,
+		GwName: gwName,
+	}
+	mock.lockGetEdgeVmSelfmanagedHa.Lock()
+	mock.calls.GetEdgeVmSelfmanagedHa = append(mock.calls.GetEdgeVmSelfmanagedHa, callInfo)
+	mock.lockGetEdgeVmSelfmanagedHa.Unlock()
+	return mock.GetEdgeVmSelfmanagedHaFunc(ctx, gwName)
+}
+
+// GetEdgeVmSelfmanagedHaCalls gets all the calls that were made to GetEdgeVmSelfmanagedHa.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetEdgeVmSelfmanagedHaCalls())
+func (mock *EdgeClientMock) GetEdgeVmSelfmanagedHaCalls() []struct {
+	Ctx    context.Context
+	GwName string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		GwName string
+	}
+	mock.lockGetEdgeVmSelfmanagedHa.RLock()
+	calls = mock.calls.GetEdgeVmSelfmanagedHa
+	mock.lockGetEdgeVmSelfmanagedHa.RUnlock()
+	return calls
+}
+
+// GetExternalDeviceConnDetail calls GetExternalDeviceConnDetailFunc.
+func (mock *EdgeClientMock) GetExternalDeviceConnDetail(externalDeviceConn *ExternalDeviceConn, localGateway *Gateway, priorHAEnabled bool) (*ExternalDeviceConn, error) {
+	if mock.GetExternalDeviceConnDetailFunc == nil {
+		panic("EdgeClientMock.GetExternalDeviceConnDetailFunc: method is nil but EdgeClient.GetExternalDeviceConnDetail was just called")
+	}
+	callInfo := struct {
+		ExternalDeviceConn *ExternalDeviceConn
+		LocalGateway       *Gateway
+		PriorHAEnabled     bool
+	}{
+		ExternalDeviceConn: externalDeviceConn,
+		LocalGateway:       localGateway,
+		PriorHAEnabled:     priorHAEnabled,
+	}
+	mock.lockGetExternalDeviceConnDetail.Lock()
+	mock.calls.GetExternalDeviceConnDetail = append(mock.calls.GetExternalDeviceConnDetail, callInfo)
+	mock.lockGetExternalDeviceConnDetail.Unlock()
+	return mock.GetExternalDeviceConnDetailFunc(externalDeviceConn, localGateway, priorHAEnabled)
+}
+
+// GetExternalDeviceConnDetailCalls gets all the calls that were made to GetExternalDeviceConnDetail.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetExternalDeviceConnDetailCalls())
+func (mock *EdgeClientMock) GetExternalDeviceConnDetailCalls() []struct {
+	ExternalDeviceConn *ExternalDeviceConn
+	LocalGateway       *Gateway
+	PriorHAEnabled     bool
+} {
+	var calls []struct {
+		ExternalDeviceConn *ExternalDeviceConn
+		LocalGateway       *Gateway
+		PriorHAEnabled     bool
+	}
+	mock.lockGetExternalDeviceConnDetail.RLock()
+	calls = mock.calls.GetExternalDeviceConnDetail
+	mock.lockGetExternalDeviceConnDetail.RUnlock()
+	return calls
+}
+
+// GetGateway calls GetGatewayFunc.
+func (mock *EdgeClientMock) GetGateway(gateway *Gateway) (*Gateway, error) {
+	if mock.GetGatewayFunc == nil {
+		panic("EdgeClientMock.GetGatewayFunc: method is nil but EdgeClient.GetGateway was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockGetGateway.Lock()
+	mock.calls.GetGateway = append(mock.calls.GetGateway, callInfo)
+	mock.lockGetGateway.Unlock()
+	return mock.GetGatewayFunc(gateway)
+}
+
+// GetGatewayCalls gets all the calls that were made to GetGateway.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetGatewayCalls())
+func (mock *EdgeClientMock) GetGatewayCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockGetGateway.RLock()
+	calls = mock.calls.GetGateway
+	mock.lockGetGateway.RUnlock()
+	return calls
+}
+
+// GetGatewayBgpCommunities calls GetGatewayBgpCommunitiesFunc.
+func (mock *EdgeClientMock) GetGatewayBgpCommunities(gwName string) (bool, bool, error) {
+	if mock.GetGatewayBgpCommunitiesFunc == nil {
+		panic("EdgeClientMock.GetGatewayBgpCommunitiesFunc: method is nil but EdgeClient.GetGatewayBgpCommunities was just called")
+	}
+	callInfo := struct {
+		GwName string
+	}{
+		GwName: gwName,
+	}
+	mock.lockGetGatewayBgpCommunities.Lock()
+	mock.calls.GetGatewayBgpCommunities = append(mock.calls.GetGatewayBgpCommunities, callInfo)
+	mock.lockGetGatewayBgpCommunities.Unlock()
+	return mock.GetGatewayBgpCommunitiesFunc(gwName)
+}
+
+// GetGatewayBgpCommunitiesCalls gets all the calls that were made to GetGatewayBgpCommunities.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetGatewayBgpCommunitiesCalls())
+func (mock *EdgeClientMock) GetGatewayBgpCommunitiesCalls() []struct {
+	GwName string
+} {
+	var calls []struct {
+		GwName string
+	}
+	mock.lockGetGatewayBgpCommunities.RLock()
+	calls = mock.calls.GetGatewayBgpCommunities
+	mock.lockGetGatewayBgpCommunities.RUnlock()
+	return calls
+}
+
+// GetGatewayBgpMedToSdnMetric calls GetGatewayBgpMedToSdnMetricFunc.
+func (mock *EdgeClientMock) GetGatewayBgpMedToSdnMetric(gwName string) (bool, error) {
+	if mock.GetGatewayBgpMedToSdnMetricFunc == nil {
+		panic("EdgeClientMock.GetGatewayBgpMedToSdnMetricFunc: method is nil but EdgeClient.GetGatewayBgpMedToSdnMetric was just called")
+	}
+	callInfo := struct {
+		GwName string
+	}{
+		GwName: gwName,
+	}
+	mock.lockGetGatewayBgpMedToSdnMetric.Lock()
+	mock.calls.GetGatewayBgpMedToSdnMetric = append(mock.calls.GetGatewayBgpMedToSdnMetric, callInfo)
+	mock.lockGetGatewayBgpMedToSdnMetric.Unlock()
+	return mock.GetGatewayBgpMedToSdnMetricFunc(gwName)
+}
+
+// GetGatewayBgpMedToSdnMetricCalls gets all the calls that were made to GetGatewayBgpMedToSdnMetric.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetGatewayBgpMedToSdnMetricCalls())
+func (mock *EdgeClientMock) GetGatewayBgpMedToSdnMetricCalls() []struct {
+	GwName string
+} {
+	var calls []struct {
+		GwName string
+	}
+	mock.lockGetGatewayBgpMedToSdnMetric.RLock()
+	calls = mock.calls.GetGatewayBgpMedToSdnMetric
+	mock.lockGetGatewayBgpMedToSdnMetric.RUnlock()
+	return calls
+}
+
+// GetGatewayDetail calls GetGatewayDetailFunc.
+func (mock *EdgeClientMock) GetGatewayDetail(gateway *Gateway) (*GatewayDetail, error) {
+	if mock.GetGatewayDetailFunc == nil {
+		panic("EdgeClientMock.GetGatewayDetailFunc: method is nil but EdgeClient.GetGatewayDetail was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockGetGatewayDetail.Lock()
+	mock.calls.GetGatewayDetail = append(mock.calls.GetGatewayDetail, callInfo)
+	mock.lockGetGatewayDetail.Unlock()
+	return mock.GetGatewayDetailFunc(gateway)
+}
+
+// GetGatewayDetailCalls gets all the calls that were made to GetGatewayDetail.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetGatewayDetailCalls())
+func (mock *EdgeClientMock) GetGatewayDetailCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockGetGatewayDetail.RLock()
+	calls = mock.calls.GetGatewayDetail
+	mock.lockGetGatewayDetail.RUnlock()
+	return calls
+}
+
+// GetGatewayGroup calls GetGatewayGroupFunc.
+func (mock *EdgeClientMock) GetGatewayGroup(ctx context.Context, groupUUID string) (*GatewayGroup, error) {
+	if mock.GetGatewayGroupFunc == nil {
+		panic("EdgeClientMock.GetGatewayGroupFunc: method is nil but EdgeClient.GetGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupUUID string
+	}{
+		Ctx:       ctx,
+		GroupUUID: groupUUID,
+	}
+	mock.lockGetGatewayGroup.Lock()
+	mock.calls.GetGatewayGroup = append(mock.calls.GetGatewayGroup, callInfo)
+	mock.lockGetGatewayGroup.Unlock()
+	return mock.GetGatewayGroupFunc(ctx, groupUUID)
+}
+
+// GetGatewayGroupCalls gets all the calls that were made to GetGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetGatewayGroupCalls())
+func (mock *EdgeClientMock) GetGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupUUID string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupUUID string
+	}
+	mock.lockGetGatewayGroup.RLock()
+	calls = mock.calls.GetGatewayGroup
+	mock.lockGetGatewayGroup.RUnlock()
+	return calls
+}
+
+// GetGatewayGroupByName calls GetGatewayGroupByNameFunc.
+func (mock *EdgeClientMock) GetGatewayGroupByName(ctx context.Context, groupName string) (*GatewayGroup, error) {
+	if mock.GetGatewayGroupByNameFunc == nil {
+		panic("EdgeClientMock.GetGatewayGroupByNameFunc: method is nil but EdgeClient.GetGatewayGroupByName was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockGetGatewayGroupByName.Lock()
+	mock.calls.GetGatewayGroupByName = append(mock.calls.GetGatewayGroupByName, callInfo)
+	mock.lockGetGatewayGroupByName.Unlock()
+	return mock.GetGatewayGroupByNameFunc(ctx, groupName)
+}
+
+// GetGatewayGroupByNameCalls gets all the calls that were made to GetGatewayGroupByName.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetGatewayGroupByNameCalls())
+func (mock *EdgeClientMock) GetGatewayGroupByNameCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockGetGatewayGroupByName.RLock()
+	calls = mock.calls.GetGatewayGroupByName
+	mock.lockGetGatewayGroupByName.RUnlock()
+	return calls
+}
+
+// GetGeoVPNName calls GetGeoVPNNameFunc.
+func (mock *EdgeClientMock) GetGeoVPNName(gateway *Gateway) (*GeoVPN, error) {
+	if mock.GetGeoVPNNameFunc == nil {
+		panic("EdgeClientMock.GetGeoVPNNameFunc: method is nil but EdgeClient.GetGeoVPNName was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockGetGeoVPNName.Lock()
+	mock.calls.GetGeoVPNName = append(mock.calls.GetGeoVPNName, callInfo)
+	mock.lockGetGeoVPNName.Unlock()
+	return mock.GetGeoVPNNameFunc(gateway)
+}
+
+// GetGeoVPNNameCalls gets all the calls that were made to GetGeoVPNName.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetGeoVPNNameCalls())
+func (mock *EdgeClientMock) GetGeoVPNNameCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockGetGeoVPNName.RLock()
+	calls = mock.calls.GetGeoVPNName
+	mock.lockGetGeoVPNName.RUnlock()
+	return calls
+}
+
+// GetGroGsoStatus calls GetGroGsoStatusFunc.
+func (mock *EdgeClientMock) GetGroGsoStatus(gateway *Gateway) (bool, error) {
+	if mock.GetGroGsoStatusFunc == nil {
+		panic("EdgeClientMock.GetGroGsoStatusFunc: method is nil but EdgeClient.GetGroGsoStatus was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockGetGroGsoStatus.Lock()
+	mock.calls.GetGroGsoStatus = append(mock.calls.GetGroGsoStatus, callInfo)
+	mock.lockGetGroGsoStatus.Unlock()
+	return mock.GetGroGsoStatusFunc(gateway)
+}
+
+// GetGroGsoStatusCalls gets all the calls that were made to GetGroGsoStatus.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetGroGsoStatusCalls())
+func (mock *EdgeClientMock) GetGroGsoStatusCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockGetGroGsoStatus.RLock()
+	calls = mock.calls.GetGroGsoStatus
+	mock.lockGetGroGsoStatus.RUnlock()
+	return calls
+}
+
+// GetIgnoreTagsConfig calls GetIgnoreTagsConfigFunc.
+func (mock *EdgeClientMock) GetIgnoreTagsConfig() *IgnoreTagsConfig {
+	if mock.GetIgnoreTagsConfigFunc == nil {
+		panic("EdgeClientMock.GetIgnoreTagsConfigFunc: method is nil but EdgeClient.GetIgnoreTagsConfig was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetIgnoreTagsConfig.Lock()
+	mock.calls.GetIgnoreTagsConfig = append(mock.calls.GetIgnoreTagsConfig, callInfo)
+	mock.lockGetIgnoreTagsConfig.Unlock()
+	return mock.GetIgnoreTagsConfigFunc()
+}
+
+// GetIgnoreTagsConfigCalls gets all the calls that were made to GetIgnoreTagsConfig.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetIgnoreTagsConfigCalls())
+func (mock *EdgeClientMock) GetIgnoreTagsConfigCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetIgnoreTagsConfig.RLock()
+	calls = mock.calls.GetIgnoreTagsConfig
+	mock.lockGetIgnoreTagsConfig.RUnlock()
+	return calls
+}
+
+// GetSplitTunnel calls GetSplitTunnelFunc.
+func (mock *EdgeClientMock) GetSplitTunnel(splitTunnel *SplitTunnel) (*SplitTunnelUnit, error) {
+	if mock.GetSplitTunnelFunc == nil {
+		panic("EdgeClientMock.GetSplitTunnelFunc: method is nil but EdgeClient.GetSplitTunnel was just called")
+	}
+	callInfo := struct {
+		SplitTunnel *SplitTunnel
+	}{
+		SplitTunnel: splitTunnel,
+	}
+	mock.lockGetSplitTunnel.Lock()
+	mock.calls.GetSplitTunnel = append(mock.calls.GetSplitTunnel, callInfo)
+	mock.lockGetSplitTunnel.Unlock()
+	return mock.GetSplitTunnelFunc(splitTunnel)
+}
+
+// GetSplitTunnelCalls gets all the calls that were made to GetSplitTunnel.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetSplitTunnelCalls())
+func (mock *EdgeClientMock) GetSplitTunnelCalls() []struct {
+	SplitTunnel *SplitTunnel
+} {
+	var calls []struct {
+		SplitTunnel *SplitTunnel
+	}
+	mock.lockGetSplitTunnel.RLock()
+	calls = mock.calls.GetSplitTunnel
+	mock.lockGetSplitTunnel.RUnlock()
+	return calls
+}
+
+// GetSpokeGatewayAdvancedConfig calls GetSpokeGatewayAdvancedConfigFunc.
+func (mock *EdgeClientMock) GetSpokeGatewayAdvancedConfig(spokeGateway *SpokeVpc) (*SpokeGatewayAdvancedConfig, error) {
+	if mock.GetSpokeGatewayAdvancedConfigFunc == nil {
+		panic("EdgeClientMock.GetSpokeGatewayAdvancedConfigFunc: method is nil but EdgeClient.GetSpokeGatewayAdvancedConfig was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+	}{
+		SpokeGateway: spokeGateway,
+	}
+	mock.lockGetSpokeGatewayAdvancedConfig.Lock()
+	mock.calls.GetSpokeGatewayAdvancedConfig = append(mock.calls.GetSpokeGatewayAdvancedConfig, callInfo)
+	mock.lockGetSpokeGatewayAdvancedConfig.Unlock()
+	return mock.GetSpokeGatewayAdvancedConfigFunc(spokeGateway)
+}
+
+// GetSpokeGatewayAdvancedConfigCalls gets all the calls that were made to GetSpokeGatewayAdvancedConfig.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetSpokeGatewayAdvancedConfigCalls())
+func (mock *EdgeClientMock) GetSpokeGatewayAdvancedConfigCalls() []struct {
+	SpokeGateway *SpokeVpc
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+	}
+	mock.lockGetSpokeGatewayAdvancedConfig.RLock()
+	calls = mock.calls.GetSpokeGatewayAdvancedConfig
+	mock.lockGetSpokeGatewayAdvancedConfig.RUnlock()
+	return calls
+}
+
+// GetSpokeGatewayList calls GetSpokeGatewayListFunc.
+func (mock *EdgeClientMock) GetSpokeGatewayList(ctx context.Context) ([]Gateway, error) {
+	if mock.GetSpokeGatewayListFunc == nil {
+		panic("EdgeClientMock.GetSpokeGatewayListFunc: method is nil but EdgeClient.GetSpokeGatewayList was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetSpokeGatewayList.Lock()
+	mock.calls.GetSpokeGatewayList = append(mock.calls.GetSpokeGatewayList, callInfo)
+	mock.lockGetSpokeGatewayList.Unlock()
+	return mock.GetSpokeGatewayListFunc(ctx)
+}
+
+// GetSpokeGatewayListCalls gets all the calls that were made to GetSpokeGatewayList.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetSpokeGatewayListCalls())
+func (mock *EdgeClientMock) GetSpokeGatewayListCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetSpokeGatewayList.RLock()
+	calls = mock.calls.GetSpokeGatewayList
+	mock.lockGetSpokeGatewayList.RUnlock()
+	return calls
+}
+
+// GetSpokeGatewaySubnetGroup calls GetSpokeGatewaySubnetGroupFunc.
+func (mock *EdgeClientMock) GetSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error {
+	if mock.GetSpokeGatewaySubnetGroupFunc == nil {
+		panic("EdgeClientMock.GetSpokeGatewaySubnetGroupFunc: method is nil but EdgeClient.GetSpokeGatewaySubnetGroup was just called")
+	}
+	callInfo := struct {
+		Ctx                     context.Context
+		SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+	}{
+		Ctx:                     ctx,
+		SpokeGatewaySubnetGroup: spokeGatewaySubnetGroup,
+	}
+	mock.lockGetSpokeGatewaySubnetGroup.Lock()
+	mock.calls.GetSpokeGatewaySubnetGroup = append(mock.calls.GetSpokeGatewaySubnetGroup, callInfo)
+	mock.lockGetSpokeGatewaySubnetGroup.Unlock()
+	return mock.GetSpokeGatewaySubnetGroupFunc(ctx, spokeGatewaySubnetGroup)
+}
+
+// GetSpokeGatewaySubnetGroupCalls gets all the calls that were made to GetSpokeGatewaySubnetGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetSpokeGatewaySubnetGroupCalls())
+func (mock *EdgeClientMock) GetSpokeGatewaySubnetGroupCalls() []struct {
+	Ctx                     context.Context
+	SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+} {
+	var calls []struct {
+		Ctx                     context.Context
+		SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+	}
+	mock.lockGetSpokeGatewaySubnetGroup.RLock()
+	calls = mock.calls.GetSpokeGatewaySubnetGroup
+	mock.lockGetSpokeGatewaySubnetGroup.RUnlock()
+	return calls
+}
+
+// GetTags calls GetTagsFunc.
+func (mock *EdgeClientMock) GetTags(tags *Tags) ([]string, error) {
+	if mock.GetTagsFunc == nil {
+		panic("EdgeClientMock.GetTagsFunc: method is nil but EdgeClient.GetTags was just called")
+	}
+	callInfo := struct {
+		Tags *Tags
+	}{
+		Tags: tags,
+	}
+	mock.lockGetTags.Lock()
+	mock.calls.GetTags = append(mock.calls.GetTags, callInfo)
+	mock.lockGetTags.Unlock()
+	return mock.GetTagsFunc(tags)
+}
+
+// GetTagsCalls gets all the calls that were made to GetTags.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetTagsCalls())
+func (mock *EdgeClientMock) GetTagsCalls() []struct {
+	Tags *Tags
+} {
+	var calls []struct {
+		Tags *Tags
+	}
+	mock.lockGetTags.RLock()
+	calls = mock.calls.GetTags
+	mock.lockGetTags.RUnlock()
+	return calls
+}
+
+// GetTransitGatewayAdvancedConfig calls GetTransitGatewayAdvancedConfigFunc.
+func (mock *EdgeClientMock) GetTransitGatewayAdvancedConfig(transitGateway *TransitVpc) (*TransitGatewayAdvancedConfig, error) {
+	if mock.GetTransitGatewayAdvancedConfigFunc == nil {
+		panic("EdgeClientMock.GetTransitGatewayAdvancedConfigFunc: method is nil but EdgeClient.GetTransitGatewayAdvancedConfig was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockGetTransitGatewayAdvancedConfig.Lock()
+	mock.calls.GetTransitGatewayAdvancedConfig = append(mock.calls.GetTransitGatewayAdvancedConfig, callInfo)
+	mock.lockGetTransitGatewayAdvancedConfig.Unlock()
+	return mock.GetTransitGatewayAdvancedConfigFunc(transitGateway)
+}
+
+// GetTransitGatewayAdvancedConfigCalls gets all the calls that were made to GetTransitGatewayAdvancedConfig.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetTransitGatewayAdvancedConfigCalls())
+func (mock *EdgeClientMock) GetTransitGatewayAdvancedConfigCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockGetTransitGatewayAdvancedConfig.RLock()
+	calls = mock.calls.GetTransitGatewayAdvancedConfig
+	mock.lockGetTransitGatewayAdvancedConfig.RUnlock()
+	return calls
+}
+
+// GetTransitGatewayLanCidr calls GetTransitGatewayLanCidrFunc.
+func (mock *EdgeClientMock) GetTransitGatewayLanCidr(gatewayName string) (string, error) {
+	if mock.GetTransitGatewayLanCidrFunc == nil {
+		panic("EdgeClientMock.GetTransitGatewayLanCidrFunc: method is nil but EdgeClient.GetTransitGatewayLanCidr was just called")
+	}
+	callInfo := struct {
+		GatewayName string
+	}{
+		GatewayName: gatewayName,
+	}
+	mock.lockGetTransitGatewayLanCidr.Lock()
+	mock.calls.GetTransitGatewayLanCidr = append(mock.calls.GetTransitGatewayLanCidr, callInfo)
+	mock.lockGetTransitGatewayLanCidr.Unlock()
+	return mock.GetTransitGatewayLanCidrFunc(gatewayName)
+}
+
+// GetTransitGatewayLanCidrCalls gets all the calls that were made to GetTransitGatewayLanCidr.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetTransitGatewayLanCidrCalls())
+func (mock *EdgeClientMock) GetTransitGatewayLanCidrCalls() []struct {
+	GatewayName string
+} {
+	var calls []struct {
+		GatewayName string
+	}
+	mock.lockGetTransitGatewayLanCidr.RLock()
+	calls = mock.calls.GetTransitGatewayLanCidr
+	mock.lockGetTransitGatewayLanCidr.RUnlock()
+	return calls
+}
+
+// GetTransitGatewayList calls GetTransitGatewayListFunc.
+func (mock *EdgeClientMock) GetTransitGatewayList(ctx context.Context) ([]Gateway, error) {
+	if mock.GetTransitGatewayListFunc == nil {
+		panic("EdgeClientMock.GetTransitGatewayListFunc: method is nil but EdgeClient.GetTransitGatewayList was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetTransitGatewayList.Lock()
+	mock.calls.GetTransitGatewayList = append(mock.calls.GetTransitGatewayList, callInfo)
+	mock.lockGetTransitGatewayList.Unlock()
+	return mock.GetTransitGatewayListFunc(ctx)
+}
+
+// GetTransitGatewayListCalls gets all the calls that were made to GetTransitGatewayList.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetTransitGatewayListCalls())
+func (mock *EdgeClientMock) GetTransitGatewayListCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetTransitGatewayList.RLock()
+	calls = mock.calls.GetTransitGatewayList
+	mock.lockGetTransitGatewayList.RUnlock()
+	return calls
+}
+
+// GetTunnelDetectionTime calls GetTunnelDetectionTimeFunc.
+func (mock *EdgeClientMock) GetTunnelDetectionTime(entity string) (int, error) {
+	if mock.GetTunnelDetectionTimeFunc == nil {
+		panic("EdgeClientMock.GetTunnelDetectionTimeFunc: method is nil but EdgeClient.GetTunnelDetectionTime was just called")
+	}
+	callInfo := struct {
+		Entity string
+	}{
+		Entity: entity,
+	}
+	mock.lockGetTunnelDetectionTime.Lock()
+	mock.calls.GetTunnelDetectionTime = append(mock.calls.GetTunnelDetectionTime, callInfo)
+	mock.lockGetTunnelDetectionTime.Unlock()
+	return mock.GetTunnelDetectionTimeFunc(entity)
+}
+
+// GetTunnelDetectionTimeCalls gets all the calls that were made to GetTunnelDetectionTime.
+// Check the length with:
+//
+//	len(mockedEdgeClient.GetTunnelDetectionTimeCalls())
+func (mock *EdgeClientMock) GetTunnelDetectionTimeCalls() []struct {
+	Entity string
+} {
+	var calls []struct {
+		Entity string
+	}
+	mock.lockGetTunnelDetectionTime.RLock()
+	calls = mock.calls.GetTunnelDetectionTime
+	mock.lockGetTunnelDetectionTime.RUnlock()
+	return calls
+}
+
+// IsTransitFireNetReadyToBeDisabled calls IsTransitFireNetReadyToBeDisabledFunc.
+func (mock *EdgeClientMock) IsTransitFireNetReadyToBeDisabled(gateway *Gateway) error {
+	if mock.IsTransitFireNetReadyToBeDisabledFunc == nil {
+		panic("EdgeClientMock.IsTransitFireNetReadyToBeDisabledFunc: method is nil but EdgeClient.IsTransitFireNetReadyToBeDisabled was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockIsTransitFireNetReadyToBeDisabled.Lock()
+	mock.calls.IsTransitFireNetReadyToBeDisabled = append(mock.calls.IsTransitFireNetReadyToBeDisabled, callInfo)
+	mock.lockIsTransitFireNetReadyToBeDisabled.Unlock()
+	return mock.IsTransitFireNetReadyToBeDisabledFunc(gateway)
+}
+
+// IsTransitFireNetReadyToBeDisabledCalls gets all the calls that were made to IsTransitFireNetReadyToBeDisabled.
+// Check the length with:
+//
+//	len(mockedEdgeClient.IsTransitFireNetReadyToBeDisabledCalls())
+func (mock *EdgeClientMock) IsTransitFireNetReadyToBeDisabledCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockIsTransitFireNetReadyToBeDisabled.RLock()
+	calls = mock.calls.IsTransitFireNetReadyToBeDisabled
+	mock.lockIsTransitFireNetReadyToBeDisabled.RUnlock()
+	return calls
+}
+
+// LaunchSpokeVpcContext calls LaunchSpokeVpcContextFunc.
+func (mock *EdgeClientMock) LaunchSpokeVpcContext(ctx context.Context, spoke *SpokeVpc) error {
+	if mock.LaunchSpokeVpcContextFunc == nil {
+		panic("EdgeClientMock.LaunchSpokeVpcContextFunc: method is nil but EdgeClient.LaunchSpokeVpcContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Spoke *SpokeVpc
+	}{
+		Ctx:   ctx,
+		Spoke: spoke,
+	}
+	mock.lockLaunchSpokeVpcContext.Lock()
+	mock.calls.LaunchSpokeVpcContext = append(mock.calls.LaunchSpokeVpcContext, callInfo)
+	mock.lockLaunchSpokeVpcContext.Unlock()
+	return mock.LaunchSpokeVpcContextFunc(ctx, spoke)
+}
+
+// LaunchSpokeVpcContextCalls gets all the calls that were made to LaunchSpokeVpcContext.
+// Check the length with:
+//
+//	len(mockedEdgeClient.LaunchSpokeVpcContextCalls())
+func (mock *EdgeClientMock) LaunchSpokeVpcContextCalls() []struct {
+	Ctx   context.Context
+	Spoke *SpokeVpc
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Spoke *SpokeVpc
+	}
+	mock.lockLaunchSpokeVpcContext.RLock()
+	calls = mock.calls.LaunchSpokeVpcContext
+	mock.lockLaunchSpokeVpcContext.RUnlock()
+	return calls
+}
+
+// LaunchTransitVpcContext calls LaunchTransitVpcContextFunc.
+func (mock *EdgeClientMock) LaunchTransitVpcContext(ctx context.Context, gateway *TransitVpc) error {
+	if mock.LaunchTransitVpcContextFunc == nil {
+		panic("EdgeClientMock.LaunchTransitVpcContextFunc: method is nil but EdgeClient.LaunchTransitVpcContext was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Gateway *TransitVpc
+	}{
+		Ctx:     ctx,
+		Gateway: gateway,
+	}
+	mock.lockLaunchTransitVpcContext.Lock()
+	mock.calls.LaunchTransitVpcContext = append(mock.calls.LaunchTransitVpcContext, callInfo)
+	mock.lockLaunchTransitVpcContext.Unlock()
+	return mock.LaunchTransitVpcContextFunc(ctx, gateway)
+}
+
+// LaunchTransitVpcContextCalls gets all the calls that were made to LaunchTransitVpcContext.
+// Check the length with:
+//
+//	len(mockedEdgeClient.LaunchTransitVpcContextCalls())
+func (mock *EdgeClientMock) LaunchTransitVpcContextCalls() []struct {
+	Ctx     context.Context
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Gateway *TransitVpc
+	}
+	mock.lockLaunchTransitVpcContext.RLock()
+	calls = mock.calls.LaunchTransitVpcContext
+	mock.lockLaunchTransitVpcContext.RUnlock()
+	return calls
+}
+
+// ModifySplitTunnel calls ModifySplitTunnelFunc.
+func (mock *EdgeClientMock) ModifySplitTunnel(splitTunnel *SplitTunnel) error {
+	if mock.ModifySplitTunnelFunc == nil {
+		panic("EdgeClientMock.ModifySplitTunnelFunc: method is nil but EdgeClient.ModifySplitTunnel was just called")
+	}
+	callInfo := struct {
+		SplitTunnel *SplitTunnel
+	}{
+		SplitTunnel: splitTunnel,
+	}
+	mock.lockModifySplitTunnel.Lock()
+	mock.calls.ModifySplitTunnel = append(mock.calls.ModifySplitTunnel, callInfo)
+	mock.lockModifySplitTunnel.Unlock()
+	return mock.ModifySplitTunnelFunc(splitTunnel)
+}
+
+// ModifySplitTunnelCalls gets all the calls that were made to ModifySplitTunnel.
+// Check the length with:
+//
+//	len(mockedEdgeClient.ModifySplitTunnelCalls())
+func (mock *EdgeClientMock) ModifySplitTunnelCalls() []struct {
+	SplitTunnel *SplitTunnel
+} {
+	var calls []struct {
+		SplitTunnel *SplitTunnel
+	}
+	mock.lockModifySplitTunnel.RLock()
+	calls = mock.calls.ModifySplitTunnel
+	mock.lockModifySplitTunnel.RUnlock()
+	return calls
+}
+
+// ModifyTunnelDetectionTime calls ModifyTunnelDetectionTimeFunc.
+func (mock *EdgeClientMock) ModifyTunnelDetectionTime(entity string, detectionTime int) error {
+	if mock.ModifyTunnelDetectionTimeFunc == nil {
+		panic("EdgeClientMock.ModifyTunnelDetectionTimeFunc: method is nil but EdgeClient.ModifyTunnelDetectionTime was just called")
+	}
+	callInfo := struct {
+		Entity        string
+		DetectionTime int
+	}{
+		Entity:        entity,
+		DetectionTime: detectionTime,
+	}
+	mock.lockModifyTunnelDetectionTime.Lock()
+	mock.calls.ModifyTunnelDetectionTime = append(mock.calls.ModifyTunnelDetectionTime, callInfo)
+	mock.lockModifyTunnelDetectionTime.Unlock()
+	return mock.ModifyTunnelDetectionTimeFunc(entity, detectionTime)
+}
+
+// ModifyTunnelDetectionTimeCalls gets all the calls that were made to ModifyTunnelDetectionTime.
+// Check the length with:
+//
+//	len(mockedEdgeClient.ModifyTunnelDetectionTimeCalls())
+func (mock *EdgeClientMock) ModifyTunnelDetectionTimeCalls() []struct {
+	Entity        string
+	DetectionTime int
+} {
+	var calls []struct {
+		Entity        string
+		DetectionTime int
+	}
+	mock.lockModifyTunnelDetectionTime.RLock()
+	calls = mock.calls.ModifyTunnelDetectionTime
+	mock.lockModifyTunnelDetectionTime.RUnlock()
+	return calls
+}
+
+// OnboardEdgeNEODevice calls OnboardEdgeNEODeviceFunc.
+func (mock *EdgeClientMock) OnboardEdgeNEODevice(ctx context.Context, edgeNEODevice *EdgeNEODevice) error {
+	if mock.OnboardEdgeNEODeviceFunc == nil {
+		panic("EdgeClientMock.OnboardEdgeNEODeviceFunc: method is nil but EdgeClient.OnboardEdgeNEODevice was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		EdgeNEODevice *EdgeNEODevice
+	}{
+		Ctx:           ctx,
+		EdgeNEODevice: edgeNEODevice,
+	}
+	mock.lockOnboardEdgeNEODevice.Lock()
+	mock.calls.OnboardEdgeNEODevice = append(mock.calls.OnboardEdgeNEODevice, callInfo)
+	mock.lockOnboardEdgeNEODevice.Unlock()
+	return mock.OnboardEdgeNEODeviceFunc(ctx, edgeNEODevice)
+}
+
+// OnboardEdgeNEODeviceCalls gets all the calls that were made to OnboardEdgeNEODevice.
+// Check the length with:
+//
+//	len(mockedEdgeClient.OnboardEdgeNEODeviceCalls())
+func (mock *EdgeClientMock) OnboardEdgeNEODeviceCalls() []struct {
+	Ctx           context.Context
+	EdgeNEODevice *EdgeNEODevice
+} {
+	var calls []struct {
+		Ctx           context.Context
+		EdgeNEODevice *EdgeNEODevice
+	}
+	mock.lockOnboardEdgeNEODevice.RLock()
+	calls = mock.calls.OnboardEdgeNEODevice
+	mock.lockOnboardEdgeNEODevice.RUnlock()
+	return calls
+}
+
+// PostAPI calls PostAPIFunc.
+func (mock *EdgeClientMock) PostAPI(action string, d interface{}, checkFunc CheckAPIResponseFunc) error {
+	if mock.PostAPIFunc == nil {
+		panic("EdgeClientMock.PostAPIFunc: method is nil but EdgeClient.PostAPI was just called")
+	}
+	callInfo := struct {
+		Action    string
+		D         interface{}
+		CheckFunc CheckAPIResponseFunc
+	}{
+		Action:    action,
+		D:         d,
+		CheckFunc: checkFunc,
+	}
+	mock.lockPostAPI.Lock()
+	mock.calls.PostAPI = append(mock.calls.PostAPI, callInfo)
+	mock.lockPostAPI.Unlock()
+	return mock.PostAPIFunc(action, d, checkFunc)
+}
+
+// PostAPICalls gets all the calls that were made to PostAPI.
+// Check the length with:
+//
+//	len(mockedEdgeClient.PostAPICalls())
+func (mock *EdgeClientMock) PostAPICalls() []struct {
+	Action    string
+	D         interface{}
+	CheckFunc CheckAPIResponseFunc
+} {
+	var calls []struct {
+		Action    string
+		D         interface{}
+		CheckFunc CheckAPIResponseFunc
+	}
+	mock.lockPostAPI.RLock()
+	calls = mock.calls.PostAPI
+	mock.lockPostAPI.RUnlock()
+	return calls
+}
+
+// SetBgpBfdPollingTime calls SetBgpBfdPollingTimeFunc.
+func (mock *EdgeClientMock) SetBgpBfdPollingTime(transitGateway *TransitVpc, newPollingTime int) error {
+	if mock.SetBgpBfdPollingTimeFunc == nil {
+		panic("EdgeClientMock.SetBgpBfdPollingTimeFunc: method is nil but EdgeClient.SetBgpBfdPollingTime was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+		NewPollingTime int
+	}{
+		TransitGateway: transitGateway,
+		NewPollingTime: newPollingTime,
+	}
+	mock.lockSetBgpBfdPollingTime.Lock()
+	mock.calls.SetBgpBfdPollingTime = append(mock.calls.SetBgpBfdPollingTime, callInfo)
+	mock.lockSetBgpBfdPollingTime.Unlock()
+	return mock.SetBgpBfdPollingTimeFunc(transitGateway, newPollingTime)
+}
+
+// SetBgpBfdPollingTimeCalls gets all the calls that were made to SetBgpBfdPollingTime.
+// Check the length with:
+//
+//	len(mockedEdgeClient.SetBgpBfdPollingTimeCalls())
+func (mock *EdgeClientMock) SetBgpBfdPollingTimeCalls() []struct {
+	TransitGateway *TransitVpc
+	NewPollingTime int
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+		NewPollingTime int
+	}
+	mock.lockSetBgpBfdPollingTime.RLock()
+	calls = mock.calls.SetBgpBfdPollingTime
+	mock.lockSetBgpBfdPollingTime.RUnlock()
+	return calls
+}
+
+// SetBgpBfdPollingTimeGatewayGroup calls SetBgpBfdPollingTimeGatewayGroupFunc.
+func (mock *EdgeClientMock) SetBgpBfdPollingTimeGatewayGroup(ctx context.Context, groupName string, pollingTime int) error {
+	if mock.SetBgpBfdPollingTimeGatewayGroupFunc == nil {
+		panic("EdgeClientMock.SetBgpBfdPollingTimeGatewayGroupFunc: method is nil but EdgeClient.SetBgpBfdPollingTimeGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		GroupName   string
+		PollingTime int
+	}{
+		Ctx:         ctx,
+		GroupName:   groupName,
+		PollingTime: pollingTime,
+	}
+	mock.lockSetBgpBfdPollingTimeGatewayGroup.Lock()
+	mock.calls.SetBgpBfdPollingTimeGatewayGroup = append(mock.calls.SetBgpBfdPollingTimeGatewayGroup, callInfo)
+	mock.lockSetBgpBfdPollingTimeGatewayGroup.Unlock()
+	return mock.SetBgpBfdPollingTimeGatewayGroupFunc(ctx, groupName, pollingTime)
+}
+
+// SetBgpBfdPollingTimeGatewayGroupCalls gets all the calls that were made to SetBgpBfdPollingTimeGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.SetBgpBfdPollingTimeGatewayGroupCalls())
+func (mock *EdgeClientMock) SetBgpBfdPollingTimeGatewayGroupCalls() []struct {
+	Ctx         context.Context
+	GroupName   string
+	PollingTime int
+} {
+	var calls []struct {
+		Ctx         context.Context
+		GroupName   string
+		PollingTime int
+	}
+	mock.lockSetBgpBfdPollingTimeGatewayGroup.RLock()
+	calls = mock.calls.SetBgpBfdPollingTimeGatewayGroup
+	mock.lockSetBgpBfdPollingTimeGatewayGroup.RUnlock()
+	return calls
+}
+
+// SetBgpBfdPollingTimeSpoke calls SetBgpBfdPollingTimeSpokeFunc.
+func (mock *EdgeClientMock) SetBgpBfdPollingTimeSpoke(spokeGateway *SpokeVpc, newPollingTime int) error {
+	if mock.SetBgpBfdPollingTimeSpokeFunc == nil {
+		panic("EdgeClientMock.SetBgpBfdPollingTimeSpokeFunc: method is nil but EdgeClient.SetBgpBfdPollingTimeSpoke was just called")
+	}
+	callInfo := struct {
+		SpokeGateway   *SpokeVpc
+		NewPollingTime int
+	}{
+		SpokeGateway:   spokeGateway,
+		NewPollingTime: newPollingTime,
+	}
+	mock.lockSetBgpBfdPollingTimeSpoke.Lock()
+	mock.calls.SetBgpBfdPollingTimeSpoke = append(mock.calls.SetBgpBfdPollingTimeSpoke, callInfo)
+	mock.lockSetBgpBfdPollingTimeSpoke.Unlock()
+	return mock.SetBgpBfdPollingTimeSpokeFunc(spokeGateway, newPollingTime)
+}
+
+// SetBgpBfdPollingTimeSpokeCalls gets all the calls that were made to SetBgpBfdPollingTimeSpoke.
+// Check the length with:
+//
+//	len(mockedEdgeClient.SetBgpBfdPollingTimeSpokeCalls())
+func (mock *EdgeClientMock) SetBgpBfdPollingTimeSpokeCalls() []struct {
+	SpokeGateway   *SpokeVpc
+	NewPollingTime int
+} {
+	var calls []struct {
+		SpokeGateway   *SpokeVpc
+		NewPollingTime int
+	}
+	mock.lockSetBgpBfdPollingTimeSpoke.RLock()
+	calls = mock.calls.SetBgpBfdPollingTimeSpoke
+	mock.lockSetBgpBfdPollingTimeSpoke.RUnlock()
+	return calls
+}
+
+// SetBgpEcmp calls SetBgpEcmpFunc.
+func (mock *EdgeClientMock) SetBgpEcmp(transitGateway *TransitVpc, enabled bool) error {
+	if mock.SetBgpEcmpFunc == nil {
+		panic("EdgeClientMock.SetBgpEcmpFunc: method is nil but EdgeClient.SetBgpEcmp was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+		Enabled        bool
+	}{
+		TransitGateway: transitGateway,
+		Enabled:        enabled,
+	}
+	mock.lockSetBgpEcmp.Lock()
+	mock.calls.SetBgpEcmp = append(mock.calls.SetBgpEcmp, callInfo)
+	mock.lockSetBgpEcmp.Unlock()
+	return mock.SetBgpEcmpFunc(transitGateway, enabled)
+}
+
+// SetBgpEcmpCalls gets all the calls that were made to SetBgpEcmp.
+// Check the length with:
+//
+//	len(mockedEdgeClient.SetBgpEcmpCalls())
+func (mock *EdgeClientMock) SetBgpEcmpCalls() []struct {
+	TransitGateway *TransitVpc
+	Enabled        bool
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+		Enabled        bool
+	}
+	mock.lockSetBgpEcmp.RLock()
+	calls = mock.calls.SetBgpEcmp
+	mock.lockSetBgpEcmp.RUnlock()
+	return calls
+}
+
+// SetBgpEcmpGatewayGroup calls SetBgpEcmpGatewayGroupFunc.
+func (mock *EdgeClientMock) SetBgpEcmpGatewayGroup(ctx context.Context, groupName string, enable bool) error {
+	if mock.SetBgpEcmpGatewayGroupFunc == nil {
+		panic("EdgeClientMock.SetBgpEcmpGatewayGroupFunc: method is nil but EdgeClient.SetBgpEcmpGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+		Enable    bool
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+		Enable:    enable,
+	}
+	mock.lockSetBgpEcmpGatewayGroup.Lock()
+	mock.calls.SetBgpEcmpGatewayGroup = append(mock.calls.SetBgpEcmpGatewayGroup, callInfo)
+	mock.lockSetBgpEcmpGatewayGroup.Unlock()
+	return mock.SetBgpEcmpGatewayGroupFunc(ctx, groupName, enable)
+}
+
+// SetBgpEcmpGatewayGroupCalls gets all the calls that were made to SetBgpEcmpGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.SetBgpEcmpGatewayGroupCalls())
+func (mock *EdgeClientMock) SetBgpEcmpGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+	Enable    bool
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+		Enable    bool
+	}
+	mock.lockSetBgpEcmpGatewayGroup.RLock()
+	calls = mock.calls.SetBgpEcmpGatewayGroup
+	mock.lockSetBgpEcmpGatewayGroup.RUnlock()
+	return calls
+}
+
+// SetBgpEcmpSpoke calls SetBgpEcmpSpokeFunc.
+func (mock *EdgeClientMock) SetBgpEcmpSpoke(spokeGateway *SpokeVpc, enabled bool) error {
+	if mock.SetBgpEcmpSpokeFunc == nil {
+		panic("EdgeClientMock.SetBgpEcmpSpokeFunc: method is nil but EdgeClient.SetBgpEcmpSpoke was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+		Enabled      bool
+	}{
+		SpokeGateway: spokeGateway,
+		Enabled:      enabled,
+	}
+	mock.lockSetBgpEcmpSpoke.Lock()
+	mock.calls.SetBgpEcmpSpoke = append(mock.calls.SetBgpEcmpSpoke, callInfo)
+	mock.lockSetBgpEcmpSpoke.Unlock()
+	return mock.SetBgpEcmpSpokeFunc(spokeGateway, enabled)
+}
+
+// SetBgpEcmpSpokeCalls gets all the calls that were made to SetBgpEcmpSpoke.
+// Check the length with:
+//
+//	len(mockedEdgeClient.SetBgpEcmpSpokeCalls())
+func (mock *EdgeClientMock) SetBgpEcmpSpokeCalls() []struct {
+	SpokeGateway *SpokeVpc
+	Enabled      bool
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+		Enabled      bool
+	}
+	mock.lockSetBgpEcmpSpoke.RLock()
+	calls = mock.calls.SetBgpEcmpSpoke
+	mock.lockSetBgpEcmpSpoke.RUnlock()
+	return calls
+}
+
+// SetBgpManualSpokeAdvertisedNetworks calls SetBgpManualSpokeAdvertisedNetworksFunc.
+func (mock *EdgeClientMock) SetBgpManualSpokeAdvertisedNetworks(transitGw *TransitVpc) error {
+	if mock.SetBgpManualSpokeAdvertisedNetworksFunc == nil {
+		panic("EdgeClientMock.SetBgpManualSpokeAdvertisedNetworksFunc: method is nil but EdgeClient.SetBgpManualSpokeAdvertisedNetworks was just called")
+	}
+	callInfo := struct {
+		TransitGw *TransitVpc
+	}{
+		TransitGw: transitGw,
+	}
+	mock.lockSetBgpManualSpokeAdvertisedNetworks.Lock()
+	mock.calls.SetBgpManualSpokeAdvertisedNetworks = append(mock.calls.SetBgpManualSpokeAdvertisedNetworks, callInfo)
+	mock.lockSetBgpManualSpokeAdvertisedNetworks.Unlock()
+	return mock.SetBgpManualSpokeAdvertisedNetworksFunc(transitGw)
+}
+
+// SetBgpManualSpokeAdvertisedNetworksCalls gets all the calls that were made to SetBgpManualSpokeAdvertisedNetworks.
+// Check the length with:
+//
+//	len(mockedEdgeClient.SetBgpManualSpokeAdvertisedNetworksCalls())
+func (mock *EdgeClientMock) SetBgpManualSpokeAdvertisedNetworksCalls() []struct {
+	TransitGw *TransitVpc
+} {
+	var calls []struct {
+		TransitGw *TransitVpc
+	}
+	mock.lockSetBgpManualSpokeAdvertisedNetworks.RLock()
+	calls = mock.calls.SetBgpManualSpokeAdvertisedNetworks
+	mock.lockSetBgpManualSpokeAdvertisedNetworks.RUnlock()
+	return calls
+}
+
+// SetBgpPollingTime calls SetBgpPollingTimeFunc.
+func (mock *EdgeClientMock) SetBgpPollingTime(transitGateway *TransitVpc, newPollingTime int) error {
+	if mock.SetBgpPollingTimeFunc == nil {
+		panic("EdgeClientMock.SetBgpPollingTimeFunc: method is nil but EdgeClient.SetBgpPollingTime was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+		NewPollingTime int
+	}{
+		TransitGateway: transitGateway,
+		NewPollingTime: newPollingTime,
+	}
+	mock.lockSetBgpPollingTime.Lock()
+	mock.calls.SetBgpPollingTime = append(mock.calls.SetBgpPollingTime, callInfo)
+	mock.lockSetBgpPollingTime.Unlock()
+	return mock.SetBgpPollingTimeFunc(transitGateway, newPollingTime)
+}
+
+// SetBgpPollingTimeCalls gets all the calls that were made to SetBgpPollingTime.
+// Check the length with:
+//
+//	len(mockedEdgeClient.SetBgpPollingTimeCalls())
+func (mock *EdgeClientMock) SetBgpPollingTimeCalls() []struct {
+	TransitGateway *TransitVpc
+	NewPollingTime int
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+		NewPollingTime int
+	}
+	mock.lockSetBgpPollingTime.RLock()
+	calls = mock.calls.SetBgpPollingTime
+	mock.lockSetBgpPollingTime.RUnlock()
+	return calls
+}
+
+// SetBgpPollingTimeGatewayGroup calls SetBgpPollingTimeGatewayGroupFunc.
+func (mock *EdgeClientMock) SetBgpPollingTimeGatewayGroup(ctx context.Context, groupName string, pollingTime int) error {
+	if mock.SetBgpPollingTimeGatewayGroupFunc == nil {
+		panic("EdgeClientMock.SetBgpPollingTimeGatewayGroupFunc: method is nil but EdgeClient.SetBgpPollingTimeGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		GroupName   string
+		PollingTime int
+	}{
+		Ctx:         ctx,
+		GroupName:   groupName,
+		PollingTime: pollingTime,
+	}
+	mock.lockSetBgpPollingTimeGatewayGroup.Lock()
+	mock.calls.SetBgpPollingTimeGatewayGroup = append(mock.calls.SetBgpPollingTimeGatewayGroup, callInfo)
+	mock.lockSetBgpPollingTimeGatewayGroup.Unlock()
+	return mock.SetBgpPollingTimeGatewayGroupFunc(ctx, groupName, pollingTime)
+}
+
+// SetBgpPollingTimeGatewayGroupCalls gets all the calls that were made to SetBgpPollingTimeGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.SetBgpPollingTimeGatewayGroupCalls())
+func (mock *EdgeClientMock) SetBgpPollingTimeGatewayGroupCalls() []struct {
+	Ctx         context.Context
+	GroupName   string
+	PollingTime int
+} {
+	var calls []struct {
+		Ctx         context.Context
+		GroupName   string
+		PollingTime int
+	}
+	mock.lockSetBgpPollingTimeGatewayGroup.RLock()
+	calls = mock.calls.SetBgpPollingTimeGatewayGroup
+	mock.lockSetBgpPollingTimeGatewayGroup.RUnlock()
+	return calls
+}
+
+// SetBgpPollingTimeSpoke calls SetBgpPollingTimeSpokeFunc.
+func (mock *EdgeClientMock) SetBgpPollingTimeSpoke(spokeGateway *SpokeVpc, newPollingTime int) error {
+	if mock.SetBgpPollingTimeSpokeFunc == nil {
+		panic("EdgeClientMock.SetBgpPollingTimeSpokeFunc: method is nil but EdgeClient.SetBgpPollingTimeSpoke was just called")
+	}
+	callInfo := struct {
+		SpokeGateway   *SpokeVpc
+		NewPollingTime int
+	}{
+		SpokeGateway:   spokeGateway,
+		NewPollingTime: newPollingTime,
+	}
+	mock.lockSetBgpPollingTimeSpoke.Lock()
+	mock.calls.SetBgpPollingTimeSpoke = append(mock.calls.SetBgpPollingTimeSpoke, callInfo)
+	mock.lockSetBgpPollingTimeSpoke.Unlock()
+	return mock.SetBgpPollingTimeSpokeFunc(spokeGateway, newPollingTime)
+}
+
+// SetBgpPollingTimeSpokeCalls gets all the calls that were made to SetBgpPollingTimeSpoke.
+// Check the length with:
+//
+//	len(mockedEdgeClient.SetBgpPollingTimeSpokeCalls())
+func (mock *EdgeClientMock) SetBgpPollingTimeSpokeCalls() []struct {
+	SpokeGateway   *SpokeVpc
+	NewPollingTime int
+} {
+	var calls []struct {
+		SpokeGateway   *SpokeVpc
+		NewPollingTime int
+	}
+	mock.lockSetBgpPollingTimeSpoke.RLock()
+	calls = mock.calls.SetBgpPollingTimeSpoke
+	mock.lockSetBgpPollingTimeSpoke.RUnlock()
+	return calls
+}
+
+// SetGatewayBgpCommunitiesAccept calls SetGatewayBgpCommunitiesAcceptFunc.
+func (mock *EdgeClientMock) SetGatewayBgpCommunitiesAccept(gwName string, acceptComm bool) error {
+	if mock.SetGatewayBgpCommunitiesAcceptFunc == nil {
+		panic("EdgeClientMock.SetGatewayBgpCommunitiesAcceptFunc: method is nil but EdgeClient.SetGatewayBgpCommunitiesAccept was just called")
+	}
+	callInfo := struct {
+		GwName     string
+		AcceptComm bool
+	}{
+		GwName:     gwName,
+		AcceptComm: acceptComm,
+	}
+	mock.lockSetGatewayBgpCommunitiesAccept.Lock()
+	mock.calls.SetGatewayBgpCommunitiesAccept = append(mock.calls.SetGatewayBgpCommunitiesAccept, callInfo)
+	mock.lockSetGatewayBgpCommunitiesAccept.Unlock()
+	return mock.SetGatewayBgpCommunitiesAcceptFunc(gwName, acceptComm)
+}
+
+// SetGatewayBgpCommunitiesAcceptCalls gets all the calls that were made to SetGatewayBgpCommunitiesAccept.
+// Check the length with:
+//
+//	len(mockedEdgeClient.SetGatewayBgpCommunitiesAcceptCalls())
+func (mock *EdgeClientMock) SetGatewayBgpCommunitiesAcceptCalls() []struct {
+	GwName     string
+	AcceptComm bool
+} {
+	var calls []struct {
+		GwName     string
+		AcceptComm bool
+	}
+	mock.lockSetGatewayBgpCommunitiesAccept.RLock()
+	calls = mock.calls.SetGatewayBgpCommunitiesAccept
+	mock.lockSetGatewayBgpCommunitiesAccept.RUnlock()
+	return calls
+}
+
+// SetGatewayBgpCommunitiesSend calls SetGatewayBgpCommunitiesSendFunc.
+func (mock *EdgeClientMock) SetGatewayBgpCommunitiesSend(gwName string, sendComm bool) error {
+	if mock.SetGatewayBgpCommunitiesSendFunc == nil {
+		panic("EdgeClientMock.SetGatewayBgpCommunitiesSendFunc: method is nil but EdgeClient.SetGatewayBgpCommunitiesSend was just called")
+	}
+	callInfo := struct {
+		GwName   string
+		SendComm bool
+	}{
+		GwName:   gwName,
+		SendComm: sendComm,
+	}
+	mock.lockSetGatewayBgpCommunitiesSend.Lock()
+	mock.calls.SetGatewayBgpCommunitiesSend = append(mock.calls.SetGatewayBgpCommunitiesSend, callInfo)
+	mock.lockSetGatewayBgpCommunitiesSend.Unlock()
+	return mock.SetGatewayBgpCommunitiesSendFunc(gwName, sendComm)
+}
+
+// SetGatewayBgpCommunitiesSendCalls gets all the calls that were made to SetGatewayBgpCommunitiesSend.
+// Check the length with:
+//
+//	len(mockedEdgeClient.SetGatewayBgpCommunitiesSendCalls())
+func (mock *EdgeClientMock) SetGatewayBgpCommunitiesSendCalls() []struct {
+	GwName   string
+	SendComm bool
+} {
+	var calls []struct {
+		GwName   string
+		SendComm bool
+	}
+	mock.lockSetGatewayBgpCommunitiesSend.RLock()
+	calls = mock.calls.SetGatewayBgpCommunitiesSend
+	mock.lockSetGatewayBgpCommunitiesSend.RUnlock()
+	return calls
+}
+
+// SetGatewayBgpMedToSdnMetric calls SetGatewayBgpMedToSdnMetricFunc.
+func (mock *EdgeClientMock) SetGatewayBgpMedToSdnMetric(gwName string, override bool) error {
+	if mock.SetGatewayBgpMedToSdnMetricFunc == nil {
+		panic("EdgeClientMock.SetGatewayBgpMedToSdnMetricFunc: method is nil but EdgeClient.SetGatewayBgpMedToSdnMetric was just called")
+	}
+	callInfo := struct {
+		GwName   string
+		Override bool
+	}{
+		GwName:   gwName,
+		Override: override,
+	}
+	mock.lockSetGatewayBgpMedToSdnMetric.Lock()
+	mock.calls.SetGatewayBgpMedToSdnMetric = append(mock.calls.SetGatewayBgpMedToSdnMetric, callInfo)
+	mock.lockSetGatewayBgpMedToSdnMetric.Unlock()
+	return mock.SetGatewayBgpMedToSdnMetricFunc(gwName, override)
+}
+
+// SetGatewayBgpMedToSdnMetricCalls gets all the calls that were made to SetGatewayBgpMedToSdnMetric.
+// Check the length with:
+//
+//	len(mockedEdgeClient.SetGatewayBgpMedToSdnMetricCalls())
+func (mock *EdgeClientMock) SetGatewayBgpMedToSdnMetricCalls() []struct {
+	GwName   string
+	Override bool
+} {
+	var calls []struct {
+		GwName   string
+		Override bool
+	}
+	mock.lockSetGatewayBgpMedToSdnMetric.RLock()
+	calls = mock.calls.SetGatewayBgpMedToSdnMetric
+	mock.lockSetGatewayBgpMedToSdnMetric.RUnlock()
+	return calls
+}
+
+// SetGatewayGroupBgpCommunitiesAccept calls SetGatewayGroupBgpCommunitiesAcceptFunc.
+func (mock *EdgeClientMock) SetGatewayGroupBgpCommunitiesAccept(ctx context.Context, groupName string, accept bool) error {
+	if mock.SetGatewayGroupBgpCommunitiesAcceptFunc == nil {
+		panic("EdgeClientMock.SetGatewayGroupBgpCommunitiesAcceptFunc: method is nil but EdgeClient.SetGatewayGroupBgpCommunitiesAccept was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+		Accept    bool
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+		Accept:    accept,
+	}
+	mock.lockSetGatewayGroupBgpCommunitiesAccept.Lock()
+	mock.calls.SetGatewayGroupBgpCommunitiesAccept = append(mock.calls.SetGatewayGroupBgpCommunitiesAccept, callInfo)
+	mock.lockSetGatewayGroupBgpCommunitiesAccept.Unlock()
+	return mock.SetGatewayGroupBgpCommunitiesAcceptFunc(ctx, groupName, accept)
+}
+
+// SetGatewayGroupBgpCommunitiesAcceptCalls gets all the calls that were made to SetGatewayGroupBgpCommunitiesAccept.
+// Check the length with:
+//
+//	len(mockedEdgeClient.SetGatewayGroupBgpCommunitiesAcceptCalls())
+func (mock *EdgeClientMock) SetGatewayGroupBgpCommunitiesAcceptCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+	Accept    bool
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+		Accept    bool
+	}
+	mock.lockSetGatewayGroupBgpCommunitiesAccept.RLock()
+	calls = mock.calls.SetGatewayGroupBgpCommunitiesAccept
+	mock.lockSetGatewayGroupBgpCommunitiesAccept.RUnlock()
+	return calls
+}
+
+// SetGatewayGroupBgpCommunitiesSend calls SetGatewayGroupBgpCommunitiesSendFunc.
+func (mock *EdgeClientMock) SetGatewayGroupBgpCommunitiesSend(ctx context.Context, groupName string, send bool) error {
+	if mock.SetGatewayGroupBgpCommunitiesSendFunc == nil {
+		panic("EdgeClientMock.SetGatewayGroupBgpCommunitiesSendFunc: method is nil but EdgeClient.SetGatewayGroupBgpCommunitiesSend was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+		Send      bool
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+		Send:      send,
+	}
+	mock.lockSetGatewayGroupBgpCommunitiesSend.Lock()
+	mock.calls.SetGatewayGroupBgpCommunitiesSend = append(mock.calls.SetGatewayGroupBgpCommunitiesSend, callInfo)
+	mock.lockSetGatewayGroupBgpCommunitiesSend.Unlock()
+	return mock.SetGatewayGroupBgpCommunitiesSendFunc(ctx, groupName, send)
+}
+
+// SetGatewayGroupBgpCommunitiesSendCalls gets all the calls that were made to SetGatewayGroupBgpCommunitiesSend.
+// Check the length with:
+//
+//	len(mockedEdgeClient.SetGatewayGroupBgpCommunitiesSendCalls())
+func (mock *EdgeClientMock) SetGatewayGroupBgpCommunitiesSendCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+	Send      bool
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+		Send      bool
+	}
+	mock.lockSetGatewayGroupBgpCommunitiesSend.RLock()
+	calls = mock.calls.SetGatewayGroupBgpCommunitiesSend
+	mock.lockSetGatewayGroupBgpCommunitiesSend.RUnlock()
+	return calls
+}
+
+// SetGatewayPhase2Policy calls SetGatewayPhase2PolicyFunc.
+func (mock *EdgeClientMock) SetGatewayPhase2Policy(gwName string, encPolicy string, pfsPolicy string) error {
+	if mock.SetGatewayPhase2PolicyFunc == nil {
+		panic("EdgeClientMock.SetGatewayPhase2PolicyFunc: method is nil but EdgeClient.SetGatewayPhase2Policy was just called")
+	}
+	callInfo := struct {
+		GwName    string
+		EncPolicy string
+		PfsPolicy string
+	}{
+		GwName:    gwName,
+		EncPolicy: encPolicy,
+		PfsPolicy: pfsPolicy,
+	}
+	mock.lockSetGatewayPhase2Policy.Lock()
+	mock.calls.SetGatewayPhase2Policy = append(mock.calls.SetGatewayPhase2Policy, callInfo)
+	mock.lockSetGatewayPhase2Policy.Unlock()
+	return mock.SetGatewayPhase2PolicyFunc(gwName, encPolicy, pfsPolicy)
+}
+
+// SetGatewayPhase2PolicyCalls gets all the calls that were made to SetGatewayPhase2Policy.
+// Check the length with:
+//
+//	len(mockedEdgeClient.SetGatewayPhase2PolicyCalls())
+func (mock *EdgeClientMock) SetGatewayPhase2PolicyCalls() []struct {
+	GwName    string
+	EncPolicy string
+	PfsPolicy string
+} {
+	var calls []struct {
+		GwName    string
+		EncPolicy string
+		PfsPolicy string
+	}
+	mock.lockSetGatewayPhase2Policy.RLock()
+	calls = mock.calls.SetGatewayPhase2Policy
+	mock.lockSetGatewayPhase2Policy.RUnlock()
+	return calls
+}
+
+// SetLocalASNumber calls SetLocalASNumberFunc.
+func (mock *EdgeClientMock) SetLocalASNumber(transitGateway *TransitVpc, localASNumber string) error {
+	if mock.SetLocalASNumberFunc == nil {
+		panic("EdgeClientMock.SetLocalASNumberFunc: method is nil but EdgeClient.SetLocalASNumber was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+		LocalASNumber  string
+	}{
+		TransitGateway: transitGateway,
+		LocalASNumber:  localASNumber,
+	}
+	mock.lockSetLocalASNumber.Lock()
+	mock.calls.SetLocalASNumber = append(mock.calls.SetLocalASNumber, callInfo)
+	mock.lockSetLocalASNumber.Unlock()
+	return mock.SetLocalASNumberFunc(transitGateway, localASNumber)
+}
+
+// SetLocalASNumberCalls gets all the calls that were made to SetLocalASNumber.
+// Check the length with:
+//
+//	len(mockedEdgeClient.SetLocalASNumberCalls())
+func (mock *EdgeClientMock) SetLocalASNumberCalls() []struct {
+	TransitGateway *TransitVpc
+	LocalASNumber  string
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+		LocalASNumber  string
+	}
+	mock.lockSetLocalASNumber.RLock()
+	calls = mock.calls.SetLocalASNumber
+	mock.lockSetLocalASNumber.RUnlock()
+	return calls
+}
+
+// SetLocalASNumberGatewayGroup calls SetLocalASNumberGatewayGroupFunc.
+func (mock *EdgeClientMock) SetLocalASNumberGatewayGroup(ctx context.Context, groupName string, localAsNumber string) error {
+	if mock.SetLocalASNumberGatewayGroupFunc == nil {
+		panic("EdgeClientMock.SetLocalASNumberGatewayGroupFunc: method is nil but EdgeClient.SetLocalASNumberGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		GroupName     string
+		LocalAsNumber string
+	}{
+		Ctx:           ctx,
+		GroupName:     groupName,
+		LocalAsNumber: localAsNumber,
+	}
+	mock.lockSetLocalASNumberGatewayGroup.Lock()
+	mock.calls.SetLocalASNumberGatewayGroup = append(mock.calls.SetLocalASNumberGatewayGroup, callInfo)
+	mock.lockSetLocalASNumberGatewayGroup.Unlock()
+	return mock.SetLocalASNumberGatewayGroupFunc(ctx, groupName, localAsNumber)
+}
+
+// SetLocalASNumberGatewayGroupCalls gets all the calls that were made to SetLocalASNumberGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.SetLocalASNumberGatewayGroupCalls())
+func (mock *EdgeClientMock) SetLocalASNumberGatewayGroupCalls() []struct {
+	Ctx           context.Context
+	GroupName     string
+	LocalAsNumber string
+} {
+	var calls []struct {
+		Ctx           context.Context
+		GroupName     string
+		LocalAsNumber string
+	}
+	mock.lockSetLocalASNumberGatewayGroup.RLock()
+	calls = mock.calls.SetLocalASNumberGatewayGroup
+	mock.lockSetLocalASNumberGatewayGroup.RUnlock()
+	return calls
+}
+
+// SetLocalASNumberSpoke calls SetLocalASNumberSpokeFunc.
+func (mock *EdgeClientMock) SetLocalASNumberSpoke(spokeGateway *SpokeVpc, localASNumber string) error {
+	if mock.SetLocalASNumberSpokeFunc == nil {
+		panic("EdgeClientMock.SetLocalASNumberSpokeFunc: method is nil but EdgeClient.SetLocalASNumberSpoke was just called")
+	}
+	callInfo := struct {
+		SpokeGateway  *SpokeVpc
+		LocalASNumber string
+	}{
+		SpokeGateway:  spokeGateway,
+		LocalASNumber: localASNumber,
+	}
+	mock.lockSetLocalASNumberSpoke.Lock()
+	mock.calls.SetLocalASNumberSpoke = append(mock.calls.SetLocalASNumberSpoke, callInfo)
+	mock.lockSetLocalASNumberSpoke.Unlock()
+	return mock.SetLocalASNumberSpokeFunc(spokeGateway, localASNumber)
+}
+
+// SetLocalASNumberSpokeCalls gets all the calls that were made to SetLocalASNumberSpoke.
+// Check the length with:
+//
+//	len(mockedEdgeClient.SetLocalASNumberSpokeCalls())
+func (mock *EdgeClientMock) SetLocalASNumberSpokeCalls() []struct {
+	SpokeGateway  *SpokeVpc
+	LocalASNumber string
+} {
+	var calls []struct {
+		SpokeGateway  *SpokeVpc
+		LocalASNumber string
+	}
+	mock.lockSetLocalASNumberSpoke.RLock()
+	calls = mock.calls.SetLocalASNumberSpoke
+	mock.lockSetLocalASNumberSpoke.RUnlock()
+	return calls
+}
+
+// SetPrependASPath calls SetPrependASPathFunc.
+func (mock *EdgeClientMock) SetPrependASPath(transitGateway *TransitVpc, prependASPath []string) error {
+	if mock.SetPrependASPathFunc == nil {
+		panic("EdgeClientMock.SetPrependASPathFunc: method is nil but EdgeClient.SetPrependASPath was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+		PrependASPath  []string
+	}{
+		TransitGateway: transitGateway,
+		PrependASPath:  prependASPath,
+	}
+	mock.lockSetPrependASPath.Lock()
+	mock.calls.SetPrependASPath = append(mock.calls.SetPrependASPath, callInfo)
+	mock.lockSetPrependASPath.Unlock()
+	return mock.SetPrependASPathFunc(transitGateway, prependASPath)
+}
+
+// SetPrependASPathCalls gets all the calls that were made to SetPrependASPath.
+// Check the length with:
+//
+//	len(mockedEdgeClient.SetPrependASPathCalls())
+func (mock *EdgeClientMock) SetPrependASPathCalls() []struct {
+	TransitGateway *TransitVpc
+	PrependASPath  []string
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+		PrependASPath  []string
+	}
+	mock.lockSetPrependASPath.RLock()
+	calls = mock.calls.SetPrependASPath
+	mock.lockSetPrependASPath.RUnlock()
+	return calls
+}
+
+// SetPrependASPathGatewayGroup calls SetPrependASPathGatewayGroupFunc.
+func (mock *EdgeClientMock) SetPrependASPathGatewayGroup(ctx context.Context, groupName string, prependAsPath []string) error {
+	if mock.SetPrependASPathGatewayGroupFunc == nil {
+		panic("EdgeClientMock.SetPrependASPathGatewayGroupFunc: method is nil but EdgeClient.SetPrependASPathGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		GroupName     string
+		PrependAsPath []string
+	}{
+		Ctx:           ctx,
+		GroupName:     groupName,
+		PrependAsPath: prependAsPath,
+	}
+	mock.lockSetPrependASPathGatewayGroup.Lock()
+	mock.calls.SetPrependASPathGatewayGroup = append(mock.calls.SetPrependASPathGatewayGroup, callInfo)
+	mock.lockSetPrependASPathGatewayGroup.Unlock()
+	return mock.SetPrependASPathGatewayGroupFunc(ctx, groupName, prependAsPath)
+}
+
+// SetPrependASPathGatewayGroupCalls gets all the calls that were made to SetPrependASPathGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.SetPrependASPathGatewayGroupCalls())
+func (mock *EdgeClientMock) SetPrependASPathGatewayGroupCalls() []struct {
+	Ctx           context.Context
+	GroupName     string
+	PrependAsPath []string
+} {
+	var calls []struct {
+		Ctx           context.Context
+		GroupName     string
+		PrependAsPath []string
+	}
+	mock.lockSetPrependASPathGatewayGroup.RLock()
+	calls = mock.calls.SetPrependASPathGatewayGroup
+	mock.lockSetPrependASPathGatewayGroup.RUnlock()
+	return calls
+}
+
+// SetPrependASPathSpoke calls SetPrependASPathSpokeFunc.
+func (mock *EdgeClientMock) SetPrependASPathSpoke(spokeGateway *SpokeVpc, prependASPath []string) error {
+	if mock.SetPrependASPathSpokeFunc == nil {
+		panic("EdgeClientMock.SetPrependASPathSpokeFunc: method is nil but EdgeClient.SetPrependASPathSpoke was just called")
+	}
+	callInfo := struct {
+		SpokeGateway  *SpokeVpc
+		PrependASPath []string
+	}{
+		SpokeGateway:  spokeGateway,
+		PrependASPath: prependASPath,
+	}
+	mock.lockSetPrependASPathSpoke.Lock()
+	mock.calls.SetPrependASPathSpoke = append(mock.calls.SetPrependASPathSpoke, callInfo)
+	mock.lockSetPrependASPathSpoke.Unlock()
+	return mock.SetPrependASPathSpokeFunc(spokeGateway, prependASPath)
+}
+
+// SetPrependASPathSpokeCalls gets all the calls that were made to SetPrependASPathSpoke.
+// Check the length with:
+//
+//	len(mockedEdgeClient.SetPrependASPathSpokeCalls())
+func (mock *EdgeClientMock) SetPrependASPathSpokeCalls() []struct {
+	SpokeGateway  *SpokeVpc
+	PrependASPath []string
+} {
+	var calls []struct {
+		SpokeGateway  *SpokeVpc
+		PrependASPath []string
+	}
+	mock.lockSetPrependASPathSpoke.RLock()
+	calls = mock.calls.SetPrependASPathSpoke
+	mock.lockSetPrependASPathSpoke.RUnlock()
+	return calls
+}
+
+// SetRxQueueSize calls SetRxQueueSizeFunc.
+func (mock *EdgeClientMock) SetRxQueueSize(gateway *Gateway) error {
+	if mock.SetRxQueueSizeFunc == nil {
+		panic("EdgeClientMock.SetRxQueueSizeFunc: method is nil but EdgeClient.SetRxQueueSize was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockSetRxQueueSize.Lock()
+	mock.calls.SetRxQueueSize = append(mock.calls.SetRxQueueSize, callInfo)
+	mock.lockSetRxQueueSize.Unlock()
+	return mock.SetRxQueueSizeFunc(gateway)
+}
+
+// SetRxQueueSizeCalls gets all the calls that were made to SetRxQueueSize.
+// Check the length with:
+//
+//	len(mockedEdgeClient.SetRxQueueSizeCalls())
+func (mock *EdgeClientMock) SetRxQueueSizeCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockSetRxQueueSize.RLock()
+	calls = mock.calls.SetRxQueueSize
+	mock.lockSetRxQueueSize.RUnlock()
+	return calls
+}
+
+// SetSpokeBgpManualAdvertisedNetworks calls SetSpokeBgpManualAdvertisedNetworksFunc.
+func (mock *EdgeClientMock) SetSpokeBgpManualAdvertisedNetworks(spokeGateway *SpokeVpc) error {
+	if mock.SetSpokeBgpManualAdvertisedNetworksFunc == nil {
+		panic("EdgeClientMock.SetSpokeBgpManualAdvertisedNetworksFunc: method is nil but EdgeClient.SetSpokeBgpManualAdvertisedNetworks was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+	}{
+		SpokeGateway: spokeGateway,
+	}
+	mock.lockSetSpokeBgpManualAdvertisedNetworks.Lock()
+	mock.calls.SetSpokeBgpManualAdvertisedNetworks = append(mock.calls.SetSpokeBgpManualAdvertisedNetworks, callInfo)
+	mock.lockSetSpokeBgpManualAdvertisedNetworks.Unlock()
+	return mock.SetSpokeBgpManualAdvertisedNetworksFunc(spokeGateway)
+}
+
+// SetSpokeBgpManualAdvertisedNetworksCalls gets all the calls that were made to SetSpokeBgpManualAdvertisedNetworks.
+// Check the length with:
+//
+//	len(mockedEdgeClient.SetSpokeBgpManualAdvertisedNetworksCalls())
+func (mock *EdgeClientMock) SetSpokeBgpManualAdvertisedNetworksCalls() []struct {
+	SpokeGateway *SpokeVpc
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+	}
+	mock.lockSetSpokeBgpManualAdvertisedNetworks.RLock()
+	calls = mock.calls.SetSpokeBgpManualAdvertisedNetworks
+	mock.lockSetSpokeBgpManualAdvertisedNetworks.RUnlock()
+	return calls
+}
+
+// SetSpokeBgpManualAdvertisedNetworksGatewayGroup calls SetSpokeBgpManualAdvertisedNetworksGatewayGroupFunc.
+func (mock *EdgeClientMock) SetSpokeBgpManualAdvertisedNetworksGatewayGroup(ctx context.Context, groupName string, cidrs string) error {
+	if mock.SetSpokeBgpManualAdvertisedNetworksGatewayGroupFunc == nil {
+		panic("EdgeClientMock.SetSpokeBgpManualAdvertisedNetworksGatewayGroupFunc: method is nil but EdgeClient.SetSpokeBgpManualAdvertisedNetworksGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+		Cidrs     string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+		Cidrs:     cidrs,
+	}
+	mock.lockSetSpokeBgpManualAdvertisedNetworksGatewayGroup.Lock()
+	mock.calls.SetSpokeBgpManualAdvertisedNetworksGatewayGroup = append(mock.calls.SetSpokeBgpManualAdvertisedNetworksGatewayGroup, callInfo)
+	mock.lockSetSpokeBgpManualAdvertisedNetworksGatewayGroup.Unlock()
+	return mock.SetSpokeBgpManualAdvertisedNetworksGatewayGroupFunc(ctx, groupName, cidrs)
+}
+
+// SetSpokeBgpManualAdvertisedNetworksGatewayGroupCalls gets all the calls that were made to SetSpokeBgpManualAdvertisedNetworksGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.SetSpokeBgpManualAdvertisedNetworksGatewayGroupCalls())
+func (mock *EdgeClientMock) SetSpokeBgpManualAdvertisedNetworksGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+	Cidrs     string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+		Cidrs     string
+	}
+	mock.lockSetSpokeBgpManualAdvertisedNetworksGatewayGroup.RLock()
+	calls = mock.calls.SetSpokeBgpManualAdvertisedNetworksGatewayGroup
+	mock.lockSetSpokeBgpManualAdvertisedNetworksGatewayGroup.RUnlock()
+	return calls
+}
+
+// SetSymmetricRoutingGatewayGroup calls SetSymmetricRoutingGatewayGroupFunc.
+func (mock *EdgeClientMock) SetSymmetricRoutingGatewayGroup(ctx context.Context, groupUUID string, enable bool) error {
+	if mock.SetSymmetricRoutingGatewayGroupFunc == nil {
+		panic("EdgeClientMock.SetSymmetricRoutingGatewayGroupFunc: method is nil but EdgeClient.SetSymmetricRoutingGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupUUID string
+		Enable    bool
+	}{
+		Ctx:       ctx,
+		GroupUUID: groupUUID,
+		Enable:    enable,
+	}
+	mock.lockSetSymmetricRoutingGatewayGroup.Lock()
+	mock.calls.SetSymmetricRoutingGatewayGroup = append(mock.calls.SetSymmetricRoutingGatewayGroup, callInfo)
+	mock.lockSetSymmetricRoutingGatewayGroup.Unlock()
+	return mock.SetSymmetricRoutingGatewayGroupFunc(ctx, groupUUID, enable)
+}
+
+// SetSymmetricRoutingGatewayGroupCalls gets all the calls that were made to SetSymmetricRoutingGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.SetSymmetricRoutingGatewayGroupCalls())
+func (mock *EdgeClientMock) SetSymmetricRoutingGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupUUID string
+	Enable    bool
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupUUID string
+		Enable    bool
+	}
+	mock.lockSetSymmetricRoutingGatewayGroup.RLock()
+	calls = mock.calls.SetSymmetricRoutingGatewayGroup
+	mock.lockSetSymmetricRoutingGatewayGroup.RUnlock()
+	return calls
+}
+
+// SetTransitLearnedCIDRsApprovalMode calls SetTransitLearnedCIDRsApprovalModeFunc.
+func (mock *EdgeClientMock) SetTransitLearnedCIDRsApprovalMode(gw *TransitVpc, mode string) error {
+	if mock.SetTransitLearnedCIDRsApprovalModeFunc == nil {
+		panic("EdgeClientMock.SetTransitLearnedCIDRsApprovalModeFunc: method is nil but EdgeClient.SetTransitLearnedCIDRsApprovalMode was just called")
+	}
+	callInfo := struct {
+		Gw   *TransitVpc
+		Mode string
+	}{
+		Gw:   gw,
+		Mode: mode,
+	}
+	mock.lockSetTransitLearnedCIDRsApprovalMode.Lock()
+	mock.calls.SetTransitLearnedCIDRsApprovalMode = append(mock.calls.SetTransitLearnedCIDRsApprovalMode, callInfo)
+	mock.lockSetTransitLearnedCIDRsApprovalMode.Unlock()
+	return mock.SetTransitLearnedCIDRsApprovalModeFunc(gw, mode)
+}
+
+// SetTransitLearnedCIDRsApprovalModeCalls gets all the calls that were made to SetTransitLearnedCIDRsApprovalMode.
+// Check the length with:
+//
+//	len(mockedEdgeClient.SetTransitLearnedCIDRsApprovalModeCalls())
+func (mock *EdgeClientMock) SetTransitLearnedCIDRsApprovalModeCalls() []struct {
+	Gw   *TransitVpc
+	Mode string
+} {
+	var calls []struct {
+		Gw   *TransitVpc
+		Mode string
+	}
+	mock.lockSetTransitLearnedCIDRsApprovalMode.RLock()
+	calls = mock.calls.SetTransitLearnedCIDRsApprovalMode
+	mock.lockSetTransitLearnedCIDRsApprovalMode.RUnlock()
+	return calls
+}
+
+// SetVpnGatewayAuthentication calls SetVpnGatewayAuthenticationFunc.
+func (mock *EdgeClientMock) SetVpnGatewayAuthentication(gateway *VpnGatewayAuth) error {
+	if mock.SetVpnGatewayAuthenticationFunc == nil {
+		panic("EdgeClientMock.SetVpnGatewayAuthenticationFunc: method is nil but EdgeClient.SetVpnGatewayAuthentication was just called")
+	}
+	callInfo := struct {
+		Gateway *VpnGatewayAuth
+	}{
+		Gateway: gateway,
+	}
+	mock.lockSetVpnGatewayAuthentication.Lock()
+	mock.calls.SetVpnGatewayAuthentication = append(mock.calls.SetVpnGatewayAuthentication, callInfo)
+	mock.lockSetVpnGatewayAuthentication.Unlock()
+	return mock.SetVpnGatewayAuthenticationFunc(gateway)
+}
+
+// SetVpnGatewayAuthenticationCalls gets all the calls that were made to SetVpnGatewayAuthentication.
+// Check the length with:
+//
+//	len(mockedEdgeClient.SetVpnGatewayAuthenticationCalls())
+func (mock *EdgeClientMock) SetVpnGatewayAuthenticationCalls() []struct {
+	Gateway *VpnGatewayAuth
+} {
+	var calls []struct {
+		Gateway *VpnGatewayAuth
+	}
+	mock.lockSetVpnGatewayAuthentication.RLock()
+	calls = mock.calls.SetVpnGatewayAuthentication
+	mock.lockSetVpnGatewayAuthentication.RUnlock()
+	return calls
+}
+
+// UpdateDNat calls UpdateDNatFunc.
+func (mock *EdgeClientMock) UpdateDNat(gateway *Gateway) error {
+	if mock.UpdateDNatFunc == nil {
+		panic("EdgeClientMock.UpdateDNatFunc: method is nil but EdgeClient.UpdateDNat was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockUpdateDNat.Lock()
+	mock.calls.UpdateDNat = append(mock.calls.UpdateDNat, callInfo)
+	mock.lockUpdateDNat.Unlock()
+	return mock.UpdateDNatFunc(gateway)
+}
+
+// UpdateDNatCalls gets all the calls that were made to UpdateDNat.
+// Check the length with:
+//
+//	len(mockedEdgeClient.UpdateDNatCalls())
+func (mock *EdgeClientMock) UpdateDNatCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockUpdateDNat.RLock()
+	calls = mock.calls.UpdateDNat
+	mock.lockUpdateDNat.RUnlock()
+	return calls
+}
+
+// UpdateEdgeCSP calls UpdateEdgeCSPFunc.
+func (mock *EdgeClientMock) UpdateEdgeCSP(ctx context.Context, edgeCSP *EdgeCSP) error {
+	if mock.UpdateEdgeCSPFunc == nil {
+		panic("EdgeClientMock.UpdateEdgeCSPFunc: method is nil but EdgeClient.UpdateEdgeCSP was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		EdgeCSP *EdgeCSP
+	}{
+		Ctx:     ctx,
+		EdgeCSP: edgeCSP,
+	}
+	mock.lockUpdateEdgeCSP.Lock()
+	mock.calls.UpdateEdgeCSP = append(mock.calls.UpdateEdgeCSP, callInfo)
+	mock.lockUpdateEdgeCSP.Unlock()
+	return mock.UpdateEdgeCSPFunc(ctx, edgeCSP)
+}
+
+// UpdateEdgeCSPCalls gets all the calls that were made to UpdateEdgeCSP.
+// Check the length with:
+//
+//	len(mockedEdgeClient.UpdateEdgeCSPCalls())
+func (mock *EdgeClientMock) UpdateEdgeCSPCalls() []struct {
+	Ctx     context.Context
+	EdgeCSP *EdgeCSP
+} {
+	var calls []struct {
+		Ctx     context.Context
+		EdgeCSP *EdgeCSP
+	}
+	mock.lockUpdateEdgeCSP.RLock()
+	calls = mock.calls.UpdateEdgeCSP
+	mock.lockUpdateEdgeCSP.RUnlock()
+	return calls
+}
+
+// UpdateEdgeCSPHa calls UpdateEdgeCSPHaFunc.
+func (mock *EdgeClientMock) UpdateEdgeCSPHa(ctx context.Context, edgeCSP *EdgeCSP) error {
+	if mock.UpdateEdgeCSPHaFunc == nil {
+		panic("EdgeClientMock.UpdateEdgeCSPHaFunc: method is nil but EdgeClient.UpdateEdgeCSPHa was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		EdgeCSP *EdgeCSP
+	}{
+		Ctx:     ctx,
+		EdgeCSP: edgeCSP,
+	}
+	mock.lockUpdateEdgeCSPHa.Lock()
+	mock.calls.UpdateEdgeCSPHa = append(mock.calls.UpdateEdgeCSPHa, callInfo)
+	mock.lockUpdateEdgeCSPHa.Unlock()
+	return mock.UpdateEdgeCSPHaFunc(ctx, edgeCSP)
+}
+
+// UpdateEdgeCSPHaCalls gets all the calls that were made to UpdateEdgeCSPHa.
+// Check the length with:
+//
+//	len(mockedEdgeClient.UpdateEdgeCSPHaCalls())
+func (mock *EdgeClientMock) UpdateEdgeCSPHaCalls() []struct {
+	Ctx     context.Context
+	EdgeCSP *EdgeCSP
+} {
+	var calls []struct {
+		Ctx     context.Context
+		EdgeCSP *EdgeCSP
+	}
+	mock.lockUpdateEdgeCSPHa.RLock()
+	calls = mock.calls.UpdateEdgeCSPHa
+	mock.lockUpdateEdgeCSPHa.RUnlock()
+	return calls
+}
+
+// UpdateEdgeEquinix calls UpdateEdgeEquinixFunc.
+func (mock *EdgeClientMock) UpdateEdgeEquinix(ctx context.Context, edgeEquinix *EdgeEquinix) error {
+	if mock.UpdateEdgeEquinixFunc == nil {
+		panic("EdgeClientMock.UpdateEdgeEquinixFunc: method is nil but EdgeClient.UpdateEdgeEquinix was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		EdgeEquinix *EdgeEquinix
+	}{
+		Ctx:         ctx,
+		EdgeEquinix: edgeEquinix,
+	}
+	mock.lockUpdateEdgeEquinix.Lock()
+	mock.calls.UpdateEdgeEquinix = append(mock.calls.UpdateEdgeEquinix, callInfo)
+	mock.lockUpdateEdgeEquinix.Unlock()
+	return mock.UpdateEdgeEquinixFunc(ctx, edgeEquinix)
+}
+
+// UpdateEdgeEquinixCalls gets all the calls that were made to UpdateEdgeEquinix.
+// Check the length with:
+//
+//	len(mockedEdgeClient.UpdateEdgeEquinixCalls())
+func (mock *EdgeClientMock) UpdateEdgeEquinixCalls() []struct {
+	Ctx         context.Context
+	EdgeEquinix *EdgeEquinix
+} {
+	var calls []struct {
+		Ctx         context.Context
+		EdgeEquinix *EdgeEquinix
+	}
+	mock.lockUpdateEdgeEquinix.RLock()
+	calls = mock.calls.UpdateEdgeEquinix
+	mock.lockUpdateEdgeEquinix.RUnlock()
+	return calls
+}
+
+// UpdateEdgeEquinixHa calls UpdateEdgeEquinixHaFunc.
+func (mock *EdgeClientMock) UpdateEdgeEquinixHa(ctx context.Context, edgeEquinix *EdgeEquinix) error {
+	if mock.UpdateEdgeEquinixHaFunc == nil {
+		panic("EdgeClientMock.UpdateEdgeEquinixHaFunc: method is nil but EdgeClient.UpdateEdgeEquinixHa was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		EdgeEquinix *EdgeEquinix
+	}{
+		Ctx:         ctx,
+		EdgeEquinix: edgeEquinix,
+	}
+	mock.lockUpdateEdgeEquinixHa.Lock()
+	mock.calls.UpdateEdgeEquinixHa = append(mock.calls.UpdateEdgeEquinixHa, callInfo)
+	mock.lockUpdateEdgeEquinixHa.Unlock()
+	return mock.UpdateEdgeEquinixHaFunc(ctx, edgeEquinix)
+}
+
+// UpdateEdgeEquinixHaCalls gets all the calls that were made to UpdateEdgeEquinixHa.
+// Check the length with:
+//
+//	len(mockedEdgeClient.UpdateEdgeEquinixHaCalls())
+func (mock *EdgeClientMock) UpdateEdgeEquinixHaCalls() []struct {
+	Ctx         context.Context
+	EdgeEquinix *EdgeEquinix
+} {
+	var calls []struct {
+		Ctx         context.Context
+		EdgeEquinix *EdgeEquinix
+	}
+	mock.lockUpdateEdgeEquinixHa.RLock()
+	calls = mock.calls.UpdateEdgeEquinixHa
+	mock.lockUpdateEdgeEquinixHa.RUnlock()
+	return calls
+}
+
+// UpdateEdgeGateway calls UpdateEdgeGatewayFunc.
+func (mock *EdgeClientMock) UpdateEdgeGateway(gateway *TransitVpc) error {
+	if mock.UpdateEdgeGatewayFunc == nil {
+		panic("EdgeClientMock.UpdateEdgeGatewayFunc: method is nil but EdgeClient.UpdateEdgeGateway was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockUpdateEdgeGateway.Lock()
+	mock.calls.UpdateEdgeGateway = append(mock.calls.UpdateEdgeGateway, callInfo)
+	mock.lockUpdateEdgeGateway.Unlock()
+	return mock.UpdateEdgeGatewayFunc(gateway)
+}
+
+// UpdateEdgeGatewayCalls gets all the calls that were made to UpdateEdgeGateway.
+// Check the length with:
+//
+//	len(mockedEdgeClient.UpdateEdgeGatewayCalls())
+func (mock *EdgeClientMock) UpdateEdgeGatewayCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockUpdateEdgeGateway.RLock()
+	calls = mock.calls.UpdateEdgeGateway
+	mock.lockUpdateEdgeGateway.RUnlock()
+	return calls
+}
+
+// UpdateEdgeGatewayV2 calls UpdateEdgeGatewayV2Func.
+func (mock *EdgeClientMock) UpdateEdgeGatewayV2(ctx context.Context, gateway *TransitVpc) error {
+	if mock.UpdateEdgeGatewayV2Func == nil {
+		panic("EdgeClientMock.UpdateEdgeGatewayV2Func: method is nil but EdgeClient.UpdateEdgeGatewayV2 was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Gateway *TransitVpc
+	}{
+		Ctx:     ctx,
+		Gateway: gateway,
+	}
+	mock.lockUpdateEdgeGatewayV2.Lock()
+	mock.calls.UpdateEdgeGatewayV2 = append(mock.calls.UpdateEdgeGatewayV2, callInfo)
+	mock.lockUpdateEdgeGatewayV2.Unlock()
+	return mock.UpdateEdgeGatewayV2Func(ctx, gateway)
+}
+
+// UpdateEdgeGatewayV2Calls gets all the calls that were made to UpdateEdgeGatewayV2.
+// Check the length with:
+//
+//	len(mockedEdgeClient.UpdateEdgeGatewayV2Calls())
+func (mock *EdgeClientMock) UpdateEdgeGatewayV2Calls() []struct {
+	Ctx     context.Context
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Gateway *TransitVpc
+	}
+	mock.lockUpdateEdgeGatewayV2.RLock()
+	calls = mock.calls.UpdateEdgeGatewayV2
+	mock.lockUpdateEdgeGatewayV2.RUnlock()
+	return calls
+}
+
+// UpdateEdgeMegaport calls UpdateEdgeMegaportFunc.
+func (mock *EdgeClientMock) UpdateEdgeMegaport(ctx context.Context, edgeMegaport *EdgeMegaport) error {
+	if mock.UpdateEdgeMegaportFunc == nil {
+		panic("EdgeClientMock.UpdateEdgeMegaportFunc: method is nil but EdgeClient.UpdateEdgeMegaport was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		EdgeMegaport *EdgeMegaport
+	}{
+		Ctx:          ctx,
+		EdgeMegaport: edgeMegaport,
+	}
+	mock.lockUpdateEdgeMegaport.Lock()
+	mock.calls.UpdateEdgeMegaport = append(mock.calls.UpdateEdgeMegaport, callInfo)
+	mock.lockUpdateEdgeMegaport.Unlock()
+	return mock.UpdateEdgeMegaportFunc(ctx, edgeMegaport)
+}
+
+// UpdateEdgeMegaportCalls gets all the calls that were made to UpdateEdgeMegaport.
+// Check the length with:
+//
+//	len(mockedEdgeClient.UpdateEdgeMegaportCalls())
+func (mock *EdgeClientMock) UpdateEdgeMegaportCalls() []struct {
+	Ctx          context.Context
+	EdgeMegaport *EdgeMegaport
+} {
+	var calls []struct {
+		Ctx          context.Context
+		EdgeMegaport *EdgeMegaport
+	}
+	mock.lockUpdateEdgeMegaport.RLock()
+	calls = mock.calls.UpdateEdgeMegaport
+	mock.lockUpdateEdgeMegaport.RUnlock()
+	return calls
+}
+
+// UpdateEdgeMegaportHa calls UpdateEdgeMegaportHaFunc.
+func (mock *EdgeClientMock) UpdateEdgeMegaportHa(ctx context.Context, edgeMegaport *EdgeMegaport) error {
+	if mock.UpdateEdgeMegaportHaFunc == nil {
+		panic("EdgeClientMock.UpdateEdgeMegaportHaFunc: method is nil but EdgeClient.UpdateEdgeMegaportHa was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		EdgeMegaport *EdgeMegaport
+	}{
+		Ctx:          ctx,
+		EdgeMegaport: edgeMegaport,
+	}
+	mock.lockUpdateEdgeMegaportHa.Lock()
+	mock.calls.UpdateEdgeMegaportHa = append(mock.calls.UpdateEdgeMegaportHa, callInfo)
+	mock.lockUpdateEdgeMegaportHa.Unlock()
+	return mock.UpdateEdgeMegaportHaFunc(ctx, edgeMegaport)
+}
+
+// UpdateEdgeMegaportHaCalls gets all the calls that were made to UpdateEdgeMegaportHa.
+// Check the length with:
+//
+//	len(mockedEdgeClient.UpdateEdgeMegaportHaCalls())
+func (mock *EdgeClientMock) UpdateEdgeMegaportHaCalls() []struct {
+	Ctx          context.Context
+	EdgeMegaport *EdgeMegaport
+} {
+	var calls []struct {
+		Ctx          context.Context
+		EdgeMegaport *EdgeMegaport
+	}
+	mock.lockUpdateEdgeMegaportHa.RLock()
+	calls = mock.calls.UpdateEdgeMegaportHa
+	mock.lockUpdateEdgeMegaportHa.RUnlock()
+	return calls
+}
+
+// UpdateEdgeNEO calls UpdateEdgeNEOFunc.
+func (mock *EdgeClientMock) UpdateEdgeNEO(ctx context.Context, edgeNEO *EdgeNEO) error {
+	if mock.UpdateEdgeNEOFunc == nil {
+		panic("EdgeClientMock.UpdateEdgeNEOFunc: method is nil but EdgeClient.UpdateEdgeNEO was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		EdgeNEO *EdgeNEO
+	}{
+		Ctx:     ctx,
+		EdgeNEO: edgeNEO,
+	}
+	mock.lockUpdateEdgeNEO.Lock()
+	mock.calls.UpdateEdgeNEO = append(mock.calls.UpdateEdgeNEO, callInfo)
+	mock.lockUpdateEdgeNEO.Unlock()
+	return mock.UpdateEdgeNEOFunc(ctx, edgeNEO)
+}
+
+// UpdateEdgeNEOCalls gets all the calls that were made to UpdateEdgeNEO.
+// Check the length with:
+//
+//	len(mockedEdgeClient.UpdateEdgeNEOCalls())
+func (mock *EdgeClientMock) UpdateEdgeNEOCalls() []struct {
+	Ctx     context.Context
+	EdgeNEO *EdgeNEO
+} {
+	var calls []struct {
+		Ctx     context.Context
+		EdgeNEO *EdgeNEO
+	}
+	mock.lockUpdateEdgeNEO.RLock()
+	calls = mock.calls.UpdateEdgeNEO
+	mock.lockUpdateEdgeNEO.RUnlock()
+	return calls
+}
+
+// UpdateEdgeNEODevice calls UpdateEdgeNEODeviceFunc.
+func (mock *EdgeClientMock) UpdateEdgeNEODevice(ctx context.Context, edgeNEODevice *EdgeNEODevice) error {
+	if mock.UpdateEdgeNEODeviceFunc == nil {
+		panic("EdgeClientMock.UpdateEdgeNEODeviceFunc: method is nil but EdgeClient.UpdateEdgeNEODevice was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		EdgeNEODevice *EdgeNEODevice
+	}{
+		Ctx:           ctx,
+		EdgeNEODevice: edgeNEODevice,
+	}
+	mock.lockUpdateEdgeNEODevice.Lock()
+	mock.calls.UpdateEdgeNEODevice = append(mock.calls.UpdateEdgeNEODevice, callInfo)
+	mock.lockUpdateEdgeNEODevice.Unlock()
+	return mock.UpdateEdgeNEODeviceFunc(ctx, edgeNEODevice)
+}
+
+// UpdateEdgeNEODeviceCalls gets all the calls that were made to UpdateEdgeNEODevice.
+// Check the length with:
+//
+//	len(mockedEdgeClient.UpdateEdgeNEODeviceCalls())
+func (mock *EdgeClientMock) UpdateEdgeNEODeviceCalls() []struct {
+	Ctx           context.Context
+	EdgeNEODevice *EdgeNEODevice
+} {
+	var calls []struct {
+		Ctx           context.Context
+		EdgeNEODevice *EdgeNEODevice
+	}
+	mock.lockUpdateEdgeNEODevice.RLock()
+	calls = mock.calls.UpdateEdgeNEODevice
+	mock.lockUpdateEdgeNEODevice.RUnlock()
+	return calls
+}
+
+// UpdateEdgeNEOHa calls UpdateEdgeNEOHaFunc.
+func (mock *EdgeClientMock) UpdateEdgeNEOHa(ctx context.Context, edgeNEO *EdgeNEO) error {
+	if mock.UpdateEdgeNEOHaFunc == nil {
+		panic("EdgeClientMock.UpdateEdgeNEOHaFunc: method is nil but EdgeClient.UpdateEdgeNEOHa was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		EdgeNEO *EdgeNEO
+	}{
+		Ctx:     ctx,
+		EdgeNEO: edgeNEO,
+	}
+	mock.lockUpdateEdgeNEOHa.Lock()
+	mock.calls.UpdateEdgeNEOHa = append(mock.calls.UpdateEdgeNEOHa, callInfo)
+	mock.lockUpdateEdgeNEOHa.Unlock()
+	return mock.UpdateEdgeNEOHaFunc(ctx, edgeNEO)
+}
+
+// UpdateEdgeNEOHaCalls gets all the calls that were made to UpdateEdgeNEOHa.
+// Check the length with:
+//
+//	len(mockedEdgeClient.UpdateEdgeNEOHaCalls())
+func (mock *EdgeClientMock) UpdateEdgeNEOHaCalls() []struct {
+	Ctx     context.Context
+	EdgeNEO *EdgeNEO
+} {
+	var calls []struct {
+		Ctx     context.Context
+		EdgeNEO *EdgeNEO
+	}
+	mock.lockUpdateEdgeNEOHa.RLock()
+	calls = mock.calls.UpdateEdgeNEOHa
+	mock.lockUpdateEdgeNEOHa.RUnlock()
+	return calls
+}
+
+// UpdateEdgeProxyProfile calls UpdateEdgeProxyProfileFunc.
+func (mock *EdgeClientMock) UpdateEdgeProxyProfile(ctx context.Context, edgeNEOProxyProfile *EdgePlatformProxyProfileUpdate) error {
+	if mock.UpdateEdgeProxyProfileFunc == nil {
+		panic("EdgeClientMock.UpdateEdgeProxyProfileFunc: method is nil but EdgeClient.UpdateEdgeProxyProfile was just called")
+	}
+	callInfo := struct {
+		Ctx                 context.Context
+		EdgeNEOProxyProfile *EdgePlatformProxyProfileUpdate
+	}{
+		Ctx:                 ctx,
+		EdgeNEOProxyProfile: edgeNEOProxyProfile,
+	}
+	mock.lockUpdateEdgeProxyProfile.Lock()
+	mock.calls.UpdateEdgeProxyProfile = append(mock.calls.UpdateEdgeProxyProfile, callInfo)
+	mock.lockUpdateEdgeProxyProfile.Unlock()
+	return mock.UpdateEdgeProxyProfileFunc(ctx, edgeNEOProxyProfile)
+}
+
+// UpdateEdgeProxyProfileCalls gets all the calls that were made to UpdateEdgeProxyProfile.
+// Check the length with:
+//
+//	len(mockedEdgeClient.UpdateEdgeProxyProfileCalls())
+func (mock *EdgeClientMock) UpdateEdgeProxyProfileCalls() []struct {
+	Ctx                 context.Context
+	EdgeNEOProxyProfile *EdgePlatformProxyProfileUpdate
+} {
+	var calls []struct {
+		Ctx                 context.Context
+		EdgeNEOProxyProfile *EdgePlatformProxyProfileUpdate
+	}
+	mock.lockUpdateEdgeProxyProfile.RLock()
+	calls = mock.calls.UpdateEdgeProxyProfile
+	mock.lockUpdateEdgeProxyProfile.RUnlock()
+	return calls
+}
+
+// UpdateEdgeSpoke calls UpdateEdgeSpokeFunc.
+func (mock *EdgeClientMock) UpdateEdgeSpoke(ctx context.Context, edgeSpoke *EdgeSpoke) error {
+	if mock.UpdateEdgeSpokeFunc == nil {
+		panic("EdgeClientMock.UpdateEdgeSpokeFunc: method is nil but EdgeClient.UpdateEdgeSpoke was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		EdgeSpoke *EdgeSpoke
+	}{
+		Ctx:       ctx,
+		EdgeSpoke: edgeSpoke,
+	}
+	mock.lockUpdateEdgeSpoke.Lock()
+	mock.calls.UpdateEdgeSpoke = append(mock.calls.UpdateEdgeSpoke, callInfo)
+	mock.lockUpdateEdgeSpoke.Unlock()
+	return mock.UpdateEdgeSpokeFunc(ctx, edgeSpoke)
+}
+
+// UpdateEdgeSpokeCalls gets all the calls that were made to UpdateEdgeSpoke.
+// Check the length with:
+//
+//	len(mockedEdgeClient.UpdateEdgeSpokeCalls())
+func (mock *EdgeClientMock) UpdateEdgeSpokeCalls() []struct {
+	Ctx       context.Context
+	EdgeSpoke *EdgeSpoke
+} {
+	var calls []struct {
+		Ctx       context.Context
+		EdgeSpoke *EdgeSpoke
+	}
+	mock.lockUpdateEdgeSpoke.RLock()
+	calls = mock.calls.UpdateEdgeSpoke
+	mock.lockUpdateEdgeSpoke.RUnlock()
+	return calls
+}
+
+// UpdateEdgeSpokeGeoCoordinate calls UpdateEdgeSpokeGeoCoordinateFunc.
+func (mock *EdgeClientMock) UpdateEdgeSpokeGeoCoordinate(ctx context.Context, edgeSpoke *EdgeSpoke) error {
+	if mock.UpdateEdgeSpokeGeoCoordinateFunc == nil {
+		panic("EdgeClientMock.UpdateEdgeSpokeGeoCoordinateFunc: method is nil but EdgeClient.UpdateEdgeSpokeGeoCoordinate was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		EdgeSpoke *EdgeSpoke
+	}{
+		Ctx:       ctx,
+		EdgeSpoke: edgeSpoke,
+	}
+	mock.lockUpdateEdgeSpokeGeoCoordinate.Lock()
+	mock.calls.UpdateEdgeSpokeGeoCoordinate = append(mock.calls.UpdateEdgeSpokeGeoCoordinate, callInfo)
+	mock.lockUpdateEdgeSpokeGeoCoordinate.Unlock()
+	return mock.UpdateEdgeSpokeGeoCoordinateFunc(ctx, edgeSpoke)
+}
+
+// UpdateEdgeSpokeGeoCoordinateCalls gets all the calls that were made to UpdateEdgeSpokeGeoCoordinate.
+// Check the length with:
+//
+//	len(mockedEdgeClient.UpdateEdgeSpokeGeoCoordinateCalls())
+func (mock *EdgeClientMock) UpdateEdgeSpokeGeoCoordinateCalls() []struct {
+	Ctx       context.Context
+	EdgeSpoke *EdgeSpoke
+} {
+	var calls []struct {
+		Ctx       context.Context
+		EdgeSpoke *EdgeSpoke
+	}
+	mock.lockUpdateEdgeSpokeGeoCoordinate.RLock()
+	calls = mock.calls.UpdateEdgeSpokeGeoCoordinate
+	mock.lockUpdateEdgeSpokeGeoCoordinate.RUnlock()
+	return calls
+}
+
+// UpdateEdgeSpokeTransitPeeringTunnelCount calls UpdateEdgeSpokeTransitPeeringTunnelCountFunc.
+func (mock *EdgeClientMock) UpdateEdgeSpokeTransitPeeringTunnelCount(gateway1 string, gateway2 string, tunnelCount int) error {
+	if mock.UpdateEdgeSpokeTransitPeeringTunnelCountFunc == nil {
+		panic("EdgeClientMock.UpdateEdgeSpokeTransitPeeringTunnelCountFunc: method is nil but EdgeClient.UpdateEdgeSpokeTransitPeeringTunnelCount was just called")
+	}
+	callInfo := struct {
+		Gateway1    string
+		Gateway2    string
+		TunnelCount int
+	}{
+		Gateway1:    gateway1,
+		Gateway2:    gateway2,
+		TunnelCount: tunnelCount,
+	}
+	mock.lockUpdateEdgeSpokeTransitPeeringTunnelCount.Lock()
+	mock.calls.UpdateEdgeSpokeTransitPeeringTunnelCount = append(mock.calls.UpdateEdgeSpokeTransitPeeringTunnelCount, callInfo)
+	mock.lockUpdateEdgeSpokeTransitPeeringTunnelCount.Unlock()
+	return mock.UpdateEdgeSpokeTransitPeeringTunnelCountFunc(gateway1, gateway2, tunnelCount)
+}
+
+// UpdateEdgeSpokeTransitPeeringTunnelCountCalls gets all the calls that were made to UpdateEdgeSpokeTransitPeeringTunnelCount.
+// Check the length with:
+//
+//	len(mockedEdgeClient.UpdateEdgeSpokeTransitPeeringTunnelCountCalls())
+func (mock *EdgeClientMock) UpdateEdgeSpokeTransitPeeringTunnelCountCalls() []struct {
+	Gateway1    string
+	Gateway2    string
+	TunnelCount int
+} {
+	var calls []struct {
+		Gateway1    string
+		Gateway2    string
+		TunnelCount int
+	}
+	mock.lockUpdateEdgeSpokeTransitPeeringTunnelCount.RLock()
+	calls = mock.calls.UpdateEdgeSpokeTransitPeeringTunnelCount
+	mock.lockUpdateEdgeSpokeTransitPeeringTunnelCount.RUnlock()
+	return calls
+}
+
+// UpdateEdgeVmSelfmanagedHa calls UpdateEdgeVmSelfmanagedHaFunc.
+func (mock *EdgeClientMock) UpdateEdgeVmSelfmanagedHa(ctx context.Context, edgeVmSelfmanaged *EdgeSpoke) error {
+	if mock.UpdateEdgeVmSelfmanagedHaFunc == nil {
+		panic("EdgeClientMock.UpdateEdgeVmSelfmanagedHaFunc: method is nil but EdgeClient.UpdateEdgeVmSelfmanagedHa was just called")
+	}
+	callInfo := struct {
+		Ctx               context.Context
+		EdgeVmSelfmanaged *EdgeSpoke
+	}{
+		Ctx:               ctx,
+		EdgeVmSelfmanaged: edgeVmSelfmanaged,
+	}
+	mock.lockUpdateEdgeVmSelfmanagedHa.Lock()
+	mock.calls.UpdateEdgeVmSelfmanagedHa = append(mock.calls.UpdateEdgeVmSelfmanagedHa, callInfo)
+	mock.lockUpdateEdgeVmSelfmanagedHa.Unlock()
+	return mock.UpdateEdgeVmSelfmanagedHaFunc(ctx, edgeVmSelfmanaged)
+}
+
+// UpdateEdgeVmSelfmanagedHaCalls gets all the calls that were made to UpdateEdgeVmSelfmanagedHa.
+// Check the length with:
+//
+//	len(mockedEdgeClient.UpdateEdgeVmSelfmanagedHaCalls())
+func (mock *EdgeClientMock) UpdateEdgeVmSelfmanagedHaCalls() []struct {
+	Ctx               context.Context
+	EdgeVmSelfmanaged *EdgeSpoke
+} {
+	var calls []struct {
+		Ctx               context.Context
+		EdgeVmSelfmanaged *EdgeSpoke
+	}
+	mock.lockUpdateEdgeVmSelfmanagedHa.RLock()
+	calls = mock.calls.UpdateEdgeVmSelfmanagedHa
+	mock.lockUpdateEdgeVmSelfmanagedHa.RUnlock()
+	return calls
+}
+
+// UpdateGateway calls UpdateGatewayFunc.
+func (mock *EdgeClientMock) UpdateGateway(gateway *Gateway) error {
+	if mock.UpdateGatewayFunc == nil {
+		panic("EdgeClientMock.UpdateGatewayFunc: method is nil but EdgeClient.UpdateGateway was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockUpdateGateway.Lock()
+	mock.calls.UpdateGateway = append(mock.calls.UpdateGateway, callInfo)
+	mock.lockUpdateGateway.Unlock()
+	return mock.UpdateGatewayFunc(gateway)
+}
+
+// UpdateGatewayCalls gets all the calls that were made to UpdateGateway.
+// Check the length with:
+//
+//	len(mockedEdgeClient.UpdateGatewayCalls())
+func (mock *EdgeClientMock) UpdateGatewayCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockUpdateGateway.RLock()
+	calls = mock.calls.UpdateGateway
+	mock.lockUpdateGateway.RUnlock()
+	return calls
+}
+
+// UpdateGatewayContext calls UpdateGatewayContextFunc.
+func (mock *EdgeClientMock) UpdateGatewayContext(ctx context.Context, gateway *Gateway) error {
+	if mock.UpdateGatewayContextFunc == nil {
+		panic("EdgeClientMock.UpdateGatewayContextFunc: method is nil but EdgeClient.UpdateGatewayContext was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}{
+		Ctx:     ctx,
+		Gateway: gateway,
+	}
+	mock.lockUpdateGatewayContext.Lock()
+	mock.calls.UpdateGatewayContext = append(mock.calls.UpdateGatewayContext, callInfo)
+	mock.lockUpdateGatewayContext.Unlock()
+	return mock.UpdateGatewayContextFunc(ctx, gateway)
+}
+
+// UpdateGatewayContextCalls gets all the calls that were made to UpdateGatewayContext.
+// Check the length with:
+//
+//	len(mockedEdgeClient.UpdateGatewayContextCalls())
+func (mock *EdgeClientMock) UpdateGatewayContextCalls() []struct {
+	Ctx     context.Context
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}
+	mock.lockUpdateGatewayContext.RLock()
+	calls = mock.calls.UpdateGatewayContext
+	mock.lockUpdateGatewayContext.RUnlock()
+	return calls
+}
+
+// UpdateGroupInstanceSize calls UpdateGroupInstanceSizeFunc.
+func (mock *EdgeClientMock) UpdateGroupInstanceSize(ctx context.Context, groupName string, instanceSize string) error {
+	if mock.UpdateGroupInstanceSizeFunc == nil {
+		panic("EdgeClientMock.UpdateGroupInstanceSizeFunc: method is nil but EdgeClient.UpdateGroupInstanceSize was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		GroupName    string
+		InstanceSize string
+	}{
+		Ctx:          ctx,
+		GroupName:    groupName,
+		InstanceSize: instanceSize,
+	}
+	mock.lockUpdateGroupInstanceSize.Lock()
+	mock.calls.UpdateGroupInstanceSize = append(mock.calls.UpdateGroupInstanceSize, callInfo)
+	mock.lockUpdateGroupInstanceSize.Unlock()
+	return mock.UpdateGroupInstanceSizeFunc(ctx, groupName, instanceSize)
+}
+
+// UpdateGroupInstanceSizeCalls gets all the calls that were made to UpdateGroupInstanceSize.
+// Check the length with:
+//
+//	len(mockedEdgeClient.UpdateGroupInstanceSizeCalls())
+func (mock *EdgeClientMock) UpdateGroupInstanceSizeCalls() []struct {
+	Ctx          context.Context
+	GroupName    string
+	InstanceSize string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		GroupName    string
+		InstanceSize string
+	}
+	mock.lockUpdateGroupInstanceSize.RLock()
+	calls = mock.calls.UpdateGroupInstanceSize
+	mock.lockUpdateGroupInstanceSize.RUnlock()
+	return calls
+}
+
+// UpdateMaxVpnConn calls UpdateMaxVpnConnFunc.
+func (mock *EdgeClientMock) UpdateMaxVpnConn(gateway *Gateway) error {
+	if mock.UpdateMaxVpnConnFunc == nil {
+		panic("EdgeClientMock.UpdateMaxVpnConnFunc: method is nil but EdgeClient.UpdateMaxVpnConn was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockUpdateMaxVpnConn.Lock()
+	mock.calls.UpdateMaxVpnConn = append(mock.calls.UpdateMaxVpnConn, callInfo)
+	mock.lockUpdateMaxVpnConn.Unlock()
+	return mock.UpdateMaxVpnConnFunc(gateway)
+}
+
+// UpdateMaxVpnConnCalls gets all the calls that were made to UpdateMaxVpnConn.
+// Check the length with:
+//
+//	len(mockedEdgeClient.UpdateMaxVpnConnCalls())
+func (mock *EdgeClientMock) UpdateMaxVpnConnCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockUpdateMaxVpnConn.RLock()
+	calls = mock.calls.UpdateMaxVpnConn
+	mock.lockUpdateMaxVpnConn.RUnlock()
+	return calls
+}
+
+// UpdateSpokeGatewaySubnetGroup calls UpdateSpokeGatewaySubnetGroupFunc.
+func (mock *EdgeClientMock) UpdateSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error {
+	if mock.UpdateSpokeGatewaySubnetGroupFunc == nil {
+		panic("EdgeClientMock.UpdateSpokeGatewaySubnetGroupFunc: method is nil but EdgeClient.UpdateSpokeGatewaySubnetGroup was just called")
+	}
+	callInfo := struct {
+		Ctx                     context.Context
+		SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+	}{
+		Ctx:                     ctx,
+		SpokeGatewaySubnetGroup: spokeGatewaySubnetGroup,
+	}
+	mock.lockUpdateSpokeGatewaySubnetGroup.Lock()
+	mock.calls.UpdateSpokeGatewaySubnetGroup = append(mock.calls.UpdateSpokeGatewaySubnetGroup, callInfo)
+	mock.lockUpdateSpokeGatewaySubnetGroup.Unlock()
+	return mock.UpdateSpokeGatewaySubnetGroupFunc(ctx, spokeGatewaySubnetGroup)
+}
+
+// UpdateSpokeGatewaySubnetGroupCalls gets all the calls that were made to UpdateSpokeGatewaySubnetGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.UpdateSpokeGatewaySubnetGroupCalls())
+func (mock *EdgeClientMock) UpdateSpokeGatewaySubnetGroupCalls() []struct {
+	Ctx                     context.Context
+	SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+} {
+	var calls []struct {
+		Ctx                     context.Context
+		SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+	}
+	mock.lockUpdateSpokeGatewaySubnetGroup.RLock()
+	calls = mock.calls.UpdateSpokeGatewaySubnetGroup
+	mock.lockUpdateSpokeGatewaySubnetGroup.RUnlock()
+	return calls
+}
+
+// UpdateSpokePendingApprovedCidrs calls UpdateSpokePendingApprovedCidrsFunc.
+func (mock *EdgeClientMock) UpdateSpokePendingApprovedCidrs(gateway *SpokeVpc) error {
+	if mock.UpdateSpokePendingApprovedCidrsFunc == nil {
+		panic("EdgeClientMock.UpdateSpokePendingApprovedCidrsFunc: method is nil but EdgeClient.UpdateSpokePendingApprovedCidrs was just called")
+	}
+	callInfo := struct {
+		Gateway *SpokeVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockUpdateSpokePendingApprovedCidrs.Lock()
+	mock.calls.UpdateSpokePendingApprovedCidrs = append(mock.calls.UpdateSpokePendingApprovedCidrs, callInfo)
+	mock.lockUpdateSpokePendingApprovedCidrs.Unlock()
+	return mock.UpdateSpokePendingApprovedCidrsFunc(gateway)
+}
+
+// UpdateSpokePendingApprovedCidrsCalls gets all the calls that were made to UpdateSpokePendingApprovedCidrs.
+// Check the length with:
+//
+//	len(mockedEdgeClient.UpdateSpokePendingApprovedCidrsCalls())
+func (mock *EdgeClientMock) UpdateSpokePendingApprovedCidrsCalls() []struct {
+	Gateway *SpokeVpc
+} {
+	var calls []struct {
+		Gateway *SpokeVpc
+	}
+	mock.lockUpdateSpokePendingApprovedCidrs.RLock()
+	calls = mock.calls.UpdateSpokePendingApprovedCidrs
+	mock.lockUpdateSpokePendingApprovedCidrs.RUnlock()
+	return calls
+}
+
+// UpdateSpokePendingApprovedCidrsGatewayGroup calls UpdateSpokePendingApprovedCidrsGatewayGroupFunc.
+func (mock *EdgeClientMock) UpdateSpokePendingApprovedCidrsGatewayGroup(ctx context.Context, groupName string, approvedCidrs []string) error {
+	if mock.UpdateSpokePendingApprovedCidrsGatewayGroupFunc == nil {
+		panic("EdgeClientMock.UpdateSpokePendingApprovedCidrsGatewayGroupFunc: method is nil but EdgeClient.UpdateSpokePendingApprovedCidrsGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		GroupName     string
+		ApprovedCidrs []string
+	}{
+		Ctx:           ctx,
+		GroupName:     groupName,
+		ApprovedCidrs: approvedCidrs,
+	}
+	mock.lockUpdateSpokePendingApprovedCidrsGatewayGroup.Lock()
+	mock.calls.UpdateSpokePendingApprovedCidrsGatewayGroup = append(mock.calls.UpdateSpokePendingApprovedCidrsGatewayGroup, callInfo)
+	mock.lockUpdateSpokePendingApprovedCidrsGatewayGroup.Unlock()
+	return mock.UpdateSpokePendingApprovedCidrsGatewayGroupFunc(ctx, groupName, approvedCidrs)
+}
+
+// UpdateSpokePendingApprovedCidrsGatewayGroupCalls gets all the calls that were made to UpdateSpokePendingApprovedCidrsGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.UpdateSpokePendingApprovedCidrsGatewayGroupCalls())
+func (mock *EdgeClientMock) UpdateSpokePendingApprovedCidrsGatewayGroupCalls() []struct {
+	Ctx           context.Context
+	GroupName     string
+	ApprovedCidrs []string
+} {
+	var calls []struct {
+		Ctx           context.Context
+		GroupName     string
+		ApprovedCidrs []string
+	}
+	mock.lockUpdateSpokePendingApprovedCidrsGatewayGroup.RLock()
+	calls = mock.calls.UpdateSpokePendingApprovedCidrsGatewayGroup
+	mock.lockUpdateSpokePendingApprovedCidrsGatewayGroup.RUnlock()
+	return calls
+}
+
+// UpdateTags calls UpdateTagsFunc.
+func (mock *EdgeClientMock) UpdateTags(tags *Tags) error {
+	if mock.UpdateTagsFunc == nil {
+		panic("EdgeClientMock.UpdateTagsFunc: method is nil but EdgeClient.UpdateTags was just called")
+	}
+	callInfo := struct {
+		Tags *Tags
+	}{
+		Tags: tags,
+	}
+	mock.lockUpdateTags.Lock()
+	mock.calls.UpdateTags = append(mock.calls.UpdateTags, callInfo)
+	mock.lockUpdateTags.Unlock()
+	return mock.UpdateTagsFunc(tags)
+}
+
+// UpdateTagsCalls gets all the calls that were made to UpdateTags.
+// Check the length with:
+//
+//	len(mockedEdgeClient.UpdateTagsCalls())
+func (mock *EdgeClientMock) UpdateTagsCalls() []struct {
+	Tags *Tags
+} {
+	var calls []struct {
+		Tags *Tags
+	}
+	mock.lockUpdateTags.RLock()
+	calls = mock.calls.UpdateTags
+	mock.lockUpdateTags.RUnlock()
+	return calls
+}
+
+// UpdateTransitGatewayCustomizedVpcRoute calls UpdateTransitGatewayCustomizedVpcRouteFunc.
+func (mock *EdgeClientMock) UpdateTransitGatewayCustomizedVpcRoute(gateway string, customizedTransitVpcRoutes []string) error {
+	if mock.UpdateTransitGatewayCustomizedVpcRouteFunc == nil {
+		panic("EdgeClientMock.UpdateTransitGatewayCustomizedVpcRouteFunc: method is nil but EdgeClient.UpdateTransitGatewayCustomizedVpcRoute was just called")
+	}
+	callInfo := struct {
+		Gateway                    string
+		CustomizedTransitVpcRoutes []string
+	}{
+		Gateway:                    gateway,
+		CustomizedTransitVpcRoutes: customizedTransitVpcRoutes,
+	}
+	mock.lockUpdateTransitGatewayCustomizedVpcRoute.Lock()
+	mock.calls.UpdateTransitGatewayCustomizedVpcRoute = append(mock.calls.UpdateTransitGatewayCustomizedVpcRoute, callInfo)
+	mock.lockUpdateTransitGatewayCustomizedVpcRoute.Unlock()
+	return mock.UpdateTransitGatewayCustomizedVpcRouteFunc(gateway, customizedTransitVpcRoutes)
+}
+
+// UpdateTransitGatewayCustomizedVpcRouteCalls gets all the calls that were made to UpdateTransitGatewayCustomizedVpcRoute.
+// Check the length with:
+//
+//	len(mockedEdgeClient.UpdateTransitGatewayCustomizedVpcRouteCalls())
+func (mock *EdgeClientMock) UpdateTransitGatewayCustomizedVpcRouteCalls() []struct {
+	Gateway                    string
+	CustomizedTransitVpcRoutes []string
+} {
+	var calls []struct {
+		Gateway                    string
+		CustomizedTransitVpcRoutes []string
+	}
+	mock.lockUpdateTransitGatewayCustomizedVpcRoute.RLock()
+	calls = mock.calls.UpdateTransitGatewayCustomizedVpcRoute
+	mock.lockUpdateTransitGatewayCustomizedVpcRoute.RUnlock()
+	return calls
+}
+
+// UpdateTransitPendingApprovedCidrs calls UpdateTransitPendingApprovedCidrsFunc.
+func (mock *EdgeClientMock) UpdateTransitPendingApprovedCidrs(gateway *TransitVpc) error {
+	if mock.UpdateTransitPendingApprovedCidrsFunc == nil {
+		panic("EdgeClientMock.UpdateTransitPendingApprovedCidrsFunc: method is nil but EdgeClient.UpdateTransitPendingApprovedCidrs was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockUpdateTransitPendingApprovedCidrs.Lock()
+	mock.calls.UpdateTransitPendingApprovedCidrs = append(mock.calls.UpdateTransitPendingApprovedCidrs, callInfo)
+	mock.lockUpdateTransitPendingApprovedCidrs.Unlock()
+	return mock.UpdateTransitPendingApprovedCidrsFunc(gateway)
+}
+
+// UpdateTransitPendingApprovedCidrsCalls gets all the calls that were made to UpdateTransitPendingApprovedCidrs.
+// Check the length with:
+//
+//	len(mockedEdgeClient.UpdateTransitPendingApprovedCidrsCalls())
+func (mock *EdgeClientMock) UpdateTransitPendingApprovedCidrsCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockUpdateTransitPendingApprovedCidrs.RLock()
+	calls = mock.calls.UpdateTransitPendingApprovedCidrs
+	mock.lockUpdateTransitPendingApprovedCidrs.RUnlock()
+	return calls
+}
+
+// UpdateTransitPendingApprovedCidrsGatewayGroup calls UpdateTransitPendingApprovedCidrsGatewayGroupFunc.
+func (mock *EdgeClientMock) UpdateTransitPendingApprovedCidrsGatewayGroup(ctx context.Context, groupName string, approvedCidrs []string) error {
+	if mock.UpdateTransitPendingApprovedCidrsGatewayGroupFunc == nil {
+		panic("EdgeClientMock.UpdateTransitPendingApprovedCidrsGatewayGroupFunc: method is nil but EdgeClient.UpdateTransitPendingApprovedCidrsGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		GroupName     string
+		ApprovedCidrs []string
+	}{
+		Ctx:           ctx,
+		GroupName:     groupName,
+		ApprovedCidrs: approvedCidrs,
+	}
+	mock.lockUpdateTransitPendingApprovedCidrsGatewayGroup.Lock()
+	mock.calls.UpdateTransitPendingApprovedCidrsGatewayGroup = append(mock.calls.UpdateTransitPendingApprovedCidrsGatewayGroup, callInfo)
+	mock.lockUpdateTransitPendingApprovedCidrsGatewayGroup.Unlock()
+	return mock.UpdateTransitPendingApprovedCidrsGatewayGroupFunc(ctx, groupName, approvedCidrs)
+}
+
+// UpdateTransitPendingApprovedCidrsGatewayGroupCalls gets all the calls that were made to UpdateTransitPendingApprovedCidrsGatewayGroup.
+// Check the length with:
+//
+//	len(mockedEdgeClient.UpdateTransitPendingApprovedCidrsGatewayGroupCalls())
+func (mock *EdgeClientMock) UpdateTransitPendingApprovedCidrsGatewayGroupCalls() []struct {
+	Ctx           context.Context
+	GroupName     string
+	ApprovedCidrs []string
+} {
+	var calls []struct {
+		Ctx           context.Context
+		GroupName     string
+		ApprovedCidrs []string
+	}
+	mock.lockUpdateTransitPendingApprovedCidrsGatewayGroup.RLock()
+	calls = mock.calls.UpdateTransitPendingApprovedCidrsGatewayGroup
+	mock.lockUpdateTransitPendingApprovedCidrsGatewayGroup.RUnlock()
+	return calls
+}
+
+// UpdateVpnCidr calls UpdateVpnCidrFunc.
+func (mock *EdgeClientMock) UpdateVpnCidr(gateway *Gateway) error {
+	if mock.UpdateVpnCidrFunc == nil {
+		panic("EdgeClientMock.UpdateVpnCidrFunc: method is nil but EdgeClient.UpdateVpnCidr was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockUpdateVpnCidr.Lock()
+	mock.calls.UpdateVpnCidr = append(mock.calls.UpdateVpnCidr, callInfo)
+	mock.lockUpdateVpnCidr.Unlock()
+	return mock.UpdateVpnCidrFunc(gateway)
+}
+
+// UpdateVpnCidrCalls gets all the calls that were made to UpdateVpnCidr.
+// Check the length with:
+//
+//	len(mockedEdgeClient.UpdateVpnCidrCalls())
+func (mock *EdgeClientMock) UpdateVpnCidrCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockUpdateVpnCidr.RLock()
+	calls = mock.calls.UpdateVpnCidr
+	mock.lockUpdateVpnCidr.RUnlock()
+	return calls
+}
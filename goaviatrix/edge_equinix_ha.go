@@ -51,7 +51,7 @@ func (c *Client) CreateEdgeEquinixHa(ctx context.Context, edgeEquinixHa *EdgeEqu
 		return "", err
 	}
 
-	fileName := edgeEquinixHa.ZtpFileDownloadPath + "/" + edgeEquinixHa.PrimaryGwName + "-hagw-cloud-init.txt"
+	fileName := edgeEquinixHa.ZtpFileDownloadPath + "/" + HaGatewayName(edgeEquinixHa.PrimaryGwName) + "-cloud-init.txt"
 
 	outFile, err := os.Create(fileName)
 	if err != nil {
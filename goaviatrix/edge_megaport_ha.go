@@ -52,7 +52,7 @@ func (c *Client) CreateEdgeMegaportHa(ctx context.Context, edgeMegaportHa *EdgeM
 		return "", err
 	}
 
-	fileName := edgeMegaportHa.ZtpFileDownloadPath + "/" + edgeMegaportHa.PrimaryGwName + "-hagw-cloud-init.txt"
+	fileName := edgeMegaportHa.ZtpFileDownloadPath + "/" + HaGatewayName(edgeMegaportHa.PrimaryGwName) + "-cloud-init.txt"
 
 	outFile, err := os.Create(fileName)
 	if err != nil {
@@ -4,14 +4,9 @@ import (
 	"context"
 	b64 "encoding/base64"
 	"encoding/json"
-	"fmt"
 	"io"
-	"math"
 	"os"
-	"strconv"
 	"strings"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 type EdgeSpoke struct {
@@ -419,47 +414,3 @@ func (c *Client) UpdateEdgeSpokeGeoCoordinate(ctx context.Context, edgeSpoke *Ed
 
 	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
-
-func ValidateEdgeSpokeLatitude(val interface{}, key string) (warns []string, errs []error) {
-	s, ok := val.(string)
-	if !ok {
-		errs = append(errs, fmt.Errorf("%s must be a string", key))
-		return
-	}
-
-	v, err := strconv.ParseFloat(s, 64)
-	if err != nil {
-		errs = append(errs, fmt.Errorf("%s must be a valid number", key))
-		return
-	}
-
-	if v < -90 || v > 90 {
-		errs = append(errs, fmt.Errorf("%s must be between -90 and 90", key))
-	}
-	return
-}
-
-func ValidateEdgeSpokeLongitude(val interface{}, key string) (warns []string, errs []error) {
-	s, ok := val.(string)
-	if !ok {
-		errs = append(errs, fmt.Errorf("%s must be a string", key))
-		return
-	}
-
-	v, err := strconv.ParseFloat(s, 64)
-	if err != nil {
-		errs = append(errs, fmt.Errorf("%s must be a valid number", key))
-		return
-	}
-
-	if v < -180 || v > 180 {
-		errs = append(errs, fmt.Errorf("%s must be between -180 and 180", key))
-	}
-	return
-}
-
-func DiffSuppressFuncEdgeSpokeCoordinate(k, old, new string, d *schema.ResourceData) bool {
-	o, _ := strconv.ParseFloat(old, 64)
-	n, _ := strconv.ParseFloat(new, 64)
-	return math.Round(o*1000000)/1000000 == math.Round(n*1000000)/1000000
-}
@@ -5,10 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"log/slog"
 	"strconv"
 	"strings"
-
-	log "github.com/sirupsen/logrus"
 )
 
 type Policy struct {
@@ -78,7 +77,7 @@ func (c *Client) GetPolicy(firewall *Firewall) (*Firewall, error) {
 	checkFunc := func(act, method, reason string, ret bool) error {
 		if !ret {
 			if strings.Contains(reason, "does not exist") {
-				log.Errorf("Couldn't find Aviatrix Firewall policies for gateway %s: %s", firewall.GwName, reason)
+				c.log(context.Background(), slog.LevelError, fmt.Sprintf("Couldn't find Aviatrix Firewall policies for gateway %s: %s", firewall.GwName, reason))
 				return ErrNotFound
 			}
 			return fmt.Errorf("rest API %s %s failed: %s", act, method, reason)
@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -91,6 +92,10 @@ type FirewallInstanceImage struct {
 }
 
 func (c *Client) CreateFirewallInstance(firewallInstance *FirewallInstance) (string, error) {
+	return c.CreateFirewallInstanceContext(context.Background(), firewallInstance)
+}
+
+func (c *Client) CreateFirewallInstanceContext(ctx context.Context, firewallInstance *FirewallInstance) (string, error) {
 	action := "add_firewall_instance"
 	form := map[string]string{
 		"CID":                    c.CID,
@@ -165,7 +170,7 @@ func (c *Client) CreateFirewallInstance(firewallInstance *FirewallInstance) (str
 	}
 
 	var data FirewallInstanceCreateResp
-	err := c.PostAPIWithResponse(&data, action, form, BasicCheck)
+	err := c.PostAPIContextWithResponse(ctx, &data, action, form, BasicCheck)
 	if err != nil {
 		return "", err
 	}
@@ -214,6 +219,10 @@ func (c *Client) GetFirewallInstance(firewallInstance *FirewallInstance) (*Firew
 }
 
 func (c *Client) DeleteFirewallInstance(firewallInstance *FirewallInstance) error {
+	return c.DeleteFirewallInstanceContext(context.Background(), firewallInstance)
+}
+
+func (c *Client) DeleteFirewallInstanceContext(ctx context.Context, firewallInstance *FirewallInstance) error {
 	form := map[string]string{
 		"CID":         c.CID,
 		"action":      "delete_firenet_firewall_instance",
@@ -222,7 +231,7 @@ func (c *Client) DeleteFirewallInstance(firewallInstance *FirewallInstance) erro
 		"async":       "true",
 	}
 
-	return c.PostAsyncAPI(form["action"], form, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) GetFirewallInstanceImages(vpcID string, versionCount int) (*[]FirewallInstanceImage, error) {
@@ -7,19 +7,25 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"reflect"
+	"log/slog"
 	"strconv"
 	"strings"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	log "github.com/sirupsen/logrus"
 )
 
 const (
 	gatewayPhase2PolicyEndpoint = "ipsec-peering-policy"
 )
 
+// HaGatewaySuffix is appended by the Controller to the name of a gateway to
+// name its HA gateway.
+const HaGatewaySuffix = "-hagw"
+
+// HaGatewayName returns the name the Controller gives the HA gateway of the
+// gateway gwName.
+func HaGatewayName(gwName string) string {
+	return gwName + HaGatewaySuffix
+}
+
 // Gateway simple struct to hold gateway details
 type Gateway struct {
 	AccountName                  string `form:"account_name,omitempty" json:"account_name,omitempty"`
@@ -421,14 +427,22 @@ type GatewayPhase2PolicyResponse struct {
 }
 
 func (c *Client) CreateGateway(gateway *Gateway) error {
+	return c.CreateGatewayContext(context.Background(), gateway)
+}
+
+func (c *Client) CreateGatewayContext(ctx context.Context, gateway *Gateway) error {
 	gateway.CID = c.CID
 	gateway.Action = "connect_container"
 	gateway.Async = true
 
-	return c.PostAsyncAPI(gateway.Action, gateway, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, gateway.Action, gateway, BasicCheck)
 }
 
 func (c *Client) CreatePublicSubnetFilteringGateway(gateway *Gateway) error {
+	return c.CreatePublicSubnetFilteringGatewayContext(context.Background(), gateway)
+}
+
+func (c *Client) CreatePublicSubnetFilteringGatewayContext(ctx context.Context, gateway *Gateway) error {
 	data := map[string]string{
 		"action":         "add_public_subnet_filtering_gateway",
 		"CID":            c.CID,
@@ -443,16 +457,20 @@ func (c *Client) CreatePublicSubnetFilteringGateway(gateway *Gateway) error {
 		"tag":            "",
 		"async":          "true",
 	}
-	return c.PostAsyncAPI(data["action"], data, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
 func (c *Client) DeletePublicSubnetFilteringGateway(gateway *Gateway) error {
+	return c.DeletePublicSubnetFilteringGatewayContext(context.Background(), gateway)
+}
+
+func (c *Client) DeletePublicSubnetFilteringGatewayContext(ctx context.Context, gateway *Gateway) error {
 	data := map[string]string{
 		"action":       "delete_public_subnet_filtering_gateway",
 		"CID":          c.CID,
 		"gateway_name": gateway.GwName,
 	}
-	return c.PostAPI(data["action"], data, BasicCheck)
+	return c.PostAPIContext(ctx, data["action"], data, BasicCheck)
 }
 
 func (c *Client) EnablePublicSubnetFilteringHAGateway(gateway *Gateway) error {
@@ -508,7 +526,7 @@ func (c *Client) EditPublicSubnetFilteringRouteTableList(gateway *Gateway, route
 }
 
 func (c *Client) EditPrivateRouteTableConfig(gateway *Gateway, routeTables []string) error {
-	log.Printf("[INFO] EditPrivateRouteTableConfig routeTables: %v", routeTables)
+	c.log(context.Background(), slog.LevelInfo, fmt.Sprintf("EditPrivateRouteTableConfig routeTables: %v", routeTables))
 	data := map[string]string{
 		"action":               "edit_private_route_tables",
 		"CID":                  c.CID,
@@ -522,7 +540,7 @@ func (c *Client) EditPrivateRouteTableConfig(gateway *Gateway, routeTables []str
 // routeTables uses AWS route table IDs (e.g. rtb-...) or Azure entries as name:resource_group.
 // An empty slice sends an empty route_tables value to clear selective configuration (controller default applies).
 func (c *Client) EditManagedRouteTables(gateway *Gateway, routeTables []string) error {
-	log.Printf("[INFO] EditManagedRouteTables gateway=%s routeTables: %v", gateway.GwName, routeTables)
+	c.log(context.Background(), slog.LevelInfo, fmt.Sprintf("EditManagedRouteTables gateway=%s routeTables: %v", gateway.GwName, routeTables))
 	data := map[string]string{
 		"action":       "edit_managed_route_tables",
 		"CID":          c.CID,
@@ -573,6 +591,10 @@ func (c *Client) DisableSingleAZGateway(gateway *Gateway) error {
 }
 
 func (c *Client) GetGateway(gateway *Gateway) (*Gateway, error) {
+	return c.GetGatewayContext(context.Background(), gateway)
+}
+
+func (c *Client) GetGatewayContext(ctx context.Context, gateway *Gateway) (*Gateway, error) {
 	action := "list_vpcs_summary"
 	params := map[string]string{
 		"CID":          c.CID,
@@ -581,7 +603,7 @@ func (c *Client) GetGateway(gateway *Gateway) (*Gateway, error) {
 	}
 
 	var data GatewayListResp
-	err := c.GetAPI(&data, action, params, BasicCheck)
+	err := c.GetAPIContext(ctx, &data, action, params, BasicCheck)
 	if err != nil {
 		return nil, err
 	}
@@ -595,7 +617,7 @@ func (c *Client) GetGateway(gateway *Gateway) (*Gateway, error) {
 			return &gwList[i], nil
 		}
 	}
-	log.Errorf("Couldn't find Aviatrix gateway %s", gateway.GwName)
+	c.log(ctx, slog.LevelError, fmt.Sprintf("Couldn't find Aviatrix gateway %s", gateway.GwName))
 	return nil, ErrNotFound
 }
 
@@ -659,19 +681,27 @@ func (c *Client) GetGatewayDetail(gateway *Gateway) (*GatewayDetail, error) {
 		return &data.Results, nil
 	}
 
-	log.Errorf("Couldn't find Aviatrix gateway %s", gateway.GwName)
+	c.log(context.Background(), slog.LevelError, fmt.Sprintf("Couldn't find Aviatrix gateway %s", gateway.GwName))
 	return nil, ErrNotFound
 }
 
 func (c *Client) UpdateGateway(gateway *Gateway) error {
+	return c.UpdateGatewayContext(context.Background(), gateway)
+}
+
+func (c *Client) UpdateGatewayContext(ctx context.Context, gateway *Gateway) error {
 	gateway.CID = c.CID
 	gateway.Action = "edit_gw_config"
 	gateway.Async = true
 
-	return c.PostAsyncAPI(gateway.Action, gateway, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, gateway.Action, gateway, BasicCheck)
 }
 
 func (c *Client) DeleteGateway(gateway *Gateway) error {
+	return c.DeleteGatewayContext(context.Background(), gateway)
+}
+
+func (c *Client) DeleteGatewayContext(ctx context.Context, gateway *Gateway) error {
 	form := map[string]string{
 		"CID":        c.CID,
 		"action":     "delete_container",
@@ -680,7 +710,7 @@ func (c *Client) DeleteGateway(gateway *Gateway) error {
 		"async":      "true",
 	}
 
-	return c.PostAsyncAPI(form["action"], form, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) EnableSNat(gateway *Gateway) error {
@@ -1356,142 +1386,6 @@ func (c *Client) SetRxQueueSize(gateway *Gateway) error {
 	return c.PostAPI(form["action"], form, BasicCheck)
 }
 
-func DiffSuppressFuncGatewaySNat(k, old, new string, d *schema.ResourceData) bool {
-	// connection_policy
-	raw := d.Get("connection_policy")
-	connectionPolicy, ok := raw.([]interface{})
-	if !ok {
-		return false
-	}
-	connectionPolicyOld := make([]map[string]interface{}, 0, len(connectionPolicy))
-	for _, policy := range connectionPolicy {
-		pl, ok := policy.(map[string]interface{})
-		if !ok {
-			return false
-		}
-		connectionPolicyOld = append(connectionPolicyOld, pl)
-	}
-
-	// interface_policy
-	raw = d.Get("interface_policy")
-	interfacePolicy, ok := raw.([]interface{})
-	if !ok {
-		return false
-	}
-	interfacePolicyOld := make([]map[string]interface{}, 0, len(interfacePolicy))
-	for _, policy := range interfacePolicy {
-		pl, ok := policy.(map[string]interface{})
-		if !ok {
-			return false
-		}
-		interfacePolicyOld = append(interfacePolicyOld, pl)
-	}
-
-	// snat_policy
-	raw = d.Get("snat_policy")
-	snatPolicies, ok := raw.([]interface{})
-	if !ok {
-		return false
-	}
-
-	connectionPolicyNew := make([]map[string]interface{}, 0)
-	interfacePolicyNew := make([]map[string]interface{}, 0)
-
-	for _, policy := range snatPolicies {
-		pl, ok := policy.(map[string]interface{})
-		if !ok {
-			return false
-		}
-
-		conn, ok := pl["connection"].(string)
-		if !ok {
-			return false
-		}
-		iface, ok := pl["interface"].(string)
-		if !ok {
-			return false
-		}
-
-		if conn != "None" {
-			connectionPolicyNew = append(connectionPolicyNew, pl)
-		}
-		if iface != "" {
-			interfacePolicyNew = append(interfacePolicyNew, pl)
-		}
-	}
-
-	return reflect.DeepEqual(connectionPolicyOld, connectionPolicyNew) &&
-		reflect.DeepEqual(interfacePolicyOld, interfacePolicyNew)
-}
-
-func DiffSuppressFuncGatewayDNat(k, old, new string, d *schema.ResourceData) bool {
-	// connection_policy
-	raw := d.Get("connection_policy")
-	connectionPolicy, ok := raw.([]interface{})
-	if !ok {
-		return false
-	}
-	connectionPolicyOld := make([]map[string]interface{}, 0, len(connectionPolicy))
-	for _, policy := range connectionPolicy {
-		pl, ok := policy.(map[string]interface{})
-		if !ok {
-			return false
-		}
-		connectionPolicyOld = append(connectionPolicyOld, pl)
-	}
-
-	// interface_policy
-	raw = d.Get("interface_policy")
-	interfacePolicy, ok := raw.([]interface{})
-	if !ok {
-		return false
-	}
-	interfacePolicyOld := make([]map[string]interface{}, 0, len(interfacePolicy))
-	for _, policy := range interfacePolicy {
-		pl, ok := policy.(map[string]interface{})
-		if !ok {
-			return false
-		}
-		interfacePolicyOld = append(interfacePolicyOld, pl)
-	}
-
-	// dnat_policy
-	raw = d.Get("dnat_policy")
-	dnatPolicies, ok := raw.([]interface{})
-	if !ok {
-		return false
-	}
-
-	connectionPolicyNew := make([]map[string]interface{}, 0)
-	interfacePolicyNew := make([]map[string]interface{}, 0)
-
-	for _, policy := range dnatPolicies {
-		pl, ok := policy.(map[string]interface{})
-		if !ok {
-			return false
-		}
-
-		conn, ok := pl["connection"].(string)
-		if !ok {
-			return false
-		}
-		iface, ok := pl["interface"].(string)
-		if !ok {
-			return false
-		}
-
-		if conn != "None" {
-			connectionPolicyNew = append(connectionPolicyNew, pl)
-		}
-		if iface != "" {
-			interfacePolicyNew = append(interfacePolicyNew, pl)
-		}
-	}
-
-	return reflect.DeepEqual(connectionPolicyOld, connectionPolicyNew) &&
-		reflect.DeepEqual(interfacePolicyOld, interfacePolicyNew)
-}
-
 func (c *Client) ChangeBgpOverLanIntfCnt(gateway *Gateway) error {
 	const action = "change_bgp_over_lan_intf_cnt"
 
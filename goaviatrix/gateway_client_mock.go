@@ -0,0 +1,10264 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package goaviatrix
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that GatewayClientMock does implement GatewayClient.
+// If this is not the case, regenerate this file with moq.
+var _ GatewayClient = &GatewayClientMock{}
+
+// GatewayClientMock is a mock implementation of GatewayClient.
+//
+//	func TestSomethingThatUsesGatewayClient(t *testing.T) {
+//
+//		// make and configure a mocked GatewayClient
+//		mockedGatewayClient := &GatewayClientMock{
+//			AddSpokeGatewaySubnetGroupFunc: func(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error {
+//				panic("mock out the AddSpokeGatewaySubnetGroup method")
+//			},
+//			AttachTransitGWForHybridFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the AttachTransitGWForHybrid method")
+//			},
+//			ChangeBgpHoldTimeFunc: func(gwName string, holdTime int) error {
+//				panic("mock out the ChangeBgpHoldTime method")
+//			},
+//			ChangeBgpHoldTimeGatewayGroupFunc: func(ctx context.Context, groupName string, holdTime int) error {
+//				panic("mock out the ChangeBgpHoldTimeGatewayGroup method")
+//			},
+//			ChangeBgpOverLanIntfCntFunc: func(gateway *Gateway) error {
+//				panic("mock out the ChangeBgpOverLanIntfCnt method")
+//			},
+//			CreateGatewayContextFunc: func(ctx context.Context, gateway *Gateway) error {
+//				panic("mock out the CreateGatewayContext method")
+//			},
+//			CreateGatewayGroupFunc: func(ctx context.Context, spokeGroup *GatewayGroup) error {
+//				panic("mock out the CreateGatewayGroup method")
+//			},
+//			CreatePublicSubnetFilteringGatewayContextFunc: func(ctx context.Context, gateway *Gateway) error {
+//				panic("mock out the CreatePublicSubnetFilteringGatewayContext method")
+//			},
+//			CreateSpokeHaGwFunc: func(spokeHaGateway *SpokeHaGateway) (string, error) {
+//				panic("mock out the CreateSpokeHaGw method")
+//			},
+//			CreateSpokeHaGwContextFunc: func(ctx context.Context, spokeHaGateway *SpokeHaGateway) (string, error) {
+//				panic("mock out the CreateSpokeHaGwContext method")
+//			},
+//			CreateTransitHaGwContextFunc: func(ctx context.Context, transitHaGateway *TransitHaGateway) (string, error) {
+//				panic("mock out the CreateTransitHaGwContext method")
+//			},
+//			DeleteGatewayFunc: func(gateway *Gateway) error {
+//				panic("mock out the DeleteGateway method")
+//			},
+//			DeleteGatewayContextFunc: func(ctx context.Context, gateway *Gateway) error {
+//				panic("mock out the DeleteGatewayContext method")
+//			},
+//			DeleteGatewayGroupFunc: func(ctx context.Context, groupUUID string) error {
+//				panic("mock out the DeleteGatewayGroup method")
+//			},
+//			DeletePublicSubnetFilteringGatewayContextFunc: func(ctx context.Context, gateway *Gateway) error {
+//				panic("mock out the DeletePublicSubnetFilteringGatewayContext method")
+//			},
+//			DeleteSpokeGatewaySubnetGroupFunc: func(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error {
+//				panic("mock out the DeleteSpokeGatewaySubnetGroup method")
+//			},
+//			DetachTransitGWForHybridFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the DetachTransitGWForHybrid method")
+//			},
+//			DisableActiveStandbyFunc: func(transitGateway *TransitVpc) error {
+//				panic("mock out the DisableActiveStandby method")
+//			},
+//			DisableActiveStandbyGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableActiveStandbyGatewayGroup method")
+//			},
+//			DisableActiveStandbySpokeFunc: func(spokeGateway *SpokeVpc) error {
+//				panic("mock out the DisableActiveStandbySpoke method")
+//			},
+//			DisableAdvertiseTransitCidrFunc: func(transitGw *TransitVpc) error {
+//				panic("mock out the DisableAdvertiseTransitCidr method")
+//			},
+//			DisableAdvertiseTransitCidrGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableAdvertiseTransitCidrGatewayGroup method")
+//			},
+//			DisableAutoAdvertiseS2CCidrsFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableAutoAdvertiseS2CCidrs method")
+//			},
+//			DisableAutoAdvertiseS2CCidrsGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableAutoAdvertiseS2CCidrsGatewayGroup method")
+//			},
+//			DisableConnectedTransitFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the DisableConnectedTransit method")
+//			},
+//			DisableConnectedTransitGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableConnectedTransitGatewayGroup method")
+//			},
+//			DisableCustomSNatFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableCustomSNat method")
+//			},
+//			DisableEgressTransitFirenetFunc: func(transitGateway *TransitVpc) error {
+//				panic("mock out the DisableEgressTransitFirenet method")
+//			},
+//			DisableFireNetGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableFireNetGatewayGroup method")
+//			},
+//			DisableGatewayFireNetInterfacesFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the DisableGatewayFireNetInterfaces method")
+//			},
+//			DisableGatewayGroupSNatFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableGatewayGroupSNat method")
+//			},
+//			DisableGatewayGroupVpcDNSServerFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableGatewayGroupVpcDNSServer method")
+//			},
+//			DisableGatewayLoadBalancerGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableGatewayLoadBalancerGatewayGroup method")
+//			},
+//			DisableGlobalVpcFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableGlobalVpc method")
+//			},
+//			DisableGlobalVpcGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableGlobalVpcGatewayGroup method")
+//			},
+//			DisableGroGsoFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableGroGso method")
+//			},
+//			DisableGroGsoGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableGroGsoGatewayGroup method")
+//			},
+//			DisableGuardDutyEnforcementFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableGuardDutyEnforcement method")
+//			},
+//			DisableHybridConnectionGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableHybridConnectionGatewayGroup method")
+//			},
+//			DisableIPv6Func: func(gateway *Gateway) error {
+//				panic("mock out the DisableIPv6 method")
+//			},
+//			DisableIPv6GatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableIPv6GatewayGroup method")
+//			},
+//			DisableJumboFrameFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableJumboFrame method")
+//			},
+//			DisableJumboFrameGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableJumboFrameGatewayGroup method")
+//			},
+//			DisableMonitorGatewaySubnetsFunc: func(gwName string) error {
+//				panic("mock out the DisableMonitorGatewaySubnets method")
+//			},
+//			DisableMultiTierTransitGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableMultiTierTransitGatewayGroup method")
+//			},
+//			DisableMultitierTransitFunc: func(gwName string) error {
+//				panic("mock out the DisableMultitierTransit method")
+//			},
+//			DisablePrivateVpcDefaultRouteFunc: func(gw *Gateway) error {
+//				panic("mock out the DisablePrivateVpcDefaultRoute method")
+//			},
+//			DisablePrivateVpcDefaultRouteGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisablePrivateVpcDefaultRouteGatewayGroup method")
+//			},
+//			DisableS2CRxBalancingFunc: func(gwName string) error {
+//				panic("mock out the DisableS2CRxBalancing method")
+//			},
+//			DisableS2cRxBalancingGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableS2cRxBalancingGatewayGroup method")
+//			},
+//			DisableSNatFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableSNat method")
+//			},
+//			DisableSegmentationFunc: func(transitGateway *TransitVpc) error {
+//				panic("mock out the DisableSegmentation method")
+//			},
+//			DisableSegmentationGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableSegmentationGatewayGroup method")
+//			},
+//			DisableSingleAZGatewayFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableSingleAZGateway method")
+//			},
+//			DisableSkipPublicRouteUpdateFunc: func(gw *Gateway) error {
+//				panic("mock out the DisableSkipPublicRouteUpdate method")
+//			},
+//			DisableSkipPublicRouteUpdateGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableSkipPublicRouteUpdateGatewayGroup method")
+//			},
+//			DisableSpokeLearnedCidrsApprovalFunc: func(gateway *SpokeVpc) error {
+//				panic("mock out the DisableSpokeLearnedCidrsApproval method")
+//			},
+//			DisableSpokeLearnedCidrsApprovalGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableSpokeLearnedCidrsApprovalGatewayGroup method")
+//			},
+//			DisableSpokeOnpremRoutePropagationFunc: func(spokeGateway *SpokeVpc) error {
+//				panic("mock out the DisableSpokeOnpremRoutePropagation method")
+//			},
+//			DisableSpokeOnpremRoutePropagationGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableSpokeOnpremRoutePropagationGatewayGroup method")
+//			},
+//			DisableSpokePreserveAsPathFunc: func(spokeGateway *SpokeVpc) error {
+//				panic("mock out the DisableSpokePreserveAsPath method")
+//			},
+//			DisableSpokePreserveAsPathGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableSpokePreserveAsPathGatewayGroup method")
+//			},
+//			DisableSummarizeCidrToTgwFunc: func(gwName string) error {
+//				panic("mock out the DisableSummarizeCidrToTgw method")
+//			},
+//			DisableTransitFireNetFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableTransitFireNet method")
+//			},
+//			DisableTransitFireNetGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableTransitFireNetGatewayGroup method")
+//			},
+//			DisableTransitLearnedCidrsApprovalFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the DisableTransitLearnedCidrsApproval method")
+//			},
+//			DisableTransitLearnedCidrsApprovalGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableTransitLearnedCidrsApprovalGatewayGroup method")
+//			},
+//			DisableTransitPreserveAsPathFunc: func(transitGateway *TransitVpc) error {
+//				panic("mock out the DisableTransitPreserveAsPath method")
+//			},
+//			DisableTransitPreserveAsPathGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableTransitPreserveAsPathGatewayGroup method")
+//			},
+//			DisableTransitSummarizeCidrToTgwGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the DisableTransitSummarizeCidrToTgwGatewayGroup method")
+//			},
+//			DisableVPNConfigFunc: func(gateway *Gateway, vpnConfig *VPNConfig) error {
+//				panic("mock out the DisableVPNConfig method")
+//			},
+//			DisableVpcDNSServerFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableVpcDNSServer method")
+//			},
+//			DisableVpnNatFunc: func(gateway *Gateway) error {
+//				panic("mock out the DisableVpnNat method")
+//			},
+//			EditDesignatedGatewayFunc: func(gateway *Gateway) error {
+//				panic("mock out the EditDesignatedGateway method")
+//			},
+//			EditGatewayAdvertisedCidrFunc: func(gateway *Gateway) error {
+//				panic("mock out the EditGatewayAdvertisedCidr method")
+//			},
+//			EditGatewayCustomRoutesFunc: func(gateway *Gateway) error {
+//				panic("mock out the EditGatewayCustomRoutes method")
+//			},
+//			EditGatewayFilterRoutesFunc: func(gateway *Gateway) error {
+//				panic("mock out the EditGatewayFilterRoutes method")
+//			},
+//			EditManagedRouteTablesFunc: func(gateway *Gateway, routeTables []string) error {
+//				panic("mock out the EditManagedRouteTables method")
+//			},
+//			EditManagedRouteTablesForGatewayGroupFunc: func(ctx context.Context, groupName string, routeTables []string) error {
+//				panic("mock out the EditManagedRouteTablesForGatewayGroup method")
+//			},
+//			EditPrivateRouteTableConfigFunc: func(gateway *Gateway, routeTables []string) error {
+//				panic("mock out the EditPrivateRouteTableConfig method")
+//			},
+//			EditPrivateRouteTableConfigForGatewayGroupFunc: func(ctx context.Context, groupName string, routeTables []string) error {
+//				panic("mock out the EditPrivateRouteTableConfigForGatewayGroup method")
+//			},
+//			EditPublicSubnetFilteringRouteTableListFunc: func(gateway *Gateway, routeTables []string) error {
+//				panic("mock out the EditPublicSubnetFilteringRouteTableList method")
+//			},
+//			EnableActiveStandbyFunc: func(transitGateway *TransitVpc) error {
+//				panic("mock out the EnableActiveStandby method")
+//			},
+//			EnableActiveStandbyGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableActiveStandbyGatewayGroup method")
+//			},
+//			EnableActiveStandbyPreemptiveFunc: func(transitGateway *TransitVpc) error {
+//				panic("mock out the EnableActiveStandbyPreemptive method")
+//			},
+//			EnableActiveStandbyPreemptiveGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableActiveStandbyPreemptiveGatewayGroup method")
+//			},
+//			EnableActiveStandbyPreemptiveSpokeFunc: func(spokeGateway *SpokeVpc) error {
+//				panic("mock out the EnableActiveStandbyPreemptiveSpoke method")
+//			},
+//			EnableActiveStandbySpokeFunc: func(spokeGateway *SpokeVpc) error {
+//				panic("mock out the EnableActiveStandbySpoke method")
+//			},
+//			EnableAdvertiseTransitCidrFunc: func(transitGw *TransitVpc) error {
+//				panic("mock out the EnableAdvertiseTransitCidr method")
+//			},
+//			EnableAdvertiseTransitCidrGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableAdvertiseTransitCidrGatewayGroup method")
+//			},
+//			EnableAutoAdvertiseS2CCidrsFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableAutoAdvertiseS2CCidrs method")
+//			},
+//			EnableAutoAdvertiseS2CCidrsGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableAutoAdvertiseS2CCidrsGatewayGroup method")
+//			},
+//			EnableConnectedTransitFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the EnableConnectedTransit method")
+//			},
+//			EnableConnectedTransitGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableConnectedTransitGatewayGroup method")
+//			},
+//			EnableCustomizedSNatFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableCustomizedSNat method")
+//			},
+//			EnableEgressTransitFirenetFunc: func(transitGateway *TransitVpc) error {
+//				panic("mock out the EnableEgressTransitFirenet method")
+//			},
+//			EnableEncryptVolumeFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableEncryptVolume method")
+//			},
+//			EnableFireNetGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableFireNetGatewayGroup method")
+//			},
+//			EnableGatewayFireNetInterfacesFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the EnableGatewayFireNetInterfaces method")
+//			},
+//			EnableGatewayFireNetInterfacesWithGWLBFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the EnableGatewayFireNetInterfacesWithGWLB method")
+//			},
+//			EnableGatewayGroupSNatFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableGatewayGroupSNat method")
+//			},
+//			EnableGatewayGroupVpcDNSServerFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableGatewayGroupVpcDNSServer method")
+//			},
+//			EnableGatewayLoadBalancerGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableGatewayLoadBalancerGatewayGroup method")
+//			},
+//			EnableGlobalVpcFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableGlobalVpc method")
+//			},
+//			EnableGlobalVpcGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableGlobalVpcGatewayGroup method")
+//			},
+//			EnableGroGsoFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableGroGso method")
+//			},
+//			EnableGroGsoGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableGroGsoGatewayGroup method")
+//			},
+//			EnableGuardDutyEnforcementFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableGuardDutyEnforcement method")
+//			},
+//			EnableHybridConnectionGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableHybridConnectionGatewayGroup method")
+//			},
+//			EnableIPv6Func: func(gateway *Gateway) error {
+//				panic("mock out the EnableIPv6 method")
+//			},
+//			EnableIPv6GatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableIPv6GatewayGroup method")
+//			},
+//			EnableJumboFrameFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableJumboFrame method")
+//			},
+//			EnableJumboFrameGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableJumboFrameGatewayGroup method")
+//			},
+//			EnableMonitorGatewaySubnetsFunc: func(gwName string, excludedInstances []string) error {
+//				panic("mock out the EnableMonitorGatewaySubnets method")
+//			},
+//			EnableMultiTierTransitGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableMultiTierTransitGatewayGroup method")
+//			},
+//			EnableMultitierTransitFunc: func(gwName string) error {
+//				panic("mock out the EnableMultitierTransit method")
+//			},
+//			EnablePeeringHaGatewayFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnablePeeringHaGateway method")
+//			},
+//			EnablePrivateVpcDefaultRouteFunc: func(gw *Gateway) error {
+//				panic("mock out the EnablePrivateVpcDefaultRoute method")
+//			},
+//			EnablePrivateVpcDefaultRouteGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnablePrivateVpcDefaultRouteGatewayGroup method")
+//			},
+//			EnablePublicSubnetFilteringHAGatewayFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnablePublicSubnetFilteringHAGateway method")
+//			},
+//			EnableS2CRxBalancingFunc: func(gwName string) error {
+//				panic("mock out the EnableS2CRxBalancing method")
+//			},
+//			EnableS2cRxBalancingGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableS2cRxBalancingGatewayGroup method")
+//			},
+//			EnableSNatFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableSNat method")
+//			},
+//			EnableSegmentationFunc: func(transitGateway *TransitVpc) error {
+//				panic("mock out the EnableSegmentation method")
+//			},
+//			EnableSegmentationGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableSegmentationGatewayGroup method")
+//			},
+//			EnableSingleAZGatewayFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableSingleAZGateway method")
+//			},
+//			EnableSkipPublicRouteUpdateFunc: func(gw *Gateway) error {
+//				panic("mock out the EnableSkipPublicRouteUpdate method")
+//			},
+//			EnableSkipPublicRouteUpdateGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableSkipPublicRouteUpdateGatewayGroup method")
+//			},
+//			EnableSpokeLearnedCidrsApprovalFunc: func(gateway *SpokeVpc) error {
+//				panic("mock out the EnableSpokeLearnedCidrsApproval method")
+//			},
+//			EnableSpokeLearnedCidrsApprovalGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableSpokeLearnedCidrsApprovalGatewayGroup method")
+//			},
+//			EnableSpokeOnpremRoutePropagationFunc: func(spokeGateway *SpokeVpc) error {
+//				panic("mock out the EnableSpokeOnpremRoutePropagation method")
+//			},
+//			EnableSpokeOnpremRoutePropagationGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableSpokeOnpremRoutePropagationGatewayGroup method")
+//			},
+//			EnableSpokePreserveAsPathFunc: func(spokeGateway *SpokeVpc) error {
+//				panic("mock out the EnableSpokePreserveAsPath method")
+//			},
+//			EnableSpokePreserveAsPathGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableSpokePreserveAsPathGatewayGroup method")
+//			},
+//			EnableSummarizeCidrToTgwFunc: func(gwName string) error {
+//				panic("mock out the EnableSummarizeCidrToTgw method")
+//			},
+//			EnableTransitFireNetFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableTransitFireNet method")
+//			},
+//			EnableTransitFireNetGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableTransitFireNetGatewayGroup method")
+//			},
+//			EnableTransitFireNetWithGWLBFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableTransitFireNetWithGWLB method")
+//			},
+//			EnableTransitFireNetWithGWLBGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableTransitFireNetWithGWLBGatewayGroup method")
+//			},
+//			EnableTransitLearnedCidrsApprovalFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the EnableTransitLearnedCidrsApproval method")
+//			},
+//			EnableTransitLearnedCidrsApprovalGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableTransitLearnedCidrsApprovalGatewayGroup method")
+//			},
+//			EnableTransitPreserveAsPathFunc: func(transitGateway *TransitVpc) error {
+//				panic("mock out the EnableTransitPreserveAsPath method")
+//			},
+//			EnableTransitPreserveAsPathGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableTransitPreserveAsPathGatewayGroup method")
+//			},
+//			EnableTransitSummarizeCidrToTgwGatewayGroupFunc: func(ctx context.Context, groupName string) error {
+//				panic("mock out the EnableTransitSummarizeCidrToTgwGatewayGroup method")
+//			},
+//			EnableVPNConfigFunc: func(gateway *Gateway, vpnConfig *VPNConfig) error {
+//				panic("mock out the EnableVPNConfig method")
+//			},
+//			EnableVpcDNSServerFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableVpcDNSServer method")
+//			},
+//			EnableVpnNatFunc: func(gateway *Gateway) error {
+//				panic("mock out the EnableVpnNat method")
+//			},
+//			GetBgpLanIPListFunc: func(transitGateway *TransitVpc) (*TransitGatewayBgpLanIpInfo, error) {
+//				panic("mock out the GetBgpLanIPList method")
+//			},
+//			GetControllerIPFunc: func() string {
+//				panic("mock out the GetControllerIP method")
+//			},
+//			GetDefaultTagsConfigFunc: func() *DefaultTagsConfig {
+//				panic("mock out the GetDefaultTagsConfig method")
+//			},
+//			GetGatewayFunc: func(gateway *Gateway) (*Gateway, error) {
+//				panic("mock out the GetGateway method")
+//			},
+//			GetGatewayBgpCommunitiesFunc: func(gwName string) (bool, bool, error) {
+//				panic("mock out the GetGatewayBgpCommunities method")
+//			},
+//			GetGatewayBgpMedToSdnMetricFunc: func(gwName string) (bool, error) {
+//				panic("mock out the GetGatewayBgpMedToSdnMetric method")
+//			},
+//			GetGatewayDetailFunc: func(gateway *Gateway) (*GatewayDetail, error) {
+//				panic("mock out the GetGatewayDetail method")
+//			},
+//			GetGatewayGroupFunc: func(ctx context.Context, groupUUID string) (*GatewayGroup, error) {
+//				panic("mock out the GetGatewayGroup method")
+//			},
+//			GetGatewayGroupByNameFunc: func(ctx context.Context, groupName string) (*GatewayGroup, error) {
+//				panic("mock out the GetGatewayGroupByName method")
+//			},
+//			GetGeoVPNNameFunc: func(gateway *Gateway) (*GeoVPN, error) {
+//				panic("mock out the GetGeoVPNName method")
+//			},
+//			GetGroGsoStatusFunc: func(gateway *Gateway) (bool, error) {
+//				panic("mock out the GetGroGsoStatus method")
+//			},
+//			GetIgnoreTagsConfigFunc: func() *IgnoreTagsConfig {
+//				panic("mock out the GetIgnoreTagsConfig method")
+//			},
+//			GetSplitTunnelFunc: func(splitTunnel *SplitTunnel) (*SplitTunnelUnit, error) {
+//				panic("mock out the GetSplitTunnel method")
+//			},
+//			GetSpokeGatewayAdvancedConfigFunc: func(spokeGateway *SpokeVpc) (*SpokeGatewayAdvancedConfig, error) {
+//				panic("mock out the GetSpokeGatewayAdvancedConfig method")
+//			},
+//			GetSpokeGatewayListFunc: func(ctx context.Context) ([]Gateway, error) {
+//				panic("mock out the GetSpokeGatewayList method")
+//			},
+//			GetSpokeGatewaySubnetGroupFunc: func(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error {
+//				panic("mock out the GetSpokeGatewaySubnetGroup method")
+//			},
+//			GetTagsFunc: func(tags *Tags) ([]string, error) {
+//				panic("mock out the GetTags method")
+//			},
+//			GetTransitGatewayAdvancedConfigFunc: func(transitGateway *TransitVpc) (*TransitGatewayAdvancedConfig, error) {
+//				panic("mock out the GetTransitGatewayAdvancedConfig method")
+//			},
+//			GetTransitGatewayLanCidrFunc: func(gatewayName string) (string, error) {
+//				panic("mock out the GetTransitGatewayLanCidr method")
+//			},
+//			GetTransitGatewayListFunc: func(ctx context.Context) ([]Gateway, error) {
+//				panic("mock out the GetTransitGatewayList method")
+//			},
+//			GetTunnelDetectionTimeFunc: func(entity string) (int, error) {
+//				panic("mock out the GetTunnelDetectionTime method")
+//			},
+//			IsTransitFireNetReadyToBeDisabledFunc: func(gateway *Gateway) error {
+//				panic("mock out the IsTransitFireNetReadyToBeDisabled method")
+//			},
+//			LaunchSpokeVpcContextFunc: func(ctx context.Context, spoke *SpokeVpc) error {
+//				panic("mock out the LaunchSpokeVpcContext method")
+//			},
+//			LaunchTransitVpcContextFunc: func(ctx context.Context, gateway *TransitVpc) error {
+//				panic("mock out the LaunchTransitVpcContext method")
+//			},
+//			ModifySplitTunnelFunc: func(splitTunnel *SplitTunnel) error {
+//				panic("mock out the ModifySplitTunnel method")
+//			},
+//			ModifyTunnelDetectionTimeFunc: func(entity string, detectionTime int) error {
+//				panic("mock out the ModifyTunnelDetectionTime method")
+//			},
+//			SetBgpBfdPollingTimeFunc: func(transitGateway *TransitVpc, newPollingTime int) error {
+//				panic("mock out the SetBgpBfdPollingTime method")
+//			},
+//			SetBgpBfdPollingTimeGatewayGroupFunc: func(ctx context.Context, groupName string, pollingTime int) error {
+//				panic("mock out the SetBgpBfdPollingTimeGatewayGroup method")
+//			},
+//			SetBgpBfdPollingTimeSpokeFunc: func(spokeGateway *SpokeVpc, newPollingTime int) error {
+//				panic("mock out the SetBgpBfdPollingTimeSpoke method")
+//			},
+//			SetBgpEcmpFunc: func(transitGateway *TransitVpc, enabled bool) error {
+//				panic("mock out the SetBgpEcmp method")
+//			},
+//			SetBgpEcmpGatewayGroupFunc: func(ctx context.Context, groupName string, enable bool) error {
+//				panic("mock out the SetBgpEcmpGatewayGroup method")
+//			},
+//			SetBgpEcmpSpokeFunc: func(spokeGateway *SpokeVpc, enabled bool) error {
+//				panic("mock out the SetBgpEcmpSpoke method")
+//			},
+//			SetBgpManualSpokeAdvertisedNetworksFunc: func(transitGw *TransitVpc) error {
+//				panic("mock out the SetBgpManualSpokeAdvertisedNetworks method")
+//			},
+//			SetBgpPollingTimeFunc: func(transitGateway *TransitVpc, newPollingTime int) error {
+//				panic("mock out the SetBgpPollingTime method")
+//			},
+//			SetBgpPollingTimeGatewayGroupFunc: func(ctx context.Context, groupName string, pollingTime int) error {
+//				panic("mock out the SetBgpPollingTimeGatewayGroup method")
+//			},
+//			SetBgpPollingTimeSpokeFunc: func(spokeGateway *SpokeVpc, newPollingTime int) error {
+//				panic("mock out the SetBgpPollingTimeSpoke method")
+//			},
+//			SetGatewayBgpCommunitiesAcceptFunc: func(gwName string, acceptComm bool) error {
+//				panic("mock out the SetGatewayBgpCommunitiesAccept method")
+//			},
+//			SetGatewayBgpCommunitiesSendFunc: func(gwName string, sendComm bool) error {
+//				panic("mock out the SetGatewayBgpCommunitiesSend method")
+//			},
+//			SetGatewayBgpMedToSdnMetricFunc: func(gwName string, override bool) error {
+//				panic("mock out the SetGatewayBgpMedToSdnMetric method")
+//			},
+//			SetGatewayGroupBgpCommunitiesAcceptFunc: func(ctx context.Context, groupName string, accept bool) error {
+//				panic("mock out the SetGatewayGroupBgpCommunitiesAccept method")
+//			},
+//			SetGatewayGroupBgpCommunitiesSendFunc: func(ctx context.Context, groupName string, send bool) error {
+//				panic("mock out the SetGatewayGroupBgpCommunitiesSend method")
+//			},
+//			SetGatewayPhase2PolicyFunc: func(gwName string, encPolicy string, pfsPolicy string) error {
+//				panic("mock out the SetGatewayPhase2Policy method")
+//			},
+//			SetLocalASNumberFunc: func(transitGateway *TransitVpc, localASNumber string) error {
+//				panic("mock out the SetLocalASNumber method")
+//			},
+//			SetLocalASNumberGatewayGroupFunc: func(ctx context.Context, groupName string, localAsNumber string) error {
+//				panic("mock out the SetLocalASNumberGatewayGroup method")
+//			},
+//			SetLocalASNumberSpokeFunc: func(spokeGateway *SpokeVpc, localASNumber string) error {
+//				panic("mock out the SetLocalASNumberSpoke method")
+//			},
+//			SetPrependASPathFunc: func(transitGateway *TransitVpc, prependASPath []string) error {
+//				panic("mock out the SetPrependASPath method")
+//			},
+//			SetPrependASPathGatewayGroupFunc: func(ctx context.Context, groupName string, prependAsPath []string) error {
+//				panic("mock out the SetPrependASPathGatewayGroup method")
+//			},
+//			SetPrependASPathSpokeFunc: func(spokeGateway *SpokeVpc, prependASPath []string) error {
+//				panic("mock out the SetPrependASPathSpoke method")
+//			},
+//			SetRxQueueSizeFunc: func(gateway *Gateway) error {
+//				panic("mock out the SetRxQueueSize method")
+//			},
+//			SetSpokeBgpManualAdvertisedNetworksFunc: func(spokeGateway *SpokeVpc) error {
+//				panic("mock out the SetSpokeBgpManualAdvertisedNetworks method")
+//			},
+//			SetSpokeBgpManualAdvertisedNetworksGatewayGroupFunc: func(ctx context.Context, groupName string, cidrs string) error {
+//				panic("mock out the SetSpokeBgpManualAdvertisedNetworksGatewayGroup method")
+//			},
+//			SetSymmetricRoutingGatewayGroupFunc: func(ctx context.Context, groupUUID string, enable bool) error {
+//				panic("mock out the SetSymmetricRoutingGatewayGroup method")
+//			},
+//			SetTransitLearnedCIDRsApprovalModeFunc: func(gw *TransitVpc, mode string) error {
+//				panic("mock out the SetTransitLearnedCIDRsApprovalMode method")
+//			},
+//			SetVpnGatewayAuthenticationFunc: func(gateway *VpnGatewayAuth) error {
+//				panic("mock out the SetVpnGatewayAuthentication method")
+//			},
+//			UpdateDNatFunc: func(gateway *Gateway) error {
+//				panic("mock out the UpdateDNat method")
+//			},
+//			UpdateEdgeGatewayFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the UpdateEdgeGateway method")
+//			},
+//			UpdateEdgeGatewayV2Func: func(ctx context.Context, gateway *TransitVpc) error {
+//				panic("mock out the UpdateEdgeGatewayV2 method")
+//			},
+//			UpdateGatewayFunc: func(gateway *Gateway) error {
+//				panic("mock out the UpdateGateway method")
+//			},
+//			UpdateGatewayContextFunc: func(ctx context.Context, gateway *Gateway) error {
+//				panic("mock out the UpdateGatewayContext method")
+//			},
+//			UpdateGroupInstanceSizeFunc: func(ctx context.Context, groupName string, instanceSize string) error {
+//				panic("mock out the UpdateGroupInstanceSize method")
+//			},
+//			UpdateMaxVpnConnFunc: func(gateway *Gateway) error {
+//				panic("mock out the UpdateMaxVpnConn method")
+//			},
+//			UpdateSpokeGatewaySubnetGroupFunc: func(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error {
+//				panic("mock out the UpdateSpokeGatewaySubnetGroup method")
+//			},
+//			UpdateSpokePendingApprovedCidrsFunc: func(gateway *SpokeVpc) error {
+//				panic("mock out the UpdateSpokePendingApprovedCidrs method")
+//			},
+//			UpdateSpokePendingApprovedCidrsGatewayGroupFunc: func(ctx context.Context, groupName string, approvedCidrs []string) error {
+//				panic("mock out the UpdateSpokePendingApprovedCidrsGatewayGroup method")
+//			},
+//			UpdateTagsFunc: func(tags *Tags) error {
+//				panic("mock out the UpdateTags method")
+//			},
+//			UpdateTransitGatewayCustomizedVpcRouteFunc: func(gateway string, customizedTransitVpcRoutes []string) error {
+//				panic("mock out the UpdateTransitGatewayCustomizedVpcRoute method")
+//			},
+//			UpdateTransitPendingApprovedCidrsFunc: func(gateway *TransitVpc) error {
+//				panic("mock out the UpdateTransitPendingApprovedCidrs method")
+//			},
+//			UpdateTransitPendingApprovedCidrsGatewayGroupFunc: func(ctx context.Context, groupName string, approvedCidrs []string) error {
+//				panic("mock out the UpdateTransitPendingApprovedCidrsGatewayGroup method")
+//			},
+//			UpdateVpnCidrFunc: func(gateway *Gateway) error {
+//				panic("mock out the UpdateVpnCidr method")
+//			},
+//		}
+//
+//		// use mockedGatewayClient in code that requires GatewayClient
+//		// and then make assertions.
+//
+//	}
+type GatewayClientMock struct {
+	// AddSpokeGatewaySubnetGroupFunc mocks the AddSpokeGatewaySubnetGroup method.
+	AddSpokeGatewaySubnetGroupFunc func(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error
+
+	// AttachTransitGWForHybridFunc mocks the AttachTransitGWForHybrid method.
+	AttachTransitGWForHybridFunc func(gateway *TransitVpc) error
+
+	// ChangeBgpHoldTimeFunc mocks the ChangeBgpHoldTime method.
+	ChangeBgpHoldTimeFunc func(gwName string, holdTime int) error
+
+	// ChangeBgpHoldTimeGatewayGroupFunc mocks the ChangeBgpHoldTimeGatewayGroup method.
+	ChangeBgpHoldTimeGatewayGroupFunc func(ctx context.Context, groupName string, holdTime int) error
+
+	// ChangeBgpOverLanIntfCntFunc mocks the ChangeBgpOverLanIntfCnt method.
+	ChangeBgpOverLanIntfCntFunc func(gateway *Gateway) error
+
+	// CreateGatewayContextFunc mocks the CreateGatewayContext method.
+	CreateGatewayContextFunc func(ctx context.Context, gateway *Gateway) error
+
+	// CreateGatewayGroupFunc mocks the CreateGatewayGroup method.
+	CreateGatewayGroupFunc func(ctx context.Context, spokeGroup *GatewayGroup) error
+
+	// CreatePublicSubnetFilteringGatewayContextFunc mocks the CreatePublicSubnetFilteringGatewayContext method.
+	CreatePublicSubnetFilteringGatewayContextFunc func(ctx context.Context, gateway *Gateway) error
+
+	// CreateSpokeHaGwFunc mocks the CreateSpokeHaGw method.
+	CreateSpokeHaGwFunc func(spokeHaGateway *SpokeHaGateway) (string, error)
+
+	// CreateSpokeHaGwContextFunc mocks the CreateSpokeHaGwContext method.
+	CreateSpokeHaGwContextFunc func(ctx context.Context, spokeHaGateway *SpokeHaGateway) (string, error)
+
+	// CreateTransitHaGwContextFunc mocks the CreateTransitHaGwContext method.
+	CreateTransitHaGwContextFunc func(ctx context.Context, transitHaGateway *TransitHaGateway) (string, error)
+
+	// DeleteGatewayFunc mocks the DeleteGateway method.
+	DeleteGatewayFunc func(gateway *Gateway) error
+
+	// DeleteGatewayContextFunc mocks the DeleteGatewayContext method.
+	DeleteGatewayContextFunc func(ctx context.Context, gateway *Gateway) error
+
+	// DeleteGatewayGroupFunc mocks the DeleteGatewayGroup method.
+	DeleteGatewayGroupFunc func(ctx context.Context, groupUUID string) error
+
+	// DeletePublicSubnetFilteringGatewayContextFunc mocks the DeletePublicSubnetFilteringGatewayContext method.
+	DeletePublicSubnetFilteringGatewayContextFunc func(ctx context.Context, gateway *Gateway) error
+
+	// DeleteSpokeGatewaySubnetGroupFunc mocks the DeleteSpokeGatewaySubnetGroup method.
+	DeleteSpokeGatewaySubnetGroupFunc func(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error
+
+	// DetachTransitGWForHybridFunc mocks the DetachTransitGWForHybrid method.
+	DetachTransitGWForHybridFunc func(gateway *TransitVpc) error
+
+	// DisableActiveStandbyFunc mocks the DisableActiveStandby method.
+	DisableActiveStandbyFunc func(transitGateway *TransitVpc) error
+
+	// DisableActiveStandbyGatewayGroupFunc mocks the DisableActiveStandbyGatewayGroup method.
+	DisableActiveStandbyGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableActiveStandbySpokeFunc mocks the DisableActiveStandbySpoke method.
+	DisableActiveStandbySpokeFunc func(spokeGateway *SpokeVpc) error
+
+	// DisableAdvertiseTransitCidrFunc mocks the DisableAdvertiseTransitCidr method.
+	DisableAdvertiseTransitCidrFunc func(transitGw *TransitVpc) error
+
+	// DisableAdvertiseTransitCidrGatewayGroupFunc mocks the DisableAdvertiseTransitCidrGatewayGroup method.
+	DisableAdvertiseTransitCidrGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableAutoAdvertiseS2CCidrsFunc mocks the DisableAutoAdvertiseS2CCidrs method.
+	DisableAutoAdvertiseS2CCidrsFunc func(gateway *Gateway) error
+
+	// DisableAutoAdvertiseS2CCidrsGatewayGroupFunc mocks the DisableAutoAdvertiseS2CCidrsGatewayGroup method.
+	DisableAutoAdvertiseS2CCidrsGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableConnectedTransitFunc mocks the DisableConnectedTransit method.
+	DisableConnectedTransitFunc func(gateway *TransitVpc) error
+
+	// DisableConnectedTransitGatewayGroupFunc mocks the DisableConnectedTransitGatewayGroup method.
+	DisableConnectedTransitGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableCustomSNatFunc mocks the DisableCustomSNat method.
+	DisableCustomSNatFunc func(gateway *Gateway) error
+
+	// DisableEgressTransitFirenetFunc mocks the DisableEgressTransitFirenet method.
+	DisableEgressTransitFirenetFunc func(transitGateway *TransitVpc) error
+
+	// DisableFireNetGatewayGroupFunc mocks the DisableFireNetGatewayGroup method.
+	DisableFireNetGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableGatewayFireNetInterfacesFunc mocks the DisableGatewayFireNetInterfaces method.
+	DisableGatewayFireNetInterfacesFunc func(gateway *TransitVpc) error
+
+	// DisableGatewayGroupSNatFunc mocks the DisableGatewayGroupSNat method.
+	DisableGatewayGroupSNatFunc func(ctx context.Context, groupName string) error
+
+	// DisableGatewayGroupVpcDNSServerFunc mocks the DisableGatewayGroupVpcDNSServer method.
+	DisableGatewayGroupVpcDNSServerFunc func(ctx context.Context, groupName string) error
+
+	// DisableGatewayLoadBalancerGatewayGroupFunc mocks the DisableGatewayLoadBalancerGatewayGroup method.
+	DisableGatewayLoadBalancerGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableGlobalVpcFunc mocks the DisableGlobalVpc method.
+	DisableGlobalVpcFunc func(gateway *Gateway) error
+
+	// DisableGlobalVpcGatewayGroupFunc mocks the DisableGlobalVpcGatewayGroup method.
+	DisableGlobalVpcGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableGroGsoFunc mocks the DisableGroGso method.
+	DisableGroGsoFunc func(gateway *Gateway) error
+
+	// DisableGroGsoGatewayGroupFunc mocks the DisableGroGsoGatewayGroup method.
+	DisableGroGsoGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableGuardDutyEnforcementFunc mocks the DisableGuardDutyEnforcement method.
+	DisableGuardDutyEnforcementFunc func(gateway *Gateway) error
+
+	// DisableHybridConnectionGatewayGroupFunc mocks the DisableHybridConnectionGatewayGroup method.
+	DisableHybridConnectionGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableIPv6Func mocks the DisableIPv6 method.
+	DisableIPv6Func func(gateway *Gateway) error
+
+	// DisableIPv6GatewayGroupFunc mocks the DisableIPv6GatewayGroup method.
+	DisableIPv6GatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableJumboFrameFunc mocks the DisableJumboFrame method.
+	DisableJumboFrameFunc func(gateway *Gateway) error
+
+	// DisableJumboFrameGatewayGroupFunc mocks the DisableJumboFrameGatewayGroup method.
+	DisableJumboFrameGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableMonitorGatewaySubnetsFunc mocks the DisableMonitorGatewaySubnets method.
+	DisableMonitorGatewaySubnetsFunc func(gwName string) error
+
+	// DisableMultiTierTransitGatewayGroupFunc mocks the DisableMultiTierTransitGatewayGroup method.
+	DisableMultiTierTransitGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableMultitierTransitFunc mocks the DisableMultitierTransit method.
+	DisableMultitierTransitFunc func(gwName string) error
+
+	// DisablePrivateVpcDefaultRouteFunc mocks the DisablePrivateVpcDefaultRoute method.
+	DisablePrivateVpcDefaultRouteFunc func(gw *Gateway) error
+
+	// DisablePrivateVpcDefaultRouteGatewayGroupFunc mocks the DisablePrivateVpcDefaultRouteGatewayGroup method.
+	DisablePrivateVpcDefaultRouteGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableS2CRxBalancingFunc mocks the DisableS2CRxBalancing method.
+	DisableS2CRxBalancingFunc func(gwName string) error
+
+	// DisableS2cRxBalancingGatewayGroupFunc mocks the DisableS2cRxBalancingGatewayGroup method.
+	DisableS2cRxBalancingGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableSNatFunc mocks the DisableSNat method.
+	DisableSNatFunc func(gateway *Gateway) error
+
+	// DisableSegmentationFunc mocks the DisableSegmentation method.
+	DisableSegmentationFunc func(transitGateway *TransitVpc) error
+
+	// DisableSegmentationGatewayGroupFunc mocks the DisableSegmentationGatewayGroup method.
+	DisableSegmentationGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableSingleAZGatewayFunc mocks the DisableSingleAZGateway method.
+	DisableSingleAZGatewayFunc func(gateway *Gateway) error
+
+	// DisableSkipPublicRouteUpdateFunc mocks the DisableSkipPublicRouteUpdate method.
+	DisableSkipPublicRouteUpdateFunc func(gw *Gateway) error
+
+	// DisableSkipPublicRouteUpdateGatewayGroupFunc mocks the DisableSkipPublicRouteUpdateGatewayGroup method.
+	DisableSkipPublicRouteUpdateGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableSpokeLearnedCidrsApprovalFunc mocks the DisableSpokeLearnedCidrsApproval method.
+	DisableSpokeLearnedCidrsApprovalFunc func(gateway *SpokeVpc) error
+
+	// DisableSpokeLearnedCidrsApprovalGatewayGroupFunc mocks the DisableSpokeLearnedCidrsApprovalGatewayGroup method.
+	DisableSpokeLearnedCidrsApprovalGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableSpokeOnpremRoutePropagationFunc mocks the DisableSpokeOnpremRoutePropagation method.
+	DisableSpokeOnpremRoutePropagationFunc func(spokeGateway *SpokeVpc) error
+
+	// DisableSpokeOnpremRoutePropagationGatewayGroupFunc mocks the DisableSpokeOnpremRoutePropagationGatewayGroup method.
+	DisableSpokeOnpremRoutePropagationGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableSpokePreserveAsPathFunc mocks the DisableSpokePreserveAsPath method.
+	DisableSpokePreserveAsPathFunc func(spokeGateway *SpokeVpc) error
+
+	// DisableSpokePreserveAsPathGatewayGroupFunc mocks the DisableSpokePreserveAsPathGatewayGroup method.
+	DisableSpokePreserveAsPathGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableSummarizeCidrToTgwFunc mocks the DisableSummarizeCidrToTgw method.
+	DisableSummarizeCidrToTgwFunc func(gwName string) error
+
+	// DisableTransitFireNetFunc mocks the DisableTransitFireNet method.
+	DisableTransitFireNetFunc func(gateway *Gateway) error
+
+	// DisableTransitFireNetGatewayGroupFunc mocks the DisableTransitFireNetGatewayGroup method.
+	DisableTransitFireNetGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableTransitLearnedCidrsApprovalFunc mocks the DisableTransitLearnedCidrsApproval method.
+	DisableTransitLearnedCidrsApprovalFunc func(gateway *TransitVpc) error
+
+	// DisableTransitLearnedCidrsApprovalGatewayGroupFunc mocks the DisableTransitLearnedCidrsApprovalGatewayGroup method.
+	DisableTransitLearnedCidrsApprovalGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableTransitPreserveAsPathFunc mocks the DisableTransitPreserveAsPath method.
+	DisableTransitPreserveAsPathFunc func(transitGateway *TransitVpc) error
+
+	// DisableTransitPreserveAsPathGatewayGroupFunc mocks the DisableTransitPreserveAsPathGatewayGroup method.
+	DisableTransitPreserveAsPathGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableTransitSummarizeCidrToTgwGatewayGroupFunc mocks the DisableTransitSummarizeCidrToTgwGatewayGroup method.
+	DisableTransitSummarizeCidrToTgwGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// DisableVPNConfigFunc mocks the DisableVPNConfig method.
+	DisableVPNConfigFunc func(gateway *Gateway, vpnConfig *VPNConfig) error
+
+	// DisableVpcDNSServerFunc mocks the DisableVpcDNSServer method.
+	DisableVpcDNSServerFunc func(gateway *Gateway) error
+
+	// DisableVpnNatFunc mocks the DisableVpnNat method.
+	DisableVpnNatFunc func(gateway *Gateway) error
+
+	// EditDesignatedGatewayFunc mocks the EditDesignatedGateway method.
+	EditDesignatedGatewayFunc func(gateway *Gateway) error
+
+	// EditGatewayAdvertisedCidrFunc mocks the EditGatewayAdvertisedCidr method.
+	EditGatewayAdvertisedCidrFunc func(gateway *Gateway) error
+
+	// EditGatewayCustomRoutesFunc mocks the EditGatewayCustomRoutes method.
+	EditGatewayCustomRoutesFunc func(gateway *Gateway) error
+
+	// EditGatewayFilterRoutesFunc mocks the EditGatewayFilterRoutes method.
+	EditGatewayFilterRoutesFunc func(gateway *Gateway) error
+
+	// EditManagedRouteTablesFunc mocks the EditManagedRouteTables method.
+	EditManagedRouteTablesFunc func(gateway *Gateway, routeTables []string) error
+
+	// EditManagedRouteTablesForGatewayGroupFunc mocks the EditManagedRouteTablesForGatewayGroup method.
+	EditManagedRouteTablesForGatewayGroupFunc func(ctx context.Context, groupName string, routeTables []string) error
+
+	// EditPrivateRouteTableConfigFunc mocks the EditPrivateRouteTableConfig method.
+	EditPrivateRouteTableConfigFunc func(gateway *Gateway, routeTables []string) error
+
+	// EditPrivateRouteTableConfigForGatewayGroupFunc mocks the EditPrivateRouteTableConfigForGatewayGroup method.
+	EditPrivateRouteTableConfigForGatewayGroupFunc func(ctx context.Context, groupName string, routeTables []string) error
+
+	// EditPublicSubnetFilteringRouteTableListFunc mocks the EditPublicSubnetFilteringRouteTableList method.
+	EditPublicSubnetFilteringRouteTableListFunc func(gateway *Gateway, routeTables []string) error
+
+	// EnableActiveStandbyFunc mocks the EnableActiveStandby method.
+	EnableActiveStandbyFunc func(transitGateway *TransitVpc) error
+
+	// EnableActiveStandbyGatewayGroupFunc mocks the EnableActiveStandbyGatewayGroup method.
+	EnableActiveStandbyGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableActiveStandbyPreemptiveFunc mocks the EnableActiveStandbyPreemptive method.
+	EnableActiveStandbyPreemptiveFunc func(transitGateway *TransitVpc) error
+
+	// EnableActiveStandbyPreemptiveGatewayGroupFunc mocks the EnableActiveStandbyPreemptiveGatewayGroup method.
+	EnableActiveStandbyPreemptiveGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableActiveStandbyPreemptiveSpokeFunc mocks the EnableActiveStandbyPreemptiveSpoke method.
+	EnableActiveStandbyPreemptiveSpokeFunc func(spokeGateway *SpokeVpc) error
+
+	// EnableActiveStandbySpokeFunc mocks the EnableActiveStandbySpoke method.
+	EnableActiveStandbySpokeFunc func(spokeGateway *SpokeVpc) error
+
+	// EnableAdvertiseTransitCidrFunc mocks the EnableAdvertiseTransitCidr method.
+	EnableAdvertiseTransitCidrFunc func(transitGw *TransitVpc) error
+
+	// EnableAdvertiseTransitCidrGatewayGroupFunc mocks the EnableAdvertiseTransitCidrGatewayGroup method.
+	EnableAdvertiseTransitCidrGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableAutoAdvertiseS2CCidrsFunc mocks the EnableAutoAdvertiseS2CCidrs method.
+	EnableAutoAdvertiseS2CCidrsFunc func(gateway *Gateway) error
+
+	// EnableAutoAdvertiseS2CCidrsGatewayGroupFunc mocks the EnableAutoAdvertiseS2CCidrsGatewayGroup method.
+	EnableAutoAdvertiseS2CCidrsGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableConnectedTransitFunc mocks the EnableConnectedTransit method.
+	EnableConnectedTransitFunc func(gateway *TransitVpc) error
+
+	// EnableConnectedTransitGatewayGroupFunc mocks the EnableConnectedTransitGatewayGroup method.
+	EnableConnectedTransitGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableCustomizedSNatFunc mocks the EnableCustomizedSNat method.
+	EnableCustomizedSNatFunc func(gateway *Gateway) error
+
+	// EnableEgressTransitFirenetFunc mocks the EnableEgressTransitFirenet method.
+	EnableEgressTransitFirenetFunc func(transitGateway *TransitVpc) error
+
+	// EnableEncryptVolumeFunc mocks the EnableEncryptVolume method.
+	EnableEncryptVolumeFunc func(gateway *Gateway) error
+
+	// EnableFireNetGatewayGroupFunc mocks the EnableFireNetGatewayGroup method.
+	EnableFireNetGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableGatewayFireNetInterfacesFunc mocks the EnableGatewayFireNetInterfaces method.
+	EnableGatewayFireNetInterfacesFunc func(gateway *TransitVpc) error
+
+	// EnableGatewayFireNetInterfacesWithGWLBFunc mocks the EnableGatewayFireNetInterfacesWithGWLB method.
+	EnableGatewayFireNetInterfacesWithGWLBFunc func(gateway *TransitVpc) error
+
+	// EnableGatewayGroupSNatFunc mocks the EnableGatewayGroupSNat method.
+	EnableGatewayGroupSNatFunc func(ctx context.Context, groupName string) error
+
+	// EnableGatewayGroupVpcDNSServerFunc mocks the EnableGatewayGroupVpcDNSServer method.
+	EnableGatewayGroupVpcDNSServerFunc func(ctx context.Context, groupName string) error
+
+	// EnableGatewayLoadBalancerGatewayGroupFunc mocks the EnableGatewayLoadBalancerGatewayGroup method.
+	EnableGatewayLoadBalancerGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableGlobalVpcFunc mocks the EnableGlobalVpc method.
+	EnableGlobalVpcFunc func(gateway *Gateway) error
+
+	// EnableGlobalVpcGatewayGroupFunc mocks the EnableGlobalVpcGatewayGroup method.
+	EnableGlobalVpcGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableGroGsoFunc mocks the EnableGroGso method.
+	EnableGroGsoFunc func(gateway *Gateway) error
+
+	// EnableGroGsoGatewayGroupFunc mocks the EnableGroGsoGatewayGroup method.
+	EnableGroGsoGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableGuardDutyEnforcementFunc mocks the EnableGuardDutyEnforcement method.
+	EnableGuardDutyEnforcementFunc func(gateway *Gateway) error
+
+	// EnableHybridConnectionGatewayGroupFunc mocks the EnableHybridConnectionGatewayGroup method.
+	EnableHybridConnectionGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableIPv6Func mocks the EnableIPv6 method.
+	EnableIPv6Func func(gateway *Gateway) error
+
+	// EnableIPv6GatewayGroupFunc mocks the EnableIPv6GatewayGroup method.
+	EnableIPv6GatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableJumboFrameFunc mocks the EnableJumboFrame method.
+	EnableJumboFrameFunc func(gateway *Gateway) error
+
+	// EnableJumboFrameGatewayGroupFunc mocks the EnableJumboFrameGatewayGroup method.
+	EnableJumboFrameGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableMonitorGatewaySubnetsFunc mocks the EnableMonitorGatewaySubnets method.
+	EnableMonitorGatewaySubnetsFunc func(gwName string, excludedInstances []string) error
+
+	// EnableMultiTierTransitGatewayGroupFunc mocks the EnableMultiTierTransitGatewayGroup method.
+	EnableMultiTierTransitGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableMultitierTransitFunc mocks the EnableMultitierTransit method.
+	EnableMultitierTransitFunc func(gwName string) error
+
+	// EnablePeeringHaGatewayFunc mocks the EnablePeeringHaGateway method.
+	EnablePeeringHaGatewayFunc func(gateway *Gateway) error
+
+	// EnablePrivateVpcDefaultRouteFunc mocks the EnablePrivateVpcDefaultRoute method.
+	EnablePrivateVpcDefaultRouteFunc func(gw *Gateway) error
+
+	// EnablePrivateVpcDefaultRouteGatewayGroupFunc mocks the EnablePrivateVpcDefaultRouteGatewayGroup method.
+	EnablePrivateVpcDefaultRouteGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnablePublicSubnetFilteringHAGatewayFunc mocks the EnablePublicSubnetFilteringHAGateway method.
+	EnablePublicSubnetFilteringHAGatewayFunc func(gateway *Gateway) error
+
+	// EnableS2CRxBalancingFunc mocks the EnableS2CRxBalancing method.
+	EnableS2CRxBalancingFunc func(gwName string) error
+
+	// EnableS2cRxBalancingGatewayGroupFunc mocks the EnableS2cRxBalancingGatewayGroup method.
+	EnableS2cRxBalancingGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableSNatFunc mocks the EnableSNat method.
+	EnableSNatFunc func(gateway *Gateway) error
+
+	// EnableSegmentationFunc mocks the EnableSegmentation method.
+	EnableSegmentationFunc func(transitGateway *TransitVpc) error
+
+	// EnableSegmentationGatewayGroupFunc mocks the EnableSegmentationGatewayGroup method.
+	EnableSegmentationGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableSingleAZGatewayFunc mocks the EnableSingleAZGateway method.
+	EnableSingleAZGatewayFunc func(gateway *Gateway) error
+
+	// EnableSkipPublicRouteUpdateFunc mocks the EnableSkipPublicRouteUpdate method.
+	EnableSkipPublicRouteUpdateFunc func(gw *Gateway) error
+
+	// EnableSkipPublicRouteUpdateGatewayGroupFunc mocks the EnableSkipPublicRouteUpdateGatewayGroup method.
+	EnableSkipPublicRouteUpdateGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableSpokeLearnedCidrsApprovalFunc mocks the EnableSpokeLearnedCidrsApproval method.
+	EnableSpokeLearnedCidrsApprovalFunc func(gateway *SpokeVpc) error
+
+	// EnableSpokeLearnedCidrsApprovalGatewayGroupFunc mocks the EnableSpokeLearnedCidrsApprovalGatewayGroup method.
+	EnableSpokeLearnedCidrsApprovalGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableSpokeOnpremRoutePropagationFunc mocks the EnableSpokeOnpremRoutePropagation method.
+	EnableSpokeOnpremRoutePropagationFunc func(spokeGateway *SpokeVpc) error
+
+	// EnableSpokeOnpremRoutePropagationGatewayGroupFunc mocks the EnableSpokeOnpremRoutePropagationGatewayGroup method.
+	EnableSpokeOnpremRoutePropagationGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableSpokePreserveAsPathFunc mocks the EnableSpokePreserveAsPath method.
+	EnableSpokePreserveAsPathFunc func(spokeGateway *SpokeVpc) error
+
+	// EnableSpokePreserveAsPathGatewayGroupFunc mocks the EnableSpokePreserveAsPathGatewayGroup method.
+	EnableSpokePreserveAsPathGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableSummarizeCidrToTgwFunc mocks the EnableSummarizeCidrToTgw method.
+	EnableSummarizeCidrToTgwFunc func(gwName string) error
+
+	// EnableTransitFireNetFunc mocks the EnableTransitFireNet method.
+	EnableTransitFireNetFunc func(gateway *Gateway) error
+
+	// EnableTransitFireNetGatewayGroupFunc mocks the EnableTransitFireNetGatewayGroup method.
+	EnableTransitFireNetGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableTransitFireNetWithGWLBFunc mocks the EnableTransitFireNetWithGWLB method.
+	EnableTransitFireNetWithGWLBFunc func(gateway *Gateway) error
+
+	// EnableTransitFireNetWithGWLBGatewayGroupFunc mocks the EnableTransitFireNetWithGWLBGatewayGroup method.
+	EnableTransitFireNetWithGWLBGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableTransitLearnedCidrsApprovalFunc mocks the EnableTransitLearnedCidrsApproval method.
+	EnableTransitLearnedCidrsApprovalFunc func(gateway *TransitVpc) error
+
+	// EnableTransitLearnedCidrsApprovalGatewayGroupFunc mocks the EnableTransitLearnedCidrsApprovalGatewayGroup method.
+	EnableTransitLearnedCidrsApprovalGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableTransitPreserveAsPathFunc mocks the EnableTransitPreserveAsPath method.
+	EnableTransitPreserveAsPathFunc func(transitGateway *TransitVpc) error
+
+	// EnableTransitPreserveAsPathGatewayGroupFunc mocks the EnableTransitPreserveAsPathGatewayGroup method.
+	EnableTransitPreserveAsPathGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableTransitSummarizeCidrToTgwGatewayGroupFunc mocks the EnableTransitSummarizeCidrToTgwGatewayGroup method.
+	EnableTransitSummarizeCidrToTgwGatewayGroupFunc func(ctx context.Context, groupName string) error
+
+	// EnableVPNConfigFunc mocks the EnableVPNConfig method.
+	EnableVPNConfigFunc func(gateway *Gateway, vpnConfig *VPNConfig) error
+
+	// EnableVpcDNSServerFunc mocks the EnableVpcDNSServer method.
+	EnableVpcDNSServerFunc func(gateway *Gateway) error
+
+	// EnableVpnNatFunc mocks the EnableVpnNat method.
+	EnableVpnNatFunc func(gateway *Gateway) error
+
+	// GetBgpLanIPListFunc mocks the GetBgpLanIPList method.
+	GetBgpLanIPListFunc func(transitGateway *TransitVpc) (*TransitGatewayBgpLanIpInfo, error)
+
+	// GetControllerIPFunc mocks the GetControllerIP method.
+	GetControllerIPFunc func() string
+
+	// GetDefaultTagsConfigFunc mocks the GetDefaultTagsConfig method.
+	GetDefaultTagsConfigFunc func() *DefaultTagsConfig
+
+	// GetGatewayFunc mocks the GetGateway method.
+	GetGatewayFunc func(gateway *Gateway) (*Gateway, error)
+
+	// GetGatewayBgpCommunitiesFunc mocks the GetGatewayBgpCommunities method.
+	GetGatewayBgpCommunitiesFunc func(gwName string) (bool, bool, error)
+
+	// GetGatewayBgpMedToSdnMetricFunc mocks the GetGatewayBgpMedToSdnMetric method.
+	GetGatewayBgpMedToSdnMetricFunc func(gwName string) (bool, error)
+
+	// GetGatewayDetailFunc mocks the GetGatewayDetail method.
+	GetGatewayDetailFunc func(gateway *Gateway) (*GatewayDetail, error)
+
+	// GetGatewayGroupFunc mocks the GetGatewayGroup method.
+	GetGatewayGroupFunc func(ctx context.Context, groupUUID string) (*GatewayGroup, error)
+
+	// GetGatewayGroupByNameFunc mocks the GetGatewayGroupByName method.
+	GetGatewayGroupByNameFunc func(ctx context.Context, groupName string) (*GatewayGroup, error)
+
+	// GetGeoVPNNameFunc mocks the GetGeoVPNName method.
+	GetGeoVPNNameFunc func(gateway *Gateway) (*GeoVPN, error)
+
+	// GetGroGsoStatusFunc mocks the GetGroGsoStatus method.
+	GetGroGsoStatusFunc func(gateway *Gateway) (bool, error)
+
+	// GetIgnoreTagsConfigFunc mocks the GetIgnoreTagsConfig method.
+	GetIgnoreTagsConfigFunc func() *IgnoreTagsConfig
+
+	// GetSplitTunnelFunc mocks the GetSplitTunnel method.
+	GetSplitTunnelFunc func(splitTunnel *SplitTunnel) (*SplitTunnelUnit, error)
+
+	// GetSpokeGatewayAdvancedConfigFunc mocks the GetSpokeGatewayAdvancedConfig method.
+	GetSpokeGatewayAdvancedConfigFunc func(spokeGateway *SpokeVpc) (*SpokeGatewayAdvancedConfig, error)
+
+	// GetSpokeGatewayListFunc mocks the GetSpokeGatewayList method.
+	GetSpokeGatewayListFunc func(ctx context.Context) ([]Gateway, error)
+
+	// GetSpokeGatewaySubnetGroupFunc mocks the GetSpokeGatewaySubnetGroup method.
+	GetSpokeGatewaySubnetGroupFunc func(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error
+
+	// GetTagsFunc mocks the GetTags method.
+	GetTagsFunc func(tags *Tags) ([]string, error)
+
+	// GetTransitGatewayAdvancedConfigFunc mocks the GetTransitGatewayAdvancedConfig method.
+	GetTransitGatewayAdvancedConfigFunc func(transitGateway *TransitVpc) (*TransitGatewayAdvancedConfig, error)
+
+	// GetTransitGatewayLanCidrFunc mocks the GetTransitGatewayLanCidr method.
+	GetTransitGatewayLanCidrFunc func(gatewayName string) (string, error)
+
+	// GetTransitGatewayListFunc mocks the GetTransitGatewayList method.
+	GetTransitGatewayListFunc func(ctx context.Context) ([]Gateway, error)
+
+	// GetTunnelDetectionTimeFunc mocks the GetTunnelDetectionTime method.
+	GetTunnelDetectionTimeFunc func(entity string) (int, error)
+
+	// IsTransitFireNetReadyToBeDisabledFunc mocks the IsTransitFireNetReadyToBeDisabled method.
+	IsTransitFireNetReadyToBeDisabledFunc func(gateway *Gateway) error
+
+	// LaunchSpokeVpcContextFunc mocks the LaunchSpokeVpcContext method.
+	LaunchSpokeVpcContextFunc func(ctx context.Context, spoke *SpokeVpc) error
+
+	// LaunchTransitVpcContextFunc mocks the LaunchTransitVpcContext method.
+	LaunchTransitVpcContextFunc func(ctx context.Context, gateway *TransitVpc) error
+
+	// ModifySplitTunnelFunc mocks the ModifySplitTunnel method.
+	ModifySplitTunnelFunc func(splitTunnel *SplitTunnel) error
+
+	// ModifyTunnelDetectionTimeFunc mocks the ModifyTunnelDetectionTime method.
+	ModifyTunnelDetectionTimeFunc func(entity string, detectionTime int) error
+
+	// SetBgpBfdPollingTimeFunc mocks the SetBgpBfdPollingTime method.
+	SetBgpBfdPollingTimeFunc func(transitGateway *TransitVpc, newPollingTime int) error
+
+	// SetBgpBfdPollingTimeGatewayGroupFunc mocks the SetBgpBfdPollingTimeGatewayGroup method.
+	SetBgpBfdPollingTimeGatewayGroupFunc func(ctx context.Context, groupName string, pollingTime int) error
+
+	// SetBgpBfdPollingTimeSpokeFunc mocks the SetBgpBfdPollingTimeSpoke method.
+	SetBgpBfdPollingTimeSpokeFunc func(spokeGateway *SpokeVpc, newPollingTime int) error
+
+	// SetBgpEcmpFunc mocks the SetBgpEcmp method.
+	SetBgpEcmpFunc func(transitGateway *TransitVpc, enabled bool) error
+
+	// SetBgpEcmpGatewayGroupFunc mocks the SetBgpEcmpGatewayGroup method.
+	SetBgpEcmpGatewayGroupFunc func(ctx context.Context, groupName string, enable bool) error
+
+	// SetBgpEcmpSpokeFunc mocks the SetBgpEcmpSpoke method.
+	SetBgpEcmpSpokeFunc func(spokeGateway *SpokeVpc, enabled bool) error
+
+	// SetBgpManualSpokeAdvertisedNetworksFunc mocks the SetBgpManualSpokeAdvertisedNetworks method.
+	SetBgpManualSpokeAdvertisedNetworksFunc func(transitGw *TransitVpc) error
+
+	// SetBgpPollingTimeFunc mocks the SetBgpPollingTime method.
+	SetBgpPollingTimeFunc func(transitGateway *TransitVpc, newPollingTime int) error
+
+	// SetBgpPollingTimeGatewayGroupFunc mocks the SetBgpPollingTimeGatewayGroup method.
+	SetBgpPollingTimeGatewayGroupFunc func(ctx context.Context, groupName string, pollingTime int) error
+
+	// SetBgpPollingTimeSpokeFunc mocks the SetBgpPollingTimeSpoke method.
+	SetBgpPollingTimeSpokeFunc func(spokeGateway *SpokeVpc, newPollingTime int) error
+
+	// SetGatewayBgpCommunitiesAcceptFunc mocks the SetGatewayBgpCommunitiesAccept method.
+	SetGatewayBgpCommunitiesAcceptFunc func(gwName string, acceptComm bool) error
+
+	// SetGatewayBgpCommunitiesSendFunc mocks the SetGatewayBgpCommunitiesSend method.
+	SetGatewayBgpCommunitiesSendFunc func(gwName string, sendComm bool) error
+
+	// SetGatewayBgpMedToSdnMetricFunc mocks the SetGatewayBgpMedToSdnMetric method.
+	SetGatewayBgpMedToSdnMetricFunc func(gwName string, override bool) error
+
+	// SetGatewayGroupBgpCommunitiesAcceptFunc mocks the SetGatewayGroupBgpCommunitiesAccept method.
+	SetGatewayGroupBgpCommunitiesAcceptFunc func(ctx context.Context, groupName string, accept bool) error
+
+	// SetGatewayGroupBgpCommunitiesSendFunc mocks the SetGatewayGroupBgpCommunitiesSend method.
+	SetGatewayGroupBgpCommunitiesSendFunc func(ctx context.Context, groupName string, send bool) error
+
+	// SetGatewayPhase2PolicyFunc mocks the SetGatewayPhase2Policy method.
+	SetGatewayPhase2PolicyFunc func(gwName string, encPolicy string, pfsPolicy string) error
+
+	// SetLocalASNumberFunc mocks the SetLocalASNumber method.
+	SetLocalASNumberFunc func(transitGateway *TransitVpc, localASNumber string) error
+
+	// SetLocalASNumberGatewayGroupFunc mocks the SetLocalASNumberGatewayGroup method.
+	SetLocalASNumberGatewayGroupFunc func(ctx context.Context, groupName string, localAsNumber string) error
+
+	// SetLocalASNumberSpokeFunc mocks the SetLocalASNumberSpoke method.
+	SetLocalASNumberSpokeFunc func(spokeGateway *SpokeVpc, localASNumber string) error
+
+	// SetPrependASPathFunc mocks the SetPrependASPath method.
+	SetPrependASPathFunc func(transitGateway *TransitVpc, prependASPath []string) error
+
+	// SetPrependASPathGatewayGroupFunc mocks the SetPrependASPathGatewayGroup method.
+	SetPrependASPathGatewayGroupFunc func(ctx context.Context, groupName string, prependAsPath []string) error
+
+	// SetPrependASPathSpokeFunc mocks the SetPrependASPathSpoke method.
+	SetPrependASPathSpokeFunc func(spokeGateway *SpokeVpc, prependASPath []string) error
+
+	// SetRxQueueSizeFunc mocks the SetRxQueueSize method.
+	SetRxQueueSizeFunc func(gateway *Gateway) error
+
+	// SetSpokeBgpManualAdvertisedNetworksFunc mocks the SetSpokeBgpManualAdvertisedNetworks method.
+	SetSpokeBgpManualAdvertisedNetworksFunc func(spokeGateway *SpokeVpc) error
+
+	// SetSpokeBgpManualAdvertisedNetworksGatewayGroupFunc mocks the SetSpokeBgpManualAdvertisedNetworksGatewayGroup method.
+	SetSpokeBgpManualAdvertisedNetworksGatewayGroupFunc func(ctx context.Context, groupName string, cidrs string) error
+
+	// SetSymmetricRoutingGatewayGroupFunc mocks the SetSymmetricRoutingGatewayGroup method.
+	SetSymmetricRoutingGatewayGroupFunc func(ctx context.Context, groupUUID string, enable bool) error
+
+	// SetTransitLearnedCIDRsApprovalModeFunc mocks the SetTransitLearnedCIDRsApprovalMode method.
+	SetTransitLearnedCIDRsApprovalModeFunc func(gw *TransitVpc, mode string) error
+
+	// SetVpnGatewayAuthenticationFunc mocks the SetVpnGatewayAuthentication method.
+	SetVpnGatewayAuthenticationFunc func(gateway *VpnGatewayAuth) error
+
+	// UpdateDNatFunc mocks the UpdateDNat method.
+	UpdateDNatFunc func(gateway *Gateway) error
+
+	// UpdateEdgeGatewayFunc mocks the UpdateEdgeGateway method.
+	UpdateEdgeGatewayFunc func(gateway *TransitVpc) error
+
+	// UpdateEdgeGatewayV2Func mocks the UpdateEdgeGatewayV2 method.
+	UpdateEdgeGatewayV2Func func(ctx context.Context, gateway *TransitVpc) error
+
+	// UpdateGatewayFunc mocks the UpdateGateway method.
+	UpdateGatewayFunc func(gateway *Gateway) error
+
+	// UpdateGatewayContextFunc mocks the UpdateGatewayContext method.
+	UpdateGatewayContextFunc func(ctx context.Context, gateway *Gateway) error
+
+	// UpdateGroupInstanceSizeFunc mocks the UpdateGroupInstanceSize method.
+	UpdateGroupInstanceSizeFunc func(ctx context.Context, groupName string, instanceSize string) error
+
+	// UpdateMaxVpnConnFunc mocks the UpdateMaxVpnConn method.
+	UpdateMaxVpnConnFunc func(gateway *Gateway) error
+
+	// UpdateSpokeGatewaySubnetGroupFunc mocks the UpdateSpokeGatewaySubnetGroup method.
+	UpdateSpokeGatewaySubnetGroupFunc func(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error
+
+	// UpdateSpokePendingApprovedCidrsFunc mocks the UpdateSpokePendingApprovedCidrs method.
+	UpdateSpokePendingApprovedCidrsFunc func(gateway *SpokeVpc) error
+
+	// UpdateSpokePendingApprovedCidrsGatewayGroupFunc mocks the UpdateSpokePendingApprovedCidrsGatewayGroup method.
+	UpdateSpokePendingApprovedCidrsGatewayGroupFunc func(ctx context.Context, groupName string, approvedCidrs []string) error
+
+	// UpdateTagsFunc mocks the UpdateTags method.
+	UpdateTagsFunc func(tags *Tags) error
+
+	// UpdateTransitGatewayCustomizedVpcRouteFunc mocks the UpdateTransitGatewayCustomizedVpcRoute method.
+	UpdateTransitGatewayCustomizedVpcRouteFunc func(gateway string, customizedTransitVpcRoutes []string) error
+
+	// UpdateTransitPendingApprovedCidrsFunc mocks the UpdateTransitPendingApprovedCidrs method.
+	UpdateTransitPendingApprovedCidrsFunc func(gateway *TransitVpc) error
+
+	// UpdateTransitPendingApprovedCidrsGatewayGroupFunc mocks the UpdateTransitPendingApprovedCidrsGatewayGroup method.
+	UpdateTransitPendingApprovedCidrsGatewayGroupFunc func(ctx context.Context, groupName string, approvedCidrs []string) error
+
+	// UpdateVpnCidrFunc mocks the UpdateVpnCidr method.
+	UpdateVpnCidrFunc func(gateway *Gateway) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AddSpokeGatewaySubnetGroup holds details about calls to the AddSpokeGatewaySubnetGroup method.
+		AddSpokeGatewaySubnetGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpokeGatewaySubnetGroup is the spokeGatewaySubnetGroup argument value.
+			SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+		}
+		// AttachTransitGWForHybrid holds details about calls to the AttachTransitGWForHybrid method.
+		AttachTransitGWForHybrid []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// ChangeBgpHoldTime holds details about calls to the ChangeBgpHoldTime method.
+		ChangeBgpHoldTime []struct {
+			// GwName is the gwName argument value.
+			GwName string
+			// HoldTime is the holdTime argument value.
+			HoldTime int
+		}
+		// ChangeBgpHoldTimeGatewayGroup holds details about calls to the ChangeBgpHoldTimeGatewayGroup method.
+		ChangeBgpHoldTimeGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// HoldTime is the holdTime argument value.
+			HoldTime int
+		}
+		// ChangeBgpOverLanIntfCnt holds details about calls to the ChangeBgpOverLanIntfCnt method.
+		ChangeBgpOverLanIntfCnt []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// CreateGatewayContext holds details about calls to the CreateGatewayContext method.
+		CreateGatewayContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// CreateGatewayGroup holds details about calls to the CreateGatewayGroup method.
+		CreateGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpokeGroup is the spokeGroup argument value.
+			SpokeGroup *GatewayGroup
+		}
+		// CreatePublicSubnetFilteringGatewayContext holds details about calls to the CreatePublicSubnetFilteringGatewayContext method.
+		CreatePublicSubnetFilteringGatewayContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// CreateSpokeHaGw holds details about calls to the CreateSpokeHaGw method.
+		CreateSpokeHaGw []struct {
+			// SpokeHaGateway is the spokeHaGateway argument value.
+			SpokeHaGateway *SpokeHaGateway
+		}
+		// CreateSpokeHaGwContext holds details about calls to the CreateSpokeHaGwContext method.
+		CreateSpokeHaGwContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpokeHaGateway is the spokeHaGateway argument value.
+			SpokeHaGateway *SpokeHaGateway
+		}
+		// CreateTransitHaGwContext holds details about calls to the CreateTransitHaGwContext method.
+		CreateTransitHaGwContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TransitHaGateway is the transitHaGateway argument value.
+			TransitHaGateway *TransitHaGateway
+		}
+		// DeleteGateway holds details about calls to the DeleteGateway method.
+		DeleteGateway []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DeleteGatewayContext holds details about calls to the DeleteGatewayContext method.
+		DeleteGatewayContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DeleteGatewayGroup holds details about calls to the DeleteGatewayGroup method.
+		DeleteGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupUUID is the groupUUID argument value.
+			GroupUUID string
+		}
+		// DeletePublicSubnetFilteringGatewayContext holds details about calls to the DeletePublicSubnetFilteringGatewayContext method.
+		DeletePublicSubnetFilteringGatewayContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DeleteSpokeGatewaySubnetGroup holds details about calls to the DeleteSpokeGatewaySubnetGroup method.
+		DeleteSpokeGatewaySubnetGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpokeGatewaySubnetGroup is the spokeGatewaySubnetGroup argument value.
+			SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+		}
+		// DetachTransitGWForHybrid holds details about calls to the DetachTransitGWForHybrid method.
+		DetachTransitGWForHybrid []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// DisableActiveStandby holds details about calls to the DisableActiveStandby method.
+		DisableActiveStandby []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// DisableActiveStandbyGatewayGroup holds details about calls to the DisableActiveStandbyGatewayGroup method.
+		DisableActiveStandbyGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableActiveStandbySpoke holds details about calls to the DisableActiveStandbySpoke method.
+		DisableActiveStandbySpoke []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+		}
+		// DisableAdvertiseTransitCidr holds details about calls to the DisableAdvertiseTransitCidr method.
+		DisableAdvertiseTransitCidr []struct {
+			// TransitGw is the transitGw argument value.
+			TransitGw *TransitVpc
+		}
+		// DisableAdvertiseTransitCidrGatewayGroup holds details about calls to the DisableAdvertiseTransitCidrGatewayGroup method.
+		DisableAdvertiseTransitCidrGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableAutoAdvertiseS2CCidrs holds details about calls to the DisableAutoAdvertiseS2CCidrs method.
+		DisableAutoAdvertiseS2CCidrs []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableAutoAdvertiseS2CCidrsGatewayGroup holds details about calls to the DisableAutoAdvertiseS2CCidrsGatewayGroup method.
+		DisableAutoAdvertiseS2CCidrsGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableConnectedTransit holds details about calls to the DisableConnectedTransit method.
+		DisableConnectedTransit []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// DisableConnectedTransitGatewayGroup holds details about calls to the DisableConnectedTransitGatewayGroup method.
+		DisableConnectedTransitGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableCustomSNat holds details about calls to the DisableCustomSNat method.
+		DisableCustomSNat []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableEgressTransitFirenet holds details about calls to the DisableEgressTransitFirenet method.
+		DisableEgressTransitFirenet []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// DisableFireNetGatewayGroup holds details about calls to the DisableFireNetGatewayGroup method.
+		DisableFireNetGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableGatewayFireNetInterfaces holds details about calls to the DisableGatewayFireNetInterfaces method.
+		DisableGatewayFireNetInterfaces []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// DisableGatewayGroupSNat holds details about calls to the DisableGatewayGroupSNat method.
+		DisableGatewayGroupSNat []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableGatewayGroupVpcDNSServer holds details about calls to the DisableGatewayGroupVpcDNSServer method.
+		DisableGatewayGroupVpcDNSServer []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableGatewayLoadBalancerGatewayGroup holds details about calls to the DisableGatewayLoadBalancerGatewayGroup method.
+		DisableGatewayLoadBalancerGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableGlobalVpc holds details about calls to the DisableGlobalVpc method.
+		DisableGlobalVpc []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableGlobalVpcGatewayGroup holds details about calls to the DisableGlobalVpcGatewayGroup method.
+		DisableGlobalVpcGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableGroGso holds details about calls to the DisableGroGso method.
+		DisableGroGso []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableGroGsoGatewayGroup holds details about calls to the DisableGroGsoGatewayGroup method.
+		DisableGroGsoGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableGuardDutyEnforcement holds details about calls to the DisableGuardDutyEnforcement method.
+		DisableGuardDutyEnforcement []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableHybridConnectionGatewayGroup holds details about calls to the DisableHybridConnectionGatewayGroup method.
+		DisableHybridConnectionGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableIPv6 holds details about calls to the DisableIPv6 method.
+		DisableIPv6 []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableIPv6GatewayGroup holds details about calls to the DisableIPv6GatewayGroup method.
+		DisableIPv6GatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableJumboFrame holds details about calls to the DisableJumboFrame method.
+		DisableJumboFrame []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableJumboFrameGatewayGroup holds details about calls to the DisableJumboFrameGatewayGroup method.
+		DisableJumboFrameGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableMonitorGatewaySubnets holds details about calls to the DisableMonitorGatewaySubnets method.
+		DisableMonitorGatewaySubnets []struct {
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// DisableMultiTierTransitGatewayGroup holds details about calls to the DisableMultiTierTransitGatewayGroup method.
+		DisableMultiTierTransitGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableMultitierTransit holds details about calls to the DisableMultitierTransit method.
+		DisableMultitierTransit []struct {
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// DisablePrivateVpcDefaultRoute holds details about calls to the DisablePrivateVpcDefaultRoute method.
+		DisablePrivateVpcDefaultRoute []struct {
+			// Gw is the gw argument value.
+			Gw *Gateway
+		}
+		// DisablePrivateVpcDefaultRouteGatewayGroup holds details about calls to the DisablePrivateVpcDefaultRouteGatewayGroup method.
+		DisablePrivateVpcDefaultRouteGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableS2CRxBalancing holds details about calls to the DisableS2CRxBalancing method.
+		DisableS2CRxBalancing []struct {
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// DisableS2cRxBalancingGatewayGroup holds details about calls to the DisableS2cRxBalancingGatewayGroup method.
+		DisableS2cRxBalancingGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableSNat holds details about calls to the DisableSNat method.
+		DisableSNat []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableSegmentation holds details about calls to the DisableSegmentation method.
+		DisableSegmentation []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// DisableSegmentationGatewayGroup holds details about calls to the DisableSegmentationGatewayGroup method.
+		DisableSegmentationGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableSingleAZGateway holds details about calls to the DisableSingleAZGateway method.
+		DisableSingleAZGateway []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableSkipPublicRouteUpdate holds details about calls to the DisableSkipPublicRouteUpdate method.
+		DisableSkipPublicRouteUpdate []struct {
+			// Gw is the gw argument value.
+			Gw *Gateway
+		}
+		// DisableSkipPublicRouteUpdateGatewayGroup holds details about calls to the DisableSkipPublicRouteUpdateGatewayGroup method.
+		DisableSkipPublicRouteUpdateGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableSpokeLearnedCidrsApproval holds details about calls to the DisableSpokeLearnedCidrsApproval method.
+		DisableSpokeLearnedCidrsApproval []struct {
+			// Gateway is the gateway argument value.
+			Gateway *SpokeVpc
+		}
+		// DisableSpokeLearnedCidrsApprovalGatewayGroup holds details about calls to the DisableSpokeLearnedCidrsApprovalGatewayGroup method.
+		DisableSpokeLearnedCidrsApprovalGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableSpokeOnpremRoutePropagation holds details about calls to the DisableSpokeOnpremRoutePropagation method.
+		DisableSpokeOnpremRoutePropagation []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+		}
+		// DisableSpokeOnpremRoutePropagationGatewayGroup holds details about calls to the DisableSpokeOnpremRoutePropagationGatewayGroup method.
+		DisableSpokeOnpremRoutePropagationGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableSpokePreserveAsPath holds details about calls to the DisableSpokePreserveAsPath method.
+		DisableSpokePreserveAsPath []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+		}
+		// DisableSpokePreserveAsPathGatewayGroup holds details about calls to the DisableSpokePreserveAsPathGatewayGroup method.
+		DisableSpokePreserveAsPathGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableSummarizeCidrToTgw holds details about calls to the DisableSummarizeCidrToTgw method.
+		DisableSummarizeCidrToTgw []struct {
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// DisableTransitFireNet holds details about calls to the DisableTransitFireNet method.
+		DisableTransitFireNet []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableTransitFireNetGatewayGroup holds details about calls to the DisableTransitFireNetGatewayGroup method.
+		DisableTransitFireNetGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableTransitLearnedCidrsApproval holds details about calls to the DisableTransitLearnedCidrsApproval method.
+		DisableTransitLearnedCidrsApproval []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// DisableTransitLearnedCidrsApprovalGatewayGroup holds details about calls to the DisableTransitLearnedCidrsApprovalGatewayGroup method.
+		DisableTransitLearnedCidrsApprovalGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableTransitPreserveAsPath holds details about calls to the DisableTransitPreserveAsPath method.
+		DisableTransitPreserveAsPath []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// DisableTransitPreserveAsPathGatewayGroup holds details about calls to the DisableTransitPreserveAsPathGatewayGroup method.
+		DisableTransitPreserveAsPathGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableTransitSummarizeCidrToTgwGatewayGroup holds details about calls to the DisableTransitSummarizeCidrToTgwGatewayGroup method.
+		DisableTransitSummarizeCidrToTgwGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// DisableVPNConfig holds details about calls to the DisableVPNConfig method.
+		DisableVPNConfig []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+			// VpnConfig is the vpnConfig argument value.
+			VpnConfig *VPNConfig
+		}
+		// DisableVpcDNSServer holds details about calls to the DisableVpcDNSServer method.
+		DisableVpcDNSServer []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// DisableVpnNat holds details about calls to the DisableVpnNat method.
+		DisableVpnNat []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EditDesignatedGateway holds details about calls to the EditDesignatedGateway method.
+		EditDesignatedGateway []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EditGatewayAdvertisedCidr holds details about calls to the EditGatewayAdvertisedCidr method.
+		EditGatewayAdvertisedCidr []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EditGatewayCustomRoutes holds details about calls to the EditGatewayCustomRoutes method.
+		EditGatewayCustomRoutes []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EditGatewayFilterRoutes holds details about calls to the EditGatewayFilterRoutes method.
+		EditGatewayFilterRoutes []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EditManagedRouteTables holds details about calls to the EditManagedRouteTables method.
+		EditManagedRouteTables []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+			// RouteTables is the routeTables argument value.
+			RouteTables []string
+		}
+		// EditManagedRouteTablesForGatewayGroup holds details about calls to the EditManagedRouteTablesForGatewayGroup method.
+		EditManagedRouteTablesForGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// RouteTables is the routeTables argument value.
+			RouteTables []string
+		}
+		// EditPrivateRouteTableConfig holds details about calls to the EditPrivateRouteTableConfig method.
+		EditPrivateRouteTableConfig []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+			// RouteTables is the routeTables argument value.
+			RouteTables []string
+		}
+		// EditPrivateRouteTableConfigForGatewayGroup holds details about calls to the EditPrivateRouteTableConfigForGatewayGroup method.
+		EditPrivateRouteTableConfigForGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// RouteTables is the routeTables argument value.
+			RouteTables []string
+		}
+		// EditPublicSubnetFilteringRouteTableList holds details about calls to the EditPublicSubnetFilteringRouteTableList method.
+		EditPublicSubnetFilteringRouteTableList []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+			// RouteTables is the routeTables argument value.
+			RouteTables []string
+		}
+		// EnableActiveStandby holds details about calls to the EnableActiveStandby method.
+		EnableActiveStandby []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// EnableActiveStandbyGatewayGroup holds details about calls to the EnableActiveStandbyGatewayGroup method.
+		EnableActiveStandbyGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableActiveStandbyPreemptive holds details about calls to the EnableActiveStandbyPreemptive method.
+		EnableActiveStandbyPreemptive []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// EnableActiveStandbyPreemptiveGatewayGroup holds details about calls to the EnableActiveStandbyPreemptiveGatewayGroup method.
+		EnableActiveStandbyPreemptiveGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableActiveStandbyPreemptiveSpoke holds details about calls to the EnableActiveStandbyPreemptiveSpoke method.
+		EnableActiveStandbyPreemptiveSpoke []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+		}
+		// EnableActiveStandbySpoke holds details about calls to the EnableActiveStandbySpoke method.
+		EnableActiveStandbySpoke []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+		}
+		// EnableAdvertiseTransitCidr holds details about calls to the EnableAdvertiseTransitCidr method.
+		EnableAdvertiseTransitCidr []struct {
+			// TransitGw is the transitGw argument value.
+			TransitGw *TransitVpc
+		}
+		// EnableAdvertiseTransitCidrGatewayGroup holds details about calls to the EnableAdvertiseTransitCidrGatewayGroup method.
+		EnableAdvertiseTransitCidrGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableAutoAdvertiseS2CCidrs holds details about calls to the EnableAutoAdvertiseS2CCidrs method.
+		EnableAutoAdvertiseS2CCidrs []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableAutoAdvertiseS2CCidrsGatewayGroup holds details about calls to the EnableAutoAdvertiseS2CCidrsGatewayGroup method.
+		EnableAutoAdvertiseS2CCidrsGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableConnectedTransit holds details about calls to the EnableConnectedTransit method.
+		EnableConnectedTransit []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// EnableConnectedTransitGatewayGroup holds details about calls to the EnableConnectedTransitGatewayGroup method.
+		EnableConnectedTransitGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableCustomizedSNat holds details about calls to the EnableCustomizedSNat method.
+		EnableCustomizedSNat []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableEgressTransitFirenet holds details about calls to the EnableEgressTransitFirenet method.
+		EnableEgressTransitFirenet []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// EnableEncryptVolume holds details about calls to the EnableEncryptVolume method.
+		EnableEncryptVolume []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableFireNetGatewayGroup holds details about calls to the EnableFireNetGatewayGroup method.
+		EnableFireNetGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableGatewayFireNetInterfaces holds details about calls to the EnableGatewayFireNetInterfaces method.
+		EnableGatewayFireNetInterfaces []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// EnableGatewayFireNetInterfacesWithGWLB holds details about calls to the EnableGatewayFireNetInterfacesWithGWLB method.
+		EnableGatewayFireNetInterfacesWithGWLB []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// EnableGatewayGroupSNat holds details about calls to the EnableGatewayGroupSNat method.
+		EnableGatewayGroupSNat []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableGatewayGroupVpcDNSServer holds details about calls to the EnableGatewayGroupVpcDNSServer method.
+		EnableGatewayGroupVpcDNSServer []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableGatewayLoadBalancerGatewayGroup holds details about calls to the EnableGatewayLoadBalancerGatewayGroup method.
+		EnableGatewayLoadBalancerGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableGlobalVpc holds details about calls to the EnableGlobalVpc method.
+		EnableGlobalVpc []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableGlobalVpcGatewayGroup holds details about calls to the EnableGlobalVpcGatewayGroup method.
+		EnableGlobalVpcGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableGroGso holds details about calls to the EnableGroGso method.
+		EnableGroGso []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableGroGsoGatewayGroup holds details about calls to the EnableGroGsoGatewayGroup method.
+		EnableGroGsoGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableGuardDutyEnforcement holds details about calls to the EnableGuardDutyEnforcement method.
+		EnableGuardDutyEnforcement []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableHybridConnectionGatewayGroup holds details about calls to the EnableHybridConnectionGatewayGroup method.
+		EnableHybridConnectionGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableIPv6 holds details about calls to the EnableIPv6 method.
+		EnableIPv6 []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableIPv6GatewayGroup holds details about calls to the EnableIPv6GatewayGroup method.
+		EnableIPv6GatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableJumboFrame holds details about calls to the EnableJumboFrame method.
+		EnableJumboFrame []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableJumboFrameGatewayGroup holds details about calls to the EnableJumboFrameGatewayGroup method.
+		EnableJumboFrameGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableMonitorGatewaySubnets holds details about calls to the EnableMonitorGatewaySubnets method.
+		EnableMonitorGatewaySubnets []struct {
+			// GwName is the gwName argument value.
+			GwName string
+			// ExcludedInstances is the excludedInstances argument value.
+			ExcludedInstances []string
+		}
+		// EnableMultiTierTransitGatewayGroup holds details about calls to the EnableMultiTierTransitGatewayGroup method.
+		EnableMultiTierTransitGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableMultitierTransit holds details about calls to the EnableMultitierTransit method.
+		EnableMultitierTransit []struct {
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// EnablePeeringHaGateway holds details about calls to the EnablePeeringHaGateway method.
+		EnablePeeringHaGateway []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnablePrivateVpcDefaultRoute holds details about calls to the EnablePrivateVpcDefaultRoute method.
+		EnablePrivateVpcDefaultRoute []struct {
+			// Gw is the gw argument value.
+			Gw *Gateway
+		}
+		// EnablePrivateVpcDefaultRouteGatewayGroup holds details about calls to the EnablePrivateVpcDefaultRouteGatewayGroup method.
+		EnablePrivateVpcDefaultRouteGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnablePublicSubnetFilteringHAGateway holds details about calls to the EnablePublicSubnetFilteringHAGateway method.
+		EnablePublicSubnetFilteringHAGateway []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableS2CRxBalancing holds details about calls to the EnableS2CRxBalancing method.
+		EnableS2CRxBalancing []struct {
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// EnableS2cRxBalancingGatewayGroup holds details about calls to the EnableS2cRxBalancingGatewayGroup method.
+		EnableS2cRxBalancingGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableSNat holds details about calls to the EnableSNat method.
+		EnableSNat []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableSegmentation holds details about calls to the EnableSegmentation method.
+		EnableSegmentation []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// EnableSegmentationGatewayGroup holds details about calls to the EnableSegmentationGatewayGroup method.
+		EnableSegmentationGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableSingleAZGateway holds details about calls to the EnableSingleAZGateway method.
+		EnableSingleAZGateway []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableSkipPublicRouteUpdate holds details about calls to the EnableSkipPublicRouteUpdate method.
+		EnableSkipPublicRouteUpdate []struct {
+			// Gw is the gw argument value.
+			Gw *Gateway
+		}
+		// EnableSkipPublicRouteUpdateGatewayGroup holds details about calls to the EnableSkipPublicRouteUpdateGatewayGroup method.
+		EnableSkipPublicRouteUpdateGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableSpokeLearnedCidrsApproval holds details about calls to the EnableSpokeLearnedCidrsApproval method.
+		EnableSpokeLearnedCidrsApproval []struct {
+			// Gateway is the gateway argument value.
+			Gateway *SpokeVpc
+		}
+		// EnableSpokeLearnedCidrsApprovalGatewayGroup holds details about calls to the EnableSpokeLearnedCidrsApprovalGatewayGroup method.
+		EnableSpokeLearnedCidrsApprovalGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableSpokeOnpremRoutePropagation holds details about calls to the EnableSpokeOnpremRoutePropagation method.
+		EnableSpokeOnpremRoutePropagation []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+		}
+		// EnableSpokeOnpremRoutePropagationGatewayGroup holds details about calls to the EnableSpokeOnpremRoutePropagationGatewayGroup method.
+		EnableSpokeOnpremRoutePropagationGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableSpokePreserveAsPath holds details about calls to the EnableSpokePreserveAsPath method.
+		EnableSpokePreserveAsPath []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+		}
+		// EnableSpokePreserveAsPathGatewayGroup holds details about calls to the EnableSpokePreserveAsPathGatewayGroup method.
+		EnableSpokePreserveAsPathGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableSummarizeCidrToTgw holds details about calls to the EnableSummarizeCidrToTgw method.
+		EnableSummarizeCidrToTgw []struct {
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// EnableTransitFireNet holds details about calls to the EnableTransitFireNet method.
+		EnableTransitFireNet []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableTransitFireNetGatewayGroup holds details about calls to the EnableTransitFireNetGatewayGroup method.
+		EnableTransitFireNetGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableTransitFireNetWithGWLB holds details about calls to the EnableTransitFireNetWithGWLB method.
+		EnableTransitFireNetWithGWLB []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableTransitFireNetWithGWLBGatewayGroup holds details about calls to the EnableTransitFireNetWithGWLBGatewayGroup method.
+		EnableTransitFireNetWithGWLBGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableTransitLearnedCidrsApproval holds details about calls to the EnableTransitLearnedCidrsApproval method.
+		EnableTransitLearnedCidrsApproval []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// EnableTransitLearnedCidrsApprovalGatewayGroup holds details about calls to the EnableTransitLearnedCidrsApprovalGatewayGroup method.
+		EnableTransitLearnedCidrsApprovalGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableTransitPreserveAsPath holds details about calls to the EnableTransitPreserveAsPath method.
+		EnableTransitPreserveAsPath []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// EnableTransitPreserveAsPathGatewayGroup holds details about calls to the EnableTransitPreserveAsPathGatewayGroup method.
+		EnableTransitPreserveAsPathGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableTransitSummarizeCidrToTgwGatewayGroup holds details about calls to the EnableTransitSummarizeCidrToTgwGatewayGroup method.
+		EnableTransitSummarizeCidrToTgwGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// EnableVPNConfig holds details about calls to the EnableVPNConfig method.
+		EnableVPNConfig []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+			// VpnConfig is the vpnConfig argument value.
+			VpnConfig *VPNConfig
+		}
+		// EnableVpcDNSServer holds details about calls to the EnableVpcDNSServer method.
+		EnableVpcDNSServer []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// EnableVpnNat holds details about calls to the EnableVpnNat method.
+		EnableVpnNat []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// GetBgpLanIPList holds details about calls to the GetBgpLanIPList method.
+		GetBgpLanIPList []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// GetControllerIP holds details about calls to the GetControllerIP method.
+		GetControllerIP []struct {
+		}
+		// GetDefaultTagsConfig holds details about calls to the GetDefaultTagsConfig method.
+		GetDefaultTagsConfig []struct {
+		}
+		// GetGateway holds details about calls to the GetGateway method.
+		GetGateway []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// GetGatewayBgpCommunities holds details about calls to the GetGatewayBgpCommunities method.
+		GetGatewayBgpCommunities []struct {
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// GetGatewayBgpMedToSdnMetric holds details about calls to the GetGatewayBgpMedToSdnMetric method.
+		GetGatewayBgpMedToSdnMetric []struct {
+			// GwName is the gwName argument value.
+			GwName string
+		}
+		// GetGatewayDetail holds details about calls to the GetGatewayDetail method.
+		GetGatewayDetail []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// GetGatewayGroup holds details about calls to the GetGatewayGroup method.
+		GetGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupUUID is the groupUUID argument value.
+			GroupUUID string
+		}
+		// GetGatewayGroupByName holds details about calls to the GetGatewayGroupByName method.
+		GetGatewayGroupByName []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+		}
+		// GetGeoVPNName holds details about calls to the GetGeoVPNName method.
+		GetGeoVPNName []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// GetGroGsoStatus holds details about calls to the GetGroGsoStatus method.
+		GetGroGsoStatus []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// GetIgnoreTagsConfig holds details about calls to the GetIgnoreTagsConfig method.
+		GetIgnoreTagsConfig []struct {
+		}
+		// GetSplitTunnel holds details about calls to the GetSplitTunnel method.
+		GetSplitTunnel []struct {
+			// SplitTunnel is the splitTunnel argument value.
+			SplitTunnel *SplitTunnel
+		}
+		// GetSpokeGatewayAdvancedConfig holds details about calls to the GetSpokeGatewayAdvancedConfig method.
+		GetSpokeGatewayAdvancedConfig []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+		}
+		// GetSpokeGatewayList holds details about calls to the GetSpokeGatewayList method.
+		GetSpokeGatewayList []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetSpokeGatewaySubnetGroup holds details about calls to the GetSpokeGatewaySubnetGroup method.
+		GetSpokeGatewaySubnetGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpokeGatewaySubnetGroup is the spokeGatewaySubnetGroup argument value.
+			SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+		}
+		// GetTags holds details about calls to the GetTags method.
+		GetTags []struct {
+			// Tags is the tags argument value.
+			Tags *Tags
+		}
+		// GetTransitGatewayAdvancedConfig holds details about calls to the GetTransitGatewayAdvancedConfig method.
+		GetTransitGatewayAdvancedConfig []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+		}
+		// GetTransitGatewayLanCidr holds details about calls to the GetTransitGatewayLanCidr method.
+		GetTransitGatewayLanCidr []struct {
+			// GatewayName is the gatewayName argument value.
+			GatewayName string
+		}
+		// GetTransitGatewayList holds details about calls to the GetTransitGatewayList method.
+		GetTransitGatewayList []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetTunnelDetectionTime holds details about calls to the GetTunnelDetectionTime method.
+		GetTunnelDetectionTime []struct {
+			// Entity is the entity argument value.
+			Entity string
+		}
+		// IsTransitFireNetReadyToBeDisabled holds details about calls to the IsTransitFireNetReadyToBeDisabled method.
+		IsTransitFireNetReadyToBeDisabled []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// LaunchSpokeVpcContext holds details about calls to the LaunchSpokeVpcContext method.
+		LaunchSpokeVpcContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Spoke is the spoke argument value.
+			Spoke *SpokeVpc
+		}
+		// LaunchTransitVpcContext holds details about calls to the LaunchTransitVpcContext method.
+		LaunchTransitVpcContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// ModifySplitTunnel holds details about calls to the ModifySplitTunnel method.
+		ModifySplitTunnel []struct {
+			// SplitTunnel is the splitTunnel argument value.
+			SplitTunnel *SplitTunnel
+		}
+		// ModifyTunnelDetectionTime holds details about calls to the ModifyTunnelDetectionTime method.
+		ModifyTunnelDetectionTime []struct {
+			// Entity is the entity argument value.
+			Entity string
+			// DetectionTime is the detectionTime argument value.
+			DetectionTime int
+		}
+		// SetBgpBfdPollingTime holds details about calls to the SetBgpBfdPollingTime method.
+		SetBgpBfdPollingTime []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+			// NewPollingTime is the newPollingTime argument value.
+			NewPollingTime int
+		}
+		// SetBgpBfdPollingTimeGatewayGroup holds details about calls to the SetBgpBfdPollingTimeGatewayGroup method.
+		SetBgpBfdPollingTimeGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// PollingTime is the pollingTime argument value.
+			PollingTime int
+		}
+		// SetBgpBfdPollingTimeSpoke holds details about calls to the SetBgpBfdPollingTimeSpoke method.
+		SetBgpBfdPollingTimeSpoke []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+			// NewPollingTime is the newPollingTime argument value.
+			NewPollingTime int
+		}
+		// SetBgpEcmp holds details about calls to the SetBgpEcmp method.
+		SetBgpEcmp []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+			// Enabled is the enabled argument value.
+			Enabled bool
+		}
+		// SetBgpEcmpGatewayGroup holds details about calls to the SetBgpEcmpGatewayGroup method.
+		SetBgpEcmpGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// Enable is the enable argument value.
+			Enable bool
+		}
+		// SetBgpEcmpSpoke holds details about calls to the SetBgpEcmpSpoke method.
+		SetBgpEcmpSpoke []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+			// Enabled is the enabled argument value.
+			Enabled bool
+		}
+		// SetBgpManualSpokeAdvertisedNetworks holds details about calls to the SetBgpManualSpokeAdvertisedNetworks method.
+		SetBgpManualSpokeAdvertisedNetworks []struct {
+			// TransitGw is the transitGw argument value.
+			TransitGw *TransitVpc
+		}
+		// SetBgpPollingTime holds details about calls to the SetBgpPollingTime method.
+		SetBgpPollingTime []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+			// NewPollingTime is the newPollingTime argument value.
+			NewPollingTime int
+		}
+		// SetBgpPollingTimeGatewayGroup holds details about calls to the SetBgpPollingTimeGatewayGroup method.
+		SetBgpPollingTimeGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// PollingTime is the pollingTime argument value.
+			PollingTime int
+		}
+		// SetBgpPollingTimeSpoke holds details about calls to the SetBgpPollingTimeSpoke method.
+		SetBgpPollingTimeSpoke []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+			// NewPollingTime is the newPollingTime argument value.
+			NewPollingTime int
+		}
+		// SetGatewayBgpCommunitiesAccept holds details about calls to the SetGatewayBgpCommunitiesAccept method.
+		SetGatewayBgpCommunitiesAccept []struct {
+			// GwName is the gwName argument value.
+			GwName string
+			// AcceptComm is the acceptComm argument value.
+			AcceptComm bool
+		}
+		// SetGatewayBgpCommunitiesSend holds details about calls to the SetGatewayBgpCommunitiesSend method.
+		SetGatewayBgpCommunitiesSend []struct {
+			// GwName is the gwName argument value.
+			GwName string
+			// SendComm is the sendComm argument value.
+			SendComm bool
+		}
+		// SetGatewayBgpMedToSdnMetric holds details about calls to the SetGatewayBgpMedToSdnMetric method.
+		SetGatewayBgpMedToSdnMetric []struct {
+			// GwName is the gwName argument value.
+			GwName string
+			// Override is the override argument value.
+			Override bool
+		}
+		// SetGatewayGroupBgpCommunitiesAccept holds details about calls to the SetGatewayGroupBgpCommunitiesAccept method.
+		SetGatewayGroupBgpCommunitiesAccept []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// Accept is the accept argument value.
+			Accept bool
+		}
+		// SetGatewayGroupBgpCommunitiesSend holds details about calls to the SetGatewayGroupBgpCommunitiesSend method.
+		SetGatewayGroupBgpCommunitiesSend []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// Send is the send argument value.
+			Send bool
+		}
+		// SetGatewayPhase2Policy holds details about calls to the SetGatewayPhase2Policy method.
+		SetGatewayPhase2Policy []struct {
+			// GwName is the gwName argument value.
+			GwName string
+			// EncPolicy is the encPolicy argument value.
+			EncPolicy string
+			// PfsPolicy is the pfsPolicy argument value.
+			PfsPolicy string
+		}
+		// SetLocalASNumber holds details about calls to the SetLocalASNumber method.
+		SetLocalASNumber []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+			// LocalASNumber is the localASNumber argument value.
+			LocalASNumber string
+		}
+		// SetLocalASNumberGatewayGroup holds details about calls to the SetLocalASNumberGatewayGroup method.
+		SetLocalASNumberGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// LocalAsNumber is the localAsNumber argument value.
+			LocalAsNumber string
+		}
+		// SetLocalASNumberSpoke holds details about calls to the SetLocalASNumberSpoke method.
+		SetLocalASNumberSpoke []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+			// LocalASNumber is the localASNumber argument value.
+			LocalASNumber string
+		}
+		// SetPrependASPath holds details about calls to the SetPrependASPath method.
+		SetPrependASPath []struct {
+			// TransitGateway is the transitGateway argument value.
+			TransitGateway *TransitVpc
+			// PrependASPath is the prependASPath argument value.
+			PrependASPath []string
+		}
+		// SetPrependASPathGatewayGroup holds details about calls to the SetPrependASPathGatewayGroup method.
+		SetPrependASPathGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// PrependAsPath is the prependAsPath argument value.
+			PrependAsPath []string
+		}
+		// SetPrependASPathSpoke holds details about calls to the SetPrependASPathSpoke method.
+		SetPrependASPathSpoke []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+			// PrependASPath is the prependASPath argument value.
+			PrependASPath []string
+		}
+		// SetRxQueueSize holds details about calls to the SetRxQueueSize method.
+		SetRxQueueSize []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// SetSpokeBgpManualAdvertisedNetworks holds details about calls to the SetSpokeBgpManualAdvertisedNetworks method.
+		SetSpokeBgpManualAdvertisedNetworks []struct {
+			// SpokeGateway is the spokeGateway argument value.
+			SpokeGateway *SpokeVpc
+		}
+		// SetSpokeBgpManualAdvertisedNetworksGatewayGroup holds details about calls to the SetSpokeBgpManualAdvertisedNetworksGatewayGroup method.
+		SetSpokeBgpManualAdvertisedNetworksGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// Cidrs is the cidrs argument value.
+			Cidrs string
+		}
+		// SetSymmetricRoutingGatewayGroup holds details about calls to the SetSymmetricRoutingGatewayGroup method.
+		SetSymmetricRoutingGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupUUID is the groupUUID argument value.
+			GroupUUID string
+			// Enable is the enable argument value.
+			Enable bool
+		}
+		// SetTransitLearnedCIDRsApprovalMode holds details about calls to the SetTransitLearnedCIDRsApprovalMode method.
+		SetTransitLearnedCIDRsApprovalMode []struct {
+			// Gw is the gw argument value.
+			Gw *TransitVpc
+			// Mode is the mode argument value.
+			Mode string
+		}
+		// SetVpnGatewayAuthentication holds details about calls to the SetVpnGatewayAuthentication method.
+		SetVpnGatewayAuthentication []struct {
+			// Gateway is the gateway argument value.
+			Gateway *VpnGatewayAuth
+		}
+		// UpdateDNat holds details about calls to the UpdateDNat method.
+		UpdateDNat []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// UpdateEdgeGateway holds details about calls to the UpdateEdgeGateway method.
+		UpdateEdgeGateway []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// UpdateEdgeGatewayV2 holds details about calls to the UpdateEdgeGatewayV2 method.
+		UpdateEdgeGatewayV2 []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// UpdateGateway holds details about calls to the UpdateGateway method.
+		UpdateGateway []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// UpdateGatewayContext holds details about calls to the UpdateGatewayContext method.
+		UpdateGatewayContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// UpdateGroupInstanceSize holds details about calls to the UpdateGroupInstanceSize method.
+		UpdateGroupInstanceSize []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// InstanceSize is the instanceSize argument value.
+			InstanceSize string
+		}
+		// UpdateMaxVpnConn holds details about calls to the UpdateMaxVpnConn method.
+		UpdateMaxVpnConn []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// UpdateSpokeGatewaySubnetGroup holds details about calls to the UpdateSpokeGatewaySubnetGroup method.
+		UpdateSpokeGatewaySubnetGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SpokeGatewaySubnetGroup is the spokeGatewaySubnetGroup argument value.
+			SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+		}
+		// UpdateSpokePendingApprovedCidrs holds details about calls to the UpdateSpokePendingApprovedCidrs method.
+		UpdateSpokePendingApprovedCidrs []struct {
+			// Gateway is the gateway argument value.
+			Gateway *SpokeVpc
+		}
+		// UpdateSpokePendingApprovedCidrsGatewayGroup holds details about calls to the UpdateSpokePendingApprovedCidrsGatewayGroup method.
+		UpdateSpokePendingApprovedCidrsGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// ApprovedCidrs is the approvedCidrs argument value.
+			ApprovedCidrs []string
+		}
+		// UpdateTags holds details about calls to the UpdateTags method.
+		UpdateTags []struct {
+			// Tags is the tags argument value.
+			Tags *Tags
+		}
+		// UpdateTransitGatewayCustomizedVpcRoute holds details about calls to the UpdateTransitGatewayCustomizedVpcRoute method.
+		UpdateTransitGatewayCustomizedVpcRoute []struct {
+			// Gateway is the gateway argument value.
+			Gateway string
+			// CustomizedTransitVpcRoutes is the customizedTransitVpcRoutes argument value.
+			CustomizedTransitVpcRoutes []string
+		}
+		// UpdateTransitPendingApprovedCidrs holds details about calls to the UpdateTransitPendingApprovedCidrs method.
+		UpdateTransitPendingApprovedCidrs []struct {
+			// Gateway is the gateway argument value.
+			Gateway *TransitVpc
+		}
+		// UpdateTransitPendingApprovedCidrsGatewayGroup holds details about calls to the UpdateTransitPendingApprovedCidrsGatewayGroup method.
+		UpdateTransitPendingApprovedCidrsGatewayGroup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GroupName is the groupName argument value.
+			GroupName string
+			// ApprovedCidrs is the approvedCidrs argument value.
+			ApprovedCidrs []string
+		}
+		// UpdateVpnCidr holds details about calls to the UpdateVpnCidr method.
+		UpdateVpnCidr []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+	}
+	lockAddSpokeGatewaySubnetGroup                      sync.RWMutex
+	lockAttachTransitGWForHybrid                        sync.RWMutex
+	lockChangeBgpHoldTime                               sync.RWMutex
+	lockChangeBgpHoldTimeGatewayGroup                   sync.RWMutex
+	lockChangeBgpOverLanIntfCnt                         sync.RWMutex
+	lockCreateGatewayContext                            sync.RWMutex
+	lockCreateGatewayGroup                              sync.RWMutex
+	lockCreatePublicSubnetFilteringGatewayContext       sync.RWMutex
+	lockCreateSpokeHaGw                                 sync.RWMutex
+	lockCreateSpokeHaGwContext                          sync.RWMutex
+	lockCreateTransitHaGwContext                        sync.RWMutex
+	lockDeleteGateway                                   sync.RWMutex
+	lockDeleteGatewayContext                            sync.RWMutex
+	lockDeleteGatewayGroup                              sync.RWMutex
+	lockDeletePublicSubnetFilteringGatewayContext       sync.RWMutex
+	lockDeleteSpokeGatewaySubnetGroup                   sync.RWMutex
+	lockDetachTransitGWForHybrid                        sync.RWMutex
+	lockDisableActiveStandby                            sync.RWMutex
+	lockDisableActiveStandbyGatewayGroup                sync.RWMutex
+	lockDisableActiveStandbySpoke                       sync.RWMutex
+	lockDisableAdvertiseTransitCidr                     sync.RWMutex
+	lockDisableAdvertiseTransitCidrGatewayGroup         sync.RWMutex
+	lockDisableAutoAdvertiseS2CCidrs                    sync.RWMutex
+	lockDisableAutoAdvertiseS2CCidrsGatewayGroup        sync.RWMutex
+	lockDisableConnectedTransit                         sync.RWMutex
+	lockDisableConnectedTransitGatewayGroup             sync.RWMutex
+	lockDisableCustomSNat                               sync.RWMutex
+	lockDisableEgressTransitFirenet                     sync.RWMutex
+	lockDisableFireNetGatewayGroup                      sync.RWMutex
+	lockDisableGatewayFireNetInterfaces                 sync.RWMutex
+	lockDisableGatewayGroupSNat                         sync.RWMutex
+	lockDisableGatewayGroupVpcDNSServer                 sync.RWMutex
+	lockDisableGatewayLoadBalancerGatewayGroup          sync.RWMutex
+	lockDisableGlobalVpc                                sync.RWMutex
+	lockDisableGlobalVpcGatewayGroup                    sync.RWMutex
+	lockDisableGroGso                                   sync.RWMutex
+	lockDisableGroGsoGatewayGroup                       sync.RWMutex
+	lockDisableGuardDutyEnforcement                     sync.RWMutex
+	lockDisableHybridConnectionGatewayGroup             sync.RWMutex
+	lockDisableIPv6                                     sync.RWMutex
+	lockDisableIPv6GatewayGroup                         sync.RWMutex
+	lockDisableJumboFrame                               sync.RWMutex
+	lockDisableJumboFrameGatewayGroup                   sync.RWMutex
+	lockDisableMonitorGatewaySubnets                    sync.RWMutex
+	lockDisableMultiTierTransitGatewayGroup             sync.RWMutex
+	lockDisableMultitierTransit                         sync.RWMutex
+	lockDisablePrivateVpcDefaultRoute                   sync.RWMutex
+	lockDisablePrivateVpcDefaultRouteGatewayGroup       sync.RWMutex
+	lockDisableS2CRxBalancing                           sync.RWMutex
+	lockDisableS2cRxBalancingGatewayGroup               sync.RWMutex
+	lockDisableSNat                                     sync.RWMutex
+	lockDisableSegmentation                             sync.RWMutex
+	lockDisableSegmentationGatewayGroup                 sync.RWMutex
+	lockDisableSingleAZGateway                          sync.RWMutex
+	lockDisableSkipPublicRouteUpdate                    sync.RWMutex
+	lockDisableSkipPublicRouteUpdateGatewayGroup        sync.RWMutex
+	lockDisableSpokeLearnedCidrsApproval                sync.RWMutex
+	lockDisableSpokeLearnedCidrsApprovalGatewayGroup    sync.RWMutex
+	lockDisableSpokeOnpremRoutePropagation              sync.RWMutex
+	lockDisableSpokeOnpremRoutePropagationGatewayGroup  sync.RWMutex
+	lockDisableSpokePreserveAsPath                      sync.RWMutex
+	lockDisableSpokePreserveAsPathGatewayGroup          sync.RWMutex
+	lockDisableSummarizeCidrToTgw                       sync.RWMutex
+	lockDisableTransitFireNet                           sync.RWMutex
+	lockDisableTransitFireNetGatewayGroup               sync.RWMutex
+	lockDisableTransitLearnedCidrsApproval              sync.RWMutex
+	lockDisableTransitLearnedCidrsApprovalGatewayGroup  sync.RWMutex
+	lockDisableTransitPreserveAsPath                    sync.RWMutex
+	lockDisableTransitPreserveAsPathGatewayGroup        sync.RWMutex
+	lockDisableTransitSummarizeCidrToTgwGatewayGroup    sync.RWMutex
+	lockDisableVPNConfig                                sync.RWMutex
+	lockDisableVpcDNSServer                             sync.RWMutex
+	lockDisableVpnNat                                   sync.RWMutex
+	lockEditDesignatedGateway                           sync.RWMutex
+	lockEditGatewayAdvertisedCidr                       sync.RWMutex
+	lockEditGatewayCustomRoutes                         sync.RWMutex
+	lockEditGatewayFilterRoutes                         sync.RWMutex
+	lockEditManagedRouteTables                          sync.RWMutex
+	lockEditManagedRouteTablesForGatewayGroup           sync.RWMutex
+	lockEditPrivateRouteTableConfig                     sync.RWMutex
+	lockEditPrivateRouteTableConfigForGatewayGroup      sync.RWMutex
+	lockEditPublicSubnetFilteringRouteTableList         sync.RWMutex
+	lockEnableActiveStandby                             sync.RWMutex
+	lockEnableActiveStandbyGatewayGroup                 sync.RWMutex
+	lockEnableActiveStandbyPreemptive                   sync.RWMutex
+	lockEnableActiveStandbyPreemptiveGatewayGroup       sync.RWMutex
+	lockEnableActiveStandbyPreemptiveSpoke              sync.RWMutex
+	lockEnableActiveStandbySpoke                        sync.RWMutex
+	lockEnableAdvertiseTransitCidr                      sync.RWMutex
+	lockEnableAdvertiseTransitCidrGatewayGroup          sync.RWMutex
+	lockEnableAutoAdvertiseS2CCidrs                     sync.RWMutex
+	lockEnableAutoAdvertiseS2CCidrsGatewayGroup         sync.RWMutex
+	lockEnableConnectedTransit                          sync.RWMutex
+	lockEnableConnectedTransitGatewayGroup              sync.RWMutex
+	lockEnableCustomizedSNat                            sync.RWMutex
+	lockEnableEgressTransitFirenet                      sync.RWMutex
+	lockEnableEncryptVolume                             sync.RWMutex
+	lockEnableFireNetGatewayGroup                       sync.RWMutex
+	lockEnableGatewayFireNetInterfaces                  sync.RWMutex
+	lockEnableGatewayFireNetInterfacesWithGWLB          sync.RWMutex
+	lockEnableGatewayGroupSNat                          sync.RWMutex
+	lockEnableGatewayGroupVpcDNSServer                  sync.RWMutex
+	lockEnableGatewayLoadBalancerGatewayGroup           sync.RWMutex
+	lockEnableGlobalVpc                                 sync.RWMutex
+	lockEnableGlobalVpcGatewayGroup                     sync.RWMutex
+	lockEnableGroGso                                    sync.RWMutex
+	lockEnableGroGsoGatewayGroup                        sync.RWMutex
+	lockEnableGuardDutyEnforcement                      sync.RWMutex
+	lockEnableHybridConnectionGatewayGroup              sync.RWMutex
+	lockEnableIPv6                                      sync.RWMutex
+	lockEnableIPv6GatewayGroup                          sync.RWMutex
+	lockEnableJumboFrame                                sync.RWMutex
+	lockEnableJumboFrameGatewayGroup                    sync.RWMutex
+	lockEnableMonitorGatewaySubnets                     sync.RWMutex
+	lockEnableMultiTierTransitGatewayGroup              sync.RWMutex
+	lockEnableMultitierTransit                          sync.RWMutex
+	lockEnablePeeringHaGateway                          sync.RWMutex
+	lockEnablePrivateVpcDefaultRoute                    sync.RWMutex
+	lockEnablePrivateVpcDefaultRouteGatewayGroup        sync.RWMutex
+	lockEnablePublicSubnetFilteringHAGateway            sync.RWMutex
+	lockEnableS2CRxBalancing                            sync.RWMutex
+	lockEnableS2cRxBalancingGatewayGroup                sync.RWMutex
+	lockEnableSNat                                      sync.RWMutex
+	lockEnableSegmentation                              sync.RWMutex
+	lockEnableSegmentationGatewayGroup                  sync.RWMutex
+	lockEnableSingleAZGateway                           sync.RWMutex
+	lockEnableSkipPublicRouteUpdate                     sync.RWMutex
+	lockEnableSkipPublicRouteUpdateGatewayGroup         sync.RWMutex
+	lockEnableSpokeLearnedCidrsApproval                 sync.RWMutex
+	lockEnableSpokeLearnedCidrsApprovalGatewayGroup     sync.RWMutex
+	lockEnableSpokeOnpremRoutePropagation               sync.RWMutex
+	lockEnableSpokeOnpremRoutePropagationGatewayGroup   sync.RWMutex
+	lockEnableSpokePreserveAsPath                       sync.RWMutex
+	lockEnableSpokePreserveAsPathGatewayGroup           sync.RWMutex
+	lockEnableSummarizeCidrToTgw                        sync.RWMutex
+	lockEnableTransitFireNet                            sync.RWMutex
+	lockEnableTransitFireNetGatewayGroup                sync.RWMutex
+	lockEnableTransitFireNetWithGWLB                    sync.RWMutex
+	lockEnableTransitFireNetWithGWLBGatewayGroup        sync.RWMutex
+	lockEnableTransitLearnedCidrsApproval               sync.RWMutex
+	lockEnableTransitLearnedCidrsApprovalGatewayGroup   sync.RWMutex
+	lockEnableTransitPreserveAsPath                     sync.RWMutex
+	lockEnableTransitPreserveAsPathGatewayGroup         sync.RWMutex
+	lockEnableTransitSummarizeCidrToTgwGatewayGroup     sync.RWMutex
+	lockEnableVPNConfig                                 sync.RWMutex
+	lockEnableVpcDNSServer                              sync.RWMutex
+	lockEnableVpnNat                                    sync.RWMutex
+	lockGetBgpLanIPList                                 sync.RWMutex
+	lockGetControllerIP                                 sync.RWMutex
+	lockGetDefaultTagsConfig                            sync.RWMutex
+	lockGetGateway                                      sync.RWMutex
+	lockGetGatewayBgpCommunities                        sync.RWMutex
+	lockGetGatewayBgpMedToSdnMetric                     sync.RWMutex
+	lockGetGatewayDetail                                sync.RWMutex
+	lockGetGatewayGroup                                 sync.RWMutex
+	lockGetGatewayGroupByName                           sync.RWMutex
+	lockGetGeoVPNName                                   sync.RWMutex
+	lockGetGroGsoStatus                                 sync.RWMutex
+	lockGetIgnoreTagsConfig                             sync.RWMutex
+	lockGetSplitTunnel                                  sync.RWMutex
+	lockGetSpokeGatewayAdvancedConfig                   sync.RWMutex
+	lockGetSpokeGatewayList                             sync.RWMutex
+	lockGetSpokeGatewaySubnetGroup                      sync.RWMutex
+	lockGetTags                                         sync.RWMutex
+	lockGetTransitGatewayAdvancedConfig                 sync.RWMutex
+	lockGetTransitGatewayLanCidr                        sync.RWMutex
+	lockGetTransitGatewayList                           sync.RWMutex
+	lockGetTunnelDetectionTime                          sync.RWMutex
+	lockIsTransitFireNetReadyToBeDisabled               sync.RWMutex
+	lockLaunchSpokeVpcContext                           sync.RWMutex
+	lockLaunchTransitVpcContext                         sync.RWMutex
+	lockModifySplitTunnel                               sync.RWMutex
+	lockModifyTunnelDetectionTime                       sync.RWMutex
+	lockSetBgpBfdPollingTime                            sync.RWMutex
+	lockSetBgpBfdPollingTimeGatewayGroup                sync.RWMutex
+	lockSetBgpBfdPollingTimeSpoke                       sync.RWMutex
+	lockSetBgpEcmp                                      sync.RWMutex
+	lockSetBgpEcmpGatewayGroup                          sync.RWMutex
+	lockSetBgpEcmpSpoke                                 sync.RWMutex
+	lockSetBgpManualSpokeAdvertisedNetworks             sync.RWMutex
+	lockSetBgpPollingTime                               sync.RWMutex
+	lockSetBgpPollingTimeGatewayGroup                   sync.RWMutex
+	lockSetBgpPollingTimeSpoke                          sync.RWMutex
+	lockSetGatewayBgpCommunitiesAccept                  sync.RWMutex
+	lockSetGatewayBgpCommunitiesSend                    sync.RWMutex
+	lockSetGatewayBgpMedToSdnMetric                     sync.RWMutex
+	lockSetGatewayGroupBgpCommunitiesAccept             sync.RWMutex
+	lockSetGatewayGroupBgpCommunitiesSend               sync.RWMutex
+	lockSetGatewayPhase2Policy                          sync.RWMutex
+	lockSetLocalASNumber                                sync.RWMutex
+	lockSetLocalASNumberGatewayGroup                    sync.RWMutex
+	lockSetLocalASNumberSpoke                           sync.RWMutex
+	lockSetPrependASPath                                sync.RWMutex
+	lockSetPrependASPathGatewayGroup                    sync.RWMutex
+	lockSetPrependASPathSpoke                           sync.RWMutex
+	lockSetRxQueueSize                                  sync.RWMutex
+	lockSetSpokeBgpManualAdvertisedNetworks             sync.RWMutex
+	lockSetSpokeBgpManualAdvertisedNetworksGatewayGroup sync.RWMutex
+	lockSetSymmetricRoutingGatewayGroup                 sync.RWMutex
+	lockSetTransitLearnedCIDRsApprovalMode              sync.RWMutex
+	lockSetVpnGatewayAuthentication                     sync.RWMutex
+	lockUpdateDNat                                      sync.RWMutex
+	lockUpdateEdgeGateway                               sync.RWMutex
+	lockUpdateEdgeGatewayV2                             sync.RWMutex
+	lockUpdateGateway                                   sync.RWMutex
+	lockUpdateGatewayContext                            sync.RWMutex
+	lockUpdateGroupInstanceSize                         sync.RWMutex
+	lockUpdateMaxVpnConn                                sync.RWMutex
+	lockUpdateSpokeGatewaySubnetGroup                   sync.RWMutex
+	lockUpdateSpokePendingApprovedCidrs                 sync.RWMutex
+	lockUpdateSpokePendingApprovedCidrsGatewayGroup     sync.RWMutex
+	lockUpdateTags                                      sync.RWMutex
+	lockUpdateTransitGatewayCustomizedVpcRoute          sync.RWMutex
+	lockUpdateTransitPendingApprovedCidrs               sync.RWMutex
+	lockUpdateTransitPendingApprovedCidrsGatewayGroup   sync.RWMutex
+	lockUpdateVpnCidr                                   sync.RWMutex
+}
+
+// AddSpokeGatewaySubnetGroup calls AddSpokeGatewaySubnetGroupFunc.
+func (mock *GatewayClientMock) AddSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error {
+	if mock.AddSpokeGatewaySubnetGroupFunc == nil {
+		panic("GatewayClientMock.AddSpokeGatewaySubnetGroupFunc: method is nil but GatewayClient.AddSpokeGatewaySubnetGroup was just called")
+	}
+	callInfo := struct {
+		Ctx                     context.Context
+		SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+	}{
+		Ctx:                     ctx,
+		SpokeGatewaySubnetGroup: spokeGatewaySubnetGroup,
+	}
+	mock.lockAddSpokeGatewaySubnetGroup.Lock()
+	mock.calls.AddSpokeGatewaySubnetGroup = append(mock.calls.AddSpokeGatewaySubnetGroup, callInfo)
+	mock.lockAddSpokeGatewaySubnetGroup.Unlock()
+	return mock.AddSpokeGatewaySubnetGroupFunc(ctx, spokeGatewaySubnetGroup)
+}
+
+// AddSpokeGatewaySubnetGroupCalls gets all the calls that were made to AddSpokeGatewaySubnetGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.AddSpokeGatewaySubnetGroupCalls())
+func (mock *GatewayClientMock) AddSpokeGatewaySubnetGroupCalls() []struct {
+	Ctx                     context.Context
+	SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+} {
+	var calls []struct {
+		Ctx                     context.Context
+		SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+	}
+	mock.lockAddSpokeGatewaySubnetGroup.RLock()
+	calls = mock.calls.AddSpokeGatewaySubnetGroup
+	mock.lockAddSpokeGatewaySubnetGroup.RUnlock()
+	return calls
+}
+
+// AttachTransitGWForHybrid calls AttachTransitGWForHybridFunc.
+func (mock *GatewayClientMock) AttachTransitGWForHybrid(gateway *TransitVpc) error {
+	if mock.AttachTransitGWForHybridFunc == nil {
+		panic("GatewayClientMock.AttachTransitGWForHybridFunc: method is nil but GatewayClient.AttachTransitGWForHybrid was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockAttachTransitGWForHybrid.Lock()
+	mock.calls.AttachTransitGWForHybrid = append(mock.calls.AttachTransitGWForHybrid, callInfo)
+	mock.lockAttachTransitGWForHybrid.Unlock()
+	return mock.AttachTransitGWForHybridFunc(gateway)
+}
+
+// AttachTransitGWForHybridCalls gets all the calls that were made to AttachTransitGWForHybrid.
+// Check the length with:
+//
+//	len(mockedGatewayClient.AttachTransitGWForHybridCalls())
+func (mock *GatewayClientMock) AttachTransitGWForHybridCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockAttachTransitGWForHybrid.RLock()
+	calls = mock.calls.AttachTransitGWForHybrid
+	mock.lockAttachTransitGWForHybrid.RUnlock()
+	return calls
+}
+
+// ChangeBgpHoldTime calls ChangeBgpHoldTimeFunc.
+func (mock *GatewayClientMock) ChangeBgpHoldTime(gwName string, holdTime int) error {
+	if mock.ChangeBgpHoldTimeFunc == nil {
+		panic("GatewayClientMock.ChangeBgpHoldTimeFunc: method is nil but GatewayClient.ChangeBgpHoldTime was just called")
+	}
+	callInfo := struct {
+		GwName   string
+		HoldTime int
+	}{
+		GwName:   gwName,
+		HoldTime: holdTime,
+	}
+	mock.lockChangeBgpHoldTime.Lock()
+	mock.calls.ChangeBgpHoldTime = append(mock.calls.ChangeBgpHoldTime, callInfo)
+	mock.lockChangeBgpHoldTime.Unlock()
+	return mock.ChangeBgpHoldTimeFunc(gwName, holdTime)
+}
+
+// ChangeBgpHoldTimeCalls gets all the calls that were made to ChangeBgpHoldTime.
+// Check the length with:
+//
+//	len(mockedGatewayClient.ChangeBgpHoldTimeCalls())
+func (mock *GatewayClientMock) ChangeBgpHoldTimeCalls() []struct {
+	GwName   string
+	HoldTime int
+} {
+	var calls []struct {
+		GwName   string
+		HoldTime int
+	}
+	mock.lockChangeBgpHoldTime.RLock()
+	calls = mock.calls.ChangeBgpHoldTime
+	mock.lockChangeBgpHoldTime.RUnlock()
+	return calls
+}
+
+// ChangeBgpHoldTimeGatewayGroup calls ChangeBgpHoldTimeGatewayGroupFunc.
+func (mock *GatewayClientMock) ChangeBgpHoldTimeGatewayGroup(ctx context.Context, groupName string, holdTime int) error {
+	if mock.ChangeBgpHoldTimeGatewayGroupFunc == nil {
+		panic("GatewayClientMock.ChangeBgpHoldTimeGatewayGroupFunc: method is nil but GatewayClient.ChangeBgpHoldTimeGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+		HoldTime  int
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+		HoldTime:  holdTime,
+	}
+	mock.lockChangeBgpHoldTimeGatewayGroup.Lock()
+	mock.calls.ChangeBgpHoldTimeGatewayGroup = append(mock.calls.ChangeBgpHoldTimeGatewayGroup, callInfo)
+	mock.lockChangeBgpHoldTimeGatewayGroup.Unlock()
+	return mock.ChangeBgpHoldTimeGatewayGroupFunc(ctx, groupName, holdTime)
+}
+
+// ChangeBgpHoldTimeGatewayGroupCalls gets all the calls that were made to ChangeBgpHoldTimeGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.ChangeBgpHoldTimeGatewayGroupCalls())
+func (mock *GatewayClientMock) ChangeBgpHoldTimeGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+	HoldTime  int
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+		HoldTime  int
+	}
+	mock.lockChangeBgpHoldTimeGatewayGroup.RLock()
+	calls = mock.calls.ChangeBgpHoldTimeGatewayGroup
+	mock.lockChangeBgpHoldTimeGatewayGroup.RUnlock()
+	return calls
+}
+
+// ChangeBgpOverLanIntfCnt calls ChangeBgpOverLanIntfCntFunc.
+func (mock *GatewayClientMock) ChangeBgpOverLanIntfCnt(gateway *Gateway) error {
+	if mock.ChangeBgpOverLanIntfCntFunc == nil {
+		panic("GatewayClientMock.ChangeBgpOverLanIntfCntFunc: method is nil but GatewayClient.ChangeBgpOverLanIntfCnt was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockChangeBgpOverLanIntfCnt.Lock()
+	mock.calls.ChangeBgpOverLanIntfCnt = append(mock.calls.ChangeBgpOverLanIntfCnt, callInfo)
+	mock.lockChangeBgpOverLanIntfCnt.Unlock()
+	return mock.ChangeBgpOverLanIntfCntFunc(gateway)
+}
+
+// ChangeBgpOverLanIntfCntCalls gets all the calls that were made to ChangeBgpOverLanIntfCnt.
+// Check the length with:
+//
+//	len(mockedGatewayClient.ChangeBgpOverLanIntfCntCalls())
+func (mock *GatewayClientMock) ChangeBgpOverLanIntfCntCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockChangeBgpOverLanIntfCnt.RLock()
+	calls = mock.calls.ChangeBgpOverLanIntfCnt
+	mock.lockChangeBgpOverLanIntfCnt.RUnlock()
+	return calls
+}
+
+// CreateGatewayContext calls CreateGatewayContextFunc.
+func (mock *GatewayClientMock) CreateGatewayContext(ctx context.Context, gateway *Gateway) error {
+	if mock.CreateGatewayContextFunc == nil {
+		panic("GatewayClientMock.CreateGatewayContextFunc: method is nil but GatewayClient.CreateGatewayContext was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}{
+		Ctx:     ctx,
+		Gateway: gateway,
+	}
+	mock.lockCreateGatewayContext.Lock()
+	mock.calls.CreateGatewayContext = append(mock.calls.CreateGatewayContext, callInfo)
+	mock.lockCreateGatewayContext.Unlock()
+	return mock.CreateGatewayContextFunc(ctx, gateway)
+}
+
+// CreateGatewayContextCalls gets all the calls that were made to CreateGatewayContext.
+// Check the length with:
+//
+//	len(mockedGatewayClient.CreateGatewayContextCalls())
+func (mock *GatewayClientMock) CreateGatewayContextCalls() []struct {
+	Ctx     context.Context
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}
+	mock.lockCreateGatewayContext.RLock()
+	calls = mock.calls.CreateGatewayContext
+	mock.lockCreateGatewayContext.RUnlock()
+	return calls
+}
+
+// CreateGatewayGroup calls CreateGatewayGroupFunc.
+func (mock *GatewayClientMock) CreateGatewayGroup(ctx context.Context, spokeGroup *GatewayGroup) error {
+	if mock.CreateGatewayGroupFunc == nil {
+		panic("GatewayClientMock.CreateGatewayGroupFunc: method is nil but GatewayClient.CreateGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		SpokeGroup *GatewayGroup
+	}{
+		Ctx:        ctx,
+		SpokeGroup: spokeGroup,
+	}
+	mock.lockCreateGatewayGroup.Lock()
+	mock.calls.CreateGatewayGroup = append(mock.calls.CreateGatewayGroup, callInfo)
+	mock.lockCreateGatewayGroup.Unlock()
+	return mock.CreateGatewayGroupFunc(ctx, spokeGroup)
+}
+
+// CreateGatewayGroupCalls gets all the calls that were made to CreateGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.CreateGatewayGroupCalls())
+func (mock *GatewayClientMock) CreateGatewayGroupCalls() []struct {
+	Ctx        context.Context
+	SpokeGroup *GatewayGroup
+} {
+	var calls []struct {
+		Ctx        context.Context
+		SpokeGroup *GatewayGroup
+	}
+	mock.lockCreateGatewayGroup.RLock()
+	calls = mock.calls.CreateGatewayGroup
+	mock.lockCreateGatewayGroup.RUnlock()
+	return calls
+}
+
+// CreatePublicSubnetFilteringGatewayContext calls CreatePublicSubnetFilteringGatewayContextFunc.
+func (mock *GatewayClientMock) CreatePublicSubnetFilteringGatewayContext(ctx context.Context, gateway *Gateway) error {
+	if mock.CreatePublicSubnetFilteringGatewayContextFunc == nil {
+		panic("GatewayClientMock.CreatePublicSubnetFilteringGatewayContextFunc: method is nil but GatewayClient.CreatePublicSubnetFilteringGatewayContext was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}{
+		Ctx:     ctx,
+		Gateway: gateway,
+	}
+	mock.lockCreatePublicSubnetFilteringGatewayContext.Lock()
+	mock.calls.CreatePublicSubnetFilteringGatewayContext = append(mock.calls.CreatePublicSubnetFilteringGatewayContext, callInfo)
+	mock.lockCreatePublicSubnetFilteringGatewayContext.Unlock()
+	return mock.CreatePublicSubnetFilteringGatewayContextFunc(ctx, gateway)
+}
+
+// CreatePublicSubnetFilteringGatewayContextCalls gets all the calls that were made to CreatePublicSubnetFilteringGatewayContext.
+// Check the length with:
+//
+//	len(mockedGatewayClient.CreatePublicSubnetFilteringGatewayContextCalls())
+func (mock *GatewayClientMock) CreatePublicSubnetFilteringGatewayContextCalls() []struct {
+	Ctx     context.Context
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}
+	mock.lockCreatePublicSubnetFilteringGatewayContext.RLock()
+	calls = mock.calls.CreatePublicSubnetFilteringGatewayContext
+	mock.lockCreatePublicSubnetFilteringGatewayContext.RUnlock()
+	return calls
+}
+
+// CreateSpokeHaGw calls CreateSpokeHaGwFunc.
+func (mock *GatewayClientMock) CreateSpokeHaGw(spokeHaGateway *SpokeHaGateway) (string, error) {
+	if mock.CreateSpokeHaGwFunc == nil {
+		panic("GatewayClientMock.CreateSpokeHaGwFunc: method is nil but GatewayClient.CreateSpokeHaGw was just called")
+	}
+	callInfo := struct {
+		SpokeHaGateway *SpokeHaGateway
+	}{
+		SpokeHaGateway: spokeHaGateway,
+	}
+	mock.lockCreateSpokeHaGw.Lock()
+	mock.calls.CreateSpokeHaGw = append(mock.calls.CreateSpokeHaGw, callInfo)
+	mock.lockCreateSpokeHaGw.Unlock()
+	return mock.CreateSpokeHaGwFunc(spokeHaGateway)
+}
+
+// CreateSpokeHaGwCalls gets all the calls that were made to CreateSpokeHaGw.
+// Check the length with:
+//
+//	len(mockedGatewayClient.CreateSpokeHaGwCalls())
+func (mock *GatewayClientMock) CreateSpokeHaGwCalls() []struct {
+	SpokeHaGateway *SpokeHaGateway
+} {
+	var calls []struct {
+		SpokeHaGateway *SpokeHaGateway
+	}
+	mock.lockCreateSpokeHaGw.RLock()
+	calls = mock.calls.CreateSpokeHaGw
+	mock.lockCreateSpokeHaGw.RUnlock()
+	return calls
+}
+
+// CreateSpokeHaGwContext calls CreateSpokeHaGwContextFunc.
+func (mock *GatewayClientMock) CreateSpokeHaGwContext(ctx context.Context, spokeHaGateway *SpokeHaGateway) (string, error) {
+	if mock.CreateSpokeHaGwContextFunc == nil {
+		panic("GatewayClientMock.CreateSpokeHaGwContextFunc: method is nil but GatewayClient.CreateSpokeHaGwContext was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		SpokeHaGateway *SpokeHaGateway
+	}{
+		Ctx:            ctx,
+		SpokeHaGateway: spokeHaGateway,
+	}
+	mock.lockCreateSpokeHaGwContext.Lock()
+	mock.calls.CreateSpokeHaGwContext = append(mock.calls.CreateSpokeHaGwContext, callInfo)
+	mock.lockCreateSpokeHaGwContext.Unlock()
+	return mock.CreateSpokeHaGwContextFunc(ctx, spokeHaGateway)
+}
+
+// CreateSpokeHaGwContextCalls gets all the calls that were made to CreateSpokeHaGwContext.
+// Check the length with:
+//
+//	len(mockedGatewayClient.CreateSpokeHaGwContextCalls())
+func (mock *GatewayClientMock) CreateSpokeHaGwContextCalls() []struct {
+	Ctx            context.Context
+	SpokeHaGateway *SpokeHaGateway
+} {
+	var calls []struct {
+		Ctx            context.Context
+		SpokeHaGateway *SpokeHaGateway
+	}
+	mock.lockCreateSpokeHaGwContext.RLock()
+	calls = mock.calls.CreateSpokeHaGwContext
+	mock.lockCreateSpokeHaGwContext.RUnlock()
+	return calls
+}
+
+// CreateTransitHaGwContext calls CreateTransitHaGwContextFunc.
+func (mock *GatewayClientMock) CreateTransitHaGwContext(ctx context.Context, transitHaGateway *TransitHaGateway) (string, error) {
+	if mock.CreateTransitHaGwContextFunc == nil {
+		panic("GatewayClientMock.CreateTransitHaGwContextFunc: method is nil but GatewayClient.CreateTransitHaGwContext was just called")
+	}
+	callInfo := struct {
+		Ctx              context.Context
+		TransitHaGateway *TransitHaGateway
+	}{
+		Ctx:              ctx,
+		TransitHaGateway: transitHaGateway,
+	}
+	mock.lockCreateTransitHaGwContext.Lock()
+	mock.calls.CreateTransitHaGwContext = append(mock.calls.CreateTransitHaGwContext, callInfo)
+	mock.lockCreateTransitHaGwContext.Unlock()
+	return mock.CreateTransitHaGwContextFunc(ctx, transitHaGateway)
+}
+
+// CreateTransitHaGwContextCalls gets all the calls that were made to CreateTransitHaGwContext.
+// Check the length with:
+//
+//	len(mockedGatewayClient.CreateTransitHaGwContextCalls())
+func (mock *GatewayClientMock) CreateTransitHaGwContextCalls() []struct {
+	Ctx              context.Context
+	TransitHaGateway *TransitHaGateway
+} {
+	var calls []struct {
+		Ctx              context.Context
+		TransitHaGateway *TransitHaGateway
+	}
+	mock.lockCreateTransitHaGwContext.RLock()
+	calls = mock.calls.CreateTransitHaGwContext
+	mock.lockCreateTransitHaGwContext.RUnlock()
+	return calls
+}
+
+// DeleteGateway calls DeleteGatewayFunc.
+func (mock *GatewayClientMock) DeleteGateway(gateway *Gateway) error {
+	if mock.DeleteGatewayFunc == nil {
+		panic("GatewayClientMock.DeleteGatewayFunc: method is nil but GatewayClient.DeleteGateway was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDeleteGateway.Lock()
+	mock.calls.DeleteGateway = append(mock.calls.DeleteGateway, callInfo)
+	mock.lockDeleteGateway.Unlock()
+	return mock.DeleteGatewayFunc(gateway)
+}
+
+// DeleteGatewayCalls gets all the calls that were made to DeleteGateway.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DeleteGatewayCalls())
+func (mock *GatewayClientMock) DeleteGatewayCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDeleteGateway.RLock()
+	calls = mock.calls.DeleteGateway
+	mock.lockDeleteGateway.RUnlock()
+	return calls
+}
+
+// DeleteGatewayContext calls DeleteGatewayContextFunc.
+func (mock *GatewayClientMock) DeleteGatewayContext(ctx context.Context, gateway *Gateway) error {
+	if mock.DeleteGatewayContextFunc == nil {
+		panic("GatewayClientMock.DeleteGatewayContextFunc: method is nil but GatewayClient.DeleteGatewayContext was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}{
+		Ctx:     ctx,
+		Gateway: gateway,
+	}
+	mock.lockDeleteGatewayContext.Lock()
+	mock.calls.DeleteGatewayContext = append(mock.calls.DeleteGatewayContext, callInfo)
+	mock.lockDeleteGatewayContext.Unlock()
+	return mock.DeleteGatewayContextFunc(ctx, gateway)
+}
+
+// DeleteGatewayContextCalls gets all the calls that were made to DeleteGatewayContext.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DeleteGatewayContextCalls())
+func (mock *GatewayClientMock) DeleteGatewayContextCalls() []struct {
+	Ctx     context.Context
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}
+	mock.lockDeleteGatewayContext.RLock()
+	calls = mock.calls.DeleteGatewayContext
+	mock.lockDeleteGatewayContext.RUnlock()
+	return calls
+}
+
+// DeleteGatewayGroup calls DeleteGatewayGroupFunc.
+func (mock *GatewayClientMock) DeleteGatewayGroup(ctx context.Context, groupUUID string) error {
+	if mock.DeleteGatewayGroupFunc == nil {
+		panic("GatewayClientMock.DeleteGatewayGroupFunc: method is nil but GatewayClient.DeleteGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupUUID string
+	}{
+		Ctx:       ctx,
+		GroupUUID: groupUUID,
+	}
+	mock.lockDeleteGatewayGroup.Lock()
+	mock.calls.DeleteGatewayGroup = append(mock.calls.DeleteGatewayGroup, callInfo)
+	mock.lockDeleteGatewayGroup.Unlock()
+	return mock.DeleteGatewayGroupFunc(ctx, groupUUID)
+}
+
+// DeleteGatewayGroupCalls gets all the calls that were made to DeleteGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DeleteGatewayGroupCalls())
+func (mock *GatewayClientMock) DeleteGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupUUID string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupUUID string
+	}
+	mock.lockDeleteGatewayGroup.RLock()
+	calls = mock.calls.DeleteGatewayGroup
+	mock.lockDeleteGatewayGroup.RUnlock()
+	return calls
+}
+
+// DeletePublicSubnetFilteringGatewayContext calls DeletePublicSubnetFilteringGatewayContextFunc.
+func (mock *GatewayClientMock) DeletePublicSubnetFilteringGatewayContext(ctx context.Context, gateway *Gateway) error {
+	if mock.DeletePublicSubnetFilteringGatewayContextFunc == nil {
+		panic("GatewayClientMock.DeletePublicSubnetFilteringGatewayContextFunc: method is nil but GatewayClient.DeletePublicSubnetFilteringGatewayContext was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}{
+		Ctx:     ctx,
+		Gateway: gateway,
+	}
+	mock.lockDeletePublicSubnetFilteringGatewayContext.Lock()
+	mock.calls.DeletePublicSubnetFilteringGatewayContext = append(mock.calls.DeletePublicSubnetFilteringGatewayContext, callInfo)
+	mock.lockDeletePublicSubnetFilteringGatewayContext.Unlock()
+	return mock.DeletePublicSubnetFilteringGatewayContextFunc(ctx, gateway)
+}
+
+// DeletePublicSubnetFilteringGatewayContextCalls gets all the calls that were made to DeletePublicSubnetFilteringGatewayContext.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DeletePublicSubnetFilteringGatewayContextCalls())
+func (mock *GatewayClientMock) DeletePublicSubnetFilteringGatewayContextCalls() []struct {
+	Ctx     context.Context
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}
+	mock.lockDeletePublicSubnetFilteringGatewayContext.RLock()
+	calls = mock.calls.DeletePublicSubnetFilteringGatewayContext
+	mock.lockDeletePublicSubnetFilteringGatewayContext.RUnlock()
+	return calls
+}
+
+// DeleteSpokeGatewaySubnetGroup calls DeleteSpokeGatewaySubnetGroupFunc.
+func (mock *GatewayClientMock) DeleteSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error {
+	if mock.DeleteSpokeGatewaySubnetGroupFunc == nil {
+		panic("GatewayClientMock.DeleteSpokeGatewaySubnetGroupFunc: method is nil but GatewayClient.DeleteSpokeGatewaySubnetGroup was just called")
+	}
+	callInfo := struct {
+		Ctx                     context.Context
+		SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+	}{
+		Ctx:                     ctx,
+		SpokeGatewaySubnetGroup: spokeGatewaySubnetGroup,
+	}
+	mock.lockDeleteSpokeGatewaySubnetGroup.Lock()
+	mock.calls.DeleteSpokeGatewaySubnetGroup = append(mock.calls.DeleteSpokeGatewaySubnetGroup, callInfo)
+	mock.lockDeleteSpokeGatewaySubnetGroup.Unlock()
+	return mock.DeleteSpokeGatewaySubnetGroupFunc(ctx, spokeGatewaySubnetGroup)
+}
+
+// DeleteSpokeGatewaySubnetGroupCalls gets all the calls that were made to DeleteSpokeGatewaySubnetGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DeleteSpokeGatewaySubnetGroupCalls())
+func (mock *GatewayClientMock) DeleteSpokeGatewaySubnetGroupCalls() []struct {
+	Ctx                     context.Context
+	SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+} {
+	var calls []struct {
+		Ctx                     context.Context
+		SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+	}
+	mock.lockDeleteSpokeGatewaySubnetGroup.RLock()
+	calls = mock.calls.DeleteSpokeGatewaySubnetGroup
+	mock.lockDeleteSpokeGatewaySubnetGroup.RUnlock()
+	return calls
+}
+
+// DetachTransitGWForHybrid calls DetachTransitGWForHybridFunc.
+func (mock *GatewayClientMock) DetachTransitGWForHybrid(gateway *TransitVpc) error {
+	if mock.DetachTransitGWForHybridFunc == nil {
+		panic("GatewayClientMock.DetachTransitGWForHybridFunc: method is nil but GatewayClient.DetachTransitGWForHybrid was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDetachTransitGWForHybrid.Lock()
+	mock.calls.DetachTransitGWForHybrid = append(mock.calls.DetachTransitGWForHybrid, callInfo)
+	mock.lockDetachTransitGWForHybrid.Unlock()
+	return mock.DetachTransitGWForHybridFunc(gateway)
+}
+
+// DetachTransitGWForHybridCalls gets all the calls that were made to DetachTransitGWForHybrid.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DetachTransitGWForHybridCalls())
+func (mock *GatewayClientMock) DetachTransitGWForHybridCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockDetachTransitGWForHybrid.RLock()
+	calls = mock.calls.DetachTransitGWForHybrid
+	mock.lockDetachTransitGWForHybrid.RUnlock()
+	return calls
+}
+
+// DisableActiveStandby calls DisableActiveStandbyFunc.
+func (mock *GatewayClientMock) DisableActiveStandby(transitGateway *TransitVpc) error {
+	if mock.DisableActiveStandbyFunc == nil {
+		panic("GatewayClientMock.DisableActiveStandbyFunc: method is nil but GatewayClient.DisableActiveStandby was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockDisableActiveStandby.Lock()
+	mock.calls.DisableActiveStandby = append(mock.calls.DisableActiveStandby, callInfo)
+	mock.lockDisableActiveStandby.Unlock()
+	return mock.DisableActiveStandbyFunc(transitGateway)
+}
+
+// DisableActiveStandbyCalls gets all the calls that were made to DisableActiveStandby.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableActiveStandbyCalls())
+func (mock *GatewayClientMock) DisableActiveStandbyCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockDisableActiveStandby.RLock()
+	calls = mock.calls.DisableActiveStandby
+	mock.lockDisableActiveStandby.RUnlock()
+	return calls
+}
+
+// DisableActiveStandbyGatewayGroup calls DisableActiveStandbyGatewayGroupFunc.
+func (mock *GatewayClientMock) DisableActiveStandbyGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableActiveStandbyGatewayGroupFunc == nil {
+		panic("GatewayClientMock.DisableActiveStandbyGatewayGroupFunc: method is nil but GatewayClient.DisableActiveStandbyGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableActiveStandbyGatewayGroup.Lock()
+	mock.calls.DisableActiveStandbyGatewayGroup = append(mock.calls.DisableActiveStandbyGatewayGroup, callInfo)
+	mock.lockDisableActiveStandbyGatewayGroup.Unlock()
+	return mock.DisableActiveStandbyGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableActiveStandbyGatewayGroupCalls gets all the calls that were made to DisableActiveStandbyGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableActiveStandbyGatewayGroupCalls())
+func (mock *GatewayClientMock) DisableActiveStandbyGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableActiveStandbyGatewayGroup.RLock()
+	calls = mock.calls.DisableActiveStandbyGatewayGroup
+	mock.lockDisableActiveStandbyGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableActiveStandbySpoke calls DisableActiveStandbySpokeFunc.
+func (mock *GatewayClientMock) DisableActiveStandbySpoke(spokeGateway *SpokeVpc) error {
+	if mock.DisableActiveStandbySpokeFunc == nil {
+		panic("GatewayClientMock.DisableActiveStandbySpokeFunc: method is nil but GatewayClient.DisableActiveStandbySpoke was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+	}{
+		SpokeGateway: spokeGateway,
+	}
+	mock.lockDisableActiveStandbySpoke.Lock()
+	mock.calls.DisableActiveStandbySpoke = append(mock.calls.DisableActiveStandbySpoke, callInfo)
+	mock.lockDisableActiveStandbySpoke.Unlock()
+	return mock.DisableActiveStandbySpokeFunc(spokeGateway)
+}
+
+// DisableActiveStandbySpokeCalls gets all the calls that were made to DisableActiveStandbySpoke.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableActiveStandbySpokeCalls())
+func (mock *GatewayClientMock) DisableActiveStandbySpokeCalls() []struct {
+	SpokeGateway *SpokeVpc
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+	}
+	mock.lockDisableActiveStandbySpoke.RLock()
+	calls = mock.calls.DisableActiveStandbySpoke
+	mock.lockDisableActiveStandbySpoke.RUnlock()
+	return calls
+}
+
+// DisableAdvertiseTransitCidr calls DisableAdvertiseTransitCidrFunc.
+func (mock *GatewayClientMock) DisableAdvertiseTransitCidr(transitGw *TransitVpc) error {
+	if mock.DisableAdvertiseTransitCidrFunc == nil {
+		panic("GatewayClientMock.DisableAdvertiseTransitCidrFunc: method is nil but GatewayClient.DisableAdvertiseTransitCidr was just called")
+	}
+	callInfo := struct {
+		TransitGw *TransitVpc
+	}{
+		TransitGw: transitGw,
+	}
+	mock.lockDisableAdvertiseTransitCidr.Lock()
+	mock.calls.DisableAdvertiseTransitCidr = append(mock.calls.DisableAdvertiseTransitCidr, callInfo)
+	mock.lockDisableAdvertiseTransitCidr.Unlock()
+	return mock.DisableAdvertiseTransitCidrFunc(transitGw)
+}
+
+// DisableAdvertiseTransitCidrCalls gets all the calls that were made to DisableAdvertiseTransitCidr.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableAdvertiseTransitCidrCalls())
+func (mock *GatewayClientMock) DisableAdvertiseTransitCidrCalls() []struct {
+	TransitGw *TransitVpc
+} {
+	var calls []struct {
+		TransitGw *TransitVpc
+	}
+	mock.lockDisableAdvertiseTransitCidr.RLock()
+	calls = mock.calls.DisableAdvertiseTransitCidr
+	mock.lockDisableAdvertiseTransitCidr.RUnlock()
+	return calls
+}
+
+// DisableAdvertiseTransitCidrGatewayGroup calls DisableAdvertiseTransitCidrGatewayGroupFunc.
+func (mock *GatewayClientMock) DisableAdvertiseTransitCidrGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableAdvertiseTransitCidrGatewayGroupFunc == nil {
+		panic("GatewayClientMock.DisableAdvertiseTransitCidrGatewayGroupFunc: method is nil but GatewayClient.DisableAdvertiseTransitCidrGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableAdvertiseTransitCidrGatewayGroup.Lock()
+	mock.calls.DisableAdvertiseTransitCidrGatewayGroup = append(mock.calls.DisableAdvertiseTransitCidrGatewayGroup, callInfo)
+	mock.lockDisableAdvertiseTransitCidrGatewayGroup.Unlock()
+	return mock.DisableAdvertiseTransitCidrGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableAdvertiseTransitCidrGatewayGroupCalls gets all the calls that were made to DisableAdvertiseTransitCidrGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableAdvertiseTransitCidrGatewayGroupCalls())
+func (mock *GatewayClientMock) DisableAdvertiseTransitCidrGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableAdvertiseTransitCidrGatewayGroup.RLock()
+	calls = mock.calls.DisableAdvertiseTransitCidrGatewayGroup
+	mock.lockDisableAdvertiseTransitCidrGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableAutoAdvertiseS2CCidrs calls DisableAutoAdvertiseS2CCidrsFunc.
+func (mock *GatewayClientMock) DisableAutoAdvertiseS2CCidrs(gateway *Gateway) error {
+	if mock.DisableAutoAdvertiseS2CCidrsFunc == nil {
+		panic("GatewayClientMock.DisableAutoAdvertiseS2CCidrsFunc: method is nil but GatewayClient.DisableAutoAdvertiseS2CCidrs was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableAutoAdvertiseS2CCidrs.Lock()
+	mock.calls.DisableAutoAdvertiseS2CCidrs = append(mock.calls.DisableAutoAdvertiseS2CCidrs, callInfo)
+	mock.lockDisableAutoAdvertiseS2CCidrs.Unlock()
+	return mock.DisableAutoAdvertiseS2CCidrsFunc(gateway)
+}
+
+// DisableAutoAdvertiseS2CCidrsCalls gets all the calls that were made to DisableAutoAdvertiseS2CCidrs.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableAutoAdvertiseS2CCidrsCalls())
+func (mock *GatewayClientMock) DisableAutoAdvertiseS2CCidrsCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableAutoAdvertiseS2CCidrs.RLock()
+	calls = mock.calls.DisableAutoAdvertiseS2CCidrs
+	mock.lockDisableAutoAdvertiseS2CCidrs.RUnlock()
+	return calls
+}
+
+// DisableAutoAdvertiseS2CCidrsGatewayGroup calls DisableAutoAdvertiseS2CCidrsGatewayGroupFunc.
+func (mock *GatewayClientMock) DisableAutoAdvertiseS2CCidrsGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableAutoAdvertiseS2CCidrsGatewayGroupFunc == nil {
+		panic("GatewayClientMock.DisableAutoAdvertiseS2CCidrsGatewayGroupFunc: method is nil but GatewayClient.DisableAutoAdvertiseS2CCidrsGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableAutoAdvertiseS2CCidrsGatewayGroup.Lock()
+	mock.calls.DisableAutoAdvertiseS2CCidrsGatewayGroup = append(mock.calls.DisableAutoAdvertiseS2CCidrsGatewayGroup, callInfo)
+	mock.lockDisableAutoAdvertiseS2CCidrsGatewayGroup.Unlock()
+	return mock.DisableAutoAdvertiseS2CCidrsGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableAutoAdvertiseS2CCidrsGatewayGroupCalls gets all the calls that were made to DisableAutoAdvertiseS2CCidrsGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableAutoAdvertiseS2CCidrsGatewayGroupCalls())
+func (mock *GatewayClientMock) DisableAutoAdvertiseS2CCidrsGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableAutoAdvertiseS2CCidrsGatewayGroup.RLock()
+	calls = mock.calls.DisableAutoAdvertiseS2CCidrsGatewayGroup
+	mock.lockDisableAutoAdvertiseS2CCidrsGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableConnectedTransit calls DisableConnectedTransitFunc.
+func (mock *GatewayClientMock) DisableConnectedTransit(gateway *TransitVpc) error {
+	if mock.DisableConnectedTransitFunc == nil {
+		panic("GatewayClientMock.DisableConnectedTransitFunc: method is nil but GatewayClient.DisableConnectedTransit was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableConnectedTransit.Lock()
+	mock.calls.DisableConnectedTransit = append(mock.calls.DisableConnectedTransit, callInfo)
+	mock.lockDisableConnectedTransit.Unlock()
+	return mock.DisableConnectedTransitFunc(gateway)
+}
+
+// DisableConnectedTransitCalls gets all the calls that were made to DisableConnectedTransit.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableConnectedTransitCalls())
+func (mock *GatewayClientMock) DisableConnectedTransitCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockDisableConnectedTransit.RLock()
+	calls = mock.calls.DisableConnectedTransit
+	mock.lockDisableConnectedTransit.RUnlock()
+	return calls
+}
+
+// DisableConnectedTransitGatewayGroup calls DisableConnectedTransitGatewayGroupFunc.
+func (mock *GatewayClientMock) DisableConnectedTransitGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableConnectedTransitGatewayGroupFunc == nil {
+		panic("GatewayClientMock.DisableConnectedTransitGatewayGroupFunc: method is nil but GatewayClient.DisableConnectedTransitGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableConnectedTransitGatewayGroup.Lock()
+	mock.calls.DisableConnectedTransitGatewayGroup = append(mock.calls.DisableConnectedTransitGatewayGroup, callInfo)
+	mock.lockDisableConnectedTransitGatewayGroup.Unlock()
+	return mock.DisableConnectedTransitGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableConnectedTransitGatewayGroupCalls gets all the calls that were made to DisableConnectedTransitGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableConnectedTransitGatewayGroupCalls())
+func (mock *GatewayClientMock) DisableConnectedTransitGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableConnectedTransitGatewayGroup.RLock()
+	calls = mock.calls.DisableConnectedTransitGatewayGroup
+	mock.lockDisableConnectedTransitGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableCustomSNat calls DisableCustomSNatFunc.
+func (mock *GatewayClientMock) DisableCustomSNat(gateway *Gateway) error {
+	if mock.DisableCustomSNatFunc == nil {
+		panic("GatewayClientMock.DisableCustomSNatFunc: method is nil but GatewayClient.DisableCustomSNat was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableCustomSNat.Lock()
+	mock.calls.DisableCustomSNat = append(mock.calls.DisableCustomSNat, callInfo)
+	mock.lockDisableCustomSNat.Unlock()
+	return mock.DisableCustomSNatFunc(gateway)
+}
+
+// DisableCustomSNatCalls gets all the calls that were made to DisableCustomSNat.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableCustomSNatCalls())
+func (mock *GatewayClientMock) DisableCustomSNatCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableCustomSNat.RLock()
+	calls = mock.calls.DisableCustomSNat
+	mock.lockDisableCustomSNat.RUnlock()
+	return calls
+}
+
+// DisableEgressTransitFirenet calls DisableEgressTransitFirenetFunc.
+func (mock *GatewayClientMock) DisableEgressTransitFirenet(transitGateway *TransitVpc) error {
+	if mock.DisableEgressTransitFirenetFunc == nil {
+		panic("GatewayClientMock.DisableEgressTransitFirenetFunc: method is nil but GatewayClient.DisableEgressTransitFirenet was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockDisableEgressTransitFirenet.Lock()
+	mock.calls.DisableEgressTransitFirenet = append(mock.calls.DisableEgressTransitFirenet, callInfo)
+	mock.lockDisableEgressTransitFirenet.Unlock()
+	return mock.DisableEgressTransitFirenetFunc(transitGateway)
+}
+
+// DisableEgressTransitFirenetCalls gets all the calls that were made to DisableEgressTransitFirenet.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableEgressTransitFirenetCalls())
+func (mock *GatewayClientMock) DisableEgressTransitFirenetCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockDisableEgressTransitFirenet.RLock()
+	calls = mock.calls.DisableEgressTransitFirenet
+	mock.lockDisableEgressTransitFirenet.RUnlock()
+	return calls
+}
+
+// DisableFireNetGatewayGroup calls DisableFireNetGatewayGroupFunc.
+func (mock *GatewayClientMock) DisableFireNetGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableFireNetGatewayGroupFunc == nil {
+		panic("GatewayClientMock.DisableFireNetGatewayGroupFunc: method is nil but GatewayClient.DisableFireNetGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableFireNetGatewayGroup.Lock()
+	mock.calls.DisableFireNetGatewayGroup = append(mock.calls.DisableFireNetGatewayGroup, callInfo)
+	mock.lockDisableFireNetGatewayGroup.Unlock()
+	return mock.DisableFireNetGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableFireNetGatewayGroupCalls gets all the calls that were made to DisableFireNetGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableFireNetGatewayGroupCalls())
+func (mock *GatewayClientMock) DisableFireNetGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableFireNetGatewayGroup.RLock()
+	calls = mock.calls.DisableFireNetGatewayGroup
+	mock.lockDisableFireNetGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableGatewayFireNetInterfaces calls DisableGatewayFireNetInterfacesFunc.
+func (mock *GatewayClientMock) DisableGatewayFireNetInterfaces(gateway *TransitVpc) error {
+	if mock.DisableGatewayFireNetInterfacesFunc == nil {
+		panic("GatewayClientMock.DisableGatewayFireNetInterfacesFunc: method is nil but GatewayClient.DisableGatewayFireNetInterfaces was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableGatewayFireNetInterfaces.Lock()
+	mock.calls.DisableGatewayFireNetInterfaces = append(mock.calls.DisableGatewayFireNetInterfaces, callInfo)
+	mock.lockDisableGatewayFireNetInterfaces.Unlock()
+	return mock.DisableGatewayFireNetInterfacesFunc(gateway)
+}
+
+// DisableGatewayFireNetInterfacesCalls gets all the calls that were made to DisableGatewayFireNetInterfaces.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableGatewayFireNetInterfacesCalls())
+func (mock *GatewayClientMock) DisableGatewayFireNetInterfacesCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockDisableGatewayFireNetInterfaces.RLock()
+	calls = mock.calls.DisableGatewayFireNetInterfaces
+	mock.lockDisableGatewayFireNetInterfaces.RUnlock()
+	return calls
+}
+
+// DisableGatewayGroupSNat calls DisableGatewayGroupSNatFunc.
+func (mock *GatewayClientMock) DisableGatewayGroupSNat(ctx context.Context, groupName string) error {
+	if mock.DisableGatewayGroupSNatFunc == nil {
+		panic("GatewayClientMock.DisableGatewayGroupSNatFunc: method is nil but GatewayClient.DisableGatewayGroupSNat was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableGatewayGroupSNat.Lock()
+	mock.calls.DisableGatewayGroupSNat = append(mock.calls.DisableGatewayGroupSNat, callInfo)
+	mock.lockDisableGatewayGroupSNat.Unlock()
+	return mock.DisableGatewayGroupSNatFunc(ctx, groupName)
+}
+
+// DisableGatewayGroupSNatCalls gets all the calls that were made to DisableGatewayGroupSNat.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableGatewayGroupSNatCalls())
+func (mock *GatewayClientMock) DisableGatewayGroupSNatCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableGatewayGroupSNat.RLock()
+	calls = mock.calls.DisableGatewayGroupSNat
+	mock.lockDisableGatewayGroupSNat.RUnlock()
+	return calls
+}
+
+// DisableGatewayGroupVpcDNSServer calls DisableGatewayGroupVpcDNSServerFunc.
+func (mock *GatewayClientMock) DisableGatewayGroupVpcDNSServer(ctx context.Context, groupName string) error {
+	if mock.DisableGatewayGroupVpcDNSServerFunc == nil {
+		panic("GatewayClientMock.DisableGatewayGroupVpcDNSServerFunc: method is nil but GatewayClient.DisableGatewayGroupVpcDNSServer was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableGatewayGroupVpcDNSServer.Lock()
+	mock.calls.DisableGatewayGroupVpcDNSServer = append(mock.calls.DisableGatewayGroupVpcDNSServer, callInfo)
+	mock.lockDisableGatewayGroupVpcDNSServer.Unlock()
+	return mock.DisableGatewayGroupVpcDNSServerFunc(ctx, groupName)
+}
+
+// DisableGatewayGroupVpcDNSServerCalls gets all the calls that were made to DisableGatewayGroupVpcDNSServer.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableGatewayGroupVpcDNSServerCalls())
+func (mock *GatewayClientMock) DisableGatewayGroupVpcDNSServerCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableGatewayGroupVpcDNSServer.RLock()
+	calls = mock.calls.DisableGatewayGroupVpcDNSServer
+	mock.lockDisableGatewayGroupVpcDNSServer.RUnlock()
+	return calls
+}
+
+// DisableGatewayLoadBalancerGatewayGroup calls DisableGatewayLoadBalancerGatewayGroupFunc.
+func (mock *GatewayClientMock) DisableGatewayLoadBalancerGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableGatewayLoadBalancerGatewayGroupFunc == nil {
+		panic("GatewayClientMock.DisableGatewayLoadBalancerGatewayGroupFunc: method is nil but GatewayClient.DisableGatewayLoadBalancerGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableGatewayLoadBalancerGatewayGroup.Lock()
+	mock.calls.DisableGatewayLoadBalancerGatewayGroup = append(mock.calls.DisableGatewayLoadBalancerGatewayGroup, callInfo)
+	mock.lockDisableGatewayLoadBalancerGatewayGroup.Unlock()
+	return mock.DisableGatewayLoadBalancerGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableGatewayLoadBalancerGatewayGroupCalls gets all the calls that were made to DisableGatewayLoadBalancerGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableGatewayLoadBalancerGatewayGroupCalls())
+func (mock *GatewayClientMock) DisableGatewayLoadBalancerGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableGatewayLoadBalancerGatewayGroup.RLock()
+	calls = mock.calls.DisableGatewayLoadBalancerGatewayGroup
+	mock.lockDisableGatewayLoadBalancerGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableGlobalVpc calls DisableGlobalVpcFunc.
+func (mock *GatewayClientMock) DisableGlobalVpc(gateway *Gateway) error {
+	if mock.DisableGlobalVpcFunc == nil {
+		panic("GatewayClientMock.DisableGlobalVpcFunc: method is nil but GatewayClient.DisableGlobalVpc was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableGlobalVpc.Lock()
+	mock.calls.DisableGlobalVpc = append(mock.calls.DisableGlobalVpc, callInfo)
+	mock.lockDisableGlobalVpc.Unlock()
+	return mock.DisableGlobalVpcFunc(gateway)
+}
+
+// DisableGlobalVpcCalls gets all the calls that were made to DisableGlobalVpc.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableGlobalVpcCalls())
+func (mock *GatewayClientMock) DisableGlobalVpcCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableGlobalVpc.RLock()
+	calls = mock.calls.DisableGlobalVpc
+	mock.lockDisableGlobalVpc.RUnlock()
+	return calls
+}
+
+// DisableGlobalVpcGatewayGroup calls DisableGlobalVpcGatewayGroupFunc.
+func (mock *GatewayClientMock) DisableGlobalVpcGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableGlobalVpcGatewayGroupFunc == nil {
+		panic("GatewayClientMock.DisableGlobalVpcGatewayGroupFunc: method is nil but GatewayClient.DisableGlobalVpcGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableGlobalVpcGatewayGroup.Lock()
+	mock.calls.DisableGlobalVpcGatewayGroup = append(mock.calls.DisableGlobalVpcGatewayGroup, callInfo)
+	mock.lockDisableGlobalVpcGatewayGroup.Unlock()
+	return mock.DisableGlobalVpcGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableGlobalVpcGatewayGroupCalls gets all the calls that were made to DisableGlobalVpcGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableGlobalVpcGatewayGroupCalls())
+func (mock *GatewayClientMock) DisableGlobalVpcGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableGlobalVpcGatewayGroup.RLock()
+	calls = mock.calls.DisableGlobalVpcGatewayGroup
+	mock.lockDisableGlobalVpcGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableGroGso calls DisableGroGsoFunc.
+func (mock *GatewayClientMock) DisableGroGso(gateway *Gateway) error {
+	if mock.DisableGroGsoFunc == nil {
+		panic("GatewayClientMock.DisableGroGsoFunc: method is nil but GatewayClient.DisableGroGso was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableGroGso.Lock()
+	mock.calls.DisableGroGso = append(mock.calls.DisableGroGso, callInfo)
+	mock.lockDisableGroGso.Unlock()
+	return mock.DisableGroGsoFunc(gateway)
+}
+
+// DisableGroGsoCalls gets all the calls that were made to DisableGroGso.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableGroGsoCalls())
+func (mock *GatewayClientMock) DisableGroGsoCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableGroGso.RLock()
+	calls = mock.calls.DisableGroGso
+	mock.lockDisableGroGso.RUnlock()
+	return calls
+}
+
+// DisableGroGsoGatewayGroup calls DisableGroGsoGatewayGroupFunc.
+func (mock *GatewayClientMock) DisableGroGsoGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableGroGsoGatewayGroupFunc == nil {
+		panic("GatewayClientMock.DisableGroGsoGatewayGroupFunc: method is nil but GatewayClient.DisableGroGsoGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableGroGsoGatewayGroup.Lock()
+	mock.calls.DisableGroGsoGatewayGroup = append(mock.calls.DisableGroGsoGatewayGroup, callInfo)
+	mock.lockDisableGroGsoGatewayGroup.Unlock()
+	return mock.DisableGroGsoGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableGroGsoGatewayGroupCalls gets all the calls that were made to DisableGroGsoGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableGroGsoGatewayGroupCalls())
+func (mock *GatewayClientMock) DisableGroGsoGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableGroGsoGatewayGroup.RLock()
+	calls = mock.calls.DisableGroGsoGatewayGroup
+	mock.lockDisableGroGsoGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableGuardDutyEnforcement calls DisableGuardDutyEnforcementFunc.
+func (mock *GatewayClientMock) DisableGuardDutyEnforcement(gateway *Gateway) error {
+	if mock.DisableGuardDutyEnforcementFunc == nil {
+		panic("GatewayClientMock.DisableGuardDutyEnforcementFunc: method is nil but GatewayClient.DisableGuardDutyEnforcement was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableGuardDutyEnforcement.Lock()
+	mock.calls.DisableGuardDutyEnforcement = append(mock.calls.DisableGuardDutyEnforcement, callInfo)
+	mock.lockDisableGuardDutyEnforcement.Unlock()
+	return mock.DisableGuardDutyEnforcementFunc(gateway)
+}
+
+// DisableGuardDutyEnforcementCalls gets all the calls that were made to DisableGuardDutyEnforcement.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableGuardDutyEnforcementCalls())
+func (mock *GatewayClientMock) DisableGuardDutyEnforcementCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableGuardDutyEnforcement.RLock()
+	calls = mock.calls.DisableGuardDutyEnforcement
+	mock.lockDisableGuardDutyEnforcement.RUnlock()
+	return calls
+}
+
+// DisableHybridConnectionGatewayGroup calls DisableHybridConnectionGatewayGroupFunc.
+func (mock *GatewayClientMock) DisableHybridConnectionGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableHybridConnectionGatewayGroupFunc == nil {
+		panic("GatewayClientMock.DisableHybridConnectionGatewayGroupFunc: method is nil but GatewayClient.DisableHybridConnectionGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableHybridConnectionGatewayGroup.Lock()
+	mock.calls.DisableHybridConnectionGatewayGroup = append(mock.calls.DisableHybridConnectionGatewayGroup, callInfo)
+	mock.lockDisableHybridConnectionGatewayGroup.Unlock()
+	return mock.DisableHybridConnectionGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableHybridConnectionGatewayGroupCalls gets all the calls that were made to DisableHybridConnectionGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableHybridConnectionGatewayGroupCalls())
+func (mock *GatewayClientMock) DisableHybridConnectionGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableHybridConnectionGatewayGroup.RLock()
+	calls = mock.calls.DisableHybridConnectionGatewayGroup
+	mock.lockDisableHybridConnectionGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableIPv6 calls DisableIPv6Func.
+func (mock *GatewayClientMock) DisableIPv6(gateway *Gateway) error {
+	if mock.DisableIPv6Func == nil {
+		panic("GatewayClientMock.DisableIPv6Func: method is nil but GatewayClient.DisableIPv6 was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableIPv6.Lock()
+	mock.calls.DisableIPv6 = append(mock.calls.DisableIPv6, callInfo)
+	mock.lockDisableIPv6.Unlock()
+	return mock.DisableIPv6Func(gateway)
+}
+
+// DisableIPv6Calls gets all the calls that were made to DisableIPv6.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableIPv6Calls())
+func (mock *GatewayClientMock) DisableIPv6Calls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableIPv6.RLock()
+	calls = mock.calls.DisableIPv6
+	mock.lockDisableIPv6.RUnlock()
+	return calls
+}
+
+// DisableIPv6GatewayGroup calls DisableIPv6GatewayGroupFunc.
+func (mock *GatewayClientMock) DisableIPv6GatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableIPv6GatewayGroupFunc == nil {
+		panic("GatewayClientMock.DisableIPv6GatewayGroupFunc: method is nil but GatewayClient.DisableIPv6GatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableIPv6GatewayGroup.Lock()
+	mock.calls.DisableIPv6GatewayGroup = append(mock.calls.DisableIPv6GatewayGroup, callInfo)
+	mock.lockDisableIPv6GatewayGroup.Unlock()
+	return mock.DisableIPv6GatewayGroupFunc(ctx, groupName)
+}
+
+// DisableIPv6GatewayGroupCalls gets all the calls that were made to DisableIPv6GatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableIPv6GatewayGroupCalls())
+func (mock *GatewayClientMock) DisableIPv6GatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableIPv6GatewayGroup.RLock()
+	calls = mock.calls.DisableIPv6GatewayGroup
+	mock.lockDisableIPv6GatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableJumboFrame calls DisableJumboFrameFunc.
+func (mock *GatewayClientMock) DisableJumboFrame(gateway *Gateway) error {
+	if mock.DisableJumboFrameFunc == nil {
+		panic("GatewayClientMock.DisableJumboFrameFunc: method is nil but GatewayClient.DisableJumboFrame was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableJumboFrame.Lock()
+	mock.calls.DisableJumboFrame = append(mock.calls.DisableJumboFrame, callInfo)
+	mock.lockDisableJumboFrame.Unlock()
+	return mock.DisableJumboFrameFunc(gateway)
+}
+
+// DisableJumboFrameCalls gets all the calls that were made to DisableJumboFrame.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableJumboFrameCalls())
+func (mock *GatewayClientMock) DisableJumboFrameCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableJumboFrame.RLock()
+	calls = mock.calls.DisableJumboFrame
+	mock.lockDisableJumboFrame.RUnlock()
+	return calls
+}
+
+// DisableJumboFrameGatewayGroup calls DisableJumboFrameGatewayGroupFunc.
+func (mock *GatewayClientMock) DisableJumboFrameGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableJumboFrameGatewayGroupFunc == nil {
+		panic("GatewayClientMock.DisableJumboFrameGatewayGroupFunc: method is nil but GatewayClient.DisableJumboFrameGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableJumboFrameGatewayGroup.Lock()
+	mock.calls.DisableJumboFrameGatewayGroup = append(mock.calls.DisableJumboFrameGatewayGroup, callInfo)
+	mock.lockDisableJumboFrameGatewayGroup.Unlock()
+	return mock.DisableJumboFrameGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableJumboFrameGatewayGroupCalls gets all the calls that were made to DisableJumboFrameGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableJumboFrameGatewayGroupCalls())
+func (mock *GatewayClientMock) DisableJumboFrameGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableJumboFrameGatewayGroup.RLock()
+	calls = mock.calls.DisableJumboFrameGatewayGroup
+	mock.lockDisableJumboFrameGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableMonitorGatewaySubnets calls DisableMonitorGatewaySubnetsFunc.
+func (mock *GatewayClientMock) DisableMonitorGatewaySubnets(gwName string) error {
+	if mock.DisableMonitorGatewaySubnetsFunc == nil {
+		panic("GatewayClientMock.DisableMonitorGatewaySubnetsFunc: method is nil but GatewayClient.DisableMonitorGatewaySubnets was just called")
+	}
+	callInfo := struct {
+		GwName string
+	}{
+		GwName: gwName,
+	}
+	mock.lockDisableMonitorGatewaySubnets.Lock()
+	mock.calls.DisableMonitorGatewaySubnets = append(mock.calls.DisableMonitorGatewaySubnets, callInfo)
+	mock.lockDisableMonitorGatewaySubnets.Unlock()
+	return mock.DisableMonitorGatewaySubnetsFunc(gwName)
+}
+
+// DisableMonitorGatewaySubnetsCalls gets all the calls that were made to DisableMonitorGatewaySubnets.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableMonitorGatewaySubnetsCalls())
+func (mock *GatewayClientMock) DisableMonitorGatewaySubnetsCalls() []struct {
+	GwName string
+} {
+	var calls []struct {
+		GwName string
+	}
+	mock.lockDisableMonitorGatewaySubnets.RLock()
+	calls = mock.calls.DisableMonitorGatewaySubnets
+	mock.lockDisableMonitorGatewaySubnets.RUnlock()
+	return calls
+}
+
+// DisableMultiTierTransitGatewayGroup calls DisableMultiTierTransitGatewayGroupFunc.
+func (mock *GatewayClientMock) DisableMultiTierTransitGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableMultiTierTransitGatewayGroupFunc == nil {
+		panic("GatewayClientMock.DisableMultiTierTransitGatewayGroupFunc: method is nil but GatewayClient.DisableMultiTierTransitGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableMultiTierTransitGatewayGroup.Lock()
+	mock.calls.DisableMultiTierTransitGatewayGroup = append(mock.calls.DisableMultiTierTransitGatewayGroup, callInfo)
+	mock.lockDisableMultiTierTransitGatewayGroup.Unlock()
+	return mock.DisableMultiTierTransitGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableMultiTierTransitGatewayGroupCalls gets all the calls that were made to DisableMultiTierTransitGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableMultiTierTransitGatewayGroupCalls())
+func (mock *GatewayClientMock) DisableMultiTierTransitGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableMultiTierTransitGatewayGroup.RLock()
+	calls = mock.calls.DisableMultiTierTransitGatewayGroup
+	mock.lockDisableMultiTierTransitGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableMultitierTransit calls DisableMultitierTransitFunc.
+func (mock *GatewayClientMock) DisableMultitierTransit(gwName string) error {
+	if mock.DisableMultitierTransitFunc == nil {
+		panic("GatewayClientMock.DisableMultitierTransitFunc: method is nil but GatewayClient.DisableMultitierTransit was just called")
+	}
+	callInfo := struct {
+		GwName string
+	}{
+		GwName: gwName,
+	}
+	mock.lockDisableMultitierTransit.Lock()
+	mock.calls.DisableMultitierTransit = append(mock.calls.DisableMultitierTransit, callInfo)
+	mock.lockDisableMultitierTransit.Unlock()
+	return mock.DisableMultitierTransitFunc(gwName)
+}
+
+// DisableMultitierTransitCalls gets all the calls that were made to DisableMultitierTransit.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableMultitierTransitCalls())
+func (mock *GatewayClientMock) DisableMultitierTransitCalls() []struct {
+	GwName string
+} {
+	var calls []struct {
+		GwName string
+	}
+	mock.lockDisableMultitierTransit.RLock()
+	calls = mock.calls.DisableMultitierTransit
+	mock.lockDisableMultitierTransit.RUnlock()
+	return calls
+}
+
+// DisablePrivateVpcDefaultRoute calls DisablePrivateVpcDefaultRouteFunc.
+func (mock *GatewayClientMock) DisablePrivateVpcDefaultRoute(gw *Gateway) error {
+	if mock.DisablePrivateVpcDefaultRouteFunc == nil {
+		panic("GatewayClientMock.DisablePrivateVpcDefaultRouteFunc: method is nil but GatewayClient.DisablePrivateVpcDefaultRoute was just called")
+	}
+	callInfo := struct {
+		Gw *Gateway
+	}{
+		Gw: gw,
+	}
+	mock.lockDisablePrivateVpcDefaultRoute.Lock()
+	mock.calls.DisablePrivateVpcDefaultRoute = append(mock.calls.DisablePrivateVpcDefaultRoute, callInfo)
+	mock.lockDisablePrivateVpcDefaultRoute.Unlock()
+	return mock.DisablePrivateVpcDefaultRouteFunc(gw)
+}
+
+// DisablePrivateVpcDefaultRouteCalls gets all the calls that were made to DisablePrivateVpcDefaultRoute.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisablePrivateVpcDefaultRouteCalls())
+func (mock *GatewayClientMock) DisablePrivateVpcDefaultRouteCalls() []struct {
+	Gw *Gateway
+} {
+	var calls []struct {
+		Gw *Gateway
+	}
+	mock.lockDisablePrivateVpcDefaultRoute.RLock()
+	calls = mock.calls.DisablePrivateVpcDefaultRoute
+	mock.lockDisablePrivateVpcDefaultRoute.RUnlock()
+	return calls
+}
+
+// DisablePrivateVpcDefaultRouteGatewayGroup calls DisablePrivateVpcDefaultRouteGatewayGroupFunc.
+func (mock *GatewayClientMock) DisablePrivateVpcDefaultRouteGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisablePrivateVpcDefaultRouteGatewayGroupFunc == nil {
+		panic("GatewayClientMock.DisablePrivateVpcDefaultRouteGatewayGroupFunc: method is nil but GatewayClient.DisablePrivateVpcDefaultRouteGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisablePrivateVpcDefaultRouteGatewayGroup.Lock()
+	mock.calls.DisablePrivateVpcDefaultRouteGatewayGroup = append(mock.calls.DisablePrivateVpcDefaultRouteGatewayGroup, callInfo)
+	mock.lockDisablePrivateVpcDefaultRouteGatewayGroup.Unlock()
+	return mock.DisablePrivateVpcDefaultRouteGatewayGroupFunc(ctx, groupName)
+}
+
+// DisablePrivateVpcDefaultRouteGatewayGroupCalls gets all the calls that were made to DisablePrivateVpcDefaultRouteGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisablePrivateVpcDefaultRouteGatewayGroupCalls())
+func (mock *GatewayClientMock) DisablePrivateVpcDefaultRouteGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisablePrivateVpcDefaultRouteGatewayGroup.RLock()
+	calls = mock.calls.DisablePrivateVpcDefaultRouteGatewayGroup
+	mock.lockDisablePrivateVpcDefaultRouteGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableS2CRxBalancing calls DisableS2CRxBalancingFunc.
+func (mock *GatewayClientMock) DisableS2CRxBalancing(gwName string) error {
+	if mock.DisableS2CRxBalancingFunc == nil {
+		panic("GatewayClientMock.DisableS2CRxBalancingFunc: method is nil but GatewayClient.DisableS2CRxBalancing was just called")
+	}
+	callInfo := struct {
+		GwName string
+	}{
+		GwName: gwName,
+	}
+	mock.lockDisableS2CRxBalancing.Lock()
+	mock.calls.DisableS2CRxBalancing = append(mock.calls.DisableS2CRxBalancing, callInfo)
+	mock.lockDisableS2CRxBalancing.Unlock()
+	return mock.DisableS2CRxBalancingFunc(gwName)
+}
+
+// DisableS2CRxBalancingCalls gets all the calls that were made to DisableS2CRxBalancing.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableS2CRxBalancingCalls())
+func (mock *GatewayClientMock) DisableS2CRxBalancingCalls() []struct {
+	GwName string
+} {
+	var calls []struct {
+		GwName string
+	}
+	mock.lockDisableS2CRxBalancing.RLock()
+	calls = mock.calls.DisableS2CRxBalancing
+	mock.lockDisableS2CRxBalancing.RUnlock()
+	return calls
+}
+
+// DisableS2cRxBalancingGatewayGroup calls DisableS2cRxBalancingGatewayGroupFunc.
+func (mock *GatewayClientMock) DisableS2cRxBalancingGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableS2cRxBalancingGatewayGroupFunc == nil {
+		panic("GatewayClientMock.DisableS2cRxBalancingGatewayGroupFunc: method is nil but GatewayClient.DisableS2cRxBalancingGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableS2cRxBalancingGatewayGroup.Lock()
+	mock.calls.DisableS2cRxBalancingGatewayGroup = append(mock.calls.DisableS2cRxBalancingGatewayGroup, callInfo)
+	mock.lockDisableS2cRxBalancingGatewayGroup.Unlock()
+	return mock.DisableS2cRxBalancingGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableS2cRxBalancingGatewayGroupCalls gets all the calls that were made to DisableS2cRxBalancingGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableS2cRxBalancingGatewayGroupCalls())
+func (mock *GatewayClientMock) DisableS2cRxBalancingGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableS2cRxBalancingGatewayGroup.RLock()
+	calls = mock.calls.DisableS2cRxBalancingGatewayGroup
+	mock.lockDisableS2cRxBalancingGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableSNat calls DisableSNatFunc.
+func (mock *GatewayClientMock) DisableSNat(gateway *Gateway) error {
+	if mock.DisableSNatFunc == nil {
+		panic("GatewayClientMock.DisableSNatFunc: method is nil but GatewayClient.DisableSNat was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableSNat.Lock()
+	mock.calls.DisableSNat = append(mock.calls.DisableSNat, callInfo)
+	mock.lockDisableSNat.Unlock()
+	return mock.DisableSNatFunc(gateway)
+}
+
+// DisableSNatCalls gets all the calls that were made to DisableSNat.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableSNatCalls())
+func (mock *GatewayClientMock) DisableSNatCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableSNat.RLock()
+	calls = mock.calls.DisableSNat
+	mock.lockDisableSNat.RUnlock()
+	return calls
+}
+
+// DisableSegmentation calls DisableSegmentationFunc.
+func (mock *GatewayClientMock) DisableSegmentation(transitGateway *TransitVpc) error {
+	if mock.DisableSegmentationFunc == nil {
+		panic("GatewayClientMock.DisableSegmentationFunc: method is nil but GatewayClient.DisableSegmentation was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockDisableSegmentation.Lock()
+	mock.calls.DisableSegmentation = append(mock.calls.DisableSegmentation, callInfo)
+	mock.lockDisableSegmentation.Unlock()
+	return mock.DisableSegmentationFunc(transitGateway)
+}
+
+// DisableSegmentationCalls gets all the calls that were made to DisableSegmentation.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableSegmentationCalls())
+func (mock *GatewayClientMock) DisableSegmentationCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockDisableSegmentation.RLock()
+	calls = mock.calls.DisableSegmentation
+	mock.lockDisableSegmentation.RUnlock()
+	return calls
+}
+
+// DisableSegmentationGatewayGroup calls DisableSegmentationGatewayGroupFunc.
+func (mock *GatewayClientMock) DisableSegmentationGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableSegmentationGatewayGroupFunc == nil {
+		panic("GatewayClientMock.DisableSegmentationGatewayGroupFunc: method is nil but GatewayClient.DisableSegmentationGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableSegmentationGatewayGroup.Lock()
+	mock.calls.DisableSegmentationGatewayGroup = append(mock.calls.DisableSegmentationGatewayGroup, callInfo)
+	mock.lockDisableSegmentationGatewayGroup.Unlock()
+	return mock.DisableSegmentationGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableSegmentationGatewayGroupCalls gets all the calls that were made to DisableSegmentationGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableSegmentationGatewayGroupCalls())
+func (mock *GatewayClientMock) DisableSegmentationGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableSegmentationGatewayGroup.RLock()
+	calls = mock.calls.DisableSegmentationGatewayGroup
+	mock.lockDisableSegmentationGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableSingleAZGateway calls DisableSingleAZGatewayFunc.
+func (mock *GatewayClientMock) DisableSingleAZGateway(gateway *Gateway) error {
+	if mock.DisableSingleAZGatewayFunc == nil {
+		panic("GatewayClientMock.DisableSingleAZGatewayFunc: method is nil but GatewayClient.DisableSingleAZGateway was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableSingleAZGateway.Lock()
+	mock.calls.DisableSingleAZGateway = append(mock.calls.DisableSingleAZGateway, callInfo)
+	mock.lockDisableSingleAZGateway.Unlock()
+	return mock.DisableSingleAZGatewayFunc(gateway)
+}
+
+// DisableSingleAZGatewayCalls gets all the calls that were made to DisableSingleAZGateway.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableSingleAZGatewayCalls())
+func (mock *GatewayClientMock) DisableSingleAZGatewayCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableSingleAZGateway.RLock()
+	calls = mock.calls.DisableSingleAZGateway
+	mock.lockDisableSingleAZGateway.RUnlock()
+	return calls
+}
+
+// DisableSkipPublicRouteUpdate calls DisableSkipPublicRouteUpdateFunc.
+func (mock *GatewayClientMock) DisableSkipPublicRouteUpdate(gw *Gateway) error {
+	if mock.DisableSkipPublicRouteUpdateFunc == nil {
+		panic("GatewayClientMock.DisableSkipPublicRouteUpdateFunc: method is nil but GatewayClient.DisableSkipPublicRouteUpdate was just called")
+	}
+	callInfo := struct {
+		Gw *Gateway
+	}{
+		Gw: gw,
+	}
+	mock.lockDisableSkipPublicRouteUpdate.Lock()
+	mock.calls.DisableSkipPublicRouteUpdate = append(mock.calls.DisableSkipPublicRouteUpdate, callInfo)
+	mock.lockDisableSkipPublicRouteUpdate.Unlock()
+	return mock.DisableSkipPublicRouteUpdateFunc(gw)
+}
+
+// DisableSkipPublicRouteUpdateCalls gets all the calls that were made to DisableSkipPublicRouteUpdate.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableSkipPublicRouteUpdateCalls())
+func (mock *GatewayClientMock) DisableSkipPublicRouteUpdateCalls() []struct {
+	Gw *Gateway
+} {
+	var calls []struct {
+		Gw *Gateway
+	}
+	mock.lockDisableSkipPublicRouteUpdate.RLock()
+	calls = mock.calls.DisableSkipPublicRouteUpdate
+	mock.lockDisableSkipPublicRouteUpdate.RUnlock()
+	return calls
+}
+
+// DisableSkipPublicRouteUpdateGatewayGroup calls DisableSkipPublicRouteUpdateGatewayGroupFunc.
+func (mock *GatewayClientMock) DisableSkipPublicRouteUpdateGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableSkipPublicRouteUpdateGatewayGroupFunc == nil {
+		panic("GatewayClientMock.DisableSkipPublicRouteUpdateGatewayGroupFunc: method is nil but GatewayClient.DisableSkipPublicRouteUpdateGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableSkipPublicRouteUpdateGatewayGroup.Lock()
+	mock.calls.DisableSkipPublicRouteUpdateGatewayGroup = append(mock.calls.DisableSkipPublicRouteUpdateGatewayGroup, callInfo)
+	mock.lockDisableSkipPublicRouteUpdateGatewayGroup.Unlock()
+	return mock.DisableSkipPublicRouteUpdateGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableSkipPublicRouteUpdateGatewayGroupCalls gets all the calls that were made to DisableSkipPublicRouteUpdateGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableSkipPublicRouteUpdateGatewayGroupCalls())
+func (mock *GatewayClientMock) DisableSkipPublicRouteUpdateGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableSkipPublicRouteUpdateGatewayGroup.RLock()
+	calls = mock.calls.DisableSkipPublicRouteUpdateGatewayGroup
+	mock.lockDisableSkipPublicRouteUpdateGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableSpokeLearnedCidrsApproval calls DisableSpokeLearnedCidrsApprovalFunc.
+func (mock *GatewayClientMock) DisableSpokeLearnedCidrsApproval(gateway *SpokeVpc) error {
+	if mock.DisableSpokeLearnedCidrsApprovalFunc == nil {
+		panic("GatewayClientMock.DisableSpokeLearnedCidrsApprovalFunc: method is nil but GatewayClient.DisableSpokeLearnedCidrsApproval was just called")
+	}
+	callInfo := struct {
+		Gateway *SpokeVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableSpokeLearnedCidrsApproval.Lock()
+	mock.calls.DisableSpokeLearnedCidrsApproval = append(mock.calls.DisableSpokeLearnedCidrsApproval, callInfo)
+	mock.lockDisableSpokeLearnedCidrsApproval.Unlock()
+	return mock.DisableSpokeLearnedCidrsApprovalFunc(gateway)
+}
+
+// DisableSpokeLearnedCidrsApprovalCalls gets all the calls that were made to DisableSpokeLearnedCidrsApproval.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableSpokeLearnedCidrsApprovalCalls())
+func (mock *GatewayClientMock) DisableSpokeLearnedCidrsApprovalCalls() []struct {
+	Gateway *SpokeVpc
+} {
+	var calls []struct {
+		Gateway *SpokeVpc
+	}
+	mock.lockDisableSpokeLearnedCidrsApproval.RLock()
+	calls = mock.calls.DisableSpokeLearnedCidrsApproval
+	mock.lockDisableSpokeLearnedCidrsApproval.RUnlock()
+	return calls
+}
+
+// DisableSpokeLearnedCidrsApprovalGatewayGroup calls DisableSpokeLearnedCidrsApprovalGatewayGroupFunc.
+func (mock *GatewayClientMock) DisableSpokeLearnedCidrsApprovalGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableSpokeLearnedCidrsApprovalGatewayGroupFunc == nil {
+		panic("GatewayClientMock.DisableSpokeLearnedCidrsApprovalGatewayGroupFunc: method is nil but GatewayClient.DisableSpokeLearnedCidrsApprovalGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableSpokeLearnedCidrsApprovalGatewayGroup.Lock()
+	mock.calls.DisableSpokeLearnedCidrsApprovalGatewayGroup = append(mock.calls.DisableSpokeLearnedCidrsApprovalGatewayGroup, callInfo)
+	mock.lockDisableSpokeLearnedCidrsApprovalGatewayGroup.Unlock()
+	return mock.DisableSpokeLearnedCidrsApprovalGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableSpokeLearnedCidrsApprovalGatewayGroupCalls gets all the calls that were made to DisableSpokeLearnedCidrsApprovalGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableSpokeLearnedCidrsApprovalGatewayGroupCalls())
+func (mock *GatewayClientMock) DisableSpokeLearnedCidrsApprovalGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableSpokeLearnedCidrsApprovalGatewayGroup.RLock()
+	calls = mock.calls.DisableSpokeLearnedCidrsApprovalGatewayGroup
+	mock.lockDisableSpokeLearnedCidrsApprovalGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableSpokeOnpremRoutePropagation calls DisableSpokeOnpremRoutePropagationFunc.
+func (mock *GatewayClientMock) DisableSpokeOnpremRoutePropagation(spokeGateway *SpokeVpc) error {
+	if mock.DisableSpokeOnpremRoutePropagationFunc == nil {
+		panic("GatewayClientMock.DisableSpokeOnpremRoutePropagationFunc: method is nil but GatewayClient.DisableSpokeOnpremRoutePropagation was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+	}{
+		SpokeGateway: spokeGateway,
+	}
+	mock.lockDisableSpokeOnpremRoutePropagation.Lock()
+	mock.calls.DisableSpokeOnpremRoutePropagation = append(mock.calls.DisableSpokeOnpremRoutePropagation, callInfo)
+	mock.lockDisableSpokeOnpremRoutePropagation.Unlock()
+	return mock.DisableSpokeOnpremRoutePropagationFunc(spokeGateway)
+}
+
+// DisableSpokeOnpremRoutePropagationCalls gets all the calls that were made to DisableSpokeOnpremRoutePropagation.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableSpokeOnpremRoutePropagationCalls())
+func (mock *GatewayClientMock) DisableSpokeOnpremRoutePropagationCalls() []struct {
+	SpokeGateway *SpokeVpc
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+	}
+	mock.lockDisableSpokeOnpremRoutePropagation.RLock()
+	calls = mock.calls.DisableSpokeOnpremRoutePropagation
+	mock.lockDisableSpokeOnpremRoutePropagation.RUnlock()
+	return calls
+}
+
+// DisableSpokeOnpremRoutePropagationGatewayGroup calls DisableSpokeOnpremRoutePropagationGatewayGroupFunc.
+func (mock *GatewayClientMock) DisableSpokeOnpremRoutePropagationGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableSpokeOnpremRoutePropagationGatewayGroupFunc == nil {
+		panic("GatewayClientMock.DisableSpokeOnpremRoutePropagationGatewayGroupFunc: method is nil but GatewayClient.DisableSpokeOnpremRoutePropagationGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableSpokeOnpremRoutePropagationGatewayGroup.Lock()
+	mock.calls.DisableSpokeOnpremRoutePropagationGatewayGroup = append(mock.calls.DisableSpokeOnpremRoutePropagationGatewayGroup, callInfo)
+	mock.lockDisableSpokeOnpremRoutePropagationGatewayGroup.Unlock()
+	return mock.DisableSpokeOnpremRoutePropagationGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableSpokeOnpremRoutePropagationGatewayGroupCalls gets all the calls that were made to DisableSpokeOnpremRoutePropagationGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableSpokeOnpremRoutePropagationGatewayGroupCalls())
+func (mock *GatewayClientMock) DisableSpokeOnpremRoutePropagationGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableSpokeOnpremRoutePropagationGatewayGroup.RLock()
+	calls = mock.calls.DisableSpokeOnpremRoutePropagationGatewayGroup
+	mock.lockDisableSpokeOnpremRoutePropagationGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableSpokePreserveAsPath calls DisableSpokePreserveAsPathFunc.
+func (mock *GatewayClientMock) DisableSpokePreserveAsPath(spokeGateway *SpokeVpc) error {
+	if mock.DisableSpokePreserveAsPathFunc == nil {
+		panic("GatewayClientMock.DisableSpokePreserveAsPathFunc: method is nil but GatewayClient.DisableSpokePreserveAsPath was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+	}{
+		SpokeGateway: spokeGateway,
+	}
+	mock.lockDisableSpokePreserveAsPath.Lock()
+	mock.calls.DisableSpokePreserveAsPath = append(mock.calls.DisableSpokePreserveAsPath, callInfo)
+	mock.lockDisableSpokePreserveAsPath.Unlock()
+	return mock.DisableSpokePreserveAsPathFunc(spokeGateway)
+}
+
+// DisableSpokePreserveAsPathCalls gets all the calls that were made to DisableSpokePreserveAsPath.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableSpokePreserveAsPathCalls())
+func (mock *GatewayClientMock) DisableSpokePreserveAsPathCalls() []struct {
+	SpokeGateway *SpokeVpc
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+	}
+	mock.lockDisableSpokePreserveAsPath.RLock()
+	calls = mock.calls.DisableSpokePreserveAsPath
+	mock.lockDisableSpokePreserveAsPath.RUnlock()
+	return calls
+}
+
+// DisableSpokePreserveAsPathGatewayGroup calls DisableSpokePreserveAsPathGatewayGroupFunc.
+func (mock *GatewayClientMock) DisableSpokePreserveAsPathGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableSpokePreserveAsPathGatewayGroupFunc == nil {
+		panic("GatewayClientMock.DisableSpokePreserveAsPathGatewayGroupFunc: method is nil but GatewayClient.DisableSpokePreserveAsPathGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableSpokePreserveAsPathGatewayGroup.Lock()
+	mock.calls.DisableSpokePreserveAsPathGatewayGroup = append(mock.calls.DisableSpokePreserveAsPathGatewayGroup, callInfo)
+	mock.lockDisableSpokePreserveAsPathGatewayGroup.Unlock()
+	return mock.DisableSpokePreserveAsPathGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableSpokePreserveAsPathGatewayGroupCalls gets all the calls that were made to DisableSpokePreserveAsPathGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableSpokePreserveAsPathGatewayGroupCalls())
+func (mock *GatewayClientMock) DisableSpokePreserveAsPathGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableSpokePreserveAsPathGatewayGroup.RLock()
+	calls = mock.calls.DisableSpokePreserveAsPathGatewayGroup
+	mock.lockDisableSpokePreserveAsPathGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableSummarizeCidrToTgw calls DisableSummarizeCidrToTgwFunc.
+func (mock *GatewayClientMock) DisableSummarizeCidrToTgw(gwName string) error {
+	if mock.DisableSummarizeCidrToTgwFunc == nil {
+		panic("GatewayClientMock.DisableSummarizeCidrToTgwFunc: method is nil but GatewayClient.DisableSummarizeCidrToTgw was just called")
+	}
+	callInfo := struct {
+		GwName string
+	}{
+		GwName: gwName,
+	}
+	mock.lockDisableSummarizeCidrToTgw.Lock()
+	mock.calls.DisableSummarizeCidrToTgw = append(mock.calls.DisableSummarizeCidrToTgw, callInfo)
+	mock.lockDisableSummarizeCidrToTgw.Unlock()
+	return mock.DisableSummarizeCidrToTgwFunc(gwName)
+}
+
+// DisableSummarizeCidrToTgwCalls gets all the calls that were made to DisableSummarizeCidrToTgw.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableSummarizeCidrToTgwCalls())
+func (mock *GatewayClientMock) DisableSummarizeCidrToTgwCalls() []struct {
+	GwName string
+} {
+	var calls []struct {
+		GwName string
+	}
+	mock.lockDisableSummarizeCidrToTgw.RLock()
+	calls = mock.calls.DisableSummarizeCidrToTgw
+	mock.lockDisableSummarizeCidrToTgw.RUnlock()
+	return calls
+}
+
+// DisableTransitFireNet calls DisableTransitFireNetFunc.
+func (mock *GatewayClientMock) DisableTransitFireNet(gateway *Gateway) error {
+	if mock.DisableTransitFireNetFunc == nil {
+		panic("GatewayClientMock.DisableTransitFireNetFunc: method is nil but GatewayClient.DisableTransitFireNet was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableTransitFireNet.Lock()
+	mock.calls.DisableTransitFireNet = append(mock.calls.DisableTransitFireNet, callInfo)
+	mock.lockDisableTransitFireNet.Unlock()
+	return mock.DisableTransitFireNetFunc(gateway)
+}
+
+// DisableTransitFireNetCalls gets all the calls that were made to DisableTransitFireNet.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableTransitFireNetCalls())
+func (mock *GatewayClientMock) DisableTransitFireNetCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableTransitFireNet.RLock()
+	calls = mock.calls.DisableTransitFireNet
+	mock.lockDisableTransitFireNet.RUnlock()
+	return calls
+}
+
+// DisableTransitFireNetGatewayGroup calls DisableTransitFireNetGatewayGroupFunc.
+func (mock *GatewayClientMock) DisableTransitFireNetGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableTransitFireNetGatewayGroupFunc == nil {
+		panic("GatewayClientMock.DisableTransitFireNetGatewayGroupFunc: method is nil but GatewayClient.DisableTransitFireNetGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableTransitFireNetGatewayGroup.Lock()
+	mock.calls.DisableTransitFireNetGatewayGroup = append(mock.calls.DisableTransitFireNetGatewayGroup, callInfo)
+	mock.lockDisableTransitFireNetGatewayGroup.Unlock()
+	return mock.DisableTransitFireNetGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableTransitFireNetGatewayGroupCalls gets all the calls that were made to DisableTransitFireNetGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableTransitFireNetGatewayGroupCalls())
+func (mock *GatewayClientMock) DisableTransitFireNetGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableTransitFireNetGatewayGroup.RLock()
+	calls = mock.calls.DisableTransitFireNetGatewayGroup
+	mock.lockDisableTransitFireNetGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableTransitLearnedCidrsApproval calls DisableTransitLearnedCidrsApprovalFunc.
+func (mock *GatewayClientMock) DisableTransitLearnedCidrsApproval(gateway *TransitVpc) error {
+	if mock.DisableTransitLearnedCidrsApprovalFunc == nil {
+		panic("GatewayClientMock.DisableTransitLearnedCidrsApprovalFunc: method is nil but GatewayClient.DisableTransitLearnedCidrsApproval was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableTransitLearnedCidrsApproval.Lock()
+	mock.calls.DisableTransitLearnedCidrsApproval = append(mock.calls.DisableTransitLearnedCidrsApproval, callInfo)
+	mock.lockDisableTransitLearnedCidrsApproval.Unlock()
+	return mock.DisableTransitLearnedCidrsApprovalFunc(gateway)
+}
+
+// DisableTransitLearnedCidrsApprovalCalls gets all the calls that were made to DisableTransitLearnedCidrsApproval.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableTransitLearnedCidrsApprovalCalls())
+func (mock *GatewayClientMock) DisableTransitLearnedCidrsApprovalCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockDisableTransitLearnedCidrsApproval.RLock()
+	calls = mock.calls.DisableTransitLearnedCidrsApproval
+	mock.lockDisableTransitLearnedCidrsApproval.RUnlock()
+	return calls
+}
+
+// DisableTransitLearnedCidrsApprovalGatewayGroup calls DisableTransitLearnedCidrsApprovalGatewayGroupFunc.
+func (mock *GatewayClientMock) DisableTransitLearnedCidrsApprovalGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableTransitLearnedCidrsApprovalGatewayGroupFunc == nil {
+		panic("GatewayClientMock.DisableTransitLearnedCidrsApprovalGatewayGroupFunc: method is nil but GatewayClient.DisableTransitLearnedCidrsApprovalGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableTransitLearnedCidrsApprovalGatewayGroup.Lock()
+	mock.calls.DisableTransitLearnedCidrsApprovalGatewayGroup = append(mock.calls.DisableTransitLearnedCidrsApprovalGatewayGroup, callInfo)
+	mock.lockDisableTransitLearnedCidrsApprovalGatewayGroup.Unlock()
+	return mock.DisableTransitLearnedCidrsApprovalGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableTransitLearnedCidrsApprovalGatewayGroupCalls gets all the calls that were made to DisableTransitLearnedCidrsApprovalGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableTransitLearnedCidrsApprovalGatewayGroupCalls())
+func (mock *GatewayClientMock) DisableTransitLearnedCidrsApprovalGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableTransitLearnedCidrsApprovalGatewayGroup.RLock()
+	calls = mock.calls.DisableTransitLearnedCidrsApprovalGatewayGroup
+	mock.lockDisableTransitLearnedCidrsApprovalGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableTransitPreserveAsPath calls DisableTransitPreserveAsPathFunc.
+func (mock *GatewayClientMock) DisableTransitPreserveAsPath(transitGateway *TransitVpc) error {
+	if mock.DisableTransitPreserveAsPathFunc == nil {
+		panic("GatewayClientMock.DisableTransitPreserveAsPathFunc: method is nil but GatewayClient.DisableTransitPreserveAsPath was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockDisableTransitPreserveAsPath.Lock()
+	mock.calls.DisableTransitPreserveAsPath = append(mock.calls.DisableTransitPreserveAsPath, callInfo)
+	mock.lockDisableTransitPreserveAsPath.Unlock()
+	return mock.DisableTransitPreserveAsPathFunc(transitGateway)
+}
+
+// DisableTransitPreserveAsPathCalls gets all the calls that were made to DisableTransitPreserveAsPath.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableTransitPreserveAsPathCalls())
+func (mock *GatewayClientMock) DisableTransitPreserveAsPathCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockDisableTransitPreserveAsPath.RLock()
+	calls = mock.calls.DisableTransitPreserveAsPath
+	mock.lockDisableTransitPreserveAsPath.RUnlock()
+	return calls
+}
+
+// DisableTransitPreserveAsPathGatewayGroup calls DisableTransitPreserveAsPathGatewayGroupFunc.
+func (mock *GatewayClientMock) DisableTransitPreserveAsPathGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableTransitPreserveAsPathGatewayGroupFunc == nil {
+		panic("GatewayClientMock.DisableTransitPreserveAsPathGatewayGroupFunc: method is nil but GatewayClient.DisableTransitPreserveAsPathGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableTransitPreserveAsPathGatewayGroup.Lock()
+	mock.calls.DisableTransitPreserveAsPathGatewayGroup = append(mock.calls.DisableTransitPreserveAsPathGatewayGroup, callInfo)
+	mock.lockDisableTransitPreserveAsPathGatewayGroup.Unlock()
+	return mock.DisableTransitPreserveAsPathGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableTransitPreserveAsPathGatewayGroupCalls gets all the calls that were made to DisableTransitPreserveAsPathGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableTransitPreserveAsPathGatewayGroupCalls())
+func (mock *GatewayClientMock) DisableTransitPreserveAsPathGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableTransitPreserveAsPathGatewayGroup.RLock()
+	calls = mock.calls.DisableTransitPreserveAsPathGatewayGroup
+	mock.lockDisableTransitPreserveAsPathGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableTransitSummarizeCidrToTgwGatewayGroup calls DisableTransitSummarizeCidrToTgwGatewayGroupFunc.
+func (mock *GatewayClientMock) DisableTransitSummarizeCidrToTgwGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.DisableTransitSummarizeCidrToTgwGatewayGroupFunc == nil {
+		panic("GatewayClientMock.DisableTransitSummarizeCidrToTgwGatewayGroupFunc: method is nil but GatewayClient.DisableTransitSummarizeCidrToTgwGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockDisableTransitSummarizeCidrToTgwGatewayGroup.Lock()
+	mock.calls.DisableTransitSummarizeCidrToTgwGatewayGroup = append(mock.calls.DisableTransitSummarizeCidrToTgwGatewayGroup, callInfo)
+	mock.lockDisableTransitSummarizeCidrToTgwGatewayGroup.Unlock()
+	return mock.DisableTransitSummarizeCidrToTgwGatewayGroupFunc(ctx, groupName)
+}
+
+// DisableTransitSummarizeCidrToTgwGatewayGroupCalls gets all the calls that were made to DisableTransitSummarizeCidrToTgwGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableTransitSummarizeCidrToTgwGatewayGroupCalls())
+func (mock *GatewayClientMock) DisableTransitSummarizeCidrToTgwGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockDisableTransitSummarizeCidrToTgwGatewayGroup.RLock()
+	calls = mock.calls.DisableTransitSummarizeCidrToTgwGatewayGroup
+	mock.lockDisableTransitSummarizeCidrToTgwGatewayGroup.RUnlock()
+	return calls
+}
+
+// DisableVPNConfig calls DisableVPNConfigFunc.
+func (mock *GatewayClientMock) DisableVPNConfig(gateway *Gateway, vpnConfig *VPNConfig) error {
+	if mock.DisableVPNConfigFunc == nil {
+		panic("GatewayClientMock.DisableVPNConfigFunc: method is nil but GatewayClient.DisableVPNConfig was just called")
+	}
+	callInfo := struct {
+		Gateway   *Gateway
+		VpnConfig *VPNConfig
+	}{
+		Gateway:   gateway,
+		VpnConfig: vpnConfig,
+	}
+	mock.lockDisableVPNConfig.Lock()
+	mock.calls.DisableVPNConfig = append(mock.calls.DisableVPNConfig, callInfo)
+	mock.lockDisableVPNConfig.Unlock()
+	return mock.DisableVPNConfigFunc(gateway, vpnConfig)
+}
+
+// DisableVPNConfigCalls gets all the calls that were made to DisableVPNConfig.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableVPNConfigCalls())
+func (mock *GatewayClientMock) DisableVPNConfigCalls() []struct {
+	Gateway   *Gateway
+	VpnConfig *VPNConfig
+} {
+	var calls []struct {
+		Gateway   *Gateway
+		VpnConfig *VPNConfig
+	}
+	mock.lockDisableVPNConfig.RLock()
+	calls = mock.calls.DisableVPNConfig
+	mock.lockDisableVPNConfig.RUnlock()
+	return calls
+}
+
+// DisableVpcDNSServer calls DisableVpcDNSServerFunc.
+func (mock *GatewayClientMock) DisableVpcDNSServer(gateway *Gateway) error {
+	if mock.DisableVpcDNSServerFunc == nil {
+		panic("GatewayClientMock.DisableVpcDNSServerFunc: method is nil but GatewayClient.DisableVpcDNSServer was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableVpcDNSServer.Lock()
+	mock.calls.DisableVpcDNSServer = append(mock.calls.DisableVpcDNSServer, callInfo)
+	mock.lockDisableVpcDNSServer.Unlock()
+	return mock.DisableVpcDNSServerFunc(gateway)
+}
+
+// DisableVpcDNSServerCalls gets all the calls that were made to DisableVpcDNSServer.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableVpcDNSServerCalls())
+func (mock *GatewayClientMock) DisableVpcDNSServerCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableVpcDNSServer.RLock()
+	calls = mock.calls.DisableVpcDNSServer
+	mock.lockDisableVpcDNSServer.RUnlock()
+	return calls
+}
+
+// DisableVpnNat calls DisableVpnNatFunc.
+func (mock *GatewayClientMock) DisableVpnNat(gateway *Gateway) error {
+	if mock.DisableVpnNatFunc == nil {
+		panic("GatewayClientMock.DisableVpnNatFunc: method is nil but GatewayClient.DisableVpnNat was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockDisableVpnNat.Lock()
+	mock.calls.DisableVpnNat = append(mock.calls.DisableVpnNat, callInfo)
+	mock.lockDisableVpnNat.Unlock()
+	return mock.DisableVpnNatFunc(gateway)
+}
+
+// DisableVpnNatCalls gets all the calls that were made to DisableVpnNat.
+// Check the length with:
+//
+//	len(mockedGatewayClient.DisableVpnNatCalls())
+func (mock *GatewayClientMock) DisableVpnNatCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockDisableVpnNat.RLock()
+	calls = mock.calls.DisableVpnNat
+	mock.lockDisableVpnNat.RUnlock()
+	return calls
+}
+
+// EditDesignatedGateway calls EditDesignatedGatewayFunc.
+func (mock *GatewayClientMock) EditDesignatedGateway(gateway *Gateway) error {
+	if mock.EditDesignatedGatewayFunc == nil {
+		panic("GatewayClientMock.EditDesignatedGatewayFunc: method is nil but GatewayClient.EditDesignatedGateway was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEditDesignatedGateway.Lock()
+	mock.calls.EditDesignatedGateway = append(mock.calls.EditDesignatedGateway, callInfo)
+	mock.lockEditDesignatedGateway.Unlock()
+	return mock.EditDesignatedGatewayFunc(gateway)
+}
+
+// EditDesignatedGatewayCalls gets all the calls that were made to EditDesignatedGateway.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EditDesignatedGatewayCalls())
+func (mock *GatewayClientMock) EditDesignatedGatewayCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEditDesignatedGateway.RLock()
+	calls = mock.calls.EditDesignatedGateway
+	mock.lockEditDesignatedGateway.RUnlock()
+	return calls
+}
+
+// EditGatewayAdvertisedCidr calls EditGatewayAdvertisedCidrFunc.
+func (mock *GatewayClientMock) EditGatewayAdvertisedCidr(gateway *Gateway) error {
+	if mock.EditGatewayAdvertisedCidrFunc == nil {
+		panic("GatewayClientMock.EditGatewayAdvertisedCidrFunc: method is nil but GatewayClient.EditGatewayAdvertisedCidr was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEditGatewayAdvertisedCidr.Lock()
+	mock.calls.EditGatewayAdvertisedCidr = append(mock.calls.EditGatewayAdvertisedCidr, callInfo)
+	mock.lockEditGatewayAdvertisedCidr.Unlock()
+	return mock.EditGatewayAdvertisedCidrFunc(gateway)
+}
+
+// EditGatewayAdvertisedCidrCalls gets all the calls that were made to EditGatewayAdvertisedCidr.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EditGatewayAdvertisedCidrCalls())
+func (mock *GatewayClientMock) EditGatewayAdvertisedCidrCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEditGatewayAdvertisedCidr.RLock()
+	calls = mock.calls.EditGatewayAdvertisedCidr
+	mock.lockEditGatewayAdvertisedCidr.RUnlock()
+	return calls
+}
+
+// EditGatewayCustomRoutes calls EditGatewayCustomRoutesFunc.
+func (mock *GatewayClientMock) EditGatewayCustomRoutes(gateway *Gateway) error {
+	if mock.EditGatewayCustomRoutesFunc == nil {
+		panic("GatewayClientMock.EditGatewayCustomRoutesFunc: method is nil but GatewayClient.EditGatewayCustomRoutes was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEditGatewayCustomRoutes.Lock()
+	mock.calls.EditGatewayCustomRoutes = append(mock.calls.EditGatewayCustomRoutes, callInfo)
+	mock.lockEditGatewayCustomRoutes.Unlock()
+	return mock.EditGatewayCustomRoutesFunc(gateway)
+}
+
+// EditGatewayCustomRoutesCalls gets all the calls that were made to EditGatewayCustomRoutes.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EditGatewayCustomRoutesCalls())
+func (mock *GatewayClientMock) EditGatewayCustomRoutesCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEditGatewayCustomRoutes.RLock()
+	calls = mock.calls.EditGatewayCustomRoutes
+	mock.lockEditGatewayCustomRoutes.RUnlock()
+	return calls
+}
+
+// EditGatewayFilterRoutes calls EditGatewayFilterRoutesFunc.
+func (mock *GatewayClientMock) EditGatewayFilterRoutes(gateway *Gateway) error {
+	if mock.EditGatewayFilterRoutesFunc == nil {
+		panic("GatewayClientMock.EditGatewayFilterRoutesFunc: method is nil but GatewayClient.EditGatewayFilterRoutes was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEditGatewayFilterRoutes.Lock()
+	mock.calls.EditGatewayFilterRoutes = append(mock.calls.EditGatewayFilterRoutes, callInfo)
+	mock.lockEditGatewayFilterRoutes.Unlock()
+	return mock.EditGatewayFilterRoutesFunc(gateway)
+}
+
+// EditGatewayFilterRoutesCalls gets all the calls that were made to EditGatewayFilterRoutes.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EditGatewayFilterRoutesCalls())
+func (mock *GatewayClientMock) EditGatewayFilterRoutesCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEditGatewayFilterRoutes.RLock()
+	calls = mock.calls.EditGatewayFilterRoutes
+	mock.lockEditGatewayFilterRoutes.RUnlock()
+	return calls
+}
+
+// EditManagedRouteTables calls EditManagedRouteTablesFunc.
+func (mock *GatewayClientMock) EditManagedRouteTables(gateway *Gateway, routeTables []string) error {
+	if mock.EditManagedRouteTablesFunc == nil {
+		panic("GatewayClientMock.EditManagedRouteTablesFunc: method is nil but GatewayClient.EditManagedRouteTables was just called")
+	}
+	callInfo := struct {
+		Gateway     *Gateway
+		RouteTables []string
+	}{
+		Gateway:     gateway,
+		RouteTables: routeTables,
+	}
+	mock.lockEditManagedRouteTables.Lock()
+	mock.calls.EditManagedRouteTables = append(mock.calls.EditManagedRouteTables, callInfo)
+	mock.lockEditManagedRouteTables.Unlock()
+	return mock.EditManagedRouteTablesFunc(gateway, routeTables)
+}
+
+// EditManagedRouteTablesCalls gets all the calls that were made to EditManagedRouteTables.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EditManagedRouteTablesCalls())
+func (mock *GatewayClientMock) EditManagedRouteTablesCalls() []struct {
+	Gateway     *Gateway
+	RouteTables []string
+} {
+	var calls []struct {
+		Gateway     *Gateway
+		RouteTables []string
+	}
+	mock.lockEditManagedRouteTables.RLock()
+	calls = mock.calls.EditManagedRouteTables
+	mock.lockEditManagedRouteTables.RUnlock()
+	return calls
+}
+
+// EditManagedRouteTablesForGatewayGroup calls EditManagedRouteTablesForGatewayGroupFunc.
+func (mock *GatewayClientMock) EditManagedRouteTablesForGatewayGroup(ctx context.Context, groupName string, routeTables []string) error {
+	if mock.EditManagedRouteTablesForGatewayGroupFunc == nil {
+		panic("GatewayClientMock.EditManagedRouteTablesForGatewayGroupFunc: method is nil but GatewayClient.EditManagedRouteTablesForGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		GroupName   string
+		RouteTables []string
+	}{
+		Ctx:         ctx,
+		GroupName:   groupName,
+		RouteTables: routeTables,
+	}
+	mock.lockEditManagedRouteTablesForGatewayGroup.Lock()
+	mock.calls.EditManagedRouteTablesForGatewayGroup = append(mock.calls.EditManagedRouteTablesForGatewayGroup, callInfo)
+	mock.lockEditManagedRouteTablesForGatewayGroup.Unlock()
+	return mock.EditManagedRouteTablesForGatewayGroupFunc(ctx, groupName, routeTables)
+}
+
+// EditManagedRouteTablesForGatewayGroupCalls gets all the calls that were made to EditManagedRouteTablesForGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EditManagedRouteTablesForGatewayGroupCalls())
+func (mock *GatewayClientMock) EditManagedRouteTablesForGatewayGroupCalls() []struct {
+	Ctx         context.Context
+	GroupName   string
+	RouteTables []string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		GroupName   string
+		RouteTables []string
+	}
+	mock.lockEditManagedRouteTablesForGatewayGroup.RLock()
+	calls = mock.calls.EditManagedRouteTablesForGatewayGroup
+	mock.lockEditManagedRouteTablesForGatewayGroup.RUnlock()
+	return calls
+}
+
+// EditPrivateRouteTableConfig calls EditPrivateRouteTableConfigFunc.
+func (mock *GatewayClientMock) EditPrivateRouteTableConfig(gateway *Gateway, routeTables []string) error {
+	if mock.EditPrivateRouteTableConfigFunc == nil {
+		panic("GatewayClientMock.EditPrivateRouteTableConfigFunc: method is nil but GatewayClient.EditPrivateRouteTableConfig was just called")
+	}
+	callInfo := struct {
+		Gateway     *Gateway
+		RouteTables []string
+	}{
+		Gateway:     gateway,
+		RouteTables: routeTables,
+	}
+	mock.lockEditPrivateRouteTableConfig.Lock()
+	mock.calls.EditPrivateRouteTableConfig = append(mock.calls.EditPrivateRouteTableConfig, callInfo)
+	mock.lockEditPrivateRouteTableConfig.Unlock()
+	return mock.EditPrivateRouteTableConfigFunc(gateway, routeTables)
+}
+
+// EditPrivateRouteTableConfigCalls gets all the calls that were made to EditPrivateRouteTableConfig.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EditPrivateRouteTableConfigCalls())
+func (mock *GatewayClientMock) EditPrivateRouteTableConfigCalls() []struct {
+	Gateway     *Gateway
+	RouteTables []string
+} {
+	var calls []struct {
+		Gateway     *Gateway
+		RouteTables []string
+	}
+	mock.lockEditPrivateRouteTableConfig.RLock()
+	calls = mock.calls.EditPrivateRouteTableConfig
+	mock.lockEditPrivateRouteTableConfig.RUnlock()
+	return calls
+}
+
+// EditPrivateRouteTableConfigForGatewayGroup calls EditPrivateRouteTableConfigForGatewayGroupFunc.
+func (mock *GatewayClientMock) EditPrivateRouteTableConfigForGatewayGroup(ctx context.Context, groupName string, routeTables []string) error {
+	if mock.EditPrivateRouteTableConfigForGatewayGroupFunc == nil {
+		panic("GatewayClientMock.EditPrivateRouteTableConfigForGatewayGroupFunc: method is nil but GatewayClient.EditPrivateRouteTableConfigForGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		GroupName   string
+		RouteTables []string
+	}{
+		Ctx:         ctx,
+		GroupName:   groupName,
+		RouteTables: routeTables,
+	}
+	mock.lockEditPrivateRouteTableConfigForGatewayGroup.Lock()
+	mock.calls.EditPrivateRouteTableConfigForGatewayGroup = append(mock.calls.EditPrivateRouteTableConfigForGatewayGroup, callInfo)
+	mock.lockEditPrivateRouteTableConfigForGatewayGroup.Unlock()
+	return mock.EditPrivateRouteTableConfigForGatewayGroupFunc(ctx, groupName, routeTables)
+}
+
+// EditPrivateRouteTableConfigForGatewayGroupCalls gets all the calls that were made to EditPrivateRouteTableConfigForGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EditPrivateRouteTableConfigForGatewayGroupCalls())
+func (mock *GatewayClientMock) EditPrivateRouteTableConfigForGatewayGroupCalls() []struct {
+	Ctx         context.Context
+	GroupName   string
+	RouteTables []string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		GroupName   string
+		RouteTables []string
+	}
+	mock.lockEditPrivateRouteTableConfigForGatewayGroup.RLock()
+	calls = mock.calls.EditPrivateRouteTableConfigForGatewayGroup
+	mock.lockEditPrivateRouteTableConfigForGatewayGroup.RUnlock()
+	return calls
+}
+
+// EditPublicSubnetFilteringRouteTableList calls EditPublicSubnetFilteringRouteTableListFunc.
+func (mock *GatewayClientMock) EditPublicSubnetFilteringRouteTableList(gateway *Gateway, routeTables []string) error {
+	if mock.EditPublicSubnetFilteringRouteTableListFunc == nil {
+		panic("GatewayClientMock.EditPublicSubnetFilteringRouteTableListFunc: method is nil but GatewayClient.EditPublicSubnetFilteringRouteTableList was just called")
+	}
+	callInfo := struct {
+		Gateway     *Gateway
+		RouteTables []string
+	}{
+		Gateway:     gateway,
+		RouteTables: routeTables,
+	}
+	mock.lockEditPublicSubnetFilteringRouteTableList.Lock()
+	mock.calls.EditPublicSubnetFilteringRouteTableList = append(mock.calls.EditPublicSubnetFilteringRouteTableList, callInfo)
+	mock.lockEditPublicSubnetFilteringRouteTableList.Unlock()
+	return mock.EditPublicSubnetFilteringRouteTableListFunc(gateway, routeTables)
+}
+
+// EditPublicSubnetFilteringRouteTableListCalls gets all the calls that were made to EditPublicSubnetFilteringRouteTableList.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EditPublicSubnetFilteringRouteTableListCalls())
+func (mock *GatewayClientMock) EditPublicSubnetFilteringRouteTableListCalls() []struct {
+	Gateway     *Gateway
+	RouteTables []string
+} {
+	var calls []struct {
+		Gateway     *Gateway
+		RouteTables []string
+	}
+	mock.lockEditPublicSubnetFilteringRouteTableList.RLock()
+	calls = mock.calls.EditPublicSubnetFilteringRouteTableList
+	mock.lockEditPublicSubnetFilteringRouteTableList.RUnlock()
+	return calls
+}
+
+// EnableActiveStandby calls EnableActiveStandbyFunc.
+func (mock *GatewayClientMock) EnableActiveStandby(transitGateway *TransitVpc) error {
+	if mock.EnableActiveStandbyFunc == nil {
+		panic("GatewayClientMock.EnableActiveStandbyFunc: method is nil but GatewayClient.EnableActiveStandby was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockEnableActiveStandby.Lock()
+	mock.calls.EnableActiveStandby = append(mock.calls.EnableActiveStandby, callInfo)
+	mock.lockEnableActiveStandby.Unlock()
+	return mock.EnableActiveStandbyFunc(transitGateway)
+}
+
+// EnableActiveStandbyCalls gets all the calls that were made to EnableActiveStandby.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableActiveStandbyCalls())
+func (mock *GatewayClientMock) EnableActiveStandbyCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockEnableActiveStandby.RLock()
+	calls = mock.calls.EnableActiveStandby
+	mock.lockEnableActiveStandby.RUnlock()
+	return calls
+}
+
+// EnableActiveStandbyGatewayGroup calls EnableActiveStandbyGatewayGroupFunc.
+func (mock *GatewayClientMock) EnableActiveStandbyGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableActiveStandbyGatewayGroupFunc == nil {
+		panic("GatewayClientMock.EnableActiveStandbyGatewayGroupFunc: method is nil but GatewayClient.EnableActiveStandbyGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableActiveStandbyGatewayGroup.Lock()
+	mock.calls.EnableActiveStandbyGatewayGroup = append(mock.calls.EnableActiveStandbyGatewayGroup, callInfo)
+	mock.lockEnableActiveStandbyGatewayGroup.Unlock()
+	return mock.EnableActiveStandbyGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableActiveStandbyGatewayGroupCalls gets all the calls that were made to EnableActiveStandbyGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableActiveStandbyGatewayGroupCalls())
+func (mock *GatewayClientMock) EnableActiveStandbyGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableActiveStandbyGatewayGroup.RLock()
+	calls = mock.calls.EnableActiveStandbyGatewayGroup
+	mock.lockEnableActiveStandbyGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableActiveStandbyPreemptive calls EnableActiveStandbyPreemptiveFunc.
+func (mock *GatewayClientMock) EnableActiveStandbyPreemptive(transitGateway *TransitVpc) error {
+	if mock.EnableActiveStandbyPreemptiveFunc == nil {
+		panic("GatewayClientMock.EnableActiveStandbyPreemptiveFunc: method is nil but GatewayClient.EnableActiveStandbyPreemptive was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockEnableActiveStandbyPreemptive.Lock()
+	mock.calls.EnableActiveStandbyPreemptive = append(mock.calls.EnableActiveStandbyPreemptive, callInfo)
+	mock.lockEnableActiveStandbyPreemptive.Unlock()
+	return mock.EnableActiveStandbyPreemptiveFunc(transitGateway)
+}
+
+// EnableActiveStandbyPreemptiveCalls gets all the calls that were made to EnableActiveStandbyPreemptive.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableActiveStandbyPreemptiveCalls())
+func (mock *GatewayClientMock) EnableActiveStandbyPreemptiveCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockEnableActiveStandbyPreemptive.RLock()
+	calls = mock.calls.EnableActiveStandbyPreemptive
+	mock.lockEnableActiveStandbyPreemptive.RUnlock()
+	return calls
+}
+
+// EnableActiveStandbyPreemptiveGatewayGroup calls EnableActiveStandbyPreemptiveGatewayGroupFunc.
+func (mock *GatewayClientMock) EnableActiveStandbyPreemptiveGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableActiveStandbyPreemptiveGatewayGroupFunc == nil {
+		panic("GatewayClientMock.EnableActiveStandbyPreemptiveGatewayGroupFunc: method is nil but GatewayClient.EnableActiveStandbyPreemptiveGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableActiveStandbyPreemptiveGatewayGroup.Lock()
+	mock.calls.EnableActiveStandbyPreemptiveGatewayGroup = append(mock.calls.EnableActiveStandbyPreemptiveGatewayGroup, callInfo)
+	mock.lockEnableActiveStandbyPreemptiveGatewayGroup.Unlock()
+	return mock.EnableActiveStandbyPreemptiveGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableActiveStandbyPreemptiveGatewayGroupCalls gets all the calls that were made to EnableActiveStandbyPreemptiveGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableActiveStandbyPreemptiveGatewayGroupCalls())
+func (mock *GatewayClientMock) EnableActiveStandbyPreemptiveGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableActiveStandbyPreemptiveGatewayGroup.RLock()
+	calls = mock.calls.EnableActiveStandbyPreemptiveGatewayGroup
+	mock.lockEnableActiveStandbyPreemptiveGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableActiveStandbyPreemptiveSpoke calls EnableActiveStandbyPreemptiveSpokeFunc.
+func (mock *GatewayClientMock) EnableActiveStandbyPreemptiveSpoke(spokeGateway *SpokeVpc) error {
+	if mock.EnableActiveStandbyPreemptiveSpokeFunc == nil {
+		panic("GatewayClientMock.EnableActiveStandbyPreemptiveSpokeFunc: method is nil but GatewayClient.EnableActiveStandbyPreemptiveSpoke was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+	}{
+		SpokeGateway: spokeGateway,
+	}
+	mock.lockEnableActiveStandbyPreemptiveSpoke.Lock()
+	mock.calls.EnableActiveStandbyPreemptiveSpoke = append(mock.calls.EnableActiveStandbyPreemptiveSpoke, callInfo)
+	mock.lockEnableActiveStandbyPreemptiveSpoke.Unlock()
+	return mock.EnableActiveStandbyPreemptiveSpokeFunc(spokeGateway)
+}
+
+// EnableActiveStandbyPreemptiveSpokeCalls gets all the calls that were made to EnableActiveStandbyPreemptiveSpoke.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableActiveStandbyPreemptiveSpokeCalls())
+func (mock *GatewayClientMock) EnableActiveStandbyPreemptiveSpokeCalls() []struct {
+	SpokeGateway *SpokeVpc
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+	}
+	mock.lockEnableActiveStandbyPreemptiveSpoke.RLock()
+	calls = mock.calls.EnableActiveStandbyPreemptiveSpoke
+	mock.lockEnableActiveStandbyPreemptiveSpoke.RUnlock()
+	return calls
+}
+
+// EnableActiveStandbySpoke calls EnableActiveStandbySpokeFunc.
+func (mock *GatewayClientMock) EnableActiveStandbySpoke(spokeGateway *SpokeVpc) error {
+	if mock.EnableActiveStandbySpokeFunc == nil {
+		panic("GatewayClientMock.EnableActiveStandbySpokeFunc: method is nil but GatewayClient.EnableActiveStandbySpoke was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+	}{
+		SpokeGateway: spokeGateway,
+	}
+	mock.lockEnableActiveStandbySpoke.Lock()
+	mock.calls.EnableActiveStandbySpoke = append(mock.calls.EnableActiveStandbySpoke, callInfo)
+	mock.lockEnableActiveStandbySpoke.Unlock()
+	return mock.EnableActiveStandbySpokeFunc(spokeGateway)
+}
+
+// EnableActiveStandbySpokeCalls gets all the calls that were made to EnableActiveStandbySpoke.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableActiveStandbySpokeCalls())
+func (mock *GatewayClientMock) EnableActiveStandbySpokeCalls() []struct {
+	SpokeGateway *SpokeVpc
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+	}
+	mock.lockEnableActiveStandbySpoke.RLock()
+	calls = mock.calls.EnableActiveStandbySpoke
+	mock.lockEnableActiveStandbySpoke.RUnlock()
+	return calls
+}
+
+// EnableAdvertiseTransitCidr calls EnableAdvertiseTransitCidrFunc.
+func (mock *GatewayClientMock) EnableAdvertiseTransitCidr(transitGw *TransitVpc) error {
+	if mock.EnableAdvertiseTransitCidrFunc == nil {
+		panic("GatewayClientMock.EnableAdvertiseTransitCidrFunc: method is nil but GatewayClient.EnableAdvertiseTransitCidr was just called")
+	}
+	callInfo := struct {
+		TransitGw *TransitVpc
+	}{
+		TransitGw: transitGw,
+	}
+	mock.lockEnableAdvertiseTransitCidr.Lock()
+	mock.calls.EnableAdvertiseTransitCidr = append(mock.calls.EnableAdvertiseTransitCidr, callInfo)
+	mock.lockEnableAdvertiseTransitCidr.Unlock()
+	return mock.EnableAdvertiseTransitCidrFunc(transitGw)
+}
+
+// EnableAdvertiseTransitCidrCalls gets all the calls that were made to EnableAdvertiseTransitCidr.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableAdvertiseTransitCidrCalls())
+func (mock *GatewayClientMock) EnableAdvertiseTransitCidrCalls() []struct {
+	TransitGw *TransitVpc
+} {
+	var calls []struct {
+		TransitGw *TransitVpc
+	}
+	mock.lockEnableAdvertiseTransitCidr.RLock()
+	calls = mock.calls.EnableAdvertiseTransitCidr
+	mock.lockEnableAdvertiseTransitCidr.RUnlock()
+	return calls
+}
+
+// EnableAdvertiseTransitCidrGatewayGroup calls EnableAdvertiseTransitCidrGatewayGroupFunc.
+func (mock *GatewayClientMock) EnableAdvertiseTransitCidrGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableAdvertiseTransitCidrGatewayGroupFunc == nil {
+		panic("GatewayClientMock.EnableAdvertiseTransitCidrGatewayGroupFunc: method is nil but GatewayClient.EnableAdvertiseTransitCidrGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableAdvertiseTransitCidrGatewayGroup.Lock()
+	mock.calls.EnableAdvertiseTransitCidrGatewayGroup = append(mock.calls.EnableAdvertiseTransitCidrGatewayGroup, callInfo)
+	mock.lockEnableAdvertiseTransitCidrGatewayGroup.Unlock()
+	return mock.EnableAdvertiseTransitCidrGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableAdvertiseTransitCidrGatewayGroupCalls gets all the calls that were made to EnableAdvertiseTransitCidrGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableAdvertiseTransitCidrGatewayGroupCalls())
+func (mock *GatewayClientMock) EnableAdvertiseTransitCidrGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableAdvertiseTransitCidrGatewayGroup.RLock()
+	calls = mock.calls.EnableAdvertiseTransitCidrGatewayGroup
+	mock.lockEnableAdvertiseTransitCidrGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableAutoAdvertiseS2CCidrs calls EnableAutoAdvertiseS2CCidrsFunc.
+func (mock *GatewayClientMock) EnableAutoAdvertiseS2CCidrs(gateway *Gateway) error {
+	if mock.EnableAutoAdvertiseS2CCidrsFunc == nil {
+		panic("GatewayClientMock.EnableAutoAdvertiseS2CCidrsFunc: method is nil but GatewayClient.EnableAutoAdvertiseS2CCidrs was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableAutoAdvertiseS2CCidrs.Lock()
+	mock.calls.EnableAutoAdvertiseS2CCidrs = append(mock.calls.EnableAutoAdvertiseS2CCidrs, callInfo)
+	mock.lockEnableAutoAdvertiseS2CCidrs.Unlock()
+	return mock.EnableAutoAdvertiseS2CCidrsFunc(gateway)
+}
+
+// EnableAutoAdvertiseS2CCidrsCalls gets all the calls that were made to EnableAutoAdvertiseS2CCidrs.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableAutoAdvertiseS2CCidrsCalls())
+func (mock *GatewayClientMock) EnableAutoAdvertiseS2CCidrsCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableAutoAdvertiseS2CCidrs.RLock()
+	calls = mock.calls.EnableAutoAdvertiseS2CCidrs
+	mock.lockEnableAutoAdvertiseS2CCidrs.RUnlock()
+	return calls
+}
+
+// EnableAutoAdvertiseS2CCidrsGatewayGroup calls EnableAutoAdvertiseS2CCidrsGatewayGroupFunc.
+func (mock *GatewayClientMock) EnableAutoAdvertiseS2CCidrsGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableAutoAdvertiseS2CCidrsGatewayGroupFunc == nil {
+		panic("GatewayClientMock.EnableAutoAdvertiseS2CCidrsGatewayGroupFunc: method is nil but GatewayClient.EnableAutoAdvertiseS2CCidrsGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableAutoAdvertiseS2CCidrsGatewayGroup.Lock()
+	mock.calls.EnableAutoAdvertiseS2CCidrsGatewayGroup = append(mock.calls.EnableAutoAdvertiseS2CCidrsGatewayGroup, callInfo)
+	mock.lockEnableAutoAdvertiseS2CCidrsGatewayGroup.Unlock()
+	return mock.EnableAutoAdvertiseS2CCidrsGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableAutoAdvertiseS2CCidrsGatewayGroupCalls gets all the calls that were made to EnableAutoAdvertiseS2CCidrsGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableAutoAdvertiseS2CCidrsGatewayGroupCalls())
+func (mock *GatewayClientMock) EnableAutoAdvertiseS2CCidrsGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableAutoAdvertiseS2CCidrsGatewayGroup.RLock()
+	calls = mock.calls.EnableAutoAdvertiseS2CCidrsGatewayGroup
+	mock.lockEnableAutoAdvertiseS2CCidrsGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableConnectedTransit calls EnableConnectedTransitFunc.
+func (mock *GatewayClientMock) EnableConnectedTransit(gateway *TransitVpc) error {
+	if mock.EnableConnectedTransitFunc == nil {
+		panic("GatewayClientMock.EnableConnectedTransitFunc: method is nil but GatewayClient.EnableConnectedTransit was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableConnectedTransit.Lock()
+	mock.calls.EnableConnectedTransit = append(mock.calls.EnableConnectedTransit, callInfo)
+	mock.lockEnableConnectedTransit.Unlock()
+	return mock.EnableConnectedTransitFunc(gateway)
+}
+
+// EnableConnectedTransitCalls gets all the calls that were made to EnableConnectedTransit.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableConnectedTransitCalls())
+func (mock *GatewayClientMock) EnableConnectedTransitCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockEnableConnectedTransit.RLock()
+	calls = mock.calls.EnableConnectedTransit
+	mock.lockEnableConnectedTransit.RUnlock()
+	return calls
+}
+
+// EnableConnectedTransitGatewayGroup calls EnableConnectedTransitGatewayGroupFunc.
+func (mock *GatewayClientMock) EnableConnectedTransitGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableConnectedTransitGatewayGroupFunc == nil {
+		panic("GatewayClientMock.EnableConnectedTransitGatewayGroupFunc: method is nil but GatewayClient.EnableConnectedTransitGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableConnectedTransitGatewayGroup.Lock()
+	mock.calls.EnableConnectedTransitGatewayGroup = append(mock.calls.EnableConnectedTransitGatewayGroup, callInfo)
+	mock.lockEnableConnectedTransitGatewayGroup.Unlock()
+	return mock.EnableConnectedTransitGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableConnectedTransitGatewayGroupCalls gets all the calls that were made to EnableConnectedTransitGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableConnectedTransitGatewayGroupCalls())
+func (mock *GatewayClientMock) EnableConnectedTransitGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableConnectedTransitGatewayGroup.RLock()
+	calls = mock.calls.EnableConnectedTransitGatewayGroup
+	mock.lockEnableConnectedTransitGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableCustomizedSNat calls EnableCustomizedSNatFunc.
+func (mock *GatewayClientMock) EnableCustomizedSNat(gateway *Gateway) error {
+	if mock.EnableCustomizedSNatFunc == nil {
+		panic("GatewayClientMock.EnableCustomizedSNatFunc: method is nil but GatewayClient.EnableCustomizedSNat was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableCustomizedSNat.Lock()
+	mock.calls.EnableCustomizedSNat = append(mock.calls.EnableCustomizedSNat, callInfo)
+	mock.lockEnableCustomizedSNat.Unlock()
+	return mock.EnableCustomizedSNatFunc(gateway)
+}
+
+// EnableCustomizedSNatCalls gets all the calls that were made to EnableCustomizedSNat.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableCustomizedSNatCalls())
+func (mock *GatewayClientMock) EnableCustomizedSNatCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableCustomizedSNat.RLock()
+	calls = mock.calls.EnableCustomizedSNat
+	mock.lockEnableCustomizedSNat.RUnlock()
+	return calls
+}
+
+// EnableEgressTransitFirenet calls EnableEgressTransitFirenetFunc.
+func (mock *GatewayClientMock) EnableEgressTransitFirenet(transitGateway *TransitVpc) error {
+	if mock.EnableEgressTransitFirenetFunc == nil {
+		panic("GatewayClientMock.EnableEgressTransitFirenetFunc: method is nil but GatewayClient.EnableEgressTransitFirenet was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockEnableEgressTransitFirenet.Lock()
+	mock.calls.EnableEgressTransitFirenet = append(mock.calls.EnableEgressTransitFirenet, callInfo)
+	mock.lockEnableEgressTransitFirenet.Unlock()
+	return mock.EnableEgressTransitFirenetFunc(transitGateway)
+}
+
+// EnableEgressTransitFirenetCalls gets all the calls that were made to EnableEgressTransitFirenet.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableEgressTransitFirenetCalls())
+func (mock *GatewayClientMock) EnableEgressTransitFirenetCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockEnableEgressTransitFirenet.RLock()
+	calls = mock.calls.EnableEgressTransitFirenet
+	mock.lockEnableEgressTransitFirenet.RUnlock()
+	return calls
+}
+
+// EnableEncryptVolume calls EnableEncryptVolumeFunc.
+func (mock *GatewayClientMock) EnableEncryptVolume(gateway *Gateway) error {
+	if mock.EnableEncryptVolumeFunc == nil {
+		panic("GatewayClientMock.EnableEncryptVolumeFunc: method is nil but GatewayClient.EnableEncryptVolume was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableEncryptVolume.Lock()
+	mock.calls.EnableEncryptVolume = append(mock.calls.EnableEncryptVolume, callInfo)
+	mock.lockEnableEncryptVolume.Unlock()
+	return mock.EnableEncryptVolumeFunc(gateway)
+}
+
+// EnableEncryptVolumeCalls gets all the calls that were made to EnableEncryptVolume.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableEncryptVolumeCalls())
+func (mock *GatewayClientMock) EnableEncryptVolumeCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableEncryptVolume.RLock()
+	calls = mock.calls.EnableEncryptVolume
+	mock.lockEnableEncryptVolume.RUnlock()
+	return calls
+}
+
+// EnableFireNetGatewayGroup calls EnableFireNetGatewayGroupFunc.
+func (mock *GatewayClientMock) EnableFireNetGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableFireNetGatewayGroupFunc == nil {
+		panic("GatewayClientMock.EnableFireNetGatewayGroupFunc: method is nil but GatewayClient.EnableFireNetGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableFireNetGatewayGroup.Lock()
+	mock.calls.EnableFireNetGatewayGroup = append(mock.calls.EnableFireNetGatewayGroup, callInfo)
+	mock.lockEnableFireNetGatewayGroup.Unlock()
+	return mock.EnableFireNetGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableFireNetGatewayGroupCalls gets all the calls that were made to EnableFireNetGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableFireNetGatewayGroupCalls())
+func (mock *GatewayClientMock) EnableFireNetGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableFireNetGatewayGroup.RLock()
+	calls = mock.calls.EnableFireNetGatewayGroup
+	mock.lockEnableFireNetGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableGatewayFireNetInterfaces calls EnableGatewayFireNetInterfacesFunc.
+func (mock *GatewayClientMock) EnableGatewayFireNetInterfaces(gateway *TransitVpc) error {
+	if mock.EnableGatewayFireNetInterfacesFunc == nil {
+		panic("GatewayClientMock.EnableGatewayFireNetInterfacesFunc: method is nil but GatewayClient.EnableGatewayFireNetInterfaces was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableGatewayFireNetInterfaces.Lock()
+	mock.calls.EnableGatewayFireNetInterfaces = append(mock.calls.EnableGatewayFireNetInterfaces, callInfo)
+	mock.lockEnableGatewayFireNetInterfaces.Unlock()
+	return mock.EnableGatewayFireNetInterfacesFunc(gateway)
+}
+
+// EnableGatewayFireNetInterfacesCalls gets all the calls that were made to EnableGatewayFireNetInterfaces.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableGatewayFireNetInterfacesCalls())
+func (mock *GatewayClientMock) EnableGatewayFireNetInterfacesCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockEnableGatewayFireNetInterfaces.RLock()
+	calls = mock.calls.EnableGatewayFireNetInterfaces
+	mock.lockEnableGatewayFireNetInterfaces.RUnlock()
+	return calls
+}
+
+// EnableGatewayFireNetInterfacesWithGWLB calls EnableGatewayFireNetInterfacesWithGWLBFunc.
+func (mock *GatewayClientMock) EnableGatewayFireNetInterfacesWithGWLB(gateway *TransitVpc) error {
+	if mock.EnableGatewayFireNetInterfacesWithGWLBFunc == nil {
+		panic("GatewayClientMock.EnableGatewayFireNetInterfacesWithGWLBFunc: method is nil but GatewayClient.EnableGatewayFireNetInterfacesWithGWLB was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableGatewayFireNetInterfacesWithGWLB.Lock()
+	mock.calls.EnableGatewayFireNetInterfacesWithGWLB = append(mock.calls.EnableGatewayFireNetInterfacesWithGWLB, callInfo)
+	mock.lockEnableGatewayFireNetInterfacesWithGWLB.Unlock()
+	return mock.EnableGatewayFireNetInterfacesWithGWLBFunc(gateway)
+}
+
+// EnableGatewayFireNetInterfacesWithGWLBCalls gets all the calls that were made to EnableGatewayFireNetInterfacesWithGWLB.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableGatewayFireNetInterfacesWithGWLBCalls())
+func (mock *GatewayClientMock) EnableGatewayFireNetInterfacesWithGWLBCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockEnableGatewayFireNetInterfacesWithGWLB.RLock()
+	calls = mock.calls.EnableGatewayFireNetInterfacesWithGWLB
+	mock.lockEnableGatewayFireNetInterfacesWithGWLB.RUnlock()
+	return calls
+}
+
+// EnableGatewayGroupSNat calls EnableGatewayGroupSNatFunc.
+func (mock *GatewayClientMock) EnableGatewayGroupSNat(ctx context.Context, groupName string) error {
+	if mock.EnableGatewayGroupSNatFunc == nil {
+		panic("GatewayClientMock.EnableGatewayGroupSNatFunc: method is nil but GatewayClient.EnableGatewayGroupSNat was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableGatewayGroupSNat.Lock()
+	mock.calls.EnableGatewayGroupSNat = append(mock.calls.EnableGatewayGroupSNat, callInfo)
+	mock.lockEnableGatewayGroupSNat.Unlock()
+	return mock.EnableGatewayGroupSNatFunc(ctx, groupName)
+}
+
+// EnableGatewayGroupSNatCalls gets all the calls that were made to EnableGatewayGroupSNat.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableGatewayGroupSNatCalls())
+func (mock *GatewayClientMock) EnableGatewayGroupSNatCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableGatewayGroupSNat.RLock()
+	calls = mock.calls.EnableGatewayGroupSNat
+	mock.lockEnableGatewayGroupSNat.RUnlock()
+	return calls
+}
+
+// EnableGatewayGroupVpcDNSServer calls EnableGatewayGroupVpcDNSServerFunc.
+func (mock *GatewayClientMock) EnableGatewayGroupVpcDNSServer(ctx context.Context, groupName string) error {
+	if mock.EnableGatewayGroupVpcDNSServerFunc == nil {
+		panic("GatewayClientMock.EnableGatewayGroupVpcDNSServerFunc: method is nil but GatewayClient.EnableGatewayGroupVpcDNSServer was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableGatewayGroupVpcDNSServer.Lock()
+	mock.calls.EnableGatewayGroupVpcDNSServer = append(mock.calls.EnableGatewayGroupVpcDNSServer, callInfo)
+	mock.lockEnableGatewayGroupVpcDNSServer.Unlock()
+	return mock.EnableGatewayGroupVpcDNSServerFunc(ctx, groupName)
+}
+
+// EnableGatewayGroupVpcDNSServerCalls gets all the calls that were made to EnableGatewayGroupVpcDNSServer.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableGatewayGroupVpcDNSServerCalls())
+func (mock *GatewayClientMock) EnableGatewayGroupVpcDNSServerCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableGatewayGroupVpcDNSServer.RLock()
+	calls = mock.calls.EnableGatewayGroupVpcDNSServer
+	mock.lockEnableGatewayGroupVpcDNSServer.RUnlock()
+	return calls
+}
+
+// EnableGatewayLoadBalancerGatewayGroup calls EnableGatewayLoadBalancerGatewayGroupFunc.
+func (mock *GatewayClientMock) EnableGatewayLoadBalancerGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableGatewayLoadBalancerGatewayGroupFunc == nil {
+		panic("GatewayClientMock.EnableGatewayLoadBalancerGatewayGroupFunc: method is nil but GatewayClient.EnableGatewayLoadBalancerGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableGatewayLoadBalancerGatewayGroup.Lock()
+	mock.calls.EnableGatewayLoadBalancerGatewayGroup = append(mock.calls.EnableGatewayLoadBalancerGatewayGroup, callInfo)
+	mock.lockEnableGatewayLoadBalancerGatewayGroup.Unlock()
+	return mock.EnableGatewayLoadBalancerGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableGatewayLoadBalancerGatewayGroupCalls gets all the calls that were made to EnableGatewayLoadBalancerGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableGatewayLoadBalancerGatewayGroupCalls())
+func (mock *GatewayClientMock) EnableGatewayLoadBalancerGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableGatewayLoadBalancerGatewayGroup.RLock()
+	calls = mock.calls.EnableGatewayLoadBalancerGatewayGroup
+	mock.lockEnableGatewayLoadBalancerGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableGlobalVpc calls EnableGlobalVpcFunc.
+func (mock *GatewayClientMock) EnableGlobalVpc(gateway *Gateway) error {
+	if mock.EnableGlobalVpcFunc == nil {
+		panic("GatewayClientMock.EnableGlobalVpcFunc: method is nil but GatewayClient.EnableGlobalVpc was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableGlobalVpc.Lock()
+	mock.calls.EnableGlobalVpc = append(mock.calls.EnableGlobalVpc, callInfo)
+	mock.lockEnableGlobalVpc.Unlock()
+	return mock.EnableGlobalVpcFunc(gateway)
+}
+
+// EnableGlobalVpcCalls gets all the calls that were made to EnableGlobalVpc.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableGlobalVpcCalls())
+func (mock *GatewayClientMock) EnableGlobalVpcCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableGlobalVpc.RLock()
+	calls = mock.calls.EnableGlobalVpc
+	mock.lockEnableGlobalVpc.RUnlock()
+	return calls
+}
+
+// EnableGlobalVpcGatewayGroup calls EnableGlobalVpcGatewayGroupFunc.
+func (mock *GatewayClientMock) EnableGlobalVpcGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableGlobalVpcGatewayGroupFunc == nil {
+		panic("GatewayClientMock.EnableGlobalVpcGatewayGroupFunc: method is nil but GatewayClient.EnableGlobalVpcGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableGlobalVpcGatewayGroup.Lock()
+	mock.calls.EnableGlobalVpcGatewayGroup = append(mock.calls.EnableGlobalVpcGatewayGroup, callInfo)
+	mock.lockEnableGlobalVpcGatewayGroup.Unlock()
+	return mock.EnableGlobalVpcGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableGlobalVpcGatewayGroupCalls gets all the calls that were made to EnableGlobalVpcGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableGlobalVpcGatewayGroupCalls())
+func (mock *GatewayClientMock) EnableGlobalVpcGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableGlobalVpcGatewayGroup.RLock()
+	calls = mock.calls.EnableGlobalVpcGatewayGroup
+	mock.lockEnableGlobalVpcGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableGroGso calls EnableGroGsoFunc.
+func (mock *GatewayClientMock) EnableGroGso(gateway *Gateway) error {
+	if mock.EnableGroGsoFunc == nil {
+		panic("GatewayClientMock.EnableGroGsoFunc: method is nil but GatewayClient.EnableGroGso was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableGroGso.Lock()
+	mock.calls.EnableGroGso = append(mock.calls.EnableGroGso, callInfo)
+	mock.lockEnableGroGso.Unlock()
+	return mock.EnableGroGsoFunc(gateway)
+}
+
+// EnableGroGsoCalls gets all the calls that were made to EnableGroGso.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableGroGsoCalls())
+func (mock *GatewayClientMock) EnableGroGsoCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableGroGso.RLock()
+	calls = mock.calls.EnableGroGso
+	mock.lockEnableGroGso.RUnlock()
+	return calls
+}
+
+// EnableGroGsoGatewayGroup calls EnableGroGsoGatewayGroupFunc.
+func (mock *GatewayClientMock) EnableGroGsoGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableGroGsoGatewayGroupFunc == nil {
+		panic("GatewayClientMock.EnableGroGsoGatewayGroupFunc: method is nil but GatewayClient.EnableGroGsoGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableGroGsoGatewayGroup.Lock()
+	mock.calls.EnableGroGsoGatewayGroup = append(mock.calls.EnableGroGsoGatewayGroup, callInfo)
+	mock.lockEnableGroGsoGatewayGroup.Unlock()
+	return mock.EnableGroGsoGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableGroGsoGatewayGroupCalls gets all the calls that were made to EnableGroGsoGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableGroGsoGatewayGroupCalls())
+func (mock *GatewayClientMock) EnableGroGsoGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableGroGsoGatewayGroup.RLock()
+	calls = mock.calls.EnableGroGsoGatewayGroup
+	mock.lockEnableGroGsoGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableGuardDutyEnforcement calls EnableGuardDutyEnforcementFunc.
+func (mock *GatewayClientMock) EnableGuardDutyEnforcement(gateway *Gateway) error {
+	if mock.EnableGuardDutyEnforcementFunc == nil {
+		panic("GatewayClientMock.EnableGuardDutyEnforcementFunc: method is nil but GatewayClient.EnableGuardDutyEnforcement was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableGuardDutyEnforcement.Lock()
+	mock.calls.EnableGuardDutyEnforcement = append(mock.calls.EnableGuardDutyEnforcement, callInfo)
+	mock.lockEnableGuardDutyEnforcement.Unlock()
+	return mock.EnableGuardDutyEnforcementFunc(gateway)
+}
+
+// EnableGuardDutyEnforcementCalls gets all the calls that were made to EnableGuardDutyEnforcement.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableGuardDutyEnforcementCalls())
+func (mock *GatewayClientMock) EnableGuardDutyEnforcementCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableGuardDutyEnforcement.RLock()
+	calls = mock.calls.EnableGuardDutyEnforcement
+	mock.lockEnableGuardDutyEnforcement.RUnlock()
+	return calls
+}
+
+// EnableHybridConnectionGatewayGroup calls EnableHybridConnectionGatewayGroupFunc.
+func (mock *GatewayClientMock) EnableHybridConnectionGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableHybridConnectionGatewayGroupFunc == nil {
+		panic("GatewayClientMock.EnableHybridConnectionGatewayGroupFunc: method is nil but GatewayClient.EnableHybridConnectionGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableHybridConnectionGatewayGroup.Lock()
+	mock.calls.EnableHybridConnectionGatewayGroup = append(mock.calls.EnableHybridConnectionGatewayGroup, callInfo)
+	mock.lockEnableHybridConnectionGatewayGroup.Unlock()
+	return mock.EnableHybridConnectionGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableHybridConnectionGatewayGroupCalls gets all the calls that were made to EnableHybridConnectionGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableHybridConnectionGatewayGroupCalls())
+func (mock *GatewayClientMock) EnableHybridConnectionGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableHybridConnectionGatewayGroup.RLock()
+	calls = mock.calls.EnableHybridConnectionGatewayGroup
+	mock.lockEnableHybridConnectionGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableIPv6 calls EnableIPv6Func.
+func (mock *GatewayClientMock) EnableIPv6(gateway *Gateway) error {
+	if mock.EnableIPv6Func == nil {
+		panic("GatewayClientMock.EnableIPv6Func: method is nil but GatewayClient.EnableIPv6 was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableIPv6.Lock()
+	mock.calls.EnableIPv6 = append(mock.calls.EnableIPv6, callInfo)
+	mock.lockEnableIPv6.Unlock()
+	return mock.EnableIPv6Func(gateway)
+}
+
+// EnableIPv6Calls gets all the calls that were made to EnableIPv6.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableIPv6Calls())
+func (mock *GatewayClientMock) EnableIPv6Calls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableIPv6.RLock()
+	calls = mock.calls.EnableIPv6
+	mock.lockEnableIPv6.RUnlock()
+	return calls
+}
+
+// EnableIPv6GatewayGroup calls EnableIPv6GatewayGroupFunc.
+func (mock *GatewayClientMock) EnableIPv6GatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableIPv6GatewayGroupFunc == nil {
+		panic("GatewayClientMock.EnableIPv6GatewayGroupFunc: method is nil but GatewayClient.EnableIPv6GatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableIPv6GatewayGroup.Lock()
+	mock.calls.EnableIPv6GatewayGroup = append(mock.calls.EnableIPv6GatewayGroup, callInfo)
+	mock.lockEnableIPv6GatewayGroup.Unlock()
+	return mock.EnableIPv6GatewayGroupFunc(ctx, groupName)
+}
+
+// EnableIPv6GatewayGroupCalls gets all the calls that were made to EnableIPv6GatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableIPv6GatewayGroupCalls())
+func (mock *GatewayClientMock) EnableIPv6GatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableIPv6GatewayGroup.RLock()
+	calls = mock.calls.EnableIPv6GatewayGroup
+	mock.lockEnableIPv6GatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableJumboFrame calls EnableJumboFrameFunc.
+func (mock *GatewayClientMock) EnableJumboFrame(gateway *Gateway) error {
+	if mock.EnableJumboFrameFunc == nil {
+		panic("GatewayClientMock.EnableJumboFrameFunc: method is nil but GatewayClient.EnableJumboFrame was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableJumboFrame.Lock()
+	mock.calls.EnableJumboFrame = append(mock.calls.EnableJumboFrame, callInfo)
+	mock.lockEnableJumboFrame.Unlock()
+	return mock.EnableJumboFrameFunc(gateway)
+}
+
+// EnableJumboFrameCalls gets all the calls that were made to EnableJumboFrame.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableJumboFrameCalls())
+func (mock *GatewayClientMock) EnableJumboFrameCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableJumboFrame.RLock()
+	calls = mock.calls.EnableJumboFrame
+	mock.lockEnableJumboFrame.RUnlock()
+	return calls
+}
+
+// EnableJumboFrameGatewayGroup calls EnableJumboFrameGatewayGroupFunc.
+func (mock *GatewayClientMock) EnableJumboFrameGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableJumboFrameGatewayGroupFunc == nil {
+		panic("GatewayClientMock.EnableJumboFrameGatewayGroupFunc: method is nil but GatewayClient.EnableJumboFrameGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableJumboFrameGatewayGroup.Lock()
+	mock.calls.EnableJumboFrameGatewayGroup = append(mock.calls.EnableJumboFrameGatewayGroup, callInfo)
+	mock.lockEnableJumboFrameGatewayGroup.Unlock()
+	return mock.EnableJumboFrameGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableJumboFrameGatewayGroupCalls gets all the calls that were made to EnableJumboFrameGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableJumboFrameGatewayGroupCalls())
+func (mock *GatewayClientMock) EnableJumboFrameGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableJumboFrameGatewayGroup.RLock()
+	calls = mock.calls.EnableJumboFrameGatewayGroup
+	mock.lockEnableJumboFrameGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableMonitorGatewaySubnets calls EnableMonitorGatewaySubnetsFunc.
+func (mock *GatewayClientMock) EnableMonitorGatewaySubnets(gwName string, excludedInstances []string) error {
+	if mock.EnableMonitorGatewaySubnetsFunc == nil {
+		panic("GatewayClientMock.EnableMonitorGatewaySubnetsFunc: method is nil but GatewayClient.EnableMonitorGatewaySubnets was just called")
+	}
+	callInfo := struct {
+		GwName            string
+		ExcludedInstances []string
+	}{
+		GwName:            gwName,
+		ExcludedInstances: excludedInstances,
+	}
+	mock.lockEnableMonitorGatewaySubnets.Lock()
+	mock.calls.EnableMonitorGatewaySubnets = append(mock.calls.EnableMonitorGatewaySubnets, callInfo)
+	mock.lockEnableMonitorGatewaySubnets.Unlock()
+	return mock.EnableMonitorGatewaySubnetsFunc(gwName, excludedInstances)
+}
+
+// EnableMonitorGatewaySubnetsCalls gets all the calls that were made to EnableMonitorGatewaySubnets.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableMonitorGatewaySubnetsCalls())
+func (mock *GatewayClientMock) EnableMonitorGatewaySubnetsCalls() []struct {
+	GwName            string
+	ExcludedInstances []string
+} {
+	var calls []struct {
+		GwName            string
+		ExcludedInstances []string
+	}
+	mock.lockEnableMonitorGatewaySubnets.RLock()
+	calls = mock.calls.EnableMonitorGatewaySubnets
+	mock.lockEnableMonitorGatewaySubnets.RUnlock()
+	return calls
+}
+
+// EnableMultiTierTransitGatewayGroup calls EnableMultiTierTransitGatewayGroupFunc.
+func (mock *GatewayClientMock) EnableMultiTierTransitGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableMultiTierTransitGatewayGroupFunc == nil {
+		panic("GatewayClientMock.EnableMultiTierTransitGatewayGroupFunc: method is nil but GatewayClient.EnableMultiTierTransitGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableMultiTierTransitGatewayGroup.Lock()
+	mock.calls.EnableMultiTierTransitGatewayGroup = append(mock.calls.EnableMultiTierTransitGatewayGroup, callInfo)
+	mock.lockEnableMultiTierTransitGatewayGroup.Unlock()
+	return mock.EnableMultiTierTransitGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableMultiTierTransitGatewayGroupCalls gets all the calls that were made to EnableMultiTierTransitGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableMultiTierTransitGatewayGroupCalls())
+func (mock *GatewayClientMock) EnableMultiTierTransitGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableMultiTierTransitGatewayGroup.RLock()
+	calls = mock.calls.EnableMultiTierTransitGatewayGroup
+	mock.lockEnableMultiTierTransitGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableMultitierTransit calls EnableMultitierTransitFunc.
+func (mock *GatewayClientMock) EnableMultitierTransit(gwName string) error {
+	if mock.EnableMultitierTransitFunc == nil {
+		panic("GatewayClientMock.EnableMultitierTransitFunc: method is nil but GatewayClient.EnableMultitierTransit was just called")
+	}
+	callInfo := struct {
+		GwName string
+	}{
+		GwName: gwName,
+	}
+	mock.lockEnableMultitierTransit.Lock()
+	mock.calls.EnableMultitierTransit = append(mock.calls.EnableMultitierTransit, callInfo)
+	mock.lockEnableMultitierTransit.Unlock()
+	return mock.EnableMultitierTransitFunc(gwName)
+}
+
+// EnableMultitierTransitCalls gets all the calls that were made to EnableMultitierTransit.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableMultitierTransitCalls())
+func (mock *GatewayClientMock) EnableMultitierTransitCalls() []struct {
+	GwName string
+} {
+	var calls []struct {
+		GwName string
+	}
+	mock.lockEnableMultitierTransit.RLock()
+	calls = mock.calls.EnableMultitierTransit
+	mock.lockEnableMultitierTransit.RUnlock()
+	return calls
+}
+
+// EnablePeeringHaGateway calls EnablePeeringHaGatewayFunc.
+func (mock *GatewayClientMock) EnablePeeringHaGateway(gateway *Gateway) error {
+	if mock.EnablePeeringHaGatewayFunc == nil {
+		panic("GatewayClientMock.EnablePeeringHaGatewayFunc: method is nil but GatewayClient.EnablePeeringHaGateway was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnablePeeringHaGateway.Lock()
+	mock.calls.EnablePeeringHaGateway = append(mock.calls.EnablePeeringHaGateway, callInfo)
+	mock.lockEnablePeeringHaGateway.Unlock()
+	return mock.EnablePeeringHaGatewayFunc(gateway)
+}
+
+// EnablePeeringHaGatewayCalls gets all the calls that were made to EnablePeeringHaGateway.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnablePeeringHaGatewayCalls())
+func (mock *GatewayClientMock) EnablePeeringHaGatewayCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnablePeeringHaGateway.RLock()
+	calls = mock.calls.EnablePeeringHaGateway
+	mock.lockEnablePeeringHaGateway.RUnlock()
+	return calls
+}
+
+// EnablePrivateVpcDefaultRoute calls EnablePrivateVpcDefaultRouteFunc.
+func (mock *GatewayClientMock) EnablePrivateVpcDefaultRoute(gw *Gateway) error {
+	if mock.EnablePrivateVpcDefaultRouteFunc == nil {
+		panic("GatewayClientMock.EnablePrivateVpcDefaultRouteFunc: method is nil but GatewayClient.EnablePrivateVpcDefaultRoute was just called")
+	}
+	callInfo := struct {
+		Gw *Gateway
+	}{
+		Gw: gw,
+	}
+	mock.lockEnablePrivateVpcDefaultRoute.Lock()
+	mock.calls.EnablePrivateVpcDefaultRoute = append(mock.calls.EnablePrivateVpcDefaultRoute, callInfo)
+	mock.lockEnablePrivateVpcDefaultRoute.Unlock()
+	return mock.EnablePrivateVpcDefaultRouteFunc(gw)
+}
+
+// EnablePrivateVpcDefaultRouteCalls gets all the calls that were made to EnablePrivateVpcDefaultRoute.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnablePrivateVpcDefaultRouteCalls())
+func (mock *GatewayClientMock) EnablePrivateVpcDefaultRouteCalls() []struct {
+	Gw *Gateway
+} {
+	var calls []struct {
+		Gw *Gateway
+	}
+	mock.lockEnablePrivateVpcDefaultRoute.RLock()
+	calls = mock.calls.EnablePrivateVpcDefaultRoute
+	mock.lockEnablePrivateVpcDefaultRoute.RUnlock()
+	return calls
+}
+
+// EnablePrivateVpcDefaultRouteGatewayGroup calls EnablePrivateVpcDefaultRouteGatewayGroupFunc.
+func (mock *GatewayClientMock) EnablePrivateVpcDefaultRouteGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnablePrivateVpcDefaultRouteGatewayGroupFunc == nil {
+		panic("GatewayClientMock.EnablePrivateVpcDefaultRouteGatewayGroupFunc: method is nil but GatewayClient.EnablePrivateVpcDefaultRouteGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnablePrivateVpcDefaultRouteGatewayGroup.Lock()
+	mock.calls.EnablePrivateVpcDefaultRouteGatewayGroup = append(mock.calls.EnablePrivateVpcDefaultRouteGatewayGroup, callInfo)
+	mock.lockEnablePrivateVpcDefaultRouteGatewayGroup.Unlock()
+	return mock.EnablePrivateVpcDefaultRouteGatewayGroupFunc(ctx, groupName)
+}
+
+// EnablePrivateVpcDefaultRouteGatewayGroupCalls gets all the calls that were made to EnablePrivateVpcDefaultRouteGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnablePrivateVpcDefaultRouteGatewayGroupCalls())
+func (mock *GatewayClientMock) EnablePrivateVpcDefaultRouteGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnablePrivateVpcDefaultRouteGatewayGroup.RLock()
+	calls = mock.calls.EnablePrivateVpcDefaultRouteGatewayGroup
+	mock.lockEnablePrivateVpcDefaultRouteGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnablePublicSubnetFilteringHAGateway calls EnablePublicSubnetFilteringHAGatewayFunc.
+func (mock *GatewayClientMock) EnablePublicSubnetFilteringHAGateway(gateway *Gateway) error {
+	if mock.EnablePublicSubnetFilteringHAGatewayFunc == nil {
+		panic("GatewayClientMock.EnablePublicSubnetFilteringHAGatewayFunc: method is nil but GatewayClient.EnablePublicSubnetFilteringHAGateway was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnablePublicSubnetFilteringHAGateway.Lock()
+	mock.calls.EnablePublicSubnetFilteringHAGateway = append(mock.calls.EnablePublicSubnetFilteringHAGateway, callInfo)
+	mock.lockEnablePublicSubnetFilteringHAGateway.Unlock()
+	return mock.EnablePublicSubnetFilteringHAGatewayFunc(gateway)
+}
+
+// EnablePublicSubnetFilteringHAGatewayCalls gets all the calls that were made to EnablePublicSubnetFilteringHAGateway.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnablePublicSubnetFilteringHAGatewayCalls())
+func (mock *GatewayClientMock) EnablePublicSubnetFilteringHAGatewayCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnablePublicSubnetFilteringHAGateway.RLock()
+	calls = mock.calls.EnablePublicSubnetFilteringHAGateway
+	mock.lockEnablePublicSubnetFilteringHAGateway.RUnlock()
+	return calls
+}
+
+// EnableS2CRxBalancing calls EnableS2CRxBalancingFunc.
+func (mock *GatewayClientMock) EnableS2CRxBalancing(gwName string) error {
+	if mock.EnableS2CRxBalancingFunc == nil {
+		panic("GatewayClientMock.EnableS2CRxBalancingFunc: method is nil but GatewayClient.EnableS2CRxBalancing was just called")
+	}
+	callInfo := struct {
+		GwName string
+	}{
+		GwName: gwName,
+	}
+	mock.lockEnableS2CRxBalancing.Lock()
+	mock.calls.EnableS2CRxBalancing = append(mock.calls.EnableS2CRxBalancing, callInfo)
+	mock.lockEnableS2CRxBalancing.Unlock()
+	return mock.EnableS2CRxBalancingFunc(gwName)
+}
+
+// EnableS2CRxBalancingCalls gets all the calls that were made to EnableS2CRxBalancing.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableS2CRxBalancingCalls())
+func (mock *GatewayClientMock) EnableS2CRxBalancingCalls() []struct {
+	GwName string
+} {
+	var calls []struct {
+		GwName string
+	}
+	mock.lockEnableS2CRxBalancing.RLock()
+	calls = mock.calls.EnableS2CRxBalancing
+	mock.lockEnableS2CRxBalancing.RUnlock()
+	return calls
+}
+
+// EnableS2cRxBalancingGatewayGroup calls EnableS2cRxBalancingGatewayGroupFunc.
+func (mock *GatewayClientMock) EnableS2cRxBalancingGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableS2cRxBalancingGatewayGroupFunc == nil {
+		panic("GatewayClientMock.EnableS2cRxBalancingGatewayGroupFunc: method is nil but GatewayClient.EnableS2cRxBalancingGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableS2cRxBalancingGatewayGroup.Lock()
+	mock.calls.EnableS2cRxBalancingGatewayGroup = append(mock.calls.EnableS2cRxBalancingGatewayGroup, callInfo)
+	mock.lockEnableS2cRxBalancingGatewayGroup.Unlock()
+	return mock.EnableS2cRxBalancingGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableS2cRxBalancingGatewayGroupCalls gets all the calls that were made to EnableS2cRxBalancingGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableS2cRxBalancingGatewayGroupCalls())
+func (mock *GatewayClientMock) EnableS2cRxBalancingGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableS2cRxBalancingGatewayGroup.RLock()
+	calls = mock.calls.EnableS2cRxBalancingGatewayGroup
+	mock.lockEnableS2cRxBalancingGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableSNat calls EnableSNatFunc.
+func (mock *GatewayClientMock) EnableSNat(gateway *Gateway) error {
+	if mock.EnableSNatFunc == nil {
+		panic("GatewayClientMock.EnableSNatFunc: method is nil but GatewayClient.EnableSNat was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableSNat.Lock()
+	mock.calls.EnableSNat = append(mock.calls.EnableSNat, callInfo)
+	mock.lockEnableSNat.Unlock()
+	return mock.EnableSNatFunc(gateway)
+}
+
+// EnableSNatCalls gets all the calls that were made to EnableSNat.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableSNatCalls())
+func (mock *GatewayClientMock) EnableSNatCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableSNat.RLock()
+	calls = mock.calls.EnableSNat
+	mock.lockEnableSNat.RUnlock()
+	return calls
+}
+
+// EnableSegmentation calls EnableSegmentationFunc.
+func (mock *GatewayClientMock) EnableSegmentation(transitGateway *TransitVpc) error {
+	if mock.EnableSegmentationFunc == nil {
+		panic("GatewayClientMock.EnableSegmentationFunc: method is nil but GatewayClient.EnableSegmentation was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockEnableSegmentation.Lock()
+	mock.calls.EnableSegmentation = append(mock.calls.EnableSegmentation, callInfo)
+	mock.lockEnableSegmentation.Unlock()
+	return mock.EnableSegmentationFunc(transitGateway)
+}
+
+// EnableSegmentationCalls gets all the calls that were made to EnableSegmentation.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableSegmentationCalls())
+func (mock *GatewayClientMock) EnableSegmentationCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockEnableSegmentation.RLock()
+	calls = mock.calls.EnableSegmentation
+	mock.lockEnableSegmentation.RUnlock()
+	return calls
+}
+
+// EnableSegmentationGatewayGroup calls EnableSegmentationGatewayGroupFunc.
+func (mock *GatewayClientMock) EnableSegmentationGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableSegmentationGatewayGroupFunc == nil {
+		panic("GatewayClientMock.EnableSegmentationGatewayGroupFunc: method is nil but GatewayClient.EnableSegmentationGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableSegmentationGatewayGroup.Lock()
+	mock.calls.EnableSegmentationGatewayGroup = append(mock.calls.EnableSegmentationGatewayGroup, callInfo)
+	mock.lockEnableSegmentationGatewayGroup.Unlock()
+	return mock.EnableSegmentationGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableSegmentationGatewayGroupCalls gets all the calls that were made to EnableSegmentationGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableSegmentationGatewayGroupCalls())
+func (mock *GatewayClientMock) EnableSegmentationGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableSegmentationGatewayGroup.RLock()
+	calls = mock.calls.EnableSegmentationGatewayGroup
+	mock.lockEnableSegmentationGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableSingleAZGateway calls EnableSingleAZGatewayFunc.
+func (mock *GatewayClientMock) EnableSingleAZGateway(gateway *Gateway) error {
+	if mock.EnableSingleAZGatewayFunc == nil {
+		panic("GatewayClientMock.EnableSingleAZGatewayFunc: method is nil but GatewayClient.EnableSingleAZGateway was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableSingleAZGateway.Lock()
+	mock.calls.EnableSingleAZGateway = append(mock.calls.EnableSingleAZGateway, callInfo)
+	mock.lockEnableSingleAZGateway.Unlock()
+	return mock.EnableSingleAZGatewayFunc(gateway)
+}
+
+// EnableSingleAZGatewayCalls gets all the calls that were made to EnableSingleAZGateway.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableSingleAZGatewayCalls())
+func (mock *GatewayClientMock) EnableSingleAZGatewayCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableSingleAZGateway.RLock()
+	calls = mock.calls.EnableSingleAZGateway
+	mock.lockEnableSingleAZGateway.RUnlock()
+	return calls
+}
+
+// EnableSkipPublicRouteUpdate calls EnableSkipPublicRouteUpdateFunc.
+func (mock *GatewayClientMock) EnableSkipPublicRouteUpdate(gw *Gateway) error {
+	if mock.EnableSkipPublicRouteUpdateFunc == nil {
+		panic("GatewayClientMock.EnableSkipPublicRouteUpdateFunc: method is nil but GatewayClient.EnableSkipPublicRouteUpdate was just called")
+	}
+	callInfo := struct {
+		Gw *Gateway
+	}{
+		Gw: gw,
+	}
+	mock.lockEnableSkipPublicRouteUpdate.Lock()
+	mock.calls.EnableSkipPublicRouteUpdate = append(mock.calls.EnableSkipPublicRouteUpdate, callInfo)
+	mock.lockEnableSkipPublicRouteUpdate.Unlock()
+	return mock.EnableSkipPublicRouteUpdateFunc(gw)
+}
+
+// EnableSkipPublicRouteUpdateCalls gets all the calls that were made to EnableSkipPublicRouteUpdate.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableSkipPublicRouteUpdateCalls())
+func (mock *GatewayClientMock) EnableSkipPublicRouteUpdateCalls() []struct {
+	Gw *Gateway
+} {
+	var calls []struct {
+		Gw *Gateway
+	}
+	mock.lockEnableSkipPublicRouteUpdate.RLock()
+	calls = mock.calls.EnableSkipPublicRouteUpdate
+	mock.lockEnableSkipPublicRouteUpdate.RUnlock()
+	return calls
+}
+
+// EnableSkipPublicRouteUpdateGatewayGroup calls EnableSkipPublicRouteUpdateGatewayGroupFunc.
+func (mock *GatewayClientMock) EnableSkipPublicRouteUpdateGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableSkipPublicRouteUpdateGatewayGroupFunc == nil {
+		panic("GatewayClientMock.EnableSkipPublicRouteUpdateGatewayGroupFunc: method is nil but GatewayClient.EnableSkipPublicRouteUpdateGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableSkipPublicRouteUpdateGatewayGroup.Lock()
+	mock.calls.EnableSkipPublicRouteUpdateGatewayGroup = append(mock.calls.EnableSkipPublicRouteUpdateGatewayGroup, callInfo)
+	mock.lockEnableSkipPublicRouteUpdateGatewayGroup.Unlock()
+	return mock.EnableSkipPublicRouteUpdateGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableSkipPublicRouteUpdateGatewayGroupCalls gets all the calls that were made to EnableSkipPublicRouteUpdateGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableSkipPublicRouteUpdateGatewayGroupCalls())
+func (mock *GatewayClientMock) EnableSkipPublicRouteUpdateGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableSkipPublicRouteUpdateGatewayGroup.RLock()
+	calls = mock.calls.EnableSkipPublicRouteUpdateGatewayGroup
+	mock.lockEnableSkipPublicRouteUpdateGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableSpokeLearnedCidrsApproval calls EnableSpokeLearnedCidrsApprovalFunc.
+func (mock *GatewayClientMock) EnableSpokeLearnedCidrsApproval(gateway *SpokeVpc) error {
+	if mock.EnableSpokeLearnedCidrsApprovalFunc == nil {
+		panic("GatewayClientMock.EnableSpokeLearnedCidrsApprovalFunc: method is nil but GatewayClient.EnableSpokeLearnedCidrsApproval was just called")
+	}
+	callInfo := struct {
+		Gateway *SpokeVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableSpokeLearnedCidrsApproval.Lock()
+	mock.calls.EnableSpokeLearnedCidrsApproval = append(mock.calls.EnableSpokeLearnedCidrsApproval, callInfo)
+	mock.lockEnableSpokeLearnedCidrsApproval.Unlock()
+	return mock.EnableSpokeLearnedCidrsApprovalFunc(gateway)
+}
+
+// EnableSpokeLearnedCidrsApprovalCalls gets all the calls that were made to EnableSpokeLearnedCidrsApproval.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableSpokeLearnedCidrsApprovalCalls())
+func (mock *GatewayClientMock) EnableSpokeLearnedCidrsApprovalCalls() []struct {
+	Gateway *SpokeVpc
+} {
+	var calls []struct {
+		Gateway *SpokeVpc
+	}
+	mock.lockEnableSpokeLearnedCidrsApproval.RLock()
+	calls = mock.calls.EnableSpokeLearnedCidrsApproval
+	mock.lockEnableSpokeLearnedCidrsApproval.RUnlock()
+	return calls
+}
+
+// EnableSpokeLearnedCidrsApprovalGatewayGroup calls EnableSpokeLearnedCidrsApprovalGatewayGroupFunc.
+func (mock *GatewayClientMock) EnableSpokeLearnedCidrsApprovalGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableSpokeLearnedCidrsApprovalGatewayGroupFunc == nil {
+		panic("GatewayClientMock.EnableSpokeLearnedCidrsApprovalGatewayGroupFunc: method is nil but GatewayClient.EnableSpokeLearnedCidrsApprovalGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableSpokeLearnedCidrsApprovalGatewayGroup.Lock()
+	mock.calls.EnableSpokeLearnedCidrsApprovalGatewayGroup = append(mock.calls.EnableSpokeLearnedCidrsApprovalGatewayGroup, callInfo)
+	mock.lockEnableSpokeLearnedCidrsApprovalGatewayGroup.Unlock()
+	return mock.EnableSpokeLearnedCidrsApprovalGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableSpokeLearnedCidrsApprovalGatewayGroupCalls gets all the calls that were made to EnableSpokeLearnedCidrsApprovalGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableSpokeLearnedCidrsApprovalGatewayGroupCalls())
+func (mock *GatewayClientMock) EnableSpokeLearnedCidrsApprovalGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableSpokeLearnedCidrsApprovalGatewayGroup.RLock()
+	calls = mock.calls.EnableSpokeLearnedCidrsApprovalGatewayGroup
+	mock.lockEnableSpokeLearnedCidrsApprovalGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableSpokeOnpremRoutePropagation calls EnableSpokeOnpremRoutePropagationFunc.
+func (mock *GatewayClientMock) EnableSpokeOnpremRoutePropagation(spokeGateway *SpokeVpc) error {
+	if mock.EnableSpokeOnpremRoutePropagationFunc == nil {
+		panic("GatewayClientMock.EnableSpokeOnpremRoutePropagationFunc: method is nil but GatewayClient.EnableSpokeOnpremRoutePropagation was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+	}{
+		SpokeGateway: spokeGateway,
+	}
+	mock.lockEnableSpokeOnpremRoutePropagation.Lock()
+	mock.calls.EnableSpokeOnpremRoutePropagation = append(mock.calls.EnableSpokeOnpremRoutePropagation, callInfo)
+	mock.lockEnableSpokeOnpremRoutePropagation.Unlock()
+	return mock.EnableSpokeOnpremRoutePropagationFunc(spokeGateway)
+}
+
+// EnableSpokeOnpremRoutePropagationCalls gets all the calls that were made to EnableSpokeOnpremRoutePropagation.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableSpokeOnpremRoutePropagationCalls())
+func (mock *GatewayClientMock) EnableSpokeOnpremRoutePropagationCalls() []struct {
+	SpokeGateway *SpokeVpc
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+	}
+	mock.lockEnableSpokeOnpremRoutePropagation.RLock()
+	calls = mock.calls.EnableSpokeOnpremRoutePropagation
+	mock.lockEnableSpokeOnpremRoutePropagation.RUnlock()
+	return calls
+}
+
+// EnableSpokeOnpremRoutePropagationGatewayGroup calls EnableSpokeOnpremRoutePropagationGatewayGroupFunc.
+func (mock *GatewayClientMock) EnableSpokeOnpremRoutePropagationGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableSpokeOnpremRoutePropagationGatewayGroupFunc == nil {
+		panic("GatewayClientMock.EnableSpokeOnpremRoutePropagationGatewayGroupFunc: method is nil but GatewayClient.EnableSpokeOnpremRoutePropagationGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableSpokeOnpremRoutePropagationGatewayGroup.Lock()
+	mock.calls.EnableSpokeOnpremRoutePropagationGatewayGroup = append(mock.calls.EnableSpokeOnpremRoutePropagationGatewayGroup, callInfo)
+	mock.lockEnableSpokeOnpremRoutePropagationGatewayGroup.Unlock()
+	return mock.EnableSpokeOnpremRoutePropagationGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableSpokeOnpremRoutePropagationGatewayGroupCalls gets all the calls that were made to EnableSpokeOnpremRoutePropagationGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableSpokeOnpremRoutePropagationGatewayGroupCalls())
+func (mock *GatewayClientMock) EnableSpokeOnpremRoutePropagationGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableSpokeOnpremRoutePropagationGatewayGroup.RLock()
+	calls = mock.calls.EnableSpokeOnpremRoutePropagationGatewayGroup
+	mock.lockEnableSpokeOnpremRoutePropagationGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableSpokePreserveAsPath calls EnableSpokePreserveAsPathFunc.
+func (mock *GatewayClientMock) EnableSpokePreserveAsPath(spokeGateway *SpokeVpc) error {
+	if mock.EnableSpokePreserveAsPathFunc == nil {
+		panic("GatewayClientMock.EnableSpokePreserveAsPathFunc: method is nil but GatewayClient.EnableSpokePreserveAsPath was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+	}{
+		SpokeGateway: spokeGateway,
+	}
+	mock.lockEnableSpokePreserveAsPath.Lock()
+	mock.calls.EnableSpokePreserveAsPath = append(mock.calls.EnableSpokePreserveAsPath, callInfo)
+	mock.lockEnableSpokePreserveAsPath.Unlock()
+	return mock.EnableSpokePreserveAsPathFunc(spokeGateway)
+}
+
+// EnableSpokePreserveAsPathCalls gets all the calls that were made to EnableSpokePreserveAsPath.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableSpokePreserveAsPathCalls())
+func (mock *GatewayClientMock) EnableSpokePreserveAsPathCalls() []struct {
+	SpokeGateway *SpokeVpc
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+	}
+	mock.lockEnableSpokePreserveAsPath.RLock()
+	calls = mock.calls.EnableSpokePreserveAsPath
+	mock.lockEnableSpokePreserveAsPath.RUnlock()
+	return calls
+}
+
+// EnableSpokePreserveAsPathGatewayGroup calls EnableSpokePreserveAsPathGatewayGroupFunc.
+func (mock *GatewayClientMock) EnableSpokePreserveAsPathGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableSpokePreserveAsPathGatewayGroupFunc == nil {
+		panic("GatewayClientMock.EnableSpokePreserveAsPathGatewayGroupFunc: method is nil but GatewayClient.EnableSpokePreserveAsPathGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableSpokePreserveAsPathGatewayGroup.Lock()
+	mock.calls.EnableSpokePreserveAsPathGatewayGroup = append(mock.calls.EnableSpokePreserveAsPathGatewayGroup, callInfo)
+	mock.lockEnableSpokePreserveAsPathGatewayGroup.Unlock()
+	return mock.EnableSpokePreserveAsPathGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableSpokePreserveAsPathGatewayGroupCalls gets all the calls that were made to EnableSpokePreserveAsPathGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableSpokePreserveAsPathGatewayGroupCalls())
+func (mock *GatewayClientMock) EnableSpokePreserveAsPathGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableSpokePreserveAsPathGatewayGroup.RLock()
+	calls = mock.calls.EnableSpokePreserveAsPathGatewayGroup
+	mock.lockEnableSpokePreserveAsPathGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableSummarizeCidrToTgw calls EnableSummarizeCidrToTgwFunc.
+func (mock *GatewayClientMock) EnableSummarizeCidrToTgw(gwName string) error {
+	if mock.EnableSummarizeCidrToTgwFunc == nil {
+		panic("GatewayClientMock.EnableSummarizeCidrToTgwFunc: method is nil but GatewayClient.EnableSummarizeCidrToTgw was just called")
+	}
+	callInfo := struct {
+		GwName string
+	}{
+		GwName: gwName,
+	}
+	mock.lockEnableSummarizeCidrToTgw.Lock()
+	mock.calls.EnableSummarizeCidrToTgw = append(mock.calls.EnableSummarizeCidrToTgw, callInfo)
+	mock.lockEnableSummarizeCidrToTgw.Unlock()
+	return mock.EnableSummarizeCidrToTgwFunc(gwName)
+}
+
+// EnableSummarizeCidrToTgwCalls gets all the calls that were made to EnableSummarizeCidrToTgw.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableSummarizeCidrToTgwCalls())
+func (mock *GatewayClientMock) EnableSummarizeCidrToTgwCalls() []struct {
+	GwName string
+} {
+	var calls []struct {
+		GwName string
+	}
+	mock.lockEnableSummarizeCidrToTgw.RLock()
+	calls = mock.calls.EnableSummarizeCidrToTgw
+	mock.lockEnableSummarizeCidrToTgw.RUnlock()
+	return calls
+}
+
+// EnableTransitFireNet calls EnableTransitFireNetFunc.
+func (mock *GatewayClientMock) EnableTransitFireNet(gateway *Gateway) error {
+	if mock.EnableTransitFireNetFunc == nil {
+		panic("GatewayClientMock.EnableTransitFireNetFunc: method is nil but GatewayClient.EnableTransitFireNet was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableTransitFireNet.Lock()
+	mock.calls.EnableTransitFireNet = append(mock.calls.EnableTransitFireNet, callInfo)
+	mock.lockEnableTransitFireNet.Unlock()
+	return mock.EnableTransitFireNetFunc(gateway)
+}
+
+// EnableTransitFireNetCalls gets all the calls that were made to EnableTransitFireNet.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableTransitFireNetCalls())
+func (mock *GatewayClientMock) EnableTransitFireNetCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableTransitFireNet.RLock()
+	calls = mock.calls.EnableTransitFireNet
+	mock.lockEnableTransitFireNet.RUnlock()
+	return calls
+}
+
+// EnableTransitFireNetGatewayGroup calls EnableTransitFireNetGatewayGroupFunc.
+func (mock *GatewayClientMock) EnableTransitFireNetGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableTransitFireNetGatewayGroupFunc == nil {
+		panic("GatewayClientMock.EnableTransitFireNetGatewayGroupFunc: method is nil but GatewayClient.EnableTransitFireNetGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableTransitFireNetGatewayGroup.Lock()
+	mock.calls.EnableTransitFireNetGatewayGroup = append(mock.calls.EnableTransitFireNetGatewayGroup, callInfo)
+	mock.lockEnableTransitFireNetGatewayGroup.Unlock()
+	return mock.EnableTransitFireNetGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableTransitFireNetGatewayGroupCalls gets all the calls that were made to EnableTransitFireNetGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableTransitFireNetGatewayGroupCalls())
+func (mock *GatewayClientMock) EnableTransitFireNetGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableTransitFireNetGatewayGroup.RLock()
+	calls = mock.calls.EnableTransitFireNetGatewayGroup
+	mock.lockEnableTransitFireNetGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableTransitFireNetWithGWLB calls EnableTransitFireNetWithGWLBFunc.
+func (mock *GatewayClientMock) EnableTransitFireNetWithGWLB(gateway *Gateway) error {
+	if mock.EnableTransitFireNetWithGWLBFunc == nil {
+		panic("GatewayClientMock.EnableTransitFireNetWithGWLBFunc: method is nil but GatewayClient.EnableTransitFireNetWithGWLB was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableTransitFireNetWithGWLB.Lock()
+	mock.calls.EnableTransitFireNetWithGWLB = append(mock.calls.EnableTransitFireNetWithGWLB, callInfo)
+	mock.lockEnableTransitFireNetWithGWLB.Unlock()
+	return mock.EnableTransitFireNetWithGWLBFunc(gateway)
+}
+
+// EnableTransitFireNetWithGWLBCalls gets all the calls that were made to EnableTransitFireNetWithGWLB.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableTransitFireNetWithGWLBCalls())
+func (mock *GatewayClientMock) EnableTransitFireNetWithGWLBCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableTransitFireNetWithGWLB.RLock()
+	calls = mock.calls.EnableTransitFireNetWithGWLB
+	mock.lockEnableTransitFireNetWithGWLB.RUnlock()
+	return calls
+}
+
+// EnableTransitFireNetWithGWLBGatewayGroup calls EnableTransitFireNetWithGWLBGatewayGroupFunc.
+func (mock *GatewayClientMock) EnableTransitFireNetWithGWLBGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableTransitFireNetWithGWLBGatewayGroupFunc == nil {
+		panic("GatewayClientMock.EnableTransitFireNetWithGWLBGatewayGroupFunc: method is nil but GatewayClient.EnableTransitFireNetWithGWLBGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableTransitFireNetWithGWLBGatewayGroup.Lock()
+	mock.calls.EnableTransitFireNetWithGWLBGatewayGroup = append(mock.calls.EnableTransitFireNetWithGWLBGatewayGroup, callInfo)
+	mock.lockEnableTransitFireNetWithGWLBGatewayGroup.Unlock()
+	return mock.EnableTransitFireNetWithGWLBGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableTransitFireNetWithGWLBGatewayGroupCalls gets all the calls that were made to EnableTransitFireNetWithGWLBGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableTransitFireNetWithGWLBGatewayGroupCalls())
+func (mock *GatewayClientMock) EnableTransitFireNetWithGWLBGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableTransitFireNetWithGWLBGatewayGroup.RLock()
+	calls = mock.calls.EnableTransitFireNetWithGWLBGatewayGroup
+	mock.lockEnableTransitFireNetWithGWLBGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableTransitLearnedCidrsApproval calls EnableTransitLearnedCidrsApprovalFunc.
+func (mock *GatewayClientMock) EnableTransitLearnedCidrsApproval(gateway *TransitVpc) error {
+	if mock.EnableTransitLearnedCidrsApprovalFunc == nil {
+		panic("GatewayClientMock.EnableTransitLearnedCidrsApprovalFunc: method is nil but GatewayClient.EnableTransitLearnedCidrsApproval was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableTransitLearnedCidrsApproval.Lock()
+	mock.calls.EnableTransitLearnedCidrsApproval = append(mock.calls.EnableTransitLearnedCidrsApproval, callInfo)
+	mock.lockEnableTransitLearnedCidrsApproval.Unlock()
+	return mock.EnableTransitLearnedCidrsApprovalFunc(gateway)
+}
+
+// EnableTransitLearnedCidrsApprovalCalls gets all the calls that were made to EnableTransitLearnedCidrsApproval.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableTransitLearnedCidrsApprovalCalls())
+func (mock *GatewayClientMock) EnableTransitLearnedCidrsApprovalCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockEnableTransitLearnedCidrsApproval.RLock()
+	calls = mock.calls.EnableTransitLearnedCidrsApproval
+	mock.lockEnableTransitLearnedCidrsApproval.RUnlock()
+	return calls
+}
+
+// EnableTransitLearnedCidrsApprovalGatewayGroup calls EnableTransitLearnedCidrsApprovalGatewayGroupFunc.
+func (mock *GatewayClientMock) EnableTransitLearnedCidrsApprovalGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableTransitLearnedCidrsApprovalGatewayGroupFunc == nil {
+		panic("GatewayClientMock.EnableTransitLearnedCidrsApprovalGatewayGroupFunc: method is nil but GatewayClient.EnableTransitLearnedCidrsApprovalGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableTransitLearnedCidrsApprovalGatewayGroup.Lock()
+	mock.calls.EnableTransitLearnedCidrsApprovalGatewayGroup = append(mock.calls.EnableTransitLearnedCidrsApprovalGatewayGroup, callInfo)
+	mock.lockEnableTransitLearnedCidrsApprovalGatewayGroup.Unlock()
+	return mock.EnableTransitLearnedCidrsApprovalGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableTransitLearnedCidrsApprovalGatewayGroupCalls gets all the calls that were made to EnableTransitLearnedCidrsApprovalGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableTransitLearnedCidrsApprovalGatewayGroupCalls())
+func (mock *GatewayClientMock) EnableTransitLearnedCidrsApprovalGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableTransitLearnedCidrsApprovalGatewayGroup.RLock()
+	calls = mock.calls.EnableTransitLearnedCidrsApprovalGatewayGroup
+	mock.lockEnableTransitLearnedCidrsApprovalGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableTransitPreserveAsPath calls EnableTransitPreserveAsPathFunc.
+func (mock *GatewayClientMock) EnableTransitPreserveAsPath(transitGateway *TransitVpc) error {
+	if mock.EnableTransitPreserveAsPathFunc == nil {
+		panic("GatewayClientMock.EnableTransitPreserveAsPathFunc: method is nil but GatewayClient.EnableTransitPreserveAsPath was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockEnableTransitPreserveAsPath.Lock()
+	mock.calls.EnableTransitPreserveAsPath = append(mock.calls.EnableTransitPreserveAsPath, callInfo)
+	mock.lockEnableTransitPreserveAsPath.Unlock()
+	return mock.EnableTransitPreserveAsPathFunc(transitGateway)
+}
+
+// EnableTransitPreserveAsPathCalls gets all the calls that were made to EnableTransitPreserveAsPath.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableTransitPreserveAsPathCalls())
+func (mock *GatewayClientMock) EnableTransitPreserveAsPathCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockEnableTransitPreserveAsPath.RLock()
+	calls = mock.calls.EnableTransitPreserveAsPath
+	mock.lockEnableTransitPreserveAsPath.RUnlock()
+	return calls
+}
+
+// EnableTransitPreserveAsPathGatewayGroup calls EnableTransitPreserveAsPathGatewayGroupFunc.
+func (mock *GatewayClientMock) EnableTransitPreserveAsPathGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableTransitPreserveAsPathGatewayGroupFunc == nil {
+		panic("GatewayClientMock.EnableTransitPreserveAsPathGatewayGroupFunc: method is nil but GatewayClient.EnableTransitPreserveAsPathGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableTransitPreserveAsPathGatewayGroup.Lock()
+	mock.calls.EnableTransitPreserveAsPathGatewayGroup = append(mock.calls.EnableTransitPreserveAsPathGatewayGroup, callInfo)
+	mock.lockEnableTransitPreserveAsPathGatewayGroup.Unlock()
+	return mock.EnableTransitPreserveAsPathGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableTransitPreserveAsPathGatewayGroupCalls gets all the calls that were made to EnableTransitPreserveAsPathGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableTransitPreserveAsPathGatewayGroupCalls())
+func (mock *GatewayClientMock) EnableTransitPreserveAsPathGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableTransitPreserveAsPathGatewayGroup.RLock()
+	calls = mock.calls.EnableTransitPreserveAsPathGatewayGroup
+	mock.lockEnableTransitPreserveAsPathGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableTransitSummarizeCidrToTgwGatewayGroup calls EnableTransitSummarizeCidrToTgwGatewayGroupFunc.
+func (mock *GatewayClientMock) EnableTransitSummarizeCidrToTgwGatewayGroup(ctx context.Context, groupName string) error {
+	if mock.EnableTransitSummarizeCidrToTgwGatewayGroupFunc == nil {
+		panic("GatewayClientMock.EnableTransitSummarizeCidrToTgwGatewayGroupFunc: method is nil but GatewayClient.EnableTransitSummarizeCidrToTgwGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockEnableTransitSummarizeCidrToTgwGatewayGroup.Lock()
+	mock.calls.EnableTransitSummarizeCidrToTgwGatewayGroup = append(mock.calls.EnableTransitSummarizeCidrToTgwGatewayGroup, callInfo)
+	mock.lockEnableTransitSummarizeCidrToTgwGatewayGroup.Unlock()
+	return mock.EnableTransitSummarizeCidrToTgwGatewayGroupFunc(ctx, groupName)
+}
+
+// EnableTransitSummarizeCidrToTgwGatewayGroupCalls gets all the calls that were made to EnableTransitSummarizeCidrToTgwGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableTransitSummarizeCidrToTgwGatewayGroupCalls())
+func (mock *GatewayClientMock) EnableTransitSummarizeCidrToTgwGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockEnableTransitSummarizeCidrToTgwGatewayGroup.RLock()
+	calls = mock.calls.EnableTransitSummarizeCidrToTgwGatewayGroup
+	mock.lockEnableTransitSummarizeCidrToTgwGatewayGroup.RUnlock()
+	return calls
+}
+
+// EnableVPNConfig calls EnableVPNConfigFunc.
+func (mock *GatewayClientMock) EnableVPNConfig(gateway *Gateway, vpnConfig *VPNConfig) error {
+	if mock.EnableVPNConfigFunc == nil {
+		panic("GatewayClientMock.EnableVPNConfigFunc: method is nil but GatewayClient.EnableVPNConfig was just called")
+	}
+	callInfo := struct {
+		Gateway   *Gateway
+		VpnConfig *VPNConfig
+	}{
+		Gateway:   gateway,
+		VpnConfig: vpnConfig,
+	}
+	mock.lockEnableVPNConfig.Lock()
+	mock.calls.EnableVPNConfig = append(mock.calls.EnableVPNConfig, callInfo)
+	mock.lockEnableVPNConfig.Unlock()
+	return mock.EnableVPNConfigFunc(gateway, vpnConfig)
+}
+
+// EnableVPNConfigCalls gets all the calls that were made to EnableVPNConfig.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableVPNConfigCalls())
+func (mock *GatewayClientMock) EnableVPNConfigCalls() []struct {
+	Gateway   *Gateway
+	VpnConfig *VPNConfig
+} {
+	var calls []struct {
+		Gateway   *Gateway
+		VpnConfig *VPNConfig
+	}
+	mock.lockEnableVPNConfig.RLock()
+	calls = mock.calls.EnableVPNConfig
+	mock.lockEnableVPNConfig.RUnlock()
+	return calls
+}
+
+// EnableVpcDNSServer calls EnableVpcDNSServerFunc.
+func (mock *GatewayClientMock) EnableVpcDNSServer(gateway *Gateway) error {
+	if mock.EnableVpcDNSServerFunc == nil {
+		panic("GatewayClientMock.EnableVpcDNSServerFunc: method is nil but GatewayClient.EnableVpcDNSServer was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableVpcDNSServer.Lock()
+	mock.calls.EnableVpcDNSServer = append(mock.calls.EnableVpcDNSServer, callInfo)
+	mock.lockEnableVpcDNSServer.Unlock()
+	return mock.EnableVpcDNSServerFunc(gateway)
+}
+
+// EnableVpcDNSServerCalls gets all the calls that were made to EnableVpcDNSServer.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableVpcDNSServerCalls())
+func (mock *GatewayClientMock) EnableVpcDNSServerCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableVpcDNSServer.RLock()
+	calls = mock.calls.EnableVpcDNSServer
+	mock.lockEnableVpcDNSServer.RUnlock()
+	return calls
+}
+
+// EnableVpnNat calls EnableVpnNatFunc.
+func (mock *GatewayClientMock) EnableVpnNat(gateway *Gateway) error {
+	if mock.EnableVpnNatFunc == nil {
+		panic("GatewayClientMock.EnableVpnNatFunc: method is nil but GatewayClient.EnableVpnNat was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockEnableVpnNat.Lock()
+	mock.calls.EnableVpnNat = append(mock.calls.EnableVpnNat, callInfo)
+	mock.lockEnableVpnNat.Unlock()
+	return mock.EnableVpnNatFunc(gateway)
+}
+
+// EnableVpnNatCalls gets all the calls that were made to EnableVpnNat.
+// Check the length with:
+//
+//	len(mockedGatewayClient.EnableVpnNatCalls())
+func (mock *GatewayClientMock) EnableVpnNatCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockEnableVpnNat.RLock()
+	calls = mock.calls.EnableVpnNat
+	mock.lockEnableVpnNat.RUnlock()
+	return calls
+}
+
+// GetBgpLanIPList calls GetBgpLanIPListFunc.
+func (mock *GatewayClientMock) GetBgpLanIPList(transitGateway *TransitVpc) (*TransitGatewayBgpLanIpInfo, error) {
+	if mock.GetBgpLanIPListFunc == nil {
+		panic("GatewayClientMock.GetBgpLanIPListFunc: method is nil but GatewayClient.GetBgpLanIPList was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockGetBgpLanIPList.Lock()
+	mock.calls.GetBgpLanIPList = append(mock.calls.GetBgpLanIPList, callInfo)
+	mock.lockGetBgpLanIPList.Unlock()
+	return mock.GetBgpLanIPListFunc(transitGateway)
+}
+
+// GetBgpLanIPListCalls gets all the calls that were made to GetBgpLanIPList.
+// Check the length with:
+//
+//	len(mockedGatewayClient.GetBgpLanIPListCalls())
+func (mock *GatewayClientMock) GetBgpLanIPListCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockGetBgpLanIPList.RLock()
+	calls = mock.calls.GetBgpLanIPList
+	mock.lockGetBgpLanIPList.RUnlock()
+	return calls
+}
+
+// GetControllerIP calls GetControllerIPFunc.
+func (mock *GatewayClientMock) GetControllerIP() string {
+	if mock.GetControllerIPFunc == nil {
+		panic("GatewayClientMock.GetControllerIPFunc: method is nil but GatewayClient.GetControllerIP was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetControllerIP.Lock()
+	mock.calls.GetControllerIP = append(mock.calls.GetControllerIP, callInfo)
+	mock.lockGetControllerIP.Unlock()
+	return mock.GetControllerIPFunc()
+}
+
+// GetControllerIPCalls gets all the calls that were made to GetControllerIP.
+// Check the length with:
+//
+//	len(mockedGatewayClient.GetControllerIPCalls())
+func (mock *GatewayClientMock) GetControllerIPCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetControllerIP.RLock()
+	calls = mock.calls.GetControllerIP
+	mock.lockGetControllerIP.RUnlock()
+	return calls
+}
+
+// GetDefaultTagsConfig calls GetDefaultTagsConfigFunc.
+func (mock *GatewayClientMock) GetDefaultTagsConfig() *DefaultTagsConfig {
+	if mock.GetDefaultTagsConfigFunc == nil {
+		panic("GatewayClientMock.GetDefaultTagsConfigFunc: method is nil but GatewayClient.GetDefaultTagsConfig was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetDefaultTagsConfig.Lock()
+	mock.calls.GetDefaultTagsConfig = append(mock.calls.GetDefaultTagsConfig, callInfo)
+	mock.lockGetDefaultTagsConfig.Unlock()
+	return mock.GetDefaultTagsConfigFunc()
+}
+
+// GetDefaultTagsConfigCalls gets all the calls that were made to GetDefaultTagsConfig.
+// Check the length with:
+//
+//	len(mockedGatewayClient.GetDefaultTagsConfigCalls())
+func (mock *GatewayClientMock) GetDefaultTagsConfigCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetDefaultTagsConfig.RLock()
+	calls = mock.calls.GetDefaultTagsConfig
+	mock.lockGetDefaultTagsConfig.RUnlock()
+	return calls
+}
+
+// GetGateway calls GetGatewayFunc.
+func (mock *GatewayClientMock) GetGateway(gateway *Gateway) (*Gateway, error) {
+	if mock.GetGatewayFunc == nil {
+		panic("GatewayClientMock.GetGatewayFunc: method is nil but GatewayClient.GetGateway was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockGetGateway.Lock()
+	mock.calls.GetGateway = append(mock.calls.GetGateway, callInfo)
+	mock.lockGetGateway.Unlock()
+	return mock.GetGatewayFunc(gateway)
+}
+
+// GetGatewayCalls gets all the calls that were made to GetGateway.
+// Check the length with:
+//
+//	len(mockedGatewayClient.GetGatewayCalls())
+func (mock *GatewayClientMock) GetGatewayCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockGetGateway.RLock()
+	calls = mock.calls.GetGateway
+	mock.lockGetGateway.RUnlock()
+	return calls
+}
+
+// GetGatewayBgpCommunities calls GetGatewayBgpCommunitiesFunc.
+func (mock *GatewayClientMock) GetGatewayBgpCommunities(gwName string) (bool, bool, error) {
+	if mock.GetGatewayBgpCommunitiesFunc == nil {
+		panic("GatewayClientMock.GetGatewayBgpCommunitiesFunc: method is nil but GatewayClient.GetGatewayBgpCommunities was just called")
+	}
+	callInfo := struct {
+		GwName string
+	}{
+		GwName: gwName,
+	}
+	mock.lockGetGatewayBgpCommunities.Lock()
+	mock.calls.GetGatewayBgpCommunities = append(mock.calls.GetGatewayBgpCommunities, callInfo)
+	mock.lockGetGatewayBgpCommunities.Unlock()
+	return mock.GetGatewayBgpCommunitiesFunc(gwName)
+}
+
+// GetGatewayBgpCommunitiesCalls gets all the calls that were made to GetGatewayBgpCommunities.
+// Check the length with:
+//
+//	len(mockedGatewayClient.GetGatewayBgpCommunitiesCalls())
+func (mock *GatewayClientMock) GetGatewayBgpCommunitiesCalls() []struct {
+	GwName string
+} {
+	var calls []struct {
+		GwName string
+	}
+	mock.lockGetGatewayBgpCommunities.RLock()
+	calls = mock.calls.GetGatewayBgpCommunities
+	mock.lockGetGatewayBgpCommunities.RUnlock()
+	return calls
+}
+
+// GetGatewayBgpMedToSdnMetric calls GetGatewayBgpMedToSdnMetricFunc.
+func (mock *GatewayClientMock) GetGatewayBgpMedToSdnMetric(gwName string) (bool, error) {
+	if mock.GetGatewayBgpMedToSdnMetricFunc == nil {
+		panic("GatewayClientMock.GetGatewayBgpMedToSdnMetricFunc: method is nil but GatewayClient.GetGatewayBgpMedToSdnMetric was just called")
+	}
+	callInfo := struct {
+		GwName string
+	}{
+		GwName: gwName,
+	}
+	mock.lockGetGatewayBgpMedToSdnMetric.Lock()
+	mock.calls.GetGatewayBgpMedToSdnMetric = append(mock.calls.GetGatewayBgpMedToSdnMetric, callInfo)
+	mock.lockGetGatewayBgpMedToSdnMetric.Unlock()
+	return mock.GetGatewayBgpMedToSdnMetricFunc(gwName)
+}
+
+// GetGatewayBgpMedToSdnMetricCalls gets all the calls that were made to GetGatewayBgpMedToSdnMetric.
+// Check the length with:
+//
+//	len(mockedGatewayClient.GetGatewayBgpMedToSdnMetricCalls())
+func (mock *GatewayClientMock) GetGatewayBgpMedToSdnMetricCalls() []struct {
+	GwName string
+} {
+	var calls []struct {
+		GwName string
+	}
+	mock.lockGetGatewayBgpMedToSdnMetric.RLock()
+	calls = mock.calls.GetGatewayBgpMedToSdnMetric
+	mock.lockGetGatewayBgpMedToSdnMetric.RUnlock()
+	return calls
+}
+
+// GetGatewayDetail calls GetGatewayDetailFunc.
+func (mock *GatewayClientMock) GetGatewayDetail(gateway *Gateway) (*GatewayDetail, error) {
+	if mock.GetGatewayDetailFunc == nil {
+		panic("GatewayClientMock.GetGatewayDetailFunc: method is nil but GatewayClient.GetGatewayDetail was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockGetGatewayDetail.Lock()
+	mock.calls.GetGatewayDetail = append(mock.calls.GetGatewayDetail, callInfo)
+	mock.lockGetGatewayDetail.Unlock()
+	return mock.GetGatewayDetailFunc(gateway)
+}
+
+// GetGatewayDetailCalls gets all the calls that were made to GetGatewayDetail.
+// Check the length with:
+//
+//	len(mockedGatewayClient.GetGatewayDetailCalls())
+func (mock *GatewayClientMock) GetGatewayDetailCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockGetGatewayDetail.RLock()
+	calls = mock.calls.GetGatewayDetail
+	mock.lockGetGatewayDetail.RUnlock()
+	return calls
+}
+
+// GetGatewayGroup calls GetGatewayGroupFunc.
+func (mock *GatewayClientMock) GetGatewayGroup(ctx context.Context, groupUUID string) (*GatewayGroup, error) {
+	if mock.GetGatewayGroupFunc == nil {
+		panic("GatewayClientMock.GetGatewayGroupFunc: method is nil but GatewayClient.GetGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupUUID string
+	}{
+		Ctx:       ctx,
+		GroupUUID: groupUUID,
+	}
+	mock.lockGetGatewayGroup.Lock()
+	mock.calls.GetGatewayGroup = append(mock.calls.GetGatewayGroup, callInfo)
+	mock.lockGetGatewayGroup.Unlock()
+	return mock.GetGatewayGroupFunc(ctx, groupUUID)
+}
+
+// GetGatewayGroupCalls gets all the calls that were made to GetGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.GetGatewayGroupCalls())
+func (mock *GatewayClientMock) GetGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupUUID string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupUUID string
+	}
+	mock.lockGetGatewayGroup.RLock()
+	calls = mock.calls.GetGatewayGroup
+	mock.lockGetGatewayGroup.RUnlock()
+	return calls
+}
+
+// GetGatewayGroupByName calls GetGatewayGroupByNameFunc.
+func (mock *GatewayClientMock) GetGatewayGroupByName(ctx context.Context, groupName string) (*GatewayGroup, error) {
+	if mock.GetGatewayGroupByNameFunc == nil {
+		panic("GatewayClientMock.GetGatewayGroupByNameFunc: method is nil but GatewayClient.GetGatewayGroupByName was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+	}
+	mock.lockGetGatewayGroupByName.Lock()
+	mock.calls.GetGatewayGroupByName = append(mock.calls.GetGatewayGroupByName, callInfo)
+	mock.lockGetGatewayGroupByName.Unlock()
+	return mock.GetGatewayGroupByNameFunc(ctx, groupName)
+}
+
+// GetGatewayGroupByNameCalls gets all the calls that were made to GetGatewayGroupByName.
+// Check the length with:
+//
+//	len(mockedGatewayClient.GetGatewayGroupByNameCalls())
+func (mock *GatewayClientMock) GetGatewayGroupByNameCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+	}
+	mock.lockGetGatewayGroupByName.RLock()
+	calls = mock.calls.GetGatewayGroupByName
+	mock.lockGetGatewayGroupByName.RUnlock()
+	return calls
+}
+
+// GetGeoVPNName calls GetGeoVPNNameFunc.
+func (mock *GatewayClientMock) GetGeoVPNName(gateway *Gateway) (*GeoVPN, error) {
+	if mock.GetGeoVPNNameFunc == nil {
+		panic("GatewayClientMock.GetGeoVPNNameFunc: method is nil but GatewayClient.GetGeoVPNName was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockGetGeoVPNName.Lock()
+	mock.calls.GetGeoVPNName = append(mock.calls.GetGeoVPNName, callInfo)
+	mock.lockGetGeoVPNName.Unlock()
+	return mock.GetGeoVPNNameFunc(gateway)
+}
+
+// GetGeoVPNNameCalls gets all the calls that were made to GetGeoVPNName.
+// Check the length with:
+//
+//	len(mockedGatewayClient.GetGeoVPNNameCalls())
+func (mock *GatewayClientMock) GetGeoVPNNameCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockGetGeoVPNName.RLock()
+	calls = mock.calls.GetGeoVPNName
+	mock.lockGetGeoVPNName.RUnlock()
+	return calls
+}
+
+// GetGroGsoStatus calls GetGroGsoStatusFunc.
+func (mock *GatewayClientMock) GetGroGsoStatus(gateway *Gateway) (bool, error) {
+	if mock.GetGroGsoStatusFunc == nil {
+		panic("GatewayClientMock.GetGroGsoStatusFunc: method is nil but GatewayClient.GetGroGsoStatus was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockGetGroGsoStatus.Lock()
+	mock.calls.GetGroGsoStatus = append(mock.calls.GetGroGsoStatus, callInfo)
+	mock.lockGetGroGsoStatus.Unlock()
+	return mock.GetGroGsoStatusFunc(gateway)
+}
+
+// GetGroGsoStatusCalls gets all the calls that were made to GetGroGsoStatus.
+// Check the length with:
+//
+//	len(mockedGatewayClient.GetGroGsoStatusCalls())
+func (mock *GatewayClientMock) GetGroGsoStatusCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockGetGroGsoStatus.RLock()
+	calls = mock.calls.GetGroGsoStatus
+	mock.lockGetGroGsoStatus.RUnlock()
+	return calls
+}
+
+// GetIgnoreTagsConfig calls GetIgnoreTagsConfigFunc.
+func (mock *GatewayClientMock) GetIgnoreTagsConfig() *IgnoreTagsConfig {
+	if mock.GetIgnoreTagsConfigFunc == nil {
+		panic("GatewayClientMock.GetIgnoreTagsConfigFunc: method is nil but GatewayClient.GetIgnoreTagsConfig was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetIgnoreTagsConfig.Lock()
+	mock.calls.GetIgnoreTagsConfig = append(mock.calls.GetIgnoreTagsConfig, callInfo)
+	mock.lockGetIgnoreTagsConfig.Unlock()
+	return mock.GetIgnoreTagsConfigFunc()
+}
+
+// GetIgnoreTagsConfigCalls gets all the calls that were made to GetIgnoreTagsConfig.
+// Check the length with:
+//
+//	len(mockedGatewayClient.GetIgnoreTagsConfigCalls())
+func (mock *GatewayClientMock) GetIgnoreTagsConfigCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetIgnoreTagsConfig.RLock()
+	calls = mock.calls.GetIgnoreTagsConfig
+	mock.lockGetIgnoreTagsConfig.RUnlock()
+	return calls
+}
+
+// GetSplitTunnel calls GetSplitTunnelFunc.
+func (mock *GatewayClientMock) GetSplitTunnel(splitTunnel *SplitTunnel) (*SplitTunnelUnit, error) {
+	if mock.GetSplitTunnelFunc == nil {
+		panic("GatewayClientMock.GetSplitTunnelFunc: method is nil but GatewayClient.GetSplitTunnel was just called")
+	}
+	callInfo := struct {
+		SplitTunnel *SplitTunnel
+	}{
+		SplitTunnel: splitTunnel,
+	}
+	mock.lockGetSplitTunnel.Lock()
+	mock.calls.GetSplitTunnel = append(mock.calls.GetSplitTunnel, callInfo)
+	mock.lockGetSplitTunnel.Unlock()
+	return mock.GetSplitTunnelFunc(splitTunnel)
+}
+
+// GetSplitTunnelCalls gets all the calls that were made to GetSplitTunnel.
+// Check the length with:
+//
+//	len(mockedGatewayClient.GetSplitTunnelCalls())
+func (mock *GatewayClientMock) GetSplitTunnelCalls() []struct {
+	SplitTunnel *SplitTunnel
+} {
+	var calls []struct {
+		SplitTunnel *SplitTunnel
+	}
+	mock.lockGetSplitTunnel.RLock()
+	calls = mock.calls.GetSplitTunnel
+	mock.lockGetSplitTunnel.RUnlock()
+	return calls
+}
+
+// GetSpokeGatewayAdvancedConfig calls GetSpokeGatewayAdvancedConfigFunc.
+func (mock *GatewayClientMock) GetSpokeGatewayAdvancedConfig(spokeGateway *SpokeVpc) (*SpokeGatewayAdvancedConfig, error) {
+	if mock.GetSpokeGatewayAdvancedConfigFunc == nil {
+		panic("GatewayClientMock.GetSpokeGatewayAdvancedConfigFunc: method is nil but GatewayClient.GetSpokeGatewayAdvancedConfig was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+	}{
+		SpokeGateway: spokeGateway,
+	}
+	mock.lockGetSpokeGatewayAdvancedConfig.Lock()
+	mock.calls.GetSpokeGatewayAdvancedConfig = append(mock.calls.GetSpokeGatewayAdvancedConfig, callInfo)
+	mock.lockGetSpokeGatewayAdvancedConfig.Unlock()
+	return mock.GetSpokeGatewayAdvancedConfigFunc(spokeGateway)
+}
+
+// GetSpokeGatewayAdvancedConfigCalls gets all the calls that were made to GetSpokeGatewayAdvancedConfig.
+// Check the length with:
+//
+//	len(mockedGatewayClient.GetSpokeGatewayAdvancedConfigCalls())
+func (mock *GatewayClientMock) GetSpokeGatewayAdvancedConfigCalls() []struct {
+	SpokeGateway *SpokeVpc
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+	}
+	mock.lockGetSpokeGatewayAdvancedConfig.RLock()
+	calls = mock.calls.GetSpokeGatewayAdvancedConfig
+	mock.lockGetSpokeGatewayAdvancedConfig.RUnlock()
+	return calls
+}
+
+// GetSpokeGatewayList calls GetSpokeGatewayListFunc.
+func (mock *GatewayClientMock) GetSpokeGatewayList(ctx context.Context) ([]Gateway, error) {
+	if mock.GetSpokeGatewayListFunc == nil {
+		panic("GatewayClientMock.GetSpokeGatewayListFunc: method is nil but GatewayClient.GetSpokeGatewayList was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetSpokeGatewayList.Lock()
+	mock.calls.GetSpokeGatewayList = append(mock.calls.GetSpokeGatewayList, callInfo)
+	mock.lockGetSpokeGatewayList.Unlock()
+	return mock.GetSpokeGatewayListFunc(ctx)
+}
+
+// GetSpokeGatewayListCalls gets all the calls that were made to GetSpokeGatewayList.
+// Check the length with:
+//
+//	len(mockedGatewayClient.GetSpokeGatewayListCalls())
+func (mock *GatewayClientMock) GetSpokeGatewayListCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetSpokeGatewayList.RLock()
+	calls = mock.calls.GetSpokeGatewayList
+	mock.lockGetSpokeGatewayList.RUnlock()
+	return calls
+}
+
+// GetSpokeGatewaySubnetGroup calls GetSpokeGatewaySubnetGroupFunc.
+func (mock *GatewayClientMock) GetSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error {
+	if mock.GetSpokeGatewaySubnetGroupFunc == nil {
+		panic("GatewayClientMock.GetSpokeGatewaySubnetGroupFunc: method is nil but GatewayClient.GetSpokeGatewaySubnetGroup was just called")
+	}
+	callInfo := struct {
+		Ctx                     context.Context
+		SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+	}{
+		Ctx:                     ctx,
+		SpokeGatewaySubnetGroup: spokeGatewaySubnetGroup,
+	}
+	mock.lockGetSpokeGatewaySubnetGroup.Lock()
+	mock.calls.GetSpokeGatewaySubnetGroup = append(mock.calls.GetSpokeGatewaySubnetGroup, callInfo)
+	mock.lockGetSpokeGatewaySubnetGroup.Unlock()
+	return mock.GetSpokeGatewaySubnetGroupFunc(ctx, spokeGatewaySubnetGroup)
+}
+
+// GetSpokeGatewaySubnetGroupCalls gets all the calls that were made to GetSpokeGatewaySubnetGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.GetSpokeGatewaySubnetGroupCalls())
+func (mock *GatewayClientMock) GetSpokeGatewaySubnetGroupCalls() []struct {
+	Ctx                     context.Context
+	SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+} {
+	var calls []struct {
+		Ctx                     context.Context
+		SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+	}
+	mock.lockGetSpokeGatewaySubnetGroup.RLock()
+	calls = mock.calls.GetSpokeGatewaySubnetGroup
+	mock.lockGetSpokeGatewaySubnetGroup.RUnlock()
+	return calls
+}
+
+// GetTags calls GetTagsFunc.
+func (mock *GatewayClientMock) GetTags(tags *Tags) ([]string, error) {
+	if mock.GetTagsFunc == nil {
+		panic("GatewayClientMock.GetTagsFunc: method is nil but GatewayClient.GetTags was just called")
+	}
+	callInfo := struct {
+		Tags *Tags
+	}{
+		Tags: tags,
+	}
+	mock.lockGetTags.Lock()
+	mock.calls.GetTags = append(mock.calls.GetTags, callInfo)
+	mock.lockGetTags.Unlock()
+	return mock.GetTagsFunc(tags)
+}
+
+// GetTagsCalls gets all the calls that were made to GetTags.
+// Check the length with:
+//
+//	len(mockedGatewayClient.GetTagsCalls())
+func (mock *GatewayClientMock) GetTagsCalls() []struct {
+	Tags *Tags
+} {
+	var calls []struct {
+		Tags *Tags
+	}
+	mock.lockGetTags.RLock()
+	calls = mock.calls.GetTags
+	mock.lockGetTags.RUnlock()
+	return calls
+}
+
+// GetTransitGatewayAdvancedConfig calls GetTransitGatewayAdvancedConfigFunc.
+func (mock *GatewayClientMock) GetTransitGatewayAdvancedConfig(transitGateway *TransitVpc) (*TransitGatewayAdvancedConfig, error) {
+	if mock.GetTransitGatewayAdvancedConfigFunc == nil {
+		panic("GatewayClientMock.GetTransitGatewayAdvancedConfigFunc: method is nil but GatewayClient.GetTransitGatewayAdvancedConfig was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+	}{
+		TransitGateway: transitGateway,
+	}
+	mock.lockGetTransitGatewayAdvancedConfig.Lock()
+	mock.calls.GetTransitGatewayAdvancedConfig = append(mock.calls.GetTransitGatewayAdvancedConfig, callInfo)
+	mock.lockGetTransitGatewayAdvancedConfig.Unlock()
+	return mock.GetTransitGatewayAdvancedConfigFunc(transitGateway)
+}
+
+// GetTransitGatewayAdvancedConfigCalls gets all the calls that were made to GetTransitGatewayAdvancedConfig.
+// Check the length with:
+//
+//	len(mockedGatewayClient.GetTransitGatewayAdvancedConfigCalls())
+func (mock *GatewayClientMock) GetTransitGatewayAdvancedConfigCalls() []struct {
+	TransitGateway *TransitVpc
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+	}
+	mock.lockGetTransitGatewayAdvancedConfig.RLock()
+	calls = mock.calls.GetTransitGatewayAdvancedConfig
+	mock.lockGetTransitGatewayAdvancedConfig.RUnlock()
+	return calls
+}
+
+// GetTransitGatewayLanCidr calls GetTransitGatewayLanCidrFunc.
+func (mock *GatewayClientMock) GetTransitGatewayLanCidr(gatewayName string) (string, error) {
+	if mock.GetTransitGatewayLanCidrFunc == nil {
+		panic("GatewayClientMock.GetTransitGatewayLanCidrFunc: method is nil but GatewayClient.GetTransitGatewayLanCidr was just called")
+	}
+	callInfo := struct {
+		GatewayName string
+	}{
+		GatewayName: gatewayName,
+	}
+	mock.lockGetTransitGatewayLanCidr.Lock()
+	mock.calls.GetTransitGatewayLanCidr = append(mock.calls.GetTransitGatewayLanCidr, callInfo)
+	mock.lockGetTransitGatewayLanCidr.Unlock()
+	return mock.GetTransitGatewayLanCidrFunc(gatewayName)
+}
+
+// GetTransitGatewayLanCidrCalls gets all the calls that were made to GetTransitGatewayLanCidr.
+// Check the length with:
+//
+//	len(mockedGatewayClient.GetTransitGatewayLanCidrCalls())
+func (mock *GatewayClientMock) GetTransitGatewayLanCidrCalls() []struct {
+	GatewayName string
+} {
+	var calls []struct {
+		GatewayName string
+	}
+	mock.lockGetTransitGatewayLanCidr.RLock()
+	calls = mock.calls.GetTransitGatewayLanCidr
+	mock.lockGetTransitGatewayLanCidr.RUnlock()
+	return calls
+}
+
+// GetTransitGatewayList calls GetTransitGatewayListFunc.
+func (mock *GatewayClientMock) GetTransitGatewayList(ctx context.Context) ([]Gateway, error) {
+	if mock.GetTransitGatewayListFunc == nil {
+		panic("GatewayClientMock.GetTransitGatewayListFunc: method is nil but GatewayClient.GetTransitGatewayList was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetTransitGatewayList.Lock()
+	mock.calls.GetTransitGatewayList = append(mock.calls.GetTransitGatewayList, callInfo)
+	mock.lockGetTransitGatewayList.Unlock()
+	return mock.GetTransitGatewayListFunc(ctx)
+}
+
+// GetTransitGatewayListCalls gets all the calls that were made to GetTransitGatewayList.
+// Check the length with:
+//
+//	len(mockedGatewayClient.GetTransitGatewayListCalls())
+func (mock *GatewayClientMock) GetTransitGatewayListCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetTransitGatewayList.RLock()
+	calls = mock.calls.GetTransitGatewayList
+	mock.lockGetTransitGatewayList.RUnlock()
+	return calls
+}
+
+// GetTunnelDetectionTime calls GetTunnelDetectionTimeFunc.
+func (mock *GatewayClientMock) GetTunnelDetectionTime(entity string) (int, error) {
+	if mock.GetTunnelDetectionTimeFunc == nil {
+		panic("GatewayClientMock.GetTunnelDetectionTimeFunc: method is nil but GatewayClient.GetTunnelDetectionTime was just called")
+	}
+	callInfo := struct {
+		Entity string
+	}{
+		Entity: entity,
+	}
+	mock.lockGetTunnelDetectionTime.Lock()
+	mock.calls.GetTunnelDetectionTime = append(mock.calls.GetTunnelDetectionTime, callInfo)
+	mock.lockGetTunnelDetectionTime.Unlock()
+	return mock.GetTunnelDetectionTimeFunc(entity)
+}
+
+// GetTunnelDetectionTimeCalls gets all the calls that were made to GetTunnelDetectionTime.
+// Check the length with:
+//
+//	len(mockedGatewayClient.GetTunnelDetectionTimeCalls())
+func (mock *GatewayClientMock) GetTunnelDetectionTimeCalls() []struct {
+	Entity string
+} {
+	var calls []struct {
+		Entity string
+	}
+	mock.lockGetTunnelDetectionTime.RLock()
+	calls = mock.calls.GetTunnelDetectionTime
+	mock.lockGetTunnelDetectionTime.RUnlock()
+	return calls
+}
+
+// IsTransitFireNetReadyToBeDisabled calls IsTransitFireNetReadyToBeDisabledFunc.
+func (mock *GatewayClientMock) IsTransitFireNetReadyToBeDisabled(gateway *Gateway) error {
+	if mock.IsTransitFireNetReadyToBeDisabledFunc == nil {
+		panic("GatewayClientMock.IsTransitFireNetReadyToBeDisabledFunc: method is nil but GatewayClient.IsTransitFireNetReadyToBeDisabled was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockIsTransitFireNetReadyToBeDisabled.Lock()
+	mock.calls.IsTransitFireNetReadyToBeDisabled = append(mock.calls.IsTransitFireNetReadyToBeDisabled, callInfo)
+	mock.lockIsTransitFireNetReadyToBeDisabled.Unlock()
+	return mock.IsTransitFireNetReadyToBeDisabledFunc(gateway)
+}
+
+// IsTransitFireNetReadyToBeDisabledCalls gets all the calls that were made to IsTransitFireNetReadyToBeDisabled.
+// Check the length with:
+//
+//	len(mockedGatewayClient.IsTransitFireNetReadyToBeDisabledCalls())
+func (mock *GatewayClientMock) IsTransitFireNetReadyToBeDisabledCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockIsTransitFireNetReadyToBeDisabled.RLock()
+	calls = mock.calls.IsTransitFireNetReadyToBeDisabled
+	mock.lockIsTransitFireNetReadyToBeDisabled.RUnlock()
+	return calls
+}
+
+// LaunchSpokeVpcContext calls LaunchSpokeVpcContextFunc.
+func (mock *GatewayClientMock) LaunchSpokeVpcContext(ctx context.Context, spoke *SpokeVpc) error {
+	if mock.LaunchSpokeVpcContextFunc == nil {
+		panic("GatewayClientMock.LaunchSpokeVpcContextFunc: method is nil but GatewayClient.LaunchSpokeVpcContext was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Spoke *SpokeVpc
+	}{
+		Ctx:   ctx,
+		Spoke: spoke,
+	}
+	mock.lockLaunchSpokeVpcContext.Lock()
+	mock.calls.LaunchSpokeVpcContext = append(mock.calls.LaunchSpokeVpcContext, callInfo)
+	mock.lockLaunchSpokeVpcContext.Unlock()
+	return mock.LaunchSpokeVpcContextFunc(ctx, spoke)
+}
+
+// LaunchSpokeVpcContextCalls gets all the calls that were made to LaunchSpokeVpcContext.
+// Check the length with:
+//
+//	len(mockedGatewayClient.LaunchSpokeVpcContextCalls())
+func (mock *GatewayClientMock) LaunchSpokeVpcContextCalls() []struct {
+	Ctx   context.Context
+	Spoke *SpokeVpc
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Spoke *SpokeVpc
+	}
+	mock.lockLaunchSpokeVpcContext.RLock()
+	calls = mock.calls.LaunchSpokeVpcContext
+	mock.lockLaunchSpokeVpcContext.RUnlock()
+	return calls
+}
+
+// LaunchTransitVpcContext calls LaunchTransitVpcContextFunc.
+func (mock *GatewayClientMock) LaunchTransitVpcContext(ctx context.Context, gateway *TransitVpc) error {
+	if mock.LaunchTransitVpcContextFunc == nil {
+		panic("GatewayClientMock.LaunchTransitVpcContextFunc: method is nil but GatewayClient.LaunchTransitVpcContext was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Gateway *TransitVpc
+	}{
+		Ctx:     ctx,
+		Gateway: gateway,
+	}
+	mock.lockLaunchTransitVpcContext.Lock()
+	mock.calls.LaunchTransitVpcContext = append(mock.calls.LaunchTransitVpcContext, callInfo)
+	mock.lockLaunchTransitVpcContext.Unlock()
+	return mock.LaunchTransitVpcContextFunc(ctx, gateway)
+}
+
+// LaunchTransitVpcContextCalls gets all the calls that were made to LaunchTransitVpcContext.
+// Check the length with:
+//
+//	len(mockedGatewayClient.LaunchTransitVpcContextCalls())
+func (mock *GatewayClientMock) LaunchTransitVpcContextCalls() []struct {
+	Ctx     context.Context
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Gateway *TransitVpc
+	}
+	mock.lockLaunchTransitVpcContext.RLock()
+	calls = mock.calls.LaunchTransitVpcContext
+	mock.lockLaunchTransitVpcContext.RUnlock()
+	return calls
+}
+
+// ModifySplitTunnel calls ModifySplitTunnelFunc.
+func (mock *GatewayClientMock) ModifySplitTunnel(splitTunnel *SplitTunnel) error {
+	if mock.ModifySplitTunnelFunc == nil {
+		panic("GatewayClientMock.ModifySplitTunnelFunc: method is nil but GatewayClient.ModifySplitTunnel was just called")
+	}
+	callInfo := struct {
+		SplitTunnel *SplitTunnel
+	}{
+		SplitTunnel: splitTunnel,
+	}
+	mock.lockModifySplitTunnel.Lock()
+	mock.calls.ModifySplitTunnel = append(mock.calls.ModifySplitTunnel, callInfo)
+	mock.lockModifySplitTunnel.Unlock()
+	return mock.ModifySplitTunnelFunc(splitTunnel)
+}
+
+// ModifySplitTunnelCalls gets all the calls that were made to ModifySplitTunnel.
+// Check the length with:
+//
+//	len(mockedGatewayClient.ModifySplitTunnelCalls())
+func (mock *GatewayClientMock) ModifySplitTunnelCalls() []struct {
+	SplitTunnel *SplitTunnel
+} {
+	var calls []struct {
+		SplitTunnel *SplitTunnel
+	}
+	mock.lockModifySplitTunnel.RLock()
+	calls = mock.calls.ModifySplitTunnel
+	mock.lockModifySplitTunnel.RUnlock()
+	return calls
+}
+
+// ModifyTunnelDetectionTime calls ModifyTunnelDetectionTimeFunc.
+func (mock *GatewayClientMock) ModifyTunnelDetectionTime(entity string, detectionTime int) error {
+	if mock.ModifyTunnelDetectionTimeFunc == nil {
+		panic("GatewayClientMock.ModifyTunnelDetectionTimeFunc: method is nil but GatewayClient.ModifyTunnelDetectionTime was just called")
+	}
+	callInfo := struct {
+		Entity        string
+		DetectionTime int
+	}{
+		Entity:        entity,
+		DetectionTime: detectionTime,
+	}
+	mock.lockModifyTunnelDetectionTime.Lock()
+	mock.calls.ModifyTunnelDetectionTime = append(mock.calls.ModifyTunnelDetectionTime, callInfo)
+	mock.lockModifyTunnelDetectionTime.Unlock()
+	return mock.ModifyTunnelDetectionTimeFunc(entity, detectionTime)
+}
+
+// ModifyTunnelDetectionTimeCalls gets all the calls that were made to ModifyTunnelDetectionTime.
+// Check the length with:
+//
+//	len(mockedGatewayClient.ModifyTunnelDetectionTimeCalls())
+func (mock *GatewayClientMock) ModifyTunnelDetectionTimeCalls() []struct {
+	Entity        string
+	DetectionTime int
+} {
+	var calls []struct {
+		Entity        string
+		DetectionTime int
+	}
+	mock.lockModifyTunnelDetectionTime.RLock()
+	calls = mock.calls.ModifyTunnelDetectionTime
+	mock.lockModifyTunnelDetectionTime.RUnlock()
+	return calls
+}
+
+// SetBgpBfdPollingTime calls SetBgpBfdPollingTimeFunc.
+func (mock *GatewayClientMock) SetBgpBfdPollingTime(transitGateway *TransitVpc, newPollingTime int) error {
+	if mock.SetBgpBfdPollingTimeFunc == nil {
+		panic("GatewayClientMock.SetBgpBfdPollingTimeFunc: method is nil but GatewayClient.SetBgpBfdPollingTime was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+		NewPollingTime int
+	}{
+		TransitGateway: transitGateway,
+		NewPollingTime: newPollingTime,
+	}
+	mock.lockSetBgpBfdPollingTime.Lock()
+	mock.calls.SetBgpBfdPollingTime = append(mock.calls.SetBgpBfdPollingTime, callInfo)
+	mock.lockSetBgpBfdPollingTime.Unlock()
+	return mock.SetBgpBfdPollingTimeFunc(transitGateway, newPollingTime)
+}
+
+// SetBgpBfdPollingTimeCalls gets all the calls that were made to SetBgpBfdPollingTime.
+// Check the length with:
+//
+//	len(mockedGatewayClient.SetBgpBfdPollingTimeCalls())
+func (mock *GatewayClientMock) SetBgpBfdPollingTimeCalls() []struct {
+	TransitGateway *TransitVpc
+	NewPollingTime int
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+		NewPollingTime int
+	}
+	mock.lockSetBgpBfdPollingTime.RLock()
+	calls = mock.calls.SetBgpBfdPollingTime
+	mock.lockSetBgpBfdPollingTime.RUnlock()
+	return calls
+}
+
+// SetBgpBfdPollingTimeGatewayGroup calls SetBgpBfdPollingTimeGatewayGroupFunc.
+func (mock *GatewayClientMock) SetBgpBfdPollingTimeGatewayGroup(ctx context.Context, groupName string, pollingTime int) error {
+	if mock.SetBgpBfdPollingTimeGatewayGroupFunc == nil {
+		panic("GatewayClientMock.SetBgpBfdPollingTimeGatewayGroupFunc: method is nil but GatewayClient.SetBgpBfdPollingTimeGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		GroupName   string
+		PollingTime int
+	}{
+		Ctx:         ctx,
+		GroupName:   groupName,
+		PollingTime: pollingTime,
+	}
+	mock.lockSetBgpBfdPollingTimeGatewayGroup.Lock()
+	mock.calls.SetBgpBfdPollingTimeGatewayGroup = append(mock.calls.SetBgpBfdPollingTimeGatewayGroup, callInfo)
+	mock.lockSetBgpBfdPollingTimeGatewayGroup.Unlock()
+	return mock.SetBgpBfdPollingTimeGatewayGroupFunc(ctx, groupName, pollingTime)
+}
+
+// SetBgpBfdPollingTimeGatewayGroupCalls gets all the calls that were made to SetBgpBfdPollingTimeGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.SetBgpBfdPollingTimeGatewayGroupCalls())
+func (mock *GatewayClientMock) SetBgpBfdPollingTimeGatewayGroupCalls() []struct {
+	Ctx         context.Context
+	GroupName   string
+	PollingTime int
+} {
+	var calls []struct {
+		Ctx         context.Context
+		GroupName   string
+		PollingTime int
+	}
+	mock.lockSetBgpBfdPollingTimeGatewayGroup.RLock()
+	calls = mock.calls.SetBgpBfdPollingTimeGatewayGroup
+	mock.lockSetBgpBfdPollingTimeGatewayGroup.RUnlock()
+	return calls
+}
+
+// SetBgpBfdPollingTimeSpoke calls SetBgpBfdPollingTimeSpokeFunc.
+func (mock *GatewayClientMock) SetBgpBfdPollingTimeSpoke(spokeGateway *SpokeVpc, newPollingTime int) error {
+	if mock.SetBgpBfdPollingTimeSpokeFunc == nil {
+		panic("GatewayClientMock.SetBgpBfdPollingTimeSpokeFunc: method is nil but GatewayClient.SetBgpBfdPollingTimeSpoke was just called")
+	}
+	callInfo := struct {
+		SpokeGateway   *SpokeVpc
+		NewPollingTime int
+	}{
+		SpokeGateway:   spokeGateway,
+		NewPollingTime: newPollingTime,
+	}
+	mock.lockSetBgpBfdPollingTimeSpoke.Lock()
+	mock.calls.SetBgpBfdPollingTimeSpoke = append(mock.calls.SetBgpBfdPollingTimeSpoke, callInfo)
+	mock.lockSetBgpBfdPollingTimeSpoke.Unlock()
+	return mock.SetBgpBfdPollingTimeSpokeFunc(spokeGateway, newPollingTime)
+}
+
+// SetBgpBfdPollingTimeSpokeCalls gets all the calls that were made to SetBgpBfdPollingTimeSpoke.
+// Check the length with:
+//
+//	len(mockedGatewayClient.SetBgpBfdPollingTimeSpokeCalls())
+func (mock *GatewayClientMock) SetBgpBfdPollingTimeSpokeCalls() []struct {
+	SpokeGateway   *SpokeVpc
+	NewPollingTime int
+} {
+	var calls []struct {
+		SpokeGateway   *SpokeVpc
+		NewPollingTime int
+	}
+	mock.lockSetBgpBfdPollingTimeSpoke.RLock()
+	calls = mock.calls.SetBgpBfdPollingTimeSpoke
+	mock.lockSetBgpBfdPollingTimeSpoke.RUnlock()
+	return calls
+}
+
+// SetBgpEcmp calls SetBgpEcmpFunc.
+func (mock *GatewayClientMock) SetBgpEcmp(transitGateway *TransitVpc, enabled bool) error {
+	if mock.SetBgpEcmpFunc == nil {
+		panic("GatewayClientMock.SetBgpEcmpFunc: method is nil but GatewayClient.SetBgpEcmp was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+		Enabled        bool
+	}{
+		TransitGateway: transitGateway,
+		Enabled:        enabled,
+	}
+	mock.lockSetBgpEcmp.Lock()
+	mock.calls.SetBgpEcmp = append(mock.calls.SetBgpEcmp, callInfo)
+	mock.lockSetBgpEcmp.Unlock()
+	return mock.SetBgpEcmpFunc(transitGateway, enabled)
+}
+
+// SetBgpEcmpCalls gets all the calls that were made to SetBgpEcmp.
+// Check the length with:
+//
+//	len(mockedGatewayClient.SetBgpEcmpCalls())
+func (mock *GatewayClientMock) SetBgpEcmpCalls() []struct {
+	TransitGateway *TransitVpc
+	Enabled        bool
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+		Enabled        bool
+	}
+	mock.lockSetBgpEcmp.RLock()
+	calls = mock.calls.SetBgpEcmp
+	mock.lockSetBgpEcmp.RUnlock()
+	return calls
+}
+
+// SetBgpEcmpGatewayGroup calls SetBgpEcmpGatewayGroupFunc.
+func (mock *GatewayClientMock) SetBgpEcmpGatewayGroup(ctx context.Context, groupName string, enable bool) error {
+	if mock.SetBgpEcmpGatewayGroupFunc == nil {
+		panic("GatewayClientMock.SetBgpEcmpGatewayGroupFunc: method is nil but GatewayClient.SetBgpEcmpGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+		Enable    bool
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+		Enable:    enable,
+	}
+	mock.lockSetBgpEcmpGatewayGroup.Lock()
+	mock.calls.SetBgpEcmpGatewayGroup = append(mock.calls.SetBgpEcmpGatewayGroup, callInfo)
+	mock.lockSetBgpEcmpGatewayGroup.Unlock()
+	return mock.SetBgpEcmpGatewayGroupFunc(ctx, groupName, enable)
+}
+
+// SetBgpEcmpGatewayGroupCalls gets all the calls that were made to SetBgpEcmpGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.SetBgpEcmpGatewayGroupCalls())
+func (mock *GatewayClientMock) SetBgpEcmpGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+	Enable    bool
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+		Enable    bool
+	}
+	mock.lockSetBgpEcmpGatewayGroup.RLock()
+	calls = mock.calls.SetBgpEcmpGatewayGroup
+	mock.lockSetBgpEcmpGatewayGroup.RUnlock()
+	return calls
+}
+
+// SetBgpEcmpSpoke calls SetBgpEcmpSpokeFunc.
+func (mock *GatewayClientMock) SetBgpEcmpSpoke(spokeGateway *SpokeVpc, enabled bool) error {
+	if mock.SetBgpEcmpSpokeFunc == nil {
+		panic("GatewayClientMock.SetBgpEcmpSpokeFunc: method is nil but GatewayClient.SetBgpEcmpSpoke was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+		Enabled      bool
+	}{
+		SpokeGateway: spokeGateway,
+		Enabled:      enabled,
+	}
+	mock.lockSetBgpEcmpSpoke.Lock()
+	mock.calls.SetBgpEcmpSpoke = append(mock.calls.SetBgpEcmpSpoke, callInfo)
+	mock.lockSetBgpEcmpSpoke.Unlock()
+	return mock.SetBgpEcmpSpokeFunc(spokeGateway, enabled)
+}
+
+// SetBgpEcmpSpokeCalls gets all the calls that were made to SetBgpEcmpSpoke.
+// Check the length with:
+//
+//	len(mockedGatewayClient.SetBgpEcmpSpokeCalls())
+func (mock *GatewayClientMock) SetBgpEcmpSpokeCalls() []struct {
+	SpokeGateway *SpokeVpc
+	Enabled      bool
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+		Enabled      bool
+	}
+	mock.lockSetBgpEcmpSpoke.RLock()
+	calls = mock.calls.SetBgpEcmpSpoke
+	mock.lockSetBgpEcmpSpoke.RUnlock()
+	return calls
+}
+
+// SetBgpManualSpokeAdvertisedNetworks calls SetBgpManualSpokeAdvertisedNetworksFunc.
+func (mock *GatewayClientMock) SetBgpManualSpokeAdvertisedNetworks(transitGw *TransitVpc) error {
+	if mock.SetBgpManualSpokeAdvertisedNetworksFunc == nil {
+		panic("GatewayClientMock.SetBgpManualSpokeAdvertisedNetworksFunc: method is nil but GatewayClient.SetBgpManualSpokeAdvertisedNetworks was just called")
+	}
+	callInfo := struct {
+		TransitGw *TransitVpc
+	}{
+		TransitGw: transitGw,
+	}
+	mock.lockSetBgpManualSpokeAdvertisedNetworks.Lock()
+	mock.calls.SetBgpManualSpokeAdvertisedNetworks = append(mock.calls.SetBgpManualSpokeAdvertisedNetworks, callInfo)
+	mock.lockSetBgpManualSpokeAdvertisedNetworks.Unlock()
+	return mock.SetBgpManualSpokeAdvertisedNetworksFunc(transitGw)
+}
+
+// SetBgpManualSpokeAdvertisedNetworksCalls gets all the calls that were made to SetBgpManualSpokeAdvertisedNetworks.
+// Check the length with:
+//
+//	len(mockedGatewayClient.SetBgpManualSpokeAdvertisedNetworksCalls())
+func (mock *GatewayClientMock) SetBgpManualSpokeAdvertisedNetworksCalls() []struct {
+	TransitGw *TransitVpc
+} {
+	var calls []struct {
+		TransitGw *TransitVpc
+	}
+	mock.lockSetBgpManualSpokeAdvertisedNetworks.RLock()
+	calls = mock.calls.SetBgpManualSpokeAdvertisedNetworks
+	mock.lockSetBgpManualSpokeAdvertisedNetworks.RUnlock()
+	return calls
+}
+
+// SetBgpPollingTime calls SetBgpPollingTimeFunc.
+func (mock *GatewayClientMock) SetBgpPollingTime(transitGateway *TransitVpc, newPollingTime int) error {
+	if mock.SetBgpPollingTimeFunc == nil {
+		panic("GatewayClientMock.SetBgpPollingTimeFunc: method is nil but GatewayClient.SetBgpPollingTime was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+		NewPollingTime int
+	}{
+		TransitGateway: transitGateway,
+		NewPollingTime: newPollingTime,
+	}
+	mock.lockSetBgpPollingTime.Lock()
+	mock.calls.SetBgpPollingTime = append(mock.calls.SetBgpPollingTime, callInfo)
+	mock.lockSetBgpPollingTime.Unlock()
+	return mock.SetBgpPollingTimeFunc(transitGateway, newPollingTime)
+}
+
+// SetBgpPollingTimeCalls gets all the calls that were made to SetBgpPollingTime.
+// Check the length with:
+//
+//	len(mockedGatewayClient.SetBgpPollingTimeCalls())
+func (mock *GatewayClientMock) SetBgpPollingTimeCalls() []struct {
+	TransitGateway *TransitVpc
+	NewPollingTime int
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+		NewPollingTime int
+	}
+	mock.lockSetBgpPollingTime.RLock()
+	calls = mock.calls.SetBgpPollingTime
+	mock.lockSetBgpPollingTime.RUnlock()
+	return calls
+}
+
+// SetBgpPollingTimeGatewayGroup calls SetBgpPollingTimeGatewayGroupFunc.
+func (mock *GatewayClientMock) SetBgpPollingTimeGatewayGroup(ctx context.Context, groupName string, pollingTime int) error {
+	if mock.SetBgpPollingTimeGatewayGroupFunc == nil {
+		panic("GatewayClientMock.SetBgpPollingTimeGatewayGroupFunc: method is nil but GatewayClient.SetBgpPollingTimeGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		GroupName   string
+		PollingTime int
+	}{
+		Ctx:         ctx,
+		GroupName:   groupName,
+		PollingTime: pollingTime,
+	}
+	mock.lockSetBgpPollingTimeGatewayGroup.Lock()
+	mock.calls.SetBgpPollingTimeGatewayGroup = append(mock.calls.SetBgpPollingTimeGatewayGroup, callInfo)
+	mock.lockSetBgpPollingTimeGatewayGroup.Unlock()
+	return mock.SetBgpPollingTimeGatewayGroupFunc(ctx, groupName, pollingTime)
+}
+
+// SetBgpPollingTimeGatewayGroupCalls gets all the calls that were made to SetBgpPollingTimeGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.SetBgpPollingTimeGatewayGroupCalls())
+func (mock *GatewayClientMock) SetBgpPollingTimeGatewayGroupCalls() []struct {
+	Ctx         context.Context
+	GroupName   string
+	PollingTime int
+} {
+	var calls []struct {
+		Ctx         context.Context
+		GroupName   string
+		PollingTime int
+	}
+	mock.lockSetBgpPollingTimeGatewayGroup.RLock()
+	calls = mock.calls.SetBgpPollingTimeGatewayGroup
+	mock.lockSetBgpPollingTimeGatewayGroup.RUnlock()
+	return calls
+}
+
+// SetBgpPollingTimeSpoke calls SetBgpPollingTimeSpokeFunc.
+func (mock *GatewayClientMock) SetBgpPollingTimeSpoke(spokeGateway *SpokeVpc, newPollingTime int) error {
+	if mock.SetBgpPollingTimeSpokeFunc == nil {
+		panic("GatewayClientMock.SetBgpPollingTimeSpokeFunc: method is nil but GatewayClient.SetBgpPollingTimeSpoke was just called")
+	}
+	callInfo := struct {
+		SpokeGateway   *SpokeVpc
+		NewPollingTime int
+	}{
+		SpokeGateway:   spokeGateway,
+		NewPollingTime: newPollingTime,
+	}
+	mock.lockSetBgpPollingTimeSpoke.Lock()
+	mock.calls.SetBgpPollingTimeSpoke = append(mock.calls.SetBgpPollingTimeSpoke, callInfo)
+	mock.lockSetBgpPollingTimeSpoke.Unlock()
+	return mock.SetBgpPollingTimeSpokeFunc(spokeGateway, newPollingTime)
+}
+
+// SetBgpPollingTimeSpokeCalls gets all the calls that were made to SetBgpPollingTimeSpoke.
+// Check the length with:
+//
+//	len(mockedGatewayClient.SetBgpPollingTimeSpokeCalls())
+func (mock *GatewayClientMock) SetBgpPollingTimeSpokeCalls() []struct {
+	SpokeGateway   *SpokeVpc
+	NewPollingTime int
+} {
+	var calls []struct {
+		SpokeGateway   *SpokeVpc
+		NewPollingTime int
+	}
+	mock.lockSetBgpPollingTimeSpoke.RLock()
+	calls = mock.calls.SetBgpPollingTimeSpoke
+	mock.lockSetBgpPollingTimeSpoke.RUnlock()
+	return calls
+}
+
+// SetGatewayBgpCommunitiesAccept calls SetGatewayBgpCommunitiesAcceptFunc.
+func (mock *GatewayClientMock) SetGatewayBgpCommunitiesAccept(gwName string, acceptComm bool) error {
+	if mock.SetGatewayBgpCommunitiesAcceptFunc == nil {
+		panic("GatewayClientMock.SetGatewayBgpCommunitiesAcceptFunc: method is nil but GatewayClient.SetGatewayBgpCommunitiesAccept was just called")
+	}
+	callInfo := struct {
+		GwName     string
+		AcceptComm bool
+	}{
+		GwName:     gwName,
+		AcceptComm: acceptComm,
+	}
+	mock.lockSetGatewayBgpCommunitiesAccept.Lock()
+	mock.calls.SetGatewayBgpCommunitiesAccept = append(mock.calls.SetGatewayBgpCommunitiesAccept, callInfo)
+	mock.lockSetGatewayBgpCommunitiesAccept.Unlock()
+	return mock.SetGatewayBgpCommunitiesAcceptFunc(gwName, acceptComm)
+}
+
+// SetGatewayBgpCommunitiesAcceptCalls gets all the calls that were made to SetGatewayBgpCommunitiesAccept.
+// Check the length with:
+//
+//	len(mockedGatewayClient.SetGatewayBgpCommunitiesAcceptCalls())
+func (mock *GatewayClientMock) SetGatewayBgpCommunitiesAcceptCalls() []struct {
+	GwName     string
+	AcceptComm bool
+} {
+	var calls []struct {
+		GwName     string
+		AcceptComm bool
+	}
+	mock.lockSetGatewayBgpCommunitiesAccept.RLock()
+	calls = mock.calls.SetGatewayBgpCommunitiesAccept
+	mock.lockSetGatewayBgpCommunitiesAccept.RUnlock()
+	return calls
+}
+
+// SetGatewayBgpCommunitiesSend calls SetGatewayBgpCommunitiesSendFunc.
+func (mock *GatewayClientMock) SetGatewayBgpCommunitiesSend(gwName string, sendComm bool) error {
+	if mock.SetGatewayBgpCommunitiesSendFunc == nil {
+		panic("GatewayClientMock.SetGatewayBgpCommunitiesSendFunc: method is nil but GatewayClient.SetGatewayBgpCommunitiesSend was just called")
+	}
+	callInfo := struct {
+		GwName   string
+		SendComm bool
+	}{
+		GwName:   gwName,
+		SendComm: sendComm,
+	}
+	mock.lockSetGatewayBgpCommunitiesSend.Lock()
+	mock.calls.SetGatewayBgpCommunitiesSend = append(mock.calls.SetGatewayBgpCommunitiesSend, callInfo)
+	mock.lockSetGatewayBgpCommunitiesSend.Unlock()
+	return mock.SetGatewayBgpCommunitiesSendFunc(gwName, sendComm)
+}
+
+// SetGatewayBgpCommunitiesSendCalls gets all the calls that were made to SetGatewayBgpCommunitiesSend.
+// Check the length with:
+//
+//	len(mockedGatewayClient.SetGatewayBgpCommunitiesSendCalls())
+func (mock *GatewayClientMock) SetGatewayBgpCommunitiesSendCalls() []struct {
+	GwName   string
+	SendComm bool
+} {
+	var calls []struct {
+		GwName   string
+		SendComm bool
+	}
+	mock.lockSetGatewayBgpCommunitiesSend.RLock()
+	calls = mock.calls.SetGatewayBgpCommunitiesSend
+	mock.lockSetGatewayBgpCommunitiesSend.RUnlock()
+	return calls
+}
+
+// SetGatewayBgpMedToSdnMetric calls SetGatewayBgpMedToSdnMetricFunc.
+func (mock *GatewayClientMock) SetGatewayBgpMedToSdnMetric(gwName string, override bool) error {
+	if mock.SetGatewayBgpMedToSdnMetricFunc == nil {
+		panic("GatewayClientMock.SetGatewayBgpMedToSdnMetricFunc: method is nil but GatewayClient.SetGatewayBgpMedToSdnMetric was just called")
+	}
+	callInfo := struct {
+		GwName   string
+		Override bool
+	}{
+		GwName:   gwName,
+		Override: override,
+	}
+	mock.lockSetGatewayBgpMedToSdnMetric.Lock()
+	mock.calls.SetGatewayBgpMedToSdnMetric = append(mock.calls.SetGatewayBgpMedToSdnMetric, callInfo)
+	mock.lockSetGatewayBgpMedToSdnMetric.Unlock()
+	return mock.SetGatewayBgpMedToSdnMetricFunc(gwName, override)
+}
+
+// SetGatewayBgpMedToSdnMetricCalls gets all the calls that were made to SetGatewayBgpMedToSdnMetric.
+// Check the length with:
+//
+//	len(mockedGatewayClient.SetGatewayBgpMedToSdnMetricCalls())
+func (mock *GatewayClientMock) SetGatewayBgpMedToSdnMetricCalls() []struct {
+	GwName   string
+	Override bool
+} {
+	var calls []struct {
+		GwName   string
+		Override bool
+	}
+	mock.lockSetGatewayBgpMedToSdnMetric.RLock()
+	calls = mock.calls.SetGatewayBgpMedToSdnMetric
+	mock.lockSetGatewayBgpMedToSdnMetric.RUnlock()
+	return calls
+}
+
+// SetGatewayGroupBgpCommunitiesAccept calls SetGatewayGroupBgpCommunitiesAcceptFunc.
+func (mock *GatewayClientMock) SetGatewayGroupBgpCommunitiesAccept(ctx context.Context, groupName string, accept bool) error {
+	if mock.SetGatewayGroupBgpCommunitiesAcceptFunc == nil {
+		panic("GatewayClientMock.SetGatewayGroupBgpCommunitiesAcceptFunc: method is nil but GatewayClient.SetGatewayGroupBgpCommunitiesAccept was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+		Accept    bool
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+		Accept:    accept,
+	}
+	mock.lockSetGatewayGroupBgpCommunitiesAccept.Lock()
+	mock.calls.SetGatewayGroupBgpCommunitiesAccept = append(mock.calls.SetGatewayGroupBgpCommunitiesAccept, callInfo)
+	mock.lockSetGatewayGroupBgpCommunitiesAccept.Unlock()
+	return mock.SetGatewayGroupBgpCommunitiesAcceptFunc(ctx, groupName, accept)
+}
+
+// SetGatewayGroupBgpCommunitiesAcceptCalls gets all the calls that were made to SetGatewayGroupBgpCommunitiesAccept.
+// Check the length with:
+//
+//	len(mockedGatewayClient.SetGatewayGroupBgpCommunitiesAcceptCalls())
+func (mock *GatewayClientMock) SetGatewayGroupBgpCommunitiesAcceptCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+	Accept    bool
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+		Accept    bool
+	}
+	mock.lockSetGatewayGroupBgpCommunitiesAccept.RLock()
+	calls = mock.calls.SetGatewayGroupBgpCommunitiesAccept
+	mock.lockSetGatewayGroupBgpCommunitiesAccept.RUnlock()
+	return calls
+}
+
+// SetGatewayGroupBgpCommunitiesSend calls SetGatewayGroupBgpCommunitiesSendFunc.
+func (mock *GatewayClientMock) SetGatewayGroupBgpCommunitiesSend(ctx context.Context, groupName string, send bool) error {
+	if mock.SetGatewayGroupBgpCommunitiesSendFunc == nil {
+		panic("GatewayClientMock.SetGatewayGroupBgpCommunitiesSendFunc: method is nil but GatewayClient.SetGatewayGroupBgpCommunitiesSend was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+		Send      bool
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+		Send:      send,
+	}
+	mock.lockSetGatewayGroupBgpCommunitiesSend.Lock()
+	mock.calls.SetGatewayGroupBgpCommunitiesSend = append(mock.calls.SetGatewayGroupBgpCommunitiesSend, callInfo)
+	mock.lockSetGatewayGroupBgpCommunitiesSend.Unlock()
+	return mock.SetGatewayGroupBgpCommunitiesSendFunc(ctx, groupName, send)
+}
+
+// SetGatewayGroupBgpCommunitiesSendCalls gets all the calls that were made to SetGatewayGroupBgpCommunitiesSend.
+// Check the length with:
+//
+//	len(mockedGatewayClient.SetGatewayGroupBgpCommunitiesSendCalls())
+func (mock *GatewayClientMock) SetGatewayGroupBgpCommunitiesSendCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+	Send      bool
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+		Send      bool
+	}
+	mock.lockSetGatewayGroupBgpCommunitiesSend.RLock()
+	calls = mock.calls.SetGatewayGroupBgpCommunitiesSend
+	mock.lockSetGatewayGroupBgpCommunitiesSend.RUnlock()
+	return calls
+}
+
+// SetGatewayPhase2Policy calls SetGatewayPhase2PolicyFunc.
+func (mock *GatewayClientMock) SetGatewayPhase2Policy(gwName string, encPolicy string, pfsPolicy string) error {
+	if mock.SetGatewayPhase2PolicyFunc == nil {
+		panic("GatewayClientMock.SetGatewayPhase2PolicyFunc: method is nil but GatewayClient.SetGatewayPhase2Policy was just called")
+	}
+	callInfo := struct {
+		GwName    string
+		EncPolicy string
+		PfsPolicy string
+	}{
+		GwName:    gwName,
+		EncPolicy: encPolicy,
+		PfsPolicy: pfsPolicy,
+	}
+	mock.lockSetGatewayPhase2Policy.Lock()
+	mock.calls.SetGatewayPhase2Policy = append(mock.calls.SetGatewayPhase2Policy, callInfo)
+	mock.lockSetGatewayPhase2Policy.Unlock()
+	return mock.SetGatewayPhase2PolicyFunc(gwName, encPolicy, pfsPolicy)
+}
+
+// SetGatewayPhase2PolicyCalls gets all the calls that were made to SetGatewayPhase2Policy.
+// Check the length with:
+//
+//	len(mockedGatewayClient.SetGatewayPhase2PolicyCalls())
+func (mock *GatewayClientMock) SetGatewayPhase2PolicyCalls() []struct {
+	GwName    string
+	EncPolicy string
+	PfsPolicy string
+} {
+	var calls []struct {
+		GwName    string
+		EncPolicy string
+		PfsPolicy string
+	}
+	mock.lockSetGatewayPhase2Policy.RLock()
+	calls = mock.calls.SetGatewayPhase2Policy
+	mock.lockSetGatewayPhase2Policy.RUnlock()
+	return calls
+}
+
+// SetLocalASNumber calls SetLocalASNumberFunc.
+func (mock *GatewayClientMock) SetLocalASNumber(transitGateway *TransitVpc, localASNumber string) error {
+	if mock.SetLocalASNumberFunc == nil {
+		panic("GatewayClientMock.SetLocalASNumberFunc: method is nil but GatewayClient.SetLocalASNumber was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+		LocalASNumber  string
+	}{
+		TransitGateway: transitGateway,
+		LocalASNumber:  localASNumber,
+	}
+	mock.lockSetLocalASNumber.Lock()
+	mock.calls.SetLocalASNumber = append(mock.calls.SetLocalASNumber, callInfo)
+	mock.lockSetLocalASNumber.Unlock()
+	return mock.SetLocalASNumberFunc(transitGateway, localASNumber)
+}
+
+// SetLocalASNumberCalls gets all the calls that were made to SetLocalASNumber.
+// Check the length with:
+//
+//	len(mockedGatewayClient.SetLocalASNumberCalls())
+func (mock *GatewayClientMock) SetLocalASNumberCalls() []struct {
+	TransitGateway *TransitVpc
+	LocalASNumber  string
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+		LocalASNumber  string
+	}
+	mock.lockSetLocalASNumber.RLock()
+	calls = mock.calls.SetLocalASNumber
+	mock.lockSetLocalASNumber.RUnlock()
+	return calls
+}
+
+// SetLocalASNumberGatewayGroup calls SetLocalASNumberGatewayGroupFunc.
+func (mock *GatewayClientMock) SetLocalASNumberGatewayGroup(ctx context.Context, groupName string, localAsNumber string) error {
+	if mock.SetLocalASNumberGatewayGroupFunc == nil {
+		panic("GatewayClientMock.SetLocalASNumberGatewayGroupFunc: method is nil but GatewayClient.SetLocalASNumberGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		GroupName     string
+		LocalAsNumber string
+	}{
+		Ctx:           ctx,
+		GroupName:     groupName,
+		LocalAsNumber: localAsNumber,
+	}
+	mock.lockSetLocalASNumberGatewayGroup.Lock()
+	mock.calls.SetLocalASNumberGatewayGroup = append(mock.calls.SetLocalASNumberGatewayGroup, callInfo)
+	mock.lockSetLocalASNumberGatewayGroup.Unlock()
+	return mock.SetLocalASNumberGatewayGroupFunc(ctx, groupName, localAsNumber)
+}
+
+// SetLocalASNumberGatewayGroupCalls gets all the calls that were made to SetLocalASNumberGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.SetLocalASNumberGatewayGroupCalls())
+func (mock *GatewayClientMock) SetLocalASNumberGatewayGroupCalls() []struct {
+	Ctx           context.Context
+	GroupName     string
+	LocalAsNumber string
+} {
+	var calls []struct {
+		Ctx           context.Context
+		GroupName     string
+		LocalAsNumber string
+	}
+	mock.lockSetLocalASNumberGatewayGroup.RLock()
+	calls = mock.calls.SetLocalASNumberGatewayGroup
+	mock.lockSetLocalASNumberGatewayGroup.RUnlock()
+	return calls
+}
+
+// SetLocalASNumberSpoke calls SetLocalASNumberSpokeFunc.
+func (mock *GatewayClientMock) SetLocalASNumberSpoke(spokeGateway *SpokeVpc, localASNumber string) error {
+	if mock.SetLocalASNumberSpokeFunc == nil {
+		panic("GatewayClientMock.SetLocalASNumberSpokeFunc: method is nil but GatewayClient.SetLocalASNumberSpoke was just called")
+	}
+	callInfo := struct {
+		SpokeGateway  *SpokeVpc
+		LocalASNumber string
+	}{
+		SpokeGateway:  spokeGateway,
+		LocalASNumber: localASNumber,
+	}
+	mock.lockSetLocalASNumberSpoke.Lock()
+	mock.calls.SetLocalASNumberSpoke = append(mock.calls.SetLocalASNumberSpoke, callInfo)
+	mock.lockSetLocalASNumberSpoke.Unlock()
+	return mock.SetLocalASNumberSpokeFunc(spokeGateway, localASNumber)
+}
+
+// SetLocalASNumberSpokeCalls gets all the calls that were made to SetLocalASNumberSpoke.
+// Check the length with:
+//
+//	len(mockedGatewayClient.SetLocalASNumberSpokeCalls())
+func (mock *GatewayClientMock) SetLocalASNumberSpokeCalls() []struct {
+	SpokeGateway  *SpokeVpc
+	LocalASNumber string
+} {
+	var calls []struct {
+		SpokeGateway  *SpokeVpc
+		LocalASNumber string
+	}
+	mock.lockSetLocalASNumberSpoke.RLock()
+	calls = mock.calls.SetLocalASNumberSpoke
+	mock.lockSetLocalASNumberSpoke.RUnlock()
+	return calls
+}
+
+// SetPrependASPath calls SetPrependASPathFunc.
+func (mock *GatewayClientMock) SetPrependASPath(transitGateway *TransitVpc, prependASPath []string) error {
+	if mock.SetPrependASPathFunc == nil {
+		panic("GatewayClientMock.SetPrependASPathFunc: method is nil but GatewayClient.SetPrependASPath was just called")
+	}
+	callInfo := struct {
+		TransitGateway *TransitVpc
+		PrependASPath  []string
+	}{
+		TransitGateway: transitGateway,
+		PrependASPath:  prependASPath,
+	}
+	mock.lockSetPrependASPath.Lock()
+	mock.calls.SetPrependASPath = append(mock.calls.SetPrependASPath, callInfo)
+	mock.lockSetPrependASPath.Unlock()
+	return mock.SetPrependASPathFunc(transitGateway, prependASPath)
+}
+
+// SetPrependASPathCalls gets all the calls that were made to SetPrependASPath.
+// Check the length with:
+//
+//	len(mockedGatewayClient.SetPrependASPathCalls())
+func (mock *GatewayClientMock) SetPrependASPathCalls() []struct {
+	TransitGateway *TransitVpc
+	PrependASPath  []string
+} {
+	var calls []struct {
+		TransitGateway *TransitVpc
+		PrependASPath  []string
+	}
+	mock.lockSetPrependASPath.RLock()
+	calls = mock.calls.SetPrependASPath
+	mock.lockSetPrependASPath.RUnlock()
+	return calls
+}
+
+// SetPrependASPathGatewayGroup calls SetPrependASPathGatewayGroupFunc.
+func (mock *GatewayClientMock) SetPrependASPathGatewayGroup(ctx context.Context, groupName string, prependAsPath []string) error {
+	if mock.SetPrependASPathGatewayGroupFunc == nil {
+		panic("GatewayClientMock.SetPrependASPathGatewayGroupFunc: method is nil but GatewayClient.SetPrependASPathGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		GroupName     string
+		PrependAsPath []string
+	}{
+		Ctx:           ctx,
+		GroupName:     groupName,
+		PrependAsPath: prependAsPath,
+	}
+	mock.lockSetPrependASPathGatewayGroup.Lock()
+	mock.calls.SetPrependASPathGatewayGroup = append(mock.calls.SetPrependASPathGatewayGroup, callInfo)
+	mock.lockSetPrependASPathGatewayGroup.Unlock()
+	return mock.SetPrependASPathGatewayGroupFunc(ctx, groupName, prependAsPath)
+}
+
+// SetPrependASPathGatewayGroupCalls gets all the calls that were made to SetPrependASPathGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.SetPrependASPathGatewayGroupCalls())
+func (mock *GatewayClientMock) SetPrependASPathGatewayGroupCalls() []struct {
+	Ctx           context.Context
+	GroupName     string
+	PrependAsPath []string
+} {
+	var calls []struct {
+		Ctx           context.Context
+		GroupName     string
+		PrependAsPath []string
+	}
+	mock.lockSetPrependASPathGatewayGroup.RLock()
+	calls = mock.calls.SetPrependASPathGatewayGroup
+	mock.lockSetPrependASPathGatewayGroup.RUnlock()
+	return calls
+}
+
+// SetPrependASPathSpoke calls SetPrependASPathSpokeFunc.
+func (mock *GatewayClientMock) SetPrependASPathSpoke(spokeGateway *SpokeVpc, prependASPath []string) error {
+	if mock.SetPrependASPathSpokeFunc == nil {
+		panic("GatewayClientMock.SetPrependASPathSpokeFunc: method is nil but GatewayClient.SetPrependASPathSpoke was just called")
+	}
+	callInfo := struct {
+		SpokeGateway  *SpokeVpc
+		PrependASPath []string
+	}{
+		SpokeGateway:  spokeGateway,
+		PrependASPath: prependASPath,
+	}
+	mock.lockSetPrependASPathSpoke.Lock()
+	mock.calls.SetPrependASPathSpoke = append(mock.calls.SetPrependASPathSpoke, callInfo)
+	mock.lockSetPrependASPathSpoke.Unlock()
+	return mock.SetPrependASPathSpokeFunc(spokeGateway, prependASPath)
+}
+
+// SetPrependASPathSpokeCalls gets all the calls that were made to SetPrependASPathSpoke.
+// Check the length with:
+//
+//	len(mockedGatewayClient.SetPrependASPathSpokeCalls())
+func (mock *GatewayClientMock) SetPrependASPathSpokeCalls() []struct {
+	SpokeGateway  *SpokeVpc
+	PrependASPath []string
+} {
+	var calls []struct {
+		SpokeGateway  *SpokeVpc
+		PrependASPath []string
+	}
+	mock.lockSetPrependASPathSpoke.RLock()
+	calls = mock.calls.SetPrependASPathSpoke
+	mock.lockSetPrependASPathSpoke.RUnlock()
+	return calls
+}
+
+// SetRxQueueSize calls SetRxQueueSizeFunc.
+func (mock *GatewayClientMock) SetRxQueueSize(gateway *Gateway) error {
+	if mock.SetRxQueueSizeFunc == nil {
+		panic("GatewayClientMock.SetRxQueueSizeFunc: method is nil but GatewayClient.SetRxQueueSize was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockSetRxQueueSize.Lock()
+	mock.calls.SetRxQueueSize = append(mock.calls.SetRxQueueSize, callInfo)
+	mock.lockSetRxQueueSize.Unlock()
+	return mock.SetRxQueueSizeFunc(gateway)
+}
+
+// SetRxQueueSizeCalls gets all the calls that were made to SetRxQueueSize.
+// Check the length with:
+//
+//	len(mockedGatewayClient.SetRxQueueSizeCalls())
+func (mock *GatewayClientMock) SetRxQueueSizeCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockSetRxQueueSize.RLock()
+	calls = mock.calls.SetRxQueueSize
+	mock.lockSetRxQueueSize.RUnlock()
+	return calls
+}
+
+// SetSpokeBgpManualAdvertisedNetworks calls SetSpokeBgpManualAdvertisedNetworksFunc.
+func (mock *GatewayClientMock) SetSpokeBgpManualAdvertisedNetworks(spokeGateway *SpokeVpc) error {
+	if mock.SetSpokeBgpManualAdvertisedNetworksFunc == nil {
+		panic("GatewayClientMock.SetSpokeBgpManualAdvertisedNetworksFunc: method is nil but GatewayClient.SetSpokeBgpManualAdvertisedNetworks was just called")
+	}
+	callInfo := struct {
+		SpokeGateway *SpokeVpc
+	}{
+		SpokeGateway: spokeGateway,
+	}
+	mock.lockSetSpokeBgpManualAdvertisedNetworks.Lock()
+	mock.calls.SetSpokeBgpManualAdvertisedNetworks = append(mock.calls.SetSpokeBgpManualAdvertisedNetworks, callInfo)
+	mock.lockSetSpokeBgpManualAdvertisedNetworks.Unlock()
+	return mock.SetSpokeBgpManualAdvertisedNetworksFunc(spokeGateway)
+}
+
+// SetSpokeBgpManualAdvertisedNetworksCalls gets all the calls that were made to SetSpokeBgpManualAdvertisedNetworks.
+// Check the length with:
+//
+//	len(mockedGatewayClient.SetSpokeBgpManualAdvertisedNetworksCalls())
+func (mock *GatewayClientMock) SetSpokeBgpManualAdvertisedNetworksCalls() []struct {
+	SpokeGateway *SpokeVpc
+} {
+	var calls []struct {
+		SpokeGateway *SpokeVpc
+	}
+	mock.lockSetSpokeBgpManualAdvertisedNetworks.RLock()
+	calls = mock.calls.SetSpokeBgpManualAdvertisedNetworks
+	mock.lockSetSpokeBgpManualAdvertisedNetworks.RUnlock()
+	return calls
+}
+
+// SetSpokeBgpManualAdvertisedNetworksGatewayGroup calls SetSpokeBgpManualAdvertisedNetworksGatewayGroupFunc.
+func (mock *GatewayClientMock) SetSpokeBgpManualAdvertisedNetworksGatewayGroup(ctx context.Context, groupName string, cidrs string) error {
+	if mock.SetSpokeBgpManualAdvertisedNetworksGatewayGroupFunc == nil {
+		panic("GatewayClientMock.SetSpokeBgpManualAdvertisedNetworksGatewayGroupFunc: method is nil but GatewayClient.SetSpokeBgpManualAdvertisedNetworksGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupName string
+		Cidrs     string
+	}{
+		Ctx:       ctx,
+		GroupName: groupName,
+		Cidrs:     cidrs,
+	}
+	mock.lockSetSpokeBgpManualAdvertisedNetworksGatewayGroup.Lock()
+	mock.calls.SetSpokeBgpManualAdvertisedNetworksGatewayGroup = append(mock.calls.SetSpokeBgpManualAdvertisedNetworksGatewayGroup, callInfo)
+	mock.lockSetSpokeBgpManualAdvertisedNetworksGatewayGroup.Unlock()
+	return mock.SetSpokeBgpManualAdvertisedNetworksGatewayGroupFunc(ctx, groupName, cidrs)
+}
+
+// SetSpokeBgpManualAdvertisedNetworksGatewayGroupCalls gets all the calls that were made to SetSpokeBgpManualAdvertisedNetworksGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.SetSpokeBgpManualAdvertisedNetworksGatewayGroupCalls())
+func (mock *GatewayClientMock) SetSpokeBgpManualAdvertisedNetworksGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupName string
+	Cidrs     string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupName string
+		Cidrs     string
+	}
+	mock.lockSetSpokeBgpManualAdvertisedNetworksGatewayGroup.RLock()
+	calls = mock.calls.SetSpokeBgpManualAdvertisedNetworksGatewayGroup
+	mock.lockSetSpokeBgpManualAdvertisedNetworksGatewayGroup.RUnlock()
+	return calls
+}
+
+// SetSymmetricRoutingGatewayGroup calls SetSymmetricRoutingGatewayGroupFunc.
+func (mock *GatewayClientMock) SetSymmetricRoutingGatewayGroup(ctx context.Context, groupUUID string, enable bool) error {
+	if mock.SetSymmetricRoutingGatewayGroupFunc == nil {
+		panic("GatewayClientMock.SetSymmetricRoutingGatewayGroupFunc: method is nil but GatewayClient.SetSymmetricRoutingGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GroupUUID string
+		Enable    bool
+	}{
+		Ctx:       ctx,
+		GroupUUID: groupUUID,
+		Enable:    enable,
+	}
+	mock.lockSetSymmetricRoutingGatewayGroup.Lock()
+	mock.calls.SetSymmetricRoutingGatewayGroup = append(mock.calls.SetSymmetricRoutingGatewayGroup, callInfo)
+	mock.lockSetSymmetricRoutingGatewayGroup.Unlock()
+	return mock.SetSymmetricRoutingGatewayGroupFunc(ctx, groupUUID, enable)
+}
+
+// SetSymmetricRoutingGatewayGroupCalls gets all the calls that were made to SetSymmetricRoutingGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.SetSymmetricRoutingGatewayGroupCalls())
+func (mock *GatewayClientMock) SetSymmetricRoutingGatewayGroupCalls() []struct {
+	Ctx       context.Context
+	GroupUUID string
+	Enable    bool
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GroupUUID string
+		Enable    bool
+	}
+	mock.lockSetSymmetricRoutingGatewayGroup.RLock()
+	calls = mock.calls.SetSymmetricRoutingGatewayGroup
+	mock.lockSetSymmetricRoutingGatewayGroup.RUnlock()
+	return calls
+}
+
+// SetTransitLearnedCIDRsApprovalMode calls SetTransitLearnedCIDRsApprovalModeFunc.
+func (mock *GatewayClientMock) SetTransitLearnedCIDRsApprovalMode(gw *TransitVpc, mode string) error {
+	if mock.SetTransitLearnedCIDRsApprovalModeFunc == nil {
+		panic("GatewayClientMock.SetTransitLearnedCIDRsApprovalModeFunc: method is nil but GatewayClient.SetTransitLearnedCIDRsApprovalMode was just called")
+	}
+	callInfo := struct {
+		Gw   *TransitVpc
+		Mode string
+	}{
+		Gw:   gw,
+		Mode: mode,
+	}
+	mock.lockSetTransitLearnedCIDRsApprovalMode.Lock()
+	mock.calls.SetTransitLearnedCIDRsApprovalMode = append(mock.calls.SetTransitLearnedCIDRsApprovalMode, callInfo)
+	mock.lockSetTransitLearnedCIDRsApprovalMode.Unlock()
+	return mock.SetTransitLearnedCIDRsApprovalModeFunc(gw, mode)
+}
+
+// SetTransitLearnedCIDRsApprovalModeCalls gets all the calls that were made to SetTransitLearnedCIDRsApprovalMode.
+// Check the length with:
+//
+//	len(mockedGatewayClient.SetTransitLearnedCIDRsApprovalModeCalls())
+func (mock *GatewayClientMock) SetTransitLearnedCIDRsApprovalModeCalls() []struct {
+	Gw   *TransitVpc
+	Mode string
+} {
+	var calls []struct {
+		Gw   *TransitVpc
+		Mode string
+	}
+	mock.lockSetTransitLearnedCIDRsApprovalMode.RLock()
+	calls = mock.calls.SetTransitLearnedCIDRsApprovalMode
+	mock.lockSetTransitLearnedCIDRsApprovalMode.RUnlock()
+	return calls
+}
+
+// SetVpnGatewayAuthentication calls SetVpnGatewayAuthenticationFunc.
+func (mock *GatewayClientMock) SetVpnGatewayAuthentication(gateway *VpnGatewayAuth) error {
+	if mock.SetVpnGatewayAuthenticationFunc == nil {
+		panic("GatewayClientMock.SetVpnGatewayAuthenticationFunc: method is nil but GatewayClient.SetVpnGatewayAuthentication was just called")
+	}
+	callInfo := struct {
+		Gateway *VpnGatewayAuth
+	}{
+		Gateway: gateway,
+	}
+	mock.lockSetVpnGatewayAuthentication.Lock()
+	mock.calls.SetVpnGatewayAuthentication = append(mock.calls.SetVpnGatewayAuthentication, callInfo)
+	mock.lockSetVpnGatewayAuthentication.Unlock()
+	return mock.SetVpnGatewayAuthenticationFunc(gateway)
+}
+
+// SetVpnGatewayAuthenticationCalls gets all the calls that were made to SetVpnGatewayAuthentication.
+// Check the length with:
+//
+//	len(mockedGatewayClient.SetVpnGatewayAuthenticationCalls())
+func (mock *GatewayClientMock) SetVpnGatewayAuthenticationCalls() []struct {
+	Gateway *VpnGatewayAuth
+} {
+	var calls []struct {
+		Gateway *VpnGatewayAuth
+	}
+	mock.lockSetVpnGatewayAuthentication.RLock()
+	calls = mock.calls.SetVpnGatewayAuthentication
+	mock.lockSetVpnGatewayAuthentication.RUnlock()
+	return calls
+}
+
+// UpdateDNat calls UpdateDNatFunc.
+func (mock *GatewayClientMock) UpdateDNat(gateway *Gateway) error {
+	if mock.UpdateDNatFunc == nil {
+		panic("GatewayClientMock.UpdateDNatFunc: method is nil but GatewayClient.UpdateDNat was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockUpdateDNat.Lock()
+	mock.calls.UpdateDNat = append(mock.calls.UpdateDNat, callInfo)
+	mock.lockUpdateDNat.Unlock()
+	return mock.UpdateDNatFunc(gateway)
+}
+
+// UpdateDNatCalls gets all the calls that were made to UpdateDNat.
+// Check the length with:
+//
+//	len(mockedGatewayClient.UpdateDNatCalls())
+func (mock *GatewayClientMock) UpdateDNatCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockUpdateDNat.RLock()
+	calls = mock.calls.UpdateDNat
+	mock.lockUpdateDNat.RUnlock()
+	return calls
+}
+
+// UpdateEdgeGateway calls UpdateEdgeGatewayFunc.
+func (mock *GatewayClientMock) UpdateEdgeGateway(gateway *TransitVpc) error {
+	if mock.UpdateEdgeGatewayFunc == nil {
+		panic("GatewayClientMock.UpdateEdgeGatewayFunc: method is nil but GatewayClient.UpdateEdgeGateway was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockUpdateEdgeGateway.Lock()
+	mock.calls.UpdateEdgeGateway = append(mock.calls.UpdateEdgeGateway, callInfo)
+	mock.lockUpdateEdgeGateway.Unlock()
+	return mock.UpdateEdgeGatewayFunc(gateway)
+}
+
+// UpdateEdgeGatewayCalls gets all the calls that were made to UpdateEdgeGateway.
+// Check the length with:
+//
+//	len(mockedGatewayClient.UpdateEdgeGatewayCalls())
+func (mock *GatewayClientMock) UpdateEdgeGatewayCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockUpdateEdgeGateway.RLock()
+	calls = mock.calls.UpdateEdgeGateway
+	mock.lockUpdateEdgeGateway.RUnlock()
+	return calls
+}
+
+// UpdateEdgeGatewayV2 calls UpdateEdgeGatewayV2Func.
+func (mock *GatewayClientMock) UpdateEdgeGatewayV2(ctx context.Context, gateway *TransitVpc) error {
+	if mock.UpdateEdgeGatewayV2Func == nil {
+		panic("GatewayClientMock.UpdateEdgeGatewayV2Func: method is nil but GatewayClient.UpdateEdgeGatewayV2 was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Gateway *TransitVpc
+	}{
+		Ctx:     ctx,
+		Gateway: gateway,
+	}
+	mock.lockUpdateEdgeGatewayV2.Lock()
+	mock.calls.UpdateEdgeGatewayV2 = append(mock.calls.UpdateEdgeGatewayV2, callInfo)
+	mock.lockUpdateEdgeGatewayV2.Unlock()
+	return mock.UpdateEdgeGatewayV2Func(ctx, gateway)
+}
+
+// UpdateEdgeGatewayV2Calls gets all the calls that were made to UpdateEdgeGatewayV2.
+// Check the length with:
+//
+//	len(mockedGatewayClient.UpdateEdgeGatewayV2Calls())
+func (mock *GatewayClientMock) UpdateEdgeGatewayV2Calls() []struct {
+	Ctx     context.Context
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Gateway *TransitVpc
+	}
+	mock.lockUpdateEdgeGatewayV2.RLock()
+	calls = mock.calls.UpdateEdgeGatewayV2
+	mock.lockUpdateEdgeGatewayV2.RUnlock()
+	return calls
+}
+
+// UpdateGateway calls UpdateGatewayFunc.
+func (mock *GatewayClientMock) UpdateGateway(gateway *Gateway) error {
+	if mock.UpdateGatewayFunc == nil {
+		panic("GatewayClientMock.UpdateGatewayFunc: method is nil but GatewayClient.UpdateGateway was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockUpdateGateway.Lock()
+	mock.calls.UpdateGateway = append(mock.calls.UpdateGateway, callInfo)
+	mock.lockUpdateGateway.Unlock()
+	return mock.UpdateGatewayFunc(gateway)
+}
+
+// UpdateGatewayCalls gets all the calls that were made to UpdateGateway.
+// Check the length with:
+//
+//	len(mockedGatewayClient.UpdateGatewayCalls())
+func (mock *GatewayClientMock) UpdateGatewayCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockUpdateGateway.RLock()
+	calls = mock.calls.UpdateGateway
+	mock.lockUpdateGateway.RUnlock()
+	return calls
+}
+
+// UpdateGatewayContext calls UpdateGatewayContextFunc.
+func (mock *GatewayClientMock) UpdateGatewayContext(ctx context.Context, gateway *Gateway) error {
+	if mock.UpdateGatewayContextFunc == nil {
+		panic("GatewayClientMock.UpdateGatewayContextFunc: method is nil but GatewayClient.UpdateGatewayContext was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}{
+		Ctx:     ctx,
+		Gateway: gateway,
+	}
+	mock.lockUpdateGatewayContext.Lock()
+	mock.calls.UpdateGatewayContext = append(mock.calls.UpdateGatewayContext, callInfo)
+	mock.lockUpdateGatewayContext.Unlock()
+	return mock.UpdateGatewayContextFunc(ctx, gateway)
+}
+
+// UpdateGatewayContextCalls gets all the calls that were made to UpdateGatewayContext.
+// Check the length with:
+//
+//	len(mockedGatewayClient.UpdateGatewayContextCalls())
+func (mock *GatewayClientMock) UpdateGatewayContextCalls() []struct {
+	Ctx     context.Context
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}
+	mock.lockUpdateGatewayContext.RLock()
+	calls = mock.calls.UpdateGatewayContext
+	mock.lockUpdateGatewayContext.RUnlock()
+	return calls
+}
+
+// UpdateGroupInstanceSize calls UpdateGroupInstanceSizeFunc.
+func (mock *GatewayClientMock) UpdateGroupInstanceSize(ctx context.Context, groupName string, instanceSize string) error {
+	if mock.UpdateGroupInstanceSizeFunc == nil {
+		panic("GatewayClientMock.UpdateGroupInstanceSizeFunc: method is nil but GatewayClient.UpdateGroupInstanceSize was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		GroupName    string
+		InstanceSize string
+	}{
+		Ctx:          ctx,
+		GroupName:    groupName,
+		InstanceSize: instanceSize,
+	}
+	mock.lockUpdateGroupInstanceSize.Lock()
+	mock.calls.UpdateGroupInstanceSize = append(mock.calls.UpdateGroupInstanceSize, callInfo)
+	mock.lockUpdateGroupInstanceSize.Unlock()
+	return mock.UpdateGroupInstanceSizeFunc(ctx, groupName, instanceSize)
+}
+
+// UpdateGroupInstanceSizeCalls gets all the calls that were made to UpdateGroupInstanceSize.
+// Check the length with:
+//
+//	len(mockedGatewayClient.UpdateGroupInstanceSizeCalls())
+func (mock *GatewayClientMock) UpdateGroupInstanceSizeCalls() []struct {
+	Ctx          context.Context
+	GroupName    string
+	InstanceSize string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		GroupName    string
+		InstanceSize string
+	}
+	mock.lockUpdateGroupInstanceSize.RLock()
+	calls = mock.calls.UpdateGroupInstanceSize
+	mock.lockUpdateGroupInstanceSize.RUnlock()
+	return calls
+}
+
+// UpdateMaxVpnConn calls UpdateMaxVpnConnFunc.
+func (mock *GatewayClientMock) UpdateMaxVpnConn(gateway *Gateway) error {
+	if mock.UpdateMaxVpnConnFunc == nil {
+		panic("GatewayClientMock.UpdateMaxVpnConnFunc: method is nil but GatewayClient.UpdateMaxVpnConn was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockUpdateMaxVpnConn.Lock()
+	mock.calls.UpdateMaxVpnConn = append(mock.calls.UpdateMaxVpnConn, callInfo)
+	mock.lockUpdateMaxVpnConn.Unlock()
+	return mock.UpdateMaxVpnConnFunc(gateway)
+}
+
+// UpdateMaxVpnConnCalls gets all the calls that were made to UpdateMaxVpnConn.
+// Check the length with:
+//
+//	len(mockedGatewayClient.UpdateMaxVpnConnCalls())
+func (mock *GatewayClientMock) UpdateMaxVpnConnCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockUpdateMaxVpnConn.RLock()
+	calls = mock.calls.UpdateMaxVpnConn
+	mock.lockUpdateMaxVpnConn.RUnlock()
+	return calls
+}
+
+// UpdateSpokeGatewaySubnetGroup calls UpdateSpokeGatewaySubnetGroupFunc.
+func (mock *GatewayClientMock) UpdateSpokeGatewaySubnetGroup(ctx context.Context, spokeGatewaySubnetGroup *SpokeGatewaySubnetGroup) error {
+	if mock.UpdateSpokeGatewaySubnetGroupFunc == nil {
+		panic("GatewayClientMock.UpdateSpokeGatewaySubnetGroupFunc: method is nil but GatewayClient.UpdateSpokeGatewaySubnetGroup was just called")
+	}
+	callInfo := struct {
+		Ctx                     context.Context
+		SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+	}{
+		Ctx:                     ctx,
+		SpokeGatewaySubnetGroup: spokeGatewaySubnetGroup,
+	}
+	mock.lockUpdateSpokeGatewaySubnetGroup.Lock()
+	mock.calls.UpdateSpokeGatewaySubnetGroup = append(mock.calls.UpdateSpokeGatewaySubnetGroup, callInfo)
+	mock.lockUpdateSpokeGatewaySubnetGroup.Unlock()
+	return mock.UpdateSpokeGatewaySubnetGroupFunc(ctx, spokeGatewaySubnetGroup)
+}
+
+// UpdateSpokeGatewaySubnetGroupCalls gets all the calls that were made to UpdateSpokeGatewaySubnetGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.UpdateSpokeGatewaySubnetGroupCalls())
+func (mock *GatewayClientMock) UpdateSpokeGatewaySubnetGroupCalls() []struct {
+	Ctx                     context.Context
+	SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+} {
+	var calls []struct {
+		Ctx                     context.Context
+		SpokeGatewaySubnetGroup *SpokeGatewaySubnetGroup
+	}
+	mock.lockUpdateSpokeGatewaySubnetGroup.RLock()
+	calls = mock.calls.UpdateSpokeGatewaySubnetGroup
+	mock.lockUpdateSpokeGatewaySubnetGroup.RUnlock()
+	return calls
+}
+
+// UpdateSpokePendingApprovedCidrs calls UpdateSpokePendingApprovedCidrsFunc.
+func (mock *GatewayClientMock) UpdateSpokePendingApprovedCidrs(gateway *SpokeVpc) error {
+	if mock.UpdateSpokePendingApprovedCidrsFunc == nil {
+		panic("GatewayClientMock.UpdateSpokePendingApprovedCidrsFunc: method is nil but GatewayClient.UpdateSpokePendingApprovedCidrs was just called")
+	}
+	callInfo := struct {
+		Gateway *SpokeVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockUpdateSpokePendingApprovedCidrs.Lock()
+	mock.calls.UpdateSpokePendingApprovedCidrs = append(mock.calls.UpdateSpokePendingApprovedCidrs, callInfo)
+	mock.lockUpdateSpokePendingApprovedCidrs.Unlock()
+	return mock.UpdateSpokePendingApprovedCidrsFunc(gateway)
+}
+
+// UpdateSpokePendingApprovedCidrsCalls gets all the calls that were made to UpdateSpokePendingApprovedCidrs.
+// Check the length with:
+//
+//	len(mockedGatewayClient.UpdateSpokePendingApprovedCidrsCalls())
+func (mock *GatewayClientMock) UpdateSpokePendingApprovedCidrsCalls() []struct {
+	Gateway *SpokeVpc
+} {
+	var calls []struct {
+		Gateway *SpokeVpc
+	}
+	mock.lockUpdateSpokePendingApprovedCidrs.RLock()
+	calls = mock.calls.UpdateSpokePendingApprovedCidrs
+	mock.lockUpdateSpokePendingApprovedCidrs.RUnlock()
+	return calls
+}
+
+// UpdateSpokePendingApprovedCidrsGatewayGroup calls UpdateSpokePendingApprovedCidrsGatewayGroupFunc.
+func (mock *GatewayClientMock) UpdateSpokePendingApprovedCidrsGatewayGroup(ctx context.Context, groupName string, approvedCidrs []string) error {
+	if mock.UpdateSpokePendingApprovedCidrsGatewayGroupFunc == nil {
+		panic("GatewayClientMock.UpdateSpokePendingApprovedCidrsGatewayGroupFunc: method is nil but GatewayClient.UpdateSpokePendingApprovedCidrsGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		GroupName     string
+		ApprovedCidrs []string
+	}{
+		Ctx:           ctx,
+		GroupName:     groupName,
+		ApprovedCidrs: approvedCidrs,
+	}
+	mock.lockUpdateSpokePendingApprovedCidrsGatewayGroup.Lock()
+	mock.calls.UpdateSpokePendingApprovedCidrsGatewayGroup = append(mock.calls.UpdateSpokePendingApprovedCidrsGatewayGroup, callInfo)
+	mock.lockUpdateSpokePendingApprovedCidrsGatewayGroup.Unlock()
+	return mock.UpdateSpokePendingApprovedCidrsGatewayGroupFunc(ctx, groupName, approvedCidrs)
+}
+
+// UpdateSpokePendingApprovedCidrsGatewayGroupCalls gets all the calls that were made to UpdateSpokePendingApprovedCidrsGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.UpdateSpokePendingApprovedCidrsGatewayGroupCalls())
+func (mock *GatewayClientMock) UpdateSpokePendingApprovedCidrsGatewayGroupCalls() []struct {
+	Ctx           context.Context
+	GroupName     string
+	ApprovedCidrs []string
+} {
+	var calls []struct {
+		Ctx           context.Context
+		GroupName     string
+		ApprovedCidrs []string
+	}
+	mock.lockUpdateSpokePendingApprovedCidrsGatewayGroup.RLock()
+	calls = mock.calls.UpdateSpokePendingApprovedCidrsGatewayGroup
+	mock.lockUpdateSpokePendingApprovedCidrsGatewayGroup.RUnlock()
+	return calls
+}
+
+// UpdateTags calls UpdateTagsFunc.
+func (mock *GatewayClientMock) UpdateTags(tags *Tags) error {
+	if mock.UpdateTagsFunc == nil {
+		panic("GatewayClientMock.UpdateTagsFunc: method is nil but GatewayClient.UpdateTags was just called")
+	}
+	callInfo := struct {
+		Tags *Tags
+	}{
+		Tags: tags,
+	}
+	mock.lockUpdateTags.Lock()
+	mock.calls.UpdateTags = append(mock.calls.UpdateTags, callInfo)
+	mock.lockUpdateTags.Unlock()
+	return mock.UpdateTagsFunc(tags)
+}
+
+// UpdateTagsCalls gets all the calls that were made to UpdateTags.
+// Check the length with:
+//
+//	len(mockedGatewayClient.UpdateTagsCalls())
+func (mock *GatewayClientMock) UpdateTagsCalls() []struct {
+	Tags *Tags
+} {
+	var calls []struct {
+		Tags *Tags
+	}
+	mock.lockUpdateTags.RLock()
+	calls = mock.calls.UpdateTags
+	mock.lockUpdateTags.RUnlock()
+	return calls
+}
+
+// UpdateTransitGatewayCustomizedVpcRoute calls UpdateTransitGatewayCustomizedVpcRouteFunc.
+func (mock *GatewayClientMock) UpdateTransitGatewayCustomizedVpcRoute(gateway string, customizedTransitVpcRoutes []string) error {
+	if mock.UpdateTransitGatewayCustomizedVpcRouteFunc == nil {
+		panic("GatewayClientMock.UpdateTransitGatewayCustomizedVpcRouteFunc: method is nil but GatewayClient.UpdateTransitGatewayCustomizedVpcRoute was just called")
+	}
+	callInfo := struct {
+		Gateway                    string
+		CustomizedTransitVpcRoutes []string
+	}{
+		Gateway:                    gateway,
+		CustomizedTransitVpcRoutes: customizedTransitVpcRoutes,
+	}
+	mock.lockUpdateTransitGatewayCustomizedVpcRoute.Lock()
+	mock.calls.UpdateTransitGatewayCustomizedVpcRoute = append(mock.calls.UpdateTransitGatewayCustomizedVpcRoute, callInfo)
+	mock.lockUpdateTransitGatewayCustomizedVpcRoute.Unlock()
+	return mock.UpdateTransitGatewayCustomizedVpcRouteFunc(gateway, customizedTransitVpcRoutes)
+}
+
+// UpdateTransitGatewayCustomizedVpcRouteCalls gets all the calls that were made to UpdateTransitGatewayCustomizedVpcRoute.
+// Check the length with:
+//
+//	len(mockedGatewayClient.UpdateTransitGatewayCustomizedVpcRouteCalls())
+func (mock *GatewayClientMock) UpdateTransitGatewayCustomizedVpcRouteCalls() []struct {
+	Gateway                    string
+	CustomizedTransitVpcRoutes []string
+} {
+	var calls []struct {
+		Gateway                    string
+		CustomizedTransitVpcRoutes []string
+	}
+	mock.lockUpdateTransitGatewayCustomizedVpcRoute.RLock()
+	calls = mock.calls.UpdateTransitGatewayCustomizedVpcRoute
+	mock.lockUpdateTransitGatewayCustomizedVpcRoute.RUnlock()
+	return calls
+}
+
+// UpdateTransitPendingApprovedCidrs calls UpdateTransitPendingApprovedCidrsFunc.
+func (mock *GatewayClientMock) UpdateTransitPendingApprovedCidrs(gateway *TransitVpc) error {
+	if mock.UpdateTransitPendingApprovedCidrsFunc == nil {
+		panic("GatewayClientMock.UpdateTransitPendingApprovedCidrsFunc: method is nil but GatewayClient.UpdateTransitPendingApprovedCidrs was just called")
+	}
+	callInfo := struct {
+		Gateway *TransitVpc
+	}{
+		Gateway: gateway,
+	}
+	mock.lockUpdateTransitPendingApprovedCidrs.Lock()
+	mock.calls.UpdateTransitPendingApprovedCidrs = append(mock.calls.UpdateTransitPendingApprovedCidrs, callInfo)
+	mock.lockUpdateTransitPendingApprovedCidrs.Unlock()
+	return mock.UpdateTransitPendingApprovedCidrsFunc(gateway)
+}
+
+// UpdateTransitPendingApprovedCidrsCalls gets all the calls that were made to UpdateTransitPendingApprovedCidrs.
+// Check the length with:
+//
+//	len(mockedGatewayClient.UpdateTransitPendingApprovedCidrsCalls())
+func (mock *GatewayClientMock) UpdateTransitPendingApprovedCidrsCalls() []struct {
+	Gateway *TransitVpc
+} {
+	var calls []struct {
+		Gateway *TransitVpc
+	}
+	mock.lockUpdateTransitPendingApprovedCidrs.RLock()
+	calls = mock.calls.UpdateTransitPendingApprovedCidrs
+	mock.lockUpdateTransitPendingApprovedCidrs.RUnlock()
+	return calls
+}
+
+// UpdateTransitPendingApprovedCidrsGatewayGroup calls UpdateTransitPendingApprovedCidrsGatewayGroupFunc.
+func (mock *GatewayClientMock) UpdateTransitPendingApprovedCidrsGatewayGroup(ctx context.Context, groupName string, approvedCidrs []string) error {
+	if mock.UpdateTransitPendingApprovedCidrsGatewayGroupFunc == nil {
+		panic("GatewayClientMock.UpdateTransitPendingApprovedCidrsGatewayGroupFunc: method is nil but GatewayClient.UpdateTransitPendingApprovedCidrsGatewayGroup was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		GroupName     string
+		ApprovedCidrs []string
+	}{
+		Ctx:           ctx,
+		GroupName:     groupName,
+		ApprovedCidrs: approvedCidrs,
+	}
+	mock.lockUpdateTransitPendingApprovedCidrsGatewayGroup.Lock()
+	mock.calls.UpdateTransitPendingApprovedCidrsGatewayGroup = append(mock.calls.UpdateTransitPendingApprovedCidrsGatewayGroup, callInfo)
+	mock.lockUpdateTransitPendingApprovedCidrsGatewayGroup.Unlock()
+	return mock.UpdateTransitPendingApprovedCidrsGatewayGroupFunc(ctx, groupName, approvedCidrs)
+}
+
+// UpdateTransitPendingApprovedCidrsGatewayGroupCalls gets all the calls that were made to UpdateTransitPendingApprovedCidrsGatewayGroup.
+// Check the length with:
+//
+//	len(mockedGatewayClient.UpdateTransitPendingApprovedCidrsGatewayGroupCalls())
+func (mock *GatewayClientMock) UpdateTransitPendingApprovedCidrsGatewayGroupCalls() []struct {
+	Ctx           context.Context
+	GroupName     string
+	ApprovedCidrs []string
+} {
+	var calls []struct {
+		Ctx           context.Context
+		GroupName     string
+		ApprovedCidrs []string
+	}
+	mock.lockUpdateTransitPendingApprovedCidrsGatewayGroup.RLock()
+	calls = mock.calls.UpdateTransitPendingApprovedCidrsGatewayGroup
+	mock.lockUpdateTransitPendingApprovedCidrsGatewayGroup.RUnlock()
+	return calls
+}
+
+// UpdateVpnCidr calls UpdateVpnCidrFunc.
+func (mock *GatewayClientMock) UpdateVpnCidr(gateway *Gateway) error {
+	if mock.UpdateVpnCidrFunc == nil {
+		panic("GatewayClientMock.UpdateVpnCidrFunc: method is nil but GatewayClient.UpdateVpnCidr was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockUpdateVpnCidr.Lock()
+	mock.calls.UpdateVpnCidr = append(mock.calls.UpdateVpnCidr, callInfo)
+	mock.lockUpdateVpnCidr.Unlock()
+	return mock.UpdateVpnCidrFunc(gateway)
+}
+
+// UpdateVpnCidrCalls gets all the calls that were made to UpdateVpnCidr.
+// Check the length with:
+//
+//	len(mockedGatewayClient.UpdateVpnCidrCalls())
+func (mock *GatewayClientMock) UpdateVpnCidrCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockUpdateVpnCidr.RLock()
+	calls = mock.calls.UpdateVpnCidr
+	mock.lockUpdateVpnCidr.RUnlock()
+	return calls
+}
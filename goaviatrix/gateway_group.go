@@ -2,6 +2,7 @@ package goaviatrix
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 )
@@ -199,8 +200,7 @@ func (c *Client) GetGatewayGroup(ctx context.Context, groupUUID string) (*Gatewa
 	}
 	grp, err := c.getGatewayGroupDetails(ctx, form)
 	if err != nil {
-		msg := strings.ToLower(err.Error())
-		if strings.Contains(msg, "does not exist") || strings.Contains(msg, "not found") {
+		if errors.Is(err, ErrNotFound) {
 			return nil, ErrNotFound
 		}
 		return nil, err
@@ -218,8 +218,7 @@ func (c *Client) GetGatewayGroupByName(ctx context.Context, groupName string) (*
 	}
 	grp, err := c.getGatewayGroupDetails(ctx, form)
 	if err != nil {
-		msg := strings.ToLower(err.Error())
-		if strings.Contains(msg, "does not exist") || strings.Contains(msg, "not found") {
+		if errors.Is(err, ErrNotFound) {
 			return nil, ErrNotFound
 		}
 		return nil, err
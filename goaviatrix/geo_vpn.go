@@ -3,10 +3,9 @@ package goaviatrix
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"strconv"
 	"strings"
-
-	log "github.com/sirupsen/logrus"
 )
 
 type GeoVPN struct {
@@ -83,7 +82,7 @@ func (c *Client) GetGeoVPNInfo(geoVPN *GeoVPN) (*GeoVPN, error) {
 		return geoVPN, nil
 	}
 
-	log.Errorf("Couldn't find Aviatrix Geo VPN: %v", geoVPN)
+	c.log(context.Background(), slog.LevelError, fmt.Sprintf("Couldn't find Aviatrix Geo VPN: %v", Redact(geoVPN)))
 	return nil, ErrNotFound
 }
 
@@ -145,6 +144,6 @@ func (c *Client) GetGeoVPNName(gateway *Gateway) (*GeoVPN, error) {
 		}
 	}
 
-	log.Errorf("Couldn't find Aviatrix Geo VPN")
+	c.log(context.Background(), slog.LevelError, "Couldn't find Aviatrix Geo VPN")
 	return nil, ErrNotFound
 }
@@ -3,10 +3,6 @@ package goaviatrix
 import (
 	"context"
 	"fmt"
-	"reflect"
-	"sort"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 type WanLink struct {
@@ -71,60 +67,3 @@ func (c *Client) DeleteLinkHierarchy(ctx context.Context, uuid string) error {
 	endpoint := fmt.Sprintf("ipsla/link-hierarchy/%s", uuid)
 	return c.DeleteAPIContext25(ctx, endpoint, nil)
 }
-
-func DiffSuppressFuncLinkHierarchy(k, old, new string, d *schema.ResourceData) bool {
-	lOld, lNew := d.GetChange("links")
-
-	oldList, ok := lOld.([]interface{})
-	if !ok {
-		return false
-	}
-	newList, ok := lNew.([]interface{})
-	if !ok {
-		return false
-	}
-
-	linksOld := make([]map[string]interface{}, 0, len(oldList))
-	for _, l0 := range oldList {
-		l1, ok := l0.(map[string]interface{})
-		if !ok {
-			return false
-		}
-		linksOld = append(linksOld, l1)
-	}
-
-	linksNew := make([]map[string]interface{}, 0, len(newList))
-	for _, l0 := range newList {
-		l1, ok := l0.(map[string]interface{})
-		if !ok {
-			return false
-		}
-		linksNew = append(linksNew, l1)
-	}
-
-	sort.Slice(linksOld, func(i, j int) bool {
-		ni, ok := linksOld[i]["name"].(string)
-		if !ok {
-			return false
-		}
-		nj, ok := linksOld[j]["name"].(string)
-		if !ok {
-			return false
-		}
-		return ni < nj
-	})
-
-	sort.Slice(linksNew, func(i, j int) bool {
-		ni, ok := linksNew[i]["name"].(string)
-		if !ok {
-			return false
-		}
-		nj, ok := linksNew[j]["name"].(string)
-		if !ok {
-			return false
-		}
-		return ni < nj
-	})
-
-	return reflect.DeepEqual(linksOld, linksNew)
-}
@@ -0,0 +1,93 @@
+package goaviatrix
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+// LevelTrace is the level of the most verbose logs, e.g. each request sent
+// with its body. It is below slog.LevelDebug, which handlers without a trace
+// level treat it as.
+const LevelTrace = slog.LevelDebug - 4
+
+// SensitiveLogKeys returns the log field keys whose values are masked: the
+// sensitive field names in lower and upper case, e.g. cid and CID.
+func SensitiveLogKeys() []string {
+	fields := append(SensitiveFields(), sensitiveExactFields...)
+	keys := make([]string, 0, 2*len(fields))
+	for _, field := range fields {
+		keys = append(keys, field, strings.ToUpper(field))
+	}
+	return keys
+}
+
+// WithLogger sets the logger the client logs to. The context of each call is
+// passed on to its handler, so that a handler can correlate the lines of a
+// call with the caller's. Without a logger nothing is logged.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *Client) { c.Logger = logger }
+}
+
+// log logs msg and fields at level, with the values of sensitive fields and
+// the credentials of the client masked.
+func (c *Client) log(ctx context.Context, level slog.Level, msg string, fields ...map[string]any) {
+	c.logAttrs(ctx, level, msg, nil, fields...)
+}
+
+// logRequest logs like log, with the fields that correlate the lines of an
+// attempt of req.
+func (c *Client) logRequest(ctx context.Context, req *Request, level slog.Level, msg string, fields ...map[string]any) {
+	c.logAttrs(ctx, level, msg, []slog.Attr{
+		slog.String("action", req.Action),
+		slog.String("request_id", req.ID),
+		slog.Int("attempt", req.Attempt),
+	}, fields...)
+}
+
+func (c *Client) logAttrs(ctx context.Context, level slog.Level, msg string, attrs []slog.Attr, fields ...map[string]any) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if c.Logger == nil || !c.Logger.Enabled(ctx, level) {
+		return
+	}
+	mask := c.secretMasker()
+	for _, f := range fields {
+		for _, key := range slices.Sorted(maps.Keys(f)) {
+			value := f[key]
+			if IsSensitiveField(key) {
+				value = RedactedValue
+			} else if s, ok := value.(string); ok {
+				value = mask.Replace(s)
+			}
+			attrs = append(attrs, slog.Any(key, value))
+		}
+	}
+	c.Logger.LogAttrs(ctx, level, mask.Replace(msg), attrs...)
+}
+
+// secretMasker replaces the credentials of the client with RedactedValue.
+func (c *Client) secretMasker() *strings.Replacer {
+	var pairs []string
+	for _, s := range []string{c.CID, c.GetCID(), c.Password, c.APIToken} {
+		if s != "" {
+			pairs = append(pairs, s, RedactedValue)
+		}
+	}
+	return strings.NewReplacer(pairs...)
+}
+
+// newRequestID returns a unique ID for a request, or "" if none could be
+// generated.
+func newRequestID() string {
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return ""
+	}
+	return id
+}
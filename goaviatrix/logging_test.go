@@ -0,0 +1,139 @@
+package goaviatrix
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newLoggingTestClient returns a client whose odd attempts fail, so that a
+// request that can be retried is sent twice.
+func newLoggingTestClient() *Client {
+	attempts := 0
+	return &Client{
+		baseURL:  "https://controller/v2/api",
+		CID:      "secret-cid",
+		Password: "secret-password",
+		HTTPClient: &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			attempts++
+			if attempts%2 == 1 {
+				return nil, errors.New("EOF")
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"return": true}`)),
+			}, nil
+		})},
+	}
+}
+
+// testLogger returns a logger that writes records of all levels to output as
+// JSON.
+func testLogger(output *bytes.Buffer) *slog.Logger {
+	return slog.New(slog.NewJSONHandler(output, &slog.HandlerOptions{Level: LevelTrace}))
+}
+
+func decodeLogs(t *testing.T, output *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var entries []map[string]any
+	dec := json.NewDecoder(output)
+	for dec.More() {
+		var entry map[string]any
+		require.NoError(t, dec.Decode(&entry))
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func sentRequestLogs(t *testing.T, output *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var sent []map[string]any
+	for _, entry := range decodeLogs(t, output) {
+		if entry["msg"] == "Sending request" {
+			sent = append(sent, entry)
+		}
+	}
+	return sent
+}
+
+func TestLogging_RequestFields(t *testing.T) {
+	var output bytes.Buffer
+	client := newLoggingTestClient()
+	client.Logger = testLogger(&output)
+
+	_, err := client.GetContext(context.Background(), client.baseURL+"?action=list_accounts&CID="+client.CID, nil)
+	require.NoError(t, err)
+
+	assert.NotContains(t, output.String(), "secret-cid")
+	assert.Contains(t, output.String(), "Request failed, retrying")
+	sent := sentRequestLogs(t, &output)
+	require.Len(t, sent, 2)
+	for i, entry := range sent {
+		assert.Equal(t, "DEBUG-4", entry["level"])
+		assert.Equal(t, "list_accounts", entry["action"])
+		assert.Equal(t, "GET", entry["method"])
+		assert.Equal(t, float64(i+1), entry["attempt"])
+		assert.NotEmpty(t, entry["request_id"])
+		assert.Equal(t, sent[0]["request_id"], entry["request_id"])
+	}
+}
+
+func TestLogging_MasksSensitiveFields(t *testing.T) {
+	var output bytes.Buffer
+	client := newLoggingTestClient()
+	client.Logger = testLogger(&output)
+
+	client.log(context.Background(), slog.LevelInfo, "Creating account with password secret-password",
+		map[string]any{"password": "hunter2", "api_token": "token", "name": "account"})
+
+	entries := decodeLogs(t, &output)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "Creating account with password ***", entries[0]["msg"])
+	assert.Equal(t, "***", entries[0]["password"])
+	assert.Equal(t, "***", entries[0]["api_token"])
+	assert.Equal(t, "account", entries[0]["name"])
+}
+
+func TestLogging_WithoutLogger(t *testing.T) {
+	client := newLoggingTestClient()
+	client.Logger = nil
+
+	_, err := client.GetContext(context.Background(), client.baseURL+"?action=list_accounts&CID="+client.CID, nil)
+	require.NoError(t, err)
+}
+
+func TestLogging_RedactsRequestBody(t *testing.T) {
+	var output bytes.Buffer
+	client := newLoggingTestClient()
+	client.Logger = testLogger(&output)
+	client.HTTPClient = &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"return": true}`)),
+		}, nil
+	})}
+
+	_, err := client.PostContext(context.Background(), client.baseURL, map[string]string{
+		"action":         "add_account",
+		"CID":            client.CID,
+		"account_name":   "prod",
+		"aws_secret_key": "aws-secret",
+	})
+	require.NoError(t, err)
+
+	assert.NotContains(t, output.String(), "aws-secret")
+	sent := sentRequestLogs(t, &output)
+	require.Len(t, sent, 1)
+	assert.Equal(t, "CID=***&account_name=prod&action=add_account&aws_secret_key=***", sent[0]["body"])
+}
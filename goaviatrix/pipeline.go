@@ -0,0 +1,477 @@
+package goaviatrix
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ajg/form"
+)
+
+// APIVersion identifies which generation of the controller API a request
+// targets. It decides how an expired session is detected and how the CID is
+// presented to the controller.
+type APIVersion int
+
+const (
+	// APIv1 is the original action based API: form encoded POSTs and query
+	// string GETs against /v2/api, with the CID carried in the payload.
+	APIv1 APIVersion = iota
+	// APIv2 is the JSON action based API on /v2/api.
+	APIv2
+	// APIv25 is the REST API on /v2.5/api, authenticated with an
+	// Authorization header.
+	APIv25
+)
+
+// Encoding is the wire format of a request payload.
+type Encoding int
+
+const (
+	EncodingForm Encoding = iota
+	EncodingJSON
+	EncodingMultipart
+)
+
+// Request describes a single logical controller API call as it flows through
+// the middleware chain. Middlewares may inspect and modify it; the payload is
+// re-encoded for every attempt so a refreshed CID is always sent.
+type Request struct {
+	// Method is the HTTP verb.
+	Method string
+	// URL is the full request URL, including any query string.
+	URL string
+	// Action is the controller action (or v2.5 path) used for logging and
+	// error messages. Derived from the payload when left empty.
+	Action string
+	// Version is the API generation the request targets.
+	Version APIVersion
+	// Encoding is the wire format of Payload.
+	Encoding Encoding
+	// Payload is the request body. Form and JSON payloads may be a struct or
+	// a map; nil means no body.
+	Payload interface{}
+	// Params and Files make up the body of a multipart request.
+	Params map[string]string
+	Files  []File
+	// Header holds extra headers to send with every attempt.
+	Header http.Header
+	// Attempt is the 1-based attempt number, maintained by the retry
+	// middleware.
+	Attempt int
+	// ID identifies the request in logs. Generated by Do when left empty.
+	ID string
+	// SkipAuth disables session handling, e.g. for the login call itself.
+	SkipAuth bool
+
+	// cid is the current session ID, which replaces the CID in the payload.
+	cid string
+}
+
+// Handler sends a Request and returns the controller response. The response
+// body is fully buffered, so it may be inspected with PeekBody.
+type Handler func(ctx context.Context, req *Request) (*http.Response, error)
+
+// Middleware wraps a Handler with additional behavior such as retries,
+// session refresh, logging or auditing.
+type Middleware func(next Handler) Handler
+
+// Use appends middlewares to the client's request pipeline. They run outside
+// the built-in retry, auth and logging middlewares, so each one observes a
+// logical request exactly once, in the order given. Use is not safe to call
+// concurrently with requests.
+func (c *Client) Use(mw ...Middleware) {
+	c.middlewares = append(c.middlewares, mw...)
+}
+
+// Do sends req through the client's middleware chain. Every request to the
+// controller, regardless of API generation, goes through Do.
+func (c *Client) Do(ctx context.Context, req *Request) (*http.Response, error) {
+	if req.Action == "" {
+		req.Action = req.action()
+	}
+	if req.ID == "" {
+		req.ID = newRequestID()
+	}
+	return c.handler()(ctx, req)
+}
+
+// handler composes the middleware chain. The outermost middleware is listed
+// first: user middlewares, then retry, auth, throttling and logging around
+// the transport.
+func (c *Client) handler() Handler {
+	chain := make([]Middleware, 0, len(c.middlewares)+5)
+	chain = append(chain, c.middlewares...)
+	chain = append(chain, c.retryMiddleware, c.authMiddleware, c.throttleMiddleware, c.tracingMiddleware, c.loggingMiddleware)
+
+	h := c.send
+	for i := len(chain) - 1; i >= 0; i-- {
+		h = chain[i](h)
+	}
+	return h
+}
+
+// send is the terminal handler. It performs the HTTP round trip and buffers
+// the response body so middlewares and callers can read it.
+func (c *Client) send(ctx context.Context, req *Request) (*http.Response, error) {
+	httpReq, err := req.build(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Version == APIv25 && !req.SkipAuth {
+		// Set CID as Authorization header for v2.5
+		httpReq.Header.Set("Authorization", fmt.Sprintf("cid %s", req.cid))
+	}
+
+	resp, err := c.HTTPClient.Do(httpReq)
+	if err != nil {
+		return resp, err
+	}
+	if resp == nil || resp.Body == nil {
+		return resp, fmt.Errorf("nil response/body")
+	}
+
+	body, readErr := readBody(resp.Body)
+	_ = resp.Body.Close()
+	if readErr != nil {
+		return resp, fmt.Errorf("read response body failed: %w", readErr)
+	}
+	// Replace resp.Body with a new ReadCloser so that other methods can read the body again.
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// PeekBody returns the buffered body of a response produced by the pipeline
+// and rewinds it so it can be read again.
+func PeekBody(resp *http.Response) ([]byte, error) {
+	if resp == nil || resp.Body == nil {
+		return nil, fmt.Errorf("nil response/body")
+	}
+	body, err := readBody(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// build creates the *http.Request for the current attempt.
+func (r *Request) build(ctx context.Context) (*http.Request, error) {
+	target := r.URL
+	body, contentType, err := r.encode()
+	if err != nil {
+		return nil, err
+	}
+	if body == nil && r.cid != "" {
+		// Update CID in GET URL
+		Url, err := url.Parse(target)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse url: %w", err)
+		}
+		query := Url.Query()
+		if _, ok := query["CID"]; ok {
+			query["CID"] = []string{r.cid}
+			Url.RawQuery = query.Encode()
+			target = Url.String()
+		}
+	}
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, r.Method, target, reader)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	for k, vals := range r.Header {
+		for _, v := range vals {
+			req.Header.Add(k, v)
+		}
+	}
+	if req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", UserAgent)
+	}
+	return req, nil
+}
+
+// encode serializes the payload, replacing the CID with the current session
+// ID. It returns a nil body when there is nothing to send.
+func (r *Request) encode() ([]byte, string, error) {
+	switch r.Encoding {
+	case EncodingMultipart:
+		if r.Params == nil && r.Files == nil {
+			return nil, "", nil
+		}
+		params := r.Params
+		if _, ok := params["CID"]; ok && r.cid != "" {
+			params = make(map[string]string, len(r.Params))
+			for k, v := range r.Params {
+				params[k] = v
+			}
+			params["CID"] = r.cid
+		}
+		buf, contentType, err := encodeMultipartFormData(params, r.Files)
+		if err != nil {
+			return nil, "", err
+		}
+		return buf.Bytes(), contentType, nil
+	case EncodingJSON:
+		if r.Payload == nil {
+			return nil, "", nil
+		}
+		body, err := json.Marshal(r.Payload)
+		if err != nil {
+			return nil, "", err
+		}
+		if r.cid != "" {
+			var m map[string]json.RawMessage
+			if json.Unmarshal(body, &m) == nil {
+				if _, ok := m["CID"]; ok {
+					m["CID"], _ = json.Marshal(r.cid)
+					if body, err = json.Marshal(m); err != nil {
+						return nil, "", err
+					}
+				}
+			}
+		}
+		return body, "application/json", nil
+	default:
+		if r.Payload == nil {
+			return nil, "", nil
+		}
+		values, err := form.EncodeToValues(r.Payload)
+		if err != nil {
+			return nil, "", err
+		}
+		if _, ok := values["CID"]; ok && r.cid != "" {
+			values.Set("CID", r.cid)
+		}
+		return []byte(values.Encode()), "application/x-www-form-urlencoded", nil
+	}
+}
+
+// action returns the controller action named by the payload or URL, falling
+// back to the URL path for the v2.5 REST API.
+func (r *Request) action() string {
+	switch {
+	case r.Encoding == EncodingMultipart:
+		return r.Params["action"]
+	case r.Payload != nil && r.Encoding == EncodingJSON:
+		var m struct {
+			Action string `json:"action"`
+		}
+		if body, err := json.Marshal(r.Payload); err == nil && json.Unmarshal(body, &m) == nil && m.Action != "" {
+			return m.Action
+		}
+	case r.Payload != nil:
+		if values, err := form.EncodeToValues(r.Payload); err == nil && values.Get("action") != "" {
+			return values.Get("action")
+		}
+	}
+	Url, err := url.Parse(r.URL)
+	if err != nil {
+		return ""
+	}
+	if a := Url.Query().Get("action"); a != "" {
+		return a
+	}
+	if r.Version == APIv25 {
+		return strings.TrimPrefix(Url.Path, "/v2.5/api/")
+	}
+	return ""
+}
+
+const (
+	maxSessionTries = 2
+	sessionBackoff  = 500 * time.Millisecond
+)
+
+// retryMiddleware retries failed requests according to the client's retry
+// policy.
+func (c *Client) retryMiddleware(next Handler) Handler {
+	return func(ctx context.Context, req *Request) (*http.Response, error) {
+		policy := c.RetryPolicy
+		if policy.MaxAttempts == 0 {
+			policy = DefaultRetryPolicy()
+		}
+
+		var resp *http.Response
+		var respErr error
+		err := RetryContext(ctx, RetryConfig{
+			MaxTries:   policy.MaxAttempts,
+			Backoff:    policy.Backoff,
+			MaxBackoff: policy.MaxBackoff,
+			Jitter:     policy.Jitter,
+			ShouldRetry: func(error) bool {
+				return ctx.Err() == nil && policy.retryable(req, resp, respErr)
+			},
+			OnRetry: func(attempt int, backoff time.Duration, err error) {
+				if resp != nil && resp.Body != nil {
+					_ = resp.Body.Close()
+				}
+				fields := map[string]any{
+					"backoff": backoff.String(),
+				}
+				if respErr != nil {
+					fields["error"] = RedactString(respErr.Error())
+				} else {
+					fields["status"] = resp.StatusCode
+					fields["reason"] = failureReason(resp)
+				}
+				c.logRequest(ctx, req, slog.LevelWarn, "Request failed, retrying", fields)
+			},
+		}, func() error {
+			req.Attempt++
+			resp, respErr = next(ctx, req)
+			if respErr != nil {
+				return respErr
+			}
+			if policy.retryable(req, resp, nil) {
+				return errRetryableResponse
+			}
+			return nil
+		})
+		if errors.Is(err, errRetryableResponse) {
+			// Out of attempts: hand the last response to the caller, which
+			// reports the controller's reason as usual.
+			return resp, nil
+		}
+		if err != nil && respErr == nil {
+			// The context was cancelled while waiting to retry.
+			return resp, err
+		}
+		return resp, respErr
+	}
+}
+
+// authMiddleware detects an expired or invalid CID, logs in again and replays
+// the request with the new CID.
+func (c *Client) authMiddleware(next Handler) Handler {
+	return func(ctx context.Context, req *Request) (*http.Response, error) {
+		if req.SkipAuth {
+			return next(ctx, req)
+		}
+		c.reloadAPITokenFile()
+
+		backoff := sessionBackoff
+		for try := 1; ; try++ {
+			req.cid = c.GetCID()
+			resp, err := next(ctx, req)
+			if err != nil {
+				return resp, err
+			}
+
+			reason, expired, err := c.sessionExpired(ctx, req, resp)
+			if err != nil || !expired {
+				return resp, err
+			}
+
+			c.logRequest(ctx, req, slog.LevelWarn, "Request failed with an expired CID", map[string]any{"session_try": try})
+
+			if try == maxSessionTries {
+				return resp, fmt.Errorf("%w: %s", ErrAuthExpired, reason)
+			}
+
+			c.logRequest(ctx, req, LevelTrace, "Logging in again")
+			if err = c.refreshSession(req.cid); err != nil {
+				return resp, err
+			}
+
+			select {
+			case <-time.After(backoff):
+				// Double the backoff time after each failed try
+				backoff *= 2
+			case <-ctx.Done():
+				return resp, ctx.Err()
+			}
+		}
+	}
+}
+
+// refreshSession logs in again after the Controller rejected staleCID.
+// Concurrent callers share a single Login, and a caller whose CID has already
+// been replaced by another goroutine does not log in at all.
+func (c *Client) refreshSession(staleCID string) error {
+	_, err, _ := c.logins.Do("login", func() (any, error) {
+		if c.GetCID() != staleCID {
+			return nil, nil
+		}
+		return nil, c.Login()
+	})
+	return err
+}
+
+// sessionExpired reports whether the controller rejected the request because
+// of an expired or invalid CID, along with the controller's reason.
+func (c *Client) sessionExpired(ctx context.Context, req *Request, resp *http.Response) (string, bool, error) {
+	if req.Version == APIv25 {
+		if resp.StatusCode != http.StatusForbidden {
+			return "", false, nil
+		}
+		body, err := PeekBody(resp)
+		if err != nil {
+			return "", false, err
+		}
+		apiError := new(APIError)
+		if err := json.Unmarshal(body, apiError); err != nil {
+			return "", false, fmt.Errorf("json Decode into error message failed: %w\n Body: %s", err, bodyForError(body))
+		}
+		if !strings.Contains(apiError.Message, "Invalid CID") {
+			c.logRequest(ctx, req, slog.LevelDebug, "API response error", map[string]any{"error": apiError.Message})
+			return "", false, nil
+		}
+		return apiError.Message, true, nil
+	}
+
+	if !strings.Contains(resp.Header.Get("Content-Type"), "json") {
+		return "", false, nil
+	}
+	body, err := PeekBody(resp)
+	if err != nil {
+		return "", false, err
+	}
+	data := new(APIResp)
+	if err := json.Unmarshal(body, data); err != nil {
+		return "", false, fmt.Errorf("json Decode into standard format failed: %w\n Body: %s", err, bodyForError(body))
+	}
+
+	if req.Version == APIv2 {
+		return data.Reason, strings.Contains(data.Reason, fmt.Sprintf("Session %s expired", req.cid)), nil
+	}
+	expired := strings.Contains(data.Reason, "CID is invalid") ||
+		strings.Contains(data.Reason, "Invalid session. Please login again.")
+	return data.Reason, expired, nil
+}
+
+// loggingMiddleware traces every attempt sent to the controller.
+func (c *Client) loggingMiddleware(next Handler) Handler {
+	return func(ctx context.Context, req *Request) (*http.Response, error) {
+		fields := map[string]any{"method": req.Method, "url": RedactString(req.URL)}
+		if req.Encoding != EncodingMultipart {
+			if body, _, err := req.encode(); err == nil && body != nil {
+				fields["body"] = RedactString(string(body))
+			}
+		}
+		c.logRequest(ctx, req, LevelTrace, "Sending request", fields)
+
+		resp, err := next(ctx, req)
+		if err != nil {
+			c.logRequest(ctx, req, slog.LevelDebug, "Request failed", map[string]any{"method": req.Method, "error": RedactString(err.Error())})
+			return resp, err
+		}
+		c.logRequest(ctx, req, slog.LevelDebug, "Received response", map[string]any{"method": req.Method, "status": resp.Status})
+		return resp, nil
+	}
+}
@@ -0,0 +1,270 @@
+package goaviatrix
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newPipelineTestServer returns a controller that issues "new-cid" on login
+// and hands every other request to next.
+func newPipelineTestServer(t *testing.T, next http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// get_api_token sends its form in the body of a GET request.
+		if body, err := io.ReadAll(r.Body); err == nil {
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			if values, err := url.ParseQuery(string(body)); err == nil && values.Get("action") == "get_api_token" {
+				r.Form = values
+			}
+		}
+		if r.Form == nil {
+			_ = r.ParseForm()
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Form.Get("action") {
+		case "get_api_token":
+			_ = json.NewEncoder(w).Encode(map[string]any{"return": true, "results": map[string]any{"api_token": "token"}})
+		case "login":
+			_ = json.NewEncoder(w).Encode(map[string]any{"return": true, "CID": "new-cid"})
+		default:
+			next(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	return &Client{
+		HTTPClient:   server.Client(),
+		CID:          "old-cid",
+		ControllerIP: u.Host,
+		baseURL:      server.URL + "/v2/api",
+	}
+}
+
+func TestPipeline_FormRequestRefreshesExpiredCID(t *testing.T) {
+	var cids []string
+	client := newPipelineTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		cids = append(cids, r.Form.Get("CID"))
+		if r.Form.Get("CID") != "new-cid" {
+			_, _ = w.Write([]byte(`{"return": false, "reason": "CID is invalid or expired."}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"return": true}`))
+	})
+
+	err := client.PostAPI("list_accounts", &APIRequest{CID: client.CID, Action: "list_accounts"}, BasicCheck)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"old-cid", "new-cid"}, cids)
+	assert.Equal(t, "new-cid", client.GetCID())
+}
+
+func TestPipeline_JSONRequestRefreshesExpiredCID(t *testing.T) {
+	var cids []string
+	client := newPipelineTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		cids = append(cids, body["CID"])
+		if body["CID"] != "new-cid" {
+			_, _ = w.Write([]byte(`{"return": false, "reason": "Session old-cid expired"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"return": true}`))
+	})
+
+	form := map[string]string{"CID": client.CID, "action": "list_vpcs"}
+	err := client.PostAPIContext2(context.Background(), nil, "list_vpcs", form, BasicCheck)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"old-cid", "new-cid"}, cids)
+}
+
+func TestPipeline_RESTRequestRefreshesExpiredCID(t *testing.T) {
+	var auth []string
+	client := newPipelineTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		auth = append(auth, r.Header.Get("Authorization"))
+		if r.Header.Get("Authorization") != "cid new-cid" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"message": "Invalid CID"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"name": "sg"}`))
+	})
+
+	var out map[string]string
+	err := client.GetAPIContext25(context.Background(), &out, "app-domains", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cid old-cid", "cid new-cid"}, auth)
+	assert.Equal(t, "sg", out["name"])
+}
+
+func TestPipeline_SessionRefreshGivesUp(t *testing.T) {
+	calls := 0
+	client := newPipelineTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		_, _ = w.Write([]byte(`{"return": false, "reason": "CID is invalid or expired."}`))
+	})
+
+	err := client.PostAPI("list_accounts", &APIRequest{CID: client.CID, Action: "list_accounts"}, BasicCheck)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "CID is invalid")
+	assert.Equal(t, maxSessionTries, calls)
+}
+
+func TestPipeline_RetryPolicyDoesNotRetryExpiredSession(t *testing.T) {
+	calls := 0
+	client := newPipelineTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		_, _ = w.Write([]byte(`{"return": false, "reason": "CID is invalid or expired."}`))
+	})
+	client.RetryPolicy = RetryPolicy{MaxAttempts: 5, Backoff: time.Millisecond}
+
+	err := client.GetAPI(nil, "list_accounts", map[string]string{"CID": client.CID, "action": "list_accounts"}, BasicCheck)
+
+	require.ErrorIs(t, err, ErrAuthExpired)
+	assert.Equal(t, maxSessionTries, calls, "a session that stays expired should end the call after a single attempt")
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+func TestPipeline_RetriesFailedGet(t *testing.T) {
+	attempts := 0
+	client := &Client{
+		baseURL: "https://controller/v2/api",
+		HTTPClient: &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			attempts++
+			if attempts < 3 {
+				return nil, errors.New("EOF")
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"return": true}`)),
+			}, nil
+		})},
+	}
+
+	resp, err := client.GetContext(context.Background(), client.baseURL+"?action=list_accounts", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestPipeline_DoesNotRetryFailedPost(t *testing.T) {
+	attempts := 0
+	client := &Client{
+		baseURL: "https://controller/v2/api",
+		HTTPClient: &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			attempts++
+			return nil, errors.New("EOF")
+		})},
+	}
+
+	_, err := client.PostContext(context.Background(), client.baseURL, map[string]string{"action": "create_gateway"})
+
+	require.Error(t, err)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestPipeline_UserMiddlewareSeesEveryGeneration(t *testing.T) {
+	client := newPipelineTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "audit", r.Header.Get("X-Audit"))
+		_, _ = w.Write([]byte(`{"return": true}`))
+	})
+
+	var actions []string
+	client.Use(func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) (*http.Response, error) {
+			actions = append(actions, req.Action)
+			if req.Header == nil {
+				req.Header = http.Header{}
+			}
+			req.Header.Set("X-Audit", "audit")
+			return next(ctx, req)
+		}
+	})
+
+	require.NoError(t, client.PostAPI("list_accounts", map[string]string{"action": "list_accounts"}, BasicCheck))
+	require.NoError(t, client.PostAPIContext2(context.Background(), nil, "list_vpcs", map[string]string{"action": "list_vpcs"}, BasicCheck))
+	require.NoError(t, client.PostAPIContext25(context.Background(), nil, "app-domains", map[string]string{"name": "sg"}))
+
+	assert.Equal(t, []string{"list_accounts", "list_vpcs", "app-domains"}, actions)
+}
+
+func TestRequest_EncodeReplacesCID(t *testing.T) {
+	req := &Request{Encoding: EncodingForm, Payload: &APIRequest{CID: "old", Action: "a"}, cid: "new"}
+	body, contentType, err := req.encode()
+	require.NoError(t, err)
+	assert.Equal(t, "application/x-www-form-urlencoded", contentType)
+	assert.True(t, strings.Contains(string(body), "CID=new"))
+
+	req = &Request{Encoding: EncodingJSON, Payload: map[string]string{"action": "a"}, cid: "new"}
+	body, _, err = req.encode()
+	require.NoError(t, err)
+	assert.NotContains(t, string(body), "CID", "CID is only replaced, never added")
+}
+
+func TestPipeline_RetryPolicyRetriesStatusAndReason(t *testing.T) {
+	calls := 0
+	client := newPipelineTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch calls {
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"return": false, "reason": "unavailable"}`))
+		case 2:
+			_, _ = w.Write([]byte(`{"return": false, "reason": "Controller is busy, please try again later"}`))
+		default:
+			_, _ = w.Write([]byte(`{"return": true}`))
+		}
+	})
+	client.RetryPolicy = RetryPolicy{
+		MaxAttempts:          3,
+		Backoff:              time.Millisecond,
+		RetryableStatusCodes: []int{http.StatusServiceUnavailable},
+		RetryableReasons:     []*regexp.Regexp{regexp.MustCompile(`(?i)busy`)},
+	}
+
+	err := client.PostAPI("create_gateway", map[string]string{"action": "create_gateway"}, BasicCheck)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestPipeline_RetryPolicyReturnsLastResponseWhenExhausted(t *testing.T) {
+	calls := 0
+	client := newPipelineTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		_, _ = w.Write([]byte(`{"return": false, "reason": "Controller is busy"}`))
+	})
+	client.RetryPolicy = RetryPolicy{
+		MaxAttempts:      2,
+		Backoff:          time.Millisecond,
+		RetryableReasons: []*regexp.Regexp{regexp.MustCompile(`busy`)},
+	}
+
+	err := client.PostAPI("create_gateway", map[string]string{"action": "create_gateway"}, BasicCheck)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Controller is busy")
+	assert.Equal(t, 2, calls)
+}
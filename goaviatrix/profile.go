@@ -1,11 +1,10 @@
 package goaviatrix
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
-
-	log "github.com/sirupsen/logrus"
 )
 
 type ProfileRule struct {
@@ -116,7 +115,7 @@ func (c *Client) GetProfile(profile *Profile) (*Profile, error) {
 	}
 
 	profile.Policy = data1.Results
-	log.Tracef("Profile policy %s", profile.Policy)
+	c.log(context.Background(), LevelTrace, fmt.Sprintf("Profile policy %s", profile.Policy))
 
 	form2 := map[string]string{
 		"CID":    c.CID,
@@ -131,12 +130,12 @@ func (c *Client) GetProfile(profile *Profile) (*Profile, error) {
 	}
 
 	profile.UserList = data2.Results[profile.Name]
-	log.Tracef("Profile list of users %s", profile.UserList)
+	c.log(context.Background(), LevelTrace, fmt.Sprintf("Profile list of users %s", profile.UserList))
 	return profile, nil
 }
 
 func (c *Client) UpdateProfilePolicy(profile *Profile) error {
-	log.Tracef("Updating Profile Policy %#v", profile)
+	c.log(context.Background(), LevelTrace, fmt.Sprintf("Updating Profile Policy %#v", Redact(profile)))
 
 	policyStr, _ := json.Marshal(profile.Policy)
 	form := map[string]string{
@@ -150,7 +149,7 @@ func (c *Client) UpdateProfilePolicy(profile *Profile) error {
 }
 
 func (c *Client) AttachUsers(profile *Profile) error {
-	log.Tracef("Attaching users %s", profile.UserList)
+	c.log(context.Background(), LevelTrace, fmt.Sprintf("Attaching users %s", profile.UserList))
 
 	for _, user := range profile.UserList {
 		form := map[string]string{
@@ -169,7 +168,7 @@ func (c *Client) AttachUsers(profile *Profile) error {
 }
 
 func (c *Client) DetachUsers(profile *Profile) error {
-	log.Tracef("Detaching users %s", profile.UserList)
+	c.log(context.Background(), LevelTrace, fmt.Sprintf("Detaching users %s", profile.UserList))
 
 	for _, user := range profile.UserList {
 		form := map[string]string{
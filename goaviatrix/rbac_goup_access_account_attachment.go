@@ -1,9 +1,10 @@
 package goaviatrix
 
 import (
+	"context"
+	"fmt"
+	"log/slog"
 	"strings"
-
-	log "github.com/sirupsen/logrus"
 )
 
 type RbacGroupAccessAccountAttachment struct {
@@ -43,14 +44,12 @@ func (c *Client) GetRbacGroupAccessAccountAttachment(rbacGroupAccessAccountAttac
 	attachments := data.RbacGroupAccessAccountAttachmentList
 	for i := range attachments {
 		if attachments[i] == rbacGroupAccessAccountAttachment.AccessAccountName {
-			log.Infof("Found Aviatrix RBAC group access account attachment: %s",
-				rbacGroupAccessAccountAttachment.GroupName+"~"+rbacGroupAccessAccountAttachment.AccessAccountName)
+			c.log(context.Background(), slog.LevelInfo, fmt.Sprintf("Found Aviatrix RBAC group access account attachment: %s", rbacGroupAccessAccountAttachment.GroupName+"~"+rbacGroupAccessAccountAttachment.AccessAccountName))
 			return rbacGroupAccessAccountAttachment, nil
 		}
 	}
 
-	log.Errorf("Couldn't find Aviatrix RBAC group access account attachment: %s",
-		rbacGroupAccessAccountAttachment.GroupName+"~"+rbacGroupAccessAccountAttachment.AccessAccountName)
+	c.log(context.Background(), slog.LevelError, fmt.Sprintf("Couldn't find Aviatrix RBAC group access account attachment: %s", rbacGroupAccessAccountAttachment.GroupName+"~"+rbacGroupAccessAccountAttachment.AccessAccountName))
 	return nil, ErrNotFound
 }
 
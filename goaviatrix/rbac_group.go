@@ -1,7 +1,9 @@
 package goaviatrix
 
 import (
-	log "github.com/sirupsen/logrus"
+	"context"
+	"fmt"
+	"log/slog"
 )
 
 type RbacGroup struct {
@@ -50,12 +52,12 @@ func (c *Client) GetPermissionGroup(rbacGroup *RbacGroup) (*RbacGroup, error) {
 	groups := data.RbacGroupList
 	for i := range groups {
 		if groups[i] == rbacGroup.GroupName {
-			log.Infof("Found Aviatrix RBAC group: %s", rbacGroup.GroupName)
+			c.log(context.Background(), slog.LevelInfo, fmt.Sprintf("Found Aviatrix RBAC group: %s", rbacGroup.GroupName))
 			return rbacGroup, nil
 		}
 	}
 
-	log.Errorf("Couldn't find Aviatrix RBAC group: %s", rbacGroup.GroupName)
+	c.log(context.Background(), slog.LevelError, fmt.Sprintf("Couldn't find Aviatrix RBAC group: %s", rbacGroup.GroupName))
 	return nil, ErrNotFound
 }
 
@@ -103,10 +105,10 @@ func (c *Client) GetPermissionGroupDetails(GroupName string) (*RbacGroupResponse
 	groups := data.RbacGroupList
 	for i := range groups {
 		if groups[i].GroupName == GroupName {
-			log.Infof("Found Aviatrix RBAC group: %s", GroupName)
+			c.log(context.Background(), slog.LevelInfo, fmt.Sprintf("Found Aviatrix RBAC group: %s", GroupName))
 			return &groups[i], nil
 		}
 	}
-	log.Errorf("Couldn't find Aviatrix RBAC group: %s", GroupName)
+	c.log(context.Background(), slog.LevelError, fmt.Sprintf("Couldn't find Aviatrix RBAC group: %s", GroupName))
 	return nil, ErrNotFound
 }
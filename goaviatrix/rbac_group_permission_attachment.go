@@ -1,7 +1,9 @@
 package goaviatrix
 
 import (
-	log "github.com/sirupsen/logrus"
+	"context"
+	"fmt"
+	"log/slog"
 )
 
 type RbacGroupPermissionAttachment struct {
@@ -48,14 +50,12 @@ func (c *Client) GetRbacGroupPermissionAttachment(rbacGroupPermissionAttachment
 	attachments := data.RbacGroupPermissionAttachmentList
 	for i := range attachments {
 		if attachments[i].Name == rbacGroupPermissionAttachment.PermissionName {
-			log.Infof("Found Aviatrix RBAC group permission attachment: %s",
-				rbacGroupPermissionAttachment.GroupName+"~"+rbacGroupPermissionAttachment.PermissionName)
+			c.log(context.Background(), slog.LevelInfo, fmt.Sprintf("Found Aviatrix RBAC group permission attachment: %s", rbacGroupPermissionAttachment.GroupName+"~"+rbacGroupPermissionAttachment.PermissionName))
 			return rbacGroupPermissionAttachment, nil
 		}
 	}
 
-	log.Errorf("Couldn't find Aviatrix RBAC group permission attachment: %s",
-		rbacGroupPermissionAttachment.GroupName+"~"+rbacGroupPermissionAttachment.PermissionName)
+	c.log(context.Background(), slog.LevelError, fmt.Sprintf("Couldn't find Aviatrix RBAC group permission attachment: %s", rbacGroupPermissionAttachment.GroupName+"~"+rbacGroupPermissionAttachment.PermissionName))
 	return nil, ErrNotFound
 }
 
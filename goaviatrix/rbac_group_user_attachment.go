@@ -1,9 +1,10 @@
 package goaviatrix
 
 import (
+	"context"
+	"fmt"
+	"log/slog"
 	"strings"
-
-	log "github.com/sirupsen/logrus"
 )
 
 type RbacGroupUserAttachment struct {
@@ -43,14 +44,12 @@ func (c *Client) GetRbacGroupUserAttachment(rbacGroupUserAttachment *RbacGroupUs
 	attachments := data.RbacGroupUserAttachmentList
 	for i := range attachments {
 		if attachments[i] == rbacGroupUserAttachment.UserName {
-			log.Infof("Found Aviatrix RBAC group user attachment: %s",
-				rbacGroupUserAttachment.GroupName+"~"+rbacGroupUserAttachment.UserName)
+			c.log(context.Background(), slog.LevelInfo, fmt.Sprintf("Found Aviatrix RBAC group user attachment: %s", rbacGroupUserAttachment.GroupName+"~"+rbacGroupUserAttachment.UserName))
 			return rbacGroupUserAttachment, nil
 		}
 	}
 
-	log.Errorf("Couldn't find Aviatrix RBAC group user attachment: %s",
-		rbacGroupUserAttachment.GroupName+"~"+rbacGroupUserAttachment.UserName)
+	c.log(context.Background(), slog.LevelError, fmt.Sprintf("Couldn't find Aviatrix RBAC group user attachment: %s", rbacGroupUserAttachment.GroupName+"~"+rbacGroupUserAttachment.UserName))
 	return nil, ErrNotFound
 }
 
@@ -0,0 +1,257 @@
+package goaviatrix
+
+import (
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// RedactedValue replaces the value of a sensitive field in logs.
+const RedactedValue = "***"
+
+// sensitiveFields are the names of the fields whose values are redacted. A
+// field is sensitive when these words appear in its name, e.g. password in
+// ldap_password or pre_shared_key in pre_shared_key_tun_1.
+var sensitiveFields = struct {
+	sync.RWMutex
+	names []string
+}{
+	names: []string{
+		"cid",
+		"password",
+		"passwd",
+		"api_token",
+		"api_key",
+		"token",
+		"pre_shared_key",
+		"private_key",
+		"secret",
+		"md5_key",
+		"sic_key",
+		"storage_access_key",
+	},
+}
+
+// sensitiveExactFields are the names of fields that are sensitive only as a
+// whole, e.g. key, the private key of a MITM CA, but not key_name. Their words
+// are too common to redact every field containing them.
+var sensitiveExactFields = []string{"key", "ca_key"}
+
+// sensitiveSuffix ends the names of fields holding a private key in PEM, e.g.
+// ca_key_pem.
+const sensitiveSuffix = "_key_pem"
+
+// RegisterSensitiveFields adds field names whose values are redacted from
+// logs, in addition to the built-in ones such as CID, password and
+// pre_shared_key. Names are matched like the built-in ones, ignoring case.
+func RegisterSensitiveFields(names ...string) {
+	sensitiveFields.Lock()
+	defer sensitiveFields.Unlock()
+	for _, name := range names {
+		name = fieldWords(name)
+		if name != "" && !slices.Contains(sensitiveFields.names, name) {
+			sensitiveFields.names = append(sensitiveFields.names, name)
+		}
+	}
+}
+
+// SensitiveFields returns the names of the fields whose values are redacted.
+func SensitiveFields() []string {
+	sensitiveFields.RLock()
+	defer sensitiveFields.RUnlock()
+	return slices.Clone(sensitiveFields.names)
+}
+
+// IsSensitiveField reports whether the value of the field is redacted. name
+// can be a form or JSON field, e.g. aws_secret_key, or a Go field, e.g.
+// AwsSecretKey.
+func IsSensitiveField(name string) bool {
+	words := fieldWords(name)
+	if slices.Contains(sensitiveExactFields, words) || strings.HasSuffix("_"+words, sensitiveSuffix) {
+		return true
+	}
+	words = "_" + words + "_"
+	sensitiveFields.RLock()
+	defer sensitiveFields.RUnlock()
+	for _, sensitive := range sensitiveFields.names {
+		if strings.Contains(words, "_"+sensitive+"_") {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldWords returns the words of a field name in lower case, separated by
+// underscores, e.g. "api_token" for APIToken, apiToken or api-token.
+func fieldWords(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		switch {
+		case r == '-' || r == '_' || r == '.' || r == ' ':
+			b.WriteByte('_')
+		case unicode.IsUpper(r):
+			// A new word starts at an upper case letter after a lower case
+			// one, or at the last letter of an acronym, as in APIToken.
+			if i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) ||
+				(unicode.IsUpper(runes[i-1]) && i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				b.WriteByte('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return strings.Trim(b.String(), "_")
+}
+
+var (
+	// redactJSON matches a JSON member, "key": "value".
+	redactJSON = regexp.MustCompile(`"([^"\\]+)"(\s*:\s*)("(?:[^"\\]|\\.)*"|[^\s,{}\[\]"]+)`)
+	// redactForm matches a form or query parameter, key=value.
+	redactForm = regexp.MustCompile(`(^|[?&\s])([\w.\-\[\]]+)=([^&#\s"]*)`)
+	// redactGo matches a field of a value printed with %#v or %+v, or an
+	// entry of a map printed with %v, Key:value.
+	redactGo = regexp.MustCompile(`([A-Za-z_]\w*):("(?:[^"\\]|\\.)*"|[^\s,{}\[\]"]+)`)
+)
+
+// RedactString redacts the values of the sensitive fields in text that can
+// hold them: form and query parameters, JSON documents and values printed
+// with the fmt package. Values printed with %v, which does not print field
+// names, cannot be redacted; log them with Redact instead.
+func RedactString(s string) string {
+	s = redactJSON.ReplaceAllStringFunc(s, func(m string) string {
+		sub := redactJSON.FindStringSubmatch(m)
+		if !IsSensitiveField(sub[1]) {
+			return m
+		}
+		return `"` + sub[1] + `"` + sub[2] + `"` + RedactedValue + `"`
+	})
+	s = redactForm.ReplaceAllStringFunc(s, func(m string) string {
+		sub := redactForm.FindStringSubmatch(m)
+		if !IsSensitiveField(sub[2]) || sub[3] == "" {
+			return m
+		}
+		return sub[1] + sub[2] + "=" + RedactedValue
+	})
+	return redactGo.ReplaceAllStringFunc(s, func(m string) string {
+		sub := redactGo.FindStringSubmatch(m)
+		if !IsSensitiveField(sub[1]) || sub[2] == `""` || sub[2] == `"`+RedactedValue+`"` {
+			return m
+		}
+		if strings.HasPrefix(sub[2], `"`) {
+			return sub[1] + `:"` + RedactedValue + `"`
+		}
+		return sub[1] + ":" + RedactedValue
+	})
+}
+
+// maxRedactDepth bounds the recursion of Redact into cyclic values.
+const maxRedactDepth = 16
+
+// Redact returns a copy of v to log, with the sensitive fields of structs and
+// the sensitive keys of maps in it set to RedactedValue. A struct field is
+// sensitive if its form or JSON name, or else its Go name, is. v itself is
+// not modified.
+func Redact(v any) any {
+	if v == nil {
+		return nil
+	}
+	return redactValue(reflect.ValueOf(v), 0).Interface()
+}
+
+func redactValue(v reflect.Value, depth int) reflect.Value {
+	if depth > maxRedactDepth {
+		return v
+	}
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() || v.Elem().Kind() != reflect.Struct {
+			return v
+		}
+		out := reflect.New(v.Elem().Type())
+		out.Elem().Set(redactValue(v.Elem(), depth+1))
+		return out
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		out := reflect.New(v.Type()).Elem()
+		out.Set(redactValue(v.Elem(), depth+1))
+		return out
+	case reflect.Struct:
+		out := reflect.New(v.Type()).Elem()
+		out.Set(v)
+		for i := range v.NumField() {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			if IsSensitiveField(structFieldName(field)) {
+				redactSensitive(out.Field(i))
+			} else {
+				out.Field(i).Set(redactValue(v.Field(i), depth+1))
+			}
+		}
+		return out
+	case reflect.Map:
+		if v.IsNil() || v.Type().Key().Kind() != reflect.String {
+			return v
+		}
+		out := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			value := reflect.New(v.Type().Elem()).Elem()
+			if IsSensitiveField(iter.Key().String()) {
+				value.Set(iter.Value())
+				redactSensitive(value)
+			} else {
+				value.Set(redactValue(iter.Value(), depth+1))
+			}
+			out.SetMapIndex(iter.Key(), value)
+		}
+		return out
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		out := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := range v.Len() {
+			out.Index(i).Set(redactValue(v.Index(i), depth+1))
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// redactSensitive sets a sensitive value to RedactedValue if it is a non-empty
+// string, or else to its zero value.
+func redactSensitive(v reflect.Value) {
+	switch {
+	case v.Kind() == reflect.String:
+		if v.Len() != 0 {
+			v.SetString(RedactedValue)
+		}
+	case v.Kind() == reflect.Interface && !v.IsNil() && v.Elem().Kind() == reflect.String:
+		v.Set(reflect.ValueOf(RedactedValue))
+	case v.Kind() == reflect.Bool:
+		// e.g. has_client_private_key, which is not a secret
+	default:
+		v.SetZero()
+	}
+}
+
+// structFieldName returns the name of a struct field in forms and JSON,
+// falling back to its Go name.
+func structFieldName(field reflect.StructField) string {
+	for _, key := range []string{"form", "json"} {
+		if name, _, _ := strings.Cut(field.Tag.Get(key), ","); name != "" && name != "-" {
+			return name
+		}
+	}
+	return field.Name
+}
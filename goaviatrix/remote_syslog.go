@@ -5,7 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/http"
 	"strconv"
 	"time"
@@ -142,7 +142,7 @@ func (c *Client) DisableRemoteSyslog(idx int) error {
 		"index":  strconv.Itoa(idx),
 	}
 
-	log.Printf("[INFO] Deleting remote syslog index %d", idx)
+	c.log(context.Background(), slog.LevelInfo, fmt.Sprintf("Deleting remote syslog index %d", idx))
 
 	return c.PostAPI(params["action"], params, BasicCheck)
 }
@@ -199,7 +199,7 @@ func (c *Client) GetAPIRemoteSyslog(
 		return fmt.Errorf("json Decode into standard format failed: %w\n Body: %s", err, bodyString)
 	}
 	if err := checkFunc(action, "Get", data.Reason, data.Return); err != nil {
-		return err
+		return classifyError(err, action, resp.StatusCode, data.Reason)
 	}
 
 	if err := myUnmarshal(bodyBytes, v); err != nil {
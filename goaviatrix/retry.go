@@ -1,6 +1,16 @@
 package goaviatrix
 
-import "time"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
 
 // RetryConfig holds configuration for retry behavior.
 type RetryConfig struct {
@@ -11,6 +21,11 @@ type RetryConfig struct {
 	// BackoffFunc advances the backoff duration after each failed attempt.
 	// Defaults to exponential doubling when nil.
 	BackoffFunc func(time.Duration) time.Duration
+	// MaxBackoff caps the sleep duration between attempts. Zero means no cap.
+	MaxBackoff time.Duration
+	// Jitter sleeps for a random duration between half and all of the
+	// backoff, so concurrent callers do not retry in lockstep.
+	Jitter bool
 	// ShouldRetry determines whether the error warrants another attempt.
 	// Defaults to always retrying when nil.
 	ShouldRetry func(error) bool
@@ -23,6 +38,12 @@ type RetryConfig struct {
 // according to cfg.Backoff and cfg.BackoffFunc. It returns immediately when
 // fn succeeds or when ShouldRetry returns false for the error.
 func Retry(cfg RetryConfig, fn func() error) error {
+	return RetryContext(context.Background(), cfg, fn)
+}
+
+// RetryContext is like Retry but stops waiting between attempts as soon as ctx
+// is done, returning the context error.
+func RetryContext(ctx context.Context, cfg RetryConfig, fn func() error) error {
 	backoffFn := cfg.BackoffFunc
 	if backoffFn == nil {
 		backoffFn = func(d time.Duration) time.Duration { return d * 2 }
@@ -41,11 +62,114 @@ func Retry(cfg RetryConfig, fn func() error) error {
 		if try == cfg.MaxTries {
 			break
 		}
+		sleep := backoff
+		if cfg.MaxBackoff > 0 && sleep > cfg.MaxBackoff {
+			sleep = cfg.MaxBackoff
+		}
+		if cfg.Jitter && sleep > 1 {
+			sleep = sleep/2 + rand.N(sleep/2) //nolint:gosec // jitter does not need a secure source
+		}
 		if cfg.OnRetry != nil {
-			cfg.OnRetry(try, backoff, err)
+			cfg.OnRetry(try, sleep, err)
+		}
+		select {
+		case <-time.After(sleep):
+		case <-ctx.Done():
+			return ctx.Err()
 		}
-		time.Sleep(backoff)
 		backoff = backoffFn(backoff)
 	}
 	return err
 }
+
+// RetryPolicy decides how the request pipeline retries failed controller
+// calls. Transport errors are only retried for GET requests, since the
+// controller may already have acted on any other request whose response was
+// lost. Responses matching RetryableStatusCodes or RetryableReasons are
+// retried for every verb, because the controller explicitly refused them.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts (including the first).
+	MaxAttempts int
+	// Backoff is the initial sleep duration between attempts. It doubles
+	// after each failed attempt.
+	Backoff time.Duration
+	// MaxBackoff caps the sleep duration between attempts. Zero means no cap.
+	MaxBackoff time.Duration
+	// Jitter randomizes each sleep between half and all of the backoff.
+	Jitter bool
+	// RetryableStatusCodes are HTTP status codes that warrant another attempt.
+	RetryableStatusCodes []int
+	// RetryableReasons are matched against the reason or message of a failed
+	// API response.
+	RetryableReasons []*regexp.Regexp
+}
+
+// DefaultRetryPolicy returns the retry policy used when none is configured.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 5,
+		Backoff:     500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+	}
+}
+
+// errRetryableResponse marks a response the policy asked to retry.
+var errRetryableResponse = errors.New("retryable response")
+
+// retryable reports whether a request should be attempted again, given the
+// response and error of the last attempt.
+func (p RetryPolicy) retryable(req *Request, resp *http.Response, err error) bool {
+	if err != nil {
+		// A rejected credential stays rejected, and a request missing
+		// from a cassette stays missing; only network errors on reads are
+		// worth another attempt.
+		return req.Method == http.MethodGet && !errors.Is(err, ErrAuthExpired) && !errors.Is(err, ErrCassetteMiss)
+	}
+	if resp == nil {
+		return false
+	}
+	if slices.Contains(p.RetryableStatusCodes, resp.StatusCode) {
+		return true
+	}
+	if len(p.RetryableReasons) == 0 || !strings.Contains(resp.Header.Get("Content-Type"), "json") {
+		return false
+	}
+	reason := failureReason(resp)
+	if reason == "" {
+		return false
+	}
+	for _, re := range p.RetryableReasons {
+		if re.MatchString(reason) {
+			return true
+		}
+	}
+	return false
+}
+
+// failureReason returns the controller's explanation for a failed response:
+// the reason of a v1/v2 response with return set to false, or the message of
+// a v2.5 error response.
+func failureReason(resp *http.Response) string {
+	body, err := PeekBody(resp)
+	if err != nil {
+		return ""
+	}
+	var data struct {
+		Return  *bool  `json:"return"`
+		Reason  string `json:"reason"`
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(body, &data) != nil {
+		return ""
+	}
+	if data.Return != nil {
+		if *data.Return {
+			return ""
+		}
+		return data.Reason
+	}
+	if resp.StatusCode >= 300 {
+		return data.Message
+	}
+	return ""
+}
@@ -0,0 +1,48 @@
+package goaviatrix
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryContext_CapsAndJittersBackoff(t *testing.T) {
+	var sleeps []time.Duration
+	err := RetryContext(context.Background(), RetryConfig{
+		MaxTries:   4,
+		Backoff:    4 * time.Millisecond,
+		MaxBackoff: 10 * time.Millisecond,
+		Jitter:     true,
+		OnRetry: func(_ int, backoff time.Duration, _ error) {
+			sleeps = append(sleeps, backoff)
+		},
+	}, func() error { return errors.New("busy") })
+
+	assert.EqualError(t, err, "busy")
+	if assert.Len(t, sleeps, 3) {
+		for i, ceiling := range []time.Duration{4, 8, 10} {
+			ceiling *= time.Millisecond
+			assert.GreaterOrEqual(t, sleeps[i], ceiling/2)
+			assert.LessOrEqual(t, sleeps[i], ceiling)
+		}
+	}
+}
+
+func TestRetryContext_StopsWhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	tries := 0
+	err := RetryContext(ctx, RetryConfig{
+		MaxTries: 5,
+		Backoff:  time.Hour,
+	}, func() error {
+		tries++
+		cancel()
+		return errors.New("busy")
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, tries)
+}
@@ -0,0 +1,463 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package goaviatrix
+
+import (
+	"sync"
+)
+
+// Ensure, that SegmentationClientMock does implement SegmentationClient.
+// If this is not the case, regenerate this file with moq.
+var _ SegmentationClient = &SegmentationClientMock{}
+
+// SegmentationClientMock is a mock implementation of SegmentationClient.
+//
+//	func TestSomethingThatUsesSegmentationClient(t *testing.T) {
+//
+//		// make and configure a mocked SegmentationClient
+//		mockedSegmentationClient := &SegmentationClientMock{
+//			CreateSegmentationSecurityDomainFunc: func(domain *SegmentationSecurityDomain) error {
+//				panic("mock out the CreateSegmentationSecurityDomain method")
+//			},
+//			CreateSegmentationSecurityDomainAssociationFunc: func(association *SegmentationSecurityDomainAssociation) error {
+//				panic("mock out the CreateSegmentationSecurityDomainAssociation method")
+//			},
+//			CreateSegmentationSecurityDomainConnectionPolicyFunc: func(policy *SegmentationSecurityDomainConnectionPolicy) error {
+//				panic("mock out the CreateSegmentationSecurityDomainConnectionPolicy method")
+//			},
+//			DeleteSegmentationSecurityDomainFunc: func(domain *SegmentationSecurityDomain) error {
+//				panic("mock out the DeleteSegmentationSecurityDomain method")
+//			},
+//			DeleteSegmentationSecurityDomainAssociationFunc: func(association *SegmentationSecurityDomainAssociation) error {
+//				panic("mock out the DeleteSegmentationSecurityDomainAssociation method")
+//			},
+//			DeleteSegmentationSecurityDomainConnectionPolicyFunc: func(policy *SegmentationSecurityDomainConnectionPolicy) error {
+//				panic("mock out the DeleteSegmentationSecurityDomainConnectionPolicy method")
+//			},
+//			GetSegmentationSecurityDomainFunc: func(domain *SegmentationSecurityDomain) (*SegmentationSecurityDomain, error) {
+//				panic("mock out the GetSegmentationSecurityDomain method")
+//			},
+//			GetSegmentationSecurityDomainAssociationFunc: func(association *SegmentationSecurityDomainAssociation) (*SegmentationSecurityDomainAssociation, error) {
+//				panic("mock out the GetSegmentationSecurityDomainAssociation method")
+//			},
+//			GetSegmentationSecurityDomainConnectionPolicyFunc: func(policy *SegmentationSecurityDomainConnectionPolicy) (*SegmentationSecurityDomainConnectionPolicy, error) {
+//				panic("mock out the GetSegmentationSecurityDomainConnectionPolicy method")
+//			},
+//			ListSegmentationSecurityDomainsFunc: func() ([]string, error) {
+//				panic("mock out the ListSegmentationSecurityDomains method")
+//			},
+//		}
+//
+//		// use mockedSegmentationClient in code that requires SegmentationClient
+//		// and then make assertions.
+//
+//	}
+type SegmentationClientMock struct {
+	// CreateSegmentationSecurityDomainFunc mocks the CreateSegmentationSecurityDomain method.
+	CreateSegmentationSecurityDomainFunc func(domain *SegmentationSecurityDomain) error
+
+	// CreateSegmentationSecurityDomainAssociationFunc mocks the CreateSegmentationSecurityDomainAssociation method.
+	CreateSegmentationSecurityDomainAssociationFunc func(association *SegmentationSecurityDomainAssociation) error
+
+	// CreateSegmentationSecurityDomainConnectionPolicyFunc mocks the CreateSegmentationSecurityDomainConnectionPolicy method.
+	CreateSegmentationSecurityDomainConnectionPolicyFunc func(policy *SegmentationSecurityDomainConnectionPolicy) error
+
+	// DeleteSegmentationSecurityDomainFunc mocks the DeleteSegmentationSecurityDomain method.
+	DeleteSegmentationSecurityDomainFunc func(domain *SegmentationSecurityDomain) error
+
+	// DeleteSegmentationSecurityDomainAssociationFunc mocks the DeleteSegmentationSecurityDomainAssociation method.
+	DeleteSegmentationSecurityDomainAssociationFunc func(association *SegmentationSecurityDomainAssociation) error
+
+	// DeleteSegmentationSecurityDomainConnectionPolicyFunc mocks the DeleteSegmentationSecurityDomainConnectionPolicy method.
+	DeleteSegmentationSecurityDomainConnectionPolicyFunc func(policy *SegmentationSecurityDomainConnectionPolicy) error
+
+	// GetSegmentationSecurityDomainFunc mocks the GetSegmentationSecurityDomain method.
+	GetSegmentationSecurityDomainFunc func(domain *SegmentationSecurityDomain) (*SegmentationSecurityDomain, error)
+
+	// GetSegmentationSecurityDomainAssociationFunc mocks the GetSegmentationSecurityDomainAssociation method.
+	GetSegmentationSecurityDomainAssociationFunc func(association *SegmentationSecurityDomainAssociation) (*SegmentationSecurityDomainAssociation, error)
+
+	// GetSegmentationSecurityDomainConnectionPolicyFunc mocks the GetSegmentationSecurityDomainConnectionPolicy method.
+	GetSegmentationSecurityDomainConnectionPolicyFunc func(policy *SegmentationSecurityDomainConnectionPolicy) (*SegmentationSecurityDomainConnectionPolicy, error)
+
+	// ListSegmentationSecurityDomainsFunc mocks the ListSegmentationSecurityDomains method.
+	ListSegmentationSecurityDomainsFunc func() ([]string, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateSegmentationSecurityDomain holds details about calls to the CreateSegmentationSecurityDomain method.
+		CreateSegmentationSecurityDomain []struct {
+			// Domain is the domain argument value.
+			Domain *SegmentationSecurityDomain
+		}
+		// CreateSegmentationSecurityDomainAssociation holds details about calls to the CreateSegmentationSecurityDomainAssociation method.
+		CreateSegmentationSecurityDomainAssociation []struct {
+			// Association is the association argument value.
+			Association *SegmentationSecurityDomainAssociation
+		}
+		// CreateSegmentationSecurityDomainConnectionPolicy holds details about calls to the CreateSegmentationSecurityDomainConnectionPolicy method.
+		CreateSegmentationSecurityDomainConnectionPolicy []struct {
+			// Policy is the policy argument value.
+			Policy *SegmentationSecurityDomainConnectionPolicy
+		}
+		// DeleteSegmentationSecurityDomain holds details about calls to the DeleteSegmentationSecurityDomain method.
+		DeleteSegmentationSecurityDomain []struct {
+			// Domain is the domain argument value.
+			Domain *SegmentationSecurityDomain
+		}
+		// DeleteSegmentationSecurityDomainAssociation holds details about calls to the DeleteSegmentationSecurityDomainAssociation method.
+		DeleteSegmentationSecurityDomainAssociation []struct {
+			// Association is the association argument value.
+			Association *SegmentationSecurityDomainAssociation
+		}
+		// DeleteSegmentationSecurityDomainConnectionPolicy holds details about calls to the DeleteSegmentationSecurityDomainConnectionPolicy method.
+		DeleteSegmentationSecurityDomainConnectionPolicy []struct {
+			// Policy is the policy argument value.
+			Policy *SegmentationSecurityDomainConnectionPolicy
+		}
+		// GetSegmentationSecurityDomain holds details about calls to the GetSegmentationSecurityDomain method.
+		GetSegmentationSecurityDomain []struct {
+			// Domain is the domain argument value.
+			Domain *SegmentationSecurityDomain
+		}
+		// GetSegmentationSecurityDomainAssociation holds details about calls to the GetSegmentationSecurityDomainAssociation method.
+		GetSegmentationSecurityDomainAssociation []struct {
+			// Association is the association argument value.
+			Association *SegmentationSecurityDomainAssociation
+		}
+		// GetSegmentationSecurityDomainConnectionPolicy holds details about calls to the GetSegmentationSecurityDomainConnectionPolicy method.
+		GetSegmentationSecurityDomainConnectionPolicy []struct {
+			// Policy is the policy argument value.
+			Policy *SegmentationSecurityDomainConnectionPolicy
+		}
+		// ListSegmentationSecurityDomains holds details about calls to the ListSegmentationSecurityDomains method.
+		ListSegmentationSecurityDomains []struct {
+		}
+	}
+	lockCreateSegmentationSecurityDomain                 sync.RWMutex
+	lockCreateSegmentationSecurityDomainAssociation      sync.RWMutex
+	lockCreateSegmentationSecurityDomainConnectionPolicy sync.RWMutex
+	lockDeleteSegmentationSecurityDomain                 sync.RWMutex
+	lockDeleteSegmentationSecurityDomainAssociation      sync.RWMutex
+	lockDeleteSegmentationSecurityDomainConnectionPolicy sync.RWMutex
+	lockGetSegmentationSecurityDomain                    sync.RWMutex
+	lockGetSegmentationSecurityDomainAssociation         sync.RWMutex
+	lockGetSegmentationSecurityDomainConnectionPolicy    sync.RWMutex
+	lockListSegmentationSecurityDomains                  sync.RWMutex
+}
+
+// CreateSegmentationSecurityDomain calls CreateSegmentationSecurityDomainFunc.
+func (mock *SegmentationClientMock) CreateSegmentationSecurityDomain(domain *SegmentationSecurityDomain) error {
+	if mock.CreateSegmentationSecurityDomainFunc == nil {
+		panic("SegmentationClientMock.CreateSegmentationSecurityDomainFunc: method is nil but SegmentationClient.CreateSegmentationSecurityDomain was just called")
+	}
+	callInfo := struct {
+		Domain *SegmentationSecurityDomain
+	}{
+		Domain: domain,
+	}
+	mock.lockCreateSegmentationSecurityDomain.Lock()
+	mock.calls.CreateSegmentationSecurityDomain = append(mock.calls.CreateSegmentationSecurityDomain, callInfo)
+	mock.lockCreateSegmentationSecurityDomain.Unlock()
+	return mock.CreateSegmentationSecurityDomainFunc(domain)
+}
+
+// CreateSegmentationSecurityDomainCalls gets all the calls that were made to CreateSegmentationSecurityDomain.
+// Check the length with:
+//
+//	len(mockedSegmentationClient.CreateSegmentationSecurityDomainCalls())
+func (mock *SegmentationClientMock) CreateSegmentationSecurityDomainCalls() []struct {
+	Domain *SegmentationSecurityDomain
+} {
+	var calls []struct {
+		Domain *SegmentationSecurityDomain
+	}
+	mock.lockCreateSegmentationSecurityDomain.RLock()
+	calls = mock.calls.CreateSegmentationSecurityDomain
+	mock.lockCreateSegmentationSecurityDomain.RUnlock()
+	return calls
+}
+
+// CreateSegmentationSecurityDomainAssociation calls CreateSegmentationSecurityDomainAssociationFunc.
+func (mock *SegmentationClientMock) CreateSegmentationSecurityDomainAssociation(association *SegmentationSecurityDomainAssociation) error {
+	if mock.CreateSegmentationSecurityDomainAssociationFunc == nil {
+		panic("SegmentationClientMock.CreateSegmentationSecurityDomainAssociationFunc: method is nil but SegmentationClient.CreateSegmentationSecurityDomainAssociation was just called")
+	}
+	callInfo := struct {
+		Association *SegmentationSecurityDomainAssociation
+	}{
+		Association: association,
+	}
+	mock.lockCreateSegmentationSecurityDomainAssociation.Lock()
+	mock.calls.CreateSegmentationSecurityDomainAssociation = append(mock.calls.CreateSegmentationSecurityDomainAssociation, callInfo)
+	mock.lockCreateSegmentationSecurityDomainAssociation.Unlock()
+	return mock.CreateSegmentationSecurityDomainAssociationFunc(association)
+}
+
+// CreateSegmentationSecurityDomainAssociationCalls gets all the calls that were made to CreateSegmentationSecurityDomainAssociation.
+// Check the length with:
+//
+//	len(mockedSegmentationClient.CreateSegmentationSecurityDomainAssociationCalls())
+func (mock *SegmentationClientMock) CreateSegmentationSecurityDomainAssociationCalls() []struct {
+	Association *SegmentationSecurityDomainAssociation
+} {
+	var calls []struct {
+		Association *SegmentationSecurityDomainAssociation
+	}
+	mock.lockCreateSegmentationSecurityDomainAssociation.RLock()
+	calls = mock.calls.CreateSegmentationSecurityDomainAssociation
+	mock.lockCreateSegmentationSecurityDomainAssociation.RUnlock()
+	return calls
+}
+
+// CreateSegmentationSecurityDomainConnectionPolicy calls CreateSegmentationSecurityDomainConnectionPolicyFunc.
+func (mock *SegmentationClientMock) CreateSegmentationSecurityDomainConnectionPolicy(policy *SegmentationSecurityDomainConnectionPolicy) error {
+	if mock.CreateSegmentationSecurityDomainConnectionPolicyFunc == nil {
+		panic("SegmentationClientMock.CreateSegmentationSecurityDomainConnectionPolicyFunc: method is nil but SegmentationClient.CreateSegmentationSecurityDomainConnectionPolicy was just called")
+	}
+	callInfo := struct {
+		Policy *SegmentationSecurityDomainConnectionPolicy
+	}{
+		Policy: policy,
+	}
+	mock.lockCreateSegmentationSecurityDomainConnectionPolicy.Lock()
+	mock.calls.CreateSegmentationSecurityDomainConnectionPolicy = append(mock.calls.CreateSegmentationSecurityDomainConnectionPolicy, callInfo)
+	mock.lockCreateSegmentationSecurityDomainConnectionPolicy.Unlock()
+	return mock.CreateSegmentationSecurityDomainConnectionPolicyFunc(policy)
+}
+
+// CreateSegmentationSecurityDomainConnectionPolicyCalls gets all the calls that were made to CreateSegmentationSecurityDomainConnectionPolicy.
+// Check the length with:
+//
+//	len(mockedSegmentationClient.CreateSegmentationSecurityDomainConnectionPolicyCalls())
+func (mock *SegmentationClientMock) CreateSegmentationSecurityDomainConnectionPolicyCalls() []struct {
+	Policy *SegmentationSecurityDomainConnectionPolicy
+} {
+	var calls []struct {
+		Policy *SegmentationSecurityDomainConnectionPolicy
+	}
+	mock.lockCreateSegmentationSecurityDomainConnectionPolicy.RLock()
+	calls = mock.calls.CreateSegmentationSecurityDomainConnectionPolicy
+	mock.lockCreateSegmentationSecurityDomainConnectionPolicy.RUnlock()
+	return calls
+}
+
+// DeleteSegmentationSecurityDomain calls DeleteSegmentationSecurityDomainFunc.
+func (mock *SegmentationClientMock) DeleteSegmentationSecurityDomain(domain *SegmentationSecurityDomain) error {
+	if mock.DeleteSegmentationSecurityDomainFunc == nil {
+		panic("SegmentationClientMock.DeleteSegmentationSecurityDomainFunc: method is nil but SegmentationClient.DeleteSegmentationSecurityDomain was just called")
+	}
+	callInfo := struct {
+		Domain *SegmentationSecurityDomain
+	}{
+		Domain: domain,
+	}
+	mock.lockDeleteSegmentationSecurityDomain.Lock()
+	mock.calls.DeleteSegmentationSecurityDomain = append(mock.calls.DeleteSegmentationSecurityDomain, callInfo)
+	mock.lockDeleteSegmentationSecurityDomain.Unlock()
+	return mock.DeleteSegmentationSecurityDomainFunc(domain)
+}
+
+// DeleteSegmentationSecurityDomainCalls gets all the calls that were made to DeleteSegmentationSecurityDomain.
+// Check the length with:
+//
+//	len(mockedSegmentationClient.DeleteSegmentationSecurityDomainCalls())
+func (mock *SegmentationClientMock) DeleteSegmentationSecurityDomainCalls() []struct {
+	Domain *SegmentationSecurityDomain
+} {
+	var calls []struct {
+		Domain *SegmentationSecurityDomain
+	}
+	mock.lockDeleteSegmentationSecurityDomain.RLock()
+	calls = mock.calls.DeleteSegmentationSecurityDomain
+	mock.lockDeleteSegmentationSecurityDomain.RUnlock()
+	return calls
+}
+
+// DeleteSegmentationSecurityDomainAssociation calls DeleteSegmentationSecurityDomainAssociationFunc.
+func (mock *SegmentationClientMock) DeleteSegmentationSecurityDomainAssociation(association *SegmentationSecurityDomainAssociation) error {
+	if mock.DeleteSegmentationSecurityDomainAssociationFunc == nil {
+		panic("SegmentationClientMock.DeleteSegmentationSecurityDomainAssociationFunc: method is nil but SegmentationClient.DeleteSegmentationSecurityDomainAssociation was just called")
+	}
+	callInfo := struct {
+		Association *SegmentationSecurityDomainAssociation
+	}{
+		Association: association,
+	}
+	mock.lockDeleteSegmentationSecurityDomainAssociation.Lock()
+	mock.calls.DeleteSegmentationSecurityDomainAssociation = append(mock.calls.DeleteSegmentationSecurityDomainAssociation, callInfo)
+	mock.lockDeleteSegmentationSecurityDomainAssociation.Unlock()
+	return mock.DeleteSegmentationSecurityDomainAssociationFunc(association)
+}
+
+// DeleteSegmentationSecurityDomainAssociationCalls gets all the calls that were made to DeleteSegmentationSecurityDomainAssociation.
+// Check the length with:
+//
+//	len(mockedSegmentationClient.DeleteSegmentationSecurityDomainAssociationCalls())
+func (mock *SegmentationClientMock) DeleteSegmentationSecurityDomainAssociationCalls() []struct {
+	Association *SegmentationSecurityDomainAssociation
+} {
+	var calls []struct {
+		Association *SegmentationSecurityDomainAssociation
+	}
+	mock.lockDeleteSegmentationSecurityDomainAssociation.RLock()
+	calls = mock.calls.DeleteSegmentationSecurityDomainAssociation
+	mock.lockDeleteSegmentationSecurityDomainAssociation.RUnlock()
+	return calls
+}
+
+// DeleteSegmentationSecurityDomainConnectionPolicy calls DeleteSegmentationSecurityDomainConnectionPolicyFunc.
+func (mock *SegmentationClientMock) DeleteSegmentationSecurityDomainConnectionPolicy(policy *SegmentationSecurityDomainConnectionPolicy) error {
+	if mock.DeleteSegmentationSecurityDomainConnectionPolicyFunc == nil {
+		panic("SegmentationClientMock.DeleteSegmentationSecurityDomainConnectionPolicyFunc: method is nil but SegmentationClient.DeleteSegmentationSecurityDomainConnectionPolicy was just called")
+	}
+	callInfo := struct {
+		Policy *SegmentationSecurityDomainConnectionPolicy
+	}{
+		Policy: policy,
+	}
+	mock.lockDeleteSegmentationSecurityDomainConnectionPolicy.Lock()
+	mock.calls.DeleteSegmentationSecurityDomainConnectionPolicy = append(mock.calls.DeleteSegmentationSecurityDomainConnectionPolicy, callInfo)
+	mock.lockDeleteSegmentationSecurityDomainConnectionPolicy.Unlock()
+	return mock.DeleteSegmentationSecurityDomainConnectionPolicyFunc(policy)
+}
+
+// DeleteSegmentationSecurityDomainConnectionPolicyCalls gets all the calls that were made to DeleteSegmentationSecurityDomainConnectionPolicy.
+// Check the length with:
+//
+//	len(mockedSegmentationClient.DeleteSegmentationSecurityDomainConnectionPolicyCalls())
+func (mock *SegmentationClientMock) DeleteSegmentationSecurityDomainConnectionPolicyCalls() []struct {
+	Policy *SegmentationSecurityDomainConnectionPolicy
+} {
+	var calls []struct {
+		Policy *SegmentationSecurityDomainConnectionPolicy
+	}
+	mock.lockDeleteSegmentationSecurityDomainConnectionPolicy.RLock()
+	calls = mock.calls.DeleteSegmentationSecurityDomainConnectionPolicy
+	mock.lockDeleteSegmentationSecurityDomainConnectionPolicy.RUnlock()
+	return calls
+}
+
+// GetSegmentationSecurityDomain calls GetSegmentationSecurityDomainFunc.
+func (mock *SegmentationClientMock) GetSegmentationSecurityDomain(domain *SegmentationSecurityDomain) (*SegmentationSecurityDomain, error) {
+	if mock.GetSegmentationSecurityDomainFunc == nil {
+		panic("SegmentationClientMock.GetSegmentationSecurityDomainFunc: method is nil but SegmentationClient.GetSegmentationSecurityDomain was just called")
+	}
+	callInfo := struct {
+		Domain *SegmentationSecurityDomain
+	}{
+		Domain: domain,
+	}
+	mock.lockGetSegmentationSecurityDomain.Lock()
+	mock.calls.GetSegmentationSecurityDomain = append(mock.calls.GetSegmentationSecurityDomain, callInfo)
+	mock.lockGetSegmentationSecurityDomain.Unlock()
+	return mock.GetSegmentationSecurityDomainFunc(domain)
+}
+
+// GetSegmentationSecurityDomainCalls gets all the calls that were made to GetSegmentationSecurityDomain.
+// Check the length with:
+//
+//	len(mockedSegmentationClient.GetSegmentationSecurityDomainCalls())
+func (mock *SegmentationClientMock) GetSegmentationSecurityDomainCalls() []struct {
+	Domain *SegmentationSecurityDomain
+} {
+	var calls []struct {
+		Domain *SegmentationSecurityDomain
+	}
+	mock.lockGetSegmentationSecurityDomain.RLock()
+	calls = mock.calls.GetSegmentationSecurityDomain
+	mock.lockGetSegmentationSecurityDomain.RUnlock()
+	return calls
+}
+
+// GetSegmentationSecurityDomainAssociation calls GetSegmentationSecurityDomainAssociationFunc.
+func (mock *SegmentationClientMock) GetSegmentationSecurityDomainAssociation(association *SegmentationSecurityDomainAssociation) (*SegmentationSecurityDomainAssociation, error) {
+	if mock.GetSegmentationSecurityDomainAssociationFunc == nil {
+		panic("SegmentationClientMock.GetSegmentationSecurityDomainAssociationFunc: method is nil but SegmentationClient.GetSegmentationSecurityDomainAssociation was just called")
+	}
+	callInfo := struct {
+		Association *SegmentationSecurityDomainAssociation
+	}{
+		Association: association,
+	}
+	mock.lockGetSegmentationSecurityDomainAssociation.Lock()
+	mock.calls.GetSegmentationSecurityDomainAssociation = append(mock.calls.GetSegmentationSecurityDomainAssociation, callInfo)
+	mock.lockGetSegmentationSecurityDomainAssociation.Unlock()
+	return mock.GetSegmentationSecurityDomainAssociationFunc(association)
+}
+
+// GetSegmentationSecurityDomainAssociationCalls gets all the calls that were made to GetSegmentationSecurityDomainAssociation.
+// Check the length with:
+//
+//	len(mockedSegmentationClient.GetSegmentationSecurityDomainAssociationCalls())
+func (mock *SegmentationClientMock) GetSegmentationSecurityDomainAssociationCalls() []struct {
+	Association *SegmentationSecurityDomainAssociation
+} {
+	var calls []struct {
+		Association *SegmentationSecurityDomainAssociation
+	}
+	mock.lockGetSegmentationSecurityDomainAssociation.RLock()
+	calls = mock.calls.GetSegmentationSecurityDomainAssociation
+	mock.lockGetSegmentationSecurityDomainAssociation.RUnlock()
+	return calls
+}
+
+// GetSegmentationSecurityDomainConnectionPolicy calls GetSegmentationSecurityDomainConnectionPolicyFunc.
+func (mock *SegmentationClientMock) GetSegmentationSecurityDomainConnectionPolicy(policy *SegmentationSecurityDomainConnectionPolicy) (*SegmentationSecurityDomainConnectionPolicy, error) {
+	if mock.GetSegmentationSecurityDomainConnectionPolicyFunc == nil {
+		panic("SegmentationClientMock.GetSegmentationSecurityDomainConnectionPolicyFunc: method is nil but SegmentationClient.GetSegmentationSecurityDomainConnectionPolicy was just called")
+	}
+	callInfo := struct {
+		Policy *SegmentationSecurityDomainConnectionPolicy
+	}{
+		Policy: policy,
+	}
+	mock.lockGetSegmentationSecurityDomainConnectionPolicy.Lock()
+	mock.calls.GetSegmentationSecurityDomainConnectionPolicy = append(mock.calls.GetSegmentationSecurityDomainConnectionPolicy, callInfo)
+	mock.lockGetSegmentationSecurityDomainConnectionPolicy.Unlock()
+	return mock.GetSegmentationSecurityDomainConnectionPolicyFunc(policy)
+}
+
+// GetSegmentationSecurityDomainConnectionPolicyCalls gets all the calls that were made to GetSegmentationSecurityDomainConnectionPolicy.
+// Check the length with:
+//
+//	len(mockedSegmentationClient.GetSegmentationSecurityDomainConnectionPolicyCalls())
+func (mock *SegmentationClientMock) GetSegmentationSecurityDomainConnectionPolicyCalls() []struct {
+	Policy *SegmentationSecurityDomainConnectionPolicy
+} {
+	var calls []struct {
+		Policy *SegmentationSecurityDomainConnectionPolicy
+	}
+	mock.lockGetSegmentationSecurityDomainConnectionPolicy.RLock()
+	calls = mock.calls.GetSegmentationSecurityDomainConnectionPolicy
+	mock.lockGetSegmentationSecurityDomainConnectionPolicy.RUnlock()
+	return calls
+}
+
+// ListSegmentationSecurityDomains calls ListSegmentationSecurityDomainsFunc.
+func (mock *SegmentationClientMock) ListSegmentationSecurityDomains() ([]string, error) {
+	if mock.ListSegmentationSecurityDomainsFunc == nil {
+		panic("SegmentationClientMock.ListSegmentationSecurityDomainsFunc: method is nil but SegmentationClient.ListSegmentationSecurityDomains was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockListSegmentationSecurityDomains.Lock()
+	mock.calls.ListSegmentationSecurityDomains = append(mock.calls.ListSegmentationSecurityDomains, callInfo)
+	mock.lockListSegmentationSecurityDomains.Unlock()
+	return mock.ListSegmentationSecurityDomainsFunc()
+}
+
+// ListSegmentationSecurityDomainsCalls gets all the calls that were made to ListSegmentationSecurityDomains.
+// Check the length with:
+//
+//	len(mockedSegmentationClient.ListSegmentationSecurityDomainsCalls())
+func (mock *SegmentationClientMock) ListSegmentationSecurityDomainsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockListSegmentationSecurityDomains.RLock()
+	calls = mock.calls.ListSegmentationSecurityDomains
+	mock.lockListSegmentationSecurityDomains.RUnlock()
+	return calls
+}
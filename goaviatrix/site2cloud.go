@@ -1,13 +1,11 @@
 package goaviatrix
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"math"
 	"strconv"
 	"strings"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 const (
@@ -220,6 +218,10 @@ type AlgorithmInfo struct {
 }
 
 func (c *Client) CreateSite2Cloud(site2cloud *Site2Cloud) error {
+	return c.CreateSite2CloudContext(context.Background(), site2cloud)
+}
+
+func (c *Client) CreateSite2CloudContext(ctx context.Context, site2cloud *Site2Cloud) error {
 	form := map[string]string{}
 	form["CID"] = c.CID
 	form["CID"] = c.CID
@@ -318,7 +320,7 @@ func (c *Client) CreateSite2Cloud(site2cloud *Site2Cloud) error {
 		form["proxy_id_enabled"] = "true"
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) GetSite2Cloud(site2cloud *Site2Cloud) (*Site2Cloud, error) {
@@ -344,6 +346,27 @@ func (c *Client) GetSite2Cloud(site2cloud *Site2Cloud) (*Site2Cloud, error) {
 	return nil, ErrNotFound
 }
 
+// ListSite2Cloud returns all site2cloud connections, with the summary
+// attributes list_site2cloud_conn reports.
+func (c *Client) ListSite2Cloud() ([]Site2Cloud, error) {
+	return c.ListSite2CloudContext(context.Background())
+}
+
+func (c *Client) ListSite2CloudContext(ctx context.Context) ([]Site2Cloud, error) {
+	form := map[string]string{
+		"CID":    c.CID,
+		"action": "list_site2cloud_conn",
+	}
+
+	var data Site2CloudResp
+
+	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+	return data.Results.Connections, nil
+}
+
 func (c *Client) GetSite2CloudConnDetail(site2cloud *Site2Cloud) (*Site2Cloud, error) {
 	form := map[string]string{
 		"CID":       c.CID,
@@ -487,10 +510,14 @@ func (c *Client) UpdateSite2Cloud(site2cloud *EditSite2Cloud) error {
 }
 
 func (c *Client) DeleteSite2Cloud(site2cloud *Site2Cloud) error {
+	return c.DeleteSite2CloudContext(context.Background(), site2cloud)
+}
+
+func (c *Client) DeleteSite2CloudContext(ctx context.Context, site2cloud *Site2Cloud) error {
 	site2cloud.CID = c.CID
 	site2cloud.Action = "delete_site2cloud_connection"
 
-	return c.PostAPI(site2cloud.Action, site2cloud, BasicCheck)
+	return c.PostAPIContext(ctx, site2cloud.Action, site2cloud, BasicCheck)
 }
 
 func (c *Client) EnableDeadPeerDetection(site2cloud *Site2Cloud) error {
@@ -505,6 +532,10 @@ func (c *Client) EnableDeadPeerDetection(site2cloud *Site2Cloud) error {
 }
 
 func (c *Client) DisableDeadPeerDetection(site2cloud *Site2Cloud) error {
+	return c.DisableDeadPeerDetectionContext(context.Background(), site2cloud)
+}
+
+func (c *Client) DisableDeadPeerDetectionContext(ctx context.Context, site2cloud *Site2Cloud) error {
 	form := map[string]string{
 		"CID":             c.CID,
 		"action":          "disable_dpd_config",
@@ -512,10 +543,14 @@ func (c *Client) DisableDeadPeerDetection(site2cloud *Site2Cloud) error {
 		"connection_name": site2cloud.TunnelName,
 	}
 
-	return c.PostAPI(form["action"], form, BasicCheck)
+	return c.PostAPIContext(ctx, form["action"], form, BasicCheck)
 }
 
 func (c *Client) EnableSite2cloudActiveActive(site2cloud *Site2Cloud) error {
+	return c.EnableSite2cloudActiveActiveContext(context.Background(), site2cloud)
+}
+
+func (c *Client) EnableSite2cloudActiveActiveContext(ctx context.Context, site2cloud *Site2Cloud) error {
 	form := map[string]string{
 		"CID":             c.CID,
 		"action":          "enable_site2cloud_active_active_ha",
@@ -533,7 +568,7 @@ func (c *Client) EnableSite2cloudActiveActive(site2cloud *Site2Cloud) error {
 		return nil
 	}
 
-	return c.PostAPI(form["action"], form, checkFunc)
+	return c.PostAPIContext(ctx, form["action"], form, checkFunc)
 }
 
 func (c *Client) DisableSite2cloudActiveActive(site2cloud *Site2Cloud) error {
@@ -618,257 +653,3 @@ func (c *Client) EditSite2CloudPhase1LocalIdentifier(s2c *EditSite2Cloud) error
 
 	return c.PostAPI(data["action"], data, checkFunc)
 }
-
-func S2CPh1RemoteIdDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
-	if d.HasChange("ha_enabled") || d.HasChange("enable_single_ip_ha") {
-		return false
-	}
-
-	ip, ok := d.Get("remote_gateway_ip").(string)
-	if !ok {
-		return false
-	}
-	haip, ok := d.Get("backup_remote_gateway_ip").(string)
-	if !ok {
-		return false
-	}
-
-	o, n := d.GetChange("phase1_remote_identifier")
-
-	haEnabled, ok := d.Get("ha_enabled").(bool)
-	if !ok {
-		return false
-	}
-	singleIpHA, ok := d.Get("enable_single_ip_ha").(bool)
-	if !ok {
-		return false
-	}
-
-	oList, ok := o.([]interface{})
-	if !ok {
-		return false
-	}
-	nList, ok := n.([]interface{})
-	if !ok {
-		return false
-	}
-
-	ph1RemoteIdListOld := ExpandStringList(oList)
-	ph1RemoteIdListNew := ExpandStringList(nList)
-
-	if len(ph1RemoteIdListOld) != 0 && len(ph1RemoteIdListNew) != 0 {
-		if haEnabled && !singleIpHA {
-			if len(ph1RemoteIdListOld) == 1 && len(ph1RemoteIdListNew) == 1 && ip == haip &&
-				ph1RemoteIdListOld[0] == ip && ph1RemoteIdListNew[0] == ip {
-				return true
-			}
-			if len(ph1RemoteIdListNew) != 2 || len(ph1RemoteIdListOld) != 2 {
-				return false
-			}
-			return ph1RemoteIdListOld[0] == ip && ph1RemoteIdListNew[0] == ip &&
-				strings.TrimSpace(ph1RemoteIdListOld[1]) == haip && strings.TrimSpace(ph1RemoteIdListNew[1]) == haip
-		} else if !haEnabled || singleIpHA {
-			if len(ph1RemoteIdListNew) != 1 {
-				return false
-			}
-			return ph1RemoteIdListOld[0] == ip && ph1RemoteIdListNew[0] == ip
-		}
-	}
-
-	return false
-}
-
-func DiffSuppressFuncRemoteSourceRealCIDRs(k, old, new string, d *schema.ResourceData) bool {
-	o, n := d.GetChange("remote_source_real_cidrs")
-
-	oList, ok := o.([]interface{})
-	if !ok {
-		return false
-	}
-	nList, ok := n.([]interface{})
-	if !ok {
-		return false
-	}
-
-	return Equivalent(ExpandStringList(oList), ExpandStringList(nList))
-}
-
-func DiffSuppressFuncRemoteSourceVirtualCIDRs(k, old, new string, d *schema.ResourceData) bool {
-	o, n := d.GetChange("remote_source_virtual_cidrs")
-
-	oList, ok := o.([]interface{})
-	if !ok {
-		return false
-	}
-	nList, ok := n.([]interface{})
-	if !ok {
-		return false
-	}
-
-	return Equivalent(ExpandStringList(oList), ExpandStringList(nList))
-}
-
-func DiffSuppressFuncRemoteDestinationRealCIDRs(k, old, new string, d *schema.ResourceData) bool {
-	o, n := d.GetChange("remote_destination_real_cidrs")
-
-	oList, ok := o.([]interface{})
-	if !ok {
-		return false
-	}
-	nList, ok := n.([]interface{})
-	if !ok {
-		return false
-	}
-
-	return Equivalent(ExpandStringList(oList), ExpandStringList(nList))
-}
-
-func DiffSuppressFuncRemoteDestinationVirtualCIDRs(k, old, new string, d *schema.ResourceData) bool {
-	o, n := d.GetChange("remote_destination_virtual_cidrs")
-
-	oList, ok := o.([]interface{})
-	if !ok {
-		return false
-	}
-	nList, ok := n.([]interface{})
-	if !ok {
-		return false
-	}
-
-	return Equivalent(ExpandStringList(oList), ExpandStringList(nList))
-}
-
-func DiffSuppressFuncLocalSourceRealCIDRs(k, old, new string, d *schema.ResourceData) bool {
-	o, n := d.GetChange("local_source_real_cidrs")
-
-	oList, ok := o.([]interface{})
-	if !ok {
-		return false
-	}
-	nList, ok := n.([]interface{})
-	if !ok {
-		return false
-	}
-
-	return Equivalent(ExpandStringList(oList), ExpandStringList(nList))
-}
-
-func DiffSuppressFuncLocalSourceVirtualCIDRs(k, old, new string, d *schema.ResourceData) bool {
-	o, n := d.GetChange("local_source_virtual_cidrs")
-
-	oList, ok := o.([]interface{})
-	if !ok {
-		return false
-	}
-	nList, ok := n.([]interface{})
-	if !ok {
-		return false
-	}
-
-	return Equivalent(ExpandStringList(oList), ExpandStringList(nList))
-}
-
-func DiffSuppressFuncLocalDestinationRealCIDRs(k, old, new string, d *schema.ResourceData) bool {
-	o, n := d.GetChange("local_destination_real_cidrs")
-
-	oList, ok := o.([]interface{})
-	if !ok {
-		return false
-	}
-	nList, ok := n.([]interface{})
-	if !ok {
-		return false
-	}
-
-	return Equivalent(ExpandStringList(oList), ExpandStringList(nList))
-}
-
-func DiffSuppressFuncLocalDestinationVirtualCIDRs(k, old, new string, d *schema.ResourceData) bool {
-	o, n := d.GetChange("local_destination_virtual_cidrs")
-
-	oList, ok := o.([]interface{})
-	if !ok {
-		return false
-	}
-	nList, ok := n.([]interface{})
-	if !ok {
-		return false
-	}
-
-	return Equivalent(ExpandStringList(oList), ExpandStringList(nList))
-}
-
-func DiffSuppressFuncRemoteGwLatitude(k, old, new string, d *schema.ResourceData) bool {
-	o, n := d.GetChange("remote_gateway_latitude")
-
-	ov, ok := o.(float64)
-	if !ok {
-		return false
-	}
-	nv, ok := n.(float64)
-	if !ok {
-		return false
-	}
-
-	return math.Abs(ov-nv) < 1
-}
-
-func DiffSuppressFuncRemoteGwLongitude(k, old, new string, d *schema.ResourceData) bool {
-	o, n := d.GetChange("remote_gateway_longitude")
-
-	ov, ok := o.(float64)
-	if !ok {
-		return false
-	}
-	nv, ok := n.(float64)
-	if !ok {
-		return false
-	}
-
-	return math.Abs(ov-nv) < 1
-}
-
-func DiffSuppressFuncBackupRemoteGwLatitude(k, old, new string, d *schema.ResourceData) bool {
-	o, n := d.GetChange("backup_remote_gateway_latitude")
-
-	ov, ok := o.(float64)
-	if !ok {
-		return false
-	}
-	nv, ok := n.(float64)
-	if !ok {
-		return false
-	}
-
-	return math.Abs(ov-nv) < 1
-}
-
-func DiffSuppressFuncBackupRemoteGwLongitude(k, old, new string, d *schema.ResourceData) bool {
-	o, n := d.GetChange("backup_remote_gateway_longitude")
-
-	ov, ok := o.(float64)
-	if !ok {
-		return false
-	}
-	nv, ok := n.(float64)
-	if !ok {
-		return false
-	}
-
-	return math.Abs(ov-nv) < 1
-}
-
-func StringCanBeEmptyButCannotBeWhiteSpace(val interface{}, key string) (warns []string, errs []error) {
-	v, ok := val.(string)
-	if !ok {
-		return nil, []error{fmt.Errorf("expected type of %q to be string", key)}
-	}
-
-	if v != "" {
-		if strings.TrimSpace(v) == "" {
-			return nil, []error{fmt.Errorf("expected %q to not be an whitespace", key)}
-		}
-	}
-
-	return nil, nil
-}
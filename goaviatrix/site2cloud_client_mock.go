@@ -0,0 +1,1103 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package goaviatrix
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that Site2CloudClientMock does implement Site2CloudClient.
+// If this is not the case, regenerate this file with moq.
+var _ Site2CloudClient = &Site2CloudClientMock{}
+
+// Site2CloudClientMock is a mock implementation of Site2CloudClient.
+//
+//	func TestSomethingThatUsesSite2CloudClient(t *testing.T) {
+//
+//		// make and configure a mocked Site2CloudClient
+//		mockedSite2CloudClient := &Site2CloudClientMock{
+//			CreateS2CCaCertFunc: func(ctx context.Context, s2cCaCert *S2CCaCert) error {
+//				panic("mock out the CreateS2CCaCert method")
+//			},
+//			CreateSite2CloudFunc: func(site2cloud *Site2Cloud) error {
+//				panic("mock out the CreateSite2Cloud method")
+//			},
+//			CreateSite2CloudContextFunc: func(ctx context.Context, site2cloud *Site2Cloud) error {
+//				panic("mock out the CreateSite2CloudContext method")
+//			},
+//			DeleteCertInstanceFunc: func(ctx context.Context, caCertInstance *CaCertInstance) error {
+//				panic("mock out the DeleteCertInstance method")
+//			},
+//			DeleteSite2CloudFunc: func(site2cloud *Site2Cloud) error {
+//				panic("mock out the DeleteSite2Cloud method")
+//			},
+//			DeleteSite2CloudContextFunc: func(ctx context.Context, site2cloud *Site2Cloud) error {
+//				panic("mock out the DeleteSite2CloudContext method")
+//			},
+//			DisableDeadPeerDetectionFunc: func(site2cloud *Site2Cloud) error {
+//				panic("mock out the DisableDeadPeerDetection method")
+//			},
+//			DisableDeadPeerDetectionContextFunc: func(ctx context.Context, site2cloud *Site2Cloud) error {
+//				panic("mock out the DisableDeadPeerDetectionContext method")
+//			},
+//			DisableSite2CloudEventTriggeredHAFunc: func(vpcID string, connectionName string) error {
+//				panic("mock out the DisableSite2CloudEventTriggeredHA method")
+//			},
+//			DisableSite2cloudActiveActiveFunc: func(site2cloud *Site2Cloud) error {
+//				panic("mock out the DisableSite2cloudActiveActive method")
+//			},
+//			DisableSpokeMappedSite2CloudForwardingFunc: func(site2cloud *Site2Cloud) error {
+//				panic("mock out the DisableSpokeMappedSite2CloudForwarding method")
+//			},
+//			EditSite2CloudPhase1LocalIdentifierFunc: func(s2c *EditSite2Cloud) error {
+//				panic("mock out the EditSite2CloudPhase1LocalIdentifier method")
+//			},
+//			EnableDeadPeerDetectionFunc: func(site2cloud *Site2Cloud) error {
+//				panic("mock out the EnableDeadPeerDetection method")
+//			},
+//			EnableSite2CloudEventTriggeredHAFunc: func(vpcID string, connectionName string) error {
+//				panic("mock out the EnableSite2CloudEventTriggeredHA method")
+//			},
+//			EnableSite2cloudActiveActiveFunc: func(site2cloud *Site2Cloud) error {
+//				panic("mock out the EnableSite2cloudActiveActive method")
+//			},
+//			EnableSite2cloudActiveActiveContextFunc: func(ctx context.Context, site2cloud *Site2Cloud) error {
+//				panic("mock out the EnableSite2cloudActiveActiveContext method")
+//			},
+//			EnableSpokeMappedSite2CloudForwardingFunc: func(site2cloud *Site2Cloud) error {
+//				panic("mock out the EnableSpokeMappedSite2CloudForwarding method")
+//			},
+//			GetGatewayFunc: func(gateway *Gateway) (*Gateway, error) {
+//				panic("mock out the GetGateway method")
+//			},
+//			GetGatewayContextFunc: func(ctx context.Context, gateway *Gateway) (*Gateway, error) {
+//				panic("mock out the GetGatewayContext method")
+//			},
+//			GetS2CCaCertTagFunc: func(ctx context.Context, s2cCaCertTag *S2CCaCertTag) (*S2CCaCertTag, error) {
+//				panic("mock out the GetS2CCaCertTag method")
+//			},
+//			GetSite2CloudConnDetailFunc: func(site2cloud *Site2Cloud) (*Site2Cloud, error) {
+//				panic("mock out the GetSite2CloudConnDetail method")
+//			},
+//			ListSite2CloudContextFunc: func(ctx context.Context) ([]Site2Cloud, error) {
+//				panic("mock out the ListSite2CloudContext method")
+//			},
+//			UpdateSite2CloudFunc: func(site2cloud *EditSite2Cloud) error {
+//				panic("mock out the UpdateSite2Cloud method")
+//			},
+//		}
+//
+//		// use mockedSite2CloudClient in code that requires Site2CloudClient
+//		// and then make assertions.
+//
+//	}
+type Site2CloudClientMock struct {
+	// CreateS2CCaCertFunc mocks the CreateS2CCaCert method.
+	CreateS2CCaCertFunc func(ctx context.Context, s2cCaCert *S2CCaCert) error
+
+	// CreateSite2CloudFunc mocks the CreateSite2Cloud method.
+	CreateSite2CloudFunc func(site2cloud *Site2Cloud) error
+
+	// CreateSite2CloudContextFunc mocks the CreateSite2CloudContext method.
+	CreateSite2CloudContextFunc func(ctx context.Context, site2cloud *Site2Cloud) error
+
+	// DeleteCertInstanceFunc mocks the DeleteCertInstance method.
+	DeleteCertInstanceFunc func(ctx context.Context, caCertInstance *CaCertInstance) error
+
+	// DeleteSite2CloudFunc mocks the DeleteSite2Cloud method.
+	DeleteSite2CloudFunc func(site2cloud *Site2Cloud) error
+
+	// DeleteSite2CloudContextFunc mocks the DeleteSite2CloudContext method.
+	DeleteSite2CloudContextFunc func(ctx context.Context, site2cloud *Site2Cloud) error
+
+	// DisableDeadPeerDetectionFunc mocks the DisableDeadPeerDetection method.
+	DisableDeadPeerDetectionFunc func(site2cloud *Site2Cloud) error
+
+	// DisableDeadPeerDetectionContextFunc mocks the DisableDeadPeerDetectionContext method.
+	DisableDeadPeerDetectionContextFunc func(ctx context.Context, site2cloud *Site2Cloud) error
+
+	// DisableSite2CloudEventTriggeredHAFunc mocks the DisableSite2CloudEventTriggeredHA method.
+	DisableSite2CloudEventTriggeredHAFunc func(vpcID string, connectionName string) error
+
+	// DisableSite2cloudActiveActiveFunc mocks the DisableSite2cloudActiveActive method.
+	DisableSite2cloudActiveActiveFunc func(site2cloud *Site2Cloud) error
+
+	// DisableSpokeMappedSite2CloudForwardingFunc mocks the DisableSpokeMappedSite2CloudForwarding method.
+	DisableSpokeMappedSite2CloudForwardingFunc func(site2cloud *Site2Cloud) error
+
+	// EditSite2CloudPhase1LocalIdentifierFunc mocks the EditSite2CloudPhase1LocalIdentifier method.
+	EditSite2CloudPhase1LocalIdentifierFunc func(s2c *EditSite2Cloud) error
+
+	// EnableDeadPeerDetectionFunc mocks the EnableDeadPeerDetection method.
+	EnableDeadPeerDetectionFunc func(site2cloud *Site2Cloud) error
+
+	// EnableSite2CloudEventTriggeredHAFunc mocks the EnableSite2CloudEventTriggeredHA method.
+	EnableSite2CloudEventTriggeredHAFunc func(vpcID string, connectionName string) error
+
+	// EnableSite2cloudActiveActiveFunc mocks the EnableSite2cloudActiveActive method.
+	EnableSite2cloudActiveActiveFunc func(site2cloud *Site2Cloud) error
+
+	// EnableSite2cloudActiveActiveContextFunc mocks the EnableSite2cloudActiveActiveContext method.
+	EnableSite2cloudActiveActiveContextFunc func(ctx context.Context, site2cloud *Site2Cloud) error
+
+	// EnableSpokeMappedSite2CloudForwardingFunc mocks the EnableSpokeMappedSite2CloudForwarding method.
+	EnableSpokeMappedSite2CloudForwardingFunc func(site2cloud *Site2Cloud) error
+
+	// GetGatewayFunc mocks the GetGateway method.
+	GetGatewayFunc func(gateway *Gateway) (*Gateway, error)
+
+	// GetGatewayContextFunc mocks the GetGatewayContext method.
+	GetGatewayContextFunc func(ctx context.Context, gateway *Gateway) (*Gateway, error)
+
+	// GetS2CCaCertTagFunc mocks the GetS2CCaCertTag method.
+	GetS2CCaCertTagFunc func(ctx context.Context, s2cCaCertTag *S2CCaCertTag) (*S2CCaCertTag, error)
+
+	// GetSite2CloudConnDetailFunc mocks the GetSite2CloudConnDetail method.
+	GetSite2CloudConnDetailFunc func(site2cloud *Site2Cloud) (*Site2Cloud, error)
+
+	// ListSite2CloudContextFunc mocks the ListSite2CloudContext method.
+	ListSite2CloudContextFunc func(ctx context.Context) ([]Site2Cloud, error)
+
+	// UpdateSite2CloudFunc mocks the UpdateSite2Cloud method.
+	UpdateSite2CloudFunc func(site2cloud *EditSite2Cloud) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateS2CCaCert holds details about calls to the CreateS2CCaCert method.
+		CreateS2CCaCert []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S2cCaCert is the s2cCaCert argument value.
+			S2cCaCert *S2CCaCert
+		}
+		// CreateSite2Cloud holds details about calls to the CreateSite2Cloud method.
+		CreateSite2Cloud []struct {
+			// Site2cloud is the site2cloud argument value.
+			Site2cloud *Site2Cloud
+		}
+		// CreateSite2CloudContext holds details about calls to the CreateSite2CloudContext method.
+		CreateSite2CloudContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Site2cloud is the site2cloud argument value.
+			Site2cloud *Site2Cloud
+		}
+		// DeleteCertInstance holds details about calls to the DeleteCertInstance method.
+		DeleteCertInstance []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CaCertInstance is the caCertInstance argument value.
+			CaCertInstance *CaCertInstance
+		}
+		// DeleteSite2Cloud holds details about calls to the DeleteSite2Cloud method.
+		DeleteSite2Cloud []struct {
+			// Site2cloud is the site2cloud argument value.
+			Site2cloud *Site2Cloud
+		}
+		// DeleteSite2CloudContext holds details about calls to the DeleteSite2CloudContext method.
+		DeleteSite2CloudContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Site2cloud is the site2cloud argument value.
+			Site2cloud *Site2Cloud
+		}
+		// DisableDeadPeerDetection holds details about calls to the DisableDeadPeerDetection method.
+		DisableDeadPeerDetection []struct {
+			// Site2cloud is the site2cloud argument value.
+			Site2cloud *Site2Cloud
+		}
+		// DisableDeadPeerDetectionContext holds details about calls to the DisableDeadPeerDetectionContext method.
+		DisableDeadPeerDetectionContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Site2cloud is the site2cloud argument value.
+			Site2cloud *Site2Cloud
+		}
+		// DisableSite2CloudEventTriggeredHA holds details about calls to the DisableSite2CloudEventTriggeredHA method.
+		DisableSite2CloudEventTriggeredHA []struct {
+			// VpcID is the vpcID argument value.
+			VpcID string
+			// ConnectionName is the connectionName argument value.
+			ConnectionName string
+		}
+		// DisableSite2cloudActiveActive holds details about calls to the DisableSite2cloudActiveActive method.
+		DisableSite2cloudActiveActive []struct {
+			// Site2cloud is the site2cloud argument value.
+			Site2cloud *Site2Cloud
+		}
+		// DisableSpokeMappedSite2CloudForwarding holds details about calls to the DisableSpokeMappedSite2CloudForwarding method.
+		DisableSpokeMappedSite2CloudForwarding []struct {
+			// Site2cloud is the site2cloud argument value.
+			Site2cloud *Site2Cloud
+		}
+		// EditSite2CloudPhase1LocalIdentifier holds details about calls to the EditSite2CloudPhase1LocalIdentifier method.
+		EditSite2CloudPhase1LocalIdentifier []struct {
+			// S2c is the s2c argument value.
+			S2c *EditSite2Cloud
+		}
+		// EnableDeadPeerDetection holds details about calls to the EnableDeadPeerDetection method.
+		EnableDeadPeerDetection []struct {
+			// Site2cloud is the site2cloud argument value.
+			Site2cloud *Site2Cloud
+		}
+		// EnableSite2CloudEventTriggeredHA holds details about calls to the EnableSite2CloudEventTriggeredHA method.
+		EnableSite2CloudEventTriggeredHA []struct {
+			// VpcID is the vpcID argument value.
+			VpcID string
+			// ConnectionName is the connectionName argument value.
+			ConnectionName string
+		}
+		// EnableSite2cloudActiveActive holds details about calls to the EnableSite2cloudActiveActive method.
+		EnableSite2cloudActiveActive []struct {
+			// Site2cloud is the site2cloud argument value.
+			Site2cloud *Site2Cloud
+		}
+		// EnableSite2cloudActiveActiveContext holds details about calls to the EnableSite2cloudActiveActiveContext method.
+		EnableSite2cloudActiveActiveContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Site2cloud is the site2cloud argument value.
+			Site2cloud *Site2Cloud
+		}
+		// EnableSpokeMappedSite2CloudForwarding holds details about calls to the EnableSpokeMappedSite2CloudForwarding method.
+		EnableSpokeMappedSite2CloudForwarding []struct {
+			// Site2cloud is the site2cloud argument value.
+			Site2cloud *Site2Cloud
+		}
+		// GetGateway holds details about calls to the GetGateway method.
+		GetGateway []struct {
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// GetGatewayContext holds details about calls to the GetGatewayContext method.
+		GetGatewayContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Gateway is the gateway argument value.
+			Gateway *Gateway
+		}
+		// GetS2CCaCertTag holds details about calls to the GetS2CCaCertTag method.
+		GetS2CCaCertTag []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// S2cCaCertTag is the s2cCaCertTag argument value.
+			S2cCaCertTag *S2CCaCertTag
+		}
+		// GetSite2CloudConnDetail holds details about calls to the GetSite2CloudConnDetail method.
+		GetSite2CloudConnDetail []struct {
+			// Site2cloud is the site2cloud argument value.
+			Site2cloud *Site2Cloud
+		}
+		// ListSite2CloudContext holds details about calls to the ListSite2CloudContext method.
+		ListSite2CloudContext []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// UpdateSite2Cloud holds details about calls to the UpdateSite2Cloud method.
+		UpdateSite2Cloud []struct {
+			// Site2cloud is the site2cloud argument value.
+			Site2cloud *EditSite2Cloud
+		}
+	}
+	lockCreateS2CCaCert                        sync.RWMutex
+	lockCreateSite2Cloud                       sync.RWMutex
+	lockCreateSite2CloudContext                sync.RWMutex
+	lockDeleteCertInstance                     sync.RWMutex
+	lockDeleteSite2Cloud                       sync.RWMutex
+	lockDeleteSite2CloudContext                sync.RWMutex
+	lockDisableDeadPeerDetection               sync.RWMutex
+	lockDisableDeadPeerDetectionContext        sync.RWMutex
+	lockDisableSite2CloudEventTriggeredHA      sync.RWMutex
+	lockDisableSite2cloudActiveActive          sync.RWMutex
+	lockDisableSpokeMappedSite2CloudForwarding sync.RWMutex
+	lockEditSite2CloudPhase1LocalIdentifier    sync.RWMutex
+	lockEnableDeadPeerDetection                sync.RWMutex
+	lockEnableSite2CloudEventTriggeredHA       sync.RWMutex
+	lockEnableSite2cloudActiveActive           sync.RWMutex
+	lockEnableSite2cloudActiveActiveContext    sync.RWMutex
+	lockEnableSpokeMappedSite2CloudForwarding  sync.RWMutex
+	lockGetGateway                             sync.RWMutex
+	lockGetGatewayContext                      sync.RWMutex
+	lockGetS2CCaCertTag                        sync.RWMutex
+	lockGetSite2CloudConnDetail                sync.RWMutex
+	lockListSite2CloudContext                  sync.RWMutex
+	lockUpdateSite2Cloud                       sync.RWMutex
+}
+
+// CreateS2CCaCert calls CreateS2CCaCertFunc.
+func (mock *Site2CloudClientMock) CreateS2CCaCert(ctx context.Context, s2cCaCert *S2CCaCert) error {
+	if mock.CreateS2CCaCertFunc == nil {
+		panic("Site2CloudClientMock.CreateS2CCaCertFunc: method is nil but Site2CloudClient.CreateS2CCaCert was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		S2cCaCert *S2CCaCert
+	}{
+		Ctx:       ctx,
+		S2cCaCert: s2cCaCert,
+	}
+	mock.lockCreateS2CCaCert.Lock()
+	mock.calls.CreateS2CCaCert = append(mock.calls.CreateS2CCaCert, callInfo)
+	mock.lockCreateS2CCaCert.Unlock()
+	return mock.CreateS2CCaCertFunc(ctx, s2cCaCert)
+}
+
+// CreateS2CCaCertCalls gets all the calls that were made to CreateS2CCaCert.
+// Check the length with:
+//
+//	len(mockedSite2CloudClient.CreateS2CCaCertCalls())
+func (mock *Site2CloudClientMock) CreateS2CCaCertCalls() []struct {
+	Ctx       context.Context
+	S2cCaCert *S2CCaCert
+} {
+	var calls []struct {
+		Ctx       context.Context
+		S2cCaCert *S2CCaCert
+	}
+	mock.lockCreateS2CCaCert.RLock()
+	calls = mock.calls.CreateS2CCaCert
+	mock.lockCreateS2CCaCert.RUnlock()
+	return calls
+}
+
+// CreateSite2Cloud calls CreateSite2CloudFunc.
+func (mock *Site2CloudClientMock) CreateSite2Cloud(site2cloud *Site2Cloud) error {
+	if mock.CreateSite2CloudFunc == nil {
+		panic("Site2CloudClientMock.CreateSite2CloudFunc: method is nil but Site2CloudClient.CreateSite2Cloud was just called")
+	}
+	callInfo := struct {
+		Site2cloud *Site2Cloud
+	}{
+		Site2cloud: site2cloud,
+	}
+	mock.lockCreateSite2Cloud.Lock()
+	mock.calls.CreateSite2Cloud = append(mock.calls.CreateSite2Cloud, callInfo)
+	mock.lockCreateSite2Cloud.Unlock()
+	return mock.CreateSite2CloudFunc(site2cloud)
+}
+
+// CreateSite2CloudCalls gets all the calls that were made to CreateSite2Cloud.
+// Check the length with:
+//
+//	len(mockedSite2CloudClient.CreateSite2CloudCalls())
+func (mock *Site2CloudClientMock) CreateSite2CloudCalls() []struct {
+	Site2cloud *Site2Cloud
+} {
+	var calls []struct {
+		Site2cloud *Site2Cloud
+	}
+	mock.lockCreateSite2Cloud.RLock()
+	calls = mock.calls.CreateSite2Cloud
+	mock.lockCreateSite2Cloud.RUnlock()
+	return calls
+}
+
+// CreateSite2CloudContext calls CreateSite2CloudContextFunc.
+func (mock *Site2CloudClientMock) CreateSite2CloudContext(ctx context.Context, site2cloud *Site2Cloud) error {
+	if mock.CreateSite2CloudContextFunc == nil {
+		panic("Site2CloudClientMock.CreateSite2CloudContextFunc: method is nil but Site2CloudClient.CreateSite2CloudContext was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Site2cloud *Site2Cloud
+	}{
+		Ctx:        ctx,
+		Site2cloud: site2cloud,
+	}
+	mock.lockCreateSite2CloudContext.Lock()
+	mock.calls.CreateSite2CloudContext = append(mock.calls.CreateSite2CloudContext, callInfo)
+	mock.lockCreateSite2CloudContext.Unlock()
+	return mock.CreateSite2CloudContextFunc(ctx, site2cloud)
+}
+
+// CreateSite2CloudContextCalls gets all the calls that were made to CreateSite2CloudContext.
+// Check the length with:
+//
+//	len(mockedSite2CloudClient.CreateSite2CloudContextCalls())
+func (mock *Site2CloudClientMock) CreateSite2CloudContextCalls() []struct {
+	Ctx        context.Context
+	Site2cloud *Site2Cloud
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Site2cloud *Site2Cloud
+	}
+	mock.lockCreateSite2CloudContext.RLock()
+	calls = mock.calls.CreateSite2CloudContext
+	mock.lockCreateSite2CloudContext.RUnlock()
+	return calls
+}
+
+// DeleteCertInstance calls DeleteCertInstanceFunc.
+func (mock *Site2CloudClientMock) DeleteCertInstance(ctx context.Context, caCertInstance *CaCertInstance) error {
+	if mock.DeleteCertInstanceFunc == nil {
+		panic("Site2CloudClientMock.DeleteCertInstanceFunc: method is nil but Site2CloudClient.DeleteCertInstance was just called")
+	}
+	callInfo := struct {
+		Ctx            context.Context
+		CaCertInstance *CaCertInstance
+	}{
+		Ctx:            ctx,
+		CaCertInstance: caCertInstance,
+	}
+	mock.lockDeleteCertInstance.Lock()
+	mock.calls.DeleteCertInstance = append(mock.calls.DeleteCertInstance, callInfo)
+	mock.lockDeleteCertInstance.Unlock()
+	return mock.DeleteCertInstanceFunc(ctx, caCertInstance)
+}
+
+// DeleteCertInstanceCalls gets all the calls that were made to DeleteCertInstance.
+// Check the length with:
+//
+//	len(mockedSite2CloudClient.DeleteCertInstanceCalls())
+func (mock *Site2CloudClientMock) DeleteCertInstanceCalls() []struct {
+	Ctx            context.Context
+	CaCertInstance *CaCertInstance
+} {
+	var calls []struct {
+		Ctx            context.Context
+		CaCertInstance *CaCertInstance
+	}
+	mock.lockDeleteCertInstance.RLock()
+	calls = mock.calls.DeleteCertInstance
+	mock.lockDeleteCertInstance.RUnlock()
+	return calls
+}
+
+// DeleteSite2Cloud calls DeleteSite2CloudFunc.
+func (mock *Site2CloudClientMock) DeleteSite2Cloud(site2cloud *Site2Cloud) error {
+	if mock.DeleteSite2CloudFunc == nil {
+		panic("Site2CloudClientMock.DeleteSite2CloudFunc: method is nil but Site2CloudClient.DeleteSite2Cloud was just called")
+	}
+	callInfo := struct {
+		Site2cloud *Site2Cloud
+	}{
+		Site2cloud: site2cloud,
+	}
+	mock.lockDeleteSite2Cloud.Lock()
+	mock.calls.DeleteSite2Cloud = append(mock.calls.DeleteSite2Cloud, callInfo)
+	mock.lockDeleteSite2Cloud.Unlock()
+	return mock.DeleteSite2CloudFunc(site2cloud)
+}
+
+// DeleteSite2CloudCalls gets all the calls that were made to DeleteSite2Cloud.
+// Check the length with:
+//
+//	len(mockedSite2CloudClient.DeleteSite2CloudCalls())
+func (mock *Site2CloudClientMock) DeleteSite2CloudCalls() []struct {
+	Site2cloud *Site2Cloud
+} {
+	var calls []struct {
+		Site2cloud *Site2Cloud
+	}
+	mock.lockDeleteSite2Cloud.RLock()
+	calls = mock.calls.DeleteSite2Cloud
+	mock.lockDeleteSite2Cloud.RUnlock()
+	return calls
+}
+
+// DeleteSite2CloudContext calls DeleteSite2CloudContextFunc.
+func (mock *Site2CloudClientMock) DeleteSite2CloudContext(ctx context.Context, site2cloud *Site2Cloud) error {
+	if mock.DeleteSite2CloudContextFunc == nil {
+		panic("Site2CloudClientMock.DeleteSite2CloudContextFunc: method is nil but Site2CloudClient.DeleteSite2CloudContext was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Site2cloud *Site2Cloud
+	}{
+		Ctx:        ctx,
+		Site2cloud: site2cloud,
+	}
+	mock.lockDeleteSite2CloudContext.Lock()
+	mock.calls.DeleteSite2CloudContext = append(mock.calls.DeleteSite2CloudContext, callInfo)
+	mock.lockDeleteSite2CloudContext.Unlock()
+	return mock.DeleteSite2CloudContextFunc(ctx, site2cloud)
+}
+
+// DeleteSite2CloudContextCalls gets all the calls that were made to DeleteSite2CloudContext.
+// Check the length with:
+//
+//	len(mockedSite2CloudClient.DeleteSite2CloudContextCalls())
+func (mock *Site2CloudClientMock) DeleteSite2CloudContextCalls() []struct {
+	Ctx        context.Context
+	Site2cloud *Site2Cloud
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Site2cloud *Site2Cloud
+	}
+	mock.lockDeleteSite2CloudContext.RLock()
+	calls = mock.calls.DeleteSite2CloudContext
+	mock.lockDeleteSite2CloudContext.RUnlock()
+	return calls
+}
+
+// DisableDeadPeerDetection calls DisableDeadPeerDetectionFunc.
+func (mock *Site2CloudClientMock) DisableDeadPeerDetection(site2cloud *Site2Cloud) error {
+	if mock.DisableDeadPeerDetectionFunc == nil {
+		panic("Site2CloudClientMock.DisableDeadPeerDetectionFunc: method is nil but Site2CloudClient.DisableDeadPeerDetection was just called")
+	}
+	callInfo := struct {
+		Site2cloud *Site2Cloud
+	}{
+		Site2cloud: site2cloud,
+	}
+	mock.lockDisableDeadPeerDetection.Lock()
+	mock.calls.DisableDeadPeerDetection = append(mock.calls.DisableDeadPeerDetection, callInfo)
+	mock.lockDisableDeadPeerDetection.Unlock()
+	return mock.DisableDeadPeerDetectionFunc(site2cloud)
+}
+
+// DisableDeadPeerDetectionCalls gets all the calls that were made to DisableDeadPeerDetection.
+// Check the length with:
+//
+//	len(mockedSite2CloudClient.DisableDeadPeerDetectionCalls())
+func (mock *Site2CloudClientMock) DisableDeadPeerDetectionCalls() []struct {
+	Site2cloud *Site2Cloud
+} {
+	var calls []struct {
+		Site2cloud *Site2Cloud
+	}
+	mock.lockDisableDeadPeerDetection.RLock()
+	calls = mock.calls.DisableDeadPeerDetection
+	mock.lockDisableDeadPeerDetection.RUnlock()
+	return calls
+}
+
+// DisableDeadPeerDetectionContext calls DisableDeadPeerDetectionContextFunc.
+func (mock *Site2CloudClientMock) DisableDeadPeerDetectionContext(ctx context.Context, site2cloud *Site2Cloud) error {
+	if mock.DisableDeadPeerDetectionContextFunc == nil {
+		panic("Site2CloudClientMock.DisableDeadPeerDetectionContextFunc: method is nil but Site2CloudClient.DisableDeadPeerDetectionContext was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Site2cloud *Site2Cloud
+	}{
+		Ctx:        ctx,
+		Site2cloud: site2cloud,
+	}
+	mock.lockDisableDeadPeerDetectionContext.Lock()
+	mock.calls.DisableDeadPeerDetectionContext = append(mock.calls.DisableDeadPeerDetectionContext, callInfo)
+	mock.lockDisableDeadPeerDetectionContext.Unlock()
+	return mock.DisableDeadPeerDetectionContextFunc(ctx, site2cloud)
+}
+
+// DisableDeadPeerDetectionContextCalls gets all the calls that were made to DisableDeadPeerDetectionContext.
+// Check the length with:
+//
+//	len(mockedSite2CloudClient.DisableDeadPeerDetectionContextCalls())
+func (mock *Site2CloudClientMock) DisableDeadPeerDetectionContextCalls() []struct {
+	Ctx        context.Context
+	Site2cloud *Site2Cloud
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Site2cloud *Site2Cloud
+	}
+	mock.lockDisableDeadPeerDetectionContext.RLock()
+	calls = mock.calls.DisableDeadPeerDetectionContext
+	mock.lockDisableDeadPeerDetectionContext.RUnlock()
+	return calls
+}
+
+// DisableSite2CloudEventTriggeredHA calls DisableSite2CloudEventTriggeredHAFunc.
+func (mock *Site2CloudClientMock) DisableSite2CloudEventTriggeredHA(vpcID string, connectionName string) error {
+	if mock.DisableSite2CloudEventTriggeredHAFunc == nil {
+		panic("Site2CloudClientMock.DisableSite2CloudEventTriggeredHAFunc: method is nil but Site2CloudClient.DisableSite2CloudEventTriggeredHA was just called")
+	}
+	callInfo := struct {
+		VpcID          string
+		ConnectionName string
+	}{
+		VpcID:          vpcID,
+		ConnectionName: connectionName,
+	}
+	mock.lockDisableSite2CloudEventTriggeredHA.Lock()
+	mock.calls.DisableSite2CloudEventTriggeredHA = append(mock.calls.DisableSite2CloudEventTriggeredHA, callInfo)
+	mock.lockDisableSite2CloudEventTriggeredHA.Unlock()
+	return mock.DisableSite2CloudEventTriggeredHAFunc(vpcID, connectionName)
+}
+
+// DisableSite2CloudEventTriggeredHACalls gets all the calls that were made to DisableSite2CloudEventTriggeredHA.
+// Check the length with:
+//
+//	len(mockedSite2CloudClient.DisableSite2CloudEventTriggeredHACalls())
+func (mock *Site2CloudClientMock) DisableSite2CloudEventTriggeredHACalls() []struct {
+	VpcID          string
+	ConnectionName string
+} {
+	var calls []struct {
+		VpcID          string
+		ConnectionName string
+	}
+	mock.lockDisableSite2CloudEventTriggeredHA.RLock()
+	calls = mock.calls.DisableSite2CloudEventTriggeredHA
+	mock.lockDisableSite2CloudEventTriggeredHA.RUnlock()
+	return calls
+}
+
+// DisableSite2cloudActiveActive calls DisableSite2cloudActiveActiveFunc.
+func (mock *Site2CloudClientMock) DisableSite2cloudActiveActive(site2cloud *Site2Cloud) error {
+	if mock.DisableSite2cloudActiveActiveFunc == nil {
+		panic("Site2CloudClientMock.DisableSite2cloudActiveActiveFunc: method is nil but Site2CloudClient.DisableSite2cloudActiveActive was just called")
+	}
+	callInfo := struct {
+		Site2cloud *Site2Cloud
+	}{
+		Site2cloud: site2cloud,
+	}
+	mock.lockDisableSite2cloudActiveActive.Lock()
+	mock.calls.DisableSite2cloudActiveActive = append(mock.calls.DisableSite2cloudActiveActive, callInfo)
+	mock.lockDisableSite2cloudActiveActive.Unlock()
+	return mock.DisableSite2cloudActiveActiveFunc(site2cloud)
+}
+
+// DisableSite2cloudActiveActiveCalls gets all the calls that were made to DisableSite2cloudActiveActive.
+// Check the length with:
+//
+//	len(mockedSite2CloudClient.DisableSite2cloudActiveActiveCalls())
+func (mock *Site2CloudClientMock) DisableSite2cloudActiveActiveCalls() []struct {
+	Site2cloud *Site2Cloud
+} {
+	var calls []struct {
+		Site2cloud *Site2Cloud
+	}
+	mock.lockDisableSite2cloudActiveActive.RLock()
+	calls = mock.calls.DisableSite2cloudActiveActive
+	mock.lockDisableSite2cloudActiveActive.RUnlock()
+	return calls
+}
+
+// DisableSpokeMappedSite2CloudForwarding calls DisableSpokeMappedSite2CloudForwardingFunc.
+func (mock *Site2CloudClientMock) DisableSpokeMappedSite2CloudForwarding(site2cloud *Site2Cloud) error {
+	if mock.DisableSpokeMappedSite2CloudForwardingFunc == nil {
+		panic("Site2CloudClientMock.DisableSpokeMappedSite2CloudForwardingFunc: method is nil but Site2CloudClient.DisableSpokeMappedSite2CloudForwarding was just called")
+	}
+	callInfo := struct {
+		Site2cloud *Site2Cloud
+	}{
+		Site2cloud: site2cloud,
+	}
+	mock.lockDisableSpokeMappedSite2CloudForwarding.Lock()
+	mock.calls.DisableSpokeMappedSite2CloudForwarding = append(mock.calls.DisableSpokeMappedSite2CloudForwarding, callInfo)
+	mock.lockDisableSpokeMappedSite2CloudForwarding.Unlock()
+	return mock.DisableSpokeMappedSite2CloudForwardingFunc(site2cloud)
+}
+
+// DisableSpokeMappedSite2CloudForwardingCalls gets all the calls that were made to DisableSpokeMappedSite2CloudForwarding.
+// Check the length with:
+//
+//	len(mockedSite2CloudClient.DisableSpokeMappedSite2CloudForwardingCalls())
+func (mock *Site2CloudClientMock) DisableSpokeMappedSite2CloudForwardingCalls() []struct {
+	Site2cloud *Site2Cloud
+} {
+	var calls []struct {
+		Site2cloud *Site2Cloud
+	}
+	mock.lockDisableSpokeMappedSite2CloudForwarding.RLock()
+	calls = mock.calls.DisableSpokeMappedSite2CloudForwarding
+	mock.lockDisableSpokeMappedSite2CloudForwarding.RUnlock()
+	return calls
+}
+
+// EditSite2CloudPhase1LocalIdentifier calls EditSite2CloudPhase1LocalIdentifierFunc.
+func (mock *Site2CloudClientMock) EditSite2CloudPhase1LocalIdentifier(s2c *EditSite2Cloud) error {
+	if mock.EditSite2CloudPhase1LocalIdentifierFunc == nil {
+		panic("Site2CloudClientMock.EditSite2CloudPhase1LocalIdentifierFunc: method is nil but Site2CloudClient.EditSite2CloudPhase1LocalIdentifier was just called")
+	}
+	callInfo := struct {
+		S2c *EditSite2Cloud
+	}{
+		S2c: s2c,
+	}
+	mock.lockEditSite2CloudPhase1LocalIdentifier.Lock()
+	mock.calls.EditSite2CloudPhase1LocalIdentifier = append(mock.calls.EditSite2CloudPhase1LocalIdentifier, callInfo)
+	mock.lockEditSite2CloudPhase1LocalIdentifier.Unlock()
+	return mock.EditSite2CloudPhase1LocalIdentifierFunc(s2c)
+}
+
+// EditSite2CloudPhase1LocalIdentifierCalls gets all the calls that were made to EditSite2CloudPhase1LocalIdentifier.
+// Check the length with:
+//
+//	len(mockedSite2CloudClient.EditSite2CloudPhase1LocalIdentifierCalls())
+func (mock *Site2CloudClientMock) EditSite2CloudPhase1LocalIdentifierCalls() []struct {
+	S2c *EditSite2Cloud
+} {
+	var calls []struct {
+		S2c *EditSite2Cloud
+	}
+	mock.lockEditSite2CloudPhase1LocalIdentifier.RLock()
+	calls = mock.calls.EditSite2CloudPhase1LocalIdentifier
+	mock.lockEditSite2CloudPhase1LocalIdentifier.RUnlock()
+	return calls
+}
+
+// EnableDeadPeerDetection calls EnableDeadPeerDetectionFunc.
+func (mock *Site2CloudClientMock) EnableDeadPeerDetection(site2cloud *Site2Cloud) error {
+	if mock.EnableDeadPeerDetectionFunc == nil {
+		panic("Site2CloudClientMock.EnableDeadPeerDetectionFunc: method is nil but Site2CloudClient.EnableDeadPeerDetection was just called")
+	}
+	callInfo := struct {
+		Site2cloud *Site2Cloud
+	}{
+		Site2cloud: site2cloud,
+	}
+	mock.lockEnableDeadPeerDetection.Lock()
+	mock.calls.EnableDeadPeerDetection = append(mock.calls.EnableDeadPeerDetection, callInfo)
+	mock.lockEnableDeadPeerDetection.Unlock()
+	return mock.EnableDeadPeerDetectionFunc(site2cloud)
+}
+
+// EnableDeadPeerDetectionCalls gets all the calls that were made to EnableDeadPeerDetection.
+// Check the length with:
+//
+//	len(mockedSite2CloudClient.EnableDeadPeerDetectionCalls())
+func (mock *Site2CloudClientMock) EnableDeadPeerDetectionCalls() []struct {
+	Site2cloud *Site2Cloud
+} {
+	var calls []struct {
+		Site2cloud *Site2Cloud
+	}
+	mock.lockEnableDeadPeerDetection.RLock()
+	calls = mock.calls.EnableDeadPeerDetection
+	mock.lockEnableDeadPeerDetection.RUnlock()
+	return calls
+}
+
+// EnableSite2CloudEventTriggeredHA calls EnableSite2CloudEventTriggeredHAFunc.
+func (mock *Site2CloudClientMock) EnableSite2CloudEventTriggeredHA(vpcID string, connectionName string) error {
+	if mock.EnableSite2CloudEventTriggeredHAFunc == nil {
+		panic("Site2CloudClientMock.EnableSite2CloudEventTriggeredHAFunc: method is nil but Site2CloudClient.EnableSite2CloudEventTriggeredHA was just called")
+	}
+	callInfo := struct {
+		VpcID          string
+		ConnectionName string
+	}{
+		VpcID:          vpcID,
+		ConnectionName: connectionName,
+	}
+	mock.lockEnableSite2CloudEventTriggeredHA.Lock()
+	mock.calls.EnableSite2CloudEventTriggeredHA = append(mock.calls.EnableSite2CloudEventTriggeredHA, callInfo)
+	mock.lockEnableSite2CloudEventTriggeredHA.Unlock()
+	return mock.EnableSite2CloudEventTriggeredHAFunc(vpcID, connectionName)
+}
+
+// EnableSite2CloudEventTriggeredHACalls gets all the calls that were made to EnableSite2CloudEventTriggeredHA.
+// Check the length with:
+//
+//	len(mockedSite2CloudClient.EnableSite2CloudEventTriggeredHACalls())
+func (mock *Site2CloudClientMock) EnableSite2CloudEventTriggeredHACalls() []struct {
+	VpcID          string
+	ConnectionName string
+} {
+	var calls []struct {
+		VpcID          string
+		ConnectionName string
+	}
+	mock.lockEnableSite2CloudEventTriggeredHA.RLock()
+	calls = mock.calls.EnableSite2CloudEventTriggeredHA
+	mock.lockEnableSite2CloudEventTriggeredHA.RUnlock()
+	return calls
+}
+
+// EnableSite2cloudActiveActive calls EnableSite2cloudActiveActiveFunc.
+func (mock *Site2CloudClientMock) EnableSite2cloudActiveActive(site2cloud *Site2Cloud) error {
+	if mock.EnableSite2cloudActiveActiveFunc == nil {
+		panic("Site2CloudClientMock.EnableSite2cloudActiveActiveFunc: method is nil but Site2CloudClient.EnableSite2cloudActiveActive was just called")
+	}
+	callInfo := struct {
+		Site2cloud *Site2Cloud
+	}{
+		Site2cloud: site2cloud,
+	}
+	mock.lockEnableSite2cloudActiveActive.Lock()
+	mock.calls.EnableSite2cloudActiveActive = append(mock.calls.EnableSite2cloudActiveActive, callInfo)
+	mock.lockEnableSite2cloudActiveActive.Unlock()
+	return mock.EnableSite2cloudActiveActiveFunc(site2cloud)
+}
+
+// EnableSite2cloudActiveActiveCalls gets all the calls that were made to EnableSite2cloudActiveActive.
+// Check the length with:
+//
+//	len(mockedSite2CloudClient.EnableSite2cloudActiveActiveCalls())
+func (mock *Site2CloudClientMock) EnableSite2cloudActiveActiveCalls() []struct {
+	Site2cloud *Site2Cloud
+} {
+	var calls []struct {
+		Site2cloud *Site2Cloud
+	}
+	mock.lockEnableSite2cloudActiveActive.RLock()
+	calls = mock.calls.EnableSite2cloudActiveActive
+	mock.lockEnableSite2cloudActiveActive.RUnlock()
+	return calls
+}
+
+// EnableSite2cloudActiveActiveContext calls EnableSite2cloudActiveActiveContextFunc.
+func (mock *Site2CloudClientMock) EnableSite2cloudActiveActiveContext(ctx context.Context, site2cloud *Site2Cloud) error {
+	if mock.EnableSite2cloudActiveActiveContextFunc == nil {
+		panic("Site2CloudClientMock.EnableSite2cloudActiveActiveContextFunc: method is nil but Site2CloudClient.EnableSite2cloudActiveActiveContext was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Site2cloud *Site2Cloud
+	}{
+		Ctx:        ctx,
+		Site2cloud: site2cloud,
+	}
+	mock.lockEnableSite2cloudActiveActiveContext.Lock()
+	mock.calls.EnableSite2cloudActiveActiveContext = append(mock.calls.EnableSite2cloudActiveActiveContext, callInfo)
+	mock.lockEnableSite2cloudActiveActiveContext.Unlock()
+	return mock.EnableSite2cloudActiveActiveContextFunc(ctx, site2cloud)
+}
+
+// EnableSite2cloudActiveActiveContextCalls gets all the calls that were made to EnableSite2cloudActiveActiveContext.
+// Check the length with:
+//
+//	len(mockedSite2CloudClient.EnableSite2cloudActiveActiveContextCalls())
+func (mock *Site2CloudClientMock) EnableSite2cloudActiveActiveContextCalls() []struct {
+	Ctx        context.Context
+	Site2cloud *Site2Cloud
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Site2cloud *Site2Cloud
+	}
+	mock.lockEnableSite2cloudActiveActiveContext.RLock()
+	calls = mock.calls.EnableSite2cloudActiveActiveContext
+	mock.lockEnableSite2cloudActiveActiveContext.RUnlock()
+	return calls
+}
+
+// EnableSpokeMappedSite2CloudForwarding calls EnableSpokeMappedSite2CloudForwardingFunc.
+func (mock *Site2CloudClientMock) EnableSpokeMappedSite2CloudForwarding(site2cloud *Site2Cloud) error {
+	if mock.EnableSpokeMappedSite2CloudForwardingFunc == nil {
+		panic("Site2CloudClientMock.EnableSpokeMappedSite2CloudForwardingFunc: method is nil but Site2CloudClient.EnableSpokeMappedSite2CloudForwarding was just called")
+	}
+	callInfo := struct {
+		Site2cloud *Site2Cloud
+	}{
+		Site2cloud: site2cloud,
+	}
+	mock.lockEnableSpokeMappedSite2CloudForwarding.Lock()
+	mock.calls.EnableSpokeMappedSite2CloudForwarding = append(mock.calls.EnableSpokeMappedSite2CloudForwarding, callInfo)
+	mock.lockEnableSpokeMappedSite2CloudForwarding.Unlock()
+	return mock.EnableSpokeMappedSite2CloudForwardingFunc(site2cloud)
+}
+
+// EnableSpokeMappedSite2CloudForwardingCalls gets all the calls that were made to EnableSpokeMappedSite2CloudForwarding.
+// Check the length with:
+//
+//	len(mockedSite2CloudClient.EnableSpokeMappedSite2CloudForwardingCalls())
+func (mock *Site2CloudClientMock) EnableSpokeMappedSite2CloudForwardingCalls() []struct {
+	Site2cloud *Site2Cloud
+} {
+	var calls []struct {
+		Site2cloud *Site2Cloud
+	}
+	mock.lockEnableSpokeMappedSite2CloudForwarding.RLock()
+	calls = mock.calls.EnableSpokeMappedSite2CloudForwarding
+	mock.lockEnableSpokeMappedSite2CloudForwarding.RUnlock()
+	return calls
+}
+
+// GetGateway calls GetGatewayFunc.
+func (mock *Site2CloudClientMock) GetGateway(gateway *Gateway) (*Gateway, error) {
+	if mock.GetGatewayFunc == nil {
+		panic("Site2CloudClientMock.GetGatewayFunc: method is nil but Site2CloudClient.GetGateway was just called")
+	}
+	callInfo := struct {
+		Gateway *Gateway
+	}{
+		Gateway: gateway,
+	}
+	mock.lockGetGateway.Lock()
+	mock.calls.GetGateway = append(mock.calls.GetGateway, callInfo)
+	mock.lockGetGateway.Unlock()
+	return mock.GetGatewayFunc(gateway)
+}
+
+// GetGatewayCalls gets all the calls that were made to GetGateway.
+// Check the length with:
+//
+//	len(mockedSite2CloudClient.GetGatewayCalls())
+func (mock *Site2CloudClientMock) GetGatewayCalls() []struct {
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Gateway *Gateway
+	}
+	mock.lockGetGateway.RLock()
+	calls = mock.calls.GetGateway
+	mock.lockGetGateway.RUnlock()
+	return calls
+}
+
+// GetGatewayContext calls GetGatewayContextFunc.
+func (mock *Site2CloudClientMock) GetGatewayContext(ctx context.Context, gateway *Gateway) (*Gateway, error) {
+	if mock.GetGatewayContextFunc == nil {
+		panic("Site2CloudClientMock.GetGatewayContextFunc: method is nil but Site2CloudClient.GetGatewayContext was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}{
+		Ctx:     ctx,
+		Gateway: gateway,
+	}
+	mock.lockGetGatewayContext.Lock()
+	mock.calls.GetGatewayContext = append(mock.calls.GetGatewayContext, callInfo)
+	mock.lockGetGatewayContext.Unlock()
+	return mock.GetGatewayContextFunc(ctx, gateway)
+}
+
+// GetGatewayContextCalls gets all the calls that were made to GetGatewayContext.
+// Check the length with:
+//
+//	len(mockedSite2CloudClient.GetGatewayContextCalls())
+func (mock *Site2CloudClientMock) GetGatewayContextCalls() []struct {
+	Ctx     context.Context
+	Gateway *Gateway
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Gateway *Gateway
+	}
+	mock.lockGetGatewayContext.RLock()
+	calls = mock.calls.GetGatewayContext
+	mock.lockGetGatewayContext.RUnlock()
+	return calls
+}
+
+// GetS2CCaCertTag calls GetS2CCaCertTagFunc.
+func (mock *Site2CloudClientMock) GetS2CCaCertTag(ctx context.Context, s2cCaCertTag *S2CCaCertTag) (*S2CCaCertTag, error) {
+	if mock.GetS2CCaCertTagFunc == nil {
+		panic("Site2CloudClientMock.GetS2CCaCertTagFunc: method is nil but Site2CloudClient.GetS2CCaCertTag was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		S2cCaCertTag *S2CCaCertTag
+	}{
+		Ctx:          ctx,
+		S2cCaCertTag: s2cCaCertTag,
+	}
+	mock.lockGetS2CCaCertTag.Lock()
+	mock.calls.GetS2CCaCertTag = append(mock.calls.GetS2CCaCertTag, callInfo)
+	mock.lockGetS2CCaCertTag.Unlock()
+	return mock.GetS2CCaCertTagFunc(ctx, s2cCaCertTag)
+}
+
+// GetS2CCaCertTagCalls gets all the calls that were made to GetS2CCaCertTag.
+// Check the length with:
+//
+//	len(mockedSite2CloudClient.GetS2CCaCertTagCalls())
+func (mock *Site2CloudClientMock) GetS2CCaCertTagCalls() []struct {
+	Ctx          context.Context
+	S2cCaCertTag *S2CCaCertTag
+} {
+	var calls []struct {
+		Ctx          context.Context
+		S2cCaCertTag *S2CCaCertTag
+	}
+	mock.lockGetS2CCaCertTag.RLock()
+	calls = mock.calls.GetS2CCaCertTag
+	mock.lockGetS2CCaCertTag.RUnlock()
+	return calls
+}
+
+// GetSite2CloudConnDetail calls GetSite2CloudConnDetailFunc.
+func (mock *Site2CloudClientMock) GetSite2CloudConnDetail(site2cloud *Site2Cloud) (*Site2Cloud, error) {
+	if mock.GetSite2CloudConnDetailFunc == nil {
+		panic("Site2CloudClientMock.GetSite2CloudConnDetailFunc: method is nil but Site2CloudClient.GetSite2CloudConnDetail was just called")
+	}
+	callInfo := struct {
+		Site2cloud *Site2Cloud
+	}{
+		Site2cloud: site2cloud,
+	}
+	mock.lockGetSite2CloudConnDetail.Lock()
+	mock.calls.GetSite2CloudConnDetail = append(mock.calls.GetSite2CloudConnDetail, callInfo)
+	mock.lockGetSite2CloudConnDetail.Unlock()
+	return mock.GetSite2CloudConnDetailFunc(site2cloud)
+}
+
+// GetSite2CloudConnDetailCalls gets all the calls that were made to GetSite2CloudConnDetail.
+// Check the length with:
+//
+//	len(mockedSite2CloudClient.GetSite2CloudConnDetailCalls())
+func (mock *Site2CloudClientMock) GetSite2CloudConnDetailCalls() []struct {
+	Site2cloud *Site2Cloud
+} {
+	var calls []struct {
+		Site2cloud *Site2Cloud
+	}
+	mock.lockGetSite2CloudConnDetail.RLock()
+	calls = mock.calls.GetSite2CloudConnDetail
+	mock.lockGetSite2CloudConnDetail.RUnlock()
+	return calls
+}
+
+// ListSite2CloudContext calls ListSite2CloudContextFunc.
+func (mock *Site2CloudClientMock) ListSite2CloudContext(ctx context.Context) ([]Site2Cloud, error) {
+	if mock.ListSite2CloudContextFunc == nil {
+		panic("Site2CloudClientMock.ListSite2CloudContextFunc: method is nil but Site2CloudClient.ListSite2CloudContext was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListSite2CloudContext.Lock()
+	mock.calls.ListSite2CloudContext = append(mock.calls.ListSite2CloudContext, callInfo)
+	mock.lockListSite2CloudContext.Unlock()
+	return mock.ListSite2CloudContextFunc(ctx)
+}
+
+// ListSite2CloudContextCalls gets all the calls that were made to ListSite2CloudContext.
+// Check the length with:
+//
+//	len(mockedSite2CloudClient.ListSite2CloudContextCalls())
+func (mock *Site2CloudClientMock) ListSite2CloudContextCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListSite2CloudContext.RLock()
+	calls = mock.calls.ListSite2CloudContext
+	mock.lockListSite2CloudContext.RUnlock()
+	return calls
+}
+
+// UpdateSite2Cloud calls UpdateSite2CloudFunc.
+func (mock *Site2CloudClientMock) UpdateSite2Cloud(site2cloud *EditSite2Cloud) error {
+	if mock.UpdateSite2CloudFunc == nil {
+		panic("Site2CloudClientMock.UpdateSite2CloudFunc: method is nil but Site2CloudClient.UpdateSite2Cloud was just called")
+	}
+	callInfo := struct {
+		Site2cloud *EditSite2Cloud
+	}{
+		Site2cloud: site2cloud,
+	}
+	mock.lockUpdateSite2Cloud.Lock()
+	mock.calls.UpdateSite2Cloud = append(mock.calls.UpdateSite2Cloud, callInfo)
+	mock.lockUpdateSite2Cloud.Unlock()
+	return mock.UpdateSite2CloudFunc(site2cloud)
+}
+
+// UpdateSite2CloudCalls gets all the calls that were made to UpdateSite2Cloud.
+// Check the length with:
+//
+//	len(mockedSite2CloudClient.UpdateSite2CloudCalls())
+func (mock *Site2CloudClientMock) UpdateSite2CloudCalls() []struct {
+	Site2cloud *EditSite2Cloud
+} {
+	var calls []struct {
+		Site2cloud *EditSite2Cloud
+	}
+	mock.lockUpdateSite2Cloud.RLock()
+	calls = mock.calls.UpdateSite2Cloud
+	mock.lockUpdateSite2Cloud.RUnlock()
+	return calls
+}
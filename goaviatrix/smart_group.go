@@ -3,7 +3,6 @@ package goaviatrix
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 )
 
@@ -193,7 +192,6 @@ func smartGroupFilterToMapBasic(filter *SmartGroupMatchExpression, keepMaps bool
 		}
 	}
 
-	log.Printf("[DEBUG] POST filter map: %v\n", filterMap)
 	return filterMap
 }
 
@@ -1,8 +1,9 @@
 package goaviatrix
 
 import (
+	"context"
 	"fmt"
-	"log"
+	"log/slog"
 )
 
 type SpokeHaGateway struct {
@@ -40,6 +41,10 @@ type APIRespHaGw struct {
 }
 
 func (c *Client) CreateSpokeHaGw(spokeHaGateway *SpokeHaGateway) (string, error) {
+	return c.CreateSpokeHaGwContext(context.Background(), spokeHaGateway)
+}
+
+func (c *Client) CreateSpokeHaGwContext(ctx context.Context, spokeHaGateway *SpokeHaGateway) (string, error) {
 	spokeHaGateway.CID = c.CID
 	spokeHaGateway.Action = "create_multicloud_ha_gateway"
 	spokeHaGateway.Async = true // Enable async mode
@@ -52,20 +57,20 @@ func (c *Client) CreateSpokeHaGw(spokeHaGateway *SpokeHaGateway) (string, error)
 		}
 	})
 
-	err := c.PostAsyncAPI(spokeHaGateway.Action, spokeHaGateway, BasicCheck, hook)
+	err := c.PostAsyncAPIContext(ctx, spokeHaGateway.Action, spokeHaGateway, BasicCheck, hook)
 	if err != nil {
 		return "", err
 	}
 
 	// If async API returned the HA gateway name, use it
 	if haGwName != "" {
-		log.Printf("[INFO] HA gateway name from async response: %s", haGwName)
+		c.log(ctx, slog.LevelInfo, fmt.Sprintf("HA gateway name from async response: %s", haGwName))
 		return haGwName, nil
 	}
 
 	// If user provided a specific HA gateway name, use it
 	if spokeHaGateway.GwName != "" {
-		log.Printf("[INFO] Using user-provided HA gateway name: %s", spokeHaGateway.GwName)
+		c.log(ctx, slog.LevelInfo, fmt.Sprintf("Using user-provided HA gateway name: %s", spokeHaGateway.GwName))
 		return spokeHaGateway.GwName, nil
 	}
 
@@ -3,11 +3,8 @@ package goaviatrix
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"strings"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	log "github.com/sirupsen/logrus"
 )
 
 type SpokeTransitAttachment struct {
@@ -126,7 +123,7 @@ func (c *Client) GetSpokeTransitAttachmentContext(ctx context.Context, spokeTran
 	checkFunc := func(act, method, reason string, ret bool) error {
 		if !ret {
 			if strings.Contains(reason, "does not exist") {
-				log.Errorf("Couldn't find Spoke Transit Attachment: %s", reason)
+				c.log(ctx, slog.LevelError, fmt.Sprintf("Couldn't find Spoke Transit Attachment: %s", reason))
 				return ErrNotFound
 			}
 			return fmt.Errorf("rest API %s %s failed: %s", act, method, reason)
@@ -158,7 +155,7 @@ func (c *Client) GetSpokeTransitAttachmentContext(ctx context.Context, spokeTran
 		}
 	}
 
-	log.Errorf("Couldn't find spoke transit attachment %s to transit %s", spokeTransitAttachment.SpokeGwName, transitGrpName)
+	c.log(ctx, slog.LevelError, fmt.Sprintf("Couldn't find spoke transit attachment %s to transit %s", spokeTransitAttachment.SpokeGwName, transitGrpName))
 	// ErrNotFound lets Terraform Read clear state on refresh when the spoke exists
 	// but is not attached to this transit (e.g. attach failed or was never applied).
 	return nil, ErrNotFound
@@ -237,28 +234,3 @@ func (c *Client) GetEdgeSpokeTransitAttachment(ctx context.Context, spokeTransit
 
 	return spokeTransitAttachment, nil
 }
-
-func DiffSuppressFuncEdgeSpokeTransitAttachmentEdgeWanInterfaces(k, old, new string, d *schema.ResourceData) bool {
-	o, n := d.GetChange("edge_wan_interfaces")
-
-	oSet, ok := o.(*schema.Set)
-	if !ok {
-		return false
-	}
-	nSet, ok := n.(*schema.Set)
-	if !ok {
-		return false
-	}
-
-	edgeWanInterfacesOld := ExpandStringList(oSet.List())
-	edgeWanInterfacesNew := ExpandStringList(nSet.List())
-
-	defaultWanInterfaces := getStringSet(d, "default_edge_wan_interfaces")
-
-	if (len(edgeWanInterfacesOld) != 0 && Equivalent(edgeWanInterfacesOld, defaultWanInterfaces) && len(edgeWanInterfacesNew) == 0) ||
-		(len(edgeWanInterfacesOld) == 0 && len(edgeWanInterfacesNew) != 0 && Equivalent(edgeWanInterfacesNew, defaultWanInterfaces)) {
-		return true
-	}
-
-	return false
-}
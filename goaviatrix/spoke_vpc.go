@@ -1,6 +1,7 @@
 package goaviatrix
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -102,14 +103,22 @@ type SpokeGatewayAdvancedConfigRespResult struct {
 }
 
 func (c *Client) LaunchSpokeVpc(spoke *SpokeVpc) error {
+	return c.LaunchSpokeVpcContext(context.Background(), spoke)
+}
+
+func (c *Client) LaunchSpokeVpcContext(ctx context.Context, spoke *SpokeVpc) error {
 	spoke.CID = c.CID
 	spoke.Action = "create_multicloud_primary_gateway"
 	spoke.Async = true
 
-	return c.PostAsyncAPI(spoke.Action, spoke, BasicCheck)
+	return c.PostAsyncAPIContext(ctx, spoke.Action, spoke, BasicCheck)
 }
 
 func (c *Client) LaunchSpokeInstance(spoke *SpokeVpc) (string, error) {
+	return c.LaunchSpokeInstanceContext(context.Background(), spoke)
+}
+
+func (c *Client) LaunchSpokeInstanceContext(ctx context.Context, spoke *SpokeVpc) (string, error) {
 	spoke.CID = c.CID
 	spoke.Action = "create_mct_gateway"
 	spoke.Async = true
@@ -154,7 +163,7 @@ func (c *Client) LaunchSpokeInstance(spoke *SpokeVpc) (string, error) {
 		}
 	})
 
-	err := c.PostAsyncAPI(spoke.Action, spoke, BasicCheck, hook)
+	err := c.PostAsyncAPIContext(ctx, spoke.Action, spoke, BasicCheck, hook)
 	if err != nil {
 		return "", err
 	}
@@ -289,7 +298,7 @@ func (c *Client) GetSpokeGatewayAdvancedConfig(spokeGateway *SpokeVpc) (*SpokeGa
 	var standbyConnections []StandbyConnection
 	for k, v := range data.Results.ActiveStandbyStatus {
 		gwType := "Primary"
-		if strings.HasSuffix(v, "-hagw") {
+		if strings.HasSuffix(v, HaGatewaySuffix) {
 			gwType = "HA"
 		}
 
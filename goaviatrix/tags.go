@@ -28,6 +28,26 @@ type IgnoreTagsConfig struct {
 	KeyPrefixes KeyValueTags
 }
 
+// DefaultTagsConfig holds the tags the provider adds to every taggable
+// resource.
+type DefaultTagsConfig struct {
+	Tags KeyValueTags
+}
+
+// MergeTags returns the default tags overridden by tags.
+func (config *DefaultTagsConfig) MergeTags(tags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+	if config != nil {
+		for k, v := range config.Tags {
+			result[k] = v
+		}
+	}
+	for k, v := range tags {
+		result[k] = v
+	}
+	return result
+}
+
 type KeyValueTags map[string]string
 
 func NewIgnoreTags(i any) KeyValueTags {
@@ -55,6 +75,27 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreTagsConfig) KeyValueTags {
 	return result
 }
 
+// RemoveDefaultConfig returns tags without the entries that only come from
+// the default tags, i.e. whose key is a default tag with the same value and
+// not one of the keys in keep.
+func (tags KeyValueTags) RemoveDefaultConfig(config *DefaultTagsConfig, keep KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if config != nil {
+			if dv, ok := config.Tags[k]; ok && dv == v {
+				if _, ok := keep[k]; !ok {
+					continue
+				}
+			}
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValueTags {
 	result := make(KeyValueTags)
 
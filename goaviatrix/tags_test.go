@@ -0,0 +1,26 @@
+package goaviatrix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultTagsConfig_MergeTags(t *testing.T) {
+	config := &DefaultTagsConfig{Tags: KeyValueTags{"owner": "platform", "environment": "prod"}}
+
+	assert.Equal(t, KeyValueTags{"owner": "platform", "environment": "dev", "app": "web"},
+		config.MergeTags(KeyValueTags{"environment": "dev", "app": "web"}))
+	assert.Equal(t, KeyValueTags{"app": "web"}, (*DefaultTagsConfig)(nil).MergeTags(KeyValueTags{"app": "web"}))
+	// The defaults are not modified.
+	assert.Equal(t, "prod", config.Tags["environment"])
+}
+
+func TestKeyValueTags_RemoveDefaultConfig(t *testing.T) {
+	config := &DefaultTagsConfig{Tags: KeyValueTags{"owner": "platform", "environment": "prod", "team": "net"}}
+	tags := KeyValueTags{"owner": "platform", "environment": "dev", "team": "net", "app": "web"}
+
+	assert.Equal(t, KeyValueTags{"environment": "dev", "team": "net", "app": "web"},
+		tags.RemoveDefaultConfig(config, KeyValueTags{"team": "net"}))
+	assert.Equal(t, tags, tags.RemoveDefaultConfig(nil, nil))
+}
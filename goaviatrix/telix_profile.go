@@ -2,9 +2,9 @@ package goaviatrix
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
-	"strings"
 	"time"
 )
 
@@ -208,7 +208,7 @@ func (c *Client) GetTelixProfile(ctx context.Context, profileID string) (*TelixP
 
 	var detail TelixProfileDetail
 	if err := c.GetAPIContext25(ctx, &detail, endpoint, nil); err != nil {
-		if strings.Contains(err.Error(), "does not exist") || strings.Contains(err.Error(), "not found") {
+		if errors.Is(err, ErrNotFound) {
 			return nil, ErrNotFound
 		}
 		return nil, err
@@ -0,0 +1,92 @@
+package goaviatrix
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"net/http"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimit caps how hard the client drives the Controller. The zero value
+// applies no limit.
+type RateLimit struct {
+	// RequestsPerSecond is the sustained rate of HTTP requests, shared by all
+	// goroutines using the client. Zero means unlimited.
+	RequestsPerSecond float64
+	// Burst is the number of requests that may be sent at once before the
+	// rate applies. It defaults to RequestsPerSecond rounded up.
+	Burst int
+	// MaxInFlight is the maximum number of HTTP requests waiting for a
+	// response at any time. Zero means unlimited.
+	MaxInFlight int
+}
+
+// WithRateLimit limits the rate and concurrency of requests sent to the
+// Controller. Retries and re-logins count against the limits like any other
+// request.
+func WithRateLimit(l RateLimit) ClientOption {
+	return func(c *Client) { c.RateLimit = l }
+}
+
+// throttle holds the state enforcing a RateLimit.
+type throttle struct {
+	limiter  *rate.Limiter
+	inFlight chan struct{}
+}
+
+// newThrottle returns the throttle for l, or nil if l sets no limit.
+func newThrottle(l RateLimit) *throttle {
+	if l.RequestsPerSecond <= 0 && l.MaxInFlight <= 0 {
+		return nil
+	}
+	t := &throttle{}
+	if l.RequestsPerSecond > 0 {
+		burst := l.Burst
+		if burst <= 0 {
+			burst = int(math.Ceil(l.RequestsPerSecond))
+		}
+		t.limiter = rate.NewLimiter(rate.Limit(l.RequestsPerSecond), burst)
+	}
+	if l.MaxInFlight > 0 {
+		t.inFlight = make(chan struct{}, l.MaxInFlight)
+	}
+	return t
+}
+
+// throttleMiddleware waits for the rate limiter, then for an in-flight slot,
+// before each HTTP attempt. It runs inside the auth middleware, so the slot is
+// released before a re-login needs one.
+func (c *Client) throttleMiddleware(next Handler) Handler {
+	return func(ctx context.Context, req *Request) (*http.Response, error) {
+		t := c.throttle
+		if t == nil {
+			return next(ctx, req)
+		}
+		if t.limiter != nil {
+			r := t.limiter.Reserve()
+			if delay := r.Delay(); delay > 0 {
+				c.logRequest(ctx, req, slog.LevelDebug, "Rate limit reached, waiting", map[string]any{"delay": delay.String()})
+				timer := time.NewTimer(delay)
+				select {
+				case <-timer.C:
+				case <-ctx.Done():
+					timer.Stop()
+					r.Cancel()
+					return nil, ctx.Err()
+				}
+			}
+		}
+		if t.inFlight != nil {
+			select {
+			case t.inFlight <- struct{}{}:
+				defer func() { <-t.inFlight }()
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		return next(ctx, req)
+	}
+}
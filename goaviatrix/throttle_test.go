@@ -0,0 +1,127 @@
+package goaviatrix
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix/controllertest"
+)
+
+func TestThrottle_MaxInFlight(t *testing.T) {
+	c := &Client{throttle: newThrottle(RateLimit{MaxInFlight: 2})}
+	var inFlight, peak atomic.Int32
+	h := c.throttleMiddleware(func(ctx context.Context, req *Request) (*http.Response, error) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	})
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			_, err := h(context.Background(), &Request{})
+			assert.NoError(t, err)
+		})
+	}
+	wg.Wait()
+	assert.Equal(t, int32(2), peak.Load())
+}
+
+func TestThrottle_RateLimit(t *testing.T) {
+	c := &Client{throttle: newThrottle(RateLimit{RequestsPerSecond: 20, Burst: 1})}
+	h := c.throttleMiddleware(func(ctx context.Context, req *Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	})
+
+	start := time.Now()
+	for range 5 {
+		_, err := h(context.Background(), &Request{})
+		require.NoError(t, err)
+	}
+	// The first request uses the burst; the other four wait 50ms each.
+	assert.GreaterOrEqual(t, time.Since(start), 180*time.Millisecond)
+}
+
+func TestThrottle_WaitHonorsContext(t *testing.T) {
+	c := &Client{throttle: newThrottle(RateLimit{RequestsPerSecond: 0.1, Burst: 1})}
+	h := c.throttleMiddleware(func(ctx context.Context, req *Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	})
+	_, err := h(context.Background(), &Request{})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = h(ctx, &Request{})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestNewThrottle_NoLimit(t *testing.T) {
+	assert.Nil(t, newThrottle(RateLimit{}))
+	assert.Equal(t, 3, newThrottle(RateLimit{RequestsPerSecond: 2.5}).limiter.Burst())
+}
+
+func TestRefreshSession_ConcurrentExpiryLogsInOnce(t *testing.T) {
+	s := controllertest.NewServer()
+	defer s.Close()
+
+	client, err := NewClient(s.Username, s.Password, s.Host(), s.Client(), nil, WithRateLimit(RateLimit{MaxInFlight: 4}))
+	require.NoError(t, err)
+	s.ExpireSessions()
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() {
+			_, err := client.ListSegmentationSecurityDomains()
+			assert.NoError(t, err)
+		})
+	}
+	wg.Wait()
+
+	logins := 0
+	for _, action := range s.Requests() {
+		if action == "login" {
+			logins++
+		}
+	}
+	// One login from NewClient and one shared by every expired request.
+	assert.Equal(t, 2, logins)
+}
+
+func TestLogin_ConcurrentWithRequests(t *testing.T) {
+	s := controllertest.NewServer()
+	defer s.Close()
+
+	client, err := NewClient(s.Username, s.Password, s.Host(), s.Client(), nil)
+	require.NoError(t, err)
+
+	// Requests built while Login replaces the session carry the new CID,
+	// so none of them fails.
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Go(func() {
+			for range 5 {
+				_, err := client.ListSegmentationSecurityDomains()
+				assert.NoError(t, err)
+			}
+		})
+	}
+	for range 3 {
+		assert.NoError(t, client.Login())
+	}
+	wg.Wait()
+}
@@ -0,0 +1,99 @@
+package goaviatrix
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// TLSOptions configure the TLS connection to the Controller.
+type TLSOptions struct {
+	// VerifyCert enables verification of the Controller's certificate chain
+	// and host name. It is off by default since most Controllers use a
+	// self-signed certificate.
+	VerifyCert bool
+	// CACertPath is a file of PEM CA certificates used instead of the system
+	// roots when VerifyCert is set.
+	CACertPath string
+	// ClientCert and ClientKey are the client certificate and key presented
+	// to a proxy that requires mutual TLS. Each is either a file path or PEM
+	// content.
+	ClientCert string
+	ClientKey  string
+	// ServerName overrides the host name used for SNI and certificate
+	// verification, e.g. when the Controller is reached through an IP.
+	ServerName string
+}
+
+// Config builds the tls.Config described by o.
+func (o TLSOptions) Config() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		// whynosemgrep: we need to support insecure TLS
+		// nosemgrep: problem-based-packs.insecure-transport.go-stdlib.bypass-tls-verification.bypass-tls-verification
+		InsecureSkipVerify: !o.VerifyCert, //nolint:gosec // G402: InsecureSkipVerify is controlled by user config
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         o.ServerName,
+	}
+
+	if o.VerifyCert && o.CACertPath != "" {
+		caCert, err := os.ReadFile(o.CACertPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA certificate: %w", err)
+		}
+		caCertPool := x509.NewCertPool()
+		if ok := caCertPool.AppendCertsFromPEM(caCert); !ok {
+			return nil, fmt.Errorf("failed to append CA certificate to pool")
+		}
+		tlsConfig.RootCAs = caCertPool
+	}
+
+	if o.ClientCert != "" || o.ClientKey != "" {
+		if o.ClientCert == "" || o.ClientKey == "" {
+			return nil, errors.New("client certificate and client key must be set together")
+		}
+		certPEM, err := readPEM(o.ClientCert, "client certificate")
+		if err != nil {
+			return nil, err
+		}
+		keyPEM, err := readPEM(o.ClientKey, "client key")
+		if err != nil {
+			return nil, err
+		}
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// NewTransport returns an HTTP transport for the Controller using the proxy
+// from the environment and the TLS settings in o.
+func NewTransport(o TLSOptions) (*http.Transport, error) {
+	tlsConfig, err := o.Config()
+	if err != nil {
+		return nil, err
+	}
+	return &http.Transport{
+		Proxy:           http.ProxyFromEnvironment,
+		TLSClientConfig: tlsConfig,
+	}, nil
+}
+
+// readPEM returns v if it is PEM content, or the content of the file it names.
+func readPEM(v, what string) ([]byte, error) {
+	if strings.Contains(v, "-----BEGIN") {
+		return []byte(v), nil
+	}
+	data, err := os.ReadFile(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", what, err)
+	}
+	return data, nil
+}
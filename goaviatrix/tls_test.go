@@ -0,0 +1,185 @@
+package goaviatrix
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"aviatrix.com/terraform-provider-aviatrix/goaviatrix/controllertest"
+)
+
+// testPKI is a CA with a server certificate for controller.example and a
+// client certificate, all PEM encoded.
+type testPKI struct {
+	caPEM                 []byte
+	serverCert            tls.Certificate
+	clientCert, clientKey []byte
+	pool                  *x509.CertPool
+}
+
+func newTestPKI(t *testing.T) *testPKI {
+	t.Helper()
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	ca, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	issue := func(serial int64, usage x509.ExtKeyUsage, dnsNames ...string) ([]byte, []byte) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: "test"},
+			DNSNames:     dnsNames,
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+		require.NoError(t, err)
+		keyDER, err := x509.MarshalECPrivateKey(key)
+		require.NoError(t, err)
+		return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+			pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	}
+
+	serverCertPEM, serverKeyPEM := issue(2, x509.ExtKeyUsageServerAuth, "controller.example")
+	serverCert, err := tls.X509KeyPair(serverCertPEM, serverKeyPEM)
+	require.NoError(t, err)
+	clientCert, clientKey := issue(3, x509.ExtKeyUsageClientAuth)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ca)
+	return &testPKI{
+		caPEM:      pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}),
+		serverCert: serverCert,
+		clientCert: clientCert,
+		clientKey:  clientKey,
+		pool:       pool,
+	}
+}
+
+// newMTLSController starts a fake Controller that requires a client
+// certificate issued by pki.
+func newMTLSController(t *testing.T, pki *testPKI) (*controllertest.Server, string) {
+	t.Helper()
+	fake := controllertest.NewServer()
+	t.Cleanup(fake.Close)
+	server := httptest.NewUnstartedServer(fake)
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{pki.serverCert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    pki.pool,
+		MinVersion:   tls.VersionTLS12,
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	return fake, u.Host
+}
+
+func TestTLSOptions_MutualTLS(t *testing.T) {
+	pki := newTestPKI(t)
+	fake, host := newMTLSController(t, pki)
+	dir := t.TempDir()
+	caPath := filepath.Join(dir, "ca.pem")
+	keyPath := filepath.Join(dir, "client.key")
+	require.NoError(t, os.WriteFile(caPath, pki.caPEM, 0o600))
+	require.NoError(t, os.WriteFile(keyPath, pki.clientKey, 0o600))
+
+	// The certificate is passed as PEM content and the key as a file path;
+	// both forms are accepted for either.
+	client, err := NewClient(fake.Username, fake.Password, host, nil, nil, WithTLSOptions(TLSOptions{
+		VerifyCert: true,
+		CACertPath: caPath,
+		ClientCert: string(pki.clientCert),
+		ClientKey:  keyPath,
+		ServerName: "controller.example",
+	}))
+	require.NoError(t, err)
+	_, err = client.ListSegmentationSecurityDomains()
+	require.NoError(t, err)
+}
+
+func TestTLSOptions_Failures(t *testing.T) {
+	pki := newTestPKI(t)
+	fake, host := newMTLSController(t, pki)
+	caPath := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caPath, pki.caPEM, 0o600))
+	fast := WithRetryPolicy(RetryPolicy{MaxAttempts: 1})
+
+	tests := []struct {
+		name    string
+		opts    TLSOptions
+		wantErr string
+	}{
+		{
+			name:    "no client certificate",
+			opts:    TLSOptions{},
+			wantErr: "certificate",
+		},
+		{
+			name:    "certificate without key",
+			opts:    TLSOptions{ClientCert: string(pki.clientCert)},
+			wantErr: "client certificate and client key must be set together",
+		},
+		{
+			name:    "missing key file",
+			opts:    TLSOptions{ClientCert: string(pki.clientCert), ClientKey: filepath.Join(t.TempDir(), "missing")},
+			wantErr: "failed to read client key",
+		},
+		{
+			name: "server name does not match",
+			opts: TLSOptions{
+				VerifyCert: true,
+				CACertPath: caPath,
+				ClientCert: string(pki.clientCert),
+				ClientKey:  string(pki.clientKey),
+			},
+			wantErr: "127.0.0.1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewClient(fake.Username, fake.Password, host, nil, nil, fast, WithTLSOptions(tt.opts))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestTLSOptions_DefaultSkipsVerification(t *testing.T) {
+	config, err := TLSOptions{ServerName: "controller.example"}.Config()
+	require.NoError(t, err)
+	assert.True(t, config.InsecureSkipVerify)
+	assert.Equal(t, "controller.example", config.ServerName)
+	assert.Empty(t, config.Certificates)
+	assert.Equal(t, uint16(tls.VersionTLS12), config.MinVersion)
+}
@@ -0,0 +1,75 @@
+package goaviatrix
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.38.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the name of the OpenTelemetry tracer of goaviatrix.
+const TracerName = "aviatrix.com/terraform-provider-aviatrix/goaviatrix"
+
+// Span attributes specific to the Controller API.
+const (
+	AttributeAction    = attribute.Key("aviatrix.action")
+	AttributeRequestID = attribute.Key("aviatrix.request_id")
+	AttributeAttempt   = attribute.Key("aviatrix.attempt")
+	AttributePolls     = attribute.Key("aviatrix.polls")
+)
+
+// WithTracerProvider sets the OpenTelemetry tracer provider of the client.
+// Without it the client uses the tracer provider of the span in the context
+// of a call, or else the global one, which does nothing unless one has been
+// registered with otel.SetTracerProvider.
+func WithTracerProvider(tp trace.TracerProvider) ClientOption {
+	return func(c *Client) { c.TracerProvider = tp }
+}
+
+// tracer returns the tracer for a call made with ctx.
+func (c *Client) tracer(ctx context.Context) trace.Tracer {
+	if c.TracerProvider != nil {
+		return c.TracerProvider.Tracer(TracerName)
+	}
+	return tracer(ctx)
+}
+
+// tracer returns the tracer of the span in ctx, or the global tracer.
+func tracer(ctx context.Context) trace.Tracer {
+	if span := trace.SpanFromContext(ctx); span.SpanContext().IsValid() {
+		return span.TracerProvider().Tracer(TracerName)
+	}
+	return otel.Tracer(TracerName)
+}
+
+// tracingMiddleware records a span for every attempt sent to the controller.
+func (c *Client) tracingMiddleware(next Handler) Handler {
+	return func(ctx context.Context, req *Request) (*http.Response, error) {
+		ctx, span := c.tracer(ctx).Start(ctx, req.Method+" "+req.Action,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(req.Method),
+				semconv.ServerAddress(c.ControllerIP),
+				AttributeAction.String(req.Action),
+				AttributeRequestID.String(req.ID),
+				AttributeAttempt.Int(req.Attempt),
+			),
+		)
+		defer span.End()
+
+		resp, err := next(ctx, req)
+		if err != nil {
+			span.SetStatus(codes.Error, RedactString(err.Error()))
+			return resp, err
+		}
+		span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+		if resp.StatusCode >= http.StatusBadRequest {
+			span.SetStatus(codes.Error, resp.Status)
+		}
+		return resp, nil
+	}
+}
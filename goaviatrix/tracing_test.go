@@ -0,0 +1,70 @@
+package goaviatrix
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func spanAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attrs := make(map[attribute.Key]attribute.Value)
+	for _, kv := range span.Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	return attrs
+}
+
+func TestTracing_RequestSpans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	client := newLoggingTestClient()
+	client.ControllerIP = "controller"
+	WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))(client)
+
+	_, err := client.GetContext(context.Background(), client.baseURL+"?action=list_accounts&CID="+client.CID, nil)
+	require.NoError(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	for i, span := range spans {
+		attrs := spanAttributes(span)
+		assert.Equal(t, "GET list_accounts", span.Name())
+		assert.Equal(t, "list_accounts", attrs[AttributeAction].AsString())
+		assert.Equal(t, int64(i+1), attrs[AttributeAttempt].AsInt64())
+		assert.Equal(t, "controller", attrs["server.address"].AsString())
+		assert.Equal(t, attrs[AttributeRequestID], spanAttributes(spans[0])[AttributeRequestID])
+	}
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Equal(t, int64(200), spanAttributes(spans[1])["http.response.status_code"].AsInt64())
+	assert.Equal(t, codes.Unset, spans[1].Status().Code)
+}
+
+func TestTracing_PollSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "create")
+	p := TaskPoller{Interval: time.Millisecond}
+	polls := 0
+
+	err := p.Wait(ctx, "create_gateway", "req-1", func(ctx context.Context) (bool, error) {
+		polls++
+		return polls == 3, nil
+	})
+	parent.End()
+
+	require.NoError(t, err)
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	poll := spans[0]
+	assert.Equal(t, "poll create_gateway", poll.Name())
+	assert.Equal(t, parent.SpanContext().SpanID(), poll.Parent().SpanID())
+	attrs := spanAttributes(poll)
+	assert.Equal(t, "req-1", attrs[AttributeRequestID].AsString())
+	assert.Equal(t, int64(3), attrs[AttributePolls].AsInt64())
+}
@@ -2,11 +2,8 @@ package goaviatrix
 
 import (
 	"fmt"
-	"reflect"
 	"strconv"
 	"strings"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // ExternalDeviceConn: a simple struct to hold external device connection details
@@ -275,94 +272,6 @@ func (c *Client) DeleteExternalDeviceConn(externalDeviceConn *ExternalDeviceConn
 	return c.PostAPI(externalDeviceConn.Action, externalDeviceConn, BasicCheck)
 }
 
-func ExternalDeviceConnBgpBfdDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
-	// In the case where BFD is disabled, we need to *not* suppress any
-	// diffs to "bgp_bfd", otherwise BFD config can be left in the state.
-	// That can break things, as BFD config is not allowed when BFD is
-	// disabled.
-	enabled, ok := d.Get("enable_bfd").(bool)
-	if !enabled || !ok {
-		return false
-	}
-
-	// You might expect that GetChange("bgp_bfd") would return old and
-	// new lists that could be compared. Unfortunately, it doesn't seem to
-	// work that way. The API can report identical values for "bgp_bfd",
-	// (along with HasChange() == false), while at the same time reporting
-	// a change for the first element ("bgp_bfd.0"). Fortunately for us, we
-	// enforce only a single element in bgp_bfd, so we can just check that.
-	// terraform will auto-populate all defaults - including for an empty
-	// list - so all we need to do is compare the two elements.
-	// The strong consensus on the internet is that SDKv2 was simply not
-	// designed for for this sort of thing and the recommended solution
-	// is to migrate to the plugin framework. Unfortunately that's not
-	// a small undertaking.
-	o, n := d.GetChange("bgp_bfd.0")
-	return reflect.DeepEqual(o, n)
-}
-
-func TransitExternalDeviceConnPh1RemoteIdDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
-	if d.HasChange("ha_enabled") {
-		return false
-	}
-
-	ip, ok := d.Get("remote_gateway_ip").(string)
-	if !ok {
-		return false
-	}
-	ipList := strings.Split(ip, ",")
-
-	haip, ok := d.Get("backup_remote_gateway_ip").(string)
-	if !ok {
-		return false
-	}
-
-	o, n := d.GetChange("phase1_remote_identifier")
-
-	haEnabled, ok := d.Get("ha_enabled").(bool)
-	if !ok {
-		return false
-	}
-
-	oList, ok := o.([]interface{})
-	if !ok {
-		return false
-	}
-	nList, ok := n.([]interface{})
-	if !ok {
-		return false
-	}
-
-	ph1RemoteIdListOld := ExpandStringList(oList)
-	ph1RemoteIdListNew := ExpandStringList(nList)
-
-	if len(ph1RemoteIdListOld) != 0 && len(ph1RemoteIdListNew) != 0 {
-		if haEnabled {
-			if len(ph1RemoteIdListNew) != 2 || len(ph1RemoteIdListOld) != 2 {
-				if len(ph1RemoteIdListNew) == 1 && len(ph1RemoteIdListOld) == 1 {
-					return ph1RemoteIdListOld[0] == ipList[0] && ph1RemoteIdListNew[0] == ipList[0]
-				}
-				return false
-			}
-			return ph1RemoteIdListOld[0] == ipList[0] && ph1RemoteIdListNew[0] == ipList[0] &&
-				strings.TrimSpace(ph1RemoteIdListOld[1]) == haip && strings.TrimSpace(ph1RemoteIdListNew[1]) == haip
-		} else {
-			if len(ph1RemoteIdListNew) == 1 && len(ph1RemoteIdListOld) == 1 {
-				return ph1RemoteIdListOld[0] == ipList[0] && ph1RemoteIdListNew[0] == ipList[0]
-			} else if len(ph1RemoteIdListNew) == 2 && len(ph1RemoteIdListOld) == 2 && len(ipList) == 2 {
-				return strings.TrimSpace(ph1RemoteIdListOld[0]) == strings.TrimSpace(ipList[0]) &&
-					strings.TrimSpace(ph1RemoteIdListOld[1]) == strings.TrimSpace(ipList[1]) &&
-					strings.TrimSpace(ph1RemoteIdListNew[0]) == strings.TrimSpace(ipList[0]) &&
-					strings.TrimSpace(ph1RemoteIdListNew[1]) == strings.TrimSpace(ipList[1])
-			} else {
-				return false
-			}
-		}
-	}
-
-	return false
-}
-
 func CreateBgpBfdConfig(bfd map[string]interface{}) BgpBfdConfig {
 	// Set default values
 	transmitInterval := defaultBfdTransmitInterval
@@ -1,7 +1,9 @@
 package goaviatrix
 
 import (
-	log "github.com/sirupsen/logrus"
+	"context"
+	"fmt"
+	"log/slog"
 )
 
 type TransitFireNetPolicy struct {
@@ -47,8 +49,7 @@ func (c *Client) GetTransitFireNetPolicy(transitFireNetPolicy *TransitFireNetPol
 	}
 
 	if len(data.Results) == 0 {
-		log.Errorf("transit firenet policy between transit firenet gateway: %s and inspected resource name: %s not found",
-			transitFireNetPolicy.TransitFireNetGatewayName, transitFireNetPolicy.InspectedResourceName)
+		c.log(context.Background(), slog.LevelError, fmt.Sprintf("transit firenet policy between transit firenet gateway: %s and inspected resource name: %s not found", transitFireNetPolicy.TransitFireNetGatewayName, transitFireNetPolicy.InspectedResourceName))
 		return ErrNotFound
 	}
 	policyList := data.Results
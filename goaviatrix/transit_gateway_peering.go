@@ -3,10 +3,9 @@ package goaviatrix
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"strconv"
 	"strings"
-
-	log "github.com/sirupsen/logrus"
 )
 
 type TransitGatewayPeering struct {
@@ -112,7 +111,9 @@ func (c *Client) CreateTransitGatewayPeering(ctx context.Context, transitGateway
 	return c.PostAPIContext2(ctx, nil, transitGatewayPeering.Action, transitGatewayPeering, BasicCheck)
 }
 
-func (c *Client) GetTransitGatewayPeering(transitGatewayPeering *TransitGatewayPeering) error {
+// ListTransitGatewayPeerings returns all transit gateway peerings, with the
+// gateway names in the order the Controller reports them.
+func (c *Client) ListTransitGatewayPeerings() ([]TransitGatewayPeering, error) {
 	form := map[string]string{
 		"CID":    c.CID,
 		"action": "list_inter_transit_gateway_peering",
@@ -121,27 +122,34 @@ func (c *Client) GetTransitGatewayPeering(transitGatewayPeering *TransitGatewayP
 	var data TransitGatewayPeeringAPIResp
 
 	err := c.GetAPI(&data, form["action"], form, BasicCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	var peerings []TransitGatewayPeering
+	for i := range data.Results {
+		peerings = append(peerings, data.Results[i]...)
+	}
+	return peerings, nil
+}
+
+func (c *Client) GetTransitGatewayPeering(transitGatewayPeering *TransitGatewayPeering) error {
+	peeringList, err := c.ListTransitGatewayPeerings()
 	if err != nil {
 		return err
 	}
 
-	if len(data.Results) == 0 {
-		log.Errorf("Transit gateway peering with gateways %s and %s not found",
-			transitGatewayPeering.TransitGatewayName1, transitGatewayPeering.TransitGatewayName2)
+	if len(peeringList) == 0 {
+		c.log(context.Background(), slog.LevelError, fmt.Sprintf("Transit gateway peering with gateways %s and %s not found", transitGatewayPeering.TransitGatewayName1, transitGatewayPeering.TransitGatewayName2))
 		return ErrNotFound
 	}
-	peeringList := data.Results
 	for i := range peeringList {
-		for j := range peeringList[i] {
-			if peeringList[i][j].TransitGatewayName1 == transitGatewayPeering.TransitGatewayName1 &&
-				peeringList[i][j].TransitGatewayName2 == transitGatewayPeering.TransitGatewayName2 ||
-				peeringList[i][j].TransitGatewayName1 == transitGatewayPeering.TransitGatewayName2 &&
-					peeringList[i][j].TransitGatewayName2 == transitGatewayPeering.TransitGatewayName1 {
-				log.Debugf("Found %s<->%s transit gateway peering: %#v",
-					transitGatewayPeering.TransitGatewayName1,
-					transitGatewayPeering.TransitGatewayName2, peeringList[i][j])
-				return nil
-			}
+		if peeringList[i].TransitGatewayName1 == transitGatewayPeering.TransitGatewayName1 &&
+			peeringList[i].TransitGatewayName2 == transitGatewayPeering.TransitGatewayName2 ||
+			peeringList[i].TransitGatewayName1 == transitGatewayPeering.TransitGatewayName2 &&
+				peeringList[i].TransitGatewayName2 == transitGatewayPeering.TransitGatewayName1 {
+			c.log(context.Background(), slog.LevelDebug, fmt.Sprintf("Found %s<->%s transit gateway peering: %#v", transitGatewayPeering.TransitGatewayName1, transitGatewayPeering.TransitGatewayName2, Redact(peeringList[i])))
+			return nil
 		}
 	}
 	return ErrNotFound
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"log/slog"
 	"os"
 )
 
@@ -52,13 +53,17 @@ type BackupLinkInterface struct {
 }
 
 func (c *Client) CreateTransitHaGw(transitHaGateway *TransitHaGateway) (string, error) {
+	return c.CreateTransitHaGwContext(context.Background(), transitHaGateway)
+}
+
+func (c *Client) CreateTransitHaGwContext(ctx context.Context, transitHaGateway *TransitHaGateway) (string, error) {
 	transitHaGateway.CID = c.CID
 	transitHaGateway.Action = "create_multicloud_ha_gateway"
 	var data CreateEdgeEquinixResp
 	var resp string
 	if IsCloudType(transitHaGateway.CloudType, EdgeRelatedCloudTypes) {
 		var err error
-		resp, err = c.PostAPIContext2HaGw(context.Background(), &data, transitHaGateway.Action, transitHaGateway, BasicCheck)
+		resp, err = c.PostAPIContext2HaGw(ctx, &data, transitHaGateway.Action, transitHaGateway, BasicCheck)
 		if err != nil {
 			return "", err
 		}
@@ -73,7 +78,7 @@ func (c *Client) CreateTransitHaGw(transitHaGateway *TransitHaGateway) (string,
 			}
 		})
 
-		err := c.PostAsyncAPI(transitHaGateway.Action, transitHaGateway, BasicCheck, hook)
+		err := c.PostAsyncAPIContext(ctx, transitHaGateway.Action, transitHaGateway, BasicCheck, hook)
 		if err != nil {
 			return "", err
 		}
@@ -122,8 +127,8 @@ func (c *Client) CreateTransitHaGw(transitHaGateway *TransitHaGateway) (string,
 				return "", fmt.Errorf("failed to write binary content to ISO file %s: %w", fileName, err)
 			}
 
-			fmt.Printf("[DEBUG] CreateTransitHaGw: Successfully wrote %d bytes (decoded from %d base64 chars) to %s\n",
-				len(decodedBytes), len(data.Result), fileName)
+			c.log(ctx, slog.LevelDebug, fmt.Sprintf("CreateTransitHaGw: Successfully wrote %d bytes (decoded from %d base64 chars) to %s",
+				len(decodedBytes), len(data.Result), fileName))
 		} else {
 			fileName = getFileName(transitHaGateway.ZtpFileDownloadPath, transitHaGateway.GwName, transitHaGateway.VpcID)
 
@@ -6,7 +6,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"log"
+	"log/slog"
 	"os"
 	"strconv"
 	"strings"
@@ -189,10 +189,14 @@ type TransitGatewayBgpLanIpInfo struct {
 }
 
 func (c *Client) LaunchTransitVpc(gateway *TransitVpc) error {
+	return c.LaunchTransitVpcContext(context.Background(), gateway)
+}
+
+func (c *Client) LaunchTransitVpcContext(ctx context.Context, gateway *TransitVpc) error {
 	gateway.CID = c.CID
 	gateway.Action = "create_multicloud_primary_gateway"
 	var data CreateEdgeEquinixResp
-	err := c.PostAPIWithResponse(&data, gateway.Action, gateway, BasicCheck)
+	err := c.PostAPIContextWithResponse(ctx, &data, gateway.Action, gateway, BasicCheck)
 	if err != nil {
 		return err
 	}
@@ -237,6 +241,10 @@ func (c *Client) LaunchTransitVpc(gateway *TransitVpc) error {
 // LaunchTransitInstance creates a transit gateway instance using the new create_mct_gateway API.
 // This API handles both primary and HA gateway creation based on the group state.
 func (c *Client) LaunchTransitInstance(gateway *TransitVpc) (string, error) {
+	return c.LaunchTransitInstanceContext(context.Background(), gateway)
+}
+
+func (c *Client) LaunchTransitInstanceContext(ctx context.Context, gateway *TransitVpc) (string, error) {
 	gateway.CID = c.CID
 	gateway.Action = "create_mct_gateway"
 	gateway.Async = true
@@ -287,7 +295,7 @@ func (c *Client) LaunchTransitInstance(gateway *TransitVpc) (string, error) {
 		}
 	})
 
-	err := c.PostAsyncAPI(gateway.Action, gateway, BasicCheck, hook)
+	err := c.PostAsyncAPIContext(ctx, gateway.Action, gateway, BasicCheck, hook)
 	if err != nil {
 		return "", err
 	}
@@ -420,7 +428,7 @@ func (c *Client) UpdateEdgeGateway(gateway *TransitVpc) error {
 	if !ok {
 		return fmt.Errorf("form[action] is not a string, got type %T", form["action"])
 	}
-	log.Printf("Formm details: %v", form)
+	c.log(context.Background(), slog.LevelInfo, fmt.Sprintf("Form details: %v", Redact(form)))
 	return c.PostAPI(action, form, BasicCheck)
 }
 
@@ -682,7 +690,7 @@ func (c *Client) GetTransitGatewayAdvancedConfig(transitGateway *TransitVpc) (*T
 	var standbyConnections []StandbyConnection
 	for k, v := range data.Results.ActiveStandbyStatus {
 		gwType := "Primary"
-		if strings.HasSuffix(v, "-hagw") {
+		if strings.HasSuffix(v, HaGatewaySuffix) {
 			gwType = "HA"
 		}
 
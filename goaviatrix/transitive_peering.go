@@ -1,13 +1,12 @@
 package goaviatrix
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
-
+	"log/slog"
 	//"github.com/davecgh/go-spew/spew"
-
-	log "github.com/sirupsen/logrus"
 )
 
 // TransPeer simple struct to hold transitive peering details
@@ -76,10 +75,7 @@ func (c *Client) GetTransPeer(transPeer *TransPeer) (*TransPeer, error) {
 		}
 	}
 
-	log.Errorf(
-		"Transitive peering with gateways %s and %s with subnet %s not found",
-		transPeer.Source, transPeer.Nexthop, transPeer.ReachableCidr,
-	)
+	c.log(context.Background(), slog.LevelError, fmt.Sprintf("Transitive peering with gateways %s and %s with subnet %s not found", transPeer.Source, transPeer.Nexthop, transPeer.ReachableCidr))
 	return nil, ErrNotFound
 }
 
@@ -3,7 +3,9 @@ package goaviatrix
 // Tunnel simple struct to hold tunnel details
 
 import (
-	log "github.com/sirupsen/logrus"
+	"context"
+	"fmt"
+	"log/slog"
 )
 
 type Tunnel struct {
@@ -59,11 +61,11 @@ func (c *Client) GetTunnel(tunnel *Tunnel) (*Tunnel, error) {
 	tunList := data.Results.PairList
 	for i := range tunList {
 		if matchesTunnelPair(tunList[i].VpcName1, tunList[i].VpcName2, tunnel.VpcName1, tunnel.VpcName2, grpName1, grpName2) {
-			log.Debugf("Found %s~%s tunnel: %#v", tunnel.VpcName1, tunnel.VpcName2, tunList[i])
+			c.log(context.Background(), slog.LevelDebug, fmt.Sprintf("Found %s~%s tunnel: %#v", tunnel.VpcName1, tunnel.VpcName2, tunList[i]))
 			return &tunList[i], nil
 		}
 	}
-	log.Errorf("Tunnel with gateways %s and %s not found", tunnel.VpcName1, tunnel.VpcName2)
+	c.log(context.Background(), slog.LevelError, fmt.Sprintf("Tunnel with gateways %s and %s not found", tunnel.VpcName1, tunnel.VpcName2))
 	return nil, ErrNotFound
 }
 
@@ -7,10 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
-	"strconv"
 	"strings"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 var ErrNotFound = fmt.Errorf("ErrNotFound")
@@ -23,6 +20,14 @@ func (d DuplicateError) Error() string {
 	return d.Err.Error()
 }
 
+func (d DuplicateError) Unwrap() error {
+	return d.Err
+}
+
+func (d DuplicateError) Is(target error) bool {
+	return target == ErrAlreadyExists
+}
+
 func ExpandStringList(configured []interface{}) []string {
 	vs := make([]string, 0, len(configured))
 	for _, v := range configured {
@@ -209,23 +214,6 @@ func CompareMapOfInterface(map1 map[string]interface{}, map2 map[string]interfac
 	return true
 }
 
-func ValidateASN(val interface{}, key string) (warns []string, errs []error) {
-	v, ok := val.(string)
-	if !ok {
-		errs = append(errs, fmt.Errorf("%q must be of type string", key))
-		return
-	}
-
-	asNum, err := strconv.ParseInt(v, 10, 64)
-
-	if err != nil || asNum < int64(1) || asNum > int64(4294967294) {
-		errs = append(errs, fmt.Errorf("%q must be an integer in 1-4294967294, got: %s", key, val))
-		return
-	}
-
-	return warns, errs
-}
-
 func ValidateAttachedVPCsForCustomizedRoutes(a, b [][]string) ([][]string, [][]string) {
 	aa := make([][]string, 0)
 	bb := make([][]string, 0)
@@ -262,41 +250,6 @@ func IsCloudType(cloudType, compare int) bool {
 	return cloudType&compare != 0
 }
 
-func ValidateRtbId(val interface{}, key string) (warns []string, errs []error) {
-	v, ok := val.(string)
-	if !ok {
-		errs = append(errs, fmt.Errorf("%q must be of type string", key))
-		return
-	}
-
-	if !strings.HasPrefix(v, "rtb-") {
-		errs = append(errs, fmt.Errorf("%q must has a prefix 'rtb-', got: %s", key, val))
-		return
-	}
-
-	return warns, errs
-}
-
-// getStringSet will convert a TypeSet attribute to a slice of string
-func getStringSet(d *schema.ResourceData, k string) []string {
-	raw := d.Get(k)
-
-	set, ok := raw.(*schema.Set)
-	if !ok || set == nil {
-		return nil
-	}
-
-	sl := make([]string, 0, set.Len())
-	for _, v := range set.List() {
-		s, ok := v.(string)
-		if !ok {
-			continue
-		}
-		sl = append(sl, s)
-	}
-	return sl
-}
-
 // MapContains returns true if the key parameter has an entry in the m map
 func MapContains(m map[string]interface{}, key string) bool {
 	val, exists := m[key]
@@ -65,6 +65,8 @@ func (c *Client) GetVersionInfo() (*VersionInfo, error) {
 	}, nil
 }
 
+// GetCompatibleImageVersion returns the image version compatible with the
+// software version. The answer is cached for the life of the client.
 func (c *Client) GetCompatibleImageVersion(ctx context.Context, cloudType int, softwareVersion string) (string, error) {
 	form := map[string]string{
 		"action":           "get_compatible_image_version",
@@ -72,14 +74,16 @@ func (c *Client) GetCompatibleImageVersion(ctx context.Context, cloudType int, s
 		"software_version": softwareVersion,
 		"cloud_type":       strconv.Itoa(cloudType),
 	}
-	var data struct {
-		Results struct {
-			ImageVersion string `json:"image_version"`
+	return cachedCapability(&c.capabilities, capabilityKey(form["action"], form["cloud_type"], softwareVersion), func() (string, error) {
+		var data struct {
+			Results struct {
+				ImageVersion string `json:"image_version"`
+			}
 		}
-	}
-	err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
-	if err != nil {
-		return "", err
-	}
-	return data.Results.ImageVersion, nil
+		err := c.GetAPIContext(ctx, &data, form["action"], form, BasicCheck)
+		if err != nil {
+			return "", err
+		}
+		return data.Results.ImageVersion, nil
+	})
 }
@@ -1,9 +1,10 @@
 package goaviatrix
 
 import (
+	"context"
+	"fmt"
+	"log/slog"
 	"math"
-
-	log "github.com/sirupsen/logrus"
 )
 
 type VpcTracker struct {
@@ -73,7 +74,7 @@ func (c *Client) GetVpcTracker() ([]*VpcTracker, error) {
 		}
 
 		vpcList = append(vpcList, &VpcTracker{
-			CloudType:     vendorNameToCloudType(vpc.VendorName),
+			CloudType:     c.vendorNameToCloudType(vpc.VendorName),
 			VpcID:         vpc.VpcID,
 			AccountName:   vpc.AccountName,
 			Region:        vpc.Region,
@@ -87,7 +88,7 @@ func (c *Client) GetVpcTracker() ([]*VpcTracker, error) {
 	return vpcList, nil
 }
 
-func vendorNameToCloudType(v string) int {
+func (c *Client) vendorNameToCloudType(v string) int {
 	vendorToCloud := map[string]int{
 		ShorthandAWSVendorName:           AWS,
 		ShorthandGOOGLEVendorName:        GCP,
@@ -101,7 +102,7 @@ func vendorNameToCloudType(v string) int {
 	}
 	ct, ok := vendorToCloud[v]
 	if !ok {
-		log.Errorf("could not map vendor name to cloud type with vendor=%s", v)
+		c.log(context.Background(), slog.LevelError, fmt.Sprintf("could not map vendor name to cloud type with vendor=%s", v))
 		return 0
 	}
 	return ct
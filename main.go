@@ -1,7 +1,11 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"fmt"
+	"os"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 
@@ -9,16 +13,35 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "generate-imports" {
+		if err := aviatrix.GenerateImportsCommand(context.Background(), os.Args[2:], os.Stdout); err != nil {
+			fmt.Fprintf(os.Stderr, "generate-imports: %s\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
+	stopTracing, err := aviatrix.StartTracing(context.Background())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "tracing: %s\n", err)
+		os.Exit(1)
+	}
 	opts := &plugin.ServeOpts{
-		Debug:        debug,
-		ProviderFunc: aviatrix.Provider,
-		ProviderAddr: "AviatrixSystems/aviatrix",
+		Debug:            debug,
+		GRPCProviderFunc: aviatrix.ProviderServer,
+		ProviderAddr:     "AviatrixSystems/aviatrix",
 	}
 
 	plugin.Serve(opts)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := stopTracing(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "tracing: %s\n", err)
+	}
 }